@@ -0,0 +1,554 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/app/runtime/v1alpha1/extension.proto
+
+package runtimev1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ExtensionModule_Describe_FullMethodName        = "/cosmos.app.runtime.v1alpha1.ExtensionModule/Describe"
+	ExtensionModule_DefaultGenesis_FullMethodName  = "/cosmos.app.runtime.v1alpha1.ExtensionModule/DefaultGenesis"
+	ExtensionModule_ValidateGenesis_FullMethodName = "/cosmos.app.runtime.v1alpha1.ExtensionModule/ValidateGenesis"
+	ExtensionModule_InitGenesis_FullMethodName     = "/cosmos.app.runtime.v1alpha1.ExtensionModule/InitGenesis"
+	ExtensionModule_ExportGenesis_FullMethodName   = "/cosmos.app.runtime.v1alpha1.ExtensionModule/ExportGenesis"
+	ExtensionModule_BeginBlock_FullMethodName      = "/cosmos.app.runtime.v1alpha1.ExtensionModule/BeginBlock"
+	ExtensionModule_EndBlock_FullMethodName        = "/cosmos.app.runtime.v1alpha1.ExtensionModule/EndBlock"
+)
+
+// ExtensionModuleClient is the client API for ExtensionModule service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExtensionModuleClient interface {
+	// Describe returns the name and the capabilities of the module.
+	Describe(ctx context.Context, in *ExtensionDescribeRequest, opts ...grpc.CallOption) (*ExtensionDescribeResponse, error)
+	// DefaultGenesis returns the default genesis state of the module.
+	DefaultGenesis(ctx context.Context, in *ExtensionDefaultGenesisRequest, opts ...grpc.CallOption) (*ExtensionDefaultGenesisResponse, error)
+	// ValidateGenesis validates a genesis state of the module.
+	ValidateGenesis(ctx context.Context, in *ExtensionValidateGenesisRequest, opts ...grpc.CallOption) (*ExtensionValidateGenesisResponse, error)
+	// InitGenesis initializes the module state from a genesis state.
+	InitGenesis(ctx context.Context, in *ExtensionInitGenesisRequest, opts ...grpc.CallOption) (*ExtensionInitGenesisResponse, error)
+	// ExportGenesis exports the module state as a genesis state.
+	ExportGenesis(ctx context.Context, in *ExtensionExportGenesisRequest, opts ...grpc.CallOption) (*ExtensionExportGenesisResponse, error)
+	// BeginBlock is called before the transactions of a block are processed.
+	BeginBlock(ctx context.Context, in *ExtensionBlockRequest, opts ...grpc.CallOption) (*ExtensionBlockResponse, error)
+	// EndBlock is called after the transactions of a block are processed.
+	EndBlock(ctx context.Context, in *ExtensionBlockRequest, opts ...grpc.CallOption) (*ExtensionBlockResponse, error)
+}
+
+type extensionModuleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExtensionModuleClient(cc grpc.ClientConnInterface) ExtensionModuleClient {
+	return &extensionModuleClient{cc}
+}
+
+func (c *extensionModuleClient) Describe(ctx context.Context, in *ExtensionDescribeRequest, opts ...grpc.CallOption) (*ExtensionDescribeResponse, error) {
+	out := new(ExtensionDescribeResponse)
+	err := c.cc.Invoke(ctx, ExtensionModule_Describe_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionModuleClient) DefaultGenesis(ctx context.Context, in *ExtensionDefaultGenesisRequest, opts ...grpc.CallOption) (*ExtensionDefaultGenesisResponse, error) {
+	out := new(ExtensionDefaultGenesisResponse)
+	err := c.cc.Invoke(ctx, ExtensionModule_DefaultGenesis_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionModuleClient) ValidateGenesis(ctx context.Context, in *ExtensionValidateGenesisRequest, opts ...grpc.CallOption) (*ExtensionValidateGenesisResponse, error) {
+	out := new(ExtensionValidateGenesisResponse)
+	err := c.cc.Invoke(ctx, ExtensionModule_ValidateGenesis_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionModuleClient) InitGenesis(ctx context.Context, in *ExtensionInitGenesisRequest, opts ...grpc.CallOption) (*ExtensionInitGenesisResponse, error) {
+	out := new(ExtensionInitGenesisResponse)
+	err := c.cc.Invoke(ctx, ExtensionModule_InitGenesis_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionModuleClient) ExportGenesis(ctx context.Context, in *ExtensionExportGenesisRequest, opts ...grpc.CallOption) (*ExtensionExportGenesisResponse, error) {
+	out := new(ExtensionExportGenesisResponse)
+	err := c.cc.Invoke(ctx, ExtensionModule_ExportGenesis_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionModuleClient) BeginBlock(ctx context.Context, in *ExtensionBlockRequest, opts ...grpc.CallOption) (*ExtensionBlockResponse, error) {
+	out := new(ExtensionBlockResponse)
+	err := c.cc.Invoke(ctx, ExtensionModule_BeginBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionModuleClient) EndBlock(ctx context.Context, in *ExtensionBlockRequest, opts ...grpc.CallOption) (*ExtensionBlockResponse, error) {
+	out := new(ExtensionBlockResponse)
+	err := c.cc.Invoke(ctx, ExtensionModule_EndBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExtensionModuleServer is the server API for ExtensionModule service.
+// All implementations must embed UnimplementedExtensionModuleServer
+// for forward compatibility
+type ExtensionModuleServer interface {
+	// Describe returns the name and the capabilities of the module.
+	Describe(context.Context, *ExtensionDescribeRequest) (*ExtensionDescribeResponse, error)
+	// DefaultGenesis returns the default genesis state of the module.
+	DefaultGenesis(context.Context, *ExtensionDefaultGenesisRequest) (*ExtensionDefaultGenesisResponse, error)
+	// ValidateGenesis validates a genesis state of the module.
+	ValidateGenesis(context.Context, *ExtensionValidateGenesisRequest) (*ExtensionValidateGenesisResponse, error)
+	// InitGenesis initializes the module state from a genesis state.
+	InitGenesis(context.Context, *ExtensionInitGenesisRequest) (*ExtensionInitGenesisResponse, error)
+	// ExportGenesis exports the module state as a genesis state.
+	ExportGenesis(context.Context, *ExtensionExportGenesisRequest) (*ExtensionExportGenesisResponse, error)
+	// BeginBlock is called before the transactions of a block are processed.
+	BeginBlock(context.Context, *ExtensionBlockRequest) (*ExtensionBlockResponse, error)
+	// EndBlock is called after the transactions of a block are processed.
+	EndBlock(context.Context, *ExtensionBlockRequest) (*ExtensionBlockResponse, error)
+	mustEmbedUnimplementedExtensionModuleServer()
+}
+
+// UnimplementedExtensionModuleServer must be embedded to have forward compatible implementations.
+type UnimplementedExtensionModuleServer struct {
+}
+
+func (UnimplementedExtensionModuleServer) Describe(context.Context, *ExtensionDescribeRequest) (*ExtensionDescribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Describe not implemented")
+}
+func (UnimplementedExtensionModuleServer) DefaultGenesis(context.Context, *ExtensionDefaultGenesisRequest) (*ExtensionDefaultGenesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DefaultGenesis not implemented")
+}
+func (UnimplementedExtensionModuleServer) ValidateGenesis(context.Context, *ExtensionValidateGenesisRequest) (*ExtensionValidateGenesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateGenesis not implemented")
+}
+func (UnimplementedExtensionModuleServer) InitGenesis(context.Context, *ExtensionInitGenesisRequest) (*ExtensionInitGenesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitGenesis not implemented")
+}
+func (UnimplementedExtensionModuleServer) ExportGenesis(context.Context, *ExtensionExportGenesisRequest) (*ExtensionExportGenesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportGenesis not implemented")
+}
+func (UnimplementedExtensionModuleServer) BeginBlock(context.Context, *ExtensionBlockRequest) (*ExtensionBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginBlock not implemented")
+}
+func (UnimplementedExtensionModuleServer) EndBlock(context.Context, *ExtensionBlockRequest) (*ExtensionBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndBlock not implemented")
+}
+func (UnimplementedExtensionModuleServer) mustEmbedUnimplementedExtensionModuleServer() {}
+
+// UnsafeExtensionModuleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExtensionModuleServer will
+// result in compilation errors.
+type UnsafeExtensionModuleServer interface {
+	mustEmbedUnimplementedExtensionModuleServer()
+}
+
+func RegisterExtensionModuleServer(s grpc.ServiceRegistrar, srv ExtensionModuleServer) {
+	s.RegisterService(&ExtensionModule_ServiceDesc, srv)
+}
+
+func _ExtensionModule_Describe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtensionDescribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionModuleServer).Describe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionModule_Describe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionModuleServer).Describe(ctx, req.(*ExtensionDescribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionModule_DefaultGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtensionDefaultGenesisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionModuleServer).DefaultGenesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionModule_DefaultGenesis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionModuleServer).DefaultGenesis(ctx, req.(*ExtensionDefaultGenesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionModule_ValidateGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtensionValidateGenesisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionModuleServer).ValidateGenesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionModule_ValidateGenesis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionModuleServer).ValidateGenesis(ctx, req.(*ExtensionValidateGenesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionModule_InitGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtensionInitGenesisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionModuleServer).InitGenesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionModule_InitGenesis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionModuleServer).InitGenesis(ctx, req.(*ExtensionInitGenesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionModule_ExportGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtensionExportGenesisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionModuleServer).ExportGenesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionModule_ExportGenesis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionModuleServer).ExportGenesis(ctx, req.(*ExtensionExportGenesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionModule_BeginBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtensionBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionModuleServer).BeginBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionModule_BeginBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionModuleServer).BeginBlock(ctx, req.(*ExtensionBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionModule_EndBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtensionBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionModuleServer).EndBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionModule_EndBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionModuleServer).EndBlock(ctx, req.(*ExtensionBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExtensionModule_ServiceDesc is the grpc.ServiceDesc for ExtensionModule service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExtensionModule_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.app.runtime.v1alpha1.ExtensionModule",
+	HandlerType: (*ExtensionModuleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Describe",
+			Handler:    _ExtensionModule_Describe_Handler,
+		},
+		{
+			MethodName: "DefaultGenesis",
+			Handler:    _ExtensionModule_DefaultGenesis_Handler,
+		},
+		{
+			MethodName: "ValidateGenesis",
+			Handler:    _ExtensionModule_ValidateGenesis_Handler,
+		},
+		{
+			MethodName: "InitGenesis",
+			Handler:    _ExtensionModule_InitGenesis_Handler,
+		},
+		{
+			MethodName: "ExportGenesis",
+			Handler:    _ExtensionModule_ExportGenesis_Handler,
+		},
+		{
+			MethodName: "BeginBlock",
+			Handler:    _ExtensionModule_BeginBlock_Handler,
+		},
+		{
+			MethodName: "EndBlock",
+			Handler:    _ExtensionModule_EndBlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/app/runtime/v1alpha1/extension.proto",
+}
+
+const (
+	ExtensionHost_Get_FullMethodName     = "/cosmos.app.runtime.v1alpha1.ExtensionHost/Get"
+	ExtensionHost_Set_FullMethodName     = "/cosmos.app.runtime.v1alpha1.ExtensionHost/Set"
+	ExtensionHost_Delete_FullMethodName  = "/cosmos.app.runtime.v1alpha1.ExtensionHost/Delete"
+	ExtensionHost_Iterate_FullMethodName = "/cosmos.app.runtime.v1alpha1.ExtensionHost/Iterate"
+)
+
+// ExtensionHostClient is the client API for ExtensionHost service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExtensionHostClient interface {
+	// Get returns the value stored under a key.
+	Get(ctx context.Context, in *ExtensionHostGetRequest, opts ...grpc.CallOption) (*ExtensionHostGetResponse, error)
+	// Set stores a value under a key.
+	Set(ctx context.Context, in *ExtensionHostSetRequest, opts ...grpc.CallOption) (*ExtensionHostSetResponse, error)
+	// Delete removes a key.
+	Delete(ctx context.Context, in *ExtensionHostDeleteRequest, opts ...grpc.CallOption) (*ExtensionHostDeleteResponse, error)
+	// Iterate returns the key-value pairs in the [start, end) range.
+	Iterate(ctx context.Context, in *ExtensionHostIterateRequest, opts ...grpc.CallOption) (*ExtensionHostIterateResponse, error)
+}
+
+type extensionHostClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExtensionHostClient(cc grpc.ClientConnInterface) ExtensionHostClient {
+	return &extensionHostClient{cc}
+}
+
+func (c *extensionHostClient) Get(ctx context.Context, in *ExtensionHostGetRequest, opts ...grpc.CallOption) (*ExtensionHostGetResponse, error) {
+	out := new(ExtensionHostGetResponse)
+	err := c.cc.Invoke(ctx, ExtensionHost_Get_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionHostClient) Set(ctx context.Context, in *ExtensionHostSetRequest, opts ...grpc.CallOption) (*ExtensionHostSetResponse, error) {
+	out := new(ExtensionHostSetResponse)
+	err := c.cc.Invoke(ctx, ExtensionHost_Set_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionHostClient) Delete(ctx context.Context, in *ExtensionHostDeleteRequest, opts ...grpc.CallOption) (*ExtensionHostDeleteResponse, error) {
+	out := new(ExtensionHostDeleteResponse)
+	err := c.cc.Invoke(ctx, ExtensionHost_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionHostClient) Iterate(ctx context.Context, in *ExtensionHostIterateRequest, opts ...grpc.CallOption) (*ExtensionHostIterateResponse, error) {
+	out := new(ExtensionHostIterateResponse)
+	err := c.cc.Invoke(ctx, ExtensionHost_Iterate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExtensionHostServer is the server API for ExtensionHost service.
+// All implementations must embed UnimplementedExtensionHostServer
+// for forward compatibility
+type ExtensionHostServer interface {
+	// Get returns the value stored under a key.
+	Get(context.Context, *ExtensionHostGetRequest) (*ExtensionHostGetResponse, error)
+	// Set stores a value under a key.
+	Set(context.Context, *ExtensionHostSetRequest) (*ExtensionHostSetResponse, error)
+	// Delete removes a key.
+	Delete(context.Context, *ExtensionHostDeleteRequest) (*ExtensionHostDeleteResponse, error)
+	// Iterate returns the key-value pairs in the [start, end) range.
+	Iterate(context.Context, *ExtensionHostIterateRequest) (*ExtensionHostIterateResponse, error)
+	mustEmbedUnimplementedExtensionHostServer()
+}
+
+// UnimplementedExtensionHostServer must be embedded to have forward compatible implementations.
+type UnimplementedExtensionHostServer struct {
+}
+
+func (UnimplementedExtensionHostServer) Get(context.Context, *ExtensionHostGetRequest) (*ExtensionHostGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedExtensionHostServer) Set(context.Context, *ExtensionHostSetRequest) (*ExtensionHostSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (UnimplementedExtensionHostServer) Delete(context.Context, *ExtensionHostDeleteRequest) (*ExtensionHostDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedExtensionHostServer) Iterate(context.Context, *ExtensionHostIterateRequest) (*ExtensionHostIterateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Iterate not implemented")
+}
+func (UnimplementedExtensionHostServer) mustEmbedUnimplementedExtensionHostServer() {}
+
+// UnsafeExtensionHostServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExtensionHostServer will
+// result in compilation errors.
+type UnsafeExtensionHostServer interface {
+	mustEmbedUnimplementedExtensionHostServer()
+}
+
+func RegisterExtensionHostServer(s grpc.ServiceRegistrar, srv ExtensionHostServer) {
+	s.RegisterService(&ExtensionHost_ServiceDesc, srv)
+}
+
+func _ExtensionHost_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtensionHostGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionHostServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionHost_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionHostServer).Get(ctx, req.(*ExtensionHostGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionHost_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtensionHostSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionHostServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionHost_Set_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionHostServer).Set(ctx, req.(*ExtensionHostSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionHost_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtensionHostDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionHostServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionHost_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionHostServer).Delete(ctx, req.(*ExtensionHostDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionHost_Iterate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtensionHostIterateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionHostServer).Iterate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExtensionHost_Iterate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionHostServer).Iterate(ctx, req.(*ExtensionHostIterateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExtensionHost_ServiceDesc is the grpc.ServiceDesc for ExtensionHost service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExtensionHost_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.app.runtime.v1alpha1.ExtensionHost",
+	HandlerType: (*ExtensionHostServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _ExtensionHost_Get_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _ExtensionHost_Set_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ExtensionHost_Delete_Handler,
+		},
+		{
+			MethodName: "Iterate",
+			Handler:    _ExtensionHost_Iterate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/app/runtime/v1alpha1/extension.proto",
+}
@@ -478,6 +478,57 @@ func (x *_Module_10_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Module_12_list)(nil)
+
+type _Module_12_list struct {
+	list *[]*ExtensionConfig
+}
+
+func (x *_Module_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Module_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Module_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExtensionConfig)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Module_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExtensionConfig)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Module_12_list) AppendMutable() protoreflect.Value {
+	v := new(ExtensionConfig)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Module_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Module_12_list) NewElement() protoreflect.Value {
+	v := new(ExtensionConfig)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Module_12_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Module                       protoreflect.MessageDescriptor
 	fd_Module_app_name              protoreflect.FieldDescriptor
@@ -491,6 +542,8 @@ var (
 	fd_Module_precommiters          protoreflect.FieldDescriptor
 	fd_Module_prepare_check_staters protoreflect.FieldDescriptor
 	fd_Module_pre_blockers          protoreflect.FieldDescriptor
+	fd_Module_extensions            protoreflect.FieldDescriptor
+	fd_Module_derive_ordering       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -507,6 +560,8 @@ func init() {
 	fd_Module_precommiters = md_Module.Fields().ByName("precommiters")
 	fd_Module_prepare_check_staters = md_Module.Fields().ByName("prepare_check_staters")
 	fd_Module_pre_blockers = md_Module.Fields().ByName("pre_blockers")
+	fd_Module_extensions = md_Module.Fields().ByName("extensions")
+	fd_Module_derive_ordering = md_Module.Fields().ByName("derive_ordering")
 }
 
 var _ protoreflect.Message = (*fastReflection_Module)(nil)
@@ -640,6 +695,18 @@ func (x *fastReflection_Module) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.Extensions) != 0 {
+		value := protoreflect.ValueOfList(&_Module_12_list{list: &x.Extensions})
+		if !f(fd_Module_extensions, value) {
+			return
+		}
+	}
+	if x.DeriveOrdering != false {
+		value := protoreflect.ValueOfBool(x.DeriveOrdering)
+		if !f(fd_Module_derive_ordering, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -677,6 +744,10 @@ func (x *fastReflection_Module) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.PrepareCheckStaters) != 0
 	case "cosmos.app.runtime.v1alpha1.Module.pre_blockers":
 		return len(x.PreBlockers) != 0
+	case "cosmos.app.runtime.v1alpha1.Module.extensions":
+		return len(x.Extensions) != 0
+	case "cosmos.app.runtime.v1alpha1.Module.derive_ordering":
+		return x.DeriveOrdering != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.Module"))
@@ -715,6 +786,10 @@ func (x *fastReflection_Module) Clear(fd protoreflect.FieldDescriptor) {
 		x.PrepareCheckStaters = nil
 	case "cosmos.app.runtime.v1alpha1.Module.pre_blockers":
 		x.PreBlockers = nil
+	case "cosmos.app.runtime.v1alpha1.Module.extensions":
+		x.Extensions = nil
+	case "cosmos.app.runtime.v1alpha1.Module.derive_ordering":
+		x.DeriveOrdering = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.Module"))
@@ -794,6 +869,15 @@ func (x *fastReflection_Module) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Module_10_list{list: &x.PreBlockers}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.app.runtime.v1alpha1.Module.extensions":
+		if len(x.Extensions) == 0 {
+			return protoreflect.ValueOfList(&_Module_12_list{})
+		}
+		listValue := &_Module_12_list{list: &x.Extensions}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.app.runtime.v1alpha1.Module.derive_ordering":
+		value := x.DeriveOrdering
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.Module"))
@@ -856,6 +940,12 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Module_10_list)
 		x.PreBlockers = *clv.list
+	case "cosmos.app.runtime.v1alpha1.Module.extensions":
+		lv := value.List()
+		clv := lv.(*_Module_12_list)
+		x.Extensions = *clv.list
+	case "cosmos.app.runtime.v1alpha1.Module.derive_ordering":
+		x.DeriveOrdering = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.Module"))
@@ -936,8 +1026,16 @@ func (x *fastReflection_Module) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Module_10_list{list: &x.PreBlockers}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.app.runtime.v1alpha1.Module.extensions":
+		if x.Extensions == nil {
+			x.Extensions = []*ExtensionConfig{}
+		}
+		value := &_Module_12_list{list: &x.Extensions}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.app.runtime.v1alpha1.Module.app_name":
 		panic(fmt.Errorf("field app_name of message cosmos.app.runtime.v1alpha1.Module is not mutable"))
+	case "cosmos.app.runtime.v1alpha1.Module.derive_ordering":
+		panic(fmt.Errorf("field derive_ordering of message cosmos.app.runtime.v1alpha1.Module is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.Module"))
@@ -983,6 +1081,11 @@ func (x *fastReflection_Module) NewField(fd protoreflect.FieldDescriptor) protor
 	case "cosmos.app.runtime.v1alpha1.Module.pre_blockers":
 		list := []string{}
 		return protoreflect.ValueOfList(&_Module_10_list{list: &list})
+	case "cosmos.app.runtime.v1alpha1.Module.extensions":
+		list := []*ExtensionConfig{}
+		return protoreflect.ValueOfList(&_Module_12_list{list: &list})
+	case "cosmos.app.runtime.v1alpha1.Module.derive_ordering":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.Module"))
@@ -1116,6 +1219,15 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.Extensions) > 0 {
+			for _, e := range x.Extensions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.DeriveOrdering {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1145,6 +1257,32 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DeriveOrdering {
+			i--
+			if x.DeriveOrdering {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.Extensions) > 0 {
+			for iNdEx := len(x.Extensions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Extensions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
 		if len(x.SkipStoreKeys) > 0 {
 			for iNdEx := len(x.SkipStoreKeys) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.SkipStoreKeys[iNdEx])
@@ -1652,6 +1790,60 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 				}
 				x.PreBlockers = append(x.PreBlockers, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Extensions = append(x.Extensions, &ExtensionConfig{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Extensions[len(x.Extensions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeriveOrdering", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DeriveOrdering = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1687,10 +1879,156 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_StoreKeyConfig_3_list)(nil)
+
+type _StoreKeyConfig_3_list struct {
+	list *[]string
+}
+
+func (x *_StoreKeyConfig_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StoreKeyConfig_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_StoreKeyConfig_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StoreKeyConfig_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StoreKeyConfig_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message StoreKeyConfig at list field AdditionalKvStoreKeys as it is not of Message kind"))
+}
+
+func (x *_StoreKeyConfig_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StoreKeyConfig_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_StoreKeyConfig_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_StoreKeyConfig_4_list)(nil)
+
+type _StoreKeyConfig_4_list struct {
+	list *[]string
+}
+
+func (x *_StoreKeyConfig_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StoreKeyConfig_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_StoreKeyConfig_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StoreKeyConfig_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StoreKeyConfig_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message StoreKeyConfig at list field AdditionalTransientStoreKeys as it is not of Message kind"))
+}
+
+func (x *_StoreKeyConfig_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StoreKeyConfig_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_StoreKeyConfig_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_StoreKeyConfig_5_list)(nil)
+
+type _StoreKeyConfig_5_list struct {
+	list *[]*StoreKeyAlias
+}
+
+func (x *_StoreKeyConfig_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StoreKeyConfig_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_StoreKeyConfig_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StoreKeyAlias)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StoreKeyConfig_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StoreKeyAlias)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StoreKeyConfig_5_list) AppendMutable() protoreflect.Value {
+	v := new(StoreKeyAlias)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StoreKeyConfig_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StoreKeyConfig_5_list) NewElement() protoreflect.Value {
+	v := new(StoreKeyAlias)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StoreKeyConfig_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_StoreKeyConfig              protoreflect.MessageDescriptor
-	fd_StoreKeyConfig_module_name  protoreflect.FieldDescriptor
-	fd_StoreKeyConfig_kv_store_key protoreflect.FieldDescriptor
+	md_StoreKeyConfig                                 protoreflect.MessageDescriptor
+	fd_StoreKeyConfig_module_name                     protoreflect.FieldDescriptor
+	fd_StoreKeyConfig_kv_store_key                    protoreflect.FieldDescriptor
+	fd_StoreKeyConfig_additional_kv_store_keys        protoreflect.FieldDescriptor
+	fd_StoreKeyConfig_additional_transient_store_keys protoreflect.FieldDescriptor
+	fd_StoreKeyConfig_kv_store_key_aliases            protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1698,6 +2036,9 @@ func init() {
 	md_StoreKeyConfig = File_cosmos_app_runtime_v1alpha1_module_proto.Messages().ByName("StoreKeyConfig")
 	fd_StoreKeyConfig_module_name = md_StoreKeyConfig.Fields().ByName("module_name")
 	fd_StoreKeyConfig_kv_store_key = md_StoreKeyConfig.Fields().ByName("kv_store_key")
+	fd_StoreKeyConfig_additional_kv_store_keys = md_StoreKeyConfig.Fields().ByName("additional_kv_store_keys")
+	fd_StoreKeyConfig_additional_transient_store_keys = md_StoreKeyConfig.Fields().ByName("additional_transient_store_keys")
+	fd_StoreKeyConfig_kv_store_key_aliases = md_StoreKeyConfig.Fields().ByName("kv_store_key_aliases")
 }
 
 var _ protoreflect.Message = (*fastReflection_StoreKeyConfig)(nil)
@@ -1777,6 +2118,24 @@ func (x *fastReflection_StoreKeyConfig) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if len(x.AdditionalKvStoreKeys) != 0 {
+		value := protoreflect.ValueOfList(&_StoreKeyConfig_3_list{list: &x.AdditionalKvStoreKeys})
+		if !f(fd_StoreKeyConfig_additional_kv_store_keys, value) {
+			return
+		}
+	}
+	if len(x.AdditionalTransientStoreKeys) != 0 {
+		value := protoreflect.ValueOfList(&_StoreKeyConfig_4_list{list: &x.AdditionalTransientStoreKeys})
+		if !f(fd_StoreKeyConfig_additional_transient_store_keys, value) {
+			return
+		}
+	}
+	if len(x.KvStoreKeyAliases) != 0 {
+		value := protoreflect.ValueOfList(&_StoreKeyConfig_5_list{list: &x.KvStoreKeyAliases})
+		if !f(fd_StoreKeyConfig_kv_store_key_aliases, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1796,6 +2155,12 @@ func (x *fastReflection_StoreKeyConfig) Has(fd protoreflect.FieldDescriptor) boo
 		return x.ModuleName != ""
 	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.kv_store_key":
 		return x.KvStoreKey != ""
+	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.additional_kv_store_keys":
+		return len(x.AdditionalKvStoreKeys) != 0
+	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.additional_transient_store_keys":
+		return len(x.AdditionalTransientStoreKeys) != 0
+	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.kv_store_key_aliases":
+		return len(x.KvStoreKeyAliases) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.StoreKeyConfig"))
@@ -1816,6 +2181,12 @@ func (x *fastReflection_StoreKeyConfig) Clear(fd protoreflect.FieldDescriptor) {
 		x.ModuleName = ""
 	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.kv_store_key":
 		x.KvStoreKey = ""
+	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.additional_kv_store_keys":
+		x.AdditionalKvStoreKeys = nil
+	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.additional_transient_store_keys":
+		x.AdditionalTransientStoreKeys = nil
+	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.kv_store_key_aliases":
+		x.KvStoreKeyAliases = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.StoreKeyConfig"))
@@ -1838,6 +2209,24 @@ func (x *fastReflection_StoreKeyConfig) Get(descriptor protoreflect.FieldDescrip
 	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.kv_store_key":
 		value := x.KvStoreKey
 		return protoreflect.ValueOfString(value)
+	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.additional_kv_store_keys":
+		if len(x.AdditionalKvStoreKeys) == 0 {
+			return protoreflect.ValueOfList(&_StoreKeyConfig_3_list{})
+		}
+		listValue := &_StoreKeyConfig_3_list{list: &x.AdditionalKvStoreKeys}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.additional_transient_store_keys":
+		if len(x.AdditionalTransientStoreKeys) == 0 {
+			return protoreflect.ValueOfList(&_StoreKeyConfig_4_list{})
+		}
+		listValue := &_StoreKeyConfig_4_list{list: &x.AdditionalTransientStoreKeys}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.kv_store_key_aliases":
+		if len(x.KvStoreKeyAliases) == 0 {
+			return protoreflect.ValueOfList(&_StoreKeyConfig_5_list{})
+		}
+		listValue := &_StoreKeyConfig_5_list{list: &x.KvStoreKeyAliases}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.StoreKeyConfig"))
@@ -1862,6 +2251,18 @@ func (x *fastReflection_StoreKeyConfig) Set(fd protoreflect.FieldDescriptor, val
 		x.ModuleName = value.Interface().(string)
 	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.kv_store_key":
 		x.KvStoreKey = value.Interface().(string)
+	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.additional_kv_store_keys":
+		lv := value.List()
+		clv := lv.(*_StoreKeyConfig_3_list)
+		x.AdditionalKvStoreKeys = *clv.list
+	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.additional_transient_store_keys":
+		lv := value.List()
+		clv := lv.(*_StoreKeyConfig_4_list)
+		x.AdditionalTransientStoreKeys = *clv.list
+	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.kv_store_key_aliases":
+		lv := value.List()
+		clv := lv.(*_StoreKeyConfig_5_list)
+		x.KvStoreKeyAliases = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.StoreKeyConfig"))
@@ -1882,6 +2283,24 @@ func (x *fastReflection_StoreKeyConfig) Set(fd protoreflect.FieldDescriptor, val
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_StoreKeyConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.additional_kv_store_keys":
+		if x.AdditionalKvStoreKeys == nil {
+			x.AdditionalKvStoreKeys = []string{}
+		}
+		value := &_StoreKeyConfig_3_list{list: &x.AdditionalKvStoreKeys}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.additional_transient_store_keys":
+		if x.AdditionalTransientStoreKeys == nil {
+			x.AdditionalTransientStoreKeys = []string{}
+		}
+		value := &_StoreKeyConfig_4_list{list: &x.AdditionalTransientStoreKeys}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.kv_store_key_aliases":
+		if x.KvStoreKeyAliases == nil {
+			x.KvStoreKeyAliases = []*StoreKeyAlias{}
+		}
+		value := &_StoreKeyConfig_5_list{list: &x.KvStoreKeyAliases}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.module_name":
 		panic(fmt.Errorf("field module_name of message cosmos.app.runtime.v1alpha1.StoreKeyConfig is not mutable"))
 	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.kv_store_key":
@@ -1903,6 +2322,15 @@ func (x *fastReflection_StoreKeyConfig) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfString("")
 	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.kv_store_key":
 		return protoreflect.ValueOfString("")
+	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.additional_kv_store_keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_StoreKeyConfig_3_list{list: &list})
+	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.additional_transient_store_keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_StoreKeyConfig_4_list{list: &list})
+	case "cosmos.app.runtime.v1alpha1.StoreKeyConfig.kv_store_key_aliases":
+		list := []*StoreKeyAlias{}
+		return protoreflect.ValueOfList(&_StoreKeyConfig_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.StoreKeyConfig"))
@@ -1980,6 +2408,24 @@ func (x *fastReflection_StoreKeyConfig) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.AdditionalKvStoreKeys) > 0 {
+			for _, s := range x.AdditionalKvStoreKeys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AdditionalTransientStoreKeys) > 0 {
+			for _, s := range x.AdditionalTransientStoreKeys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.KvStoreKeyAliases) > 0 {
+			for _, e := range x.KvStoreKeyAliases {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2009,6 +2455,40 @@ func (x *fastReflection_StoreKeyConfig) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.KvStoreKeyAliases) > 0 {
+			for iNdEx := len(x.KvStoreKeyAliases) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.KvStoreKeyAliases[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.AdditionalTransientStoreKeys) > 0 {
+			for iNdEx := len(x.AdditionalTransientStoreKeys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AdditionalTransientStoreKeys[iNdEx])
+				copy(dAtA[i:], x.AdditionalTransientStoreKeys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AdditionalTransientStoreKeys[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.AdditionalKvStoreKeys) > 0 {
+			for iNdEx := len(x.AdditionalKvStoreKeys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AdditionalKvStoreKeys[iNdEx])
+				copy(dAtA[i:], x.AdditionalKvStoreKeys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AdditionalKvStoreKeys[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
 		if len(x.KvStoreKey) > 0 {
 			i -= len(x.KvStoreKey)
 			copy(dAtA[i:], x.KvStoreKey)
@@ -2016,10 +2496,1172 @@ func (x *fastReflection_StoreKeyConfig) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.ModuleName) > 0 {
-			i -= len(x.ModuleName)
-			copy(dAtA[i:], x.ModuleName)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleName)))
+		if len(x.ModuleName) > 0 {
+			i -= len(x.ModuleName)
+			copy(dAtA[i:], x.ModuleName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleName)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StoreKeyConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreKeyConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreKeyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KvStoreKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KvStoreKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdditionalKvStoreKeys", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AdditionalKvStoreKeys = append(x.AdditionalKvStoreKeys, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdditionalTransientStoreKeys", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AdditionalTransientStoreKeys = append(x.AdditionalTransientStoreKeys, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KvStoreKeyAliases", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KvStoreKeyAliases = append(x.KvStoreKeyAliases, &StoreKeyAlias{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.KvStoreKeyAliases[len(x.KvStoreKeyAliases)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_StoreKeyAlias              protoreflect.MessageDescriptor
+	fd_StoreKeyAlias_alias        protoreflect.FieldDescriptor
+	fd_StoreKeyAlias_kv_store_key protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_app_runtime_v1alpha1_module_proto_init()
+	md_StoreKeyAlias = File_cosmos_app_runtime_v1alpha1_module_proto.Messages().ByName("StoreKeyAlias")
+	fd_StoreKeyAlias_alias = md_StoreKeyAlias.Fields().ByName("alias")
+	fd_StoreKeyAlias_kv_store_key = md_StoreKeyAlias.Fields().ByName("kv_store_key")
+}
+
+var _ protoreflect.Message = (*fastReflection_StoreKeyAlias)(nil)
+
+type fastReflection_StoreKeyAlias StoreKeyAlias
+
+func (x *StoreKeyAlias) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StoreKeyAlias)(x)
+}
+
+func (x *StoreKeyAlias) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_app_runtime_v1alpha1_module_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StoreKeyAlias_messageType fastReflection_StoreKeyAlias_messageType
+var _ protoreflect.MessageType = fastReflection_StoreKeyAlias_messageType{}
+
+type fastReflection_StoreKeyAlias_messageType struct{}
+
+func (x fastReflection_StoreKeyAlias_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StoreKeyAlias)(nil)
+}
+func (x fastReflection_StoreKeyAlias_messageType) New() protoreflect.Message {
+	return new(fastReflection_StoreKeyAlias)
+}
+func (x fastReflection_StoreKeyAlias_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StoreKeyAlias
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StoreKeyAlias) Descriptor() protoreflect.MessageDescriptor {
+	return md_StoreKeyAlias
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StoreKeyAlias) Type() protoreflect.MessageType {
+	return _fastReflection_StoreKeyAlias_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StoreKeyAlias) New() protoreflect.Message {
+	return new(fastReflection_StoreKeyAlias)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StoreKeyAlias) Interface() protoreflect.ProtoMessage {
+	return (*StoreKeyAlias)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StoreKeyAlias) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Alias != "" {
+		value := protoreflect.ValueOfString(x.Alias)
+		if !f(fd_StoreKeyAlias_alias, value) {
+			return
+		}
+	}
+	if x.KvStoreKey != "" {
+		value := protoreflect.ValueOfString(x.KvStoreKey)
+		if !f(fd_StoreKeyAlias_kv_store_key, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StoreKeyAlias) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.app.runtime.v1alpha1.StoreKeyAlias.alias":
+		return x.Alias != ""
+	case "cosmos.app.runtime.v1alpha1.StoreKeyAlias.kv_store_key":
+		return x.KvStoreKey != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.StoreKeyAlias"))
+		}
+		panic(fmt.Errorf("message cosmos.app.runtime.v1alpha1.StoreKeyAlias does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreKeyAlias) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.app.runtime.v1alpha1.StoreKeyAlias.alias":
+		x.Alias = ""
+	case "cosmos.app.runtime.v1alpha1.StoreKeyAlias.kv_store_key":
+		x.KvStoreKey = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.StoreKeyAlias"))
+		}
+		panic(fmt.Errorf("message cosmos.app.runtime.v1alpha1.StoreKeyAlias does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StoreKeyAlias) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.app.runtime.v1alpha1.StoreKeyAlias.alias":
+		value := x.Alias
+		return protoreflect.ValueOfString(value)
+	case "cosmos.app.runtime.v1alpha1.StoreKeyAlias.kv_store_key":
+		value := x.KvStoreKey
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.StoreKeyAlias"))
+		}
+		panic(fmt.Errorf("message cosmos.app.runtime.v1alpha1.StoreKeyAlias does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreKeyAlias) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.app.runtime.v1alpha1.StoreKeyAlias.alias":
+		x.Alias = value.Interface().(string)
+	case "cosmos.app.runtime.v1alpha1.StoreKeyAlias.kv_store_key":
+		x.KvStoreKey = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.StoreKeyAlias"))
+		}
+		panic(fmt.Errorf("message cosmos.app.runtime.v1alpha1.StoreKeyAlias does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreKeyAlias) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.app.runtime.v1alpha1.StoreKeyAlias.alias":
+		panic(fmt.Errorf("field alias of message cosmos.app.runtime.v1alpha1.StoreKeyAlias is not mutable"))
+	case "cosmos.app.runtime.v1alpha1.StoreKeyAlias.kv_store_key":
+		panic(fmt.Errorf("field kv_store_key of message cosmos.app.runtime.v1alpha1.StoreKeyAlias is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.StoreKeyAlias"))
+		}
+		panic(fmt.Errorf("message cosmos.app.runtime.v1alpha1.StoreKeyAlias does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StoreKeyAlias) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.app.runtime.v1alpha1.StoreKeyAlias.alias":
+		return protoreflect.ValueOfString("")
+	case "cosmos.app.runtime.v1alpha1.StoreKeyAlias.kv_store_key":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.StoreKeyAlias"))
+		}
+		panic(fmt.Errorf("message cosmos.app.runtime.v1alpha1.StoreKeyAlias does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StoreKeyAlias) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.app.runtime.v1alpha1.StoreKeyAlias", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StoreKeyAlias) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreKeyAlias) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StoreKeyAlias) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StoreKeyAlias) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StoreKeyAlias)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Alias)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.KvStoreKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StoreKeyAlias)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.KvStoreKey) > 0 {
+			i -= len(x.KvStoreKey)
+			copy(dAtA[i:], x.KvStoreKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.KvStoreKey)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Alias) > 0 {
+			i -= len(x.Alias)
+			copy(dAtA[i:], x.Alias)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Alias)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StoreKeyAlias)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreKeyAlias: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreKeyAlias: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Alias = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KvStoreKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KvStoreKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ExtensionConfig              protoreflect.MessageDescriptor
+	fd_ExtensionConfig_name         protoreflect.FieldDescriptor
+	fd_ExtensionConfig_plugin_path  protoreflect.FieldDescriptor
+	fd_ExtensionConfig_grpc_address protoreflect.FieldDescriptor
+	fd_ExtensionConfig_host_address protoreflect.FieldDescriptor
+	fd_ExtensionConfig_config       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_app_runtime_v1alpha1_module_proto_init()
+	md_ExtensionConfig = File_cosmos_app_runtime_v1alpha1_module_proto.Messages().ByName("ExtensionConfig")
+	fd_ExtensionConfig_name = md_ExtensionConfig.Fields().ByName("name")
+	fd_ExtensionConfig_plugin_path = md_ExtensionConfig.Fields().ByName("plugin_path")
+	fd_ExtensionConfig_grpc_address = md_ExtensionConfig.Fields().ByName("grpc_address")
+	fd_ExtensionConfig_host_address = md_ExtensionConfig.Fields().ByName("host_address")
+	fd_ExtensionConfig_config = md_ExtensionConfig.Fields().ByName("config")
+}
+
+var _ protoreflect.Message = (*fastReflection_ExtensionConfig)(nil)
+
+type fastReflection_ExtensionConfig ExtensionConfig
+
+func (x *ExtensionConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExtensionConfig)(x)
+}
+
+func (x *ExtensionConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_app_runtime_v1alpha1_module_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ExtensionConfig_messageType fastReflection_ExtensionConfig_messageType
+var _ protoreflect.MessageType = fastReflection_ExtensionConfig_messageType{}
+
+type fastReflection_ExtensionConfig_messageType struct{}
+
+func (x fastReflection_ExtensionConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExtensionConfig)(nil)
+}
+func (x fastReflection_ExtensionConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExtensionConfig)
+}
+func (x fastReflection_ExtensionConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExtensionConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ExtensionConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExtensionConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ExtensionConfig) Type() protoreflect.MessageType {
+	return _fastReflection_ExtensionConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ExtensionConfig) New() protoreflect.Message {
+	return new(fastReflection_ExtensionConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ExtensionConfig) Interface() protoreflect.ProtoMessage {
+	return (*ExtensionConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ExtensionConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_ExtensionConfig_name, value) {
+			return
+		}
+	}
+	if x.PluginPath != "" {
+		value := protoreflect.ValueOfString(x.PluginPath)
+		if !f(fd_ExtensionConfig_plugin_path, value) {
+			return
+		}
+	}
+	if x.GrpcAddress != "" {
+		value := protoreflect.ValueOfString(x.GrpcAddress)
+		if !f(fd_ExtensionConfig_grpc_address, value) {
+			return
+		}
+	}
+	if x.HostAddress != "" {
+		value := protoreflect.ValueOfString(x.HostAddress)
+		if !f(fd_ExtensionConfig_host_address, value) {
+			return
+		}
+	}
+	if len(x.Config) != 0 {
+		value := protoreflect.ValueOfBytes(x.Config)
+		if !f(fd_ExtensionConfig_config, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ExtensionConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.name":
+		return x.Name != ""
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.plugin_path":
+		return x.PluginPath != ""
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.grpc_address":
+		return x.GrpcAddress != ""
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.host_address":
+		return x.HostAddress != ""
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.config":
+		return len(x.Config) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.ExtensionConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.app.runtime.v1alpha1.ExtensionConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExtensionConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.name":
+		x.Name = ""
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.plugin_path":
+		x.PluginPath = ""
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.grpc_address":
+		x.GrpcAddress = ""
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.host_address":
+		x.HostAddress = ""
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.config":
+		x.Config = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.ExtensionConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.app.runtime.v1alpha1.ExtensionConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ExtensionConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.plugin_path":
+		value := x.PluginPath
+		return protoreflect.ValueOfString(value)
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.grpc_address":
+		value := x.GrpcAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.host_address":
+		value := x.HostAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.config":
+		value := x.Config
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.ExtensionConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.app.runtime.v1alpha1.ExtensionConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExtensionConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.name":
+		x.Name = value.Interface().(string)
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.plugin_path":
+		x.PluginPath = value.Interface().(string)
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.grpc_address":
+		x.GrpcAddress = value.Interface().(string)
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.host_address":
+		x.HostAddress = value.Interface().(string)
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.config":
+		x.Config = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.ExtensionConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.app.runtime.v1alpha1.ExtensionConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExtensionConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.name":
+		panic(fmt.Errorf("field name of message cosmos.app.runtime.v1alpha1.ExtensionConfig is not mutable"))
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.plugin_path":
+		panic(fmt.Errorf("field plugin_path of message cosmos.app.runtime.v1alpha1.ExtensionConfig is not mutable"))
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.grpc_address":
+		panic(fmt.Errorf("field grpc_address of message cosmos.app.runtime.v1alpha1.ExtensionConfig is not mutable"))
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.host_address":
+		panic(fmt.Errorf("field host_address of message cosmos.app.runtime.v1alpha1.ExtensionConfig is not mutable"))
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.config":
+		panic(fmt.Errorf("field config of message cosmos.app.runtime.v1alpha1.ExtensionConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.ExtensionConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.app.runtime.v1alpha1.ExtensionConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ExtensionConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.name":
+		return protoreflect.ValueOfString("")
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.plugin_path":
+		return protoreflect.ValueOfString("")
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.grpc_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.host_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.app.runtime.v1alpha1.ExtensionConfig.config":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v1alpha1.ExtensionConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.app.runtime.v1alpha1.ExtensionConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ExtensionConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.app.runtime.v1alpha1.ExtensionConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ExtensionConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExtensionConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ExtensionConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ExtensionConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ExtensionConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PluginPath)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GrpcAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HostAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Config)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ExtensionConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Config) > 0 {
+			i -= len(x.Config)
+			copy(dAtA[i:], x.Config)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Config)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.HostAddress) > 0 {
+			i -= len(x.HostAddress)
+			copy(dAtA[i:], x.HostAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HostAddress)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.GrpcAddress) > 0 {
+			i -= len(x.GrpcAddress)
+			copy(dAtA[i:], x.GrpcAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GrpcAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.PluginPath) > 0 {
+			i -= len(x.PluginPath)
+			copy(dAtA[i:], x.PluginPath)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PluginPath)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2034,7 +3676,7 @@ func (x *fastReflection_StoreKeyConfig) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*StoreKeyConfig)
+		x := input.Message.Interface().(*ExtensionConfig)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2066,15 +3708,15 @@ func (x *fastReflection_StoreKeyConfig) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreKeyConfig: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExtensionConfig: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreKeyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExtensionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2102,11 +3744,11 @@ func (x *fastReflection_StoreKeyConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ModuleName = string(dAtA[iNdEx:postIndex])
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KvStoreKey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PluginPath", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2134,7 +3776,105 @@ func (x *fastReflection_StoreKeyConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.KvStoreKey = string(dAtA[iNdEx:postIndex])
+				x.PluginPath = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrpcAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GrpcAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HostAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Config = append(x.Config[:0], dAtA[iNdEx:postIndex]...)
+				if x.Config == nil {
+					x.Config = []byte{}
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2231,6 +3971,17 @@ type Module struct {
 	// to call in the order in which they should be called. If this is left empty
 	// no pre blocker will be registered.
 	PreBlockers []string `protobuf:"bytes,10,rep,name=pre_blockers,json=preBlockers,proto3" json:"pre_blockers,omitempty"`
+	// extensions is an optional list of modules which are not compiled into the
+	// node binary but loaded at startup, either from a Go plugin or from a
+	// sidecar process implementing the ExtensionModule gRPC service.
+	// Extension modules must be listed in the ordering fields above like any
+	// other module.
+	Extensions []*ExtensionConfig `protobuf:"bytes,12,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	// derive_ordering makes runtime compute the ordering fields above which are
+	// left empty from the ordering constraints declared by the modules
+	// (see appmodule.HasOrderingConstraints), instead of disabling the
+	// corresponding hooks. Modules without constraints are ordered by name.
+	DeriveOrdering bool `protobuf:"varint,13,opt,name=derive_ordering,json=deriveOrdering,proto3" json:"derive_ordering,omitempty"`
 }
 
 func (x *Module) Reset() {
@@ -2330,6 +4081,20 @@ func (x *Module) GetPreBlockers() []string {
 	return nil
 }
 
+func (x *Module) GetExtensions() []*ExtensionConfig {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *Module) GetDeriveOrdering() bool {
+	if x != nil {
+		return x.DeriveOrdering
+	}
+	return false
+}
+
 // StoreKeyConfig may be supplied to override the default module store key, which
 // is the module name.
 type StoreKeyConfig struct {
@@ -2341,6 +4106,15 @@ type StoreKeyConfig struct {
 	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
 	// the kv store key to use instead of the module name.
 	KvStoreKey string `protobuf:"bytes,2,opt,name=kv_store_key,json=kvStoreKey,proto3" json:"kv_store_key,omitempty"`
+	// additional_kv_store_keys are the names of additional kv store keys mounted
+	// for the module, accessible through runtime.ModuleStores.
+	AdditionalKvStoreKeys []string `protobuf:"bytes,3,rep,name=additional_kv_store_keys,json=additionalKvStoreKeys,proto3" json:"additional_kv_store_keys,omitempty"`
+	// additional_transient_store_keys are the names of additional transient
+	// store keys mounted for the module, accessible through runtime.ModuleStores.
+	AdditionalTransientStoreKeys []string `protobuf:"bytes,4,rep,name=additional_transient_store_keys,json=additionalTransientStoreKeys,proto3" json:"additional_transient_store_keys,omitempty"`
+	// kv_store_key_aliases lets the module access its kv stores under other names,
+	// typically the legacy store key names referenced by store migrations.
+	KvStoreKeyAliases []*StoreKeyAlias `protobuf:"bytes,5,rep,name=kv_store_key_aliases,json=kvStoreKeyAliases,proto3" json:"kv_store_key_aliases,omitempty"`
 }
 
 func (x *StoreKeyConfig) Reset() {
@@ -2377,6 +4151,156 @@ func (x *StoreKeyConfig) GetKvStoreKey() string {
 	return ""
 }
 
+func (x *StoreKeyConfig) GetAdditionalKvStoreKeys() []string {
+	if x != nil {
+		return x.AdditionalKvStoreKeys
+	}
+	return nil
+}
+
+func (x *StoreKeyConfig) GetAdditionalTransientStoreKeys() []string {
+	if x != nil {
+		return x.AdditionalTransientStoreKeys
+	}
+	return nil
+}
+
+func (x *StoreKeyConfig) GetKvStoreKeyAliases() []*StoreKeyAlias {
+	if x != nil {
+		return x.KvStoreKeyAliases
+	}
+	return nil
+}
+
+// StoreKeyAlias maps an alias to one of the kv store keys of a module.
+type StoreKeyAlias struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// alias is the name under which the store can be accessed.
+	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	// kv_store_key is the name of the aliased kv store key. It must be either the
+	// module kv store key or one of its additional kv store keys.
+	KvStoreKey string `protobuf:"bytes,2,opt,name=kv_store_key,json=kvStoreKey,proto3" json:"kv_store_key,omitempty"`
+}
+
+func (x *StoreKeyAlias) Reset() {
+	*x = StoreKeyAlias{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_app_runtime_v1alpha1_module_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreKeyAlias) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreKeyAlias) ProtoMessage() {}
+
+// Deprecated: Use StoreKeyAlias.ProtoReflect.Descriptor instead.
+func (*StoreKeyAlias) Descriptor() ([]byte, []int) {
+	return file_cosmos_app_runtime_v1alpha1_module_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StoreKeyAlias) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *StoreKeyAlias) GetKvStoreKey() string {
+	if x != nil {
+		return x.KvStoreKey
+	}
+	return ""
+}
+
+// ExtensionConfig declares a module that is loaded at startup instead of being
+// compiled into the node binary. Exactly one of plugin_path and grpc_address
+// must be set.
+type ExtensionConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the module. It is used in the ordering lists and as the name of
+	// the module store key.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// plugin_path is the path to a Go plugin exporting a NewAppModule symbol of
+	// type runtime.ExtensionConstructor.
+	PluginPath string `protobuf:"bytes,2,opt,name=plugin_path,json=pluginPath,proto3" json:"plugin_path,omitempty"`
+	// grpc_address is the address of a sidecar process serving the
+	// ExtensionModule gRPC service.
+	GrpcAddress string `protobuf:"bytes,3,opt,name=grpc_address,json=grpcAddress,proto3" json:"grpc_address,omitempty"`
+	// host_address is the address on which runtime serves the ExtensionHost
+	// gRPC service used by the sidecar process to access its store, either a
+	// unix socket address (unix:///path/to/socket) or a loopback TCP address.
+	// It is required when grpc_address is set.
+	HostAddress string `protobuf:"bytes,4,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+	// config is an opaque configuration blob handed to the module when it is
+	// loaded.
+	Config []byte `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ExtensionConfig) Reset() {
+	*x = ExtensionConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_app_runtime_v1alpha1_module_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtensionConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionConfig) ProtoMessage() {}
+
+// Deprecated: Use ExtensionConfig.ProtoReflect.Descriptor instead.
+func (*ExtensionConfig) Descriptor() ([]byte, []int) {
+	return file_cosmos_app_runtime_v1alpha1_module_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExtensionConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExtensionConfig) GetPluginPath() string {
+	if x != nil {
+		return x.PluginPath
+	}
+	return ""
+}
+
+func (x *ExtensionConfig) GetGrpcAddress() string {
+	if x != nil {
+		return x.GrpcAddress
+	}
+	return ""
+}
+
+func (x *ExtensionConfig) GetHostAddress() string {
+	if x != nil {
+		return x.HostAddress
+	}
+	return ""
+}
+
+func (x *ExtensionConfig) GetConfig() []byte {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
 var File_cosmos_app_runtime_v1alpha1_module_proto protoreflect.FileDescriptor
 
 var file_cosmos_app_runtime_v1alpha1_module_proto_rawDesc = []byte{
@@ -2386,7 +4310,7 @@ var file_cosmos_app_runtime_v1alpha1_module_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
 	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
 	0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x04, 0x0a, 0x06, 0x4d, 0x6f,
+	0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x05, 0x0a, 0x06, 0x4d, 0x6f,
 	0x64, 0x75, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12,
 	0x25, 0x0a, 0x0e, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72,
@@ -2416,33 +4340,70 @@ var file_cosmos_app_runtime_v1alpha1_module_proto_rawDesc = []byte{
 	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x43,
 	0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70,
 	0x72, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x3a, 0x43,
-	0xba, 0xc0, 0x96, 0xda, 0x01, 0x3d, 0x0a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x13,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x22, 0x53, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6b, 0x76, 0x5f, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x76,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x42, 0xfb, 0x01, 0x0a, 0x1f, 0x63, 0x6f, 0x6d,
+	0x09, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x4c,
+	0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x43, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x3d, 0x0a, 0x24, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x13, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70,
+	0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0xb0, 0x02, 0x0a, 0x0e, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0c, 0x6b, 0x76, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x76, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79,
+	0x12, 0x37, 0x0a, 0x18, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x6b,
+	0x76, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x15, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4b, 0x76,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x45, 0x0a, 0x1f, 0x61, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x1c, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x73,
+	0x12, 0x5b, 0x0a, 0x14, 0x6b, 0x76, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x0b, 0x4d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3c, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x52, 0xaa,
-	0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x2e, 0x52, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x1b,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x70, 0x70, 0x5c, 0x52, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x27, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x70, 0x70, 0x5c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a,
-	0x41, 0x70, 0x70, 0x3a, 0x3a, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x3a, 0x56, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x4b, 0x65, 0x79, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x11, 0x6b, 0x76, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x22, 0x47, 0x0a,
+	0x0d, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6b, 0x76, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x76, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x22, 0xa4, 0x01, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x21, 0x0a, 0x0c, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x70, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x6f, 0x73, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0xfb, 0x01,
+	0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x3c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02,
+	0x03, 0x43, 0x41, 0x52, 0xaa, 0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x70,
+	0x70, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0xca, 0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x70, 0x70, 0x5c,
+	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0xe2, 0x02, 0x27, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x70, 0x70, 0x5c, 0x52, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x70, 0x70, 0x3a, 0x3a, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2457,18 +4418,22 @@ func file_cosmos_app_runtime_v1alpha1_module_proto_rawDescGZIP() []byte {
 	return file_cosmos_app_runtime_v1alpha1_module_proto_rawDescData
 }
 
-var file_cosmos_app_runtime_v1alpha1_module_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cosmos_app_runtime_v1alpha1_module_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_cosmos_app_runtime_v1alpha1_module_proto_goTypes = []interface{}{
-	(*Module)(nil),         // 0: cosmos.app.runtime.v1alpha1.Module
-	(*StoreKeyConfig)(nil), // 1: cosmos.app.runtime.v1alpha1.StoreKeyConfig
+	(*Module)(nil),          // 0: cosmos.app.runtime.v1alpha1.Module
+	(*StoreKeyConfig)(nil),  // 1: cosmos.app.runtime.v1alpha1.StoreKeyConfig
+	(*StoreKeyAlias)(nil),   // 2: cosmos.app.runtime.v1alpha1.StoreKeyAlias
+	(*ExtensionConfig)(nil), // 3: cosmos.app.runtime.v1alpha1.ExtensionConfig
 }
 var file_cosmos_app_runtime_v1alpha1_module_proto_depIdxs = []int32{
 	1, // 0: cosmos.app.runtime.v1alpha1.Module.override_store_keys:type_name -> cosmos.app.runtime.v1alpha1.StoreKeyConfig
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 1: cosmos.app.runtime.v1alpha1.Module.extensions:type_name -> cosmos.app.runtime.v1alpha1.ExtensionConfig
+	2, // 2: cosmos.app.runtime.v1alpha1.StoreKeyConfig.kv_store_key_aliases:type_name -> cosmos.app.runtime.v1alpha1.StoreKeyAlias
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_app_runtime_v1alpha1_module_proto_init() }
@@ -2501,6 +4466,30 @@ func file_cosmos_app_runtime_v1alpha1_module_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_app_runtime_v1alpha1_module_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreKeyAlias); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_app_runtime_v1alpha1_module_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtensionConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2508,7 +4497,7 @@ func file_cosmos_app_runtime_v1alpha1_module_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_app_runtime_v1alpha1_module_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
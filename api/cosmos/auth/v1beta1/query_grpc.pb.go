@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Query_Accounts_FullMethodName             = "/cosmos.auth.v1beta1.Query/Accounts"
+	Query_AccountsStream_FullMethodName       = "/cosmos.auth.v1beta1.Query/AccountsStream"
 	Query_Account_FullMethodName              = "/cosmos.auth.v1beta1.Query/Account"
 	Query_AccountAddressByID_FullMethodName   = "/cosmos.auth.v1beta1.Query/AccountAddressByID"
 	Query_Params_FullMethodName               = "/cosmos.auth.v1beta1.Query/Params"
@@ -29,6 +30,7 @@ const (
 	Query_AddressBytesToString_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressBytesToString"
 	Query_AddressStringToBytes_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressStringToBytes"
 	Query_AccountInfo_FullMethodName          = "/cosmos.auth.v1beta1.Query/AccountInfo"
+	Query_AccountByPubKey_FullMethodName      = "/cosmos.auth.v1beta1.Query/AccountByPubKey"
 )
 
 // QueryClient is the client API for Query service.
@@ -39,6 +41,9 @@ type QueryClient interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error)
+	// AccountsStream streams all the existing accounts in batches, without the
+	// round-trips of the paginated Accounts query.
+	AccountsStream(ctx context.Context, in *QueryAccountsStreamRequest, opts ...grpc.CallOption) (Query_AccountsStreamClient, error)
 	// Account returns account details based on address.
 	Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error)
 	// AccountAddressByID returns account address based on account number.
@@ -57,6 +62,8 @@ type QueryClient interface {
 	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// AccountByPubKey returns the accounts with the given public key.
+	AccountByPubKey(ctx context.Context, in *QueryAccountByPubKeyRequest, opts ...grpc.CallOption) (*QueryAccountByPubKeyResponse, error)
 }
 
 type queryClient struct {
@@ -76,6 +83,38 @@ func (c *queryClient) Accounts(ctx context.Context, in *QueryAccountsRequest, op
 	return out, nil
 }
 
+func (c *queryClient) AccountsStream(ctx context.Context, in *QueryAccountsStreamRequest, opts ...grpc.CallOption) (Query_AccountsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[0], Query_AccountsStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryAccountsStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_AccountsStreamClient interface {
+	Recv() (*QueryAccountsStreamResponse, error)
+	grpc.ClientStream
+}
+
+type queryAccountsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryAccountsStreamClient) Recv() (*QueryAccountsStreamResponse, error) {
+	m := new(QueryAccountsStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queryClient) Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error) {
 	out := new(QueryAccountResponse)
 	err := c.cc.Invoke(ctx, Query_Account_FullMethodName, in, out, opts...)
@@ -157,6 +196,15 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) AccountByPubKey(ctx context.Context, in *QueryAccountByPubKeyRequest, opts ...grpc.CallOption) (*QueryAccountByPubKeyResponse, error) {
+	out := new(QueryAccountByPubKeyResponse)
+	err := c.cc.Invoke(ctx, Query_AccountByPubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -165,6 +213,9 @@ type QueryServer interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	Accounts(context.Context, *QueryAccountsRequest) (*QueryAccountsResponse, error)
+	// AccountsStream streams all the existing accounts in batches, without the
+	// round-trips of the paginated Accounts query.
+	AccountsStream(*QueryAccountsStreamRequest, Query_AccountsStreamServer) error
 	// Account returns account details based on address.
 	Account(context.Context, *QueryAccountRequest) (*QueryAccountResponse, error)
 	// AccountAddressByID returns account address based on account number.
@@ -183,6 +234,8 @@ type QueryServer interface {
 	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// AccountByPubKey returns the accounts with the given public key.
+	AccountByPubKey(context.Context, *QueryAccountByPubKeyRequest) (*QueryAccountByPubKeyResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -193,6 +246,9 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Accounts(context.Context, *QueryAccountsRequest) (*QueryAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
 }
+func (UnimplementedQueryServer) AccountsStream(*QueryAccountsStreamRequest, Query_AccountsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method AccountsStream not implemented")
+}
 func (UnimplementedQueryServer) Account(context.Context, *QueryAccountRequest) (*QueryAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
 }
@@ -220,6 +276,9 @@ func (UnimplementedQueryServer) AddressStringToBytes(context.Context, *AddressSt
 func (UnimplementedQueryServer) AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (UnimplementedQueryServer) AccountByPubKey(context.Context, *QueryAccountByPubKeyRequest) (*QueryAccountByPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountByPubKey not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -251,6 +310,27 @@ func _Query_Accounts_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryAccountsStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).AccountsStream(m, &queryAccountsStreamServer{stream})
+}
+
+type Query_AccountsStreamServer interface {
+	Send(*QueryAccountsStreamResponse) error
+	grpc.ServerStream
+}
+
+type queryAccountsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryAccountsStreamServer) Send(m *QueryAccountsStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Query_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAccountRequest)
 	if err := dec(in); err != nil {
@@ -413,6 +493,24 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountByPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountByPubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountByPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountByPubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountByPubKey(ctx, req.(*QueryAccountByPubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -460,7 +558,17 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "AccountByPubKey",
+			Handler:    _Query_AccountByPubKey_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AccountsStream",
+			Handler:       _Query_AccountsStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
 }
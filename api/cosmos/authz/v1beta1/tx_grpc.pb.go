@@ -26,6 +26,8 @@ const (
 	Msg_Revoke_FullMethodName             = "/cosmos.authz.v1beta1.Msg/Revoke"
 	Msg_RevokeAll_FullMethodName          = "/cosmos.authz.v1beta1.Msg/RevokeAll"
 	Msg_PruneExpiredGrants_FullMethodName = "/cosmos.authz.v1beta1.Msg/PruneExpiredGrants"
+	Msg_GrantBatch_FullMethodName         = "/cosmos.authz.v1beta1.Msg/GrantBatch"
+	Msg_RevokeBatch_FullMethodName        = "/cosmos.authz.v1beta1.Msg/RevokeBatch"
 )
 
 // MsgClient is the client API for Msg service.
@@ -48,6 +50,14 @@ type MsgClient interface {
 	RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error)
 	// PruneExpiredGrants prunes the expired grants. Currently up to 75 at a time.
 	PruneExpiredGrants(ctx context.Context, in *MsgPruneExpiredGrants, opts ...grpc.CallOption) (*MsgPruneExpiredGrantsResponse, error)
+	// GrantBatch grants the provided authorizations to the grantees on the
+	// granter's account. All grants are processed atomically: if one of them
+	// fails, none of them is saved.
+	GrantBatch(ctx context.Context, in *MsgGrantBatch, opts ...grpc.CallOption) (*MsgGrantBatchResponse, error)
+	// RevokeBatch revokes the provided authorizations granted to the grantees on
+	// the granter's account. All revocations are processed atomically: if one of
+	// them fails, none of them is applied.
+	RevokeBatch(ctx context.Context, in *MsgRevokeBatch, opts ...grpc.CallOption) (*MsgRevokeBatchResponse, error)
 }
 
 type msgClient struct {
@@ -103,6 +113,24 @@ func (c *msgClient) PruneExpiredGrants(ctx context.Context, in *MsgPruneExpiredG
 	return out, nil
 }
 
+func (c *msgClient) GrantBatch(ctx context.Context, in *MsgGrantBatch, opts ...grpc.CallOption) (*MsgGrantBatchResponse, error) {
+	out := new(MsgGrantBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeBatch(ctx context.Context, in *MsgRevokeBatch, opts ...grpc.CallOption) (*MsgRevokeBatchResponse, error) {
+	out := new(MsgRevokeBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -123,6 +151,14 @@ type MsgServer interface {
 	RevokeAll(context.Context, *MsgRevokeAll) (*MsgRevokeAllResponse, error)
 	// PruneExpiredGrants prunes the expired grants. Currently up to 75 at a time.
 	PruneExpiredGrants(context.Context, *MsgPruneExpiredGrants) (*MsgPruneExpiredGrantsResponse, error)
+	// GrantBatch grants the provided authorizations to the grantees on the
+	// granter's account. All grants are processed atomically: if one of them
+	// fails, none of them is saved.
+	GrantBatch(context.Context, *MsgGrantBatch) (*MsgGrantBatchResponse, error)
+	// RevokeBatch revokes the provided authorizations granted to the grantees on
+	// the granter's account. All revocations are processed atomically: if one of
+	// them fails, none of them is applied.
+	RevokeBatch(context.Context, *MsgRevokeBatch) (*MsgRevokeBatchResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -145,6 +181,12 @@ func (UnimplementedMsgServer) RevokeAll(context.Context, *MsgRevokeAll) (*MsgRev
 func (UnimplementedMsgServer) PruneExpiredGrants(context.Context, *MsgPruneExpiredGrants) (*MsgPruneExpiredGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneExpiredGrants not implemented")
 }
+func (UnimplementedMsgServer) GrantBatch(context.Context, *MsgGrantBatch) (*MsgGrantBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantBatch not implemented")
+}
+func (UnimplementedMsgServer) RevokeBatch(context.Context, *MsgRevokeBatch) (*MsgRevokeBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeBatch not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -248,6 +290,42 @@ func _Msg_PruneExpiredGrants_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantBatch(ctx, req.(*MsgGrantBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeBatch(ctx, req.(*MsgRevokeBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -275,6 +353,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PruneExpiredGrants",
 			Handler:    _Msg_PruneExpiredGrants_Handler,
 		},
+		{
+			MethodName: "GrantBatch",
+			Handler:    _Msg_GrantBatch_Handler,
+		},
+		{
+			MethodName: "RevokeBatch",
+			Handler:    _Msg_RevokeBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
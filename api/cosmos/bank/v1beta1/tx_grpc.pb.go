@@ -19,11 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Send_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Send"
-	Msg_MultiSend_FullMethodName      = "/cosmos.bank.v1beta1.Msg/MultiSend"
-	Msg_Burn_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Burn"
-	Msg_UpdateParams_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateParams"
-	Msg_SetSendEnabled_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_Send_FullMethodName               = "/cosmos.bank.v1beta1.Msg/Send"
+	Msg_MultiSend_FullMethodName          = "/cosmos.bank.v1beta1.Msg/MultiSend"
+	Msg_Burn_FullMethodName               = "/cosmos.bank.v1beta1.Msg/Burn"
+	Msg_UpdateParams_FullMethodName       = "/cosmos.bank.v1beta1.Msg/UpdateParams"
+	Msg_SetSendEnabled_FullMethodName     = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_UpdateDeniedDenoms_FullMethodName = "/cosmos.bank.v1beta1.Msg/UpdateDeniedDenoms"
 )
 
 // MsgClient is the client API for Msg service.
@@ -44,6 +45,9 @@ type MsgClient interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// UpdateDeniedDenoms is a governance operation for adding denoms to and
+	// removing denoms from the list of denoms which can't be transferred.
+	UpdateDeniedDenoms(ctx context.Context, in *MsgUpdateDeniedDenoms, opts ...grpc.CallOption) (*MsgUpdateDeniedDenomsResponse, error)
 }
 
 type msgClient struct {
@@ -99,6 +103,15 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) UpdateDeniedDenoms(ctx context.Context, in *MsgUpdateDeniedDenoms, opts ...grpc.CallOption) (*MsgUpdateDeniedDenomsResponse, error) {
+	out := new(MsgUpdateDeniedDenomsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateDeniedDenoms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -117,6 +130,9 @@ type MsgServer interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// UpdateDeniedDenoms is a governance operation for adding denoms to and
+	// removing denoms from the list of denoms which can't be transferred.
+	UpdateDeniedDenoms(context.Context, *MsgUpdateDeniedDenoms) (*MsgUpdateDeniedDenomsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -139,6 +155,9 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (UnimplementedMsgServer) UpdateDeniedDenoms(context.Context, *MsgUpdateDeniedDenoms) (*MsgUpdateDeniedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeniedDenoms not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -242,6 +261,24 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateDeniedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDeniedDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDeniedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateDeniedDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDeniedDenoms(ctx, req.(*MsgUpdateDeniedDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -269,6 +306,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "UpdateDeniedDenoms",
+			Handler:    _Msg_UpdateDeniedDenoms_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
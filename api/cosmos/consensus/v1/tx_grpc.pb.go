@@ -21,7 +21,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.consensus.v1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName          = "/cosmos.consensus.v1.Msg/UpdateParams"
+	Msg_UpdateBlockParams_FullMethodName     = "/cosmos.consensus.v1.Msg/UpdateBlockParams"
+	Msg_UpdateEvidenceParams_FullMethodName  = "/cosmos.consensus.v1.Msg/UpdateEvidenceParams"
+	Msg_UpdateValidatorParams_FullMethodName = "/cosmos.consensus.v1.Msg/UpdateValidatorParams"
+	Msg_UpdateSynchronyParams_FullMethodName = "/cosmos.consensus.v1.Msg/UpdateSynchronyParams"
+	Msg_UpdateFeatureParams_FullMethodName   = "/cosmos.consensus.v1.Msg/UpdateFeatureParams"
+	Msg_SetHalt_FullMethodName               = "/cosmos.consensus.v1.Msg/SetHalt"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +37,30 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/consensus module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateBlockParams defines a governance operation for updating only the block parameters
+	// of the x/consensus module, leaving the other parameters unchanged.
+	// The authority is defined in the keeper.
+	UpdateBlockParams(ctx context.Context, in *MsgUpdateBlockParams, opts ...grpc.CallOption) (*MsgUpdateBlockParamsResponse, error)
+	// UpdateEvidenceParams defines a governance operation for updating only the evidence parameters
+	// of the x/consensus module, leaving the other parameters unchanged.
+	// The authority is defined in the keeper.
+	UpdateEvidenceParams(ctx context.Context, in *MsgUpdateEvidenceParams, opts ...grpc.CallOption) (*MsgUpdateEvidenceParamsResponse, error)
+	// UpdateValidatorParams defines a governance operation for updating only the validator parameters
+	// of the x/consensus module, leaving the other parameters unchanged.
+	// The authority is defined in the keeper.
+	UpdateValidatorParams(ctx context.Context, in *MsgUpdateValidatorParams, opts ...grpc.CallOption) (*MsgUpdateValidatorParamsResponse, error)
+	// UpdateSynchronyParams defines a governance operation for updating only the synchrony parameters
+	// of the x/consensus module, leaving the other parameters unchanged.
+	// The authority is defined in the keeper.
+	UpdateSynchronyParams(ctx context.Context, in *MsgUpdateSynchronyParams, opts ...grpc.CallOption) (*MsgUpdateSynchronyParamsResponse, error)
+	// UpdateFeatureParams defines a governance operation for updating only the feature parameters
+	// of the x/consensus module, leaving the other parameters unchanged.
+	// The authority is defined in the keeper.
+	UpdateFeatureParams(ctx context.Context, in *MsgUpdateFeatureParams, opts ...grpc.CallOption) (*MsgUpdateFeatureParamsResponse, error)
+	// SetHalt defines a governance operation for setting the height and time at which
+	// the chain halts. Setting both to zero cancels a scheduled halt.
+	// The authority is defined in the keeper.
+	SetHalt(ctx context.Context, in *MsgSetHalt, opts ...grpc.CallOption) (*MsgSetHaltResponse, error)
 }
 
 type msgClient struct {
@@ -50,6 +80,60 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateBlockParams(ctx context.Context, in *MsgUpdateBlockParams, opts ...grpc.CallOption) (*MsgUpdateBlockParamsResponse, error) {
+	out := new(MsgUpdateBlockParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateBlockParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateEvidenceParams(ctx context.Context, in *MsgUpdateEvidenceParams, opts ...grpc.CallOption) (*MsgUpdateEvidenceParamsResponse, error) {
+	out := new(MsgUpdateEvidenceParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateEvidenceParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateValidatorParams(ctx context.Context, in *MsgUpdateValidatorParams, opts ...grpc.CallOption) (*MsgUpdateValidatorParamsResponse, error) {
+	out := new(MsgUpdateValidatorParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateValidatorParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSynchronyParams(ctx context.Context, in *MsgUpdateSynchronyParams, opts ...grpc.CallOption) (*MsgUpdateSynchronyParamsResponse, error) {
+	out := new(MsgUpdateSynchronyParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateSynchronyParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFeatureParams(ctx context.Context, in *MsgUpdateFeatureParams, opts ...grpc.CallOption) (*MsgUpdateFeatureParamsResponse, error) {
+	out := new(MsgUpdateFeatureParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateFeatureParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetHalt(ctx context.Context, in *MsgSetHalt, opts ...grpc.CallOption) (*MsgSetHaltResponse, error) {
+	out := new(MsgSetHaltResponse)
+	err := c.cc.Invoke(ctx, Msg_SetHalt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -57,6 +141,30 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/consensus module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateBlockParams defines a governance operation for updating only the block parameters
+	// of the x/consensus module, leaving the other parameters unchanged.
+	// The authority is defined in the keeper.
+	UpdateBlockParams(context.Context, *MsgUpdateBlockParams) (*MsgUpdateBlockParamsResponse, error)
+	// UpdateEvidenceParams defines a governance operation for updating only the evidence parameters
+	// of the x/consensus module, leaving the other parameters unchanged.
+	// The authority is defined in the keeper.
+	UpdateEvidenceParams(context.Context, *MsgUpdateEvidenceParams) (*MsgUpdateEvidenceParamsResponse, error)
+	// UpdateValidatorParams defines a governance operation for updating only the validator parameters
+	// of the x/consensus module, leaving the other parameters unchanged.
+	// The authority is defined in the keeper.
+	UpdateValidatorParams(context.Context, *MsgUpdateValidatorParams) (*MsgUpdateValidatorParamsResponse, error)
+	// UpdateSynchronyParams defines a governance operation for updating only the synchrony parameters
+	// of the x/consensus module, leaving the other parameters unchanged.
+	// The authority is defined in the keeper.
+	UpdateSynchronyParams(context.Context, *MsgUpdateSynchronyParams) (*MsgUpdateSynchronyParamsResponse, error)
+	// UpdateFeatureParams defines a governance operation for updating only the feature parameters
+	// of the x/consensus module, leaving the other parameters unchanged.
+	// The authority is defined in the keeper.
+	UpdateFeatureParams(context.Context, *MsgUpdateFeatureParams) (*MsgUpdateFeatureParamsResponse, error)
+	// SetHalt defines a governance operation for setting the height and time at which
+	// the chain halts. Setting both to zero cancels a scheduled halt.
+	// The authority is defined in the keeper.
+	SetHalt(context.Context, *MsgSetHalt) (*MsgSetHaltResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -67,6 +175,24 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) UpdateBlockParams(context.Context, *MsgUpdateBlockParams) (*MsgUpdateBlockParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlockParams not implemented")
+}
+func (UnimplementedMsgServer) UpdateEvidenceParams(context.Context, *MsgUpdateEvidenceParams) (*MsgUpdateEvidenceParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEvidenceParams not implemented")
+}
+func (UnimplementedMsgServer) UpdateValidatorParams(context.Context, *MsgUpdateValidatorParams) (*MsgUpdateValidatorParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidatorParams not implemented")
+}
+func (UnimplementedMsgServer) UpdateSynchronyParams(context.Context, *MsgUpdateSynchronyParams) (*MsgUpdateSynchronyParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSynchronyParams not implemented")
+}
+func (UnimplementedMsgServer) UpdateFeatureParams(context.Context, *MsgUpdateFeatureParams) (*MsgUpdateFeatureParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeatureParams not implemented")
+}
+func (UnimplementedMsgServer) SetHalt(context.Context, *MsgSetHalt) (*MsgSetHaltResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHalt not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -98,6 +224,114 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateBlockParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBlockParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBlockParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateBlockParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBlockParams(ctx, req.(*MsgUpdateBlockParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateEvidenceParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEvidenceParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEvidenceParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateEvidenceParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEvidenceParams(ctx, req.(*MsgUpdateEvidenceParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateValidatorParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateValidatorParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateValidatorParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateValidatorParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateValidatorParams(ctx, req.(*MsgUpdateValidatorParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSynchronyParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSynchronyParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSynchronyParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateSynchronyParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSynchronyParams(ctx, req.(*MsgUpdateSynchronyParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFeatureParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeatureParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeatureParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateFeatureParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeatureParams(ctx, req.(*MsgUpdateFeatureParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetHalt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHalt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetHalt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetHalt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHalt(ctx, req.(*MsgSetHalt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -109,6 +343,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateBlockParams",
+			Handler:    _Msg_UpdateBlockParams_Handler,
+		},
+		{
+			MethodName: "UpdateEvidenceParams",
+			Handler:    _Msg_UpdateEvidenceParams_Handler,
+		},
+		{
+			MethodName: "UpdateValidatorParams",
+			Handler:    _Msg_UpdateValidatorParams_Handler,
+		},
+		{
+			MethodName: "UpdateSynchronyParams",
+			Handler:    _Msg_UpdateSynchronyParams_Handler,
+		},
+		{
+			MethodName: "UpdateFeatureParams",
+			Handler:    _Msg_UpdateFeatureParams_Handler,
+		},
+		{
+			MethodName: "SetHalt",
+			Handler:    _Msg_SetHalt_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/tx.proto",
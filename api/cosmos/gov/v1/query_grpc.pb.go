@@ -32,6 +32,7 @@ const (
 	Query_TallyResult_FullMethodName         = "/cosmos.gov.v1.Query/TallyResult"
 	Query_ProposalVoteOptions_FullMethodName = "/cosmos.gov.v1.Query/ProposalVoteOptions"
 	Query_MessageBasedParams_FullMethodName  = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_VoteBreakdown_FullMethodName       = "/cosmos.gov.v1.Query/VoteBreakdown"
 )
 
 // QueryClient is the client API for Query service.
@@ -60,6 +61,10 @@ type QueryClient interface {
 	ProposalVoteOptions(ctx context.Context, in *QueryProposalVoteOptionsRequest, opts ...grpc.CallOption) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// VoteBreakdown queries, for each bonded validator, how much of its voting
+	// power on a proposal in voting period is inherited from its delegators who
+	// did not vote, and how much is overridden by the votes of its delegators.
+	VoteBreakdown(ctx context.Context, in *QueryVoteBreakdownRequest, opts ...grpc.CallOption) (*QueryVoteBreakdownResponse, error)
 }
 
 type queryClient struct {
@@ -169,6 +174,15 @@ func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBa
 	return out, nil
 }
 
+func (c *queryClient) VoteBreakdown(ctx context.Context, in *QueryVoteBreakdownRequest, opts ...grpc.CallOption) (*QueryVoteBreakdownResponse, error) {
+	out := new(QueryVoteBreakdownResponse)
+	err := c.cc.Invoke(ctx, Query_VoteBreakdown_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -195,6 +209,10 @@ type QueryServer interface {
 	ProposalVoteOptions(context.Context, *QueryProposalVoteOptionsRequest) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// VoteBreakdown queries, for each bonded validator, how much of its voting
+	// power on a proposal in voting period is inherited from its delegators who
+	// did not vote, and how much is overridden by the votes of its delegators.
+	VoteBreakdown(context.Context, *QueryVoteBreakdownRequest) (*QueryVoteBreakdownResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -235,6 +253,9 @@ func (UnimplementedQueryServer) ProposalVoteOptions(context.Context, *QueryPropo
 func (UnimplementedQueryServer) MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
 }
+func (UnimplementedQueryServer) VoteBreakdown(context.Context, *QueryVoteBreakdownRequest) (*QueryVoteBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteBreakdown not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -446,6 +467,24 @@ func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VoteBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VoteBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteBreakdown(ctx, req.(*QueryVoteBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -497,6 +536,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MessageBasedParams",
 			Handler:    _Query_MessageBasedParams_Handler,
 		},
+		{
+			MethodName: "VoteBreakdown",
+			Handler:    _Query_VoteBreakdown_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -35,6 +35,8 @@ const (
 	Query_GroupsByMember_FullMethodName         = "/cosmos.group.v1.Query/GroupsByMember"
 	Query_TallyResult_FullMethodName            = "/cosmos.group.v1.Query/TallyResult"
 	Query_Groups_FullMethodName                 = "/cosmos.group.v1.Query/Groups"
+	Query_ProposalsByVoter_FullMethodName       = "/cosmos.group.v1.Query/ProposalsByVoter"
+	Query_ProposalsByStatus_FullMethodName      = "/cosmos.group.v1.Query/ProposalsByStatus"
 )
 
 // QueryClient is the client API for Query service.
@@ -73,6 +75,12 @@ type QueryClient interface {
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
 	// Groups queries all groups in state.
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// ProposalsByVoter queries the proposals a voter has voted on. Votes are
+	// pruned once proposals are tallied, so these are the proposals still open
+	// for voting.
+	ProposalsByVoter(ctx context.Context, in *QueryProposalsByVoterRequest, opts ...grpc.CallOption) (*QueryProposalsByVoterResponse, error)
+	// ProposalsByStatus queries the proposals with a given status.
+	ProposalsByStatus(ctx context.Context, in *QueryProposalsByStatusRequest, opts ...grpc.CallOption) (*QueryProposalsByStatusResponse, error)
 }
 
 type queryClient struct {
@@ -209,6 +217,24 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ProposalsByVoter(ctx context.Context, in *QueryProposalsByVoterRequest, opts ...grpc.CallOption) (*QueryProposalsByVoterResponse, error) {
+	out := new(QueryProposalsByVoterResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalsByVoter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalsByStatus(ctx context.Context, in *QueryProposalsByStatusRequest, opts ...grpc.CallOption) (*QueryProposalsByStatusResponse, error) {
+	out := new(QueryProposalsByStatusResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalsByStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -245,6 +271,12 @@ type QueryServer interface {
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
 	// Groups queries all groups in state.
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// ProposalsByVoter queries the proposals a voter has voted on. Votes are
+	// pruned once proposals are tallied, so these are the proposals still open
+	// for voting.
+	ProposalsByVoter(context.Context, *QueryProposalsByVoterRequest) (*QueryProposalsByVoterResponse, error)
+	// ProposalsByStatus queries the proposals with a given status.
+	ProposalsByStatus(context.Context, *QueryProposalsByStatusRequest) (*QueryProposalsByStatusResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -294,6 +326,12 @@ func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRe
 func (UnimplementedQueryServer) Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (UnimplementedQueryServer) ProposalsByVoter(context.Context, *QueryProposalsByVoterRequest) (*QueryProposalsByVoterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByVoter not implemented")
+}
+func (UnimplementedQueryServer) ProposalsByStatus(context.Context, *QueryProposalsByStatusRequest) (*QueryProposalsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByStatus not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -559,6 +597,42 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalsByVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByVoterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalsByVoter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByVoter(ctx, req.(*QueryProposalsByVoterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalsByStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByStatus(ctx, req.(*QueryProposalsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -622,6 +696,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "ProposalsByVoter",
+			Handler:    _Query_ProposalsByVoter_Handler,
+		},
+		{
+			MethodName: "ProposalsByStatus",
+			Handler:    _Query_ProposalsByStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
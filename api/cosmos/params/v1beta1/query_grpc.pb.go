@@ -19,8 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName    = "/cosmos.params.v1beta1.Query/Params"
-	Query_Subspaces_FullMethodName = "/cosmos.params.v1beta1.Query/Subspaces"
+	Query_Params_FullMethodName           = "/cosmos.params.v1beta1.Query/Params"
+	Query_Subspaces_FullMethodName        = "/cosmos.params.v1beta1.Query/Subspaces"
+	Query_Schemas_FullMethodName          = "/cosmos.params.v1beta1.Query/Schemas"
+	Query_RegisteredKeys_FullMethodName   = "/cosmos.params.v1beta1.Query/RegisteredKeys"
+	Query_ParamsMigrations_FullMethodName = "/cosmos.params.v1beta1.Query/ParamsMigrations"
 )
 
 // QueryClient is the client API for Query service.
@@ -32,6 +35,18 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Subspaces queries for all registered subspaces and all keys for a subspace.
 	Subspaces(ctx context.Context, in *QuerySubspacesRequest, opts ...grpc.CallOption) (*QuerySubspacesResponse, error)
+	// Schemas queries for the registered subspaces together with the schema and
+	// the current raw value of each of their parameters.
+	Schemas(ctx context.Context, in *QuerySchemasRequest, opts ...grpc.CallOption) (*QuerySchemasResponse, error)
+	// RegisteredKeys queries for the registered subspaces and the keys
+	// registered in each of their key tables, which are the keys a parameter
+	// change proposal can update.
+	RegisteredKeys(ctx context.Context, in *QueryRegisteredKeysRequest, opts ...grpc.CallOption) (*QueryRegisteredKeysResponse, error)
+	// ParamsMigrations generates the MsgUpdateParams messages which set the
+	// parameters stored in legacy subspaces as the parameters of the modules
+	// which manage their own parameters, for the subspaces with a registered
+	// migration.
+	ParamsMigrations(ctx context.Context, in *QueryParamsMigrationsRequest, opts ...grpc.CallOption) (*QueryParamsMigrationsResponse, error)
 }
 
 type queryClient struct {
@@ -60,6 +75,33 @@ func (c *queryClient) Subspaces(ctx context.Context, in *QuerySubspacesRequest,
 	return out, nil
 }
 
+func (c *queryClient) Schemas(ctx context.Context, in *QuerySchemasRequest, opts ...grpc.CallOption) (*QuerySchemasResponse, error) {
+	out := new(QuerySchemasResponse)
+	err := c.cc.Invoke(ctx, Query_Schemas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegisteredKeys(ctx context.Context, in *QueryRegisteredKeysRequest, opts ...grpc.CallOption) (*QueryRegisteredKeysResponse, error) {
+	out := new(QueryRegisteredKeysResponse)
+	err := c.cc.Invoke(ctx, Query_RegisteredKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamsMigrations(ctx context.Context, in *QueryParamsMigrationsRequest, opts ...grpc.CallOption) (*QueryParamsMigrationsResponse, error) {
+	out := new(QueryParamsMigrationsResponse)
+	err := c.cc.Invoke(ctx, Query_ParamsMigrations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -69,6 +111,18 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Subspaces queries for all registered subspaces and all keys for a subspace.
 	Subspaces(context.Context, *QuerySubspacesRequest) (*QuerySubspacesResponse, error)
+	// Schemas queries for the registered subspaces together with the schema and
+	// the current raw value of each of their parameters.
+	Schemas(context.Context, *QuerySchemasRequest) (*QuerySchemasResponse, error)
+	// RegisteredKeys queries for the registered subspaces and the keys
+	// registered in each of their key tables, which are the keys a parameter
+	// change proposal can update.
+	RegisteredKeys(context.Context, *QueryRegisteredKeysRequest) (*QueryRegisteredKeysResponse, error)
+	// ParamsMigrations generates the MsgUpdateParams messages which set the
+	// parameters stored in legacy subspaces as the parameters of the modules
+	// which manage their own parameters, for the subspaces with a registered
+	// migration.
+	ParamsMigrations(context.Context, *QueryParamsMigrationsRequest) (*QueryParamsMigrationsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -82,6 +136,15 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) Subspaces(context.Context, *QuerySubspacesRequest) (*QuerySubspacesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Subspaces not implemented")
 }
+func (UnimplementedQueryServer) Schemas(context.Context, *QuerySchemasRequest) (*QuerySchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schemas not implemented")
+}
+func (UnimplementedQueryServer) RegisteredKeys(context.Context, *QueryRegisteredKeysRequest) (*QueryRegisteredKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredKeys not implemented")
+}
+func (UnimplementedQueryServer) ParamsMigrations(context.Context, *QueryParamsMigrationsRequest) (*QueryParamsMigrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsMigrations not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -131,6 +194,60 @@ func _Query_Subspaces_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Schemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Schemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Schemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Schemas(ctx, req.(*QuerySchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegisteredKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RegisteredKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredKeys(ctx, req.(*QueryRegisteredKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsMigrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParamsMigrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsMigrations(ctx, req.(*QueryParamsMigrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -146,6 +263,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Subspaces",
 			Handler:    _Query_Subspaces_Handler,
 		},
+		{
+			MethodName: "Schemas",
+			Handler:    _Query_Schemas_Handler,
+		},
+		{
+			MethodName: "RegisteredKeys",
+			Handler:    _Query_RegisteredKeys_Handler,
+		},
+		{
+			MethodName: "ParamsMigrations",
+			Handler:    _Query_ParamsMigrations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/params/v1beta1/query.proto",
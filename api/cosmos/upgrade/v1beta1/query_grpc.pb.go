@@ -24,6 +24,9 @@ const (
 	Query_UpgradedConsensusState_FullMethodName = "/cosmos.upgrade.v1beta1.Query/UpgradedConsensusState"
 	Query_ModuleVersions_FullMethodName         = "/cosmos.upgrade.v1beta1.Query/ModuleVersions"
 	Query_Authority_FullMethodName              = "/cosmos.upgrade.v1beta1.Query/Authority"
+	Query_UpgradeQueue_FullMethodName           = "/cosmos.upgrade.v1beta1.Query/UpgradeQueue"
+	Query_PlanArtifacts_FullMethodName          = "/cosmos.upgrade.v1beta1.Query/PlanArtifacts"
+	Query_Params_FullMethodName                 = "/cosmos.upgrade.v1beta1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -46,6 +49,13 @@ type QueryClient interface {
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// UpgradeQueue queries the upgrade plans queued after the current plan.
+	UpgradeQueue(ctx context.Context, in *QueryUpgradeQueueRequest, opts ...grpc.CallOption) (*QueryUpgradeQueueResponse, error)
+	// PlanArtifacts queries the binaries listed in the info of the current upgrade plan,
+	// with the checksums and signatures they must be verified against.
+	PlanArtifacts(ctx context.Context, in *QueryPlanArtifactsRequest, opts ...grpc.CallOption) (*QueryPlanArtifactsResponse, error)
+	// Params queries the parameters of the x/upgrade module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -102,6 +112,33 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) UpgradeQueue(ctx context.Context, in *QueryUpgradeQueueRequest, opts ...grpc.CallOption) (*QueryUpgradeQueueResponse, error) {
+	out := new(QueryUpgradeQueueResponse)
+	err := c.cc.Invoke(ctx, Query_UpgradeQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PlanArtifacts(ctx context.Context, in *QueryPlanArtifactsRequest, opts ...grpc.CallOption) (*QueryPlanArtifactsResponse, error) {
+	out := new(QueryPlanArtifactsResponse)
+	err := c.cc.Invoke(ctx, Query_PlanArtifacts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -122,6 +159,13 @@ type QueryServer interface {
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// UpgradeQueue queries the upgrade plans queued after the current plan.
+	UpgradeQueue(context.Context, *QueryUpgradeQueueRequest) (*QueryUpgradeQueueResponse, error)
+	// PlanArtifacts queries the binaries listed in the info of the current upgrade plan,
+	// with the checksums and signatures they must be verified against.
+	PlanArtifacts(context.Context, *QueryPlanArtifactsRequest) (*QueryPlanArtifactsResponse, error)
+	// Params queries the parameters of the x/upgrade module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -144,6 +188,15 @@ func (UnimplementedQueryServer) ModuleVersions(context.Context, *QueryModuleVers
 func (UnimplementedQueryServer) Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (UnimplementedQueryServer) UpgradeQueue(context.Context, *QueryUpgradeQueueRequest) (*QueryUpgradeQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeQueue not implemented")
+}
+func (UnimplementedQueryServer) PlanArtifacts(context.Context, *QueryPlanArtifactsRequest) (*QueryPlanArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanArtifacts not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -247,6 +300,60 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpgradeQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UpgradeQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeQueue(ctx, req.(*QueryUpgradeQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PlanArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlanArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlanArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PlanArtifacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlanArtifacts(ctx, req.(*QueryPlanArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -274,6 +381,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "UpgradeQueue",
+			Handler:    _Query_UpgradeQueue_Handler,
+		},
+		{
+			MethodName: "PlanArtifacts",
+			Handler:    _Query_PlanArtifacts_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
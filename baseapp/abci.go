@@ -2,6 +2,7 @@ package baseapp
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
@@ -12,6 +13,8 @@ import (
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	"github.com/cosmos/gogoproto/proto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
 
@@ -294,6 +297,16 @@ func (app *BaseApp) OfferSnapshot(req *abci.OfferSnapshotRequest) (*abci.OfferSn
 		)
 		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_REJECT}, nil
 
+	case errors.Is(err, snapshottypes.ErrBaseSnapshotNotFound):
+		// the base snapshot of a differential snapshot must have been restored locally
+		app.logger.Info(
+			"rejecting differential snapshot without local base snapshot",
+			"height", req.Snapshot.Height,
+			"format", req.Snapshot.Format,
+			"err", err,
+		)
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_REJECT}, nil
+
 	default:
 		// CometBFT errors are defined here: https://github.com/cometbft/cometbft/blob/main/statesync/syncer.go
 		// It may happen that in case of a CometBFT error, such as a timeout (which occurs after two minutes),
@@ -722,13 +735,20 @@ func (app *BaseApp) VerifyVoteExtension(req *abci.VerifyVoteExtensionRequest) (r
 // Execution flow or by the FinalizeBlock ABCI method. The context received is
 // only used to handle early cancellation, for anything related to state app.finalizeBlockState.Context()
 // must be used.
-func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
+func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.FinalizeBlockRequest) (_ *abci.FinalizeBlockResponse, err error) {
 	var events []abci.Event
 
+	ctx, span := telemetry.StartSpan(ctx, "FinalizeBlock", attribute.Int64("height", req.Height), attribute.Int("txs", len(req.Txs)))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	if err := app.checkHalt(req.Height, req.Time); err != nil {
 		return nil, err
 	}
 
+	if err := app.invariantAuditor.checkHalt(); err != nil {
+		return nil, err
+	}
+
 	if err := app.validateFinalizeBlockHeight(req); err != nil {
 		return nil, err
 	}
@@ -755,8 +775,10 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 		app.setState(execModeFinalize, header)
 	}
 
-	// Context is now updated with Header information.
+	// Context is now updated with Header information, and holds the block span
+	// so that the spans of the block execution are its children.
 	app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().
+		WithContext(trace.ContextWithSpan(app.finalizeBlockState.Context().Context(), span)).
 		WithBlockHeader(header).
 		WithHeaderHash(req.Hash).
 		WithHeaderInfo(coreheader.Info{
@@ -786,13 +808,23 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 			WithHeaderHash(req.Hash))
 	}
 
+	_, preBlockSpan := telemetry.StartSpan(ctx, "PreBlock")
 	preblockEvents, err := app.preBlock(req)
+	telemetry.EndSpan(preBlockSpan, err)
 	if err != nil {
 		return nil, err
 	}
 	events = append(events, preblockEvents...)
 
+	// the on-chain halt is checked after PreBlock, so that an upgraded binary can
+	// lift it by clearing it in its PreBlocker.
+	if err := app.checkOnChainHalt(app.finalizeBlockState.Context(), req.Height, req.Time); err != nil {
+		return nil, err
+	}
+
+	_, beginBlockSpan := telemetry.StartSpan(ctx, "BeginBlock")
 	beginBlock, err := app.beginBlock(req)
+	telemetry.EndSpan(beginBlockSpan, err)
 	if err != nil {
 		return nil, err
 	}
@@ -817,27 +849,18 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 	//
 	// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
 	// vote extensions, so skip those.
-	txResults := make([]*abci.ExecTxResult, 0, len(req.Txs))
-	for _, rawTx := range req.Txs {
-
-		response := app.deliverTx(rawTx)
-
-		// check after every tx if we should abort
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-			// continue
-		}
-
-		txResults = append(txResults, response)
+	txResults, err := app.deliverTxs(ctx, req.Txs)
+	if err != nil {
+		return nil, err
 	}
 
 	if app.finalizeBlockState.ms.TracingEnabled() {
 		app.finalizeBlockState.ms = app.finalizeBlockState.ms.SetTracingContext(nil).(storetypes.CacheMultiStore)
 	}
 
+	_, endBlockSpan := telemetry.StartSpan(ctx, "EndBlock")
 	endBlock, err := app.endBlock(app.finalizeBlockState.Context())
+	telemetry.EndSpan(endBlockSpan, err)
 	if err != nil {
 		return nil, err
 	}
@@ -912,22 +935,56 @@ func (app *BaseApp) FinalizeBlock(req *abci.FinalizeBlockRequest) (res *abci.Fin
 
 // checkHalt checks if height or time exceeds halt-height or halt-time respectively.
 func (app *BaseApp) checkHalt(height int64, time time.Time) error {
-	var halt bool
-	switch {
-	case app.haltHeight > 0 && uint64(height) > app.haltHeight:
-		halt = true
+	if shouldHalt(app.haltHeight, app.haltTime, height, time) {
+		return fmt.Errorf("halt per configuration height %d time %d", app.haltHeight, app.haltTime)
+	}
+
+	return nil
+}
 
-	case app.haltTime > 0 && time.Unix() > int64(app.haltTime):
-		halt = true
+// checkOnChainHalt checks if height is right after the halt height, or if time
+// exceeds the halt time, coordinated on-chain through the halt store, if any.
+//
+// Unlike the halt-height of the configuration, which the operators remove to
+// restart, the on-chain halt height is part of the state: it only halts the
+// block following it, so that the chain is not halted forever once it went
+// past it. Both halts can be lifted by clearing them in the PreBlocker of an
+// upgraded binary.
+func (app *BaseApp) checkOnChainHalt(ctx context.Context, height int64, time time.Time) error {
+	if app.haltStore == nil {
+		return nil
 	}
 
-	if halt {
-		return fmt.Errorf("halt per configuration height %d time %d", app.haltHeight, app.haltTime)
+	haltHeight, haltTime, err := app.haltStore.GetHalt(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get on-chain halt: %w", err)
+	}
+
+	switch {
+	case haltHeight > 0 && uint64(height) == haltHeight+1:
+		return fmt.Errorf("halt per on-chain height %d time %d", haltHeight, haltTime)
+
+	case haltTime > 0 && time.Unix() > int64(haltTime):
+		return fmt.Errorf("halt per on-chain height %d time %d", haltHeight, haltTime)
 	}
 
 	return nil
 }
 
+// shouldHalt returns true if height or time exceeds the non-zero halt height
+// or halt time respectively.
+func shouldHalt(haltHeight, haltTime uint64, height int64, time time.Time) bool {
+	switch {
+	case haltHeight > 0 && uint64(height) > haltHeight:
+		return true
+
+	case haltTime > 0 && time.Unix() > int64(haltTime):
+		return true
+	}
+
+	return false
+}
+
 // Commit implements the ABCI interface. It will commit all state that exists in
 // the deliver state's multi-store and includes the resulting commit ID in the
 // returned abci.ResponseCommit. Commit will set the check state based on the
@@ -975,6 +1032,9 @@ func (app *BaseApp) Commit() (*abci.CommitResponse, error) {
 
 	app.finalizeBlockState = nil
 
+	// the cached query responses are invalidated on commit
+	app.grpcQueryRouter.PurgeQueryCache()
+
 	if app.prepareCheckStater != nil {
 		app.prepareCheckStater(app.checkState.Context())
 	}
@@ -982,6 +1042,9 @@ func (app *BaseApp) Commit() (*abci.CommitResponse, error) {
 	// The SnapshotIfApplicable method will create the snapshot by starting the goroutine
 	app.snapshotManager.SnapshotIfApplicable(header.Height)
 
+	// The auditIfApplicable method will assert the invariants by starting the goroutine
+	app.invariantAuditor.auditIfApplicable(app.cms, header)
+
 	return resp, nil
 }
 
@@ -1030,6 +1093,58 @@ func handleQueryApp(app *BaseApp, path []string, req *abci.QueryRequest) *abci.Q
 				Value:     bz,
 			}
 
+		case "simulate_gas_trace":
+			_, _, trace, err := app.SimulateWithGasTrace(req.Data)
+			if err != nil {
+				return queryResult(errorsmod.Wrap(err, "failed to simulate tx"), app.trace)
+			}
+
+			bz, err := json.Marshal(trace)
+			if err != nil {
+				return queryResult(errorsmod.Wrap(err, "failed to JSON encode gas trace"), app.trace)
+			}
+
+			return &abci.QueryResponse{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
+		case "state_stats":
+			var stats any
+			if rms, ok := app.cms.(*rootmulti.Store); ok {
+				// a nil map is reported as disabled, not as a null value
+				if s := rms.StateStats(); s != nil {
+					stats = s
+				}
+			}
+			if stats == nil {
+				return queryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "state stats are not enabled"), app.trace)
+			}
+
+			bz, err := json.Marshal(stats)
+			if err != nil {
+				return queryResult(errorsmod.Wrap(err, "failed to JSON encode state stats"), app.trace)
+			}
+
+			return &abci.QueryResponse{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
+		case "invariant_statuses":
+			bz, err := json.Marshal(app.InvariantStatuses())
+			if err != nil {
+				return queryResult(errorsmod.Wrap(err, "failed to JSON encode invariant statuses"), app.trace)
+			}
+
+			return &abci.QueryResponse{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
 		case "version":
 			return &abci.QueryResponse{
 				Codespace: sdkerrors.RootCodespace,
@@ -1045,7 +1160,7 @@ func handleQueryApp(app *BaseApp, path []string, req *abci.QueryRequest) *abci.Q
 	return queryResult(
 		errorsmod.Wrap(
 			sdkerrors.ErrUnknownRequest,
-			"expected second parameter to be either 'simulate' or 'version', neither was present",
+			"expected second parameter to be either 'simulate', 'simulate_gas_trace', 'invariant_statuses' or 'version', none was present",
 		), app.trace)
 }
 
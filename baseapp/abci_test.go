@@ -6,11 +6,13 @@ import (
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/rand"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -27,14 +29,20 @@ import (
 	any "github.com/cosmos/gogoproto/types/any"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/statskv"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/auth/signing"
+	banktypes "cosmossdk.io/x/bank/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
@@ -493,6 +501,326 @@ func TestABCI_GRPCQuery(t *testing.T) {
 	require.Equal(t, "Hello foo!", res.Greeting)
 }
 
+// countingBankQueryServer counts the calls of the deterministic bank Balance
+// query.
+type countingBankQueryServer struct {
+	banktypes.UnimplementedQueryServer
+	calls int
+}
+
+func (s *countingBankQueryServer) Balance(_ context.Context, req *banktypes.QueryBalanceRequest) (*banktypes.QueryBalanceResponse, error) {
+	s.calls++
+	coin := sdk.NewInt64Coin(req.Denom, int64(s.calls))
+	return &banktypes.QueryBalanceResponse{Balance: &coin}, nil
+}
+
+// countingTestdataQueryServer counts the calls of the non deterministic
+// testdata SayHello query.
+type countingTestdataQueryServer struct {
+	testdata.QueryImpl
+	calls int
+}
+
+func (s *countingTestdataQueryServer) SayHello(ctx context.Context, req *testdata.SayHelloRequest) (*testdata.SayHelloResponse, error) {
+	s.calls++
+	return s.QueryImpl.SayHello(ctx, req)
+}
+
+func TestABCI_GRPCQuery_Cache(t *testing.T) {
+	bankServer := &countingBankQueryServer{}
+	testdataServer := &countingTestdataQueryServer{}
+	grpcQueryOpt := func(bapp *baseapp.BaseApp) {
+		banktypes.RegisterQueryServer(bapp.GRPCQueryRouter(), bankServer)
+		testdata.RegisterQueryServer(bapp.GRPCQueryRouter(), testdataServer)
+	}
+
+	suite := NewBaseAppSuite(t, grpcQueryOpt, baseapp.SetQueryCacheSize(10))
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	commit := func() {
+		_, err := suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: suite.baseApp.LastBlockHeight() + 1})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+	commit()
+
+	queryBalance := func(denom string) int64 {
+		reqBz, err := (&banktypes.QueryBalanceRequest{Address: "addr", Denom: denom}).Marshal()
+		require.NoError(t, err)
+
+		resQuery, err := suite.baseApp.Query(context.TODO(), &abci.QueryRequest{
+			Data: reqBz,
+			Path: "/cosmos.bank.v1beta1.Query/Balance",
+		})
+		require.NoError(t, err)
+		require.Equal(t, abci.CodeTypeOK, resQuery.Code, resQuery)
+
+		var res banktypes.QueryBalanceResponse
+		require.NoError(t, res.Unmarshal(resQuery.Value))
+		return res.Balance.Amount.Int64()
+	}
+
+	// the response of a deterministic query is cached
+	require.Equal(t, int64(1), queryBalance("stake"))
+	require.Equal(t, int64(1), queryBalance("stake"))
+	require.Equal(t, 1, bankServer.calls)
+
+	// another request is not served from the cache
+	require.Equal(t, int64(2), queryBalance("atom"))
+	require.Equal(t, 2, bankServer.calls)
+
+	// the cache is invalidated on commit
+	commit()
+	require.Equal(t, int64(3), queryBalance("stake"))
+	require.Equal(t, 3, bankServer.calls)
+
+	// non deterministic queries are never cached
+	reqBz, err := (&testdata.SayHelloRequest{Name: fooStr}).Marshal()
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		resQuery, err := suite.baseApp.Query(context.TODO(), &abci.QueryRequest{
+			Data: reqBz,
+			Path: "/testpb.Query/SayHello",
+		})
+		require.NoError(t, err)
+		require.Equal(t, abci.CodeTypeOK, resQuery.Code, resQuery)
+	}
+	require.Equal(t, 2, testdataServer.calls)
+}
+
+func TestABCI_InvariantAudit(t *testing.T) {
+	reports := make(chan baseapp.InvariantAuditReport, 1)
+	auditOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetEndBlocker(func(ctx sdk.Context) (sdk.EndBlock, error) {
+			ctx.KVStore(capKey1).Set([]byte("height"), []byte(fmt.Sprint(ctx.BlockHeight())))
+			return sdk.EndBlock{}, nil
+		})
+		bapp.InvariantRegistry().RegisterRoute("test", "always-holds", func(ctx sdk.Context) (string, bool) {
+			return "", false
+		})
+		bapp.InvariantRegistry().RegisterRoute("test", "height", func(ctx sdk.Context) (string, bool) {
+			return fmt.Sprintf("stored height %s", ctx.KVStore(capKey1).Get([]byte("height"))), true
+		})
+		bapp.InvariantRegistry().RegisterRoute("test", "panics", func(ctx sdk.Context) (string, bool) {
+			panic("boom")
+		})
+		bapp.SetInvariantAuditReporter(func(report baseapp.InvariantAuditReport) {
+			reports <- report
+		})
+	}
+
+	suite := NewBaseAppSuite(t, auditOpt, baseapp.SetInvariantAuditInterval(2))
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	commit := func() {
+		_, err := suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: suite.baseApp.LastBlockHeight() + 1})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	for height := int64(1); height <= 4; height++ {
+		commit()
+		if height%2 != 0 {
+			continue
+		}
+
+		// the invariants are asserted on the state committed at the height, and
+		// a broken invariant does not halt the chain
+		report := <-reports
+		require.Equal(t, height, report.Height)
+		require.Equal(t, []baseapp.BrokenInvariant{
+			{Route: "test/height", Msg: fmt.Sprintf("stored height %d", height)},
+			{Route: "test/panics", Msg: "invariant panicked: boom"},
+		}, report.Broken)
+	}
+
+	require.NoError(t, suite.baseApp.Close())
+	require.Empty(t, reports)
+}
+
+func TestABCI_InvariantAuditBatches(t *testing.T) {
+	reports := make(chan baseapp.InvariantAuditReport, 1)
+	auditOpt := func(bapp *baseapp.BaseApp) {
+		for _, route := range []string{"a", "b", "c"} {
+			bapp.InvariantRegistry().RegisterRoute("test", route, func(ctx sdk.Context) (string, bool) {
+				return fmt.Sprintf("%s at %d", route, ctx.BlockHeight()), route == "b"
+			})
+		}
+		bapp.SetInvariantAuditReporter(func(report baseapp.InvariantAuditReport) {
+			reports <- report
+		})
+	}
+
+	suite := NewBaseAppSuite(t, auditOpt, baseapp.SetInvariantAuditInterval(1), baseapp.SetInvariantAuditBatchSize(2))
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	queryStatuses := func() []baseapp.InvariantStatus {
+		res, err := suite.baseApp.Query(context.TODO(), &abci.QueryRequest{Path: "/app/invariant_statuses"})
+		require.NoError(t, err)
+		require.True(t, res.IsOK(), res.Log)
+
+		var statuses []baseapp.InvariantStatus
+		require.NoError(t, json.Unmarshal(res.Value, &statuses))
+		return statuses
+	}
+
+	// no invariant is asserted before the first audit
+	require.Equal(t, []baseapp.InvariantStatus{
+		{Route: "test/a"}, {Route: "test/b"}, {Route: "test/c"},
+	}, queryStatuses())
+
+	// the batches cycle through the invariants
+	expected := [][]string{
+		{"test/a", "test/b"},
+		{"test/c", "test/a"},
+		{"test/b", "test/c"},
+	}
+	for i, routes := range expected {
+		height := int64(i + 1)
+		_, err := suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+
+		report := <-reports
+		require.Equal(t, height, report.Height)
+		require.Equal(t, routes, report.Routes)
+	}
+
+	require.Equal(t, []baseapp.InvariantStatus{
+		{Route: "test/a", Height: 2, Msg: "a at 2"},
+		{Route: "test/b", Height: 3, Broken: true, Msg: "b at 3"},
+		{Route: "test/c", Height: 3, Msg: "c at 3"},
+	}, queryStatuses())
+}
+
+func TestABCI_InvariantAuditHaltPolicy(t *testing.T) {
+	reports := make(chan baseapp.InvariantAuditReport, 1)
+	auditOpt := func(bapp *baseapp.BaseApp) {
+		bapp.InvariantRegistry().RegisterRoute("other", "broken", func(ctx sdk.Context) (string, bool) {
+			return "reported", true
+		})
+		bapp.InvariantRegistry().RegisterRoute("test", "broken", func(ctx sdk.Context) (string, bool) {
+			return "halting", ctx.BlockHeight() >= 2
+		})
+		bapp.SetInvariantAuditReporter(func(report baseapp.InvariantAuditReport) {
+			reports <- report
+		})
+	}
+
+	suite := NewBaseAppSuite(t, auditOpt,
+		baseapp.SetInvariantAuditInterval(1),
+		baseapp.SetInvariantAuditPolicy("test", baseapp.InvariantPolicyHalt),
+	)
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// the invariants with the default policy are only reported
+	for height := int64(1); height <= 2; height++ {
+		_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+		<-reports
+	}
+
+	// the node halts at the block following the audit which found the
+	// invariant with the halt policy broken
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 3})
+	require.ErrorContains(t, err, "halt per invariant policy, invariant test/broken broken: halting")
+}
+
+func TestABCI_InvariantAuditPrunedVersion(t *testing.T) {
+	reports := make(chan baseapp.InvariantAuditReport, 1)
+	started, release := make(chan struct{}), make(chan struct{})
+	auditOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetEndBlocker(func(ctx sdk.Context) (sdk.EndBlock, error) {
+			ctx.KVStore(capKey1).Set([]byte("height"), []byte(fmt.Sprint(ctx.BlockHeight())))
+			return sdk.EndBlock{}, nil
+		})
+		bapp.InvariantRegistry().RegisterRoute("test", "height", func(ctx sdk.Context) (string, bool) {
+			if ctx.BlockHeight() == 2 {
+				close(started)
+				<-release
+			}
+			stored := string(ctx.KVStore(capKey1).Get([]byte("height")))
+			return "stored height " + stored, stored != fmt.Sprint(ctx.BlockHeight())
+		})
+		bapp.SetInvariantAuditReporter(func(report baseapp.InvariantAuditReport) {
+			reports <- report
+		})
+	}
+
+	suite := NewBaseAppSuite(t, auditOpt,
+		baseapp.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningEverything)),
+		// the fast nodes are not safe to read concurrently with the commits
+		baseapp.SetIAVLDisableFastNode(true),
+		baseapp.SetInvariantAuditInterval(2),
+		baseapp.SetInvariantAuditPolicy("test", baseapp.InvariantPolicyHalt),
+	)
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	commit := func() {
+		_, err := suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: suite.baseApp.LastBlockHeight() + 1})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	// the audited version is not pruned by the commits made during the audit,
+	// the versions up to 7 being pruned at 10
+	commit()
+	commit()
+	<-started
+	for height := 3; height <= 10; height++ {
+		commit()
+	}
+	require.Never(t, func() bool {
+		_, err := suite.baseApp.CommitMultiStore().CacheMultiStoreWithVersion(2)
+		return err != nil
+	}, 200*time.Millisecond, 10*time.Millisecond)
+	close(release)
+	report := <-reports
+	require.Equal(t, int64(2), report.Height)
+	require.NoError(t, report.Err)
+	require.Empty(t, report.Broken)
+
+	// the version is pruned once the audit completes
+	for height := int64(11); height <= 20; height++ {
+		commit()
+		if height%2 == 0 {
+			report := <-reports
+			require.Equal(t, height, report.Height)
+			require.Empty(t, report.Broken)
+		}
+	}
+	require.Eventually(t, func() bool {
+		_, err := suite.baseApp.CommitMultiStore().CacheMultiStoreWithVersion(2)
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+}
+
 func TestABCI_P2PQuery(t *testing.T) {
 	addrPeerFilterOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAddrPeerFilter(func(addrport string) *abci.QueryResponse {
@@ -685,6 +1013,219 @@ func TestABCI_FinalizeBlock_DeliverTx(t *testing.T) {
 	}
 }
 
+func TestABCI_FinalizeBlock_Tracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prevProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prevProvider) })
+
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{
+		Height: 1,
+		Txs:    [][]byte{txBytes},
+	})
+	require.NoError(t, err)
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+
+	msgName := sdk.MsgTypeURL(&baseapptestutil.MsgCounter{})
+	for _, name := range []string{"FinalizeBlock", "PreBlock", "BeginBlock", "runTx", "AnteHandler", msgName, "EndBlock"} {
+		require.Contains(t, spans, name)
+	}
+
+	block := spans["FinalizeBlock"].SpanContext()
+	for _, name := range []string{"PreBlock", "BeginBlock", "runTx", "EndBlock"} {
+		require.Equal(t, block.SpanID(), spans[name].Parent().SpanID(), name)
+	}
+
+	runTx := spans["runTx"].SpanContext()
+	require.Equal(t, runTx.SpanID(), spans["AnteHandler"].Parent().SpanID())
+	require.Equal(t, runTx.SpanID(), spans[msgName].Parent().SpanID())
+	require.Equal(t, block.TraceID(), spans[msgName].SpanContext().TraceID())
+}
+
+// sumCounterServerImpl adds the counter of messages to the value stored at
+// its key, so that transactions conflict with each other.
+type sumCounterServerImpl struct {
+	key []byte
+}
+
+func (m sumCounterServerImpl) IncrementCounter(ctx context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	if msg.FailOnHandler {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+	}
+
+	store := sdk.UnwrapSDKContext(ctx).KVStore(capKey1)
+	sum, _ := binary.Varint(store.Get(m.key))
+	setIntOnStore(store, m.key, sum+msg.Counter)
+
+	return &baseapptestutil.MsgCreateCounterResponse{}, nil
+}
+
+func TestABCI_FinalizeBlock_ParallelTxExecution(t *testing.T) {
+	sumKey := []byte("sum-key")
+	newSuite := func(opts ...func(*baseapp.BaseApp)) *BaseAppSuite {
+		suite := NewBaseAppSuite(t, opts...)
+		baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), sumCounterServerImpl{sumKey})
+		baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+
+		_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+			ConsensusParams: &cmtproto.ConsensusParams{},
+		})
+		require.NoError(t, err)
+
+		return suite
+	}
+
+	serial := newSuite()
+	parallel := newSuite(baseapp.SetParallelTxExecution(4))
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	nBlocks := 3
+	txPerHeight := 20
+
+	for blockN := 0; blockN < nBlocks; blockN++ {
+		txs := [][]byte{}
+		for i := 0; i < txPerHeight; i++ {
+			var tx signing.Tx
+			switch i % 3 {
+			case 0:
+				// conflicting txs
+				tx = newTxCounter(t, serial.txConfig, int64(i), int64(i))
+			case 1:
+				// independent txs
+				builder := serial.txConfig.NewTxBuilder()
+				require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{
+					Key:    []byte(fmt.Sprintf("key-%d-%d", blockN, i)),
+					Value:  []byte(fmt.Sprintf("value-%d", i)),
+					Signer: addr.String(),
+				}))
+				setTxSignature(t, builder, 0)
+				tx = builder.GetTx()
+			default:
+				// failing txs
+				tx = setFailOnHandler(t, serial.txConfig, newTxCounter(t, serial.txConfig, int64(i), int64(i)), true)
+			}
+
+			txBytes, err := serial.txConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+			txs = append(txs, txBytes)
+		}
+
+		req := &abci.FinalizeBlockRequest{
+			Height: int64(blockN) + 1,
+			Txs:    txs,
+		}
+		serialRes, err := serial.baseApp.FinalizeBlock(req)
+		require.NoError(t, err)
+		parallelRes, err := parallel.baseApp.FinalizeBlock(req)
+		require.NoError(t, err)
+
+		require.Len(t, parallelRes.TxResults, txPerHeight)
+		for i, txRes := range parallelRes.TxResults {
+			require.Equal(t, i%3 != 2, txRes.IsOK(), txRes.Log)
+		}
+		require.Equal(t, serialRes.TxResults, parallelRes.TxResults)
+		require.Equal(t, serialRes.AppHash, parallelRes.AppHash)
+
+		_, err = serial.baseApp.Commit()
+		require.NoError(t, err)
+		_, err = parallel.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	store := parallel.baseApp.NewContext(true).KVStore(capKey1)
+	sum, _ := binary.Varint(store.Get(sumKey))
+	require.Equal(t, int64(nBlocks*(0+3+6+9+12+15+18)), sum)
+}
+
+func TestABCI_FinalizeBlock_ParallelTxExecution_TxEffects(t *testing.T) {
+	sumKey := []byte("sum-key")
+	newSuite := func(opts ...func(*baseapp.BaseApp)) *BaseAppSuite {
+		// the ante handler rejects the duplicated txs with an in memory set of
+		// the memos of the txs, like the unordered txs
+		var mtx sync.Mutex
+		seen := make(map[string]bool)
+		isDuplicate := func(memo string) bool {
+			mtx.Lock()
+			defer mtx.Unlock()
+			return seen[memo]
+		}
+		anteOpt := func(bapp *baseapp.BaseApp) {
+			bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+				memo := tx.(sdk.TxWithMemo).GetMemo()
+				if isDuplicate(memo) {
+					return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "duplicated tx")
+				}
+				if ctx.ExecMode() != sdk.ExecModeFinalize {
+					return ctx, nil
+				}
+				return ctx, ctx.ApplyTxEffect(func() error {
+					if isDuplicate(memo) {
+						return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "duplicated tx")
+					}
+					mtx.Lock()
+					defer mtx.Unlock()
+					seen[memo] = true
+					return nil
+				})
+			})
+		}
+
+		suite := NewBaseAppSuite(t, append(opts, anteOpt)...)
+		baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), sumCounterServerImpl{sumKey})
+
+		_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+			ConsensusParams: &cmtproto.ConsensusParams{},
+		})
+		require.NoError(t, err)
+
+		return suite
+	}
+
+	serial := newSuite()
+	parallel := newSuite(baseapp.SetParallelTxExecution(4))
+
+	txs := [][]byte{}
+	for _, counter := range []int64{1, 2, 2, 3} {
+		txBytes, err := serial.txConfig.TxEncoder()(newTxCounter(t, serial.txConfig, counter, counter))
+		require.NoError(t, err)
+		txs = append(txs, txBytes)
+	}
+
+	// all the txs conflict with the previous ones, so that they are executed
+	// again after their optimistic execution
+	req := &abci.FinalizeBlockRequest{Height: 1, Txs: txs}
+	serialRes, err := serial.baseApp.FinalizeBlock(req)
+	require.NoError(t, err)
+	parallelRes, err := parallel.baseApp.FinalizeBlock(req)
+	require.NoError(t, err)
+
+	require.Len(t, parallelRes.TxResults, len(txs))
+	for i, txRes := range parallelRes.TxResults {
+		require.Equal(t, i != 2, txRes.IsOK(), txRes.Log)
+	}
+	require.Equal(t, serialRes.TxResults, parallelRes.TxResults)
+	require.Equal(t, serialRes.AppHash, parallelRes.AppHash)
+}
+
 func TestABCI_FinalizeBlock_MultiMsg(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
@@ -1058,6 +1599,75 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 	}
 }
 
+func TestABCI_Query_SimulateGasTrace(t *testing.T) {
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			ctx.GasMeter().ConsumeGas(3, "ante")
+			return ctx, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{7})
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	counterMsg := &baseapptestutil.MsgCounter{Counter: 1, Signer: addr.String()}
+	kvMsg := &baseapptestutil.MsgKeyValue{Key: []byte("key"), Value: []byte("value"), Signer: addr.String()}
+	builder := suite.txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(counterMsg, kvMsg))
+	setTxSignature(t, builder, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	gInfo, result, trace, err := suite.baseApp.SimulateWithGasTrace(txBytes)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	// the trace accounts for all the gas consumed
+	require.Equal(t, gInfo.GasUsed, trace.GasUsed)
+	require.Equal(t, uint64(3), trace.AnteGas)
+	require.Equal(t, gInfo.GasUsed-3, trace.MsgsGas)
+
+	var total uint64
+	gasByPhase := make(map[string]uint64)
+	var phases []string
+	for _, entry := range trace.Entries {
+		total += entry.Gas
+		if _, ok := gasByPhase[entry.Phase]; !ok {
+			phases = append(phases, entry.Phase)
+		}
+		gasByPhase[entry.Phase] += entry.Gas
+	}
+	require.Equal(t, gInfo.GasUsed, total)
+	require.Equal(t, []string{sdk.GasTracePhaseAnte, sdk.MsgTypeURL(counterMsg), sdk.MsgTypeURL(kvMsg)}, phases)
+	require.Equal(t, uint64(3), gasByPhase[sdk.GasTracePhaseAnte])
+	require.Equal(t, uint64(7), gasByPhase[sdk.MsgTypeURL(counterMsg)])
+	require.Contains(t, trace.Entries, sdk.GasTraceEntry{
+		Phase:      sdk.MsgTypeURL(kvMsg),
+		Descriptor: storetypes.GasWriteCostFlatDesc,
+		Count:      1,
+		Gas:        storetypes.KVGasConfig().WriteCostFlat,
+	})
+
+	// the trace is the same when querying it
+	queryResult, err := suite.baseApp.Query(context.TODO(), &abci.QueryRequest{
+		Path: "/app/simulate_gas_trace",
+		Data: txBytes,
+	})
+	require.NoError(t, err)
+	require.True(t, queryResult.IsOK(), queryResult.Log)
+
+	var queriedTrace sdk.GasTrace
+	require.NoError(t, json.Unmarshal(queryResult.Value, &queriedTrace))
+	require.Equal(t, *trace, queriedTrace)
+}
+
 func TestABCI_InvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -1483,6 +2093,55 @@ func TestABCI_Query(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+func TestABCI_Query_StateStats(t *testing.T) {
+	key, value := []byte("hello"), []byte("goodbye")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			store := ctx.KVStore(capKey1)
+			store.Set(key, value)
+			return
+		})
+	}
+
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetStateStats(true))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{})
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	bz, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{
+		Height: 1,
+		Txs:    [][]byte{bz},
+	})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	res, err := suite.baseApp.Query(context.TODO(), &abci.QueryRequest{Path: "/app/state_stats"})
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), res.Log)
+
+	var stats map[string]statskv.Stats
+	require.NoError(t, json.Unmarshal(res.Value, &stats))
+	require.Equal(t, statskv.Stats{
+		Initialized:  true,
+		Keys:         1,
+		Bytes:        int64(len(key) + len(value)),
+		Writes:       1,
+		BytesWritten: uint64(len(key) + len(value)),
+	}, stats[capKey1.Name()])
+
+	// the query fails if the state stats are not enabled
+	suite = NewBaseAppSuite(t)
+	res, err = suite.baseApp.Query(context.TODO(), &abci.QueryRequest{Path: "/app/state_stats"})
+	require.NoError(t, err)
+	require.False(t, res.IsOK())
+}
+
 func TestABCI_GetBlockRetentionHeight(t *testing.T) {
 	logger := log.NewTestLogger(t)
 	db := dbm.NewMemDB()
@@ -1965,6 +2624,70 @@ func TestABCI_PrepareProposal_MaxGas(t *testing.T) {
 	require.Len(t, res.Txs, 10, "invalid number of transactions returned")
 }
 
+func TestABCI_PrepareProposal_Lanes(t *testing.T) {
+	// the counter lane has 30% of the block space reserved, while the default
+	// lane takes the remaining space.
+	pool, err := mempool.NewLaneMempool(
+		mempool.Lane{
+			Name:       "counter",
+			Match:      mempool.MatchMsgTypes(sdk.MsgTypeURL(&baseapptestutil.MsgCounter{})),
+			BlockSpace: math.LegacyNewDecWithPrec(3, 1),
+			Mempool:    mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(5000)),
+		},
+		mempool.Lane{
+			Name:    "default",
+			Mempool: mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(5000)),
+		},
+	)
+	require.NoError(t, err)
+
+	suite := NewBaseAppSuite(t, baseapp.SetMempool(pool))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+
+	// set max block gas limit to 100, this will allow 10 txs of 10 gas each.
+	_, err = suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxGas: 100},
+		},
+	})
+	require.NoError(t, err)
+
+	// insert 20 txs in each lane, each with a gas limit of 10
+	_, _, addr := testdata.KeyTestPubAddr()
+	for i := int64(0); i < 20; i++ {
+		for _, msg := range []sdk.Msg{
+			&baseapptestutil.MsgCounter{Counter: i, FailOnHandler: false, Signer: addr.String()},
+			&baseapptestutil.MsgKeyValue{Key: []byte{byte(i)}, Value: []byte{byte(i)}, Signer: addr.String()},
+		} {
+			builder := suite.txConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(msg))
+			builder.SetMemo(counterStr + strconv.FormatInt(i, 10) + failStr)
+			builder.SetGasLimit(10)
+			setTxSignature(t, builder, uint64(i))
+
+			require.NoError(t, pool.Insert(sdk.Context{}, builder.GetTx()))
+		}
+	}
+	require.Equal(t, 20, pool.Lanes()[0].Mempool.CountTx())
+	require.Equal(t, 20, pool.Lanes()[1].Mempool.CountTx())
+
+	res, err := suite.baseApp.PrepareProposal(&abci.PrepareProposalRequest{
+		MaxTxBytes: 1_000_000, // large enough to ignore restriction
+		Height:     1,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 10, "invalid number of transactions returned")
+
+	// 3 txs of the counter lane are selected first, then 7 of the default lane
+	for i, txBz := range res.Txs {
+		tx, err := suite.txConfig.TxDecoder()(txBz)
+		require.NoError(t, err)
+		_, isCounter := tx.GetMsgs()[0].(*baseapptestutil.MsgCounter)
+		require.Equal(t, i < 3, isCounter, "tx %d", i)
+	}
+}
+
 func TestABCI_PrepareProposal_Failures(t *testing.T) {
 	anteKey := []byte("ante-key")
 	pool := mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(5000))
@@ -2286,6 +3009,132 @@ func TestABCI_HaltChain(t *testing.T) {
 	}
 }
 
+// haltStore is a BaseApp HaltStore returning fixed halt values.
+type haltStore struct {
+	height, time uint64
+}
+
+func (s haltStore) GetHalt(context.Context) (uint64, uint64, error) {
+	return s.height, s.time, nil
+}
+
+func TestABCI_OnChainHaltChain(t *testing.T) {
+	testCases := []struct {
+		name        string
+		haltHeight  uint64
+		haltTime    uint64
+		blockHeight int64
+		blockTime   int64
+		expHalt     bool
+	}{
+		{"default", 0, 0, 10, 0, false},
+		{"halt-height-edge", 10, 0, 10, 0, false},
+		{"halt-height", 10, 0, 11, 0, true},
+		{"past-halt-height", 10, 0, 12, 0, false},
+		{"halt-time-edge", 0, 10, 1, 10, false},
+		{"halt-time", 0, 10, 1, 11, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := NewBaseAppSuite(t, func(app *baseapp.BaseApp) {
+				app.SetHaltStore(haltStore{height: tc.haltHeight, time: tc.haltTime})
+			})
+			_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+				ConsensusParams: &cmtproto.ConsensusParams{},
+				InitialHeight:   tc.blockHeight,
+			})
+			require.NoError(t, err)
+			_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{
+				Height: tc.blockHeight,
+				Time:   time.Unix(tc.blockTime, 0),
+			})
+			if !tc.expHalt {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.True(t, strings.HasPrefix(err.Error(), "halt per on-chain"))
+			}
+		})
+	}
+}
+
+// kvHaltStore is a BaseApp HaltStore reading the halt height from the state.
+type kvHaltStore struct{}
+
+var haltKey = []byte("halt")
+
+func (kvHaltStore) GetHalt(ctx context.Context) (uint64, uint64, error) {
+	bz := sdk.UnwrapSDKContext(ctx).KVStore(capKey1).Get(haltKey)
+	if bz == nil {
+		return 0, 0, nil
+	}
+
+	return binary.BigEndian.Uint64(bz), 0, nil
+}
+
+func TestABCI_OnChainHaltRestart(t *testing.T) {
+	db := dbm.NewMemDB()
+	newApp := func(opts ...func(*baseapp.BaseApp)) *baseapp.BaseApp {
+		app := baseapp.NewBaseApp(t.Name(), log.NewTestLogger(t), db, nil, opts...)
+		app.MountStores(capKey1)
+		app.SetParamStore(paramStore{db: dbm.NewMemDB()})
+		app.SetHaltStore(kvHaltStore{})
+		require.NoError(t, app.LoadLatestVersion())
+		return app
+	}
+	finalizeBlock := func(app *baseapp.BaseApp, height int64) error {
+		_, err := app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height})
+		if err != nil {
+			return err
+		}
+
+		_, err = app.Commit()
+		return err
+	}
+
+	app := newApp(baseapp.SetChainID(t.Name()), func(app *baseapp.BaseApp) {
+		app.SetInitChainer(func(ctx sdk.Context, _ *abci.InitChainRequest) (*abci.InitChainResponse, error) {
+			ctx.KVStore(capKey1).Set(haltKey, binary.BigEndian.AppendUint64(nil, 2))
+			return &abci.InitChainResponse{}, nil
+		})
+	})
+	_, err := app.InitChain(&abci.InitChainRequest{ChainId: t.Name(), ConsensusParams: &cmtproto.ConsensusParams{}, InitialHeight: 1})
+	require.NoError(t, err)
+	require.NoError(t, finalizeBlock(app, 1))
+	require.NoError(t, finalizeBlock(app, 2))
+	require.ErrorContains(t, finalizeBlock(app, 3), "halt per on-chain height 2")
+
+	// restarting the same binary doesn't lift the halt
+	app = newApp(baseapp.SetChainID(t.Name()))
+	require.ErrorContains(t, finalizeBlock(app, 3), "halt per on-chain height 2")
+
+	// an upgraded binary lifts the halt in its PreBlocker, and the chain resumes
+	app = newApp(baseapp.SetChainID(t.Name()), func(app *baseapp.BaseApp) {
+		app.SetPreBlocker(func(ctx sdk.Context, req *abci.FinalizeBlockRequest) error {
+			if req.Height == 3 {
+				ctx.KVStore(capKey1).Delete(haltKey)
+			}
+			return nil
+		})
+	})
+	require.NoError(t, finalizeBlock(app, 3))
+	require.NoError(t, finalizeBlock(app, 4))
+
+	// the halt height only halts the block following it, the chain isn't halted
+	// once it went past it even if it is not cleared
+	app = newApp(baseapp.SetChainID(t.Name()), func(app *baseapp.BaseApp) {
+		app.SetPreBlocker(func(ctx sdk.Context, req *abci.FinalizeBlockRequest) error {
+			if req.Height == 5 {
+				ctx.KVStore(capKey1).Set(haltKey, binary.BigEndian.AppendUint64(nil, 2))
+			}
+			return nil
+		})
+	})
+	require.NoError(t, finalizeBlock(app, 5))
+	require.NoError(t, finalizeBlock(app, 6))
+}
+
 func TestBaseApp_PreBlocker(t *testing.T) {
 	db := dbm.NewMemDB()
 	name := t.Name()
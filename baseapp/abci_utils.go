@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 
 	"cosmossdk.io/core/comet"
+	"cosmossdk.io/math"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -285,80 +286,170 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 			return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
 		}
 
-		iterator := h.mempool.Select(ctx, req.Txs)
 		selectedTxsSignersSeqs := make(map[string]uint64)
-		var selectedTxsNums int
-		for iterator != nil {
-			memTx := iterator.Tx()
-			signerData, err := h.signerExtAdapter.GetSigners(memTx)
-			if err != nil {
+		if laneMempool, ok := h.mempool.(*mempool.LaneMempool); ok {
+			if err := h.selectLaneTxs(ctx, laneMempool, req.Txs, uint64(req.MaxTxBytes), maxBlockGas, selectedTxsSignersSeqs); err != nil {
 				return nil, err
 			}
 
-			// If the signers aren't in selectedTxsSignersSeqs then we haven't seen them before
-			// so we add them and continue given that we don't need to check the sequence.
-			shouldAdd := true
-			txSignersSeqs := make(map[string]uint64)
-			for _, signer := range signerData {
-				seq, ok := selectedTxsSignersSeqs[signer.Signer.String()]
-				if !ok {
-					txSignersSeqs[signer.Signer.String()] = signer.Sequence
-					continue
-				}
+			return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
+		}
 
-				// If we have seen this signer before in this block, we must make
-				// sure that the current sequence is seq+1; otherwise is invalid
-				// and we skip it.
-				if seq+1 != signer.Sequence {
-					shouldAdd = false
-					break
-				}
+		iterator := h.mempool.Select(ctx, req.Txs)
+		if _, _, err := h.selectTxs(ctx, iterator, uint64(req.MaxTxBytes), maxBlockGas, selectedTxsSignersSeqs); err != nil {
+			return nil, err
+		}
+
+		return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
+	}
+}
+
+// selectLaneTxs selects the transactions of a lane mempool for the proposal,
+// lane after lane. Each lane can use the block space reserved to it, in bytes
+// and gas, and lanes without reservation can use the space not reserved to
+// the following lanes.
+func (h *DefaultProposalHandler) selectLaneTxs(
+	ctx sdk.Context,
+	laneMempool *mempool.LaneMempool,
+	reqTxs [][]byte,
+	maxTxBytes, maxBlockGas uint64,
+	selectedTxsSignersSeqs map[string]uint64,
+) error {
+	lanes := laneMempool.Lanes()
+
+	// reserved[i] is the space reserved to the lanes after lane i.
+	reservedBytes := make([]uint64, len(lanes))
+	reservedGas := make([]uint64, len(lanes))
+	for i := len(lanes) - 2; i >= 0; i-- {
+		reservedBytes[i] = reservedBytes[i+1] + laneSpace(lanes[i+1].BlockSpace, maxTxBytes)
+		reservedGas[i] = reservedGas[i+1] + laneSpace(lanes[i+1].BlockSpace, maxBlockGas)
+	}
+
+	var totalTxBytes, totalTxGas uint64
+	for i, lane := range lanes {
+		laneMaxTxBytes := maxTxBytes - reservedBytes[i]
+		laneMaxBlockGas := maxBlockGas - reservedGas[i]
+		if lane.BlockSpace.IsPositive() {
+			laneMaxTxBytes = min(laneMaxTxBytes, totalTxBytes+laneSpace(lane.BlockSpace, maxTxBytes))
+			laneMaxBlockGas = min(laneMaxBlockGas, totalTxGas+laneSpace(lane.BlockSpace, maxBlockGas))
+		}
+		if maxBlockGas == 0 {
+			laneMaxBlockGas = 0
+		}
+
+		// the lane cannot select any transaction
+		if laneMaxTxBytes <= totalTxBytes || (maxBlockGas > 0 && laneMaxBlockGas <= totalTxGas) {
+			continue
+		}
+
+		txBytes, txGas, err := h.selectTxs(ctx, lane.Mempool.Select(ctx, reqTxs), laneMaxTxBytes, laneMaxBlockGas, selectedTxsSignersSeqs)
+		if err != nil {
+			return err
+		}
+		totalTxBytes += txBytes
+		totalTxGas += txGas
+	}
+
+	return nil
+}
+
+// laneSpace returns the share of the block space reserved to a lane.
+func laneSpace(blockSpace math.LegacyDec, maxSpace uint64) uint64 {
+	if !blockSpace.IsPositive() {
+		return 0
+	}
+
+	return blockSpace.MulInt(math.NewIntFromUint64(maxSpace)).TruncateInt().Uint64()
+}
+
+// selectTxs selects the valid transactions of the mempool iterator for the
+// proposal, until the TxSelector halts given the maximum bytes and gas. It
+// returns the bytes and gas of the selected transactions.
+func (h *DefaultProposalHandler) selectTxs(
+	ctx sdk.Context,
+	iterator mempool.Iterator,
+	maxTxBytes, maxBlockGas uint64,
+	selectedTxsSignersSeqs map[string]uint64,
+) (totalTxBytes, totalTxGas uint64, err error) {
+	selectedTxsNums := len(h.txSelector.SelectedTxs(ctx))
+	for iterator != nil {
+		memTx := iterator.Tx()
+		signerData, err := h.signerExtAdapter.GetSigners(memTx)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		// If the signers aren't in selectedTxsSignersSeqs then we haven't seen them before
+		// so we add them and continue given that we don't need to check the sequence.
+		shouldAdd := true
+		txSignersSeqs := make(map[string]uint64)
+		for _, signer := range signerData {
+			seq, ok := selectedTxsSignersSeqs[signer.Signer.String()]
+			if !ok {
 				txSignersSeqs[signer.Signer.String()] = signer.Sequence
-			}
-			if !shouldAdd {
-				iterator = iterator.Next()
 				continue
 			}
 
-			// NOTE: Since transaction verification was already executed in CheckTx,
-			// which calls mempool.Insert, in theory everything in the pool should be
-			// valid. But some mempool implementations may insert invalid txs, so we
-			// check again.
-			txBz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
-			if err != nil {
-				err := h.mempool.Remove(memTx)
-				if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
-					return nil, err
-				}
-			} else {
-				stop := h.txSelector.SelectTxForProposal(ctx, uint64(req.MaxTxBytes), maxBlockGas, memTx, txBz)
-				if stop {
-					break
-				}
+			// If we have seen this signer before in this block, we must make
+			// sure that the current sequence is seq+1; otherwise is invalid
+			// and we skip it.
+			if seq+1 != signer.Sequence {
+				shouldAdd = false
+				break
+			}
+			txSignersSeqs[signer.Signer.String()] = signer.Sequence
+		}
+		if !shouldAdd {
+			iterator = iterator.Next()
+			continue
+		}
+
+		// NOTE: Since transaction verification was already executed in CheckTx,
+		// which calls mempool.Insert, in theory everything in the pool should be
+		// valid. But some mempool implementations may insert invalid txs, so we
+		// check again.
+		txBz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
+		if err != nil {
+			err := h.mempool.Remove(memTx)
+			if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+				return 0, 0, err
+			}
+		} else {
+			stop := h.txSelector.SelectTxForProposal(ctx, maxTxBytes, maxBlockGas, memTx, txBz)
 
-				txsLen := len(h.txSelector.SelectedTxs(ctx))
-				for sender, seq := range txSignersSeqs {
-					// If txsLen != selectedTxsNums is true, it means that we've
-					// added a new tx to the selected txs, so we need to update
-					// the sequence of the sender.
-					if txsLen != selectedTxsNums {
-						selectedTxsSignersSeqs[sender] = seq
-					} else if _, ok := selectedTxsSignersSeqs[sender]; !ok {
-						// The transaction hasn't been added but it passed the
-						// verification, so we know that the sequence is correct.
-						// So we set this sender's sequence to seq-1, in order
-						// to avoid unnecessary calls to PrepareProposalVerifyTx.
-						selectedTxsSignersSeqs[sender] = seq - 1
-					}
+			txsLen := len(h.txSelector.SelectedTxs(ctx))
+			if txsLen != selectedTxsNums {
+				totalTxBytes += uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz}))
+				if gasTx, ok := memTx.(GasTx); ok {
+					totalTxGas += gasTx.GetGas()
 				}
-				selectedTxsNums = txsLen
 			}
 
-			iterator = iterator.Next()
+			if stop {
+				break
+			}
+
+			for sender, seq := range txSignersSeqs {
+				// If txsLen != selectedTxsNums is true, it means that we've
+				// added a new tx to the selected txs, so we need to update
+				// the sequence of the sender.
+				if txsLen != selectedTxsNums {
+					selectedTxsSignersSeqs[sender] = seq
+				} else if _, ok := selectedTxsSignersSeqs[sender]; !ok {
+					// The transaction hasn't been added but it passed the
+					// verification, so we know that the sequence is correct.
+					// So we set this sender's sequence to seq-1, in order
+					// to avoid unnecessary calls to PrepareProposalVerifyTx.
+					selectedTxsSignersSeqs[sender] = seq - 1
+				}
+			}
+			selectedTxsNums = txsLen
 		}
 
-		return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
+		iterator = iterator.Next()
 	}
+
+	return totalTxBytes, totalTxGas, nil
 }
 
 // ProcessProposalHandler returns the default implementation for processing an
@@ -14,14 +14,18 @@ import (
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/gogoproto/proto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/maps"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"cosmossdk.io/core/header"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/schema/decoding"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
 	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
 
@@ -98,6 +102,9 @@ type BaseApp struct {
 	// manages snapshots, i.e. dumps of app state at certain intervals
 	snapshotManager *snapshots.Manager
 
+	// asserts the registered invariants in the background at certain intervals
+	invariantAuditor *invariantAuditor
+
 	// volatile states:
 	//
 	// - checkState is set on InitChain and reset on Commit
@@ -149,6 +156,14 @@ type BaseApp struct {
 	// minimum block time (in Unix seconds) at which to halt the chain and gracefully shutdown
 	haltTime uint64
 
+	// number of workers executing FinalizeBlock transactions in parallel, the
+	// execution is serial if lower than 2
+	parallelTxWorkers int
+
+	// haltStore is used to query for the halt height and time coordinated
+	// on-chain, in addition to the ones configured on the node.
+	haltStore HaltStore
+
 	// minRetainBlocks defines the minimum block height offset from the current
 	// block being committed, such that all blocks past this offset are pruned
 	// from CometBFT. It is used as part of the process of determining the
@@ -177,6 +192,14 @@ type BaseApp struct {
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
+	// streamingPublishers are the publisher factories of the streaming sinks by
+	// plugin name
+	streamingPublishers map[string]StreamingPublisherFactory
+
+	// streamingDecoderResolver resolves the module decoders of the state changes
+	// published by the streaming sinks
+	streamingDecoderResolver decoding.DecoderResolver
+
 	chainID string
 
 	cdc codec.Codec
@@ -208,6 +231,7 @@ func NewBaseApp(
 		fauxMerkleMode:   false,
 		sigverifyTx:      true,
 		queryGasLimit:    math.MaxUint64,
+		invariantAuditor: newInvariantAuditor(logger),
 	}
 
 	for _, option := range options {
@@ -454,6 +478,10 @@ func (app *BaseApp) setHaltTime(haltTime uint64) {
 	app.haltTime = haltTime
 }
 
+func (app *BaseApp) setParallelTxWorkers(workers int) {
+	app.parallelTxWorkers = workers
+}
+
 func (app *BaseApp) setMinRetainBlocks(minRetainBlocks uint64) {
 	app.minRetainBlocks = minRetainBlocks
 }
@@ -755,7 +783,13 @@ func (app *BaseApp) beginBlock(_ *abci.FinalizeBlockRequest) (sdk.BeginBlock, er
 }
 
 func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
-	gInfo := sdk.GasInfo{}
+	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx)
+	return app.execTxResult(gInfo, result, anteEvents, err)
+}
+
+// execTxResult returns the ExecTxResult of a transaction executed in
+// FinalizeBlock and records its telemetry.
+func (app *BaseApp) execTxResult(gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) *abci.ExecTxResult {
 	resultStr := "successful"
 
 	var resp *abci.ExecTxResult
@@ -767,7 +801,6 @@ func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx)
 	if err != nil {
 		resultStr = "failed"
 		resp = responseExecTxResultWithEvents(
@@ -829,14 +862,29 @@ type HasNestedMsgs interface {
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes)
+}
+
+// runTxWithContext processes a transaction like runTx, within the provided
+// context instead of the one of the execution mode state.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
+	spanCtx, span := telemetry.StartSpan(ctx.Context(), "runTx", attribute.Int("exec_mode", int(mode)))
+	defer func() { telemetry.EndSpan(span, err) }()
+	ctx = ctx.WithContext(spanCtx)
+
 	ms := ctx.MultiStore()
 
+	// the gas consumptions are recorded when tracing the gas of the tx
+	tracer, _ := ctx.Value(gasTracerKey{}).(*gasTracer)
+	if tracer != nil {
+		ctx = ctx.WithGasMeter(tracer.wrap(ctx.GasMeter()))
+	}
+
 	// only run the tx if there is block gas remaining
 	if mode == execModeFinalize && ctx.BlockGasMeter().IsOutOfGas() {
 		return gInfo, nil, nil, errorsmod.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
@@ -908,7 +956,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		if mode == execModeSimulate {
 			anteCtx = anteCtx.WithExecMode(sdk.ExecMode(execModeSimulate))
 		}
-		newCtx, err := app.anteHandler(anteCtx, tx, mode == execModeSimulate)
+		anteSpanCtx, anteSpan := telemetry.StartSpan(anteCtx.Context(), "AnteHandler")
+		newCtx, err := app.anteHandler(anteCtx.WithContext(anteSpanCtx), tx, mode == execModeSimulate)
+		telemetry.EndSpan(anteSpan, err)
 
 		if !newCtx.IsZero() {
 			// At this point, newCtx.MultiStore() is a store branch, or something else
@@ -918,6 +968,7 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 			// the instantiated gas meter in the AnteHandler, so we update the context
 			// prior to returning.
 			ctx = newCtx.WithMultiStore(ms)
+			ctx = ctx.WithContext(trace.ContextWithSpan(ctx.Context(), span))
 		}
 
 		events := ctx.EventManager().Events()
@@ -939,16 +990,27 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		anteEvents = events.ToABCIEvents()
 	}
 
+	if tracer != nil {
+		// the AnteHandler may have replaced the gas meter
+		tracer.anteDone, tracer.anteGas = true, ctx.GasMeter().GasConsumed()
+		ctx = ctx.WithGasMeter(tracer.wrap(ctx.GasMeter()))
+	}
+
 	if mode == execModeCheck {
 		err = app.mempool.Insert(ctx, tx)
 		if err != nil {
 			return gInfo, nil, anteEvents, err
 		}
 	} else if mode == execModeFinalize {
-		err = app.mempool.Remove(tx)
-		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
-			return gInfo, nil, anteEvents,
-				fmt.Errorf("failed to remove tx from mempool: %w", err)
+		// the tx is removed from the mempool once its execution is committed
+		err = ctx.ApplyTxEffect(func() error {
+			if err := app.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+				return fmt.Errorf("failed to remove tx from mempool: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			return gInfo, nil, anteEvents, err
 		}
 	}
 
@@ -1032,8 +1094,14 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, reflectMsgs []proto
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "no message handler found for %T", msg)
 		}
 
+		if tracer, ok := ctx.Value(gasTracerKey{}).(*gasTracer); ok {
+			tracer.phase = sdk.MsgTypeURL(msg)
+		}
+
 		// ADR 031 request type routing
-		msgResult, err := handler(ctx, msg)
+		msgSpanCtx, msgSpan := telemetry.StartSpan(ctx.Context(), sdk.MsgTypeURL(msg), attribute.Int("msg_index", i))
+		msgResult, err := handler(ctx.WithContext(msgSpanCtx), msg)
+		telemetry.EndSpan(msgSpan, err)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
@@ -1205,6 +1273,14 @@ func (app *BaseApp) TxEncode(tx sdk.Tx) ([]byte, error) {
 func (app *BaseApp) Close() error {
 	var errs []error
 
+	// Stop the background pruning of the store before closing its db
+	if rms, ok := app.cms.(*rootmulti.Store); ok {
+		rms.StopBackgroundPruning()
+	}
+
+	// Wait for the running invariant audit, which reads the store
+	app.invariantAuditor.wait()
+
 	// Close app.db (opened by cosmos-sdk/server/start.go call to openDB)
 	if app.db != nil {
 		app.logger.Info("Closing application.db")
@@ -1228,6 +1304,18 @@ func (app *BaseApp) Close() error {
 	return errors.Join(errs...)
 }
 
+// InvariantRegistry returns the registry of the invariants asserted by the
+// invariant audit, on which the modules register their invariants.
+func (app *BaseApp) InvariantRegistry() sdk.InvariantRegistry {
+	return app.invariantAuditor
+}
+
+// InvariantStatuses returns the result of the last assertion of every
+// registered invariant by the invariant audit, in registration order.
+func (app *BaseApp) InvariantStatuses() []InvariantStatus {
+	return app.invariantAuditor.invariantStatuses()
+}
+
 // GetBaseApp returns the pointer to itself.
 func (app *BaseApp) GetBaseApp() *BaseApp {
 	return app
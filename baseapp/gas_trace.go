@@ -0,0 +1,94 @@
+package baseapp
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// gasTracerKey is the context key of the gasTracer of a transaction.
+type gasTracerKey struct{}
+
+// gasTracer records the gas consumptions of a transaction, aggregated by phase
+// and descriptor.
+type gasTracer struct {
+	phase   string
+	entries []sdk.GasTraceEntry
+	index   map[[2]string]int
+
+	anteDone bool
+	anteGas  uint64
+}
+
+func newGasTracer() *gasTracer {
+	return &gasTracer{
+		phase: sdk.GasTracePhaseAnte,
+		index: make(map[[2]string]int),
+	}
+}
+
+func (t *gasTracer) record(amount storetypes.Gas, descriptor string) {
+	key := [2]string{t.phase, descriptor}
+	i, ok := t.index[key]
+	if !ok {
+		i = len(t.entries)
+		t.index[key] = i
+		t.entries = append(t.entries, sdk.GasTraceEntry{Phase: t.phase, Descriptor: descriptor})
+	}
+
+	t.entries[i].Count++
+	t.entries[i].Gas += amount
+}
+
+// wrap returns a gas meter recording the gas consumptions of the meter.
+func (t *gasTracer) wrap(meter storetypes.GasMeter) storetypes.GasMeter {
+	if tracing, ok := meter.(*tracingGasMeter); ok {
+		meter = tracing.GasMeter
+	}
+
+	return &tracingGasMeter{GasMeter: meter, tracer: t}
+}
+
+// trace returns the gas trace of the transaction.
+func (t *gasTracer) trace(gInfo sdk.GasInfo) *sdk.GasTrace {
+	anteGas := gInfo.GasUsed
+	if t.anteDone {
+		anteGas = t.anteGas
+	}
+
+	return &sdk.GasTrace{
+		GasWanted: gInfo.GasWanted,
+		GasUsed:   gInfo.GasUsed,
+		AnteGas:   anteGas,
+		MsgsGas:   gInfo.GasUsed - anteGas,
+		Entries:   t.entries,
+	}
+}
+
+// tracingGasMeter is a GasMeter recording its gas consumptions in a gasTracer.
+type tracingGasMeter struct {
+	storetypes.GasMeter
+
+	tracer *gasTracer
+}
+
+// ConsumeGas implements GasMeter.
+func (m *tracingGasMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	m.tracer.record(amount, descriptor)
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// SimulateWithGasTrace simulates the transaction like Simulate, and returns
+// the breakdown of the gas it consumed, in the AnteHandler and per message,
+// by descriptor (e.g. store reads and writes).
+//
+// NOTE: the gas consumed by the AnteHandler is only broken down up to the
+// AnteHandler replacing the gas meter of the transaction, e.g. in the
+// SetUpContextDecorator.
+func (app *BaseApp) SimulateWithGasTrace(txBytes []byte) (sdk.GasInfo, *sdk.Result, *sdk.GasTrace, error) {
+	tracer := newGasTracer()
+	ctx := app.getContextForTx(execModeSimulate, txBytes).WithValue(gasTracerKey{}, tracer)
+
+	gInfo, result, _, err := app.runTxWithContext(ctx, execModeSimulate, txBytes)
+	return gInfo, result, tracer.trace(gInfo), err
+}
@@ -3,9 +3,11 @@ package baseapp
 import (
 	"context"
 	"fmt"
+	"reflect"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	gogoproto "github.com/cosmos/gogoproto/proto"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/encoding"
 	"google.golang.org/protobuf/runtime/protoiface"
@@ -40,6 +42,12 @@ type GRPCQueryRouter struct {
 	cdc encoding.Codec
 	// serviceData contains the gRPC services and their handlers.
 	serviceData []serviceData
+	// cacheableRoutes contains the deterministic query routes, whose responses
+	// are cached when the query cache is enabled.
+	cacheableRoutes map[string]bool
+	// queryCache caches the responses of the deterministic queries, nil if
+	// disabled.
+	queryCache *queryCache
 }
 
 // serviceData represents a gRPC service, along with its handler.
@@ -59,6 +67,7 @@ func NewGRPCQueryRouter() *GRPCQueryRouter {
 		routes:                map[string]GRPCQueryHandler{},
 		hybridHandlers:        map[string][]func(ctx context.Context, req, resp protoiface.MessageV1) error{},
 		responseByRequestName: map[string]string{},
+		cacheableRoutes:       map[string]bool{},
 	}
 }
 
@@ -117,7 +126,15 @@ func (qrt *GRPCQueryRouter) registerABCIQueryHandler(sd *grpc.ServiceDesc, metho
 		)
 	}
 
+	qrt.cacheableRoutes[fqName] = isModuleQuerySafe(sd.ServiceName, method.MethodName)
 	qrt.routes[fqName] = func(ctx sdk.Context, req *abci.QueryRequest) (*abci.QueryResponse, error) {
+		if resBytes, ok := qrt.cachedResponse(fqName, req.Data, ctx.BlockHeight()); ok {
+			return &abci.QueryResponse{
+				Height: req.Height,
+				Value:  resBytes,
+			}, nil
+		}
+
 		// call the method handler from the service description with the handler object,
 		// a wrapped sdk.Context with proto-unmarshaled data from the ABCI request data
 		res, err := methodHandler(handler, ctx, func(i interface{}) error {
@@ -133,6 +150,7 @@ func (qrt *GRPCQueryRouter) registerABCIQueryHandler(sd *grpc.ServiceDesc, metho
 		if err != nil {
 			return nil, err
 		}
+		qrt.cacheResponse(fqName, req.Data, ctx.BlockHeight(), resBytes)
 
 		// return the result bytes as the response value
 		return &abci.QueryResponse{
@@ -181,3 +199,63 @@ func (qrt *GRPCQueryRouter) SetInterfaceRegistry(interfaceRegistry codectypes.In
 	// registry reflection gRPC service.
 	reflection.RegisterReflectionServiceServer(qrt, reflection.NewReflectionServiceServer(interfaceRegistry))
 }
+
+// SetQueryCacheSize enables the caching of the responses of deterministic
+// queries, i.e. annotated with cosmos.query.v1.module_query_safe, in a LRU
+// cache of the given size. The cache is disabled if size is 0.
+func (qrt *GRPCQueryRouter) SetQueryCacheSize(size int) error {
+	if size <= 0 {
+		qrt.queryCache = nil
+		return nil
+	}
+
+	cache, err := newQueryCache(size)
+	if err != nil {
+		return err
+	}
+
+	qrt.queryCache = cache
+	return nil
+}
+
+// PurgeQueryCache removes all the cached query responses.
+func (qrt *GRPCQueryRouter) PurgeQueryCache() {
+	if qrt.queryCache != nil {
+		qrt.queryCache.purge()
+	}
+}
+
+// cachedResponse returns the cached response of a query at a given height.
+func (qrt *GRPCQueryRouter) cachedResponse(path string, reqBytes []byte, height int64) ([]byte, bool) {
+	if qrt.queryCache == nil || !qrt.cacheableRoutes[path] {
+		return nil, false
+	}
+
+	return qrt.queryCache.get(path, reqBytes, height)
+}
+
+// cacheResponse caches the response of a deterministic query at a given
+// height.
+func (qrt *GRPCQueryRouter) cacheResponse(path string, reqBytes []byte, height int64, resBytes []byte) {
+	if qrt.queryCache == nil || !qrt.cacheableRoutes[path] {
+		return
+	}
+
+	qrt.queryCache.add(path, reqBytes, height, resBytes)
+}
+
+// newResponse returns a new response message for the request, if its type is
+// known.
+func (qrt *GRPCQueryRouter) newResponse(req interface{}) (interface{}, bool) {
+	msg, ok := req.(gogoproto.Message)
+	if !ok {
+		return nil, false
+	}
+
+	typ := gogoproto.MessageType(qrt.responseByRequestName[gogoproto.MessageName(msg)])
+	if typ == nil {
+		return nil, false
+	}
+
+	return reflect.New(typ.Elem()).Interface(), true
+}
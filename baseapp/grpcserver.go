@@ -8,6 +8,7 @@ import (
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpcrecovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -26,47 +27,69 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 	// Define an interceptor for all gRPC queries: this interceptor will create
 	// a new sdk.Context, and pass it into the query handler.
 	interceptor := func(grpcCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		// If there's some metadata in the context, retrieve it.
-		md, ok := metadata.FromIncomingContext(grpcCtx)
-		if !ok {
-			return nil, status.Error(codes.Internal, "unable to retrieve metadata")
+		grpcCtx, sdkCtx, err := app.grpcQueryContext(grpcCtx)
+		if err != nil {
+			return nil, err
 		}
 
-		// Get height header from the request context, if present.
-		var height int64
-		if heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader); len(heightHeaders) == 1 {
-			height, err = strconv.ParseInt(heightHeaders[0], 10, 64)
-			if err != nil {
-				return nil, errorsmod.Wrapf(
-					sdkerrors.ErrInvalidRequest,
-					"Baseapp.RegisterGRPCServer: invalid height header %q: %v", grpctypes.GRPCBlockHeightHeader, err)
+		app.logger.Debug("gRPC query received of type: " + fmt.Sprintf("%#v", req))
+
+		// Catch an OutOfGasPanic caused in the query handlers
+		defer func() {
+			if r := recover(); r != nil {
+				switch rType := r.(type) {
+				case storetypes.ErrorOutOfGas:
+					err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "Query gas limit exceeded: %v, out of gas in location: %v", sdkCtx.GasMeter().Limit(), rType.Descriptor)
+				default:
+					panic(r)
+				}
 			}
-			if err := checkNegativeHeight(height); err != nil {
-				return nil, err
+		}()
+
+		return handler(grpcCtx, req)
+	}
+
+	// Define an interceptor serving the responses of deterministic queries from
+	// the query cache, if enabled.
+	cacheInterceptor := func(grpcCtx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		qrt := app.GRPCQueryRouter()
+		if qrt.queryCache == nil || !qrt.cacheableRoutes[info.FullMethod] {
+			return handler(grpcCtx, req)
+		}
+
+		height := sdk.UnwrapSDKContext(grpcCtx).BlockHeight()
+		reqBz, err := qrt.cdc.Marshal(req)
+		if err != nil {
+			return handler(grpcCtx, req)
+		}
+
+		if resBz, ok := qrt.cachedResponse(info.FullMethod, reqBz, height); ok {
+			if res, ok := qrt.newResponse(req); ok && qrt.cdc.Unmarshal(resBz, res) == nil {
+				return res, nil
 			}
 		}
 
-		// Create the sdk.Context. Passing false as 2nd arg, as we can't
-		// actually support proofs with gRPC right now.
-		sdkCtx, err := app.CreateQueryContext(height, false)
+		res, err := handler(grpcCtx, req)
 		if err != nil {
 			return nil, err
 		}
 
-		// Add relevant gRPC headers
-		if height == 0 {
-			height = sdkCtx.BlockHeight() // If height was not set in the request, set it to the latest
+		if resBz, err := qrt.cdc.Marshal(res); err == nil {
+			qrt.cacheResponse(info.FullMethod, reqBz, height, resBz)
 		}
 
-		// Attach the sdk.Context into the gRPC's context.Context.
-		grpcCtx = context.WithValue(grpcCtx, sdk.SdkContextKey, sdkCtx)
+		return res, nil
+	}
 
-		md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
-		if err = grpc.SetHeader(grpcCtx, md); err != nil {
-			app.logger.Error("failed to set gRPC header", "err", err)
+	// Define an interceptor for all server streaming gRPC queries, which does the
+	// same as the unary interceptor for the context of the stream.
+	streamInterceptor := func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		grpcCtx, sdkCtx, err := app.grpcQueryContext(stream.Context())
+		if err != nil {
+			return err
 		}
 
-		app.logger.Debug("gRPC query received of type: " + fmt.Sprintf("%#v", req))
+		app.logger.Debug("gRPC stream query received: " + info.FullMethod)
 
 		// Catch an OutOfGasPanic caused in the query handlers
 		defer func() {
@@ -80,7 +103,7 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			}
 		}()
 
-		return handler(grpcCtx, req)
+		return handler(srv, &queryServerStream{ServerStream: stream, ctx: grpcCtx})
 	}
 
 	// Loop through all services and methods, add the interceptor, and register
@@ -97,19 +120,102 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 					return methodHandler(srv, ctx, dec, grpcmiddleware.ChainUnaryServer(
 						grpcrecovery.UnaryServerInterceptor(),
 						interceptor,
+						cacheInterceptor,
 					))
 				},
 			}
 		}
 
+		newStreams := make([]grpc.StreamDesc, len(desc.Streams))
+		for i, stream := range desc.Streams {
+			streamHandler := stream.Handler
+			newStreams[i] = stream
+			newStreams[i].Handler = func(srv interface{}, serverStream grpc.ServerStream) error {
+				return grpcmiddleware.ChainStreamServer(
+					grpcrecovery.StreamServerInterceptor(),
+					streamInterceptor,
+				)(srv, serverStream, &grpc.StreamServerInfo{
+					FullMethod:     fmt.Sprintf("/%s/%s", desc.ServiceName, stream.StreamName),
+					IsServerStream: stream.ServerStreams,
+					IsClientStream: stream.ClientStreams,
+				}, streamHandler)
+			}
+		}
+
 		newDesc := &grpc.ServiceDesc{
 			ServiceName: desc.ServiceName,
 			HandlerType: desc.HandlerType,
 			Methods:     newMethods,
-			Streams:     desc.Streams,
+			Streams:     newStreams,
 			Metadata:    desc.Metadata,
 		}
 
 		server.RegisterService(newDesc, data.handler)
 	}
 }
+
+// grpcQueryContext creates the sdk.Context of a gRPC query at the height
+// requested in the gRPC metadata, or the latest height, and attaches it to the
+// gRPC context.
+func (app *BaseApp) grpcQueryContext(grpcCtx context.Context) (context.Context, sdk.Context, error) {
+	// If there's some metadata in the context, retrieve it.
+	md, ok := metadata.FromIncomingContext(grpcCtx)
+	if !ok {
+		return nil, sdk.Context{}, status.Error(codes.Internal, "unable to retrieve metadata")
+	}
+
+	// Get height header from the request context, if present.
+	var (
+		height int64
+		err    error
+	)
+	if heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader); len(heightHeaders) == 1 {
+		height, err = strconv.ParseInt(heightHeaders[0], 10, 64)
+		if err != nil {
+			return nil, sdk.Context{}, errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"Baseapp.RegisterGRPCServer: invalid height header %q: %v", grpctypes.GRPCBlockHeightHeader, err)
+		}
+		if err := checkNegativeHeight(height); err != nil {
+			return nil, sdk.Context{}, err
+		}
+	}
+
+	// Create the sdk.Context. Passing false as 2nd arg, as we can't
+	// actually support proofs with gRPC right now.
+	sdkCtx, err := app.CreateQueryContext(height, false)
+	if err != nil {
+		return nil, sdk.Context{}, err
+	}
+
+	// Add relevant gRPC headers
+	if height == 0 {
+		height = sdkCtx.BlockHeight() // If height was not set in the request, set it to the latest
+	}
+
+	// The query handlers get the span of the gRPC call, if any, through the
+	// sdk.Context.
+	sdkCtx = sdkCtx.WithContext(trace.ContextWithSpan(sdkCtx.Context(), trace.SpanFromContext(grpcCtx)))
+
+	// Attach the sdk.Context into the gRPC's context.Context.
+	grpcCtx = context.WithValue(grpcCtx, sdk.SdkContextKey, sdkCtx)
+
+	md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+	if err = grpc.SetHeader(grpcCtx, md); err != nil {
+		app.logger.Error("failed to set gRPC header", "err", err)
+	}
+
+	return grpcCtx, sdkCtx, nil
+}
+
+// queryServerStream overrides the context of a grpc.ServerStream with the
+// context holding the query sdk.Context.
+type queryServerStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *queryServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,310 @@
+package baseapp
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/hashicorp/go-metrics"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.InvariantRegistry = (*invariantAuditor)(nil)
+
+// BrokenInvariant is an invariant found broken by an invariant audit.
+type BrokenInvariant struct {
+	// Route is the "module/route" route of the invariant.
+	Route string
+	// Msg is the message returned by the invariant.
+	Msg string
+}
+
+// InvariantStatus is the result of the last assertion of an invariant.
+type InvariantStatus struct {
+	// Route is the "module/route" route of the invariant.
+	Route string `json:"route"`
+	// Height is the height of the state the invariant was last asserted on, or
+	// 0 if it was not asserted yet.
+	Height int64 `json:"height"`
+	// Broken is true if the invariant was broken at Height.
+	Broken bool `json:"broken"`
+	// Msg is the message returned by the invariant.
+	Msg string `json:"msg,omitempty"`
+}
+
+// InvariantAuditReport is the result of an invariant audit.
+type InvariantAuditReport struct {
+	// Height is the height of the audited state.
+	Height int64
+	// Routes are the routes of the asserted invariants, in assertion order.
+	Routes []string
+	// Broken are the broken invariants, in assertion order.
+	Broken []BrokenInvariant
+	// Duration is the time the audit took.
+	Duration time.Duration
+	// Err is the error which prevented the audit, e.g. the audited version
+	// could not be loaded. No invariant is asserted then.
+	Err error
+}
+
+// InvariantPolicy is the action taken when an invariant is found broken by an
+// invariant audit.
+type InvariantPolicy int
+
+const (
+	// InvariantPolicyReport logs the broken invariant, records it in the
+	// telemetry and passes it to the reporter. It is the default policy.
+	InvariantPolicyReport InvariantPolicy = iota
+	// InvariantPolicyHalt reports the broken invariant and halts the node at
+	// the next block, like the halt-height.
+	InvariantPolicyHalt
+)
+
+// versionPinner is implemented by the multi stores which can protect a version
+// from pruning, e.g. rootmulti.Store.
+type versionPinner interface {
+	PinVersion(version int64) (unpin func())
+}
+
+// InvariantAuditReporter is called with the report of every invariant audit.
+// It is called from the goroutine of the audit.
+type InvariantAuditReporter func(InvariantAuditReport)
+
+// invariantAuditor asserts the registered invariants in the background, on the
+// state committed every interval blocks. Unlike the halting invariant checks of
+// the former x/crisis module, a broken invariant does not stop the chain: it is
+// logged, counted in the telemetry and passed to the reporter, and only halts
+// the node if the policy of the invariant says so.
+//
+// If a batch size is set, every audit only asserts the next batch of
+// invariants, so that expensive invariants are asserted incrementally across
+// audits. The result of the last assertion of every invariant is kept and can
+// be queried. The results are local to the node, they are not part of the
+// state as the audit runs outside of the block execution.
+type invariantAuditor struct {
+	logger    log.Logger
+	interval  uint64
+	batchSize int
+	reporter  InvariantAuditReporter
+
+	// policies are the policies by invariant route or by module name.
+	policies map[string]InvariantPolicy
+	// halted is the first broken invariant with the halt policy.
+	halted atomic.Pointer[BrokenInvariant]
+
+	routes     []string
+	invariants map[string]sdk.Invariant
+
+	// next is the index of the first invariant of the next batch, it is only
+	// accessed by the running audit.
+	next int
+
+	mtx      sync.RWMutex
+	statuses map[string]InvariantStatus
+
+	running atomic.Bool
+	wg      sync.WaitGroup
+}
+
+func newInvariantAuditor(logger log.Logger) *invariantAuditor {
+	return &invariantAuditor{
+		logger:     logger.With(log.ModuleKey, "invariant-audit"),
+		invariants: make(map[string]sdk.Invariant),
+		statuses:   make(map[string]InvariantStatus),
+		policies:   make(map[string]InvariantPolicy),
+	}
+}
+
+// RegisterRoute implements sdk.InvariantRegistry. Invariants are asserted in
+// registration order.
+func (ia *invariantAuditor) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	fullRoute := fmt.Sprintf("%s/%s", moduleName, route)
+	if _, ok := ia.invariants[fullRoute]; ok {
+		panic(fmt.Sprintf("invariant route %s already registered", fullRoute))
+	}
+
+	ia.routes = append(ia.routes, fullRoute)
+	ia.invariants[fullRoute] = invar
+}
+
+// auditIfApplicable starts an audit of the state committed at the given height
+// if the height is a multiple of the interval. The audit is skipped if the
+// previous one is still running, so that audits never pile up.
+func (ia *invariantAuditor) auditIfApplicable(cms storetypes.CommitMultiStore, h cmtproto.Header) {
+	if ia.interval == 0 || len(ia.routes) == 0 || h.Height <= 0 || uint64(h.Height)%ia.interval != 0 {
+		return
+	}
+
+	if !ia.running.CompareAndSwap(false, true) {
+		ia.logger.Info("invariant audit skipped, the previous audit is still running", "height", h.Height)
+		return
+	}
+
+	// the audited version is protected from the pruning until the audit
+	// completes, as it is read lazily.
+	unpin := func() {}
+	if pinner, ok := cms.(versionPinner); ok {
+		unpin = pinner.PinVersion(h.Height)
+	}
+
+	// the branch of the committed version must be taken before the next block
+	// is committed, it can then be read concurrently.
+	ms, err := cms.CacheMultiStoreWithVersion(h.Height)
+	if err != nil {
+		unpin()
+		ia.running.Store(false)
+		ia.report(InvariantAuditReport{Height: h.Height, Err: fmt.Errorf("could not load the version: %w", err)})
+		return
+	}
+
+	ia.wg.Add(1)
+	go func() {
+		defer ia.wg.Done()
+		defer ia.running.Store(false)
+		defer unpin()
+
+		ctx := sdk.NewContext(ms, true, ia.logger).
+			WithBlockHeader(h).
+			WithHeaderInfo(header.Info{Height: h.Height, Time: h.Time, ChainID: h.ChainID, AppHash: h.AppHash})
+		ia.report(ia.audit(ctx))
+	}()
+}
+
+// audit asserts the next batch of the registered invariants against the given
+// context, and records their statuses.
+func (ia *invariantAuditor) audit(ctx sdk.Context) InvariantAuditReport {
+	start := time.Now()
+	report := InvariantAuditReport{Height: ctx.BlockHeight(), Routes: ia.nextBatch()}
+
+	statuses := make([]InvariantStatus, 0, len(report.Routes))
+	for _, route := range report.Routes {
+		msg, broken := ia.assert(ctx, route)
+		if broken {
+			report.Broken = append(report.Broken, BrokenInvariant{Route: route, Msg: msg})
+		}
+
+		statuses = append(statuses, InvariantStatus{Route: route, Height: report.Height, Broken: broken, Msg: msg})
+	}
+
+	ia.mtx.Lock()
+	for _, status := range statuses {
+		ia.statuses[status.Route] = status
+	}
+	ia.mtx.Unlock()
+
+	report.Duration = time.Since(start)
+	return report
+}
+
+// nextBatch returns the routes of the invariants to assert in the next audit,
+// in registration order. All the invariants are asserted if no batch size is
+// set. The batches cycle through the invariants in registration order.
+func (ia *invariantAuditor) nextBatch() []string {
+	if ia.batchSize <= 0 || ia.batchSize >= len(ia.routes) {
+		return ia.routes
+	}
+
+	batch := make([]string, 0, ia.batchSize)
+	for i := 0; i < ia.batchSize; i++ {
+		batch = append(batch, ia.routes[(ia.next+i)%len(ia.routes)])
+	}
+	ia.next = (ia.next + ia.batchSize) % len(ia.routes)
+
+	return batch
+}
+
+// assert asserts a single invariant, an invariant which panics is broken.
+func (ia *invariantAuditor) assert(ctx sdk.Context, route string) (msg string, broken bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			msg, broken = fmt.Sprintf("invariant panicked: %v", r), true
+		}
+	}()
+
+	return ia.invariants[route](ctx)
+}
+
+// report logs the result of an audit, records it in the telemetry and passes it
+// to the reporter. An audit which failed to load the state never halts the node.
+func (ia *invariantAuditor) report(report InvariantAuditReport) {
+	if report.Err != nil {
+		telemetry.IncrCounter(1, "invariant_audit", "error")
+		ia.logger.Error("invariant audit failed", "height", report.Height, "err", report.Err)
+
+		if ia.reporter != nil {
+			ia.reporter(report)
+		}
+		return
+	}
+
+	telemetry.IncrCounter(1, "invariant_audit", "count")
+	telemetry.SetGauge(float32(len(report.Broken)), "invariant_audit", "broken")
+	telemetry.SetGauge(float32(report.Duration.Milliseconds()), "invariant_audit", "duration_ms")
+
+	for _, broken := range report.Broken {
+		telemetry.IncrCounterWithLabels([]string{"invariant_audit", "broken_invariant"}, 1, []metrics.Label{telemetry.NewLabel("route", broken.Route)})
+		ia.logger.Error("invariant broken", "height", report.Height, "route", broken.Route, "msg", broken.Msg)
+
+		if ia.policy(broken.Route) == InvariantPolicyHalt && ia.halted.CompareAndSwap(nil, &broken) {
+			ia.logger.Error("halting the node per the invariant policy", "route", broken.Route)
+		}
+	}
+	ia.logger.Info("invariant audit completed", "height", report.Height, "invariants", len(report.Routes), "broken", len(report.Broken), "duration", report.Duration)
+
+	if ia.reporter != nil {
+		ia.reporter(report)
+	}
+}
+
+// policy returns the policy of the invariant with the given route, the policy
+// of the route takes precedence over the policy of its module.
+func (ia *invariantAuditor) policy(route string) InvariantPolicy {
+	if policy, ok := ia.policies[route]; ok {
+		return policy
+	}
+
+	moduleName, _, _ := strings.Cut(route, "/")
+	return ia.policies[moduleName]
+}
+
+// checkHalt returns an error if an invariant with the halt policy was found
+// broken.
+func (ia *invariantAuditor) checkHalt() error {
+	if broken := ia.halted.Load(); broken != nil {
+		return fmt.Errorf("halt per invariant policy, invariant %s broken: %s", broken.Route, broken.Msg)
+	}
+
+	return nil
+}
+
+// invariantStatuses returns the statuses of all the registered invariants, in
+// registration order.
+func (ia *invariantAuditor) invariantStatuses() []InvariantStatus {
+	ia.mtx.RLock()
+	defer ia.mtx.RUnlock()
+
+	statuses := make([]InvariantStatus, len(ia.routes))
+	for i, route := range ia.routes {
+		status, ok := ia.statuses[route]
+		if !ok {
+			status = InvariantStatus{Route: route}
+		}
+		statuses[i] = status
+	}
+
+	return statuses
+}
+
+// wait waits for the running audit, if any, to complete.
+func (ia *invariantAuditor) wait() {
+	ia.wg.Wait()
+}
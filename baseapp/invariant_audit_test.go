@@ -0,0 +1,49 @@
+package baseapp
+
+import (
+	"errors"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// prunedMultiStore is a multi store whose versions are all pruned.
+type prunedMultiStore struct {
+	storetypes.CommitMultiStore
+}
+
+func (prunedMultiStore) CacheMultiStoreWithVersion(int64) (storetypes.CacheMultiStore, error) {
+	return nil, errors.New("version does not exist")
+}
+
+func TestInvariantAuditLoadError(t *testing.T) {
+	ia := newInvariantAuditor(log.NewNopLogger())
+	ia.interval = 1
+	ia.policies["test"] = InvariantPolicyHalt
+	ia.RegisterRoute("test", "broken", func(sdk.Context) (string, bool) {
+		return "broken", true
+	})
+
+	var reports []InvariantAuditReport
+	ia.reporter = func(report InvariantAuditReport) {
+		reports = append(reports, report)
+	}
+
+	// the audit is reported as failed, without any broken invariant
+	ia.auditIfApplicable(prunedMultiStore{}, cmtproto.Header{Height: 1})
+	ia.wait()
+	require.Len(t, reports, 1)
+	require.ErrorContains(t, reports[0].Err, "could not load the version: version does not exist")
+	require.Empty(t, reports[0].Broken)
+
+	// a load error never halts the node, and the next audit runs
+	require.NoError(t, ia.checkHalt())
+	require.False(t, ia.running.Load())
+	require.Equal(t, []InvariantStatus{{Route: "test/broken"}}, ia.invariantStatuses())
+}
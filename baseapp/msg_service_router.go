@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/hashicorp/go-metrics"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/runtime/protoiface"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp/internal/protocompat"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -191,7 +194,7 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 		)
 	}
 
-	msr.routes[requestTypeName] = func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	msr.routes[requestTypeName] = withMsgMetrics(requestTypeName, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 		interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 			goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
@@ -242,10 +245,41 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 			Events:       events,
 			MsgResponses: []*codectypes.Any{anyResp},
 		}, nil
-	}
+	})
 	return nil
 }
 
+// withMsgMetrics wraps the handler of the messages of the given type URL to
+// emit, when telemetry is enabled, their count, failures, gas used and
+// execution time, labeled by type URL.
+func withMsgMetrics(typeURL string, handler MsgServiceHandler) MsgServiceHandler {
+	labels := []metrics.Label{telemetry.NewLabel(telemetry.MetricLabelNameMsgTypeURL, typeURL)}
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if !telemetry.IsTelemetryEnabled() {
+			return handler(ctx, msg)
+		}
+
+		start, gasBefore := time.Now(), ctx.GasMeter().GasConsumed()
+		succeeded := false
+
+		// deferred so that handlers panicking, e.g. out of gas, are measured too
+		defer func() {
+			telemetry.IncrCounterWithLabels([]string{"msg", "count"}, 1, labels)
+			if !succeeded {
+				telemetry.IncrCounterWithLabels([]string{"msg", "failed"}, 1, labels)
+			}
+			telemetry.AddSampleWithLabels([]string{"msg", "gas_used"}, float32(ctx.GasMeter().GasConsumed()-gasBefore), labels)
+			telemetry.MeasureSinceWithLabels([]string{"msg", "execution_time"}, start, labels)
+		}()
+
+		res, err := handler(ctx, msg)
+		succeeded = err == nil
+
+		return res, err
+	}
+}
+
 // SetInterfaceRegistry sets the interface registry for the router.
 func (msr *MsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
 	msr.interfaceRegistry = interfaceRegistry
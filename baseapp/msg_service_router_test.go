@@ -2,6 +2,7 @@ package baseapp_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
@@ -10,6 +11,7 @@ import (
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 	authsigning "cosmossdk.io/x/auth/signing"
 	authtx "cosmossdk.io/x/auth/tx"
 
@@ -17,7 +19,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -201,3 +206,73 @@ func TestMsgService(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, abci.CodeTypeOK, res.TxResults[0].Code, "res=%+v", res)
 }
+
+func TestMsgServiceMetrics(t *testing.T) {
+	var (
+		appBuilder *runtime.AppBuilder
+		registry   codectypes.InterfaceRegistry
+	)
+	err := depinject.Inject(
+		depinject.Configs(
+			makeMinimalConfig(),
+			depinject.Supply(log.NewTestLogger(t)),
+		), &appBuilder, &registry)
+	require.NoError(t, err)
+	app := appBuilder.Build(dbm.NewMemDB(), nil)
+
+	testdata.RegisterInterfaces(registry)
+	testdata.RegisterMsgServer(app.MsgServiceRouter(), testdata.MsgServerImpl{})
+
+	m, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "test"})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := telemetry.New(telemetry.Config{Enabled: false})
+		require.NoError(t, err)
+	})
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	handler := app.MsgServiceRouter().Handler(msg)
+	require.NotNil(t, handler)
+
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+	for i := 0; i < 3; i++ {
+		_, err = handler(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	gr, err := m.Gather(telemetry.FormatText)
+	require.NoError(t, err)
+
+	var summary struct {
+		Counters []struct {
+			Name   string
+			Count  int
+			Labels map[string]string
+		}
+		Samples []struct {
+			Name   string
+			Labels map[string]string
+		}
+	}
+	require.NoError(t, json.Unmarshal(gr.Metrics, &summary))
+
+	typeURL := sdk.MsgTypeURL(msg)
+	counted := false
+	for _, c := range summary.Counters {
+		if c.Name == "test.msg.count" && c.Labels[telemetry.MetricLabelNameMsgTypeURL] == typeURL {
+			require.Equal(t, 3, c.Count)
+			counted = true
+		}
+		require.NotEqual(t, "test.msg.failed", c.Name)
+	}
+	require.True(t, counted)
+
+	samples := make(map[string]bool)
+	for _, s := range summary.Samples {
+		if s.Labels[telemetry.MetricLabelNameMsgTypeURL] == typeURL {
+			samples[s.Name] = true
+		}
+	}
+	require.True(t, samples["test.msg.gas_used"])
+	require.True(t, samples["test.msg.execution_time"])
+}
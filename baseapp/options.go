@@ -9,8 +9,10 @@ import (
 
 	dbm "github.com/cosmos/cosmos-db"
 
+	"cosmossdk.io/schema/decoding"
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
@@ -49,11 +51,53 @@ func SetQueryGasLimit(queryGasLimit uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.queryGasLimit = queryGasLimit }
 }
 
+// SetQueryCacheSize returns an option that enables the caching of the responses
+// of deterministic gRPC queries in a LRU cache of the given size. The cache is
+// disabled if size is 0.
+func SetQueryCacheSize(size int) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		if err := bapp.grpcQueryRouter.SetQueryCacheSize(size); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// SetInvariantAuditInterval returns an option that enables the invariant audit,
+// asserting the registered invariants in the background on the state committed
+// every interval blocks. The audit is disabled if interval is 0.
+func SetInvariantAuditInterval(interval uint64) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.invariantAuditor.interval = interval }
+}
+
+// SetInvariantAuditBatchSize returns an option that limits the number of
+// invariants asserted by every invariant audit, the invariants are then
+// asserted in batches across the audits. All the invariants are asserted by
+// every audit if size is 0.
+func SetInvariantAuditBatchSize(size int) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.invariantAuditor.batchSize = size }
+}
+
+// SetInvariantAuditPolicy returns an option that sets the policy applied when
+// the invariants of the given route, or of the given module, are found broken
+// by the invariant audit.
+func SetInvariantAuditPolicy(route string, policy InvariantPolicy) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.invariantAuditor.policies[route] = policy }
+}
+
 // SetHaltHeight returns a BaseApp option function that sets the halt block height.
 func SetHaltHeight(blockHeight uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setHaltHeight(blockHeight) }
 }
 
+// SetParallelTxExecution returns a BaseApp option function that executes the
+// FinalizeBlock transactions in parallel with the given number of workers,
+// using optimistic concurrency control. Transactions conflicting with the
+// previous ones of the block are executed again, so results are the same as
+// with a serial execution. The execution is serial if workers is lower than 2.
+func SetParallelTxExecution(workers int) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.setParallelTxWorkers(workers) }
+}
+
 // SetHaltTime returns a BaseApp option function that sets the halt block time.
 func SetHaltTime(haltTime uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setHaltTime(haltTime) }
@@ -86,6 +130,28 @@ func SetIAVLDisableFastNode(disable bool) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLDisableFastNode(disable) }
 }
 
+// SetBackgroundPruning provides a BaseApp option function that prunes the
+// application state in a background worker instead of at commit, pruning at
+// most rateLimit versions per second if rateLimit is not 0.
+func SetBackgroundPruning(enabled bool, rateLimit uint64) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		if rms, ok := bapp.cms.(*rootmulti.Store); ok {
+			rms.SetBackgroundPruning(enabled, rateLimit)
+		}
+	}
+}
+
+// SetStateStats provides a BaseApp option function that enables the tracking of
+// the statistics of the state of each store, reported as telemetry metrics and
+// by the "/app/state_stats" query.
+func SetStateStats(enabled bool) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		if rms, ok := bapp.cms.(*rootmulti.Store); ok {
+			rms.SetStateStats(enabled)
+		}
+	}
+}
+
 // SetInterBlockCache provides a BaseApp option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache storetypes.MultiStorePersistentCache) func(*BaseApp) {
@@ -102,6 +168,18 @@ func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetMempool(mempool) }
 }
 
+// SetStreamingPublisher provides a BaseApp option function that registers the
+// publisher factory of the streaming sink selected by the given plugin name in
+// the app.toml, e.g. the kafka or nats sink of cosmossdk.io/store/streaming/sinks.
+func SetStreamingPublisher(pluginName string, newPublisher StreamingPublisherFactory) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if app.streamingPublishers == nil {
+			app.streamingPublishers = make(map[string]StreamingPublisherFactory)
+		}
+		app.streamingPublishers[pluginName] = newPublisher
+	}
+}
+
 // SetChainID sets the chain ID in BaseApp.
 func SetChainID(chainID string) func(*BaseApp) {
 	return func(app *BaseApp) { app.chainID = chainID }
@@ -149,6 +227,16 @@ func (app *BaseApp) SetParamStore(ps ParamStore) {
 	app.paramStore = ps
 }
 
+// SetHaltStore sets the store of the halt height and time coordinated on-chain
+// on the BaseApp.
+func (app *BaseApp) SetHaltStore(hs HaltStore) {
+	if app.sealed {
+		panic("SetHaltStore() on sealed BaseApp")
+	}
+
+	app.haltStore = hs
+}
+
 // SetVersion sets the application's version string.
 func (app *BaseApp) SetVersion(v string) {
 	if app.sealed {
@@ -238,6 +326,16 @@ func (app *BaseApp) SetPrepareCheckStater(prepareCheckStater sdk.PrepareCheckSta
 	app.prepareCheckStater = prepareCheckStater
 }
 
+// SetInvariantAuditReporter sets the function called with the report of every
+// invariant audit, e.g. to alert the node operator of broken invariants.
+func (app *BaseApp) SetInvariantAuditReporter(reporter InvariantAuditReporter) {
+	if app.sealed {
+		panic("SetInvariantAuditReporter() on sealed BaseApp")
+	}
+
+	app.invariantAuditor.reporter = reporter
+}
+
 func (app *BaseApp) SetPrecommiter(precommiter sdk.Precommiter) {
 	if app.sealed {
 		panic("SetPrecommiter() on sealed BaseApp")
@@ -315,7 +413,7 @@ func (app *BaseApp) SetSnapshot(snapshotStore *snapshots.Store, opts snapshottyp
 		app.snapshotManager = nil
 		return
 	}
-	app.cms.SetSnapshotInterval(opts.Interval)
+	app.cms.SetSnapshotInterval(opts.SnapshotHeightInterval())
 	app.snapshotManager = snapshots.NewManager(snapshotStore, opts, app.cms, nil, app.logger)
 }
 
@@ -399,6 +497,19 @@ func (app *BaseApp) SetStreamingManager(manager storetypes.StreamingManager) {
 	app.streamingManager = manager
 }
 
+// AddABCIListener adds an ABCIListener to the streaming manager of the BaseApp,
+// along with the listeners already registered.
+func (app *BaseApp) AddABCIListener(listener storetypes.ABCIListener) {
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, listener)
+}
+
+// SetStreamingModules sets the app modules whose state changes are decoded by
+// the streaming sinks, see the decode option of the streaming.sink
+// app.toml section. The modules must implement schema.HasModuleCodec.
+func (app *BaseApp) SetStreamingModules(appModules map[string]any) {
+	app.streamingDecoderResolver = decoding.ModuleSetDecoderResolver(appModules)
+}
+
 // SetMsgServiceRouter sets the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) SetMsgServiceRouter(msgServiceRouter *MsgServiceRouter) {
 	app.msgServiceRouter = msgServiceRouter
@@ -0,0 +1,308 @@
+package baseapp
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// keyRange is a [start, end) range of keys iterated by a transaction. A nil
+// bound means the range is unbounded on that side.
+type keyRange struct {
+	start, end []byte
+}
+
+// contains returns true if the key is within the range.
+func (r keyRange) contains(key []byte) bool {
+	return (r.start == nil || bytes.Compare(key, r.start) >= 0) &&
+		(r.end == nil || bytes.Compare(key, r.end) < 0)
+}
+
+// trackedKVStore wraps a KVStore and records the keys read, the ranges
+// iterated and the keys written through it.
+type trackedKVStore struct {
+	storetypes.KVStore
+
+	mtx    sync.Mutex
+	reads  map[string]struct{}
+	ranges []keyRange
+	writes map[string]struct{}
+}
+
+var _ storetypes.KVStore = (*trackedKVStore)(nil)
+
+func newTrackedKVStore(parent storetypes.KVStore) *trackedKVStore {
+	return &trackedKVStore{
+		KVStore: parent,
+		reads:   make(map[string]struct{}),
+		writes:  make(map[string]struct{}),
+	}
+}
+
+func (s *trackedKVStore) recordRead(key []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.reads[string(key)] = struct{}{}
+}
+
+func (s *trackedKVStore) recordRange(start, end []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.ranges = append(s.ranges, keyRange{start: bytes.Clone(start), end: bytes.Clone(end)})
+}
+
+func (s *trackedKVStore) recordWrite(key []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.writes[string(key)] = struct{}{}
+}
+
+// Get implements KVStore.
+func (s *trackedKVStore) Get(key []byte) []byte {
+	s.recordRead(key)
+	return s.KVStore.Get(key)
+}
+
+// Has implements KVStore.
+func (s *trackedKVStore) Has(key []byte) bool {
+	s.recordRead(key)
+	return s.KVStore.Has(key)
+}
+
+// Set implements KVStore.
+func (s *trackedKVStore) Set(key, value []byte) {
+	s.recordWrite(key)
+	s.KVStore.Set(key, value)
+}
+
+// Delete implements KVStore.
+func (s *trackedKVStore) Delete(key []byte) {
+	s.recordWrite(key)
+	s.KVStore.Delete(key)
+}
+
+// Iterator implements KVStore.
+func (s *trackedKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.recordRange(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator implements KVStore.
+func (s *trackedKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.recordRange(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// CacheWrap implements CacheWrapper.
+func (s *trackedKVStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (s *trackedKVStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// readsAny returns true if the store read a key, or iterated over a range
+// containing a key, among the given written keys.
+func (s *trackedKVStore) readsAny(written map[string]struct{}) bool {
+	for key := range s.reads {
+		if _, ok := written[key]; ok {
+			return true
+		}
+	}
+
+	for _, r := range s.ranges {
+		for key := range written {
+			if r.contains([]byte(key)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// txExecution is the result of the execution of a transaction on its own
+// branch of the FinalizeBlock state.
+type txExecution struct {
+	ms       storetypes.CacheMultiStore
+	stores   map[storetypes.StoreKey]*trackedKVStore
+	effects  *sdk.TxEffects
+	blockGas uint64
+
+	gInfo      sdk.GasInfo
+	result     *sdk.Result
+	anteEvents []abci.Event
+	err        error
+}
+
+// conflicts returns true if the transaction read state written by the
+// transactions committed before it.
+func (e *txExecution) conflicts(written map[storetypes.StoreKey]map[string]struct{}) bool {
+	for key, store := range e.stores {
+		if keys := written[key]; len(keys) > 0 && store.readsAny(keys) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// deliverTxs executes the FinalizeBlock transactions, in parallel if enabled,
+// and returns their results in order.
+func (app *BaseApp) deliverTxs(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if app.parallelTxWorkers > 1 && len(txs) > 1 && ok && !app.finalizeBlockState.ms.TracingEnabled() {
+		return app.deliverTxsParallel(ctx, txs, rms.StoreKeysByName())
+	}
+
+	txResults := make([]*abci.ExecTxResult, 0, len(txs))
+	for _, rawTx := range txs {
+
+		response := app.deliverTx(rawTx)
+
+		// check after every tx if we should abort
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// continue
+		}
+
+		txResults = append(txResults, response)
+	}
+
+	return txResults, nil
+}
+
+// deliverTxsParallel executes the transactions with optimistic concurrency
+// control. All transactions are first executed concurrently, each one on its
+// own branch of the FinalizeBlock state recording the keys it reads and
+// writes. Branches are then written in order; a transaction reading state
+// written by a previous transaction of the block, exceeding the block gas
+// left, or with side effects outside of the state which can no longer be
+// applied, is executed again on top of the up-to-date state. The results are
+// thus the same as with a serial execution.
+func (app *BaseApp) deliverTxsParallel(ctx context.Context, txs [][]byte, keys map[string]storetypes.StoreKey) ([]*abci.ExecTxResult, error) {
+	executions := make([]*txExecution, len(txs))
+
+	var wg sync.WaitGroup
+	workers := make(chan struct{}, app.parallelTxWorkers)
+	for i, rawTx := range txs {
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(i int, rawTx []byte) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+			executions[i] = app.executeTxOnBranch(keys, rawTx, storetypes.NewInfiniteGasMeter(), &sdk.TxEffects{})
+		}(i, rawTx)
+	}
+	wg.Wait()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+		// continue
+	}
+
+	blockGasMeter := app.finalizeBlockState.Context().BlockGasMeter()
+	written := make(map[storetypes.StoreKey]map[string]struct{})
+	txResults := make([]*abci.ExecTxResult, 0, len(txs))
+	for i, exec := range executions {
+		// the side effects held back by the optimistic execution are applied
+		// last, once the execution is known to be up-to-date
+		if exec.conflicts(written) || blockGasMeter.IsOutOfGas() || exec.blockGas > blockGasMeter.GasRemaining() ||
+			exec.effects.Apply() != nil {
+			// the re-execution consumes the block gas and applies the side
+			// effects itself
+			exec = app.executeTxOnBranch(keys, txs[i], blockGasMeter, nil)
+		} else {
+			blockGasMeter.ConsumeGas(exec.blockGas, "block gas meter")
+		}
+
+		exec.ms.Write()
+		for key, store := range exec.stores {
+			if len(store.writes) == 0 {
+				continue
+			}
+			if written[key] == nil {
+				written[key] = make(map[string]struct{})
+			}
+			for k := range store.writes {
+				written[key][k] = struct{}{}
+			}
+		}
+
+		txResults = append(txResults, app.execTxResult(exec.gInfo, exec.result, exec.anteEvents, exec.err))
+
+		// check after every tx if we should abort
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// continue
+		}
+	}
+
+	return txResults, nil
+}
+
+// executeTxOnBranch executes the transaction on a new branch of the
+// FinalizeBlock state, recording the keys read and written. The branch is not
+// written. The side effects of the transaction outside of the state are held
+// back in effects if not nil, or applied otherwise.
+func (app *BaseApp) executeTxOnBranch(
+	keys map[string]storetypes.StoreKey, txBytes []byte, blockGasMeter storetypes.GasMeter, effects *sdk.TxEffects,
+) *txExecution {
+	parent := app.finalizeBlockState.ms
+	stores := make(map[storetypes.StoreKey]*trackedKVStore, len(keys))
+	wrappers := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keys))
+	for _, key := range keys {
+		store := newTrackedKVStore(parent.GetKVStore(key))
+		stores[key] = store
+		wrappers[key] = store
+	}
+	ms := cachemulti.NewStore(dbm.NewMemDB(), wrappers, keys, nil, nil)
+
+	ctx := app.getContextForTx(execModeFinalize, txBytes).
+		WithMultiStore(ms).
+		WithEventManager(sdk.NewEventManager()).
+		WithBlockGasMeter(blockGasMeter).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+	if effects != nil {
+		ctx = ctx.WithTxEffects(effects)
+	}
+	// consensus params are read again from the branch, as they may be
+	// updated by previous transactions of the block.
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	gasBefore := blockGasMeter.GasConsumed()
+	gInfo, result, anteEvents, err := app.runTxWithContext(ctx, execModeFinalize, txBytes)
+
+	return &txExecution{
+		ms:         ms,
+		stores:     stores,
+		effects:    effects,
+		blockGas:   blockGasMeter.GasConsumed() - gasBefore,
+		gInfo:      gInfo,
+		result:     result,
+		anteEvents: anteEvents,
+		err:        err,
+	}
+}
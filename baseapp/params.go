@@ -15,3 +15,9 @@ type ParamStore interface {
 	Has(ctx context.Context) (bool, error)
 	Set(ctx context.Context, cp cmtproto.ConsensusParams) error
 }
+
+// HaltStore defines the interface the store of the halt height and time
+// coordinated on-chain must fulfill. Zero values disable the respective halt.
+type HaltStore interface {
+	GetHalt(ctx context.Context) (height, time uint64, err error)
+}
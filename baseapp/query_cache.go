@@ -0,0 +1,69 @@
+package baseapp
+
+import (
+	"fmt"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	lru "github.com/hashicorp/golang-lru"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	queryv1 "cosmossdk.io/api/cosmos/query/v1"
+)
+
+// queryCacheKey identifies a query response: the response of a deterministic
+// query is the same for a given request at a given height.
+type queryCacheKey struct {
+	path    string
+	request string
+	height  int64
+}
+
+// queryCache is a LRU cache of the responses of deterministic gRPC queries.
+type queryCache struct {
+	cache *lru.Cache
+}
+
+func newQueryCache(size int) (*queryCache, error) {
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryCache{cache: cache}, nil
+}
+
+func (c *queryCache) get(path string, request []byte, height int64) ([]byte, bool) {
+	value, ok := c.cache.Get(queryCacheKey{path: path, request: string(request), height: height})
+	if !ok {
+		return nil, false
+	}
+
+	return value.([]byte), true
+}
+
+func (c *queryCache) add(path string, request []byte, height int64, response []byte) {
+	c.cache.Add(queryCacheKey{path: path, request: string(request), height: height}, response)
+}
+
+func (c *queryCache) purge() {
+	c.cache.Purge()
+}
+
+// isModuleQuerySafe returns true if the query method is annotated with
+// cosmos.query.v1.module_query_safe, i.e. is deterministic.
+func isModuleQuerySafe(serviceName, methodName string) bool {
+	methodFullName := protoreflect.FullName(fmt.Sprintf("%s.%s", serviceName, methodName))
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(methodFullName)
+	if err != nil {
+		return false
+	}
+
+	methodDesc, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok || methodDesc.Options() == nil {
+		return false
+	}
+
+	safe, ok := proto.GetExtension(methodDesc.Options(), queryv1.E_ModuleQuerySafe).(bool)
+	return ok && safe
+}
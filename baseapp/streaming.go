@@ -26,8 +26,22 @@ const (
 	StreamingABCIPluginTomlKey        = "plugin"
 	StreamingABCIKeysTomlKey          = "keys"
 	StreamingABCIStopNodeOnErrTomlKey = "stop-node-on-err"
+
+	// StreamingKafkaPlugin and StreamingNATSPlugin are the names of the
+	// streaming plugins publishing to Kafka and NATS, whose publishers are
+	// registered with SetStreamingPublisher.
+	StreamingKafkaPlugin = "kafka"
+	StreamingNATSPlugin  = "nats"
+
+	StreamingSinkTomlKey = "sink"
 )
 
+// StreamingPublisherFactory returns the publisher of a streaming sink, e.g. a
+// Kafka or NATS publisher of cosmossdk.io/store/streaming/sinks, configured
+// with the options of its app.toml section: opt returns the value of the given
+// key of the streaming.<plugin> section.
+type StreamingPublisherFactory func(opt func(key string) any) (streaming.Publisher, error)
+
 // EnableIndexer enables the built-in indexer with the provided options (usually from the app.toml indexer key),
 // kv-store keys, and app modules. Using the built-in indexer framework is mutually exclusive from using other
 // types of streaming listeners.
@@ -60,7 +74,18 @@ func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, ke
 	for service := range streamingCfg {
 		pluginKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, service, StreamingABCIPluginTomlKey)
 		pluginName := strings.TrimSpace(cast.ToString(appOpts.Get(pluginKey)))
-		if len(pluginName) > 0 {
+		switch newPublisher, ok := app.streamingPublishers[pluginName]; {
+		case pluginName == "":
+			continue
+
+		case ok:
+			listener, err := app.newSinkListener(appOpts, pluginName, newPublisher)
+			if err != nil {
+				return fmt.Errorf("failed to create %s streaming sink: %w", pluginName, err)
+			}
+			app.registerABCIListenerPlugin(appOpts, keys, listener)
+
+		default:
 			logLevel := cast.ToString(appOpts.Get(flags.FlagLogLevel))
 			plugin, err := streaming.NewStreamingPlugin(pluginName, logLevel)
 			if err != nil {
@@ -110,6 +135,37 @@ func (app *BaseApp) registerABCIListenerPlugin(
 	)
 }
 
+// newSinkListener returns the listener of a streaming sink, publishing with the
+// publisher registered for the plugin, configured with the options of its
+// app.toml section.
+func (app *BaseApp) newSinkListener(
+	appOpts servertypes.AppOptions,
+	pluginName string,
+	newPublisher StreamingPublisherFactory,
+) (*streaming.SinkListener, error) {
+	opt := func(section, key string) interface{} {
+		return appOpts.Get(fmt.Sprintf("%s.%s.%s", StreamingTomlKey, section, key))
+	}
+
+	publisher, err := newPublisher(func(key string) any { return opt(pluginName, key) })
+	if err != nil {
+		return nil, err
+	}
+
+	sinkOpts := streaming.SinkOptions{
+		BatchSize:    cast.ToInt(opt(StreamingSinkTomlKey, "batch-size")),
+		MaxRetries:   cast.ToInt(opt(StreamingSinkTomlKey, "max-retries")),
+		RetryBackoff: cast.ToDuration(opt(StreamingSinkTomlKey, "retry-backoff")),
+	}
+	if cast.ToBool(opt(StreamingSinkTomlKey, "decode")) {
+		// the modules are resolved lazily, as the decoder resolver may be set
+		// once the module manager is built
+		sinkOpts.Decoder = newStateChangeDecoder(app)
+	}
+
+	return streaming.NewSinkListener(publisher, sinkOpts, app.logger.With("module", "streaming", "sink", pluginName)), nil
+}
+
 func exposeAll(list []string) bool {
 	for _, ele := range list {
 		if ele == "*" {
@@ -0,0 +1,189 @@
+package baseapp
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/store/streaming"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+var _ streaming.KVPairDecoder = (*stateChangeDecoder)(nil)
+
+// stateChangeDecoder decodes the state changes of the modules implementing
+// schema.HasModuleCodec, resolved with the streaming decoder resolver of the
+// BaseApp, into objects keyed by the names of their fields.
+type stateChangeDecoder struct {
+	app *BaseApp
+
+	mtx    sync.Mutex
+	codecs map[string]*schema.ModuleCodec
+}
+
+func newStateChangeDecoder(app *BaseApp) *stateChangeDecoder {
+	return &stateChangeDecoder{
+		app:    app,
+		codecs: make(map[string]*schema.ModuleCodec),
+	}
+}
+
+// DecodeKVPair implements streaming.KVPairDecoder.
+func (d *stateChangeDecoder) DecodeKVPair(pair *storetypes.StoreKVPair) ([]streaming.StateChange, bool, error) {
+	cdc, err := d.lookupCodec(pair.StoreKey)
+	if err != nil || cdc == nil || cdc.KVDecoder == nil {
+		return nil, false, err
+	}
+
+	updates, err := cdc.KVDecoder(schema.KVPairUpdate{Key: pair.Key, Value: pair.Value, Delete: pair.Delete})
+	if err != nil {
+		return nil, true, err
+	}
+
+	changes := make([]streaming.StateChange, 0, len(updates))
+	for _, update := range updates {
+		objectType, ok := lookupObjectType(cdc.Schema, update.TypeName)
+		if !ok {
+			return nil, true, fmt.Errorf("object type %q not found in the schema of module %s", update.TypeName, pair.StoreKey)
+		}
+
+		change := streaming.StateChange{StoreKey: pair.StoreKey, Type: update.TypeName, Delete: update.Delete}
+		if change.Key, err = decodeFields(objectType.KeyFields, update.Key); err != nil {
+			return nil, true, fmt.Errorf("invalid key of %s: %w", update.TypeName, err)
+		}
+		if !update.Delete {
+			if change.Value, err = decodeFields(objectType.ValueFields, update.Value); err != nil {
+				return nil, true, fmt.Errorf("invalid value of %s: %w", update.TypeName, err)
+			}
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, true, nil
+}
+
+// lookupCodec returns the codec of a module, or nil if it has none.
+func (d *stateChangeDecoder) lookupCodec(moduleName string) (*schema.ModuleCodec, error) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	if cdc, ok := d.codecs[moduleName]; ok {
+		return cdc, nil
+	}
+
+	resolver := d.app.streamingDecoderResolver
+	if resolver == nil {
+		return nil, nil
+	}
+
+	cdc, found, err := resolver.LookupDecoder(moduleName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pcdc *schema.ModuleCodec
+	if found {
+		pcdc = &cdc
+	}
+	d.codecs[moduleName] = pcdc
+
+	return pcdc, nil
+}
+
+func lookupObjectType(moduleSchema schema.ModuleSchema, name string) (schema.ObjectType, bool) {
+	for _, objectType := range moduleSchema.ObjectTypes {
+		if objectType.Name == name {
+			return objectType, true
+		}
+	}
+
+	return schema.ObjectType{}, false
+}
+
+// decodeFields returns the values of the fields of an object key or value, by
+// field name, as defined by schema.ObjectUpdate.
+func decodeFields(fields []schema.Field, value interface{}) (map[string]interface{}, error) {
+	res := make(map[string]interface{}, len(fields))
+
+	if updates, ok := value.(schema.ValueUpdates); ok {
+		var err error
+		iterErr := updates.Iterate(func(name string, v interface{}) bool {
+			for _, field := range fields {
+				if field.Name == name {
+					res[name], err = encodeFieldValue(field, v)
+					return err == nil
+				}
+			}
+
+			err = fmt.Errorf("unknown field %q", name)
+			return false
+		})
+		if iterErr != nil {
+			return nil, iterErr
+		}
+
+		return res, err
+	}
+
+	switch len(fields) {
+	case 0:
+		return res, nil
+
+	case 1:
+		v, err := encodeFieldValue(fields[0], value)
+		if err != nil {
+			return nil, err
+		}
+		res[fields[0].Name] = v
+		return res, nil
+
+	default:
+		values, ok := value.([]interface{})
+		if !ok || len(values) != len(fields) {
+			return nil, fmt.Errorf("expected %d values, got %T", len(fields), value)
+		}
+
+		for i, field := range fields {
+			v, err := encodeFieldValue(field, values[i])
+			if err != nil {
+				return nil, err
+			}
+			res[field.Name] = v
+		}
+
+		return res, nil
+	}
+}
+
+// encodeFieldValue returns the JSON friendly representation of a field value:
+// the addresses are bech32 encoded with the prefix of their field, and the 64
+// bits integers and durations are encoded as strings, like in the protobuf JSON
+// encoding.
+func encodeFieldValue(field schema.Field, value interface{}) (interface{}, error) {
+	if err := field.ValidateValue(value); err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, nil
+	}
+
+	switch field.Kind {
+	case schema.Bech32AddressKind:
+		if field.AddressPrefix != "" {
+			return bech32.ConvertAndEncode(field.AddressPrefix, value.([]byte))
+		}
+	case schema.Int64Kind:
+		return strconv.FormatInt(value.(int64), 10), nil
+	case schema.Uint64Kind:
+		return strconv.FormatUint(value.(uint64), 10), nil
+	case schema.DurationKind:
+		return value.(time.Duration).String(), nil
+	}
+
+	return value, nil
+}
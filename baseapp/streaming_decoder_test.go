@@ -0,0 +1,82 @@
+package baseapp
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/schema"
+	storetypes "cosmossdk.io/store/types"
+)
+
+type testCodecModule struct{}
+
+func (testCodecModule) ModuleCodec() (schema.ModuleCodec, error) {
+	return schema.ModuleCodec{
+		Schema: schema.ModuleSchema{
+			ObjectTypes: []schema.ObjectType{{
+				Name: "balances",
+				KeyFields: []schema.Field{
+					{Name: "address", Kind: schema.Bech32AddressKind, AddressPrefix: "cosmos"},
+					{Name: "denom", Kind: schema.StringKind},
+				},
+				ValueFields: []schema.Field{{Name: "amount", Kind: schema.Uint64Kind}},
+			}},
+		},
+		KVDecoder: func(update schema.KVPairUpdate) ([]schema.ObjectUpdate, error) {
+			if len(update.Key) < 2 {
+				return nil, errors.New("invalid key")
+			}
+
+			return []schema.ObjectUpdate{{
+				TypeName: "balances",
+				Key:      []interface{}{update.Key[:1], string(update.Key[1:])},
+				Value:    uint64(len(update.Value)),
+				Delete:   update.Delete,
+			}}, nil
+		},
+	}, nil
+}
+
+func TestStateChangeDecoder(t *testing.T) {
+	app := &BaseApp{}
+	d := newStateChangeDecoder(app)
+
+	// the modules are resolved once the resolver is set
+	_, ok, err := d.DecodeKVPair(&storetypes.StoreKVPair{StoreKey: "bank", Key: []byte("\x01stake")})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	app.SetStreamingModules(map[string]any{
+		"bank": testCodecModule{},
+		"acc":  struct{}{},
+	})
+
+	changes, ok, err := d.DecodeKVPair(&storetypes.StoreKVPair{StoreKey: "bank", Key: []byte("\x01stake"), Value: []byte("abc")})
+	require.NoError(t, err)
+	require.True(t, ok)
+	bz, err := json.Marshal(changes)
+	require.NoError(t, err)
+	require.JSONEq(t, `[{
+		"store_key": "bank",
+		"type": "balances",
+		"key": {"address": "cosmos1qyfkm2y3", "denom": "stake"},
+		"value": {"amount": "3"}
+	}]`, string(bz))
+
+	changes, ok, err = d.DecodeKVPair(&storetypes.StoreKVPair{StoreKey: "bank", Key: []byte("\x01stake"), Delete: true})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, changes[0].Delete)
+	require.Nil(t, changes[0].Value)
+
+	_, ok, err = d.DecodeKVPair(&storetypes.StoreKVPair{StoreKey: "bank", Key: []byte{1}})
+	require.ErrorContains(t, err, "invalid key")
+	require.True(t, ok)
+
+	_, ok, err = d.DecodeKVPair(&storetypes.StoreKVPair{StoreKey: "acc", Key: []byte{1}})
+	require.NoError(t, err)
+	require.False(t, ok)
+}
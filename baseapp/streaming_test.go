@@ -9,6 +9,7 @@ import (
 	tmproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/store/streaming"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -146,3 +147,54 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+// recordingPublisher is a streaming.Publisher recording the messages published.
+type recordingPublisher struct {
+	msgs []streaming.Message
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, msgs []streaming.Message) error {
+	p.msgs = append(p.msgs, msgs...)
+	return nil
+}
+
+func (p *recordingPublisher) Close() error { return nil }
+
+type mapAppOptions map[string]interface{}
+
+func (m mapAppOptions) Get(key string) interface{} { return m[key] }
+
+func TestRegisterStreamingServices_Publisher(t *testing.T) {
+	publisher := &recordingPublisher{}
+	var topic interface{}
+	publisherOpt := baseapp.SetStreamingPublisher("recording", func(opt func(key string) any) (streaming.Publisher, error) {
+		topic = opt("topic")
+		return publisher, nil
+	})
+	suite := NewBaseAppSuite(t, publisherOpt)
+
+	appOpts := mapAppOptions{
+		"streaming":                 map[string]interface{}{"abci": map[string]interface{}{}},
+		"streaming.abci.plugin":     "recording",
+		"streaming.abci.keys":       []string{"*"},
+		"streaming.recording.topic": "cosmos",
+	}
+	require.NoError(t, suite.baseApp.RegisterStreamingServices(appOpts, map[string]*storetypes.KVStoreKey{capKey1.Name(): capKey1}))
+	require.Equal(t, "cosmos", topic)
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{ConsensusParams: &tmproto.ConsensusParams{}})
+	require.NoError(t, err)
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	// the FinalizeBlock and Commit messages of the block are published
+	require.Len(t, publisher.msgs, 2)
+	require.Equal(t, "cosmos.store.streaming.abci.ListenFinalizeBlockRequest", publisher.msgs[0].Schema)
+	require.Equal(t, "cosmos.store.streaming.abci.ListenCommitRequest", publisher.msgs[1].Schema)
+
+	// a plugin without a registered publisher is loaded as a plugin binary
+	appOpts["streaming.abci.plugin"] = "unregistered"
+	require.ErrorContains(t, suite.baseApp.RegisterStreamingServices(appOpts, nil), "failed to load streaming plugin")
+}
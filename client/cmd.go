@@ -269,7 +269,8 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 	if clientCtx.FeeGranter == nil || flagSet.Changed(flags.FlagFeeGranter) {
 		granter, _ := flagSet.GetString(flags.FlagFeeGranter)
 
-		if granter != "" {
+		// the fee granter is selected by the tx factory if auto
+		if granter != "" && granter != flags.FeeGranterAuto {
 			granterAcc, err := clientCtx.AddressCodec.StringToBytes(granter)
 			if err != nil {
 				return clientCtx, err
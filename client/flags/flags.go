@@ -18,6 +18,14 @@ const (
 	DefaultGasLimit      = 200000
 	GasFlagAuto          = "auto"
 
+	// GasRetryMultiplier is the factor the gas adjustment is multiplied by
+	// when a transaction ran out of gas and is retried.
+	GasRetryMultiplier = 1.5
+
+	// FeeGranterAuto is the --fee-granter value selecting a fee allowance
+	// granted to the fee payer which covers the transaction.
+	FeeGranterAuto = "auto"
+
 	// DefaultKeyringBackend defines the default keyring backend to be used
 	DefaultKeyringBackend = keyring.BackendOS
 
@@ -51,6 +59,7 @@ const (
 	FlagGRPCInsecure     = "grpc-insecure"
 	FlagHeight           = "height"
 	FlagGasAdjustment    = "gas-adjustment"
+	FlagGasRetries       = "gas-retries"
 	FlagFrom             = "from"
 	FlagName             = "name"
 	FlagAccountNumber    = "account-number"
@@ -61,6 +70,7 @@ const (
 	FlagGasPrices        = "gas-prices"
 	FlagBroadcastMode    = "broadcast-mode"
 	FlagDryRun           = "dry-run"
+	FlagTraceGas         = "trace-gas"
 	FlagGenerateOnly     = "generate-only"
 	FlagOffline          = "offline"
 	FlagOutputDocument   = "output-document" // inspired by wget -O
@@ -74,6 +84,7 @@ const (
 	FlagOffset           = "offset"
 	FlagCountTotal       = "count-total"
 	FlagTimeoutTimestamp = "timeout-timestamp"
+	FlagTimeoutDuration  = "timeout-duration"
 	FlagUnordered        = "unordered"
 	FlagKeyAlgorithm     = "algo"
 	FlagKeyType          = "key-type"
@@ -130,16 +141,19 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	f.String(FlagNode, "tcp://localhost:26657", "<host>:<port> to CometBFT rpc interface for this chain")
 	f.Bool(FlagUseLedger, false, "Use a connected Ledger device")
 	f.Float64(FlagGasAdjustment, DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored ")
+	f.Uint64(FlagGasRetries, 0, fmt.Sprintf("number of times a tx failing with out of gas is simulated again and broadcast with a gas adjustment increased %.1fx (requires --gas=%s and sync broadcast mode)", GasRetryMultiplier, GasFlagAuto))
 	f.StringP(FlagBroadcastMode, "b", BroadcastSync, "Transaction broadcasting mode (sync|async)")
 	f.Bool(FlagDryRun, false, "ignore the --gas flag and perform a simulation of a transaction, but don't broadcast it (when enabled, the local Keybase is not accessible)")
+	f.Bool(FlagTraceGas, false, "print the breakdown of the gas consumed by the transaction when simulating it, with --dry-run or --gas=auto")
 	f.Bool(FlagGenerateOnly, false, "Build an unsigned transaction and write it to STDOUT (when enabled, the local Keybase only accessed when providing a key name)")
 	f.Bool(FlagOffline, false, "Offline mode (does not allow any online functionality)")
 	f.BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
 	f.String(FlagSignMode, "", "Choose sign mode (direct|amino-json|direct-aux|textual), this is an advanced feature")
 	f.Int64(FlagTimeoutTimestamp, 0, "Set a block timeout timestamp to prevent the tx from being committed past a certain time")
-	f.Bool(FlagUnordered, false, "Enable unordered transaction delivery; must be used in conjunction with --timeout-timestamp")
+	f.Duration(FlagTimeoutDuration, 0, "Set the block timeout timestamp of the tx to the current time plus the given duration (e.g. 30s), instead of --timeout-timestamp")
+	f.Bool(FlagUnordered, false, "Enable unordered transaction delivery, signed without the account sequence; must be used in conjunction with --timeout-timestamp or --timeout-duration")
 	f.String(FlagFeePayer, "", "Fee payer pays fees for the transaction instead of deducting from the signer")
-	f.String(FlagFeeGranter, "", "Fee granter grants fees for the transaction")
+	f.String(FlagFeeGranter, "", fmt.Sprintf("Fee granter grants fees for the transaction; set to %q to select one of the fee allowances granted to the fee payer", FeeGranterAuto))
 	f.String(FlagTip, "", "Tip is the amount that is going to be transferred to the fee payer on the target chain. This flag is only valid when used with --aux, and is ignored if the target chain didn't enable the TipDecorator")
 	f.Bool(FlagAux, false, "Generate aux signer data instead of sending a tx")
 	f.String(FlagChainID, "", "The network chain ID")
@@ -41,6 +41,8 @@ const (
 	flagPubKeyBase64 = "pubkey-base64"
 	flagIndiscreet   = "indiscreet"
 	flagMnemonicSrc  = "source"
+	flagSigner       = "signer"
+	flagKeyID        = "key-id"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -74,6 +76,14 @@ sorted by address, unless the flag --nosort is set.
 Example:
 
     keys add mymultisig --multisig "keyname1,keyname2,keyname3" --multisig-threshold 2
+
+Use the --signer and --key-id flags to store a reference to a key held by an external
+signer, such as a PKCS#11 hardware security module or a remote key management service.
+The signer is either set in the keyring options of the application, or is the plugin
+executable "cosmos-signer-<signer>" found in the PATH.
+Example:
+
+    keys add validator --signer pkcs11 --key-id "validator-key"
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmdPrepare,
@@ -86,6 +96,8 @@ Example:
 	f.String(flagPubKeyBase64, "", "Parse a public key in base64 format and saves key info.")
 	f.BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
 	f.Bool(flags.FlagUseLedger, false, "Store a local reference to a private key on a Ledger device")
+	f.String(flagSigner, "", "Store a local reference to a private key held by the given external signer")
+	f.String(flagKeyID, "", "ID of the key in the external signer, for use in conjunction with --signer")
 	f.Bool(flagRecover, false, "Provide seed phrase to recover existing key instead of creating")
 	f.Bool(flagNoBackup, false, "Don't print out seed phrase (if others are watching the terminal)")
 	f.Bool(flags.FlagDryRun, false, "Perform action, but don't add key to local keystore")
@@ -264,6 +276,20 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		return printCreate(ctx, cmd, k, false, false, "", outputFormat)
 	}
 
+	if signer, _ := cmd.Flags().GetString(flagSigner); signer != "" {
+		keyID, _ := cmd.Flags().GetString(flagKeyID)
+		if keyID == "" {
+			return fmt.Errorf("flag %s is required with --%s", flagKeyID, flagSigner)
+		}
+
+		k, err := kb.SaveExternalKey(name, signer, keyID)
+		if err != nil {
+			return err
+		}
+
+		return printCreate(ctx, cmd, k, false, false, "", outputFormat)
+	}
+
 	coinType, _ := cmd.Flags().GetUint32(flagCoinType)
 	account, _ := cmd.Flags().GetUint32(flagAccount)
 	index, _ := cmd.Flags().GetUint32(flagIndex)
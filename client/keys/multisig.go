@@ -0,0 +1,308 @@
+package keys
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerr "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	flagThreshold    = "threshold"
+	flagParticipants = "participants"
+	flagAddMembers   = "add"
+	flagRemove       = "remove"
+)
+
+// MultisigKeyOutput defines the output of a multisig key with its threshold
+// and, optionally, its participants.
+type MultisigKeyOutput struct {
+	KeyOutput
+	Threshold    uint        `json:"threshold" yaml:"threshold"`
+	Participants []KeyOutput `json:"participants,omitempty" yaml:"participants,omitempty"`
+}
+
+// MultisigCommand returns the commands to manage multisig keys.
+func MultisigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig",
+		Short: "Manage multisig keys",
+		Long: `Create, inspect and rotate legacy multisig keys stored in the keyring.
+
+A multisig key is a public key reference made of the public keys of its participants
+and a threshold of required signatures. As its address is derived from them, rotating
+a multisig key creates a new key with a new address, to which the funds and
+authorizations of the old one must be transferred.`,
+		RunE: client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		MultisigCreateCommand(),
+		MultisigShowCommand(),
+		MultisigRotateCommand(),
+	)
+
+	return cmd
+}
+
+// MultisigCreateCommand returns the command creating a multisig key.
+func MultisigCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <name> <name_or_address>...",
+		Short: "Create a multisig key from keys stored in the keyring",
+		Long: `Create a multisig key of the given keys, by name or address, requiring the
+signatures of --threshold of them. The keys are sorted by address, unless the flag
+--nosort is set.
+
+Example:
+
+    keys multisig create mymultisig keyname1 keyname2 keyname3 --threshold 2
+`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pks, err := fetchPubKeys(clientCtx, args[1:])
+			if err != nil {
+				return err
+			}
+
+			threshold, _ := cmd.Flags().GetInt(flagThreshold)
+			noSort, _ := cmd.Flags().GetBool(flagNoSort)
+			pk, err := keyring.NewMultisigPubKey(threshold, pks, noSort)
+			if err != nil {
+				return err
+			}
+
+			k, err := clientCtx.Keyring.SaveMultisig(args[0], pk)
+			if err != nil {
+				return err
+			}
+
+			return printCreate(clientCtx, cmd, k, false, false, "", clientCtx.OutputFormat)
+		},
+	}
+
+	cmd.Flags().Int(flagThreshold, 1, "K out of N required signatures")
+	cmd.Flags().Bool(flagNoSort, false, "Keys are taken in the order they're supplied")
+
+	return cmd
+}
+
+// MultisigShowCommand returns the command showing a multisig key.
+func MultisigShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show <name_or_address>",
+		Short: "Show the threshold and participants of a multisig key",
+		Long: `Show a multisig key with its threshold. If the --participants flag is set, the
+address and public key of each participant are shown as well, with the name of the
+key of the keyring holding it, if any.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			k, err := fetchKey(clientCtx.Keyring, args[0], clientCtx.AddressCodec)
+			if err != nil {
+				return fmt.Errorf("%s is not a valid name or address: %w", args[0], err)
+			}
+			pk, err := k.GetMultisigPubKey()
+			if err != nil {
+				return err
+			}
+
+			ko, err := MkAccKeyOutput(k, clientCtx.AddressCodec)
+			if err != nil {
+				return err
+			}
+			out := MultisigKeyOutput{KeyOutput: ko, Threshold: pk.GetThreshold()}
+
+			if showParticipants, _ := cmd.Flags().GetBool(flagParticipants); showParticipants {
+				for _, member := range pk.GetPubKeys() {
+					po, err := mkParticipantOutput(clientCtx, member)
+					if err != nil {
+						return err
+					}
+					out.Participants = append(out.Participants, po)
+				}
+			}
+
+			return printMultisigKeyOutput(cmd, out, clientCtx.OutputFormat)
+		},
+	}
+
+	cmd.Flags().Bool(flagParticipants, false, "Show the participants of the multisig key")
+
+	return cmd
+}
+
+// MultisigRotateCommand returns the command rotating a multisig key.
+func MultisigRotateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate <name> <new_name>",
+		Short: "Create a new multisig key by changing the participants or threshold of another",
+		Long: `Create the multisig key <new_name> from the multisig key <name>, without the
+participants of --remove and with the keys of --add, by name or address, requiring
+--threshold signatures, or as many as <name> if not set. The keys are sorted by
+address, unless the flag --nosort is set, in which case the added keys follow the
+kept ones.
+
+The multisig key <name> is kept, as the new key has a new address: the funds and
+authorizations of the old address must be transferred to the new one, with
+transactions signed by the participants of the old key.
+
+Example:
+
+    keys multisig rotate mymultisig mymultisig2 --add keyname4 --remove keyname1 --threshold 3
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			k, err := fetchKey(clientCtx.Keyring, args[0], clientCtx.AddressCodec)
+			if err != nil {
+				return fmt.Errorf("%s is not a valid name or address: %w", args[0], err)
+			}
+			pk, err := k.GetMultisigPubKey()
+			if err != nil {
+				return err
+			}
+
+			addRefs, _ := cmd.Flags().GetStringSlice(flagAddMembers)
+			add, err := fetchPubKeys(clientCtx, addRefs)
+			if err != nil {
+				return err
+			}
+			removeRefs, _ := cmd.Flags().GetStringSlice(flagRemove)
+			remove, err := fetchParticipants(clientCtx, pk, removeRefs)
+			if err != nil {
+				return err
+			}
+
+			threshold, _ := cmd.Flags().GetInt(flagThreshold)
+			noSort, _ := cmd.Flags().GetBool(flagNoSort)
+			rotated, err := keyring.RotateMultisigPubKey(pk, add, remove, threshold, noSort)
+			if err != nil {
+				return err
+			}
+
+			newKey, err := clientCtx.Keyring.SaveMultisig(args[1], rotated)
+			if err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("The address of %s differs from the one of %s, its funds must be transferred.\n", args[1], k.Name)
+			return printCreate(clientCtx, cmd, newKey, false, false, "", clientCtx.OutputFormat)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagAddMembers, nil, "Keys to add to the participants, by name or address")
+	cmd.Flags().StringSlice(flagRemove, nil, "Keys to remove from the participants, by name or address")
+	cmd.Flags().Int(flagThreshold, 0, "K out of N required signatures (default to the threshold of <name>)")
+	cmd.Flags().Bool(flagNoSort, false, "Keys are taken in the order of <name> followed by the added ones")
+
+	return cmd
+}
+
+// fetchPubKeys returns the public keys of the given keys, by name or address.
+func fetchPubKeys(clientCtx client.Context, keyRefs []string) ([]cryptotypes.PubKey, error) {
+	pks := make([]cryptotypes.PubKey, len(keyRefs))
+	for i, keyRef := range keyRefs {
+		k, err := fetchKey(clientCtx.Keyring, keyRef, clientCtx.AddressCodec)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid name or address: %w", keyRef, err)
+		}
+
+		pks[i], err = k.GetPubKey()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pks, nil
+}
+
+// fetchParticipants returns the public keys of the given participants of a
+// multisig key, by name or address, which don't need to be in the keyring.
+func fetchParticipants(clientCtx client.Context, pk *multisig.LegacyAminoPubKey, refs []string) ([]cryptotypes.PubKey, error) {
+	pks := make([]cryptotypes.PubKey, len(refs))
+	for i, ref := range refs {
+		var addr []byte
+		if k, err := clientCtx.Keyring.Key(ref); err == nil {
+			addr, err = k.GetAddress()
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			addr, err = clientCtx.AddressCodec.StringToBytes(ref)
+			if err != nil {
+				return nil, fmt.Errorf("%s is not a valid name or address: %w", ref, err)
+			}
+		}
+
+		for _, member := range pk.GetPubKeys() {
+			if bytes.Equal(member.Address(), addr) {
+				pks[i] = member
+				break
+			}
+		}
+		if pks[i] == nil {
+			return nil, fmt.Errorf("%s is not a participant of the multisig key", ref)
+		}
+	}
+
+	return pks, nil
+}
+
+// mkParticipantOutput returns the output of a participant of a multisig key,
+// with the name and type of the key of the keyring holding it, if any.
+func mkParticipantOutput(clientCtx client.Context, pk cryptotypes.PubKey) (KeyOutput, error) {
+	k, err := clientCtx.Keyring.KeyByAddress(pk.Address())
+	if errorsmod.IsOf(err, sdkerr.ErrKeyNotFound) {
+		ko, err := NewKeyOutput("", keyring.TypeOffline, pk.Address(), pk, clientCtx.AddressCodec)
+		ko.Type = ""
+		return ko, err
+	}
+	if err != nil {
+		return KeyOutput{}, err
+	}
+
+	return MkAccKeyOutput(k, clientCtx.AddressCodec)
+}
+
+func printMultisigKeyOutput(cmd *cobra.Command, out MultisigKeyOutput, outputFormat string) error {
+	var (
+		bz  []byte
+		err error
+	)
+	if outputFormat == flags.OutputFormatJSON {
+		bz, err = json.Marshal(out)
+	} else {
+		bz, err = yaml.Marshal(out)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+	return err
+}
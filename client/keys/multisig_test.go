@@ -0,0 +1,127 @@
+package keys
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func Test_multisigCommands(t *testing.T) {
+	kbHome := t.TempDir()
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil, cdc)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithCodec(cdc).
+		WithAddressCodec(addresscodec.NewBech32Codec("cosmos")).
+		WithValidatorAddressCodec(addresscodec.NewBech32Codec("cosmosvaloper")).
+		WithConsensusAddressCodec(addresscodec.NewBech32Codec("cosmosvalcons"))
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	for _, name := range []string{"key1", "key2", "key3", "key4"} {
+		_, _, err := kb.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+	}
+	// a participant which is not in the keyring
+	outsider := secp256k1.GenPrivKey().PubKey()
+	outsiderAddr, err := clientCtx.AddressCodec.BytesToString(outsider.Address())
+	require.NoError(t, err)
+	_, err = kb.SaveOfflineKey("outsider", outsider)
+	require.NoError(t, err)
+
+	run := func(cmd *cobra.Command, args ...string) (string, error) {
+		cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+		testutil.ApplyMockIODiscardOutErr(cmd)
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetArgs(append(args,
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+			fmt.Sprintf("--%s=%s", flags.FlagOutput, flags.OutputFormatJSON),
+		))
+		err := cmd.ExecuteContext(ctx)
+		return out.String(), err
+	}
+	show := func(name string) MultisigKeyOutput {
+		out, err := run(MultisigShowCommand(), name, "--participants")
+		require.NoError(t, err)
+		var ko MultisigKeyOutput
+		require.NoError(t, json.Unmarshal([]byte(out), &ko))
+		return ko
+	}
+	participantNames := func(ko MultisigKeyOutput) []string {
+		names := make([]string, len(ko.Participants))
+		for i, p := range ko.Participants {
+			names[i] = p.Name
+			if names[i] == "" {
+				names[i] = p.Address
+			}
+		}
+		return names
+	}
+
+	_, err = run(MultisigCreateCommand(), "multi", "key1", "key2", "outsider", "--threshold=4")
+	require.EqualError(t, err, "threshold k of n multisignature: 3 < 4")
+	_, err = run(MultisigCreateCommand(), "multi", "key1", "key1", "--threshold=1")
+	require.ErrorContains(t, err, "duplicate multisig member")
+	_, err = run(MultisigCreateCommand(), "multi", "key1", "key2", "outsider", "--threshold=2", "--nosort")
+	require.NoError(t, err)
+	require.NoError(t, kb.Delete("outsider"))
+
+	multi := show("multi")
+	require.Equal(t, "multi", multi.Name)
+	require.Equal(t, uint(2), multi.Threshold)
+	require.Equal(t, []string{"key1", "key2", outsiderAddr}, participantNames(multi))
+	require.Equal(t, keyring.TypeLocal.String(), multi.Participants[0].Type)
+	require.Empty(t, multi.Participants[2].Type)
+
+	// the participants are only shown with --participants
+	out, err := run(MultisigShowCommand(), "multi")
+	require.NoError(t, err)
+	require.NotContains(t, out, "participants")
+
+	_, err = run(MultisigShowCommand(), "key1")
+	require.ErrorIs(t, err, keyring.ErrNotMultisig)
+
+	_, err = run(MultisigRotateCommand(), "multi", "rotated", "--remove=key3")
+	require.EqualError(t, err, "key3 is not a participant of the multisig key")
+	_, err = run(MultisigRotateCommand(), "multi", "rotated", "--add=key2")
+	require.ErrorContains(t, err, "is already a multisig member")
+	_, err = run(MultisigRotateCommand(), "multi", "rotated", "--threshold=2", "--nosort")
+	require.EqualError(t, err, "the rotated multisig key is unchanged")
+
+	// the participant not in the keyring is removed by address
+	_, err = run(MultisigRotateCommand(), "multi", "rotated", "--remove=key1,"+outsiderAddr, "--add=key3,key4", "--threshold=3", "--nosort")
+	require.NoError(t, err)
+
+	rotated := show("rotated")
+	require.Equal(t, uint(3), rotated.Threshold)
+	require.Equal(t, []string{"key2", "key3", "key4"}, participantNames(rotated))
+	require.NotEqual(t, multi.Address, rotated.Address)
+
+	// the rotated key has the address of the same key created from scratch
+	_, err = run(MultisigCreateCommand(), "created", "key2", "key3", "key4", "--threshold=3", "--nosort")
+	require.NoError(t, err)
+	require.Equal(t, rotated.Address, show("created").Address)
+
+	// the original key is kept
+	require.Equal(t, multi, show("multi"))
+}
@@ -16,7 +16,7 @@ func Cmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra
 		ListSnapshotsCmd,
 		RestoreSnapshotCmd(appCreator),
 		ExportSnapshotCmd(appCreator),
-		DumpArchiveCmd(),
+		DumpArchiveCmd(appCreator),
 		LoadArchiveCmd(),
 		DeleteSnapshotCmd(),
 	)
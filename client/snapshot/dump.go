@@ -11,24 +11,35 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/log"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
 
-// DumpArchiveCmd returns a command to dump the snapshot as portable archive format
-func DumpArchiveCmd() *cobra.Command {
+const flagSkipModuleVersions = "skip-module-versions"
+
+// DumpArchiveCmd returns a command to dump the snapshot as portable archive format,
+// along with a manifest of the snapshot.
+func DumpArchiveCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "dump <height> <format>",
 		Short: "Dump the snapshot as portable archive format",
-		Args:  cobra.ExactArgs(2),
+		Long: `Dump the snapshot as portable archive format.
+
+The archive starts with a manifest of the snapshot, listing its chunk hashes and the module versions
+of the application state at the snapshot height, read from the application database unless
+--skip-module-versions is set, e.g. while the node is running.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			viper := client.GetViperFromCmd(cmd)
-			snapshotStore, err := server.GetSnapshotStore(viper)
+
+			output, err := cmd.Flags().GetString("output")
 			if err != nil {
 				return err
 			}
-
-			output, err := cmd.Flags().GetString("output")
+			skipModuleVersions, err := cmd.Flags().GetBool(flagSkipModuleVersions)
 			if err != nil {
 				return err
 			}
@@ -46,6 +57,20 @@ func DumpArchiveCmd() *cobra.Command {
 				output = fmt.Sprintf("%d-%d.tar.gz", height, format)
 			}
 
+			// the application is loaded first, as it opens the snapshot store as well
+			var moduleVersions map[string]uint64
+			if !skipModuleVersions {
+				moduleVersions, err = loadModuleVersions(cmd, appCreator, height)
+				if err != nil {
+					cmd.PrintErrf("module versions not included in the manifest: %v\n", err)
+				}
+			}
+
+			snapshotStore, err := server.GetSnapshotStore(viper)
+			if err != nil {
+				return err
+			}
+
 			snapshot, err := snapshotStore.Get(height, uint32(format))
 			if err != nil {
 				return err
@@ -55,6 +80,11 @@ func DumpArchiveCmd() *cobra.Command {
 				return errors.New("snapshot doesn't exist")
 			}
 
+			manifest, err := NewManifest(snapshot, moduleVersions).Marshal()
+			if err != nil {
+				return err
+			}
+
 			bz, err := snapshot.Marshal()
 			if err != nil {
 				return err
@@ -72,6 +102,16 @@ func DumpArchiveCmd() *cobra.Command {
 				return err
 			}
 			tarWriter := tar.NewWriter(gzipWriter)
+			if err := tarWriter.WriteHeader(&tar.Header{
+				Name: ManifestFileName,
+				Mode: 0o644,
+				Size: int64(len(manifest)),
+			}); err != nil {
+				return fmt.Errorf("failed to write manifest header to tar: %w", err)
+			}
+			if _, err := tarWriter.Write(manifest); err != nil {
+				return fmt.Errorf("failed to write manifest to tar: %w", err)
+			}
 			if err := tarWriter.WriteHeader(&tar.Header{
 				Name: SnapshotFileName,
 				Mode: 0o644,
@@ -104,10 +144,27 @@ func DumpArchiveCmd() *cobra.Command {
 	}
 
 	cmd.Flags().StringP("output", "o", "", "output file")
+	cmd.Flags().Bool(flagSkipModuleVersions, false, "Don't read the module versions from the application database")
 
 	return cmd
 }
 
+// loadModuleVersions returns the module versions of the application state at
+// the given height.
+func loadModuleVersions[T servertypes.Application](cmd *cobra.Command, appCreator servertypes.AppCreator[T], height uint64) (map[string]uint64, error) {
+	cfg := client.GetConfigFromCmd(cmd)
+	viper := client.GetViperFromCmd(cmd)
+
+	db, err := openDB(cfg.RootDir, server.GetAppDBBackend(viper))
+	if err != nil {
+		return nil, err
+	}
+	app := appCreator(log.NewNopLogger(), db, nil, viper)
+	defer func() { _ = app.Close() }()
+
+	return queryModuleVersions(app, height)
+}
+
 func processChunk(tarWriter *tar.Writer, path, tarName string) error {
 	file, err := os.Open(path)
 	if err != nil {
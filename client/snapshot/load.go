@@ -9,6 +9,7 @@ import (
 	"io"
 	"os"
 	"reflect"
+	"slices"
 	"strconv"
 
 	"github.com/spf13/cobra"
@@ -26,7 +27,11 @@ func LoadArchiveCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "load <archive-file>",
 		Short: "Load a snapshot archive file (.tar.gz) into snapshot store",
-		Args:  cobra.ExactArgs(1),
+		Long: `Load a snapshot archive file (.tar.gz) into snapshot store.
+
+The snapshot and its chunks are verified against the manifest of the archive, if any, whose module
+versions are printed.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			viper := client.GetViperFromCmd(cmd)
 			snapshotStore, err := server.GetSnapshotStore(viper)
@@ -54,6 +59,23 @@ func LoadArchiveCmd() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to read snapshot file header: %w", err)
 			}
+
+			// the archives dumped by previous versions don't have a manifest
+			var manifest *Manifest
+			if hdr.Name == ManifestFileName {
+				bz, err := io.ReadAll(tr)
+				if err != nil {
+					return fmt.Errorf("failed to read manifest file: %w", err)
+				}
+				if manifest, err = UnmarshalManifest(bz); err != nil {
+					return fmt.Errorf("failed to unmarshal manifest: %w", err)
+				}
+
+				hdr, err = tr.Next()
+				if err != nil {
+					return fmt.Errorf("failed to read snapshot file header: %w", err)
+				}
+			}
 			if hdr.Name != SnapshotFileName {
 				return fmt.Errorf("invalid archive, expect file: snapshot, got: %s", hdr.Name)
 			}
@@ -64,6 +86,11 @@ func LoadArchiveCmd() *cobra.Command {
 			if err := snapshot.Unmarshal(bz); err != nil {
 				return fmt.Errorf("failed to unmarshal snapshot: %w", err)
 			}
+			if manifest != nil {
+				if err := manifest.Validate(&snapshot); err != nil {
+					return fmt.Errorf("invalid archive: %w", err)
+				}
+			}
 
 			// make sure the channel is unbuffered, because the tar reader can't do concurrency
 			chunks := make(chan io.ReadCloser)
@@ -96,6 +123,16 @@ func LoadArchiveCmd() *cobra.Command {
 				if err != nil {
 					return fmt.Errorf("failed to read chunk file: %w", err)
 				}
+				if manifest != nil {
+					if err := manifest.VerifyChunk(i, bz); err != nil {
+						// the snapshot saved so far is incomplete
+						close(chunks)
+						if <-quitChan != nil {
+							_ = snapshotStore.Delete(snapshot.Height, snapshot.Format)
+						}
+						return fmt.Errorf("invalid archive: %w", err)
+					}
+				}
 				chunks <- io.NopCloser(bytes.NewReader(bz))
 			}
 			close(chunks)
@@ -110,6 +147,18 @@ func LoadArchiveCmd() *cobra.Command {
 				return errors.New("invalid archive, the saved snapshot is not equal to the original one")
 			}
 
+			if manifest != nil && len(manifest.ModuleVersions) > 0 {
+				names := make([]string, 0, len(manifest.ModuleVersions))
+				for name := range manifest.ModuleVersions {
+					names = append(names, name)
+				}
+				slices.Sort(names)
+				cmd.Printf("Loaded snapshot at height %d, format %d, module versions:\n", snapshot.Height, snapshot.Format)
+				for _, name := range names {
+					cmd.Printf("  %s: %d\n", name, manifest.ModuleVersions[name])
+				}
+			}
+
 			return nil
 		},
 	}
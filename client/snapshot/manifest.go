@@ -0,0 +1,138 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"google.golang.org/protobuf/proto"
+
+	upgradev1beta1 "cosmossdk.io/api/cosmos/upgrade/v1beta1"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	// ManifestFileName is the name of the manifest file of a snapshot archive,
+	// written before the snapshot file.
+	ManifestFileName = "_manifest.json"
+
+	moduleVersionsQueryPath = "/cosmos.upgrade.v1beta1.Query/ModuleVersions"
+)
+
+// Manifest describes the content of a snapshot archive, allowing operators to
+// check its integrity and the module versions of the state it contains before
+// loading it.
+type Manifest struct {
+	Height     uint64 `json:"height"`
+	Format     uint32 `json:"format"`
+	BaseHeight uint64 `json:"base_height,omitempty"`
+	// Hash is the hex encoded hash of the snapshot.
+	Hash string `json:"hash"`
+	// ChunkHashes are the hex encoded SHA-256 hashes of the snapshot chunks.
+	ChunkHashes []string `json:"chunk_hashes"`
+	// ModuleVersions are the consensus versions of the modules at the snapshot
+	// height, if known.
+	ModuleVersions map[string]uint64 `json:"module_versions,omitempty"`
+}
+
+// NewManifest returns the manifest of a snapshot.
+func NewManifest(snapshot *snapshottypes.Snapshot, moduleVersions map[string]uint64) *Manifest {
+	chunkHashes := make([]string, len(snapshot.Metadata.ChunkHashes))
+	for i, hash := range snapshot.Metadata.ChunkHashes {
+		chunkHashes[i] = hex.EncodeToString(hash)
+	}
+
+	return &Manifest{
+		Height:         snapshot.Height,
+		Format:         snapshot.Format,
+		BaseHeight:     snapshot.Metadata.BaseHeight,
+		Hash:           hex.EncodeToString(snapshot.Hash),
+		ChunkHashes:    chunkHashes,
+		ModuleVersions: moduleVersions,
+	}
+}
+
+// Validate checks that the manifest describes the given snapshot.
+func (m *Manifest) Validate(snapshot *snapshottypes.Snapshot) error {
+	expected := NewManifest(snapshot, nil)
+	switch {
+	case m.Height != expected.Height || m.Format != expected.Format || m.BaseHeight != expected.BaseHeight:
+		return fmt.Errorf("manifest of snapshot at height %d, format %d doesn't match snapshot at height %d, format %d",
+			m.Height, m.Format, snapshot.Height, snapshot.Format)
+	case m.Hash != expected.Hash:
+		return fmt.Errorf("snapshot hash mismatch, manifest: %s, snapshot: %s", m.Hash, expected.Hash)
+	case len(m.ChunkHashes) != int(snapshot.Chunks) || len(m.ChunkHashes) != len(expected.ChunkHashes):
+		return fmt.Errorf("manifest has %d chunk hashes, snapshot has %d chunks", len(m.ChunkHashes), snapshot.Chunks)
+	}
+
+	for i, hash := range m.ChunkHashes {
+		if hash != expected.ChunkHashes[i] {
+			return fmt.Errorf("chunk %d hash mismatch, manifest: %s, snapshot: %s", i, hash, expected.ChunkHashes[i])
+		}
+	}
+
+	return nil
+}
+
+// VerifyChunk checks the hash of the chunk of the given index.
+func (m *Manifest) VerifyChunk(index uint32, chunk []byte) error {
+	if int(index) >= len(m.ChunkHashes) {
+		return fmt.Errorf("unexpected chunk %d", index)
+	}
+
+	hash := sha256.Sum256(chunk)
+	if expected, err := hex.DecodeString(m.ChunkHashes[index]); err != nil || !bytes.Equal(hash[:], expected) {
+		return fmt.Errorf("chunk %d hash mismatch, manifest: %s, chunk: %X", index, m.ChunkHashes[index], hash)
+	}
+
+	return nil
+}
+
+// Marshal returns the indented JSON encoding of the manifest.
+func (m *Manifest) Marshal() ([]byte, error) {
+	return json.MarshalIndent(m, "", "  ")
+}
+
+// UnmarshalManifest decodes the JSON encoding of a manifest.
+func UnmarshalManifest(bz []byte) (*Manifest, error) {
+	var manifest Manifest
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return nil, err
+	}
+
+	return &manifest, nil
+}
+
+// queryModuleVersions returns the module versions of the application state at
+// the given height, as stored by the upgrade module.
+func queryModuleVersions(app servertypes.Application, height uint64) (map[string]uint64, error) {
+	res, err := app.Query(context.Background(), &abci.QueryRequest{
+		Path:   moduleVersionsQueryPath,
+		Height: int64(height),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return nil, errors.New(res.Log)
+	}
+
+	var versions upgradev1beta1.QueryModuleVersionsResponse
+	if err := proto.Unmarshal(res.Value, &versions); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal module versions: %w", err)
+	}
+
+	moduleVersions := make(map[string]uint64, len(versions.ModuleVersions))
+	for _, mv := range versions.ModuleVersions {
+		moduleVersions[mv.Name] = mv.Version
+	}
+
+	return moduleVersions, nil
+}
@@ -0,0 +1,50 @@
+package snapshot
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+)
+
+func TestManifest(t *testing.T) {
+	chunks := [][]byte{{1, 2, 3}, {4, 5, 6}}
+	snapshot := &snapshottypes.Snapshot{
+		Height: 10,
+		Format: snapshottypes.CurrentFormat,
+		Chunks: uint32(len(chunks)),
+		Hash:   []byte{0xab, 0xcd},
+	}
+	for _, chunk := range chunks {
+		hash := sha256.Sum256(chunk)
+		snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, hash[:])
+	}
+
+	bz, err := NewManifest(snapshot, map[string]uint64{"bank": 4, "staking": 5}).Marshal()
+	require.NoError(t, err)
+	manifest, err := UnmarshalManifest(bz)
+	require.NoError(t, err)
+	require.Equal(t, "abcd", manifest.Hash)
+	require.Equal(t, map[string]uint64{"bank": 4, "staking": 5}, manifest.ModuleVersions)
+
+	require.NoError(t, manifest.Validate(snapshot))
+	for i, chunk := range chunks {
+		require.NoError(t, manifest.VerifyChunk(uint32(i), chunk))
+	}
+	require.ErrorContains(t, manifest.VerifyChunk(0, chunks[1]), "chunk 0 hash mismatch")
+	require.ErrorContains(t, manifest.VerifyChunk(2, chunks[1]), "unexpected chunk 2")
+
+	other := *snapshot
+	other.Hash = []byte{0xab}
+	require.ErrorContains(t, manifest.Validate(&other), "snapshot hash mismatch")
+
+	other = *snapshot
+	other.Height = 11
+	require.Error(t, manifest.Validate(&other))
+
+	other = *snapshot
+	other.Metadata.ChunkHashes = [][]byte{snapshot.Metadata.ChunkHashes[1], snapshot.Metadata.ChunkHashes[0]}
+	require.ErrorContains(t, manifest.Validate(&other), "chunk 0 hash mismatch")
+}
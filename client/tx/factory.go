@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/go-bip39"
 	"github.com/spf13/pflag"
 
+	feegrantv1beta1 "cosmossdk.io/api/cosmos/feegrant/v1beta1"
 	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -36,6 +37,7 @@ type Factory struct {
 	timeoutHeight      uint64
 	timeoutTimestamp   time.Time
 	gasAdjustment      float64
+	gasRetries         uint64
 	chainID            string
 	fromName           string
 	unordered          bool
@@ -44,11 +46,13 @@ type Factory struct {
 	memo               string
 	fees               sdk.Coins
 	feeGranter         sdk.AccAddress
+	autoFeeGranter     bool
 	feePayer           sdk.AccAddress
 	gasPrices          sdk.DecCoins
 	extOptions         []*codectypes.Any
 	signMode           signing.SignMode
 	simulateAndExecute bool
+	traceGas           bool
 	preprocessTxHook   client.PreprocessTxFn
 }
 
@@ -76,12 +80,18 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 		signMode = signing.SignMode_SIGN_MODE_EIP_191
 	}
 
+	unordered := clientCtx.Viper.GetBool(flags.FlagUnordered)
+
 	var accNum, accSeq uint64
 	if clientCtx.Offline {
-		if flagSet.Changed(flags.FlagAccountNumber) && flagSet.Changed(flags.FlagSequence) {
+		switch {
+		case unordered && flagSet.Changed(flags.FlagAccountNumber):
+			// unordered transactions are signed with a zero sequence
+			accNum = clientCtx.Viper.GetUint64(flags.FlagAccountNumber)
+		case flagSet.Changed(flags.FlagAccountNumber) && flagSet.Changed(flags.FlagSequence):
 			accNum = clientCtx.Viper.GetUint64(flags.FlagAccountNumber)
 			accSeq = clientCtx.Viper.GetUint64(flags.FlagSequence)
-		} else {
+		default:
 			return Factory{}, errors.New("account-number and sequence must be set in offline mode")
 		}
 	}
@@ -90,7 +100,13 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 	memo := clientCtx.Viper.GetString(flags.FlagNote)
 	timestampUnix := clientCtx.Viper.GetInt64(flags.FlagTimeoutTimestamp)
 	timeoutTimestamp := time.Unix(timestampUnix, 0)
-	unordered := clientCtx.Viper.GetBool(flags.FlagUnordered)
+	if timeoutDuration := clientCtx.Viper.GetDuration(flags.FlagTimeoutDuration); timeoutDuration > 0 {
+		if timestampUnix != 0 {
+			return Factory{}, errors.New("cannot provide both timeout-timestamp and timeout-duration")
+		}
+		timeoutTimestamp = time.Now().Add(timeoutDuration)
+	}
+	traceGas := clientCtx.Viper.GetBool(flags.FlagTraceGas)
 
 	gasStr := clientCtx.Viper.GetString(flags.FlagGas)
 	gasSetting, _ := flags.ParseGasSetting(gasStr)
@@ -105,14 +121,17 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 		generateOnly:       clientCtx.GenerateOnly,
 		gas:                gasSetting.Gas,
 		simulateAndExecute: gasSetting.Simulate,
+		traceGas:           traceGas,
 		accountNumber:      accNum,
 		sequence:           accSeq,
 		timeoutTimestamp:   timeoutTimestamp,
 		unordered:          unordered,
 		gasAdjustment:      gasAdj,
+		gasRetries:         clientCtx.Viper.GetUint64(flags.FlagGasRetries),
 		memo:               memo,
 		signMode:           signMode,
 		feeGranter:         clientCtx.FeeGranter,
+		autoFeeGranter:     clientCtx.Viper.GetString(flags.FlagFeeGranter) == flags.FeeGranterAuto,
 		feePayer:           clientCtx.FeePayer,
 	}
 
@@ -146,6 +165,18 @@ func (f Factory) FromName() string                          { return f.fromName
 // using the gas from the simulation results
 func (f Factory) SimulateAndExecute() bool { return f.simulateAndExecute }
 
+// TraceGas returns the option to print the gas trace of the transaction when
+// simulating it
+func (f Factory) TraceGas() bool { return f.traceGas }
+
+// GasRetries returns the number of times a transaction running out of gas is
+// simulated again and broadcast with an increased gas adjustment
+func (f Factory) GasRetries() uint64 { return f.gasRetries }
+
+// AutoFeeGranter returns the option to select the fee granter of the transaction
+// among the fee allowances granted to its fee payer
+func (f Factory) AutoFeeGranter() bool { return f.autoFeeGranter }
+
 // WithTxConfig returns a copy of the Factory with an updated TxConfig.
 func (f Factory) WithTxConfig(g client.TxConfig) Factory {
 	f.txConfig = g
@@ -236,6 +267,19 @@ func (f Factory) WithSimulateAndExecute(sim bool) Factory {
 	return f
 }
 
+// WithTraceGas returns a copy of the Factory with an updated gas trace value.
+func (f Factory) WithTraceGas(traceGas bool) Factory {
+	f.traceGas = traceGas
+	return f
+}
+
+// WithGasRetries returns a copy of the Factory with an updated number of gas
+// retries.
+func (f Factory) WithGasRetries(retries uint64) Factory {
+	f.gasRetries = retries
+	return f
+}
+
 // SignMode returns the sign mode configured in the Factory
 func (f Factory) SignMode() signing.SignMode {
 	return f.signMode
@@ -271,6 +315,13 @@ func (f Factory) WithFeeGranter(fg sdk.AccAddress) Factory {
 	return f
 }
 
+// WithAutoFeeGranter returns a copy of the Factory with an updated automatic fee
+// granter selection option.
+func (f Factory) WithAutoFeeGranter(auto bool) Factory {
+	f.autoFeeGranter = auto
+	return f
+}
+
 // WithFeePayer returns a copy of the Factory with an updated fee granter.
 func (f Factory) WithFeePayer(fp sdk.AccAddress) Factory {
 	f.feePayer = fp
@@ -354,6 +405,10 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 		}
 	}
 
+	if f.unordered && (f.timeoutTimestamp.IsZero() || f.timeoutTimestamp.Unix() <= 0) {
+		return nil, errors.New("timeout timestamp must be set for unordered transactions")
+	}
+
 	// Prevent simple inclusion of a valid mnemonic in the memo field
 	if f.memo != "" && bip39.IsMnemonicValid(strings.ToLower(f.memo)) {
 		return nil, errors.New("cannot provide a valid mnemonic seed in the memo field")
@@ -386,6 +441,21 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 // simulated and also printed to the same writer before the transaction is
 // printed.
 func (f Factory) PrintUnsignedTx(clientCtx client.Context, msgs ...sdk.Msg) error {
+	var feeGrants []*feegrantv1beta1.Grant
+	if f.AutoFeeGranter() {
+		if clientCtx.Offline {
+			return errors.New("cannot select a fee granter in offline mode")
+		}
+
+		var err error
+		if feeGrants, err = queryFeePayerAllowances(clientCtx); err != nil {
+			return err
+		}
+		if f, err = selectFeeGranter(clientCtx, f, feeGrants, f.Fees(), msgs); err != nil {
+			return err
+		}
+	}
+
 	if f.SimulateAndExecute() {
 		if clientCtx.Offline {
 			return errors.New("cannot estimate gas in offline mode")
@@ -405,9 +475,15 @@ func (f Factory) PrintUnsignedTx(clientCtx client.Context, msgs ...sdk.Msg) erro
 
 		f = f.WithGas(adjusted)
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: f.Gas()})
+
+		if f.TraceGas() {
+			if err := printGasTrace(clientCtx, preparedTxf, msgs...); err != nil {
+				return err
+			}
+		}
 	}
 
-	unsignedTx, err := f.BuildUnsignedTx(msgs...)
+	_, unsignedTx, err := buildUnsignedTx(clientCtx, f, feeGrants, msgs...)
 	if err != nil {
 		return err
 	}
@@ -506,7 +582,8 @@ func (f Factory) getSimSignatureData(pk cryptotypes.PubKey) signing.SignatureDat
 
 // Prepare ensures the account defined by ctx.GetFromAddress() exists and
 // if the account number and/or the account sequence number are zero (not set),
-// they will be queried for and set on the provided Factory.
+// they will be queried for and set on the provided Factory. The sequence of an
+// unordered transaction is always zero.
 // A new Factory with the updated fields will be returned.
 // Note: When in offline mode, the Prepare does nothing and returns the original factory.
 func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
@@ -517,8 +594,15 @@ func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 	fc := f
 	from := clientCtx.FromAddress
 
+	// unordered transactions are signed with a zero sequence, so that concurrent
+	// transactions of the account don't race for its sequence
+	if fc.unordered {
+		fc = fc.WithSequence(0)
+	}
+
 	initNum, initSeq := fc.accountNumber, fc.sequence
-	if initNum == 0 || initSeq == 0 {
+	fetchSeq := initSeq == 0 && !fc.unordered
+	if initNum == 0 || fetchSeq {
 		num, seq, err := fc.accountRetriever.GetAccountNumberSequence(clientCtx, from)
 		if err != nil {
 			return fc, err
@@ -528,7 +612,7 @@ func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 			fc = fc.WithAccountNumber(num)
 		}
 
-		if initSeq == 0 {
+		if fetchSeq {
 			fc = fc.WithSequence(seq)
 		}
 	}
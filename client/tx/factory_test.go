@@ -41,6 +41,13 @@ func TestFactoryPrepare(t *testing.T) {
 	require.NotEqual(t, output, factory)
 	require.Equal(t, output.AccountNumber(), uint64(10))
 	require.Equal(t, output.Sequence(), uint64(1))
+
+	// the sequence of unordered transactions is always zero
+	factory = Factory{}.WithAccountRetriever(client.MockAccountRetriever{ReturnAccNum: 10, ReturnAccSeq: 1}).WithUnordered(true).WithSequence(3)
+	output, err = factory.Prepare(clientCtx.WithFrom("foo"))
+	require.NoError(t, err)
+	require.Equal(t, output.AccountNumber(), uint64(10))
+	require.Equal(t, output.Sequence(), uint64(0))
 }
 
 func TestFactory_getSimPKType(t *testing.T) {
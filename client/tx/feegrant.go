@@ -0,0 +1,153 @@
+package tx
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	basev1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	coinv1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	feegrantv1beta1 "cosmossdk.io/api/cosmos/feegrant/v1beta1"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QueryFeeAllowances returns the fee allowances granted to the given grantee.
+func QueryFeeAllowances(clientCtx client.Context, grantee string) ([]*feegrantv1beta1.Grant, error) {
+	var (
+		grants []*feegrantv1beta1.Grant
+		key    []byte
+	)
+	for {
+		req, err := proto.Marshal(&feegrantv1beta1.QueryAllowancesRequest{
+			Grantee:    grantee,
+			Pagination: &basev1beta1.PageRequest{Key: key},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		bz, _, err := clientCtx.QueryWithData(feegrantv1beta1.Query_Allowances_FullMethodName, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query the fee allowances of %s: %w", grantee, err)
+		}
+
+		var res feegrantv1beta1.QueryAllowancesResponse
+		if err := proto.Unmarshal(bz, &res); err != nil {
+			return nil, err
+		}
+		grants = append(grants, res.Allowances...)
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return grants, nil
+		}
+		key = res.Pagination.NextKey
+	}
+}
+
+// SelectFeeGranter returns the granter of the first of the given fee allowances
+// which is not expired at the given time, allows all the messages, and whose
+// spend limit, if any, covers the fees.
+func SelectFeeGranter(
+	grants []*feegrantv1beta1.Grant, msgs []sdk.Msg, fees sdk.Coins, now time.Time,
+) (string, error) {
+	msgTypeURLs := make([]string, len(msgs))
+	for i, msg := range msgs {
+		msgTypeURLs[i] = sdk.MsgTypeURL(msg)
+	}
+
+	for _, grant := range grants {
+		ok, err := allowanceCovers(grant.Allowance, msgTypeURLs, fees, now)
+		if err != nil {
+			return "", fmt.Errorf("invalid fee allowance of %s: %w", grant.Granter, err)
+		}
+		if ok {
+			return grant.Granter, nil
+		}
+	}
+
+	return "", fmt.Errorf("no fee allowance covers fees %s of messages %v", fees, msgTypeURLs)
+}
+
+// allowanceCovers reports whether a fee allowance is not expired at the given
+// time, allows the given messages and covers the given fees. The allowances of
+// an unknown type are not used.
+func allowanceCovers(allowance *anypb.Any, msgTypeURLs []string, fees sdk.Coins, now time.Time) (bool, error) {
+	if allowance == nil {
+		return false, nil
+	}
+
+	switch allowance.MessageName() {
+	case "cosmos.feegrant.v1beta1.BasicAllowance":
+		var basic feegrantv1beta1.BasicAllowance
+		if err := allowance.UnmarshalTo(&basic); err != nil {
+			return false, err
+		}
+		return basicAllowanceCovers(&basic, fees, now)
+
+	case "cosmos.feegrant.v1beta1.PeriodicAllowance":
+		var periodic feegrantv1beta1.PeriodicAllowance
+		if err := allowance.UnmarshalTo(&periodic); err != nil {
+			return false, err
+		}
+		if periodic.Basic != nil {
+			if ok, err := basicAllowanceCovers(periodic.Basic, fees, now); !ok || err != nil {
+				return false, err
+			}
+		}
+
+		// the spend limit of the period is reset once the period is over
+		canSpend := periodic.PeriodCanSpend
+		if periodic.PeriodReset == nil || !now.Before(periodic.PeriodReset.AsTime()) {
+			canSpend = periodic.PeriodSpendLimit
+		}
+		return spendLimitCovers(canSpend, fees)
+
+	case "cosmos.feegrant.v1beta1.AllowedMsgAllowance":
+		var allowed feegrantv1beta1.AllowedMsgAllowance
+		if err := allowance.UnmarshalTo(&allowed); err != nil {
+			return false, err
+		}
+		for _, typeURL := range msgTypeURLs {
+			if !slices.Contains(allowed.AllowedMessages, typeURL) {
+				return false, nil
+			}
+		}
+		return allowanceCovers(allowed.Allowance, msgTypeURLs, fees, now)
+
+	default:
+		return false, nil
+	}
+}
+
+func basicAllowanceCovers(basic *feegrantv1beta1.BasicAllowance, fees sdk.Coins, now time.Time) (bool, error) {
+	if basic.Expiration != nil && !now.Before(basic.Expiration.AsTime()) {
+		return false, nil
+	}
+
+	return spendLimitCovers(basic.SpendLimit, fees)
+}
+
+// spendLimitCovers reports whether a spend limit covers the given fees. An empty
+// spend limit has no limit.
+func spendLimitCovers(limit []*coinv1beta1.Coin, fees sdk.Coins) (bool, error) {
+	if len(limit) == 0 {
+		return true, nil
+	}
+
+	coins := make(sdk.Coins, 0, len(limit))
+	for _, coin := range limit {
+		amount, ok := math.NewIntFromString(coin.Amount)
+		if !ok {
+			return false, fmt.Errorf("invalid spend limit amount %q", coin.Amount)
+		}
+		coins = append(coins, sdk.NewCoin(coin.Denom, amount))
+	}
+
+	return fees.IsAllLTE(coins.Sort()), nil
+}
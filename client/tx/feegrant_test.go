@@ -0,0 +1,132 @@
+package tx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	coinv1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	feegrantv1beta1 "cosmossdk.io/api/cosmos/feegrant/v1beta1"
+
+	countertypes "github.com/cosmos/cosmos-sdk/testutil/x/counter/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestSelectFeeGranter(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	msg := &countertypes.MsgIncreaseCounter{Signer: sdk.AccAddress("from").String(), Count: 1}
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	pack := func(m proto.Message) *anypb.Any {
+		a, err := anypb.New(m)
+		require.NoError(t, err)
+		return a
+	}
+	limit := func(amount string) []*coinv1beta1.Coin {
+		return []*coinv1beta1.Coin{{Denom: "stake", Amount: amount}}
+	}
+
+	testCases := []struct {
+		name      string
+		allowance proto.Message
+		covers    bool
+	}{
+		{
+			name:      "basic without limit",
+			allowance: &feegrantv1beta1.BasicAllowance{},
+			covers:    true,
+		},
+		{
+			name:      "basic with enough spend limit",
+			allowance: &feegrantv1beta1.BasicAllowance{SpendLimit: limit("100")},
+			covers:    true,
+		},
+		{
+			name:      "basic with insufficient spend limit",
+			allowance: &feegrantv1beta1.BasicAllowance{SpendLimit: limit("99")},
+		},
+		{
+			name:      "basic expired",
+			allowance: &feegrantv1beta1.BasicAllowance{Expiration: timestamppb.New(now)},
+		},
+		{
+			name: "periodic with enough period spend",
+			allowance: &feegrantv1beta1.PeriodicAllowance{
+				Basic:            &feegrantv1beta1.BasicAllowance{},
+				PeriodSpendLimit: limit("1000"),
+				PeriodCanSpend:   limit("100"),
+				PeriodReset:      timestamppb.New(now.Add(time.Hour)),
+			},
+			covers: true,
+		},
+		{
+			name: "periodic with insufficient period spend",
+			allowance: &feegrantv1beta1.PeriodicAllowance{
+				Basic:            &feegrantv1beta1.BasicAllowance{},
+				PeriodSpendLimit: limit("1000"),
+				PeriodCanSpend:   limit("50"),
+				PeriodReset:      timestamppb.New(now.Add(time.Hour)),
+			},
+		},
+		{
+			name: "periodic with a period to reset",
+			allowance: &feegrantv1beta1.PeriodicAllowance{
+				Basic:            &feegrantv1beta1.BasicAllowance{},
+				PeriodSpendLimit: limit("1000"),
+				PeriodCanSpend:   limit("50"),
+				PeriodReset:      timestamppb.New(now.Add(-time.Hour)),
+			},
+			covers: true,
+		},
+		{
+			name: "allowed message",
+			allowance: &feegrantv1beta1.AllowedMsgAllowance{
+				Allowance:       pack(&feegrantv1beta1.BasicAllowance{}),
+				AllowedMessages: []string{sdk.MsgTypeURL(msg)},
+			},
+			covers: true,
+		},
+		{
+			name: "not allowed message",
+			allowance: &feegrantv1beta1.AllowedMsgAllowance{
+				Allowance:       pack(&feegrantv1beta1.BasicAllowance{}),
+				AllowedMessages: []string{"/cosmos.bank.v1beta1.MsgSend"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			grants := []*feegrantv1beta1.Grant{{Granter: "granter", Allowance: pack(tc.allowance)}}
+			granter, err := SelectFeeGranter(grants, []sdk.Msg{msg}, fees, now)
+			if !tc.covers {
+				require.ErrorContains(t, err, "no fee allowance covers fees")
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "granter", granter)
+		})
+	}
+
+	// the first allowance covering the fees is selected
+	grants := []*feegrantv1beta1.Grant{
+		{Granter: "granter1", Allowance: pack(&feegrantv1beta1.BasicAllowance{SpendLimit: limit("10")})},
+		{Granter: "granter2", Allowance: pack(&feegrantv1beta1.BasicAllowance{})},
+		{Granter: "granter3", Allowance: pack(&feegrantv1beta1.BasicAllowance{})},
+	}
+	granter, err := SelectFeeGranter(grants, []sdk.Msg{msg}, fees, now)
+	require.NoError(t, err)
+	require.Equal(t, "granter2", granter)
+}
+
+func TestIsOutOfGas(t *testing.T) {
+	require.True(t, isOutOfGas(&sdk.TxResponse{Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrOutOfGas.ABCICode()}))
+	require.False(t, isOutOfGas(&sdk.TxResponse{Codespace: "bank", Code: sdkerrors.ErrOutOfGas.ABCICode()}))
+	require.False(t, isOutOfGas(&sdk.TxResponse{Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrInsufficientFee.ABCICode()}))
+	require.False(t, isOutOfGas(&sdk.TxResponse{}))
+}
@@ -7,13 +7,16 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/spf13/pflag"
 
+	feegrantv1beta1 "cosmossdk.io/api/cosmos/feegrant/v1beta1"
 	authsigning "cosmossdk.io/x/auth/signing"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -68,7 +71,10 @@ func GenerateOrBroadcastTxWithFactory(clientCtx client.Context, txf Factory, msg
 }
 
 // BroadcastTx attempts to generate, sign and broadcast a transaction with the
-// given set of messages. It will also simulate gas requirements if necessary.
+// given set of messages. It will also simulate gas requirements if necessary,
+// and select the fee granter if automatic. If the transaction simulated runs
+// out of gas once broadcast, it is simulated again and broadcast with a higher
+// gas adjustment, up to the number of gas retries of the factory.
 // It will return an error upon failure.
 func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 	txf, err := txf.Prepare(clientCtx)
@@ -76,25 +82,43 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
+	var feeGrants []*feegrantv1beta1.Grant
+	if txf.AutoFeeGranter() {
+		if clientCtx.Offline {
+			return errors.New("cannot select a fee granter in offline mode")
+		}
+
+		if feeGrants, err = queryFeePayerAllowances(clientCtx); err != nil {
+			return err
+		}
+
+		// the fee granter is selected before the simulation, which pays its fees
+		if txf, err = selectFeeGranter(clientCtx, txf, feeGrants, txf.Fees(), msgs); err != nil {
+			return err
+		}
+	}
+
 	if txf.SimulateAndExecute() || clientCtx.Simulate {
 		if clientCtx.Offline {
 			return errors.New("cannot estimate gas in offline mode")
 		}
 
-		_, adjusted, err := CalculateGas(clientCtx, txf, msgs...)
-		if err != nil {
+		if txf, err = estimateGas(clientCtx, txf, msgs...); err != nil {
 			return err
 		}
 
-		txf = txf.WithGas(adjusted)
-		_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: txf.Gas()})
+		if txf.TraceGas() {
+			if err := printGasTrace(clientCtx, txf, msgs...); err != nil {
+				return err
+			}
+		}
 	}
 
 	if clientCtx.Simulate {
 		return nil
 	}
 
-	tx, err := txf.BuildUnsignedTx(msgs...)
+	txf, tx, err := buildUnsignedTx(clientCtx, txf, feeGrants, msgs...)
 	if err != nil {
 		return err
 	}
@@ -126,22 +150,113 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		}
 	}
 
-	if err = Sign(clientCtx.CmdContext, txf, clientCtx.FromName, tx, true); err != nil {
-		return err
+	for retry := uint64(0); ; retry++ {
+		if err = Sign(clientCtx.CmdContext, txf, clientCtx.FromName, tx, true); err != nil {
+			return err
+		}
+
+		txBytes, err := clientCtx.TxConfig.TxEncoder()(tx.GetTx())
+		if err != nil {
+			return err
+		}
+
+		// broadcast to a CometBFT node
+		res, err := clientCtx.BroadcastTx(txBytes)
+		if err != nil {
+			return err
+		}
+
+		if !txf.SimulateAndExecute() || retry >= txf.GasRetries() || !isOutOfGas(res) {
+			return clientCtx.PrintProto(res)
+		}
+
+		// the state changed since the simulation, which is done again with a
+		// higher gas adjustment
+		txf = txf.WithGasAdjustment(txf.GasAdjustment() * flags.GasRetryMultiplier)
+		_, _ = fmt.Fprintf(os.Stderr, "transaction ran out of gas, retrying with gas adjustment %g\n", txf.GasAdjustment())
+
+		if txf, err = estimateGas(clientCtx, txf, msgs...); err != nil {
+			return err
+		}
+		if txf, tx, err = buildUnsignedTx(clientCtx, txf, feeGrants, msgs...); err != nil {
+			return err
+		}
 	}
+}
 
-	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx.GetTx())
+// estimateGas returns the factory with the gas estimated by simulating the
+// transaction, printing the estimate to stderr.
+func estimateGas(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) (Factory, error) {
+	_, adjusted, err := CalculateGas(clientCtx, txf, msgs...)
 	if err != nil {
-		return err
+		return txf, err
 	}
 
-	// broadcast to a CometBFT node
-	res, err := clientCtx.BroadcastTx(txBytes)
+	txf = txf.WithGas(adjusted)
+	_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: txf.Gas()})
+
+	return txf, nil
+}
+
+// buildUnsignedTx builds the unsigned transaction of the factory. If the fee
+// granter is automatic, it is selected again for the fees of the transaction,
+// which may be derived from the estimated gas and the gas prices.
+func buildUnsignedTx(
+	clientCtx client.Context, txf Factory, feeGrants []*feegrantv1beta1.Grant, msgs ...sdk.Msg,
+) (Factory, client.TxBuilder, error) {
+	tx, err := txf.BuildUnsignedTx(msgs...)
 	if err != nil {
-		return err
+		return txf, nil, err
 	}
 
-	return clientCtx.PrintProto(res)
+	if txf.AutoFeeGranter() {
+		if txf, err = selectFeeGranter(clientCtx, txf, feeGrants, tx.GetTx().GetFee(), msgs); err != nil {
+			return txf, nil, err
+		}
+		tx.SetFeeGranter(txf.feeGranter)
+	}
+
+	return txf, tx, nil
+}
+
+// queryFeePayerAllowances returns the fee allowances granted to the fee payer
+// of the transaction, i.e. its first signer unless set.
+func queryFeePayerAllowances(clientCtx client.Context) ([]*feegrantv1beta1.Grant, error) {
+	feePayer := clientCtx.FeePayer
+	if feePayer == nil {
+		feePayer = clientCtx.FromAddress
+	}
+
+	grantee, err := clientCtx.AddressCodec.BytesToString(feePayer)
+	if err != nil {
+		return nil, err
+	}
+
+	return QueryFeeAllowances(clientCtx, grantee)
+}
+
+// selectFeeGranter returns the factory with the granter of the first fee
+// allowance covering the messages and fees.
+func selectFeeGranter(
+	clientCtx client.Context, txf Factory, feeGrants []*feegrantv1beta1.Grant, fees sdk.Coins, msgs []sdk.Msg,
+) (Factory, error) {
+	granter, err := SelectFeeGranter(feeGrants, msgs, fees, time.Now())
+	if err != nil {
+		return txf, err
+	}
+
+	granterAddr, err := clientCtx.AddressCodec.StringToBytes(granter)
+	if err != nil {
+		return txf, err
+	}
+
+	return txf.WithFeeGranter(granterAddr), nil
+}
+
+// isOutOfGas returns whether the transaction of a broadcast response ran out of
+// gas.
+func isOutOfGas(res *sdk.TxResponse) bool {
+	return res.Codespace == sdkerrors.RootCodespace && res.Code == sdkerrors.ErrOutOfGas.ABCICode()
 }
 
 // CalculateGas simulates the execution of a transaction and returns the
@@ -165,6 +280,43 @@ func CalculateGas(
 	return simRes, uint64(txf.GasAdjustment() * float64(simRes.GasInfo.GasUsed)), nil
 }
 
+// QueryGasTrace simulates the execution of a transaction and returns the
+// breakdown of the gas it consumed.
+func QueryGasTrace(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) (*sdk.GasTrace, error) {
+	txBytes, err := txf.BuildSimTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, _, err := clientCtx.QueryWithData("/app/simulate_gas_trace", txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var trace sdk.GasTrace
+	if err := json.Unmarshal(bz, &trace); err != nil {
+		return nil, fmt.Errorf("failed to decode gas trace: %w", err)
+	}
+
+	return &trace, nil
+}
+
+// printGasTrace prints the gas trace of the transaction to stderr.
+func printGasTrace(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
+	trace, err := QueryGasTrace(clientCtx, txf, msgs...)
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.MarshalIndent(trace, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr, "%s\n", bz)
+	return nil
+}
+
 // SignWithPrivKey signs a given tx with the given private key, and returns the
 // corresponding SignatureV2 if the signing is successful.
 func SignWithPrivKey(
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -156,6 +157,21 @@ func TestBuildUnsignedTx(t *testing.T) {
 	require.Empty(t, sigs)
 }
 
+func TestBuildUnsignedTx_Unordered(t *testing.T) {
+	txConfig, _ := newTestTxConfig()
+	txf := mockTxFactory(txConfig).WithUnordered(true)
+	msg := &countertypes.MsgIncreaseCounter{Signer: sdk.AccAddress("from").String(), Count: 1}
+
+	_, err := txf.BuildUnsignedTx(msg)
+	require.ErrorContains(t, err, "timeout timestamp must be set for unordered transactions")
+
+	timeout := time.Unix(time.Now().Add(time.Minute).Unix(), 0)
+	tx, err := txf.WithTimeoutTimestamp(timeout).BuildUnsignedTx(msg)
+	require.NoError(t, err)
+	require.True(t, tx.GetTx().(sdk.TxWithUnordered).GetUnordered())
+	require.Equal(t, timeout, tx.GetTx().(sdk.TxWithUnordered).GetTimeoutTimeStamp().Local())
+}
+
 func TestBuildUnsignedTxWithWithExtensionOptions(t *testing.T) {
 	txCfg := moduletestutil.MakeBuilderTestTxConfig(testutil.CodecOptions{})
 	extOpts := []*codectypes.Any{
@@ -0,0 +1,35 @@
+package appmodule
+
+// Hook identifies a module hook whose call order across modules is defined by
+// the app. The values match the names of the runtime config ordering fields.
+type Hook string
+
+const (
+	HookPreBlocker        Hook = "pre_blockers"
+	HookBeginBlocker      Hook = "begin_blockers"
+	HookEndBlocker        Hook = "end_blockers"
+	HookInitGenesis       Hook = "init_genesis"
+	HookExportGenesis     Hook = "export_genesis"
+	HookPrecommit         Hook = "precommiters"
+	HookPrepareCheckState Hook = "prepare_check_staters"
+)
+
+// OrderingConstraint declares that the given hook of a module must be called
+// after and/or before the same hook of other modules. Constraints referencing
+// modules which are not part of the app, or which do not implement the hook,
+// are ignored.
+type OrderingConstraint struct {
+	Hook   Hook
+	After  []string
+	Before []string
+}
+
+// HasOrderingConstraints is the extension interface that modules can implement
+// to declare ordering dependencies between their hooks and the hooks of other
+// modules, so that the app does not have to maintain explicit ordering lists.
+type HasOrderingConstraints interface {
+	AppModule
+
+	// OrderingConstraints returns the ordering constraints of the module hooks.
+	OrderingConstraints() []OrderingConstraint
+}
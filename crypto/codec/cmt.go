@@ -3,6 +3,7 @@ package codec
 import (
 	cmtprotocrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
 	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	cmtbls12381 "github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/encoding"
 
 	"cosmossdk.io/errors"
@@ -79,3 +80,13 @@ func ToCmtPubKeyInterface(pk cryptotypes.PubKey) (cmtcrypto.PubKey, error) {
 
 	return encoding.PubKeyFromProto(tmProtoPk)
 }
+
+// ToCmtPubKeyType returns the type of our own PubKey as known by CMT, e.g. in the
+// validator updates and the consensus params.
+func ToCmtPubKeyType(pk cryptotypes.PubKey) string {
+	if _, ok := pk.(*bls12_381.PubKey); ok {
+		return cmtbls12381.KeyType
+	}
+
+	return pk.Type()
+}
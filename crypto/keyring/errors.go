@@ -35,4 +35,10 @@ var (
 	ErrLegacyToRecord = errors.New("unable to convert LegacyInfo to Record")
 	// ErrUnknownLegacyType is raised when a LegacyInfo type is unknown.
 	ErrUnknownLegacyType = errors.New("unknown LegacyInfo type")
+	// ErrNotMultisig is raised when a record is not a multisig record.
+	ErrNotMultisig = errors.New("not a multisig key")
+	// ErrUnknownExternalSigner is raised when an external signer is neither set in the options nor found as a plugin.
+	ErrUnknownExternalSigner = errors.New("unknown external signer")
+	// ErrExternalInvalidSignature is raised when an external signer generates an invalid signature.
+	ErrExternalInvalidSignature = errors.New("external signer generated an invalid signature")
 )
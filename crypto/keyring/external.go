@@ -0,0 +1,196 @@
+package keyring
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// PluginSignerPrefix is the prefix of the name of the executables resolved as
+// the plugin signers of the external signers not set in the keyring options,
+// e.g. the external signer "pkcs11" is resolved as the executable
+// "cosmos-signer-pkcs11" in the PATH.
+const PluginSignerPrefix = "cosmos-signer-"
+
+// ExternalSigner signs with keys held outside of the keyring, such as in a
+// PKCS#11 hardware security module or a remote key management service, so that
+// the private keys never leave the device or service holding them.
+type ExternalSigner interface {
+	// PubKey returns the public key of the key of the given ID.
+	PubKey(keyID string) (types.PubKey, error)
+
+	// Sign signs a message with the key of the given ID, and returns the
+	// signature in the format of the key type, e.g. the 64-byte R || S
+	// encoding with a low S for secp256k1 keys.
+	Sign(keyID string, msg []byte, signMode signing.SignMode) ([]byte, error)
+}
+
+// PluginSigner is an ExternalSigner delegating to an executable, which allows
+// to support any device or service without linking its libraries.
+//
+// The executable is run once per operation with a JSON request on its stdin,
+// and writes a JSON response on its stdout:
+//
+//	{"method": "pubkey", "key_id": "..."}
+//	-> {"pub_key_type": "secp256k1", "pub_key": "<base64 compressed public key>"}
+//
+//	{"method": "sign", "key_id": "...", "sign_mode": "SIGN_MODE_DIRECT", "msg": "<base64>"}
+//	-> {"signature": "<base64>"}
+//
+// A response with a non-empty "error" field reports a failure. The only
+// supported public key type is secp256k1.
+type PluginSigner struct {
+	path string
+	args []string
+}
+
+var _ ExternalSigner = PluginSigner{}
+
+// NewPluginSigner returns a PluginSigner running the executable at the given
+// path with the given arguments.
+func NewPluginSigner(path string, args ...string) PluginSigner {
+	return PluginSigner{path: path, args: args}
+}
+
+type pluginRequest struct {
+	Method   string `json:"method"`
+	KeyID    string `json:"key_id"`
+	SignMode string `json:"sign_mode,omitempty"`
+	Msg      []byte `json:"msg,omitempty"`
+}
+
+type pluginResponse struct {
+	PubKeyType string `json:"pub_key_type,omitempty"`
+	PubKey     []byte `json:"pub_key,omitempty"`
+	Signature  []byte `json:"signature,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// PubKey implements ExternalSigner.
+func (s PluginSigner) PubKey(keyID string) (types.PubKey, error) {
+	res, err := s.call(pluginRequest{Method: "pubkey", KeyID: keyID})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.PubKeyType != string(hd.Secp256k1Type) {
+		return nil, errorsmod.Wrap(ErrUnsupportedSigningAlgo, res.PubKeyType)
+	}
+	if len(res.PubKey) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("invalid secp256k1 public key size %d", len(res.PubKey))
+	}
+
+	return &secp256k1.PubKey{Key: res.PubKey}, nil
+}
+
+// Sign implements ExternalSigner.
+func (s PluginSigner) Sign(keyID string, msg []byte, signMode signing.SignMode) ([]byte, error) {
+	res, err := s.call(pluginRequest{Method: "sign", KeyID: keyID, SignMode: signMode.String(), Msg: msg})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Signature, nil
+}
+
+func (s PluginSigner) call(req pluginRequest) (*pluginResponse, error) {
+	bz, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(s.path, s.args...)
+	cmd.Stdin = bytes.NewReader(bz)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("signer plugin %s failed: %w: %s", s.path, err, strings.TrimSpace(stderr.String()))
+	}
+
+	res := &pluginResponse{}
+	if err := json.Unmarshal(stdout.Bytes(), res); err != nil {
+		return nil, fmt.Errorf("invalid response of signer plugin %s: %w", s.path, err)
+	}
+	if res.Error != "" {
+		return nil, fmt.Errorf("signer plugin %s: %s", s.path, res.Error)
+	}
+
+	return res, nil
+}
+
+// externalSigner returns the external signer of the given name, set in the
+// keyring options or else resolved as a plugin signer.
+func (ks keystore) externalSigner(name string) (ExternalSigner, error) {
+	if signer, ok := ks.options.ExternalSigners[name]; ok {
+		return signer, nil
+	}
+
+	path, err := exec.LookPath(PluginSignerPrefix + name)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrUnknownExternalSigner, "%s: %v", name, err)
+	}
+
+	return NewPluginSigner(path), nil
+}
+
+// SaveExternalKey retrieves the public key of a key held by an external signer
+// and persists a reference to it.
+func (ks keystore) SaveExternalKey(uid, signerName, keyID string) (*Record, error) {
+	signer, err := ks.externalSigner(signerName)
+	if err != nil {
+		return nil, err
+	}
+
+	pk, err := signer.PubKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := NewExternalRecord(uid, pk, signerName, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return k, ks.writeRecord(k)
+}
+
+// signWithExternal signs a message with the external signer of a record, and
+// checks the signature against the public key of the record.
+func (ks keystore) signWithExternal(k *Record, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	external := k.GetExternal()
+	if external == nil {
+		return nil, nil, errors.New("not an external key")
+	}
+
+	pub, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signer, err := ks.externalSigner(external.Signer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig, err := signer.Sign(external.KeyId, msg, signMode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !pub.VerifySignature(msg, sig) {
+		return nil, nil, ErrExternalInvalidSignature
+	}
+
+	return sig, pub, nil
+}
@@ -0,0 +1,113 @@
+package keyring
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+type testExternalSigner struct {
+	keys map[string]types.PrivKey
+}
+
+func (s testExternalSigner) PubKey(keyID string) (types.PubKey, error) {
+	priv, ok := s.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found", keyID)
+	}
+
+	return priv.PubKey(), nil
+}
+
+func (s testExternalSigner) Sign(keyID string, msg []byte, _ signing.SignMode) ([]byte, error) {
+	priv, ok := s.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found", keyID)
+	}
+
+	return priv.Sign(msg)
+}
+
+func TestExternalKey(t *testing.T) {
+	hsmKey := secp256k1.GenPrivKey()
+	signer := testExternalSigner{keys: map[string]types.PrivKey{"key1": hsmKey}}
+	kr := NewInMemory(getCodec(), func(options *Options) {
+		options.ExternalSigners = map[string]ExternalSigner{"hsm": signer}
+	})
+
+	k, err := kr.SaveExternalKey("validator", "hsm", "key1")
+	require.NoError(t, err)
+	require.Equal(t, TypeExternal, k.GetType())
+	require.Equal(t, "hsm", k.GetExternal().Signer)
+	require.Equal(t, "key1", k.GetExternal().KeyId)
+
+	k, err = kr.Key("validator")
+	require.NoError(t, err)
+	pub, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, hsmKey.PubKey().Equals(pub))
+
+	msg := []byte("message")
+	sig, signPub, err := kr.Sign("validator", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pub.Equals(signPub))
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// the signature of another key is rejected
+	signer.keys["key1"] = secp256k1.GenPrivKey()
+	_, _, err = kr.Sign("validator", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrExternalInvalidSignature)
+
+	_, err = kr.SaveExternalKey("other", "hsm", "unknown")
+	require.ErrorContains(t, err, "key unknown not found")
+
+	_, err = kr.SaveExternalKey("other", "unknown", "key1")
+	require.ErrorIs(t, err, ErrUnknownExternalSigner)
+}
+
+func TestPluginSigner(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test plugin is a shell script")
+	}
+
+	priv := secp256k1.GenPrivKey()
+	msg := []byte("message")
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+
+	// the plugin answers with a fixed public key and signature
+	dir := t.TempDir()
+	script := fmt.Sprintf(`#!/bin/sh
+read -r req
+case "$req" in
+  *'"method":"pubkey"'*) echo '{"pub_key_type":"secp256k1","pub_key":"%s"}' ;;
+  *'"key_id":"key1"'*) echo '{"signature":"%s"}' ;;
+  *) echo '{"error":"unknown key"}' ;;
+esac
+`, base64.StdEncoding.EncodeToString(priv.PubKey().Bytes()), base64.StdEncoding.EncodeToString(sig))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, PluginSignerPrefix+"test"), []byte(script), 0o755))
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	kr := NewInMemory(getCodec())
+	k, err := kr.SaveExternalKey("validator", "test", "key1")
+	require.NoError(t, err)
+	pub, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, priv.PubKey().Equals(pub))
+
+	signed, _, err := kr.Sign("validator", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.Equal(t, sig, signed)
+
+	_, err = NewPluginSigner(filepath.Join(dir, PluginSignerPrefix+"test")).Sign("key2", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorContains(t, err, "unknown key")
+}
@@ -94,6 +94,10 @@ type Keyring interface {
 	// SaveLedgerKey retrieves a public key reference from a Ledger device and persists it.
 	SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coinType, account, index uint32) (*Record, error)
 
+	// SaveExternalKey retrieves the public key of a key held by an external signer, such as a
+	// hardware security module or a remote key management service, and persists a reference to it.
+	SaveExternalKey(uid, signer, keyID string) (*Record, error)
+
 	// SaveOfflineKey stores a public key and returns the persisted Info structure.
 	SaveOfflineKey(uid string, pubkey types.PubKey) (*Record, error)
 
@@ -162,6 +166,8 @@ type Options struct {
 	// indicate whether Ledger should skip DER Conversion on signature,
 	// depending on which format (DER or BER) the Ledger app returns signatures
 	LedgerSigSkipDERConv bool
+	// external signers by name, the other ones being resolved as plugin signers
+	ExternalSigners map[string]ExternalSigner
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -416,6 +422,9 @@ func (ks keystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]by
 	case k.GetLedger() != nil:
 		return SignWithLedger(k, msg, signMode)
 
+	case k.GetExternal() != nil:
+		return ks.signWithExternal(k, msg, signMode)
+
 		// multi or offline record
 	default:
 		pub, err := k.GetPubKey()
@@ -0,0 +1,110 @@
+package keyring
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// GetMultisigPubKey fetches the multisig public key of a multisig record.
+func (k Record) GetMultisigPubKey() (*multisig.LegacyAminoPubKey, error) {
+	if k.GetMulti() == nil {
+		return nil, ErrNotMultisig
+	}
+
+	pk, err := k.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	multisigPK, ok := pk.(*multisig.LegacyAminoPubKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: unexpected public key type %T", ErrNotMultisig, pk)
+	}
+
+	return multisigPK, nil
+}
+
+// NewMultisigPubKey returns the multisig public key of the given threshold and
+// members, sorted by address unless noSort is set. The threshold must be
+// positive and at most the number of members, which must be distinct.
+func NewMultisigPubKey(threshold int, pubKeys []types.PubKey, noSort bool) (*multisig.LegacyAminoPubKey, error) {
+	if threshold <= 0 {
+		return nil, errors.New("threshold must be a positive integer")
+	}
+	if len(pubKeys) < threshold {
+		return nil, fmt.Errorf("threshold k of n multisignature: %d < %d", len(pubKeys), threshold)
+	}
+
+	pks := make([]types.PubKey, len(pubKeys))
+	copy(pks, pubKeys)
+	for i, pk := range pks {
+		for _, other := range pks[:i] {
+			if pk.Equals(other) {
+				return nil, fmt.Errorf("duplicate multisig member %s", pk.Address())
+			}
+		}
+	}
+
+	if !noSort {
+		sort.Slice(pks, func(i, j int) bool {
+			return bytes.Compare(pks[i].Address(), pks[j].Address()) < 0
+		})
+	}
+
+	return multisig.NewLegacyAminoPubKey(threshold, pks), nil
+}
+
+// RotateMultisigPubKey returns a new multisig public key from the members of the
+// given one, without the removed members and with the added ones. The threshold
+// of the given key is kept if the given threshold is zero. As the address of a
+// multisig key is derived from its members and threshold, the new key has a new
+// address.
+func RotateMultisigPubKey(
+	pk *multisig.LegacyAminoPubKey, add, remove []types.PubKey, threshold int, noSort bool,
+) (*multisig.LegacyAminoPubKey, error) {
+	members := pk.GetPubKeys()
+
+	for _, removed := range remove {
+		idx := indexOfPubKey(members, removed)
+		if idx < 0 {
+			return nil, fmt.Errorf("%s is not a multisig member", removed.Address())
+		}
+		members = append(members[:idx:idx], members[idx+1:]...)
+	}
+
+	for _, added := range add {
+		if indexOfPubKey(members, added) >= 0 {
+			return nil, fmt.Errorf("%s is already a multisig member", added.Address())
+		}
+		members = append(members, added)
+	}
+
+	if threshold == 0 {
+		threshold = int(pk.GetThreshold())
+	}
+
+	rotated, err := NewMultisigPubKey(threshold, members, noSort)
+	if err != nil {
+		return nil, err
+	}
+	if rotated.Equals(pk) {
+		return nil, errors.New("the rotated multisig key is unchanged")
+	}
+
+	return rotated, nil
+}
+
+func indexOfPubKey(pks []types.PubKey, pk types.PubKey) int {
+	for i, other := range pks {
+		if other.Equals(pk) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -69,6 +69,13 @@ func NewMultiRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 	return newRecord(name, pk, recordMultiItem)
 }
 
+// NewExternalRecord creates a new Record with external item
+func NewExternalRecord(name string, pk cryptotypes.PubKey, signer, keyID string) (*Record, error) {
+	recordExternal := &Record_External{Signer: signer, KeyId: keyID}
+	recordExternalItem := &Record_External_{recordExternal}
+	return newRecord(name, pk, recordExternalItem)
+}
+
 // GetPubKey fetches a public key of the record
 func (k *Record) GetPubKey() (cryptotypes.PubKey, error) {
 	pk, ok := k.PubKey.GetCachedValue().(cryptotypes.PubKey)
@@ -99,6 +106,8 @@ func (k Record) GetType() KeyType {
 		return TypeMulti
 	case k.GetOffline() != nil:
 		return TypeOffline
+	case k.GetExternal() != nil:
+		return TypeExternal
 	default:
 		panic("unrecognized record type")
 	}
@@ -45,17 +45,19 @@ type KeyType uint
 
 // Info KeyTypes
 const (
-	TypeLocal   KeyType = 0
-	TypeLedger  KeyType = 1
-	TypeOffline KeyType = 2
-	TypeMulti   KeyType = 3
+	TypeLocal    KeyType = 0
+	TypeLedger   KeyType = 1
+	TypeOffline  KeyType = 2
+	TypeMulti    KeyType = 3
+	TypeExternal KeyType = 4
 )
 
 var keyTypes = map[KeyType]string{
-	TypeLocal:   "local",
-	TypeLedger:  "ledger",
-	TypeOffline: "offline",
-	TypeMulti:   "multi",
+	TypeLocal:    "local",
+	TypeLedger:   "ledger",
+	TypeOffline:  "offline",
+	TypeMulti:    "multi",
+	TypeExternal: "external",
 }
 
 // String implements the stringer interface for KeyType.
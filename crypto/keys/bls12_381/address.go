@@ -0,0 +1,88 @@
+package bls12_381
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"golang.org/x/crypto/sha3"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// AddressScheme defines how the address of a public key is derived.
+type AddressScheme int
+
+const (
+	// AddressSchemeComet derives the address as the truncated SHA-256 hash of
+	// the public key, like CometBFT does for the validator addresses.
+	AddressSchemeComet AddressScheme = iota
+	// AddressSchemeEthereum derives the address as the last 20 bytes of the
+	// Keccak-256 hash of the public key, like Ethereum does.
+	AddressSchemeEthereum
+)
+
+// addressScheme is the scheme used by PubKey.Address.
+var addressScheme = AddressSchemeComet
+
+// SetAddressScheme sets the scheme deriving the addresses of the public keys,
+// AddressSchemeComet by default. It must be called when wiring the app, before
+// any address is derived, as changing the scheme of a running chain changes the
+// addresses of its existing keys, see AddressMigration.
+//
+// Note that CometBFT always derives the validator addresses with
+// AddressSchemeComet, which must be taken into account to map them to the
+// consensus addresses of the app with another scheme.
+func SetAddressScheme(scheme AddressScheme) {
+	if scheme != AddressSchemeComet && scheme != AddressSchemeEthereum {
+		panic(fmt.Sprintf("unknown bls12_381 address scheme %d", scheme))
+	}
+
+	addressScheme = scheme
+}
+
+// GetAddressScheme returns the scheme deriving the addresses of the public keys.
+func GetAddressScheme() AddressScheme {
+	return addressScheme
+}
+
+// String implements fmt.Stringer.
+func (s AddressScheme) String() string {
+	switch s {
+	case AddressSchemeComet:
+		return "comet"
+	case AddressSchemeEthereum:
+		return "ethereum"
+	default:
+		return fmt.Sprintf("AddressScheme(%d)", int(s))
+	}
+}
+
+// Address derives the address of the public key bytes with the scheme.
+func (s AddressScheme) Address(pubKey []byte) crypto.Address {
+	switch s {
+	case AddressSchemeEthereum:
+		hasher := sha3.NewLegacyKeccak256()
+		hasher.Write(pubKey)
+		return crypto.Address(hasher.Sum(nil)[32-crypto.AddressSize:])
+	default:
+		return crypto.Address(tmhash.SumTruncated(pubKey))
+	}
+}
+
+// AddressMigration maps the addresses of the public keys derived with the from
+// scheme to the ones derived with the to scheme, keyed by the string of the
+// former. It helps migrating the state indexed by addresses, such as consensus
+// addresses, when changing the scheme of a running chain.
+func AddressMigration(pubKeys []cryptotypes.PubKey, from, to AddressScheme) (map[string]crypto.Address, error) {
+	migration := make(map[string]crypto.Address, len(pubKeys))
+	for _, pk := range pubKeys {
+		if pk.Type() != KeyType {
+			return nil, fmt.Errorf("invalid public key type: expected %s, got %s", KeyType, pk.Type())
+		}
+
+		migration[from.Address(pk.Bytes()).String()] = to.Address(pk.Bytes())
+	}
+
+	return migration, nil
+}
@@ -0,0 +1,43 @@
+package bls12_381_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func TestAddressScheme(t *testing.T) {
+	pubKey := make([]byte, bls12_381.PubKeySize)
+	pubKey[0] = 1
+
+	require.Equal(t, tmhash.SumTruncated(pubKey), []byte(bls12_381.AddressSchemeComet.Address(pubKey)))
+	ethAddr := bls12_381.AddressSchemeEthereum.Address(pubKey)
+	require.Len(t, ethAddr, 20)
+	require.NotEqual(t, bls12_381.AddressSchemeComet.Address(pubKey), ethAddr)
+
+	require.Equal(t, bls12_381.AddressSchemeComet, bls12_381.GetAddressScheme())
+	require.Panics(t, func() { bls12_381.SetAddressScheme(bls12_381.AddressScheme(2)) })
+}
+
+func TestAddressMigration(t *testing.T) {
+	pubKeys := []cryptotypes.PubKey{
+		&bls12_381.PubKey{Key: make([]byte, bls12_381.PubKeySize)},
+		&bls12_381.PubKey{Key: append([]byte{1}, make([]byte, bls12_381.PubKeySize-1)...)},
+	}
+
+	migration, err := bls12_381.AddressMigration(pubKeys, bls12_381.AddressSchemeComet, bls12_381.AddressSchemeEthereum)
+	require.NoError(t, err)
+	require.Len(t, migration, 2)
+	for _, pk := range pubKeys {
+		oldAddr := bls12_381.AddressSchemeComet.Address(pk.Bytes())
+		require.Equal(t, bls12_381.AddressSchemeEthereum.Address(pk.Bytes()), migration[oldAddr.String()])
+	}
+
+	_, err = bls12_381.AddressMigration([]cryptotypes.PubKey{ed25519.GenPrivKey().PubKey()}, bls12_381.AddressSchemeComet, bls12_381.AddressSchemeEthereum)
+	require.Error(t, err)
+}
@@ -0,0 +1,25 @@
+//go:build !bls12381
+
+package bls12_381
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// AggregateSignatures aggregates the given signatures into a single signature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
+// AggregatePubKeys aggregates the given public keys into a single public key.
+// The returned key verifies the signatures aggregated with AggregateSignatures
+// over the same message, see VerifyAggregateSignature.
+func AggregatePubKeys(pubKeys []cryptotypes.PubKey) (*PubKey, error) {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
+// VerifyAggregateSignature verifies that sig is the aggregation of the
+// signatures of msg by every one of pubKeys.
+func VerifyAggregateSignature(msg, sig []byte, pubKeys []cryptotypes.PubKey) bool {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
@@ -0,0 +1,112 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	blst "github.com/supranational/blst/bindings/go"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// signatureDST is the domain separation tag of the signatures, which is the one
+// used by the signing keys.
+var signatureDST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// AggregateSignatures aggregates the given signatures into a single signature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+
+	for i, sig := range sigs {
+		if len(sig) != SignatureLength {
+			return nil, fmt.Errorf("invalid signature length at index %d: expected %d, got %d", i, SignatureLength, len(sig))
+		}
+	}
+
+	aggSig := new(blst.P2Aggregate)
+	if !aggSig.AggregateCompressed(sigs, true) {
+		return nil, errors.New("invalid signature")
+	}
+
+	return aggSig.ToAffine().Compress(), nil
+}
+
+// AggregatePubKeys aggregates the given public keys into a single public key.
+// The returned key verifies the signatures aggregated with AggregateSignatures
+// over the same message, see VerifyAggregateSignature.
+func AggregatePubKeys(pubKeys []cryptotypes.PubKey) (*PubKey, error) {
+	if len(pubKeys) == 0 {
+		return nil, errors.New("no public keys to aggregate")
+	}
+
+	keys := make([]*blst.P1Affine, len(pubKeys))
+	for i, pk := range pubKeys {
+		key, err := blstPubKey(pk)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key at index %d: %w", i, err)
+		}
+		keys[i] = key
+	}
+
+	aggPubKey := new(blst.P1Aggregate)
+	if !aggPubKey.Aggregate(keys, false) {
+		return nil, errors.New("failed to aggregate public keys")
+	}
+
+	return &PubKey{Key: aggPubKey.ToAffine().Compress()}, nil
+}
+
+// VerifyAggregateSignature verifies that sig is the aggregation of the
+// signatures of msg by every one of pubKeys.
+//
+// The verification is vulnerable to rogue-key attacks: the proofs of possession
+// of pubKeys must be verified beforehand, see VerifyProofOfPossession.
+func VerifyAggregateSignature(msg, sig []byte, pubKeys []cryptotypes.PubKey) bool {
+	if len(sig) != SignatureLength || len(pubKeys) == 0 {
+		return false
+	}
+
+	keys := make([]*blst.P1Affine, len(pubKeys))
+	for i, pk := range pubKeys {
+		key, err := blstPubKey(pk)
+		if err != nil {
+			return false
+		}
+		keys[i] = key
+	}
+
+	aggSig := new(blst.P2Affine).Uncompress(sig)
+	if aggSig == nil { // bad signature
+		return false
+	}
+
+	if len(msg) > MaxMsgLen {
+		hash := sha256.Sum256(msg)
+		msg = hash[:]
+	}
+
+	if len(msg) != MaxMsgLen {
+		return false
+	}
+
+	return aggSig.FastAggregateVerify(true, keys, msg, signatureDST)
+}
+
+// blstPubKey decodes and validates a bls12_381 public key.
+func blstPubKey(pubKey cryptotypes.PubKey) (*blst.P1Affine, error) {
+	if pubKey.Type() != KeyType {
+		return nil, fmt.Errorf("expected %s public key, got %s", KeyType, pubKey.Type())
+	}
+
+	key := new(blst.P1Affine).Uncompress(pubKey.Bytes())
+	if key == nil || !key.KeyValidate() {
+		return nil, errors.New("invalid bls12_381 public key")
+	}
+
+	return key, nil
+}
@@ -0,0 +1,150 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	blst "github.com/supranational/blst/bindings/go"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func genPrivKeys(t *testing.T, n int) []bls12_381.PrivKey {
+	t.Helper()
+
+	privKeys := make([]bls12_381.PrivKey, n)
+	for i := range privKeys {
+		privKey, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		privKeys[i] = privKey
+	}
+
+	return privKeys
+}
+
+func signAll(t *testing.T, privKeys []bls12_381.PrivKey, msg []byte) ([][]byte, []cryptotypes.PubKey) {
+	t.Helper()
+
+	sigs := make([][]byte, len(privKeys))
+	pubKeys := make([]cryptotypes.PubKey, len(privKeys))
+	for i, privKey := range privKeys {
+		sig, err := privKey.Sign(msg)
+		require.NoError(t, err)
+		sigs[i], pubKeys[i] = sig, privKey.PubKey()
+	}
+
+	return sigs, pubKeys
+}
+
+func TestAggregateSignatures(t *testing.T) {
+	msg := []byte("the messages longer than 32 bytes are hashed before being signed")
+	sigs, pubKeys := signAll(t, genPrivKeys(t, 4), msg)
+
+	aggSig, err := bls12_381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.Len(t, aggSig, bls12_381.SignatureLength)
+	require.True(t, bls12_381.VerifyAggregateSignature(msg, aggSig, pubKeys))
+
+	// the aggregated public key verifies the aggregated signature
+	aggPubKey, err := bls12_381.AggregatePubKeys(pubKeys)
+	require.NoError(t, err)
+	require.True(t, aggPubKey.VerifySignature(msg, aggSig))
+
+	// the aggregation doesn't depend on the order
+	reversed := [][]byte{sigs[3], sigs[2], sigs[1], sigs[0]}
+	aggSigReversed, err := bls12_381.AggregateSignatures(reversed)
+	require.NoError(t, err)
+	require.Equal(t, aggSig, aggSigReversed)
+
+	// a single signature aggregates to itself
+	single, err := bls12_381.AggregateSignatures(sigs[:1])
+	require.NoError(t, err)
+	require.Equal(t, sigs[0], single)
+	require.True(t, bls12_381.VerifyAggregateSignature(msg, single, pubKeys[:1]))
+}
+
+func TestAggregateSignaturesInvalid(t *testing.T) {
+	msg := []byte("the messages longer than 32 bytes are hashed before being signed")
+	sigs, pubKeys := signAll(t, genPrivKeys(t, 2), msg)
+
+	_, err := bls12_381.AggregateSignatures(nil)
+	require.ErrorContains(t, err, "no signatures to aggregate")
+
+	_, err = bls12_381.AggregateSignatures([][]byte{sigs[0], sigs[1][:10]})
+	require.ErrorContains(t, err, "invalid signature length at index 1")
+
+	_, err = bls12_381.AggregateSignatures([][]byte{sigs[0], make([]byte, bls12_381.SignatureLength)})
+	require.ErrorContains(t, err, "invalid signature")
+
+	_, err = bls12_381.AggregatePubKeys(nil)
+	require.ErrorContains(t, err, "no public keys to aggregate")
+
+	_, err = bls12_381.AggregatePubKeys([]cryptotypes.PubKey{pubKeys[0], ed25519.GenPrivKey().PubKey()})
+	require.ErrorContains(t, err, "invalid public key at index 1")
+
+	_, err = bls12_381.AggregatePubKeys([]cryptotypes.PubKey{&bls12_381.PubKey{Key: make([]byte, 48)}})
+	require.ErrorContains(t, err, "invalid public key at index 0")
+}
+
+func TestVerifyAggregateSignature(t *testing.T) {
+	msg := []byte("the messages longer than 32 bytes are hashed before being signed")
+	otherMsg := []byte("another message longer than 32 bytes, signed by one of the keys")
+	privKeys := genPrivKeys(t, 3)
+	sigs, pubKeys := signAll(t, privKeys, msg)
+
+	aggSig, err := bls12_381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.True(t, bls12_381.VerifyAggregateSignature(msg, aggSig, pubKeys))
+
+	// empty set of keys
+	require.False(t, bls12_381.VerifyAggregateSignature(msg, aggSig, nil))
+
+	// missing or additional signer
+	require.False(t, bls12_381.VerifyAggregateSignature(msg, aggSig, pubKeys[:2]))
+	require.False(t, bls12_381.VerifyAggregateSignature(msg, aggSig, append(pubKeys, genPrivKeys(t, 1)[0].PubKey())))
+
+	// different message
+	require.False(t, bls12_381.VerifyAggregateSignature(otherMsg, aggSig, pubKeys))
+
+	// one of the keys signed another message
+	otherSig, err := privKeys[2].Sign(otherMsg)
+	require.NoError(t, err)
+	mismatched, err := bls12_381.AggregateSignatures([][]byte{sigs[0], sigs[1], otherSig})
+	require.NoError(t, err)
+	require.False(t, bls12_381.VerifyAggregateSignature(msg, mismatched, pubKeys))
+
+	// invalid signatures and keys
+	require.False(t, bls12_381.VerifyAggregateSignature(msg, aggSig[:10], pubKeys))
+	require.False(t, bls12_381.VerifyAggregateSignature(msg, make([]byte, bls12_381.SignatureLength), pubKeys))
+	require.False(t, bls12_381.VerifyAggregateSignature(msg, aggSig, []cryptotypes.PubKey{pubKeys[0], pubKeys[1], ed25519.GenPrivKey().PubKey()}))
+	require.False(t, bls12_381.VerifyAggregateSignature(msg[:10], aggSig, pubKeys))
+}
+
+func TestVerifyAggregateSignatureRogueKey(t *testing.T) {
+	msg := []byte("the messages longer than 32 bytes are hashed before being signed")
+	privKeys := genPrivKeys(t, 2)
+	victim, attacker := privKeys[0].PubKey(), privKeys[1]
+
+	// the rogue key is the attacker key minus the victim key, so that their
+	// aggregation is the attacker key
+	attackerKey := new(blst.P1Affine).Uncompress(attacker.PubKey().Bytes())
+	victimKey := new(blst.P1Affine).Uncompress(victim.Bytes())
+	rogue := new(blst.P1)
+	rogue.FromAffine(attackerKey)
+	rogue.SubAssign(victimKey)
+	rogueKey := &bls12_381.PubKey{Key: rogue.ToAffine().Compress()}
+
+	// the attacker alone forges a signature aggregated with the victim
+	forged, err := attacker.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, bls12_381.VerifyAggregateSignature(msg, forged, []cryptotypes.PubKey{victim, rogueKey}))
+
+	// but can't prove the possession of the rogue key
+	proof, err := bls12_381.GenerateProofOfPossession(attacker)
+	require.NoError(t, err)
+	require.False(t, bls12_381.VerifyProofOfPossession(rogueKey, proof))
+}
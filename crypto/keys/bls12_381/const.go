@@ -18,4 +18,7 @@ const (
 	MaxMsgLen = 32
 	// KeyType is the type of key this package provides.
 	KeyType = "bls12381"
+	// ProofOfPossessionDomain separates the messages signed as proofs of
+	// possession from any other message signed by a key.
+	ProofOfPossessionDomain = "BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
 )
@@ -100,7 +100,8 @@ func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
 
 var _ cryptotypes.PubKey = &PubKey{}
 
-// Address returns the address of the key.
+// Address returns the address of the key, derived with the scheme set by
+// SetAddressScheme.
 //
 // The function will panic if the public key is invalid.
 func (pubKey PubKey) Address() crypto.Address {
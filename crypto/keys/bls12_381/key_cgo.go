@@ -9,7 +9,6 @@ import (
 	"fmt"
 
 	"github.com/cometbft/cometbft/crypto"
-	"github.com/cometbft/cometbft/crypto/tmhash"
 
 	bls12381 "github.com/cosmos/crypto/curves/bls12381"
 
@@ -131,7 +130,8 @@ func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
 
 var _ cryptotypes.PubKey = &PubKey{}
 
-// Address returns the address of the key.
+// Address returns the address of the key, derived with the scheme set by
+// SetAddressScheme.
 //
 // The function will panic if the public key is invalid.
 func (pubKey PubKey) Address() crypto.Address {
@@ -139,7 +139,7 @@ func (pubKey PubKey) Address() crypto.Address {
 	if len(pk.Marshal()) != PubKeySize {
 		panic("pubkey is incorrect size")
 	}
-	return crypto.Address(tmhash.SumTruncated(pubKey.Key))
+	return addressScheme.Address(pubKey.Key)
 }
 
 // VerifySignature verifies the given signature.
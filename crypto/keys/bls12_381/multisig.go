@@ -0,0 +1,155 @@
+package bls12_381
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// MultiKeyType is the type of the multisig keys this package provides.
+const MultiKeyType = "bls12381-multi"
+
+var _ cryptotypes.PubKey = &MultiPubKey{}
+
+// NewMultiPubKey returns a threshold multisig of the given bls12_381 public
+// keys. The proofs of possession of the keys are verified, so that none of
+// them is a rogue key chosen to forge the aggregated signatures of the others.
+func NewMultiPubKey(threshold int, pubKeys []cryptotypes.PubKey, proofs [][]byte) (*MultiPubKey, error) {
+	if threshold <= 0 || threshold > len(pubKeys) {
+		return nil, fmt.Errorf("threshold must be between 1 and the number of keys %d, got %d", len(pubKeys), threshold)
+	}
+
+	if len(proofs) != len(pubKeys) {
+		return nil, fmt.Errorf("expected %d proofs of possession, got %d", len(pubKeys), len(proofs))
+	}
+
+	keys := make([][]byte, len(pubKeys))
+	for i, pk := range pubKeys {
+		if !VerifyProofOfPossession(pk, proofs[i]) {
+			return nil, fmt.Errorf("invalid proof of possession of the public key at index %d", i)
+		}
+		keys[i] = pk.Bytes()
+	}
+
+	return &MultiPubKey{Threshold: uint32(threshold), PubKeys: keys, ProofsOfPossession: proofs}, nil
+}
+
+// AggregateSignature aggregates the signatures of the keys into a signature of
+// the multisig. sigs are the signatures in the order of the keys, with a nil
+// signature for the keys which did not sign.
+//
+// The signature of the multisig is the bitmap of the keys which signed, one bit
+// per key in the order of the keys, followed by their aggregated signature.
+func (m *MultiPubKey) AggregateSignature(sigs [][]byte) ([]byte, error) {
+	if len(sigs) != len(m.PubKeys) {
+		return nil, fmt.Errorf("expected %d signatures, got %d", len(m.PubKeys), len(sigs))
+	}
+
+	bitmap := make([]byte, m.bitmapLen())
+	signed := make([][]byte, 0, len(sigs))
+	for i, sig := range sigs {
+		if sig == nil {
+			continue
+		}
+
+		bitmap[i/8] |= 1 << (i % 8)
+		signed = append(signed, sig)
+	}
+
+	if len(signed) < int(m.Threshold) {
+		return nil, fmt.Errorf("not enough signatures: got %d, expected at least %d", len(signed), m.Threshold)
+	}
+
+	aggSig, err := AggregateSignatures(signed)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(bitmap, aggSig...), nil
+}
+
+// VerifySignature verifies that sig is the aggregated signature of msg by at
+// least threshold of the keys, see AggregateSignature.
+func (m *MultiPubKey) VerifySignature(msg, sig []byte) bool {
+	bitmapLen := m.bitmapLen()
+	if len(m.PubKeys) == 0 || len(m.ProofsOfPossession) != len(m.PubKeys) || len(sig) != bitmapLen+SignatureLength {
+		return false
+	}
+
+	bitmap, aggSig := sig[:bitmapLen], sig[bitmapLen:]
+
+	// the unused bits of the bitmap must be unset, so that the signature is not
+	// malleable
+	if n := len(m.PubKeys) % 8; n != 0 && bitmap[bitmapLen-1]>>n != 0 {
+		return false
+	}
+
+	var signers []cryptotypes.PubKey
+	for i, key := range m.PubKeys {
+		if bitmap[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+
+		pk := &PubKey{Key: key}
+		if !VerifyProofOfPossession(pk, m.ProofsOfPossession[i]) {
+			return false
+		}
+		signers = append(signers, pk)
+	}
+
+	if len(signers) < int(m.Threshold) {
+		return false
+	}
+
+	return VerifyAggregateSignature(msg, aggSig, signers)
+}
+
+// GetPubKeys returns the public keys of the multisig.
+func (m *MultiPubKey) GetPubKeys() []cryptotypes.PubKey {
+	pubKeys := make([]cryptotypes.PubKey, len(m.PubKeys))
+	for i, key := range m.PubKeys {
+		pubKeys[i] = &PubKey{Key: key}
+	}
+
+	return pubKeys
+}
+
+// Address returns the address of the multisig, i.e. the truncated SHA-256 hash
+// of its protobuf encoding.
+func (m *MultiPubKey) Address() crypto.Address {
+	return tmhash.SumTruncated(m.Bytes())
+}
+
+// Bytes returns the protobuf encoding of the multisig.
+func (m *MultiPubKey) Bytes() []byte {
+	bz, err := m.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// Type returns the type of the multisig keys.
+func (*MultiPubKey) Type() string {
+	return MultiKeyType
+}
+
+// Equals returns true if other is the same multisig.
+func (m *MultiPubKey) Equals(other cryptotypes.PubKey) bool {
+	return m.Type() == other.Type() && bytes.Equal(m.Bytes(), other.Bytes())
+}
+
+// String implements fmt.Stringer.
+func (m *MultiPubKey) String() string {
+	return fmt.Sprintf("MultiPubKeyBls12_381{%d/%d}", m.Threshold, len(m.PubKeys))
+}
+
+// bitmapLen returns the length of the bitmap of the keys which signed.
+func (m *MultiPubKey) bitmapLen() int {
+	return (len(m.PubKeys) + 7) / 8
+}
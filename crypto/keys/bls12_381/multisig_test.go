@@ -0,0 +1,117 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func newMultiPubKey(t *testing.T, threshold int, privKeys []bls12_381.PrivKey) *bls12_381.MultiPubKey {
+	t.Helper()
+
+	pubKeys := make([]cryptotypes.PubKey, len(privKeys))
+	proofs := make([][]byte, len(privKeys))
+	for i, privKey := range privKeys {
+		proof, err := bls12_381.GenerateProofOfPossession(privKey)
+		require.NoError(t, err)
+		pubKeys[i], proofs[i] = privKey.PubKey(), proof
+	}
+
+	multiPubKey, err := bls12_381.NewMultiPubKey(threshold, pubKeys, proofs)
+	require.NoError(t, err)
+	return multiPubKey
+}
+
+func TestMultiPubKey(t *testing.T) {
+	msg := []byte("the messages longer than 32 bytes are hashed before being signed")
+	privKeys := genPrivKeys(t, 10)
+	multiPubKey := newMultiPubKey(t, 6, privKeys)
+	require.Equal(t, bls12_381.MultiKeyType, multiPubKey.Type())
+	require.Len(t, multiPubKey.GetPubKeys(), 10)
+
+	sigs, _ := signAll(t, privKeys, msg)
+
+	// any threshold of the keys can sign
+	partial := make([][]byte, len(sigs))
+	for _, i := range []int{0, 2, 5, 7, 8, 9} {
+		partial[i] = sigs[i]
+	}
+	sig, err := multiPubKey.AggregateSignature(partial)
+	require.NoError(t, err)
+	require.Len(t, sig, 2+bls12_381.SignatureLength)
+	require.True(t, multiPubKey.VerifySignature(msg, sig))
+
+	all, err := multiPubKey.AggregateSignature(sigs)
+	require.NoError(t, err)
+	require.True(t, multiPubKey.VerifySignature(msg, all))
+
+	// the bitmap must match the signers
+	tampered := append([]byte{}, sig...)
+	tampered[0] ^= 1 << 1
+	require.False(t, multiPubKey.VerifySignature(msg, tampered))
+
+	// the unused bits of the bitmap must be unset
+	tampered = append([]byte{}, all...)
+	tampered[1] |= 1 << 7
+	require.False(t, multiPubKey.VerifySignature(msg, tampered))
+
+	require.False(t, multiPubKey.VerifySignature([]byte("another message longer than 32 bytes to be signed"), sig))
+	require.False(t, multiPubKey.VerifySignature(msg, sig[:len(sig)-1]))
+
+	// less than threshold signatures
+	partial[0] = nil
+	_, err = multiPubKey.AggregateSignature(partial)
+	require.ErrorContains(t, err, "not enough signatures: got 5, expected at least 6")
+
+	forged, err := bls12_381.AggregateSignatures([][]byte{sigs[2], sigs[5], sigs[7], sigs[8], sigs[9]})
+	require.NoError(t, err)
+	require.False(t, multiPubKey.VerifySignature(msg, append([]byte{0b10100100, 0b11}, forged...)))
+
+	// the multisig round trips through its encoding
+	var decoded bls12_381.MultiPubKey
+	require.NoError(t, decoded.Unmarshal(multiPubKey.Bytes()))
+	require.True(t, decoded.Equals(multiPubKey))
+	require.Equal(t, multiPubKey.Address(), decoded.Address())
+	require.True(t, decoded.VerifySignature(msg, sig))
+	require.False(t, multiPubKey.Equals(newMultiPubKey(t, 5, privKeys)))
+}
+
+func TestNewMultiPubKeyInvalid(t *testing.T) {
+	privKeys := genPrivKeys(t, 2)
+	pubKeys := []cryptotypes.PubKey{privKeys[0].PubKey(), privKeys[1].PubKey()}
+	proof0, err := bls12_381.GenerateProofOfPossession(privKeys[0])
+	require.NoError(t, err)
+	proof1, err := bls12_381.GenerateProofOfPossession(privKeys[1])
+	require.NoError(t, err)
+
+	_, err = bls12_381.NewMultiPubKey(0, pubKeys, [][]byte{proof0, proof1})
+	require.ErrorContains(t, err, "threshold must be between 1 and the number of keys 2, got 0")
+
+	_, err = bls12_381.NewMultiPubKey(3, pubKeys, [][]byte{proof0, proof1})
+	require.ErrorContains(t, err, "threshold must be between 1 and the number of keys 2, got 3")
+
+	_, err = bls12_381.NewMultiPubKey(1, pubKeys, [][]byte{proof0})
+	require.ErrorContains(t, err, "expected 2 proofs of possession, got 1")
+
+	_, err = bls12_381.NewMultiPubKey(1, pubKeys, [][]byte{proof1, proof0})
+	require.ErrorContains(t, err, "invalid proof of possession of the public key at index 0")
+}
+
+func TestMultiPubKeyRogueKey(t *testing.T) {
+	msg := []byte("the messages longer than 32 bytes are hashed before being signed")
+	privKeys := genPrivKeys(t, 2)
+	multiPubKey := newMultiPubKey(t, 2, privKeys)
+
+	// a multisig decoded with a key whose proof of possession is invalid, e.g.
+	// a rogue key, never verifies a signature
+	multiPubKey.ProofsOfPossession[1] = multiPubKey.ProofsOfPossession[0]
+	sigs, _ := signAll(t, privKeys, msg)
+	sig, err := multiPubKey.AggregateSignature(sigs)
+	require.NoError(t, err)
+	require.False(t, multiPubKey.VerifySignature(msg, sig))
+}
@@ -0,0 +1,19 @@
+//go:build !bls12381
+
+package bls12_381
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// GenerateProofOfPossession returns the proof of possession of the private key,
+// i.e. the signature of its public key, see VerifyProofOfPossession.
+func GenerateProofOfPossession(privKey PrivKey) ([]byte, error) {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
+// VerifyProofOfPossession verifies that proof was generated by the private key
+// of pubKey with GenerateProofOfPossession.
+func VerifyProofOfPossession(pubKey cryptotypes.PubKey, proof []byte) bool {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
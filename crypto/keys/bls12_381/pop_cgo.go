@@ -0,0 +1,45 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381
+
+import (
+	"crypto/sha256"
+	"errors"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// GenerateProofOfPossession returns the proof of possession of the private key,
+// i.e. the signature of its public key, see VerifyProofOfPossession.
+//
+// Aggregated signatures are vulnerable to rogue-key attacks, where a public key
+// is chosen as a function of the others to forge their aggregated signature.
+// Such a key can't come with a proof of possession, which must be verified
+// before accepting a public key for aggregation.
+func GenerateProofOfPossession(privKey PrivKey) ([]byte, error) {
+	pubKey := privKey.PubKey()
+	if pubKey == nil {
+		return nil, errors.New("invalid private key")
+	}
+
+	msg := proofOfPossessionMsg(pubKey.Bytes())
+	return privKey.Sign(msg[:])
+}
+
+// VerifyProofOfPossession verifies that proof was generated by the private key
+// of pubKey with GenerateProofOfPossession.
+func VerifyProofOfPossession(pubKey cryptotypes.PubKey, proof []byte) bool {
+	if pubKey.Type() != KeyType {
+		return false
+	}
+
+	msg := proofOfPossessionMsg(pubKey.Bytes())
+	return pubKey.VerifySignature(msg[:], proof)
+}
+
+// proofOfPossessionMsg returns the message signed by the proof of possession of
+// a public key, which is separated from the messages signed by the key
+// otherwise.
+func proofOfPossessionMsg(pubKey []byte) [MaxMsgLen]byte {
+	return sha256.Sum256(append([]byte(ProofOfPossessionDomain), pubKey...))
+}
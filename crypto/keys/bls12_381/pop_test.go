@@ -0,0 +1,34 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+)
+
+func TestProofOfPossession(t *testing.T) {
+	privKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+	otherPrivKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+
+	proof, err := bls12_381.GenerateProofOfPossession(privKey)
+	require.NoError(t, err)
+	require.True(t, bls12_381.VerifyProofOfPossession(privKey.PubKey(), proof))
+
+	// the proof is bound to the public key
+	require.False(t, bls12_381.VerifyProofOfPossession(otherPrivKey.PubKey(), proof))
+
+	// a signature of the public key is not a proof of possession
+	sig, err := privKey.Sign(privKey.PubKey().Bytes())
+	require.NoError(t, err)
+	require.False(t, bls12_381.VerifyProofOfPossession(privKey.PubKey(), sig))
+
+	require.False(t, bls12_381.VerifyProofOfPossession(privKey.PubKey(), nil))
+	require.False(t, bls12_381.VerifyProofOfPossession(ed25519.GenPrivKey().PubKey(), proof))
+}
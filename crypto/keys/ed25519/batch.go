@@ -0,0 +1,37 @@
+package ed25519
+
+import (
+	"github.com/hdevalence/ed25519consensus"
+)
+
+// BatchVerifier verifies ed25519 signatures in batch, which is significantly
+// faster than verifying them one by one. It follows the ZIP-215 rules of
+// PubKey.VerifySignature, so that a batch is valid if and only if each of its
+// signatures is valid on its own.
+type BatchVerifier struct {
+	verifier ed25519consensus.BatchVerifier
+	size     int
+}
+
+// NewBatchVerifier returns an empty BatchVerifier.
+func NewBatchVerifier() *BatchVerifier {
+	return &BatchVerifier{verifier: ed25519consensus.NewBatchVerifier()}
+}
+
+// Add adds the signature of a message to the batch.
+func (b *BatchVerifier) Add(pubKey *PubKey, msg, sig []byte) {
+	b.verifier.Add(pubKey.Key, msg, sig)
+	b.size++
+}
+
+// Size returns the number of signatures of the batch.
+func (b *BatchVerifier) Size() int {
+	return b.size
+}
+
+// Verify returns true if all the signatures of the batch are valid. If not, the
+// invalid signatures are unknown and must be verified one by one. An empty
+// batch is never valid.
+func (b *BatchVerifier) Verify() bool {
+	return b.verifier.Verify()
+}
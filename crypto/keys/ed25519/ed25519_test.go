@@ -47,6 +47,33 @@ func TestSignAndValidateEd25519(t *testing.T) {
 	assert.False(t, pubKey.VerifySignature(msg, sig))
 }
 
+func TestBatchVerifier(t *testing.T) {
+	require.False(t, ed25519.NewBatchVerifier().Verify())
+
+	bv := ed25519.NewBatchVerifier()
+	var sigs [][]byte
+	for i := 0; i < 10; i++ {
+		privKey := ed25519.GenPrivKey()
+		msg := crypto.CRandBytes(100)
+		sig, err := privKey.Sign(msg)
+		require.NoError(t, err)
+		sigs = append(sigs, sig)
+		bv.Add(privKey.PubKey().(*ed25519.PubKey), msg, sig)
+	}
+	require.Equal(t, 10, bv.Size())
+	require.True(t, bv.Verify())
+
+	// a single invalid signature fails the batch
+	privKey := ed25519.GenPrivKey()
+	bv.Add(privKey.PubKey().(*ed25519.PubKey), []byte("message"), sigs[0])
+	require.False(t, bv.Verify())
+
+	// so does a malformed one
+	bv = ed25519.NewBatchVerifier()
+	bv.Add(privKey.PubKey().(*ed25519.PubKey), []byte("message"), sigs[0][:32])
+	require.False(t, bv.Verify())
+}
+
 func TestPubKeyEquals(t *testing.T) {
 	ed25519PubKey := ed25519.GenPrivKey().PubKey().(*ed25519.PubKey)
 
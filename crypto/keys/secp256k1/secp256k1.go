@@ -13,6 +13,7 @@ import (
 	secp256k1dcrd "github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"gitlab.com/yawning/secp256k1-voi/secec"
 	"golang.org/x/crypto/ripemd160" //nolint: staticcheck // keep around for backwards compatibility
+	"golang.org/x/crypto/sha3"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -178,6 +179,13 @@ func (pubKey *PubKey) Address() crypto.Address {
 	return crypto.Address(hasherRIPEMD160.Sum(nil))
 }
 
+// keccak256 returns the Keccak-256 hash of msg, the hash Ethereum signs.
+func keccak256(msg []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(msg) // does not error
+	return hasher.Sum(nil)
+}
+
 // Bytes returns the pubkey byte format.
 func (pubKey *PubKey) Bytes() []byte {
 	return pubKey.Key
@@ -25,3 +25,12 @@ func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
 func (pubKey *PubKey) VerifySignature(msg, sigStr []byte) bool {
 	return secp256k1.VerifySignature(pubKey.Bytes(), crypto.Sha256(msg), sigStr)
 }
+
+// VerifyKeccak256Signature verifies a signature of the form R || S, or of the
+// form R || S || V produced by Ethereum wallets, over the Keccak-256 hash of msg.
+func (pubKey *PubKey) VerifyKeccak256Signature(msg, sigStr []byte) bool {
+	if len(sigStr) == 65 {
+		sigStr = sigStr[:64]
+	}
+	return secp256k1.VerifySignature(pubKey.Bytes(), keccak256(msg), sigStr)
+}
@@ -39,6 +39,27 @@ func (pubKey *PubKey) VerifySignature(msg, sigStr []byte) bool {
 	return signature.Verify(crypto.Sha256(msg), pub)
 }
 
+// VerifyKeccak256Signature verifies a signature of the form R || S, or of the
+// form R || S || V produced by Ethereum wallets, over the Keccak-256 hash of msg.
+// It rejects signatures which are not in lower-S form.
+func (pubKey *PubKey) VerifyKeccak256Signature(msg, sigStr []byte) bool {
+	if len(sigStr) == 65 {
+		sigStr = sigStr[:64]
+	}
+	if len(sigStr) != 64 {
+		return false
+	}
+	pub, err := secp256k1.ParsePubKey(pubKey.Key)
+	if err != nil {
+		return false
+	}
+	signature, err := signatureFromBytes(sigStr)
+	if err != nil {
+		return false
+	}
+	return signature.Verify(keccak256(msg), pub)
+}
+
 // Read Signature struct from R || S. Caller needs to ensure
 // that len(sigStr) == 64.
 // Rejects malleable signatures (if S value if it is over half order).
@@ -14,6 +14,7 @@ import (
 	btcecdsa "github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
@@ -216,6 +217,29 @@ func TestSignAndValidateSecp256k1(t *testing.T) {
 	assert.False(t, pubKey.VerifySignature(msg, sig))
 }
 
+func TestVerifyKeccak256Secp256k1(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	pubKey := privKey.PubKey().(*secp256k1.PubKey)
+	msg := crypto.CRandBytes(1000)
+
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(msg)
+	// SignCompact returns V || R || S, Ethereum wallets R || S || V
+	compact := btcecdsa.SignCompact(secp.PrivKeyFromBytes(privKey.Key), hasher.Sum(nil), false)
+	sig := append(compact[1:], compact[0]-27)
+
+	require.True(t, pubKey.VerifyKeccak256Signature(msg, sig))
+	require.True(t, pubKey.VerifyKeccak256Signature(msg, sig[:64]))
+	require.False(t, pubKey.VerifySignature(msg, sig[:64]))
+
+	sha256Sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.False(t, pubKey.VerifyKeccak256Signature(msg, sha256Sig))
+
+	sig[3] ^= byte(0x01)
+	require.False(t, pubKey.VerifyKeccak256Signature(msg, sig))
+}
+
 // This test is intended to justify the removal of calls to the underlying library
 // in creating the privkey.
 func TestSecp256k1LoadPrivkeyAndSerializeIsIdentity(t *testing.T) {
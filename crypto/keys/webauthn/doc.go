@@ -0,0 +1,26 @@
+// Package webauthn implements a Cosmos-SDK compatible public key for WebAuthn
+// credentials (passkeys), so that transactions can be signed by the
+// authenticators of mobile and web wallets.
+//
+// A transaction is signed by requesting an assertion of the credential whose
+// challenge is the SHA-256 hash of its sign bytes, see Challenge. The assertion
+// is then encoded as a Signature in the transaction signature.
+package webauthn
+
+import (
+	"cosmossdk.io/core/registry"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const (
+	// pubKeySize is the size of a compressed secp256r1 public key.
+	pubKeySize = 33
+
+	name = "webauthn"
+)
+
+// RegisterInterfaces adds webauthn PubKey to pubkey registry
+func RegisterInterfaces(registry registry.InterfaceRegistrar) {
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+}
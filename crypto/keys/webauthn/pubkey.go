@@ -0,0 +1,160 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cosmos/gogoproto/proto"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// clientDataTypeGet is the type of the client data of assertions.
+	clientDataTypeGet = "webauthn.get"
+
+	// authenticatorDataMinSize is the size of the rpIdHash, flags and signCount
+	// of the authenticator data, which may be followed by extensions.
+	authenticatorDataMinSize = 37
+
+	// flagUserPresent is the user present (UP) flag of the authenticator data.
+	flagUserPresent = 0x01
+)
+
+var _ cryptotypes.PubKey = &PubKey{}
+
+// clientData holds the fields of the client data JSON verified on chain.
+// Ref: https://www.w3.org/TR/webauthn-2/#dictionary-client-data
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+}
+
+// Challenge returns the challenge of the assertion signing signBytes.
+func Challenge(signBytes []byte) []byte {
+	h := sha256.Sum256(signBytes)
+	return h[:]
+}
+
+// String implements proto.Message interface.
+func (m *PubKey) String() string {
+	return fmt.Sprintf("%s{%X, %s}", name, m.Key, m.RPID)
+}
+
+// Bytes implements SDK PubKey interface.
+func (m *PubKey) Bytes() []byte {
+	if m == nil {
+		return nil
+	}
+	return m.Key
+}
+
+// Equals implements SDK PubKey interface.
+func (m *PubKey) Equals(other cryptotypes.PubKey) bool {
+	pk2, ok := other.(*PubKey)
+	if !ok {
+		return false
+	}
+	return bytes.Equal(m.Key, pk2.Key) && m.RPID == pk2.RPID
+}
+
+// Address implements SDK PubKey interface. The address commits to the relying
+// party, as the same key may not be used for another one.
+func (m *PubKey) Address() cmtcrypto.Address {
+	bz := make([]byte, 0, len(m.Key)+len(m.RPID))
+	bz = append(bz, m.Key...)
+	return address.Hash(proto.MessageName(m), append(bz, m.RPID...))
+}
+
+// Type returns key type name. Implements SDK PubKey interface.
+func (m *PubKey) Type() string {
+	return name
+}
+
+// String implements proto.Message interface.
+func (m *Signature) String() string {
+	return fmt.Sprintf("%s.Signature{%X, %s, %X}", name, m.AuthenticatorData, m.ClientDataJSON, m.Signature)
+}
+
+// ECDSA returns the secp256r1 public key of the credential.
+func (m *PubKey) ECDSA() (*ecdsa.PublicKey, error) {
+	if len(m.Key) != pubKeySize {
+		return nil, fmt.Errorf("wrong webauthn public key size, expecting %d bytes, got %d", pubKeySize, len(m.Key))
+	}
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), m.Key)
+	if x == nil {
+		return nil, errors.New("webauthn public key is not on the secp256r1 curve")
+	}
+
+	return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+}
+
+// VerifySignature implements SDK PubKey interface. sig is a protobuf encoded
+// Signature whose challenge must be the SHA-256 hash of msg.
+func (m *PubKey) VerifySignature(msg, sig []byte) bool {
+	var assertion Signature
+	if err := assertion.Unmarshal(sig); err != nil {
+		return false
+	}
+
+	return m.VerifyAssertion(msg, &assertion) == nil
+}
+
+// VerifyAssertion verifies that the assertion is a valid WebAuthn assertion of
+// the credential, for the relying party of the key, with the user present, and
+// whose challenge is the SHA-256 hash of msg.
+//
+// ECDSA signatures of authenticators are not normalized to the lower-S form, so
+// both forms are accepted.
+func (m *PubKey) VerifyAssertion(msg []byte, assertion *Signature) error {
+	pubKey, err := m.ECDSA()
+	if err != nil {
+		return err
+	}
+
+	authData := assertion.AuthenticatorData
+	if len(authData) < authenticatorDataMinSize {
+		return fmt.Errorf("authenticator data is too short: %d bytes", len(authData))
+	}
+	rpIDHash := sha256.Sum256([]byte(m.RPID))
+	if !bytes.Equal(authData[:32], rpIDHash[:]) {
+		return errors.New("authenticator data is not for the relying party of the key")
+	}
+	if authData[32]&flagUserPresent == 0 {
+		return errors.New("user is not present")
+	}
+
+	var cd clientData
+	if err := json.Unmarshal(assertion.ClientDataJSON, &cd); err != nil {
+		return fmt.Errorf("invalid client data: %w", err)
+	}
+	if cd.Type != clientDataTypeGet {
+		return fmt.Errorf("invalid client data type %q, expected %q", cd.Type, clientDataTypeGet)
+	}
+	challenge, err := base64.RawURLEncoding.DecodeString(cd.Challenge)
+	if err != nil {
+		return fmt.Errorf("invalid client data challenge: %w", err)
+	}
+	if !bytes.Equal(challenge, Challenge(msg)) {
+		return errors.New("client data challenge does not match the sign bytes")
+	}
+
+	clientDataHash := sha256.Sum256(assertion.ClientDataJSON)
+	signed := make([]byte, 0, len(authData)+len(clientDataHash))
+	signed = append(signed, authData...)
+	signed = append(signed, clientDataHash[:]...)
+	digest := sha256.Sum256(signed)
+	if !ecdsa.VerifyASN1(pubKey, digest[:], assertion.Signature) {
+		return errors.New("invalid assertion signature")
+	}
+
+	return nil
+}
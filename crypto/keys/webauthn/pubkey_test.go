@@ -0,0 +1,172 @@
+package webauthn_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
+)
+
+const rpID = "wallet.example.com"
+
+// authenticator simulates a WebAuthn authenticator holding a passkey.
+type authenticator struct {
+	priv *ecdsa.PrivateKey
+	rpID string
+}
+
+func newAuthenticator(t testing.TB) *authenticator {
+	t.Helper()
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return &authenticator{priv: priv, rpID: rpID}
+}
+
+func (a *authenticator) pubKey() *webauthn.PubKey {
+	return &webauthn.PubKey{
+		Key:  elliptic.MarshalCompressed(elliptic.P256(), a.priv.X, a.priv.Y),
+		RPID: a.rpID,
+	}
+}
+
+// sign returns the assertion of challenge, with the given authenticator data flags.
+func (a *authenticator) sign(t testing.TB, challenge []byte, flags byte) *webauthn.Signature {
+	t.Helper()
+
+	rpIDHash := sha256.Sum256([]byte(a.rpID))
+	authData := append(rpIDHash[:], flags, 0, 0, 0, 1)
+	clientDataJSON := []byte(fmt.Sprintf(`{"type":"webauthn.get","challenge":%q,"origin":"https://%s","crossOrigin":false}`,
+		base64.RawURLEncoding.EncodeToString(challenge), a.rpID))
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.priv, digest[:])
+	require.NoError(t, err)
+
+	return &webauthn.Signature{
+		AuthenticatorData: authData,
+		ClientDataJSON:    clientDataJSON,
+		Signature:         sig,
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	a := newAuthenticator(t)
+	pk := a.pubKey()
+	msg := []byte("sign bytes")
+
+	sig, err := a.sign(t, webauthn.Challenge(msg), 0x05).Marshal()
+	require.NoError(t, err)
+	require.True(t, pk.VerifySignature(msg, sig))
+	require.False(t, pk.VerifySignature([]byte("other sign bytes"), sig))
+	require.False(t, pk.VerifySignature(msg, sig[1:]))
+	require.False(t, newAuthenticator(t).pubKey().VerifySignature(msg, sig))
+}
+
+func TestVerifyAssertion(t *testing.T) {
+	a := newAuthenticator(t)
+	pk := a.pubKey()
+	msg := []byte("sign bytes")
+
+	testCases := []struct {
+		name     string
+		malleate func(*webauthn.Signature)
+		expErr   string
+	}{
+		{"valid", func(*webauthn.Signature) {}, ""},
+		{
+			"wrong challenge",
+			func(s *webauthn.Signature) { *s = *a.sign(t, webauthn.Challenge([]byte("other")), 0x01) },
+			"challenge does not match",
+		},
+		{
+			"user not present",
+			func(s *webauthn.Signature) { *s = *a.sign(t, webauthn.Challenge(msg), 0x04) },
+			"user is not present",
+		},
+		{
+			"wrong relying party",
+			func(s *webauthn.Signature) {
+				other := &authenticator{priv: a.priv, rpID: "evil.example.com"}
+				*s = *other.sign(t, webauthn.Challenge(msg), 0x01)
+			},
+			"relying party",
+		},
+		{
+			"wrong client data type",
+			func(s *webauthn.Signature) {
+				s.ClientDataJSON = []byte(fmt.Sprintf(`{"type":"webauthn.create","challenge":%q}`,
+					base64.RawURLEncoding.EncodeToString(webauthn.Challenge(msg))))
+			},
+			"invalid client data type",
+		},
+		{
+			"tampered client data",
+			func(s *webauthn.Signature) { s.ClientDataJSON = append(s.ClientDataJSON, ' ') },
+			"invalid assertion signature",
+		},
+		{
+			"tampered authenticator data",
+			func(s *webauthn.Signature) { s.AuthenticatorData[36]++ },
+			"invalid assertion signature",
+		},
+		{
+			"short authenticator data",
+			func(s *webauthn.Signature) { s.AuthenticatorData = s.AuthenticatorData[:36] },
+			"too short",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sig := a.sign(t, webauthn.Challenge(msg), 0x01)
+			tc.malleate(sig)
+
+			err := pk.VerifyAssertion(msg, sig)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestPubKey(t *testing.T) {
+	a := newAuthenticator(t)
+	pk := a.pubKey()
+
+	require.Equal(t, "webauthn", pk.Type())
+	require.Len(t, pk.Bytes(), 33)
+	require.True(t, pk.Equals(a.pubKey()))
+
+	// the relying party is part of the key identity
+	other := &webauthn.PubKey{Key: pk.Key, RPID: "other.example.com"}
+	require.False(t, pk.Equals(other))
+	require.NotEqual(t, pk.Address(), other.Address())
+
+	_, err := (&webauthn.PubKey{Key: pk.Key[1:], RPID: rpID}).ECDSA()
+	require.Error(t, err)
+}
+
+func BenchmarkVerifySignature(b *testing.B) {
+	a := newAuthenticator(b)
+	pk := a.pubKey()
+	msg := make([]byte, 1000)
+	sig, err := a.sign(b, webauthn.Challenge(msg), 0x01).Marshal()
+	require.NoError(b, err)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		require.True(b, pk.VerifySignature(msg, sig))
+	}
+}
@@ -102,6 +102,39 @@ func invoke(ctr *container, key *moduleKey, invokers []interface{}) error {
 	return nil
 }
 
+// Decorate defines a container configuration which registers decorators for
+// types provided by other providers, for instance to wrap a keeper with a
+// metering proxy without replacing its provider. A decorator for type T must
+// return a single T, optionally followed by an error, and have exactly one
+// input parameter of type T which receives the provided value. Its other input
+// parameters are resolved like those of providers.
+//
+// Decorators for the same type are applied in the order in which they were
+// registered, each receiving the value returned by the previous one, and are
+// called at most once, when the type is first resolved. Every provider and
+// invoker depending on T then receives the decorated value. Only types which
+// are provided once per container can be decorated, and the dependencies of a
+// decorator can't depend on the type it decorates.
+//
+// All decorator functions must be declared, exported functions not
+// internal packages and all of their input and output types must also be declared
+// and exported and not in internal packages.
+func Decorate(decorators ...interface{}) Config {
+	return containerConfig(func(ctr *container) error {
+		for _, d := range decorators {
+			rc, err := extractProviderDescriptor(d)
+			if err != nil {
+				return fmt.Errorf("%w\n%s", err, getStackTrace())
+			}
+			err = ctr.addDecorator(&rc)
+			if err != nil {
+				return fmt.Errorf("%w\n%s", err, getStackTrace())
+			}
+		}
+		return nil
+	})
+}
+
 // BindInterface defines a container configuration for an explicit interface binding of inTypeName to outTypeName
 // in global scope.  The example below demonstrates a configuration where the container always provides a Canvasback
 // instance when an interface of type Duck is requested as an input.
@@ -15,6 +15,10 @@ type container struct {
 	resolvers         map[string]resolver
 	interfaceBindings map[string]interfaceBinding
 	invokers          []invoker
+	lifecycle         *Lifecycle
+	decorators        map[reflect.Type][]*decorator
+	decorated         map[reflect.Type]reflect.Value
+	decorating        map[reflect.Type]bool
 
 	moduleKeyContext *ModuleKeyContext
 
@@ -49,6 +53,10 @@ func newContainer(cfg *debugConfig) *container {
 		resolvers:         map[string]resolver{},
 		moduleKeyContext:  &ModuleKeyContext{},
 		interfaceBindings: map[string]interfaceBinding{},
+		lifecycle:         &Lifecycle{},
+		decorators:        map[reflect.Type][]*decorator{},
+		decorated:         map[reflect.Type]reflect.Value{},
+		decorating:        map[reflect.Type]bool{},
 		callerStack:       nil,
 		callerMap:         map[Location]bool{},
 	}
@@ -258,6 +266,10 @@ func (c *container) addNode(provider *providerDescriptor, key *moduleKey) (inter
 					typ, typ.Elem())
 			}
 
+			if typ == lifecycleType {
+				return nil, fmt.Errorf("%v is provided by the container and can't be provided by %s", typ, provider.Location)
+			}
+
 			// many-per-container slices of many-per-container types
 			if isManyPerContainerSliceType(typ) {
 				typ = typ.Elem()
@@ -313,6 +325,10 @@ func (c *container) addNode(provider *providerDescriptor, key *moduleKey) (inter
 
 		c.logf("Registering resolver for module-scoped type %v", typ)
 
+		if typ == lifecycleType {
+			return nil, fmt.Errorf("%v is provided by the container and can't be provided by %s", typ, provider.Location)
+		}
+
 		existing, ok := c.resolverByType(typ)
 		if ok {
 			return nil, fmt.Errorf("duplicate provision of type %v by module-scoped provider %s\n\talready provided by %s",
@@ -341,6 +357,10 @@ func (c *container) supply(value reflect.Value, location Location) error {
 	typeGraphNode := c.typeGraphNode(typ)
 	c.addGraphEdge(locGrapNode, typeGraphNode)
 
+	if typ == lifecycleType {
+		return fmt.Errorf("%v is provided by the container and can't be supplied", typ)
+	}
+
 	if existing, ok := c.resolverByType(typ); ok {
 		return duplicateDefinitionError(typ, location, existing.describeLocation())
 	}
@@ -392,6 +412,13 @@ func (c *container) resolve(in providerInput, moduleKey *moduleKey, caller Locat
 		return reflect.ValueOf(OwnModuleKey{moduleKey}), nil
 	}
 
+	if in.Type == lifecycleType {
+		c.logf("Providing Lifecycle")
+		markGraphNodeAsUsed(typeGraphNode)
+		c.resolveStack = c.resolveStack[:len(c.resolveStack)-1]
+		return reflect.ValueOf(c.lifecycle), nil
+	}
+
 	vr, err := c.getResolver(in.Type, moduleKey)
 	if err != nil {
 		return reflect.Value{}, err
@@ -414,6 +441,14 @@ func (c *container) resolve(in providerInput, moduleKey *moduleKey, caller Locat
 		return reflect.Value{}, err
 	}
 
+	if len(c.decorators[in.Type]) > 0 {
+		res, err = c.decorate(in.Type, vr, res)
+		if err != nil {
+			markGraphNodeAsFailed(typeGraphNode)
+			return reflect.Value{}, err
+		}
+	}
+
 	markGraphNodeAsUsed(typeGraphNode)
 
 	c.resolveStack = c.resolveStack[:len(c.resolveStack)-1]
@@ -547,6 +547,33 @@ func TestManyPerContainer(t *testing.T) {
 	)
 }
 
+func CollectManyPerContainerIntsVariadic(xs ...ManyPerContainerInt) string {
+	return CollectManyPerContainerInts(xs)
+}
+
+func TestVariadicManyPerContainer(t *testing.T) {
+	var sum string
+	require.NoError(t,
+		depinject.Inject(
+			depinject.Provide(
+				ManyPerContainerInt4, ManyPerContainerInt9,
+				CollectManyPerContainerIntsVariadic,
+			),
+			&sum,
+		),
+	)
+	require.Equal(t, "13", sum)
+
+	require.NoError(t,
+		depinject.Inject(
+			depinject.Provide(CollectManyPerContainerIntsVariadic),
+			&sum,
+		),
+		"no providers",
+	)
+	require.Equal(t, "0", sum)
+}
+
 func TestSupply(t *testing.T) {
 	var x int
 	require.NoError(t,
@@ -0,0 +1,121 @@
+package depinject
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// decorator is a function registered with Decorate which receives the value
+// provided for typ and returns the value which is injected instead.
+type decorator struct {
+	provider *providerDescriptor
+	typ      reflect.Type
+	// idx is the index of the input parameter receiving the decorated value.
+	idx int
+}
+
+func (c *container) addDecorator(provider *providerDescriptor) error {
+	if len(provider.Outputs) != 1 {
+		return fmt.Errorf("decorator %s must return exactly one value besides an optional error", provider.Location)
+	}
+
+	typ := provider.Outputs[0].Type
+	switch {
+	case typ == moduleKeyType || typ == ownModuleKeyType || typ == lifecycleType:
+		return fmt.Errorf("%v is provided by the container and can't be decorated by %s", typ, provider.Location)
+	case isManyPerContainerType(typ) || isManyPerContainerSliceType(typ):
+		return fmt.Errorf("many-per-container type %v can't be decorated by %s", typ, provider.Location)
+	case isOnePerModuleType(typ) || isOnePerModuleMapType(typ):
+		return fmt.Errorf("one-per-module type %v can't be decorated by %s", typ, provider.Location)
+	}
+
+	idx := -1
+	for i, in := range provider.Inputs {
+		if in.Type != typ {
+			continue
+		}
+
+		if idx >= 0 {
+			return fmt.Errorf("decorator %s has more than one input parameter of type %v", provider.Location, typ)
+		}
+		idx = i
+	}
+
+	if idx < 0 {
+		return fmt.Errorf("decorator %s must have an input parameter of its output type %v", provider.Location, typ)
+	}
+
+	c.logf("Registering decorator %s for %v", provider.Location, typ)
+	decoratorGraphNode := c.locationGraphNode(provider.Location, nil)
+	typeGraphNode := c.typeGraphNode(typ)
+	c.addGraphEdge(typeGraphNode, decoratorGraphNode)
+	c.addGraphEdge(decoratorGraphNode, typeGraphNode)
+
+	c.decorators[typ] = append(c.decorators[typ], &decorator{
+		provider: provider,
+		typ:      typ,
+		idx:      idx,
+	})
+
+	return nil
+}
+
+// decorate applies the decorators registered for typ to the value resolved by
+// vr, in the order in which they were registered. The decorated value is
+// cached so that each decorator is called at most once.
+func (c *container) decorate(typ reflect.Type, vr resolver, value reflect.Value) (reflect.Value, error) {
+	if decorated, ok := c.decorated[typ]; ok {
+		return decorated, nil
+	}
+
+	switch vr.(type) {
+	case *simpleResolver, *supplyResolver:
+	default:
+		return reflect.Value{}, fmt.Errorf("%v can't be decorated because it isn't provided once per container by %s",
+			typ, vr.describeLocation())
+	}
+
+	// a decorator depending on the type it decorates, directly or through
+	// other providers, would otherwise be called recursively
+	if c.decorating[typ] {
+		return reflect.Value{}, fmt.Errorf("cyclic dependency while decorating %v:\n%s", typ, c.formatResolveStack())
+	}
+
+	c.decorating[typ] = true
+	defer delete(c.decorating, typ)
+
+	for _, d := range c.decorators[typ] {
+		loc := d.provider.Location
+		graphNode := c.locationGraphNode(loc, nil)
+		markGraphNodeAsFailed(graphNode)
+
+		c.logf("Resolving dependencies for decorator %s", loc)
+		c.indentLogger()
+		inVals := make([]reflect.Value, len(d.provider.Inputs))
+		for i, in := range d.provider.Inputs {
+			if i == d.idx {
+				inVals[i] = value
+				continue
+			}
+
+			val, err := c.resolve(in, nil, loc)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			inVals[i] = val
+		}
+		c.dedentLogger()
+
+		c.logf("Decorating %v with %s", typ, loc)
+		out, err := d.provider.Fn(inVals)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("error calling decorator %s: %w", loc, err)
+		}
+
+		markGraphNodeAsUsed(graphNode)
+		value = out[0]
+	}
+
+	c.decorated[typ] = value
+	return value, nil
+}
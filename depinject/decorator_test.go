@@ -0,0 +1,171 @@
+package depinject_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+)
+
+type DecoratedKeeper interface {
+	Send(amount int) int
+}
+
+type BaseKeeper struct{}
+
+func (BaseKeeper) Send(amount int) int { return amount }
+
+type MeteringKeeper struct {
+	DecoratedKeeper
+	Meter *Meter
+}
+
+func (k MeteringKeeper) Send(amount int) int {
+	k.Meter.Calls++
+	return k.DecoratedKeeper.Send(amount)
+}
+
+type DoublingKeeper struct {
+	DecoratedKeeper
+}
+
+func (k DoublingKeeper) Send(amount int) int {
+	return 2 * k.DecoratedKeeper.Send(amount)
+}
+
+type Meter struct {
+	Calls int
+}
+
+type KeeperUser struct {
+	Keeper DecoratedKeeper
+}
+
+func ProvideDecoratedKeeper() DecoratedKeeper {
+	return BaseKeeper{}
+}
+
+func ProvideMeter() *Meter {
+	return &Meter{}
+}
+
+func ProvideKeeperUser(keeper DecoratedKeeper) KeeperUser {
+	return KeeperUser{Keeper: keeper}
+}
+
+func DecorateMetering(keeper DecoratedKeeper, meter *Meter) DecoratedKeeper {
+	return MeteringKeeper{DecoratedKeeper: keeper, Meter: meter}
+}
+
+func DecorateDoubling(keeper DecoratedKeeper) DecoratedKeeper {
+	return DoublingKeeper{DecoratedKeeper: keeper}
+}
+
+func TestDecorate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		user   KeeperUser
+		keeper DecoratedKeeper
+		meter  *Meter
+	)
+	require.NoError(t, depinject.Inject(
+		depinject.Configs(
+			depinject.Provide(ProvideDecoratedKeeper, ProvideMeter, ProvideKeeperUser),
+			depinject.Decorate(DecorateMetering, DecorateDoubling),
+		),
+		&user, &keeper, &meter,
+	))
+
+	// decorators are applied in order and called once
+	require.Equal(t, DoublingKeeper{MeteringKeeper{BaseKeeper{}, meter}}, keeper)
+	require.Equal(t, keeper, user.Keeper)
+	require.Equal(t, 4, user.Keeper.Send(2))
+	require.Equal(t, 1, meter.Calls)
+}
+
+func TestDecorateSupplied(t *testing.T) {
+	t.Parallel()
+
+	var keeper DecoratedKeeper
+	require.NoError(t, depinject.Inject(
+		depinject.Configs(
+			depinject.Decorate(DecorateDoubling),
+			depinject.Supply(DecoratedKeeper(BaseKeeper{})),
+		),
+		&keeper,
+	))
+	require.Equal(t, DoublingKeeper{BaseKeeper{}}, keeper)
+}
+
+func DecorateKeeperError(DecoratedKeeper) (DecoratedKeeper, error) {
+	return nil, errors.New("can't decorate")
+}
+
+func DecorateWithoutInput(*Meter) DecoratedKeeper {
+	return BaseKeeper{}
+}
+
+func DecorateCommand(c Command) Command {
+	return c
+}
+
+func ProvideMeterFromUser(KeeperUser) *Meter {
+	return &Meter{}
+}
+
+func TestDecorateErrors(t *testing.T) {
+	t.Parallel()
+
+	var keeper DecoratedKeeper
+	require.ErrorContains(t,
+		depinject.Inject(
+			depinject.Configs(
+				depinject.Provide(ProvideDecoratedKeeper),
+				depinject.Decorate(DecorateKeeperError),
+			),
+			&keeper,
+		),
+		"can't decorate",
+	)
+
+	require.ErrorContains(t,
+		depinject.Inject(depinject.Decorate(DecorateWithoutInput), &keeper),
+		"must have an input parameter of its output type",
+	)
+
+	var commands []Command
+	require.ErrorContains(t,
+		depinject.Inject(depinject.Decorate(DecorateCommand), &commands),
+		"many-per-container type",
+	)
+
+	require.ErrorContains(t,
+		depinject.Inject(
+			depinject.Configs(
+				depinject.Provide(ProvideDecoratedKeeper, ProvideKeeperUser, ProvideMeterFromUser),
+				depinject.Decorate(DecorateMetering),
+			),
+			&keeper,
+		),
+		"cyclic dependency",
+	)
+}
+
+func TestExplainDecorate(t *testing.T) {
+	t.Parallel()
+
+	var user KeeperUser
+	explanation, err := depinject.Explain(
+		depinject.Configs(
+			depinject.Provide(ProvideDecoratedKeeper, ProvideMeter, ProvideKeeperUser),
+			depinject.Decorate(DecorateMetering),
+		),
+		&user,
+	)
+	require.NoError(t, err)
+	require.Contains(t, explanation, "decorated by cosmossdk.io/depinject_test.DecorateMetering")
+	require.Contains(t, explanation, "provided by cosmossdk.io/depinject_test.ProvideMeter")
+}
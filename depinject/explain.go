@@ -0,0 +1,187 @@
+package depinject
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Explain describes how the container specified by containerConfig resolves
+// each of the provided outputs, which must be pointers like the outputs of
+// Inject. No provider or invoker is called.
+//
+// For each type, the explanation names the provider or supplied value which
+// resolves it, followed by how the dependencies of that provider are resolved,
+// recursively. When a type can't be resolved, the reason is given instead,
+// such as a missing provider or ambiguous interface implementations. An error
+// is only returned if the container configuration itself is invalid.
+//
+// Ex:
+//
+//	var app *App
+//	explanation, err := Explain(config, &app)
+func Explain(containerConfig Config, outputs ...interface{}) (string, error) {
+	cfg, err := newDebugConfig()
+	if err != nil {
+		return "", err
+	}
+
+	ctr := newContainer(cfg)
+	if err := containerConfig.apply(ctr); err != nil {
+		return "", err
+	}
+
+	var inputs []providerInput
+	for _, output := range outputs {
+		typ := reflect.TypeOf(output)
+		if typ == nil || typ.Kind() != reflect.Pointer {
+			return "", fmt.Errorf("output type must be a pointer, %v is invalid", typ)
+		}
+
+		inputs = append(inputs, providerInput{Type: typ.Elem()})
+	}
+
+	desc, err := expandStructArgsProvider(providerDescriptor{Inputs: inputs, Location: LocationFromCaller(1)})
+	if err != nil {
+		return "", err
+	}
+
+	e := &explainer{
+		ctr:       ctr,
+		buf:       &strings.Builder{},
+		explained: map[*providerDescriptor]bool{},
+		visiting:  map[*providerDescriptor]bool{},
+	}
+	for _, in := range desc.Inputs {
+		e.explainInput(in, nil, "")
+	}
+
+	return e.buf.String(), nil
+}
+
+type explainer struct {
+	ctr *container
+	buf *strings.Builder
+	// explained contains the providers whose dependencies were already
+	// explained, which aren't explained again.
+	explained map[*providerDescriptor]bool
+	// visiting contains the providers whose dependencies are being explained,
+	// in order to detect cycles.
+	visiting map[*providerDescriptor]bool
+}
+
+func (e *explainer) printf(indent, format string, args ...interface{}) {
+	_, _ = fmt.Fprintf(e.buf, indent+format+"\n", args...)
+}
+
+func (e *explainer) explainInput(in providerInput, key *moduleKey, indent string) {
+	typ := in.Type
+	name := moreUsefulTypeString(typ)
+	if in.Optional {
+		name += " (optional)"
+	}
+	e.printf(indent, "%s", name)
+	indent += "  "
+
+	switch typ {
+	case moduleKeyType, ownModuleKeyType:
+		if key == nil {
+			e.printf(indent, "can't be resolved: not inside of any module's scope")
+		} else {
+			e.printf(indent, "provided by the container for module %s", key.name)
+		}
+		return
+	case lifecycleType:
+		e.printf(indent, "provided by the container")
+		return
+	}
+
+	r, err := e.ctr.getResolver(typ, key)
+	if err != nil {
+		e.printf(indent, "can't be resolved: %s", strings.ReplaceAll(err.Error(), "\n", "\n"+indent))
+		return
+	}
+
+	switch r := r.(type) {
+	case nil:
+		switch {
+		case in.Optional:
+			e.printf(indent, "not provided, the zero value is used")
+		case typ.Kind() == reflect.Interface:
+			e.printf(indent, "can't be resolved: no provided type implements it")
+		default:
+			e.printf(indent, "can't be resolved: no provider or supplied value for this type")
+		}
+	case *supplyResolver:
+		e.printf(indent, "supplied by %s", r.loc.Name())
+	case *simpleResolver:
+		if r.getType() != typ {
+			e.printf(indent, "bound to %s", moreUsefulTypeString(r.getType()))
+		}
+		e.printf(indent, "provided by %s", r.node.provider.Location.Name())
+		e.explainProvider(r.node.provider, r.node.moduleKey, indent, -1)
+	case *moduleDepResolver:
+		e.printf(indent, "provided for each module by %s", r.node.provider.Location.Name())
+		e.explainProvider(r.node.provider, key, indent, -1)
+	case *groupResolver:
+		e.printf(indent, "can't be resolved: %v is a many-per-container type, use %v instead", r.typ, r.sliceType)
+	case *sliceGroupResolver:
+		e.printf(indent, "many-per-container type collected from %d provider(s)", len(r.providers))
+		for _, node := range r.providers {
+			e.printf(indent, "provided by %s", node.provider.Location.Name())
+			e.explainProvider(node.provider, node.moduleKey, indent, -1)
+		}
+	case *onePerModuleResolver:
+		e.printf(indent, "can't be resolved: %v is a one-per-module type, use %v instead", r.typ, r.mapType)
+	case *mapOfOnePerModuleResolver:
+		keys := make([]*moduleKey, 0, len(r.providers))
+		for k := range r.providers {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].name < keys[j].name
+		})
+
+		e.printf(indent, "one-per-module type collected from %d module(s)", len(keys))
+		for _, k := range keys {
+			node := r.providers[k]
+			e.printf(indent, "provided by %s in module %s", node.provider.Location.Name(), k.name)
+			e.explainProvider(node.provider, node.moduleKey, indent, -1)
+		}
+	default:
+		e.printf(indent, "resolved by %s", r.describeLocation())
+	}
+
+	if r == nil {
+		return
+	}
+
+	for _, d := range e.ctr.decorators[typ] {
+		e.printf(indent, "decorated by %s", d.provider.Location.Name())
+		e.explainProvider(d.provider, nil, indent, d.idx)
+	}
+}
+
+// explainProvider explains how the inputs of provider are resolved, except for
+// the input at skipIdx, which is the decorated value for decorators.
+func (e *explainer) explainProvider(provider *providerDescriptor, key *moduleKey, indent string, skipIdx int) {
+	if e.visiting[provider] {
+		e.printf(indent, "cyclic dependency: %s depends on itself", provider.Location.Name())
+		return
+	}
+
+	if e.explained[provider] && len(provider.Inputs) > 0 {
+		e.printf(indent, "dependencies of %s explained above", provider.Location.Name())
+		return
+	}
+
+	e.visiting[provider] = true
+	for i, in := range provider.Inputs {
+		if i != skipIdx {
+			e.explainInput(in, key, indent+"  ")
+		}
+	}
+	delete(e.visiting, provider)
+	e.explained[provider] = true
+}
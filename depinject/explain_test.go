@@ -0,0 +1,101 @@
+package depinject_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+)
+
+type ExplainKeeper struct{}
+
+type ExplainHandler struct{}
+
+type ExplainMissing struct{}
+
+type ExplainService interface {
+	Serve()
+}
+
+type ExplainServiceA struct{}
+
+func (ExplainServiceA) Serve() {}
+
+type ExplainServiceB struct{}
+
+func (ExplainServiceB) Serve() {}
+
+func ProvideExplainKeeper(depinject.OwnModuleKey, string) ExplainKeeper {
+	return ExplainKeeper{}
+}
+
+func ProvideExplainHandler(ExplainKeeper) ExplainHandler {
+	return ExplainHandler{}
+}
+
+func ProvideExplainMissing(ExplainMissing) ExplainHandler {
+	return ExplainHandler{}
+}
+
+func ProvideExplainServiceA() ExplainServiceA {
+	return ExplainServiceA{}
+}
+
+func ProvideExplainServiceB() ExplainServiceB {
+	return ExplainServiceB{}
+}
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+
+	var handler ExplainHandler
+	explanation, err := depinject.Explain(
+		depinject.Configs(
+			depinject.Supply("foo"),
+			depinject.Provide(ProvideExplainHandler),
+			depinject.ProvideInModule("a", ProvideExplainKeeper),
+		),
+		&handler,
+	)
+	require.NoError(t, err)
+	require.Contains(t, explanation, "depinject_test.ExplainHandler\n  provided by cosmossdk.io/depinject_test.ProvideExplainHandler")
+	require.Contains(t, explanation, "provided by cosmossdk.io/depinject_test.ProvideExplainKeeper")
+	require.Contains(t, explanation, "provided by the container for module a")
+	require.Contains(t, explanation, "supplied by")
+	require.NotContains(t, explanation, "can't be resolved")
+
+	// explaining doesn't call any provider, so handler is unchanged
+	require.Equal(t, ExplainHandler{}, handler)
+}
+
+func TestExplainUnresolved(t *testing.T) {
+	t.Parallel()
+
+	var handler ExplainHandler
+	explanation, err := depinject.Explain(depinject.Provide(ProvideExplainMissing), &handler)
+	require.NoError(t, err)
+	require.Contains(t, explanation, "depinject_test.ExplainMissing\n      can't be resolved: no provider")
+
+	var service ExplainService
+	explanation, err = depinject.Explain(depinject.Provide(ProvideExplainServiceA, ProvideExplainServiceB), &service)
+	require.NoError(t, err)
+	require.Contains(t, explanation, "can't be resolved: Multiple implementations found")
+
+	explanation, err = depinject.Explain(
+		depinject.Configs(
+			depinject.Provide(ProvideExplainServiceA, ProvideExplainServiceB),
+			depinject.BindInterface("cosmossdk.io/depinject_test/depinject_test.ExplainService", "cosmossdk.io/depinject_test/depinject_test.ExplainServiceB"),
+		),
+		&service,
+	)
+	require.NoError(t, err)
+	require.Contains(t, explanation, "bound to cosmossdk.io/depinject_test.ExplainServiceB")
+
+	// invalid configs are reported as errors
+	_, err = depinject.Explain(depinject.Provide(ProvideExplainMissing, ProvideExplainMissing), &handler)
+	require.Error(t, err)
+
+	_, err = depinject.Explain(depinject.Configs(), handler)
+	require.ErrorContains(t, err, "must be a pointer")
+}
@@ -10,7 +10,7 @@ import (
 // ManyPerContainerType marks a type which automatically gets grouped together. For an ManyPerContainerType T,
 // T and []T can be declared as output parameters for providers as many times within the container
 // as desired. All of the provided values for T can be retrieved by declaring an
-// []T input parameter.
+// []T input parameter, or a variadic ...T parameter as the last input parameter.
 type ManyPerContainerType interface {
 	// IsManyPerContainerType is a marker function which just indicates that this is a many-per-container type.
 	IsManyPerContainerType()
@@ -0,0 +1,115 @@
+package depinject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Lifecycle is a special type which allows providers to register hooks that
+// are called when the components built by the container are started and
+// stopped, for instance to start a server or flush a telemetry sink.
+//
+// Providers can declare an input parameter of type *Lifecycle to register
+// hooks with Append. Every provider of a container receives the same
+// *Lifecycle, which can also be requested as an output of Inject in order to
+// call Start and Stop:
+//
+//	var lifecycle *depinject.Lifecycle
+//	err := depinject.Inject(config, &app, &lifecycle)
+//	...
+//	err = lifecycle.Start(ctx)
+//	...
+//	err = lifecycle.Stop(ctx)
+//
+// As a provider is always called after the providers of its dependencies,
+// hooks are appended in dependency order: Start calls the OnStart hooks in
+// that order and Stop calls the OnStop hooks in the reverse order, so that a
+// component is started after and stopped before its dependencies.
+type Lifecycle struct {
+	mtx     sync.Mutex
+	hooks   []Hook
+	started int
+}
+
+// Hook is a pair of functions called when a Lifecycle is started and stopped.
+// Either function may be nil.
+type Hook struct {
+	// OnStart is called by Lifecycle.Start. It should not block; long-running
+	// work should be started in a goroutine and stopped by OnStop.
+	OnStart func(context.Context) error
+
+	// OnStop is called by Lifecycle.Stop if OnStart was called successfully.
+	OnStop func(context.Context) error
+
+	// location is the location of the provider which appended the hook.
+	location Location
+}
+
+var lifecycleType = reflect.TypeOf((*Lifecycle)(nil))
+
+// Append registers a hook. Hooks appended while the lifecycle is running are
+// only called by the next Start.
+func (l *Lifecycle) Append(hook Hook) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	hook.location = LocationFromCaller(1)
+	l.hooks = append(l.hooks, hook)
+}
+
+// Start calls the OnStart hooks in the order in which they were appended. If
+// a hook fails, the hooks which were already started are stopped and the
+// error is returned.
+func (l *Lifecycle) Start(ctx context.Context) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.started != 0 {
+		return errors.New("lifecycle already started")
+	}
+
+	for i, hook := range l.hooks {
+		if err := ctx.Err(); err != nil {
+			return errors.Join(err, l.stop(ctx))
+		}
+
+		if hook.OnStart != nil {
+			if err := hook.OnStart(ctx); err != nil {
+				err = fmt.Errorf("error starting hook appended by %s: %w", hook.location, err)
+				return errors.Join(err, l.stop(ctx))
+			}
+		}
+		l.started = i + 1
+	}
+
+	return nil
+}
+
+// Stop calls the OnStop hooks of the hooks which were started, in the
+// reverse order in which they were appended. All the hooks are stopped even
+// if some of them fail, and the errors are joined.
+func (l *Lifecycle) Stop(ctx context.Context) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	return l.stop(ctx)
+}
+
+func (l *Lifecycle) stop(ctx context.Context) error {
+	var errs []error
+	for ; l.started > 0; l.started-- {
+		hook := l.hooks[l.started-1]
+		if hook.OnStop == nil {
+			continue
+		}
+
+		if err := hook.OnStop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("error stopping hook appended by %s: %w", hook.location, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
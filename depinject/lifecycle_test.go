@@ -0,0 +1,131 @@
+package depinject_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+)
+
+type LifecycleLog struct {
+	events []string
+}
+
+type LifecycleServer struct{}
+
+type LifecycleSink struct{}
+
+func ProvideLifecycleLog() *LifecycleLog {
+	return &LifecycleLog{}
+}
+
+func lifecycleHook(log *LifecycleLog, name string) depinject.Hook {
+	return depinject.Hook{
+		OnStart: func(context.Context) error {
+			log.events = append(log.events, "start "+name)
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			log.events = append(log.events, "stop "+name)
+			return nil
+		},
+	}
+}
+
+// ProvideLifecycleServer depends on LifecycleSink, so it should be started
+// after and stopped before it.
+func ProvideLifecycleServer(lifecycle *depinject.Lifecycle, log *LifecycleLog, _ LifecycleSink) LifecycleServer {
+	lifecycle.Append(lifecycleHook(log, "server"))
+	return LifecycleServer{}
+}
+
+func ProvideLifecycleSink(lifecycle *depinject.Lifecycle, log *LifecycleLog) LifecycleSink {
+	lifecycle.Append(lifecycleHook(log, "sink"))
+	return LifecycleSink{}
+}
+
+func TestLifecycle(t *testing.T) {
+	t.Parallel()
+
+	var (
+		server    LifecycleServer
+		log       *LifecycleLog
+		lifecycle *depinject.Lifecycle
+	)
+	require.NoError(t, depinject.Inject(
+		depinject.Provide(ProvideLifecycleServer, ProvideLifecycleSink, ProvideLifecycleLog),
+		&server, &log, &lifecycle,
+	))
+	require.NotNil(t, lifecycle)
+	require.Empty(t, log.events)
+
+	ctx := context.Background()
+	require.NoError(t, lifecycle.Start(ctx))
+	require.Equal(t, []string{"start sink", "start server"}, log.events)
+	require.ErrorContains(t, lifecycle.Start(ctx), "already started")
+
+	require.NoError(t, lifecycle.Stop(ctx))
+	require.Equal(t, []string{"start sink", "start server", "stop server", "stop sink"}, log.events)
+
+	// stopping again is a no-op and the lifecycle can be restarted
+	require.NoError(t, lifecycle.Stop(ctx))
+	require.NoError(t, lifecycle.Start(ctx))
+	require.Len(t, log.events, 6)
+}
+
+func TestLifecycleStartError(t *testing.T) {
+	t.Parallel()
+
+	var events []string
+	lifecycle := &depinject.Lifecycle{}
+	lifecycle.Append(depinject.Hook{
+		OnStart: func(context.Context) error {
+			events = append(events, "start a")
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			events = append(events, "stop a")
+			return errors.New("stop a failed")
+		},
+	})
+	// hooks without OnStart or OnStop are allowed
+	lifecycle.Append(depinject.Hook{
+		OnStop: func(context.Context) error {
+			events = append(events, "stop b")
+			return nil
+		},
+	})
+	lifecycle.Append(depinject.Hook{
+		OnStart: func(context.Context) error {
+			return errors.New("start c failed")
+		},
+		OnStop: func(context.Context) error {
+			events = append(events, "stop c")
+			return nil
+		},
+	})
+
+	// the hooks which were started are stopped and all the errors are returned
+	err := lifecycle.Start(context.Background())
+	require.ErrorContains(t, err, "start c failed")
+	require.ErrorContains(t, err, "stop a failed")
+	require.Equal(t, []string{"start a", "stop b", "stop a"}, events)
+}
+
+func ProvideLifecycle() *depinject.Lifecycle {
+	return &depinject.Lifecycle{}
+}
+
+func TestLifecycleCannotBeProvided(t *testing.T) {
+	t.Parallel()
+
+	var lifecycle *depinject.Lifecycle
+	err := depinject.Inject(depinject.Provide(ProvideLifecycle), &lifecycle)
+	require.ErrorContains(t, err, "provided by the container")
+
+	err = depinject.Inject(depinject.Supply(&depinject.Lifecycle{}), &lifecycle)
+	require.ErrorContains(t, err, "provided by the container")
+}
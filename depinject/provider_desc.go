@@ -89,11 +89,17 @@ func doExtractProviderDescriptor(ctr interface{}) (providerDescriptor, error) {
 		return providerDescriptor{}, fmt.Errorf("function must not be in an internal package: %s", loc)
 	}
 
+	numIn := typ.NumIn()
+
+	// the variadic parameter is resolved as a slice of all the values of its
+	// element type, so that element type must be a many-per-container type
 	if typ.IsVariadic() {
-		return providerDescriptor{}, fmt.Errorf("variadic function can't be used as a provider: %s", loc)
+		elemType := typ.In(numIn - 1).Elem()
+		if !isManyPerContainerType(elemType) {
+			return providerDescriptor{}, fmt.Errorf("variadic parameter of %s must be a many-per-container type, got %v", loc, elemType)
+		}
 	}
 
-	numIn := typ.NumIn()
 	in := make([]providerInput, numIn)
 	for i := 0; i < numIn; i++ {
 		in[i] = providerInput{
@@ -120,7 +126,12 @@ func doExtractProviderDescriptor(ctr interface{}) (providerDescriptor, error) {
 		Inputs:  in,
 		Outputs: out,
 		Fn: func(values []reflect.Value) ([]reflect.Value, error) {
-			res := val.Call(values)
+			var res []reflect.Value
+			if typ.IsVariadic() {
+				res = val.CallSlice(values)
+			} else {
+				res = val.Call(values)
+			}
 			if errIdx >= 0 {
 				err := res[errIdx]
 				if !err.IsZero() {
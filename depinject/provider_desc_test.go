@@ -51,6 +51,12 @@ func BadOptionalFn(_ BadOptional) int { return 0 }
 
 func Variadic(...float64) int { return 0 }
 
+type VariadicElem int
+
+func (VariadicElem) IsManyPerContainerType() {}
+
+func VariadicManyPerContainer(float64, ...VariadicElem) int { return 0 }
+
 func TestExtractProviderDescriptor(t *testing.T) {
 	var (
 		intType     = reflect.TypeOf(0)
@@ -145,7 +151,14 @@ func TestExtractProviderDescriptor(t *testing.T) {
 			Variadic,
 			nil,
 			nil,
-			"variadic function can't be used",
+			"variadic parameter of",
+		},
+		{
+			"variadic many-per-container",
+			VariadicManyPerContainer,
+			[]providerInput{{Type: float64Type}, {Type: reflect.TypeOf([]VariadicElem{})}},
+			[]providerOutput{{Type: intType}},
+			"",
 		},
 	}
 	for _, tt := range tests {
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protodesc"
@@ -49,6 +50,9 @@ type moduleDB struct {
 	prefix       []byte
 	filesByID    map[uint32]*fileDescriptorDB
 	tablesByName map[protoreflect.FullName]ormtable.Table
+	// snapshotTables are the names of the tables stored in the commitment
+	// store, sorted by name, which are included in snapshots.
+	snapshotTables []protoreflect.FullName
 }
 
 // ModuleDBOptions are options for constructing a ModuleDB.
@@ -169,9 +173,16 @@ func NewModuleDB(schema *ormv1alpha1.ModuleSchemaDescriptor, options ModuleDBOpt
 			}
 
 			db.tablesByName[name] = table
+			if entry.StorageType == ormv1alpha1.StorageType_STORAGE_TYPE_DEFAULT_UNSPECIFIED {
+				db.snapshotTables = append(db.snapshotTables, name)
+			}
 		}
 	}
 
+	sort.Slice(db.snapshotTables, func(i, j int) bool {
+		return db.snapshotTables[i] < db.snapshotTables[j]
+	})
+
 	return db, nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"strings"
 	"testing"
 
@@ -356,6 +357,68 @@ func TestHooks(t *testing.T) {
 	assert.NilError(t, k.Burn(ctx, acct1, denom, 5))
 }
 
+func TestSnapshot(t *testing.T) {
+	db, err := ormdb.NewModuleDB(TestBankSchema, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+	backend := ormtest.NewMemoryBackend()
+	ctx := ormtable.WrapContextDefault(backend)
+	k, err := NewKeeper(db)
+	assert.NilError(t, err)
+
+	runSimpleBankTests(t, k, ctx)
+
+	var payloads [][]byte
+	assert.NilError(t, db.ExportSnapshot(ctx, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	}))
+	// one payload for each of the balance and supply tables
+	assert.Equal(t, 2, len(payloads))
+
+	readPayloads := func(payloads [][]byte) func() ([]byte, error) {
+		return func() ([]byte, error) {
+			if len(payloads) == 0 {
+				return nil, io.EOF
+			}
+			payload := payloads[0]
+			payloads = payloads[1:]
+			return payload, nil
+		}
+	}
+
+	backend2 := ormtest.NewMemoryBackend()
+	ctx2 := ormtable.WrapContextDefault(backend2)
+	assert.NilError(t, db.ImportSnapshot(ctx2, readPayloads(payloads)))
+	testkv.AssertBackendsEqual(t, backend, backend2)
+	testkv.AssertBackendsEqual(t, backend2, backend)
+
+	// the payloads of a table must be consecutive
+	err = db.ImportSnapshot(
+		ormtable.WrapContextDefault(ormtest.NewMemoryBackend()),
+		readPayloads([][]byte{payloads[0], payloads[1], payloads[0]}),
+	)
+	assert.ErrorIs(t, err, ormerrors.InvalidSnapshot)
+
+	// tables which aren't stored in the commitment store are not exported
+	memDB, err := ormdb.NewModuleDB(&ormv1alpha1.ModuleSchemaDescriptor{
+		SchemaFile: []*ormv1alpha1.ModuleSchemaDescriptor_FileEntry{
+			{
+				Id:            1,
+				ProtoFileName: testpb.File_testpb_bank_proto.Path(),
+				StorageType:   ormv1alpha1.StorageType_STORAGE_TYPE_MEMORY,
+			},
+		},
+	}, ormdb.ModuleDBOptions{
+		MemoryStoreService: testStoreService{db: dbm.NewMemDB()},
+	})
+	assert.NilError(t, err)
+	assert.NilError(t, memDB.ExportSnapshot(context.Background(), func([]byte) error {
+		return errors.New("unexpected payload")
+	}))
+	err = memDB.ImportSnapshot(context.Background(), readPayloads(payloads))
+	assert.ErrorIs(t, err, ormerrors.InvalidSnapshot)
+}
+
 type testStoreService struct {
 	db dbm.DB
 }
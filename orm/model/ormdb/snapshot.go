@@ -0,0 +1,128 @@
+package ormdb
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/orm/types/ormerrors"
+)
+
+// ExportSnapshot exports the tables stored in the commitment store in order of
+// their names. Each payload of a table is prefixed with the length-prefixed
+// name of the table. Tables using memory or transient storage are not
+// exported.
+func (m moduleDB) ExportSnapshot(ctx context.Context, payloadWriter func([]byte) error) error {
+	for _, name := range m.snapshotTables {
+		prefix := binary.AppendUvarint(nil, uint64(len(name)))
+		prefix = append(prefix, name...)
+
+		err := m.tablesByName[name].ExportSnapshot(ctx, func(payload []byte) error {
+			bz := make([]byte, 0, len(prefix)+len(payload))
+			bz = append(bz, prefix...)
+			return payloadWriter(append(bz, payload...))
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m moduleDB) ImportSnapshot(ctx context.Context, payloadReader func() ([]byte, error)) error {
+	reader := &moduleSnapshotReader{payloadReader: payloadReader}
+	imported := map[protoreflect.FullName]bool{}
+	for {
+		ok, err := reader.peek()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+
+		name := reader.nextTable
+		table, ok := m.tablesByName[name]
+		if !ok || !m.isSnapshotTable(name) {
+			return ormerrors.InvalidSnapshot.Wrapf("unexpected table %s", name)
+		}
+		if imported[name] {
+			return ormerrors.InvalidSnapshot.Wrapf("table %s was already imported", name)
+		}
+		imported[name] = true
+
+		reader.table = name
+		err = table.ImportSnapshot(ctx, reader.readTablePayload)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (m moduleDB) isSnapshotTable(name protoreflect.FullName) bool {
+	for _, n := range m.snapshotTables {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// moduleSnapshotReader splits the payloads of a module snapshot by table, so
+// that all the consecutive payloads of a table are imported at once.
+type moduleSnapshotReader struct {
+	payloadReader func() ([]byte, error)
+	// table is the table currently being imported.
+	table protoreflect.FullName
+	// nextTable and next are the table name and the payload of the pending
+	// payload, if hasNext is true.
+	nextTable protoreflect.FullName
+	next      []byte
+	hasNext   bool
+}
+
+// peek reads the next payload if there is no pending one, and returns false
+// once all the payloads have been read.
+func (r *moduleSnapshotReader) peek() (bool, error) {
+	if r.hasNext {
+		return true, nil
+	}
+
+	bz, err := r.payloadReader()
+	if errors.Is(err, io.EOF) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	n, read := binary.Uvarint(bz)
+	if read <= 0 || uint64(len(bz)-read) < n {
+		return false, ormerrors.InvalidSnapshot.Wrap("missing table name")
+	}
+
+	end := read + int(n)
+	r.nextTable = protoreflect.FullName(bz[read:end])
+	r.next = bz[end:]
+	r.hasNext = true
+	return true, nil
+}
+
+// readTablePayload returns the next payload of the current table, or io.EOF
+// if the next payload belongs to another table.
+func (r *moduleSnapshotReader) readTablePayload() ([]byte, error) {
+	ok, err := r.peek()
+	if err != nil {
+		return nil, err
+	}
+	if !ok || r.nextTable != r.table {
+		return nil, io.EOF
+	}
+
+	r.hasNext = false
+	return r.next, nil
+}
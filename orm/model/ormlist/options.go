@@ -28,7 +28,10 @@ func Filter(filterFn func(message proto.Message) bool) Option {
 }
 
 // Cursor specifies a cursor after which to restart iteration. Cursor values
-// are returned by iterators and in pagination results.
+// are returned by iterators and in pagination results. They point to an entry
+// of the iterated index, so iteration resumes at the same position even if
+// entries were inserted or deleted in the meantime. A cursor can only be used
+// with the index and the prefix or range of the query it was returned by.
 func Cursor(cursor CursorT) Option {
 	return listinternal.FuncOption(func(options *listinternal.Options) {
 		options.Cursor = cursor
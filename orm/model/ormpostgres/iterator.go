@@ -0,0 +1,158 @@
+package ormpostgres
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/core/store"
+)
+
+// iteratorPageSize is the number of pairs fetched by each query of an
+// iterator. Pages are fully read before being iterated over, so that other
+// queries can be made on the same connection while an iterator is open,
+// which is required when the connection is a transaction.
+const iteratorPageSize = 100
+
+type iterator struct {
+	store   *kvStore
+	start   []byte
+	end     []byte
+	reverse bool
+
+	keys   [][]byte
+	values [][]byte
+	pos    int
+	// done is true once the last page has been fetched.
+	done bool
+	err  error
+}
+
+func (s *kvStore) newIterator(start, end []byte, reverse bool) (store.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errKeyEmpty
+	}
+
+	it := &iterator{
+		store:   s,
+		start:   start,
+		end:     end,
+		reverse: reverse,
+	}
+	it.fetch(nil)
+	if it.err != nil {
+		return nil, it.err
+	}
+
+	return it, nil
+}
+
+// fetch reads the page of pairs following the provided key, or the first
+// page if it is nil.
+func (it *iterator) fetch(after []byte) {
+	var conds []string
+	var args []interface{}
+	addCond := func(op string, key []byte) {
+		args = append(args, key)
+		conds = append(conds, fmt.Sprintf("key %s $%d", op, len(args)))
+	}
+
+	order := "ASC"
+	if !it.reverse {
+		switch {
+		case after != nil:
+			addCond(">", after)
+		case it.start != nil:
+			addCond(">=", it.start)
+		}
+		if it.end != nil {
+			addCond("<", it.end)
+		}
+	} else {
+		order = "DESC"
+		switch {
+		case after != nil:
+			addCond("<", after)
+		case it.end != nil:
+			addCond("<", it.end)
+		}
+		if it.start != nil {
+			addCond(">=", it.start)
+		}
+	}
+
+	query := fmt.Sprintf("SELECT key, value FROM %s", it.store.table)
+	if len(conds) > 0 {
+		query += " WHERE " + strings.Join(conds, " AND ")
+	}
+	query += fmt.Sprintf(" ORDER BY key %s LIMIT %d", order, iteratorPageSize)
+
+	it.keys, it.values, it.pos = nil, nil, 0
+	rows, err := it.store.conn.QueryContext(it.store.ctx, query, args...)
+	if err != nil {
+		it.err = err
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key, value []byte
+		if err := rows.Scan(&key, &value); err != nil {
+			it.err = err
+			return
+		}
+		if value == nil {
+			value = []byte{}
+		}
+		it.keys = append(it.keys, key)
+		it.values = append(it.values, value)
+	}
+	if err := rows.Err(); err != nil {
+		it.err = err
+		return
+	}
+
+	it.done = len(it.keys) < iteratorPageSize
+}
+
+func (it *iterator) Domain() (start, end []byte) {
+	return it.start, it.end
+}
+
+func (it *iterator) Valid() bool {
+	return it.err == nil && it.pos < len(it.keys)
+}
+
+func (it *iterator) Next() {
+	it.assertValid()
+	it.pos++
+	if it.pos == len(it.keys) && !it.done {
+		it.fetch(it.keys[it.pos-1])
+	}
+}
+
+func (it *iterator) Key() []byte {
+	it.assertValid()
+	return it.keys[it.pos]
+}
+
+func (it *iterator) Value() []byte {
+	it.assertValid()
+	return it.values[it.pos]
+}
+
+func (it *iterator) Error() error {
+	return it.err
+}
+
+func (it *iterator) Close() error {
+	it.keys, it.values = nil, nil
+	return nil
+}
+
+func (it *iterator) assertValid() {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+}
+
+var _ store.Iterator = &iterator{}
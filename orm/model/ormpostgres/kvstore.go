@@ -0,0 +1,161 @@
+// Package ormpostgres provides a kv-store backed by a PostgreSQL table which
+// can be used as an ORM backend, so that off-chain services such as indexers
+// can replay state into a relational database using the same ormtable
+// schemas as the chain.
+//
+// Only the database/sql package is used, so any PostgreSQL driver can be
+// registered by the caller.
+package ormpostgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/orm/model/ormtable"
+)
+
+// DBConn is an interface that abstracts the *sql.DB, *sql.Tx and *sql.Conn types.
+type DBConn interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// CreateTable creates the table storing the kv-store pairs if it doesn't
+// already exist.
+func CreateTable(ctx context.Context, conn DBConn, tableName string) error {
+	_, err := conn.ExecContext(ctx, fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s (key BYTEA PRIMARY KEY, value BYTEA NOT NULL)",
+		quoteIdentifier(tableName),
+	))
+	return err
+}
+
+// BackendOptions defines options for creating a Backend.
+type BackendOptions struct {
+	// CommitmentTable is the name of the table storing the commitment store.
+	CommitmentTable string
+
+	// IndexTable is an optional name of the table storing the index store.
+	// If it is empty, the commitment table is used.
+	IndexTable string
+
+	// ValidateHooks are optional hooks into ORM insert, update and delete
+	// operations.
+	ValidateHooks ormtable.ValidateHooks
+
+	// WriteHooks are optional hooks which are called after ORM insert, update
+	// and delete operations.
+	WriteHooks ormtable.WriteHooks
+}
+
+// NewBackend returns an ORM backend storing its data in the provided tables,
+// which must have been created with CreateTable. All the queries are made
+// with the provided context and connection, so a backend created with a
+// *sql.Tx can be used to apply the writes of a block atomically.
+func NewBackend(ctx context.Context, conn DBConn, options BackendOptions) ormtable.Backend {
+	commitmentStore := NewKVStore(ctx, conn, options.CommitmentTable)
+	indexStore := commitmentStore
+	if options.IndexTable != "" {
+		indexStore = NewKVStore(ctx, conn, options.IndexTable)
+	}
+
+	return ormtable.NewBackend(ormtable.BackendOptions{
+		CommitmentStore: commitmentStore,
+		IndexStore:      indexStore,
+		ValidateHooks:   options.ValidateHooks,
+		WriteHooks:      options.WriteHooks,
+	})
+}
+
+// NewKVStore returns a kv-store storing its pairs in the provided table, which
+// must have been created with CreateTable.
+func NewKVStore(ctx context.Context, conn DBConn, tableName string) store.KVStore {
+	table := quoteIdentifier(tableName)
+	return &kvStore{
+		ctx:         ctx,
+		conn:        conn,
+		table:       table,
+		getQuery:    fmt.Sprintf("SELECT value FROM %s WHERE key = $1", table),
+		setQuery:    fmt.Sprintf("INSERT INTO %s (key, value) VALUES ($1, $2) ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value", table),
+		deleteQuery: fmt.Sprintf("DELETE FROM %s WHERE key = $1", table),
+	}
+}
+
+type kvStore struct {
+	ctx         context.Context
+	conn        DBConn
+	table       string
+	getQuery    string
+	setQuery    string
+	deleteQuery string
+}
+
+var errKeyEmpty = errors.New("key cannot be empty")
+
+func (s *kvStore) Get(key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, errKeyEmpty
+	}
+
+	var value []byte
+	err := s.conn.QueryRowContext(s.ctx, s.getQuery, key).Scan(&value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// nil means that the key doesn't exist, so empty values are returned
+	// as an empty slice
+	if value == nil {
+		value = []byte{}
+	}
+
+	return value, nil
+}
+
+func (s *kvStore) Has(key []byte) (bool, error) {
+	value, err := s.Get(key)
+	return value != nil, err
+}
+
+func (s *kvStore) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+	if value == nil {
+		return errors.New("value cannot be nil")
+	}
+
+	_, err := s.conn.ExecContext(s.ctx, s.setQuery, key, value)
+	return err
+}
+
+func (s *kvStore) Delete(key []byte) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+
+	_, err := s.conn.ExecContext(s.ctx, s.deleteQuery, key)
+	return err
+}
+
+func (s *kvStore) Iterator(start, end []byte) (store.Iterator, error) {
+	return s.newIterator(start, end, false)
+}
+
+func (s *kvStore) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	return s.newIterator(start, end, true)
+}
+
+// quoteIdentifier quotes a table name so that it is case-sensitive and can't
+// clash with reserved names.
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
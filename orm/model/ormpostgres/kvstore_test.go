@@ -0,0 +1,186 @@
+package ormpostgres_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	_ "github.com/mattn/go-sqlite3"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/orm/internal/testkv"
+	"cosmossdk.io/orm/internal/testpb"
+	"cosmossdk.io/orm/model/ormpostgres"
+	"cosmossdk.io/orm/model/ormtable"
+)
+
+// The queries made by the kv-store are portable across PostgreSQL and SQLite,
+// so these tests use SQLite to avoid depending on a PostgreSQL server.
+func openDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "orm.db"))
+	assert.NilError(t, err)
+	t.Cleanup(func() { assert.NilError(t, db.Close()) })
+	return db
+}
+
+func assertIteratorsEqual(t *testing.T, expected, actual store.Iterator) {
+	t.Helper()
+	for expected.Valid() {
+		assert.Assert(t, actual.Valid())
+		assert.DeepEqual(t, expected.Key(), actual.Key())
+		assert.DeepEqual(t, expected.Value(), actual.Value())
+		expected.Next()
+		actual.Next()
+	}
+	assert.Assert(t, !actual.Valid())
+	assert.NilError(t, actual.Error())
+	assert.NilError(t, actual.Close())
+}
+
+func TestKVStore(t *testing.T) {
+	ctx := context.Background()
+	db := openDB(t)
+	assert.NilError(t, ormpostgres.CreateTable(ctx, db, "kv"))
+	// creating the table is idempotent
+	assert.NilError(t, ormpostgres.CreateTable(ctx, db, "kv"))
+
+	kv := ormpostgres.NewKVStore(ctx, db, "kv")
+	expected := testkv.TestStore{Db: dbm.NewMemDB()}
+
+	// enough keys to span several iterator pages
+	for i := 0; i < 350; i++ {
+		key := []byte(fmt.Sprintf("key%03d", i))
+		value := []byte(fmt.Sprintf("value%d", i))
+		if i%10 == 0 {
+			value = []byte{}
+		}
+		assert.NilError(t, kv.Set(key, value))
+		assert.NilError(t, expected.Set(key, value))
+	}
+	for i := 0; i < 350; i += 7 {
+		key := []byte(fmt.Sprintf("key%03d", i))
+		assert.NilError(t, kv.Delete(key))
+		assert.NilError(t, expected.Delete(key))
+	}
+	assert.NilError(t, kv.Set([]byte("key001"), []byte("updated")))
+	assert.NilError(t, expected.Set([]byte("key001"), []byte("updated")))
+
+	value, err := kv.Get([]byte("key001"))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []byte("updated"), value)
+
+	// empty values are distinguished from missing keys
+	value, err = kv.Get([]byte("key010"))
+	assert.NilError(t, err)
+	assert.Assert(t, value != nil && len(value) == 0)
+	has, err := kv.Has([]byte("key010"))
+	assert.NilError(t, err)
+	assert.Assert(t, has)
+
+	value, err = kv.Get([]byte("key007"))
+	assert.NilError(t, err)
+	assert.Assert(t, value == nil)
+	has, err = kv.Has([]byte("key007"))
+	assert.NilError(t, err)
+	assert.Assert(t, !has)
+
+	_, err = kv.Get([]byte{})
+	assert.ErrorContains(t, err, "key cannot be empty")
+	assert.ErrorContains(t, kv.Set([]byte("a"), nil), "value cannot be nil")
+
+	ranges := [][2][]byte{
+		{nil, nil},
+		{[]byte("key050"), nil},
+		{nil, []byte("key250")},
+		{[]byte("key007"), []byte("key301")},
+		{[]byte("key1"), []byte("key2")},
+		{[]byte("z"), nil},
+	}
+	for _, r := range ranges {
+		start, end := r[0], r[1]
+		t.Run(fmt.Sprintf("%s-%s", start, end), func(t *testing.T) {
+			expectedIt, err := expected.Iterator(start, end)
+			assert.NilError(t, err)
+			it, err := kv.Iterator(start, end)
+			assert.NilError(t, err)
+			assertIteratorsEqual(t, expectedIt, it)
+
+			expectedIt, err = expected.ReverseIterator(start, end)
+			assert.NilError(t, err)
+			it, err = kv.ReverseIterator(start, end)
+			assert.NilError(t, err)
+			assertIteratorsEqual(t, expectedIt, it)
+		})
+	}
+}
+
+func TestBackend(t *testing.T) {
+	ctx := context.Background()
+	db := openDB(t)
+	assert.NilError(t, ormpostgres.CreateTable(ctx, db, "commitment"))
+	assert.NilError(t, ormpostgres.CreateTable(ctx, db, "index"))
+
+	table, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.ExampleTable{}).ProtoReflect().Type(),
+	})
+	assert.NilError(t, err)
+
+	options := ormpostgres.BackendOptions{CommitmentTable: "commitment", IndexTable: "index"}
+	expectedBackend := testkv.NewSplitMemBackend()
+	expectedCtx := ormtable.WrapContextDefault(expectedBackend)
+
+	// writes made in a transaction are only visible once it is committed
+	tx, err := db.BeginTx(ctx, nil)
+	assert.NilError(t, err)
+	txCtx := ormtable.WrapContextDefault(ormpostgres.NewBackend(ctx, tx, options))
+	for i := 0; i < 150; i++ {
+		msg := &testpb.ExampleTable{
+			U32: uint32(i),
+			I64: int64(i % 7),
+			Str: fmt.Sprintf("str%d", i%13),
+			U64: uint64(i),
+			Bz:  []byte{byte(i % 5)},
+		}
+		assert.NilError(t, table.Insert(txCtx, msg))
+		assert.NilError(t, table.Insert(expectedCtx, msg))
+	}
+	for i := 0; i < 150; i += 4 {
+		msg := &testpb.ExampleTable{U32: uint32(i), I64: int64(i % 7), Str: fmt.Sprintf("str%d", i%13)}
+		assert.NilError(t, table.Delete(txCtx, msg))
+		assert.NilError(t, table.Delete(expectedCtx, msg))
+	}
+
+	backend := ormpostgres.NewBackend(ctx, db, options)
+	it, err := backend.CommitmentStoreReader().Iterator(nil, nil)
+	assert.NilError(t, err)
+	assert.Assert(t, !it.Valid())
+
+	assert.NilError(t, tx.Commit())
+	testkv.AssertBackendsEqual(t, expectedBackend, backend)
+	testkv.AssertBackendsEqual(t, backend, expectedBackend)
+
+	// the ORM queries work against the database
+	found := &testpb.ExampleTable{U32: 1, I64: 1, Str: "str1"}
+	has, err := table.Get(ormtable.WrapContextDefault(backend), found)
+	assert.NilError(t, err)
+	assert.Assert(t, has)
+	assert.Equal(t, uint64(1), found.U64)
+
+	// as well as index queries
+	countStr1 := func(ctx context.Context) int {
+		it, err := table.GetIndex("str,u32").List(ctx, []interface{}{"str1"})
+		assert.NilError(t, err)
+		defer it.Close()
+		n := 0
+		for it.Next() {
+			n++
+		}
+		return n
+	}
+	assert.Equal(t, countStr1(expectedCtx), countStr1(ormtable.WrapContextDefault(backend)))
+}
@@ -61,6 +61,18 @@ func (t autoIncrementTable) Update(ctx context.Context, message proto.Message) e
 	return err
 }
 
+func (t autoIncrementTable) BeginBatch(ctx context.Context) (Batch, error) {
+	backend, err := t.getWriteBackend(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTableBatch(ctx, backend, func(ctx context.Context, backend Backend, message proto.Message) error {
+		_, err := t.save(ctx, backend, message, saveModeDefault)
+		return err
+	}, t.deleteMessage), nil
+}
+
 func (t autoIncrementTable) LastInsertedSequence(ctx context.Context) (uint64, error) {
 	backend, err := t.getBackend(ctx)
 	if err != nil {
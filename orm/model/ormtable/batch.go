@@ -1,10 +1,123 @@
 package ormtable
 
 import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/orm/types/kv"
 )
 
+// Batch buffers the writes of several Save and Delete operations on a table,
+// including the index writes, and applies them to the backend in a single
+// pass when Flush is called. Write hooks are called when the batch is
+// flushed.
+//
+// Each operation of a batch sees the writes of the previous operations of the
+// batch, so that primary and unique key constraints as well as auto-increment
+// sequences behave as if the operations were applied one by one. Iterators
+// opened while a batch is pending don't see its writes.
+//
+// An operation returning an error leaves the batch unchanged. A batch which
+// isn't flushed has no effect.
+type Batch interface {
+	// Save buffers the save of the provided entry. See Table.Save for more
+	// details on behavior.
+	Save(message proto.Message) error
+
+	// Delete buffers the deletion of the entry with the primary key fields set
+	// on message. See Table.Delete for more details on behavior.
+	Delete(message proto.Message) error
+
+	// Flush applies all the pending writes to the backend and resets the batch,
+	// which can then be reused.
+	Flush() error
+}
+
+type tableBatch struct {
+	ctx     context.Context
+	backend *batchBackend
+	save    func(ctx context.Context, backend Backend, message proto.Message) error
+	delete  func(ctx context.Context, backend Backend, message proto.Message) error
+}
+
+func newTableBatch(
+	ctx context.Context,
+	backend Backend,
+	save, delete func(ctx context.Context, backend Backend, message proto.Message) error,
+) *tableBatch {
+	return &tableBatch{
+		ctx:     ctx,
+		backend: newBatchBackend(backend),
+		save:    save,
+		delete:  delete,
+	}
+}
+
+func (b *tableBatch) Save(message proto.Message) error {
+	return b.save(b.ctx, b.backend, message)
+}
+
+func (b *tableBatch) Delete(message proto.Message) error {
+	return b.delete(b.ctx, b.backend, message)
+}
+
+func (b *tableBatch) Flush() error {
+	return b.backend.Write()
+}
+
+// batchBackend is a Backend which buffers all the writes made against it
+// until Write is called. Unlike batchIndexCommitmentWriter, reads see the
+// pending writes and write hooks are deferred until Write is called, so that
+// multiple ORM operations can be buffered.
+type batchBackend struct {
+	*batchIndexCommitmentWriter
+}
+
+func newBatchBackend(backend Backend) *batchBackend {
+	writer := newBatchIndexCommitmentWriter(backend)
+	writer.commitmentWriter.pending = map[string]*batchWriterEntry{}
+	writer.indexWriter.pending = map[string]*batchWriterEntry{}
+	return &batchBackend{writer}
+}
+
+func (b *batchBackend) CommitmentStoreReader() kv.ReadonlyStore {
+	return b.commitmentWriter
+}
+
+func (b *batchBackend) IndexStoreReader() kv.ReadonlyStore {
+	return b.indexWriter
+}
+
+func (b *batchBackend) WriteHooks() WriteHooks {
+	hooks := b.Backend.WriteHooks()
+	if hooks == nil {
+		return nil
+	}
+
+	return batchWriteHooks{writer: b.batchIndexCommitmentWriter, hooks: hooks}
+}
+
+// batchWriteHooks defers the calls to the wrapped hooks until the batch is
+// written.
+type batchWriteHooks struct {
+	writer *batchIndexCommitmentWriter
+	hooks  WriteHooks
+}
+
+func (h batchWriteHooks) OnInsert(ctx context.Context, message proto.Message) {
+	h.writer.enqueueHook(func() { h.hooks.OnInsert(ctx, message) })
+}
+
+func (h batchWriteHooks) OnUpdate(ctx context.Context, existing, new proto.Message) {
+	h.writer.enqueueHook(func() { h.hooks.OnUpdate(ctx, existing, new) })
+}
+
+func (h batchWriteHooks) OnDelete(ctx context.Context, message proto.Message) {
+	h.writer.enqueueHook(func() { h.hooks.OnDelete(ctx, message) })
+}
+
 type batchIndexCommitmentWriter struct {
 	Backend
 	commitmentWriter *batchStoreWriter
@@ -53,19 +166,22 @@ func (w *batchIndexCommitmentWriter) Write() error {
 
 func flushWrites(store kv.Store, writer *batchStoreWriter) error {
 	for _, buf := range writer.prevBufs {
-		err := flushBuf(store, buf)
+		err := flushBuf(store, writer, buf)
 		if err != nil {
 			return err
 		}
 	}
-	return flushBuf(store, writer.curBuf)
+	return flushBuf(store, writer, writer.curBuf)
 }
 
-func flushBuf(store kv.Store, writes []*batchWriterEntry) error {
+func flushBuf(store kv.Store, writer *batchStoreWriter, writes []*batchWriterEntry) error {
 	for _, write := range writes {
 		switch {
 		case write.hookCall != nil:
 			write.hookCall()
+		case writer.isOverwritten(write):
+			// only the last write of a key is applied
+			continue
 		case !write.delete:
 			err := store.Set(write.key, write.value)
 			if err != nil {
@@ -84,10 +200,8 @@ func flushBuf(store kv.Store, writes []*batchWriterEntry) error {
 // Close discards any pending writes and should generally be called using
 // a defer statement.
 func (w *batchIndexCommitmentWriter) Close() {
-	w.commitmentWriter.prevBufs = nil
-	w.commitmentWriter.curBuf = nil
-	w.indexWriter.prevBufs = nil
-	w.indexWriter.curBuf = nil
+	w.commitmentWriter.reset()
+	w.indexWriter.reset()
 }
 
 type batchWriterEntry struct {
@@ -100,10 +214,49 @@ type batchStoreWriter struct {
 	kv.ReadonlyStore
 	prevBufs [][]*batchWriterEntry
 	curBuf   []*batchWriterEntry
+
+	// pending tracks the last write of each key when reads should see the
+	// pending writes, and is nil otherwise.
+	pending map[string]*batchWriterEntry
 }
 
 const capacity = 16
 
+func (b *batchStoreWriter) Get(key []byte) ([]byte, error) {
+	if entry, ok := b.pending[string(key)]; ok {
+		if entry.delete {
+			return nil, nil
+		}
+		return entry.value, nil
+	}
+
+	return b.ReadonlyStore.Get(key)
+}
+
+func (b *batchStoreWriter) Has(key []byte) (bool, error) {
+	if entry, ok := b.pending[string(key)]; ok {
+		return !entry.delete, nil
+	}
+
+	return b.ReadonlyStore.Has(key)
+}
+
+func (b *batchStoreWriter) isOverwritten(entry *batchWriterEntry) bool {
+	if b.pending == nil {
+		return false
+	}
+
+	return b.pending[string(entry.key)] != entry
+}
+
+func (b *batchStoreWriter) reset() {
+	b.prevBufs = nil
+	b.curBuf = nil
+	if b.pending != nil {
+		b.pending = map[string]*batchWriterEntry{}
+	}
+}
+
 func (b *batchStoreWriter) Set(key, value []byte) error {
 	b.append(&batchWriterEntry{key: key, value: value})
 	return nil
@@ -125,6 +278,9 @@ func (b *batchStoreWriter) append(entry *batchWriterEntry) {
 	}
 
 	b.curBuf = append(b.curBuf, entry)
+	if b.pending != nil && entry.hookCall == nil {
+		b.pending[string(entry.key)] = entry
+	}
 }
 
 var _ Backend = &batchIndexCommitmentWriter{}
@@ -0,0 +1,167 @@
+package ormtable_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/orm/internal/testkv"
+	"cosmossdk.io/orm/internal/testpb"
+	"cosmossdk.io/orm/model/ormtable"
+	"cosmossdk.io/orm/testing/ormmocks"
+	"cosmossdk.io/orm/types/ormerrors"
+)
+
+func TestBatch(t *testing.T) {
+	table, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.ExampleTable{}).ProtoReflect().Type(),
+	})
+	assert.NilError(t, err)
+
+	expectedBackend := testkv.NewSplitMemBackend()
+	expectedCtx := ormtable.WrapContextDefault(expectedBackend)
+	backend := testkv.NewSplitMemBackend()
+	ctx := ormtable.WrapContextDefault(backend)
+
+	const n = 100
+	data := make([]*testpb.ExampleTable, n)
+	for i := range data {
+		data[i] = &testpb.ExampleTable{
+			U32: uint32(i),
+			I64: int64(i % 7),
+			Str: fmt.Sprintf("str%d", i%13),
+			U64: uint64(i),
+			Bz:  []byte{byte(i % 5)},
+		}
+	}
+
+	// write the expected state one operation at a time
+	for _, msg := range data {
+		assert.NilError(t, table.Save(expectedCtx, msg))
+	}
+	for i := 0; i < n; i += 3 {
+		msg := &testpb.ExampleTable{U32: data[i].U32, I64: data[i].I64, Str: data[i].Str, U64: uint64(n + i), Bz: []byte{9}}
+		assert.NilError(t, table.Save(expectedCtx, msg))
+	}
+	for i := 0; i < n; i += 5 {
+		assert.NilError(t, table.Delete(expectedCtx, data[i]))
+	}
+
+	// write the same state in a batch, including updates and deletes of
+	// entries inserted by the same batch
+	batch, err := table.BeginBatch(ctx)
+	assert.NilError(t, err)
+	for _, msg := range data {
+		assert.NilError(t, batch.Save(msg))
+	}
+	for i := 0; i < n; i += 3 {
+		msg := &testpb.ExampleTable{U32: data[i].U32, I64: data[i].I64, Str: data[i].Str, U64: uint64(n + i), Bz: []byte{9}}
+		assert.NilError(t, batch.Save(msg))
+	}
+	for i := 0; i < n; i += 5 {
+		assert.NilError(t, batch.Delete(data[i]))
+	}
+
+	// unique key violations are detected against the pending writes, and
+	// leave the batch unchanged
+	err = batch.Save(&testpb.ExampleTable{U32: 1000, U64: data[1].U64, Str: data[1].Str})
+	assert.ErrorIs(t, err, ormerrors.UniqueKeyViolation)
+
+	// nothing is written before the batch is flushed
+	found, err := table.Has(ctx, data[1])
+	assert.NilError(t, err)
+	assert.Assert(t, !found)
+
+	assert.NilError(t, batch.Flush())
+	testkv.AssertBackendsEqual(t, expectedBackend, backend)
+	testkv.AssertBackendsEqual(t, backend, expectedBackend)
+	checkEncodeDecodeEntries(t, table, backend.IndexStoreReader())
+
+	// the batch can be reused after being flushed
+	assert.NilError(t, batch.Delete(data[1]))
+	assert.NilError(t, batch.Flush())
+	found, err = table.Has(ctx, data[1])
+	assert.NilError(t, err)
+	assert.Assert(t, !found)
+}
+
+func TestAutoIncrementBatch(t *testing.T) {
+	table, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.ExampleAutoIncrementTable{}).ProtoReflect().Type(),
+	})
+	assert.NilError(t, err)
+	autoTable, ok := table.(ormtable.AutoIncrementTable)
+	assert.Assert(t, ok)
+
+	ctx := ormtable.WrapContextDefault(testkv.NewSplitMemBackend())
+	assert.NilError(t, table.Insert(ctx, &testpb.ExampleAutoIncrementTable{X: "a"}))
+
+	batch, err := table.BeginBatch(ctx)
+	assert.NilError(t, err)
+
+	msgs := []*testpb.ExampleAutoIncrementTable{{X: "b"}, {X: "c"}, {X: "d"}}
+	for _, msg := range msgs {
+		assert.NilError(t, batch.Save(msg))
+	}
+	assert.Equal(t, uint64(2), msgs[0].Id)
+	assert.Equal(t, uint64(4), msgs[2].Id)
+
+	err = batch.Save(&testpb.ExampleAutoIncrementTable{X: "c"})
+	assert.ErrorIs(t, err, ormerrors.UniqueKeyViolation)
+
+	seq, err := autoTable.LastInsertedSequence(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(1), seq)
+
+	assert.NilError(t, batch.Flush())
+
+	seq, err = autoTable.LastInsertedSequence(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(4), seq)
+
+	for _, msg := range msgs {
+		found := &testpb.ExampleAutoIncrementTable{Id: msg.Id}
+		has, err := table.Get(ctx, found)
+		assert.NilError(t, err)
+		assert.Assert(t, has)
+		assert.Equal(t, msg.X, found.X)
+	}
+}
+
+func TestBatchHooks(t *testing.T) {
+	table, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.ExampleAutoIncrementTable{}).ProtoReflect().Type(),
+	})
+	assert.NilError(t, err)
+
+	ctrl := gomock.NewController(t)
+	writeHooks := ormmocks.NewMockWriteHooks(ctrl)
+	ctx := ormtable.WrapContextDefault(testkv.NewSplitMemBackend().WithWriteHooks(writeHooks))
+
+	batch, err := table.BeginBatch(ctx)
+	assert.NilError(t, err)
+
+	inserted := &testpb.ExampleAutoIncrementTable{X: "a"}
+	assert.NilError(t, batch.Save(inserted))
+	updated := &testpb.ExampleAutoIncrementTable{Id: inserted.Id, X: "b"}
+	assert.NilError(t, batch.Save(updated))
+	assert.NilError(t, batch.Delete(updated))
+
+	// write hooks are only called once the batch is flushed, in order
+	gomock.InOrder(
+		writeHooks.EXPECT().OnInsert(gomock.Any(), ormmocks.Eq(&testpb.ExampleAutoIncrementTable{Id: 1, X: "a"})),
+		writeHooks.EXPECT().OnUpdate(
+			gomock.Any(),
+			ormmocks.Eq(&testpb.ExampleAutoIncrementTable{Id: 1, X: "a"}),
+			ormmocks.Eq(&testpb.ExampleAutoIncrementTable{Id: 1, X: "b"}),
+		),
+		writeHooks.EXPECT().OnDelete(gomock.Any(), ormmocks.Eq(&testpb.ExampleAutoIncrementTable{Id: 1, X: "b"})),
+	)
+	assert.NilError(t, batch.Flush())
+
+	has, err := table.Has(ctx, updated)
+	assert.NilError(t, err)
+	assert.Assert(t, !has)
+}
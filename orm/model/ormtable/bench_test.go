@@ -51,6 +51,12 @@ func bench(b *testing.B, newBackend func(testing.TB) ormtable.Backend) {
 		b.StartTimer()
 		benchInsert(b, ctx)
 	})
+	b.Run("batch insert", func(b *testing.B) {
+		b.StopTimer()
+		ctx := ormtable.WrapContextDefault(newBackend(b))
+		b.StartTimer()
+		benchBatchInsert(b, ctx)
+	})
 	b.Run("update", func(b *testing.B) {
 		b.StopTimer()
 		ctx := ormtable.WrapContextDefault(newBackend(b))
@@ -86,6 +92,25 @@ func benchInsert(b *testing.B, ctx context.Context) {
 	}
 }
 
+func benchBatchInsert(b *testing.B, ctx context.Context) {
+	b.Helper()
+	table, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.Balance{}).ProtoReflect().Type(),
+	})
+	assert.NilError(b, err)
+
+	batch, err := table.BeginBatch(ctx)
+	assert.NilError(b, err)
+	for i := 0; i < b.N; i++ {
+		assert.NilError(b, batch.Save(&testpb.Balance{
+			Address: fmt.Sprintf("acct%d", i),
+			Denom:   "bar",
+			Amount:  10,
+		}))
+	}
+	assert.NilError(b, batch.Flush())
+}
+
 func benchUpdate(b *testing.B, ctx context.Context) {
 	b.Helper()
 	balanceTable := initBalanceTable(b)
@@ -0,0 +1,59 @@
+package ormtable
+
+import (
+	"bytes"
+
+	"cosmossdk.io/orm/encoding/ormkv"
+	"cosmossdk.io/orm/model/ormlist"
+	"cosmossdk.io/orm/types/ormerrors"
+)
+
+// cursorVersion is the first byte of the cursors returned by iterators, so
+// that their encoding can evolve without misinterpreting older cursors.
+const cursorVersion byte = 0x1
+
+// encodeCursor returns the cursor pointing to the index entry with the
+// provided kv-store key. An index key contains all the key fields of the
+// index, followed by the primary key fields for non-unique indexes, so it
+// identifies a position in the index which stays valid when entries are
+// inserted or deleted between pages, including the entry it points to.
+func encodeCursor(key []byte) ormlist.CursorT {
+	cursor := make([]byte, 0, len(key)+1)
+	cursor = append(cursor, cursorVersion)
+	return append(cursor, key...)
+}
+
+// decodeCursor returns the index key a cursor points to, after checking that
+// the cursor was returned by an iterator over the index with the provided key
+// codec.
+func decodeCursor(codec *ormkv.KeyCodec, cursor ormlist.CursorT) ([]byte, error) {
+	if cursor[0] != cursorVersion {
+		return nil, ormerrors.InvalidListOptions.Wrapf("unsupported cursor version %d", cursor[0])
+	}
+
+	key := make([]byte, len(cursor)-1)
+	copy(key, cursor[1:])
+
+	r := bytes.NewReader(key)
+	_, err := codec.DecodeKey(r)
+	if err != nil || r.Len() != 0 {
+		return nil, ormerrors.InvalidListOptions.Wrapf(
+			"cursor doesn't point to an entry of index %s of %s",
+			codec.GetFieldNames(), codec.MessageType().Descriptor().FullName(),
+		)
+	}
+
+	return key, nil
+}
+
+// checkCursorInRange checks that the index key a cursor points to is in the
+// iterated range, as cursors returned by other queries would otherwise
+// restart iteration outside of it. A nil end means that the range isn't
+// bounded.
+func checkCursorInRange(key, start, end []byte) error {
+	if bytes.Compare(key, start) < 0 || (end != nil && bytes.Compare(key, end) >= 0) {
+		return ormerrors.InvalidListOptions.Wrap("cursor is outside of the iterated range")
+	}
+
+	return nil
+}
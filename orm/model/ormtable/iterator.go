@@ -63,12 +63,25 @@ func prefixIterator(iteratorStore kv.ReadonlyStore, backend ReadBackend, index c
 		return nil, err
 	}
 
+	var cursor []byte
+	if len(options.Cursor) != 0 {
+		cursor, err = decodeCursor(codec, options.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		err = checkCursorInRange(cursor, prefixBz, prefixEndBytes(prefixBz))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var res Iterator
 	if !options.Reverse {
 		var start []byte
-		if len(options.Cursor) != 0 {
+		if cursor != nil {
 			// must start right after cursor
-			start = append(options.Cursor, 0x0)
+			start = inclusiveEndBytes(cursor)
 		} else {
 			start = prefixBz
 		}
@@ -85,9 +98,9 @@ func prefixIterator(iteratorStore kv.ReadonlyStore, backend ReadBackend, index c
 		}
 	} else {
 		var end []byte
-		if len(options.Cursor) != 0 {
+		if cursor != nil {
 			// end bytes is already exclusive by default
-			end = options.Cursor
+			end = cursor
 		} else {
 			end = prefixEndBytes(prefixBz)
 		}
@@ -135,16 +148,29 @@ func rangeIterator(iteratorStore kv.ReadonlyStore, reader ReadBackend, index con
 	// if it did then we need to use inclusive end bytes, otherwise we prefix the end bytes
 	fullEndKey := len(codec.GetFieldNames()) == len(end)
 
-	var res Iterator
-	if !options.Reverse {
-		if len(options.Cursor) != 0 {
-			startBz = append(options.Cursor, 0)
+	if fullEndKey {
+		endBz = inclusiveEndBytes(endBz)
+	} else {
+		endBz = prefixEndBytes(endBz)
+	}
+
+	var cursor []byte
+	if len(options.Cursor) != 0 {
+		cursor, err = decodeCursor(codec, options.Cursor)
+		if err != nil {
+			return nil, err
 		}
 
-		if fullEndKey {
-			endBz = inclusiveEndBytes(endBz)
-		} else {
-			endBz = prefixEndBytes(endBz)
+		err = checkCursorInRange(cursor, startBz, endBz)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var res Iterator
+	if !options.Reverse {
+		if cursor != nil {
+			startBz = inclusiveEndBytes(cursor)
 		}
 
 		it, err := iteratorStore.Iterator(startBz, endBz)
@@ -158,14 +184,8 @@ func rangeIterator(iteratorStore kv.ReadonlyStore, reader ReadBackend, index con
 			started:  false,
 		}
 	} else {
-		if len(options.Cursor) != 0 {
-			endBz = options.Cursor
-		} else {
-			if fullEndKey {
-				endBz = inclusiveEndBytes(endBz)
-			} else {
-				endBz = prefixEndBytes(endBz)
-			}
+		if cursor != nil {
+			endBz = cursor
 		}
 		it, err := iteratorStore.ReverseIterator(startBz, endBz)
 		if err != nil {
@@ -250,7 +270,7 @@ func (i *indexIterator) GetMessage() (proto.Message, error) {
 }
 
 func (i indexIterator) Cursor() ormlist.CursorT {
-	return i.iterator.Key()
+	return encodeCursor(i.iterator.Key())
 }
 
 func (i indexIterator) Close() {
@@ -118,6 +118,10 @@ func (p primaryKeyIndex) doDelete(ctx context.Context, primaryKeyValues []protor
 		return err
 	}
 
+	return p.deleteWithBackend(ctx, backend, primaryKeyValues)
+}
+
+func (p primaryKeyIndex) deleteWithBackend(ctx context.Context, backend Backend, primaryKeyValues []protoreflect.Value) error {
 	// delete object
 	writer := newBatchIndexCommitmentWriter(backend)
 	defer writer.Close()
@@ -0,0 +1,212 @@
+package ormtable
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"cosmossdk.io/orm/encoding/ormkv"
+	"cosmossdk.io/orm/types/ormerrors"
+)
+
+// snapshotPayloadSize is the size above which the entries exported by
+// ExportSnapshot are split into a new payload.
+const snapshotPayloadSize = 1 << 20
+
+// snapshotWriter buffers the kv-store pairs of a snapshot and passes them to
+// the payload writer in chunks of roughly snapshotPayloadSize bytes. Each
+// pair is encoded as the length-prefixed key followed by the length-prefixed
+// value.
+type snapshotWriter struct {
+	buf           []byte
+	payloadWriter func([]byte) error
+}
+
+func newSnapshotWriter(payloadWriter func([]byte) error) *snapshotWriter {
+	return &snapshotWriter{payloadWriter: payloadWriter}
+}
+
+func (w *snapshotWriter) write(k, v []byte) error {
+	w.buf = binary.AppendUvarint(w.buf, uint64(len(k)))
+	w.buf = append(w.buf, k...)
+	w.buf = binary.AppendUvarint(w.buf, uint64(len(v)))
+	w.buf = append(w.buf, v...)
+
+	if len(w.buf) >= snapshotPayloadSize {
+		return w.flush()
+	}
+
+	return nil
+}
+
+// flush writes any buffered pairs as a payload.
+func (w *snapshotWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	err := w.payloadWriter(w.buf)
+	w.buf = nil
+	return err
+}
+
+// readSnapshot reads payloads from payloadReader until it returns io.EOF and
+// calls onEntry for each kv-store pair. The writes made while importing a
+// payload are buffered and applied to the backend once the whole payload has
+// been read.
+func readSnapshot(payloadReader func() ([]byte, error), backend Backend, onEntry func(backend Backend, k, v []byte) error) error {
+	for {
+		payload, err := payloadReader()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		batch := newBatchBackend(backend)
+		for len(payload) > 0 {
+			var k, v []byte
+			k, payload, err = readSnapshotBytes(payload)
+			if err != nil {
+				return err
+			}
+			v, payload, err = readSnapshotBytes(payload)
+			if err != nil {
+				return err
+			}
+
+			err = onEntry(batch, k, v)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = batch.Write()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func readSnapshotBytes(payload []byte) (bz, rest []byte, err error) {
+	n, read := binary.Uvarint(payload)
+	if read <= 0 || uint64(len(payload)-read) < n {
+		return nil, nil, ormerrors.InvalidSnapshot.Wrap("truncated payload")
+	}
+
+	end := read + int(n)
+	return payload[read:end], payload[end:], nil
+}
+
+// exportSnapshotEntries writes the primary key entries of the table to writer.
+func (t tableImpl) exportSnapshotEntries(backend ReadBackend, writer *snapshotWriter) error {
+	prefix := t.PrimaryKeyCodec.Prefix()
+	it, err := backend.CommitmentStoreReader().Iterator(prefix, prefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		err = writer.write(it.Key(), it.Value())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importSnapshotEntry inserts the primary key entry of a snapshot, which also
+// writes its index entries.
+func (t tableImpl) importSnapshotEntry(ctx context.Context, backend Backend, k, v []byte) error {
+	if !bytes.HasPrefix(k, t.PrimaryKeyCodec.Prefix()) {
+		return ormerrors.InvalidSnapshot.Wrapf("unexpected key %x for table %s", k, t.MessageType().Descriptor().FullName())
+	}
+
+	entry, err := t.PrimaryKeyCodec.DecodeEntry(k, v)
+	if err != nil {
+		return err
+	}
+
+	return t.save(ctx, backend, entry.(*ormkv.PrimaryKeyEntry).Value, saveModeInsert)
+}
+
+func (t tableImpl) ExportSnapshot(ctx context.Context, payloadWriter func([]byte) error) error {
+	backend, err := t.getBackend(ctx)
+	if err != nil {
+		return err
+	}
+
+	writer := newSnapshotWriter(payloadWriter)
+	err = t.exportSnapshotEntries(backend, writer)
+	if err != nil {
+		return err
+	}
+
+	return writer.flush()
+}
+
+func (t tableImpl) ImportSnapshot(ctx context.Context, payloadReader func() ([]byte, error)) error {
+	backend, err := t.getWriteBackend(ctx)
+	if err != nil {
+		return err
+	}
+
+	return readSnapshot(payloadReader, backend, func(backend Backend, k, v []byte) error {
+		return t.importSnapshotEntry(ctx, backend, k, v)
+	})
+}
+
+// ExportSnapshot exports the sequence of the table before its entries.
+func (t autoIncrementTable) ExportSnapshot(ctx context.Context, payloadWriter func([]byte) error) error {
+	backend, err := t.getBackend(ctx)
+	if err != nil {
+		return err
+	}
+
+	writer := newSnapshotWriter(payloadWriter)
+	seqKey := t.seqCodec.Prefix()
+	bz, err := backend.IndexStoreReader().Get(seqKey)
+	if err != nil {
+		return err
+	}
+	if bz != nil {
+		err = writer.write(seqKey, bz)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = t.exportSnapshotEntries(backend, writer)
+	if err != nil {
+		return err
+	}
+
+	return writer.flush()
+}
+
+func (t autoIncrementTable) ImportSnapshot(ctx context.Context, payloadReader func() ([]byte, error)) error {
+	backend, err := t.getWriteBackend(ctx)
+	if err != nil {
+		return err
+	}
+
+	return readSnapshot(payloadReader, backend, func(backend Backend, k, v []byte) error {
+		if bytes.Equal(k, t.seqCodec.Prefix()) {
+			seq, err := t.seqCodec.DecodeValue(v)
+			if err != nil {
+				return err
+			}
+
+			return t.setSeqValue(backend.IndexStore(), seq)
+		}
+
+		// the primary key is already set, so the entry is inserted directly
+		// without assigning a new sequence number
+		return t.importSnapshotEntry(ctx, backend, k, v)
+	})
+}
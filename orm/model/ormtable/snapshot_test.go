@@ -0,0 +1,138 @@
+package ormtable_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/orm/internal/testkv"
+	"cosmossdk.io/orm/internal/testpb"
+	"cosmossdk.io/orm/model/ormtable"
+	"cosmossdk.io/orm/types/ormerrors"
+)
+
+func exportSnapshot(t *testing.T, schema ormtable.Schema, ctx context.Context) [][]byte {
+	t.Helper()
+	var payloads [][]byte
+	assert.NilError(t, schema.ExportSnapshot(ctx, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	}))
+	return payloads
+}
+
+func payloadReader(payloads [][]byte) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		payload := payloads[0]
+		payloads = payloads[1:]
+		return payload, nil
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	table, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.ExampleTable{}).ProtoReflect().Type(),
+	})
+	assert.NilError(t, err)
+
+	backend := testkv.NewSplitMemBackend()
+	ctx := ormtable.WrapContextDefault(backend)
+	for i := 0; i < 2000; i++ {
+		assert.NilError(t, table.Insert(ctx, &testpb.ExampleTable{
+			U32: uint32(i),
+			I64: int64(i % 7),
+			Str: fmt.Sprintf("str%d", i%13),
+			U64: uint64(i),
+			Bz:  bytes.Repeat([]byte{byte(i)}, 1024),
+		}))
+	}
+
+	payloads := exportSnapshot(t, table, ctx)
+	// the entries are split across several payloads
+	assert.Assert(t, len(payloads) > 1)
+	// and the export is deterministic
+	assert.DeepEqual(t, payloads, exportSnapshot(t, table, ctx))
+
+	backend2 := testkv.NewSplitMemBackend()
+	ctx2 := ormtable.WrapContextDefault(backend2)
+	assert.NilError(t, table.ImportSnapshot(ctx2, payloadReader(payloads)))
+	testkv.AssertBackendsEqual(t, backend, backend2)
+	testkv.AssertBackendsEqual(t, backend2, backend)
+
+	// entries can't be imported twice
+	err = table.ImportSnapshot(ctx2, payloadReader(payloads))
+	assert.ErrorIs(t, err, ormerrors.AlreadyExists)
+
+	// truncated payloads are rejected
+	err = table.ImportSnapshot(ormtable.WrapContextDefault(testkv.NewSplitMemBackend()), payloadReader([][]byte{payloads[0][:10]}))
+	assert.ErrorIs(t, err, ormerrors.InvalidSnapshot)
+
+	// as are entries of another table
+	otherTable, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.SimpleExample{}).ProtoReflect().Type(),
+	})
+	assert.NilError(t, err)
+	err = otherTable.ImportSnapshot(ormtable.WrapContextDefault(testkv.NewSplitMemBackend()), payloadReader(payloads))
+	assert.ErrorIs(t, err, ormerrors.InvalidSnapshot)
+}
+
+func TestAutoIncrementSnapshot(t *testing.T) {
+	table, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.ExampleAutoIncrementTable{}).ProtoReflect().Type(),
+	})
+	assert.NilError(t, err)
+	autoTable := table.(ormtable.AutoIncrementTable)
+
+	backend := testkv.NewSplitMemBackend()
+	ctx := ormtable.WrapContextDefault(backend)
+	for i := 0; i < 10; i++ {
+		assert.NilError(t, table.Insert(ctx, &testpb.ExampleAutoIncrementTable{X: fmt.Sprintf("x%d", i)}))
+	}
+	// the sequence is exported even if the last entries were deleted
+	assert.NilError(t, table.Delete(ctx, &testpb.ExampleAutoIncrementTable{Id: 10}))
+
+	backend2 := testkv.NewSplitMemBackend()
+	ctx2 := ormtable.WrapContextDefault(backend2)
+	assert.NilError(t, table.ImportSnapshot(ctx2, payloadReader(exportSnapshot(t, table, ctx))))
+	testkv.AssertBackendsEqual(t, backend, backend2)
+	testkv.AssertBackendsEqual(t, backend2, backend)
+
+	seq, err := autoTable.LastInsertedSequence(ctx2)
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(10), seq)
+
+	id, err := autoTable.InsertReturningPKey(ctx2, &testpb.ExampleAutoIncrementTable{X: "y"})
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(11), id)
+}
+
+func TestSingletonSnapshot(t *testing.T) {
+	table, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.ExampleSingleton{}).ProtoReflect().Type(),
+	})
+	assert.NilError(t, err)
+
+	// an empty singleton exports no payload
+	ctx := ormtable.WrapContextDefault(testkv.NewSplitMemBackend())
+	assert.Equal(t, 0, len(exportSnapshot(t, table, ctx)))
+
+	singleton := &testpb.ExampleSingleton{Foo: "abc", Bar: 3}
+	assert.NilError(t, table.Save(ctx, singleton))
+
+	ctx2 := ormtable.WrapContextDefault(testkv.NewSplitMemBackend())
+	assert.NilError(t, table.ImportSnapshot(ctx2, payloadReader(exportSnapshot(t, table, ctx))))
+
+	found := &testpb.ExampleSingleton{}
+	has, err := table.Get(ctx2, found)
+	assert.NilError(t, err)
+	assert.Assert(t, has)
+	assert.Assert(t, proto.Equal(singleton, found))
+}
@@ -135,6 +135,12 @@ type Table interface {
 	// first element in the JSON array.
 	ExportJSON(context.Context, io.Writer) error
 
+	// BeginBatch starts a batch of writes to the table, which are applied to
+	// the backend resolved from the context in a single pass when the batch is
+	// flushed. It should be preferred over successive calls to Save and Delete
+	// when writing many entries at once.
+	BeginBatch(ctx context.Context) (Batch, error)
+
 	// ID is the ID of this table within the schema of its FileDescriptor.
 	ID() uint32
 
@@ -149,6 +155,23 @@ type Schema interface {
 
 	// GetTable returns the table for the provided message type or nil.
 	GetTable(message proto.Message) Table
+
+	// ExportSnapshot exports the entries of all the tables of the schema,
+	// including auto-increment sequences, as a series of opaque payloads
+	// passed to payloadWriter. The payloads are deterministic for a given
+	// state and are split so that each payload stays reasonably small,
+	// which makes them usable as a snapshot extension for state sync.
+	// Index entries are not exported as they are rebuilt on import.
+	ExportSnapshot(ctx context.Context, payloadWriter func([]byte) error) error
+
+	// ImportSnapshot imports the payloads produced by ExportSnapshot, which
+	// are read from payloadReader until it returns io.EOF. It is expected to
+	// be called against an empty store, and fails if an imported entry
+	// already exists.
+	//
+	// Like ImportJSON, ImportSnapshot is not atomic with respect to the
+	// underlying store.
+	ImportSnapshot(ctx context.Context, payloadReader func() ([]byte, error)) error
 }
 
 type AutoIncrementTable interface {
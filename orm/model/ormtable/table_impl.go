@@ -170,6 +170,22 @@ func (t tableImpl) Delete(ctx context.Context, message proto.Message) error {
 	return t.doDelete(ctx, pk)
 }
 
+func (t tableImpl) BeginBatch(ctx context.Context) (Batch, error) {
+	backend, err := t.getWriteBackend(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTableBatch(ctx, backend, func(ctx context.Context, backend Backend, message proto.Message) error {
+		return t.save(ctx, backend, message, saveModeDefault)
+	}, t.deleteMessage), nil
+}
+
+func (t tableImpl) deleteMessage(ctx context.Context, backend Backend, message proto.Message) error {
+	pk := t.PrimaryKeyCodec.GetKeyValues(message.ProtoReflect())
+	return t.deleteWithBackend(ctx, backend, pk)
+}
+
 func (t tableImpl) GetIndex(fields string) Index {
 	return t.indexesByFields[fieldnames.CommaSeparatedFieldNames(fields)]
 }
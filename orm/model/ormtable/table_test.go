@@ -95,6 +95,77 @@ func TestPaginationLimitCountTotal(t *testing.T) {
 	assert.Equal(t, uint64(3), pr.Total)
 }
 
+func TestPaginationCursor(t *testing.T) {
+	table, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.ExampleTable{}).ProtoReflect().Type(),
+	})
+	assert.NilError(t, err)
+	ctx := ormtable.WrapContextDefault(testkv.NewSplitMemBackend())
+	store, err := testpb.NewExampleTableTable(table)
+	assert.NilError(t, err)
+
+	for i := uint32(1); i <= 6; i++ {
+		assert.NilError(t, store.Insert(ctx, &testpb.ExampleTable{U32: i, Str: "a", U64: uint64(i)}))
+		assert.NilError(t, store.Insert(ctx, &testpb.ExampleTable{U32: i, Str: "b", U64: uint64(i)}))
+	}
+
+	listPage := func(key []byte) ([]uint32, *queryv1beta1.PageResponse) {
+		it, err := store.List(ctx, testpb.ExampleTableStrU32IndexKey{}.WithStr("a"), ormlist.Paginate(&queryv1beta1.PageRequest{
+			Key:   key,
+			Limit: 2,
+		}))
+		assert.NilError(t, err)
+		defer it.Close()
+
+		var res []uint32
+		for it.Next() {
+			msg, err := it.Value()
+			assert.NilError(t, err)
+			res = append(res, msg.U32)
+		}
+		return res, it.PageResponse()
+	}
+
+	page, res := listPage(nil)
+	assert.DeepEqual(t, []uint32{1, 2}, page)
+	assert.Assert(t, res.NextKey != nil)
+
+	// the next page is unaffected by writes before the cursor, including the
+	// deletion of the entry the cursor points to
+	assert.NilError(t, store.Insert(ctx, &testpb.ExampleTable{U32: 0, Str: "a", U64: 100}))
+	assert.NilError(t, store.Delete(ctx, &testpb.ExampleTable{U32: 2, Str: "a"}))
+	assert.NilError(t, store.Insert(ctx, &testpb.ExampleTable{U32: 10, Str: "a", U64: 101}))
+
+	page, res = listPage(res.NextKey)
+	assert.DeepEqual(t, []uint32{3, 4}, page)
+	page, res = listPage(res.NextKey)
+	assert.DeepEqual(t, []uint32{5, 6}, page)
+	page, res = listPage(res.NextKey)
+	assert.DeepEqual(t, []uint32{10}, page)
+	assert.Assert(t, res.NextKey == nil)
+
+	_, res = listPage(nil)
+	cursor := res.NextKey
+
+	// a cursor can't be used outside of the query it was returned by
+	_, err = store.List(ctx, testpb.ExampleTableStrU32IndexKey{}.WithStr("b"), ormlist.Cursor(cursor))
+	assert.ErrorIs(t, err, ormerrors.InvalidListOptions)
+	_, err = store.ListRange(ctx,
+		testpb.ExampleTableStrU32IndexKey{}.WithStrU32("a", 4),
+		testpb.ExampleTableStrU32IndexKey{}.WithStrU32("a", 6),
+		ormlist.Cursor(cursor),
+	)
+	assert.ErrorIs(t, err, ormerrors.InvalidListOptions)
+	_, err = store.List(ctx, testpb.ExampleTablePrimaryKey{}, ormlist.Cursor(cursor))
+	assert.ErrorIs(t, err, ormerrors.InvalidListOptions)
+
+	// cursors with an unknown version or truncated are rejected
+	_, err = store.List(ctx, testpb.ExampleTableStrU32IndexKey{}.WithStr("a"), ormlist.Cursor(append([]byte{0x2}, cursor[1:]...)))
+	assert.ErrorIs(t, err, ormerrors.InvalidListOptions)
+	_, err = store.List(ctx, testpb.ExampleTableStrU32IndexKey{}.WithStr("a"), ormlist.Cursor(cursor[:len(cursor)-1]))
+	assert.ErrorIs(t, err, ormerrors.InvalidListOptions)
+}
+
 // check that the ormkv.Entry's decode and encode to the same bytes
 func checkEncodeDecodeEntries(t *testing.T, table ormtable.Table, store kv.ReadonlyStore) {
 	t.Helper()
@@ -45,4 +45,5 @@ var (
 	AlreadyExists                 = errors.RegisterWithGRPCCode(codespace, 31, codes.AlreadyExists, "already exists")
 	ConstraintViolation           = errors.RegisterWithGRPCCode(codespace, 32, codes.FailedPrecondition, "failed precondition")
 	NoTableDescriptor             = errors.New(codespace, 33, "no table descriptor found")
+	InvalidSnapshot               = errors.New(codespace, 34, "invalid snapshot")
 )
@@ -1,13 +1,16 @@
 package runtime
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"slices"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 
 	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/legacy"
 	"cosmossdk.io/log"
@@ -43,6 +46,7 @@ type App struct {
 	ModuleManager     *module.Manager
 	configurator      module.Configurator // nolint:staticcheck // SA1019: Configurator is deprecated but still used in runtime v1.
 	config            *runtimev1alpha1.Module
+	appConfig         *appv1alpha1.Config
 	storeKeys         []storetypes.StoreKey
 	interfaceRegistry codectypes.InterfaceRegistry
 	cdc               codec.Codec
@@ -51,6 +55,9 @@ type App struct {
 	msgServiceRouter  *baseapp.MsgServiceRouter
 	grpcQueryRouter   *baseapp.GRPCQueryRouter
 	logger            log.Logger
+	// extensionHosts are the extension host servers of the sidecar modules,
+	// which are served while the app is loaded.
+	extensionHosts []*extensionHost
 	// initChainer is the init chainer function defined by the app config.
 	// this is only required if the chain wants to add special InitChainer logic.
 	initChainer sdk.InitChainer
@@ -100,6 +107,21 @@ func (a *App) RegisterStores(keys ...storetypes.StoreKey) error {
 
 // Load finishes all initialization operations and loads the app.
 func (a *App) Load(loadLatest bool) error {
+	config, issues, err := resolveWiring(a.config, a.appConfig, a.ModuleManager.Modules)
+	if err != nil {
+		return err
+	}
+	a.config = config
+
+	for _, issue := range issues {
+		if issue.Severity == WiringWarning {
+			a.logger.Warn("app wiring issue", "issue", issue.String())
+		}
+	}
+	if err := issues.Err(); err != nil {
+		return fmt.Errorf("invalid app wiring: %w", err)
+	}
+
 	if len(a.config.InitGenesis) != 0 {
 		a.ModuleManager.SetOrderInitGenesis(a.config.InitGenesis...)
 		if a.initChainer == nil {
@@ -144,15 +166,48 @@ func (a *App) Load(loadLatest bool) error {
 		a.ModuleManager.SetOrderMigrations(a.config.OrderMigrations...)
 	}
 
+	// the invariants are only asserted if the invariant audit is enabled
+	a.ModuleManager.RegisterInvariants(a.InvariantRegistry())
+
 	if loadLatest {
 		if err := a.LoadLatestVersion(); err != nil {
 			return err
 		}
 	}
 
+	return a.startExtensionHosts()
+}
+
+// Close stops the extension hosts served by Load and closes the BaseApp.
+func (a *App) Close() error {
+	return errors.Join(a.stopExtensionHosts(context.Background()), a.BaseApp.Close())
+}
+
+// startExtensionHosts serves the extension hosts of the sidecar modules. If a
+// host fails to listen, the hosts already served are stopped.
+func (a *App) startExtensionHosts() error {
+	for _, host := range a.extensionHosts {
+		if err := host.listen(); err != nil {
+			return errors.Join(err, a.stopExtensionHosts(context.Background()))
+		}
+	}
+
 	return nil
 }
 
+// stopExtensionHosts stops the served extension hosts, in the reverse order in
+// which they were started.
+func (a *App) stopExtensionHosts(ctx context.Context) error {
+	var errs []error
+	for i := len(a.extensionHosts) - 1; i >= 0; i-- {
+		if err := a.extensionHosts[i].stop(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 // PreBlocker application updates every pre block
 func (a *App) PreBlocker(ctx sdk.Context, _ *abci.FinalizeBlockRequest) error {
 	return a.ModuleManager.PreBlock(ctx)
@@ -0,0 +1,163 @@
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"plugin"
+
+	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ExtensionSymbol is the name of the symbol a Go plugin must export to be
+// loaded as an extension module. The symbol must be of type ExtensionConstructor.
+const ExtensionSymbol = "NewAppModule"
+
+// ExtensionInputs holds the services handed to an extension module when it is
+// loaded.
+type ExtensionInputs struct {
+	// Name is the name of the module as declared in the app config.
+	Name string
+	// Config is the opaque configuration declared for the module in the app config.
+	Config []byte
+	// Environment is the module environment, backed by a store key named after the module.
+	Environment appmodule.Environment
+	// Codec is the app codec.
+	Codec codec.Codec
+}
+
+// ExtensionConstructor constructs an extension module loaded from a Go plugin.
+type ExtensionConstructor = func(ExtensionInputs) (appmodule.AppModule, error)
+
+// extensionLoader loads the extension modules declared in the runtime config.
+type extensionLoader struct {
+	logger log.Logger
+	app    *AppBuilder
+
+	// openPlugin is swapped in tests.
+	openPlugin func(path string) (plugin.Symbol, error)
+}
+
+func newExtensionLoader(logger log.Logger, app *AppBuilder) *extensionLoader {
+	return &extensionLoader{
+		logger:     logger,
+		app:        app,
+		openPlugin: lookupPluginSymbol,
+	}
+}
+
+// Load loads every extension declared in config and adds it to modules.
+// It errors if an extension name collides with an already registered module.
+func (l *extensionLoader) Load(config *runtimev1alpha1.Module, modules map[string]appmodule.AppModule) error {
+	for _, ext := range config.Extensions {
+		if ext.Name == "" {
+			return errors.New("extension module name cannot be empty")
+		}
+
+		if _, ok := modules[ext.Name]; ok {
+			return fmt.Errorf("extension module %s conflicts with an already registered module", ext.Name)
+		}
+
+		mod, err := l.load(ext)
+		if err != nil {
+			return fmt.Errorf("failed to load extension module %s: %w", ext.Name, err)
+		}
+
+		modules[ext.Name] = mod
+	}
+
+	return nil
+}
+
+func (l *extensionLoader) load(ext *runtimev1alpha1.ExtensionConfig) (appmodule.AppModule, error) {
+	switch {
+	case ext.PluginPath != "" && ext.GrpcAddress != "":
+		return nil, errors.New("only one of plugin_path and grpc_address can be set")
+	case ext.PluginPath != "":
+		return l.loadPlugin(ext)
+	case ext.GrpcAddress != "":
+		return l.loadSidecar(ext)
+	default:
+		return nil, errors.New("one of plugin_path and grpc_address must be set")
+	}
+}
+
+func (l *extensionLoader) loadPlugin(ext *runtimev1alpha1.ExtensionConfig) (appmodule.AppModule, error) {
+	sym, err := l.openPlugin(ext.PluginPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var constructor ExtensionConstructor
+	switch fn := sym.(type) {
+	case ExtensionConstructor:
+		constructor = fn
+	case *ExtensionConstructor:
+		constructor = *fn
+	default:
+		return nil, fmt.Errorf("plugin symbol %s has type %T, expected %T", ExtensionSymbol, sym, constructor)
+	}
+
+	mod, err := constructor(ExtensionInputs{
+		Name:        ext.Name,
+		Config:      ext.Config,
+		Environment: l.environment(ext.Name),
+		Codec:       l.app.app.cdc,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	l.logger.Info("loaded extension module from plugin", "module", ext.Name, "path", ext.PluginPath)
+	return mod, nil
+}
+
+func (l *extensionLoader) loadSidecar(ext *runtimev1alpha1.ExtensionConfig) (appmodule.AppModule, error) {
+	if ext.HostAddress == "" {
+		return nil, errors.New("host_address must be set for sidecar extension modules")
+	}
+
+	mod, err := newSidecarModule(ext, l.kvStoreService(ext.Name), l.logger.With(log.ModuleKey, fmt.Sprintf("x/%s", ext.Name)))
+	if err != nil {
+		return nil, err
+	}
+
+	// the host is served while the app is loaded, the sidecar can only access
+	// the store during a call made by the app anyway.
+	l.app.app.extensionHosts = append(l.app.app.extensionHosts, mod.host)
+
+	l.logger.Info("loaded extension module from sidecar", "module", ext.Name, "address", ext.GrpcAddress)
+	return mod, nil
+}
+
+func (l *extensionLoader) kvStoreService(name string) kvStoreService {
+	storeKey := storetypes.NewKVStoreKey(name)
+	registerStoreKey(l.app, storeKey)
+	return kvStoreService{key: storeKey}
+}
+
+func (l *extensionLoader) environment(name string) appmodule.Environment {
+	memStoreKey := storetypes.NewMemoryStoreKey(fmt.Sprintf("memory:%s", name))
+	registerStoreKey(l.app, memStoreKey)
+
+	return NewEnvironment(
+		l.kvStoreService(name),
+		l.logger.With(log.ModuleKey, fmt.Sprintf("x/%s", name)),
+		EnvWithMsgRouterService(l.app.app.msgServiceRouter),
+		EnvWithQueryRouterService(l.app.app.grpcQueryRouter),
+		EnvWithMemStoreService(memStoreService{key: memStoreKey}),
+	)
+}
+
+func lookupPluginSymbol(path string) (plugin.Symbol, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Lookup(ExtensionSymbol)
+}
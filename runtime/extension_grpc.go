@@ -0,0 +1,486 @@
+package runtime
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ appmodule.AppModule       = (*sidecarModule)(nil)
+	_ appmodule.HasGenesis      = (*sidecarModule)(nil)
+	_ appmodule.HasBeginBlocker = (*sidecarModule)(nil)
+	_ appmodule.HasEndBlocker   = (*sidecarModule)(nil)
+
+	_ runtimev1alpha1.ExtensionHostServer = (*extensionHost)(nil)
+)
+
+var (
+	// sidecarDialTimeout is the time to wait for the connection to a sidecar
+	// when loading it.
+	sidecarDialTimeout = 10 * time.Second
+	// sidecarCallTimeout is the timeout of the calls made to a sidecar outside
+	// of a block or genesis context, i.e. describing it and validating genesis.
+	sidecarCallTimeout = 30 * time.Second
+)
+
+// sidecarModule is an AppModule whose logic runs in a separate process
+// serving the ExtensionModule gRPC service. Store access is served back to the
+// sidecar through the ExtensionHost service, scoped to a session which is only
+// valid for the duration of a single call.
+type sidecarModule struct {
+	name   string
+	client runtimev1alpha1.ExtensionModuleClient
+	host   *extensionHost
+	logger log.Logger
+
+	hasGenesis      bool
+	hasBeginBlocker bool
+	hasEndBlocker   bool
+
+	// defaultGenesis is fetched when loading the module, as DefaultGenesis
+	// cannot return an error.
+	defaultGenesis json.RawMessage
+}
+
+func newSidecarModule(
+	ext *runtimev1alpha1.ExtensionConfig,
+	storeService store.KVStoreService,
+	logger log.Logger,
+) (*sidecarModule, error) {
+	conn, err := grpc.NewClient(ext.GrpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	mod, err := describeSidecar(ext, conn)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	mod.logger = logger
+	mod.host = newExtensionHost(ext.Name, ext.HostAddress, storeService, logger)
+
+	return mod, nil
+}
+
+// describeSidecar connects to the sidecar and describes it, fetching its
+// default genesis if it has any, within the dial and call timeouts.
+func describeSidecar(ext *runtimev1alpha1.ExtensionConfig, conn *grpc.ClientConn) (*sidecarModule, error) {
+	dialCtx, cancel := context.WithTimeout(context.Background(), sidecarDialTimeout)
+	defer cancel()
+	if err := waitForConnection(dialCtx, conn); err != nil {
+		return nil, fmt.Errorf("failed to connect to sidecar at %s: %w", ext.GrpcAddress, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sidecarCallTimeout)
+	defer cancel()
+
+	client := runtimev1alpha1.NewExtensionModuleClient(conn)
+	desc, err := client.Describe(ctx, &runtimev1alpha1.ExtensionDescribeRequest{Config: ext.Config})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe sidecar at %s: %w", ext.GrpcAddress, err)
+	}
+
+	if desc.Name != ext.Name {
+		return nil, fmt.Errorf("sidecar at %s describes module %s, expected %s", ext.GrpcAddress, desc.Name, ext.Name)
+	}
+
+	mod := &sidecarModule{
+		name:            ext.Name,
+		client:          client,
+		hasGenesis:      desc.HasGenesis,
+		hasBeginBlocker: desc.HasBeginBlocker,
+		hasEndBlocker:   desc.HasEndBlocker,
+	}
+
+	if mod.hasGenesis {
+		res, err := client.DefaultGenesis(ctx, &runtimev1alpha1.ExtensionDefaultGenesisRequest{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get default genesis of extension module %s: %w", ext.Name, err)
+		}
+		mod.defaultGenesis = res.Genesis
+	}
+
+	return mod, nil
+}
+
+// waitForConnection connects conn and waits until it is ready, or until ctx is
+// done.
+func waitForConnection(ctx context.Context, conn *grpc.ClientConn) error {
+	conn.Connect()
+	for {
+		state := conn.GetState()
+		if state == connectivity.Ready {
+			return nil
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("connection is %s: %w", state, ctx.Err())
+		}
+	}
+}
+
+// IsAppModule implements the appmodule.AppModule interface.
+func (m *sidecarModule) IsAppModule() {}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (m *sidecarModule) IsOnePerModuleType() {}
+
+// DefaultGenesis returns the default genesis of the sidecar module, as
+// described when loading it.
+func (m *sidecarModule) DefaultGenesis() json.RawMessage {
+	return m.defaultGenesis
+}
+
+// ValidateGenesis validates the genesis of the sidecar module.
+func (m *sidecarModule) ValidateGenesis(data json.RawMessage) error {
+	if !m.hasGenesis {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sidecarCallTimeout)
+	defer cancel()
+
+	res, err := m.client.ValidateGenesis(ctx, &runtimev1alpha1.ExtensionValidateGenesisRequest{Genesis: data})
+	if err != nil {
+		return err
+	}
+
+	if res.Error != "" {
+		return errors.New(res.Error)
+	}
+
+	return nil
+}
+
+// InitGenesis initializes the state of the sidecar module from its genesis.
+func (m *sidecarModule) InitGenesis(ctx context.Context, data json.RawMessage) error {
+	if !m.hasGenesis {
+		return nil
+	}
+
+	session, done := m.host.open(ctx)
+	defer done()
+
+	_, err := m.client.InitGenesis(ctx, &runtimev1alpha1.ExtensionInitGenesisRequest{Session: session, Genesis: data})
+	return err
+}
+
+// ExportGenesis exports the state of the sidecar module.
+func (m *sidecarModule) ExportGenesis(ctx context.Context) (json.RawMessage, error) {
+	if !m.hasGenesis {
+		return nil, nil
+	}
+
+	session, done := m.host.open(ctx)
+	defer done()
+
+	res, err := m.client.ExportGenesis(ctx, &runtimev1alpha1.ExtensionExportGenesisRequest{Session: session})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Genesis, nil
+}
+
+// BeginBlock forwards the begin block call to the sidecar module.
+func (m *sidecarModule) BeginBlock(ctx context.Context) error {
+	if !m.hasBeginBlocker {
+		return nil
+	}
+
+	return m.block(ctx, m.client.BeginBlock)
+}
+
+// EndBlock forwards the end block call to the sidecar module.
+func (m *sidecarModule) EndBlock(ctx context.Context) error {
+	if !m.hasEndBlocker {
+		return nil
+	}
+
+	return m.block(ctx, m.client.EndBlock)
+}
+
+func (m *sidecarModule) block(
+	ctx context.Context,
+	call func(context.Context, *runtimev1alpha1.ExtensionBlockRequest, ...grpc.CallOption) (*runtimev1alpha1.ExtensionBlockResponse, error),
+) error {
+	session, done := m.host.open(ctx)
+	defer done()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	headerInfo := sdkCtx.HeaderInfo()
+	res, err := call(ctx, &runtimev1alpha1.ExtensionBlockRequest{
+		Session: session,
+		ChainId: headerInfo.ChainID,
+		Height:  headerInfo.Height,
+		Time:    timestamppb.New(headerInfo.Time),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, ev := range res.Events {
+		attrs := make([]sdk.Attribute, 0, len(ev.Attributes))
+		for _, attr := range ev.Attributes {
+			attrs = append(attrs, sdk.NewAttribute(attr.Key, attr.Value))
+		}
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(ev.Type_, attrs...))
+	}
+
+	return nil
+}
+
+// extensionHost serves the module store to a sidecar process. Each call made
+// to the sidecar opens a session bound to the context of that call, so that
+// reads and writes go through the same (possibly branched) store.
+//
+// The host only listens on a unix socket or a loopback address, as the
+// sessions are the only authentication of the sidecar. Session ids are random
+// tokens, and the store of a session is only accessed by one request at a
+// time as the stores and gas meters are not safe for concurrent use.
+type extensionHost struct {
+	runtimev1alpha1.UnimplementedExtensionHostServer
+
+	name         string
+	address      string
+	storeService store.KVStoreService
+	logger       log.Logger
+	srv          *grpc.Server
+
+	mu       sync.RWMutex
+	sessions map[string]*extensionSession
+}
+
+// extensionSession is the context of a call made to a sidecar.
+type extensionSession struct {
+	mu     sync.Mutex
+	ctx    context.Context
+	closed bool
+}
+
+func newExtensionHost(name, address string, storeService store.KVStoreService, logger log.Logger) *extensionHost {
+	return &extensionHost{
+		name:         name,
+		address:      address,
+		storeService: storeService,
+		logger:       logger,
+		sessions:     make(map[string]*extensionSession),
+	}
+}
+
+// listenExtensionHost listens on the given extension host address, which is
+// either a unix socket address of the form unix:///path/to/socket or a TCP
+// address on a loopback interface.
+func listenExtensionHost(address string) (net.Listener, error) {
+	if path, ok := strings.CutPrefix(address, "unix://"); ok {
+		return net.Listen("unix", path)
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	ips, err := net.LookupIP(host)
+	if err != nil {
+		return nil, err
+	}
+	for _, ip := range ips {
+		if !ip.IsLoopback() {
+			return nil, fmt.Errorf("%s is not a loopback address, use a loopback address or a unix socket", host)
+		}
+	}
+
+	return net.Listen("tcp", address)
+}
+
+// listen starts serving the ExtensionHost service on the host address, until
+// stop is called.
+func (h *extensionHost) listen() error {
+	if h.srv != nil {
+		return fmt.Errorf("extension host of %s is already served", h.name)
+	}
+
+	lis, err := listenExtensionHost(h.address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on extension host address %s: %w", h.address, err)
+	}
+
+	srv := grpc.NewServer()
+	runtimev1alpha1.RegisterExtensionHostServer(srv, h)
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			h.logger.Error("extension host server stopped", "err", err)
+		}
+	}()
+	h.srv = srv
+
+	return nil
+}
+
+// stop gracefully stops the ExtensionHost server, or stops it immediately if
+// ctx is done first. It is a no-op if the host is not served.
+func (h *extensionHost) stop(ctx context.Context) error {
+	srv := h.srv
+	if srv == nil {
+		return nil
+	}
+	h.srv = nil
+
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		srv.Stop()
+		return ctx.Err()
+	}
+}
+
+// open registers a new session for ctx and returns its id together with a
+// function closing it. The function waits for the request being served in the
+// session, if any, so that the store is not accessed once the call returns.
+func (h *extensionHost) open(ctx context.Context) (string, func()) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		panic(fmt.Errorf("failed to generate extension session token: %w", err))
+	}
+	id := h.name + "/" + hex.EncodeToString(token)
+	session := &extensionSession{ctx: ctx}
+
+	h.mu.Lock()
+	h.sessions[id] = session
+	h.mu.Unlock()
+
+	return id, func() {
+		h.mu.Lock()
+		delete(h.sessions, id)
+		h.mu.Unlock()
+
+		session.mu.Lock()
+		session.closed = true
+		session.mu.Unlock()
+	}
+}
+
+// store returns the store of the given session together with a function to
+// call once done with the store, the session is locked in the meantime.
+func (h *extensionHost) store(id string) (store.KVStore, func(), error) {
+	h.mu.RLock()
+	session, ok := h.sessions[id]
+	h.mu.RUnlock()
+	if !ok {
+		return nil, nil, status.Errorf(codes.NotFound, "unknown or expired session %q", id)
+	}
+
+	session.mu.Lock()
+	if session.closed {
+		session.mu.Unlock()
+		return nil, nil, status.Errorf(codes.NotFound, "unknown or expired session %q", id)
+	}
+
+	return h.storeService.OpenKVStore(session.ctx), session.mu.Unlock, nil
+}
+
+// Get implements the ExtensionHost Get method.
+func (h *extensionHost) Get(_ context.Context, req *runtimev1alpha1.ExtensionHostGetRequest) (*runtimev1alpha1.ExtensionHostGetResponse, error) {
+	kv, release, err := h.store(req.Session)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	value, err := kv.Get(req.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtimev1alpha1.ExtensionHostGetResponse{Value: value, Found: value != nil}, nil
+}
+
+// Set implements the ExtensionHost Set method.
+func (h *extensionHost) Set(_ context.Context, req *runtimev1alpha1.ExtensionHostSetRequest) (*runtimev1alpha1.ExtensionHostSetResponse, error) {
+	kv, release, err := h.store(req.Session)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if err := kv.Set(req.Key, req.Value); err != nil {
+		return nil, err
+	}
+
+	return &runtimev1alpha1.ExtensionHostSetResponse{}, nil
+}
+
+// Delete implements the ExtensionHost Delete method.
+func (h *extensionHost) Delete(_ context.Context, req *runtimev1alpha1.ExtensionHostDeleteRequest) (*runtimev1alpha1.ExtensionHostDeleteResponse, error) {
+	kv, release, err := h.store(req.Session)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if err := kv.Delete(req.Key); err != nil {
+		return nil, err
+	}
+
+	return &runtimev1alpha1.ExtensionHostDeleteResponse{}, nil
+}
+
+// Iterate implements the ExtensionHost Iterate method.
+func (h *extensionHost) Iterate(_ context.Context, req *runtimev1alpha1.ExtensionHostIterateRequest) (*runtimev1alpha1.ExtensionHostIterateResponse, error) {
+	kv, release, err := h.store(req.Session)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	var it store.Iterator
+	if req.Reverse {
+		it, err = kv.ReverseIterator(req.Start, req.End)
+	} else {
+		it, err = kv.Iterator(req.Start, req.End)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	res := &runtimev1alpha1.ExtensionHostIterateResponse{}
+	for ; it.Valid(); it.Next() {
+		if req.Limit > 0 && uint64(len(res.Pairs)) >= req.Limit {
+			break
+		}
+		res.Pairs = append(res.Pairs, &runtimev1alpha1.ExtensionKVPair{Key: it.Key(), Value: it.Value()})
+	}
+
+	return res, it.Error()
+}
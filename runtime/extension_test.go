@@ -0,0 +1,291 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"plugin"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+type extensionTestModule struct {
+	name string
+}
+
+func (extensionTestModule) IsAppModule()        {}
+func (extensionTestModule) IsOnePerModuleType() {}
+
+func TestExtensionLoaderConfig(t *testing.T) {
+	testCases := []struct {
+		name   string
+		ext    *runtimev1alpha1.ExtensionConfig
+		expErr string
+	}{
+		{"empty name", &runtimev1alpha1.ExtensionConfig{PluginPath: "ext.so"}, "name cannot be empty"},
+		{"conflicting name", &runtimev1alpha1.ExtensionConfig{Name: "bank", PluginPath: "ext.so"}, "conflicts with an already registered module"},
+		{"no source", &runtimev1alpha1.ExtensionConfig{Name: "ext"}, "one of plugin_path and grpc_address must be set"},
+		{"both sources", &runtimev1alpha1.ExtensionConfig{Name: "ext", PluginPath: "ext.so", GrpcAddress: "localhost:1"}, "only one of plugin_path and grpc_address can be set"},
+		{"sidecar without host address", &runtimev1alpha1.ExtensionConfig{Name: "ext", GrpcAddress: "localhost:1"}, "host_address must be set"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			loader := newExtensionLoader(log.NewNopLogger(), &AppBuilder{app: &App{}})
+			modules := map[string]appmodule.AppModule{"bank": extensionTestModule{}}
+			err := loader.Load(&runtimev1alpha1.Module{Extensions: []*runtimev1alpha1.ExtensionConfig{tc.ext}}, modules)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestExtensionLoaderPlugin(t *testing.T) {
+	app := &AppBuilder{app: &App{}}
+	loader := newExtensionLoader(log.NewNopLogger(), app)
+
+	var constructor ExtensionConstructor = func(in ExtensionInputs) (appmodule.AppModule, error) {
+		require.Equal(t, []byte("config"), in.Config)
+		require.NotNil(t, in.Environment.KVStoreService)
+		return extensionTestModule{name: in.Name}, nil
+	}
+	loader.openPlugin = func(path string) (plugin.Symbol, error) {
+		switch path {
+		case "ext.so":
+			return &constructor, nil
+		case "invalid.so":
+			return "not a constructor", nil
+		default:
+			return nil, errors.New("plugin not found")
+		}
+	}
+
+	modules := map[string]appmodule.AppModule{}
+	err := loader.Load(&runtimev1alpha1.Module{Extensions: []*runtimev1alpha1.ExtensionConfig{
+		{Name: "ext", PluginPath: "ext.so", Config: []byte("config")},
+	}}, modules)
+	require.NoError(t, err)
+	require.Equal(t, extensionTestModule{name: "ext"}, modules["ext"])
+	require.Len(t, app.app.storeKeys, 2)
+
+	err = loader.Load(&runtimev1alpha1.Module{Extensions: []*runtimev1alpha1.ExtensionConfig{
+		{Name: "invalid", PluginPath: "invalid.so"},
+	}}, modules)
+	require.ErrorContains(t, err, "plugin symbol NewAppModule has type string")
+
+	err = loader.Load(&runtimev1alpha1.Module{Extensions: []*runtimev1alpha1.ExtensionConfig{
+		{Name: "missing", PluginPath: "missing.so"},
+	}}, modules)
+	require.ErrorContains(t, err, "plugin not found")
+}
+
+// testSidecar is an ExtensionModule which writes the block height to its
+// store through the extension host on every begin block, and then the given
+// number of keys concurrently.
+type testSidecar struct {
+	runtimev1alpha1.UnimplementedExtensionModuleServer
+
+	host        runtimev1alpha1.ExtensionHostClient
+	concurrency int
+	session     string
+}
+
+func (s *testSidecar) Describe(context.Context, *runtimev1alpha1.ExtensionDescribeRequest) (*runtimev1alpha1.ExtensionDescribeResponse, error) {
+	return &runtimev1alpha1.ExtensionDescribeResponse{Name: "sidecar", HasBeginBlocker: true}, nil
+}
+
+func (s *testSidecar) BeginBlock(ctx context.Context, req *runtimev1alpha1.ExtensionBlockRequest) (*runtimev1alpha1.ExtensionBlockResponse, error) {
+	s.session = req.Session
+	_, err := s.host.Set(ctx, &runtimev1alpha1.ExtensionHostSetRequest{Session: req.Session, Key: []byte("height"), Value: []byte{byte(req.Height)}})
+	if err != nil {
+		return nil, err
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	for i := 0; i < s.concurrency; i++ {
+		g.Go(func() error {
+			key := []byte{'k', byte(i)}
+			if _, err := s.host.Set(gctx, &runtimev1alpha1.ExtensionHostSetRequest{Session: req.Session, Key: key, Value: []byte{byte(i)}}); err != nil {
+				return err
+			}
+			if _, err := s.host.Get(gctx, &runtimev1alpha1.ExtensionHostGetRequest{Session: req.Session, Key: key}); err != nil {
+				return err
+			}
+			_, err := s.host.Iterate(gctx, &runtimev1alpha1.ExtensionHostIterateRequest{Session: req.Session})
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return &runtimev1alpha1.ExtensionBlockResponse{Events: []*runtimev1alpha1.ExtensionEvent{{
+		Type_:      "sidecar_begin_block",
+		Attributes: []*runtimev1alpha1.ExtensionEventAttribute{{Key: "chain_id", Value: req.ChainId}},
+	}}}, nil
+}
+
+func TestExtensionLoaderSidecar(t *testing.T) {
+	hostLis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	hostAddr := hostLis.Addr().String()
+	require.NoError(t, hostLis.Close())
+
+	testExtensionSidecar(t, hostAddr, hostAddr)
+}
+
+func TestExtensionLoaderSidecarUnixSocket(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "host.sock")
+	testExtensionSidecar(t, "unix://"+socket, "unix://"+socket)
+}
+
+func testExtensionSidecar(t *testing.T, hostAddr, hostTarget string) {
+	t.Helper()
+
+	conn, err := grpc.NewClient(hostTarget, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	sidecar := &testSidecar{host: runtimev1alpha1.NewExtensionHostClient(conn), concurrency: 16}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	runtimev1alpha1.RegisterExtensionModuleServer(srv, sidecar)
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	app := &AppBuilder{app: &App{}}
+	modules := map[string]appmodule.AppModule{}
+	err = newExtensionLoader(log.NewNopLogger(), app).Load(&runtimev1alpha1.Module{Extensions: []*runtimev1alpha1.ExtensionConfig{
+		{Name: "sidecar", GrpcAddress: lis.Addr().String(), HostAddress: hostAddr},
+	}}, modules)
+	require.NoError(t, err)
+	require.Len(t, app.app.storeKeys, 1)
+
+	// the host is only served once the app is loaded
+	requireExtensionHostUnavailable(t, hostTarget)
+	require.NoError(t, app.app.startExtensionHosts())
+
+	mod, ok := modules["sidecar"].(appmodule.HasBeginBlocker)
+	require.True(t, ok)
+
+	key := app.app.storeKeys[0]
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{ChainID: "test-chain", Height: 7})
+	require.NoError(t, mod.BeginBlock(ctx))
+
+	require.Equal(t, []byte{7}, ctx.KVStore(key).Get([]byte("height")))
+	for i := 0; i < sidecar.concurrency; i++ {
+		require.Equal(t, []byte{byte(i)}, ctx.KVStore(key).Get([]byte{'k', byte(i)}))
+	}
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "sidecar_begin_block", events[0].Type)
+	require.Equal(t, "test-chain", events[0].Attributes[0].Value)
+
+	// sessions are closed once the call returns
+	require.True(t, strings.HasPrefix(sidecar.session, "sidecar/"))
+	_, err = sidecar.host.Get(context.Background(), &runtimev1alpha1.ExtensionHostGetRequest{Session: sidecar.session, Key: []byte("height")})
+	require.ErrorContains(t, err, "unknown or expired session")
+
+	require.NoError(t, app.app.stopExtensionHosts(context.Background()))
+	requireExtensionHostUnavailable(t, hostTarget)
+}
+
+// blockingSidecar is an ExtensionModule whose Describe call blocks until the
+// call is canceled, and whose default genesis cannot be fetched.
+type blockingSidecar struct {
+	runtimev1alpha1.UnimplementedExtensionModuleServer
+
+	block atomic.Bool
+}
+
+func (s *blockingSidecar) Describe(ctx context.Context, _ *runtimev1alpha1.ExtensionDescribeRequest) (*runtimev1alpha1.ExtensionDescribeResponse, error) {
+	if s.block.Load() {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	return &runtimev1alpha1.ExtensionDescribeResponse{Name: "sidecar", HasGenesis: true}, nil
+}
+
+func (s *blockingSidecar) DefaultGenesis(context.Context, *runtimev1alpha1.ExtensionDefaultGenesisRequest) (*runtimev1alpha1.ExtensionDefaultGenesisResponse, error) {
+	return nil, errors.New("genesis unavailable")
+}
+
+func TestExtensionLoaderSidecarErrors(t *testing.T) {
+	dialTimeout, callTimeout := sidecarDialTimeout, sidecarCallTimeout
+	sidecarDialTimeout, sidecarCallTimeout = 100*time.Millisecond, 100*time.Millisecond
+	defer func() { sidecarDialTimeout, sidecarCallTimeout = dialTimeout, callTimeout }()
+
+	load := func(address string) error {
+		return newExtensionLoader(log.NewNopLogger(), &AppBuilder{app: &App{}}).Load(&runtimev1alpha1.Module{Extensions: []*runtimev1alpha1.ExtensionConfig{
+			{Name: "sidecar", GrpcAddress: address, HostAddress: "127.0.0.1:0"},
+		}}, map[string]appmodule.AppModule{})
+	}
+
+	// a listener which never completes the handshake
+	silentLis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer silentLis.Close()
+	require.ErrorContains(t, load(silentLis.Addr().String()), "failed to connect to sidecar")
+
+	sidecar := &blockingSidecar{}
+	sidecar.block.Store(true)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	runtimev1alpha1.RegisterExtensionModuleServer(srv, sidecar)
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	err = load(lis.Addr().String())
+	require.ErrorContains(t, err, "failed to describe sidecar")
+	require.Equal(t, codes.DeadlineExceeded, status.Code(errors.Unwrap(err)))
+
+	sidecar.block.Store(false)
+	require.ErrorContains(t, load(lis.Addr().String()), "failed to get default genesis of extension module sidecar: rpc error: code = Unknown desc = genesis unavailable")
+}
+
+// requireExtensionHostUnavailable dials the host on a new connection, so that
+// the connection backoff of the sidecar is not triggered.
+func requireExtensionHostUnavailable(t *testing.T, hostTarget string) {
+	t.Helper()
+
+	conn, err := grpc.NewClient(hostTarget, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = runtimev1alpha1.NewExtensionHostClient(conn).Get(context.Background(), &runtimev1alpha1.ExtensionHostGetRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestListenExtensionHost(t *testing.T) {
+	for _, address := range []string{"0.0.0.0:0", "[::]:0", "8.8.8.8:0"} {
+		_, err := listenExtensionHost(address)
+		require.ErrorContains(t, err, "is not a loopback address", address)
+	}
+
+	for _, address := range []string{"127.0.0.1:0", "localhost:0"} {
+		lis, err := listenExtensionHost(address)
+		require.NoError(t, err, address)
+		require.NoError(t, lis.Close())
+	}
+}
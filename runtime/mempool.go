@@ -0,0 +1,55 @@
+package runtime
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// MempoolLane is a depinject.ManyPerContainerType which can be used by modules
+// to reserve a lane of the app-side mempool to their transactions, e.g. an
+// oracle module reserving a share of the block space to its price updates.
+//
+// The provided lanes, ordered by name, take precedence over the lanes of the
+// mempool configured in the app.toml, which is turned into a mempool.LaneMempool
+// if it is not one already. The lanes are ignored if the app-side mempool is
+// disabled.
+type MempoolLane mempool.Lane
+
+// IsManyPerContainerType indicates that this is a depinject.ManyPerContainerType.
+func (MempoolLane) IsManyPerContainerType() {}
+
+// mempoolLanesOption returns a BaseApp option adding the given lanes before the
+// lanes of the configured mempool.
+func mempoolLanesOption(lanes []MempoolLane) BaseAppOption {
+	lanes = slices.Clone(lanes)
+	slices.SortFunc(lanes, func(a, b MempoolLane) int { return strings.Compare(a.Name, b.Name) })
+
+	return func(app *baseapp.BaseApp) {
+		configured := app.Mempool()
+		if _, ok := configured.(mempool.NoOpMempool); ok || configured == nil {
+			return
+		}
+
+		mempoolLanes := make([]mempool.Lane, 0, len(lanes)+1)
+		for _, lane := range lanes {
+			mempoolLanes = append(mempoolLanes, mempool.Lane(lane))
+		}
+
+		if laneMempool, ok := configured.(*mempool.LaneMempool); ok {
+			mempoolLanes = append(mempoolLanes, laneMempool.Lanes()...)
+		} else {
+			mempoolLanes = append(mempoolLanes, mempool.Lane{Name: "default", Mempool: configured})
+		}
+
+		laneMempool, err := mempool.NewLaneMempool(mempoolLanes...)
+		if err != nil {
+			panic(fmt.Errorf("failed to create the lane mempool: %w", err))
+		}
+
+		app.SetMempool(laneMempool)
+	}
+}
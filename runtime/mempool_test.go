@@ -0,0 +1,60 @@
+package runtime
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+func TestMempoolLanesOption(t *testing.T) {
+	lanes := []MempoolLane{
+		{Name: "votes", Match: mempool.MatchMsgTypes("/cosmos.gov.v1.MsgVote"), BlockSpace: math.LegacyNewDecWithPrec(1, 1), Mempool: mempool.NewSenderNonceMempool()},
+		{Name: "oracle", Match: mempool.MatchMsgTypes("/oracle.v1.MsgUpdate"), BlockSpace: math.LegacyNewDecWithPrec(2, 1), Mempool: mempool.NewSenderNonceMempool()},
+	}
+
+	laneNames := func(mp mempool.Mempool) []string {
+		laneMempool, ok := mp.(*mempool.LaneMempool)
+		require.True(t, ok, "mempool is a %T", mp)
+
+		var names []string
+		for _, lane := range laneMempool.Lanes() {
+			names = append(names, lane.Name)
+		}
+		return names
+	}
+
+	newBaseApp := func(opts ...func(*baseapp.BaseApp)) *baseapp.BaseApp {
+		return baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil, opts...)
+	}
+
+	// the configured mempool becomes the last lane
+	app := newBaseApp(baseapp.SetMempool(mempool.NewSenderNonceMempool()), mempoolLanesOption(lanes))
+	require.Equal(t, []string{"oracle", "votes", "default"}, laneNames(app.Mempool()))
+
+	// the lanes of a configured lane mempool follow the provided lanes
+	configured, err := mempool.NewLaneMempool(
+		mempool.Lane{Name: "gov", Match: mempool.MatchMsgTypes("/cosmos.gov.v1.MsgSubmitProposal"), Mempool: mempool.NewSenderNonceMempool()},
+		mempool.Lane{Name: "other", Mempool: mempool.NewSenderNonceMempool()},
+	)
+	require.NoError(t, err)
+	app = newBaseApp(baseapp.SetMempool(configured), mempoolLanesOption(lanes))
+	require.Equal(t, []string{"oracle", "votes", "gov", "other"}, laneNames(app.Mempool()))
+
+	// a disabled mempool stays disabled
+	app = newBaseApp(baseapp.SetMempool(mempool.NoOpMempool{}), mempoolLanesOption(lanes))
+	require.IsType(t, mempool.NoOpMempool{}, app.Mempool())
+
+	// the lanes cannot reserve more than the whole block
+	overflow := append([]MempoolLane{}, lanes...)
+	overflow = append(overflow, MempoolLane{Name: "bulk", BlockSpace: math.LegacyOneDec(), Mempool: mempool.NewSenderNonceMempool()})
+	require.PanicsWithError(t, "failed to create the lane mempool: block space reserved to lanes exceeds the block: 1.300000000000000000", func() {
+		newBaseApp(baseapp.SetMempool(mempool.NewSenderNonceMempool()), mempoolLanesOption(overflow))
+	})
+}
@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"fmt"
+	"maps"
 	"os"
 	"slices"
 
@@ -10,6 +11,7 @@ import (
 	"google.golang.org/protobuf/reflect/protoregistry"
 
 	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
 	"cosmossdk.io/core/app"
@@ -101,6 +103,7 @@ func init() {
 			ProvideGenesisTxHandler,
 			ProvideEnvironment,
 			ProvideTransientStoreService,
+			ProvideModuleStores,
 			ProvideModuleManager,
 			ProvideAppVersionModifier,
 			ProvideCometService,
@@ -155,9 +158,11 @@ type AppInputs struct {
 
 	Logger            log.Logger
 	Config            *runtimev1alpha1.Module
+	AppConfig         *appv1alpha1.Config `optional:"true"`
 	AppBuilder        *AppBuilder
 	ModuleManager     *module.Manager
 	BaseAppOptions    []BaseAppOption
+	MempoolLanes      []MempoolLane
 	InterfaceRegistry codectypes.InterfaceRegistry
 	LegacyAmino       legacy.Amino
 }
@@ -165,7 +170,12 @@ type AppInputs struct {
 func SetupAppBuilder(inputs AppInputs) {
 	app := inputs.AppBuilder.app
 	app.baseAppOptions = inputs.BaseAppOptions
+	if len(inputs.MempoolLanes) > 0 {
+		// the lanes are added once the mempool of the app.toml is set
+		app.baseAppOptions = append(app.baseAppOptions, mempoolLanesOption(inputs.MempoolLanes))
+	}
 	app.config = inputs.Config
+	app.appConfig = inputs.AppConfig
 	app.logger = inputs.Logger
 	app.ModuleManager = inputs.ModuleManager
 	app.ModuleManager.RegisterInterfaces(inputs.InterfaceRegistry)
@@ -176,6 +186,34 @@ func registerStoreKey(wrapper *AppBuilder, key storetypes.StoreKey) {
 	wrapper.app.storeKeys = append(wrapper.app.storeKeys, key)
 }
 
+// kvStoreKey returns the registered kv store key with the given name, or
+// registers a new one. Store keys are compared by pointer when mounted, so the
+// same instance must be shared by every provider requesting it.
+func kvStoreKey(wrapper *AppBuilder, name string) *storetypes.KVStoreKey {
+	for _, key := range wrapper.app.storeKeys {
+		if key, ok := key.(*storetypes.KVStoreKey); ok && key.Name() == name {
+			return key
+		}
+	}
+
+	storeKey := storetypes.NewKVStoreKey(name)
+	registerStoreKey(wrapper, storeKey)
+	return storeKey
+}
+
+// transientStoreKey is the transient store equivalent of kvStoreKey.
+func transientStoreKey(wrapper *AppBuilder, name string) *storetypes.TransientStoreKey {
+	for _, key := range wrapper.app.storeKeys {
+		if key, ok := key.(*storetypes.TransientStoreKey); ok && key.Name() == name {
+			return key
+		}
+	}
+
+	storeKey := storetypes.NewTransientStoreKey(name)
+	registerStoreKey(wrapper, storeKey)
+	return storeKey
+}
+
 func storeKeyOverride(config *runtimev1alpha1.Module, moduleName string) *runtimev1alpha1.StoreKeyConfig {
 	for _, cfg := range config.OverrideStoreKeys {
 		if cfg.ModuleName == moduleName {
@@ -197,15 +235,13 @@ func ProvideKVStoreKey(
 	override := storeKeyOverride(config, key.Name())
 
 	var storeKeyName string
-	if override != nil {
+	if override != nil && override.KvStoreKey != "" {
 		storeKeyName = override.KvStoreKey
 	} else {
 		storeKeyName = key.Name()
 	}
 
-	storeKey := storetypes.NewKVStoreKey(storeKeyName)
-	registerStoreKey(app, storeKey)
-	return storeKey
+	return kvStoreKey(app, storeKeyName)
 }
 
 func ProvideTransientStoreKey(
@@ -217,9 +253,7 @@ func ProvideTransientStoreKey(
 		return nil
 	}
 
-	storeKey := storetypes.NewTransientStoreKey(fmt.Sprintf("transient:%s", key.Name()))
-	registerStoreKey(app, storeKey)
-	return storeKey
+	return transientStoreKey(app, fmt.Sprintf("transient:%s", key.Name()))
 }
 
 func ProvideMemoryStoreKey(
@@ -236,8 +270,21 @@ func ProvideMemoryStoreKey(
 	return storeKey
 }
 
-func ProvideModuleManager(modules map[string]appmodule.AppModule) *module.Manager {
-	return module.NewManagerFromMap(modules)
+func ProvideModuleManager(
+	logger log.Logger,
+	config *runtimev1alpha1.Module,
+	app *AppBuilder,
+	modules map[string]appmodule.AppModule,
+) (*module.Manager, error) {
+	if len(config.Extensions) > 0 {
+		// copy the modules map so that the depinject provided one is left untouched
+		modules = maps.Clone(modules)
+		if err := newExtensionLoader(logger, app).Load(config, modules); err != nil {
+			return nil, err
+		}
+	}
+
+	return module.NewManagerFromMap(modules), nil
 }
 
 func ProvideGenesisTxHandler(appBuilder *AppBuilder) genesis.TxHandler {
@@ -288,6 +335,55 @@ func ProvideTransientStoreService(
 	return transientStoreService{key: storeKey}
 }
 
+// ProvideModuleStores provides the additional store keys and aliases declared
+// for a module in the runtime config store key overrides.
+func ProvideModuleStores(
+	config *runtimev1alpha1.Module,
+	key depinject.ModuleKey,
+	app *AppBuilder,
+) (ModuleStores, error) {
+	stores := ModuleStores{
+		kvStores:        make(map[string]store.KVStoreService),
+		transientStores: make(map[string]store.TransientStoreService),
+		aliases:         make(map[string]string),
+	}
+
+	override := storeKeyOverride(config, key.Name())
+	if override == nil {
+		return stores, nil
+	}
+
+	if mainKey := ProvideKVStoreKey(config, key, app); mainKey != nil {
+		stores.kvStores[mainKey.Name()] = kvStoreService{key: mainKey}
+	}
+
+	for _, name := range override.AdditionalKvStoreKeys {
+		if _, ok := stores.kvStores[name]; ok {
+			return ModuleStores{}, fmt.Errorf("kv store key %s is declared more than once for module %s", name, key.Name())
+		}
+		stores.kvStores[name] = kvStoreService{key: kvStoreKey(app, name)}
+	}
+
+	for _, name := range override.AdditionalTransientStoreKeys {
+		if _, ok := stores.transientStores[name]; ok {
+			return ModuleStores{}, fmt.Errorf("transient store key %s is declared more than once for module %s", name, key.Name())
+		}
+		stores.transientStores[name] = transientStoreService{key: transientStoreKey(app, name)}
+	}
+
+	for _, alias := range override.KvStoreKeyAliases {
+		if _, ok := stores.kvStores[alias.KvStoreKey]; !ok {
+			return ModuleStores{}, fmt.Errorf("alias %s of module %s references kv store key %s which is not declared for the module", alias.Alias, key.Name(), alias.KvStoreKey)
+		}
+		if _, ok := stores.kvStores[alias.Alias]; ok {
+			return ModuleStores{}, fmt.Errorf("alias %s of module %s shadows one of its kv store keys", alias.Alias, key.Name())
+		}
+		stores.aliases[alias.Alias] = alias.KvStoreKey
+	}
+
+	return stores, nil
+}
+
 func ProvideAppVersionModifier(app *AppBuilder) app.VersionModifier {
 	return app.app
 }
@@ -0,0 +1,203 @@
+package runtime
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
+	"cosmossdk.io/core/appmodule"
+)
+
+// orderingField is a runtime config ordering field together with the hook it
+// orders.
+type orderingField struct {
+	hook       appmodule.Hook
+	order      *[]string
+	implements func(appmodule.AppModule) bool
+}
+
+func orderingFields(config *runtimev1alpha1.Module) []orderingField {
+	return []orderingField{
+		{appmodule.HookPreBlocker, &config.PreBlockers, hasHook[appmodule.HasPreBlocker]},
+		{appmodule.HookBeginBlocker, &config.BeginBlockers, hasHook[appmodule.HasBeginBlocker]},
+		{appmodule.HookEndBlocker, &config.EndBlockers, hasEndBlocker},
+		{appmodule.HookInitGenesis, &config.InitGenesis, hasGenesis},
+		{appmodule.HookExportGenesis, &config.ExportGenesis, hasGenesis},
+		{appmodule.HookPrecommit, &config.Precommiters, hasHook[appmodule.HasPrecommit]},
+		{appmodule.HookPrepareCheckState, &config.PrepareCheckStaters, hasHook[appmodule.HasPrepareCheckState]},
+	}
+}
+
+// deriveOrdering returns a copy of config where the empty ordering fields are
+// computed from the ordering constraints declared by the modules, if
+// config.DeriveOrdering is set. The export genesis ordering is only derived
+// when a module declares export genesis constraints, otherwise it keeps
+// defaulting to the init genesis ordering.
+func deriveOrdering(config *runtimev1alpha1.Module, modules map[string]appmodule.AppModule) (*runtimev1alpha1.Module, error) {
+	if config == nil || !config.DeriveOrdering {
+		return config, nil
+	}
+
+	constraints := moduleConstraints(modules)
+
+	config = proto.Clone(config).(*runtimev1alpha1.Module)
+	for _, field := range orderingFields(config) {
+		if len(*field.order) != 0 {
+			continue
+		}
+
+		if field.hook == appmodule.HookExportGenesis && len(constraints[field.hook]) == 0 {
+			continue
+		}
+
+		order, err := deriveOrder(field.hook, modules, field.implements, constraints[field.hook])
+		if err != nil {
+			return nil, err
+		}
+		*field.order = order
+	}
+
+	return config, nil
+}
+
+// moduleConstraints groups the ordering constraints declared by the modules
+// by hook, as a map of module name to its constraints.
+func moduleConstraints(modules map[string]appmodule.AppModule) map[appmodule.Hook]map[string][]appmodule.OrderingConstraint {
+	constraints := make(map[appmodule.Hook]map[string][]appmodule.OrderingConstraint)
+	for name, mod := range modules {
+		mod, ok := mod.(appmodule.HasOrderingConstraints)
+		if !ok {
+			continue
+		}
+
+		for _, c := range mod.OrderingConstraints() {
+			if constraints[c.Hook] == nil {
+				constraints[c.Hook] = make(map[string][]appmodule.OrderingConstraint)
+			}
+			constraints[c.Hook][name] = append(constraints[c.Hook][name], c)
+		}
+	}
+
+	return constraints
+}
+
+// deriveOrder computes a deterministic ordering of the modules implementing
+// hook which satisfies the given constraints. Among the modules which can be
+// called next, the one with the smallest name is picked first.
+func deriveOrder(
+	hook appmodule.Hook,
+	modules map[string]appmodule.AppModule,
+	implements func(appmodule.AppModule) bool,
+	constraints map[string][]appmodule.OrderingConstraint,
+) ([]string, error) {
+	var names []string
+	for name, mod := range modules {
+		if implements(mod) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	// edges[a] lists the modules which must be called after a.
+	edges := make(map[string][]string, len(names))
+	inDegree := make(map[string]int, len(names))
+	addEdge := func(from, to string) {
+		if from == to || !slices.Contains(names, from) || !slices.Contains(names, to) || slices.Contains(edges[from], to) {
+			return
+		}
+		edges[from] = append(edges[from], to)
+		inDegree[to]++
+	}
+
+	for _, name := range names {
+		for _, c := range constraints[name] {
+			for _, after := range c.After {
+				addEdge(after, name)
+			}
+			for _, before := range c.Before {
+				addEdge(name, before)
+			}
+		}
+	}
+
+	var ready []string
+	for _, name := range names {
+		if inDegree[name] == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	order := make([]string, 0, len(names))
+	for len(ready) > 0 {
+		next := ready[0]
+		ready = ready[1:]
+		order = append(order, next)
+
+		for _, to := range edges[next] {
+			inDegree[to]--
+			if inDegree[to] == 0 {
+				ready = append(ready, to)
+			}
+		}
+		sort.Strings(ready)
+	}
+
+	if len(order) != len(names) {
+		var cycle []string
+		for _, name := range names {
+			if inDegree[name] > 0 {
+				cycle = append(cycle, name)
+			}
+		}
+		return nil, fmt.Errorf("cannot derive %s ordering, the ordering constraints of modules %v form a cycle", hook, cycle)
+	}
+
+	return order, nil
+}
+
+// validateConstraints reports the explicit orderings of config which violate
+// the ordering constraints declared by the modules.
+func validateConstraints(config *runtimev1alpha1.Module, modules map[string]appmodule.AppModule) WiringIssues {
+	var issues WiringIssues
+
+	constraints := moduleConstraints(modules)
+	for _, field := range orderingFields(config) {
+		position := make(map[string]int, len(*field.order))
+		for i, name := range *field.order {
+			if _, ok := position[name]; !ok {
+				position[name] = i
+			}
+		}
+
+		names := make([]string, 0, len(constraints[field.hook]))
+		for name := range constraints[field.hook] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			pos, ok := position[name]
+			if !ok {
+				continue
+			}
+
+			for _, c := range constraints[field.hook][name] {
+				for _, after := range c.After {
+					if p, ok := position[after]; ok && p > pos {
+						issues = append(issues, WiringIssue{Severity: WiringWarning, Field: string(field.hook), Module: name, Message: fmt.Sprintf("module must be called after %s", after)})
+					}
+				}
+				for _, before := range c.Before {
+					if p, ok := position[before]; ok && p < pos {
+						issues = append(issues, WiringIssue{Severity: WiringWarning, Field: string(field.hook), Module: name, Message: fmt.Sprintf("module must be called before %s", before)})
+					}
+				}
+			}
+		}
+	}
+
+	return issues
+}
@@ -0,0 +1,78 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
+	"cosmossdk.io/core/appmodule"
+)
+
+type orderedModule struct {
+	wiringBlockerModule
+	constraints []appmodule.OrderingConstraint
+}
+
+func (m orderedModule) OrderingConstraints() []appmodule.OrderingConstraint {
+	return m.constraints
+}
+
+func TestDeriveOrdering(t *testing.T) {
+	modules := map[string]appmodule.AppModule{
+		"staking": wiringBlockerModule{},
+		"mint":    wiringBlockerModule{},
+		"distribution": orderedModule{constraints: []appmodule.OrderingConstraint{
+			{Hook: appmodule.HookBeginBlocker, After: []string{"mint", "absent"}},
+		}},
+		"slashing": orderedModule{constraints: []appmodule.OrderingConstraint{
+			{Hook: appmodule.HookBeginBlocker, After: []string{"distribution"}},
+		}},
+		"auth": orderedModule{constraints: []appmodule.OrderingConstraint{
+			{Hook: appmodule.HookBeginBlocker, Before: []string{"mint"}},
+		}},
+		"nohook": extensionTestModule{},
+	}
+
+	config := &runtimev1alpha1.Module{EndBlockers: []string{"staking"}}
+	derived, err := deriveOrdering(config, modules)
+	require.NoError(t, err)
+	require.Same(t, config, derived)
+
+	config.DeriveOrdering = true
+	derived, err = deriveOrdering(config, modules)
+	require.NoError(t, err)
+	require.Equal(t, []string{"auth", "mint", "distribution", "slashing", "staking"}, derived.BeginBlockers)
+	require.Equal(t, []string{"staking"}, derived.EndBlockers)
+	require.Empty(t, derived.ExportGenesis)
+	require.Empty(t, config.BeginBlockers)
+
+	// the derived ordering does not depend on the map iteration order
+	for i := 0; i < 10; i++ {
+		again, err := deriveOrdering(config, modules)
+		require.NoError(t, err)
+		require.Equal(t, derived.BeginBlockers, again.BeginBlockers)
+	}
+
+	modules["mint"] = orderedModule{constraints: []appmodule.OrderingConstraint{
+		{Hook: appmodule.HookBeginBlocker, After: []string{"slashing"}},
+	}}
+	_, err = deriveOrdering(config, modules)
+	require.ErrorContains(t, err, "cannot derive begin_blockers ordering, the ordering constraints of modules [distribution mint slashing] form a cycle")
+}
+
+func TestValidateWiringConstraints(t *testing.T) {
+	modules := map[string]appmodule.AppModule{
+		"mint": wiringBlockerModule{},
+		"distribution": orderedModule{constraints: []appmodule.OrderingConstraint{
+			{Hook: appmodule.HookBeginBlocker, After: []string{"mint"}},
+		}},
+	}
+
+	issues := ValidateWiring(&runtimev1alpha1.Module{BeginBlockers: []string{"distribution", "mint"}}, nil, modules)
+	require.Equal(t, WiringIssues{
+		{Severity: WiringWarning, Field: "begin_blockers", Module: "distribution", Message: "module must be called after mint"},
+	}, issues)
+
+	require.Empty(t, ValidateWiring(&runtimev1alpha1.Module{BeginBlockers: []string{"mint", "distribution"}}, nil, modules))
+}
@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"context"
+	"fmt"
 	"io"
 
 	dbm "github.com/cosmos/cosmos-db"
@@ -48,6 +49,40 @@ func (t transientStoreService) OpenTransientStore(ctx context.Context) store.KVS
 	return newKVStore(sdk.UnwrapSDKContext(ctx).KVStore(t.key))
 }
 
+// ModuleStores gives a module access to its additional stores, declared in
+// the runtime config store key overrides, by store key name or alias.
+type ModuleStores struct {
+	kvStores        map[string]store.KVStoreService
+	transientStores map[string]store.TransientStoreService
+	aliases         map[string]string
+}
+
+// KVStoreService returns the kv store service of the module store key with the
+// given name or alias.
+func (s ModuleStores) KVStoreService(name string) (store.KVStoreService, error) {
+	if target, ok := s.aliases[name]; ok {
+		name = target
+	}
+
+	kvStore, ok := s.kvStores[name]
+	if !ok {
+		return nil, fmt.Errorf("kv store key %s is not declared for this module: verify runtime `override_store_keys` app config", name)
+	}
+
+	return kvStore, nil
+}
+
+// TransientStoreService returns the transient store service of the module
+// transient store key with the given name.
+func (s ModuleStores) TransientStoreService(name string) (store.TransientStoreService, error) {
+	transientStore, ok := s.transientStores[name]
+	if !ok {
+		return nil, fmt.Errorf("transient store key %s is not declared for this module: verify runtime `override_store_keys` app config", name)
+	}
+
+	return transientStore, nil
+}
+
 type failingStoreService struct{}
 
 func (failingStoreService) OpenKVStore(ctx context.Context) store.KVStore {
@@ -0,0 +1,61 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
+	"cosmossdk.io/depinject"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+func TestProvideModuleStores(t *testing.T) {
+	config := &runtimev1alpha1.Module{
+		OverrideStoreKeys: []*runtimev1alpha1.StoreKeyConfig{{
+			ModuleName:                   "auth",
+			KvStoreKey:                   "acc",
+			AdditionalKvStoreKeys:        []string{"auth_extra"},
+			AdditionalTransientStoreKeys: []string{"transient:auth_extra"},
+			KvStoreKeyAliases:            []*runtimev1alpha1.StoreKeyAlias{{Alias: "auth", KvStoreKey: "acc"}},
+		}},
+	}
+	moduleKey := (&depinject.ModuleKeyContext{}).For("auth")
+	app := &AppBuilder{app: &App{}}
+
+	mainKey := ProvideKVStoreKey(config, moduleKey, app)
+	stores, err := ProvideModuleStores(config, moduleKey, app)
+	require.NoError(t, err)
+
+	// the module store key is shared with the other providers
+	require.Len(t, app.app.storeKeys, 3)
+	require.Same(t, mainKey, app.app.storeKeys[0])
+
+	testCtx := testutil.DefaultContextWithDB(t, mainKey, storetypes.NewTransientStoreKey("transient_test"))
+	mainStore, err := stores.KVStoreService("acc")
+	require.NoError(t, err)
+	require.NoError(t, mainStore.OpenKVStore(testCtx.Ctx).Set([]byte("key"), []byte("value")))
+
+	aliased, err := stores.KVStoreService("auth")
+	require.NoError(t, err)
+	value, err := aliased.OpenKVStore(testCtx.Ctx).Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	_, err = stores.KVStoreService("auth_extra")
+	require.NoError(t, err)
+	_, err = stores.TransientStoreService("transient:auth_extra")
+	require.NoError(t, err)
+	_, err = stores.KVStoreService("bank")
+	require.ErrorContains(t, err, "kv store key bank is not declared for this module")
+
+	config.OverrideStoreKeys[0].KvStoreKeyAliases = []*runtimev1alpha1.StoreKeyAlias{{Alias: "legacy", KvStoreKey: "unknown"}}
+	_, err = ProvideModuleStores(config, moduleKey, app)
+	require.ErrorContains(t, err, "references kv store key unknown which is not declared for the module")
+
+	config.OverrideStoreKeys[0].KvStoreKeyAliases = []*runtimev1alpha1.StoreKeyAlias{{Alias: "auth_extra", KvStoreKey: "acc"}}
+	_, err = ProvideModuleStores(config, moduleKey, app)
+	require.ErrorContains(t, err, "shadows one of its kv store keys")
+}
@@ -0,0 +1,331 @@
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// WiringSeverity is the severity of a WiringIssue.
+type WiringSeverity int
+
+const (
+	// WiringWarning marks a wiring issue which does not prevent the app from
+	// starting but most likely is a mistake.
+	WiringWarning WiringSeverity = iota
+	// WiringError marks a wiring issue which prevents the app from starting.
+	WiringError
+)
+
+func (s WiringSeverity) String() string {
+	if s == WiringError {
+		return "error"
+	}
+
+	return "warning"
+}
+
+// WiringIssue describes a single app wiring mistake.
+type WiringIssue struct {
+	Severity WiringSeverity
+	// Field is the runtime config field the issue was found in, if any.
+	Field string
+	// Module is the name of the module the issue relates to.
+	Module string
+	// Message is the human readable description of the issue.
+	Message string
+}
+
+func (i WiringIssue) String() string {
+	if i.Field == "" {
+		return fmt.Sprintf("%s: module %s: %s", i.Severity, i.Module, i.Message)
+	}
+
+	return fmt.Sprintf("%s: %s: module %s: %s", i.Severity, i.Field, i.Module, i.Message)
+}
+
+// WiringIssues is a list of wiring issues.
+type WiringIssues []WiringIssue
+
+// Err returns an error joining all the issues with the WiringError severity,
+// or nil if there are none.
+func (issues WiringIssues) Err() error {
+	var errs []error
+	for _, issue := range issues {
+		if issue.Severity == WiringError {
+			errs = append(errs, errors.New(issue.String()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateWiring detects common app wiring mistakes, so that they can be
+// reported at startup instead of failing deep inside InitChain or block execution.
+//
+// It reports the following errors:
+//   - modules listed more than once in an ordering field
+//   - modules missing from a non-empty ordering field although they implement
+//     the corresponding hook
+//
+// and the following warnings:
+//   - ordering fields and store key configs referencing modules absent from the app
+//   - modules with genesis or block hooks while the corresponding ordering field is empty
+//   - module authorities which are neither a module of the app nor a bech32 address
+//   - orderings violating the ordering constraints declared by the modules
+//
+// appConfig is optional and only used for the module configs level checks,
+// which also cover the store key configs.
+func ValidateWiring(config *runtimev1alpha1.Module, appConfig *appv1alpha1.Config, modules map[string]appmodule.AppModule) WiringIssues {
+	var issues WiringIssues
+	if config == nil {
+		return append(issues, WiringIssue{Severity: WiringError, Module: ModuleName, Message: "runtime module config is missing"})
+	}
+
+	names := make([]string, 0, len(modules))
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, field := range orderingFields(config) {
+		order := *field.order
+		if field.hook == appmodule.HookExportGenesis && len(order) == 0 {
+			order = config.InitGenesis
+		}
+
+		issues = append(issues, validateOrder(string(field.hook), order, field.implements, names, modules)...)
+	}
+	issues = append(issues, validateOrder("order_migrations", config.OrderMigrations, nil, names, modules)...)
+	issues = append(issues, validateConstraints(config, modules)...)
+
+	if appConfig != nil {
+		issues = append(issues, validateModuleConfigs(config, appConfig)...)
+	}
+
+	return issues
+}
+
+// resolveWiring derives the empty orderings of config, if the config requests
+// it, and validates the resulting wiring. It is the resolution done by
+// App.Load, so that the validate-wiring command reports the issues the app
+// would fail or warn about.
+func resolveWiring(config *runtimev1alpha1.Module, appConfig *appv1alpha1.Config, modules map[string]appmodule.AppModule) (*runtimev1alpha1.Module, WiringIssues, error) {
+	config, err := deriveOrdering(config, modules)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return config, ValidateWiring(config, appConfig, modules), nil
+}
+
+func validateOrder(field string, order []string, hook func(appmodule.AppModule) bool, names []string, modules map[string]appmodule.AppModule) WiringIssues {
+	var issues WiringIssues
+
+	seen := make(map[string]bool, len(order))
+	for _, name := range order {
+		if seen[name] {
+			issues = append(issues, WiringIssue{Severity: WiringError, Field: field, Module: name, Message: "module is listed more than once"})
+			continue
+		}
+		seen[name] = true
+
+		if _, ok := modules[name]; !ok {
+			issues = append(issues, WiringIssue{Severity: WiringWarning, Field: field, Module: name, Message: "module is absent from the app and will be ignored"})
+		}
+	}
+
+	for _, name := range names {
+		if seen[name] || (hook != nil && !hook(modules[name])) {
+			continue
+		}
+
+		// the module manager only applies non-empty orderings, so that a
+		// missing ordering only disables the corresponding hooks.
+		if len(order) == 0 {
+			if hook != nil {
+				issues = append(issues, WiringIssue{Severity: WiringWarning, Field: field, Module: name, Message: "module implements the hook but the ordering is empty, so it will never be called"})
+			}
+			continue
+		}
+
+		issues = append(issues, WiringIssue{Severity: WiringError, Field: field, Module: name, Message: "module is missing from the ordering"})
+	}
+
+	return issues
+}
+
+// validateModuleConfigs checks the module configs of the app config for
+// duplicate module names, store key configs referencing absent modules and
+// authorities pointing to absent modules. The extension modules declared in
+// the runtime config are modules of the app as well.
+func validateModuleConfigs(config *runtimev1alpha1.Module, appConfig *appv1alpha1.Config) WiringIssues {
+	var issues WiringIssues
+
+	declared := make(map[string]bool, len(appConfig.Modules)+len(config.Extensions))
+	for _, mod := range appConfig.Modules {
+		if declared[mod.Name] {
+			issues = append(issues, WiringIssue{Severity: WiringError, Module: mod.Name, Message: "module is declared more than once in the app config"})
+		}
+		declared[mod.Name] = true
+	}
+	for _, ext := range config.Extensions {
+		declared[ext.Name] = true
+	}
+
+	for _, override := range config.OverrideStoreKeys {
+		if !declared[override.ModuleName] {
+			issues = append(issues, WiringIssue{
+				Severity: WiringWarning,
+				Field:    "override_store_keys",
+				Module:   override.ModuleName,
+				Message:  "store key override references a module absent from the app",
+			})
+		}
+	}
+
+	for _, name := range config.SkipStoreKeys {
+		if !declared[name] {
+			issues = append(issues, WiringIssue{
+				Severity: WiringWarning,
+				Field:    "skip_store_keys",
+				Module:   name,
+				Message:  "skipped store key references a module absent from the app",
+			})
+		}
+	}
+
+	for _, mod := range appConfig.Modules {
+		if mod.Config == nil {
+			continue
+		}
+
+		msg, err := anypb.UnmarshalNew(mod.Config, proto.UnmarshalOptions{})
+		if err != nil {
+			// unknown module config types are reported by depinject
+			continue
+		}
+
+		field := msg.ProtoReflect().Descriptor().Fields().ByName("authority")
+		if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+			continue
+		}
+
+		authority := msg.ProtoReflect().Get(field).String()
+		if authority == "" {
+			// modules default to the gov module account.
+			authority = "gov"
+		}
+
+		if declared[authority] {
+			continue
+		}
+
+		if _, _, err := bech32.DecodeAndConvert(authority); err == nil {
+			continue
+		}
+
+		issues = append(issues, WiringIssue{
+			Severity: WiringWarning,
+			Module:   mod.Name,
+			Message:  fmt.Sprintf("authority %q is neither a module of the app nor a bech32 address, authority gated messages cannot be executed", authority),
+		})
+	}
+
+	return issues
+}
+
+func hasHook[T any](mod appmodule.AppModule) bool {
+	_, ok := mod.(T)
+	return ok
+}
+
+func hasEndBlocker(mod appmodule.AppModule) bool {
+	return hasHook[appmodule.HasEndBlocker](mod) || hasHook[module.HasABCIEndBlock](mod)
+}
+
+func hasGenesis(mod appmodule.AppModule) bool {
+	return hasHook[appmodule.HasGenesisAuto](mod) || hasHook[module.HasABCIGenesis](mod) || hasHook[module.HasGenesis](mod)
+}
+
+// runtimeConfig returns the runtime module config declared in appConfig.
+func runtimeConfig(appConfig *appv1alpha1.Config) (*runtimev1alpha1.Module, error) {
+	for _, mod := range appConfig.Modules {
+		if mod.Config == nil || !mod.Config.MessageIs(&runtimev1alpha1.Module{}) {
+			continue
+		}
+
+		config := &runtimev1alpha1.Module{}
+		if err := mod.Config.UnmarshalTo(config); err != nil {
+			return nil, err
+		}
+
+		return config, nil
+	}
+
+	return nil, errors.New("app config does not declare the runtime module")
+}
+
+// ValidateWiringCmd returns a command reporting the wiring issues of an app
+// config, resolved as by App.Load, see ValidateWiring.
+func ValidateWiringCmd(appConfig *appv1alpha1.Config, mm *module.Manager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate-wiring",
+		Short: "Validates the app wiring and reports common mistakes",
+		Long: `Validates the app wiring and reports common mistakes, such as modules missing from
+or listed twice in the genesis and block hooks orderings, orderings referencing modules absent
+from the app, or module authorities pointing to absent modules.
+The orderings are derived from the module ordering constraints as done when loading the app,
+if the runtime config requests it, and the extension modules are validated as app modules.
+The command exits with an error if any issue prevents the app from starting.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config, err := runtimeConfig(appConfig)
+			if err != nil {
+				return err
+			}
+
+			_, issues, err := resolveWiring(config, appConfig, mm.Modules)
+			if err != nil {
+				return fmt.Errorf("app wiring is invalid: %w", err)
+			}
+
+			if len(issues) == 0 {
+				cmd.Println("no wiring issues found")
+				return nil
+			}
+
+			// report errors first
+			slices.SortStableFunc(issues, func(a, b WiringIssue) int {
+				return int(b.Severity) - int(a.Severity)
+			})
+
+			errCount := 0
+			for _, issue := range issues {
+				if issue.Severity == WiringError {
+					errCount++
+				}
+				cmd.Println(issue.String())
+			}
+
+			if errCount > 0 {
+				return fmt.Errorf("app wiring is invalid, found %d error(s)", errCount)
+			}
+
+			return nil
+		},
+	}
+}
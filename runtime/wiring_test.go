@@ -0,0 +1,123 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
+	bankmodulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+type wiringBlockerModule struct{}
+
+func (wiringBlockerModule) IsAppModule()                     {}
+func (wiringBlockerModule) IsOnePerModuleType()              {}
+func (wiringBlockerModule) BeginBlock(context.Context) error { return nil }
+
+func TestValidateWiring(t *testing.T) {
+	modules := map[string]appmodule.AppModule{
+		"a": wiringBlockerModule{},
+		"b": wiringBlockerModule{},
+		"c": extensionTestModule{},
+	}
+
+	issues := ValidateWiring(&runtimev1alpha1.Module{BeginBlockers: []string{"a", "b"}}, nil, modules)
+	require.Empty(t, issues)
+	require.NoError(t, issues.Err())
+
+	issues = ValidateWiring(&runtimev1alpha1.Module{
+		BeginBlockers: []string{"a", "a", "d"},
+	}, nil, modules)
+	require.Equal(t, WiringIssues{
+		{Severity: WiringError, Field: "begin_blockers", Module: "a", Message: "module is listed more than once"},
+		{Severity: WiringWarning, Field: "begin_blockers", Module: "d", Message: "module is absent from the app and will be ignored"},
+		{Severity: WiringError, Field: "begin_blockers", Module: "b", Message: "module is missing from the ordering"},
+	}, issues)
+	require.ErrorContains(t, issues.Err(), "error: begin_blockers: module b: module is missing from the ordering")
+
+	issues = ValidateWiring(&runtimev1alpha1.Module{}, nil, modules)
+	require.Len(t, issues, 2)
+	require.NoError(t, issues.Err())
+
+	require.NotEmpty(t, ValidateWiring(nil, nil, modules).Err())
+}
+
+func TestValidateWiringModuleConfigs(t *testing.T) {
+	wrap := func(name string, authority string) *appv1alpha1.ModuleConfig {
+		cfg, err := anypb.New(&bankmodulev1.Module{Authority: authority})
+		require.NoError(t, err)
+		return &appv1alpha1.ModuleConfig{Name: name, Config: cfg}
+	}
+
+	addr, err := bech32.ConvertAndEncode("cosmos", make([]byte, 20))
+	require.NoError(t, err)
+
+	appConfig := &appv1alpha1.Config{Modules: []*appv1alpha1.ModuleConfig{
+		wrap("bank", ""),
+		wrap("bank", addr),
+		wrap("other", "group"),
+	}}
+
+	issues := ValidateWiring(&runtimev1alpha1.Module{
+		OverrideStoreKeys: []*runtimev1alpha1.StoreKeyConfig{{ModuleName: "e", KvStoreKey: "e"}},
+		SkipStoreKeys:     []string{"other"},
+	}, appConfig, nil)
+	require.Len(t, issues, 4)
+	require.Equal(t, WiringIssue{Severity: WiringError, Module: "bank", Message: "module is declared more than once in the app config"}, issues[0])
+	require.Equal(t, WiringIssue{Severity: WiringWarning, Field: "override_store_keys", Module: "e", Message: "store key override references a module absent from the app"}, issues[1])
+	require.Equal(t, "bank", issues[2].Module)
+	require.Contains(t, issues[2].Message, `authority "gov"`)
+	require.Equal(t, "other", issues[3].Module)
+	require.Contains(t, issues[3].Message, `authority "group"`)
+}
+
+func TestValidateWiringCmd(t *testing.T) {
+	runtimeConfig := &runtimev1alpha1.Module{
+		DeriveOrdering:    true,
+		Extensions:        []*runtimev1alpha1.ExtensionConfig{{Name: "ext", GrpcAddress: "localhost:1"}},
+		OverrideStoreKeys: []*runtimev1alpha1.StoreKeyConfig{{ModuleName: "ext", KvStoreKey: "ext"}},
+	}
+	cfg, err := anypb.New(runtimeConfig)
+	require.NoError(t, err)
+	appConfig := &appv1alpha1.Config{Modules: []*appv1alpha1.ModuleConfig{{Name: ModuleName, Config: cfg}}}
+
+	run := func(modules map[string]appmodule.AppModule) (string, error) {
+		cmd := ValidateWiringCmd(appConfig, module.NewManagerFromMap(modules))
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetArgs(nil)
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	// the orderings are derived as in App.Load, and the extension modules are
+	// modules of the app
+	out, err := run(map[string]appmodule.AppModule{
+		"mint": wiringBlockerModule{},
+		"distribution": orderedModule{constraints: []appmodule.OrderingConstraint{
+			{Hook: appmodule.HookBeginBlocker, After: []string{"mint"}},
+		}},
+		"ext": wiringBlockerModule{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "no wiring issues found\n", out)
+
+	_, err = run(map[string]appmodule.AppModule{
+		"mint": orderedModule{constraints: []appmodule.OrderingConstraint{
+			{Hook: appmodule.HookBeginBlocker, After: []string{"distribution"}},
+		}},
+		"distribution": orderedModule{constraints: []appmodule.OrderingConstraint{
+			{Hook: appmodule.HookBeginBlocker, After: []string{"mint"}},
+		}},
+	})
+	require.ErrorContains(t, err, "app wiring is invalid: cannot derive begin_blockers ordering")
+}
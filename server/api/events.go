@@ -0,0 +1,309 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/gorilla/websocket"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventStreamPath is the path of the WebSocket endpoint of the event stream.
+	EventStreamPath = "/cosmos/events/subscribe"
+
+	// eventStreamBufferSize is the number of events buffered for a subscriber.
+	// A subscriber falling further behind is disconnected.
+	eventStreamBufferSize = 1000
+)
+
+var _ storetypes.ABCIListener = (*EventStream)(nil)
+
+type (
+	// StreamedEvent is an event sent to the subscribers of the event stream.
+	StreamedEvent struct {
+		// Height is the height of the block emitting the event.
+		Height int64 `json:"height"`
+		// TxHash is the hash of the transaction emitting the event, empty for
+		// block events.
+		TxHash string `json:"tx_hash,omitempty"`
+		// Type is the type of the event.
+		Type string `json:"type"`
+		// Attributes are the attributes of the event.
+		Attributes []StreamedEventAttribute `json:"attributes"`
+		// TypedEvent is the JSON encoding of the proto message of a typed event,
+		// i.e. emitted with EmitTypedEvent.
+		TypedEvent json.RawMessage `json:"typed_event,omitempty"`
+	}
+
+	// StreamedEventAttribute is an attribute of a StreamedEvent.
+	StreamedEventAttribute struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}
+
+	// EventFilter selects the events sent to a subscriber.
+	EventFilter struct {
+		// Types are the event types matched, all types if empty.
+		Types []string
+		// Attributes are the attribute values an event must have.
+		Attributes map[string]string
+	}
+
+	// EventStream is an ABCIListener streaming the committed block and
+	// transaction events to the WebSocket subscribers of the API server.
+	EventStream struct {
+		cdc    codec.JSONCodec
+		logger log.Logger
+
+		mtx         sync.Mutex
+		pending     []StreamedEvent
+		subscribers map[*eventSubscriber]struct{}
+	}
+
+	eventSubscriber struct {
+		filter EventFilter
+		events chan StreamedEvent
+	}
+)
+
+// NewEventStream returns a new EventStream encoding the typed events with the
+// given codec.
+func NewEventStream(cdc codec.JSONCodec, logger log.Logger) *EventStream {
+	return &EventStream{
+		cdc:         cdc,
+		logger:      logger,
+		subscribers: make(map[*eventSubscriber]struct{}),
+	}
+}
+
+// ParseEventFilter parses an event filter from the type and attribute query
+// parameters of a subscription, e.g.
+// ?type=transfer&attribute=recipient=cosmos1...
+func ParseEventFilter(query map[string][]string) (EventFilter, error) {
+	filter := EventFilter{
+		Types:      query["type"],
+		Attributes: make(map[string]string),
+	}
+
+	for _, attr := range query["attribute"] {
+		key, value, ok := strings.Cut(attr, "=")
+		if !ok || key == "" {
+			return EventFilter{}, fmt.Errorf("invalid attribute filter %q, expected key=value", attr)
+		}
+		filter.Attributes[key] = value
+	}
+
+	return filter, nil
+}
+
+// Matches returns true if the event is selected by the filter. The attribute
+// values of typed events, which are JSON encoded, match the filter values
+// either encoded or not.
+func (f EventFilter) Matches(event StreamedEvent) bool {
+	if len(f.Types) > 0 {
+		found := false
+		for _, typ := range f.Types {
+			if typ == event.Type {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	for key, value := range f.Attributes {
+		found := false
+		for _, attr := range event.Attributes {
+			if attr.Key == key && (attr.Value == value || attr.Value == strconv.Quote(value)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ListenFinalizeBlock implements ABCIListener. The events of the block are
+// sent to the subscribers once the block is committed.
+func (s *EventStream) ListenFinalizeBlock(_ context.Context, req abci.FinalizeBlockRequest, res abci.FinalizeBlockResponse) error {
+	events := make([]StreamedEvent, 0, len(res.Events))
+	for _, event := range res.Events {
+		events = append(events, s.streamedEvent(req.Height, "", event))
+	}
+
+	for i, txResult := range res.TxResults {
+		if i >= len(req.Txs) {
+			break
+		}
+
+		txHash := fmt.Sprintf("%X", sha256.Sum256(req.Txs[i]))
+		for _, event := range txResult.Events {
+			events = append(events, s.streamedEvent(req.Height, txHash, event))
+		}
+	}
+
+	s.mtx.Lock()
+	s.pending = events
+	s.mtx.Unlock()
+
+	return nil
+}
+
+// ListenCommit implements ABCIListener.
+func (s *EventStream) ListenCommit(context.Context, abci.CommitResponse, []*storetypes.StoreKVPair) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	events := s.pending
+	s.pending = nil
+
+	for sub := range s.subscribers {
+		s.publishLocked(sub, events)
+	}
+
+	return nil
+}
+
+// publishLocked sends the events matching its filter to the subscriber. A
+// subscriber too slow to receive them is disconnected rather than blocking the
+// commit.
+func (s *EventStream) publishLocked(sub *eventSubscriber, events []StreamedEvent) {
+	for _, event := range events {
+		if !sub.filter.Matches(event) {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			s.unsubscribeLocked(sub)
+			return
+		}
+	}
+}
+
+// streamedEvent converts an ABCI event, decoding it if it is a typed event.
+func (s *EventStream) streamedEvent(height int64, txHash string, event abci.Event) StreamedEvent {
+	streamed := StreamedEvent{
+		Height:     height,
+		TxHash:     txHash,
+		Type:       event.Type,
+		Attributes: make([]StreamedEventAttribute, 0, len(event.Attributes)),
+	}
+	for _, attr := range event.Attributes {
+		streamed.Attributes = append(streamed.Attributes, StreamedEventAttribute{Key: attr.Key, Value: attr.Value})
+	}
+
+	// only typed events are named after their proto message
+	if s.cdc != nil && strings.Contains(event.Type, ".") {
+		if msg, err := sdk.ParseTypedEvent(event); err == nil {
+			if bz, err := s.cdc.MarshalJSON(msg); err == nil {
+				streamed.TypedEvent = bz
+			}
+		}
+	}
+
+	return streamed
+}
+
+func (s *EventStream) subscribe(filter EventFilter) *eventSubscriber {
+	sub := &eventSubscriber{
+		filter: filter,
+		events: make(chan StreamedEvent, eventStreamBufferSize),
+	}
+
+	s.mtx.Lock()
+	s.subscribers[sub] = struct{}{}
+	s.mtx.Unlock()
+
+	return sub
+}
+
+func (s *EventStream) unsubscribe(sub *eventSubscriber) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.unsubscribeLocked(sub)
+}
+
+func (s *EventStream) unsubscribeLocked(sub *eventSubscriber) {
+	if _, ok := s.subscribers[sub]; ok {
+		delete(s.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+// ServeHTTP upgrades the request to a WebSocket connection, on which the events
+// matching the filter of the request query are sent as JSON messages.
+func (s *EventStream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	filter, err := ParseEventFilter(r.URL.Query())
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// the event stream is read-only, so requests from any origin are accepted
+	upgrader := websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader already replied with an error
+		return
+	}
+	defer conn.Close()
+
+	sub := s.subscribe(filter)
+	defer s.unsubscribe(sub)
+
+	// read the connection to handle the close and control messages
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-closed:
+			return
+
+		case event, ok := <-sub.events:
+			if !ok {
+				_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "subscriber too slow"))
+				return
+			}
+
+			if err := conn.WriteJSON(event); err != nil {
+				s.logger.Debug("failed to send event to subscriber", "err", err)
+				return
+			}
+		}
+	}
+}
+
+// RegisterEventStream registers the WebSocket endpoint of the event stream.
+// The stream must also be added as an ABCIListener of the application.
+func (s *Server) RegisterEventStream(stream *EventStream) {
+	s.Router.Handle(EventStreamPath, stream).Methods("GET")
+}
@@ -0,0 +1,141 @@
+package api_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/server/api"
+)
+
+func TestEventFilter(t *testing.T) {
+	event := api.StreamedEvent{
+		Type: "transfer",
+		Attributes: []api.StreamedEventAttribute{
+			{Key: "recipient", Value: "alice"},
+			{Key: "amount", Value: `"10stake"`},
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		query   map[string][]string
+		matches bool
+		expErr  string
+	}{
+		{"no filter", nil, true, ""},
+		{"type", map[string][]string{"type": {"message", "transfer"}}, true, ""},
+		{"other type", map[string][]string{"type": {"message"}}, false, ""},
+		{"attribute", map[string][]string{"attribute": {"recipient=alice"}}, true, ""},
+		{"json attribute", map[string][]string{"attribute": {"amount=10stake"}}, true, ""},
+		{"all attributes", map[string][]string{"attribute": {"recipient=alice", "amount=10stake"}}, true, ""},
+		{"other attribute value", map[string][]string{"type": {"transfer"}, "attribute": {"recipient=bob"}}, false, ""},
+		{"missing attribute", map[string][]string{"attribute": {"sender=alice"}}, false, ""},
+		{"invalid attribute", map[string][]string{"attribute": {"recipient"}}, false, "expected key=value"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := api.ParseEventFilter(tc.query)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.matches, filter.Matches(event))
+		})
+	}
+}
+
+func TestEventStream(t *testing.T) {
+	stream := api.NewEventStream(nil, log.NewNopLogger())
+	srv := httptest.NewServer(stream)
+	defer srv.Close()
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + api.EventStreamPath + "?type=transfer&attribute=recipient=alice"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	transfer := func(recipient string) abci.Event {
+		return abci.Event{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "recipient", Value: recipient}}}
+	}
+
+	events := make(chan api.StreamedEvent, 10)
+	go func() {
+		for {
+			var event api.StreamedEvent
+			if err := conn.ReadJSON(&event); err != nil {
+				close(events)
+				return
+			}
+			events <- event
+		}
+	}()
+
+	// wait for the subscription to be registered
+	require.Eventually(t, func() bool {
+		require.NoError(t, stream.ListenFinalizeBlock(context.Background(), abci.FinalizeBlockRequest{Height: 1}, abci.FinalizeBlockResponse{
+			Events: []abci.Event{transfer("alice")},
+		}))
+		require.NoError(t, stream.ListenCommit(context.Background(), abci.CommitResponse{}, nil))
+
+		select {
+		case event := <-events:
+			return event.Height == 1
+		case <-time.After(10 * time.Millisecond):
+			return false
+		}
+	}, time.Second, 20*time.Millisecond)
+
+	// nextEvent returns the next event after the first block, if any
+	nextEvent := func(timeout time.Duration) (api.StreamedEvent, bool) {
+		deadline := time.After(timeout)
+		for {
+			select {
+			case event := <-events:
+				if event.Height > 1 {
+					return event, true
+				}
+			case <-deadline:
+				return api.StreamedEvent{}, false
+			}
+		}
+	}
+
+	// events are only sent once their block is committed
+	tx := []byte("tx")
+	require.NoError(t, stream.ListenFinalizeBlock(context.Background(), abci.FinalizeBlockRequest{
+		Height: 2,
+		Txs:    [][]byte{tx},
+	}, abci.FinalizeBlockResponse{
+		Events: []abci.Event{transfer("bob"), {Type: "message"}},
+		TxResults: []*abci.ExecTxResult{
+			{Events: []abci.Event{transfer("alice")}},
+		},
+	}))
+	_, ok := nextEvent(50 * time.Millisecond)
+	require.False(t, ok)
+
+	require.NoError(t, stream.ListenCommit(context.Background(), abci.CommitResponse{}, nil))
+	event, ok := nextEvent(time.Second)
+	require.True(t, ok)
+	require.Equal(t, int64(2), event.Height)
+	require.Equal(t, "transfer", event.Type)
+	require.Equal(t, fmt.Sprintf("%X", sha256.Sum256(tx)), event.TxHash)
+
+	// only the matching events are sent
+	_, ok = nextEvent(50 * time.Millisecond)
+	require.False(t, ok)
+}
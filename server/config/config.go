@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -42,10 +43,22 @@ type BaseConfig struct {
 	// If set to 0, it is unbounded.
 	QueryGasLimit uint64 `mapstructure:"query-gas-limit"`
 
+	// QueryCacheSize is the number of deterministic grpc/Rest query responses
+	// cached until the next commit. If set to 0, the cache is disabled.
+	QueryCacheSize int `mapstructure:"query-cache-size"`
+
 	Pruning           string `mapstructure:"pruning"`
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
 
+	// PruningBackground prunes the application state in a background worker
+	// instead of at commit.
+	PruningBackground bool `mapstructure:"pruning-background"`
+
+	// PruningRateLimit is the maximum number of versions pruned per second by the
+	// background pruning. If set to 0, the background pruning is not throttled.
+	PruningRateLimit uint64 `mapstructure:"pruning-rate-limit"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -88,6 +101,14 @@ type BaseConfig struct {
 	// IAVLDisableFastNode enables or disables the fast sync node.
 	IAVLDisableFastNode bool `mapstructure:"iavl-disable-fastnode"`
 
+	// StateStats enables the tracking of the number of keys, size and writes of
+	// the state of each store, reported as telemetry metrics.
+	StateStats bool `mapstructure:"state-stats"`
+
+	// ParallelTxWorkers defines the number of workers executing the block
+	// transactions in parallel. Parallel execution is disabled if lower than 2.
+	ParallelTxWorkers int `mapstructure:"parallel-tx-workers"`
+
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the CometBFT config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
@@ -119,6 +140,10 @@ type APIConfig struct {
 	// RPCMaxBodyBytes defines the CometBFT maximum request body (in bytes)
 	RPCMaxBodyBytes uint `mapstructure:"rpc-max-body-bytes"`
 
+	// EventStream defines if the WebSocket event subscription endpoint should be
+	// enabled.
+	EventStream bool `mapstructure:"event-stream"`
+
 	// TODO: TLS/Proxy configuration.
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/issues/6420
@@ -141,6 +166,25 @@ type GRPCConfig struct {
 	MaxSendMsgSize int `mapstructure:"max-send-msg-size"`
 }
 
+// RateLimitConfig defines the rate limits of the requests to the gRPC and API
+// servers.
+type RateLimitConfig struct {
+	// Enable defines if the rate limits should be enforced.
+	Enable bool `mapstructure:"enable"`
+
+	// MethodRequestsPerSecond defines the maximum number of requests per second
+	// to a single method, from all clients. 0 means unlimited.
+	MethodRequestsPerSecond float64 `mapstructure:"method-requests-per-second"`
+
+	// ClientRequestsPerSecond defines the maximum number of requests per second
+	// from a single client IP to a single method. 0 means unlimited.
+	ClientRequestsPerSecond float64 `mapstructure:"client-requests-per-second"`
+
+	// Burst defines the number of requests allowed at once above the rate
+	// limits. 0 means the number of requests allowed in one second.
+	Burst int `mapstructure:"burst"`
+}
+
 // StateSyncConfig defines the state sync snapshot configuration.
 type StateSyncConfig struct {
 	// SnapshotInterval sets the interval at which state sync snapshots are taken.
@@ -150,6 +194,22 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotDeltaInterval sets the interval at which differential state sync
+	// snapshots are taken between the full snapshots. 0 disables them.
+	SnapshotDeltaInterval uint64 `mapstructure:"snapshot-delta-interval"`
+}
+
+// ArchiveConfig defines the configuration of the archival of the historical
+// versions of the state to a cold storage backend.
+type ArchiveConfig struct {
+	// URL is the URL of the cold storage backend the versions are archived to
+	// before being pruned. An empty URL disables the archival.
+	URL string `mapstructure:"url"`
+
+	// CheckpointInterval sets the interval of the versions whose full state is
+	// archived, the other versions being archived as changes.
+	CheckpointInterval uint64 `mapstructure:"checkpoint-interval"`
 }
 
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
@@ -160,13 +220,35 @@ type MempoolConfig struct {
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
 	MaxTxs int `mapstructure:"max-txs"`
+
+	// Lanes partition the mempool in lanes, ordered from the highest priority
+	// to the lowest. No lanes indicates that the mempool is not partitioned.
+	Lanes []MempoolLaneConfig `mapstructure:"lanes"`
+}
+
+// MempoolLaneConfig defines the configuration of a lane of the app-side mempool.
+type MempoolLaneConfig struct {
+	// Name is the unique name of the lane.
+	Name string `mapstructure:"name"`
+
+	// MsgTypes are the type URLs of the messages of the transactions stored in
+	// the lane. A lane without message types matches all transactions.
+	MsgTypes []string `mapstructure:"msg-types"`
+
+	// BlockSpace is the share of the block space reserved to the lane, as a
+	// decimal. An empty or zero block space lets the lane use all the space not
+	// reserved to the other lanes.
+	BlockSpace string `mapstructure:"block-space"`
 }
 
 // State Streaming configuration
 type (
 	// StreamingConfig defines application configuration for external streaming services
 	StreamingConfig struct {
-		ABCI ABCIListenerConfig `mapstructure:"abci"`
+		ABCI  ABCIListenerConfig  `mapstructure:"abci"`
+		Sink  StreamingSinkConfig `mapstructure:"sink"`
+		Kafka KafkaSinkConfig     `mapstructure:"kafka"`
+		NATS  NATSSinkConfig      `mapstructure:"nats"`
 	}
 	// ABCIListenerConfig defines application configuration for ABCIListener streaming service
 	ABCIListenerConfig struct {
@@ -174,6 +256,29 @@ type (
 		Plugin        string   `mapstructure:"plugin"`
 		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
 	}
+	// StreamingSinkConfig defines the batching and retry configuration of the
+	// kafka and nats streaming plugins
+	StreamingSinkConfig struct {
+		BatchSize    int           `mapstructure:"batch-size"`
+		MaxRetries   int           `mapstructure:"max-retries"`
+		RetryBackoff time.Duration `mapstructure:"retry-backoff"`
+		Decode       bool          `mapstructure:"decode"`
+	}
+	// KafkaSinkConfig defines the configuration of the kafka streaming plugin
+	KafkaSinkConfig struct {
+		Brokers      []string      `mapstructure:"brokers"`
+		Topic        string        `mapstructure:"topic"`
+		Partition    int32         `mapstructure:"partition"`
+		RequiredAcks int16         `mapstructure:"required-acks"`
+		Timeout      time.Duration `mapstructure:"timeout"`
+		MaxBatchSize int           `mapstructure:"max-batch-size"`
+	}
+	// NATSSinkConfig defines the configuration of the nats streaming plugin
+	NATSSinkConfig struct {
+		URL     string        `mapstructure:"url"`
+		Subject string        `mapstructure:"subject"`
+		Timeout time.Duration `mapstructure:"timeout"`
+	}
 )
 
 // Config defines the server's top level configuration
@@ -184,7 +289,9 @@ type Config struct {
 	Telemetry telemetry.Config `mapstructure:"telemetry"`
 	API       APIConfig        `mapstructure:"api"`
 	GRPC      GRPCConfig       `mapstructure:"grpc"`
+	RateLimit RateLimitConfig  `mapstructure:"rate-limit"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Archive   ArchiveConfig    `mapstructure:"archive"`
 	Streaming StreamingConfig  `mapstructure:"streaming"`
 	Mempool   MempoolConfig    `mapstructure:"mempool"`
 }
@@ -214,6 +321,7 @@ func DefaultConfig() *Config {
 		BaseConfig: BaseConfig{
 			MinGasPrices:        defaultMinGasPrices,
 			QueryGasLimit:       0,
+			QueryCacheSize:      0,
 			InterBlockCache:     true,
 			Pruning:             pruningtypes.PruningOptionDefault,
 			PruningKeepRecent:   "0",
@@ -222,11 +330,16 @@ func DefaultConfig() *Config {
 			IndexEvents:         make([]string, 0),
 			IAVLCacheSize:       781250,
 			IAVLDisableFastNode: false,
+			StateStats:          false,
+			ParallelTxWorkers:   0,
 			AppDBBackend:        "",
 		},
 		Telemetry: telemetry.Config{
-			Enabled:      false,
-			GlobalLabels: [][]string{},
+			Enabled:           false,
+			GlobalLabels:      [][]string{},
+			TracingEnabled:    false,
+			TracingEndpoint:   "localhost:4317",
+			TracingSampleRate: 1,
 		},
 		API: APIConfig{
 			Enable:             false,
@@ -235,6 +348,7 @@ func DefaultConfig() *Config {
 			MaxOpenConnections: 1000,
 			RPCReadTimeout:     10,
 			RPCMaxBodyBytes:    1000000,
+			EventStream:        false,
 		},
 		GRPC: GRPCConfig{
 			Enable:         true,
@@ -242,15 +356,42 @@ func DefaultConfig() *Config {
 			MaxRecvMsgSize: DefaultGRPCMaxRecvMsgSize,
 			MaxSendMsgSize: DefaultGRPCMaxSendMsgSize,
 		},
+		RateLimit: RateLimitConfig{
+			Enable:                  false,
+			MethodRequestsPerSecond: 0,
+			ClientRequestsPerSecond: 0,
+			Burst:                   0,
+		},
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		Archive: ArchiveConfig{
+			URL:                "",
+			CheckpointInterval: 1000,
+		},
 		Streaming: StreamingConfig{
 			ABCI: ABCIListenerConfig{
 				Keys:          []string{},
 				StopNodeOnErr: true,
 			},
+			Sink: StreamingSinkConfig{
+				BatchSize:    1,
+				MaxRetries:   3,
+				RetryBackoff: 100 * time.Millisecond,
+			},
+			Kafka: KafkaSinkConfig{
+				Brokers:      []string{"localhost:9092"},
+				Topic:        "cosmos-sdk-streaming",
+				RequiredAcks: -1,
+				Timeout:      10 * time.Second,
+				MaxBatchSize: 1 << 20,
+			},
+			NATS: NATSSinkConfig{
+				URL:     "nats://localhost:4222",
+				Subject: "cosmos-sdk-streaming",
+				Timeout: 10 * time.Second,
+			},
 		},
 		Mempool: MempoolConfig{
 			MaxTxs: -1,
@@ -277,6 +418,26 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	if interval := c.StateSync.SnapshotDeltaInterval; interval > 0 &&
+		(interval >= c.StateSync.SnapshotInterval || c.StateSync.SnapshotInterval%interval != 0) {
+		return sdkerrors.ErrAppConfig.Wrap("state sync snapshot delta interval must be a divisor of the snapshot interval lower than it")
+	}
+	if c.Archive.URL != "" && c.Pruning == pruningtypes.PruningOptionNothing {
+		return sdkerrors.ErrAppConfig.Wrapf(
+			"cannot archive the state with '%s' pruning setting", pruningtypes.PruningOptionNothing,
+		)
+	}
+	if c.RateLimit.MethodRequestsPerSecond < 0 || c.RateLimit.ClientRequestsPerSecond < 0 || c.RateLimit.Burst < 0 {
+		return sdkerrors.ErrAppConfig.Wrap("rate limits cannot be negative")
+	}
+	if c.Telemetry.TracingEnabled {
+		if c.Telemetry.TracingEndpoint == "" {
+			return sdkerrors.ErrAppConfig.Wrap("tracing endpoint must be set when tracing is enabled")
+		}
+		if c.Telemetry.TracingSampleRate < 0 || c.Telemetry.TracingSampleRate > 1 {
+			return sdkerrors.ErrAppConfig.Wrap("tracing sample rate must be between 0 and 1")
+		}
+	}
 
 	return nil
 }
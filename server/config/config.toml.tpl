@@ -14,6 +14,11 @@ minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 # If this is set to zero, the query can consume an unbounded amount of gas.
 query-gas-limit = "{{ .BaseConfig.QueryGasLimit }}"
 
+# The number of responses of deterministic queries coming over rest/grpc cached
+# by the node, keyed by query path, request and height. The cache is cleared on
+# every commit. If this is set to zero, the cache is disabled.
+query-cache-size = {{ .BaseConfig.QueryCacheSize }}
+
 # default: the last 362880 states are kept, pruning at 10 block intervals
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: 2 latest states will be kept; pruning at 10 block intervals.
@@ -24,6 +29,14 @@ pruning = "{{ .BaseConfig.Pruning }}"
 pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# pruning-background prunes the application state in a background worker instead of at commit,
+# avoiding block time spikes when a large number of states are pruned.
+pruning-background = {{ .BaseConfig.PruningBackground }}
+
+# pruning-rate-limit is the maximum number of states pruned per second by the background pruning,
+# throttling its IO (0 for no limit).
+pruning-rate-limit = {{ .BaseConfig.PruningRateLimit }}
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -70,6 +83,17 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Default is false.
 iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 
+# StateStats enables the tracking of the number of keys, size and writes of the state of each
+# store, reported as telemetry metrics and by the "/app/state_stats" ABCI query. The initial
+# state of the stores is counted in the background when the node starts.
+state-stats = {{ .BaseConfig.StateStats }}
+
+# ParallelTxWorkers defines the number of workers executing the transactions of
+# a block in parallel, using optimistic concurrency control. Transactions
+# conflicting with previous ones of the block are executed again serially.
+# A value lower than 2 disables parallel execution.
+parallel-tx-workers = {{ .BaseConfig.ParallelTxWorkers }}
+
 # AppDBBackend defines the database backend type to use for the application and snapshots DBs.
 # An empty string indicates that a fallback will be used.
 # The fallback is the db_backend value set in CometBFT's config.toml.
@@ -121,6 +145,20 @@ statsd-addr = "{{ .Telemetry.StatsdAddr }}"
 # Datadog. Only utilized if MetricsSink is set to "dogstatsd".
 datadog-hostname = "{{ .Telemetry.DatadogHostname }}"
 
+# TracingEnabled enables the OpenTelemetry tracing of block execution (FinalizeBlock,
+# ante handler and message execution) and gRPC queries. Spans are exported to an
+# OTLP collector over gRPC. The W3C trace context sent by gRPC clients is propagated.
+tracing-enabled = {{ .Telemetry.TracingEnabled }}
+
+# TracingEndpoint defines the address of the OTLP gRPC collector spans are exported to.
+tracing-endpoint = "{{ .Telemetry.TracingEndpoint }}"
+
+# TracingInsecure disables the TLS of the connection to the OTLP collector.
+tracing-insecure = {{ .Telemetry.TracingInsecure }}
+
+# TracingSampleRate defines the ratio, between 0 and 1, of the traces sampled.
+tracing-sample-rate = {{ .Telemetry.TracingSampleRate }}
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -151,6 +189,12 @@ rpc-max-body-bytes = {{ .API.RPCMaxBodyBytes }}
 # EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk).
 enabled-unsafe-cors = {{ .API.EnableUnsafeCORS }}
 
+# EventStream defines if the WebSocket event subscription endpoint should be
+# enabled. Clients connecting to /cosmos/events/subscribe receive the events of
+# the committed blocks, optionally filtered by type and attribute values, e.g.
+# /cosmos/events/subscribe?type=transfer&attribute=recipient=cosmos1...
+event-stream = {{ .API.EventStream }}
+
 ###############################################################################
 ###                           gRPC Configuration                            ###
 ###############################################################################
@@ -171,6 +215,29 @@ max-recv-msg-size = "{{ .GRPC.MaxRecvMsgSize }}"
 # The default value is math.MaxInt32.
 max-send-msg-size = "{{ .GRPC.MaxSendMsgSize }}"
 
+###############################################################################
+###                        Rate Limit Configuration                         ###
+###############################################################################
+
+# Rate limits are enforced on the requests to the gRPC and API servers. Rejected
+# requests are counted by the server_rate_limit_rejected telemetry metric.
+[rate-limit]
+
+# Enable defines if the rate limits should be enforced.
+enable = {{ .RateLimit.Enable }}
+
+# MethodRequestsPerSecond defines the maximum number of requests per second to a
+# single method, from all clients. 0 means unlimited.
+method-requests-per-second = {{ .RateLimit.MethodRequestsPerSecond }}
+
+# ClientRequestsPerSecond defines the maximum number of requests per second from
+# a single client IP to a single method. 0 means unlimited.
+client-requests-per-second = {{ .RateLimit.ClientRequestsPerSecond }}
+
+# Burst defines the number of requests allowed at once above the rate limits.
+# 0 means the number of requests allowed in one second.
+burst = {{ .RateLimit.Burst }}
+
 ###############################################################################
 ###                        State Sync Configuration                         ###
 ###############################################################################
@@ -186,6 +253,32 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+# snapshot-delta-interval specifies the block interval at which differential snapshots, only
+# containing the state changed since the latest full snapshot, are taken between the full
+# snapshots (0 to disable). It must be a divisor of snapshot-interval.
+snapshot-delta-interval = {{ .StateSync.SnapshotDeltaInterval }}
+
+###############################################################################
+###                           Archive Configuration                         ###
+###############################################################################
+
+# The archive offloads the historical versions of the state to a cold storage backend before they
+# are pruned, so that only the recent versions, as set by the pruning configuration, are kept
+# locally. The historical queries of the pruned versions are served from the archive.
+[archive]
+
+# url specifies the cold storage backend the versions are archived to (empty to disable), either:
+# - file:///path/to/dir, a directory of the local filesystem, e.g. a network filesystem mount;
+# - s3://bucket/prefix?endpoint=https://host&region=region, a bucket of an S3 compatible object
+#   store, whose credentials are read from the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY
+#   environment variables.
+url = "{{ .Archive.URL }}"
+
+# checkpoint-interval specifies the block interval at which the full state is archived, the
+# other versions being archived as the changes from the previous one. A lower interval makes
+# the historical queries faster at the cost of a larger archive.
+checkpoint-interval = {{ .Archive.CheckpointInterval }}
+
 ###############################################################################
 ###                              State Streaming                            ###
 ###############################################################################
@@ -206,12 +299,55 @@ keys = [{{ range .Streaming.ABCI.Keys }}{{ printf "%q, " . }}{{end}}]
 
 # The plugin name used for streaming via gRPC.
 # Streaming is only enabled if this is set.
-# Supported plugins: abci
+# Supported plugins: abci, and the kafka and nats plugins, configured below and
+# registered by the app, publishing the ListenFinalizeBlockRequest and
+# ListenCommitRequest protobuf messages tagged with their type name.
 plugin = "{{ .Streaming.ABCI.Plugin }}"
 
 # stop-node-on-err specifies whether to stop the node on message delivery error.
 stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 
+# streaming.sink specifies the batching and retries of the kafka and nats plugins.
+[streaming.sink]
+
+# The number of blocks published at once.
+batch-size = {{ .Streaming.Sink.BatchSize }}
+
+# The number of retries of a failed publication, with an exponential backoff
+# starting at retry-backoff. The messages are then published with the next batch.
+max-retries = {{ .Streaming.Sink.MaxRetries }}
+retry-backoff = "{{ .Streaming.Sink.RetryBackoff }}"
+
+# decode specifies whether to decode the state changes with the schemas of the
+# modules, publishing them as JSON objects keyed by field names, in place of the
+# raw store keys and values of the ListenCommitRequest messages.
+# The changes of the modules without a schema are published with their raw,
+# base64 encoded, key and value.
+decode = {{ .Streaming.Sink.Decode }}
+
+# streaming.kafka specifies the configuration of the kafka plugin, producing the
+# messages in order to a single topic partition.
+[streaming.kafka]
+
+brokers = [{{ range .Streaming.Kafka.Brokers }}{{ printf "%q, " . }}{{end}}]
+topic = "{{ .Streaming.Kafka.Topic }}"
+partition = {{ .Streaming.Kafka.Partition }}
+
+# The acknowledgements required: -1 for all the in-sync replicas, 1 for the leader.
+required-acks = {{ .Streaming.Kafka.RequiredAcks }}
+timeout = "{{ .Streaming.Kafka.Timeout }}"
+
+# The maximum size in bytes of a record batch, which must not exceed the
+# max.message.bytes of the topic.
+max-batch-size = {{ .Streaming.Kafka.MaxBatchSize }}
+
+# streaming.nats specifies the configuration of the nats plugin.
+[streaming.nats]
+
+url = "{{ .Streaming.NATS.URL }}"
+subject = "{{ .Streaming.NATS.Subject }}"
+timeout = "{{ .Streaming.NATS.Timeout }}"
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
@@ -223,4 +359,28 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 #
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
-max-txs = {{ .Mempool.MaxTxs }}
\ No newline at end of file
+max-txs = {{ .Mempool.MaxTxs }}
+
+# lanes partition the mempool in lanes, ordered from the highest priority to the
+# lowest. A transaction is stored in the first lane whose msg-types contain the
+# types of all its messages, a lane without msg-types matching all transactions.
+# Transactions matching no lane are rejected. block-space is the share of the
+# block reserved to the lane, an empty or zero block-space letting the lane use
+# all the space not reserved to the other lanes. max-txs applies to every lane.
+#
+# Example:
+#
+# [[mempool.lanes]]
+# name = "votes"
+# msg-types = ["/cosmos.gov.v1.MsgVote", "/cosmos.gov.v1.MsgVoteWeighted"]
+# block-space = "0.1"
+#
+# [[mempool.lanes]]
+# name = "default"
+{{- range .Mempool.Lanes }}
+
+[[mempool.lanes]]
+name = "{{ .Name }}"
+msg-types = [{{ range .MsgTypes }}{{ printf "%q, " . }}{{end}}]
+block-space = "{{ .BlockSpace }}"
+{{- end }}
\ No newline at end of file
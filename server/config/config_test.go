@@ -5,11 +5,14 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	pruningtypes "cosmossdk.io/store/pruning/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -32,6 +35,31 @@ func TestGetAndSetMinimumGas(t *testing.T) {
 	require.EqualValues(t, cfg.GetMinGasPrices(), input)
 }
 
+func TestValidateSnapshotDeltaInterval(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	cfg.StateSync.SnapshotInterval = 1000
+
+	for interval, valid := range map[uint64]bool{0: true, 100: true, 300: false, 1000: false, 2000: false} {
+		cfg.StateSync.SnapshotDeltaInterval = interval
+		if valid {
+			require.NoError(t, cfg.ValidateBasic(), interval)
+		} else {
+			require.Error(t, cfg.ValidateBasic(), interval)
+		}
+	}
+}
+
+func TestValidateArchive(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	cfg.Archive.URL = "file:///archive"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Pruning = pruningtypes.PruningOptionNothing
+	require.Error(t, cfg.ValidateBasic())
+}
+
 func TestIndexEventsMarshalling(t *testing.T) {
 	expectedIn := `index-events = ["key1", "key2", ]` + "\n"
 	cfg := DefaultConfig()
@@ -52,6 +80,25 @@ func TestStreamingConfig(t *testing.T) {
 				Plugin:        "plugin-A",
 				StopNodeOnErr: false,
 			},
+			Sink: StreamingSinkConfig{
+				BatchSize:    10,
+				MaxRetries:   2,
+				RetryBackoff: time.Second,
+				Decode:       true,
+			},
+			Kafka: KafkaSinkConfig{
+				Brokers:      []string{"kafka-1:9092", "kafka-2:9092"},
+				Topic:        "state",
+				Partition:    1,
+				RequiredAcks: 1,
+				Timeout:      5 * time.Second,
+				MaxBatchSize: 1000,
+			},
+			NATS: NATSSinkConfig{
+				URL:     "nats://nats:4222",
+				Subject: "state",
+				Timeout: 5 * time.Second,
+			},
 		},
 	}
 
@@ -69,6 +116,12 @@ func TestStreamingConfig(t *testing.T) {
 		`keys = ["one", "two", ]`,
 		`plugin = "plugin-A"`,
 		`stop-node-on-err = false`,
+		`batch-size = 10`,
+		`retry-backoff = "1s"`,
+		`decode = true`,
+		`brokers = ["kafka-1:9092", "kafka-2:9092", ]`,
+		`required-acks = 1`,
+		`url = "nats://nats:4222"`,
 	}
 
 	for _, line := range expectedLines {
@@ -149,6 +202,30 @@ func TestIndexEventsWriteRead(t *testing.T) {
 	require.Equal(t, expected, actual, "config value")
 }
 
+func TestMempoolLanesWriteRead(t *testing.T) {
+	expected := []MempoolLaneConfig{
+		{Name: "votes", MsgTypes: []string{"/cosmos.gov.v1.MsgVote", "/cosmos.gov.v1.MsgVoteWeighted"}, BlockSpace: "0.1"},
+		{Name: "default", MsgTypes: []string{}, BlockSpace: ""},
+	}
+
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.Mempool.MaxTxs = 5000
+	conf.Mempool.Lanes = expected
+
+	err := WriteConfigFile(confFile, conf)
+	require.NoError(t, err)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig(), "reading config file into viper")
+
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err, "parsing config")
+	require.Equal(t, 5000, cfg.Mempool.MaxTxs)
+	require.Equal(t, expected, cfg.Mempool.Lanes)
+}
+
 func TestGlobalLabelsEventsMarshalling(t *testing.T) {
 	expectedIn := `global-labels = [
   ["labelname1", "labelvalue1"],
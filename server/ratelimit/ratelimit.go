@@ -0,0 +1,245 @@
+// Package ratelimit implements the rate limiting of the requests to the gRPC
+// and API servers, per method and per client IP.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+const (
+	// ServerGRPC is the label of the rate limits of the gRPC server.
+	ServerGRPC = "grpc"
+	// ServerAPI is the label of the rate limits of the API server.
+	ServerAPI = "api"
+
+	// maxClientBuckets is the number of client buckets above which the idle
+	// ones are pruned.
+	maxClientBuckets = 10_000
+
+	// forwardedForHeader is the metadata set by the gRPC gateway of the API
+	// server to the IP of the client.
+	forwardedForHeader = "x-forwarded-for"
+)
+
+// bucket is a token bucket, refilled at the rate limit.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// refill adds the tokens accumulated since the last refill, up to burst.
+func (b *bucket) refill(now time.Time, rate, burst float64) {
+	b.tokens = math.Min(burst, b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+}
+
+type clientKey struct {
+	method, client string
+}
+
+// Limiter enforces the request rate limits of a server, per method and per
+// client IP and method, with token buckets.
+type Limiter struct {
+	server string
+	cfg    config.RateLimitConfig
+	now    func() time.Time
+
+	mtx     sync.Mutex
+	methods map[string]*bucket
+	clients map[clientKey]*bucket
+}
+
+// NewLimiter returns a Limiter enforcing the rate limits of the given server.
+func NewLimiter(server string, cfg config.RateLimitConfig) *Limiter {
+	return &Limiter{
+		server:  server,
+		cfg:     cfg,
+		now:     time.Now,
+		methods: make(map[string]*bucket),
+		clients: make(map[clientKey]*bucket),
+	}
+}
+
+// burst returns the size of the buckets refilled at the given rate.
+func (l *Limiter) burst(rate float64) float64 {
+	if l.cfg.Burst > 0 {
+		return float64(l.cfg.Burst)
+	}
+
+	return math.Max(1, math.Ceil(rate))
+}
+
+// Allow returns true if a request from the client to the method is within the
+// rate limits, and consumes it from the limits. Otherwise, the rejection is
+// recorded in the server_rate_limit_rejected metric.
+func (l *Limiter) Allow(method, client string) bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := l.now()
+
+	var methodBucket, clientBucket *bucket
+	if rate := l.cfg.MethodRequestsPerSecond; rate > 0 {
+		methodBucket = getBucket(l.methods, method, now, rate, l.burst(rate))
+		if methodBucket.tokens < 1 {
+			l.reject(method, "method")
+			return false
+		}
+	}
+
+	if rate := l.cfg.ClientRequestsPerSecond; rate > 0 {
+		if len(l.clients) >= maxClientBuckets {
+			l.pruneClients(now)
+		}
+
+		clientBucket = getBucket(l.clients, clientKey{method: method, client: client}, now, rate, l.burst(rate))
+		if clientBucket.tokens < 1 {
+			l.reject(method, "client")
+			return false
+		}
+	}
+
+	if methodBucket != nil {
+		methodBucket.tokens--
+	}
+	if clientBucket != nil {
+		clientBucket.tokens--
+	}
+
+	return true
+}
+
+// getBucket returns the refilled bucket of the given key, creating a full one
+// if none exists.
+func getBucket[K comparable](buckets map[K]*bucket, key K, now time.Time, rate, burst float64) *bucket {
+	b, ok := buckets[key]
+	if !ok {
+		b = &bucket{tokens: burst, last: now}
+		buckets[key] = b
+	}
+
+	b.refill(now, rate, burst)
+	return b
+}
+
+// pruneClients removes the client buckets which are full again, i.e. of the
+// clients idle long enough to be no longer limited.
+func (l *Limiter) pruneClients(now time.Time) {
+	rate := l.cfg.ClientRequestsPerSecond
+	burst := l.burst(rate)
+	for key, b := range l.clients {
+		b.refill(now, rate, burst)
+		if b.tokens >= burst {
+			delete(l.clients, key)
+		}
+	}
+}
+
+// reject records a rejected request. The method label is only set for the gRPC
+// server, as the URL paths of the API server are unbounded.
+func (l *Limiter) reject(method, limit string) {
+	labels := []metrics.Label{
+		telemetry.NewLabel("server", l.server),
+		telemetry.NewLabel("limit", limit),
+	}
+	if l.server == ServerGRPC {
+		labels = append(labels, telemetry.NewLabel("method", method))
+	}
+
+	telemetry.IncrCounterWithLabels([]string{"server", "rate_limit", "rejected"}, 1, labels)
+}
+
+// UnaryServerInterceptor returns a gRPC interceptor rejecting the unary
+// requests exceeding the rate limits with a ResourceExhausted error.
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !l.Allow(info.FullMethod, grpcClientIP(ctx)) {
+			return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s", info.FullMethod)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a gRPC interceptor rejecting the streams
+// exceeding the rate limits with a ResourceExhausted error.
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !l.Allow(info.FullMethod, grpcClientIP(stream.Context())) {
+			return status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s", info.FullMethod)
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+// ServerOptions returns the gRPC server options enforcing the rate limits.
+func (l *Limiter) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(l.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(l.StreamServerInterceptor()),
+	}
+}
+
+// Middleware returns an HTTP handler rejecting the requests exceeding the rate
+// limits with a 429 Too Many Requests status. The method of a request is its
+// URL path.
+func (l *Limiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !l.Allow(r.URL.Path, hostIP(r.RemoteAddr)) {
+			http.Error(w, "rate limit exceeded for "+r.URL.Path, http.StatusTooManyRequests)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// grpcClientIP returns the IP of the client of a gRPC request. The requests
+// forwarded by a local gRPC gateway are attributed to the IP of the original
+// client, i.e. the last one of the x-forwarded-for metadata appended by the
+// gateway.
+func grpcClientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	ip := hostIP(p.Addr.String())
+	if parsed := net.ParseIP(ip); parsed != nil && parsed.IsLoopback() {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if forwarded := md.Get(forwardedForHeader); len(forwarded) > 0 {
+			ips := strings.Split(forwarded[len(forwarded)-1], ",")
+			return strings.TrimSpace(ips[len(ips)-1])
+		}
+	}
+
+	return ip
+}
+
+// hostIP returns the host of an address, or the address itself if it has no
+// port.
+func hostIP(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+
+	return host
+}
@@ -0,0 +1,145 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+func newTestLimiter(cfg config.RateLimitConfig) (*Limiter, *time.Time) {
+	now := time.Unix(0, 0)
+	l := NewLimiter(ServerGRPC, cfg)
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func TestLimiterMethodLimit(t *testing.T) {
+	l, now := newTestLimiter(config.RateLimitConfig{MethodRequestsPerSecond: 2})
+
+	// the burst defaults to the rate
+	require.True(t, l.Allow("/a", "1.1.1.1"))
+	require.True(t, l.Allow("/a", "2.2.2.2"))
+	require.False(t, l.Allow("/a", "3.3.3.3"))
+
+	// methods are limited independently
+	require.True(t, l.Allow("/b", "1.1.1.1"))
+
+	// tokens are refilled at the rate
+	*now = now.Add(500 * time.Millisecond)
+	require.True(t, l.Allow("/a", "1.1.1.1"))
+	require.False(t, l.Allow("/a", "1.1.1.1"))
+
+	*now = now.Add(time.Hour)
+	require.True(t, l.Allow("/a", "1.1.1.1"))
+	require.True(t, l.Allow("/a", "1.1.1.1"))
+	require.False(t, l.Allow("/a", "1.1.1.1"))
+}
+
+func TestLimiterClientLimit(t *testing.T) {
+	l, now := newTestLimiter(config.RateLimitConfig{
+		MethodRequestsPerSecond: 3,
+		ClientRequestsPerSecond: 1,
+	})
+
+	require.True(t, l.Allow("/a", "1.1.1.1"))
+	require.False(t, l.Allow("/a", "1.1.1.1"))
+
+	// other clients and methods are not limited, and the requests rejected by
+	// the client limit did not consume the method limit
+	require.True(t, l.Allow("/a", "2.2.2.2"))
+	require.True(t, l.Allow("/a", "3.3.3.3"))
+	require.False(t, l.Allow("/a", "4.4.4.4"))
+	require.True(t, l.Allow("/b", "1.1.1.1"))
+
+	*now = now.Add(time.Second)
+	require.True(t, l.Allow("/a", "1.1.1.1"))
+	require.False(t, l.Allow("/a", "1.1.1.1"))
+}
+
+func TestLimiterBurst(t *testing.T) {
+	l, now := newTestLimiter(config.RateLimitConfig{ClientRequestsPerSecond: 1, Burst: 3})
+
+	for i := 0; i < 3; i++ {
+		require.True(t, l.Allow("/a", "1.1.1.1"))
+	}
+	require.False(t, l.Allow("/a", "1.1.1.1"))
+
+	*now = now.Add(time.Second)
+	require.True(t, l.Allow("/a", "1.1.1.1"))
+	require.False(t, l.Allow("/a", "1.1.1.1"))
+}
+
+func TestLimiterPruneClients(t *testing.T) {
+	l, now := newTestLimiter(config.RateLimitConfig{ClientRequestsPerSecond: 1})
+
+	for i := 0; i < maxClientBuckets; i++ {
+		require.True(t, l.Allow("/a", net.IPv4(10, 0, byte(i>>8), byte(i)).String()))
+	}
+	require.Len(t, l.clients, maxClientBuckets)
+
+	// idle clients are pruned once their bucket is full again
+	*now = now.Add(time.Second)
+	require.True(t, l.Allow("/a", "1.1.1.1"))
+	require.Len(t, l.clients, 1)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	l, _ := newTestLimiter(config.RateLimitConfig{ClientRequestsPerSecond: 1})
+	interceptor := l.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/cosmos.bank.v1beta1.Query/Balance"}
+	handler := func(context.Context, any) (any, error) { return "ok", nil }
+
+	peerCtx := func(addr string, md metadata.MD) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 1234}})
+		return metadata.NewIncomingContext(ctx, md)
+	}
+
+	res, err := interceptor(peerCtx("1.1.1.1", nil), nil, info, handler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", res)
+
+	_, err = interceptor(peerCtx("1.1.1.1", nil), nil, info, handler)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// requests forwarded by the local gateway are attributed to the original
+	// client, but a remote client cannot spoof its IP
+	_, err = interceptor(peerCtx("127.0.0.1", metadata.Pairs(forwardedForHeader, "1.1.1.1, 2.2.2.2")), nil, info, handler)
+	require.NoError(t, err)
+	_, err = interceptor(peerCtx("127.0.0.1", metadata.Pairs(forwardedForHeader, "2.2.2.2")), nil, info, handler)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, err = interceptor(peerCtx("3.3.3.3", metadata.Pairs(forwardedForHeader, "4.4.4.4")), nil, info, handler)
+	require.NoError(t, err)
+	_, err = interceptor(peerCtx("3.3.3.3", metadata.Pairs(forwardedForHeader, "5.5.5.5")), nil, info, handler)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestMiddleware(t *testing.T) {
+	l, _ := newTestLimiter(config.RateLimitConfig{ClientRequestsPerSecond: 1})
+	handler := l.Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(remoteAddr string) int {
+		req := httptest.NewRequest(http.MethodGet, "/cosmos/bank/v1beta1/params", nil)
+		req.RemoteAddr = remoteAddr
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	require.Equal(t, http.StatusOK, serve("1.1.1.1:1234"))
+	require.Equal(t, http.StatusTooManyRequests, serve("1.1.1.1:5678"))
+	require.Equal(t, http.StatusOK, serve("2.2.2.2:1234"))
+}
@@ -38,6 +38,7 @@ import (
 
 	"cosmossdk.io/log"
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -45,6 +46,7 @@ import (
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	servercmtlog "github.com/cosmos/cosmos-sdk/server/log"
+	"github.com/cosmos/cosmos-sdk/server/ratelimit"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
@@ -61,26 +63,36 @@ const (
 	flagCPUProfile         = "cpu-profile"
 	FlagMinGasPrices       = "minimum-gas-prices"
 	FlagQueryGasLimit      = "query-gas-limit"
+	FlagQueryCacheSize     = "query-cache-size"
 	FlagHaltHeight         = "halt-height"
 	FlagHaltTime           = "halt-time"
 	FlagInterBlockCache    = "inter-block-cache"
 	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
 	FlagTrace              = "trace"
 	FlagInvCheckPeriod     = "inv-check-period"
+	FlagInvCheckBatchSize  = "inv-check-batch-size"
+	FlagInvCheckHalt       = "inv-check-halt"
 
 	FlagPruning             = "pruning"
 	FlagPruningKeepRecent   = "pruning-keep-recent"
 	FlagPruningInterval     = "pruning-interval"
+	FlagPruningBackground   = "pruning-background"
+	FlagPruningRateLimit    = "pruning-rate-limit"
 	FlagIndexEvents         = "index-events"
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
+	FlagStateStats          = "state-stats"
+	FlagArchiveURL          = "archive.url"
+	FlagArchiveCheckpoint   = "archive.checkpoint-interval"
+	FlagParallelTxWorkers   = "parallel-tx-workers"
 	FlagShutdownGrace       = "shutdown-grace"
 
 	// state sync-related flags
 
-	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
-	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotInterval      = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent    = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotDeltaInterval = "state-sync.snapshot-delta-interval"
 
 	// api-related flags
 
@@ -92,6 +104,7 @@ const (
 	FlagRPCWriteTimeout       = "api.rpc-write-timeout"
 	FlagRPCMaxBodyBytes       = "api.rpc-max-body-bytes"
 	FlagAPIEnableUnsafeCORS   = "api.enabled-unsafe-cors"
+	FlagAPIEventStream        = "api.event-stream"
 
 	// gRPC-related flags
 
@@ -102,6 +115,7 @@ const (
 	// mempool flags
 
 	FlagMempoolMaxTxs = "mempool.max-txs"
+	FlagMempoolLanes  = "mempool.lanes"
 
 	// testnet keys
 
@@ -230,6 +244,16 @@ func start[T types.Application](svrCtx *Context, clientCtx client.Context, appCr
 		return err
 	}
 
+	shutdownTracing, err := telemetry.NewTracing(context.Background(), svrCfg.Telemetry)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			svrCtx.Logger.Error("failed to shutdown tracing", "err", err)
+		}
+	}()
+
 	emitServerInfoMetrics()
 
 	if !withCmt {
@@ -250,6 +274,11 @@ func startStandAlone[T types.Application](svrCtx *Context, svrCfg serverconfig.C
 
 	svr.SetLogger(servercmtlog.CometLoggerWrapper{Logger: svrCtx.Logger.With("module", "abci-server")})
 
+	eventStream, err := addEventStream(svrCfg, clientCtx, svrCtx, app)
+	if err != nil {
+		return err
+	}
+
 	g, ctx := getCtx(svrCtx, false)
 
 	// Add the tx service to the gRPC router. We only need to register this
@@ -272,12 +301,12 @@ func startStandAlone[T types.Application](svrCtx *Context, svrCfg serverconfig.C
 		app.RegisterNodeService(clientCtx, svrCfg)
 	}
 
-	grpcSrv, clientCtx, err := startGrpcServer(ctx, g, svrCfg.GRPC, clientCtx, svrCtx, app)
+	grpcSrv, clientCtx, err := startGrpcServer(ctx, g, svrCfg.GRPC, svrCfg.RateLimit, clientCtx, svrCtx, app)
 	if err != nil {
 		return err
 	}
 
-	err = startAPIServer(ctx, g, svrCfg, clientCtx, svrCtx, app, svrCtx.Config.RootDir, grpcSrv, metrics)
+	err = startAPIServer(ctx, g, svrCfg, clientCtx, svrCtx, app, svrCtx.Config.RootDir, grpcSrv, eventStream, metrics)
 	if err != nil {
 		return err
 	}
@@ -310,6 +339,12 @@ func startInProcess[T types.Application](svrCtx *Context, svrCfg serverconfig.Co
 	cmtCfg := svrCtx.Config
 	gRPCOnly := svrCtx.Viper.GetBool(flagGRPCOnly)
 
+	// the event stream listens to the app before it starts processing blocks
+	eventStream, err := addEventStream(svrCfg, clientCtx, svrCtx, app)
+	if err != nil {
+		return err
+	}
+
 	g, ctx := getCtx(svrCtx, true)
 
 	if gRPCOnly {
@@ -338,12 +373,12 @@ func startInProcess[T types.Application](svrCtx *Context, svrCfg serverconfig.Co
 		}
 	}
 
-	grpcSrv, clientCtx, err := startGrpcServer(ctx, g, svrCfg.GRPC, clientCtx, svrCtx, app)
+	grpcSrv, clientCtx, err := startGrpcServer(ctx, g, svrCfg.GRPC, svrCfg.RateLimit, clientCtx, svrCtx, app)
 	if err != nil {
 		return err
 	}
 
-	err = startAPIServer(ctx, g, svrCfg, clientCtx, svrCtx, app, cmtCfg.RootDir, grpcSrv, metrics)
+	err = startAPIServer(ctx, g, svrCfg, clientCtx, svrCtx, app, cmtCfg.RootDir, grpcSrv, eventStream, metrics)
 	if err != nil {
 		return err
 	}
@@ -476,6 +511,7 @@ func startGrpcServer(
 	ctx context.Context,
 	g *errgroup.Group,
 	config serverconfig.GRPCConfig,
+	rateLimitCfg serverconfig.RateLimitConfig,
 	clientCtx client.Context,
 	svrCtx *Context,
 	app types.Application,
@@ -516,7 +552,12 @@ func startGrpcServer(
 	clientCtx = clientCtx.WithGRPCClient(grpcClient)
 	svrCtx.Logger.Debug("gRPC client assigned to client context", "target", config.Address)
 
-	grpcSrv, err := servergrpc.NewGRPCServer(clientCtx, app, config)
+	var opts []grpc.ServerOption
+	if rateLimitCfg.Enable {
+		opts = ratelimit.NewLimiter(ratelimit.ServerGRPC, rateLimitCfg).ServerOptions()
+	}
+
+	grpcSrv, err := servergrpc.NewGRPCServer(clientCtx, app, config, opts...)
 	if err != nil {
 		return nil, clientCtx, err
 	}
@@ -538,6 +579,7 @@ func startAPIServer(
 	app types.Application,
 	home string,
 	grpcSrv *grpc.Server,
+	eventStream *api.EventStream,
 	metrics *telemetry.Metrics,
 ) error {
 	if !svrCfg.API.Enable {
@@ -549,6 +591,10 @@ func startAPIServer(
 	apiSrv := api.New(clientCtx, svrCtx.Logger.With("module", "api-server"), grpcSrv)
 	app.RegisterAPIRoutes(apiSrv, svrCfg.API)
 
+	if eventStream != nil {
+		apiSrv.RegisterEventStream(eventStream)
+	}
+
 	if svrCfg.Telemetry.Enabled {
 		apiSrv.SetTelemetry(metrics)
 	}
@@ -559,6 +605,26 @@ func startAPIServer(
 	return nil
 }
 
+// addEventStream adds the event stream of the API server as an ABCI listener of
+// the app, if enabled.
+func addEventStream(svrCfg serverconfig.Config, clientCtx client.Context, svrCtx *Context, app types.Application) (*api.EventStream, error) {
+	if !svrCfg.API.Enable || !svrCfg.API.EventStream {
+		return nil, nil
+	}
+
+	listenerApp, ok := app.(interface {
+		AddABCIListener(storetypes.ABCIListener)
+	})
+	if !ok {
+		return nil, fmt.Errorf("the event stream requires the app to support ABCI listeners, %T does not", app)
+	}
+
+	eventStream := api.NewEventStream(clientCtx.Codec, svrCtx.Logger.With("module", "event-stream"))
+	listenerApp.AddABCIListener(eventStream)
+
+	return eventStream, nil
+}
+
 func startTelemetry(cfg serverconfig.Config) (*telemetry.Metrics, error) {
 	return telemetry.New(cfg.Telemetry)
 }
@@ -983,6 +1049,7 @@ func addStartNodeFlags[T types.Application](cmd *cobra.Command, opts StartCmdOpt
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
 	cmd.Flags().String(FlagMinGasPrices, "", "Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)")
 	cmd.Flags().Uint64(FlagQueryGasLimit, 0, "Maximum gas a Rest/Grpc query can consume. Blank and 0 imply unbounded.")
+	cmd.Flags().Int(FlagQueryCacheSize, 0, "Number of deterministic Rest/Grpc query responses cached until the next commit. Blank and 0 disable the cache.")
 	cmd.Flags().IntSlice(FlagUnsafeSkipUpgrades, []int{}, "Skip a set of upgrade heights to continue the old binary")
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
@@ -992,7 +1059,11 @@ func addStartNodeFlags[T types.Application](cmd *cobra.Command, opts StartCmdOpt
 	cmd.Flags().String(FlagPruning, pruningtypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
-	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
+	cmd.Flags().Bool(FlagPruningBackground, false, "Prune the application state in a background worker instead of at commit")
+	cmd.Flags().Uint64(FlagPruningRateLimit, 0, "Maximum number of heights pruned per second by the background pruning (0 for no limit)")
+	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants in the background every N blocks, broken invariants are reported without halting the node")
+	cmd.Flags().Uint(FlagInvCheckBatchSize, 0, "Maximum number of invariants asserted every inv-check-period blocks, the invariants are asserted in batches (0 for all)")
+	cmd.Flags().StringSlice(FlagInvCheckHalt, []string{}, "Invariant routes (module/route) or modules whose broken invariants halt the node")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().Bool(FlagAPIEnable, false, "Define if the API server should be enabled")
 	cmd.Flags().Bool(FlagAPISwagger, false, "Define if swagger documentation should automatically be registered (Note: the API must also be enabled)")
@@ -1002,12 +1073,18 @@ func addStartNodeFlags[T types.Application](cmd *cobra.Command, opts StartCmdOpt
 	cmd.Flags().Uint(FlagRPCWriteTimeout, 0, "Define the CometBFT RPC write timeout (in seconds)")
 	cmd.Flags().Uint(FlagRPCMaxBodyBytes, 1000000, "Define the CometBFT maximum request body (in bytes)")
 	cmd.Flags().Bool(FlagAPIEnableUnsafeCORS, false, "Define if CORS should be enabled (unsafe - use it at your own risk)")
+	cmd.Flags().Bool(FlagAPIEventStream, false, "Define if the WebSocket event subscription endpoint should be enabled (Note: the API must also be enabled)")
 	cmd.Flags().Bool(flagGRPCOnly, false, "Start the node in gRPC query only mode (no CometBFT process is started)")
 	cmd.Flags().Bool(flagGRPCEnable, true, "Define if the gRPC server should be enabled")
 	cmd.Flags().String(flagGRPCAddress, serverconfig.DefaultGRPCAddress, "the gRPC server address to listen on")
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint64(FlagStateSyncSnapshotDeltaInterval, 0, "State sync differential snapshot interval")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().Bool(FlagStateStats, false, "Track the number of keys, size and writes of the state of each store")
+	cmd.Flags().String(FlagArchiveURL, "", "URL of the cold storage backend the historical versions are archived to before being pruned")
+	cmd.Flags().Uint64(FlagArchiveCheckpoint, 1000, "Block interval at which the full state is archived")
+	cmd.Flags().Int(FlagParallelTxWorkers, 0, "Number of workers executing block transactions in parallel (disabled if lower than 2)")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
 	cmd.Flags().Duration(FlagShutdownGrace, 0*time.Second, "On Shutdown, duration to wait for resource clean up")
 
@@ -26,7 +26,10 @@ import (
 
 	corectx "cosmossdk.io/core/context"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"cosmossdk.io/store"
+	"cosmossdk.io/store/archive"
+	"cosmossdk.io/store/rootmulti"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
@@ -525,17 +528,36 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotInterval)),
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
 	)
+	snapshotOptions.DeltaInterval = cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotDeltaInterval))
+
+	stateArchive, err := GetArchive(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	mempoolLanes, err := GetMempoolLanes(appOpts)
+	if err != nil {
+		panic(err)
+	}
 
 	defaultMempool := baseapp.SetMempool(mempool.NoOpMempool{})
 	if maxTxs := cast.ToInt(appOpts.Get(FlagMempoolMaxTxs)); maxTxs >= 0 {
-		defaultMempool = baseapp.SetMempool(
-			mempool.NewSenderNonceMempool(
-				mempool.SenderNonceMaxTxOpt(maxTxs),
-			),
-		)
+		if len(mempoolLanes) > 0 {
+			laneMempool, err := NewLaneMempool(mempoolLanes, maxTxs)
+			if err != nil {
+				panic(err)
+			}
+			defaultMempool = baseapp.SetMempool(laneMempool)
+		} else {
+			defaultMempool = baseapp.SetMempool(
+				mempool.NewSenderNonceMempool(
+					mempool.SenderNonceMaxTxOpt(maxTxs),
+				),
+			)
+		}
 	}
 
-	return []func(*baseapp.BaseApp){
+	opts := []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(FlagHaltHeight))),
@@ -545,11 +567,118 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(FlagTrace))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(FlagIndexEvents))),
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
+		baseapp.SetBackgroundPruning(
+			cast.ToBool(appOpts.Get(FlagPruningBackground)),
+			cast.ToUint64(appOpts.Get(FlagPruningRateLimit)),
+		),
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(FlagIAVLCacheSize))),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(FlagDisableIAVLFastNode))),
+		baseapp.SetStateStats(cast.ToBool(appOpts.Get(FlagStateStats))),
+		SetArchive(stateArchive),
+		baseapp.SetParallelTxExecution(cast.ToInt(appOpts.Get(FlagParallelTxWorkers))),
 		defaultMempool,
 		baseapp.SetChainID(chainID),
 		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(FlagQueryGasLimit))),
+		baseapp.SetQueryCacheSize(cast.ToInt(appOpts.Get(FlagQueryCacheSize))),
+		baseapp.SetInvariantAuditInterval(cast.ToUint64(appOpts.Get(FlagInvCheckPeriod))),
+		baseapp.SetInvariantAuditBatchSize(cast.ToInt(appOpts.Get(FlagInvCheckBatchSize))),
+	}
+
+	for _, route := range cast.ToStringSlice(appOpts.Get(FlagInvCheckHalt)) {
+		opts = append(opts, baseapp.SetInvariantAuditPolicy(route, baseapp.InvariantPolicyHalt))
+	}
+
+	return opts
+}
+
+// GetMempoolLanes returns the mempool lanes configured in the app.toml, ordered
+// from the highest priority to the lowest.
+func GetMempoolLanes(appOpts types.AppOptions) ([]config.MempoolLaneConfig, error) {
+	switch lanes := appOpts.Get(FlagMempoolLanes).(type) {
+	case nil:
+		return nil, nil
+	case []config.MempoolLaneConfig:
+		return lanes, nil
+	}
+
+	items, err := cast.ToSliceE(appOpts.Get(FlagMempoolLanes))
+	if err != nil {
+		return nil, fmt.Errorf("invalid mempool lanes: %w", err)
+	}
+
+	lanes := make([]config.MempoolLaneConfig, 0, len(items))
+	for i, item := range items {
+		lane, err := cast.ToStringMapE(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid mempool lane %d: %w", i, err)
+		}
+
+		lanes = append(lanes, config.MempoolLaneConfig{
+			Name:       cast.ToString(lane["name"]),
+			MsgTypes:   cast.ToStringSlice(lane["msg-types"]),
+			BlockSpace: cast.ToString(lane["block-space"]),
+		})
+	}
+
+	return lanes, nil
+}
+
+// NewLaneMempool returns a mempool.LaneMempool made of the given lanes, every
+// lane storing at most maxTxs transactions in a sender nonce mempool.
+func NewLaneMempool(lanes []config.MempoolLaneConfig, maxTxs int) (*mempool.LaneMempool, error) {
+	mempoolLanes := make([]mempool.Lane, 0, len(lanes))
+	for _, lane := range lanes {
+		blockSpace := math.LegacyZeroDec()
+		if lane.BlockSpace != "" {
+			var err error
+			if blockSpace, err = math.LegacyNewDecFromStr(lane.BlockSpace); err != nil {
+				return nil, fmt.Errorf("invalid block space of mempool lane %s: %w", lane.Name, err)
+			}
+		}
+
+		var match func(sdk.Tx) bool
+		if len(lane.MsgTypes) > 0 {
+			match = mempool.MatchMsgTypes(lane.MsgTypes...)
+		}
+
+		mempoolLanes = append(mempoolLanes, mempool.Lane{
+			Name:       lane.Name,
+			Match:      match,
+			BlockSpace: blockSpace,
+			Mempool:    mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(maxTxs)),
+		})
+	}
+
+	return mempool.NewLaneMempool(mempoolLanes...)
+}
+
+// GetArchive returns the archive of the historical versions of the state in the
+// configured cold storage backend, or nil if the archival is disabled.
+func GetArchive(appOpts types.AppOptions) (*archive.Archive, error) {
+	url := cast.ToString(appOpts.Get(FlagArchiveURL))
+	if url == "" {
+		return nil, nil
+	}
+
+	backend, err := archive.NewBackend(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return archive.NewArchive(backend, cast.ToUint64(appOpts.Get(FlagArchiveCheckpoint))), nil
+}
+
+// SetArchive provides a BaseApp option function that sets the archive the
+// historical versions of the state are offloaded to before being pruned, and
+// read from by the historical queries. A nil archive disables the archival.
+func SetArchive(archive *archive.Archive) func(*baseapp.BaseApp) {
+	return func(bapp *baseapp.BaseApp) {
+		if archive == nil {
+			return
+		}
+		if rms, ok := bapp.CommitMultiStore().(*rootmulti.Store); ok {
+			rms.SetArchive(archive)
+		}
 	}
 }
 
@@ -486,6 +486,49 @@ func TestEmptyMinGasPrices(t *testing.T) {
 	require.Errorf(t, err, sdkerrors.ErrAppConfig.Error())
 }
 
+func TestGetMempoolLanes(t *testing.T) {
+	appCfgFilePath := filepath.Join(t.TempDir(), "app.toml")
+	appConf := config.DefaultConfig()
+	appConf.Mempool.MaxTxs = 10
+	appConf.Mempool.Lanes = []config.MempoolLaneConfig{
+		{Name: "votes", MsgTypes: []string{"/cosmos.gov.v1.MsgVote"}, BlockSpace: "0.1"},
+		{Name: "default"},
+	}
+	require.NoError(t, config.WriteConfigFile(appCfgFilePath, appConf))
+
+	v := viper.New()
+	v.SetConfigFile(appCfgFilePath)
+	require.NoError(t, v.ReadInConfig())
+
+	lanes, err := server.GetMempoolLanes(v)
+	require.NoError(t, err)
+	require.Equal(t, []config.MempoolLaneConfig{
+		{Name: "votes", MsgTypes: []string{"/cosmos.gov.v1.MsgVote"}, BlockSpace: "0.1"},
+		{Name: "default"},
+	}, lanes)
+
+	laneMempool, err := server.NewLaneMempool(lanes, 10)
+	require.NoError(t, err)
+	require.Len(t, laneMempool.Lanes(), 2)
+	require.Equal(t, "votes", laneMempool.Lanes()[0].Name)
+	require.Equal(t, "0.100000000000000000", laneMempool.Lanes()[0].BlockSpace.String())
+	require.NotNil(t, laneMempool.Lanes()[0].Match)
+	require.Equal(t, "default", laneMempool.Lanes()[1].Name)
+	require.True(t, laneMempool.Lanes()[1].BlockSpace.IsZero())
+	require.Nil(t, laneMempool.Lanes()[1].Match)
+
+	// no lanes are configured by default
+	lanes, err = server.GetMempoolLanes(mapGetter{})
+	require.NoError(t, err)
+	require.Empty(t, lanes)
+
+	_, err = server.NewLaneMempool([]config.MempoolLaneConfig{{Name: "votes", BlockSpace: "1.5"}}, 10)
+	require.ErrorContains(t, err, "block space reserved to lanes exceeds the block")
+
+	_, err = server.NewLaneMempool([]config.MempoolLaneConfig{{Name: "votes", BlockSpace: "half"}}, 10)
+	require.ErrorContains(t, err, "invalid block space of mempool lane votes")
+}
+
 type mapGetter map[string]interface{}
 
 func (m mapGetter) Get(key string) interface{} {
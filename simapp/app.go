@@ -20,6 +20,8 @@ import (
 	"cosmossdk.io/client/v2/autocli"
 	clienthelpers "cosmossdk.io/client/v2/helpers"
 	"cosmossdk.io/log"
+	"cosmossdk.io/store/streaming/sinks/kafka"
+	"cosmossdk.io/store/streaming/sinks/nats"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/accounts"
 	"cosmossdk.io/x/accounts/accountstd"
@@ -179,6 +181,7 @@ type SimApp struct {
 	// managers
 	ModuleManager      *module.Manager
 	UnorderedTxManager *unorderedtx.Manager
+	sigBatchVerifier   *ante.SigBatchVerifier
 	sm                 *module.SimulationManager
 
 	// module configurator
@@ -255,6 +258,12 @@ func NewSimApp(
 	baseAppOptions = append(baseAppOptions, voteExtOp, baseapp.SetOptimisticExecution(),
 		baseapp.SetIncludeNestedMsgsGas([]sdk.Msg{&govv1.MsgSubmitProposal{}}))
 
+	// register the Kafka and NATS streaming sinks, selected in the app.toml
+	baseAppOptions = append(baseAppOptions,
+		baseapp.SetStreamingPublisher(baseapp.StreamingKafkaPlugin, kafka.NewProducerFromOptions),
+		baseapp.SetStreamingPublisher(baseapp.StreamingNATSPlugin, nats.NewPublisherFromOptions),
+	)
+
 	bApp := baseapp.NewBaseApp(appName, logger, db, txConfig.TxDecoder(), baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetVersion(version.Version)
@@ -270,6 +279,8 @@ func NewSimApp(
 		accounts.StoreKey, epochstypes.StoreKey,
 	)
 
+	tkeys := storetypes.NewTransientStoreKeys(authtypes.TStoreKey)
+
 	// register streaming services
 	if err := bApp.RegisterStreamingServices(appOpts, keys); err != nil {
 		panic(err)
@@ -286,9 +297,10 @@ func NewSimApp(
 	}
 	cometService := runtime.NewContextAwareCometInfoService()
 
-	// set the BaseApp's parameter store
+	// set the BaseApp's parameter and halt stores
 	app.ConsensusParamsKeeper = consensusparamkeeper.NewKeeper(appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[consensusparamtypes.StoreKey]), logger.With(log.ModuleKey, "x/consensus")), authtypes.NewModuleAddress(govtypes.ModuleName).String())
-	bApp.SetParamStore(app.ConsensusParamsKeeper.ParamsStore)
+	bApp.SetParamStore(app.ConsensusParamsKeeper.ParamStore())
+	bApp.SetHaltStore(app.ConsensusParamsKeeper)
 
 	// add keepers
 	accountsKeeper, err := accounts.NewKeeper(
@@ -312,7 +324,7 @@ func NewSimApp(
 	}
 	app.AccountsKeeper = accountsKeeper
 
-	app.AuthKeeper = authkeeper.NewAccountKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[authtypes.StoreKey]), logger.With(log.ModuleKey, "x/auth")), appCodec, authtypes.ProtoBaseAccount, accountsKeeper, maccPerms, signingCtx.AddressCodec(), sdk.Bech32MainPrefix, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	app.AuthKeeper = authkeeper.NewAccountKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[authtypes.StoreKey]), logger.With(log.ModuleKey, "x/auth")), runtime.NewTransientStoreService(tkeys[authtypes.TStoreKey]), appCodec, authtypes.ProtoBaseAccount, accountsKeeper, maccPerms, signingCtx.AddressCodec(), sdk.Bech32MainPrefix, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
 		runtime.NewEnvironment(runtime.NewKVStoreService(keys[banktypes.StoreKey]), logger.With(log.ModuleKey, "x/bank")),
@@ -374,6 +386,9 @@ func NewSimApp(
 		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
 
+	// register the modules checked before pruning an account
+	app.AuthKeeper.SetAccountPruneCheckers(app.BankKeeper, app.StakingKeeper)
+
 	app.CircuitKeeper = circuitkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[circuittypes.StoreKey]), logger.With(log.ModuleKey, "x/circuit")), appCodec, authtypes.NewModuleAddress(govtypes.ModuleName).String(), app.AuthKeeper.AddressCodec())
 	app.BaseApp.SetCircuitBreaker(&app.CircuitKeeper)
 
@@ -469,6 +484,13 @@ func NewSimApp(
 	app.ModuleManager.RegisterLegacyAminoCodec(legacyAmino)
 	app.ModuleManager.RegisterInterfaces(interfaceRegistry)
 
+	// decode the state changes of the modules streamed by the built-in streaming plugins
+	streamingModules := make(map[string]any, len(app.ModuleManager.Modules))
+	for name, mod := range app.ModuleManager.Modules {
+		streamingModules[name] = mod
+	}
+	app.SetStreamingModules(streamingModules)
+
 	// NOTE: upgrade module is required to be prioritized
 	app.ModuleManager.SetOrderPreBlockers(
 		upgradetypes.ModuleName,
@@ -491,10 +513,12 @@ func NewSimApp(
 	app.ModuleManager.SetOrderEndBlockers(
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
+		distrtypes.ModuleName,
 		genutiltypes.ModuleName,
 		feegrant.ModuleName,
 		group.ModuleName,
 		pooltypes.ModuleName,
+		authtypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -520,6 +544,9 @@ func NewSimApp(
 		panic(err)
 	}
 
+	// the invariants are only asserted if the invariant audit is enabled
+	app.ModuleManager.RegisterInvariants(app.InvariantRegistry())
+
 	// RegisterUpgradeHandlers is used for registering any on-chain upgrades.
 	// Make sure it's called after `app.ModuleManager` and `app.configurator` are set.
 	app.RegisterUpgradeHandlers()
@@ -567,6 +594,7 @@ func NewSimApp(
 
 	// initialize stores
 	app.MountKVStores(keys)
+	app.MountTransientStores(tkeys)
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
@@ -613,6 +641,8 @@ func NewSimApp(
 }
 
 func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
+	app.sigBatchVerifier = ante.NewSigBatchVerifier(app.AuthKeeper, txConfig.SignModeHandler(), txConfig.TxDecoder())
+
 	anteHandler, err := NewAnteHandler(
 		HandlerOptions{
 			ante.HandlerOptions{
@@ -623,6 +653,8 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 				SignModeHandler:          txConfig.SignModeHandler(),
 				FeegrantKeeper:           app.FeeGrantKeeper,
 				SigGasConsumer:           ante.DefaultSigVerificationGasConsumer,
+				SigBatchVerifier:         app.sigBatchVerifier,
+				TxRateLimitKeeper:        app.AuthKeeper,
 			},
 			&app.CircuitKeeper,
 			app.UnorderedTxManager,
@@ -657,7 +689,8 @@ func (app *SimApp) Close() error {
 func (app *SimApp) Name() string { return app.BaseApp.Name() }
 
 // PreBlocker application updates every pre block
-func (app *SimApp) PreBlocker(ctx sdk.Context, _ *abci.FinalizeBlockRequest) error {
+func (app *SimApp) PreBlocker(ctx sdk.Context, req *abci.FinalizeBlockRequest) error {
+	app.sigBatchVerifier.VerifyBlock(ctx, req.Txs)
 	return app.ModuleManager.PreBlock(ctx)
 }
 
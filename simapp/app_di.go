@@ -4,6 +4,7 @@ package simapp
 
 import (
 	_ "embed"
+	"errors"
 	"fmt"
 	"io"
 	"path/filepath"
@@ -16,6 +17,8 @@ import (
 	"cosmossdk.io/core/legacy"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	"cosmossdk.io/store/streaming/sinks/kafka"
+	"cosmossdk.io/store/streaming/sinks/nats"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/accounts"
 	"cosmossdk.io/x/auth"
@@ -242,20 +245,27 @@ func NewSimApp(
 	}
 	baseAppOptions = append(baseAppOptions, voteExtOp, baseapp.SetOptimisticExecution())
 
+	// register the Kafka and NATS streaming sinks, selected in the app.toml
+	baseAppOptions = append(baseAppOptions,
+		baseapp.SetStreamingPublisher(baseapp.StreamingKafkaPlugin, kafka.NewProducerFromOptions),
+		baseapp.SetStreamingPublisher(baseapp.StreamingNATSPlugin, nats.NewPublisherFromOptions),
+	)
+
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
+	moduleSet := map[string]any{}
+	for modName, mod := range appModules {
+		moduleSet[modName] = mod
+	}
 	if indexerOpts := appOpts.Get("indexer"); indexerOpts != nil {
 		// if we have indexer options in app.toml, then enable the built-in indexer framework
-		moduleSet := map[string]any{}
-		for modName, mod := range appModules {
-			moduleSet[modName] = mod
-		}
 		err := app.EnableIndexer(indexerOpts, app.kvStoreKeys(), moduleSet)
 		if err != nil {
 			panic(err)
 		}
 	} else {
 		// register legacy streaming services if we don't have the built-in indexer enabled
+		app.SetStreamingModules(moduleSet)
 		if err := app.RegisterStreamingServices(appOpts, app.kvStoreKeys()); err != nil {
 			panic(err)
 		}
@@ -326,12 +336,13 @@ func (app *SimApp) setCustomAnteHandler() {
 	anteHandler, err := NewAnteHandler(
 		HandlerOptions{
 			ante.HandlerOptions{
-				AccountKeeper:   app.AuthKeeper,
-				BankKeeper:      app.BankKeeper,
-				SignModeHandler: app.txConfig.SignModeHandler(),
-				FeegrantKeeper:  app.FeeGrantKeeper,
-				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
-				Environment:     app.AuthKeeper.Environment,
+				AccountKeeper:     app.AuthKeeper,
+				BankKeeper:        app.BankKeeper,
+				SignModeHandler:   app.txConfig.SignModeHandler(),
+				FeegrantKeeper:    app.FeeGrantKeeper,
+				SigGasConsumer:    ante.DefaultSigVerificationGasConsumer,
+				Environment:       app.AuthKeeper.Environment,
+				TxRateLimitKeeper: app.AuthKeeper,
 			},
 			&app.CircuitBreakerKeeper,
 			app.UnorderedTxManager,
@@ -348,7 +359,7 @@ func (app *SimApp) setCustomAnteHandler() {
 // Close implements the Application interface and closes all necessary application
 // resources.
 func (app *SimApp) Close() error {
-	return app.UnorderedTxManager.Close()
+	return errors.Join(app.UnorderedTxManager.Close(), app.App.Close())
 }
 
 // LegacyAmino returns SimApp's amino codec.
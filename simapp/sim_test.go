@@ -11,13 +11,16 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	storetypes "cosmossdk.io/store/types"
 	authzkeeper "cosmossdk.io/x/authz/keeper"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/feegrant"
 	slashingtypes "cosmossdk.io/x/slashing/types"
+	stakingsims "cosmossdk.io/x/staking/simulation"
 	stakingtypes "cosmossdk.io/x/staking/types"
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
@@ -54,6 +57,18 @@ func TestFullAppSimulation(t *testing.T) {
 	sims.Run(t, NewSimApp, setupStateFactory)
 }
 
+// TestMassUndelegationScenario stresses staking with a group of delegators
+// mostly undelegating, and moves time past the unbonding period halfway.
+func TestMassUndelegationScenario(t *testing.T) {
+	scenario := simtestutil.NewScenario("mass-undelegation").
+		WithPersona(simtestutil.AccountRange("delegators", 0, 50)).
+		WithModule(stakingtypes.ModuleName, 90, "delegators").
+		WithModule(banktypes.ModuleName, 10).
+		WithOperationWeight(stakingsims.OpWeightMsgUndelegate, 1000).
+		WithTimeJump(int64(simcli.FlagNumBlocksValue/2), 21*24*time.Hour)
+	sims.RunScenario(t, NewSimApp, setupStateFactory, scenario)
+}
+
 func setupStateFactory(app *SimApp) sims.SimStateFactory {
 	return sims.SimStateFactory{
 		Codec:       app.AppCodec(),
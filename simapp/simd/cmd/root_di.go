@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
 	authv1 "cosmossdk.io/api/cosmos/auth/module/v1"
 	stakingv1 "cosmossdk.io/api/cosmos/staking/module/v1"
 	"cosmossdk.io/client/v2/autocli"
@@ -23,6 +24,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/config"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
@@ -33,6 +35,7 @@ func NewRootCmd() *cobra.Command {
 		autoCliOpts   autocli.AppOptions
 		moduleManager *module.Manager
 		clientCtx     client.Context
+		appConfig     *appv1alpha1.Config
 	)
 
 	if err := depinject.Inject(
@@ -45,6 +48,7 @@ func NewRootCmd() *cobra.Command {
 		&autoCliOpts,
 		&moduleManager,
 		&clientCtx,
+		&appConfig,
 	); err != nil {
 		panic(err)
 	}
@@ -82,6 +86,7 @@ func NewRootCmd() *cobra.Command {
 	}
 
 	initRootCmd(rootCmd, moduleManager)
+	rootCmd.AddCommand(runtime.ValidateWiringCmd(appConfig, moduleManager))
 
 	if err := autoCliOpts.EnhanceRootCommand(rootCmd); err != nil {
 		panic(err)
@@ -4,10 +4,13 @@ import (
 	"testing"
 	"time"
 
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/simapp"
 
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 )
 
@@ -41,3 +44,24 @@ func (s *IntegrationTestSuite) TestNetwork_Liveness() {
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
+
+func TestNetworkConsensusKeyTypes(t *testing.T) {
+	for _, keyType := range []string{cmttypes.ABCIPubKeyTypeEd25519, cmttypes.ABCIPubKeyTypeSecp256k1} {
+		t.Run(keyType, func(t *testing.T) {
+			cfg := network.DefaultConfig(simapp.NewTestNetworkFixture)
+			cfg.NumValidators = 2
+			cfg.ConsensusKeyType = keyType
+
+			n, err := network.New(t, t.TempDir(), cfg)
+			require.NoError(t, err)
+			defer n.Cleanup()
+
+			h, err := n.WaitForHeightWithTimeout(3, time.Minute)
+			require.NoError(t, err, "expected to reach 3 blocks; got %d", h)
+
+			for _, val := range n.GetValidators() {
+				require.Equal(t, keyType, cryptocodec.ToCmtPubKeyType(val.GetPubKey()))
+			}
+		})
+	}
+}
@@ -0,0 +1,335 @@
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	dbm "github.com/cosmos/cosmos-db"
+	protoio "github.com/cosmos/gogoproto/io"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/types"
+)
+
+const (
+	// metaObject is the name of the object of the metadata of the archive.
+	metaObject = "meta.json"
+
+	// maxPairSize is the maximum size of an encoded key-value pair of an object.
+	maxPairSize = 64e6
+)
+
+// Meta is the metadata of an archive.
+type Meta struct {
+	// FirstVersion and LatestVersion are the range of the archived versions,
+	// which are zero if none is.
+	FirstVersion  int64 `json:"first_version"`
+	LatestVersion int64 `json:"latest_version"`
+	// CheckpointInterval is the interval of the versions whose full state is
+	// archived.
+	CheckpointInterval uint64 `json:"checkpoint_interval"`
+}
+
+// Archive is the archive of the historical versions of the stores of a
+// multistore in a cold storage backend.
+//
+// The full state of each store is archived at the first archived version and at
+// every version multiple of the checkpoint interval, the other versions being
+// archived as the changes from the previous one. The state of a store at an
+// archived version is thus rebuilt from the previous checkpoint and the changes
+// since, in memory. The objects of a store at a version are named
+// <version>/<store>/checkpoint and <version>/<store>/changes, as streams of
+// length-prefixed StoreKVPair messages compressed with zlib, and the metadata
+// of the archive is stored in the meta.json object.
+type Archive struct {
+	backend            Backend
+	checkpointInterval uint64
+
+	mtx  sync.Mutex
+	meta *Meta
+	// loaded are the states of the stores at the version loadedVersion, which
+	// are cached as the historical queries often target the same version.
+	loaded        map[string]*dbm.MemDB
+	loadedVersion int64
+}
+
+// NewArchive returns a new Archive in the given backend. A checkpoint interval
+// of zero archives the full state only at the first archived version. The
+// checkpoint interval of an existing archive is kept.
+func NewArchive(backend Backend, checkpointInterval uint64) *Archive {
+	return &Archive{
+		backend:            backend,
+		checkpointInterval: checkpointInterval,
+	}
+}
+
+// Meta returns the metadata of the archive.
+func (a *Archive) Meta(ctx context.Context) (Meta, error) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	meta, err := a.loadMeta(ctx)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	return *meta, nil
+}
+
+// HasVersion returns whether the given version is archived.
+func (a *Archive) HasVersion(ctx context.Context, version int64) (bool, error) {
+	meta, err := a.Meta(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return meta.FirstVersion > 0 && meta.FirstVersion <= version && version <= meta.LatestVersion, nil
+}
+
+// IsCheckpoint returns whether the full state of the stores must be archived at
+// the given version, which must be the version following the latest archived
+// one, if any.
+func (a *Archive) IsCheckpoint(ctx context.Context, version int64) (bool, error) {
+	meta, err := a.Meta(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return meta.LatestVersion == 0 || isCheckpoint(&meta, version), nil
+}
+
+// WriteCheckpoint archives the full state of a store at a version, whose
+// key-value pairs are emitted by the given export function.
+func (a *Archive) WriteCheckpoint(
+	ctx context.Context, version int64, storeName string,
+	export func(emit func(key, value []byte) error) error,
+) error {
+	return a.writeObject(ctx, objectName(version, storeName, "checkpoint"), func(w protoio.Writer) error {
+		return export(func(key, value []byte) error {
+			return w.WriteMsg(&types.StoreKVPair{Key: key, Value: value})
+		})
+	})
+}
+
+// WriteChanges archives the changes of a store at a version, i.e. the keys set
+// or deleted since the previous version. Nothing is written if there are no
+// changes.
+func (a *Archive) WriteChanges(ctx context.Context, version int64, storeName string, changes []*types.StoreKVPair) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return a.writeObject(ctx, objectName(version, storeName, "changes"), func(w protoio.Writer) error {
+		for _, change := range changes {
+			if err := w.WriteMsg(change); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Commit records the given version as archived, once the checkpoints or
+// changes of all its stores are written. The version must follow the latest
+// archived one, if any.
+func (a *Archive) Commit(ctx context.Context, version int64) error {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	meta, err := a.loadMeta(ctx)
+	if err != nil {
+		return err
+	}
+	if meta.LatestVersion != 0 && version != meta.LatestVersion+1 {
+		return fmt.Errorf("archived versions must be contiguous: got %d, expected %d", version, meta.LatestVersion+1)
+	}
+
+	updated := *meta
+	if updated.FirstVersion == 0 {
+		updated.FirstVersion = version
+	}
+	updated.LatestVersion = version
+
+	bz, err := json.Marshal(updated)
+	if err != nil {
+		return err
+	}
+	if err := a.backend.Put(ctx, metaObject, bytes.NewReader(bz), int64(len(bz))); err != nil {
+		return err
+	}
+	a.meta = &updated
+
+	return nil
+}
+
+// LoadStore returns the state of a store at an archived version, rebuilt in
+// memory. The returned store must not be written to.
+func (a *Archive) LoadStore(ctx context.Context, version int64, storeName string) (types.KVStore, error) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	meta, err := a.loadMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if meta.FirstVersion == 0 || version < meta.FirstVersion || version > meta.LatestVersion {
+		return nil, fmt.Errorf("version %d is not archived", version)
+	}
+
+	if a.loadedVersion != version {
+		a.loaded = make(map[string]*dbm.MemDB)
+		a.loadedVersion = version
+	}
+	if db, ok := a.loaded[storeName]; ok {
+		return dbadapter.Store{DB: db}, nil
+	}
+
+	db := dbm.NewMemDB()
+	apply := func(pair *types.StoreKVPair) error {
+		if pair.Delete {
+			return db.Delete(pair.Key)
+		}
+		return db.Set(pair.Key, pair.Value)
+	}
+
+	checkpoint := checkpointOf(meta, version)
+	if err := a.readObject(ctx, objectName(checkpoint, storeName, "checkpoint"), apply); err != nil {
+		return nil, err
+	}
+	for v := checkpoint + 1; v <= version; v++ {
+		if err := a.readObject(ctx, objectName(v, storeName, "changes"), apply); err != nil {
+			return nil, err
+		}
+	}
+	a.loaded[storeName] = db
+
+	return dbadapter.Store{DB: db}, nil
+}
+
+// loadMeta returns the metadata of the archive, reading it from the backend on
+// first use. It must be called with the mutex held.
+func (a *Archive) loadMeta(ctx context.Context) (*Meta, error) {
+	if a.meta != nil {
+		return a.meta, nil
+	}
+
+	r, err := a.backend.Get(ctx, metaObject)
+	if errors.Is(err, ErrNotFound) {
+		a.meta = &Meta{CheckpointInterval: a.checkpointInterval}
+		return a.meta, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	meta := &Meta{}
+	if err := json.NewDecoder(r).Decode(meta); err != nil {
+		return nil, fmt.Errorf("invalid archive metadata: %w", err)
+	}
+	a.meta = meta
+
+	return meta, nil
+}
+
+// writeObject writes an object of length-prefixed messages compressed with
+// zlib. The object is written to a temporary file first, as the backend needs
+// its size and the checkpoints may not fit in memory.
+func (a *Archive) writeObject(ctx context.Context, name string, write func(protoio.Writer) error) (err error) {
+	f, err := os.CreateTemp("", "archive-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		f.Close()
+		os.Remove(f.Name())
+	}()
+
+	bufWriter := bufio.NewWriter(f)
+	zWriter := zlib.NewWriter(bufWriter)
+	if err := write(protoio.NewDelimitedWriter(zWriter)); err != nil {
+		return err
+	}
+	if err := zWriter.Close(); err != nil {
+		return err
+	}
+	if err := bufWriter.Flush(); err != nil {
+		return err
+	}
+
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	return a.backend.Put(ctx, name, f, size)
+}
+
+// readObject reads the messages of an object written by writeObject. A missing
+// object is read as empty, as the stores without changes at a version, or not
+// existing at a checkpoint, have no object.
+func (a *Archive) readObject(ctx context.Context, name string, fn func(*types.StoreKVPair) error) error {
+	r, err := a.backend.Get(ctx, name)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	zReader, err := zlib.NewReader(bufio.NewReader(r))
+	if err != nil {
+		return fmt.Errorf("object %s: %w", name, err)
+	}
+	defer zReader.Close()
+
+	protoReader := protoio.NewDelimitedReader(zReader, maxPairSize)
+	for {
+		pair := &types.StoreKVPair{}
+		err := protoReader.ReadMsg(pair)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("object %s: %w", name, err)
+		}
+		if err := fn(pair); err != nil {
+			return err
+		}
+	}
+}
+
+func objectName(version int64, storeName, kind string) string {
+	return fmt.Sprintf("%d/%s/%s", version, storeName, kind)
+}
+
+func isCheckpoint(meta *Meta, version int64) bool {
+	return version == meta.FirstVersion ||
+		(meta.CheckpointInterval > 0 && uint64(version)%meta.CheckpointInterval == 0)
+}
+
+// checkpointOf returns the latest checkpoint at or before an archived version.
+func checkpointOf(meta *Meta, version int64) int64 {
+	if meta.CheckpointInterval == 0 {
+		return meta.FirstVersion
+	}
+
+	checkpoint := version - int64(uint64(version)%meta.CheckpointInterval)
+	if checkpoint < meta.FirstVersion {
+		return meta.FirstVersion
+	}
+
+	return checkpoint
+}
@@ -0,0 +1,114 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/types"
+)
+
+func TestArchive(t *testing.T) {
+	ctx := context.Background()
+	backend := NewFSBackend(t.TempDir())
+	a := NewArchive(backend, 3)
+
+	// version 1 is the first checkpoint, and 3 the next one
+	state := map[string]string{}
+	for version := int64(1); version <= 5; version++ {
+		changes := []*types.StoreKVPair{{Key: []byte("key"), Value: []byte(fmt.Sprint(version))}}
+		if version == 4 {
+			changes = append(changes, &types.StoreKVPair{Key: []byte("first"), Delete: true})
+		}
+		if version == 1 {
+			changes = append(changes, &types.StoreKVPair{Key: []byte("first"), Value: []byte("value")})
+		}
+		for _, change := range changes {
+			if change.Delete {
+				delete(state, string(change.Key))
+			} else {
+				state[string(change.Key)] = string(change.Value)
+			}
+		}
+
+		checkpoint, err := a.IsCheckpoint(ctx, version)
+		require.NoError(t, err)
+		require.Equal(t, version == 1 || version == 3, checkpoint, "version %d", version)
+		if checkpoint {
+			err = a.WriteCheckpoint(ctx, version, "store", func(emit func(key, value []byte) error) error {
+				for key, value := range state {
+					if err := emit([]byte(key), []byte(value)); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		} else {
+			err = a.WriteChanges(ctx, version, "store", changes)
+		}
+		require.NoError(t, err)
+		require.NoError(t, a.Commit(ctx, version))
+	}
+	require.ErrorContains(t, a.Commit(ctx, 7), "archived versions must be contiguous")
+
+	// the metadata is persisted in the backend
+	a = NewArchive(backend, 10)
+	meta, err := a.Meta(ctx)
+	require.NoError(t, err)
+	require.Equal(t, Meta{FirstVersion: 1, LatestVersion: 5, CheckpointInterval: 3}, meta)
+
+	for version := int64(1); version <= 5; version++ {
+		store, err := a.LoadStore(ctx, version, "store")
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprint(version)), store.Get([]byte("key")), "version %d", version)
+		require.Equal(t, version < 4, store.Has([]byte("first")), "version %d", version)
+
+		archived, err := a.HasVersion(ctx, version)
+		require.NoError(t, err)
+		require.True(t, archived)
+	}
+
+	// the stores without objects are empty
+	store, err := a.Store(ctx, 2, "other")
+	require.NoError(t, err)
+	iter := store.Iterator(nil, nil)
+	require.False(t, iter.Valid())
+	require.NoError(t, iter.Close())
+	require.Panics(t, func() { store.Set([]byte("key"), []byte("value")) })
+
+	archived, err := a.HasVersion(ctx, 6)
+	require.NoError(t, err)
+	require.False(t, archived)
+	_, err = a.LoadStore(ctx, 6, "store")
+	require.ErrorContains(t, err, "version 6 is not archived")
+	_, err = a.Store(ctx, 6, "store")
+	require.ErrorContains(t, err, "failed to load store store at archived version 6")
+}
+
+func TestNewBackend(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "access")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+
+	backend, err := NewBackend("file:///var/archive")
+	require.NoError(t, err)
+	require.Equal(t, NewFSBackend("/var/archive"), backend)
+
+	backend, err = NewBackend("s3://bucket/prefix/?region=eu-west-1")
+	require.NoError(t, err)
+	s3 := backend.(*S3Backend)
+	require.Equal(t, "https://s3.eu-west-1.amazonaws.com", s3.cfg.Endpoint)
+	require.Equal(t, "bucket", s3.cfg.Bucket)
+	require.Equal(t, "prefix", s3.cfg.Prefix)
+
+	backend, err = NewBackend("s3://bucket?endpoint=http://localhost:9000")
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:9000", backend.(*S3Backend).cfg.Endpoint)
+	require.Equal(t, "us-east-1", backend.(*S3Backend).cfg.Region)
+
+	_, err = NewBackend("gs://bucket")
+	require.ErrorContains(t, err, "unsupported scheme")
+	_, err = NewBackend("s3:///prefix")
+	require.ErrorContains(t, err, "missing bucket")
+}
@@ -0,0 +1,131 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrNotFound is returned by a Backend when an object doesn't exist.
+var ErrNotFound = errors.New("archive object not found")
+
+// Backend is a cold storage backend, e.g. an object store, storing objects by
+// name. Object names are slash-separated paths.
+type Backend interface {
+	// Put stores the object of the given name, whose size bytes are read from r,
+	// replacing any existing object of the same name.
+	Put(ctx context.Context, name string, r io.Reader, size int64) error
+	// Get returns a reader of the object of the given name, or ErrNotFound if it
+	// doesn't exist. The reader must be closed by the caller.
+	Get(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
+// NewBackend returns the backend of the given URL, which is either:
+//
+//   - file:///path/to/dir, a directory of the local filesystem, e.g. a mount of
+//     a network filesystem;
+//   - s3://bucket/prefix?endpoint=https://host&region=region, a bucket of an S3
+//     compatible object store. The endpoint defaults to the AWS endpoint of the
+//     region, and the credentials are read from the AWS_ACCESS_KEY_ID and
+//     AWS_SECRET_ACCESS_KEY environment variables.
+func NewBackend(rawURL string) (Backend, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid archive URL: %w", err)
+	}
+
+	switch u.Scheme {
+	case "file":
+		if u.Path == "" {
+			return nil, fmt.Errorf("invalid archive URL %s: missing path", rawURL)
+		}
+		return NewFSBackend(u.Path), nil
+
+	case "s3":
+		if u.Host == "" {
+			return nil, fmt.Errorf("invalid archive URL %s: missing bucket", rawURL)
+		}
+		query := u.Query()
+		region := query.Get("region")
+		if region == "" {
+			region = "us-east-1"
+		}
+		endpoint := query.Get("endpoint")
+		if endpoint == "" {
+			endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", region)
+		}
+		return NewS3Backend(S3Config{
+			Endpoint:  endpoint,
+			Region:    region,
+			Bucket:    u.Host,
+			Prefix:    strings.Trim(u.Path, "/"),
+			AccessKey: os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		})
+
+	default:
+		return nil, fmt.Errorf("invalid archive URL %s: unsupported scheme %q", rawURL, u.Scheme)
+	}
+}
+
+var _ Backend = (*FSBackend)(nil)
+
+// FSBackend is a Backend storing the objects as files of a local directory.
+type FSBackend struct {
+	dir string
+}
+
+// NewFSBackend returns a new FSBackend storing the objects in the given
+// directory, which is created if needed.
+func NewFSBackend(dir string) *FSBackend {
+	return &FSBackend{dir: dir}
+}
+
+// Put implements Backend. The object is written to a temporary file renamed
+// once complete, so that partial objects are never read.
+func (b *FSBackend) Put(_ context.Context, name string, r io.Reader, size int64) error {
+	path := b.path(name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	written, err := io.Copy(f, r)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	if written != size {
+		_ = f.Close()
+		return fmt.Errorf("object %s: wrote %d bytes, expected %d", name, written, size)
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+// Get implements Backend.
+func (b *FSBackend) Get(_ context.Context, name string) (io.ReadCloser, error) {
+	f, err := os.Open(b.path(name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+
+	return f, err
+}
+
+func (b *FSBackend) path(name string) string {
+	return filepath.Join(b.dir, filepath.FromSlash(name))
+}
@@ -0,0 +1,204 @@
+package archive
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// unsignedPayload is the payload hash of the requests whose body isn't signed,
+// so that the objects are streamed without being hashed first.
+const unsignedPayload = "UNSIGNED-PAYLOAD"
+
+// S3Config is the configuration of an S3Backend.
+type S3Config struct {
+	// Endpoint is the base URL of the object store, e.g. https://s3.us-east-1.amazonaws.com.
+	Endpoint string
+	// Region is the region the requests are signed for.
+	Region string
+	// Bucket is the bucket of the objects, addressed in the path of the requests.
+	Bucket string
+	// Prefix is the prefix of the names of the objects in the bucket, if any.
+	Prefix string
+	// AccessKey and SecretKey are the credentials the requests are signed with.
+	AccessKey string
+	SecretKey string
+	// Client is the HTTP client of the requests, http.DefaultClient if nil.
+	Client *http.Client
+}
+
+var _ Backend = (*S3Backend)(nil)
+
+// S3Backend is a Backend storing the objects in a bucket of an S3 compatible
+// object store, using path-style requests signed with AWS Signature Version 4.
+type S3Backend struct {
+	cfg      S3Config
+	endpoint *url.URL
+}
+
+// NewS3Backend returns a new S3Backend of the given configuration.
+func NewS3Backend(cfg S3Config) (*S3Backend, error) {
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid S3 endpoint: %w", err)
+	}
+	if endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid S3 endpoint %q", cfg.Endpoint)
+	}
+	if cfg.Bucket == "" {
+		return nil, errors.New("missing S3 bucket")
+	}
+	if cfg.AccessKey == "" || cfg.SecretKey == "" {
+		return nil, errors.New("missing S3 credentials")
+	}
+	if cfg.Client == nil {
+		cfg.Client = http.DefaultClient
+	}
+
+	return &S3Backend{cfg: cfg, endpoint: endpoint}, nil
+}
+
+// Put implements Backend.
+func (b *S3Backend) Put(ctx context.Context, name string, r io.Reader, size int64) error {
+	req, err := b.newRequest(ctx, http.MethodPut, name, io.NopCloser(r))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	if size == 0 {
+		req.Body = http.NoBody
+	}
+
+	resp, err := b.cfg.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return responseError(resp, name)
+	}
+
+	return nil
+}
+
+// Get implements Backend.
+func (b *S3Backend) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	req, err := b.newRequest(ctx, http.MethodGet, name, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := b.cfg.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, ErrNotFound
+	default:
+		defer resp.Body.Close()
+		return nil, responseError(resp, name)
+	}
+}
+
+// newRequest returns a signed request of the given object.
+func (b *S3Backend) newRequest(ctx context.Context, method, name string, body io.ReadCloser) (*http.Request, error) {
+	key := name
+	if b.cfg.Prefix != "" {
+		key = b.cfg.Prefix + "/" + name
+	}
+
+	u := *b.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + b.cfg.Bucket + "/" + key
+	u.RawPath = uriEncodePath(u.Path)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	signRequest(req, b.cfg.Region, b.cfg.AccessKey, b.cfg.SecretKey, time.Now())
+
+	return req, nil
+}
+
+// signRequest signs a request of the s3 service with AWS Signature Version 4,
+// setting its x-amz-date, x-amz-content-sha256 and Authorization headers.
+func signRequest(req *http.Request, region, accessKey, secretKey string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", unsignedPayload)
+
+	const signedHeaders = "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		"host:" + req.URL.Host + "\n" +
+			"x-amz-content-sha256:" + unsignedPayload + "\n" +
+			"x-amz-date:" + amzDate + "\n",
+		signedHeaders,
+		unsignedPayload,
+	}, "\n")
+
+	scope := date + "/" + region + "/s3/aws4_request"
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalHash[:])
+	signature := hmacSHA256(signingKey(secretKey, date, region, "s3"), []byte(stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKey, scope, signedHeaders, hex.EncodeToString(signature),
+	))
+}
+
+// signingKey derives the key of the signatures of a service in a region at the
+// given date (YYYYMMDD).
+func signingKey(secretKey, date, region, service string) []byte {
+	key := hmacSHA256([]byte("AWS4"+secretKey), []byte(date))
+	key = hmacSHA256(key, []byte(region))
+	key = hmacSHA256(key, []byte(service))
+	return hmacSHA256(key, []byte("aws4_request"))
+}
+
+func hmacSHA256(key, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+// uriEncodePath encodes a path as required by AWS Signature Version 4: every
+// byte but the unreserved characters and the slashes is percent-encoded.
+func uriEncodePath(path string) string {
+	var sb strings.Builder
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/':
+			sb.WriteByte(c)
+		default:
+			fmt.Fprintf(&sb, "%%%02X", c)
+		}
+	}
+
+	return sb.String()
+}
+
+func responseError(resp *http.Response, name string) error {
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("object %s: unexpected status %s: %s", name, resp.Status, strings.TrimSpace(string(msg)))
+}
@@ -0,0 +1,81 @@
+package archive
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigningKey(t *testing.T) {
+	// example of the AWS Signature Version 4 documentation
+	key := signingKey("wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "20120215", "us-east-1", "iam")
+	require.Equal(t, "f4780e2d9f65fa895f9c67b32ce1baf0b0d8a43505a000a1a9e090d414db404d", hex.EncodeToString(key))
+}
+
+func TestS3Backend(t *testing.T) {
+	var mtx sync.Mutex
+	objects := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=access/") ||
+			!strings.Contains(auth, "/us-east-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=") ||
+			r.Header.Get("x-amz-date") == "" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		mtx.Lock()
+		defer mtx.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			bz, _ := io.ReadAll(r.Body)
+			objects[r.URL.EscapedPath()] = bz
+		case http.MethodGet:
+			bz, ok := objects[r.URL.EscapedPath()]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(bz)
+		}
+	}))
+	defer server.Close()
+
+	backend, err := NewS3Backend(S3Config{
+		Endpoint:  server.URL,
+		Region:    "us-east-1",
+		Bucket:    "bucket",
+		Prefix:    "chain",
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, backend.Put(ctx, "1/store 1/changes", strings.NewReader("data"), 4))
+	require.Contains(t, objects, "/bucket/chain/1/store%201/changes")
+
+	r, err := backend.Get(ctx, "1/store 1/changes")
+	require.NoError(t, err)
+	bz, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, "data", string(bz))
+
+	_, err = backend.Get(ctx, "2/store/changes")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	backend.cfg.SecretKey = ""
+	backend.cfg.AccessKey = "other"
+	require.ErrorContains(t, backend.Put(ctx, "name", strings.NewReader(""), 0), "403 Forbidden")
+
+	_, err = NewS3Backend(S3Config{Endpoint: server.URL, Bucket: "bucket"})
+	require.ErrorContains(t, err, "missing S3 credentials")
+}
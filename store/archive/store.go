@@ -0,0 +1,78 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+)
+
+var _ types.KVStore = &Store{}
+
+// Store is a read-only KVStore of a store at an archived version, rebuilt in
+// memory from the archive.
+type Store struct {
+	parent types.KVStore
+}
+
+// Store returns the read-only KVStore of a store at an archived version. The
+// store is rebuilt when returned, so that an archive failure is returned rather
+// than raised by the KVStore methods.
+func (a *Archive) Store(ctx context.Context, version int64, storeName string) (*Store, error) {
+	parent, err := a.LoadStore(ctx, version, storeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load store %s at archived version %d: %w", storeName, version, err)
+	}
+
+	return &Store{parent: parent}, nil
+}
+
+// Get implements the KVStore interface.
+func (s *Store) Get(key []byte) []byte {
+	return s.parent.Get(key)
+}
+
+// Has implements the KVStore interface.
+func (s *Store) Has(key []byte) bool {
+	return s.parent.Has(key)
+}
+
+// Set implements the KVStore interface. It panics as an archived store is
+// read-only.
+func (s *Store) Set(_, _ []byte) {
+	panic("cannot write to an archived store")
+}
+
+// Delete implements the KVStore interface. It panics as an archived store is
+// read-only.
+func (s *Store) Delete(_ []byte) {
+	panic("cannot delete from an archived store")
+}
+
+// Iterator implements the KVStore interface.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return s.parent.ReverseIterator(start, end)
+}
+
+// GetStoreType implements the KVStore interface.
+func (s *Store) GetStoreType() types.StoreType {
+	return types.StoreTypeDB
+}
+
+// CacheWrap implements the KVStore interface.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
@@ -9,6 +9,9 @@ import (
 // StoreMetrics defines the set of metrics for the store package
 type StoreMetrics interface {
 	MeasureSince(keys ...string)
+	SetGauge(val float32, keys ...string)
+	SetGaugeWithLabels(keys []string, val float32, labels []metrics.Label)
+	IncrCounterWithLabels(keys []string, val float32, labels []metrics.Label)
 }
 
 var (
@@ -44,6 +47,24 @@ func (m Metrics) MeasureSince(keys ...string) {
 	metrics.MeasureSinceWithLabels(keys, start.UTC(), m.Labels)
 }
 
+// SetGauge provides a wrapper functionality for emitting a gauge metric with
+// global labels (if any).
+func (m Metrics) SetGauge(val float32, keys ...string) {
+	metrics.SetGaugeWithLabels(keys, val, m.Labels)
+}
+
+// SetGaugeWithLabels provides a wrapper functionality for emitting a gauge
+// metric with the given labels and global labels (if any).
+func (m Metrics) SetGaugeWithLabels(keys []string, val float32, labels []metrics.Label) {
+	metrics.SetGaugeWithLabels(keys, val, append(labels, m.Labels...))
+}
+
+// IncrCounterWithLabels provides a wrapper functionality for emitting a counter
+// metric with the given labels and global labels (if any).
+func (m Metrics) IncrCounterWithLabels(keys []string, val float32, labels []metrics.Label) {
+	metrics.IncrCounterWithLabels(keys, val, append(labels, m.Labels...))
+}
+
 // NoOpMetrics is a no-op implementation of the StoreMetrics interface
 type NoOpMetrics struct{}
 
@@ -54,3 +75,12 @@ func NewNoOpMetrics() NoOpMetrics {
 
 // MeasureSince is a no-op implementation of the StoreMetrics interface to avoid time.Now() calls
 func (m NoOpMetrics) MeasureSince(keys ...string) {}
+
+// SetGauge is a no-op implementation of the StoreMetrics interface
+func (m NoOpMetrics) SetGauge(val float32, keys ...string) {}
+
+// SetGaugeWithLabels is a no-op implementation of the StoreMetrics interface
+func (m NoOpMetrics) SetGaugeWithLabels(keys []string, val float32, labels []metrics.Label) {}
+
+// IncrCounterWithLabels is a no-op implementation of the StoreMetrics interface
+func (m NoOpMetrics) IncrCounterWithLabels(keys []string, val float32, labels []metrics.Label) {}
@@ -0,0 +1,297 @@
+package rootmulti
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	iavltree "github.com/cosmos/iavl"
+
+	"cosmossdk.io/store/archive"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/types"
+)
+
+// SetArchive sets the archive the versions of the IAVL stores are written to
+// before being pruned, and read from by the historical queries of the pruned
+// versions, so that only the recent versions are kept locally.
+func (rs *Store) SetArchive(archive *archive.Archive) {
+	rs.stopArchiving()
+	rs.archive = archive
+}
+
+// stopArchiving stops the archiving worker, if running, waiting for the version
+// being archived. It is restarted by the next pruning.
+func (rs *Store) stopArchiving() {
+	if rs.archiver != nil {
+		rs.archiver.stop()
+		rs.archiver = nil
+	}
+}
+
+// archiveVersions schedules the archiving of the versions up to the given
+// height, and returns the height the versions are archived up to, which are the
+// only ones which can be pruned.
+func (rs *Store) archiveVersions(height int64) int64 {
+	if rs.archiver == nil {
+		rs.archiver = newArchiver(rs, rs.archive)
+	}
+	rs.archiver.schedule(height)
+
+	return rs.archiver.archivedHeight()
+}
+
+// archiver archives the versions of the IAVL stores of a multi store in a
+// background goroutine, so that the uploads to the archive never delay the
+// commits nor the pruning. The versions are archived in order, and the
+// archiving is retried at the next pruning if it fails.
+type archiver struct {
+	rs      *Store
+	archive *archive.Archive
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mtx sync.Mutex
+	// target is the height to archive the versions up to.
+	target int64
+	// archived is the height the versions are archived up to, which is -1 until
+	// the metadata of the archive is loaded.
+	archived atomic.Int64
+
+	wake chan struct{}
+	done chan struct{}
+}
+
+func newArchiver(rs *Store, archive *archive.Archive) *archiver {
+	ctx, cancel := context.WithCancel(context.Background())
+	a := &archiver{
+		rs:      rs,
+		archive: archive,
+		ctx:     ctx,
+		cancel:  cancel,
+		wake:    make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	a.archived.Store(-1)
+	go a.run()
+
+	return a
+}
+
+// schedule requests the archiving of the versions up to the given height.
+func (a *archiver) schedule(height int64) {
+	a.mtx.Lock()
+	if height > a.target {
+		a.target = height
+	}
+	a.mtx.Unlock()
+
+	select {
+	case a.wake <- struct{}{}:
+	default:
+	}
+}
+
+// archivedHeight returns the height the versions are archived up to, or 0 if
+// not known yet.
+func (a *archiver) archivedHeight() int64 {
+	return max(a.archived.Load(), 0)
+}
+
+// stop stops the archiving, cancelling the pending uploads, and waits for the
+// worker to exit.
+func (a *archiver) stop() {
+	a.cancel()
+	<-a.done
+}
+
+func (a *archiver) run() {
+	defer close(a.done)
+
+	for {
+		select {
+		case <-a.wake:
+		case <-a.ctx.Done():
+			return
+		}
+
+		a.mtx.Lock()
+		target := a.target
+		a.mtx.Unlock()
+
+		if err := a.archiveTo(target); err != nil && a.ctx.Err() == nil {
+			a.rs.logger.Error("failed to archive versions, they are not pruned until archived", "height", target, "err", err)
+		}
+	}
+}
+
+// archiveTo archives the versions up to the given height which are not archived
+// yet. The first archived version is the earliest version of the stores.
+func (a *archiver) archiveTo(height int64) error {
+	meta, err := a.archive.Meta(a.ctx)
+	if err != nil {
+		return err
+	}
+	a.archived.Store(meta.LatestVersion)
+	if meta.LatestVersion >= height {
+		return nil
+	}
+
+	names, stores := a.iavlStores()
+
+	from := meta.LatestVersion + 1
+	if meta.LatestVersion == 0 {
+		from = height + 1
+		for _, store := range stores {
+			if earliest := earliestVersion(store, height); earliest > 0 && earliest < from {
+				from = earliest
+			}
+		}
+	}
+
+	for version := from; version <= height; version++ {
+		checkpoint, err := a.archive.IsCheckpoint(a.ctx, version)
+		if err != nil {
+			return err
+		}
+
+		exists := false
+		for _, name := range names {
+			store := stores[name]
+			if !store.VersionExists(version) {
+				// the store didn't exist at this version, e.g. added by an upgrade
+				continue
+			}
+			exists = true
+
+			if checkpoint {
+				err = a.archive.WriteCheckpoint(a.ctx, version, name, func(emit func(key, value []byte) error) error {
+					return exportState(store, version, emit)
+				})
+			} else {
+				var changes []*types.StoreKVPair
+				changes, err = stateChanges(store, version)
+				if err == nil {
+					err = a.archive.WriteChanges(a.ctx, version, name, changes)
+				}
+			}
+			if err != nil {
+				return fmt.Errorf("failed to archive store %s at version %d: %w", name, version, err)
+			}
+		}
+		if !exists {
+			return fmt.Errorf("failed to archive version %d: %w", version, iavltree.ErrVersionDoesNotExist)
+		}
+
+		if err := a.archive.Commit(a.ctx, version); err != nil {
+			return err
+		}
+		a.archived.Store(version)
+	}
+
+	return nil
+}
+
+// iavlStores returns the IAVL stores of the multi store by name, with their
+// sorted names. They are read while no commit is in progress.
+func (a *archiver) iavlStores() ([]string, map[string]*iavl.Store) {
+	a.rs.pruningMtx.Lock()
+	defer a.rs.pruningMtx.Unlock()
+
+	names := make([]string, 0, len(a.rs.stores))
+	stores := make(map[string]*iavl.Store, len(a.rs.stores))
+	for key, store := range a.rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL || a.rs.removalMap[key] {
+			continue
+		}
+		names = append(names, key.Name())
+		stores[key.Name()] = a.rs.GetCommitKVStore(key).(*iavl.Store)
+	}
+	sort.Strings(names)
+
+	return names, stores
+}
+
+// archivedStore returns the store of the given key at a version which is not
+// available locally, from the archive, if archived.
+func (rs *Store) archivedStore(key types.StoreKey, version int64) (types.KVStore, bool, error) {
+	if rs.archive == nil {
+		return nil, false, nil
+	}
+
+	archived, err := rs.archive.HasVersion(context.Background(), version)
+	if err != nil || !archived {
+		return nil, false, err
+	}
+
+	store, err := rs.archive.Store(context.Background(), version, key.Name())
+	if err != nil {
+		return nil, false, err
+	}
+
+	return store, true, nil
+}
+
+// earliestVersion returns the earliest version of an IAVL store up to the given
+// height, or 0 if there is none. As the versions of a store are contiguous, it
+// is searched by bisection.
+func earliestVersion(store *iavl.Store, height int64) int64 {
+	if !store.VersionExists(height) {
+		return 0
+	}
+
+	low, high := int64(1), height
+	for low < high {
+		mid := low + (high-low)/2
+		if store.VersionExists(mid) {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+
+	return low
+}
+
+// exportState emits the key-value pairs of an IAVL store at the given version.
+func exportState(store *iavl.Store, version int64, emit func(key, value []byte) error) error {
+	exporter, err := store.Export(version)
+	if err != nil {
+		return err
+	}
+	defer exporter.Close()
+
+	for {
+		node, err := exporter.Next()
+		if errors.Is(err, iavltree.ErrorExportDone) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if node.Height == 0 {
+			if err := emit(node.Key, node.Value); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// stateChanges returns the changes of an IAVL store at the given version, i.e.
+// the keys set or deleted since the previous version.
+func stateChanges(store *iavl.Store, version int64) ([]*types.StoreKVPair, error) {
+	var changes []*types.StoreKVPair
+	err := store.TraverseStateChanges(version, version, func(_ int64, changeSet *iavltree.ChangeSet) error {
+		for _, pair := range changeSet.Pairs {
+			changes = append(changes, &types.StoreKVPair{Delete: pair.Delete, Key: pair.Key, Value: pair.Value})
+		}
+		return nil
+	})
+
+	return changes, err
+}
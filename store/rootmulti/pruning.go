@@ -0,0 +1,188 @@
+package rootmulti
+
+import (
+	"sync"
+	"time"
+)
+
+// backgroundPruner prunes the stores of a multi store in a background goroutine,
+// so that the pruning doesn't delay the commits. The versions are pruned one at
+// a time, at most rateLimit per second if set. As the earliest version of the
+// stores is not known, the versions up to the first pruning height are pruned at
+// once.
+type backgroundPruner struct {
+	rs        *Store
+	rateLimit uint64
+
+	mtx sync.Mutex
+	// target is the height to prune the stores up to, and pruned the height the
+	// stores are pruned up to.
+	target int64
+	pruned int64
+
+	wake chan struct{}
+	quit chan struct{}
+	done chan struct{}
+}
+
+func newBackgroundPruner(rs *Store, rateLimit uint64) *backgroundPruner {
+	p := &backgroundPruner{
+		rs:        rs,
+		rateLimit: rateLimit,
+		wake:      make(chan struct{}, 1),
+		quit:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go p.run()
+
+	return p
+}
+
+// prune schedules the pruning of the stores up to the given height.
+func (p *backgroundPruner) prune(height int64) {
+	if height <= 0 {
+		return
+	}
+
+	p.mtx.Lock()
+	if p.pruned == 0 {
+		p.pruned = height - 1
+	}
+	if height > p.target {
+		p.target = height
+	}
+	p.reportBacklog()
+	p.mtx.Unlock()
+
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+// backlog returns the number of versions waiting to be pruned.
+func (p *backgroundPruner) backlog() int64 {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	return p.target - p.pruned
+}
+
+// reportBacklog reports the number of versions waiting to be pruned. It must be
+// called with the mutex held.
+func (p *backgroundPruner) reportBacklog() {
+	if p.rs.metrics != nil {
+		p.rs.metrics.SetGauge(float32(p.target-p.pruned), "store", "pruning", "backlog")
+	}
+}
+
+// stop stops the pruning, waiting for the version being pruned, if any.
+func (p *backgroundPruner) stop() {
+	close(p.quit)
+	<-p.done
+}
+
+func (p *backgroundPruner) run() {
+	defer close(p.done)
+
+	var throttle <-chan time.Time
+	for {
+		if throttle != nil {
+			select {
+			case <-throttle:
+				throttle = nil
+			case <-p.quit:
+				return
+			}
+		}
+
+		p.mtx.Lock()
+		height := p.pruned + 1
+		pending := height <= p.target
+		p.mtx.Unlock()
+
+		if !pending {
+			select {
+			case <-p.wake:
+				continue
+			case <-p.quit:
+				return
+			}
+		}
+
+		select {
+		case <-p.quit:
+			return
+		default:
+		}
+
+		p.pruneTo(height)
+
+		p.mtx.Lock()
+		p.pruned = height
+		p.reportBacklog()
+		p.mtx.Unlock()
+
+		if p.rateLimit > 0 {
+			throttle = time.After(time.Second / time.Duration(p.rateLimit))
+		}
+	}
+}
+
+// pruneTo prunes the stores up to the given height, while no commit is in
+// progress.
+func (p *backgroundPruner) pruneTo(height int64) {
+	p.rs.pruningMtx.Lock()
+	defer p.rs.pruningMtx.Unlock()
+
+	p.rs.logger.Debug("prune start", "height", height)
+	defer p.rs.logger.Debug("prune end", "height", height)
+	if err := p.rs.PruneStores(height); err != nil {
+		p.rs.logger.Error(
+			"failed to prune store, please check your pruning configuration",
+			"err", err,
+		)
+	}
+}
+
+// PinVersion protects the given version from pruning until the returned unpin
+// function is called, e.g. while the version is read in the background. It
+// waits for the version being pruned in the background, if any.
+func (rs *Store) PinVersion(version int64) (unpin func()) {
+	rs.pruningMtx.Lock()
+	defer rs.pruningMtx.Unlock()
+
+	rs.pinsMtx.Lock()
+	defer rs.pinsMtx.Unlock()
+	if rs.pins == nil {
+		rs.pins = make(map[int64]int)
+	}
+	rs.pins[version]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			rs.pinsMtx.Lock()
+			defer rs.pinsMtx.Unlock()
+			if rs.pins[version]--; rs.pins[version] <= 0 {
+				delete(rs.pins, version)
+			}
+		})
+	}
+}
+
+// earliestPinnedVersion returns the earliest version protected from pruning, or
+// 0 if there is none.
+func (rs *Store) earliestPinnedVersion() int64 {
+	rs.pinsMtx.Lock()
+	defer rs.pinsMtx.Unlock()
+
+	var earliest int64
+	for version := range rs.pins {
+		if earliest == 0 || version < earliest {
+			earliest = version
+		}
+	}
+
+	return earliest
+}
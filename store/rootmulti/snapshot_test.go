@@ -233,6 +233,68 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+func TestMultistoreSnapshotRestoreDelta(t *testing.T) {
+	source := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	store1 := source.GetStoreByName("iavl1").(types.CommitKVStore)
+	store2 := source.GetStoreByName("iavl2").(types.CommitKVStore)
+	for i := uint64(0); i < 500; i++ {
+		store1.Set(types.Uint64ToBigEndian(i), []byte{byte(i)})
+		store2.Set(types.Uint64ToBigEndian(i), []byte{byte(i)})
+	}
+	source.Commit()
+
+	store1.Set(types.Uint64ToBigEndian(10), []byte("updated"))
+	store1.Set(types.Uint64ToBigEndian(1000), []byte("added"))
+	store1.Delete(types.Uint64ToBigEndian(250))
+	source.Commit()
+
+	store1.Set(types.Uint64ToBigEndian(499), []byte("updated"))
+	source.GetStoreByName("iavl3").(types.CommitKVStore).Set([]byte("new"), []byte("store"))
+	source.Commit()
+
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	opts := snapshottypes.NewSnapshotOptions(10, 2)
+	manager := snapshots.NewManager(snapshotStore, opts, source, nil, log.NewNopLogger())
+
+	base, err := manager.Create(1)
+	require.NoError(t, err)
+	delta, err := manager.CreateDelta(3, 1)
+	require.NoError(t, err)
+	require.Equal(t, snapshottypes.DeltaFormat, delta.Format)
+	require.Equal(t, uint64(1), delta.Metadata.BaseHeight)
+
+	size := func(snapshot *snapshottypes.Snapshot) (size int) {
+		for i := uint32(0); i < snapshot.Chunks; i++ {
+			chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+			require.NoError(t, err)
+			size += len(chunk)
+		}
+		return size
+	}
+	require.Less(t, size(delta)*4, size(base))
+
+	// the differential snapshot is restored along with its base snapshot
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	targetManager := snapshots.NewManager(snapshotStore, opts, target, nil, log.NewNopLogger())
+	require.NoError(t, targetManager.RestoreLocalSnapshot(3, snapshottypes.DeltaFormat))
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, key := range source.StoreKeysByName() {
+		sourceStore := source.GetStoreByName(key.Name()).(types.CommitKVStore)
+		targetStore := target.GetStoreByName(key.Name()).(types.CommitKVStore)
+		if sourceStore.GetStoreType() == types.StoreTypeIAVL {
+			assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+		}
+	}
+
+	// a differential snapshot can't be restored without its base snapshot
+	require.NoError(t, snapshotStore.Delete(1, snapshottypes.CurrentFormat))
+	err = snapshots.NewManager(snapshotStore, opts, newMultiStoreWithMixedMounts(dbm.NewMemDB()), nil, log.NewNopLogger()).
+		Restore(*delta)
+	require.ErrorIs(t, err, snapshottypes.ErrBaseSnapshotNotFound)
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Helper()
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
@@ -0,0 +1,105 @@
+package rootmulti
+
+import (
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/statskv"
+	"cosmossdk.io/store/types"
+)
+
+// SetStateStats sets whether the statistics of the state of the IAVL stores,
+// i.e. their number of keys, size and writes, are tracked and reported as
+// metrics at commit. It must be called before the stores are loaded.
+func (rs *Store) SetStateStats(enabled bool) {
+	rs.stateStatsEnabled = enabled
+}
+
+// StateStats returns the statistics of the state of the IAVL stores by store
+// name, or nil if they are not tracked.
+func (rs *Store) StateStats() map[string]statskv.Stats {
+	rs.stateStatsMtx.RLock()
+	defer rs.stateStatsMtx.RUnlock()
+
+	if rs.stateStats == nil {
+		return nil
+	}
+
+	stats := make(map[string]statskv.Stats, len(rs.stateStats))
+	for key, tracker := range rs.stateStats {
+		stats[key.Name()] = tracker.Stats()
+	}
+
+	return stats
+}
+
+// initStateStats starts tracking the statistics of the state of the IAVL
+// stores loaded at the given version, counting their keys and size at this
+// version in a background goroutine.
+func (rs *Store) initStateStats(version int64) {
+	if !rs.stateStatsEnabled {
+		return
+	}
+
+	trackers := make(map[types.StoreKey]*statskv.Tracker)
+	stores := make(map[*statskv.Tracker]*iavl.Store)
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL || rs.removalMap[key] {
+			continue
+		}
+
+		tracker := statskv.NewTracker()
+		trackers[key] = tracker
+		if version == 0 {
+			tracker.Init(0, 0)
+		} else {
+			stores[tracker] = rs.GetCommitKVStore(key).(*iavl.Store)
+		}
+	}
+
+	rs.stateStatsMtx.Lock()
+	rs.stateStats = trackers
+	rs.stateStatsMtx.Unlock()
+
+	if len(stores) == 0 {
+		return
+	}
+	go func() {
+		for tracker, store := range stores {
+			keys, bytes, err := countState(store, version)
+			if err != nil {
+				rs.logger.Error("failed to count the state of store", "version", version, "err", err)
+				continue
+			}
+			tracker.Init(keys, bytes)
+		}
+	}()
+}
+
+// reportStateStats reports the statistics of the state of the IAVL stores, if
+// tracked.
+func (rs *Store) reportStateStats() {
+	if rs.metrics == nil {
+		return
+	}
+
+	for key, tracker := range rs.stateStats {
+		tracker.Report(rs.metrics, key.Name())
+	}
+}
+
+// countState returns the number of keys and the total size of the keys and
+// values of an IAVL store at the given version. The version isn't pruned while
+// it is exported.
+func countState(store *iavl.Store, version int64) (keys, bytes int64, err error) {
+	if !store.VersionExists(version) {
+		// the store is empty, e.g. added by an upgrade
+		return 0, 0, nil
+	}
+
+	err = exportState(store, version, func(key, value []byte) error {
+		keys++
+		bytes += int64(len(key) + len(value))
+		return nil
+	})
+
+	return keys, bytes, err
+}
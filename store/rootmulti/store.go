@@ -17,6 +17,7 @@ import (
 	iavltree "github.com/cosmos/iavl"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/archive"
 	"cosmossdk.io/store/cachemulti"
 	"cosmossdk.io/store/dbadapter"
 	"cosmossdk.io/store/iavl"
@@ -26,6 +27,7 @@ import (
 	"cosmossdk.io/store/pruning"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/statskv"
 	"cosmossdk.io/store/tracekv"
 	"cosmossdk.io/store/transient"
 	"cosmossdk.io/store/types"
@@ -73,6 +75,26 @@ type Store struct {
 	listeners           map[types.StoreKey]*types.MemoryListener
 	metrics             metrics.StoreMetrics
 	commitHeader        cmtproto.Header
+
+	// pruningMtx guards the stores against the background pruning.
+	pruningMtx        sync.Mutex
+	backgroundPruning bool
+	pruningRateLimit  uint64
+	pruner            *backgroundPruner
+	// pins are the reference counts of the versions protected from pruning.
+	pinsMtx sync.Mutex
+	pins    map[int64]int
+
+	// stateStats are the trackers of the statistics of the state of the IAVL
+	// stores, if enabled.
+	stateStatsEnabled bool
+	stateStatsMtx     sync.RWMutex
+	stateStats        map[types.StoreKey]*statskv.Tracker
+
+	// archive is the cold storage the versions of the IAVL stores are archived
+	// to before being pruned, if set, by the archiver.
+	archive  *archive.Archive
+	archiver *archiver
 }
 
 var (
@@ -112,6 +134,26 @@ func (rs *Store) SetPruning(pruningOpts pruningtypes.PruningOptions) {
 	rs.pruningManager.SetOptions(pruningOpts)
 }
 
+// SetBackgroundPruning sets whether the stores are pruned in a background
+// worker instead of at commit, pruning at most rateLimit versions per second if
+// rateLimit is not 0.
+func (rs *Store) SetBackgroundPruning(enabled bool, rateLimit uint64) {
+	rs.StopBackgroundPruning()
+	rs.backgroundPruning = enabled
+	rs.pruningRateLimit = rateLimit
+}
+
+// StopBackgroundPruning stops the background pruning and archiving workers, if
+// running, waiting for the version being pruned. They are restarted by the next
+// pruning.
+func (rs *Store) StopBackgroundPruning() {
+	if rs.pruner != nil {
+		rs.pruner.stop()
+		rs.pruner = nil
+	}
+	rs.stopArchiving()
+}
+
 // SetMetrics sets the metrics gatherer for the store package
 func (rs *Store) SetMetrics(metrics metrics.StoreMetrics) {
 	rs.metrics = metrics
@@ -287,7 +329,11 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	}
 
 	rs.lastCommitInfo = cInfo
+	rs.pruningMtx.Lock()
 	rs.stores = newStores
+	rs.pruningMtx.Unlock()
+
+	rs.initStateStats(cInfo.Version)
 
 	// load any snapshot heights we missed from disk to be pruned on the next run
 	if err := rs.pruningManager.LoadSnapshotHeights(rs.db); err != nil {
@@ -489,28 +535,31 @@ func (rs *Store) Commit() types.CommitID {
 	}
 
 	func() { // ensure unpause
+		rs.pruningMtx.Lock()
+		defer rs.pruningMtx.Unlock()
+
 		// set the committing flag on all stores to block the pruning
 		rs.PausePruning(true)
 		// unset the committing flag on all stores to continue the pruning
 		defer rs.PausePruning(false)
 		rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
+
+		// remove remnants of removed stores
+		for sk := range rs.removalMap {
+			if _, ok := rs.stores[sk]; ok {
+				delete(rs.stores, sk)
+				delete(rs.storesParams, sk)
+				delete(rs.keysByName, sk.Name())
+			}
+		}
+
+		// reset the removalMap
+		rs.removalMap = make(map[types.StoreKey]bool)
 	}()
 
 	rs.lastCommitInfo.Timestamp = rs.commitHeader.Time
 	defer rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
 
-	// remove remnants of removed stores
-	for sk := range rs.removalMap {
-		if _, ok := rs.stores[sk]; ok {
-			delete(rs.stores, sk)
-			delete(rs.storesParams, sk)
-			delete(rs.keysByName, sk.Name())
-		}
-	}
-
-	// reset the removalMap
-	rs.removalMap = make(map[types.StoreKey]bool)
-
 	if err := rs.handlePruning(version); err != nil {
 		rs.logger.Error(
 			"failed to prune store, please check your pruning configuration",
@@ -518,6 +567,8 @@ func (rs *Store) Commit() types.CommitID {
 		)
 	}
 
+	rs.reportStateStats()
+
 	return types.CommitID{
 		Version: version,
 		Hash:    rs.lastCommitInfo.Hash(),
@@ -571,6 +622,9 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
 		store := types.KVStore(v)
+		if tracker := rs.stateStats[k]; tracker != nil {
+			store = statskv.NewStore(store, tracker)
+		}
 		// Wire the listenkv.Store to allow listeners to observe the writes from the cache store,
 		// set same listeners on cache store will observe duplicated writes.
 		if rs.ListeningEnabled(k) {
@@ -601,6 +655,16 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 			// version does not exist or is pruned, an error should be returned.
 			var err error
 			cacheStore, err = store.(*iavl.Store).GetImmutable(version)
+			if err != nil {
+				// the version may be pruned locally but archived
+				archivedStore, archived, errArchive := rs.archivedStore(key, version)
+				if errArchive != nil {
+					return nil, errArchive
+				}
+				if archived {
+					cacheStore, err = archivedStore, nil
+				}
+			}
 			// if we got error from loading a module store
 			// we fetch commit info of this version
 			// we use commit info to check if the store existed at this version or not
@@ -673,6 +737,9 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	}
 	store := types.KVStore(s)
 
+	if tracker := rs.stateStats[key]; tracker != nil {
+		store = statskv.NewStore(store, tracker)
+	}
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.getTracingContext())
 	}
@@ -685,6 +752,16 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 
 func (rs *Store) handlePruning(version int64) error {
 	pruneHeight := rs.pruningManager.GetPruningHeight(version)
+	if rs.backgroundPruning {
+		if pruneHeight > 0 && rs.pruner == nil {
+			rs.pruner = newBackgroundPruner(rs, rs.pruningRateLimit)
+		}
+		if rs.pruner != nil {
+			rs.pruner.prune(pruneHeight)
+		}
+		return nil
+	}
+
 	rs.logger.Debug("prune start", "height", version)
 	defer rs.logger.Debug("prune end", "height", version)
 	return rs.PruneStores(pruneHeight)
@@ -692,11 +769,26 @@ func (rs *Store) handlePruning(version int64) error {
 
 // PruneStores prunes all history up to the specific height of the multi store.
 func (rs *Store) PruneStores(pruningHeight int64) (err error) {
+	if pinned := rs.earliestPinnedVersion(); pinned > 0 && pruningHeight >= pinned {
+		// the versions after the pinned one are pruned once it is unpinned
+		pruningHeight = pinned - 1
+	}
 	if pruningHeight <= 0 {
 		rs.logger.Debug("pruning skipped, height is less than or equal to 0")
 		return nil
 	}
 
+	if rs.archive != nil {
+		// the versions are never pruned before being archived, the versions after
+		// the archived ones are pruned once archived
+		if archived := rs.archiveVersions(pruningHeight); pruningHeight > archived {
+			pruningHeight = archived
+		}
+		if pruningHeight <= 0 {
+			return nil
+		}
+	}
+
 	rs.logger.Debug("pruning store", "heights", pruningHeight)
 
 	for key, store := range rs.stores {
@@ -2,8 +2,10 @@ package rootmulti
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"fmt"
+	"io"
 	"testing"
 	"time"
 
@@ -12,11 +14,13 @@ import (
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/store/archive"
 	"cosmossdk.io/store/cachemulti"
 	"cosmossdk.io/store/iavl"
 	sdkmaps "cosmossdk.io/store/internal/maps"
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/statskv"
 	"cosmossdk.io/store/types"
 )
 
@@ -551,6 +555,242 @@ func TestMultiStore_Pruning(t *testing.T) {
 	}
 }
 
+func TestMultiStore_BackgroundPruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 5))
+	ms.SetBackgroundPruning(true, 10)
+	require.NoError(t, ms.LoadLatestVersion())
+	defer ms.StopBackgroundPruning()
+
+	for i := 0; i < 20; i++ {
+		ms.Commit()
+	}
+
+	// the versions are pruned one at a time, at most 10 per second
+	require.NotNil(t, ms.pruner)
+	require.Positive(t, ms.pruner.backlog())
+	_, err := ms.CacheMultiStoreWithVersion(17)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return ms.pruner.backlog() == 0 }, 5*time.Second, 10*time.Millisecond)
+	for v := int64(1); v <= 17; v++ {
+		checkErr := func() bool {
+			_, err := ms.CacheMultiStoreWithVersion(v)
+			return err != nil
+		}
+		require.Eventually(t, checkErr, 1*time.Second, 10*time.Millisecond, "expected error when loading height: %d", v)
+	}
+	for _, v := range []int64{18, 19, 20} {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err, "expected no error when loading height: %d", v)
+	}
+
+	// the pruning is restarted after being stopped
+	ms.StopBackgroundPruning()
+	require.Nil(t, ms.pruner)
+	for i := 0; i < 5; i++ {
+		ms.Commit()
+	}
+	require.NotNil(t, ms.pruner)
+}
+
+func TestMultiStore_PinVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 1))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := 0; i < 3; i++ {
+		ms.Commit()
+	}
+	unpin := ms.PinVersion(3)
+	for i := 0; i < 7; i++ {
+		ms.Commit()
+	}
+
+	// the pinned version and the following ones are not pruned
+	isPruned := func(v int64) func() bool {
+		return func() bool {
+			_, err := ms.CacheMultiStoreWithVersion(v)
+			return err != nil
+		}
+	}
+	require.Eventually(t, isPruned(2), time.Second, 10*time.Millisecond)
+	for v := int64(3); v <= 10; v++ {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err, "expected no error when loading height: %d", v)
+	}
+
+	// the versions are pruned once unpinned
+	unpin()
+	unpin()
+	ms.Commit()
+	for v := int64(3); v <= 8; v++ {
+		require.Eventually(t, isPruned(v), time.Second, 10*time.Millisecond, "expected error when loading height: %d", v)
+	}
+	require.Empty(t, ms.pins)
+}
+
+func TestMultiStore_StateStats(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStateStats(true)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cms := ms.CacheMultiStore()
+	cms.GetKVStore(testStoreKey1).Set([]byte("key1"), []byte("value1"))
+	cms.GetKVStore(testStoreKey1).Set([]byte("key2"), []byte("value2"))
+	cms.Write()
+	ms.GetKVStore(testStoreKey2).Set([]byte("key"), []byte("value"))
+	ms.Commit()
+
+	// the initial state of an empty multistore is counted at once
+	stats := ms.StateStats()
+	require.Len(t, stats, 3)
+	require.Equal(t, statskv.Stats{Initialized: true, Keys: 2, Bytes: 20, Writes: 2, BytesWritten: 20}, stats["store1"])
+
+	// the state of the stores is counted when they are loaded
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStateStats(true)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Eventually(t, func() bool {
+		stats := ms.StateStats()
+		return stats["store1"].Initialized && stats["store2"].Initialized && stats["store3"].Initialized
+	}, time.Second, 10*time.Millisecond)
+
+	ms.GetKVStore(testStoreKey1).Delete([]byte("key1"))
+	stats = ms.StateStats()
+	require.Equal(t, statskv.Stats{Initialized: true, Keys: 1, Bytes: 10, Deletes: 1}, stats["store1"])
+	require.Equal(t, statskv.Stats{Initialized: true, Keys: 1, Bytes: 8}, stats["store2"])
+	require.Equal(t, statskv.Stats{Initialized: true}, stats["store3"])
+
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Nil(t, ms.StateStats())
+}
+
+func TestMultiStore_Archive(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 5))
+	ms.SetArchive(archive.NewArchive(archive.NewFSBackend(t.TempDir()), 4))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for v := 1; v <= 12; v++ {
+		store1 := ms.GetKVStore(testStoreKey1)
+		store1.Set([]byte("key"), []byte(fmt.Sprint(v)))
+		switch v {
+		case 3:
+			store1.Set([]byte("deleted"), []byte("value"))
+		case 6:
+			store1.Delete([]byte("deleted"))
+		}
+		ms.GetKVStore(testStoreKey2).Set([]byte(fmt.Sprintf("key%d", v)), []byte("value"))
+		ms.Commit()
+	}
+
+	// the versions are archived in the background, and pruned at the next
+	// pruning once archived
+	require.Eventually(t, func() bool {
+		meta, err := ms.archive.Meta(context.Background())
+		return err == nil && meta.FirstVersion == 1 && meta.LatestVersion == 7
+	}, time.Second, 10*time.Millisecond)
+	require.True(t, ms.GetCommitKVStore(testStoreKey1).(*iavl.Store).VersionExists(7))
+	for v := 0; v < 3; v++ {
+		ms.Commit()
+	}
+	require.Eventually(t, func() bool {
+		return !ms.GetCommitKVStore(testStoreKey1).(*iavl.Store).VersionExists(7)
+	}, time.Second, 10*time.Millisecond)
+
+	// the pruned versions are read from the archive
+	for v := int64(1); v <= 12; v++ {
+		cms, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err, "version %d", v)
+
+		store1 := cms.GetKVStore(testStoreKey1)
+		require.Equal(t, []byte(fmt.Sprint(v)), store1.Get([]byte("key")), "version %d", v)
+		require.Equal(t, v >= 3 && v < 6, store1.Has([]byte("deleted")), "version %d", v)
+
+		var keys int64
+		iter := cms.GetKVStore(testStoreKey2).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			keys++
+		}
+		require.NoError(t, iter.Close())
+		require.Equal(t, v, keys, "version %d", v)
+	}
+
+	// the versions not archived yet are not pruned
+	ms.SetArchive(archive.NewArchive(failingBackend{}, 4))
+	for v := 0; v < 5; v++ {
+		ms.Commit()
+	}
+	_, err := ms.CacheMultiStoreWithVersion(9)
+	require.NoError(t, err)
+
+	// the archived versions fail to be read if the archive does
+	_, err = ms.CacheMultiStoreWithVersion(2)
+	require.ErrorContains(t, err, "backend failure")
+	ms.StopBackgroundPruning()
+}
+
+func TestMultiStore_ArchiveDoesNotBlockCommits(t *testing.T) {
+	backend := &blockingBackend{Backend: archive.NewFSBackend(t.TempDir()), release: make(chan struct{})}
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(2, 5))
+	ms.SetArchive(archive.NewArchive(backend, 4))
+	ms.SetBackgroundPruning(true, 0)
+	require.NoError(t, ms.LoadLatestVersion())
+	defer ms.StopBackgroundPruning()
+
+	// the commits and the pruning proceed while the uploads are blocked, the
+	// versions not archived are not pruned
+	for v := 1; v <= 20; v++ {
+		ms.GetKVStore(testStoreKey1).Set([]byte("key"), []byte(fmt.Sprint(v)))
+		ms.Commit()
+	}
+	require.Equal(t, int64(20), ms.LastCommitID().Version)
+	require.True(t, ms.GetCommitKVStore(testStoreKey1).(*iavl.Store).VersionExists(1))
+
+	// the versions are pruned at the next pruning height once archived
+	close(backend.release)
+	require.Eventually(t, func() bool {
+		meta, err := ms.archive.Meta(context.Background())
+		return err == nil && meta.LatestVersion == 17
+	}, time.Second, 10*time.Millisecond)
+	for v := 0; v < 5; v++ {
+		ms.Commit()
+	}
+	require.Eventually(t, func() bool {
+		return !ms.GetCommitKVStore(testStoreKey1).(*iavl.Store).VersionExists(17)
+	}, time.Second, 10*time.Millisecond)
+}
+
+// blockingBackend is a backend whose writes are blocked until released.
+type blockingBackend struct {
+	archive.Backend
+	release chan struct{}
+}
+
+func (b *blockingBackend) Put(ctx context.Context, name string, r io.Reader, size int64) error {
+	select {
+	case <-b.release:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return b.Backend.Put(ctx, name, r, size)
+}
+
+type failingBackend struct{}
+
+func (failingBackend) Put(context.Context, string, io.Reader, int64) error {
+	return fmt.Errorf("backend failure")
+}
+
+func (failingBackend) Get(context.Context, string) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("backend failure")
+}
+
 func TestMultiStore_Pruning_SameHeightsTwice(t *testing.T) {
 	const (
 		numVersions int64  = 10
@@ -0,0 +1,295 @@
+package snapshots
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/store/snapshots/types"
+)
+
+// A differential snapshot is the stream of a full snapshot, where the IAVL subtrees unchanged
+// since the height of its base snapshot are replaced by references to the base snapshot.
+//
+// IAVL nodes are immutable: a node of version v <= base height of the tree at the snapshot
+// height is a node of the tree at the base height, along with its subtree. As the nodes are
+// exported in post-order, and the version of a node is greater than or equal to the versions
+// of its children, the unchanged subtrees are contiguous runs of nodes in both exports,
+// starting at their leftmost leaf. A subtree is thus referenced by the key of its leftmost
+// leaf and its number of nodes. The subtrees referenced cover disjoint key ranges in
+// increasing order, so they appear in the same order in the export of the base snapshot,
+// which is read along with the differential snapshot while restoring it.
+
+// snapshotWriter is the writer of the items of a snapshot stream.
+type snapshotWriter interface {
+	protoio.Writer
+	Close() error
+	CloseWithError(err error)
+}
+
+var _ snapshotWriter = (*deltaWriter)(nil)
+
+// deltaSubtree is a complete subtree of the IAVL export, waiting for its parent node.
+type deltaSubtree struct {
+	unchanged bool
+	firstKey  []byte
+	nodes     uint64
+}
+
+// deltaWriter writes a differential snapshot from the items of a full snapshot.
+type deltaWriter struct {
+	*StreamWriter
+	baseHeight int64
+
+	// subtrees is the stack of the subtrees of the current store waiting for their parent,
+	// where the unchanged subtrees from index written on have not been written yet.
+	subtrees []deltaSubtree
+	written  int
+}
+
+func newDeltaWriter(sw *StreamWriter, baseHeight uint64) *deltaWriter {
+	return &deltaWriter{StreamWriter: sw, baseHeight: int64(baseHeight)}
+}
+
+// WriteMsg implements protoio.Writer.
+func (w *deltaWriter) WriteMsg(msg proto.Message) error {
+	item, ok := msg.(*types.SnapshotItem)
+	if !ok {
+		return w.StreamWriter.WriteMsg(msg)
+	}
+
+	node := item.GetIAVL()
+	if node == nil {
+		// the end of the nodes of a store
+		if err := w.flush(); err != nil {
+			return err
+		}
+		w.subtrees, w.written = w.subtrees[:0], 0
+		return w.StreamWriter.WriteMsg(item)
+	}
+
+	subtree := deltaSubtree{unchanged: node.Version <= w.baseHeight, nodes: 1}
+	if !subtree.unchanged {
+		// the parents of the unchanged subtrees waiting for their parent are ancestors of the
+		// node, and thus changed as well: the unchanged subtrees are complete
+		if err := w.flush(); err != nil {
+			return err
+		}
+		if err := w.StreamWriter.WriteMsg(item); err != nil {
+			return err
+		}
+	}
+
+	if node.Height > 0 {
+		if len(w.subtrees) < 2 {
+			return fmt.Errorf("invalid IAVL export: inner node at height %d without children", node.Height)
+		}
+		left, right := w.subtrees[len(w.subtrees)-2], w.subtrees[len(w.subtrees)-1]
+		w.subtrees = w.subtrees[:len(w.subtrees)-2]
+		w.written = min(w.written, len(w.subtrees))
+
+		if subtree.unchanged {
+			if !left.unchanged || !right.unchanged {
+				return fmt.Errorf("invalid IAVL export: node of version %d has a newer child", node.Version)
+			}
+			subtree.firstKey = left.firstKey
+			subtree.nodes += left.nodes + right.nodes
+		}
+	} else if subtree.unchanged {
+		subtree.firstKey = node.Key
+	}
+
+	w.subtrees = append(w.subtrees, subtree)
+	if !subtree.unchanged {
+		w.written = len(w.subtrees)
+	}
+
+	return nil
+}
+
+// flush writes the references of the unchanged subtrees not written yet.
+func (w *deltaWriter) flush() error {
+	for _, subtree := range w.subtrees[w.written:] {
+		if !subtree.unchanged {
+			continue
+		}
+
+		err := w.StreamWriter.WriteMsg(&types.SnapshotItem{
+			Item: &types.SnapshotItem_IAVLSubtree{
+				IAVLSubtree: &types.SnapshotIAVLSubtree{
+					FirstKey: subtree.firstKey,
+					Nodes:    subtree.nodes,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	w.written = len(w.subtrees)
+	return nil
+}
+
+// Close writes the pending references and closes the stream.
+func (w *deltaWriter) Close() error {
+	if err := w.flush(); err != nil {
+		w.CloseWithError(err)
+		return err
+	}
+
+	return w.StreamWriter.Close()
+}
+
+// deltaReader reads the items of the full snapshot of a differential snapshot, replacing the
+// references to the base snapshot with its nodes.
+type deltaReader struct {
+	delta protoio.Reader
+	base  protoio.Reader
+
+	// baseItem is the next item of the base snapshot, if peeked.
+	baseItem *types.SnapshotItem
+	// store is the store of the current items, and baseStore whether the base snapshot is
+	// positioned at its nodes.
+	store     string
+	baseStore bool
+	// copying is the number of nodes of a subtree left to read from the base snapshot.
+	copying uint64
+}
+
+func newDeltaReader(delta, base protoio.Reader) *deltaReader {
+	return &deltaReader{delta: delta, base: base}
+}
+
+// ReadMsg implements protoio.Reader.
+func (r *deltaReader) ReadMsg(msg proto.Message) error {
+	item, ok := msg.(*types.SnapshotItem)
+	if !ok {
+		return fmt.Errorf("unexpected snapshot message %T", msg)
+	}
+
+	if r.copying > 0 {
+		return r.copyNode(item)
+	}
+
+	if err := r.delta.ReadMsg(item); err != nil {
+		return err
+	}
+
+	switch it := item.Item.(type) {
+	case *types.SnapshotItem_Store:
+		r.store = it.Store.Name
+		return r.seekStore()
+
+	case *types.SnapshotItem_IAVLSubtree:
+		if it.IAVLSubtree.Nodes == 0 {
+			return errors.New("invalid snapshot: empty IAVL subtree")
+		}
+		if err := r.seekSubtree(it.IAVLSubtree.FirstKey); err != nil {
+			return err
+		}
+		r.copying = it.IAVLSubtree.Nodes
+		return r.copyNode(item)
+
+	default:
+		return nil
+	}
+}
+
+// copyNode reads the next node of the subtree being copied from the base snapshot.
+func (r *deltaReader) copyNode(item *types.SnapshotItem) error {
+	next, err := r.nextBaseNode()
+	if err != nil {
+		return err
+	}
+	if next == nil {
+		return fmt.Errorf("invalid snapshot: IAVL subtree of store %s truncated in the base snapshot", r.store)
+	}
+
+	*item = *next
+	r.copying--
+	return nil
+}
+
+// seekStore positions the base snapshot at the nodes of the current store, if any.
+func (r *deltaReader) seekStore() error {
+	r.baseStore = false
+	for {
+		next, err := r.peekBase()
+		if err != nil || next == nil {
+			return err
+		}
+
+		store := next.GetStore()
+		switch {
+		case store == nil:
+			// nodes of a previous store
+		case store.Name == r.store:
+			r.baseItem = nil
+			r.baseStore = true
+			return nil
+		case store.Name > r.store:
+			// the stores are sorted by name: the store is not in the base snapshot
+			return nil
+		}
+		r.baseItem = nil
+	}
+}
+
+// seekSubtree positions the base snapshot at the leaf of the given key of the current store.
+func (r *deltaReader) seekSubtree(firstKey []byte) error {
+	for r.baseStore {
+		next, err := r.peekBase()
+		if err != nil {
+			return err
+		}
+		node := next.GetIAVL()
+		if node == nil {
+			break
+		}
+		if node.Height == 0 && bytes.Equal(node.Key, firstKey) {
+			return nil
+		}
+		r.baseItem = nil
+	}
+
+	return fmt.Errorf("invalid snapshot: IAVL subtree of store %s at key %X not found in the base snapshot", r.store, firstKey)
+}
+
+// nextBaseNode returns the next IAVL node of the current store in the base snapshot, or nil.
+func (r *deltaReader) nextBaseNode() (*types.SnapshotItem, error) {
+	if !r.baseStore {
+		return nil, nil
+	}
+
+	next, err := r.peekBase()
+	if err != nil || next == nil || next.GetIAVL() == nil {
+		return nil, err
+	}
+
+	r.baseItem = nil
+	return next, nil
+}
+
+// peekBase returns the next item of the base snapshot, without consuming it, or nil at the
+// end of the base snapshot.
+func (r *deltaReader) peekBase() (*types.SnapshotItem, error) {
+	if r.baseItem != nil {
+		return r.baseItem, nil
+	}
+
+	item := &types.SnapshotItem{}
+	if err := r.base.ReadMsg(item); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read the base snapshot: %w", err)
+	}
+
+	r.baseItem = item
+	return item, nil
+}
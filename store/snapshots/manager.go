@@ -11,6 +11,8 @@ import (
 	"sort"
 	"sync"
 
+	protoio "github.com/cosmos/gogoproto/io"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
@@ -159,6 +161,20 @@ func (m *Manager) GetSnapshotBlockRetentionHeights() int64 {
 
 // Create creates a snapshot and returns its metadata.
 func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
+	return m.create(height, 0)
+}
+
+// CreateDelta creates a differential snapshot based on the snapshot at the given base height,
+// and returns its metadata.
+func (m *Manager) CreateDelta(height, baseHeight uint64) (*types.Snapshot, error) {
+	if baseHeight == 0 || baseHeight >= height {
+		return nil, errorsmod.Wrapf(storetypes.ErrLogic, "invalid base height %v for a snapshot at height %v", baseHeight, height)
+	}
+
+	return m.create(height, baseHeight)
+}
+
+func (m *Manager) create(height, baseHeight uint64) (*types.Snapshot, error) {
 	if m == nil {
 		return nil, errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
 	}
@@ -180,34 +196,53 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	if baseHeight > 0 {
+		base, err := m.store.Get(baseHeight, types.CurrentFormat)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to examine base snapshot")
+		}
+		if base == nil {
+			return nil, errorsmod.Wrapf(types.ErrBaseSnapshotNotFound, "height %v", baseHeight)
+		}
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	go m.createSnapshot(height, baseHeight, ch)
 
+	if baseHeight > 0 {
+		return m.store.SaveDelta(height, baseHeight, ch)
+	}
 	return m.store.Save(height, types.CurrentFormat, ch)
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
-// the produced chunks are written to the channel.
-func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
+// the produced chunks are written to the channel. If the base height is set, a differential
+// snapshot is produced.
+func (m *Manager) createSnapshot(height, baseHeight uint64, ch chan<- io.ReadCloser) {
 	streamWriter := NewStreamWriter(ch)
 	if streamWriter == nil {
 		return
 	}
+
+	var protoWriter snapshotWriter = streamWriter
+	if baseHeight > 0 {
+		protoWriter = newDeltaWriter(streamWriter, baseHeight)
+	}
 	defer func() {
-		if err := streamWriter.Close(); err != nil {
-			streamWriter.CloseWithError(err)
+		if err := protoWriter.Close(); err != nil {
+			protoWriter.CloseWithError(err)
 		}
 	}()
 
-	if err := m.multistore.Snapshot(height, streamWriter); err != nil {
-		streamWriter.CloseWithError(err)
+	if err := m.multistore.Snapshot(height, protoWriter); err != nil {
+		protoWriter.CloseWithError(err)
 		return
 	}
 	for _, name := range m.sortedExtensionNames() {
 		extension := m.extensions[name]
 		// write extension metadata
-		err := streamWriter.WriteMsg(&types.SnapshotItem{
+		err := protoWriter.WriteMsg(&types.SnapshotItem{
 			Item: &types.SnapshotItem_Extension{
 				Extension: &types.SnapshotExtensionMeta{
 					Name:   name,
@@ -216,14 +251,14 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 			},
 		})
 		if err != nil {
-			streamWriter.CloseWithError(err)
+			protoWriter.CloseWithError(err)
 			return
 		}
 		payloadWriter := func(payload []byte) error {
-			return types.WriteExtensionPayload(streamWriter, payload)
+			return types.WriteExtensionPayload(protoWriter, payload)
 		}
 		if err := extension.SnapshotExtension(height, payloadWriter); err != nil {
-			streamWriter.CloseWithError(err)
+			protoWriter.CloseWithError(err)
 			return
 		}
 	}
@@ -274,7 +309,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	if snapshot.Format != types.CurrentFormat && snapshot.Format != types.DeltaFormat {
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -284,6 +319,21 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 		return errorsmod.Wrapf(types.ErrInvalidMetadata,
 			"snapshot height %v cannot exceed %v", snapshot.Height, int64(math.MaxInt64))
 	}
+	if snapshot.Format == types.DeltaFormat {
+		// a differential snapshot is restored along with its base snapshot, which must be
+		// available locally
+		if snapshot.Metadata.BaseHeight == 0 || snapshot.Metadata.BaseHeight >= snapshot.Height {
+			return errorsmod.Wrapf(types.ErrInvalidMetadata,
+				"invalid base height %v for a snapshot at height %v", snapshot.Metadata.BaseHeight, snapshot.Height)
+		}
+		base, err := m.store.Get(snapshot.Metadata.BaseHeight, types.CurrentFormat)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to examine base snapshot")
+		}
+		if base == nil {
+			return errorsmod.Wrapf(types.ErrBaseSnapshotNotFound, "height %v", snapshot.Metadata.BaseHeight)
+		}
+	}
 
 	err := m.beginLocked(opRestore)
 	if err != nil {
@@ -349,10 +399,32 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 	}
 	defer streamReader.Close()
 
+	var protoReader protoio.Reader = streamReader
+	format := snapshot.Format
+	if snapshot.Format == types.DeltaFormat {
+		// the full snapshot is read from the differential snapshot and its base snapshot
+		_, baseChunks, err := m.store.Load(snapshot.Metadata.BaseHeight, types.CurrentFormat)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to load base snapshot")
+		}
+		if baseChunks == nil {
+			return errorsmod.Wrapf(types.ErrBaseSnapshotNotFound, "height %v", snapshot.Metadata.BaseHeight)
+		}
+		baseReader, err := NewStreamReader(baseChunks)
+		if err != nil {
+			DrainChunks(baseChunks)
+			return err
+		}
+		defer baseReader.Close()
+
+		protoReader = newDeltaReader(streamReader, baseReader)
+		format = types.CurrentFormat
+	}
+
 	// payloadReader reads an extension payload for extension snapshotter, it returns `io.EOF` at extension boundaries.
 	payloadReader := func() ([]byte, error) {
 		nextItem.Reset()
-		if err := streamReader.ReadMsg(&nextItem); err != nil {
+		if err := protoReader.ReadMsg(&nextItem); err != nil {
 			return nil, err
 		}
 		payload := nextItem.GetExtensionPayload()
@@ -362,7 +434,7 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return payload.Payload, nil
 	}
 
-	nextItem, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	nextItem, err = m.multistore.Restore(snapshot.Height, format, protoReader)
 	if err != nil {
 		return errorsmod.Wrap(err, "multistore restore")
 	}
@@ -519,7 +591,25 @@ func (m *Manager) SnapshotIfApplicable(height int64) {
 
 // shouldTakeSnapshot returns true is snapshot should be taken at height.
 func (m *Manager) shouldTakeSnapshot(height int64) bool {
-	return m.opts.Interval > 0 && uint64(height)%m.opts.Interval == 0
+	interval := m.opts.SnapshotHeightInterval()
+	return interval > 0 && uint64(height)%interval == 0
+}
+
+// latestFullSnapshot returns the height of the latest full snapshot before the given height,
+// or 0 if there is none.
+func (m *Manager) latestFullSnapshot(height uint64) (uint64, error) {
+	snapshots, err := m.store.List()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, snapshot := range snapshots {
+		if snapshot.Format == types.CurrentFormat && snapshot.Height < height {
+			return snapshot.Height, nil
+		}
+	}
+
+	return 0, nil
 }
 
 func (m *Manager) snapshot(height int64) {
@@ -530,13 +620,29 @@ func (m *Manager) snapshot(height int64) {
 		return
 	}
 
-	snapshot, err := m.Create(uint64(height))
+	var (
+		snapshot *types.Snapshot
+		err      error
+	)
+	if uint64(height)%m.opts.Interval == 0 {
+		snapshot, err = m.Create(uint64(height))
+	} else {
+		var baseHeight uint64
+		baseHeight, err = m.latestFullSnapshot(uint64(height))
+		if err == nil && baseHeight == 0 {
+			m.logger.Debug("differential snapshot is skipped, no base snapshot", "height", height)
+			return
+		}
+		if err == nil {
+			snapshot, err = m.CreateDelta(uint64(height), baseHeight)
+		}
+	}
 	if err != nil {
 		m.logger.Error("failed to create state snapshot", "height", height, "err", err)
 		return
 	}
 
-	m.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
+	m.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format, "base_height", snapshot.Metadata.BaseHeight)
 
 	if m.opts.KeepRecent > 0 {
 		m.logger.Debug("pruning state snapshots")
@@ -247,6 +247,35 @@ func TestManager_Restore(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestManager_Delta(t *testing.T) {
+	store := setupStore(t)
+	snapshotter := &mockSnapshotter{prunedHeights: make(map[int64]struct{})}
+	manager := snapshots.NewManager(store, opts, snapshotter, nil, log.NewNopLogger())
+
+	// CreateDelta errors on an invalid or missing base snapshot
+	_, err := manager.CreateDelta(5, 0)
+	require.Error(t, err)
+	_, err = manager.CreateDelta(5, 5)
+	require.Error(t, err)
+	_, err = manager.CreateDelta(5, 4)
+	require.ErrorIs(t, err, types.ErrBaseSnapshotNotFound)
+
+	// Restore errors on an invalid or missing base snapshot
+	snapshot := types.Snapshot{
+		Height:   5,
+		Format:   types.DeltaFormat,
+		Hash:     []byte{1, 2, 3},
+		Chunks:   1,
+		Metadata: types.Metadata{ChunkHashes: [][]byte{{1}}},
+	}
+	err = manager.Restore(snapshot)
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+
+	snapshot.Metadata.BaseHeight = 4
+	err = manager.Restore(snapshot)
+	require.ErrorIs(t, err, types.ErrBaseSnapshotNotFound)
+}
+
 func TestManager_TakeError(t *testing.T) {
 	snapshotter := &mockErrorSnapshotter{}
 	store, err := snapshots.NewStore(db.NewMemDB(), GetTempDir(t))
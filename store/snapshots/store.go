@@ -188,7 +188,8 @@ func (s *Store) loadChunkFile(height uint64, format, chunk uint32) (io.ReadClose
 	return os.Open(path)
 }
 
-// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained.
+// Prune removes old snapshots. The given number of most recent heights of full snapshots
+// (regardless of format) are retained, along with the differential snapshots based on them.
 func (s *Store) Prune(retain uint32) (uint64, error) {
 	iter, err := s.db.ReverseIterator(encodeKey(0, 0), encodeKey(uint64(math.MaxUint64), math.MaxUint32))
 	if err != nil {
@@ -199,11 +200,21 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 	pruned := uint64(0)
 	prunedHeights := make(map[uint64]bool)
 	skip := make(map[uint64]bool)
+	// the differential snapshots are pruned along with their base snapshot
+	var deltas []*types.Snapshot
 	for ; iter.Valid(); iter.Next() {
 		height, format, err := decodeKey(iter.Key())
 		if err != nil {
 			return 0, errors.Wrap(err, "failed to prune snapshots")
 		}
+		if format == types.DeltaFormat {
+			snapshot := &types.Snapshot{}
+			if err := proto.Unmarshal(iter.Value(), snapshot); err != nil {
+				return 0, errors.Wrap(err, "failed to decode snapshot info")
+			}
+			deltas = append(deltas, snapshot)
+			continue
+		}
 		if skip[height] || uint32(len(skip)) < retain {
 			skip[height] = true
 			continue
@@ -215,6 +226,22 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 		pruned++
 		prunedHeights[height] = true
 	}
+	if err := iter.Error(); err != nil {
+		return 0, errors.Wrap(err, "failed to prune snapshots")
+	}
+
+	for _, delta := range deltas {
+		if skip[delta.Metadata.BaseHeight] {
+			continue
+		}
+		err = s.Delete(delta.Height, delta.Format)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to prune snapshots")
+		}
+		pruned++
+		prunedHeights[delta.Height] = true
+	}
+
 	// Since Delete() deletes a specific format, while we want to prune a height, we clean up
 	// the height directory as well
 	for height, ok := range prunedHeights {
@@ -225,14 +252,31 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 			}
 		}
 	}
-	return pruned, iter.Error()
+	return pruned, nil
 }
 
 // Save saves a snapshot to disk, returning it.
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
 ) (*types.Snapshot, error) {
+	return s.save(&types.Snapshot{Height: height, Format: format}, chunks)
+}
+
+// SaveDelta saves a differential snapshot based on the snapshot at the given base height to
+// disk, returning it.
+func (s *Store) SaveDelta(
+	height, baseHeight uint64, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	return s.save(&types.Snapshot{
+		Height:   height,
+		Format:   types.DeltaFormat,
+		Metadata: types.Metadata{BaseHeight: baseHeight},
+	}, chunks)
+}
+
+func (s *Store) save(snapshot *types.Snapshot, chunks <-chan io.ReadCloser) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
+	height, format := snapshot.Height, snapshot.Format
 	if height == 0 {
 		return nil, errors.Wrap(storetypes.ErrLogic, "snapshot height cannot be 0")
 	}
@@ -260,11 +304,6 @@ func (s *Store) Save(
 			"snapshot already exists for height %v format %v", height, format)
 	}
 
-	snapshot := &types.Snapshot{
-		Height: height,
-		Format: format,
-	}
-
 	dirCreated := false
 	index := uint32(0)
 	snapshotHasher := sha256.New()
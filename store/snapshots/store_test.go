@@ -262,6 +262,35 @@ func TestStore_Prune(t *testing.T) {
 	assert.Empty(t, snapshots)
 }
 
+func TestStore_PruneDelta(t *testing.T) {
+	store, err := snapshots.NewStore(db.NewMemDB(), GetTempDir(t))
+	require.NoError(t, err)
+
+	for _, height := range []uint64{10, 20} {
+		_, err = store.Save(height, types.CurrentFormat, makeChunks([][]byte{{1}}))
+		require.NoError(t, err)
+		for _, delta := range []uint64{height + 2, height + 4} {
+			snapshot, err := store.SaveDelta(delta, height, makeChunks([][]byte{{2}}))
+			require.NoError(t, err)
+			require.Equal(t, types.DeltaFormat, snapshot.Format)
+			require.Equal(t, height, snapshot.Metadata.BaseHeight)
+		}
+	}
+
+	// the differential snapshots are retained along with their base snapshot
+	pruned, err := store.Prune(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, pruned)
+
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 3)
+	for i, height := range []uint64{24, 22, 20} {
+		require.Equal(t, height, snapshots[i].Height)
+	}
+	require.Equal(t, uint64(20), snapshots[0].Metadata.BaseHeight)
+}
+
 func TestStore_Save(t *testing.T) {
 	store := setupStore(t)
 	// Saving a snapshot should work
@@ -16,4 +16,8 @@ var (
 
 	// ErrInvalidSnapshotVersion is returned when the snapshot version is invalid
 	ErrInvalidSnapshotVersion = errors.New("invalid snapshot version")
+
+	// ErrBaseSnapshotNotFound is returned when the base snapshot of a differential snapshot
+	// is not available locally.
+	ErrBaseSnapshotNotFound = errors.New("base snapshot not found")
 )
@@ -4,3 +4,8 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 3
+
+// DeltaFormat is the format of differential snapshots, based on a CurrentFormat snapshot at the
+// height Metadata.BaseHeight. Their IAVL subtrees unchanged since the base height are replaced by
+// SnapshotIAVLSubtree references to the subtrees of the base snapshot.
+const DeltaFormat uint32 = 4
@@ -8,6 +8,10 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// DeltaInterval defines at which heights, between the snapshot intervals, a differential
+	// snapshot based on the latest snapshot is taken. 0 disables differential snapshots.
+	DeltaInterval uint64
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
@@ -16,3 +20,13 @@ func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
 		KeepRecent: keepRecent,
 	}
 }
+
+// SnapshotHeightInterval returns the interval of the heights snapshotted, either by a full or
+// a differential snapshot.
+func (o SnapshotOptions) SnapshotHeightInterval() uint64 {
+	if o.DeltaInterval > 0 && o.DeltaInterval < o.Interval {
+		return o.DeltaInterval
+	}
+
+	return o.Interval
+}
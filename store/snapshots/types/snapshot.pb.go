@@ -104,6 +104,9 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// base_height is the height of the full snapshot a differential snapshot is
+	// based on.
+	BaseHeight uint64 `protobuf:"varint,2,opt,name=base_height,json=baseHeight,proto3" json:"base_height,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -146,16 +149,23 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetBaseHeight() uint64 {
+	if m != nil {
+		return m.BaseHeight
+	}
+	return 0
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 type SnapshotItem struct {
 	// item is the specific type of snapshot item.
 	//
 	// Types that are valid to be assigned to Item:
-	//
 	//	*SnapshotItem_Store
 	//	*SnapshotItem_IAVL
 	//	*SnapshotItem_Extension
 	//	*SnapshotItem_ExtensionPayload
+	//	*SnapshotItem_IAVLSubtree
 	Item isSnapshotItem_Item `protobuf_oneof:"item"`
 }
 
@@ -210,11 +220,15 @@ type SnapshotItem_Extension struct {
 type SnapshotItem_ExtensionPayload struct {
 	ExtensionPayload *SnapshotExtensionPayload `protobuf:"bytes,4,opt,name=extension_payload,json=extensionPayload,proto3,oneof" json:"extension_payload,omitempty"`
 }
+type SnapshotItem_IAVLSubtree struct {
+	IAVLSubtree *SnapshotIAVLSubtree `protobuf:"bytes,5,opt,name=iavl_subtree,json=iavlSubtree,proto3,oneof" json:"iavl_subtree,omitempty"`
+}
 
 func (*SnapshotItem_Store) isSnapshotItem_Item()            {}
 func (*SnapshotItem_IAVL) isSnapshotItem_Item()             {}
 func (*SnapshotItem_Extension) isSnapshotItem_Item()        {}
 func (*SnapshotItem_ExtensionPayload) isSnapshotItem_Item() {}
+func (*SnapshotItem_IAVLSubtree) isSnapshotItem_Item()      {}
 
 func (m *SnapshotItem) GetItem() isSnapshotItem_Item {
 	if m != nil {
@@ -251,6 +265,13 @@ func (m *SnapshotItem) GetExtensionPayload() *SnapshotExtensionPayload {
 	return nil
 }
 
+func (m *SnapshotItem) GetIAVLSubtree() *SnapshotIAVLSubtree {
+	if x, ok := m.GetItem().(*SnapshotItem_IAVLSubtree); ok {
+		return x.IAVLSubtree
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -258,6 +279,7 @@ func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 		(*SnapshotItem_IAVL)(nil),
 		(*SnapshotItem_Extension)(nil),
 		(*SnapshotItem_ExtensionPayload)(nil),
+		(*SnapshotItem_IAVLSubtree)(nil),
 	}
 }
 
@@ -377,6 +399,62 @@ func (m *SnapshotIAVLItem) GetHeight() int32 {
 	return 0
 }
 
+// SnapshotIAVLSubtree references a subtree of exported IAVL nodes of the base
+// snapshot of a differential snapshot, unchanged since the base height.
+type SnapshotIAVLSubtree struct {
+	// first_key is the key of the leftmost leaf node of the subtree.
+	FirstKey []byte `protobuf:"bytes,1,opt,name=first_key,json=firstKey,proto3" json:"first_key,omitempty"`
+	// nodes is the number of nodes of the subtree.
+	Nodes uint64 `protobuf:"varint,2,opt,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (m *SnapshotIAVLSubtree) Reset()         { *m = SnapshotIAVLSubtree{} }
+func (m *SnapshotIAVLSubtree) String() string { return proto.CompactTextString(m) }
+func (*SnapshotIAVLSubtree) ProtoMessage()    {}
+func (*SnapshotIAVLSubtree) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d5cca1aa5b69183, []int{5}
+}
+func (m *SnapshotIAVLSubtree) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotIAVLSubtree) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotIAVLSubtree.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotIAVLSubtree) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotIAVLSubtree.Merge(m, src)
+}
+func (m *SnapshotIAVLSubtree) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotIAVLSubtree) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotIAVLSubtree.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotIAVLSubtree proto.InternalMessageInfo
+
+func (m *SnapshotIAVLSubtree) GetFirstKey() []byte {
+	if m != nil {
+		return m.FirstKey
+	}
+	return nil
+}
+
+func (m *SnapshotIAVLSubtree) GetNodes() uint64 {
+	if m != nil {
+		return m.Nodes
+	}
+	return 0
+}
+
 // SnapshotExtensionMeta contains metadata about an external snapshotter.
 type SnapshotExtensionMeta struct {
 	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -387,7 +465,7 @@ func (m *SnapshotExtensionMeta) Reset()         { *m = SnapshotExtensionMeta{} }
 func (m *SnapshotExtensionMeta) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionMeta) ProtoMessage()    {}
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{5}
+	return fileDescriptor_3d5cca1aa5b69183, []int{6}
 }
 func (m *SnapshotExtensionMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,7 +517,7 @@ func (m *SnapshotExtensionPayload) Reset()         { *m = SnapshotExtensionPaylo
 func (m *SnapshotExtensionPayload) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionPayload) ProtoMessage()    {}
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{6}
+	return fileDescriptor_3d5cca1aa5b69183, []int{7}
 }
 func (m *SnapshotExtensionPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,6 +559,7 @@ func init() {
 	proto.RegisterType((*SnapshotItem)(nil), "cosmos.store.snapshots.v1.SnapshotItem")
 	proto.RegisterType((*SnapshotStoreItem)(nil), "cosmos.store.snapshots.v1.SnapshotStoreItem")
 	proto.RegisterType((*SnapshotIAVLItem)(nil), "cosmos.store.snapshots.v1.SnapshotIAVLItem")
+	proto.RegisterType((*SnapshotIAVLSubtree)(nil), "cosmos.store.snapshots.v1.SnapshotIAVLSubtree")
 	proto.RegisterType((*SnapshotExtensionMeta)(nil), "cosmos.store.snapshots.v1.SnapshotExtensionMeta")
 	proto.RegisterType((*SnapshotExtensionPayload)(nil), "cosmos.store.snapshots.v1.SnapshotExtensionPayload")
 }
@@ -490,41 +569,46 @@ func init() {
 }
 
 var fileDescriptor_3d5cca1aa5b69183 = []byte{
-	// 538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x6f, 0xd3, 0x3c,
-	0x18, 0x8e, 0xd7, 0xb4, 0x5f, 0xf7, 0x26, 0x9f, 0xe8, 0xcc, 0x40, 0x61, 0x87, 0x2c, 0x84, 0x4b,
-	0x24, 0x68, 0xba, 0x75, 0x88, 0x03, 0xda, 0x85, 0x8a, 0x49, 0xad, 0x00, 0x69, 0xf2, 0x24, 0x84,
-	0xb8, 0x54, 0xde, 0x6a, 0x9a, 0xaa, 0x4d, 0x5d, 0xd5, 0x5e, 0x45, 0x8f, 0xfc, 0x03, 0xfe, 0x08,
-	0x37, 0x7e, 0xc4, 0x8e, 0x13, 0x27, 0x4e, 0x13, 0x6a, 0xff, 0x02, 0x3f, 0x00, 0xd9, 0x4e, 0x0a,
-	0xda, 0x52, 0x34, 0x6e, 0xef, 0xf3, 0xfa, 0x79, 0x1e, 0xfb, 0x7d, 0xec, 0x04, 0xa2, 0x33, 0x2e,
-	0x52, 0x2e, 0x1a, 0x42, 0xf2, 0x29, 0x6b, 0x88, 0x31, 0x9d, 0x88, 0x84, 0x4b, 0xd1, 0x98, 0xed,
-	0xaf, 0x40, 0x3c, 0x99, 0x72, 0xc9, 0xf1, 0x03, 0xc3, 0x8c, 0x35, 0x33, 0x5e, 0x31, 0xe3, 0xd9,
-	0xfe, 0xce, 0x76, 0x9f, 0xf7, 0xb9, 0x66, 0x35, 0x54, 0x65, 0x04, 0x3b, 0x99, 0xa0, 0x6b, 0x16,
-	0x32, 0xb5, 0x06, 0xe1, 0x17, 0x04, 0xd5, 0x93, 0xcc, 0x01, 0xdf, 0x87, 0x4a, 0xc2, 0x06, 0xfd,
-	0x44, 0x7a, 0x28, 0x40, 0x91, 0x4d, 0x32, 0xa4, 0xfa, 0x1f, 0xf8, 0x34, 0xa5, 0xd2, 0xdb, 0x08,
-	0x50, 0xf4, 0x3f, 0xc9, 0x90, 0xea, 0x9f, 0x25, 0xe7, 0xe3, 0xa1, 0xf0, 0x4a, 0xa6, 0x6f, 0x10,
-	0xc6, 0x60, 0x27, 0x54, 0x24, 0x9e, 0x1d, 0xa0, 0xc8, 0x25, 0xba, 0xc6, 0x47, 0x50, 0x4d, 0x99,
-	0xa4, 0x3d, 0x2a, 0xa9, 0x57, 0x0e, 0x50, 0xe4, 0x34, 0x1f, 0xc5, 0x6b, 0xe7, 0x88, 0xdf, 0x64,
-	0xd4, 0x96, 0x7d, 0x71, 0xb5, 0x6b, 0x91, 0x95, 0x34, 0xac, 0x43, 0x35, 0x5f, 0xc3, 0x0f, 0xc1,
-	0xd5, 0x1b, 0x76, 0xd5, 0x06, 0x4c, 0x78, 0x28, 0x28, 0x45, 0x2e, 0x71, 0x74, 0xaf, 0xad, 0x5b,
-	0xe1, 0xcf, 0x0d, 0x70, 0xf3, 0xf1, 0x3a, 0x92, 0xa5, 0xf8, 0x25, 0x94, 0xf5, 0x76, 0x7a, 0x42,
-	0xa7, 0xf9, 0xe4, 0x2f, 0x67, 0xc8, 0x75, 0x27, 0x6a, 0x49, 0x89, 0xdb, 0x16, 0x31, 0x62, 0xfc,
-	0x0a, 0xec, 0x01, 0x9d, 0x8d, 0x74, 0x1c, 0x4e, 0xf3, 0xf1, 0x2d, 0x4c, 0x3a, 0x2f, 0xde, 0xbe,
-	0x56, 0x1e, 0xad, 0xea, 0xe2, 0x6a, 0xd7, 0x56, 0xa8, 0x6d, 0x11, 0x6d, 0x82, 0x8f, 0x61, 0x93,
-	0x7d, 0x94, 0x6c, 0x2c, 0x06, 0x7c, 0xac, 0x83, 0x74, 0x9a, 0x7b, 0xb7, 0x70, 0x3c, 0xca, 0x35,
-	0x2a, 0x8f, 0xb6, 0x45, 0x7e, 0x9b, 0xe0, 0x53, 0xd8, 0x5a, 0x81, 0xee, 0x84, 0xce, 0x47, 0x9c,
-	0xf6, 0xf4, 0x65, 0x38, 0xcd, 0x83, 0x7f, 0x71, 0x3e, 0x36, 0xd2, 0xb6, 0x45, 0x6a, 0xec, 0x5a,
-	0xef, 0xf9, 0xdd, 0x6f, 0x5f, 0xeb, 0x77, 0x8c, 0x57, 0x5d, 0xf4, 0x86, 0xc1, 0x5e, 0xfc, 0xf4,
-	0x59, 0xab, 0x02, 0xf6, 0x40, 0xb2, 0x34, 0x3c, 0x84, 0xad, 0x1b, 0xe9, 0xa9, 0x57, 0x31, 0xa6,
-	0xa9, 0x49, 0x7e, 0x93, 0xe8, 0xba, 0xd0, 0x25, 0xfc, 0x84, 0xa0, 0x76, 0x3d, 0x37, 0x5c, 0x83,
-	0xd2, 0x90, 0xcd, 0xb5, 0xd8, 0x25, 0xaa, 0xc4, 0xdb, 0x50, 0x9e, 0xd1, 0xd1, 0x39, 0xd3, 0xb7,
-	0xe0, 0x12, 0x03, 0xb0, 0x07, 0xff, 0xcd, 0xd8, 0x74, 0x95, 0x65, 0x89, 0xe4, 0xf0, 0x8f, 0xd7,
-	0xad, 0xa2, 0x28, 0xe7, 0xaf, 0xbb, 0xf8, 0x0c, 0xef, 0xe0, 0x5e, 0x61, 0xd0, 0x45, 0x53, 0xac,
-	0xfb, 0x3e, 0x8a, 0x9d, 0x3b, 0xe0, 0xad, 0x0b, 0x5a, 0x1d, 0x3e, 0xbf, 0x2e, 0x33, 0x68, 0x0e,
-	0x8b, 0xe3, 0x3e, 0xbc, 0x58, 0xf8, 0xe8, 0x72, 0xe1, 0xa3, 0x1f, 0x0b, 0x1f, 0x7d, 0x5e, 0xfa,
-	0xd6, 0xe5, 0xd2, 0xb7, 0xbe, 0x2f, 0x7d, 0xeb, 0x7d, 0x68, 0xa8, 0xa2, 0x37, 0x8c, 0x07, 0xfc,
-	0xc6, 0x2f, 0x45, 0xce, 0x27, 0x4c, 0x9c, 0x56, 0xf4, 0x1f, 0xe0, 0xe0, 0x57, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x57, 0xe2, 0xd5, 0x36, 0x79, 0x04, 0x00, 0x00,
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x4f, 0xd4, 0x40,
+	0x14, 0x6e, 0xdd, 0x2e, 0x2e, 0xaf, 0x35, 0xc0, 0x80, 0xa6, 0x62, 0xb2, 0xac, 0xf5, 0xb2, 0x89,
+	0xd2, 0x85, 0x02, 0x1e, 0x0c, 0x17, 0x37, 0x92, 0x94, 0xa0, 0x09, 0x19, 0x12, 0x63, 0x4c, 0xcc,
+	0x66, 0x96, 0x0e, 0xb4, 0x59, 0xda, 0xd9, 0x74, 0x86, 0x8d, 0x1c, 0xfd, 0x07, 0xfe, 0x11, 0x6f,
+	0xfe, 0x08, 0x8e, 0xc4, 0x93, 0xe1, 0x40, 0xcc, 0xf2, 0x47, 0xcc, 0xcc, 0xb4, 0x0b, 0x81, 0x62,
+	0xf0, 0x36, 0xdf, 0x9b, 0xf7, 0x7d, 0xf3, 0xcd, 0x7b, 0x6f, 0x06, 0xda, 0xfb, 0x8c, 0xa7, 0x8c,
+	0x77, 0xb8, 0x60, 0x39, 0xed, 0xf0, 0x8c, 0x0c, 0x79, 0xcc, 0x04, 0xef, 0x8c, 0x56, 0x27, 0xc0,
+	0x1f, 0xe6, 0x4c, 0x30, 0xf4, 0x54, 0x67, 0xfa, 0x2a, 0xd3, 0x9f, 0x64, 0xfa, 0xa3, 0xd5, 0xc5,
+	0x85, 0x43, 0x76, 0xc8, 0x54, 0x56, 0x47, 0xae, 0x34, 0x61, 0xb1, 0x20, 0xf4, 0xf4, 0x46, 0xc1,
+	0x56, 0xc0, 0xfb, 0x61, 0x42, 0x63, 0xaf, 0x50, 0x40, 0x4f, 0x60, 0x2a, 0xa6, 0xc9, 0x61, 0x2c,
+	0x5c, 0xb3, 0x65, 0xb6, 0x2d, 0x5c, 0x20, 0x19, 0x3f, 0x60, 0x79, 0x4a, 0x84, 0xfb, 0xa0, 0x65,
+	0xb6, 0x1f, 0xe1, 0x02, 0xc9, 0xf8, 0x7e, 0x7c, 0x9c, 0x0d, 0xb8, 0x5b, 0xd3, 0x71, 0x8d, 0x10,
+	0x02, 0x2b, 0x26, 0x3c, 0x76, 0xad, 0x96, 0xd9, 0x76, 0xb0, 0x5a, 0xa3, 0x2d, 0x68, 0xa4, 0x54,
+	0x90, 0x88, 0x08, 0xe2, 0xd6, 0x5b, 0x66, 0xdb, 0x0e, 0x5e, 0xf8, 0x77, 0xde, 0xc3, 0xff, 0x50,
+	0xa4, 0x76, 0xad, 0xd3, 0x8b, 0x25, 0x03, 0x4f, 0xa8, 0xde, 0x3e, 0x34, 0xca, 0x3d, 0xf4, 0x1c,
+	0x1c, 0x75, 0x60, 0x4f, 0x1e, 0x40, 0xb9, 0x6b, 0xb6, 0x6a, 0x6d, 0x07, 0xdb, 0x2a, 0x16, 0xaa,
+	0x10, 0x5a, 0x07, 0xbb, 0x4f, 0x38, 0xed, 0x15, 0xd7, 0x92, 0xf6, 0xad, 0xee, 0xfc, 0xf9, 0xcf,
+	0xe5, 0x19, 0x7d, 0xf6, 0x32, 0x8f, 0x06, 0xad, 0x15, 0x7f, 0x23, 0xc0, 0x20, 0xf3, 0x42, 0x95,
+	0xe6, 0x9d, 0xd7, 0xc0, 0x29, 0x8b, 0xb2, 0x2d, 0x68, 0x8a, 0xde, 0x41, 0x5d, 0x99, 0x54, 0x75,
+	0xb1, 0x83, 0x57, 0xff, 0x70, 0x5e, 0xf2, 0xf6, 0xe4, 0x96, 0x24, 0x87, 0x06, 0xd6, 0x64, 0xb4,
+	0x03, 0x56, 0x42, 0x46, 0x47, 0xca, 0x85, 0x1d, 0xbc, 0xbc, 0x87, 0xc8, 0xf6, 0xdb, 0x8f, 0xef,
+	0xa5, 0x46, 0xb7, 0x31, 0xbe, 0x58, 0xb2, 0x24, 0x0a, 0x0d, 0xac, 0x44, 0xd0, 0x2e, 0x4c, 0xd3,
+	0xaf, 0x82, 0x66, 0x3c, 0x61, 0x99, 0x2a, 0xbf, 0x1d, 0xac, 0xdc, 0x43, 0x71, 0xab, 0xe4, 0xc8,
+	0x2a, 0x86, 0x06, 0xbe, 0x12, 0x41, 0x7d, 0x98, 0x9b, 0x80, 0xde, 0x90, 0x9c, 0x1c, 0x31, 0x12,
+	0xa9, 0x16, 0xda, 0xc1, 0xda, 0xff, 0x28, 0xef, 0x6a, 0x6a, 0x68, 0xe0, 0x59, 0x7a, 0x23, 0x86,
+	0x22, 0x70, 0xa4, 0xfb, 0x1e, 0x3f, 0xee, 0x8b, 0x9c, 0xd2, 0x62, 0x12, 0xfc, 0x7b, 0x96, 0x62,
+	0x4f, 0xb3, 0xba, 0x33, 0xe3, 0x8b, 0x25, 0xfb, 0x5a, 0x20, 0x34, 0xb0, 0x2d, 0x65, 0x0b, 0xf8,
+	0x66, 0xfe, 0xd7, 0xcd, 0x06, 0xaf, 0xbf, 0xee, 0x4e, 0x81, 0x95, 0x08, 0x9a, 0x7a, 0x9b, 0x30,
+	0x77, 0xab, 0x47, 0x72, 0x62, 0x33, 0x92, 0xea, 0xfe, 0x4e, 0x63, 0xb5, 0xae, 0x54, 0xf1, 0xbe,
+	0x99, 0x30, 0x7b, 0xb3, 0x3b, 0x68, 0x16, 0x6a, 0x03, 0x7a, 0xa2, 0xc8, 0x0e, 0x96, 0x4b, 0xb4,
+	0x00, 0xf5, 0x11, 0x39, 0x3a, 0xa6, 0xaa, 0xd7, 0x0e, 0xd6, 0x00, 0xb9, 0xf0, 0x70, 0x44, 0xf3,
+	0x49, 0xc7, 0x6a, 0xb8, 0x84, 0xd7, 0x5e, 0x9e, 0x2c, 0x78, 0xbd, 0x7c, 0x79, 0xd5, 0x1e, 0xbe,
+	0xc0, 0x7c, 0x45, 0x55, 0xd0, 0x33, 0x98, 0x3e, 0x48, 0x72, 0x2e, 0x7a, 0x57, 0x5e, 0x1a, 0x2a,
+	0xb0, 0xa3, 0x0d, 0x65, 0x2c, 0xa2, 0x5c, 0x3f, 0x01, 0xac, 0x41, 0x85, 0xfc, 0x46, 0xe0, 0x7d,
+	0x82, 0xc7, 0x95, 0xd3, 0x52, 0x55, 0xa4, 0xbb, 0xbe, 0x86, 0x6a, 0xe3, 0xdb, 0xe0, 0xde, 0x35,
+	0x2d, 0xb2, 0x36, 0xe5, 0xcc, 0x69, 0xef, 0x25, 0xac, 0xee, 0xe6, 0xe6, 0xe9, 0xb8, 0x69, 0x9e,
+	0x8d, 0x9b, 0xe6, 0x9f, 0x71, 0xd3, 0xfc, 0x7e, 0xd9, 0x34, 0xce, 0x2e, 0x9b, 0xc6, 0xef, 0xcb,
+	0xa6, 0xf1, 0xd9, 0xd3, 0xa9, 0x3c, 0x1a, 0xf8, 0x09, 0xbb, 0xf5, 0x9b, 0x8a, 0x93, 0x21, 0xe5,
+	0xfd, 0x29, 0xf5, 0xf9, 0xad, 0xfd, 0x1d, 0x00, 0x71, 0x53, 0x36, 0x02, 0x74, 0x05, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -602,6 +686,11 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BaseHeight != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.BaseHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -730,6 +819,27 @@ func (m *SnapshotItem_ExtensionPayload) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *SnapshotItem_IAVLSubtree) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotItem_IAVLSubtree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IAVLSubtree != nil {
+		{
+			size, err := m.IAVLSubtree.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SnapshotStoreItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -807,6 +917,41 @@ func (m *SnapshotIAVLItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotIAVLSubtree) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotIAVLSubtree) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotIAVLSubtree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nodes != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Nodes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FirstKey) > 0 {
+		i -= len(m.FirstKey)
+		copy(dAtA[i:], m.FirstKey)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.FirstKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SnapshotExtensionMeta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -919,6 +1064,9 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	if m.BaseHeight != 0 {
+		n += 1 + sovSnapshot(uint64(m.BaseHeight))
+	}
 	return n
 }
 
@@ -982,6 +1130,18 @@ func (m *SnapshotItem_ExtensionPayload) Size() (n int) {
 	}
 	return n
 }
+func (m *SnapshotItem_IAVLSubtree) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IAVLSubtree != nil {
+		l = m.IAVLSubtree.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
 func (m *SnapshotStoreItem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1018,6 +1178,22 @@ func (m *SnapshotIAVLItem) Size() (n int) {
 	return n
 }
 
+func (m *SnapshotIAVLSubtree) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FirstKey)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.Nodes != 0 {
+		n += 1 + sovSnapshot(uint64(m.Nodes))
+	}
+	return n
+}
+
 func (m *SnapshotExtensionMeta) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1288,6 +1464,25 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseHeight", wireType)
+			}
+			m.BaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -1478,6 +1673,41 @@ func (m *SnapshotItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &SnapshotItem_ExtensionPayload{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IAVLSubtree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SnapshotIAVLSubtree{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &SnapshotItem_IAVLSubtree{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -1737,6 +1967,109 @@ func (m *SnapshotIAVLItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SnapshotIAVLSubtree) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotIAVLSubtree: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotIAVLSubtree: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstKey = append(m.FirstKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.FirstKey == nil {
+				m.FirstKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			m.Nodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nodes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SnapshotExtensionMeta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
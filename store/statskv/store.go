@@ -0,0 +1,83 @@
+package statskv
+
+import (
+	"io"
+
+	"cosmossdk.io/store/types"
+)
+
+var _ types.KVStore = &Store{}
+
+// Store implements the KVStore interface, recording the writes to the parent
+// KVStore in the statistics of its tracker. As the size of the value replaced
+// or deleted is recorded, each write also reads the previous value of the key.
+type Store struct {
+	parent  types.KVStore
+	tracker *Tracker
+}
+
+// NewStore returns a reference to a new statskv Store given a parent KVStore
+// implementation and the tracker of its statistics.
+func NewStore(parent types.KVStore, tracker *Tracker) *Store {
+	return &Store{parent: parent, tracker: tracker}
+}
+
+// Get implements the KVStore interface. It delegates the Get call to the parent
+// KVStore.
+func (s *Store) Get(key []byte) []byte {
+	return s.parent.Get(key)
+}
+
+// Set implements the KVStore interface. It records the write and delegates the
+// Set call to the parent KVStore.
+func (s *Store) Set(key, value []byte) {
+	types.AssertValidKey(key)
+	types.AssertValidValue(value)
+	prev := s.parent.Get(key)
+	s.parent.Set(key, value)
+	s.tracker.recordSet(key, prev, value)
+}
+
+// Delete implements the KVStore interface. It records the deletion and
+// delegates the Delete call to the parent KVStore.
+func (s *Store) Delete(key []byte) {
+	prev := s.parent.Get(key)
+	s.parent.Delete(key)
+	s.tracker.recordDelete(key, prev)
+}
+
+// Has implements the KVStore interface. It delegates the Has call to the
+// parent KVStore.
+func (s *Store) Has(key []byte) bool {
+	return s.parent.Has(key)
+}
+
+// Iterator implements the KVStore interface. It delegates the Iterator call
+// to the parent KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface. It delegates the
+// ReverseIterator call to the parent KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return s.parent.ReverseIterator(start, end)
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface. It panics as a Store
+// cannot be cache wrapped.
+func (s *Store) CacheWrap() types.CacheWrap {
+	panic("cannot CacheWrap a StatsKVStore")
+}
+
+// CacheWrapWithTrace implements the KVStore interface. It panics as a
+// Store cannot be cache wrapped.
+func (s *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	panic("cannot CacheWrapWithTrace a StatsKVStore")
+}
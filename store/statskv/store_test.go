@@ -0,0 +1,35 @@
+package statskv_test
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/statskv"
+)
+
+func TestStatsKVStore(t *testing.T) {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	parent.Set([]byte("key1"), []byte("value1"))
+
+	tracker := statskv.NewTracker()
+	store := statskv.NewStore(parent, tracker)
+
+	store.Set([]byte("key2"), []byte("value2"))
+	store.Set([]byte("key1"), []byte("v1"))
+	store.Delete([]byte("key3"))
+	require.Equal(t, statskv.Stats{Keys: 1, Bytes: 10 - 4, Writes: 2, Deletes: 1, BytesWritten: 16}, tracker.Stats())
+
+	// the initial state is added to the changes recorded
+	tracker.Init(1, 10)
+	require.Equal(t, statskv.Stats{Initialized: true, Keys: 2, Bytes: 16, Writes: 2, Deletes: 1, BytesWritten: 16}, tracker.Stats())
+
+	store.Delete([]byte("key2"))
+	require.Equal(t, statskv.Stats{Initialized: true, Keys: 1, Bytes: 6, Writes: 2, Deletes: 2, BytesWritten: 16}, tracker.Stats())
+	require.Equal(t, []byte("v1"), store.Get([]byte("key1")))
+	require.False(t, store.Has([]byte("key2")))
+
+	require.Panics(t, func() { store.CacheWrap() })
+}
@@ -0,0 +1,104 @@
+package statskv
+
+import (
+	"sync"
+
+	gometrics "github.com/hashicorp/go-metrics"
+
+	"cosmossdk.io/store/metrics"
+)
+
+// Stats are the statistics of the state of a store.
+type Stats struct {
+	// Initialized reports whether the number of keys and the size of the store
+	// are known, i.e. whether its initial state has been counted.
+	Initialized bool `json:"initialized"`
+	// Keys is the number of keys of the store, and Bytes the total size of its
+	// keys and values.
+	Keys  int64 `json:"keys"`
+	Bytes int64 `json:"bytes"`
+
+	// Writes and Deletes are the number of keys written and deleted since the
+	// store was loaded, and BytesWritten the total size of the keys and values
+	// written.
+	Writes       uint64 `json:"writes"`
+	Deletes      uint64 `json:"deletes"`
+	BytesWritten uint64 `json:"bytes_written"`
+}
+
+// Tracker tracks the statistics of a store. The number of keys and size of the
+// store are tracked as changes until its initial state is counted.
+type Tracker struct {
+	mtx      sync.Mutex
+	stats    Stats
+	reported Stats
+}
+
+// NewTracker returns a new Tracker, whose initial state is not counted yet.
+func NewTracker() *Tracker {
+	return &Tracker{}
+}
+
+// Init sets the number of keys and the size of the initial state of the store,
+// i.e. the state before the writes recorded so far.
+func (t *Tracker) Init(keys, bytes int64) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.stats.Initialized = true
+	t.stats.Keys += keys
+	t.stats.Bytes += bytes
+}
+
+// Stats returns the statistics of the store. The number of keys and size of
+// the store are the changes since it was loaded if it is not initialized.
+func (t *Tracker) Stats() Stats {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	return t.stats
+}
+
+// Report emits the statistics of the store as metrics labeled with its name:
+// the number of keys and size of the store as gauges, once initialized, and
+// the writes since the previous report as counters.
+func (t *Tracker) Report(m metrics.StoreMetrics, storeName string) {
+	t.mtx.Lock()
+	stats, reported := t.stats, t.reported
+	t.reported = stats
+	t.mtx.Unlock()
+
+	labels := []gometrics.Label{{Name: "store", Value: storeName}}
+	if stats.Initialized {
+		m.SetGaugeWithLabels([]string{"store", "state", "keys"}, float32(stats.Keys), labels)
+		m.SetGaugeWithLabels([]string{"store", "state", "bytes"}, float32(stats.Bytes), labels)
+	}
+	m.IncrCounterWithLabels([]string{"store", "state", "writes"}, float32(stats.Writes-reported.Writes), labels)
+	m.IncrCounterWithLabels([]string{"store", "state", "deletes"}, float32(stats.Deletes-reported.Deletes), labels)
+	m.IncrCounterWithLabels([]string{"store", "state", "bytes_written"}, float32(stats.BytesWritten-reported.BytesWritten), labels)
+}
+
+func (t *Tracker) recordSet(key, prev, value []byte) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.stats.Writes++
+	t.stats.BytesWritten += uint64(len(key) + len(value))
+	if prev == nil {
+		t.stats.Keys++
+		t.stats.Bytes += int64(len(key) + len(value))
+	} else {
+		t.stats.Bytes += int64(len(value) - len(prev))
+	}
+}
+
+func (t *Tracker) recordDelete(key, prev []byte) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.stats.Deletes++
+	if prev != nil {
+		t.stats.Keys--
+		t.stats.Bytes -= int64(len(key) + len(prev))
+	}
+}
@@ -0,0 +1,237 @@
+package streaming
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/log"
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// StateChangesSchema is the schema of the messages of the decoded state changes
+// of a block, published in place of ListenCommitRequest by a SinkListener with
+// a KVPairDecoder. Their value is a JSON encoded StateChanges.
+const StateChangesSchema = "cosmos.store.streaming.StateChanges"
+
+var _ storetypes.ABCIListener = (*SinkListener)(nil)
+
+// Message is a message published to a sink. Its value is a protobuf payload,
+// tagged with the full name of its proto message type, or a JSON encoded
+// StateChanges tagged with StateChangesSchema.
+type Message struct {
+	// Key is the big endian encoded height of the block of the message.
+	Key []byte
+	// Schema is the full name of the proto message type of the value.
+	Schema string
+	// Value is the encoded payload.
+	Value []byte
+}
+
+// StateChanges are the decoded state changes of a block.
+type StateChanges struct {
+	BlockHeight int64         `json:"block_height"`
+	Changes     []StateChange `json:"changes"`
+}
+
+// StateChange is a state change decoded into a typed object. The changes of
+// the stores which can't be decoded only have their raw key and value, base64
+// encoded.
+type StateChange struct {
+	// StoreKey is the name of the store, i.e. of its module.
+	StoreKey string `json:"store_key"`
+	// Type is the name of the object type in the schema of the module.
+	Type string `json:"type,omitempty"`
+	// Key is the key of the object, by field name.
+	Key any `json:"key"`
+	// Value is the value of the object, by field name, unset for a deletion.
+	Value any `json:"value,omitempty"`
+	// Delete is set when the object is deleted.
+	Delete bool `json:"delete,omitempty"`
+}
+
+// KVPairDecoder decodes the KV pairs of the state changes into typed objects.
+type KVPairDecoder interface {
+	// DecodeKVPair returns the objects changed by a KV pair, or false if the
+	// store of the pair has no decoder.
+	DecodeKVPair(pair *storetypes.StoreKVPair) ([]StateChange, bool, error)
+}
+
+// Publisher publishes messages to an external system, e.g. Kafka or NATS.
+type Publisher interface {
+	// Publish publishes the messages in order, returning once they are all
+	// acknowledged.
+	Publish(ctx context.Context, msgs []Message) error
+	// Close closes the connections of the publisher.
+	Close() error
+}
+
+// SinkOptions defines the batching and retry behavior of a SinkListener.
+type SinkOptions struct {
+	// BatchSize is the number of blocks published at once. Defaults to 1.
+	BatchSize int
+	// MaxRetries is the number of times a failed publication is retried before
+	// returning an error. The messages are then kept and published again with
+	// the next batch.
+	MaxRetries int
+	// RetryBackoff is the delay before the first retry, doubled on each retry.
+	// Defaults to 100ms.
+	RetryBackoff time.Duration
+	// Decoder, if set, decodes the state changes, which are published as
+	// StateChanges in place of the ListenCommitRequest messages.
+	Decoder KVPairDecoder
+}
+
+// SinkListener is an ABCIListener publishing the FinalizeBlock and Commit
+// messages, i.e. ListenFinalizeBlockRequest and ListenCommitRequest, to a
+// Publisher. The messages are published by batches of blocks on commit.
+type SinkListener struct {
+	publisher Publisher
+	opts      SinkOptions
+	logger    log.Logger
+
+	mtx     sync.Mutex
+	height  int64
+	blocks  int
+	pending []Message
+}
+
+// NewSinkListener returns a SinkListener publishing to the given publisher.
+func NewSinkListener(publisher Publisher, opts SinkOptions, logger log.Logger) *SinkListener {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 1
+	}
+	if opts.RetryBackoff <= 0 {
+		opts.RetryBackoff = 100 * time.Millisecond
+	}
+
+	return &SinkListener{
+		publisher: publisher,
+		opts:      opts,
+		logger:    logger,
+	}
+}
+
+// ListenFinalizeBlock implements ABCIListener.
+func (l *SinkListener) ListenFinalizeBlock(_ context.Context, req abci.FinalizeBlockRequest, res abci.FinalizeBlockResponse) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.height = req.Height
+	return l.add(&streamingabci.ListenFinalizeBlockRequest{Req: &req, Res: &res})
+}
+
+// ListenCommit implements ABCIListener. The pending messages are published once
+// BatchSize blocks are committed.
+func (l *SinkListener) ListenCommit(ctx context.Context, res abci.CommitResponse, changeSet []*storetypes.StoreKVPair) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.opts.Decoder != nil {
+		if err := l.addStateChanges(changeSet); err != nil {
+			return err
+		}
+	} else if err := l.add(&streamingabci.ListenCommitRequest{BlockHeight: l.height, Res: &res, ChangeSet: changeSet}); err != nil {
+		return err
+	}
+
+	l.blocks++
+	if l.blocks < l.opts.BatchSize {
+		return nil
+	}
+
+	if err := l.publish(ctx); err != nil {
+		return err
+	}
+
+	l.pending = nil
+	l.blocks = 0
+	return nil
+}
+
+// Close closes the publisher of the listener.
+func (l *SinkListener) Close() error {
+	return l.publisher.Close()
+}
+
+func (l *SinkListener) add(msg proto.Message) error {
+	value, err := proto.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", proto.MessageName(msg), err)
+	}
+
+	l.pending = append(l.pending, Message{
+		Key:    binary.BigEndian.AppendUint64(nil, uint64(l.height)),
+		Schema: proto.MessageName(msg),
+		Value:  value,
+	})
+
+	return nil
+}
+
+// addStateChanges adds the message of the decoded state changes. The pairs
+// failing to decode are added undecoded.
+func (l *SinkListener) addStateChanges(changeSet []*storetypes.StoreKVPair) error {
+	stateChanges := StateChanges{BlockHeight: l.height, Changes: make([]StateChange, 0, len(changeSet))}
+	for _, pair := range changeSet {
+		changes, ok, err := l.opts.Decoder.DecodeKVPair(pair)
+		if err != nil {
+			l.logger.Error("failed to decode state change", "height", l.height, "store", pair.StoreKey, "err", err)
+		}
+		if err != nil || !ok {
+			change := StateChange{StoreKey: pair.StoreKey, Key: pair.Key, Delete: pair.Delete}
+			if !pair.Delete {
+				change.Value = pair.Value
+			}
+			changes = []StateChange{change}
+		}
+
+		stateChanges.Changes = append(stateChanges.Changes, changes...)
+	}
+
+	value, err := json.Marshal(stateChanges)
+	if err != nil {
+		return fmt.Errorf("failed to encode state changes: %w", err)
+	}
+
+	l.pending = append(l.pending, Message{
+		Key:    binary.BigEndian.AppendUint64(nil, uint64(l.height)),
+		Schema: StateChangesSchema,
+		Value:  value,
+	})
+
+	return nil
+}
+
+// publish publishes the pending messages, retrying with an exponential
+// backoff on failure.
+func (l *SinkListener) publish(ctx context.Context) error {
+	backoff := l.opts.RetryBackoff
+	for retry := 0; ; retry++ {
+		err := l.publisher.Publish(ctx, l.pending)
+		if err == nil {
+			return nil
+		}
+
+		if retry >= l.opts.MaxRetries {
+			return fmt.Errorf("failed to publish %d messages up to height %d: %w", len(l.pending), l.height, err)
+		}
+
+		l.logger.Error("failed to publish messages, retrying", "height", l.height, "retry", retry+1, "err", err)
+
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(backoff):
+			backoff *= 2
+		}
+	}
+}
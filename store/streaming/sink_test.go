@@ -0,0 +1,140 @@
+package streaming
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+)
+
+type mockPublisher struct {
+	failures  int
+	published [][]Message
+	calls     int
+}
+
+func (p *mockPublisher) Publish(_ context.Context, msgs []Message) error {
+	p.calls++
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("unavailable")
+	}
+
+	p.published = append(p.published, append([]Message(nil), msgs...))
+	return nil
+}
+
+func (p *mockPublisher) Close() error { return nil }
+
+func listenBlock(t *testing.T, l *SinkListener, height int64) error {
+	t.Helper()
+
+	ctx := context.Background()
+	require.NoError(t, l.ListenFinalizeBlock(ctx, abci.FinalizeBlockRequest{Height: height}, abci.FinalizeBlockResponse{}))
+	return l.ListenCommit(ctx, abci.CommitResponse{}, []*storetypes.StoreKVPair{
+		{StoreKey: "bank", Key: []byte("key"), Value: []byte("value")},
+	})
+}
+
+func TestSinkListener(t *testing.T) {
+	publisher := &mockPublisher{}
+	l := NewSinkListener(publisher, SinkOptions{BatchSize: 2}, log.NewNopLogger())
+
+	require.NoError(t, listenBlock(t, l, 1))
+	require.Empty(t, publisher.published)
+
+	require.NoError(t, listenBlock(t, l, 2))
+	require.Len(t, publisher.published, 1)
+
+	msgs := publisher.published[0]
+	require.Len(t, msgs, 4)
+	for i, msg := range msgs {
+		require.Equal(t, uint64(i/2+1), binary.BigEndian.Uint64(msg.Key))
+	}
+
+	require.Equal(t, "cosmos.store.streaming.abci.ListenFinalizeBlockRequest", msgs[0].Schema)
+	var finalizeBlock streamingabci.ListenFinalizeBlockRequest
+	require.NoError(t, proto.Unmarshal(msgs[0].Value, &finalizeBlock))
+	require.Equal(t, int64(1), finalizeBlock.Req.Height)
+
+	require.Equal(t, "cosmos.store.streaming.abci.ListenCommitRequest", msgs[3].Schema)
+	var commit streamingabci.ListenCommitRequest
+	require.NoError(t, proto.Unmarshal(msgs[3].Value, &commit))
+	require.Equal(t, int64(2), commit.BlockHeight)
+	require.Len(t, commit.ChangeSet, 1)
+	require.Equal(t, "bank", commit.ChangeSet[0].StoreKey)
+}
+
+func TestSinkListenerRetries(t *testing.T) {
+	publisher := &mockPublisher{failures: 4}
+	l := NewSinkListener(publisher, SinkOptions{MaxRetries: 2, RetryBackoff: time.Millisecond}, log.NewNopLogger())
+
+	// the publication fails after the retries, the messages are kept
+	require.ErrorContains(t, listenBlock(t, l, 1), "unavailable")
+	require.Equal(t, 3, publisher.calls)
+	require.Empty(t, publisher.published)
+
+	// and published with the next block once the publisher is available
+	require.NoError(t, listenBlock(t, l, 2))
+	require.Equal(t, 5, publisher.calls)
+	require.Len(t, publisher.published, 1)
+	require.Len(t, publisher.published[0], 4)
+
+	require.NoError(t, listenBlock(t, l, 3))
+	require.Len(t, publisher.published, 2)
+	require.Len(t, publisher.published[1], 2)
+}
+
+type mockDecoder struct{}
+
+func (mockDecoder) DecodeKVPair(pair *storetypes.StoreKVPair) ([]StateChange, bool, error) {
+	switch pair.StoreKey {
+	case "bank":
+		return []StateChange{{
+			StoreKey: pair.StoreKey,
+			Type:     "balances",
+			Key:      map[string]any{"denom": string(pair.Key)},
+			Value:    map[string]any{"amount": string(pair.Value)},
+		}}, true, nil
+	case "invalid":
+		return nil, true, errors.New("invalid key")
+	default:
+		return nil, false, nil
+	}
+}
+
+func TestSinkListenerDecoder(t *testing.T) {
+	publisher := &mockPublisher{}
+	l := NewSinkListener(publisher, SinkOptions{Decoder: mockDecoder{}}, log.NewNopLogger())
+
+	ctx := context.Background()
+	require.NoError(t, l.ListenFinalizeBlock(ctx, abci.FinalizeBlockRequest{Height: 3}, abci.FinalizeBlockResponse{}))
+	require.NoError(t, l.ListenCommit(ctx, abci.CommitResponse{}, []*storetypes.StoreKVPair{
+		{StoreKey: "bank", Key: []byte("stake"), Value: []byte("10")},
+		{StoreKey: "acc", Key: []byte{1}, Delete: true},
+		{StoreKey: "invalid", Key: []byte{2}, Value: []byte{3}},
+	}))
+
+	require.Len(t, publisher.published, 1)
+	msgs := publisher.published[0]
+	require.Len(t, msgs, 2)
+	require.Equal(t, StateChangesSchema, msgs[1].Schema)
+	require.Equal(t, uint64(3), binary.BigEndian.Uint64(msgs[1].Key))
+	require.JSONEq(t, `{
+		"block_height": 3,
+		"changes": [
+			{"store_key": "bank", "type": "balances", "key": {"denom": "stake"}, "value": {"amount": "10"}},
+			{"store_key": "acc", "key": "AQ==", "delete": true},
+			{"store_key": "invalid", "key": "Ag==", "value": "Aw=="}
+		]
+	}`, string(msgs[1].Value))
+}
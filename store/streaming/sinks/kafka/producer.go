@@ -0,0 +1,166 @@
+// Package kafka implements a streaming.Publisher producing messages to a Kafka
+// topic partition with the segmentio/kafka-go client.
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/spf13/cast"
+
+	"cosmossdk.io/store/streaming"
+)
+
+const (
+	// SchemaHeader is the header of the records set to the full name of the
+	// proto message type of their value.
+	SchemaHeader = "cosmos-schema"
+
+	defaultClientID     = "cosmos-sdk-streaming"
+	defaultTimeout      = 10 * time.Second
+	defaultMaxBatchSize = 1 << 20
+)
+
+var _ streaming.Publisher = (*Producer)(nil)
+
+// Config defines the configuration of a Kafka Producer.
+type Config struct {
+	// Brokers are the addresses of the bootstrap brokers, e.g. localhost:9092.
+	Brokers []string
+	// Topic is the topic the messages are produced to.
+	Topic string
+	// Partition is the partition of the topic the messages are produced to, so
+	// that they are consumed in order.
+	Partition int32
+	// RequiredAcks is the number of acknowledgements required for a message to
+	// be produced: -1 for all the in-sync replicas, or 1 for the leader only.
+	// Defaults to -1.
+	RequiredAcks int16
+	// Timeout is the timeout of the connection and of the requests. Defaults
+	// to 10s.
+	Timeout time.Duration
+	// MaxBatchSize is the maximum size in bytes of the record batches, which
+	// should not exceed the max.message.bytes of the topic. Defaults to 1MiB.
+	MaxBatchSize int
+	// ClientID is the client id sent to the brokers.
+	ClientID string
+}
+
+// Producer produces messages to a Kafka topic partition. The messages are the
+// records of the produced record batches, keyed by the height of their block
+// and with their schema in the SchemaHeader header.
+type Producer struct {
+	writer    *kafka.Writer
+	partition int32
+}
+
+// NewProducer returns a Producer for the given configuration. The connections
+// to the brokers are established on the first publication.
+func NewProducer(cfg Config) (*Producer, error) {
+	if len(cfg.Brokers) == 0 {
+		return nil, errors.New("kafka: no brokers")
+	}
+	if cfg.Topic == "" {
+		return nil, errors.New("kafka: no topic")
+	}
+	if cfg.Partition < 0 {
+		return nil, fmt.Errorf("kafka: invalid partition %d", cfg.Partition)
+	}
+
+	requiredAcks := kafka.RequireAll
+	switch cfg.RequiredAcks {
+	case 0, -1:
+	case 1:
+		requiredAcks = kafka.RequireOne
+	default:
+		return nil, fmt.Errorf("kafka: invalid required acks %d, expected -1 or 1", cfg.RequiredAcks)
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.MaxBatchSize <= 0 {
+		cfg.MaxBatchSize = defaultMaxBatchSize
+	}
+	if cfg.ClientID == "" {
+		cfg.ClientID = defaultClientID
+	}
+
+	return &Producer{
+		partition: cfg.Partition,
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(cfg.Brokers...),
+			Topic:        cfg.Topic,
+			Balancer:     partitionBalancer(cfg.Partition),
+			RequiredAcks: requiredAcks,
+			// the batches are only bounded by their size, and sent as soon as
+			// the messages of a publication are queued
+			BatchSize:    math.MaxInt32,
+			BatchBytes:   int64(cfg.MaxBatchSize),
+			BatchTimeout: time.Millisecond,
+			ReadTimeout:  cfg.Timeout,
+			WriteTimeout: cfg.Timeout,
+			// the failed publications are retried by the sink listener
+			MaxAttempts: 1,
+			Transport: &kafka.Transport{
+				ClientID:    cfg.ClientID,
+				DialTimeout: cfg.Timeout,
+			},
+		},
+	}, nil
+}
+
+// NewProducerFromOptions returns a Producer configured with the options of the
+// streaming.kafka section of the app.toml, to be registered as the publisher of
+// the kafka streaming plugin with baseapp.SetStreamingPublisher.
+func NewProducerFromOptions(opt func(key string) any) (streaming.Publisher, error) {
+	producer, err := NewProducer(Config{
+		Brokers:      cast.ToStringSlice(opt("brokers")),
+		Topic:        cast.ToString(opt("topic")),
+		Partition:    cast.ToInt32(opt("partition")),
+		RequiredAcks: cast.ToInt16(opt("required-acks")),
+		Timeout:      cast.ToDuration(opt("timeout")),
+		MaxBatchSize: cast.ToInt(opt("max-batch-size")),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return producer, nil
+}
+
+// Publish implements streaming.Publisher. It returns once all the messages are
+// acknowledged by the brokers.
+func (p *Producer) Publish(ctx context.Context, msgs []streaming.Message) error {
+	records := make([]kafka.Message, len(msgs))
+	for i, msg := range msgs {
+		records[i] = kafka.Message{
+			Key:     msg.Key,
+			Value:   msg.Value,
+			Headers: []kafka.Header{{Key: SchemaHeader, Value: []byte(msg.Schema)}},
+		}
+	}
+
+	if err := p.writer.WriteMessages(ctx, records...); err != nil {
+		return fmt.Errorf("kafka: failed to produce to %s/%d: %w", p.writer.Topic, p.partition, err)
+	}
+
+	return nil
+}
+
+// Close implements streaming.Publisher.
+func (p *Producer) Close() error {
+	return p.writer.Close()
+}
+
+// partitionBalancer assigns all the records to the same partition, so that they
+// are consumed in order.
+type partitionBalancer int32
+
+// Balance implements kafka.Balancer.
+func (b partitionBalancer) Balance(kafka.Message, ...int) int {
+	return int(b)
+}
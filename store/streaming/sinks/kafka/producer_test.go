@@ -0,0 +1,97 @@
+package kafka
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/streaming"
+)
+
+func testMessages(n int) []streaming.Message {
+	msgs := make([]streaming.Message, n)
+	for i := range msgs {
+		msgs[i] = streaming.Message{
+			Key:    binary.BigEndian.AppendUint64(nil, uint64(i+1)),
+			Schema: "cosmos.store.streaming.abci.ListenCommitRequest",
+			Value:  []byte("value-" + strconv.Itoa(i)),
+		}
+	}
+	return msgs
+}
+
+func TestNewProducer(t *testing.T) {
+	producer, err := NewProducer(Config{Brokers: []string{"localhost:9092", "localhost:9093"}, Topic: "cosmos", Partition: 2})
+	require.NoError(t, err)
+	defer producer.Close()
+
+	w := producer.writer
+	require.Equal(t, "localhost:9092,localhost:9093", w.Addr.String())
+	require.Equal(t, "cosmos", w.Topic)
+	require.Equal(t, kafka.RequireAll, w.RequiredAcks)
+	require.Equal(t, int64(defaultMaxBatchSize), w.BatchBytes)
+	require.Equal(t, defaultTimeout, w.WriteTimeout)
+	require.Equal(t, defaultClientID, w.Transport.(*kafka.Transport).ClientID)
+	require.Equal(t, 2, w.Balancer.Balance(kafka.Message{}, 0, 1, 2, 3))
+
+	producer, err = NewProducer(Config{Brokers: []string{"localhost:9092"}, Topic: "cosmos", RequiredAcks: 1, MaxBatchSize: 1024})
+	require.NoError(t, err)
+	require.Equal(t, kafka.RequireOne, producer.writer.RequiredAcks)
+	require.Equal(t, int64(1024), producer.writer.BatchBytes)
+
+	_, err = NewProducer(Config{Topic: "cosmos"})
+	require.ErrorContains(t, err, "no brokers")
+	_, err = NewProducer(Config{Brokers: []string{"localhost:9092"}})
+	require.ErrorContains(t, err, "no topic")
+	_, err = NewProducer(Config{Brokers: []string{"localhost:9092"}, Topic: "cosmos", Partition: -1})
+	require.ErrorContains(t, err, "invalid partition")
+	_, err = NewProducer(Config{Brokers: []string{"localhost:9092"}, Topic: "cosmos", RequiredAcks: 2})
+	require.ErrorContains(t, err, "invalid required acks")
+}
+
+func TestNewProducerFromOptions(t *testing.T) {
+	opts := map[string]any{
+		"brokers":        []any{"localhost:9092"},
+		"topic":          "cosmos",
+		"partition":      int64(1),
+		"required-acks":  int64(1),
+		"timeout":        "5s",
+		"max-batch-size": int64(2048),
+	}
+
+	publisher, err := NewProducerFromOptions(func(key string) any { return opts[key] })
+	require.NoError(t, err)
+	defer publisher.Close()
+
+	w := publisher.(*Producer).writer
+	require.Equal(t, "localhost:9092", w.Addr.String())
+	require.Equal(t, "cosmos", w.Topic)
+	require.Equal(t, 1, w.Balancer.Balance(kafka.Message{}, 0, 1))
+	require.Equal(t, kafka.RequireOne, w.RequiredAcks)
+	require.Equal(t, 5*time.Second, w.WriteTimeout)
+	require.Equal(t, int64(2048), w.BatchBytes)
+
+	_, err = NewProducerFromOptions(func(string) any { return nil })
+	require.ErrorContains(t, err, "no brokers")
+}
+
+func TestProducerUnavailableBroker(t *testing.T) {
+	// a listener closed right away gives the address of an unavailable broker
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	producer, err := NewProducer(Config{Brokers: []string{addr}, Topic: "cosmos", Timeout: time.Second})
+	require.NoError(t, err)
+	defer producer.Close()
+
+	err = producer.Publish(context.Background(), testMessages(2))
+	require.ErrorContains(t, err, "kafka: failed to produce to cosmos/0")
+}
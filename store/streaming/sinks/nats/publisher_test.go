@@ -0,0 +1,137 @@
+package nats
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/streaming"
+)
+
+// runServer runs a NATS server with a user allowed to publish on cosmos.state
+// only, and a max payload of 256 bytes.
+func runServer(t *testing.T) *server.Server {
+	t.Helper()
+
+	s, err := server.NewServer(&server.Options{
+		Host:       "127.0.0.1",
+		Port:       -1,
+		NoLog:      true,
+		NoSigs:     true,
+		MaxPayload: 256,
+		Users: []*server.User{{
+			Username: "user",
+			Password: "secret",
+			Permissions: &server.Permissions{
+				Publish: &server.SubjectPermission{Allow: []string{"cosmos.state"}},
+			},
+		}},
+	})
+	require.NoError(t, err)
+
+	go s.Start()
+	require.True(t, s.ReadyForConnections(5*time.Second))
+	t.Cleanup(s.Shutdown)
+
+	return s
+}
+
+func serverURL(s *server.Server, user string) string {
+	u, _ := url.Parse(s.ClientURL())
+	u.User = url.UserPassword(user, "secret")
+	return u.String()
+}
+
+func testMessages(n int) []streaming.Message {
+	msgs := make([]streaming.Message, n)
+	for i := range msgs {
+		msgs[i] = streaming.Message{
+			Key:    binary.BigEndian.AppendUint64(nil, uint64(i+1)),
+			Schema: "cosmos.store.streaming.abci.ListenCommitRequest",
+			Value:  []byte("value-" + strconv.Itoa(i)),
+		}
+	}
+	return msgs
+}
+
+func TestPublisher(t *testing.T) {
+	s := runServer(t)
+
+	sub, err := nats.Connect(s.ClientURL(), nats.UserInfo("user", "secret"))
+	require.NoError(t, err)
+	defer sub.Close()
+	received, err := sub.SubscribeSync("cosmos.state")
+	require.NoError(t, err)
+	require.NoError(t, sub.Flush())
+
+	publisher, err := NewPublisher(Config{URL: serverURL(s, "user"), Subject: "cosmos.state"})
+	require.NoError(t, err)
+	defer publisher.Close()
+
+	msgs := testMessages(3)
+	require.NoError(t, publisher.Publish(context.Background(), msgs))
+
+	for i := range msgs {
+		msg, err := received.NextMsg(5 * time.Second)
+		require.NoError(t, err)
+		require.Equal(t, msgs[i].Schema, msg.Header.Get(SchemaHeader))
+		require.Equal(t, fmt.Sprintf("%016x", i+1), msg.Header.Get(HeightHeader))
+		require.Equal(t, msgs[i].Value, msg.Data)
+	}
+}
+
+func TestPublisherErrors(t *testing.T) {
+	s := runServer(t)
+
+	// the credentials are checked on connection
+	publisher, err := NewPublisher(Config{URL: serverURL(s, "other"), Subject: "cosmos.state", Timeout: time.Second})
+	require.NoError(t, err)
+	require.ErrorContains(t, publisher.Publish(context.Background(), testMessages(1)), "failed to connect to NATS server")
+	require.NoError(t, publisher.Close())
+
+	publisher, err = NewPublisher(Config{URL: serverURL(s, "user"), Subject: "cosmos.state"})
+	require.NoError(t, err)
+	defer publisher.Close()
+
+	// the messages exceeding the max payload of the server are rejected
+	msgs := testMessages(1)
+	msgs[0].Value = make([]byte, 256)
+	require.ErrorIs(t, publisher.Publish(context.Background(), msgs), nats.ErrMaxPayload)
+	require.NoError(t, publisher.Publish(context.Background(), testMessages(1)))
+
+	// the errors of the server fail the publication, but not the next ones
+	denied, err := NewPublisher(Config{URL: serverURL(s, "user"), Subject: "cosmos.denied"})
+	require.NoError(t, err)
+	defer denied.Close()
+	require.ErrorContains(t, denied.Publish(context.Background(), testMessages(1)), "Permissions Violation")
+	denied.cfg.Subject = "cosmos.state"
+	require.NoError(t, denied.Publish(context.Background(), testMessages(1)))
+
+	_, err = NewPublisher(Config{Subject: "cosmos.state"})
+	require.ErrorContains(t, err, "no NATS url")
+	_, err = NewPublisher(Config{URL: "nats://localhost", Subject: "cosmos state"})
+	require.ErrorContains(t, err, "invalid NATS subject")
+}
+
+func TestNewPublisherFromOptions(t *testing.T) {
+	opts := map[string]any{
+		"url":     "nats://localhost:4222",
+		"subject": "cosmos.state",
+		"timeout": "5s",
+	}
+
+	publisher, err := NewPublisherFromOptions(func(key string) any { return opts[key] })
+	require.NoError(t, err)
+	require.Equal(t, Config{URL: "nats://localhost:4222", Subject: "cosmos.state", Timeout: 5 * time.Second}, publisher.(*Publisher).cfg)
+
+	_, err = NewPublisherFromOptions(func(string) any { return nil })
+	require.ErrorContains(t, err, "no NATS url")
+}
@@ -85,6 +85,22 @@ type Config struct {
 	// DatadogHostname defines the hostname to use when emitting metrics to
 	// Datadog. Only utilized if MetricsSink is set to "dogstatsd".
 	DatadogHostname string `mapstructure:"datadog-hostname"`
+
+	// TracingEnabled enables the OpenTelemetry tracing of block execution and
+	// gRPC queries. Spans are exported to an OTLP collector over gRPC.
+	TracingEnabled bool `mapstructure:"tracing-enabled"`
+
+	// TracingEndpoint defines the address of the OTLP gRPC collector spans are
+	// exported to. Only utilized if TracingEnabled is true.
+	TracingEndpoint string `mapstructure:"tracing-endpoint"`
+
+	// TracingInsecure disables the TLS of the connection to the OTLP collector.
+	TracingInsecure bool `mapstructure:"tracing-insecure"`
+
+	// TracingSampleRate defines the ratio, between 0 and 1, of the traces
+	// sampled. Traces propagated by gRPC clients follow the sampling decision
+	// of the client.
+	TracingSampleRate float64 `mapstructure:"tracing-sample-rate"`
 }
 
 // Metrics defines a wrapper around application telemetry functionality. It allows
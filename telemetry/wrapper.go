@@ -13,6 +13,7 @@ const (
 	MetricKeyPrepareCheckStater = "prepare_check_stater"
 	MetricKeyPrecommiter        = "precommiter"
 	MetricLabelNameModule       = "module"
+	MetricLabelNameMsgTypeURL   = "msg_type_url"
 )
 
 // NewLabel creates a new instance of Label with name and value
@@ -100,6 +101,27 @@ func MeasureSince(start time.Time, keys ...string) {
 	metrics.MeasureSinceWithLabels(keys, start.UTC(), globalLabels)
 }
 
+// MeasureSinceWithLabels provides a wrapper functionality for emitting a time
+// measure metric with global labels (if any) along with the provided labels.
+func MeasureSinceWithLabels(keys []string, start time.Time, labels []metrics.Label) {
+	if !IsTelemetryEnabled() {
+		return
+	}
+
+	metrics.MeasureSinceWithLabels(keys, start.UTC(), append(labels, globalLabels...))
+}
+
+// AddSampleWithLabels provides a wrapper functionality for emitting a sample
+// metric, i.e. a histogram, with global labels (if any) along with the provided
+// labels.
+func AddSampleWithLabels(keys []string, val float32, labels []metrics.Label) {
+	if !IsTelemetryEnabled() {
+		return
+	}
+
+	metrics.AddSampleWithLabels(keys, val, append(labels, globalLabels...))
+}
+
 // Now return the current time if telemetry is enabled or a zero time if it's not
 func Now() time.Time {
 	if !IsTelemetryEnabled() {
@@ -92,6 +92,7 @@ func initFixture(t *testing.T) *fixture {
 
 	authKeeper := authkeeper.NewAccountKeeper(
 		runtime.NewEnvironment(runtime.NewKVStoreService(keys[authtypes.StoreKey]), log.NewNopLogger()),
+		nil,
 		cdc,
 		authtypes.ProtoBaseAccount,
 		accountsKeeper,
@@ -94,6 +94,7 @@ func initDeterministicFixture(t *testing.T) *deterministicFixture {
 
 	accountKeeper := authkeeper.NewAccountKeeper(
 		runtime.NewEnvironment(runtime.NewKVStoreService(keys[authtypes.StoreKey]), log.NewNopLogger()),
+		nil,
 		cdc,
 		authtypes.ProtoBaseAccount,
 		acctsModKeeper,
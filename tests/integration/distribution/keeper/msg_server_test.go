@@ -106,6 +106,7 @@ func initFixture(t *testing.T) *fixture {
 
 	accountKeeper := authkeeper.NewAccountKeeper(
 		runtime.NewEnvironment(runtime.NewKVStoreService(keys[authtypes.StoreKey]), log.NewNopLogger()),
+		nil,
 		cdc,
 		authtypes.ProtoBaseAccount,
 		acctsModKeeper,
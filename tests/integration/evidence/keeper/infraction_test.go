@@ -122,6 +122,7 @@ func initFixture(tb testing.TB) *fixture {
 
 	accountKeeper := authkeeper.NewAccountKeeper(
 		runtime.NewEnvironment(runtime.NewKVStoreService(keys[authtypes.StoreKey]), log.NewNopLogger()),
+		nil,
 		cdc,
 		authtypes.ProtoBaseAccount,
 		acctsModKeeper,
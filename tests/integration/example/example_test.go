@@ -60,6 +60,7 @@ func Example() {
 
 	accountKeeper := authkeeper.NewAccountKeeper(
 		runtime.NewEnvironment(runtime.NewKVStoreService(keys[authtypes.StoreKey]), log.NewNopLogger()),
+		nil,
 		encodingCfg.Codec,
 		authtypes.ProtoBaseAccount,
 		acctsModKeeper,
@@ -164,6 +165,7 @@ func Example_oneModule() {
 
 	accountKeeper := authkeeper.NewAccountKeeper(
 		runtime.NewEnvironment(runtime.NewKVStoreService(keys[authtypes.StoreKey]), log.NewNopLogger()),
+		nil,
 		encodingCfg.Codec,
 		authtypes.ProtoBaseAccount,
 		acctsModKeeper,
@@ -39,6 +39,7 @@ type Config struct {
 	EndBlockersOrder   []string
 	InitGenesisOrder   []string
 	setInitGenesis     bool
+	deriveOrdering     bool
 }
 
 func defaultConfig() *Config {
@@ -358,6 +359,15 @@ func EpochsModule() ModuleOption {
 	}
 }
 
+// WithDerivedOrdering makes runtime derive the pre blockers, begin blockers,
+// end blockers and init genesis orderings from the ordering constraints declared
+// by the modules instead of using the configured orderings.
+func WithDerivedOrdering() ModuleOption {
+	return func(config *Config) {
+		config.deriveOrdering = true
+	}
+}
+
 func OmitInitGenesis() ModuleOption {
 	return func(config *Config) {
 		config.setInitGenesis = false
@@ -414,6 +424,13 @@ func NewAppConfig(opts ...ModuleOption) depinject.Config {
 	if cfg.setInitGenesis {
 		runtimeConfig.InitGenesis = initGenesis
 	}
+	if cfg.deriveOrdering {
+		runtimeConfig.PreBlockers = nil
+		runtimeConfig.BeginBlockers = nil
+		runtimeConfig.EndBlockers = nil
+		runtimeConfig.InitGenesis = nil
+		runtimeConfig.DeriveOrdering = true
+	}
 
 	modules := []*appv1alpha1.ModuleConfig{{
 		Name:   "runtime",
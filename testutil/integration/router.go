@@ -91,11 +91,11 @@ func NewIntegrationApp(
 	if keys[consensusparamtypes.StoreKey] != nil {
 		// set baseApp param store
 		consensusParamsKeeper := consensusparamkeeper.NewKeeper(appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[consensusparamtypes.StoreKey]), log.NewNopLogger(), runtime.EnvWithQueryRouterService(grpcRouter), runtime.EnvWithMsgRouterService(msgRouter)), authtypes.NewModuleAddress("gov").String())
-		bApp.SetParamStore(consensusParamsKeeper.ParamsStore)
+		bApp.SetParamStore(consensusParamsKeeper.ParamStore())
 		consensusparamtypes.RegisterQueryServer(grpcRouter, consensusParamsKeeper)
 
 		params := cmttypes.ConsensusParamsFromProto(*simtestutil.DefaultConsensusParams) // This fills up missing param sections
-		err := consensusParamsKeeper.ParamsStore.Set(sdkCtx, params.ToProto())
+		err := consensusParamsKeeper.SetParams(sdkCtx, params.ToProto())
 		if err != nil {
 			panic(fmt.Errorf("failed to set consensus params: %w", err))
 		}
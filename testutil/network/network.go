@@ -18,6 +18,7 @@ import (
 	"time"
 
 	cmtcfg "github.com/cometbft/cometbft/config"
+	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -43,7 +44,6 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
-	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
@@ -124,6 +124,7 @@ type Config struct {
 	APIAddress       string                     // REST API listen address (including port)
 	GRPCAddress      string                     // GRPC server listen address (including port)
 	PrintMnemonic    bool                       // print the mnemonic of first validator as log output for testing
+	ConsensusKeyType string                     // consensus key type of the validators (ed25519, secp256k1 or bls12_381)
 
 	// Address codecs
 	AddressCodec          address.Codec                 // address codec
@@ -157,6 +158,7 @@ func DefaultConfig(factory TestFixtureFactory) Config {
 		SigningAlgo:           string(hd.Secp256k1Type),
 		KeyringOptions:        []keyring.Option{},
 		PrintMnemonic:         false,
+		ConsensusKeyType:      cmttypes.ABCIPubKeyTypeEd25519,
 		AddressCodec:          addresscodec.NewBech32Codec("cosmos"),
 		ValidatorAddressCodec: addresscodec.NewBech32Codec("cosmosvaloper"),
 		ConsensusAddressCodec: addresscodec.NewBech32Codec("cosmosvalcons"),
@@ -429,7 +431,7 @@ func New(l Logger, baseDir string, cfg Config) (NetworkI, error) {
 			mnemonic = cfg.Mnemonics[i]
 		}
 
-		nodeID, pubKey, err := genutil.InitializeNodeValidatorFilesFromMnemonic(cmtCfg, mnemonic, ed25519.PrivKeyName)
+		nodeID, pubKey, err := genutil.InitializeNodeValidatorFilesFromMnemonic(cmtCfg, mnemonic, cfg.ConsensusKeyType)
 		if err != nil {
 			return nil, err
 		}
@@ -497,6 +499,12 @@ func New(l Logger, baseDir string, cfg Config) (NetworkI, error) {
 			return nil, err
 		}
 
+		// bls12_381 consensus keys must come with a proof of possession
+		createValMsg.PubkeyProofOfPossession, err = genutil.NodeValidatorProofOfPossession(cmtCfg)
+		if err != nil {
+			return nil, err
+		}
+
 		p2pURL, err := url.Parse(p2pAddr)
 		if err != nil {
 			return nil, err
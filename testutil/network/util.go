@@ -14,6 +14,7 @@ import (
 	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
 	"github.com/cometbft/cometbft/rpc/client/local"
+	cmttypes "github.com/cometbft/cometbft/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
 	"golang.org/x/sync/errgroup"
 
@@ -166,7 +167,11 @@ func collectGenFiles(cfg Config, vals []*Validator, cmtConfigs []*cmtcfg.Config,
 		}
 
 		// overwrite each validator's genesis file to have a canonical genesis time
-		if err := genutil.ExportGenesisFileWithTime(genFile, cfg.ChainID, nil, appState, genTime); err != nil {
+		// while keeping the consensus params set up in initGenFiles
+		genesis := genutiltypes.NewAppGenesisWithVersion(cfg.ChainID, appState)
+		genesis.GenesisTime = genTime
+		genesis.Consensus.Params = appGenesis.Consensus.Params
+		if err := genutil.ExportGenesisFile(genesis, genFile); err != nil {
 			return err
 		}
 
@@ -210,6 +215,7 @@ func initGenFiles(cfg Config, genAccounts []authtypes.GenesisAccount, genBalance
 		AppState: appGenStateJSON,
 		Consensus: &genutiltypes.ConsensusGenesis{
 			Validators: nil,
+			Params:     consensusParams(cfg),
 		},
 	}
 
@@ -223,6 +229,17 @@ func initGenFiles(cfg Config, genAccounts []authtypes.GenesisAccount, genBalance
 	return nil
 }
 
+// consensusParams returns the default consensus params allowing only the
+// consensus key type configured for the network validators.
+func consensusParams(cfg Config) *cmttypes.ConsensusParams {
+	params := cmttypes.DefaultConsensusParams()
+	if cfg.ConsensusKeyType != "" {
+		params.Validator.PubKeyTypes = []string{cfg.ConsensusKeyType}
+	}
+
+	return params
+}
+
 func writeFile(name, dir string, contents []byte) error {
 	file := filepath.Join(dir, name)
 
@@ -0,0 +1,234 @@
+package sims
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+const (
+	// scenarioWeightPrecision is the total weight the operations of a module
+	// with a weight of 1 are scaled to, so that small operation weights are not
+	// rounded down to 0.
+	scenarioWeightPrecision = 1000
+
+	// personaBias is how much more often the operations of a module bound to
+	// personas pick an account of the personas rather than another account.
+	personaBias = 20
+)
+
+// Persona is a named group of simulation accounts operating the modules a
+// scenario binds it to. Other accounts are still passed to the operations of
+// these modules, as operations look up the accounts of on-chain entities such
+// as validator operators, but are picked far less often.
+type Persona struct {
+	Name string
+	// Accounts selects the accounts of the persona among the simulation accounts.
+	Accounts func(accs []simtypes.Account) []simtypes.Account
+}
+
+// AccountRange returns a persona made of the simulation accounts in [from, to).
+func AccountRange(name string, from, to int) Persona {
+	return Persona{
+		Name: name,
+		Accounts: func(accs []simtypes.Account) []simtypes.Account {
+			return accs[min(from, len(accs)):min(to, len(accs))]
+		},
+	}
+}
+
+type scenarioModule struct {
+	name     string
+	weight   int
+	personas []string
+}
+
+type scenarioBlockTime struct {
+	fromHeight int64
+	min, max   time.Duration
+}
+
+// Scenario declares a simulation: the modules whose operations run and their
+// share of the operations, the accounts operating them and how block time
+// progresses. Modules not part of the scenario are not simulated.
+//
+//	scenario := sims.NewScenario("mass-undelegation").
+//		WithPersona(sims.AccountRange("delegators", 0, 50)).
+//		WithModule(stakingtypes.ModuleName, 90, "delegators").
+//		WithModule(banktypes.ModuleName, 10).
+//		WithOperationWeight(stakingsims.OpWeightMsgUndelegate, 1000).
+//		WithTimeJump(100, 21*24*time.Hour)
+type Scenario struct {
+	Name string
+
+	modules    []scenarioModule
+	opWeights  map[string]int
+	personas   map[string]Persona
+	blockTimes []scenarioBlockTime
+	timeJumps  map[int64]time.Duration
+}
+
+// NewScenario creates a new, empty, scenario.
+func NewScenario(name string) *Scenario {
+	return &Scenario{
+		Name:      name,
+		opWeights: make(map[string]int),
+		personas:  make(map[string]Persona),
+		timeJumps: make(map[int64]time.Duration),
+	}
+}
+
+// WithModule adds the operations of a module to the scenario. The weight is the
+// share of the module in the operations, the weights of the module operations
+// being scaled so that they add up to it. When personas are given, the module
+// is mostly operated by their accounts.
+func (s *Scenario) WithModule(name string, weight int, personas ...string) *Scenario {
+	s.modules = append(s.modules, scenarioModule{name: name, weight: weight, personas: personas})
+	return s
+}
+
+// WithOperationWeight overrides the weight of an operation within its module,
+// key being the simulation app params key of the weight (e.g.
+// "op_weight_msg_undelegate").
+func (s *Scenario) WithOperationWeight(key string, weight int) *Scenario {
+	s.opWeights[key] = weight
+	return s
+}
+
+// WithPersona registers a persona modules can be bound to.
+func (s *Scenario) WithPersona(persona Persona) *Scenario {
+	s.personas[persona.Name] = persona
+	return s
+}
+
+// WithBlockTime makes blocks from the given height on be between minTime and
+// maxTime apart.
+func (s *Scenario) WithBlockTime(fromHeight int64, minTime, maxTime time.Duration) *Scenario {
+	s.blockTimes = append(s.blockTimes, scenarioBlockTime{fromHeight: fromHeight, min: minTime, max: maxTime})
+	return s
+}
+
+// WithTimeJump moves the time of the block at the given height forward by d,
+// e.g. to mature unbonding delegations.
+func (s *Scenario) WithTimeJump(height int64, d time.Duration) *Scenario {
+	s.timeJumps[height] += d
+	return s
+}
+
+// WeightedOperations returns the weighted operations of the scenario modules.
+func (s *Scenario) WeightedOperations(
+	app runtime.AppSimI, cdc codec.Codec, config simtypes.Config, txConfig client.TxConfig,
+) ([]simtypes.WeightedOperation, error) {
+	if len(s.modules) == 0 {
+		return nil, fmt.Errorf("scenario %s has no module", s.Name)
+	}
+
+	simState := simulationState(app, cdc, config, txConfig, s.opWeights)
+
+	modules := make(map[string][]simtypes.WeightedOperation)
+	for _, m := range app.SimulationManager().Modules {
+		if named, ok := m.(interface{ Name() string }); ok {
+			modules[named.Name()] = m.WeightedOperations(simState)
+		}
+	}
+
+	var wOps []simtypes.WeightedOperation
+	for _, m := range s.modules {
+		ops, ok := modules[m.name]
+		if !ok {
+			return nil, fmt.Errorf("scenario %s: module %s has no simulation operations", s.Name, m.name)
+		}
+
+		personas := make([]Persona, 0, len(m.personas))
+		for _, name := range m.personas {
+			persona, ok := s.personas[name]
+			if !ok {
+				return nil, fmt.Errorf("scenario %s: unknown persona %s", s.Name, name)
+			}
+			personas = append(personas, persona)
+		}
+
+		totalWeight := 0
+		for _, op := range ops {
+			totalWeight += op.Weight()
+		}
+
+		for _, op := range ops {
+			if op.Weight() <= 0 || m.weight <= 0 {
+				continue
+			}
+
+			weight := max(1, m.weight*op.Weight()*scenarioWeightPrecision/totalWeight)
+			wOps = append(wOps, scenarioOperation{weight: weight, op: withPersonas(op.Op(), personas)})
+		}
+	}
+
+	if len(wOps) == 0 {
+		return nil, fmt.Errorf("scenario %s has no operation with a positive weight", s.Name)
+	}
+
+	return wOps, nil
+}
+
+// BlockTimeFn returns the block time progression of the scenario, or nil when
+// it uses the default one.
+func (s *Scenario) BlockTimeFn() simtypes.BlockTimeFn {
+	if len(s.blockTimes) == 0 && len(s.timeJumps) == 0 {
+		return nil
+	}
+
+	return func(r *rand.Rand, height int64, blockTime time.Time) time.Time {
+		// default simulation block times
+		minTime, maxTime, fromHeight := 5000*time.Second, 10000*time.Second, int64(-1)
+		for _, bt := range s.blockTimes {
+			if bt.fromHeight <= height && bt.fromHeight > fromHeight {
+				minTime, maxTime, fromHeight = bt.min, bt.max, bt.fromHeight
+			}
+		}
+
+		blockTime = blockTime.Add(minTime)
+		if maxTime > minTime {
+			blockTime = blockTime.Add(time.Duration(r.Int63n(int64(maxTime - minTime))))
+		}
+
+		return blockTime.Add(s.timeJumps[height])
+	}
+}
+
+type scenarioOperation struct {
+	weight int
+	op     simtypes.Operation
+}
+
+func (o scenarioOperation) Weight() int            { return o.weight }
+func (o scenarioOperation) Op() simtypes.Operation { return o.op }
+
+// withPersonas biases the accounts operating op towards the ones of the given
+// personas, by repeating them in the accounts op is given.
+func withPersonas(op simtypes.Operation, personas []Persona) simtypes.Operation {
+	if len(personas) == 0 {
+		return op
+	}
+
+	return func(
+		r *rand.Rand, app simtypes.AppEntrypoint, ctx sdk.Context, accounts []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var personaAccs []simtypes.Account
+		for _, persona := range personas {
+			personaAccs = append(personaAccs, persona.Accounts(accounts)...)
+		}
+
+		accs := make([]simtypes.Account, 0, personaBias*len(personaAccs)+len(accounts))
+		for i := 0; i < personaBias; i++ {
+			accs = append(accs, personaAccs...)
+		}
+
+		return op(r, app, ctx, append(accs, accounts...), chainID)
+	}
+}
@@ -0,0 +1,65 @@
+package sims
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestScenarioBlockTimeFn(t *testing.T) {
+	require.Nil(t, NewScenario("default").BlockTimeFn())
+
+	scenario := NewScenario("time").
+		WithBlockTime(10, 5*time.Second, 5*time.Second).
+		WithBlockTime(1, time.Second, 2*time.Second).
+		WithTimeJump(20, time.Hour)
+	blockTimeFn := scenario.BlockTimeFn()
+
+	r := rand.New(rand.NewSource(1))
+	start := time.Unix(0, 0)
+
+	next := blockTimeFn(r, 2, start)
+	require.GreaterOrEqual(t, next.Sub(start), time.Second)
+	require.Less(t, next.Sub(start), 2*time.Second)
+
+	require.Equal(t, 5*time.Second, blockTimeFn(r, 10, start).Sub(start))
+	require.Equal(t, time.Hour+5*time.Second, blockTimeFn(r, 20, start).Sub(start))
+}
+
+func TestWithPersonas(t *testing.T) {
+	accs := simulation.RandomAccounts(rand.New(rand.NewSource(1)), 10)
+
+	var given []simulation.Account
+	op := func(_ *rand.Rand, _ simulation.AppEntrypoint, _ sdk.Context, accounts []simulation.Account, _ string) (
+		simulation.OperationMsg, []simulation.FutureOperation, error,
+	) {
+		given = accounts
+		return simulation.OperationMsg{}, nil, nil
+	}
+
+	_, _, err := withPersonas(op, nil)(nil, nil, sdk.Context{}, accs, "")
+	require.NoError(t, err)
+	require.Equal(t, accs, given)
+
+	_, _, err = withPersonas(op, []Persona{AccountRange("first", 0, 2), AccountRange("out-of-range", 8, 20)})(nil, nil, sdk.Context{}, accs, "")
+	require.NoError(t, err)
+	require.Len(t, given, personaBias*4+len(accs))
+	require.Equal(t, accs, given[personaBias*4:])
+
+	counts := make(map[string]int)
+	for _, acc := range given {
+		counts[acc.Address.String()]++
+	}
+	for i, acc := range accs {
+		if i < 2 || i >= 8 {
+			require.Equal(t, personaBias+1, counts[acc.Address.String()])
+		} else {
+			require.Equal(t, 1, counts[acc.Address.String()])
+		}
+	}
+}
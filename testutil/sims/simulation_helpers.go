@@ -52,6 +52,16 @@ func SetupSimulation(config simtypes.Config, dirPrefix, dbName string, verbose,
 // SimulationOperations retrieves the simulation params from the provided file path
 // and returns all the modules weighted operations
 func SimulationOperations(app runtime.AppSimI, cdc codec.Codec, config simtypes.Config, txConfig client.TxConfig) []simtypes.WeightedOperation {
+	simState := simulationState(app, cdc, config, txConfig, nil)
+	return app.SimulationManager().WeightedOperations(simState)
+}
+
+// simulationState returns the simulation state the modules weighted operations
+// are generated from. The given operation weights override the ones of the
+// simulation params file.
+func simulationState(
+	app runtime.AppSimI, cdc codec.Codec, config simtypes.Config, txConfig client.TxConfig, opWeights map[string]int,
+) module.SimulationState {
 	signingCtx := cdc.InterfaceRegistry().SigningContext()
 	simState := module.SimulationState{
 		AppParams:      make(simtypes.AppParams),
@@ -74,9 +84,18 @@ func SimulationOperations(app runtime.AppSimI, cdc codec.Codec, config simtypes.
 		}
 	}
 
+	for key, weight := range opWeights {
+		bz, err := json.Marshal(weight)
+		if err != nil {
+			panic(err)
+		}
+
+		simState.AppParams[key] = bz
+	}
+
 	simState.LegacyProposalContents = app.SimulationManager().GetProposalContents(simState) //nolint:staticcheck // we're testing the old way here
 	simState.ProposalMsgs = app.SimulationManager().GetProposalMsgs(simState)
-	return app.SimulationManager().WeightedOperations(simState)
+	return simState
 }
 
 // CheckExportSimulation exports the app state and simulation parameters to JSON
@@ -95,10 +95,54 @@ func RunWithSeeds[T SimulationApp](
 	seeds []int64,
 	fuzzSeed []byte,
 	postRunActions ...func(t *testing.T, app TestInstance[T]),
+) {
+	t.Helper()
+	runWithSeeds(t, appFactory, setupStateFactory, seeds, fuzzSeed, nil, postRunActions...)
+}
+
+// RunScenario is a helper function that runs a simulation test of the given
+// scenario with the default seeds. Only the operations of the scenario modules
+// are simulated, with the scenario weights, personas and block times.
+func RunScenario[T SimulationApp](
+	t *testing.T,
+	appFactory func(
+		logger log.Logger,
+		db dbm.DB,
+		traceStore io.Writer,
+		loadLatest bool,
+		appOpts servertypes.AppOptions,
+		baseAppOptions ...func(*baseapp.BaseApp),
+	) T,
+	setupStateFactory func(app T) SimStateFactory,
+	scenario *simtestutil.Scenario,
+	postRunActions ...func(t *testing.T, app TestInstance[T]),
+) {
+	t.Helper()
+	runWithSeeds(t, appFactory, setupStateFactory, defaultSeeds, nil, scenario, postRunActions...)
+}
+
+func runWithSeeds[T SimulationApp](
+	t *testing.T,
+	appFactory func(
+		logger log.Logger,
+		db dbm.DB,
+		traceStore io.Writer,
+		loadLatest bool,
+		appOpts servertypes.AppOptions,
+		baseAppOptions ...func(*baseapp.BaseApp),
+	) T,
+	setupStateFactory func(app T) SimStateFactory,
+	seeds []int64,
+	fuzzSeed []byte,
+	scenario *simtestutil.Scenario,
+	postRunActions ...func(t *testing.T, app TestInstance[T]),
 ) {
 	t.Helper()
 	cfg := cli.NewConfigFromFlags()
 	cfg.ChainID = SimAppChainID
+	if scenario != nil {
+		cfg.BlockTimeFn = scenario.BlockTimeFn()
+	}
 	for i := range seeds {
 		seed := seeds[i]
 		t.Run(fmt.Sprintf("seed: %d", seed), func(t *testing.T) {
@@ -116,6 +160,14 @@ func RunWithSeeds[T SimulationApp](
 
 			app := testInstance.App
 			stateFactory := setupStateFactory(app)
+			var ops []simtypes.WeightedOperation
+			if scenario != nil {
+				var err error
+				ops, err = scenario.WeightedOperations(app, stateFactory.Codec, tCfg, app.TxConfig())
+				require.NoError(t, err)
+			} else {
+				ops = simtestutil.SimulationOperations(app, stateFactory.Codec, tCfg, testInstance.App.TxConfig())
+			}
 			simParams, err := simulation.SimulateFromSeedX(
 				t,
 				runLogger,
@@ -123,7 +175,7 @@ func RunWithSeeds[T SimulationApp](
 				app.GetBaseApp(),
 				stateFactory.AppStateFn,
 				simtypes.RandomAccounts, // Replace with own random account function if using keys other than secp256k1
-				simtestutil.SimulationOperations(app, stateFactory.Codec, tCfg, testInstance.App.TxConfig()),
+				ops,
 				stateFactory.BlockedAddr,
 				tCfg,
 				stateFactory.Codec,
@@ -362,6 +362,55 @@ func (c Context) CacheContext() (cc Context, writeCache func()) {
 	return cc, writeCache
 }
 
+// txEffectsKey is the key in the context.Context which holds the TxEffects of
+// a transaction.
+type txEffectsKey struct{}
+
+// TxEffects holds back the side effects of a transaction which live outside
+// of the state, e.g. in memory caches, and are therefore not discarded with
+// its branch of the state. It is used by the optimistic execution of the
+// transactions of a block, the side effects being applied once the execution
+// of the transaction is committed.
+type TxEffects struct {
+	effects []func() error
+}
+
+// Apply applies the side effects held back in order. It returns the error of
+// the first side effect which could not be applied, meaning that the
+// optimistic execution of the transaction is stale.
+func (e *TxEffects) Apply() error {
+	for _, effect := range e.effects {
+		if err := effect(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WithTxEffects returns a Context holding back the side effects of the
+// transaction in effects, see ApplyTxEffect.
+func (c Context) WithTxEffects(effects *TxEffects) Context {
+	return c.WithValue(txEffectsKey{}, effects)
+}
+
+// ApplyTxEffect applies a side effect of the transaction living outside of the
+// state, or holds it back until the transaction is committed if the Context
+// holds TxEffects. The side effect must return an error instead of being
+// applied if it conflicts with the side effects of the previous transactions,
+// e.g. a duplicate, so that the transaction is executed again.
+func (c Context) ApplyTxEffect(effect func() error) error {
+	if c.baseCtx == nil {
+		return effect()
+	}
+	if effects, ok := c.baseCtx.Value(txEffectsKey{}).(*TxEffects); ok && effects != nil {
+		effects.effects = append(effects.effects, effect)
+		return nil
+	}
+
+	return effect()
+}
+
 var (
 	_ context.Context    = Context{}
 	_ storetypes.Context = Context{}
@@ -0,0 +1,34 @@
+package types
+
+// GasTracePhaseAnte is the phase of a GasTraceEntry for the gas consumed by
+// the AnteHandler.
+const GasTracePhaseAnte = "ante"
+
+// GasTrace is the breakdown of the gas consumed by a transaction, used to find
+// gas hotspots of modules.
+type GasTrace struct {
+	// GasWanted is the gas limit of the transaction.
+	GasWanted uint64 `json:"gas_wanted"`
+	// GasUsed is the gas consumed by the transaction.
+	GasUsed uint64 `json:"gas_used"`
+	// AnteGas is the gas consumed by the AnteHandler.
+	AnteGas uint64 `json:"ante_gas"`
+	// MsgsGas is the gas consumed by the execution of the messages.
+	MsgsGas uint64 `json:"msgs_gas"`
+	// Entries are the gas consumptions aggregated by phase and descriptor, in
+	// order of first consumption.
+	Entries []GasTraceEntry `json:"entries"`
+}
+
+// GasTraceEntry is the gas consumed in a phase of a transaction for a given
+// descriptor, e.g. store reads or writes.
+type GasTraceEntry struct {
+	// Phase is either GasTracePhaseAnte or the type URL of the executed message.
+	Phase string `json:"phase"`
+	// Descriptor is the descriptor of the gas consumption, e.g. "ReadFlat".
+	Descriptor string `json:"descriptor"`
+	// Count is the number of gas consumptions.
+	Count uint64 `json:"count"`
+	// Gas is the total gas consumed.
+	Gas uint64 `json:"gas"`
+}
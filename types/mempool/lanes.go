@@ -0,0 +1,209 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Mempool = (*LaneMempool)(nil)
+
+// ErrNoLaneMatched is returned when inserting a transaction matched by none of
+// the lanes of a LaneMempool.
+var ErrNoLaneMatched = errors.New("tx does not match any mempool lane")
+
+type (
+	// Lane defines a partition of a LaneMempool, holding the transactions it
+	// matches in its own mempool.
+	Lane struct {
+		// Name is the unique name of the lane.
+		Name string
+
+		// Match returns true if the transaction belongs to the lane. A nil Match
+		// matches all transactions.
+		Match func(tx sdk.Tx) bool
+
+		// BlockSpace is the share of the block space, in bytes and gas, reserved
+		// to the lane by the default PrepareProposal handler. Transactions of a
+		// lane with a positive BlockSpace cannot use more than this share, while
+		// a lane with a zero BlockSpace may use all the space not reserved to
+		// the other lanes.
+		BlockSpace math.LegacyDec
+
+		// Mempool stores the transactions of the lane.
+		Mempool Mempool
+	}
+
+	// LaneMempool is a mempool made of lanes, e.g. separate lanes for oracle,
+	// governance and other transactions. A transaction is stored in the first
+	// lane matching it, and transactions are selected lane after lane, in the
+	// order the lanes are defined.
+	LaneMempool struct {
+		lanes []Lane
+	}
+
+	// laneIterator iterates over the transactions of all lanes in order.
+	laneIterator struct {
+		ctx   context.Context
+		txs   [][]byte
+		lanes []Lane
+		lane  int
+		iter  Iterator
+	}
+)
+
+// NewLaneMempool returns a LaneMempool with the given lanes, ordered from the
+// highest priority to the lowest. The block space reserved to the lanes must
+// not exceed the whole block.
+func NewLaneMempool(lanes ...Lane) (*LaneMempool, error) {
+	if len(lanes) == 0 {
+		return nil, errors.New("lane mempool must have at least one lane")
+	}
+
+	lanes = slices.Clone(lanes)
+	names := make(map[string]struct{}, len(lanes))
+	total := math.LegacyZeroDec()
+	for i, lane := range lanes {
+		if lane.Name == "" {
+			return nil, fmt.Errorf("lane %d has no name", i)
+		}
+		if _, ok := names[lane.Name]; ok {
+			return nil, fmt.Errorf("duplicate lane %s", lane.Name)
+		}
+		names[lane.Name] = struct{}{}
+
+		if lane.Mempool == nil {
+			return nil, fmt.Errorf("lane %s has no mempool", lane.Name)
+		}
+
+		if lane.BlockSpace.IsNil() {
+			lanes[i].BlockSpace = math.LegacyZeroDec()
+			continue
+		}
+		if lane.BlockSpace.IsNegative() {
+			return nil, fmt.Errorf("lane %s has a negative block space %s", lane.Name, lane.BlockSpace)
+		}
+		total = total.Add(lane.BlockSpace)
+	}
+
+	if total.GT(math.LegacyOneDec()) {
+		return nil, fmt.Errorf("block space reserved to lanes exceeds the block: %s", total)
+	}
+
+	return &LaneMempool{lanes: lanes}, nil
+}
+
+// MatchMsgTypes returns a lane Match function matching the transactions
+// containing only messages of the given types.
+func MatchMsgTypes(msgTypeURLs ...string) func(tx sdk.Tx) bool {
+	types := make(map[string]struct{}, len(msgTypeURLs))
+	for _, typeURL := range msgTypeURLs {
+		types[typeURL] = struct{}{}
+	}
+
+	return func(tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+
+		for _, msg := range msgs {
+			if _, ok := types[sdk.MsgTypeURL(msg)]; !ok {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Lanes returns the lanes of the mempool, ordered from the highest priority to
+// the lowest.
+func (mp *LaneMempool) Lanes() []Lane {
+	return mp.lanes
+}
+
+// lane returns the first lane matching the transaction.
+func (mp *LaneMempool) lane(tx sdk.Tx) (Lane, bool) {
+	for _, lane := range mp.lanes {
+		if lane.Match == nil || lane.Match(tx) {
+			return lane, true
+		}
+	}
+
+	return Lane{}, false
+}
+
+// Insert inserts the transaction in the first lane matching it.
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	lane, ok := mp.lane(tx)
+	if !ok {
+		return ErrNoLaneMatched
+	}
+
+	return lane.Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the transactions of all lanes, lane after
+// lane.
+func (mp *LaneMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	iter := &laneIterator{
+		ctx:   ctx,
+		txs:   txs,
+		lanes: mp.lanes,
+		lane:  0,
+		iter:  mp.lanes[0].Mempool.Select(ctx, txs),
+	}
+
+	return iter.skipEmptyLanes()
+}
+
+// CountTx returns the number of transactions in all lanes.
+func (mp *LaneMempool) CountTx() int {
+	count := 0
+	for _, lane := range mp.lanes {
+		count += lane.Mempool.CountTx()
+	}
+
+	return count
+}
+
+// Remove removes the transaction from the first lane matching it.
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	lane, ok := mp.lane(tx)
+	if !ok {
+		return ErrTxNotFound
+	}
+
+	return lane.Mempool.Remove(tx)
+}
+
+// skipEmptyLanes moves the iterator to the next lane until a transaction is
+// found, returning nil once all lanes are exhausted.
+func (i *laneIterator) skipEmptyLanes() Iterator {
+	for i.iter == nil {
+		i.lane++
+		if i.lane >= len(i.lanes) {
+			return nil
+		}
+		i.iter = i.lanes[i.lane].Mempool.Select(i.ctx, i.txs)
+	}
+
+	return i
+}
+
+// Next implements Iterator.
+func (i *laneIterator) Next() Iterator {
+	i.iter = i.iter.Next()
+	return i.skipEmptyLanes()
+}
+
+// Tx implements Iterator.
+func (i *laneIterator) Tx() sdk.Tx {
+	return i.iter.Tx()
+}
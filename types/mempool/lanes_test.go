@@ -0,0 +1,131 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestNewLaneMempool(t *testing.T) {
+	testCases := []struct {
+		name   string
+		lanes  []mempool.Lane
+		expErr string
+	}{
+		{
+			name:   "no lanes",
+			expErr: "at least one lane",
+		},
+		{
+			name:   "no name",
+			lanes:  []mempool.Lane{{Mempool: mempool.NoOpMempool{}}},
+			expErr: "lane 0 has no name",
+		},
+		{
+			name: "duplicate lane",
+			lanes: []mempool.Lane{
+				{Name: "default", Mempool: mempool.NoOpMempool{}},
+				{Name: "default", Mempool: mempool.NoOpMempool{}},
+			},
+			expErr: "duplicate lane default",
+		},
+		{
+			name:   "no mempool",
+			lanes:  []mempool.Lane{{Name: "default"}},
+			expErr: "lane default has no mempool",
+		},
+		{
+			name:   "negative block space",
+			lanes:  []mempool.Lane{{Name: "default", BlockSpace: math.LegacyNewDec(-1), Mempool: mempool.NoOpMempool{}}},
+			expErr: "negative block space",
+		},
+		{
+			name: "block space exceeded",
+			lanes: []mempool.Lane{
+				{Name: "oracle", BlockSpace: math.LegacyNewDecWithPrec(6, 1), Mempool: mempool.NoOpMempool{}},
+				{Name: "gov", BlockSpace: math.LegacyNewDecWithPrec(5, 1), Mempool: mempool.NoOpMempool{}},
+			},
+			expErr: "exceeds the block",
+		},
+		{
+			name: "valid",
+			lanes: []mempool.Lane{
+				{Name: "oracle", BlockSpace: math.LegacyNewDecWithPrec(2, 1), Mempool: mempool.NoOpMempool{}},
+				{Name: "gov", BlockSpace: math.LegacyNewDecWithPrec(1, 1), Mempool: mempool.NoOpMempool{}},
+				{Name: "default", Mempool: mempool.NoOpMempool{}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mp, err := mempool.NewLaneMempool(tc.lanes...)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, mp.Lanes(), len(tc.lanes))
+			for _, lane := range mp.Lanes() {
+				require.False(t, lane.BlockSpace.IsNil())
+			}
+		})
+	}
+}
+
+func TestLaneMempool(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+
+	// txs with an even id go to the first lane, others to the second one
+	even := func(tx sdk.Tx) bool { return tx.(testTx).id%2 == 0 }
+	mp, err := mempool.NewLaneMempool(
+		mempool.Lane{Name: "even", Match: even, Mempool: mempool.DefaultPriorityMempool()},
+		mempool.Lane{Name: "odd", Match: func(tx sdk.Tx) bool { return !even(tx) }, Mempool: mempool.DefaultPriorityMempool()},
+	)
+	require.NoError(t, err)
+
+	// empty mempool behavior
+	require.Equal(t, 0, mp.CountTx())
+	require.Nil(t, mp.Select(ctx, nil))
+
+	txs := []testTx{
+		{id: 1, priority: 30, nonce: 0, address: accounts[0].Address},
+		{id: 2, priority: 10, nonce: 0, address: accounts[1].Address},
+		{id: 3, priority: 20, nonce: 1, address: accounts[0].Address},
+		{id: 4, priority: 5, nonce: 1, address: accounts[1].Address},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, 2, mp.Lanes()[0].Mempool.CountTx())
+	require.Equal(t, 2, mp.Lanes()[1].Mempool.CountTx())
+
+	// txs are selected lane after lane, regardless of their priority
+	var ids []int
+	for _, tx := range fetchTxs(mp.Select(ctx, nil), 100) {
+		ids = append(ids, tx.(testTx).id)
+	}
+	require.Equal(t, []int{2, 4, 1, 3}, ids)
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.Equal(t, 3, mp.CountTx())
+	require.Equal(t, 1, mp.Lanes()[0].Mempool.CountTx())
+	require.ErrorIs(t, mp.Remove(txs[1]), mempool.ErrTxNotFound)
+
+	// a tx matching no lane is rejected
+	mp, err = mempool.NewLaneMempool(mempool.Lane{Name: "even", Match: even, Mempool: mempool.DefaultPriorityMempool()})
+	require.NoError(t, err)
+	require.ErrorIs(t, mp.Insert(ctx, txs[0]), mempool.ErrNoLaneMatched)
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+}
@@ -317,21 +317,28 @@ func (m *Manager) DefaultGenesis() map[string]json.RawMessage {
 
 // ValidateGenesis performs genesis state validation for all modules
 func (m *Manager) ValidateGenesis(genesisData map[string]json.RawMessage) error {
-	for name, b := range m.Modules {
-		if mod, ok := b.(HasGenesisBasics); ok {
-			if err := mod.ValidateGenesis(genesisData[name]); err != nil {
-				return err
-			}
-		} else if mod, ok := b.(appmodule.HasGenesis); ok {
-			if err := mod.ValidateGenesis(genesisData[name]); err != nil {
-				return err
-			}
+	for name := range m.Modules {
+		if err := m.ValidateModuleGenesis(name, genesisData[name]); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// ValidateModuleGenesis performs genesis state validation for a single module.
+// Unknown modules and modules without genesis are ignored.
+func (m *Manager) ValidateModuleGenesis(moduleName string, genesisData json.RawMessage) error {
+	switch mod := m.Modules[moduleName].(type) {
+	case HasGenesisBasics:
+		return mod.ValidateGenesis(genesisData)
+	case appmodule.HasGenesis:
+		return mod.ValidateGenesis(genesisData)
+	}
+
+	return nil
+}
+
 // RegisterGRPCGatewayRoutes registers all module rest routes
 func (m *Manager) RegisterGRPCGatewayRoutes(clientCtx client.Context, rtr *runtime.ServeMux) {
 	for _, b := range m.Modules {
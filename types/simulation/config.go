@@ -22,8 +22,9 @@ type Config struct {
 	Lean   bool // lean simulation log output
 	Commit bool // have the simulation commit
 
-	DBBackend   string // custom db backend type
-	BlockMaxGas int64  // custom max gas for block
+	DBBackend   string      // custom db backend type
+	BlockMaxGas int64       // custom max gas for block
+	BlockTimeFn BlockTimeFn // custom block time progression; when nil blocks are 5000 to 10000 seconds apart
 	FuzzSeed    []byte
 	T           testing.TB
 }
@@ -159,6 +159,10 @@ type FutureOperation struct {
 	Op          Operation
 }
 
+// BlockTimeFn returns the time of the block at the given height, given the time
+// of the block preceding it.
+type BlockTimeFn func(r *rand.Rand, height int64, blockTime time.Time) time.Time
+
 // AppParams defines a flat JSON of key/values for all possible configurable
 // simulation parameters. It might contain: operation weights, simulation parameters
 // and flattened module state parameters (i.e not stored under it's respective module name).
@@ -0,0 +1,267 @@
+package lockup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Clawback terminates the lockup and sends the coins that are still locked to
+// an account chosen by the funder. The locked coins that are delegated cannot be
+// sent right away, they are undelegated and tracked as pending clawback, the
+// funder can claw them back with another MsgClawback once the unbonding completes.
+func (bva *BaseLockup) Clawback(
+	ctx context.Context, msg *lockuptypes.MsgClawback, getLockedCoinsFunc getLockedCoinsFunc,
+) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	err := bva.checkFunder(ctx, msg.Funder)
+	if err != nil {
+		return nil, err
+	}
+	whoami := accountstd.Whoami(ctx)
+	fromAddress, err := bva.addressCodec.BytesToString(whoami)
+	if err != nil {
+		return nil, err
+	}
+
+	hs := bva.headerService.HeaderInfo(ctx)
+
+	denoms := []string{}
+	err = bva.IterateCoinEntries(ctx, bva.OriginalLocking, func(denom string, _ math.Int) (stop bool, err error) {
+		denoms = append(denoms, denom)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, denoms...)
+	if err != nil {
+		return nil, err
+	}
+
+	clawedBack := sdk.Coins{}
+	unbonding := sdk.Coins{}
+	for _, denom := range denoms {
+		lockedAmt := lockedCoins.AmountOf(denom)
+		pendingAmt, err := bva.getClawbackPending(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+
+		// the coins still locked are removed from the lockup, so that once it is
+		// terminated the owner is left with the coins unlocked so far.
+		if !lockedAmt.IsZero() {
+			originalLockingAmt, err := bva.OriginalLocking.Get(ctx, denom)
+			if err != nil {
+				return nil, err
+			}
+			err = bva.OriginalLocking.Set(ctx, denom, originalLockingAmt.Sub(lockedAmt))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		owedAmt := lockedAmt.Add(pendingAmt)
+		if owedAmt.IsZero() {
+			continue
+		}
+
+		balance, err := bva.getBalance(ctx, fromAddress, denom)
+		if err != nil {
+			return nil, err
+		}
+		notBondedOwedCoin, err := bva.GetNotBondedLockedCoin(ctx, sdk.NewCoin(denom, owedAmt), denom)
+		if err != nil {
+			return nil, err
+		}
+
+		clawbackAmt := math.MinInt(notBondedOwedCoin.Amount, balance.Amount)
+		if clawbackAmt.IsPositive() {
+			clawedBack = append(clawedBack, sdk.NewCoin(denom, clawbackAmt))
+		}
+
+		// the owed coins that are delegated are undelegated, they will be
+		// clawed back once the unbonding completes
+		undelegatedAmt := math.ZeroInt()
+		bondedOwedAmt := owedAmt.Sub(notBondedOwedCoin.Amount)
+		if bondedOwedAmt.IsPositive() {
+			undelegatedAmt, err = bva.undelegateLocking(ctx, fromAddress, sdk.NewCoin(denom, bondedOwedAmt))
+			if err != nil {
+				return nil, err
+			}
+			if undelegatedAmt.IsPositive() {
+				unbonding = append(unbonding, sdk.NewCoin(denom, undelegatedAmt))
+			}
+		}
+
+		err = bva.ClawbackPending.Set(ctx, denom, notBondedOwedCoin.Amount.Sub(clawbackAmt).Add(undelegatedAmt))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(clawedBack) == 0 && len(unbonding) == 0 {
+		return nil, errors.New("no locked tokens available for clawback")
+	}
+
+	// terminate the lockup, if it was not already
+	endTime, err := bva.EndTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hs.Time.Before(endTime) {
+		err = bva.EndTime.Set(ctx, hs.Time)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(clawedBack) != 0 {
+		msgSend := &banktypes.MsgSend{
+			FromAddress: fromAddress,
+			ToAddress:   msg.ToAddress,
+			Amount:      clawedBack,
+		}
+		_, err = sendMessage(ctx, msgSend)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = bva.eventService.EventManager(ctx).EmitKV("clawback",
+		event.NewAttribute("funder", msg.Funder),
+		event.NewAttribute("receiver", msg.ToAddress),
+		event.NewAttribute("amount", clawedBack.String()),
+		event.NewAttribute("unbonding", unbonding.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgClawbackResponse{
+		AmountClawedBack: clawedBack,
+		AmountUnbonding:  unbonding,
+	}, nil
+}
+
+// undelegateLocking undelegates up to amount from the delegations of the account
+// and removes it from the delegated locking coins. The undelegated amount is
+// returned, it is lower than amount if the delegations were slashed.
+func (bva *BaseLockup) undelegateLocking(ctx context.Context, delegatorAddress string, amount sdk.Coin) (math.Int, error) {
+	delegationsQueryReq := &stakingtypes.QueryDelegatorDelegationsRequest{DelegatorAddr: delegatorAddress}
+	resp, err := accountstd.QueryModule[stakingtypes.QueryDelegatorDelegationsResponse](ctx, delegationsQueryReq)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	remaining := amount.Amount
+	for _, delegation := range resp.DelegationResponses {
+		undelegateAmt := math.MinInt(remaining, delegation.Balance.Amount)
+		if !undelegateAmt.IsPositive() {
+			continue
+		}
+
+		msgUndelegate := &stakingtypes.MsgUndelegate{
+			DelegatorAddress: delegatorAddress,
+			ValidatorAddress: delegation.Delegation.ValidatorAddress,
+			Amount:           sdk.NewCoin(amount.Denom, undelegateAmt),
+		}
+		_, err = sendMessage(ctx, msgUndelegate)
+		if err != nil {
+			return math.Int{}, err
+		}
+
+		remaining = remaining.Sub(undelegateAmt)
+		if remaining.IsZero() {
+			break
+		}
+	}
+
+	delLockingAmt, err := bva.DelegatedLocking.Get(ctx, amount.Denom)
+	if err != nil {
+		return math.Int{}, err
+	}
+	err = bva.DelegatedLocking.Set(ctx, amount.Denom, delLockingAmt.Sub(amount.Amount))
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	return amount.Amount.Sub(remaining), nil
+}
+
+// withClawbackPending returns a getLockedCoinsFunc which also counts the coins
+// pending clawback as locked, so that the owner cannot spend them.
+func (bva *BaseLockup) withClawbackPending(getLockedCoinsFunc getLockedCoinsFunc) getLockedCoinsFunc {
+	return func(ctx context.Context, blockTime time.Time, denoms ...string) (sdk.Coins, error) {
+		lockedCoins, err := getLockedCoinsFunc(ctx, blockTime, denoms...)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, denom := range denoms {
+			pendingAmt, err := bva.getClawbackPending(ctx, denom)
+			if err != nil {
+				return nil, err
+			}
+			if pendingAmt.IsZero() {
+				continue
+			}
+			lockedCoins[i] = sdk.NewCoin(denom, lockedCoins.AmountOf(denom).Add(pendingAmt))
+		}
+
+		return lockedCoins, nil
+	}
+}
+
+func (bva *BaseLockup) getClawbackPending(ctx context.Context, denom string) (math.Int, error) {
+	pendingAmt, err := bva.ClawbackPending.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return pendingAmt, err
+}
+
+func (bva *BaseLockup) setFunder(ctx context.Context, funder string) error {
+	if funder == "" {
+		return nil
+	}
+
+	funderBytes, err := bva.addressCodec.StringToBytes(funder)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid 'funder' address: %s", err)
+	}
+
+	return bva.Funder.Set(ctx, funderBytes)
+}
+
+func (bva *BaseLockup) checkFunder(ctx context.Context, sender string) error {
+	funder, err := bva.Funder.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errors.New("clawback is not enabled for this lockup account")
+	}
+	if err != nil {
+		return err
+	}
+	senderBytes, err := bva.addressCodec.StringToBytes(sender)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err.Error())
+	}
+	if !bytes.Equal(funder, senderBytes) {
+		return errors.New("sender is not the funder of this lockup account")
+	}
+
+	return nil
+}
@@ -78,6 +78,12 @@ func (cva *ContinuousLockingAccount) WithdrawUnlockedCoins(ctx context.Context,
 	return cva.BaseLockup.WithdrawUnlockedCoins(ctx, msg, cva.GetLockedCoinsWithDenoms)
 }
 
+func (cva *ContinuousLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return cva.BaseLockup.Clawback(ctx, msg, cva.GetLockedCoinsWithDenoms)
+}
+
 // GetLockCoinsInfo returns the total number of unlocked and locked coins.
 func (cva ContinuousLockingAccount) GetLockCoinsInfo(ctx context.Context, blockTime time.Time) (unlockedCoins, lockedCoins sdk.Coins, err error) {
 	unlockedCoins = sdk.Coins{}
@@ -124,7 +130,7 @@ func (cva ContinuousLockingAccount) GetLockCoinInfoWithDenom(ctx context.Context
 	originalLocking := sdk.NewCoin(denom, originalLockingAmt)
 	if startTime.After(blockTime) {
 		return &sdk.Coin{}, &originalLocking, nil
-	} else if endTime.Before(blockTime) {
+	} else if !endTime.After(blockTime) {
 		return &originalLocking, &sdk.Coin{}, nil
 	}
 
@@ -195,6 +201,7 @@ func (cva ContinuousLockingAccount) RegisterExecuteHandlers(builder *accountstd.
 	accountstd.RegisterExecuteHandler(builder, cva.Delegate)
 	accountstd.RegisterExecuteHandler(builder, cva.SendCoins)
 	accountstd.RegisterExecuteHandler(builder, cva.WithdrawUnlockedCoins)
+	accountstd.RegisterExecuteHandler(builder, cva.Clawback)
 	cva.BaseLockup.RegisterExecuteHandlers(builder)
 }
 
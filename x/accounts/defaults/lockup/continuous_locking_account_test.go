@@ -27,6 +27,7 @@ func setupContinousAccount(t *testing.T, ctx context.Context, ss store.KVStoreSe
 		Owner:     owner,
 		EndTime:   time.Now().Add(time.Minute * 2),
 		StartTime: time.Now(),
+		Funder:    "funder",
 	})
 	require.NoError(t, err)
 
@@ -206,6 +207,89 @@ func TestContinousAccountWithdrawUnlockedCoins(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestContinousAccountClawback(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc := setupContinousAccount(t, sdkCtx, ss)
+	startTime, err := acc.StartTime.Get(sdkCtx)
+	require.NoError(t, err)
+
+	// Update context time to unlocked half of the original locking amount
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute * 1),
+	})
+
+	// delegate all the locked coins
+	_, err = acc.Delegate(sdkCtx, &lockuptypes.MsgDelegate{
+		Sender:           "owner",
+		ValidatorAddress: "val_address",
+		Amount:           sdk.NewCoin("test", math.NewInt(6)),
+	})
+	require.NoError(t, err)
+
+	_, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{
+		Funder:    "owner",
+		ToAddress: "receiver",
+	})
+	require.Error(t, err)
+
+	// the delegated locked coins are undelegated and pending clawback
+	resp, err := acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{
+		Funder:    "funder",
+		ToAddress: "receiver",
+	})
+	require.NoError(t, err)
+	require.True(t, resp.AmountClawedBack.IsZero())
+	require.True(t, resp.AmountUnbonding.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5)))))
+
+	pending, err := acc.ClawbackPending.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, pending.Equal(math.NewInt(5)))
+
+	delLocking, err := acc.DelegatedLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, delLocking.IsZero())
+
+	// the lockup is terminated, the owner keeps the unlocked coins
+	unlocked, locked, err := acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Minute*2))
+	require.NoError(t, err)
+	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(5)))
+	require.True(t, locked.AmountOf("test").IsZero())
+
+	// the coins pending clawback cannot be sent by the owner
+	_, err = acc.SendCoins(sdkCtx, &lockuptypes.MsgSend{
+		Sender:    "owner",
+		ToAddress: "receiver",
+		Amount:    sdk.NewCoins(sdk.NewCoin("test", math.NewInt(6))),
+	})
+	require.Error(t, err)
+
+	// once the unbonding completes the pending coins are clawed back
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute * 3),
+	})
+	resp, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{
+		Funder:    "funder",
+		ToAddress: "receiver",
+	})
+	require.NoError(t, err)
+	require.True(t, resp.AmountClawedBack.Equal(sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5)))))
+	require.True(t, resp.AmountUnbonding.IsZero())
+
+	pending, err = acc.ClawbackPending.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.True(t, pending.IsZero())
+
+	_, err = acc.Clawback(sdkCtx, &lockuptypes.MsgClawback{
+		Funder:    "funder",
+		ToAddress: "receiver",
+	})
+	require.Error(t, err)
+}
+
 func TestContinousAccountGetLockCoinInfo(t *testing.T) {
 	ctx, ss := newMockContext(t)
 	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
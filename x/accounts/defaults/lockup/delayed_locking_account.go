@@ -34,6 +34,10 @@ func (dva DelayedLockingAccount) Init(ctx context.Context, msg *lockuptypes.MsgI
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid end time %s", msg.EndTime.String())
 	}
 
+	if msg.Funder != "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("clawback is not supported by delayed locking accounts")
+	}
+
 	return dva.BaseLockup.Init(ctx, msg)
 }
 
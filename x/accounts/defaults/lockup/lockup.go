@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/collections"
 	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
 	"cosmossdk.io/core/header"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -34,6 +35,8 @@ var (
 	LockingPeriodsPrefix   = collections.NewPrefix(5)
 	OwnerPrefix            = collections.NewPrefix(6)
 	WithdrawedCoinsPrefix  = collections.NewPrefix(7)
+	FunderPrefix           = collections.NewPrefix(8)
+	ClawbackPendingPrefix  = collections.NewPrefix(9)
 )
 
 var (
@@ -53,8 +56,11 @@ func newBaseLockup(d accountstd.Dependencies) *BaseLockup {
 		DelegatedFree:    collections.NewMap(d.SchemaBuilder, DelegatedFreePrefix, "delegated_free", collections.StringKey, sdk.IntValue),
 		DelegatedLocking: collections.NewMap(d.SchemaBuilder, DelegatedLockingPrefix, "delegated_locking", collections.StringKey, sdk.IntValue),
 		WithdrawedCoins:  collections.NewMap(d.SchemaBuilder, WithdrawedCoinsPrefix, "withdrawed_coins", collections.StringKey, sdk.IntValue),
+		Funder:           collections.NewItem(d.SchemaBuilder, FunderPrefix, "funder", collections.BytesValue),
+		ClawbackPending:  collections.NewMap(d.SchemaBuilder, ClawbackPendingPrefix, "clawback_pending", collections.StringKey, sdk.IntValue),
 		addressCodec:     d.AddressCodec,
 		headerService:    d.Environment.HeaderService,
+		eventService:     d.Environment.EventService,
 		EndTime:          collections.NewItem(d.SchemaBuilder, EndTimePrefix, "end_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
 	}
 
@@ -68,8 +74,15 @@ type BaseLockup struct {
 	DelegatedFree    collections.Map[string, math.Int]
 	DelegatedLocking collections.Map[string, math.Int]
 	WithdrawedCoins  collections.Map[string, math.Int]
-	addressCodec     address.Codec
-	headerService    header.Service
+	// Funder is the address allowed to claw back the locked coins, it is not
+	// set if clawback is disabled.
+	Funder collections.Item[[]byte]
+	// ClawbackPending tracks the clawed back coins that were still delegated
+	// at the time of the clawback and are not sent to the funder yet.
+	ClawbackPending collections.Map[string, math.Int]
+	addressCodec    address.Codec
+	headerService   header.Service
+	eventService    event.Service
 	// lockup end time.
 	EndTime collections.Item[time.Time]
 }
@@ -86,6 +99,11 @@ func (bva *BaseLockup) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupA
 		return nil, err
 	}
 
+	err = bva.setFunder(ctx, msg.Funder)
+	if err != nil {
+		return nil, err
+	}
+
 	funds := accountstd.Funds(ctx)
 
 	sortedAmt := funds.Sort()
@@ -143,6 +161,7 @@ func (bva *BaseLockup) Delegate(
 	}
 
 	hs := bva.headerService.HeaderInfo(ctx)
+	getLockedCoinsFunc = bva.withClawbackPending(getLockedCoinsFunc)
 
 	balance, err := bva.getBalance(ctx, delegatorAddress, msg.Amount.Denom)
 	if err != nil {
@@ -252,6 +271,7 @@ func (bva *BaseLockup) SendCoins(
 	}
 
 	hs := bva.headerService.HeaderInfo(ctx)
+	getLockedCoinsFunc = bva.withClawbackPending(getLockedCoinsFunc)
 
 	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, msg.Amount.Denoms()...)
 	if err != nil {
@@ -294,6 +314,8 @@ func (bva *BaseLockup) WithdrawUnlockedCoins(
 	}
 
 	hs := bva.headerService.HeaderInfo(ctx)
+	getLockedCoinsFunc = bva.withClawbackPending(getLockedCoinsFunc)
+
 	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, msg.Denoms...)
 	if err != nil {
 		return nil, err
@@ -9,6 +9,7 @@ import (
 	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Compile-time type assertions
@@ -28,6 +29,10 @@ type PermanentLockingAccount struct {
 }
 
 func (plva PermanentLockingAccount) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupAccount) (*lockuptypes.MsgInitLockupAccountResponse, error) {
+	if msg.Funder != "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("clawback is not supported by permanent locking accounts")
+	}
+
 	resp, err := plva.BaseLockup.Init(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -12,6 +12,7 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/event"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/math"
@@ -70,6 +71,16 @@ func (h headerService) HeaderInfo(ctx context.Context) header.Info {
 	return sdk.UnwrapSDKContext(ctx).HeaderInfo()
 }
 
+// mock event service
+type eventService struct{}
+
+func (eventService) EventManager(context.Context) event.Manager { return eventManager{} }
+
+type eventManager struct{}
+
+func (eventManager) Emit(gogoproto.Message) error            { return nil }
+func (eventManager) EmitKV(string, ...event.Attribute) error { return nil }
+
 func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
 	t.Helper()
 	return accountstd.NewMockContext(
@@ -90,24 +101,33 @@ func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
 				return nil, errors.New("unrecognized request type")
 			}
 		}, func(ctx context.Context, req, resp ProtoMsg) error {
-			_, ok := req.(*banktypes.QueryBalanceRequest)
-			if !ok {
-				_, ok = req.(*stakingtypes.QueryParamsRequest)
-				require.True(t, ok)
-				gogoproto.Merge(resp.(gogoproto.Message), &stakingtypes.QueryParamsResponse{
-					Params: stakingtypes.Params{
-						BondDenom: "test",
-					},
-				})
-			} else {
-				// NOTE: using gogoproto.Merge will fail for some reason unknown to me, but
-				// using proto.Merge with gogo messages seems to work fine.
+			// NOTE: using gogoproto.Merge will fail for some reason unknown to me, but
+			// using proto.Merge with gogo messages seems to work fine.
+			switch req.(type) {
+			case *banktypes.QueryBalanceRequest:
 				proto.Merge(resp.(gogoproto.Message), &banktypes.QueryBalanceResponse{
 					Balance: &(sdk.Coin{
 						Denom:  "test",
 						Amount: TestFunds.AmountOf("test"),
 					}),
 				})
+			case *stakingtypes.QueryDelegatorDelegationsRequest:
+				proto.Merge(resp.(gogoproto.Message), &stakingtypes.QueryDelegatorDelegationsResponse{
+					DelegationResponses: stakingtypes.DelegationResponses{
+						{
+							Delegation: stakingtypes.Delegation{ValidatorAddress: "val_address"},
+							Balance:    sdk.NewCoin("test", math.NewInt(5)),
+						},
+					},
+				})
+			default:
+				_, ok := req.(*stakingtypes.QueryParamsRequest)
+				require.True(t, ok)
+				gogoproto.Merge(resp.(gogoproto.Message), &stakingtypes.QueryParamsResponse{
+					Params: stakingtypes.Params{
+						BondDenom: "test",
+					},
+				})
 			}
 
 			return nil
@@ -124,6 +144,7 @@ func makeMockDependencies(storeservice store.KVStoreService) accountstd.Dependen
 		LegacyStateCodec: mockStateCodec{},
 		Environment: appmodule.Environment{
 			HeaderService: headerService{},
+			EventService:  eventService{},
 		},
 	}
 }
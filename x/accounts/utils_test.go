@@ -67,6 +67,7 @@ func newKeeper(t *testing.T, accounts ...implementation.AccountCreatorFunc) (Kee
 		&bankv1beta1.MsgSetSendEnabled{},
 		&bankv1beta1.MsgMultiSend{},
 		&bankv1beta1.MsgUpdateParams{},
+		&bankv1beta1.MsgUpdateDeniedDenoms{},
 	)
 	queryRouter.RegisterService(&bankv1beta1.Query_ServiceDesc, &bankQueryServer{})
 	msgRouter.RegisterService(&bankv1beta1.Msg_ServiceDesc, &bankMsgServer{})
@@ -4,6 +4,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth/ante/unorderedtx"
 	"cosmossdk.io/x/auth/types"
 	txsigning "cosmossdk.io/x/tx/signing"
 
@@ -23,6 +24,16 @@ type HandlerOptions struct {
 	SignModeHandler          *txsigning.HandlerMap
 	SigGasConsumer           func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker             TxFeeChecker
+	// UnorderedTxManager tracks the unordered transactions until their timeout,
+	// rejecting their replays. Unordered transactions are rejected if nil.
+	UnorderedTxManager *unorderedtx.Manager
+	// SigBatchVerifier verifies in advance the signatures of the transactions
+	// of a block, if set. It must be called by the PreBlocker of the app.
+	SigBatchVerifier *SigBatchVerifier
+	// TxRateLimitKeeper tracks the number of transactions per block and per
+	// account, to enforce the tx rate limit params. Transactions are not rate
+	// limited if nil.
+	TxRateLimitKeeper TxRateLimitKeeper
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -46,11 +57,13 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		NewValidateBasicDecorator(options.Environment),
 		NewTxTimeoutHeightDecorator(options.Environment),
+		NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, options.UnorderedTxManager, options.Environment, DefaultSha256Cost),
+		NewTxRateLimitDecorator(options.Environment, options.TxRateLimitKeeper),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		NewValidateSigCountDecorator(options.AccountKeeper),
-		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper),
+		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper).WithBatchVerifier(options.SigBatchVerifier),
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
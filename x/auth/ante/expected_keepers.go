@@ -28,6 +28,20 @@ type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
 
+// FeeMarketKeeper defines the expected keeper of a dynamic fee market.
+type FeeMarketKeeper interface {
+	GetBaseFee(ctx context.Context) (sdk.DecCoin, bool, error)
+	ConsumeBlockGas(ctx context.Context, gas uint64) error
+}
+
+// TxRateLimitKeeper defines the expected keeper tracking the number of
+// transactions per block and per account.
+type TxRateLimitKeeper interface {
+	GetParams(ctx context.Context) (params types.Params)
+	IncrementBlockTxCount(ctx context.Context) (uint64, error)
+	IncrementAccountTxCount(ctx context.Context, addr sdk.AccAddress) (uint64, error)
+}
+
 type ConsensusKeeper interface {
 	Params(context.Context, *consensustypes.QueryParamsRequest) (*consensustypes.QueryParamsResponse, error)
 }
@@ -10,3 +10,7 @@ var SimSecp256k1PubkeyInternal = simSecp256k1Pubkey
 func SetSVDPubKey(svd SigVerificationDecorator, ctx sdk.Context, acc sdk.AccountI, txPubKey cryptotypes.PubKey) error {
 	return svd.setPubKey(ctx, acc, txPubKey)
 }
+
+func NumVerifiedSigs(v *SigBatchVerifier) int {
+	return len(v.verified)
+}
@@ -0,0 +1,57 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewDynamicFeeChecker returns a TxFeeChecker implementing an EIP-1559 style
+// fee market, to be used with the DeductFeeDecorator. The minimum gas price of
+// the transactions is the base fee tracked in state by the FeeMarketKeeper,
+// which is adjusted at the end of each block to the gas wanted by the block.
+// The tx priority is the tip per unit of gas paid on top of the base fee.
+//
+// When the fee market is disabled, it falls back to the validator minimum gas
+// prices.
+func NewDynamicFeeChecker(fmk FeeMarketKeeper) TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		baseFee, enabled, err := fmk.GetBaseFee(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !enabled {
+			return checkTxFeeWithValidatorMinGasPrices(ctx, tx)
+		}
+
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		feeCoins := feeTx.GetFee()
+		gas := feeTx.GetGas()
+
+		// Unlike the validator minimum gas prices, the base fee is part of the
+		// consensus and enforced in every execution mode.
+		requiredFee := sdk.NewCoin(baseFee.Denom, baseFee.Amount.MulInt64(int64(gas)).Ceil().RoundInt())
+		paidAmt := feeCoins.AmountOf(baseFee.Denom)
+		if paidAmt.LT(requiredFee.Amount) {
+			return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFee)
+		}
+
+		if ctx.ExecMode() == sdk.ExecModeFinalize {
+			if err := fmk.ConsumeBlockGas(ctx, gas); err != nil {
+				return nil, 0, err
+			}
+		}
+
+		var priority int64
+		if tip := paidAmt.Sub(requiredFee.Amount); tip.IsPositive() && gas > 0 {
+			priority = getTxPriority(sdk.NewCoins(sdk.NewCoin(baseFee.Denom, tip)), int64(gas))
+		}
+
+		return feeCoins, priority, nil
+	}
+}
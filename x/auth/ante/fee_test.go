@@ -105,6 +105,59 @@ func TestEnsureMempoolFees(t *testing.T) {
 	require.Equal(t, int64(10), newCtx.Priority())
 }
 
+func TestDynamicFeeChecker(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	mfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, ante.NewDynamicFeeChecker(s.accountKeeper))
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	// keys and addresses
+	accs := s.CreateTestAccounts(1)
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := uint64(15)
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(gasLimit)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, feeAmount).Return(nil).Times(1)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	// the base fee of 20atom per gas is higher than the fee
+	params := authtypes.DefaultParams()
+	params.FeeMarket = &authtypes.FeeMarketParams{
+		Denom:                    "atom",
+		MinBaseFee:               math.LegacyNewDec(20),
+		TargetBlockGas:           100,
+		BaseFeeChangeDenominator: 8,
+	}
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+
+	// the base fee is enforced in DeliverTx
+	s.ctx = s.ctx.WithExecMode(sdk.ExecModeFinalize)
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	params.FeeMarket.MinBaseFee = math.LegacyNewDec(5)
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+
+	newCtx, err := antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+	// the priority is the tip per unit of gas, the fee of 150atom is 75atom
+	// above the base fee
+	require.Equal(t, int64(5), newCtx.Priority())
+
+	gasWanted, err := s.accountKeeper.BlockGasWanted.Get(s.ctx)
+	require.NoError(t, err)
+	require.Equal(t, gasLimit, gasWanted)
+}
+
 func TestDeductFees(t *testing.T) {
 	s := SetupTestSuite(t, false)
 	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
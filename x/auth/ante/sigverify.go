@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 
+	"filippo.io/edwards25519"
 	secp256k1dcrd "github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -22,6 +23,7 @@ import (
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -63,8 +65,10 @@ type AccountAbstractionKeeper interface {
 // gas for signature verification.
 //
 // In cases where unordered or parallel transactions are desired, it is recommended
-// to set unordered=true with a reasonable timeout_height value, in which case
-// this nonce verification and increment will be skipped.
+// to set unordered=true with a reasonable timeout_timestamp value, in which case
+// the transaction must be signed with a zero sequence and the account sequence
+// isn't incremented. The UnorderedTxDecorator must then precede this decorator
+// to protect the unordered transactions against replays.
 //
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigVerificationDecorator struct {
@@ -72,6 +76,7 @@ type SigVerificationDecorator struct {
 	aaKeeper        AccountAbstractionKeeper
 	signModeHandler *txsigning.HandlerMap
 	sigGasConsumer  SignatureVerificationGasConsumer
+	batchVerifier   *SigBatchVerifier
 }
 
 func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler *txsigning.HandlerMap, sigGasConsumer SignatureVerificationGasConsumer, aaKeeper AccountAbstractionKeeper) SigVerificationDecorator {
@@ -83,6 +88,13 @@ func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler *txsigning.Ha
 	}
 }
 
+// WithBatchVerifier returns the decorator skipping the verification of the
+// signatures verified in advance by the given SigBatchVerifier, if not nil.
+func (svd SigVerificationDecorator) WithBatchVerifier(batchVerifier *SigBatchVerifier) SigVerificationDecorator {
+	svd.batchVerifier = batchVerifier
+	return svd
+}
+
 // OnlyLegacyAminoSigners checks SignatureData to see if all
 // signers are using SIGN_MODE_LEGACY_AMINO_JSON. If this is the case
 // then the corresponding SignatureV2 struct will not have account sequence
@@ -123,12 +135,22 @@ func verifyIsOnCurve(pubKey cryptotypes.PubKey) (err error) {
 			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "secp256k1 key is not on curve")
 		}
 
+	case *ed25519.PubKey:
+		if _, err := new(edwards25519.Point).SetBytes(typedPubKey.Key); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "ed25519 key is not on curve")
+		}
+
 	case *secp256r1.PubKey:
 		pubKeyObject := typedPubKey.Key.PublicKey
 		if !pubKeyObject.IsOnCurve(pubKeyObject.X, pubKeyObject.Y) {
 			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "secp256r1 key is not on curve")
 		}
 
+	case *webauthn.PubKey:
+		if _, err := typedPubKey.ECDSA(); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+		}
+
 	case multisig.PubKey:
 		pubKeysObjects := typedPubKey.GetPubKeys()
 		ok := true
@@ -300,7 +322,16 @@ func (svd SigVerificationDecorator) consumeSignatureGas(
 
 // verifySig will verify the signature of the provided signer account.
 func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sdk.AccountI, sig signing.SignatureV2, newlyCreated bool) error {
-	if sig.Sequence != acc.GetSequence() {
+	if isUnorderedTx(tx) {
+		// unordered transactions are protected against replays by their timeout
+		// instead of the account sequence, so that they can be signed concurrently
+		if sig.Sequence != 0 {
+			return errorsmod.Wrapf(
+				sdkerrors.ErrWrongSequence,
+				"unordered transaction must be signed with a zero sequence, got %d", sig.Sequence,
+			)
+		}
+	} else if sig.Sequence != acc.GetSequence() {
 		return errorsmod.Wrapf(
 			sdkerrors.ErrWrongSequence,
 			"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
@@ -343,7 +374,7 @@ func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sd
 		Address:       acc.GetAddress().String(),
 		ChainID:       chainID,
 		AccountNumber: accNum,
-		Sequence:      acc.GetSequence(),
+		Sequence:      sig.Sequence,
 		PubKey: &anypb.Any{
 			TypeUrl: anyPk.TypeUrl,
 			Value:   anyPk.Value,
@@ -354,6 +385,9 @@ func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sd
 		return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
 	}
 	txData := adaptableTx.GetSigningTxData()
+	if svd.batchVerifier.isVerified(ctx, pubKey, signerData, sig.Data, txData) {
+		return nil
+	}
 	err := authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
 	if err != nil {
 		var errMsg string
@@ -416,14 +450,19 @@ func (svd SigVerificationDecorator) increaseSequence(tx authsigning.Tx, acc sdk.
 	// Bypass incrementing sequence for transactions with unordered set to true.
 	// The actual parameters of the un-ordered tx will be checked in a separate
 	// decorator.
-	unorderedTx, ok := tx.(sdk.TxWithUnordered)
-	if ok && unorderedTx.GetUnordered() {
+	if isUnorderedTx(tx) {
 		return nil
 	}
 
 	return acc.SetSequence(acc.GetSequence() + 1)
 }
 
+// isUnorderedTx returns whether the tx is unordered.
+func isUnorderedTx(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
+
 // authenticateAbstractedAccount computes an AA authentication instruction and invokes the auth flow on the AA.
 func (svd SigVerificationDecorator) authenticateAbstractedAccount(ctx sdk.Context, authTx authsigning.Tx, signer []byte, index int) error {
 	// the bundler is the AA itself.
@@ -516,6 +555,10 @@ func DefaultSigVerificationGasConsumer(meter storetypes.GasMeter, sig signing.Si
 		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 		return nil
 
+	case *webauthn.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostWebAuthn(), "ante verify: webauthn")
+		return nil
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
@@ -0,0 +1,314 @@
+package ante
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"runtime"
+	"sync"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"cosmossdk.io/core/transaction"
+	storetypes "cosmossdk.io/store/types"
+	authsigning "cosmossdk.io/x/auth/signing"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// ed25519BatchSize is the maximum number of ed25519 signatures verified in a
+// single batch, so that the batches of a block are verified concurrently.
+const ed25519BatchSize = 128
+
+// SigBatchVerifier verifies the signatures of the transactions of a block before
+// their execution, in batches for the ed25519 keys and concurrently for the ECDSA
+// keys (secp256k1, secp256r1 and webauthn), which don't support batch
+// verification. The SigVerificationDecorator then skips the verification of the
+// signatures found valid, which significantly reduces the time to execute
+// signature-heavy blocks.
+//
+// The signer data of a transaction depends on the state left by the previous
+// transactions of the block, e.g. the account number of an account created in
+// the block, so it is predicted from the state at the beginning of the block.
+// As the valid signatures are identified by the public key, sign bytes and
+// signature, a mispredicted signature is simply verified again by the
+// SigVerificationDecorator. Multisig and SIGN_MODE_EIP_712 signatures are not
+// verified in advance.
+type SigBatchVerifier struct {
+	ak              AccountKeeper
+	signModeHandler *txsigning.HandlerMap
+	txDecoder       sdk.TxDecoder
+
+	mtx      sync.RWMutex
+	height   int64
+	verified map[[sha256.Size]byte]struct{}
+}
+
+// NewSigBatchVerifier returns a SigBatchVerifier, which must be set in the
+// SigVerificationDecorator and called by the PreBlocker of the app with the
+// transactions of the block.
+func NewSigBatchVerifier(ak AccountKeeper, signModeHandler *txsigning.HandlerMap, txDecoder sdk.TxDecoder) *SigBatchVerifier {
+	return &SigBatchVerifier{
+		ak:              ak,
+		signModeHandler: signModeHandler,
+		txDecoder:       txDecoder,
+		verified:        map[[sha256.Size]byte]struct{}{},
+	}
+}
+
+type pendingSig struct {
+	pubKey    cryptotypes.PubKey
+	signBytes []byte
+	sig       []byte
+	valid     bool
+}
+
+// VerifyBlock verifies the signatures of the given transactions of the block
+// being finalized, forgetting the ones verified for the previous block. It must
+// be called before the execution of the transactions, e.g. in the PreBlocker.
+// The transactions which can't be decoded are ignored, as they are rejected
+// during their execution.
+func (v *SigBatchVerifier) VerifyBlock(ctx sdk.Context, txs [][]byte) {
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	var ed25519Sigs, ecdsaSigs []*pendingSig
+	for _, txBytes := range txs {
+		tx, err := v.txDecoder(txBytes)
+		if err != nil {
+			continue
+		}
+
+		for _, s := range v.pendingSigs(ctx, tx) {
+			if _, ok := s.pubKey.(*ed25519.PubKey); ok {
+				ed25519Sigs = append(ed25519Sigs, s)
+			} else {
+				ecdsaSigs = append(ecdsaSigs, s)
+			}
+		}
+	}
+
+	var tasks []func()
+	for start := 0; start < len(ed25519Sigs); start += ed25519BatchSize {
+		batch := ed25519Sigs[start:min(start+ed25519BatchSize, len(ed25519Sigs))]
+		tasks = append(tasks, func() { verifyEd25519Batch(batch) })
+	}
+	for _, s := range ecdsaSigs {
+		tasks = append(tasks, func() { s.valid = s.pubKey.VerifySignature(s.signBytes, s.sig) })
+	}
+	runConcurrently(tasks)
+
+	verified := make(map[[sha256.Size]byte]struct{}, len(ed25519Sigs)+len(ecdsaSigs))
+	for _, sigs := range [][]*pendingSig{ed25519Sigs, ecdsaSigs} {
+		for _, s := range sigs {
+			if s.valid {
+				verified[sigCacheKey(s.pubKey, s.signBytes, s.sig)] = struct{}{}
+			}
+		}
+	}
+
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	v.height = ctx.BlockHeight()
+	v.verified = verified
+}
+
+// pendingSigs returns the single signatures of a transaction to verify, with
+// their sign bytes computed as the SigVerificationDecorator would from the
+// current state.
+func (v *SigBatchVerifier) pendingSigs(ctx sdk.Context, tx sdk.Tx) []*pendingSig {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return nil
+	}
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return nil
+	}
+
+	signatures, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return nil
+	}
+	pubKeys, err := sigTx.GetPubKeys()
+	if err != nil {
+		return nil
+	}
+	if len(signatures) != len(signers) || len(pubKeys) != len(signers) {
+		return nil
+	}
+
+	txData := adaptableTx.GetSigningTxData()
+	var pending []*pendingSig
+	for i, signer := range signers {
+		// SIGN_MODE_EIP_712 signatures are over the Keccak-256 hash of the sign bytes
+		data, ok := signatures[i].Data.(*signing.SingleSignatureData)
+		if !ok || data.SignMode == signing.SignMode_SIGN_MODE_EIP_712 {
+			continue
+		}
+
+		// an account created by the transaction signs with the account number 0
+		var accNum uint64
+		pubKey := pubKeys[i]
+		if acc := v.ak.GetAccount(ctx, signer); acc != nil {
+			if ctx.BlockHeight() != 0 {
+				accNum = acc.GetAccountNumber()
+			}
+			if acc.GetPubKey() != nil {
+				pubKey = acc.GetPubKey()
+			}
+		}
+
+		switch pubKey.(type) {
+		case *ed25519.PubKey, *secp256k1.PubKey, *secp256r1.PubKey, *webauthn.PubKey:
+		default:
+			continue
+		}
+
+		signerData, err := newSignerData(sdk.AccAddress(signer).String(), ctx.ChainID(), accNum, signatures[i].Sequence, pubKey)
+		if err != nil {
+			continue
+		}
+		signBytes, err := singleSignBytes(ctx, v.signModeHandler, signerData, data, txData)
+		if err != nil {
+			continue
+		}
+
+		pending = append(pending, &pendingSig{pubKey: pubKey, signBytes: signBytes, sig: data.Signature})
+	}
+
+	return pending
+}
+
+// isVerified returns true if a single signature was found valid by the last call
+// to VerifyBlock, when executing the transactions of the same block.
+func (v *SigBatchVerifier) isVerified(
+	ctx sdk.Context,
+	pubKey cryptotypes.PubKey,
+	signerData txsigning.SignerData,
+	sigData signing.SignatureData,
+	txData txsigning.TxData,
+) bool {
+	if v == nil || v.ak.GetEnvironment().TransactionService.ExecMode(ctx) != transaction.ExecModeFinalize {
+		return false
+	}
+
+	data, ok := sigData.(*signing.SingleSignatureData)
+	if !ok || data.SignMode == signing.SignMode_SIGN_MODE_EIP_712 {
+		return false
+	}
+
+	v.mtx.RLock()
+	skip := v.height != ctx.BlockHeight() || len(v.verified) == 0
+	v.mtx.RUnlock()
+	if skip {
+		return false
+	}
+
+	// the sign bytes are computed again on a miss, which must not consume gas
+	signBytes, err := singleSignBytes(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), v.signModeHandler, signerData, data, txData)
+	if err != nil {
+		return false
+	}
+	key := sigCacheKey(pubKey, signBytes, data.Signature)
+
+	v.mtx.RLock()
+	defer v.mtx.RUnlock()
+	_, ok = v.verified[key]
+	return ok
+}
+
+// verifyEd25519Batch verifies a batch of ed25519 signatures, one by one if the
+// batch is invalid to find the valid ones.
+func verifyEd25519Batch(sigs []*pendingSig) {
+	bv := ed25519.NewBatchVerifier()
+	for _, s := range sigs {
+		bv.Add(s.pubKey.(*ed25519.PubKey), s.signBytes, s.sig)
+	}
+
+	valid := bv.Verify()
+	for _, s := range sigs {
+		s.valid = valid || s.pubKey.VerifySignature(s.signBytes, s.sig)
+	}
+}
+
+// runConcurrently runs the given tasks with as many goroutines as CPUs.
+func runConcurrently(tasks []func()) {
+	queue := make(chan func())
+	var wg sync.WaitGroup
+	for i := 0; i < min(runtime.GOMAXPROCS(0), len(tasks)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range queue {
+				task()
+			}
+		}()
+	}
+
+	for _, task := range tasks {
+		queue <- task
+	}
+	close(queue)
+	wg.Wait()
+}
+
+// sigCacheKey identifies a signature by the public key, sign bytes and signature,
+// which determine the result of its verification.
+func sigCacheKey(pubKey cryptotypes.PubKey, signBytes, sig []byte) [sha256.Size]byte {
+	h := sha256.New()
+	for _, bz := range [][]byte{[]byte(pubKey.Type()), pubKey.Bytes(), signBytes, sig} {
+		_ = binary.Write(h, binary.BigEndian, uint64(len(bz)))
+		h.Write(bz)
+	}
+
+	var key [sha256.Size]byte
+	h.Sum(key[:0])
+	return key
+}
+
+// newSignerData returns the signer data of a signature of the given account.
+func newSignerData(address, chainID string, accNum, sequence uint64, pubKey cryptotypes.PubKey) (txsigning.SignerData, error) {
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return txsigning.SignerData{}, err
+	}
+
+	return txsigning.SignerData{
+		Address:       address,
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      sequence,
+		PubKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+	}, nil
+}
+
+// singleSignBytes returns the bytes signed by a single signature.
+func singleSignBytes(
+	ctx context.Context,
+	handler *txsigning.HandlerMap,
+	signerData txsigning.SignerData,
+	data *signing.SingleSignatureData,
+	txData txsigning.TxData,
+) ([]byte, error) {
+	signMode, err := authsigning.InternalSignModeToAPI(data.SignMode)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.GetSignBytes(ctx, signMode, signerData, txData)
+}
@@ -3,6 +3,7 @@ package ante_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -22,10 +23,12 @@ import (
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -72,6 +75,7 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		{"PubKeyEd25519", args{storetypes.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, p.SigVerifyCostED25519, true},
 		{"PubKeySecp256k1", args{storetypes.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
 		{"PubKeySecp256r1", args{storetypes.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
+		{"PubKeyWebAuthn", args{storetypes.NewInfiniteGasMeter(), nil, &webauthn.PubKey{Key: skR1.PubKey().Bytes(), RPID: "example.com"}, params}, p.SigVerifyCostWebAuthn(), false},
 		{"Multisig", args{storetypes.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
 		{"Multisig simulation", args{storetypes.NewInfiniteGasMeter(), multisigSimulationSignature, multisigKey1, params}, simulationExpectedCost, false},
 		{"unknown key", args{storetypes.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
@@ -236,6 +240,123 @@ func TestSigVerification(t *testing.T) {
 	}
 }
 
+func TestSigVerification_UnorderedTx(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.ctx = suite.ctx.WithBlockHeight(1).WithIsSigverifyTx(true)
+
+	priv, _, addr := testdata.KeyTestPubAddr()
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetSequence(3))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	noOpGasConsume := func(_ storetypes.GasMeter, _ signing.SignatureV2, _ types.Params) error { return nil }
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), noOpGasConsume, nil)
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	testCases := []struct {
+		name      string
+		unordered bool
+		seq       uint64
+		expErr    string
+	}{
+		{"unordered tx with zero sequence", true, 0, ""},
+		{"unordered tx with account sequence", true, 3, "unordered transaction must be signed with a zero sequence"},
+		{"ordered tx with zero sequence", false, 0, "account sequence mismatch"},
+		{"ordered tx with account sequence", false, 3, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := suite.ctx.CacheContext()
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			suite.txBuilder.SetUnordered(tc.unordered)
+			suite.txBuilder.SetTimeoutTimestamp(time.Now().Add(time.Minute))
+
+			tx, err := suite.CreateTestTx(ctx, []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{tc.seq}, ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+
+			_, err = antehandler(ctx, tx, false)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// the sequence isn't incremented by unordered transactions
+			expSeq := uint64(4)
+			if tc.unordered {
+				expSeq = 3
+			}
+			require.Equal(t, expSeq, suite.accountKeeper.GetAccount(ctx, addr).GetSequence())
+		})
+	}
+}
+
+func TestSigBatchVerifier(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	suite.ctx = suite.ctx.WithBlockHeight(1).WithExecMode(sdk.ExecModeFinalize)
+
+	secp256r1Priv, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	privs := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), ed25519.GenPrivKey(), ed25519.GenPrivKey(), secp256r1Priv}
+	accs := make([]sdk.AccountI, len(privs))
+	for i, priv := range privs {
+		accs[i] = suite.accountKeeper.NewAccountWithAddress(suite.ctx, sdk.AccAddress(priv.PubKey().Address()))
+		suite.accountKeeper.SetAccount(suite.ctx, accs[i])
+	}
+
+	noOpGasConsume := func(_ storetypes.GasMeter, _ signing.SignatureV2, _ types.Params) error { return nil }
+	txConfig := suite.clientCtx.TxConfig
+	bv := ante.NewSigBatchVerifier(suite.accountKeeper, txConfig.SignModeHandler(), txConfig.TxDecoder())
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, txConfig.SignModeHandler(), noOpGasConsume, nil).WithBatchVerifier(bv)
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	// the transactions signed with a wrong account number have invalid signatures
+	testCases := []struct {
+		name   string
+		priv   int
+		accNum uint64
+		valid  bool
+	}{
+		{"secp256k1", 0, accs[0].GetAccountNumber(), true},
+		{"ed25519", 1, accs[1].GetAccountNumber(), true},
+		{"invalid ed25519", 2, 100, false},
+		{"secp256r1", 3, accs[3].GetAccountNumber(), true},
+		{"invalid secp256k1", 0, 100, false},
+	}
+	txs := make([]sdk.Tx, len(testCases))
+	txsBytes := [][]byte{[]byte("invalid tx")}
+	for i, tc := range testCases {
+		suite.txBuilder = txConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(accs[tc.priv].GetAddress())))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		txs[i], err = suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{privs[tc.priv]}, []uint64{tc.accNum}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+
+		txBytes, err := txConfig.TxEncoder()(txs[i])
+		require.NoError(t, err)
+		txsBytes = append(txsBytes, txBytes)
+	}
+
+	bv.VerifyBlock(suite.ctx, txsBytes)
+	require.Equal(t, 3, ante.NumVerifiedSigs(bv))
+
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := suite.ctx.CacheContext()
+			_, err := antehandler(ctx, txs[i], false)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+			}
+		})
+	}
+}
+
 func TestSigIntegration(t *testing.T) {
 	// generate private keys
 	privs := []cryptotypes.PrivKey{
@@ -393,7 +514,8 @@ func TestAnteHandlerChecks(t *testing.T) {
 				if tc.supported {
 					require.ErrorContains(t, err, "not on curve")
 				} else {
-					require.ErrorContains(t, err, "unsupported key type")
+					// ed25519 keys are on curve but rejected by the default gas consumer
+					require.ErrorContains(t, err, "ED25519 public keys are unsupported")
 				}
 			} else {
 				require.Nil(t, err, "TestCase %d: %s errored unexpectedly. Err: %v", i, tc.name, err)
@@ -172,6 +172,126 @@ func (mr *MockFeegrantKeeperMockRecorder) UseGrantedFees(ctx, granter, grantee,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseGrantedFees", reflect.TypeOf((*MockFeegrantKeeper)(nil).UseGrantedFees), ctx, granter, grantee, fee, msgs)
 }
 
+// MockFeeMarketKeeper is a mock of FeeMarketKeeper interface.
+type MockFeeMarketKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeMarketKeeperMockRecorder
+}
+
+// MockFeeMarketKeeperMockRecorder is the mock recorder for MockFeeMarketKeeper.
+type MockFeeMarketKeeperMockRecorder struct {
+	mock *MockFeeMarketKeeper
+}
+
+// NewMockFeeMarketKeeper creates a new mock instance.
+func NewMockFeeMarketKeeper(ctrl *gomock.Controller) *MockFeeMarketKeeper {
+	mock := &MockFeeMarketKeeper{ctrl: ctrl}
+	mock.recorder = &MockFeeMarketKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeMarketKeeper) EXPECT() *MockFeeMarketKeeperMockRecorder {
+	return m.recorder
+}
+
+// ConsumeBlockGas mocks base method.
+func (m *MockFeeMarketKeeper) ConsumeBlockGas(ctx context.Context, gas uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeBlockGas", ctx, gas)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConsumeBlockGas indicates an expected call of ConsumeBlockGas.
+func (mr *MockFeeMarketKeeperMockRecorder) ConsumeBlockGas(ctx, gas interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeBlockGas", reflect.TypeOf((*MockFeeMarketKeeper)(nil).ConsumeBlockGas), ctx, gas)
+}
+
+// GetBaseFee mocks base method.
+func (m *MockFeeMarketKeeper) GetBaseFee(ctx context.Context) (types1.DecCoin, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBaseFee", ctx)
+	ret0, _ := ret[0].(types1.DecCoin)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBaseFee indicates an expected call of GetBaseFee.
+func (mr *MockFeeMarketKeeperMockRecorder) GetBaseFee(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaseFee", reflect.TypeOf((*MockFeeMarketKeeper)(nil).GetBaseFee), ctx)
+}
+
+// MockTxRateLimitKeeper is a mock of TxRateLimitKeeper interface.
+type MockTxRateLimitKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxRateLimitKeeperMockRecorder
+}
+
+// MockTxRateLimitKeeperMockRecorder is the mock recorder for MockTxRateLimitKeeper.
+type MockTxRateLimitKeeperMockRecorder struct {
+	mock *MockTxRateLimitKeeper
+}
+
+// NewMockTxRateLimitKeeper creates a new mock instance.
+func NewMockTxRateLimitKeeper(ctrl *gomock.Controller) *MockTxRateLimitKeeper {
+	mock := &MockTxRateLimitKeeper{ctrl: ctrl}
+	mock.recorder = &MockTxRateLimitKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTxRateLimitKeeper) EXPECT() *MockTxRateLimitKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetParams mocks base method.
+func (m *MockTxRateLimitKeeper) GetParams(ctx context.Context) types.Params {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParams", ctx)
+	ret0, _ := ret[0].(types.Params)
+	return ret0
+}
+
+// GetParams indicates an expected call of GetParams.
+func (mr *MockTxRateLimitKeeperMockRecorder) GetParams(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockTxRateLimitKeeper)(nil).GetParams), ctx)
+}
+
+// IncrementAccountTxCount mocks base method.
+func (m *MockTxRateLimitKeeper) IncrementAccountTxCount(ctx context.Context, addr types1.AccAddress) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementAccountTxCount", ctx, addr)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementAccountTxCount indicates an expected call of IncrementAccountTxCount.
+func (mr *MockTxRateLimitKeeperMockRecorder) IncrementAccountTxCount(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementAccountTxCount", reflect.TypeOf((*MockTxRateLimitKeeper)(nil).IncrementAccountTxCount), ctx, addr)
+}
+
+// IncrementBlockTxCount mocks base method.
+func (m *MockTxRateLimitKeeper) IncrementBlockTxCount(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementBlockTxCount", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementBlockTxCount indicates an expected call of IncrementBlockTxCount.
+func (mr *MockTxRateLimitKeeperMockRecorder) IncrementBlockTxCount(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementBlockTxCount", reflect.TypeOf((*MockTxRateLimitKeeper)(nil).IncrementBlockTxCount), ctx)
+}
+
 // MockConsensusKeeper is a mock of ConsensusKeeper interface.
 type MockConsensusKeeper struct {
 	ctrl     *gomock.Controller
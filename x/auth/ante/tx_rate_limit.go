@@ -0,0 +1,97 @@
+package ante
+
+import (
+	"context"
+
+	"cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/transaction"
+	errorsmod "cosmossdk.io/errors"
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TxRateLimitDecorator limits the number of transactions in a block, and the
+// number of transactions an account can sign in a block, as set in the tx rate
+// limit params. It is a no-op when the keeper is nil or the tx
+// rate limit params are not set.
+//
+// During CheckTx the counts include the transactions accepted in the mempool
+// since the last block, so that an account can't fill the mempool beyond its
+// limit either. Simulations and genesis transactions are not counted.
+type TxRateLimitDecorator struct {
+	env appmodule.Environment
+	k   TxRateLimitKeeper
+}
+
+func NewTxRateLimitDecorator(env appmodule.Environment, k TxRateLimitKeeper) TxRateLimitDecorator {
+	return TxRateLimitDecorator{
+		env: env,
+		k:   k,
+	}
+}
+
+// AnteHandle implements an AnteHandler decorator for the TxRateLimitDecorator.
+func (trld TxRateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// genesis transactions are not rate limited
+	if ctx.BlockHeight() == 0 {
+		return next(ctx, tx, false)
+	}
+
+	if err := trld.ValidateTx(ctx, tx); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, false)
+}
+
+// ValidateTx implements an TxValidator for TxRateLimitDecorator
+func (trld TxRateLimitDecorator) ValidateTx(ctx context.Context, tx sdk.Tx) error {
+	if trld.k == nil {
+		return nil
+	}
+
+	if trld.env.TransactionService.ExecMode(ctx) == transaction.ExecModeSimulate {
+		return nil
+	}
+
+	limits := trld.k.GetParams(ctx).TxRateLimit
+	if limits == nil {
+		return nil
+	}
+
+	blockTxs, err := trld.k.IncrementBlockTxCount(ctx)
+	if err != nil {
+		return err
+	}
+	if limits.MaxBlockTxs > 0 && blockTxs > limits.MaxBlockTxs {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "tx rate limit exceeded: more than %d transactions in the block", limits.MaxBlockTxs)
+	}
+
+	if limits.MaxAccountTxs == 0 {
+		return nil
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return err
+	}
+
+	for _, signer := range signers {
+		accountTxs, err := trld.k.IncrementAccountTxCount(ctx, signer)
+		if err != nil {
+			return err
+		}
+		if accountTxs > limits.MaxAccountTxs {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "tx rate limit exceeded: more than %d transactions signed by the same account in the block", limits.MaxAccountTxs)
+		}
+	}
+
+	return nil
+}
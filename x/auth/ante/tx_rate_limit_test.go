@@ -0,0 +1,81 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth/ante"
+	authtypes "cosmossdk.io/x/auth/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestTxRateLimitDecorator(t *testing.T) {
+	s := SetupTestSuite(t, true)
+
+	trld := ante.NewTxRateLimitDecorator(s.env, s.accountKeeper)
+	antehandler := sdk.ChainAnteDecorators(trld)
+
+	accs := s.CreateTestAccounts(2)
+	txs := make([]sdk.Tx, len(accs))
+	for i, acc := range accs {
+		s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, s.txBuilder.SetMsgs(testdata.NewTestMsg(acc.acc.GetAddress())))
+		s.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{acc.priv}, []uint64{uint64(i)}, []uint64{0}
+		tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		txs[i] = tx
+	}
+
+	// like in baseapp, the state changes of a rejected tx are discarded
+	runTx := func(ctx sdk.Context, tx sdk.Tx) error {
+		cacheCtx, write := ctx.CacheContext()
+		_, err := antehandler(cacheCtx, tx, false)
+		if err == nil {
+			write()
+		}
+		return err
+	}
+
+	// the txs are not rate limited by default
+	for i := 0; i < 5; i++ {
+		require.NoError(t, runTx(s.ctx, txs[0]))
+	}
+	_, err := s.accountKeeper.BlockTxCount.Get(s.ctx)
+	require.Error(t, err)
+
+	params := authtypes.DefaultParams()
+	params.TxRateLimit = &authtypes.TxRateLimitParams{
+		MaxBlockTxs:   3,
+		MaxAccountTxs: 2,
+	}
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+
+	require.NoError(t, runTx(s.ctx, txs[0]))
+	require.NoError(t, runTx(s.ctx, txs[0]))
+	err = runTx(s.ctx, txs[0])
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(t, err, "signed by the same account")
+
+	require.NoError(t, runTx(s.ctx, txs[1]))
+	err = runTx(s.ctx, txs[1])
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(t, err, "in the block")
+
+	// simulations are not counted
+	require.NoError(t, runTx(s.ctx.WithExecMode(sdk.ExecModeSimulate), txs[1]))
+
+	// the counts are reset at the next block, with the transient store
+	s.ctx.MultiStore().(storetypes.CommitMultiStore).Commit()
+	require.NoError(t, runTx(s.ctx, txs[1]))
+	require.NoError(t, runTx(s.ctx, txs[0]))
+}
@@ -35,12 +35,14 @@ var _ sdk.AnteDecorator = (*UnorderedTxDecorator)(nil)
 // nonce incremented, which allows fire-and-forget along with possible parallel
 // transaction processing, without having to deal with nonces.
 //
-// The transaction sender must ensure that unordered=true and a timeout_height
-// is appropriately set. The AnteHandler will check that the transaction is not
+// The transaction sender must ensure that unordered=true and a timeout_timestamp
+// is appropriately set, and sign the transaction with a zero sequence. The AnteHandler will check that the transaction is not
 // a duplicate and will evict it from memory when the timeout is reached.
 //
 // The UnorderedTxDecorator should be placed as early as possible in the AnteHandler
 // chain to ensure that during DeliverTx, the transaction is added to the UnorderedTxManager.
+// Unordered transactions are rejected if the UnorderedTxManager is nil, as they
+// could otherwise be replayed.
 type UnorderedTxDecorator struct {
 	// maxUnOrderedTTL defines the maximum TTL a transaction can define.
 	maxTimeoutDuration time.Duration
@@ -75,6 +77,9 @@ func (d *UnorderedTxDecorator) AnteHandle(
 		// unordered value as false, we bypass.
 		return next(ctx, tx, false)
 	}
+	if d.txManager == nil {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrNotSupported, "unordered transactions are not supported")
+	}
 
 	headerInfo := d.env.HeaderService.HeaderInfo(ctx)
 	timeoutTimestamp := unorderedTx.GetTimeoutTimeStamp()
@@ -117,19 +122,31 @@ func (d *UnorderedTxDecorator) AnteHandle(
 
 	// check for duplicates
 	if d.txManager.Contains(txHash) {
-		return ctx, errorsmod.Wrap(
-			sdkerrors.ErrInvalidRequest,
-			"tx %X is duplicated",
-		)
+		return ctx, duplicatedTxError(txHash)
 	}
 	if d.env.TransactionService.ExecMode(ctx) == transaction.ExecModeFinalize {
 		// a new tx included in the block, add the hash to the unordered tx manager
-		d.txManager.Add(txHash, timeoutTimestamp)
+		// once the tx is committed, the tx being a duplicate if a previous tx of
+		// the block added it meanwhile
+		err := ctx.ApplyTxEffect(func() error {
+			if d.txManager.Contains(txHash) {
+				return duplicatedTxError(txHash)
+			}
+			d.txManager.Add(txHash, timeoutTimestamp)
+			return nil
+		})
+		if err != nil {
+			return ctx, err
+		}
 	}
 
 	return next(ctx, tx, false)
 }
 
+func duplicatedTxError(txHash [32]byte) error {
+	return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "tx %X is duplicated", txHash)
+}
+
 // TxIdentifier returns a unique identifier for a transaction that is intended to be unordered.
 func TxIdentifier(timeout uint64, tx sdk.Tx) ([32]byte, error) {
 	feetx := tx.(sdk.FeeTx)
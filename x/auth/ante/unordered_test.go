@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -141,6 +142,56 @@ func TestUnorderedTxDecorator_UnorderedTx_ValidDeliverTx(t *testing.T) {
 	require.True(t, txm.Contains(bz))
 }
 
+func TestUnorderedTxDecorator_UnorderedTx_OptimisticDeliverTx(t *testing.T) {
+	txm := unorderedtx.NewManager(t.TempDir())
+	defer func() {
+		require.NoError(t, txm.Close())
+	}()
+
+	txm.Start()
+
+	suite := SetupTestSuite(t, false)
+
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, txm, suite.accountKeeper.GetEnvironment(), ante.DefaultSha256Cost))
+
+	tx, txBz := genUnorderedTx(t, true, time.Now().Add(time.Minute))
+	bz := [32]byte{}
+	copy(bz[:], txBz[:32])
+
+	// the hash is only added once the optimistic executions are committed
+	newCtx := func(effects *sdk.TxEffects) sdk.Context {
+		return sdk.Context{}.WithContext(context.Background()).WithTxBytes(txBz).WithHeaderInfo(header.Info{Time: time.Now()}).WithExecMode(sdk.ExecModeFinalize).
+			WithGasMeter(storetypes.NewGasMeter(gasConsumed)).WithTxEffects(effects)
+	}
+	first, second := &sdk.TxEffects{}, &sdk.TxEffects{}
+	_, err := chain(newCtx(first), tx, false)
+	require.NoError(t, err)
+	_, err = chain(newCtx(second), tx, false)
+	require.NoError(t, err)
+	require.False(t, txm.Contains(bz))
+
+	// the duplicate fails to be committed, to be executed again
+	require.NoError(t, first.Apply())
+	require.True(t, txm.Contains(bz))
+	require.ErrorContains(t, second.Apply(), "is duplicated")
+}
+
+func TestUnorderedTxDecorator_NoManager(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, nil, suite.accountKeeper.GetEnvironment(), ante.DefaultSha256Cost))
+
+	tx, txBz := genUnorderedTx(t, false, time.Time{})
+	_, err := chain(sdk.Context{}.WithTxBytes(txBz), tx, false)
+	require.NoError(t, err)
+
+	// unordered transactions are rejected, as their replays can't be detected
+	tx, txBz = genUnorderedTx(t, true, time.Now().Add(time.Minute))
+	ctx := sdk.Context{}.WithTxBytes(txBz).WithHeaderInfo(header.Info{Time: time.Now()}).WithExecMode(sdk.ExecModeFinalize).WithGasMeter(storetypes.NewGasMeter(gasConsumed))
+	_, err = chain(ctx, tx, false)
+	require.ErrorContains(t, err, "unordered transactions are not supported")
+}
+
 func genUnorderedTx(t *testing.T, unordered bool, timestamp time.Time) (sdk.Tx, []byte) {
 	t.Helper()
 
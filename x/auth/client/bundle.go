@@ -0,0 +1,165 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// UnsignedBundle is a portable bundle of an unsigned transaction with all the
+// data needed to sign it offline, i.e. the chain ID, the sign mode, and the
+// account number and sequence of each of its signers, so that it can be signed
+// on an air-gapped machine without setting these manually.
+type UnsignedBundle struct {
+	// Tx is the JSON encoding of the unsigned transaction.
+	Tx       json.RawMessage `json:"tx"`
+	ChainID  string          `json:"chain_id"`
+	SignMode string          `json:"sign_mode"`
+	Signers  []BundleSigner  `json:"signers"`
+}
+
+// BundleSigner is the signer data of a signer of an UnsignedBundle.
+type BundleSigner struct {
+	Address       string `json:"address"`
+	AccountNumber uint64 `json:"account_number,string"`
+	Sequence      uint64 `json:"sequence,string"`
+}
+
+// NewUnsignedBundle returns the bundle of an unsigned transaction, with the
+// chain ID and sign mode of the factory. The account numbers and sequences of
+// the signers are queried, unless offline, in which case the transaction must
+// have a single signer whose account number and sequence are those of the
+// factory. The sequence of an unordered transaction is always zero.
+func NewUnsignedBundle(clientCtx client.Context, txFactory tx.Factory, unsignedTx sdk.Tx) (*UnsignedBundle, error) {
+	if txFactory.ChainID() == "" {
+		return nil, errors.New("set the chain id with either the --chain-id flag or config file")
+	}
+
+	txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+	signers, err := txBuilder.GetTx().GetSigners()
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) == 0 {
+		return nil, errors.New("transaction has no signers")
+	}
+	if clientCtx.Offline && len(signers) > 1 {
+		return nil, errors.New("cannot bundle a transaction with multiple signers offline")
+	}
+
+	unordered := false
+	if utx, ok := unsignedTx.(sdk.TxWithUnordered); ok {
+		unordered = utx.GetUnordered()
+	}
+
+	signMode := txFactory.SignMode()
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		signMode, err = authsigning.APISignModeToInternal(clientCtx.TxConfig.SignModeHandler().DefaultMode())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bundle := &UnsignedBundle{
+		ChainID:  txFactory.ChainID(),
+		SignMode: signMode.String(),
+		Signers:  make([]BundleSigner, len(signers)),
+	}
+	for i, signer := range signers {
+		addr := sdk.AccAddress(signer)
+		accNum, seq := txFactory.AccountNumber(), txFactory.Sequence()
+		if !clientCtx.Offline {
+			accNum, seq, err = clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to query the account of signer %s: %w", addr, err)
+			}
+		}
+		if unordered {
+			seq = 0
+		}
+
+		bundle.Signers[i] = BundleSigner{Address: addr.String(), AccountNumber: accNum, Sequence: seq}
+	}
+
+	bundle.Tx, err = clientCtx.TxConfig.TxJSONEncoder()(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return bundle, nil
+}
+
+// ReadUnsignedBundleFromFile reads an UnsignedBundle from the given filename
+// and decodes its transaction. Can pass "-" to read from stdin.
+func ReadUnsignedBundleFromFile(ctx client.Context, filename string) (*UnsignedBundle, sdk.Tx, error) {
+	var (
+		bz  []byte
+		err error
+	)
+	if filename == "-" {
+		bz, err = io.ReadAll(os.Stdin)
+	} else {
+		bz, err = os.ReadFile(filename)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bundle := &UnsignedBundle{}
+	if err := json.Unmarshal(bz, bundle); err != nil {
+		return nil, nil, fmt.Errorf("invalid unsigned bundle: %w", err)
+	}
+	if len(bundle.Tx) == 0 || bundle.ChainID == "" {
+		return nil, nil, errors.New("invalid unsigned bundle: missing transaction or chain id")
+	}
+
+	unsignedTx, err := ctx.TxConfig.TxJSONDecoder()(bundle.Tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return bundle, unsignedTx, nil
+}
+
+// Signer returns the signer data of the given address.
+func (b *UnsignedBundle) Signer(addr sdk.AccAddress) (BundleSigner, error) {
+	for _, signer := range b.Signers {
+		if signer.Address == addr.String() {
+			return signer, nil
+		}
+	}
+
+	return BundleSigner{}, fmt.Errorf("%s is not a signer of the bundled transaction", addr)
+}
+
+// ApplyToFactory returns a copy of the factory with the chain ID, sign mode,
+// and account number and sequence of the given signer of the bundle.
+func (b *UnsignedBundle) ApplyToFactory(txFactory tx.Factory, signer sdk.AccAddress) (tx.Factory, error) {
+	signMode, ok := signing.SignMode_value[b.SignMode]
+	if !ok {
+		return txFactory, fmt.Errorf("invalid sign mode %q in unsigned bundle", b.SignMode)
+	}
+
+	signerData, err := b.Signer(signer)
+	if err != nil {
+		return txFactory, err
+	}
+
+	return txFactory.
+		WithChainID(b.ChainID).
+		WithSignMode(signing.SignMode(signMode)).
+		WithAccountNumber(signerData.AccountNumber).
+		WithSequence(signerData.Sequence), nil
+}
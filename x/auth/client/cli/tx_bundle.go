@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	authclient "cosmossdk.io/x/auth/client"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const flagBundle = "bundle"
+
+// GetExportBundleCommand returns the command exporting an unsigned transaction
+// as an unsigned bundle.
+func GetExportBundleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-bundle [file]",
+		Short: "Export a transaction generated offline as an unsigned bundle",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export a transaction created with the --generate-only flag as an unsigned bundle.
+It will read a transaction from [file], query the account number and sequence of each
+of its signers, and print the bundle of the transaction with these, the chain ID and the
+sign mode.
+
+The bundle can then be signed on an air-gapped machine with the --bundle flag of the
+sign and multi-sign commands, without setting the chain ID, sign mode, account number
+and sequence manually.
+
+If the --offline flag is set, the transaction must have a single signer, whose account
+number and sequence are set with the --account-number and --sequence flags.
+
+Example:
+$ %s tx export-bundle unsigned.json --chain-id=<chain-id> --sign-mode=amino-json > bundle.json
+$ %s tx sign bundle.json --bundle --from=<key> --multisig=<multisig> > sig.json
+$ %s tx multi-sign bundle.json <multisig> sig1.json sig2.json --bundle
+`,
+				version.AppName, version.AppName, version.AppName,
+			),
+		),
+		PreRun: preSignCmd,
+		RunE:   makeExportBundleCmd(),
+		Args:   cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func makeExportBundleCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		clientCtx, txFactory, unsignedTx, err := readTxAndInitContexts(clientCtx, cmd, args[0])
+		if err != nil {
+			return err
+		}
+
+		bundle, err := authclient.NewUnsignedBundle(clientCtx, txFactory, unsignedTx)
+		if err != nil {
+			return err
+		}
+
+		bz, err := json.MarshalIndent(bundle, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		closeFunc, err := setOutputFile(cmd)
+		if err != nil {
+			return err
+		}
+		defer closeFunc()
+
+		cmd.Printf("%s\n", bz)
+		return nil
+	}
+}
+
+// readBundleAndInitContexts reads an unsigned bundle from the given filename,
+// and returns the offline client context and the factory of the given signer
+// initialized from the bundle.
+func readBundleAndInitContexts(
+	clientCtx client.Context, cmd *cobra.Command, filename string, signer sdk.AccAddress,
+) (client.Context, tx.Factory, sdk.Tx, error) {
+	bundle, unsignedTx, err := authclient.ReadUnsignedBundleFromFile(clientCtx, filename)
+	if err != nil {
+		return clientCtx, tx.Factory{}, nil, err
+	}
+
+	// the account number and sequence are read from the bundle, not the flags
+	clientCtx = clientCtx.WithOffline(false)
+	txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+	if err != nil {
+		return clientCtx, tx.Factory{}, nil, err
+	}
+
+	txFactory, err = bundle.ApplyToFactory(txFactory, signer)
+	if err != nil {
+		return clientCtx, tx.Factory{}, nil, err
+	}
+
+	return clientCtx.WithOffline(true).WithChainID(bundle.ChainID), txFactory, unsignedTx, nil
+}
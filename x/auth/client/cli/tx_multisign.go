@@ -48,6 +48,10 @@ If the --offline flag is on, the client will not reach out to an external node.
 Account number or sequence number lookups are not performed so you must
 set these parameters manually.
 
+If the --bundle flag is on, [file] is an unsigned bundle written by the export-bundle
+command, and the chain ID, sign mode, account number and sequence of the multisig
+account are read from it instead of being queried or set manually.
+
 If the --skip-signature-verification flag is on, the command will not verify the
 signatures in the provided signature files. This is useful when the multisig
 account is a signer in a nested multisig scenario.
@@ -64,6 +68,7 @@ The SIGN_MODE_DIRECT sign mode is not supported.'
 
 	cmd.Flags().Bool(flagSkipSignatureVerification, false, "Skip signature verification")
 	cmd.Flags().Bool(flagSigOnly, false, "Print only the generated signature, then exit")
+	cmd.Flags().Bool(flagBundle, false, "Read an unsigned bundle from [file] and use its signer data")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
 	flags.AddTxFlagsToCmd(cmd)
 	_ = cmd.Flags().MarkHidden(flags.FlagOutput)
@@ -82,35 +87,39 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 		if err != nil {
 			return err
 		}
-		parsedTx, err := authclient.ReadTxFromFile(clientCtx, file)
+
+		k, err := clientCtx.Keyring.Key(name)
 		if err != nil {
-			return err
+			return errorsmod.Wrap(err, "error getting keybase multisig account")
 		}
-
-		txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+		pubKey, err := k.GetPubKey()
 		if err != nil {
 			return err
 		}
-		if txFactory.SignMode() == signingtypes.SignMode_SIGN_MODE_UNSPECIFIED {
-			txFactory = txFactory.WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
-		}
 
-		txCfg := clientCtx.TxConfig
-		txBuilder, err := txCfg.WrapTxBuilder(parsedTx)
+		addr, err := k.GetAddress()
 		if err != nil {
 			return err
 		}
 
-		k, err := clientCtx.Keyring.Key(name)
-		if err != nil {
-			return errorsmod.Wrap(err, "error getting keybase multisig account")
+		var (
+			parsedTx  sdk.Tx
+			txFactory tx.Factory
+		)
+		if bundle, _ := cmd.Flags().GetBool(flagBundle); bundle {
+			clientCtx, txFactory, parsedTx, err = readBundleAndInitContexts(clientCtx, cmd, file, addr)
+		} else {
+			clientCtx, txFactory, parsedTx, err = readTxAndInitContexts(clientCtx, cmd, file)
 		}
-		pubKey, err := k.GetPubKey()
 		if err != nil {
 			return err
 		}
+		if txFactory.SignMode() == signingtypes.SignMode_SIGN_MODE_UNSPECIFIED {
+			txFactory = txFactory.WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+		}
 
-		addr, err := k.GetAddress()
+		txCfg := clientCtx.TxConfig
+		txBuilder, err := txCfg.WrapTxBuilder(parsedTx)
 		if err != nil {
 			return err
 		}
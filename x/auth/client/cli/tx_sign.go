@@ -337,6 +337,10 @@ the transaction to fail.
 The --multisig=<multisig_key> flag generates a signature on behalf of a multisig account
 key. It implies --signature-only. Full multisig signed transactions may eventually
 be generated via the 'multisign' command.
+
+The --bundle flag reads an unsigned bundle written by the 'export-bundle' command from
+[file] instead of a transaction. The transaction is then signed offline with the chain ID,
+sign mode, account number and sequence of the bundle.
 `,
 		PreRun: preSignCmd,
 		RunE:   makeSignCmd(),
@@ -346,6 +350,7 @@ be generated via the 'multisign' command.
 	cmd.Flags().String(flagMultisig, "", "Address or key name of the multisig account on behalf of which the transaction shall be signed")
 	cmd.Flags().Bool(flagOverwrite, false, "Overwrite existing signatures with a new one. If disabled, new signature will be appended")
 	cmd.Flags().Bool(flagSigOnly, false, "Print only the signatures")
+	cmd.Flags().Bool(flagBundle, false, "Read an unsigned bundle from [file] and sign it offline with its signer data")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -354,8 +359,9 @@ be generated via the 'multisign' command.
 
 func preSignCmd(cmd *cobra.Command, _ []string) {
 	// Conditionally mark the account and sequence numbers required as no RPC
-	// query will be done.
-	if offline, _ := cmd.Flags().GetBool(flags.FlagOffline); offline {
+	// query will be done, unless they are read from an unsigned bundle.
+	bundle, _ := cmd.Flags().GetBool(flagBundle)
+	if offline, _ := cmd.Flags().GetBool(flags.FlagOffline); offline && !bundle {
 		err := cmd.MarkFlagRequired(flags.FlagAccountNumber)
 		if err != nil {
 			panic(err)
@@ -376,7 +382,22 @@ func makeSignCmd() func(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
-		clientCtx, txF, newTx, err := readTxAndInitContexts(clientCtx, cmd, args[0])
+		var (
+			txF   tx.Factory
+			newTx sdk.Tx
+		)
+		if bundle, _ := cmd.Flags().GetBool(flagBundle); bundle {
+			signer := clientCtx.FromAddress
+			if multisigKey, _ := cmd.Flags().GetString(flagMultisig); multisigKey != "" {
+				signer, _, _, err = client.GetFromFields(clientCtx, clientCtx.Keyring, multisigKey)
+				if err != nil {
+					return fmt.Errorf("error getting account from keybase: %w", err)
+				}
+			}
+			clientCtx, txF, newTx, err = readBundleAndInitContexts(clientCtx, cmd, args[0], signer)
+		} else {
+			clientCtx, txF, newTx, err = readTxAndInitContexts(clientCtx, cmd, args[0])
+		}
 		if err != nil {
 			return err
 		}
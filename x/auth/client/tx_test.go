@@ -1,6 +1,7 @@
 package client_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
@@ -11,11 +12,14 @@ import (
 	authclient "cosmossdk.io/x/auth/client"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 func TestParseQueryResponse(t *testing.T) {
@@ -186,3 +190,61 @@ func TestBatchScanner_Scan(t *testing.T) {
 		})
 	}
 }
+
+func TestUnsignedBundle(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	txConfig := encodingConfig.TxConfig
+
+	clientCtx := client.Context{}.
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithTxConfig(txConfig).
+		WithOffline(true)
+
+	testdata.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	signer := sdk.AccAddress("signer")
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(signer)))
+	txBuilder.SetFeeAmount(sdk.Coins{sdk.NewInt64Coin("atom", 150)})
+	txBuilder.SetMemo("foomemo")
+
+	txFactory := tx.Factory{}.
+		WithTxConfig(txConfig).
+		WithAccountNumber(7).
+		WithSequence(3)
+
+	// the chain id is required
+	_, err := authclient.NewUnsignedBundle(clientCtx, txFactory, txBuilder.GetTx())
+	require.ErrorContains(t, err, "chain id")
+
+	txFactory = txFactory.WithChainID("test-chain")
+	bundle, err := authclient.NewUnsignedBundle(clientCtx, txFactory, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, "test-chain", bundle.ChainID)
+	require.Equal(t, signing.SignMode_SIGN_MODE_DIRECT.String(), bundle.SignMode)
+	require.Equal(t, []authclient.BundleSigner{{Address: signer.String(), AccountNumber: 7, Sequence: 3}}, bundle.Signers)
+
+	bz, err := json.Marshal(bundle)
+	require.NoError(t, err)
+	bundleFile := testutil.WriteToNewTempFile(t, string(bz))
+
+	readBundle, readTx, err := authclient.ReadUnsignedBundleFromFile(clientCtx, bundleFile.Name())
+	require.NoError(t, err)
+	require.Equal(t, bundle.Signers, readBundle.Signers)
+	txBldr, err := txConfig.WrapTxBuilder(readTx)
+	require.NoError(t, err)
+	require.Equal(t, "foomemo", txBldr.GetTx().GetMemo())
+
+	signerFactory, err := readBundle.ApplyToFactory(tx.Factory{}, signer)
+	require.NoError(t, err)
+	require.Equal(t, "test-chain", signerFactory.ChainID())
+	require.Equal(t, signing.SignMode_SIGN_MODE_DIRECT, signerFactory.SignMode())
+	require.Equal(t, uint64(7), signerFactory.AccountNumber())
+	require.Equal(t, uint64(3), signerFactory.Sequence())
+
+	_, err = readBundle.ApplyToFactory(tx.Factory{}, sdk.AccAddress("other"))
+	require.ErrorContains(t, err, "is not a signer")
+
+	_, _, err = authclient.ReadUnsignedBundleFromFile(clientCtx, testutil.WriteToNewTempFile(t, "{}").Name())
+	require.Error(t, err)
+}
@@ -1,9 +1,14 @@
 package auth
 
 import (
+	"sort"
+
+	"golang.org/x/exp/maps"
+
 	modulev1 "cosmossdk.io/api/cosmos/auth/module/v1"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
 	"cosmossdk.io/x/auth/keeper"
@@ -22,6 +27,7 @@ func (am AppModule) IsOnePerModuleType() {}
 func init() {
 	appconfig.RegisterModule(&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetAccountPruneCheckers),
 	)
 }
 
@@ -36,6 +42,7 @@ type ModuleInputs struct {
 	AddressCodec            address.Codec
 	RandomGenesisAccountsFn types.RandomGenesisAccountsFn `optional:"true"`
 	AccountI                func() sdk.AccountI           `optional:"true"`
+	TransientStoreService   store.TransientStoreService   `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -45,6 +52,25 @@ type ModuleOutputs struct {
 	Module        appmodule.AppModule
 }
 
+// InvokeSetAccountPruneCheckers sets the account prune checkers provided by
+// the other modules on the keeper.
+func InvokeSetAccountPruneCheckers(keeper keeper.AccountKeeper, checkers map[string]types.AccountPruneCheckerWrapper) {
+	if len(checkers) == 0 {
+		return
+	}
+
+	// Default ordering is lexical by module name.
+	modNames := maps.Keys(checkers)
+	sort.Strings(modNames)
+
+	pruneCheckers := make([]types.AccountPruneChecker, 0, len(modNames))
+	for _, modName := range modNames {
+		pruneCheckers = append(pruneCheckers, checkers[modName])
+	}
+
+	keeper.SetAccountPruneCheckers(pruneCheckers...)
+}
+
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	maccPerms := map[string][]string{}
 	for _, permission := range in.Config.ModuleAccountPermissions {
@@ -70,7 +96,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		panic(err)
 	}
 
-	k := keeper.NewAccountKeeper(in.Environment, in.Cdc, in.AccountI, in.AccountsModKeeper, maccPerms, in.AddressCodec, in.Config.Bech32Prefix, auth)
+	k := keeper.NewAccountKeeper(in.Environment, in.TransientStoreService, in.Cdc, in.AccountI, in.AccountsModKeeper, maccPerms, in.AddressCodec, in.Config.Bech32Prefix, auth)
 	m := NewAppModule(in.Cdc, k, in.AccountsModKeeper, in.RandomGenesisAccountsFn)
 
 	return ModuleOutputs{AccountKeeper: k, Module: m}
@@ -88,6 +88,7 @@ func (suite *DeterministicTestSuite) SetupTest() {
 
 	suite.accountKeeper = keeper.NewAccountKeeper(
 		env,
+		nil,
 		suite.encCfg.Codec,
 		types.ProtoBaseAccount,
 		suite.acctsModKeeper,
@@ -309,6 +310,7 @@ func (suite *DeterministicTestSuite) TestGRPCQueryModuleAccounts() {
 
 		ak := keeper.NewAccountKeeper(
 			suite.environment,
+			nil,
 			suite.encCfg.Codec,
 			types.ProtoBaseAccount,
 			suite.acctsModKeeper,
@@ -357,6 +359,7 @@ func (suite *DeterministicTestSuite) TestGRPCQueryModuleAccountByName() {
 
 		ak := keeper.NewAccountKeeper(
 			suite.environment,
+			nil,
 			suite.encCfg.Codec,
 			types.ProtoBaseAccount,
 			suite.acctsModKeeper,
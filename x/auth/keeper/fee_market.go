@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetBaseFee returns the current base fee of the fee market, which is the
+// minimum gas price of the transactions. It returns false when the fee market
+// is disabled.
+func (ak AccountKeeper) GetBaseFee(ctx context.Context) (sdk.DecCoin, bool, error) {
+	params, err := ak.Params.Get(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, false, err
+	}
+
+	if params.FeeMarket == nil {
+		return sdk.DecCoin{}, false, nil
+	}
+
+	baseFee, err := ak.getBaseFee(ctx, *params.FeeMarket)
+	if err != nil {
+		return sdk.DecCoin{}, false, err
+	}
+
+	return baseFee, true, nil
+}
+
+// ConsumeBlockGas adds the gas wanted by a transaction to the gas wanted by
+// the current block.
+func (ak AccountKeeper) ConsumeBlockGas(ctx context.Context, gas uint64) error {
+	gasWanted, err := ak.BlockGasWanted.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	return ak.BlockGasWanted.Set(ctx, gasWanted+gas)
+}
+
+// UpdateBaseFee adjusts the base fee to the gas wanted by the current block,
+// and resets the gas wanted for the next block. As in EIP-1559, the base fee
+// increases when the gas wanted is above the target and decreases otherwise,
+// by at most 1/BaseFeeChangeDenominator of its value.
+func (ak AccountKeeper) UpdateBaseFee(ctx context.Context) error {
+	params, err := ak.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.FeeMarket == nil {
+		return nil
+	}
+	feeMarket := *params.FeeMarket
+
+	baseFee, err := ak.getBaseFee(ctx, feeMarket)
+	if err != nil {
+		return err
+	}
+
+	gasWanted, err := ak.BlockGasWanted.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	// the gas wanted above twice the target is ignored, so that the change of
+	// the base fee stays bounded.
+	target := math.NewIntFromUint64(feeMarket.TargetBlockGas)
+	gasWantedInt := math.MinInt(math.NewIntFromUint64(gasWanted), target.MulRaw(2))
+
+	// change := baseFee * (gasWanted - target) / target / denominator
+	change := baseFee.Amount.MulInt(gasWantedInt.Sub(target)).
+		QuoInt(target).
+		QuoInt(math.NewIntFromUint64(feeMarket.BaseFeeChangeDenominator))
+	baseFee.Amount = math.LegacyMaxDec(baseFee.Amount.Add(change), feeMarket.MinBaseFee)
+
+	if err := ak.BaseFee.Set(ctx, baseFee); err != nil {
+		return err
+	}
+
+	return ak.BlockGasWanted.Remove(ctx)
+}
+
+// getBaseFee returns the stored base fee, or the min base fee if no base fee is
+// stored yet for the fee market denom.
+func (ak AccountKeeper) getBaseFee(ctx context.Context, feeMarket types.FeeMarketParams) (sdk.DecCoin, error) {
+	baseFee, err := ak.BaseFee.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return sdk.DecCoin{}, err
+	}
+
+	if err != nil || baseFee.Denom != feeMarket.Denom || baseFee.Amount.LT(feeMarket.MinBaseFee) {
+		return sdk.NewDecCoinFromDec(feeMarket.Denom, feeMarket.MinBaseFee), nil
+	}
+
+	return baseFee, nil
+}
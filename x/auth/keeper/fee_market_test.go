@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateBaseFee() {
+	ctx := suite.ctx
+	ak := suite.accountKeeper
+
+	baseFeeAmount := func() math.LegacyDec {
+		baseFee, enabled, err := ak.GetBaseFee(ctx)
+		suite.Require().NoError(err)
+		suite.Require().True(enabled)
+		suite.Require().Equal("stake", baseFee.Denom)
+		return baseFee.Amount
+	}
+
+	// the fee market is disabled by default
+	params := types.DefaultParams()
+	suite.Require().NoError(ak.Params.Set(ctx, params))
+	suite.Require().NoError(ak.UpdateBaseFee(ctx))
+	_, enabled, err := ak.GetBaseFee(ctx)
+	suite.Require().NoError(err)
+	suite.Require().False(enabled)
+
+	params.FeeMarket = &types.FeeMarketParams{
+		Denom:                    "stake",
+		MinBaseFee:               math.LegacyNewDec(10),
+		TargetBlockGas:           1000,
+		BaseFeeChangeDenominator: 8,
+	}
+	suite.Require().NoError(ak.Params.Set(ctx, params))
+
+	// the base fee starts at the min base fee
+	suite.Require().Equal(math.LegacyNewDec(10), baseFeeAmount())
+
+	// a full block increases the base fee by 1/8
+	suite.Require().NoError(ak.ConsumeBlockGas(ctx, 1500))
+	suite.Require().NoError(ak.ConsumeBlockGas(ctx, 500))
+	suite.Require().NoError(ak.UpdateBaseFee(ctx))
+	suite.Require().Equal(math.LegacyMustNewDecFromStr("11.25"), baseFeeAmount())
+
+	// the gas wanted above twice the target is ignored
+	suite.Require().NoError(ak.ConsumeBlockGas(ctx, 10000))
+	suite.Require().NoError(ak.UpdateBaseFee(ctx))
+	suite.Require().Equal(math.LegacyMustNewDecFromStr("12.65625"), baseFeeAmount())
+
+	// a block at the target leaves the base fee unchanged
+	suite.Require().NoError(ak.ConsumeBlockGas(ctx, 1000))
+	suite.Require().NoError(ak.UpdateBaseFee(ctx))
+	suite.Require().Equal(math.LegacyMustNewDecFromStr("12.65625"), baseFeeAmount())
+
+	// an empty block decreases the base fee by 1/8
+	suite.Require().NoError(ak.UpdateBaseFee(ctx))
+	suite.Require().Equal(math.LegacyMustNewDecFromStr("11.07421875"), baseFeeAmount())
+
+	// the base fee never decreases below the min base fee
+	for i := 0; i < 5; i++ {
+		suite.Require().NoError(ak.UpdateBaseFee(ctx))
+	}
+	suite.Require().Equal(math.LegacyNewDec(10), baseFeeAmount())
+}
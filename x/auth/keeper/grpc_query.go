@@ -9,9 +9,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/auth/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
@@ -65,6 +67,80 @@ func (s queryServer) Accounts(ctx context.Context, req *types.QueryAccountsReque
 	return &types.QueryAccountsResponse{Accounts: accounts, Pagination: pageRes}, err
 }
 
+const (
+	// defaultAccountsStreamBatchSize is the default number of accounts sent in
+	// each AccountsStream response.
+	defaultAccountsStreamBatchSize = 100
+	// maxAccountsStreamBatchSize is the maximum number of accounts sent in each
+	// AccountsStream response.
+	maxAccountsStreamBatchSize = 1000
+)
+
+// AccountsStream streams all the accounts in batches. The stream is throttled
+// by the flow control of the client, as sending a batch blocks until the client
+// is ready to receive it.
+func (s queryServer) AccountsStream(req *types.QueryAccountsStreamRequest, stream types.Query_AccountsStreamServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	batchSize := int(req.BatchSize)
+	switch {
+	case batchSize == 0:
+		batchSize = defaultAccountsStreamBatchSize
+	case batchSize > maxAccountsStreamBatchSize:
+		batchSize = maxAccountsStreamBatchSize
+	}
+
+	ctx := stream.Context()
+	ranger := new(collections.Range[sdk.AccAddress])
+	if len(req.Key) != 0 {
+		ranger = ranger.StartInclusive(req.Key)
+	}
+
+	iter, err := s.k.Accounts.Iterate(ctx, ranger)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	batch := make([]*codectypes.Any, 0, batchSize)
+	for ; iter.Valid(); iter.Next() {
+		if len(batch) == batchSize {
+			key, err := iter.Key()
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			if err := stream.Send(&types.QueryAccountsStreamResponse{Accounts: batch, NextKey: key}); err != nil {
+				return err
+			}
+			batch = make([]*codectypes.Any, 0, batchSize)
+		}
+
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		account, err := iter.Value()
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		any, err := codectypes.NewAnyWithValue(account)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		batch = append(batch, any)
+	}
+
+	if len(batch) == 0 {
+		return nil
+	}
+
+	return stream.Send(&types.QueryAccountsStreamResponse{Accounts: batch})
+}
+
 // Account returns account details based on address
 func (s queryServer) Account(ctx context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
 	if req == nil {
@@ -208,6 +284,51 @@ func (s queryServer) AddressStringToBytes(ctx context.Context, req *types.Addres
 	return &types.AddressStringToBytesResponse{AddressBytes: bz}, nil
 }
 
+// AccountByPubKey returns the accounts with the given public key.
+func (s queryServer) AccountByPubKey(ctx context.Context, req *types.QueryAccountByPubKeyRequest) (*types.QueryAccountByPubKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PubKey == nil {
+		return nil, status.Error(codes.InvalidArgument, "public key cannot be empty")
+	}
+
+	var pubKey cryptotypes.PubKey
+	if err := s.k.cdc.UnpackAny(req.PubKey, &pubKey); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addresses, err := s.k.Accounts.Indexes.PubKey.MatchExact(ctx, pubKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	accounts := make([]*codectypes.Any, 0, len(addresses))
+	for _, addr := range addresses {
+		account := s.k.GetAccount(ctx, addr)
+		if account == nil {
+			continue
+		}
+		// the public key bytes of different key types may collide
+		if accPubKey := account.GetPubKey(); accPubKey == nil || !pubKey.Equals(accPubKey) {
+			continue
+		}
+
+		any, err := codectypes.NewAnyWithValue(account)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		accounts = append(accounts, any)
+	}
+
+	if len(accounts) == 0 {
+		return nil, status.Error(codes.NotFound, "no account found with the given public key")
+	}
+
+	return &types.QueryAccountByPubKeyResponse{Accounts: accounts}, nil
+}
+
 // AccountInfo implements the AccountInfo query.
 func (s queryServer) AccountInfo(ctx context.Context, req *types.QueryAccountInfoRequest) (*types.QueryAccountInfoResponse, error) {
 	if req == nil {
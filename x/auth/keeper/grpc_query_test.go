@@ -8,9 +8,14 @@ import (
 	"sort"
 
 	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/x/auth/keeper"
 	"cosmossdk.io/x/auth/types"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -536,3 +541,96 @@ func (suite *KeeperTestSuite) TestQueryAccountInfoWithoutPubKey() {
 	suite.Require().Equal(addr.String(), res.Info.Address)
 	suite.Require().Nil(res.Info.PubKey)
 }
+
+func (suite *KeeperTestSuite) TestQueryAccountByPubKey() {
+	_, pk, addr := testdata.KeyTestPubAddr()
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	pkAny, err := codectypes.NewAnyWithValue(pk)
+	suite.Require().NoError(err)
+
+	// the account is indexed once its public key is set
+	_, err = suite.queryClient.AccountByPubKey(context.Background(), &types.QueryAccountByPubKeyRequest{PubKey: pkAny})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	suite.Require().NoError(acc.SetPubKey(pk))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	res, err := suite.queryClient.AccountByPubKey(context.Background(), &types.QueryAccountByPubKeyRequest{PubKey: pkAny})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 1)
+	var account sdk.AccountI
+	suite.Require().NoError(suite.encCfg.InterfaceRegistry.UnpackAny(res.Accounts[0], &account))
+	suite.Require().Equal(addr, account.GetAddress())
+
+	// the index is cleaned up when the account is removed
+	suite.accountKeeper.RemoveAccount(suite.ctx, acc)
+	_, err = suite.queryClient.AccountByPubKey(context.Background(), &types.QueryAccountByPubKeyRequest{PubKey: pkAny})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	_, err = suite.queryClient.AccountByPubKey(context.Background(), &types.QueryAccountByPubKeyRequest{})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+type accountsStream struct {
+	grpc.ServerStream
+
+	ctx       context.Context
+	responses []*types.QueryAccountsStreamResponse
+}
+
+func (s *accountsStream) Context() context.Context { return s.ctx }
+
+func (s *accountsStream) Send(res *types.QueryAccountsStreamResponse) error {
+	s.responses = append(s.responses, res)
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryAccountsStream() {
+	queryServer := keeper.NewQueryServer(suite.accountKeeper)
+
+	var addrs []sdk.AccAddress
+	for i := 0; i < 5; i++ {
+		_, _, addr := testdata.KeyTestPubAddr()
+		suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr))
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i], addrs[j]) < 0 })
+
+	streamAddrs := func(responses []*types.QueryAccountsStreamResponse) []sdk.AccAddress {
+		var res []sdk.AccAddress
+		for _, r := range responses {
+			for _, any := range r.Accounts {
+				var account sdk.AccountI
+				suite.Require().NoError(suite.encCfg.InterfaceRegistry.UnpackAny(any, &account))
+				res = append(res, account.GetAddress())
+			}
+		}
+		return res
+	}
+
+	stream := &accountsStream{ctx: suite.ctx}
+	suite.Require().NoError(queryServer.AccountsStream(&types.QueryAccountsStreamRequest{BatchSize: 2}, stream))
+	suite.Require().Len(stream.responses, 3)
+	suite.Require().Equal(addrs[2].Bytes(), stream.responses[0].NextKey)
+	suite.Require().Equal(addrs[4].Bytes(), stream.responses[1].NextKey)
+	suite.Require().Empty(stream.responses[2].NextKey)
+	suite.Require().Equal(addrs, streamAddrs(stream.responses))
+
+	// resume from a next key with the default batch size
+	stream = &accountsStream{ctx: suite.ctx}
+	suite.Require().NoError(queryServer.AccountsStream(&types.QueryAccountsStreamRequest{Key: addrs[2]}, stream))
+	suite.Require().Len(stream.responses, 1)
+	suite.Require().Equal(addrs[2:], streamAddrs(stream.responses))
+
+	// cancelled streams stop
+	ctx, cancel := context.WithCancel(suite.ctx)
+	cancel()
+	stream = &accountsStream{ctx: suite.ctx.WithContext(ctx)}
+	err := queryServer.AccountsStream(&types.QueryAccountsStreamRequest{}, stream)
+	suite.Require().Equal(codes.Canceled, status.Code(err))
+	suite.Require().Empty(stream.responses)
+
+	suite.Require().Error(queryServer.AccountsStream(nil, stream))
+}
@@ -6,9 +6,11 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/auth/types"
 
@@ -65,17 +67,21 @@ func NewAccountIndexes(sb *collections.SchemaBuilder) AccountsIndexes {
 				return v.GetAccountNumber(), nil
 			},
 		),
+		PubKey: NewPubKeyIndex(sb, types.AccountPubKeyStoreKeyPrefix, "account_by_pubkey"),
 	}
 }
 
 type AccountsIndexes struct {
 	// Number is a unique index that indexes accounts by their account number.
 	Number *indexes.Unique[uint64, sdk.AccAddress, sdk.AccountI]
+	// PubKey indexes the accounts which have a public key by their public key.
+	PubKey *PubKeyIndex
 }
 
 func (a AccountsIndexes) IndexesList() []collections.Index[sdk.AccAddress, sdk.AccountI] {
 	return []collections.Index[sdk.AccAddress, sdk.AccountI]{
 		a.Number,
+		a.PubKey,
 	}
 }
 
@@ -108,6 +114,26 @@ type AccountKeeper struct {
 	accountNumber collections.Sequence
 	// Accounts key: AccAddr | value: AccountI | index: AccountsIndex
 	Accounts *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
+	// AccountPruningCursor is the address to resume the account pruning from.
+	AccountPruningCursor collections.Item[sdk.AccAddress]
+	// BaseFee is the current base fee of the fee market.
+	BaseFee collections.Item[sdk.DecCoin]
+	// BlockGasWanted is the gas wanted by the transactions of the current block.
+	BlockGasWanted collections.Item[uint64]
+
+	// Transient state, reset at the end of every block, which is only available
+	// with a transient store service.
+	//
+	// BlockTxCount is the number of transactions in the current block.
+	BlockTxCount collections.Item[uint64]
+	// AccountTxCounts key: AccAddr | value: number of transactions signed by the
+	// account in the current block.
+	AccountTxCounts collections.Map[sdk.AccAddress, uint64]
+	txCountsEnabled bool
+
+	// pruneCheckers is shared by all the copies of the keeper, so that the
+	// checkers set after the keeper has been handed to the app module are used.
+	pruneCheckers *[]types.AccountPruneChecker
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -118,8 +144,10 @@ var _ AccountKeeperI = &AccountKeeper{}
 // types.PermissionsForAddress and is used in keeper.ValidatePermissions. Permissions are plain strings,
 // and don't have to fit into any predefined structure. This auth module does not use account permissions internally, though other modules
 // may use auth.Keeper to access the accounts permissions map.
+// The transient store service tracks the transaction counts of the rate limit,
+// it can be nil if the transactions are not rate limited.
 func NewAccountKeeper(
-	env appmodule.Environment, cdc codec.BinaryCodec, proto func() sdk.AccountI, accountsModKeeper types.AccountsModKeeper,
+	env appmodule.Environment, tss store.TransientStoreService, cdc codec.BinaryCodec, proto func() sdk.AccountI, accountsModKeeper types.AccountsModKeeper,
 	maccPerms map[string][]string, ac address.Codec, bech32Prefix, authority string,
 ) AccountKeeper {
 	permAddrs := make(map[string]types.PermissionsForAddress)
@@ -130,23 +158,38 @@ func NewAccountKeeper(
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 
 	ak := AccountKeeper{
-		Environment:       env,
-		addressCodec:      ac,
-		bech32Prefix:      bech32Prefix,
-		proto:             proto,
-		cdc:               cdc,
-		AccountsModKeeper: accountsModKeeper,
-		permAddrs:         permAddrs,
-		authority:         authority,
-		Params:            collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		accountNumber:     collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
-		Accounts:          collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		Environment:          env,
+		addressCodec:         ac,
+		bech32Prefix:         bech32Prefix,
+		proto:                proto,
+		cdc:                  cdc,
+		AccountsModKeeper:    accountsModKeeper,
+		permAddrs:            permAddrs,
+		authority:            authority,
+		Params:               collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		accountNumber:        collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
+		Accounts:             collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		AccountPruningCursor: collections.NewItem(sb, types.AccountPruningCursorKey, "account_pruning_cursor", collcodec.KeyToValueCodec(sdk.AccAddressKey)),
+		BaseFee:              collections.NewItem(sb, types.BaseFeeKey, "base_fee", codec.CollValue[sdk.DecCoin](cdc)),
+		BlockGasWanted:       collections.NewItem(sb, types.BlockGasWantedKey, "block_gas_wanted", collections.Uint64Value),
+		pruneCheckers:        new([]types.AccountPruneChecker),
 	}
 	schema, err := sb.Build()
 	if err != nil {
 		panic(err)
 	}
 	ak.Schema = schema
+
+	if tss != nil {
+		tsb := collections.NewSchemaBuilderFromAccessor(tss.OpenTransientStore)
+		ak.BlockTxCount = collections.NewItem(tsb, types.BlockTxCountKey, "block_tx_count", collections.Uint64Value)
+		ak.AccountTxCounts = collections.NewMap(tsb, types.AccountTxCountsKeyPrefix, "account_tx_counts", sdk.AccAddressKey, collections.Uint64Value)
+		if _, err := tsb.Build(); err != nil {
+			panic(err)
+		}
+		ak.txCountsEnabled = true
+	}
+
 	return ak
 }
 
@@ -56,7 +56,8 @@ func (suite *KeeperTestSuite) SetupTest() {
 	key := storetypes.NewKVStoreKey(types.StoreKey)
 	storeService := runtime.NewKVStoreService(key)
 	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger())
-	testCtx := testutil.DefaultContextWithDB(suite.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	tkey := storetypes.NewTransientStoreKey("transient_test")
+	testCtx := testutil.DefaultContextWithDB(suite.T(), key, tkey)
 	suite.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{})
 
 	// gomock initializations
@@ -81,6 +82,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 
 	suite.accountKeeper = keeper.NewAccountKeeper(
 		env,
+		runtime.NewTransientStoreService(tkey),
 		suite.encCfg.Codec,
 		types.ProtoBaseAccount,
 		acctsModKeeper,
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	v5 "cosmossdk.io/x/auth/migrations/v5"
 	"cosmossdk.io/x/auth/types"
 
@@ -45,6 +46,32 @@ func (m Migrator) Migrate4To5(ctx context.Context) error {
 	return v5.Migrate(ctx, m.keeper.KVStoreService, m.keeper.accountNumber)
 }
 
+// Migrate5To6 migrates the x/auth module state from the consensus version 5 to 6.
+// It indexes the existing accounts by public key.
+func (m Migrator) Migrate5To6(ctx context.Context) error {
+	var accounts []sdk.AccountI
+	err := m.keeper.Accounts.Walk(ctx, nil, func(_ sdk.AccAddress, acc sdk.AccountI) (stop bool, err error) {
+		if acc.GetPubKey() != nil {
+			accounts = append(accounts, acc)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, acc := range accounts {
+		err := m.keeper.Accounts.Indexes.PubKey.Reference(ctx, acc.GetAddress(), acc, func() (sdk.AccountI, error) {
+			return nil, collections.ErrNotFound
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // V45SetAccount implements V45_SetAccount
 // set the account without map to accAddr to accNumber.
 //
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAccountPruneCheckers sets the checkers consulted before pruning an
+// account. Accounts are never pruned when no checker is set, as x/auth alone
+// cannot tell whether other modules still reference an account.
+func (ak AccountKeeper) SetAccountPruneCheckers(checkers ...types.AccountPruneChecker) {
+	if len(*ak.pruneCheckers) != 0 {
+		panic("cannot set account prune checkers twice")
+	}
+
+	*ak.pruneCheckers = checkers
+}
+
+// PruneAccounts inspects the next AccountPruningBatchSize accounts, starting
+// from the account pruning cursor, and removes the prunable ones. The cursor
+// wraps around once all the accounts have been inspected.
+//
+// An account is prunable if it is a BaseAccount which has never sent a
+// transaction and holds no state in the other modules, according to the
+// account prune checkers.
+func (ak AccountKeeper) PruneAccounts(ctx context.Context) error {
+	params, err := ak.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.AccountPruningBatchSize == 0 || len(*ak.pruneCheckers) == 0 {
+		return nil
+	}
+
+	cursor, err := ak.AccountPruningCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	ranger := new(collections.Range[sdk.AccAddress])
+	if cursor != nil {
+		ranger = ranger.StartInclusive(cursor)
+	}
+
+	iter, err := ak.Accounts.Iterate(ctx, ranger)
+	if err != nil {
+		return err
+	}
+
+	var (
+		prunable []sdk.AccountI
+		next     sdk.AccAddress
+	)
+	for inspected := uint64(0); iter.Valid(); iter.Next() {
+		if inspected == params.AccountPruningBatchSize {
+			next, err = iter.Key()
+			if err != nil {
+				iter.Close()
+				return err
+			}
+			break
+		}
+		inspected++
+
+		acc, err := iter.Value()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+
+		ok, err := ak.isPrunable(ctx, acc)
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		if ok {
+			prunable = append(prunable, acc)
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for _, acc := range prunable {
+		if err := ak.Accounts.Remove(ctx, acc.GetAddress()); err != nil {
+			return err
+		}
+
+		addr, err := ak.addressCodec.BytesToString(acc.GetAddress())
+		if err != nil {
+			return err
+		}
+
+		if err := ak.EventService.EventManager(ctx).EmitKV(
+			types.EventTypePruneAccount,
+			event.NewAttribute(types.AttributeKeyAddress, addr),
+		); err != nil {
+			return err
+		}
+	}
+
+	if next == nil {
+		return ak.AccountPruningCursor.Remove(ctx)
+	}
+
+	return ak.AccountPruningCursor.Set(ctx, next)
+}
+
+func (ak AccountKeeper) isPrunable(ctx context.Context, acc sdk.AccountI) (bool, error) {
+	// only plain accounts are pruned, module, vesting and custom accounts are kept.
+	if _, ok := acc.(*types.BaseAccount); !ok {
+		return false, nil
+	}
+
+	if acc.GetSequence() != 0 || acc.GetPubKey() != nil {
+		return false, nil
+	}
+
+	for _, checker := range *ak.pruneCheckers {
+		ok, err := checker.CanPruneAccount(ctx, acc.GetAddress())
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
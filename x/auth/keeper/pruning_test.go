@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"context"
+
+	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// pruneChecker allows pruning every account but the kept ones.
+type pruneChecker struct {
+	kept map[string]bool
+}
+
+func (c pruneChecker) CanPruneAccount(_ context.Context, addr sdk.AccAddress) (bool, error) {
+	return !c.kept[addr.String()], nil
+}
+
+func (suite *KeeperTestSuite) TestPruneAccounts() {
+	ctx := suite.ctx
+	ak := suite.accountKeeper
+	has := func(acc sdk.AccountI) bool {
+		ok, err := ak.Accounts.Has(ctx, acc.GetAddress())
+		suite.Require().NoError(err)
+		return ok
+	}
+
+	params := types.DefaultParams()
+	params.AccountPruningBatchSize = 2
+	suite.Require().NoError(ak.Params.Set(ctx, params))
+
+	newAccount := func() sdk.AccountI {
+		_, _, addr := testdata.KeyTestPubAddr()
+		acc := ak.NewAccountWithAddress(ctx, addr)
+		ak.SetAccount(ctx, acc)
+		return acc
+	}
+
+	empty := newAccount()
+	kept := newAccount()
+	withSequence := newAccount()
+	suite.Require().NoError(withSequence.SetSequence(1))
+	ak.SetAccount(ctx, withSequence)
+	moduleAcc := types.NewEmptyModuleAccount("pruning")
+	ak.SetAccount(ctx, ak.NewAccount(ctx, moduleAcc))
+
+	// accounts are never pruned without checkers
+	suite.Require().NoError(ak.PruneAccounts(ctx))
+	suite.Require().True(has(empty))
+
+	ak.SetAccountPruneCheckers(pruneChecker{kept: map[string]bool{kept.GetAddress().String(): true}})
+	suite.Require().Panics(func() { ak.SetAccountPruneCheckers(pruneChecker{}) })
+
+	// the 4 accounts are inspected over 2 blocks
+	suite.Require().NoError(ak.PruneAccounts(ctx))
+	cursor, err := ak.AccountPruningCursor.Get(ctx)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(cursor)
+
+	suite.Require().NoError(ak.PruneAccounts(ctx))
+	hasCursor, err := ak.AccountPruningCursor.Has(ctx)
+	suite.Require().NoError(err)
+	suite.Require().False(hasCursor)
+
+	suite.Require().False(has(empty))
+	suite.Require().True(has(kept))
+	suite.Require().True(has(withSequence))
+	suite.Require().True(has(moduleAcc))
+
+	var pruneEvents int
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type == types.EventTypePruneAccount {
+			pruneEvents++
+		}
+	}
+	suite.Require().Equal(1, pruneEvents)
+
+	// pruning is disabled with a zero batch size
+	params.AccountPruningBatchSize = 0
+	suite.Require().NoError(ak.Params.Set(ctx, params))
+	empty = newAccount()
+	suite.Require().NoError(ak.PruneAccounts(ctx))
+	suite.Require().True(has(empty))
+}
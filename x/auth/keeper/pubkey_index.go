@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ collections.Index[sdk.AccAddress, sdk.AccountI] = (*PubKeyIndex)(nil)
+
+// PubKeyIndex indexes the accounts by public key. Unlike indexes.Multi, the
+// accounts without a public key are not indexed.
+type PubKeyIndex struct {
+	refKeys collections.KeySet[collections.Pair[[]byte, sdk.AccAddress]]
+}
+
+// NewPubKeyIndex instantiates a new PubKeyIndex.
+func NewPubKeyIndex(sb *collections.SchemaBuilder, prefix collections.Prefix, name string) *PubKeyIndex {
+	return &PubKeyIndex{
+		refKeys: collections.NewKeySet(sb, prefix, name, collections.PairKeyCodec(collections.BytesKey, sdk.AccAddressKey)),
+	}
+}
+
+// Reference implements collections.Index.
+func (i *PubKeyIndex) Reference(ctx context.Context, pk sdk.AccAddress, newValue sdk.AccountI, lazyOldValue func() (sdk.AccountI, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	case err == nil:
+		if err := i.unreference(ctx, pk, oldValue); err != nil {
+			return err
+		}
+	case errors.Is(err, collections.ErrNotFound):
+	default:
+		return err
+	}
+
+	pubKey := newValue.GetPubKey()
+	if pubKey == nil {
+		return nil
+	}
+
+	return i.refKeys.Set(ctx, collections.Join(pubKey.Bytes(), pk))
+}
+
+// Unreference implements collections.Index.
+func (i *PubKeyIndex) Unreference(ctx context.Context, pk sdk.AccAddress, getValue func() (sdk.AccountI, error)) error {
+	value, err := getValue()
+	if err != nil {
+		return err
+	}
+
+	return i.unreference(ctx, pk, value)
+}
+
+func (i *PubKeyIndex) unreference(ctx context.Context, pk sdk.AccAddress, value sdk.AccountI) error {
+	pubKey := value.GetPubKey()
+	if pubKey == nil {
+		return nil
+	}
+
+	return i.refKeys.Remove(ctx, collections.Join(pubKey.Bytes(), pk))
+}
+
+// MatchExact returns the addresses of the accounts with the given public key.
+func (i *PubKeyIndex) MatchExact(ctx context.Context, pubKey cryptotypes.PubKey) ([]sdk.AccAddress, error) {
+	iter, err := i.refKeys.Iterate(ctx, collections.NewPrefixedPairRange[[]byte, sdk.AccAddress](pubKey.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]sdk.AccAddress, len(keys))
+	for j, key := range keys {
+		addresses[j] = key.K2()
+	}
+
+	return addresses, nil
+}
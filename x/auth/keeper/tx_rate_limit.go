@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// errTxCountsNotTracked is returned when the transaction counts are used by a
+// keeper without a transient store service.
+var errTxCountsNotTracked = errors.New("the transaction counts are not tracked: the account keeper has no transient store service")
+
+// IncrementBlockTxCount increments the number of transactions in the current
+// block, and returns the updated count.
+func (ak AccountKeeper) IncrementBlockTxCount(ctx context.Context) (uint64, error) {
+	if !ak.txCountsEnabled {
+		return 0, errTxCountsNotTracked
+	}
+
+	count, err := ak.BlockTxCount.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+
+	count++
+	return count, ak.BlockTxCount.Set(ctx, count)
+}
+
+// IncrementAccountTxCount increments the number of transactions signed by an
+// account in the current block, and returns the updated count.
+func (ak AccountKeeper) IncrementAccountTxCount(ctx context.Context, addr sdk.AccAddress) (uint64, error) {
+	if !ak.txCountsEnabled {
+		return 0, errTxCountsNotTracked
+	}
+
+	count, err := ak.AccountTxCounts.Get(ctx, addr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+
+	count++
+	return count, ak.AccountTxCounts.Set(ctx, addr, count)
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/x/auth/keeper"
+	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestTxCounts() {
+	ak := suite.accountKeeper
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	for i := uint64(1); i <= 3; i++ {
+		count, err := ak.IncrementAccountTxCount(suite.ctx, addr)
+		suite.Require().NoError(err)
+		suite.Require().Equal(i, count)
+		count, err = ak.IncrementBlockTxCount(suite.ctx)
+		suite.Require().NoError(err)
+		suite.Require().Equal(i, count)
+	}
+
+	// the counts are transient, they are not part of the persistent state
+	kvStore := ak.KVStoreService.OpenKVStore(suite.ctx)
+	has, err := kvStore.Has(types.BlockTxCountKey)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+	has, err = kvStore.Has(append(types.AccountTxCountsKeyPrefix.Bytes(), addr...))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
+func (suite *KeeperTestSuite) TestTxCountsWithoutTransientStore() {
+	ak := keeper.NewAccountKeeper(
+		suite.accountKeeper.Environment, nil, suite.encCfg.Codec, types.ProtoBaseAccount, suite.acctsModKeeper, nil,
+		suite.accountKeeper.AddressCodec(), "cosmos", types.NewModuleAddress("gov").String(),
+	)
+
+	_, err := ak.IncrementBlockTxCount(suite.ctx)
+	suite.Require().ErrorContains(err, "no transient store service")
+	_, err = ak.IncrementAccountTxCount(suite.ctx, sdk.AccAddress("addr"))
+	suite.Require().ErrorContains(err, "no transient store service")
+}
@@ -27,17 +27,19 @@ import (
 
 // ConsensusVersion defines the current x/auth module consensus version.
 const (
-	ConsensusVersion = 5
+	ConsensusVersion = 6
 	GovModuleName    = "gov"
 )
 
 var (
 	_ module.AppModuleSimulation = AppModule{}
 
-	_ appmodulev2.HasGenesis    = AppModule{}
-	_ appmodulev2.AppModule     = AppModule{}
-	_ appmodule.HasServices     = AppModule{}
-	_ appmodulev2.HasMigrations = AppModule{}
+	_ appmodulev2.HasGenesis           = AppModule{}
+	_ appmodulev2.AppModule            = AppModule{}
+	_ appmodule.HasServices            = AppModule{}
+	_ appmodule.HasOrderingConstraints = AppModule{}
+	_ appmodulev2.HasMigrations        = AppModule{}
+	_ appmodulev2.HasEndBlocker        = AppModule{}
 )
 
 // AppModule implements an application module for the auth module.
@@ -113,6 +115,9 @@ func (am AppModule) RegisterMigrations(mr appmodule.MigrationRegistrar) error {
 	if err := mr.Register(types.ModuleName, 4, m.Migrate4To5); err != nil {
 		return fmt.Errorf("failed to migrate x/%s from version 4 to 5: %w", types.ModuleName, err)
 	}
+	if err := mr.Register(types.ModuleName, 5, m.Migrate5To6); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 5 to 6: %w", types.ModuleName, err)
+	}
 
 	return nil
 }
@@ -176,6 +181,24 @@ func (am AppModule) TxValidator(ctx context.Context, tx transaction.Tx) error {
 	return nil
 }
 
+// EndBlock prunes the empty accounts and updates the base fee of the fee
+// market, if enabled in the params.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	if err := am.accountKeeper.PruneAccounts(ctx); err != nil {
+		return err
+	}
+
+	return am.accountKeeper.UpdateBaseFee(ctx)
+}
+
+// OrderingConstraints implements appmodule.HasOrderingConstraints.
+// Accounts are initialized before the auth module accounts.
+func (AppModule) OrderingConstraints() []appmodule.OrderingConstraint {
+	return []appmodule.OrderingConstraint{
+		{Hook: appmodule.HookInitGenesis, After: []string{"accounts"}},
+	}
+}
+
 // ConsensusVersion implements appmodule.HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
@@ -37,13 +37,15 @@ func APISignModeToInternal(mode signingv1beta1.SignMode) (signing.SignMode, erro
 		return signing.SignMode_SIGN_MODE_TEXTUAL, nil
 	case signingv1beta1.SignMode_SIGN_MODE_DIRECT_AUX:
 		return signing.SignMode_SIGN_MODE_DIRECT_AUX, nil
+	case signingv1beta1.SignMode_SIGN_MODE_EIP_712:
+		return signing.SignMode_SIGN_MODE_EIP_712, nil
 	default:
 		return signing.SignMode_SIGN_MODE_UNSPECIFIED, fmt.Errorf("unsupported sign mode %s", mode)
 	}
 }
 
-// internalSignModeToAPI converts a signing.SignMode to a protobuf SignMode.
-func internalSignModeToAPI(mode signing.SignMode) (signingv1beta1.SignMode, error) {
+// InternalSignModeToAPI converts a signing.SignMode to a protobuf SignMode.
+func InternalSignModeToAPI(mode signing.SignMode) (signingv1beta1.SignMode, error) {
 	switch mode {
 	case signing.SignMode_SIGN_MODE_DIRECT:
 		return signingv1beta1.SignMode_SIGN_MODE_DIRECT, nil
@@ -53,11 +55,34 @@ func internalSignModeToAPI(mode signing.SignMode) (signingv1beta1.SignMode, erro
 		return signingv1beta1.SignMode_SIGN_MODE_TEXTUAL, nil
 	case signing.SignMode_SIGN_MODE_DIRECT_AUX:
 		return signingv1beta1.SignMode_SIGN_MODE_DIRECT_AUX, nil
+	case signing.SignMode_SIGN_MODE_EIP_712:
+		return signingv1beta1.SignMode_SIGN_MODE_EIP_712, nil
 	default:
 		return signingv1beta1.SignMode_SIGN_MODE_UNSPECIFIED, fmt.Errorf("unsupported sign mode %s", mode)
 	}
 }
 
+// Keccak256Verifier is implemented by public keys able to verify signatures over
+// the Keccak-256 hash of a message, as produced by Ethereum wallets for the
+// SIGN_MODE_EIP_712 sign bytes.
+type Keccak256Verifier interface {
+	VerifyKeccak256Signature(msg, sig []byte) bool
+}
+
+// verifySingleSignature verifies a signature of the given sign mode. The
+// SIGN_MODE_EIP_712 sign bytes are signed over their Keccak-256 hash, which
+// public keys not implementing Keccak256Verifier, such as Ethereum keys, are
+// expected to hash them with.
+func verifySingleSignature(pubKey cryptotypes.PubKey, mode signing.SignMode, signBytes, sig []byte) bool {
+	if mode == signing.SignMode_SIGN_MODE_EIP_712 {
+		if verifier, ok := pubKey.(Keccak256Verifier); ok {
+			return verifier.VerifyKeccak256Signature(signBytes, sig)
+		}
+	}
+
+	return pubKey.VerifySignature(signBytes, sig)
+}
+
 // VerifySignature verifies a transaction signature contained in SignatureData abstracting over different signing
 // modes. It differs from VerifySignature in that it uses the new txsigning.TxData interface in x/tx.
 func VerifySignature(
@@ -70,7 +95,7 @@ func VerifySignature(
 ) error {
 	switch data := signatureData.(type) {
 	case *signing.SingleSignatureData:
-		signMode, err := internalSignModeToAPI(data.SignMode)
+		signMode, err := InternalSignModeToAPI(data.SignMode)
 		if err != nil {
 			return err
 		}
@@ -78,7 +103,7 @@ func VerifySignature(
 		if err != nil {
 			return err
 		}
-		if !pubKey.VerifySignature(signBytes, data.Signature) {
+		if !verifySingleSignature(pubKey, data.SignMode, signBytes, data.Signature) {
 			return fmt.Errorf("unable to verify single signer signature '%s' for signBytes '%s'", hex.EncodeToString(data.Signature), hex.EncodeToString(signBytes))
 		}
 		return nil
@@ -89,7 +114,7 @@ func VerifySignature(
 			return fmt.Errorf("expected %T, got %T", (multisig.PubKey)(nil), pubKey)
 		}
 		err := multiPK.VerifyMultisignature(func(mode signing.SignMode) ([]byte, error) {
-			signMode, err := internalSignModeToAPI(mode)
+			signMode, err := InternalSignModeToAPI(mode)
 			if err != nil {
 				return nil, err
 			}
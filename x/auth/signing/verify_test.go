@@ -0,0 +1,89 @@
+package signing_test
+
+import (
+	"context"
+	"testing"
+
+	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	authsign "cosmossdk.io/x/auth/signing"
+	"cosmossdk.io/x/auth/tx"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestVerifySignatureEIP712(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	signingCtx := encodingConfig.InterfaceRegistry.SigningContext()
+	txConfig, err := tx.NewTxConfigWithOptions(encodingConfig.Codec, tx.ConfigOptions{
+		EnabledSignModes: []signing.SignMode{signing.SignMode_SIGN_MODE_EIP_712},
+		SigningOptions: &txsigning.Options{
+			AddressCodec:          signingCtx.AddressCodec(),
+			ValidatorAddressCodec: signingCtx.ValidatorAddressCodec(),
+		},
+	})
+	require.NoError(t, err)
+
+	privKey := secp256k1.GenPrivKey()
+	pubKey := privKey.PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(testdata.NewTestMsg(addr)))
+	builder.SetFeeAmount(testdata.NewTestFeeAmount())
+	builder.SetGasLimit(testdata.NewTestGasLimit())
+	builder.SetMemo("memo")
+
+	sigData := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_EIP_712}
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{PubKey: pubKey, Data: sigData, Sequence: 3}))
+
+	signerData := authsign.SignerData{
+		Address:       addr.String(),
+		ChainID:       "cosmos_9000-1",
+		AccountNumber: 7,
+		Sequence:      3,
+		PubKey:        pubKey,
+	}
+	signBytes, err := authsign.GetSignBytesAdapter(context.Background(), txConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_EIP_712, signerData, builder.GetTx())
+	require.NoError(t, err)
+
+	// Ethereum wallets sign the Keccak-256 hash of the sign bytes
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(signBytes)
+	compact := ecdsa.SignCompact(secp.PrivKeyFromBytes(privKey.Key), hasher.Sum(nil), false)
+	sigData.Signature = append(compact[1:], compact[0]-27)
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{PubKey: pubKey, Data: sigData, Sequence: 3}))
+
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+	txSignerData := txsigning.SignerData{
+		Address:       signerData.Address,
+		ChainID:       signerData.ChainID,
+		AccountNumber: signerData.AccountNumber,
+		Sequence:      signerData.Sequence,
+		PubKey:        &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value},
+	}
+	txData := builder.GetTx().(authsign.V2AdaptableTx).GetSigningTxData()
+	require.NoError(t, authsign.VerifySignature(context.Background(), pubKey, txSignerData, sigData, txConfig.SignModeHandler(), txData))
+
+	// the signature is bound to the signer data
+	txSignerData.AccountNumber++
+	require.Error(t, authsign.VerifySignature(context.Background(), pubKey, txSignerData, sigData, txConfig.SignModeHandler(), txData))
+	txSignerData.AccountNumber--
+
+	// a SHA-256 signature of the sign bytes is not valid
+	sigData.Signature, err = privKey.Sign(signBytes)
+	require.NoError(t, err)
+	require.Error(t, authsign.VerifySignature(context.Background(), pubKey, txSignerData, sigData, txConfig.SignModeHandler(), txData))
+}
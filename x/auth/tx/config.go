@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/x/tx/signing/aminojson"
 	"cosmossdk.io/x/tx/signing/direct"
 	"cosmossdk.io/x/tx/signing/directaux"
+	"cosmossdk.io/x/tx/signing/eip712"
 	"cosmossdk.io/x/tx/signing/textual"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -47,6 +48,10 @@ type ConfigOptions struct {
 	// TextualCoinMetadataQueryFn is the function that will be used to query coin metadata when constructing
 	// textual sign mode handler. This is required if SIGN_MODE_TEXTUAL is enabled.
 	TextualCoinMetadataQueryFn textual.CoinMetadataQueryFn
+	// EIP712ChainIDFn is the function that will be used to derive the EIP-155 chain ID of the EIP-712 domain
+	// from the chain ID when constructing the SIGN_MODE_EIP_712 handler. If nil, chain IDs of the form
+	// {identifier}_{EIP155}-{version} are supported.
+	EIP712ChainIDFn eip712.ChainIDFn
 	// CustomSignModes are the custom sign modes that will be added to the txsigning.HandlerMap.
 	CustomSignModes []txsigning.SignModeHandler
 	// ProtoDecoder is the decoder that will be used to decode protobuf transactions.
@@ -65,6 +70,7 @@ var DefaultSignModes = []signingtypes.SignMode{
 	signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
 	signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
 	// signingtypes.SignMode_SIGN_MODE_TEXTUAL is not enabled by default, as it requires a x/bank keeper or gRPC connection.
+	// signingtypes.SignMode_SIGN_MODE_EIP_712 is not enabled by default, as it requires an EIP-155 chain ID.
 }
 
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and sign modes. The
@@ -154,6 +160,12 @@ func NewSigningHandlerMap(configOpts ConfigOptions) (*txsigning.HandlerMap, erro
 			if err != nil {
 				return nil, err
 			}
+		case signingtypes.SignMode_SIGN_MODE_EIP_712:
+			handlers[i] = eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{
+				FileResolver: signingOpts.FileResolver,
+				TypeResolver: signingOpts.TypeResolver,
+				ChainIDFn:    configOpts.EIP712ChainIDFn,
+			})
 		}
 	}
 	for i, m := range configOpts.CustomSignModes {
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -172,6 +173,15 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// account_pruning_batch_size is the number of accounts inspected for pruning
+	// at the end of each block. Pruning is disabled when it is 0.
+	AccountPruningBatchSize uint64 `protobuf:"varint,6,opt,name=account_pruning_batch_size,json=accountPruningBatchSize,proto3" json:"account_pruning_batch_size,omitempty"`
+	// fee_market defines the parameters of the dynamic fee market, the fee market
+	// is disabled when it is not set.
+	FeeMarket *FeeMarketParams `protobuf:"bytes,7,opt,name=fee_market,json=feeMarket,proto3" json:"fee_market,omitempty"`
+	// tx_rate_limit defines the limits on the number of transactions per block
+	// and per account, the transactions are not rate limited when it is not set.
+	TxRateLimit *TxRateLimitParams `protobuf:"bytes,8,opt,name=tx_rate_limit,json=txRateLimit,proto3" json:"tx_rate_limit,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -242,64 +252,234 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetAccountPruningBatchSize() uint64 {
+	if m != nil {
+		return m.AccountPruningBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetFeeMarket() *FeeMarketParams {
+	if m != nil {
+		return m.FeeMarket
+	}
+	return nil
+}
+
+func (m *Params) GetTxRateLimit() *TxRateLimitParams {
+	if m != nil {
+		return m.TxRateLimit
+	}
+	return nil
+}
+
+// FeeMarketParams defines the parameters of an EIP-1559 style fee market, where
+// the minimum gas price of the transactions, the base fee, is adjusted at the
+// end of each block depending on the gas wanted by the block transactions.
+type FeeMarketParams struct {
+	// denom is the denomination the fees must be paid in.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// min_base_fee is the minimum gas price the base fee can decrease to.
+	MinBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_fee"`
+	// target_block_gas is the gas wanted per block the base fee targets. The base
+	// fee increases when a block wants more gas than the target, and decreases
+	// otherwise.
+	TargetBlockGas uint64 `protobuf:"varint,3,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// base_fee_change_denominator bounds the change of the base fee between two
+	// blocks, to 1/base_fee_change_denominator of the base fee.
+	BaseFeeChangeDenominator uint64 `protobuf:"varint,4,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+}
+
+func (m *FeeMarketParams) Reset()         { *m = FeeMarketParams{} }
+func (m *FeeMarketParams) String() string { return proto.CompactTextString(m) }
+func (*FeeMarketParams) ProtoMessage()    {}
+func (*FeeMarketParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
+}
+func (m *FeeMarketParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMarketParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarketParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeMarketParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarketParams.Merge(m, src)
+}
+func (m *FeeMarketParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarketParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarketParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMarketParams proto.InternalMessageInfo
+
+func (m *FeeMarketParams) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeMarketParams) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *FeeMarketParams) GetBaseFeeChangeDenominator() uint64 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+// TxRateLimitParams defines the limits on the number of transactions included
+// in a block and signed by an account, protecting the chain from spam when fees
+// alone are not a sufficient deterrent.
+type TxRateLimitParams struct {
+	// max_block_txs is the maximum number of transactions in a block, 0 means no
+	// limit.
+	MaxBlockTxs uint64 `protobuf:"varint,1,opt,name=max_block_txs,json=maxBlockTxs,proto3" json:"max_block_txs,omitempty"`
+	// max_account_txs is the maximum number of transactions an account can sign
+	// in a block, 0 means no limit.
+	MaxAccountTxs uint64 `protobuf:"varint,2,opt,name=max_account_txs,json=maxAccountTxs,proto3" json:"max_account_txs,omitempty"`
+}
+
+func (m *TxRateLimitParams) Reset()         { *m = TxRateLimitParams{} }
+func (m *TxRateLimitParams) String() string { return proto.CompactTextString(m) }
+func (*TxRateLimitParams) ProtoMessage()    {}
+func (*TxRateLimitParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{5}
+}
+func (m *TxRateLimitParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxRateLimitParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxRateLimitParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxRateLimitParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxRateLimitParams.Merge(m, src)
+}
+func (m *TxRateLimitParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxRateLimitParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxRateLimitParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxRateLimitParams proto.InternalMessageInfo
+
+func (m *TxRateLimitParams) GetMaxBlockTxs() uint64 {
+	if m != nil {
+		return m.MaxBlockTxs
+	}
+	return 0
+}
+
+func (m *TxRateLimitParams) GetMaxAccountTxs() uint64 {
+	if m != nil {
+		return m.MaxAccountTxs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*ModuleCredential)(nil), "cosmos.auth.v1beta1.ModuleCredential")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*FeeMarketParams)(nil), "cosmos.auth.v1beta1.FeeMarketParams")
+	proto.RegisterType((*TxRateLimitParams)(nil), "cosmos.auth.v1beta1.TxRateLimitParams")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xc1, 0x4e, 0xf3, 0x46,
-	0x10, 0x8e, 0x93, 0x94, 0xbf, 0x6c, 0xf8, 0xa1, 0x98, 0x94, 0x9a, 0xa8, 0x8a, 0x4d, 0xa4, 0x8a,
-	0x08, 0x35, 0x0e, 0x09, 0xa5, 0x15, 0xb9, 0x91, 0xb4, 0xaa, 0x10, 0x85, 0x22, 0x47, 0xe5, 0xc0,
-	0xc5, 0x5a, 0xdb, 0x8b, 0x59, 0x25, 0xf6, 0xba, 0xde, 0x35, 0x8a, 0x79, 0x02, 0xd4, 0x53, 0xd5,
-	0x4b, 0xaf, 0xb4, 0x4f, 0xc0, 0x81, 0x87, 0xa8, 0x7a, 0x42, 0x5c, 0xda, 0x53, 0x54, 0x85, 0x03,
-	0xa8, 0xea, 0x43, 0x54, 0xde, 0x75, 0x48, 0x82, 0x72, 0x89, 0x3c, 0xdf, 0xf7, 0xcd, 0xcc, 0x37,
-	0x93, 0xb1, 0x41, 0xd9, 0x26, 0xd4, 0x23, 0xb4, 0x0e, 0x23, 0x76, 0x59, 0xbf, 0x6a, 0x58, 0x88,
-	0xc1, 0x06, 0x0f, 0xf4, 0x20, 0x24, 0x8c, 0xc8, 0x6b, 0x82, 0xd7, 0x39, 0x94, 0xf2, 0xa5, 0x55,
-	0xe8, 0x61, 0x9f, 0xd4, 0xf9, 0xaf, 0xd0, 0x95, 0x36, 0x84, 0xce, 0xe4, 0x51, 0x3d, 0x4d, 0x12,
-	0x54, 0xd1, 0x25, 0x2e, 0x11, 0x78, 0xf2, 0x34, 0x4e, 0x70, 0x09, 0x71, 0xfb, 0xa8, 0xce, 0x23,
-	0x2b, 0xba, 0xa8, 0x43, 0x3f, 0x16, 0x54, 0xe5, 0xb7, 0x2c, 0x28, 0xb4, 0x21, 0x45, 0x07, 0xb6,
-	0x4d, 0x22, 0x9f, 0xc9, 0x4d, 0xf0, 0x0e, 0x3a, 0x4e, 0x88, 0x28, 0x55, 0x24, 0x4d, 0xaa, 0x2e,
-	0xb6, 0x95, 0xc7, 0xfb, 0x5a, 0x31, 0xed, 0x71, 0x20, 0x98, 0x2e, 0x0b, 0xb1, 0xef, 0x1a, 0x63,
-	0xa1, 0x7c, 0x06, 0xde, 0x05, 0x91, 0x65, 0xf6, 0x50, 0xac, 0x64, 0x35, 0xa9, 0x5a, 0x68, 0x16,
-	0x75, 0xd1, 0x50, 0x1f, 0x37, 0xd4, 0x0f, 0xfc, 0xb8, 0xbd, 0xf5, 0xef, 0x50, 0x2d, 0x06, 0x91,
-	0xd5, 0xc7, 0x76, 0xa2, 0xfd, 0x9c, 0x78, 0x98, 0x21, 0x2f, 0x60, 0xf1, 0xef, 0xcf, 0x77, 0xdb,
-	0x60, 0x42, 0x18, 0x0b, 0x41, 0x64, 0x1d, 0xa1, 0x58, 0xfe, 0x0c, 0x2c, 0x43, 0x61, 0xcb, 0xf4,
-	0x23, 0xcf, 0x42, 0xa1, 0x92, 0xd3, 0xa4, 0x6a, 0xde, 0x78, 0x9f, 0xa2, 0x27, 0x1c, 0x94, 0x4b,
-	0xe0, 0x43, 0x8a, 0x7e, 0x8c, 0x90, 0x6f, 0x23, 0x25, 0xcf, 0x05, 0xaf, 0x71, 0xab, 0x73, 0x73,
-	0xab, 0x66, 0x5e, 0x6e, 0xd5, 0xcc, 0x9f, 0xf7, 0xb5, 0x4f, 0xe7, 0xac, 0x57, 0x4f, 0xe7, 0x3e,
-	0xfc, 0xe9, 0xf9, 0x6e, 0x7b, 0x5d, 0x08, 0x6a, 0xd4, 0xe9, 0xd5, 0xa7, 0x76, 0x52, 0xf9, 0x4f,
-	0x02, 0xef, 0x8f, 0x89, 0x13, 0xf5, 0x5f, 0xb7, 0x74, 0x08, 0x96, 0x2c, 0x48, 0x91, 0x99, 0x1a,
-	0xe1, 0xab, 0x2a, 0x34, 0x35, 0x7d, 0x5e, 0x87, 0xa9, 0x4a, 0xed, 0xfc, 0xc3, 0x50, 0x95, 0x8c,
-	0x82, 0x35, 0xb5, 0x70, 0x19, 0xe4, 0x7d, 0xe8, 0x21, 0xbe, 0xb9, 0x45, 0x83, 0x3f, 0xcb, 0x1a,
-	0x28, 0x04, 0x28, 0xf4, 0x30, 0xa5, 0x98, 0xf8, 0x54, 0xc9, 0x69, 0xb9, 0xea, 0xa2, 0x31, 0x0d,
-	0xb5, 0xce, 0x6f, 0xc4, 0x4c, 0x95, 0x79, 0x1d, 0x67, 0xbc, 0xf2, 0xc9, 0x94, 0xa9, 0xc9, 0x66,
-	0xd8, 0x5f, 0x9e, 0xef, 0xb6, 0x97, 0x3d, 0x8e, 0x8c, 0x87, 0xa9, 0xfc, 0x2a, 0x81, 0x8f, 0x84,
-	0xa8, 0x13, 0x22, 0x07, 0xf9, 0x0c, 0xc3, 0xbe, 0xac, 0x82, 0x42, 0x2a, 0xe3, 0x6e, 0xf9, 0x6d,
-	0x18, 0x40, 0x40, 0x27, 0x89, 0xe7, 0x2d, 0xb0, 0xe2, 0xa0, 0x10, 0x5f, 0x41, 0x86, 0x89, 0x9f,
-	0xfc, 0x8d, 0x54, 0xc9, 0x6a, 0xb9, 0xea, 0x92, 0xb1, 0x3c, 0x81, 0x8f, 0x50, 0x4c, 0x5b, 0xfb,
-	0x8f, 0xf7, 0xb5, 0x95, 0x89, 0x1f, 0x6d, 0x47, 0xff, 0xe2, 0xab, 0xc4, 0xe3, 0xe6, 0x94, 0xc7,
-	0x6f, 0x43, 0x12, 0x05, 0xa9, 0xc5, 0x89, 0x89, 0xca, 0x5f, 0x59, 0xb0, 0x70, 0x0a, 0x43, 0xe8,
-	0x51, 0x59, 0x07, 0x6b, 0x1e, 0x1c, 0x98, 0x1e, 0xf2, 0x88, 0x69, 0x5f, 0xc2, 0x10, 0xda, 0x0c,
-	0x85, 0xe2, 0x66, 0xf3, 0xc6, 0xaa, 0x07, 0x07, 0xc7, 0xc8, 0x23, 0x9d, 0x57, 0x42, 0xd6, 0xc0,
-	0x12, 0x1b, 0x98, 0x14, 0xbb, 0x66, 0x1f, 0x7b, 0x98, 0xf1, 0x75, 0xe7, 0x0d, 0xc0, 0x06, 0x5d,
-	0xec, 0x7e, 0x97, 0x20, 0xf2, 0x0e, 0xf8, 0x98, 0x2b, 0xae, 0x91, 0x69, 0x13, 0xca, 0xcc, 0x00,
-	0x85, 0xa6, 0x15, 0x33, 0x94, 0x1e, 0xdd, 0x6a, 0x22, 0xbd, 0x46, 0x1d, 0x42, 0xd9, 0x29, 0x0a,
-	0xdb, 0x31, 0x43, 0xf2, 0xf7, 0xe0, 0x93, 0xa4, 0xe0, 0x15, 0x0a, 0xf1, 0x45, 0x2c, 0x92, 0x90,
-	0xd3, 0xdc, 0xdb, 0x6b, 0xec, 0x8b, 0x3b, 0x6c, 0x2b, 0xa3, 0xa1, 0x5a, 0xec, 0x62, 0xf7, 0x8c,
-	0x2b, 0x92, 0xd4, 0x6f, 0xbe, 0xe6, 0xbc, 0x51, 0xa4, 0x33, 0xa8, 0xc8, 0x92, 0x7f, 0x00, 0x1b,
-	0x6f, 0x0b, 0x52, 0x64, 0x07, 0xcd, 0xbd, 0x2f, 0x7b, 0x0d, 0xe5, 0x03, 0x5e, 0xb2, 0x34, 0x1a,
-	0xaa, 0xeb, 0x33, 0x25, 0xbb, 0x63, 0x85, 0xb1, 0x4e, 0xe7, 0xe2, 0xad, 0xcd, 0x97, 0x5b, 0x55,
-	0x7a, 0x7b, 0x06, 0x03, 0xf1, 0x19, 0x12, 0xeb, 0x6c, 0xef, 0xfe, 0x31, 0x2a, 0x4b, 0x0f, 0xa3,
-	0xb2, 0xf4, 0xcf, 0xa8, 0x2c, 0xfd, 0xfc, 0x54, 0xce, 0x3c, 0x3c, 0x95, 0x33, 0x7f, 0x3f, 0x95,
-	0x33, 0xe7, 0xe9, 0xc7, 0x86, 0x3a, 0x3d, 0x1d, 0x93, 0x71, 0x16, 0x8b, 0x03, 0x44, 0xad, 0x05,
-	0xfe, 0x7a, 0xef, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x84, 0x7c, 0x64, 0xd8, 0x04, 0x00,
-	0x00,
+	// 1024 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0xcf, 0x4f, 0x1b, 0xc7,
+	0x17, 0xf7, 0x82, 0x43, 0xc2, 0x98, 0x1f, 0x61, 0xe2, 0x2f, 0x2c, 0xe4, 0x2b, 0xdb, 0xb1, 0xda,
+	0xc4, 0x42, 0x65, 0x1d, 0x9c, 0x92, 0x2a, 0x48, 0x3d, 0x60, 0x68, 0xa2, 0x28, 0x21, 0x45, 0x0b,
+	0x8d, 0xa2, 0x5c, 0x56, 0xb3, 0xeb, 0xc7, 0xb2, 0xb2, 0x67, 0x67, 0xbb, 0x33, 0x8b, 0x76, 0x73,
+	0xed, 0x25, 0xea, 0xa9, 0xea, 0xa5, 0x57, 0xda, 0x53, 0x8f, 0x1c, 0xf8, 0x23, 0xa2, 0x9e, 0x10,
+	0xa7, 0x2a, 0x07, 0xab, 0x82, 0x4a, 0x44, 0x55, 0xff, 0x88, 0x6a, 0x67, 0xd6, 0xd8, 0x10, 0xb7,
+	0x17, 0x6b, 0xe7, 0xf3, 0x3e, 0xef, 0xbd, 0xcf, 0x7b, 0xf3, 0xde, 0x18, 0x95, 0x1c, 0xc6, 0x29,
+	0xe3, 0x75, 0x12, 0x89, 0xbd, 0xfa, 0xfe, 0xb2, 0x0d, 0x82, 0x2c, 0xcb, 0x83, 0x11, 0x84, 0x4c,
+	0x30, 0x7c, 0x4b, 0xd9, 0x0d, 0x09, 0x65, 0xf6, 0x85, 0x19, 0x42, 0x3d, 0x9f, 0xd5, 0xe5, 0xaf,
+	0xe2, 0x2d, 0xcc, 0x2b, 0x9e, 0x25, 0x4f, 0xf5, 0xcc, 0x49, 0x99, 0x8a, 0x2e, 0x73, 0x99, 0xc2,
+	0xd3, 0xaf, 0x9e, 0x83, 0xcb, 0x98, 0xdb, 0x81, 0xba, 0x3c, 0xd9, 0xd1, 0x6e, 0x9d, 0xf8, 0x89,
+	0x32, 0x55, 0x7f, 0x1e, 0x41, 0x85, 0x26, 0xe1, 0xb0, 0xe6, 0x38, 0x2c, 0xf2, 0x05, 0x6e, 0xa0,
+	0xeb, 0xa4, 0xd5, 0x0a, 0x81, 0x73, 0x5d, 0xab, 0x68, 0xb5, 0xf1, 0xa6, 0x7e, 0x72, 0xb4, 0x54,
+	0xcc, 0x72, 0xac, 0x29, 0xcb, 0xb6, 0x08, 0x3d, 0xdf, 0x35, 0x7b, 0x44, 0xfc, 0x12, 0x5d, 0x0f,
+	0x22, 0xdb, 0x6a, 0x43, 0xa2, 0x8f, 0x54, 0xb4, 0x5a, 0xa1, 0x51, 0x34, 0x54, 0x42, 0xa3, 0x97,
+	0xd0, 0x58, 0xf3, 0x93, 0xe6, 0xbd, 0xbf, 0xba, 0xe5, 0x62, 0x10, 0xd9, 0x1d, 0xcf, 0x49, 0xb9,
+	0x9f, 0x31, 0xea, 0x09, 0xa0, 0x81, 0x48, 0x7e, 0x39, 0x3f, 0x5c, 0x44, 0x7d, 0x83, 0x39, 0x16,
+	0x44, 0xf6, 0x33, 0x48, 0xf0, 0xa7, 0x68, 0x8a, 0x28, 0x59, 0x96, 0x1f, 0x51, 0x1b, 0x42, 0x7d,
+	0xb4, 0xa2, 0xd5, 0xf2, 0xe6, 0x64, 0x86, 0xbe, 0x90, 0x20, 0x5e, 0x40, 0x37, 0x38, 0x7c, 0x1b,
+	0x81, 0xef, 0x80, 0x9e, 0x97, 0x84, 0x8b, 0xf3, 0xea, 0xfa, 0xdb, 0x83, 0x72, 0xee, 0xc3, 0x41,
+	0x39, 0xf7, 0xdb, 0xd1, 0xd2, 0xff, 0x87, 0xb4, 0xd7, 0xc8, 0xea, 0x7e, 0xfa, 0xfd, 0xf9, 0xe1,
+	0xe2, 0xac, 0x22, 0x2c, 0xf1, 0x56, 0xbb, 0x3e, 0xd0, 0x93, 0xea, 0xdf, 0x1a, 0x9a, 0xdc, 0x64,
+	0xad, 0xa8, 0x73, 0xd1, 0xa5, 0xa7, 0x68, 0xc2, 0x26, 0x1c, 0xac, 0x4c, 0x88, 0x6c, 0x55, 0xa1,
+	0x51, 0x31, 0x86, 0x65, 0x18, 0x88, 0xd4, 0xcc, 0x1f, 0x77, 0xcb, 0x9a, 0x59, 0xb0, 0x07, 0x1a,
+	0x8e, 0x51, 0xde, 0x27, 0x14, 0x64, 0xe7, 0xc6, 0x4d, 0xf9, 0x8d, 0x2b, 0xa8, 0x10, 0x40, 0x48,
+	0x3d, 0xce, 0x3d, 0xe6, 0x73, 0x7d, 0xb4, 0x32, 0x5a, 0x1b, 0x37, 0x07, 0xa1, 0xd5, 0xd7, 0x6f,
+	0x55, 0x4d, 0xd5, 0x61, 0x19, 0x2f, 0x69, 0x95, 0x95, 0xe9, 0x03, 0x95, 0x5d, 0xb2, 0xfe, 0x78,
+	0x7e, 0xb8, 0x38, 0x45, 0x25, 0xd2, 0x2b, 0xa6, 0xfa, 0x93, 0x86, 0x6e, 0x2a, 0xd2, 0x7a, 0x08,
+	0x2d, 0xf0, 0x85, 0x47, 0x3a, 0xb8, 0x8c, 0x0a, 0x19, 0x4d, 0xaa, 0x95, 0xb3, 0x61, 0x22, 0x05,
+	0xbd, 0x48, 0x35, 0xdf, 0x43, 0xd3, 0x2d, 0x08, 0xbd, 0x7d, 0x22, 0x3c, 0xe6, 0xa7, 0xd7, 0xc8,
+	0xf5, 0x91, 0xca, 0x68, 0x6d, 0xc2, 0x9c, 0xea, 0xc3, 0xcf, 0x20, 0xe1, 0xab, 0x8f, 0x4e, 0x8e,
+	0x96, 0xa6, 0xfb, 0x7a, 0x2a, 0xf7, 0x8d, 0xcf, 0xbf, 0x48, 0x35, 0xde, 0x19, 0xd0, 0xf8, 0x24,
+	0x64, 0x51, 0x90, 0x49, 0xec, 0x8b, 0xa8, 0xfe, 0x99, 0x47, 0x63, 0x5b, 0x24, 0x24, 0x94, 0x63,
+	0x03, 0xdd, 0xa2, 0x24, 0xb6, 0x28, 0x50, 0x66, 0x39, 0x7b, 0x24, 0x24, 0x8e, 0x80, 0x50, 0xcd,
+	0x6c, 0xde, 0x9c, 0xa1, 0x24, 0xde, 0x04, 0xca, 0xd6, 0x2f, 0x0c, 0xb8, 0x82, 0x26, 0x44, 0x6c,
+	0x71, 0xcf, 0xb5, 0x3a, 0x1e, 0xf5, 0x84, 0x6c, 0x77, 0xde, 0x44, 0x22, 0xde, 0xf6, 0xdc, 0xe7,
+	0x29, 0x82, 0xef, 0xa3, 0xff, 0x49, 0xc6, 0x1b, 0xb0, 0x1c, 0xc6, 0x85, 0x15, 0x40, 0x68, 0xd9,
+	0x89, 0x80, 0x6c, 0xe8, 0x66, 0x52, 0xea, 0x1b, 0x58, 0x67, 0x5c, 0x6c, 0x41, 0xd8, 0x4c, 0x04,
+	0xe0, 0xaf, 0xd1, 0x5c, 0x1a, 0x70, 0x1f, 0x42, 0x6f, 0x37, 0x51, 0x4e, 0xd0, 0x6a, 0xac, 0xac,
+	0x2c, 0x3f, 0x52, 0x73, 0xd8, 0xd4, 0x4f, 0xbb, 0xe5, 0xe2, 0xb6, 0xe7, 0xbe, 0x94, 0x8c, 0xd4,
+	0xf5, 0xab, 0x0d, 0x69, 0x37, 0x8b, 0xfc, 0x12, 0xaa, 0xbc, 0xf0, 0x37, 0x68, 0xfe, 0x6a, 0x40,
+	0x0e, 0x4e, 0xd0, 0x58, 0x79, 0xd8, 0x5e, 0xd6, 0xaf, 0xc9, 0x90, 0x0b, 0xa7, 0xdd, 0xf2, 0xec,
+	0xa5, 0x90, 0xdb, 0x3d, 0x86, 0x39, 0xcb, 0x87, 0xe2, 0x78, 0x0b, 0x2d, 0xf4, 0xf6, 0x28, 0x08,
+	0x23, 0xdf, 0xf3, 0x5d, 0xcb, 0x26, 0xc2, 0xd9, 0x93, 0xc5, 0xea, 0x63, 0x32, 0xee, 0xad, 0xf7,
+	0x57, 0x6f, 0x65, 0xa5, 0x61, 0xce, 0x65, 0x6e, 0x5b, 0xca, 0xab, 0x99, 0x3a, 0xa5, 0x4d, 0xc0,
+	0xaf, 0x10, 0xda, 0x05, 0xb0, 0x28, 0x09, 0xdb, 0x20, 0xf4, 0xeb, 0x72, 0xfa, 0x3f, 0x19, 0x3a,
+	0xfd, 0x8f, 0x01, 0x36, 0x25, 0x4b, 0xdd, 0xdb, 0xf0, 0x3c, 0xe3, 0xbb, 0x3d, 0x16, 0xb6, 0xd0,
+	0xa4, 0x88, 0xad, 0x90, 0x08, 0xc8, 0x2e, 0xea, 0x86, 0x0c, 0x7e, 0x77, 0x68, 0xf0, 0x9d, 0xd8,
+	0x24, 0x02, 0xe4, 0xf5, 0xfd, 0x57, 0xf8, 0x82, 0xe8, 0xf3, 0x56, 0xef, 0x7c, 0x38, 0x28, 0x6b,
+	0x57, 0x77, 0x22, 0x56, 0x6f, 0xb2, 0x0a, 0x52, 0xfd, 0x6e, 0x04, 0x4d, 0x5f, 0xd1, 0x8d, 0x8b,
+	0xe8, 0x5a, 0x0b, 0x7c, 0x46, 0xb3, 0xc9, 0x57, 0x07, 0xfc, 0x0a, 0x4d, 0x50, 0xcf, 0xb7, 0xe4,
+	0x5b, 0xb0, 0x0b, 0xd9, 0x12, 0x37, 0x1f, 0xbe, 0xeb, 0x96, 0x73, 0xef, 0xbb, 0xe5, 0xdb, 0x2a,
+	0x03, 0x6f, 0xb5, 0x0d, 0x8f, 0xd5, 0x29, 0x11, 0x7b, 0xc6, 0x73, 0x70, 0x89, 0x93, 0x6c, 0x80,
+	0x73, 0x72, 0xb4, 0x84, 0xb2, 0x92, 0x36, 0xc0, 0xf9, 0xf5, 0xfc, 0x70, 0x51, 0x33, 0x11, 0xf5,
+	0xfc, 0xf4, 0xb5, 0x78, 0x0c, 0x80, 0x6b, 0xe8, 0xa6, 0x20, 0xa1, 0x0b, 0xc2, 0xb2, 0x3b, 0xcc,
+	0x69, 0x5b, 0x2e, 0xe1, 0xd9, 0x20, 0x4e, 0x29, 0xbc, 0x99, 0xc2, 0x4f, 0x08, 0xc7, 0x5f, 0xa2,
+	0xdb, 0xbd, 0xfc, 0xe9, 0x26, 0xf8, 0x2e, 0x58, 0x52, 0x9c, 0xe7, 0x13, 0xc1, 0xc2, 0xec, 0x45,
+	0xd4, 0x6d, 0x15, 0x77, 0x5d, 0x12, 0x36, 0xfa, 0xf6, 0xd5, 0xb9, 0xb4, 0x1f, 0x27, 0x1f, 0x77,
+	0xad, 0x1a, 0xa3, 0x99, 0x8f, 0xfa, 0x8b, 0xab, 0x68, 0x32, 0x5d, 0x3b, 0xa5, 0x49, 0xc4, 0xbd,
+	0x85, 0x2b, 0x50, 0x12, 0x4b, 0x41, 0x3b, 0x31, 0xc7, 0x77, 0xd1, 0x74, 0xca, 0xe9, 0x8d, 0x5c,
+	0xca, 0x52, 0xdb, 0x96, 0xba, 0x66, 0x4b, 0xbd, 0x13, 0xf3, 0x7f, 0xcd, 0xdc, 0x7c, 0xf0, 0xee,
+	0xb4, 0xa4, 0x1d, 0x9f, 0x96, 0xb4, 0x3f, 0x4e, 0x4b, 0xda, 0x0f, 0x67, 0xa5, 0xdc, 0xf1, 0x59,
+	0x29, 0xf7, 0xfb, 0x59, 0x29, 0xf7, 0x7a, 0xfe, 0x52, 0x47, 0xb3, 0x5b, 0x13, 0x49, 0x00, 0xdc,
+	0x1e, 0x93, 0xff, 0x35, 0x0f, 0xfe, 0x19, 0x00, 0xb4, 0x4d, 0x04, 0xcb, 0x65, 0x07, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -336,6 +516,75 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.AccountPruningBatchSize != that1.AccountPruningBatchSize {
+		return false
+	}
+	if !this.FeeMarket.Equal(that1.FeeMarket) {
+		return false
+	}
+	if !this.TxRateLimit.Equal(that1.TxRateLimit) {
+		return false
+	}
+	return true
+}
+func (this *FeeMarketParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeMarketParams)
+	if !ok {
+		that2, ok := that.(FeeMarketParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.MinBaseFee.Equal(that1.MinBaseFee) {
+		return false
+	}
+	if this.TargetBlockGas != that1.TargetBlockGas {
+		return false
+	}
+	if this.BaseFeeChangeDenominator != that1.BaseFeeChangeDenominator {
+		return false
+	}
+	return true
+}
+func (this *TxRateLimitParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxRateLimitParams)
+	if !ok {
+		that2, ok := that.(TxRateLimitParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxBlockTxs != that1.MaxBlockTxs {
+		return false
+	}
+	if this.MaxAccountTxs != that1.MaxAccountTxs {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -500,6 +749,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TxRateLimit != nil {
+		{
+			size, err := m.TxRateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.FeeMarket != nil {
+		{
+			size, err := m.FeeMarket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AccountPruningBatchSize != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.AccountPruningBatchSize))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -528,6 +806,89 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeMarketParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMarketParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMarketParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxRateLimitParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxRateLimitParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxRateLimitParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAccountTxs != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MaxAccountTxs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxBlockTxs != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MaxBlockTxs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -625,6 +986,53 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.AccountPruningBatchSize != 0 {
+		n += 1 + sovAuth(uint64(m.AccountPruningBatchSize))
+	}
+	if m.FeeMarket != nil {
+		l = m.FeeMarket.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.TxRateLimit != nil {
+		l = m.TxRateLimit.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeMarketParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovAuth(uint64(m.TargetBlockGas))
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovAuth(uint64(m.BaseFeeChangeDenominator))
+	}
+	return n
+}
+
+func (m *TxRateLimitParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBlockTxs != 0 {
+		n += 1 + sovAuth(uint64(m.MaxBlockTxs))
+	}
+	if m.MaxAccountTxs != 0 {
+		n += 1 + sovAuth(uint64(m.MaxAccountTxs))
+	}
 	return n
 }
 
@@ -1178,6 +1586,339 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountPruningBatchSize", wireType)
+			}
+			m.AccountPruningBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountPruningBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMarket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeMarket == nil {
+				m.FeeMarket = &FeeMarketParams{}
+			}
+			if err := m.FeeMarket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxRateLimit == nil {
+				m.TxRateLimit = &TxRateLimitParams{}
+			}
+			if err := m.TxRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeMarketParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMarketParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMarketParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxRateLimitParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxRateLimitParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxRateLimitParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockTxs", wireType)
+			}
+			m.MaxBlockTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAccountTxs", wireType)
+			}
+			m.MaxAccountTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAccountTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -0,0 +1,8 @@
+package types
+
+// auth module event types
+const (
+	EventTypePruneAccount = "prune_account"
+
+	AttributeKeyAddress = "address"
+)
@@ -11,6 +11,9 @@ const (
 	// StoreKey is string representation of the store key for auth
 	StoreKey = "acc"
 
+	// TStoreKey is the string store key for the auth transient store
+	TStoreKey = "transient_acc"
+
 	// FeeCollectorName the root string for the fee collector account address
 	FeeCollectorName = "fee_collector"
 )
@@ -26,6 +29,28 @@ var (
 	// account number is stored.
 	GlobalAccountNumberKey = collections.NewPrefix(2)
 
+	// AccountPruningCursorKey is the key of the address to resume the account
+	// pruning from.
+	AccountPruningCursorKey = collections.NewPrefix(3)
+
+	// BaseFeeKey is the key of the current base fee of the fee market.
+	BaseFeeKey = collections.NewPrefix(4)
+
+	// BlockGasWantedKey is the key of the gas wanted by the transactions of the
+	// current block, used to adjust the base fee.
+	BlockGasWantedKey = collections.NewPrefix(5)
+
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
+
+	// AccountPubKeyStoreKeyPrefix prefix for account-by-pubkey store
+	AccountPubKeyStoreKeyPrefix = collections.NewPrefix(6)
+
+	// BlockTxCountKey is the key of the number of transactions in the current
+	// block in the transient store, used to rate limit the transactions.
+	BlockTxCountKey = collections.NewPrefix(7)
+
+	// AccountTxCountsKeyPrefix is the prefix of the number of transactions signed
+	// by each account in the current block in the transient store.
+	AccountTxCountsKeyPrefix = collections.NewPrefix(8)
 )
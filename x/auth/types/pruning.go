@@ -0,0 +1,23 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountPruneChecker is implemented by the modules holding state for
+// accounts, such as balances or delegations, to prevent the pruning of the
+// accounts they still reference.
+type AccountPruneChecker interface {
+	// CanPruneAccount returns whether the module holds no state for the account
+	// at the given address, so that the account can be pruned.
+	CanPruneAccount(ctx context.Context, addr sdk.AccAddress) (bool, error)
+}
+
+// AccountPruneCheckerWrapper is a wrapper for modules to inject an
+// AccountPruneChecker using depinject.
+type AccountPruneCheckerWrapper struct{ AccountPruneChecker }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (AccountPruneCheckerWrapper) IsOnePerModuleType() {}
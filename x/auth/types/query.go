@@ -3,6 +3,7 @@ package types
 import (
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -12,3 +13,22 @@ func (m *QueryAccountResponse) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacke
 }
 
 var _ gogoprotoany.UnpackInterfacesMessage = &QueryAccountResponse{}
+
+func (m *QueryAccountByPubKeyRequest) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(m.PubKey, &pubKey)
+}
+
+var _ gogoprotoany.UnpackInterfacesMessage = &QueryAccountByPubKeyRequest{}
+
+func (m *QueryAccountByPubKeyResponse) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	for _, any := range m.Accounts {
+		var account sdk.AccountI
+		if err := unpacker.UnpackAny(any, &account); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ gogoprotoany.UnpackInterfacesMessage = &QueryAccountByPubKeyResponse{}
@@ -133,6 +133,119 @@ func (m *QueryAccountsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAccountsStreamRequest is the request type for the Query/AccountsStream RPC method.
+type QueryAccountsStreamRequest struct {
+	// key is the account address to start streaming from, as returned in the
+	// next_key of a previous response, to resume an interrupted stream.
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// batch_size is the number of accounts sent in each response. It defaults
+	// to 100 and is capped at 1000.
+	BatchSize uint32 `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+}
+
+func (m *QueryAccountsStreamRequest) Reset()         { *m = QueryAccountsStreamRequest{} }
+func (m *QueryAccountsStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsStreamRequest) ProtoMessage()    {}
+func (*QueryAccountsStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{2}
+}
+func (m *QueryAccountsStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountsStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsStreamRequest.Merge(m, src)
+}
+func (m *QueryAccountsStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountsStreamRequest proto.InternalMessageInfo
+
+func (m *QueryAccountsStreamRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *QueryAccountsStreamRequest) GetBatchSize() uint32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+// QueryAccountsStreamResponse is the response type for the Query/AccountsStream RPC method.
+type QueryAccountsStreamResponse struct {
+	// accounts are the existing accounts of the batch
+	Accounts []*any.Any `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// next_key is the key to resume the stream from after this batch. It is
+	// empty for the last batch.
+	NextKey []byte `protobuf:"bytes,2,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+}
+
+func (m *QueryAccountsStreamResponse) Reset()         { *m = QueryAccountsStreamResponse{} }
+func (m *QueryAccountsStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsStreamResponse) ProtoMessage()    {}
+func (*QueryAccountsStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{3}
+}
+func (m *QueryAccountsStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountsStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsStreamResponse.Merge(m, src)
+}
+func (m *QueryAccountsStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountsStreamResponse proto.InternalMessageInfo
+
+func (m *QueryAccountsStreamResponse) GetAccounts() []*any.Any {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *QueryAccountsStreamResponse) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
 // QueryAccountRequest is the request type for the Query/Account RPC method.
 type QueryAccountRequest struct {
 	// address defines the address to query for.
@@ -143,7 +256,7 @@ func (m *QueryAccountRequest) Reset()         { *m = QueryAccountRequest{} }
 func (m *QueryAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountRequest) ProtoMessage()    {}
 func (*QueryAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{2}
+	return fileDescriptor_c451370b3929a27c, []int{4}
 }
 func (m *QueryAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -182,7 +295,7 @@ func (m *QueryAccountResponse) Reset()         { *m = QueryAccountResponse{} }
 func (m *QueryAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountResponse) ProtoMessage()    {}
 func (*QueryAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{3}
+	return fileDescriptor_c451370b3929a27c, []int{5}
 }
 func (m *QueryAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -226,7 +339,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{4}
+	return fileDescriptor_c451370b3929a27c, []int{6}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -265,7 +378,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{5}
+	return fileDescriptor_c451370b3929a27c, []int{7}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -309,7 +422,7 @@ func (m *QueryModuleAccountsRequest) Reset()         { *m = QueryModuleAccountsR
 func (m *QueryModuleAccountsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleAccountsRequest) ProtoMessage()    {}
 func (*QueryModuleAccountsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{6}
+	return fileDescriptor_c451370b3929a27c, []int{8}
 }
 func (m *QueryModuleAccountsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +460,7 @@ func (m *QueryModuleAccountsResponse) Reset()         { *m = QueryModuleAccounts
 func (m *QueryModuleAccountsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleAccountsResponse) ProtoMessage()    {}
 func (*QueryModuleAccountsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{7}
+	return fileDescriptor_c451370b3929a27c, []int{9}
 }
 func (m *QueryModuleAccountsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -392,7 +505,7 @@ func (m *QueryModuleAccountByNameRequest) Reset()         { *m = QueryModuleAcco
 func (m *QueryModuleAccountByNameRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleAccountByNameRequest) ProtoMessage()    {}
 func (*QueryModuleAccountByNameRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{8}
+	return fileDescriptor_c451370b3929a27c, []int{10}
 }
 func (m *QueryModuleAccountByNameRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +550,7 @@ func (m *QueryModuleAccountByNameResponse) Reset()         { *m = QueryModuleAcc
 func (m *QueryModuleAccountByNameResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleAccountByNameResponse) ProtoMessage()    {}
 func (*QueryModuleAccountByNameResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{9}
+	return fileDescriptor_c451370b3929a27c, []int{11}
 }
 func (m *QueryModuleAccountByNameResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,7 +594,7 @@ func (m *Bech32PrefixRequest) Reset()         { *m = Bech32PrefixRequest{} }
 func (m *Bech32PrefixRequest) String() string { return proto.CompactTextString(m) }
 func (*Bech32PrefixRequest) ProtoMessage()    {}
 func (*Bech32PrefixRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{10}
+	return fileDescriptor_c451370b3929a27c, []int{12}
 }
 func (m *Bech32PrefixRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +632,7 @@ func (m *Bech32PrefixResponse) Reset()         { *m = Bech32PrefixResponse{} }
 func (m *Bech32PrefixResponse) String() string { return proto.CompactTextString(m) }
 func (*Bech32PrefixResponse) ProtoMessage()    {}
 func (*Bech32PrefixResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{11}
+	return fileDescriptor_c451370b3929a27c, []int{13}
 }
 func (m *Bech32PrefixResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -564,7 +677,7 @@ func (m *AddressBytesToStringRequest) Reset()         { *m = AddressBytesToStrin
 func (m *AddressBytesToStringRequest) String() string { return proto.CompactTextString(m) }
 func (*AddressBytesToStringRequest) ProtoMessage()    {}
 func (*AddressBytesToStringRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{12}
+	return fileDescriptor_c451370b3929a27c, []int{14}
 }
 func (m *AddressBytesToStringRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -609,7 +722,7 @@ func (m *AddressBytesToStringResponse) Reset()         { *m = AddressBytesToStri
 func (m *AddressBytesToStringResponse) String() string { return proto.CompactTextString(m) }
 func (*AddressBytesToStringResponse) ProtoMessage()    {}
 func (*AddressBytesToStringResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{13}
+	return fileDescriptor_c451370b3929a27c, []int{15}
 }
 func (m *AddressBytesToStringResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -654,7 +767,7 @@ func (m *AddressStringToBytesRequest) Reset()         { *m = AddressStringToByte
 func (m *AddressStringToBytesRequest) String() string { return proto.CompactTextString(m) }
 func (*AddressStringToBytesRequest) ProtoMessage()    {}
 func (*AddressStringToBytesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{14}
+	return fileDescriptor_c451370b3929a27c, []int{16}
 }
 func (m *AddressStringToBytesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -699,7 +812,7 @@ func (m *AddressStringToBytesResponse) Reset()         { *m = AddressStringToByt
 func (m *AddressStringToBytesResponse) String() string { return proto.CompactTextString(m) }
 func (*AddressStringToBytesResponse) ProtoMessage()    {}
 func (*AddressStringToBytesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{15}
+	return fileDescriptor_c451370b3929a27c, []int{17}
 }
 func (m *AddressStringToBytesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +864,7 @@ func (m *QueryAccountAddressByIDRequest) Reset()         { *m = QueryAccountAddr
 func (m *QueryAccountAddressByIDRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountAddressByIDRequest) ProtoMessage()    {}
 func (*QueryAccountAddressByIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{16}
+	return fileDescriptor_c451370b3929a27c, []int{18}
 }
 func (m *QueryAccountAddressByIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -804,7 +917,7 @@ func (m *QueryAccountAddressByIDResponse) Reset()         { *m = QueryAccountAdd
 func (m *QueryAccountAddressByIDResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountAddressByIDResponse) ProtoMessage()    {}
 func (*QueryAccountAddressByIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{17}
+	return fileDescriptor_c451370b3929a27c, []int{19}
 }
 func (m *QueryAccountAddressByIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -850,7 +963,7 @@ func (m *QueryAccountInfoRequest) Reset()         { *m = QueryAccountInfoRequest
 func (m *QueryAccountInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountInfoRequest) ProtoMessage()    {}
 func (*QueryAccountInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{18}
+	return fileDescriptor_c451370b3929a27c, []int{20}
 }
 func (m *QueryAccountInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -896,7 +1009,7 @@ func (m *QueryAccountInfoResponse) Reset()         { *m = QueryAccountInfoRespon
 func (m *QueryAccountInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountInfoResponse) ProtoMessage()    {}
 func (*QueryAccountInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{19}
+	return fileDescriptor_c451370b3929a27c, []int{21}
 }
 func (m *QueryAccountInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -932,9 +1045,105 @@ func (m *QueryAccountInfoResponse) GetInfo() *BaseAccount {
 	return nil
 }
 
+// QueryAccountByPubKeyRequest is the Query/AccountByPubKey request type.
+type QueryAccountByPubKeyRequest struct {
+	// pub_key is the public key of the accounts.
+	PubKey *any.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *QueryAccountByPubKeyRequest) Reset()         { *m = QueryAccountByPubKeyRequest{} }
+func (m *QueryAccountByPubKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountByPubKeyRequest) ProtoMessage()    {}
+func (*QueryAccountByPubKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{22}
+}
+func (m *QueryAccountByPubKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountByPubKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountByPubKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountByPubKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountByPubKeyRequest.Merge(m, src)
+}
+func (m *QueryAccountByPubKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountByPubKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountByPubKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountByPubKeyRequest proto.InternalMessageInfo
+
+func (m *QueryAccountByPubKeyRequest) GetPubKey() *any.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+// QueryAccountByPubKeyResponse is the Query/AccountByPubKey response type.
+type QueryAccountByPubKeyResponse struct {
+	// accounts are the accounts with the given public key. As the address of an
+	// account is derived from its public key there is usually a single account,
+	// but custom account types may share a public key.
+	Accounts []*any.Any `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (m *QueryAccountByPubKeyResponse) Reset()         { *m = QueryAccountByPubKeyResponse{} }
+func (m *QueryAccountByPubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountByPubKeyResponse) ProtoMessage()    {}
+func (*QueryAccountByPubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{23}
+}
+func (m *QueryAccountByPubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountByPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountByPubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountByPubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountByPubKeyResponse.Merge(m, src)
+}
+func (m *QueryAccountByPubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountByPubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountByPubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountByPubKeyResponse proto.InternalMessageInfo
+
+func (m *QueryAccountByPubKeyResponse) GetAccounts() []*any.Any {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
+	proto.RegisterType((*QueryAccountsStreamRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsStreamRequest")
+	proto.RegisterType((*QueryAccountsStreamResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsStreamResponse")
 	proto.RegisterType((*QueryAccountRequest)(nil), "cosmos.auth.v1beta1.QueryAccountRequest")
 	proto.RegisterType((*QueryAccountResponse)(nil), "cosmos.auth.v1beta1.QueryAccountResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.auth.v1beta1.QueryParamsRequest")
@@ -953,84 +1162,98 @@ func init() {
 	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
 	proto.RegisterType((*QueryAccountInfoRequest)(nil), "cosmos.auth.v1beta1.QueryAccountInfoRequest")
 	proto.RegisterType((*QueryAccountInfoResponse)(nil), "cosmos.auth.v1beta1.QueryAccountInfoResponse")
+	proto.RegisterType((*QueryAccountByPubKeyRequest)(nil), "cosmos.auth.v1beta1.QueryAccountByPubKeyRequest")
+	proto.RegisterType((*QueryAccountByPubKeyResponse)(nil), "cosmos.auth.v1beta1.QueryAccountByPubKeyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 1143 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xa6, 0xa1, 0x49, 0x5f, 0xd2, 0x54, 0x8c, 0x5d, 0x11, 0x36, 0xa9, 0x6d, 0x6d, 0x21,
-	0x5f, 0xd4, 0xbb, 0xf5, 0x47, 0x53, 0x88, 0xc4, 0x21, 0x2b, 0xd4, 0x2a, 0x42, 0x20, 0x77, 0x5b,
-	0x41, 0xe9, 0xc5, 0x5a, 0x67, 0xd7, 0xce, 0xaa, 0xf5, 0xae, 0xeb, 0x5d, 0xa3, 0x9a, 0xc8, 0x02,
-	0x21, 0x21, 0xf5, 0xc0, 0x01, 0x09, 0x6e, 0x5c, 0xf2, 0x27, 0x70, 0xb0, 0xc4, 0x01, 0x6e, 0x70,
-	0x28, 0x39, 0x55, 0xe1, 0x82, 0x38, 0x20, 0x94, 0x20, 0xc1, 0x9f, 0x81, 0x3c, 0xf3, 0xf6, 0xc3,
-	0xf1, 0xd8, 0xde, 0xb4, 0xb7, 0xf5, 0xcc, 0x7b, 0xbf, 0xf7, 0xfb, 0xbd, 0x79, 0xf3, 0xde, 0x18,
-	0x32, 0xbb, 0x8e, 0xdb, 0x70, 0x5c, 0x45, 0x6f, 0x7b, 0x7b, 0xca, 0xa7, 0xf9, 0xaa, 0xe9, 0xe9,
-	0x79, 0xe5, 0x71, 0xdb, 0x6c, 0x75, 0xe4, 0x66, 0xcb, 0xf1, 0x1c, 0x92, 0x64, 0x06, 0x72, 0xdf,
-	0x40, 0x46, 0x03, 0x71, 0x03, 0xbd, 0xaa, 0xba, 0x6b, 0x32, 0xeb, 0xc0, 0xb7, 0xa9, 0xd7, 0x2d,
-	0x5b, 0xf7, 0x2c, 0xc7, 0x66, 0x00, 0x62, 0xaa, 0xee, 0xd4, 0x1d, 0xfa, 0xa9, 0xf4, 0xbf, 0x70,
-	0xf5, 0xf5, 0xba, 0xe3, 0xd4, 0x1f, 0x99, 0x0a, 0xfd, 0x55, 0x6d, 0xd7, 0x14, 0xdd, 0xc6, 0x88,
-	0xe2, 0x32, 0x6e, 0xe9, 0x4d, 0x4b, 0xd1, 0x6d, 0xdb, 0xf1, 0x28, 0x9a, 0x8b, 0xbb, 0x69, 0x1e,
-	0x61, 0x4a, 0x0e, 0x81, 0xd9, 0x7e, 0x85, 0x45, 0x44, 0xf2, 0x6c, 0x6b, 0x09, 0x5d, 0x7d, 0xc2,
-	0x51, 0x9d, 0x92, 0x0b, 0xa9, 0x3b, 0xfd, 0x9f, 0xdb, 0xbb, 0xbb, 0x4e, 0xdb, 0xf6, 0x5c, 0xcd,
-	0x7c, 0xdc, 0x36, 0x5d, 0x8f, 0xdc, 0x02, 0x08, 0x25, 0x2d, 0x0a, 0x59, 0x61, 0x6d, 0xae, 0xb0,
-	0x22, 0x23, 0x6e, 0x5f, 0xbf, 0xcc, 0x50, 0x90, 0x8a, 0x5c, 0xd6, 0xeb, 0x26, 0xfa, 0x6a, 0x11,
-	0xcf, 0xad, 0xe4, 0x51, 0x2f, 0x77, 0x89, 0xb9, 0xe5, 0x5c, 0xe3, 0x61, 0xf6, 0xba, 0x5c, 0x2a,
-	0x4a, 0xbf, 0x09, 0x70, 0xf9, 0x54, 0x54, 0xb7, 0xe9, 0xd8, 0xae, 0x49, 0x34, 0x98, 0xd5, 0x71,
-	0x6d, 0x51, 0xc8, 0x9e, 0x5b, 0x9b, 0x2b, 0xa4, 0x64, 0x96, 0x17, 0xd9, 0x4f, 0x99, 0xbc, 0x6d,
-	0x77, 0xd4, 0xec, 0x61, 0x2f, 0xb7, 0xcc, 0x39, 0x22, 0x19, 0x11, 0x77, 0xb4, 0x00, 0x87, 0xdc,
-	0x1e, 0x90, 0x32, 0x45, 0xa5, 0xac, 0x4e, 0x94, 0xc2, 0x08, 0x4d, 0xd2, 0x72, 0x53, 0xba, 0x0b,
-	0xc9, 0xa8, 0x14, 0x3f, 0x7f, 0x05, 0x98, 0xd1, 0x0d, 0xa3, 0x65, 0xba, 0x2e, 0x4d, 0xde, 0x05,
-	0x75, 0xf1, 0xa8, 0x97, 0x4b, 0x61, 0xd0, 0x6d, 0xb6, 0x73, 0xd7, 0x6b, 0x59, 0x76, 0x5d, 0xf3,
-	0x0d, 0xb7, 0x66, 0x9f, 0x1e, 0x64, 0x12, 0xff, 0x1d, 0x64, 0x12, 0xd2, 0xde, 0xe0, 0xa9, 0x04,
-	0xe9, 0x29, 0xc3, 0x0c, 0xca, 0xc2, 0x23, 0x79, 0xd1, 0xec, 0xf8, 0x30, 0x52, 0x0a, 0x08, 0x8d,
-	0x54, 0xd6, 0x5b, 0x7a, 0xc3, 0x3f, 0x7d, 0xa9, 0x8c, 0xa2, 0xfc, 0x55, 0x0c, 0xff, 0x0e, 0x9c,
-	0x6f, 0xd2, 0x15, 0x8c, 0xbe, 0x24, 0xf3, 0x82, 0x30, 0x27, 0x75, 0xfa, 0xd9, 0x5f, 0x99, 0x84,
-	0x86, 0x0e, 0x52, 0x1e, 0x44, 0x8a, 0xf8, 0x81, 0x63, 0xb4, 0x1f, 0x99, 0xa7, 0xaa, 0x8d, 0x97,
-	0xd9, 0x4d, 0xe9, 0x6b, 0x01, 0x96, 0xb8, 0x3e, 0xc8, 0xe6, 0x7e, 0xcc, 0x5a, 0x59, 0x39, 0xec,
-	0xe5, 0x24, 0x1e, 0xd1, 0x01, 0xdc, 0x48, 0xc5, 0xf0, 0xe9, 0xdc, 0x80, 0xcc, 0x30, 0x1b, 0xb5,
-	0xf3, 0xa1, 0xde, 0xf0, 0x0b, 0x9f, 0x10, 0x98, 0xb6, 0xf5, 0x86, 0xc9, 0x4e, 0x5c, 0xa3, 0xdf,
-	0xd2, 0x67, 0x90, 0x1d, 0xed, 0x86, 0x4a, 0x3e, 0x8a, 0x77, 0xac, 0x71, 0x85, 0x04, 0x87, 0xbb,
-	0x01, 0x49, 0xd5, 0xdc, 0xdd, 0x2b, 0x16, 0xca, 0x2d, 0xb3, 0x66, 0x3d, 0x19, 0x9b, 0xed, 0x32,
-	0xa4, 0x06, 0x6d, 0x91, 0xdb, 0x55, 0xb8, 0x58, 0xa5, 0xeb, 0x95, 0x26, 0xdd, 0x40, 0x71, 0xf3,
-	0xd5, 0x88, 0x31, 0x1f, 0xf1, 0x63, 0x58, 0xc2, 0x42, 0x57, 0x3b, 0x9e, 0xe9, 0xde, 0x73, 0xb0,
-	0xde, 0x31, 0x59, 0x57, 0xe1, 0x22, 0x16, 0x7e, 0xa5, 0xda, 0xdf, 0xa7, 0xc0, 0xf3, 0xda, 0xbc,
-	0x1e, 0xf1, 0xe1, 0x03, 0x3f, 0x80, 0x65, 0x3e, 0x30, 0x52, 0x7e, 0x13, 0x16, 0x7c, 0x64, 0x97,
-	0xee, 0x20, 0x67, 0x3f, 0x1e, 0x33, 0xe7, 0x63, 0x7f, 0x12, 0x90, 0x66, 0x56, 0xf7, 0x1c, 0x1a,
-	0xc3, 0x27, 0xfd, 0x32, 0xd0, 0xf7, 0x03, 0xda, 0xa7, 0xa0, 0xc3, 0x4c, 0xbf, 0x60, 0x42, 0x3e,
-	0x87, 0x74, 0xb4, 0x5d, 0x04, 0xc9, 0xd9, 0x79, 0x2f, 0xac, 0xcc, 0x29, 0xcb, 0xa0, 0x80, 0xe7,
-	0xd4, 0xa9, 0x45, 0x41, 0x9b, 0xb2, 0x0c, 0x52, 0x00, 0xc0, 0x42, 0xa9, 0x58, 0x06, 0xed, 0x8b,
-	0xd3, 0x6a, 0xf2, 0xcf, 0xe1, 0x16, 0xa7, 0x5d, 0x40, 0xb3, 0x1d, 0x63, 0xeb, 0xf2, 0x51, 0x2f,
-	0xf7, 0xea, 0xa9, 0xf0, 0x72, 0x41, 0xda, 0xc7, 0xbb, 0xc1, 0x23, 0x80, 0xea, 0xb6, 0xe1, 0x92,
-	0x1f, 0x2d, 0x6e, 0x63, 0x5c, 0xd0, 0x07, 0xe0, 0x46, 0x05, 0xaf, 0xc2, 0x6b, 0xd1, 0xe0, 0x3b,
-	0x76, 0xcd, 0x79, 0x99, 0x2e, 0xcc, 0xed, 0xf2, 0x26, 0x2c, 0x0e, 0xc7, 0x40, 0x65, 0x25, 0x98,
-	0xb6, 0xec, 0x9a, 0x83, 0x57, 0x37, 0xcb, 0xed, 0x89, 0xaa, 0xee, 0xfa, 0xf7, 0x53, 0xa3, 0xd6,
-	0xdc, 0x30, 0x85, 0x2f, 0x16, 0xe0, 0x15, 0x1a, 0x87, 0x1c, 0x08, 0x30, 0xeb, 0x77, 0x3c, 0xb2,
-	0xce, 0xc5, 0xe4, 0xcd, 0x6d, 0x71, 0x23, 0x8e, 0x29, 0x23, 0x2e, 0xbd, 0x7b, 0x38, 0x3c, 0x9b,
-	0x9f, 0xfe, 0xfb, 0xc3, 0x86, 0xf0, 0xe5, 0xef, 0xff, 0x7c, 0x3b, 0x95, 0x21, 0x57, 0x14, 0xee,
-	0xa3, 0xc3, 0x67, 0xf5, 0x9d, 0x00, 0x33, 0x88, 0x49, 0xd6, 0x26, 0x86, 0xf5, 0x09, 0xae, 0xc7,
-	0xb0, 0x44, 0x7e, 0xa5, 0x90, 0xcc, 0x3a, 0x59, 0x1d, 0x4b, 0x46, 0xd9, 0xc7, 0xe3, 0xeb, 0x92,
-	0x23, 0x01, 0xc8, 0x70, 0x1d, 0x92, 0xe2, 0xc4, 0xb8, 0xc3, 0xd7, 0x46, 0x2c, 0x9d, 0xcd, 0x09,
-	0x79, 0xdf, 0x39, 0xe4, 0xd5, 0x69, 0x28, 0x26, 0x4f, 0x14, 0xbe, 0x98, 0xe0, 0xf6, 0x57, 0x2c,
-	0x43, 0xd9, 0x0f, 0x2f, 0x67, 0x97, 0x7c, 0x25, 0xc0, 0x79, 0x36, 0x57, 0xc9, 0xea, 0x68, 0x4e,
-	0x03, 0x43, 0x5c, 0x5c, 0x9b, 0x6c, 0x88, 0x84, 0xd7, 0x42, 0x6e, 0x57, 0xc8, 0x12, 0x97, 0x1b,
-	0x1b, 0xe3, 0xe4, 0x27, 0x01, 0x16, 0x06, 0xc7, 0x31, 0x51, 0x46, 0x87, 0xe1, 0x0e, 0x7b, 0xf1,
-	0x7a, 0x7c, 0x07, 0xe4, 0x77, 0x6b, 0x42, 0x42, 0x57, 0xc8, 0x1b, 0x5c, 0xd2, 0x0d, 0x0a, 0x57,
-	0x09, 0x2a, 0xf6, 0x67, 0x01, 0x92, 0x9c, 0x39, 0x4c, 0x4a, 0x31, 0x19, 0x0d, 0x4c, 0x7b, 0xf1,
-	0xc6, 0x19, 0xbd, 0x50, 0xcc, 0xdb, 0x21, 0xef, 0x1c, 0x79, 0x2b, 0x0e, 0x6f, 0x65, 0xbf, 0xff,
-	0x92, 0xe8, 0x92, 0xef, 0x05, 0x98, 0x8f, 0xce, 0xe8, 0x11, 0xb7, 0x8e, 0x33, 0xf2, 0x47, 0xdc,
-	0x3a, 0xde, 0xc0, 0x97, 0x36, 0x87, 0xbb, 0xc2, 0xe6, 0xd8, 0xd2, 0x60, 0x2f, 0x01, 0xf2, 0x8b,
-	0x00, 0x29, 0xde, 0x58, 0x26, 0xfc, 0xf3, 0x1e, 0xf3, 0x34, 0x10, 0xf3, 0x67, 0xf0, 0x88, 0x94,
-	0x08, 0x97, 0xf5, 0xa8, 0x1c, 0x33, 0xd6, 0x41, 0xdf, 0x60, 0x23, 0xb7, 0x4b, 0x7e, 0x0d, 0x55,
-	0x0c, 0x4c, 0xe9, 0xf1, 0x2a, 0x78, 0x6f, 0x85, 0xf1, 0x2a, 0xb8, 0x4f, 0x00, 0xe9, 0xf6, 0x28,
-	0x15, 0x32, 0xb9, 0x16, 0x4b, 0x05, 0x7b, 0x94, 0x74, 0xc9, 0x8f, 0x02, 0xcc, 0x45, 0x66, 0x15,
-	0xb9, 0x36, 0xb1, 0x91, 0x45, 0xc6, 0xa6, 0x98, 0x8b, 0x69, 0x8d, 0xac, 0xdf, 0x1f, 0x66, 0x7d,
-	0x73, 0x72, 0x91, 0x07, 0xed, 0xcd, 0xae, 0x39, 0x61, 0xfb, 0x56, 0x8b, 0xcf, 0x8e, 0xd3, 0xc2,
-	0xf3, 0xe3, 0xb4, 0xf0, 0xf7, 0x71, 0x5a, 0xf8, 0xe6, 0x24, 0x9d, 0x78, 0x7e, 0x92, 0x4e, 0xfc,
-	0x71, 0x92, 0x4e, 0x3c, 0xc0, 0xbf, 0xb8, 0xae, 0xf1, 0x50, 0xb6, 0x1c, 0xe5, 0x09, 0x43, 0xf3,
-	0x3a, 0x4d, 0xd3, 0xad, 0x9e, 0xa7, 0xef, 0xe4, 0xe2, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf9,
-	0xa5, 0x31, 0xbf, 0xd7, 0x0f, 0x00, 0x00,
+	// 1334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xa4, 0x21, 0x49, 0x5f, 0xd3, 0xb4, 0x8c, 0x5d, 0x91, 0x6e, 0x52, 0x3b, 0xda, 0x42,
+	0x9a, 0xa4, 0xf5, 0x6e, 0xec, 0xa4, 0x2d, 0x44, 0xe2, 0x10, 0x0b, 0xb5, 0x8a, 0x2a, 0x90, 0xbb,
+	0xa9, 0xa0, 0xf4, 0x62, 0xed, 0xc6, 0x13, 0x67, 0x95, 0x7a, 0xd7, 0xf5, 0xae, 0x51, 0xb7, 0x56,
+	0x84, 0x84, 0x84, 0xa8, 0x10, 0x07, 0x24, 0xb8, 0x71, 0x29, 0xff, 0x01, 0x87, 0x48, 0x1c, 0xe0,
+	0x82, 0xe0, 0x50, 0x7c, 0x2a, 0xe1, 0x82, 0x38, 0x20, 0xd4, 0x22, 0xc1, 0x9f, 0x81, 0x3c, 0xfb,
+	0xd6, 0xde, 0xb5, 0xc7, 0xf6, 0xa6, 0x55, 0x4f, 0x59, 0xcf, 0xbc, 0x1f, 0xdf, 0xf7, 0xe6, 0xcd,
+	0x9b, 0x4f, 0x81, 0xf4, 0xb6, 0xed, 0x54, 0x6c, 0x47, 0xd5, 0xeb, 0xee, 0xae, 0xfa, 0x51, 0xd6,
+	0x60, 0xae, 0x9e, 0x55, 0xef, 0xd5, 0x59, 0xcd, 0x53, 0xaa, 0x35, 0xdb, 0xb5, 0x69, 0xc2, 0x37,
+	0x50, 0x5a, 0x06, 0x0a, 0x1a, 0x48, 0xcb, 0xe8, 0x65, 0xe8, 0x0e, 0xf3, 0xad, 0xdb, 0xbe, 0x55,
+	0xbd, 0x6c, 0x5a, 0xba, 0x6b, 0xda, 0x96, 0x1f, 0x40, 0x4a, 0x96, 0xed, 0xb2, 0xcd, 0x3f, 0xd5,
+	0xd6, 0x17, 0xae, 0x9e, 0x2d, 0xdb, 0x76, 0xf9, 0x2e, 0x53, 0xf9, 0x2f, 0xa3, 0xbe, 0xa3, 0xea,
+	0x16, 0x66, 0x94, 0xe6, 0x70, 0x4b, 0xaf, 0x9a, 0xaa, 0x6e, 0x59, 0xb6, 0xcb, 0xa3, 0x39, 0xb8,
+	0x9b, 0x12, 0x01, 0xe6, 0xe0, 0x30, 0xb0, 0xbf, 0x5f, 0xf4, 0x33, 0x22, 0x78, 0x7f, 0x6b, 0x16,
+	0x5d, 0x03, 0xc0, 0x61, 0x9e, 0xb2, 0x03, 0xc9, 0x9b, 0xad, 0x9f, 0x1b, 0xdb, 0xdb, 0x76, 0xdd,
+	0x72, 0x1d, 0x8d, 0xdd, 0xab, 0x33, 0xc7, 0xa5, 0xd7, 0x00, 0x3a, 0x94, 0x66, 0xc8, 0x3c, 0x59,
+	0x3c, 0x91, 0x5b, 0x50, 0x30, 0x6e, 0x8b, 0xbf, 0xe2, 0x47, 0x41, 0x28, 0x4a, 0x41, 0x2f, 0x33,
+	0xf4, 0xd5, 0x42, 0x9e, 0xeb, 0x89, 0xc3, 0x83, 0xcc, 0x29, 0xdf, 0x2d, 0xe3, 0x94, 0xf6, 0xe6,
+	0x57, 0x94, 0xb5, 0x55, 0xf9, 0x57, 0x02, 0x67, 0xba, 0xb2, 0x3a, 0x55, 0xdb, 0x72, 0x18, 0xd5,
+	0x60, 0x52, 0xc7, 0xb5, 0x19, 0x32, 0x7f, 0x6c, 0xf1, 0x44, 0x2e, 0xa9, 0xf8, 0x75, 0x51, 0x82,
+	0x92, 0x29, 0x1b, 0x96, 0x97, 0x9f, 0x6f, 0x1e, 0x64, 0xe6, 0x04, 0x47, 0xa4, 0x60, 0xc4, 0x4d,
+	0xad, 0x1d, 0x87, 0x5e, 0x8f, 0x50, 0x19, 0xe5, 0x54, 0x2e, 0x0c, 0xa5, 0xe2, 0x03, 0x1a, 0xc6,
+	0xe5, 0xaa, 0x6c, 0x80, 0x14, 0xa1, 0xb2, 0xe5, 0xd6, 0x98, 0x5e, 0x09, 0xca, 0x78, 0x1a, 0x8e,
+	0xed, 0x31, 0x8f, 0xd7, 0x6f, 0x4a, 0x6b, 0x7d, 0xd2, 0x73, 0x00, 0x86, 0xee, 0x6e, 0xef, 0x16,
+	0x1d, 0xf3, 0x01, 0xe3, 0x68, 0x4e, 0x6a, 0xc7, 0xf9, 0xca, 0x96, 0xf9, 0x80, 0x09, 0x72, 0x5c,
+	0xce, 0xc9, 0xdf, 0x12, 0x98, 0x15, 0x26, 0x79, 0x89, 0x55, 0x3b, 0x0b, 0x93, 0x16, 0xbb, 0xef,
+	0x16, 0x5b, 0xf0, 0x47, 0x39, 0xfc, 0x89, 0xd6, 0xef, 0x1b, 0xcc, 0x13, 0x63, 0xdc, 0x82, 0x44,
+	0x18, 0x62, 0x50, 0x80, 0x1c, 0x4c, 0xe8, 0xa5, 0x52, 0x8d, 0x39, 0x0e, 0x2f, 0xc2, 0xf1, 0xfc,
+	0xcc, 0xe1, 0x41, 0x26, 0x89, 0x18, 0x36, 0xfc, 0x9d, 0x2d, 0xb7, 0x66, 0x5a, 0x65, 0x2d, 0x30,
+	0x5c, 0x9f, 0x7c, 0xf8, 0x28, 0x3d, 0xf2, 0xdf, 0xa3, 0xf4, 0x88, 0xbc, 0x1b, 0xed, 0xce, 0x36,
+	0xe1, 0x02, 0x4c, 0x20, 0x50, 0x6c, 0xcd, 0xe7, 0xe5, 0x1b, 0x84, 0x91, 0x93, 0x40, 0x79, 0xa6,
+	0x82, 0x5e, 0xd3, 0x2b, 0xc1, 0x2d, 0x90, 0x0b, 0x90, 0x88, 0xac, 0x62, 0xfa, 0xb7, 0x60, 0xbc,
+	0xca, 0x57, 0x30, 0xfb, 0xac, 0x22, 0x4a, 0xe2, 0x3b, 0xe5, 0xc7, 0x1e, 0xff, 0x95, 0x1e, 0xd1,
+	0xd0, 0x41, 0xce, 0x62, 0xbb, 0xbc, 0x6b, 0x97, 0xea, 0x77, 0x59, 0xd7, 0xad, 0x13, 0x75, 0xd8,
+	0x15, 0xf9, 0x8b, 0xe0, 0xf4, 0xbb, 0x7d, 0x10, 0xcd, 0xed, 0x98, 0xa7, 0xbf, 0xd0, 0x3c, 0xc8,
+	0xc8, 0x22, 0xa0, 0x91, 0xb8, 0xa1, 0x1e, 0x10, 0xc3, 0xb9, 0x0c, 0xe9, 0x5e, 0x34, 0x79, 0xef,
+	0x3d, 0xbd, 0x12, 0x0c, 0x00, 0x4a, 0x61, 0xcc, 0xd2, 0x2b, 0xcc, 0x3f, 0x71, 0x8d, 0x7f, 0xcb,
+	0x0f, 0x60, 0xbe, 0xbf, 0x1b, 0x32, 0x79, 0x3f, 0xde, 0xb1, 0xc6, 0x25, 0xd2, 0x3e, 0xdc, 0x65,
+	0x48, 0xe4, 0xd9, 0xf6, 0xee, 0x6a, 0xae, 0x50, 0x63, 0x3b, 0xe6, 0xfd, 0x81, 0xd5, 0x2e, 0x40,
+	0x32, 0x6a, 0x8b, 0xd8, 0xce, 0xc3, 0x49, 0x83, 0xaf, 0x17, 0xab, 0x7c, 0x03, 0xc9, 0x4d, 0x19,
+	0x21, 0x63, 0x71, 0xc4, 0x0f, 0x60, 0x16, 0x1b, 0x3d, 0xef, 0xb9, 0xcc, 0xb9, 0x65, 0x63, 0xbf,
+	0x63, 0xb1, 0xce, 0xc3, 0x49, 0x6c, 0xfc, 0xa2, 0xd1, 0xda, 0xc7, 0x61, 0x31, 0xa5, 0x87, 0x7c,
+	0xc4, 0x81, 0xef, 0xc0, 0x9c, 0x38, 0x30, 0x42, 0x7e, 0x03, 0xa6, 0x83, 0xc8, 0x0e, 0xdf, 0x41,
+	0xcc, 0x41, 0x3e, 0xdf, 0x5c, 0x1c, 0xfb, 0xc3, 0x36, 0x68, 0xdf, 0xea, 0x96, 0xcd, 0x73, 0x04,
+	0xa0, 0x5f, 0x24, 0xf4, 0xed, 0x36, 0xec, 0xae, 0xd0, 0x9d, 0x4a, 0x3f, 0x67, 0x41, 0x3e, 0x86,
+	0x54, 0x78, 0x5c, 0xb4, 0x8b, 0xb3, 0xf9, 0x4e, 0xa7, 0x33, 0x47, 0xcd, 0x12, 0x0f, 0x78, 0x2c,
+	0x3f, 0x3a, 0x43, 0xb4, 0x51, 0xb3, 0x44, 0x73, 0x00, 0xd8, 0x28, 0x45, 0xb3, 0xc4, 0x67, 0xdd,
+	0x58, 0x3e, 0xf1, 0x67, 0xef, 0xa8, 0xd7, 0x8e, 0xa3, 0xd9, 0x66, 0x69, 0xfd, 0xcc, 0xe1, 0x41,
+	0xe6, 0xd5, 0xae, 0xf4, 0x4a, 0x4e, 0x6e, 0x40, 0xba, 0x2f, 0x00, 0x64, 0xb7, 0x01, 0xa7, 0x82,
+	0x6c, 0x71, 0x07, 0xe3, 0xb4, 0x1e, 0x09, 0xd7, 0x2f, 0xb9, 0x01, 0xaf, 0x85, 0x93, 0x6f, 0x5a,
+	0x3b, 0xf6, 0x8b, 0x4c, 0x61, 0xe1, 0x6b, 0xc7, 0x60, 0xa6, 0x37, 0x07, 0x32, 0x5b, 0x83, 0x31,
+	0xd3, 0xda, 0xb1, 0xf1, 0xea, 0xce, 0x0b, 0x67, 0x62, 0x5e, 0x77, 0x82, 0xfb, 0xa9, 0x71, 0x6b,
+	0x71, 0x9a, 0x46, 0xf4, 0xbd, 0xcb, 0x7b, 0x85, 0xba, 0x71, 0x83, 0x79, 0x01, 0x9d, 0xeb, 0x30,
+	0x51, 0xad, 0x1b, 0xc5, 0xe0, 0x65, 0xed, 0x37, 0x27, 0x66, 0x9a, 0x1d, 0x92, 0xdb, 0x35, 0xaf,
+	0xea, 0xda, 0x0a, 0xc6, 0x19, 0xaf, 0xf2, 0xbf, 0xe2, 0x97, 0xec, 0x33, 0x02, 0x73, 0xe2, 0xec,
+	0x2f, 0xef, 0xb9, 0x15, 0x22, 0xc9, 0xfd, 0x76, 0x1a, 0x5e, 0xe1, 0x48, 0xe8, 0x23, 0x02, 0x93,
+	0x1b, 0xc1, 0xd3, 0xbc, 0x24, 0x2c, 0xad, 0x48, 0xc6, 0x49, 0xcb, 0x71, 0x4c, 0x7d, 0x5a, 0xf2,
+	0xdb, 0xcd, 0x5e, 0xa9, 0xf6, 0xf0, 0xdf, 0xef, 0x96, 0xc9, 0x27, 0xbf, 0xff, 0xf3, 0xd5, 0x68,
+	0x9a, 0x9e, 0x53, 0x85, 0x1a, 0x34, 0x40, 0xf5, 0x39, 0x81, 0xe9, 0xa8, 0x3e, 0xa1, 0xea, 0xf0,
+	0xec, 0x11, 0xb9, 0x24, 0xad, 0xc4, 0x77, 0x40, 0xd0, 0x89, 0x66, 0x6f, 0xdd, 0x56, 0x08, 0xfd,
+	0x9a, 0xc0, 0x04, 0x7a, 0xd0, 0xc5, 0xa1, 0x41, 0x83, 0xf4, 0x4b, 0x31, 0x2c, 0x31, 0xef, 0x5a,
+	0xa7, 0x32, 0x4b, 0xf4, 0xc2, 0xc0, 0xca, 0xa8, 0x0d, 0xbc, 0x52, 0xfb, 0xf4, 0x90, 0x00, 0xed,
+	0x9d, 0x0d, 0x74, 0x75, 0x68, 0xde, 0xde, 0x51, 0x26, 0xad, 0x1d, 0xcd, 0x09, 0x71, 0xdf, 0x6c,
+	0x8a, 0x66, 0x47, 0x87, 0x4c, 0x96, 0xaa, 0x62, 0x32, 0xed, 0x89, 0x5c, 0x34, 0x4b, 0x6a, 0xa3,
+	0x33, 0x30, 0xf7, 0xe9, 0xa7, 0x04, 0xc6, 0x7d, 0xad, 0x43, 0x2f, 0xf4, 0xc7, 0x14, 0x11, 0x56,
+	0xd2, 0xe2, 0x70, 0x43, 0x04, 0xbc, 0xd8, 0xc1, 0x76, 0x8e, 0xce, 0x0a, 0xb1, 0xf9, 0xd2, 0x8a,
+	0xfe, 0x40, 0x60, 0x3a, 0x2a, 0x91, 0x06, 0x35, 0xa0, 0x50, 0x80, 0x49, 0x2b, 0xf1, 0x1d, 0x10,
+	0xdf, 0xb5, 0x21, 0x05, 0x5d, 0xa0, 0xaf, 0x0b, 0x41, 0x57, 0x78, 0xb8, 0x62, 0xfb, 0xfa, 0xfc,
+	0x48, 0x20, 0x21, 0xd0, 0x46, 0x74, 0x2d, 0x26, 0xa2, 0x88, 0x02, 0x93, 0x2e, 0x1f, 0xd1, 0x0b,
+	0xc9, 0xbc, 0xd9, 0xc1, 0x9d, 0xa1, 0x17, 0xe3, 0xe0, 0x56, 0x1b, 0x2d, 0x75, 0xb7, 0x4f, 0xbf,
+	0x21, 0x30, 0x15, 0xd6, 0x4d, 0x7d, 0x6e, 0x9d, 0x40, 0x86, 0x49, 0x4b, 0x31, 0x2c, 0x11, 0xdf,
+	0x95, 0xde, 0x11, 0x75, 0x65, 0x60, 0x6b, 0xf8, 0xea, 0x8c, 0xfe, 0x4c, 0x20, 0x29, 0x92, 0x4a,
+	0x54, 0x7c, 0xde, 0x03, 0xe4, 0x9a, 0x94, 0x3d, 0x82, 0x47, 0xa8, 0x45, 0x84, 0xa8, 0xfb, 0xd5,
+	0xd8, 0x47, 0xad, 0x36, 0x22, 0x32, 0x68, 0x9f, 0xfe, 0xd2, 0x61, 0x11, 0x51, 0x4e, 0x83, 0x59,
+	0x88, 0xf4, 0x9b, 0x94, 0x3d, 0x82, 0x07, 0xb2, 0xb8, 0xde, 0x8f, 0x85, 0x42, 0x2f, 0xc5, 0x62,
+	0xe1, 0x0b, 0xc5, 0x7d, 0xfa, 0x3d, 0x81, 0x13, 0x21, 0xfd, 0x40, 0x2f, 0x0d, 0x1d, 0x64, 0x21,
+	0x29, 0x23, 0x65, 0x62, 0x5a, 0x23, 0xea, 0x1b, 0xbd, 0xa8, 0xaf, 0x0e, 0x6f, 0xf2, 0xf6, 0x78,
+	0xb3, 0x76, 0xec, 0xd0, 0xf8, 0xfe, 0x89, 0xc0, 0xa9, 0x2e, 0x51, 0x40, 0x87, 0x3f, 0x59, 0x5d,
+	0xea, 0x45, 0xca, 0x1e, 0xc1, 0x03, 0x59, 0x6c, 0x0a, 0x5e, 0xb9, 0x0e, 0x8b, 0x8b, 0xf2, 0xc2,
+	0x40, 0x16, 0x86, 0x57, 0xac, 0xd6, 0x8d, 0x3d, 0xe6, 0xad, 0x93, 0xe5, 0xfc, 0xea, 0xe3, 0xa7,
+	0x29, 0xf2, 0xe4, 0x69, 0x8a, 0xfc, 0xfd, 0x34, 0x45, 0xbe, 0x7c, 0x96, 0x1a, 0x79, 0xf2, 0x2c,
+	0x35, 0xf2, 0xc7, 0xb3, 0xd4, 0xc8, 0x1d, 0xfc, 0x17, 0x92, 0x53, 0xda, 0x53, 0x4c, 0x5b, 0xbd,
+	0xef, 0xc7, 0x72, 0xbd, 0x2a, 0x73, 0x8c, 0x71, 0xae, 0x6b, 0x56, 0xff, 0x1f, 0x00, 0x21, 0x5e,
+	0x3a, 0xd4, 0x37, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1049,6 +1272,9 @@ type QueryClient interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error)
+	// AccountsStream streams all the existing accounts in batches, without the
+	// round-trips of the paginated Accounts query.
+	AccountsStream(ctx context.Context, in *QueryAccountsStreamRequest, opts ...grpc.CallOption) (Query_AccountsStreamClient, error)
 	// Account returns account details based on address.
 	Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error)
 	// AccountAddressByID returns account address based on account number.
@@ -1067,6 +1293,8 @@ type QueryClient interface {
 	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// AccountByPubKey returns the accounts with the given public key.
+	AccountByPubKey(ctx context.Context, in *QueryAccountByPubKeyRequest, opts ...grpc.CallOption) (*QueryAccountByPubKeyResponse, error)
 }
 
 type queryClient struct {
@@ -1086,6 +1314,38 @@ func (c *queryClient) Accounts(ctx context.Context, in *QueryAccountsRequest, op
 	return out, nil
 }
 
+func (c *queryClient) AccountsStream(ctx context.Context, in *QueryAccountsStreamRequest, opts ...grpc.CallOption) (Query_AccountsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/cosmos.auth.v1beta1.Query/AccountsStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryAccountsStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_AccountsStreamClient interface {
+	Recv() (*QueryAccountsStreamResponse, error)
+	grpc.ClientStream
+}
+
+type queryAccountsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryAccountsStreamClient) Recv() (*QueryAccountsStreamResponse, error) {
+	m := new(QueryAccountsStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queryClient) Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error) {
 	out := new(QueryAccountResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/Account", in, out, opts...)
@@ -1167,12 +1427,24 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) AccountByPubKey(ctx context.Context, in *QueryAccountByPubKeyRequest, opts ...grpc.CallOption) (*QueryAccountByPubKeyResponse, error) {
+	out := new(QueryAccountByPubKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/AccountByPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts.
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	Accounts(context.Context, *QueryAccountsRequest) (*QueryAccountsResponse, error)
+	// AccountsStream streams all the existing accounts in batches, without the
+	// round-trips of the paginated Accounts query.
+	AccountsStream(*QueryAccountsStreamRequest, Query_AccountsStreamServer) error
 	// Account returns account details based on address.
 	Account(context.Context, *QueryAccountRequest) (*QueryAccountResponse, error)
 	// AccountAddressByID returns account address based on account number.
@@ -1191,6 +1463,8 @@ type QueryServer interface {
 	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// AccountByPubKey returns the accounts with the given public key.
+	AccountByPubKey(context.Context, *QueryAccountByPubKeyRequest) (*QueryAccountByPubKeyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1200,6 +1474,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccountsRequest) (*QueryAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
 }
+func (*UnimplementedQueryServer) AccountsStream(req *QueryAccountsStreamRequest, srv Query_AccountsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method AccountsStream not implemented")
+}
 func (*UnimplementedQueryServer) Account(ctx context.Context, req *QueryAccountRequest) (*QueryAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
 }
@@ -1227,6 +1504,9 @@ func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *
 func (*UnimplementedQueryServer) AccountInfo(ctx context.Context, req *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (*UnimplementedQueryServer) AccountByPubKey(ctx context.Context, req *QueryAccountByPubKeyRequest) (*QueryAccountByPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountByPubKey not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1250,6 +1530,27 @@ func _Query_Accounts_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryAccountsStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).AccountsStream(m, &queryAccountsStreamServer{stream})
+}
+
+type Query_AccountsStreamServer interface {
+	Send(*QueryAccountsStreamResponse) error
+	grpc.ServerStream
+}
+
+type queryAccountsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryAccountsStreamServer) Send(m *QueryAccountsStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Query_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAccountRequest)
 	if err := dec(in); err != nil {
@@ -1412,6 +1713,24 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountByPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountByPubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountByPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/AccountByPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountByPubKey(ctx, req.(*QueryAccountByPubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1456,8 +1775,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "AccountByPubKey",
+			Handler:    _Query_AccountByPubKey_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AccountsStream",
+			Handler:       _Query_AccountsStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
 }
 
@@ -1545,7 +1874,7 @@ func (m *QueryAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAccountsStreamRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1555,27 +1884,32 @@ func (m *QueryAccountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAccountsStreamRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAccountsStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if m.BatchSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAccountsStreamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1585,32 +1919,41 @@ func (m *QueryAccountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAccountsStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAccountsStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Account != nil {
-		{
-			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.NextKey) > 0 {
+		i -= len(m.NextKey)
+		copy(dAtA[i:], m.NextKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NextKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1620,20 +1963,27 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1643,7 +1993,65 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -2092,6 +2500,78 @@ func (m *QueryAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAccountByPubKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountByPubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountByPubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountByPubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountByPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountByPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2135,6 +2615,41 @@ func (m *QueryAccountsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAccountsStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovQuery(uint64(m.BatchSize))
+	}
+	return n
+}
+
+func (m *QueryAccountsStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryAccountRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2343,29 +2858,263 @@ func (m *QueryAccountInfoRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryAccountInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountByPubKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountByPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &any.Any{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryAccountInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountsStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2388,17 +3137,17 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountsStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountsStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2408,28 +3157,45 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2451,7 +3217,7 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountsStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2474,10 +3240,10 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountsStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountsStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2516,9 +3282,9 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2528,26 +3294,24 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3976,6 +4740,176 @@ func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAccountByPubKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountByPubKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountByPubKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &any.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountByPubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountByPubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountByPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &any.Any{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -63,6 +63,7 @@ func (s *VestingAccountTestSuite) SetupTest() {
 
 	s.accountKeeper = keeper.NewAccountKeeper(
 		env,
+		nil,
 		encCfg.Codec,
 		authtypes.ProtoBaseAccount,
 		acctsModKeeper,
@@ -3,6 +3,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -47,6 +48,8 @@ func GetTxCmd() *cobra.Command {
 	authorizationTxCmd.AddCommand(
 		NewCmdGrantAuthorization(),
 		NewCmdExecAuthorization(),
+		NewCmdGrantBatch(),
+		NewCmdRevokeBatch(),
 	)
 
 	return authorizationTxCmd
@@ -256,6 +259,109 @@ Examples:
 	return cmd
 }
 
+// NewCmdGrantBatch returns a CLI command handler for creating a MsgGrantBatch transaction.
+func NewCmdGrantBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-batch [grants-json-file] --from [granter]",
+		Short: "Grant several authorizations at once",
+		Long: `Grant several authorizations to grantees on behalf of the granter in a single transaction.
+The grants are processed atomically: if one of them fails, none of them is saved.`,
+		Example: fmt.Sprintf(`$ %s tx authz grant-batch grants.json --from=cosmos1skj..
+
+Where grants.json contains:
+
+{
+  "grants": [
+    {
+      "grantee": "cosmos1...",
+      "grant": {
+        "authorization": {
+          "@type": "/cosmos.bank.v1beta1.SendAuthorization",
+          "spend_limit": [{"denom": "stake", "amount": "100"}]
+        },
+        "expiration": "2030-01-01T00:00:00Z"
+      }
+    }
+  ]
+}`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var msg authz.MsgGrantBatch
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &msg); err != nil {
+				return fmt.Errorf("failed to parse grants: %w", err)
+			}
+
+			msg.Granter, err = clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdRevokeBatch returns a CLI command handler for creating a MsgRevokeBatch transaction.
+func NewCmdRevokeBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-batch [revokes-json-file] --from [granter]",
+		Short: "Revoke several authorizations at once",
+		Long: `Revoke several authorizations granted to grantees by the granter in a single transaction.
+The revocations are processed atomically: if one of them fails, none of them is applied.`,
+		Example: fmt.Sprintf(`$ %s tx authz revoke-batch revokes.json --from=cosmos1skj..
+
+Where revokes.json contains:
+
+{
+  "revokes": [
+    {"grantee": "cosmos1...", "msg_type_url": "%s"}
+  ]
+}`, version.AppName, bank.SendAuthorization{}.MsgTypeURL()),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var msg authz.MsgRevokeBatch
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &msg); err != nil {
+				return fmt.Errorf("failed to parse revokes: %w", err)
+			}
+
+			msg.Granter, err = clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	exp, err := cmd.Flags().GetInt64(FlagExpiration)
 	if err != nil {
@@ -17,6 +17,8 @@ func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	legacy.RegisterAminoMsg(cdc, &MsgGrant{}, "cosmos-sdk/MsgGrant")
 	legacy.RegisterAminoMsg(cdc, &MsgRevoke{}, "cosmos-sdk/MsgRevoke")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "cosmos-sdk/MsgExec")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantBatch{}, "cosmos-sdk/MsgGrantBatch")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeBatch{}, "cosmos-sdk/MsgRevokeBatch")
 
 	cdc.RegisterInterface((*Authorization)(nil), nil)
 	cdc.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization")
@@ -28,6 +30,8 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgGrant{},
 		&MsgRevoke{},
 		&MsgExec{},
+		&MsgGrantBatch{},
+		&MsgRevokeBatch{},
 	)
 
 	// since bank.SendAuthorization and staking.StakeAuthorization both implement Authorization
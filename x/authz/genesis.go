@@ -24,6 +24,21 @@ func ValidateGenesis(data GenesisState) error {
 		}
 
 	}
+
+	for i, u := range data.GrantUsages {
+		if u.Grantee == "" {
+			return fmt.Errorf("grant usage: %d, missing grantee", i)
+		}
+		if u.Granter == "" {
+			return fmt.Errorf("grant usage: %d, missing granter", i)
+		}
+		if u.MsgTypeUrl == "" {
+			return fmt.Errorf("grant usage: %d, missing msg type url", i)
+		}
+		if err := u.Usage.Spent.Validate(); err != nil {
+			return fmt.Errorf("grant usage: %d, invalid spent amount: %w", i, err)
+		}
+	}
 	return nil
 }
 
@@ -27,6 +27,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the authz module's genesis state.
 type GenesisState struct {
 	Authorization []GrantAuthorization `protobuf:"bytes,1,rep,name=authorization,proto3" json:"authorization"`
+	// grant_usages defines the usage accounting of the grants.
+	GrantUsages []GrantUsageRecord `protobuf:"bytes,2,rep,name=grant_usages,json=grantUsages,proto3" json:"grant_usages"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +71,13 @@ func (m *GenesisState) GetAuthorization() []GrantAuthorization {
 	return nil
 }
 
+func (m *GenesisState) GetGrantUsages() []GrantUsageRecord {
+	if m != nil {
+		return m.GrantUsages
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.authz.v1beta1.GenesisState")
 }
@@ -78,21 +87,23 @@ func init() {
 }
 
 var fileDescriptor_4c2fbb971da7c892 = []byte{
-	// 211 bytes of a gzipped FileDescriptorProto
+	// 246 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
 	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
 	0x81, 0xa8, 0xd1, 0x03, 0xab, 0xd1, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
 	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x14, 0xb0, 0x9a, 0x07, 0xd1, 0x09, 0x51, 0x21, 0x98, 0x98,
-	0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x42, 0x4a, 0x99, 0x5c, 0x3c, 0xee, 0x10, 0x1b, 0x83,
-	0x4b, 0x12, 0x4b, 0x52, 0x85, 0x22, 0xb9, 0x78, 0x41, 0x3a, 0xf2, 0x8b, 0x32, 0xab, 0x12, 0x4b,
-	0x32, 0xf3, 0xf3, 0x24, 0x18, 0x15, 0x98, 0x35, 0xb8, 0x8d, 0x34, 0xf4, 0xb0, 0x39, 0x44, 0xcf,
-	0xbd, 0x28, 0x31, 0xaf, 0xc4, 0x11, 0x59, 0xbd, 0x13, 0xe7, 0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e,
-	0x6f, 0xd0, 0x62, 0x0c, 0x42, 0x35, 0xc9, 0x49, 0xef, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4,
-	0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f,
-	0xe5, 0x18, 0xa2, 0xa0, 0xbe, 0x2c, 0x4e, 0xc9, 0xd6, 0xcb, 0xcc, 0xd7, 0xaf, 0x80, 0xb8, 0x39,
-	0x89, 0x0d, 0xec, 0x42, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xad, 0xcf, 0xc0, 0x1c, 0x28,
-	0x01, 0x00, 0x00,
+	0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x42, 0x4a, 0xfb, 0x19, 0xb9, 0x78, 0xdc, 0x21, 0x56,
+	0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x45, 0x72, 0xf1, 0x82, 0xb4, 0xe4, 0x17, 0x65, 0x56, 0x25,
+	0x96, 0x64, 0xe6, 0xe7, 0x49, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x1b, 0x69, 0xe8, 0x61, 0x73, 0x89,
+	0x9e, 0x7b, 0x51, 0x62, 0x5e, 0x89, 0x23, 0xb2, 0x7a, 0x27, 0xce, 0x13, 0xf7, 0xe4, 0x19, 0x56,
+	0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x84, 0x6a, 0x92, 0x50, 0x08, 0x17, 0x4f, 0x3a, 0x48, 0x7d, 0x7c,
+	0x69, 0x71, 0x62, 0x7a, 0x6a, 0xb1, 0x04, 0x13, 0xd8, 0x64, 0x35, 0x3c, 0x26, 0x87, 0x82, 0x14,
+	0x06, 0xa5, 0x26, 0xe7, 0x17, 0xa5, 0x20, 0x9b, 0xcb, 0x9d, 0x0e, 0x97, 0x2c, 0x76, 0xd2, 0x3b,
+	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
+	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x68, 0xe0, 0x15, 0xa7, 0x64, 0xeb,
+	0x65, 0xe6, 0xeb, 0x57, 0x40, 0x82, 0x22, 0x89, 0x0d, 0xec, 0x71, 0x63, 0xc0, 0x00, 0x4a, 0x6a,
+	0xd5, 0x57, 0x7f, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -115,6 +126,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GrantUsages) > 0 {
+		for iNdEx := len(m.GrantUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GrantUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Authorization) > 0 {
 		for iNdEx := len(m.Authorization) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -155,6 +180,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.GrantUsages) > 0 {
+		for _, e := range m.GrantUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -227,6 +258,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantUsages = append(m.GrantUsages, GrantUsageRecord{})
+			if err := m.GrantUsages[len(m.GrantUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -37,6 +37,27 @@ func (k Keeper) InitGenesis(ctx context.Context, data *authz.GenesisState) error
 			return err
 		}
 	}
+
+	for _, entry := range data.GrantUsages {
+		grantee, err := k.authKeeper.AddressCodec().StringToBytes(entry.Grantee)
+		if err != nil {
+			return err
+		}
+		granter, err := k.authKeeper.AddressCodec().StringToBytes(entry.Granter)
+		if err != nil {
+			return err
+		}
+
+		// ignore the usage of grants that were not imported, e.g. expired ones
+		if _, found := k.getGrant(ctx, grantStoreKey(grantee, granter, entry.MsgTypeUrl)); !found {
+			continue
+		}
+
+		err = k.setGrantUsage(ctx, grantee, granter, entry.MsgTypeUrl, entry.Usage)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -63,5 +84,30 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*authz.GenesisState, error)
 	if err != nil {
 		return nil, err
 	}
-	return authz.NewGenesisState(entries), nil
+
+	var usages []authz.GrantUsageRecord
+	err = k.IterateGrantUsages(ctx, func(granter, grantee sdk.AccAddress, msgType string, usage authz.GrantUsage) (bool, error) {
+		granterAddr, err := k.authKeeper.AddressCodec().BytesToString(granter)
+		if err != nil {
+			return false, err
+		}
+		granteeAddr, err := k.authKeeper.AddressCodec().BytesToString(grantee)
+		if err != nil {
+			return false, err
+		}
+		usages = append(usages, authz.GrantUsageRecord{
+			Granter:    granterAddr,
+			Grantee:    granteeAddr,
+			MsgTypeUrl: msgType,
+			Usage:      usage,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genState := authz.NewGenesisState(entries)
+	genState.GrantUsages = usages
+	return genState, nil
 }
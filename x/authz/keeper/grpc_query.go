@@ -192,3 +192,37 @@ func (k Keeper) GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrants
 		Pagination: pageRes,
 	}, nil
 }
+
+// GrantUsage implements the Query/GrantUsage gRPC method.
+func (k Keeper) GrantUsage(ctx context.Context, req *authz.QueryGrantUsageRequest) (*authz.QueryGrantUsageResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.MsgTypeUrl == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty msg type url")
+	}
+
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := k.authKeeper.AddressCodec().StringToBytes(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.getGrant(ctx, grantStoreKey(grantee, granter, req.MsgTypeUrl)); !found {
+		return nil, errors.Wrapf(authz.ErrNoAuthorizationFound, "authorization not found for %s type", req.MsgTypeUrl)
+	}
+
+	usage, err := k.GetGrantUsage(ctx, grantee, granter, req.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.QueryGrantUsageResponse{
+		Usage: &usage,
+	}, nil
+}
@@ -309,3 +309,49 @@ func (suite *TestSuite) createSendAuthorizationWithAllowList(grantee, granter sd
 	suite.Require().NoError(err)
 	return authorization
 }
+
+func (suite *TestSuite) TestGRPCQueryGrantUsage() {
+	require := suite.Require()
+	queryClient, addrs := suite.queryClient, suite.addrs
+
+	granterAddr, granteeAddr := addrs[0], addrs[1]
+	granter, err := suite.accountKeeper.AddressCodec().BytesToString(granterAddr)
+	require.NoError(err)
+	grantee, err := suite.accountKeeper.AddressCodec().BytesToString(granteeAddr)
+	require.NoError(err)
+	recipient, err := suite.accountKeeper.AddressCodec().BytesToString(addrs[2])
+	require.NoError(err)
+
+	req := &authz.QueryGrantUsageRequest{Granter: granter, Grantee: grantee, MsgTypeUrl: bankSendAuthMsgType}
+
+	_, err = queryClient.GrantUsage(gocontext.Background(), req)
+	require.ErrorContains(err, "authorization not found")
+
+	expiration := suite.ctx.HeaderInfo().Time.AddDate(0, 1, 0)
+	a := banktypes.NewSendAuthorization(coins100, nil, suite.accountKeeper.AddressCodec())
+	require.NoError(suite.authzKeeper.SaveGrant(suite.ctx, granteeAddr, granterAddr, a, &expiration))
+
+	res, err := queryClient.GrantUsage(gocontext.Background(), req)
+	require.NoError(err)
+	require.Zero(res.Usage.ExecCount)
+	require.True(res.Usage.Spent.IsZero())
+	require.Nil(res.Usage.LastExecTime)
+
+	msg := &banktypes.MsgSend{Amount: coins10, FromAddress: granter, ToAddress: recipient}
+	for i := 0; i < 2; i++ {
+		_, err = suite.authzKeeper.DispatchActions(suite.ctx, granteeAddr, []sdk.Msg{msg})
+		require.NoError(err)
+	}
+
+	res, err = queryClient.GrantUsage(gocontext.Background(), req)
+	require.NoError(err)
+	require.Equal(uint64(2), res.Usage.ExecCount)
+	require.Equal(coins10.Add(coins10...), res.Usage.Spent)
+	require.Equal(suite.ctx.HeaderInfo().Time, *res.Usage.LastExecTime)
+
+	// the usage accounting is removed along with the grant
+	require.NoError(suite.authzKeeper.DeleteGrant(suite.ctx, granteeAddr, granterAddr, bankSendAuthMsgType))
+	usage, err := suite.authzKeeper.GetGrantUsage(suite.ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	require.NoError(err)
+	require.Zero(usage.ExecCount)
+}
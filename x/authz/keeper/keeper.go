@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"reflect"
 	"time"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
@@ -80,6 +81,80 @@ func (k Keeper) update(ctx context.Context, grantee, granter sdk.AccAddress, upd
 	return store.Set(skey, k.cdc.MustMarshal(&grant))
 }
 
+// GetGrantUsage returns the usage accounting of the grant for the provided
+// message type granted to the grantee by the granter. A zero usage is returned
+// if the grant has never been exercised.
+func (k Keeper) GetGrantUsage(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) (authz.GrantUsage, error) {
+	var usage authz.GrantUsage
+	store := k.KVStoreService.OpenKVStore(ctx)
+	bz, err := store.Get(grantUsageStoreKey(grantee, granter, msgType))
+	if err != nil || bz == nil {
+		return usage, err
+	}
+
+	err = k.cdc.Unmarshal(bz, &usage)
+	return usage, err
+}
+
+func (k Keeper) setGrantUsage(ctx context.Context, grantee, granter sdk.AccAddress, msgType string, usage authz.GrantUsage) error {
+	bz, err := k.cdc.Marshal(&usage)
+	if err != nil {
+		return err
+	}
+
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return store.Set(grantUsageStoreKey(grantee, granter, msgType), bz)
+}
+
+// recordGrantUsage accounts an execution of msg through the grant of the
+// granter to the grantee.
+func (k Keeper) recordGrantUsage(ctx context.Context, grantee, granter sdk.AccAddress, msg sdk.Msg, now time.Time) error {
+	msgType := sdk.MsgTypeURL(msg)
+	usage, err := k.GetGrantUsage(ctx, grantee, granter, msgType)
+	if err != nil {
+		return err
+	}
+
+	usage.ExecCount++
+	usage.Spent = usage.Spent.Add(msgAmount(msg)...)
+	usage.LastExecTime = &now
+
+	return k.setGrantUsage(ctx, grantee, granter, msgType, usage)
+}
+
+// msgAmount returns the coins carried by the `Amount` field of msg, if any,
+// e.g. the amount of a MsgSend or of a MsgDelegate.
+func msgAmount(msg sdk.Msg) sdk.Coins {
+	v := reflect.Indirect(reflect.ValueOf(msg))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	field := v.FieldByName("Amount")
+	if !field.IsValid() || !field.CanInterface() {
+		return nil
+	}
+
+	switch amount := field.Interface().(type) {
+	case sdk.Coins:
+		return sdk.NewCoins(amount...)
+	case []sdk.Coin:
+		return sdk.NewCoins(amount...)
+	case sdk.Coin:
+		if amount.IsNil() {
+			return nil
+		}
+		return sdk.NewCoins(amount)
+	case *sdk.Coin:
+		if amount == nil || amount.IsNil() {
+			return nil
+		}
+		return sdk.NewCoins(*amount)
+	default:
+		return nil
+	}
+}
+
 // DispatchActions attempts to execute the provided messages via authorization
 // grants from the message signer to the grantee.
 func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
@@ -142,6 +217,13 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 			if !resp.Accept {
 				return nil, sdkerrors.ErrUnauthorized
 			}
+
+			// the usage accounting of a deleted grant is removed along with it
+			if !resp.Delete {
+				if err := k.recordGrantUsage(ctx, grantee, granter, msg, now); err != nil {
+					return nil, err
+				}
+			}
 		}
 
 		// no need to use the branch service here, as if the transaction fails, the transaction will be reverted
@@ -244,6 +326,11 @@ func (k Keeper) DeleteGrant(ctx context.Context, grantee, granter sdk.AccAddress
 		return err
 	}
 
+	err = k.deleteGrantUsage(ctx, grantee, granter, msgType)
+	if err != nil {
+		return err
+	}
+
 	granterAddr, err := k.authKeeper.AddressCodec().BytesToString(granter)
 	if err != nil {
 		return err
@@ -259,6 +346,36 @@ func (k Keeper) DeleteGrant(ctx context.Context, grantee, granter sdk.AccAddress
 	})
 }
 
+func (k Keeper) deleteGrantUsage(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) error {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return store.Delete(grantUsageStoreKey(grantee, granter, msgType))
+}
+
+// IterateGrantUsages iterates over the usage accounting of all grants.
+// The iteration stops when the handler function returns true or the iterator exhaust.
+func (k Keeper) IterateGrantUsages(ctx context.Context,
+	handler func(granterAddr, granteeAddr sdk.AccAddress, msgType string, usage authz.GrantUsage) (bool, error),
+) error {
+	store := runtime.KVStoreAdapter(k.KVStoreService.OpenKVStore(ctx))
+	iter := storetypes.KVStorePrefixIterator(store, GrantUsageKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var usage authz.GrantUsage
+		granterAddr, granteeAddr, msgType := parseGrantStoreKey(iter.Key())
+		if err := k.cdc.Unmarshal(iter.Value(), &usage); err != nil {
+			return err
+		}
+		ok, err := handler(granterAddr, granteeAddr, msgType, usage)
+		if err != nil {
+			return err
+		}
+		if ok {
+			break
+		}
+	}
+	return nil
+}
+
 // DeleteAllGrants revokes all authorizations granted to the grantee by the granter.
 func (k Keeper) DeleteAllGrants(ctx context.Context, granter sdk.AccAddress) error {
 	var keysToDelete [][]byte
@@ -490,6 +607,11 @@ func (k Keeper) DequeueAndDeleteExpiredGrants(ctx context.Context, limit int) er
 			if err != nil {
 				return err
 			}
+
+			err = store.Delete(grantUsageStoreKey(grantee, granter, typeURL))
+			if err != nil {
+				return err
+			}
 		}
 
 		// limit the amount of iterations to avoid taking too much time
@@ -16,9 +16,11 @@ import (
 //
 // - 0x01<grant_Bytes>: Grant
 // - 0x02<grant_expiration_Bytes>: GrantQueueItem
+// - 0x03<grant_Bytes>: GrantUsage
 var (
 	GrantKey         = []byte{0x01} // prefix for each key
 	GrantQueuePrefix = []byte{0x02}
+	GrantUsageKey    = []byte{0x03}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -39,6 +41,19 @@ func grantStoreKey(grantee, granter sdk.AccAddress, msgType string) []byte {
 	return key
 }
 
+// grantUsageStoreKey - return grant usage store key
+// Items are stored with the following key: values
+//
+// - 0x03<granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>: GrantUsage
+func grantUsageStoreKey(grantee, granter sdk.AccAddress, msgType string) []byte {
+	m := conv.UnsafeStrToBytes(msgType)
+	granter = address.MustLengthPrefix(granter)
+	grantee = address.MustLengthPrefix(grantee)
+	key := sdk.AppendLengthPrefixedBytes(GrantUsageKey, granter, grantee, m)
+
+	return key
+}
+
 func granterStoreKey(granter sdk.AccAddress) []byte {
 	granter = address.MustLengthPrefix(granter)
 	key := sdk.AppendLengthPrefixedBytes(GrantKey, granter)
@@ -16,73 +16,85 @@ var _ authz.MsgServer = Keeper{}
 
 // Grant implements the MsgServer.Grant method to create a new grant.
 func (k Keeper) Grant(ctx context.Context, msg *authz.MsgGrant) (*authz.MsgGrantResponse, error) {
-	if strings.EqualFold(msg.Grantee, msg.Granter) {
-		return nil, authz.ErrGranteeIsGranter
+	if err := k.grant(ctx, msg.Granter, msg.Grantee, msg.Grant); err != nil {
+		return nil, err
 	}
 
-	grantee, err := k.authKeeper.AddressCodec().StringToBytes(msg.Grantee)
+	return &authz.MsgGrantResponse{}, nil
+}
+
+// grant validates and saves the grant of the granter to the grantee.
+func (k Keeper) grant(ctx context.Context, granterStr, granteeStr string, grant authz.Grant) error {
+	if strings.EqualFold(granteeStr, granterStr) {
+		return authz.ErrGranteeIsGranter
+	}
+
+	grantee, err := k.authKeeper.AddressCodec().StringToBytes(granteeStr)
 	if err != nil {
-		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
 	}
 
-	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(granterStr)
 	if err != nil {
-		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
 	}
 
-	if err := msg.Grant.ValidateBasic(); err != nil {
-		return nil, err
+	if err := grant.ValidateBasic(); err != nil {
+		return err
 	}
 
-	authorization, err := msg.GetAuthorization()
+	authorization, err := grant.GetAuthorization()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	t := authorization.MsgTypeURL()
 	if err := k.MsgRouterService.CanInvoke(ctx, t); err != nil {
-		return nil, sdkerrors.ErrInvalidType.Wrapf("%s doesn't exist", t)
+		return sdkerrors.ErrInvalidType.Wrapf("%s doesn't exist", t)
 	}
 
 	// Disable granting other accounts with grant permission.
 	// Preventing user from accidentally authorizing their entire account to a different account.
 	if t == sdk.MsgTypeURL(&authz.MsgGrant{}) {
-		return nil, sdkerrors.ErrInvalidType.Wrap("authz msgGrant is not allowed")
+		return sdkerrors.ErrInvalidType.Wrap("authz msgGrant is not allowed")
 	}
-
-	err = k.SaveGrant(ctx, grantee, granter, authorization, msg.Grant.Expiration)
-	if err != nil {
-		return nil, err
+	if t == sdk.MsgTypeURL(&authz.MsgGrantBatch{}) {
+		return sdkerrors.ErrInvalidType.Wrap("authz msgGrantBatch is not allowed")
 	}
 
-	return &authz.MsgGrantResponse{}, nil
+	return k.SaveGrant(ctx, grantee, granter, authorization, grant.Expiration)
 }
 
 // Revoke implements the MsgServer.Revoke method.
 func (k Keeper) Revoke(ctx context.Context, msg *authz.MsgRevoke) (*authz.MsgRevokeResponse, error) {
-	if strings.EqualFold(msg.Grantee, msg.Granter) {
-		return nil, authz.ErrGranteeIsGranter
+	if err := k.revoke(ctx, msg.Granter, msg.Grantee, msg.MsgTypeUrl); err != nil {
+		return nil, err
 	}
 
-	grantee, err := k.authKeeper.AddressCodec().StringToBytes(msg.Grantee)
-	if err != nil {
-		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	return &authz.MsgRevokeResponse{}, nil
+}
+
+// revoke deletes the grant of the granter to the grantee for the msg type.
+func (k Keeper) revoke(ctx context.Context, granterStr, granteeStr, msgTypeURL string) error {
+	if strings.EqualFold(granteeStr, granterStr) {
+		return authz.ErrGranteeIsGranter
 	}
 
-	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	grantee, err := k.authKeeper.AddressCodec().StringToBytes(granteeStr)
 	if err != nil {
-		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
 	}
 
-	if msg.MsgTypeUrl == "" {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("missing msg method name")
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(granterStr)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
 	}
 
-	if err = k.DeleteGrant(ctx, grantee, granter, msg.MsgTypeUrl); err != nil {
-		return nil, err
+	if msgTypeURL == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("missing msg method name")
 	}
 
-	return &authz.MsgRevokeResponse{}, nil
+	return k.DeleteGrant(ctx, grantee, granter, msgTypeURL)
 }
 
 // RevokeAll implements the MsgServer.RevokeAll method.
@@ -131,6 +143,48 @@ func (k Keeper) Exec(ctx context.Context, msg *authz.MsgExec) (*authz.MsgExecRes
 	return &authz.MsgExecResponse{Results: results}, nil
 }
 
+// GrantBatch implements the MsgServer.GrantBatch method. The grants are
+// processed in order and the whole batch fails if one of them fails.
+func (k Keeper) GrantBatch(ctx context.Context, msg *authz.MsgGrantBatch) (*authz.MsgGrantBatchResponse, error) {
+	if len(msg.Grants) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("grants cannot be empty")
+	}
+
+	if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		for i, entry := range msg.Grants {
+			if err := k.grant(ctx, msg.Granter, entry.Grantee, entry.Grant); err != nil {
+				return errorsmod.Wrapf(err, "grant %d", i)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgGrantBatchResponse{}, nil
+}
+
+// RevokeBatch implements the MsgServer.RevokeBatch method. The revocations are
+// processed in order and the whole batch fails if one of them fails.
+func (k Keeper) RevokeBatch(ctx context.Context, msg *authz.MsgRevokeBatch) (*authz.MsgRevokeBatchResponse, error) {
+	if len(msg.Revokes) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("revokes cannot be empty")
+	}
+
+	if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		for i, entry := range msg.Revokes {
+			if err := k.revoke(ctx, msg.Granter, entry.Grantee, entry.MsgTypeUrl); err != nil {
+				return errorsmod.Wrapf(err, "revoke %d", i)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgRevokeBatchResponse{}, nil
+}
+
 func (k Keeper) PruneExpiredGrants(ctx context.Context, msg *authz.MsgPruneExpiredGrants) (*authz.MsgPruneExpiredGrantsResponse, error) {
 	// 75 is an arbitrary value, we can change it later if needed
 	if err := k.DequeueAndDeleteExpiredGrants(ctx, 75); err != nil {
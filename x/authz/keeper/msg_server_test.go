@@ -513,3 +513,80 @@ func (suite *TestSuite) TestRevokeAllGrants() {
 		})
 	}
 }
+
+func (suite *TestSuite) TestGrantBatch() {
+	require := suite.Require()
+	addrs := suite.addrs
+	expiration := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+
+	granter, err := suite.accountKeeper.AddressCodec().BytesToString(addrs[0])
+	require.NoError(err)
+	grantee1, err := suite.accountKeeper.AddressCodec().BytesToString(addrs[1])
+	require.NoError(err)
+	grantee2, err := suite.accountKeeper.AddressCodec().BytesToString(addrs[2])
+	require.NoError(err)
+
+	sendAuthz := banktypes.NewSendAuthorization(coins100, nil, suite.accountKeeper.AddressCodec())
+	entry1, err := authz.NewGrantBatchEntry(grantee1, sendAuthz, &expiration)
+	require.NoError(err)
+	entry2, err := authz.NewGrantBatchEntry(grantee2, sendAuthz, &expiration)
+	require.NoError(err)
+	invalidEntry, err := authz.NewGrantBatchEntry(granter, sendAuthz, &expiration)
+	require.NoError(err)
+
+	_, err = suite.msgSrvr.GrantBatch(suite.ctx, authz.NewMsgGrantBatch(granter, nil))
+	require.ErrorContains(err, "grants cannot be empty")
+
+	// the batch is atomic: no grant is saved if one of them fails
+	_, err = suite.msgSrvr.GrantBatch(suite.ctx, authz.NewMsgGrantBatch(granter, []authz.GrantBatchEntry{entry1, invalidEntry}))
+	require.ErrorContains(err, "grant 1")
+	authorization, _ := suite.authzKeeper.GetAuthorization(suite.ctx, addrs[1], addrs[0], bankSendAuthMsgType)
+	require.Nil(authorization)
+
+	_, err = suite.msgSrvr.GrantBatch(suite.ctx, authz.NewMsgGrantBatch(granter, []authz.GrantBatchEntry{entry1, entry2}))
+	require.NoError(err)
+	for _, grantee := range addrs[1:3] {
+		authorization, exp := suite.authzKeeper.GetAuthorization(suite.ctx, grantee, addrs[0], bankSendAuthMsgType)
+		require.NotNil(authorization)
+		require.Equal(expiration, *exp)
+	}
+}
+
+func (suite *TestSuite) TestRevokeBatch() {
+	require := suite.Require()
+	addrs := suite.addrs
+
+	granter, err := suite.accountKeeper.AddressCodec().BytesToString(addrs[0])
+	require.NoError(err)
+	grantee1, err := suite.accountKeeper.AddressCodec().BytesToString(addrs[1])
+	require.NoError(err)
+	grantee2, err := suite.accountKeeper.AddressCodec().BytesToString(addrs[2])
+	require.NoError(err)
+
+	suite.createSendAuthorization(addrs[1], addrs[0])
+	suite.createSendAuthorization(addrs[2], addrs[0])
+
+	_, err = suite.msgSrvr.RevokeBatch(suite.ctx, &authz.MsgRevokeBatch{Granter: granter})
+	require.ErrorContains(err, "revokes cannot be empty")
+
+	// the batch is atomic: no grant is revoked if one of the revocations fails
+	msg := authz.NewMsgRevokeBatch(granter, []authz.RevokeBatchEntry{
+		{Grantee: grantee1, MsgTypeUrl: bankSendAuthMsgType},
+		{Grantee: grantee2, MsgTypeUrl: "/cosmos.bank.v1beta1.MsgMultiSend"},
+	})
+	_, err = suite.msgSrvr.RevokeBatch(suite.ctx, &msg)
+	require.ErrorContains(err, "revoke 1")
+	authorization, _ := suite.authzKeeper.GetAuthorization(suite.ctx, addrs[1], addrs[0], bankSendAuthMsgType)
+	require.NotNil(authorization)
+
+	msg = authz.NewMsgRevokeBatch(granter, []authz.RevokeBatchEntry{
+		{Grantee: grantee1, MsgTypeUrl: bankSendAuthMsgType},
+		{Grantee: grantee2, MsgTypeUrl: bankSendAuthMsgType},
+	})
+	_, err = suite.msgSrvr.RevokeBatch(suite.ctx, &msg)
+	require.NoError(err)
+	for _, grantee := range addrs[1:3] {
+		authorization, _ := suite.authzKeeper.GetAuthorization(suite.ctx, grantee, addrs[0], bankSendAuthMsgType)
+		require.Nil(authorization)
+	}
+}
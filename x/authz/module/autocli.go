@@ -44,6 +44,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "grantee"},
 					},
 				},
+				{
+					RpcMethod: "GrantUsage",
+					Use:       "grant-usage [granter-addr] [grantee-addr] [msg-type-url]",
+					Short:     "Query how many times and for what cumulative amounts a grant has been exercised",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "grantee"},
+						{ProtoField: "msg_type_url"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -76,6 +86,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Revoke all authorizations from the signer",
 					Example:   fmt.Sprintf("%s tx authz revoke-all --from=cosmos1skj..", version.AppName),
 				},
+				{
+					RpcMethod: "GrantBatch",
+					Skip:      true, // skipped because it has a custom command
+				},
+				{
+					RpcMethod: "RevokeBatch",
+					Skip:      true, // skipped because it has a custom command
+				},
 				{
 					RpcMethod: "PruneExpiredGrants",
 					Use:       "prune-grants --from [granter]",
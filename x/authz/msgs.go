@@ -15,9 +15,12 @@ var (
 	_ sdk.Msg = &MsgGrant{}
 	_ sdk.Msg = &MsgRevoke{}
 	_ sdk.Msg = &MsgExec{}
+	_ sdk.Msg = &MsgGrantBatch{}
+	_ sdk.Msg = &MsgRevokeBatch{}
 
 	_ gogoprotoany.UnpackInterfacesMessage = &MsgGrant{}
 	_ gogoprotoany.UnpackInterfacesMessage = &MsgExec{}
+	_ gogoprotoany.UnpackInterfacesMessage = &MsgGrantBatch{}
 )
 
 // NewMsgGrant creates a new MsgGrant
@@ -71,6 +74,42 @@ func (msg MsgGrant) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
 	return msg.Grant.UnpackInterfaces(unpacker)
 }
 
+// NewGrantBatchEntry creates a new GrantBatchEntry
+func NewGrantBatchEntry(grantee string, a Authorization, expiration *time.Time) (GrantBatchEntry, error) {
+	m, ok := a.(proto.Message)
+	if !ok {
+		return GrantBatchEntry{}, sdkerrors.ErrPackAny.Wrapf("can't proto marshal %T", m)
+	}
+	any, err := gogoprotoany.NewAnyWithCacheWithValue(m)
+	if err != nil {
+		return GrantBatchEntry{}, err
+	}
+
+	return GrantBatchEntry{
+		Grantee: grantee,
+		Grant:   Grant{Authorization: any, Expiration: expiration},
+	}, nil
+}
+
+// NewMsgGrantBatch creates a new MsgGrantBatch
+func NewMsgGrantBatch(granter string, grants []GrantBatchEntry) *MsgGrantBatch {
+	return &MsgGrantBatch{
+		Granter: granter,
+		Grants:  grants,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantBatch) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	for _, entry := range msg.Grants {
+		if err := entry.Grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // NewMsgRevoke creates a new MsgRevoke
 func NewMsgRevoke(granter, grantee, msgTypeURL string) MsgRevoke {
 	return MsgRevoke{
@@ -80,6 +119,14 @@ func NewMsgRevoke(granter, grantee, msgTypeURL string) MsgRevoke {
 	}
 }
 
+// NewMsgRevokeBatch creates a new MsgRevokeBatch
+func NewMsgRevokeBatch(granter string, revokes []RevokeBatchEntry) MsgRevokeBatch {
+	return MsgRevokeBatch{
+		Granter: granter,
+		Revokes: revokes,
+	}
+}
+
 // NewMsgExec creates a new MsgExecAuthorized
 func NewMsgExec(grantee string, msgs []sdk.Msg) MsgExec {
 	msgsAny := make([]*cdctypes.Any, len(msgs))
@@ -374,6 +374,113 @@ func (m *QueryGranteeGrantsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryGrantUsageRequest is the request type for the Query/GrantUsage RPC method.
+type QueryGrantUsageRequest struct {
+	Granter    string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee    string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *QueryGrantUsageRequest) Reset()         { *m = QueryGrantUsageRequest{} }
+func (m *QueryGrantUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGrantUsageRequest) ProtoMessage()    {}
+func (*QueryGrantUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{6}
+}
+func (m *QueryGrantUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGrantUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGrantUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGrantUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGrantUsageRequest.Merge(m, src)
+}
+func (m *QueryGrantUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGrantUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGrantUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGrantUsageRequest proto.InternalMessageInfo
+
+func (m *QueryGrantUsageRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QueryGrantUsageRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *QueryGrantUsageRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// QueryGrantUsageResponse is the response type for the Query/GrantUsage RPC method.
+type QueryGrantUsageResponse struct {
+	// usage is the usage accounting of the grant.
+	Usage *GrantUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (m *QueryGrantUsageResponse) Reset()         { *m = QueryGrantUsageResponse{} }
+func (m *QueryGrantUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGrantUsageResponse) ProtoMessage()    {}
+func (*QueryGrantUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{7}
+}
+func (m *QueryGrantUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGrantUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGrantUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGrantUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGrantUsageResponse.Merge(m, src)
+}
+func (m *QueryGrantUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGrantUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGrantUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGrantUsageResponse proto.InternalMessageInfo
+
+func (m *QueryGrantUsageResponse) GetUsage() *GrantUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGrantsRequest)(nil), "cosmos.authz.v1beta1.QueryGrantsRequest")
 	proto.RegisterType((*QueryGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGrantsResponse")
@@ -381,46 +488,53 @@ func init() {
 	proto.RegisterType((*QueryGranterGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGranterGrantsResponse")
 	proto.RegisterType((*QueryGranteeGrantsRequest)(nil), "cosmos.authz.v1beta1.QueryGranteeGrantsRequest")
 	proto.RegisterType((*QueryGranteeGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGranteeGrantsResponse")
+	proto.RegisterType((*QueryGrantUsageRequest)(nil), "cosmos.authz.v1beta1.QueryGrantUsageRequest")
+	proto.RegisterType((*QueryGrantUsageResponse)(nil), "cosmos.authz.v1beta1.QueryGrantUsageResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/query.proto", fileDescriptor_376d714ffdeb1545) }
 
 var fileDescriptor_376d714ffdeb1545 = []byte{
-	// 534 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0xeb, 0x6e, 0x14, 0xe1, 0x81, 0x90, 0x0c, 0x87, 0x2c, 0x4c, 0x51, 0x54, 0x21, 0x28,
-	0x48, 0xb5, 0xbb, 0x0e, 0x71, 0x66, 0x3b, 0x6c, 0xe2, 0x06, 0x01, 0x2e, 0x5c, 0xaa, 0x94, 0xbe,
-	0xca, 0xa2, 0xb5, 0x71, 0x66, 0x3b, 0x88, 0x0e, 0xed, 0x02, 0x67, 0x24, 0xa4, 0x1d, 0xf8, 0x08,
-	0x7c, 0x81, 0x5d, 0xb9, 0x23, 0x4e, 0x13, 0x5c, 0x38, 0xa2, 0x16, 0xf1, 0x39, 0x50, 0x6d, 0x97,
-	0xae, 0x23, 0xdb, 0xc2, 0x9f, 0x4a, 0xbb, 0xc5, 0xd1, 0xf3, 0xbc, 0xef, 0xef, 0x7d, 0x1c, 0x3b,
-	0xd8, 0x7f, 0xc6, 0x65, 0x8f, 0x4b, 0x16, 0x66, 0x6a, 0x73, 0x87, 0x3d, 0x5f, 0x6e, 0x83, 0x0a,
-	0x97, 0xd9, 0x76, 0x06, 0xa2, 0x4f, 0x53, 0xc1, 0x15, 0x27, 0x57, 0x8d, 0x82, 0x6a, 0x05, 0xb5,
-	0x0a, 0x77, 0x29, 0xe2, 0x3c, 0xea, 0x02, 0x0b, 0xd3, 0x98, 0x85, 0x49, 0xc2, 0x55, 0xa8, 0x62,
-	0x9e, 0x48, 0xe3, 0x71, 0x6f, 0xdb, 0xaa, 0xed, 0x50, 0x82, 0x29, 0xf6, 0xab, 0x74, 0x1a, 0x46,
-	0x71, 0xa2, 0xc5, 0x56, 0x9b, 0x4f, 0x60, 0xba, 0x19, 0xc5, 0xa2, 0x51, 0xb4, 0xf4, 0x8a, 0x59,
-	0x1c, 0xbd, 0xa8, 0xfe, 0x40, 0x98, 0x3c, 0x1c, 0xd5, 0xdf, 0x10, 0x61, 0xa2, 0x64, 0x00, 0xdb,
-	0x19, 0x48, 0x45, 0x9a, 0xf8, 0x7c, 0x34, 0x7a, 0x01, 0xc2, 0x41, 0x3e, 0xaa, 0x5d, 0x58, 0x73,
-	0x3e, 0xef, 0xd7, 0xc7, 0x83, 0xac, 0x76, 0x3a, 0x02, 0xa4, 0x7c, 0xa4, 0x44, 0x9c, 0x44, 0xc1,
-	0x58, 0x38, 0xf1, 0x80, 0x53, 0x2e, 0xe6, 0x01, 0xe2, 0xe3, 0x8b, 0x3d, 0x19, 0xb5, 0x54, 0x3f,
-	0x85, 0x56, 0x26, 0xba, 0xce, 0xdc, 0xc8, 0x18, 0xe0, 0x9e, 0x8c, 0x1e, 0xf7, 0x53, 0x78, 0x22,
-	0xba, 0x64, 0x1d, 0xe3, 0xc9, 0xc4, 0xce, 0xbc, 0x8f, 0x6a, 0x0b, 0xcd, 0x1b, 0xd4, 0x56, 0x1d,
-	0xc5, 0x43, 0x4d, 0xd6, 0x76, 0x6e, 0xfa, 0x20, 0x8c, 0xc0, 0x4e, 0x11, 0x1c, 0x72, 0x56, 0xf7,
-	0x10, 0xbe, 0x32, 0x35, 0xa8, 0x4c, 0x79, 0x22, 0x81, 0xac, 0xe0, 0x8a, 0x86, 0x91, 0x0e, 0xf2,
-	0xe7, 0x6a, 0x0b, 0xcd, 0x6b, 0x34, 0x6f, 0xbb, 0xa8, 0x76, 0x05, 0x56, 0x4a, 0x36, 0xa6, 0xa0,
-	0xca, 0x1a, 0xea, 0xe6, 0xa9, 0x50, 0xa6, 0xe3, 0x14, 0xd5, 0x3b, 0x84, 0x17, 0x27, 0x54, 0x20,
-	0xfe, 0x7d, 0x17, 0xd6, 0x73, 0xd0, 0xfe, 0x26, 0xaf, 0xf7, 0x08, 0xbb, 0x79, 0x64, 0x36, 0xb6,
-	0x7b, 0x47, 0x62, 0xab, 0x9d, 0x10, 0xdb, 0x6a, 0xa6, 0x36, 0xb9, 0x88, 0x77, 0x74, 0xe1, 0x99,
-	0x67, 0x08, 0xc7, 0x64, 0x08, 0x45, 0x33, 0x84, 0x59, 0x65, 0x08, 0x67, 0x36, 0xc3, 0xe6, 0x9b,
-	0x79, 0x7c, 0x4e, 0x93, 0x92, 0xd7, 0x08, 0x57, 0x0c, 0x27, 0x39, 0x86, 0xe7, 0xf7, 0xeb, 0xc2,
-	0xbd, 0x55, 0x40, 0x69, 0xba, 0x56, 0xaf, 0xbf, 0xfa, 0xf2, 0x7d, 0xaf, 0xec, 0x91, 0x25, 0x96,
-	0x7b, 0x6d, 0xd9, 0xc1, 0x3e, 0x20, 0x7c, 0x69, 0xea, 0xc3, 0x23, 0xec, 0xb4, 0x16, 0x47, 0x0e,
-	0x8f, 0xdb, 0x28, 0x6e, 0xb0, 0x68, 0xf7, 0x3f, 0xed, 0xd7, 0x2f, 0x1b, 0x53, 0x5d, 0x76, 0xb6,
-	0xfc, 0x06, 0xbd, 0x73, 0x57, 0xd3, 0x36, 0x08, 0x3d, 0x89, 0x96, 0xd9, 0xb3, 0xc7, 0x5e, 0xda,
-	0x87, 0xdd, 0x43, 0xfc, 0x50, 0x98, 0x1f, 0xfe, 0x94, 0x1f, 0xfe, 0x2f, 0x3f, 0x8c, 0xf9, 0x61,
-	0x77, 0x8d, 0x7e, 0x1c, 0x78, 0xe8, 0x60, 0xe0, 0xa1, 0x6f, 0x03, 0x0f, 0xbd, 0x1d, 0x7a, 0xa5,
-	0x83, 0xa1, 0x57, 0xfa, 0x3a, 0xf4, 0x4a, 0x4f, 0xed, 0xd1, 0x91, 0x9d, 0x2d, 0x1a, 0x73, 0xf6,
-	0xc2, 0x14, 0x6c, 0x57, 0xf4, 0xdf, 0x64, 0xe5, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x08, 0x92,
-	0xc5, 0x36, 0x0e, 0x07, 0x00, 0x00,
+	// 620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xbb, 0xed, 0x2f, 0xf9, 0x89, 0x0d, 0x15, 0x62, 0x41, 0xe0, 0x9a, 0xca, 0xb2, 0xa2,
+	0x0a, 0x02, 0x22, 0xeb, 0x24, 0x45, 0x3d, 0xc0, 0x85, 0xf6, 0xd0, 0x8a, 0x1b, 0x04, 0x7a, 0xe1,
+	0x12, 0x39, 0x64, 0xe4, 0x5a, 0x4d, 0xbc, 0xae, 0xd7, 0x46, 0xa4, 0xa8, 0x17, 0x78, 0x01, 0xa4,
+	0x1e, 0x78, 0x03, 0x78, 0x81, 0xc0, 0x8d, 0x3b, 0xea, 0xa9, 0x2a, 0x17, 0x8e, 0x28, 0x41, 0x3c,
+	0x07, 0xca, 0xee, 0x06, 0x27, 0xa9, 0x9b, 0x98, 0x3f, 0x95, 0x7a, 0x8b, 0x9d, 0xef, 0xcc, 0x7c,
+	0xe6, 0x3b, 0xbb, 0x23, 0x63, 0xf3, 0x19, 0xe3, 0x2d, 0xc6, 0x2d, 0x3b, 0x0a, 0xb7, 0x76, 0xad,
+	0xe7, 0xe5, 0x3a, 0x84, 0x76, 0xd9, 0xda, 0x89, 0x20, 0x68, 0x53, 0x3f, 0x60, 0x21, 0x23, 0x97,
+	0xa5, 0x82, 0x0a, 0x05, 0x55, 0x0a, 0x7d, 0xd1, 0x61, 0xcc, 0x69, 0x82, 0x65, 0xfb, 0xae, 0x65,
+	0x7b, 0x1e, 0x0b, 0xed, 0xd0, 0x65, 0x1e, 0x97, 0x31, 0xfa, 0x2d, 0x95, 0xb5, 0x6e, 0x73, 0x90,
+	0xc9, 0x7e, 0xa5, 0xf6, 0x6d, 0xc7, 0xf5, 0x84, 0x58, 0x69, 0x93, 0x09, 0x64, 0x35, 0xa9, 0x58,
+	0x90, 0x8a, 0x9a, 0x78, 0xb2, 0xe4, 0x83, 0xfc, 0x2b, 0xff, 0x03, 0x61, 0xf2, 0xa8, 0x9f, 0x7f,
+	0x23, 0xb0, 0xbd, 0x90, 0x57, 0x61, 0x27, 0x02, 0x1e, 0x92, 0x0a, 0xfe, 0xdf, 0xe9, 0xbf, 0x80,
+	0x40, 0x43, 0x26, 0x2a, 0x9c, 0x5b, 0xd3, 0x8e, 0x3a, 0xc5, 0x41, 0x23, 0xab, 0x8d, 0x46, 0x00,
+	0x9c, 0x3f, 0x0e, 0x03, 0xd7, 0x73, 0xaa, 0x03, 0x61, 0x1c, 0x03, 0xda, 0x6c, 0xba, 0x18, 0x20,
+	0x26, 0x3e, 0xdf, 0xe2, 0x4e, 0x2d, 0x6c, 0xfb, 0x50, 0x8b, 0x82, 0xa6, 0x36, 0xd7, 0x0f, 0xac,
+	0xe2, 0x16, 0x77, 0x9e, 0xb4, 0x7d, 0xd8, 0x0c, 0x9a, 0x64, 0x1d, 0xe3, 0xb8, 0x63, 0xed, 0x3f,
+	0x13, 0x15, 0x72, 0x95, 0xeb, 0x54, 0x65, 0xed, 0xdb, 0x43, 0xa5, 0xd7, 0xaa, 0x6f, 0xfa, 0xd0,
+	0x76, 0x40, 0x75, 0x51, 0x1d, 0x8a, 0xcc, 0xef, 0x23, 0x7c, 0x69, 0xa4, 0x51, 0xee, 0x33, 0x8f,
+	0x03, 0x59, 0xc6, 0x59, 0x01, 0xc3, 0x35, 0x64, 0xce, 0x15, 0x72, 0x95, 0x6b, 0x34, 0x69, 0x5c,
+	0x54, 0x44, 0x55, 0x95, 0x94, 0x6c, 0x8c, 0x40, 0xcd, 0x0a, 0xa8, 0x1b, 0x53, 0xa1, 0x64, 0xc5,
+	0x11, 0xaa, 0xb7, 0x08, 0x2f, 0xc4, 0x54, 0x10, 0xfc, 0xfd, 0x14, 0xd6, 0x13, 0xd0, 0xfe, 0xc4,
+	0xaf, 0xf7, 0x08, 0xeb, 0x49, 0x64, 0xca, 0xb6, 0xfb, 0x63, 0xb6, 0x15, 0x26, 0xd8, 0xb6, 0x1a,
+	0x85, 0x5b, 0x2c, 0x70, 0x77, 0x45, 0xe2, 0x53, 0xf7, 0x10, 0x4e, 0xf0, 0x10, 0xd2, 0x7a, 0x08,
+	0xa7, 0xe5, 0x21, 0x9c, 0x5d, 0x0f, 0x3f, 0x20, 0x7c, 0x25, 0x26, 0xdd, 0xe4, 0x71, 0x43, 0x67,
+	0x67, 0x15, 0xdc, 0xbd, 0x78, 0xd4, 0x29, 0xce, 0xbf, 0x90, 0x9b, 0xcd, 0x2c, 0xd3, 0x12, 0x2d,
+	0xe5, 0x1b, 0xf8, 0xea, 0x31, 0x6c, 0xe5, 0xee, 0x0a, 0xce, 0x44, 0xfd, 0x17, 0x82, 0x3a, 0x57,
+	0x31, 0x27, 0x98, 0x2b, 0x03, 0xa5, 0x3c, 0xa1, 0x4a, 0xe5, 0x63, 0x06, 0x67, 0x44, 0x19, 0xf2,
+	0x1a, 0xe1, 0xac, 0x9c, 0x22, 0x39, 0x61, 0x5a, 0xc7, 0x97, 0xa9, 0x7e, 0x33, 0x85, 0x52, 0x42,
+	0xe7, 0x97, 0x5e, 0x7d, 0xf9, 0xbe, 0x3f, 0x6b, 0x90, 0x45, 0x2b, 0x71, 0xa9, 0xab, 0xb1, 0x7f,
+	0x42, 0x78, 0x7e, 0xe4, 0x5a, 0x12, 0x6b, 0x5a, 0x89, 0xb1, 0xd5, 0xa2, 0x97, 0xd2, 0x07, 0x28,
+	0xb4, 0x07, 0x07, 0x9d, 0xe2, 0x05, 0x19, 0x54, 0xe4, 0x8d, 0x6d, 0xb3, 0x44, 0xef, 0xac, 0x08,
+	0xda, 0x12, 0xa1, 0x93, 0x68, 0x2d, 0x75, 0x28, 0xac, 0x97, 0xea, 0xc7, 0xde, 0x10, 0x3f, 0xa4,
+	0xe6, 0x87, 0xdf, 0xe5, 0x87, 0x7f, 0xcb, 0x0f, 0x03, 0x7e, 0xd8, 0x23, 0xef, 0x10, 0xc6, 0xf1,
+	0xc1, 0x21, 0xb7, 0xa7, 0xb1, 0x0c, 0xdf, 0x27, 0xbd, 0x98, 0x52, 0xad, 0xb0, 0xef, 0x1d, 0x8c,
+	0x1f, 0x47, 0x01, 0xbd, 0x44, 0xf2, 0x13, 0xa1, 0xc5, 0x59, 0x5e, 0xa3, 0x9f, 0xbb, 0x06, 0x3a,
+	0xec, 0x1a, 0xe8, 0x5b, 0xd7, 0x40, 0x6f, 0x7a, 0xc6, 0xcc, 0x61, 0xcf, 0x98, 0xf9, 0xda, 0x33,
+	0x66, 0x9e, 0xaa, 0xcb, 0xc8, 0x1b, 0xdb, 0xd4, 0x65, 0x96, 0xca, 0x5f, 0xcf, 0x8a, 0x8f, 0x82,
+	0xe5, 0x9f, 0x03, 0x00, 0x99, 0x41, 0x8f, 0x18, 0xd5, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -441,6 +555,9 @@ type QueryClient interface {
 	GranterGrants(ctx context.Context, in *QueryGranterGrantsRequest, opts ...grpc.CallOption) (*QueryGranterGrantsResponse, error)
 	// GranteeGrants returns a list of `GrantAuthorization` by grantee.
 	GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error)
+	// GrantUsage returns how many times and for what cumulative amounts a grant
+	// has been exercised.
+	GrantUsage(ctx context.Context, in *QueryGrantUsageRequest, opts ...grpc.CallOption) (*QueryGrantUsageResponse, error)
 }
 
 type queryClient struct {
@@ -478,6 +595,15 @@ func (c *queryClient) GranteeGrants(ctx context.Context, in *QueryGranteeGrantsR
 	return out, nil
 }
 
+func (c *queryClient) GrantUsage(ctx context.Context, in *QueryGrantUsageRequest, opts ...grpc.CallOption) (*QueryGrantUsageResponse, error) {
+	out := new(QueryGrantUsageResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Query/GrantUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Returns list of `Authorization`, granted to the grantee by the granter.
@@ -486,6 +612,9 @@ type QueryServer interface {
 	GranterGrants(context.Context, *QueryGranterGrantsRequest) (*QueryGranterGrantsResponse, error)
 	// GranteeGrants returns a list of `GrantAuthorization` by grantee.
 	GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error)
+	// GrantUsage returns how many times and for what cumulative amounts a grant
+	// has been exercised.
+	GrantUsage(context.Context, *QueryGrantUsageRequest) (*QueryGrantUsageResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -501,6 +630,9 @@ func (*UnimplementedQueryServer) GranterGrants(ctx context.Context, req *QueryGr
 func (*UnimplementedQueryServer) GranteeGrants(ctx context.Context, req *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrants not implemented")
 }
+func (*UnimplementedQueryServer) GrantUsage(ctx context.Context, req *QueryGrantUsageRequest) (*QueryGrantUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantUsage not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -560,6 +692,24 @@ func _Query_GranteeGrants_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GrantUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGrantUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GrantUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Query/GrantUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GrantUsage(ctx, req.(*QueryGrantUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -576,6 +726,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GranteeGrants",
 			Handler:    _Query_GranteeGrants_Handler,
 		},
+		{
+			MethodName: "GrantUsage",
+			Handler:    _Query_GrantUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/query.proto",
@@ -868,6 +1022,85 @@ func (m *QueryGranteeGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGrantUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGrantUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -995,6 +1228,40 @@ func (m *QueryGranteeGrantsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGrantUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGrantUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1779,6 +2046,238 @@ func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGrantUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGrantUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGrantUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGrantUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGrantUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGrantUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = &GrantUsage{}
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
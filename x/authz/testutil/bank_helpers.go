@@ -27,3 +27,7 @@ func (k MockBankKeeper) UpdateParams(ctx context.Context, req *bank.MsgUpdatePar
 func (k MockBankKeeper) SetSendEnabled(ctx context.Context, req *bank.MsgSetSendEnabled) (*bank.MsgSetSendEnabledResponse, error) {
 	return nil, nil
 }
+
+func (k MockBankKeeper) UpdateDeniedDenoms(ctx context.Context, req *bank.MsgUpdateDeniedDenoms) (*bank.MsgUpdateDeniedDenomsResponse, error) {
+	return nil, nil
+}
@@ -420,6 +420,238 @@ func (m *MsgPruneExpiredGrantsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPruneExpiredGrantsResponse proto.InternalMessageInfo
 
+// GrantBatchEntry defines a grant of a MsgGrantBatch.
+type GrantBatchEntry struct {
+	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Grant   Grant  `protobuf:"bytes,2,opt,name=grant,proto3" json:"grant"`
+}
+
+func (m *GrantBatchEntry) Reset()         { *m = GrantBatchEntry{} }
+func (m *GrantBatchEntry) String() string { return proto.CompactTextString(m) }
+func (*GrantBatchEntry) ProtoMessage()    {}
+func (*GrantBatchEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{10}
+}
+func (m *GrantBatchEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantBatchEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantBatchEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantBatchEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantBatchEntry.Merge(m, src)
+}
+func (m *GrantBatchEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantBatchEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantBatchEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantBatchEntry proto.InternalMessageInfo
+
+// MsgGrantBatch is a request type for GrantBatch method. It declares
+// authorizations to several grantees on behalf of the granter.
+type MsgGrantBatch struct {
+	Granter string            `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grants  []GrantBatchEntry `protobuf:"bytes,2,rep,name=grants,proto3" json:"grants"`
+}
+
+func (m *MsgGrantBatch) Reset()         { *m = MsgGrantBatch{} }
+func (m *MsgGrantBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantBatch) ProtoMessage()    {}
+func (*MsgGrantBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{11}
+}
+func (m *MsgGrantBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantBatch.Merge(m, src)
+}
+func (m *MsgGrantBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantBatch proto.InternalMessageInfo
+
+// MsgGrantBatchResponse defines the Msg/MsgGrantBatch response type.
+type MsgGrantBatchResponse struct {
+}
+
+func (m *MsgGrantBatchResponse) Reset()         { *m = MsgGrantBatchResponse{} }
+func (m *MsgGrantBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantBatchResponse) ProtoMessage()    {}
+func (*MsgGrantBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{12}
+}
+func (m *MsgGrantBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantBatchResponse.Merge(m, src)
+}
+func (m *MsgGrantBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantBatchResponse proto.InternalMessageInfo
+
+// RevokeBatchEntry defines a revocation of a MsgRevokeBatch.
+type RevokeBatchEntry struct {
+	Grantee    string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *RevokeBatchEntry) Reset()         { *m = RevokeBatchEntry{} }
+func (m *RevokeBatchEntry) String() string { return proto.CompactTextString(m) }
+func (*RevokeBatchEntry) ProtoMessage()    {}
+func (*RevokeBatchEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{13}
+}
+func (m *RevokeBatchEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokeBatchEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeBatchEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevokeBatchEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeBatchEntry.Merge(m, src)
+}
+func (m *RevokeBatchEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokeBatchEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeBatchEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeBatchEntry proto.InternalMessageInfo
+
+// MsgRevokeBatch revokes several authorizations granted to grantees on the
+// granter's account.
+type MsgRevokeBatch struct {
+	Granter string             `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Revokes []RevokeBatchEntry `protobuf:"bytes,2,rep,name=revokes,proto3" json:"revokes"`
+}
+
+func (m *MsgRevokeBatch) Reset()         { *m = MsgRevokeBatch{} }
+func (m *MsgRevokeBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeBatch) ProtoMessage()    {}
+func (*MsgRevokeBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{14}
+}
+func (m *MsgRevokeBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeBatch.Merge(m, src)
+}
+func (m *MsgRevokeBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeBatch proto.InternalMessageInfo
+
+// MsgRevokeBatchResponse defines the Msg/MsgRevokeBatchResponse response type.
+type MsgRevokeBatchResponse struct {
+}
+
+func (m *MsgRevokeBatchResponse) Reset()         { *m = MsgRevokeBatchResponse{} }
+func (m *MsgRevokeBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeBatchResponse) ProtoMessage()    {}
+func (*MsgRevokeBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{15}
+}
+func (m *MsgRevokeBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeBatchResponse.Merge(m, src)
+}
+func (m *MsgRevokeBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeBatchResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrant)(nil), "cosmos.authz.v1beta1.MsgGrant")
 	proto.RegisterType((*MsgGrantResponse)(nil), "cosmos.authz.v1beta1.MsgGrantResponse")
@@ -431,55 +663,73 @@ func init() {
 	proto.RegisterType((*MsgRevokeAllResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeAllResponse")
 	proto.RegisterType((*MsgPruneExpiredGrants)(nil), "cosmos.authz.v1beta1.MsgPruneExpiredGrants")
 	proto.RegisterType((*MsgPruneExpiredGrantsResponse)(nil), "cosmos.authz.v1beta1.MsgPruneExpiredGrantsResponse")
+	proto.RegisterType((*GrantBatchEntry)(nil), "cosmos.authz.v1beta1.GrantBatchEntry")
+	proto.RegisterType((*MsgGrantBatch)(nil), "cosmos.authz.v1beta1.MsgGrantBatch")
+	proto.RegisterType((*MsgGrantBatchResponse)(nil), "cosmos.authz.v1beta1.MsgGrantBatchResponse")
+	proto.RegisterType((*RevokeBatchEntry)(nil), "cosmos.authz.v1beta1.RevokeBatchEntry")
+	proto.RegisterType((*MsgRevokeBatch)(nil), "cosmos.authz.v1beta1.MsgRevokeBatch")
+	proto.RegisterType((*MsgRevokeBatchResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeBatchResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/tx.proto", fileDescriptor_3ceddab7d8589ad1) }
 
 var fileDescriptor_3ceddab7d8589ad1 = []byte{
-	// 687 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0x35, 0xfd, 0x43, 0xae, 0x15, 0xa5, 0x4e, 0x50, 0x5d, 0x57, 0x71, 0x2d, 0x43, 0x21,
-	0x4a, 0x95, 0x73, 0x92, 0x8a, 0x25, 0xb0, 0x24, 0x52, 0xc5, 0x42, 0x04, 0x32, 0xb0, 0xb0, 0x54,
-	0x49, 0x73, 0x5c, 0xa3, 0x3a, 0xb6, 0xe5, 0x73, 0xa2, 0x84, 0x05, 0xc4, 0x08, 0x0b, 0x1f, 0x03,
-	0xb6, 0x0e, 0x19, 0xf9, 0x00, 0x55, 0xa7, 0xaa, 0x03, 0x62, 0x42, 0xd0, 0x0e, 0xfd, 0x18, 0x20,
-	0xdf, 0x9d, 0xdd, 0x7f, 0x6e, 0x52, 0x18, 0x58, 0xda, 0xf7, 0xe7, 0xf7, 0xde, 0xfd, 0x5e, 0xde,
-	0xef, 0x19, 0x66, 0xb7, 0x1c, 0xda, 0x71, 0xa8, 0xd1, 0xe8, 0xfa, 0xdb, 0x6f, 0x8c, 0x5e, 0xa9,
-	0x89, 0xfd, 0x46, 0xc9, 0xf0, 0xfb, 0xc8, 0xf5, 0x1c, 0xdf, 0x91, 0x32, 0x3c, 0x8d, 0x58, 0x1a,
-	0x89, 0xb4, 0xb2, 0xc4, 0xa3, 0x9b, 0x0c, 0x63, 0x08, 0x08, 0x73, 0x94, 0x0c, 0x71, 0x88, 0xc3,
-	0xe3, 0x81, 0x25, 0xa2, 0x4b, 0xc4, 0x71, 0x88, 0x85, 0x0d, 0xe6, 0x35, 0xbb, 0xaf, 0x8d, 0x86,
-	0x3d, 0x10, 0x29, 0x2d, 0x96, 0x00, 0x7f, 0x8f, 0x23, 0x16, 0x05, 0xa2, 0x43, 0x89, 0xd1, 0x2b,
-	0x05, 0xff, 0x44, 0x62, 0xa1, 0xd1, 0x69, 0xdb, 0x8e, 0xc1, 0xfe, 0xf2, 0x90, 0xfe, 0x0d, 0xc0,
-	0x1b, 0x75, 0x4a, 0x1e, 0x7b, 0x0d, 0xdb, 0x97, 0xca, 0x70, 0x86, 0x04, 0x06, 0xf6, 0x64, 0xa0,
-	0x81, 0x5c, 0xaa, 0x26, 0x1f, 0x0e, 0x0b, 0xe1, 0x44, 0xd5, 0x56, 0xcb, 0xc3, 0x94, 0x3e, 0xf7,
-	0xbd, 0xb6, 0x4d, 0xcc, 0x10, 0x78, 0x5a, 0x83, 0xe5, 0x89, 0xeb, 0xd5, 0x60, 0xe9, 0x11, 0x9c,
-	0x62, 0xa6, 0x9c, 0xd4, 0x40, 0x6e, 0xb6, 0xbc, 0x8c, 0xe2, 0x7e, 0x34, 0xc4, 0x38, 0xd5, 0x52,
-	0x7b, 0x3f, 0x56, 0x12, 0x9f, 0x4f, 0x76, 0xf3, 0xc0, 0xe4, 0x45, 0x95, 0xbb, 0xef, 0x4f, 0x76,
-	0xf3, 0xe1, 0xfb, 0x1f, 0x4e, 0x76, 0xf3, 0x69, 0x5e, 0x5e, 0xa0, 0xad, 0x1d, 0x23, 0x9c, 0x45,
-	0x97, 0xe0, 0xad, 0xd0, 0x36, 0x31, 0x75, 0x1d, 0x9b, 0x62, 0xfd, 0x0b, 0x80, 0x33, 0x75, 0x4a,
-	0x36, 0xfa, 0x78, 0xeb, 0x2c, 0x6f, 0x70, 0x5d, 0xde, 0x1b, 0x70, 0xb2, 0x43, 0x09, 0x95, 0x27,
-	0xb4, 0x64, 0x6e, 0xb6, 0x9c, 0x41, 0x7c, 0x49, 0x28, 0x5c, 0x12, 0xaa, 0xda, 0x83, 0xda, 0xf2,
-	0xfe, 0xb0, 0x20, 0x16, 0x80, 0x9a, 0x0d, 0x8a, 0xa3, 0x71, 0xea, 0x94, 0x98, 0xac, 0xbc, 0x72,
-	0xe7, 0xcc, 0x00, 0x38, 0x18, 0x40, 0x3a, 0x3f, 0x40, 0xc0, 0x4f, 0x5f, 0x83, 0xf3, 0xc2, 0x0c,
-	0xe9, 0x4b, 0x32, 0x9c, 0xf1, 0x30, 0xed, 0x5a, 0x3e, 0x95, 0x81, 0x96, 0xcc, 0xcd, 0x99, 0xa1,
-	0xab, 0x7f, 0x05, 0x30, 0x15, 0xf4, 0xc7, 0x3d, 0x67, 0x07, 0xff, 0xb7, 0x35, 0x6a, 0x70, 0xae,
-	0x43, 0xc9, 0xa6, 0x3f, 0x70, 0xf1, 0x66, 0xd7, 0xb3, 0xd8, 0x36, 0x53, 0x26, 0xec, 0x50, 0xf2,
-	0x62, 0xe0, 0xe2, 0x97, 0x9e, 0x55, 0x59, 0xbd, 0xb8, 0xaa, 0xcc, 0xf9, 0x49, 0x39, 0x61, 0x3d,
-	0x0d, 0x17, 0x22, 0x27, 0x5a, 0xd6, 0x5b, 0x38, 0x17, 0x05, 0xab, 0x96, 0xf5, 0x2f, 0x53, 0x55,
-	0x1e, 0x1e, 0x0e, 0x0b, 0xf3, 0xa7, 0x4f, 0x6a, 0x45, 0xf4, 0xa0, 0x74, 0x91, 0xd2, 0x62, 0x1c,
-	0xa5, 0xaa, 0x65, 0xe9, 0x6b, 0x30, 0x73, 0xd6, 0x0f, 0x89, 0x55, 0xd2, 0x31, 0x4d, 0xf5, 0x6d,
-	0x78, 0xbb, 0x4e, 0xc9, 0x33, 0xaf, 0x6b, 0xe3, 0x8d, 0xbe, 0xdb, 0xf6, 0x70, 0x8b, 0x49, 0x8f,
-	0x4a, 0x45, 0x38, 0xed, 0x06, 0xd1, 0xf1, 0xac, 0x05, 0xae, 0x92, 0x3d, 0x1c, 0x16, 0x6e, 0xf6,
-	0xf9, 0x45, 0x6b, 0xbd, 0x22, 0x2a, 0xa3, 0x62, 0xc0, 0x59, 0xa4, 0xf5, 0x75, 0x98, 0x8d, 0x7d,
-	0x29, 0xe2, 0x27, 0x5d, 0xae, 0x2f, 0xff, 0x4e, 0xc2, 0x64, 0x9d, 0x12, 0xe9, 0x29, 0x9c, 0xe2,
-	0xa7, 0xae, 0xc6, 0xdf, 0x5c, 0x78, 0x32, 0xca, 0xbd, 0xd1, 0xf9, 0x48, 0x93, 0x4f, 0xe0, 0x24,
-	0x3b, 0xa7, 0xec, 0x95, 0xf8, 0x20, 0xad, 0xac, 0x8e, 0x4c, 0x47, 0xdd, 0x4c, 0x38, 0x2d, 0x34,
-	0xbc, 0x72, 0x65, 0x01, 0x07, 0x28, 0xf7, 0xc7, 0x00, 0xa2, 0x9e, 0x2e, 0x4c, 0x9d, 0x8a, 0x48,
-	0x1f, 0x53, 0x55, 0xb5, 0x2c, 0x25, 0x3f, 0x1e, 0x13, 0x89, 0x34, 0xbd, 0x7f, 0x59, 0x0b, 0xd2,
-	0x47, 0x00, 0xa5, 0x18, 0x25, 0xac, 0x5d, 0xd9, 0xf7, 0x32, 0x58, 0x59, 0xff, 0x0b, 0xf0, 0x48,
-	0x36, 0xca, 0xd4, 0xbb, 0xe0, 0xe3, 0x59, 0x2b, 0xef, 0xfd, 0x52, 0x13, 0x7b, 0x47, 0x2a, 0x38,
-	0x38, 0x52, 0xc1, 0xcf, 0x23, 0x15, 0x7c, 0x3a, 0x56, 0x13, 0x07, 0xc7, 0x6a, 0xe2, 0xfb, 0xb1,
-	0x9a, 0x78, 0x25, 0x14, 0x49, 0x5b, 0x3b, 0xa8, 0xed, 0x18, 0x42, 0x3c, 0xcd, 0x69, 0xf6, 0x65,
-	0x5b, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x34, 0x9d, 0x5f, 0xf4, 0x06, 0x00, 0x00,
+	// 877 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0xe3, 0x54,
+	0x10, 0xcf, 0x4b, 0x36, 0x29, 0x99, 0x76, 0xb7, 0x1b, 0x27, 0xcb, 0xba, 0x5e, 0xc5, 0x6b, 0x79,
+	0xb7, 0x4b, 0x94, 0x10, 0x3b, 0x49, 0xc5, 0xc5, 0xcb, 0x25, 0x91, 0x22, 0x90, 0x20, 0x02, 0x05,
+	0xb8, 0x70, 0xa9, 0x92, 0xe6, 0xe1, 0x46, 0x75, 0xec, 0xe0, 0xe7, 0x44, 0x09, 0x1c, 0x40, 0x1c,
+	0xe1, 0xc2, 0x89, 0xcf, 0x00, 0xb7, 0x1e, 0x72, 0xe4, 0x03, 0x94, 0x1e, 0xd0, 0x2a, 0x07, 0xc4,
+	0x09, 0x41, 0x7b, 0x28, 0x1f, 0x03, 0xf9, 0x3d, 0xdb, 0xcd, 0x1f, 0x37, 0x09, 0x41, 0xe2, 0xd2,
+	0xbe, 0x37, 0xf3, 0x9b, 0x99, 0xdf, 0xcc, 0xbc, 0x19, 0x07, 0xb2, 0x27, 0x16, 0xe9, 0x59, 0x44,
+	0x6d, 0x0d, 0x9c, 0xd3, 0x2f, 0xd4, 0x61, 0xb9, 0x8d, 0x9d, 0x56, 0x59, 0x75, 0x46, 0x4a, 0xdf,
+	0xb6, 0x1c, 0x8b, 0xcb, 0x30, 0xb5, 0x42, 0xd5, 0x8a, 0xa7, 0x16, 0x0e, 0x98, 0xf4, 0x98, 0x62,
+	0x54, 0x0f, 0x42, 0x2f, 0x42, 0x46, 0xb7, 0x74, 0x8b, 0xc9, 0xdd, 0x93, 0x27, 0x3d, 0xd0, 0x2d,
+	0x4b, 0x37, 0xb0, 0x4a, 0x6f, 0xed, 0xc1, 0x67, 0x6a, 0xcb, 0x1c, 0x7b, 0x2a, 0x29, 0x94, 0x00,
+	0x8b, 0xc7, 0x10, 0x8f, 0x3d, 0x44, 0x8f, 0xe8, 0xea, 0xb0, 0xec, 0xfe, 0xf3, 0x14, 0xa9, 0x56,
+	0xaf, 0x6b, 0x5a, 0x2a, 0xfd, 0xcb, 0x44, 0xf2, 0x6f, 0x08, 0x5e, 0x6b, 0x10, 0xfd, 0x1d, 0xbb,
+	0x65, 0x3a, 0x5c, 0x05, 0x76, 0x74, 0xf7, 0x80, 0x6d, 0x1e, 0x49, 0x28, 0x97, 0xac, 0xf1, 0xd3,
+	0x49, 0xd1, 0xcf, 0xa8, 0xda, 0xe9, 0xd8, 0x98, 0x90, 0x8f, 0x1c, 0xbb, 0x6b, 0xea, 0x4d, 0x1f,
+	0x78, 0x6b, 0x83, 0xf9, 0xe8, 0x66, 0x36, 0x98, 0x7b, 0x1b, 0xe2, 0xf4, 0xc8, 0xc7, 0x24, 0x94,
+	0xdb, 0xad, 0x3c, 0x51, 0xc2, 0x8a, 0xa6, 0x50, 0x4e, 0xb5, 0xe4, 0xc5, 0x1f, 0x4f, 0x23, 0x3f,
+	0xde, 0x9c, 0xe7, 0x51, 0x93, 0x19, 0x69, 0xcf, 0xbf, 0xb9, 0x39, 0xcf, 0xfb, 0xf1, 0xbf, 0xbd,
+	0x39, 0xcf, 0xa7, 0x99, 0x79, 0x91, 0x74, 0xce, 0x54, 0x3f, 0x17, 0x99, 0x83, 0x87, 0xfe, 0xb9,
+	0x89, 0x49, 0xdf, 0x32, 0x09, 0x96, 0x7f, 0x42, 0xb0, 0xd3, 0x20, 0x7a, 0x7d, 0x84, 0x4f, 0x66,
+	0x79, 0xa3, 0x4d, 0x79, 0xd7, 0xe1, 0x5e, 0x8f, 0xe8, 0x84, 0x8f, 0x4a, 0xb1, 0xdc, 0x6e, 0x25,
+	0xa3, 0xb0, 0x26, 0x29, 0x7e, 0x93, 0x94, 0xaa, 0x39, 0xae, 0x3d, 0xb9, 0x9c, 0x14, 0xbd, 0x06,
+	0x28, 0xed, 0x16, 0xc1, 0x41, 0x3a, 0x0d, 0xa2, 0x37, 0xa9, 0xb9, 0xf6, 0x6c, 0x26, 0x01, 0xec,
+	0x26, 0xc0, 0xcd, 0x27, 0xe0, 0xf2, 0x93, 0x0b, 0xb0, 0xef, 0x1d, 0x7d, 0xfa, 0x1c, 0x0f, 0x3b,
+	0x36, 0x26, 0x03, 0xc3, 0x21, 0x3c, 0x92, 0x62, 0xb9, 0xbd, 0xa6, 0x7f, 0x95, 0x7f, 0x46, 0x90,
+	0x74, 0xfd, 0xe3, 0xa1, 0x75, 0x86, 0xff, 0xb7, 0x36, 0x4a, 0xb0, 0xd7, 0x23, 0xfa, 0xb1, 0x33,
+	0xee, 0xe3, 0xe3, 0x81, 0x6d, 0xd0, 0x6e, 0x26, 0x9b, 0xd0, 0x23, 0xfa, 0xc7, 0xe3, 0x3e, 0xfe,
+	0xc4, 0x36, 0xb4, 0xc3, 0xc5, 0x56, 0x65, 0xe6, 0x33, 0x65, 0x84, 0xe5, 0x34, 0xa4, 0x82, 0x4b,
+	0xd0, 0xac, 0xaf, 0x60, 0x2f, 0x10, 0x56, 0x0d, 0x63, 0x9b, 0xac, 0xb4, 0x97, 0xd3, 0x49, 0x71,
+	0xff, 0x36, 0xa4, 0x54, 0x52, 0xde, 0x2a, 0x2f, 0x52, 0x7a, 0x1c, 0x46, 0xa9, 0x6a, 0x18, 0x72,
+	0x01, 0x32, 0xb3, 0x77, 0x9f, 0x98, 0x96, 0x0e, 0x71, 0x2a, 0x9f, 0xc2, 0xa3, 0x06, 0xd1, 0x3f,
+	0xb4, 0x07, 0x26, 0xae, 0x8f, 0xfa, 0x5d, 0x1b, 0x77, 0xe8, 0xd3, 0x23, 0x5c, 0x09, 0x12, 0x7d,
+	0x57, 0xba, 0x9e, 0xb5, 0x87, 0xd3, 0xb2, 0xd3, 0x49, 0xf1, 0xc1, 0x88, 0x4d, 0xb4, 0x34, 0x2c,
+	0x29, 0x15, 0xa5, 0xe4, 0x72, 0xf6, 0xd4, 0xf2, 0x11, 0x64, 0x43, 0x23, 0x05, 0xfc, 0xb8, 0x65,
+	0x7b, 0xf9, 0x07, 0x04, 0xfb, 0x6c, 0x9e, 0x5a, 0xce, 0xc9, 0x69, 0xdd, 0x74, 0xec, 0xf1, 0x56,
+	0x13, 0x10, 0x4c, 0x6e, 0x74, 0x9b, 0xc9, 0x4d, 0x4d, 0x27, 0xc5, 0xfb, 0x3e, 0xb3, 0xb2, 0x52,
+	0x52, 0x4a, 0xf2, 0x2f, 0x08, 0xee, 0xfb, 0x73, 0x4a, 0xb9, 0x6d, 0xf5, 0x7a, 0xdf, 0x85, 0x04,
+	0x3d, 0xfa, 0xa3, 0x79, 0xb8, 0x8a, 0x57, 0x50, 0x81, 0x59, 0x86, 0x9e, 0xbd, 0xa6, 0x2d, 0x51,
+	0x5c, 0x7c, 0x2f, 0x7c, 0xc8, 0xb6, 0xa1, 0x3e, 0xe5, 0x3c, 0x3c, 0x9a, 0x13, 0x04, 0x1d, 0x09,
+	0xc9, 0xfb, 0x4b, 0x78, 0xc8, 0x5e, 0xd6, 0x7f, 0x6c, 0xc8, 0xe2, 0x0c, 0x46, 0x97, 0x66, 0x30,
+	0x24, 0xf8, 0xaf, 0x08, 0x1e, 0x04, 0x4f, 0x7b, 0xfb, 0xaa, 0xbf, 0xe7, 0xee, 0x23, 0xd7, 0x85,
+	0x5f, 0xf6, 0x17, 0xe1, 0x65, 0x5f, 0x4c, 0x74, 0xb6, 0xee, 0xbe, 0x07, 0xed, 0xe5, 0x12, 0xcd,
+	0xc5, 0xc2, 0x1f, 0x84, 0x0d, 0x2a, 0xab, 0x7c, 0x01, 0x5e, 0x9f, 0x97, 0xac, 0x28, 0x7d, 0xe5,
+	0xef, 0x38, 0xc4, 0x1a, 0x44, 0xe7, 0x3e, 0x80, 0x38, 0xfb, 0xec, 0x89, 0xe1, 0xb4, 0xfd, 0x5e,
+	0x0a, 0x2f, 0x56, 0xeb, 0x83, 0xfd, 0xfc, 0x3e, 0xdc, 0xa3, 0x9f, 0x96, 0xec, 0x9d, 0x78, 0x57,
+	0x2d, 0x1c, 0xae, 0x54, 0x07, 0xde, 0x9a, 0x90, 0xf0, 0xf6, 0xf9, 0xd3, 0x3b, 0x0d, 0x18, 0x40,
+	0x78, 0x63, 0x0d, 0x20, 0xf0, 0xd9, 0x87, 0xe4, 0xed, 0x42, 0x95, 0xd7, 0x58, 0x55, 0x0d, 0x43,
+	0xc8, 0xaf, 0xc7, 0x04, 0x0b, 0x3b, 0x7d, 0xb9, 0xbc, 0x17, 0xb9, 0xef, 0x10, 0x70, 0x21, 0x5b,
+	0xb1, 0x70, 0xa7, 0xdf, 0x65, 0xb0, 0x70, 0xf4, 0x2f, 0xc0, 0xab, 0xd9, 0x7c, 0x0e, 0x30, 0xb3,
+	0x69, 0x9e, 0xad, 0xee, 0x2b, 0x05, 0x09, 0x85, 0x0d, 0x40, 0x41, 0xd0, 0xd4, 0xe5, 0xe2, 0x6b,
+	0xe3, 0x1c, 0xd8, 0x9d, 0x9d, 0xb3, 0xe7, 0x6b, 0x0a, 0xca, 0x82, 0xbe, 0xb9, 0x09, 0x6a, 0x45,
+	0x54, 0x21, 0xfe, 0xb5, 0x3b, 0x5d, 0xb5, 0xca, 0xc5, 0x5f, 0x62, 0xe4, 0xe2, 0x4a, 0x44, 0xaf,
+	0xae, 0x44, 0xf4, 0xe7, 0x95, 0x88, 0xbe, 0xbf, 0x16, 0x23, 0xaf, 0xae, 0xc5, 0xc8, 0xef, 0xd7,
+	0x62, 0xe4, 0x53, 0x6f, 0xbc, 0x49, 0xe7, 0x4c, 0xe9, 0x5a, 0xaa, 0xe7, 0xa0, 0x9d, 0xa0, 0x3f,
+	0x67, 0x8e, 0xfe, 0x19, 0x00, 0xc2, 0x6c, 0x6c, 0xb6, 0xe9, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -510,6 +760,14 @@ type MsgClient interface {
 	RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error)
 	// PruneExpiredGrants prunes the expired grants. Currently up to 75 at a time.
 	PruneExpiredGrants(ctx context.Context, in *MsgPruneExpiredGrants, opts ...grpc.CallOption) (*MsgPruneExpiredGrantsResponse, error)
+	// GrantBatch grants the provided authorizations to the grantees on the
+	// granter's account. All grants are processed atomically: if one of them
+	// fails, none of them is saved.
+	GrantBatch(ctx context.Context, in *MsgGrantBatch, opts ...grpc.CallOption) (*MsgGrantBatchResponse, error)
+	// RevokeBatch revokes the provided authorizations granted to the grantees on
+	// the granter's account. All revocations are processed atomically: if one of
+	// them fails, none of them is applied.
+	RevokeBatch(ctx context.Context, in *MsgRevokeBatch, opts ...grpc.CallOption) (*MsgRevokeBatchResponse, error)
 }
 
 type msgClient struct {
@@ -565,6 +823,24 @@ func (c *msgClient) PruneExpiredGrants(ctx context.Context, in *MsgPruneExpiredG
 	return out, nil
 }
 
+func (c *msgClient) GrantBatch(ctx context.Context, in *MsgGrantBatch, opts ...grpc.CallOption) (*MsgGrantBatchResponse, error) {
+	out := new(MsgGrantBatchResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/GrantBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeBatch(ctx context.Context, in *MsgRevokeBatch, opts ...grpc.CallOption) (*MsgRevokeBatchResponse, error) {
+	out := new(MsgRevokeBatchResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/RevokeBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Grant grants the provided authorization to the grantee on the granter's
@@ -583,6 +859,14 @@ type MsgServer interface {
 	RevokeAll(context.Context, *MsgRevokeAll) (*MsgRevokeAllResponse, error)
 	// PruneExpiredGrants prunes the expired grants. Currently up to 75 at a time.
 	PruneExpiredGrants(context.Context, *MsgPruneExpiredGrants) (*MsgPruneExpiredGrantsResponse, error)
+	// GrantBatch grants the provided authorizations to the grantees on the
+	// granter's account. All grants are processed atomically: if one of them
+	// fails, none of them is saved.
+	GrantBatch(context.Context, *MsgGrantBatch) (*MsgGrantBatchResponse, error)
+	// RevokeBatch revokes the provided authorizations granted to the grantees on
+	// the granter's account. All revocations are processed atomically: if one of
+	// them fails, none of them is applied.
+	RevokeBatch(context.Context, *MsgRevokeBatch) (*MsgRevokeBatchResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -604,6 +888,12 @@ func (*UnimplementedMsgServer) RevokeAll(ctx context.Context, req *MsgRevokeAll)
 func (*UnimplementedMsgServer) PruneExpiredGrants(ctx context.Context, req *MsgPruneExpiredGrants) (*MsgPruneExpiredGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneExpiredGrants not implemented")
 }
+func (*UnimplementedMsgServer) GrantBatch(ctx context.Context, req *MsgGrantBatch) (*MsgGrantBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantBatch not implemented")
+}
+func (*UnimplementedMsgServer) RevokeBatch(ctx context.Context, req *MsgRevokeBatch) (*MsgRevokeBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeBatch not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -699,6 +989,42 @@ func _Msg_PruneExpiredGrants_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/GrantBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantBatch(ctx, req.(*MsgGrantBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/RevokeBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeBatch(ctx, req.(*MsgRevokeBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -723,6 +1049,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PruneExpiredGrants",
 			Handler:    _Msg_PruneExpiredGrants_Handler,
 		},
+		{
+			MethodName: "GrantBatch",
+			Handler:    _Msg_GrantBatch_Handler,
+		},
+		{
+			MethodName: "RevokeBatch",
+			Handler:    _Msg_RevokeBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
@@ -1047,54 +1381,265 @@ func (m *MsgPruneExpiredGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GrantBatchEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgGrant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GrantBatchEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantBatchEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Grant.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Grant.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGrantResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGrantBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgExec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgGrantBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RevokeBatchEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeBatchEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeBatchEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revokes) > 0 {
+		for iNdEx := len(m.Revokes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revokes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Grant.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	if len(m.Msgs) > 0 {
 		for _, e := range m.Msgs {
@@ -1194,6 +1739,94 @@ func (m *MsgPruneExpiredGrantsResponse) Size() (n int) {
 	return n
 }
 
+func (m *GrantBatchEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Grant.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgGrantBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGrantBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RevokeBatchEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Revokes) > 0 {
+		for _, e := range m.Revokes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRevokeBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2055,6 +2688,567 @@ func (m *MsgPruneExpiredGrantsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GrantBatchEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantBatchEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantBatchEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Grant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, GrantBatchEntry{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevokeBatchEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevokeBatchEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevokeBatchEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revokes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revokes = append(m.Revokes, RevokeBatchEntry{})
+			if err := m.Revokes[len(m.Revokes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -54,6 +54,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query the supply of a single coin denom",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
 				},
+				{
+					RpcMethod:      "SupplyAtHeight",
+					Use:            "total-supply-at-height [denom] [height]",
+					Short:          "Query the supply of a single coin denom at a past height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "height"}},
+				},
+				{
+					RpcMethod:      "SupplyHistory",
+					Use:            "total-supply-history [denom]",
+					Short:          "Query the supply history of a single coin denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
 				{
 					RpcMethod: "Params",
 					Use:       "params",
@@ -87,6 +99,16 @@ To look up all denoms, do not provide any arguments.`,
 					),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms", Varargs: true}},
 				},
+				{
+					RpcMethod: "DeniedDenoms",
+					Use:       "denied-denoms",
+					Short:     "Query the denoms which can't be transferred",
+				},
+				{
+					RpcMethod: "SendRestrictions",
+					Use:       "send-restrictions",
+					Short:     "Query the send restrictions, in the order they are run",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -128,6 +150,13 @@ Note: multiple coins can be send by space separated.`,
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod:   "UpdateDeniedDenoms",
+					Use:         "update-denied-denoms-proposal",
+					Short:       "Submit a proposal to deny or allow the transfers of denoms",
+					Example:     fmt.Sprintf(`%s tx bank update-denied-denoms-proposal --deny ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 --allow stake`, version.AppName),
+					GovProposal: true,
+				},
 			},
 		},
 	}
@@ -43,8 +43,9 @@ type ModuleInputs struct {
 type ModuleOutputs struct {
 	depinject.Out
 
-	BankKeeper keeper.BaseKeeper
-	Module     appmodule.AppModule
+	BankKeeper          keeper.BaseKeeper
+	Module              appmodule.AppModule
+	AccountPruneChecker authtypes.AccountPruneCheckerWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -91,7 +92,11 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	)
 	m := NewAppModule(in.Cdc, bankKeeper, in.AccountKeeper)
 
-	return ModuleOutputs{BankKeeper: bankKeeper, Module: m}
+	return ModuleOutputs{
+		BankKeeper:          bankKeeper,
+		Module:              m,
+		AccountPruneChecker: authtypes.AccountPruneCheckerWrapper{AccountPruneChecker: bankKeeper},
+	}
 }
 
 func InvokeSetSendRestrictions(
@@ -118,13 +123,15 @@ func InvokeSetSendRestrictions(
 		return nil
 	}
 
-	for _, module := range order {
+	for i, module := range order {
 		restriction, ok := restrictions[module]
 		if !ok {
 			return fmt.Errorf("can't find send restriction for module %s", module)
 		}
 
-		keeper.AppendSendRestriction(restriction)
+		if err := keeper.RegisterSendRestriction(module, int64(i), restriction); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	coretesting "cosmossdk.io/core/testing"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/bank/keeper"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// benchmarkInputOutputCoins benchmarks a multi-send of numOutputs outputs to
+// numRecipients recipients, with the given keeper method.
+func benchmarkInputOutputCoins(b *testing.B, numOutputs, numRecipients int, batched bool) {
+	b.Helper()
+
+	key := storetypes.NewKVStoreKey(banktypes.StoreKey)
+	ctx := testutil.DefaultContextWithDB(b, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger())
+	ac := address.NewBech32Codec("cosmos")
+
+	authority, err := ac.BytesToString(authtypes.NewModuleAddress(banktypes.GovModuleName))
+	require.NoError(b, err)
+
+	sender := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	authKeeper := banktestutil.NewMockAccountKeeper(gomock.NewController(b))
+	authKeeper.EXPECT().AddressCodec().Return(ac).AnyTimes()
+	authKeeper.EXPECT().GetModuleAccount(gomock.Any(), mintAcc.Name).Return(mintAcc).AnyTimes()
+	authKeeper.EXPECT().GetModuleAddress(mintAcc.Name).Return(mintAcc.GetAddress()).AnyTimes()
+	authKeeper.EXPECT().GetAccount(gomock.Any(), mintAcc.GetAddress()).Return(mintAcc).AnyTimes()
+	authKeeper.EXPECT().GetAccount(gomock.Any(), sender.GetAddress()).Return(sender).AnyTimes()
+
+	bankKeeper := keeper.NewBaseKeeper(env, encCfg.Codec, authKeeper, map[string]bool{}, authority)
+	require.NoError(b, bankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+
+	senderStr, err := ac.BytesToString(sender.GetAddress())
+	require.NoError(b, err)
+
+	outputs := make([]banktypes.Output, numOutputs)
+	for i := range outputs {
+		addr, err := ac.BytesToString(sdk.AccAddress(fmt.Sprintf("recipient%011d", i%numRecipients)))
+		require.NoError(b, err)
+		outputs[i] = banktypes.NewOutput(addr, sdk.NewCoins(newFooCoin(1), newBarCoin(1)))
+	}
+	input := banktypes.NewInput(senderStr, sdk.NewCoins(newFooCoin(int64(numOutputs)), newBarCoin(int64(numOutputs))))
+
+	inputOutputCoins := bankKeeper.InputOutputCoins
+	if batched {
+		inputOutputCoins = bankKeeper.InputOutputCoinsBatched
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		require.NoError(b, banktestutil.FundAccount(ctx, bankKeeper, sender.GetAddress(), input.Coins))
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		b.StartTimer()
+
+		if err := inputOutputCoins(ctx, input, outputs); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkInputOutputCoins(b *testing.B) {
+	for _, tc := range []struct {
+		outputs    int
+		recipients int
+	}{
+		{1000, 1000},
+		{1000, 10},
+		{5000, 100},
+	} {
+		for _, batched := range []bool{false, true} {
+			b.Run(fmt.Sprintf("outputs=%d/recipients=%d/batched=%t", tc.outputs, tc.recipients, batched), func(b *testing.B) {
+				benchmarkInputOutputCoins(b, tc.outputs, tc.recipients, batched)
+			})
+		}
+	}
+}
@@ -6,9 +6,14 @@ import "cosmossdk.io/x/bank/types"
 // for the purpose of testing in the keeper_test package.
 
 func (k BaseSendKeeper) SetSendRestriction(restriction types.SendRestrictionFn) {
-	k.sendRestriction.fn = restriction
+	k.sendRestriction.clear()
+	k.sendRestriction.append(restriction)
 }
 
 func (k BaseSendKeeper) GetSendRestrictionFn() types.SendRestrictionFn {
-	return k.sendRestriction.fn
+	fns := make([]types.SendRestrictionFn, len(k.sendRestriction.entries))
+	for i, e := range k.sendRestriction.entries {
+		fns[i] = e.fn
+	}
+	return types.ComposeSendRestrictions(fns...)
 }
@@ -51,7 +51,9 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	}
 
 	for _, supply := range totalSupply {
-		k.setSupply(ctx, supply)
+		if err := k.setSupply(ctx, supply); err != nil {
+			return err
+		}
 	}
 
 	for _, meta := range genState.DenomMetadata {
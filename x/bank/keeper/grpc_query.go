@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -156,6 +157,56 @@ func (k BaseKeeper) SupplyOf(ctx context.Context, req *types.QuerySupplyOfReques
 	return &types.QuerySupplyOfResponse{Amount: sdk.NewCoin(req.Denom, supply.Amount)}, nil
 }
 
+// SupplyAtHeight implements the Query/SupplyAtHeight gRPC method
+func (k BaseKeeper) SupplyAtHeight(ctx context.Context, req *types.QuerySupplyAtHeightRequest) (*types.QuerySupplyAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Height < 0 || req.Height > k.HeaderService.HeaderInfo(ctx).Height {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height %d", req.Height)
+	}
+
+	supply, bucket, err := k.GetSupplyAtHeight(ctx, req.Denom, uint64(req.Height))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no supply history of %s at height %d", req.Denom, req.Height)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySupplyAtHeightResponse{Amount: supply, BucketHeight: int64(bucket)}, nil
+}
+
+// SupplyHistory implements the Query/SupplyHistory gRPC method
+func (k BaseKeeper) SupplyHistory(ctx context.Context, req *types.QuerySupplyHistoryRequest) (*types.QuerySupplyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	history, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.HistoricalSupply,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], value math.Int) (types.SupplyHistoryEntry, error) {
+			return types.SupplyHistoryEntry{BucketHeight: int64(key.K2()), Amount: sdk.NewCoin(req.Denom, value)}, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Denom),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySupplyHistoryResponse{History: history, Pagination: pageRes}, nil
+}
+
 // Params implements the gRPC service handler for querying x/bank parameters.
 func (k BaseKeeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
@@ -351,3 +402,21 @@ func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDeno
 
 	return &types.QueryDenomOwnersByQueryResponse{DenomOwners: resp.DenomOwners, Pagination: resp.Pagination}, nil
 }
+
+// DeniedDenoms implements the Query/DeniedDenoms gRPC method
+func (k BaseKeeper) DeniedDenoms(ctx context.Context, req *types.QueryDeniedDenomsRequest) (*types.QueryDeniedDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryDeniedDenomsResponse{Denoms: k.GetDeniedDenoms(ctx)}, nil
+}
+
+// SendRestrictions implements the Query/SendRestrictions gRPC method
+func (k BaseKeeper) SendRestrictions(_ context.Context, req *types.QuerySendRestrictionsRequest) (*types.QuerySendRestrictionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QuerySendRestrictionsResponse{Restrictions: k.GetSendRestrictions()}, nil
+}
@@ -335,6 +335,59 @@ func (suite *KeeperTestSuite) TestQueryTotalSupplyOf() {
 	suite.Require().Equal(sdk.NewInt64Coin("bogus", 0), res.Amount)
 }
 
+func (suite *KeeperTestSuite) TestQuerySupplyHistory() {
+	params := suite.bankKeeper.GetParams(suite.ctx)
+	params.SupplyHistoryBucketBlocks = 10
+	params.SupplyHistoryRetentionBuckets = 2
+	suite.Require().NoError(suite.bankKeeper.SetParams(suite.ctx, params))
+
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	for _, height := range []int64{5, 12, 27, 45} {
+		suite.ctx = ctx.WithHeaderInfo(header.Info{Height: height})
+		suite.mockMintCoins(mintAcc)
+		suite.Require().NoError(suite.bankKeeper.MintCoins(suite.ctx, types.MintModuleName, sdk.NewCoins(sdk.NewInt64Coin("test1", height))))
+	}
+
+	// buckets 0 and 10 were pruned, bucket 20 is kept as the supply until bucket 40
+	res, err := suite.bankKeeper.SupplyHistory(suite.ctx, &types.QuerySupplyHistoryRequest{Denom: "test1"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SupplyHistoryEntry{
+		{BucketHeight: 20, Amount: sdk.NewInt64Coin("test1", 44)},
+		{BucketHeight: 40, Amount: sdk.NewInt64Coin("test1", 89)},
+	}, res.History)
+
+	res, err = suite.bankKeeper.SupplyHistory(suite.ctx, &types.QuerySupplyHistoryRequest{Denom: "test1", Pagination: &query.PageRequest{Limit: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.History, 1)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = suite.bankKeeper.SupplyHistory(suite.ctx, &types.QuerySupplyHistoryRequest{Denom: "bogus"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.History)
+
+	_, err = suite.bankKeeper.SupplyHistory(suite.ctx, &types.QuerySupplyHistoryRequest{})
+	suite.Require().Error(err)
+
+	atHeight, err := suite.bankKeeper.SupplyAtHeight(suite.ctx, &types.QuerySupplyAtHeightRequest{Denom: "test1", Height: 33})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("test1", 44), atHeight.Amount)
+	suite.Require().Equal(int64(20), atHeight.BucketHeight)
+
+	atHeight, err = suite.bankKeeper.SupplyAtHeight(suite.ctx, &types.QuerySupplyAtHeightRequest{Denom: "test1", Height: 45})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("test1", 89), atHeight.Amount)
+	suite.Require().Equal(int64(40), atHeight.BucketHeight)
+
+	_, err = suite.bankKeeper.SupplyAtHeight(suite.ctx, &types.QuerySupplyAtHeightRequest{Denom: "test1", Height: 5})
+	suite.Require().ErrorContains(err, "no supply history")
+
+	_, err = suite.bankKeeper.SupplyAtHeight(suite.ctx, &types.QuerySupplyAtHeightRequest{Denom: "test1", Height: 46})
+	suite.Require().ErrorContains(err, "invalid height")
+
+	_, err = suite.bankKeeper.SupplyAtHeight(suite.ctx, &types.QuerySupplyAtHeightRequest{Denom: "bogus", Height: 45})
+	suite.Require().ErrorContains(err, "no supply history")
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	res, err := suite.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
 	suite.Require().NoError(err)
@@ -342,6 +395,33 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().Equal(suite.bankKeeper.GetParams(suite.ctx), res.GetParams())
 }
 
+func (suite *KeeperTestSuite) TestQueryDeniedDenoms() {
+	res, err := suite.queryClient.DeniedDenoms(gocontext.Background(), &types.QueryDeniedDenomsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Denoms)
+
+	suite.Require().NoError(suite.bankKeeper.UpdateDeniedDenoms(suite.ctx, []string{"foo", "bar"}, nil))
+	res, err = suite.queryClient.DeniedDenoms(gocontext.Background(), &types.QueryDeniedDenomsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"bar", "foo"}, res.Denoms)
+}
+
+func (suite *KeeperTestSuite) TestQuerySendRestrictions() {
+	bk := suite.bankKeeper
+	bk.ClearSendRestriction()
+	defer bk.ClearSendRestriction()
+
+	res, err := suite.queryClient.SendRestrictions(gocontext.Background(), &types.QuerySendRestrictionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Restrictions)
+
+	suite.Require().NoError(bk.RegisterSendRestriction("sanction", 1, types.NoOpSendRestrictionFn))
+	suite.Require().NoError(bk.RegisterSendRestriction("vesting", 0, types.NoOpSendRestrictionFn))
+	res, err = suite.queryClient.SendRestrictions(gocontext.Background(), &types.QuerySendRestrictionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SendRestriction{{Name: "vesting", Order: 0}, {Name: "sanction", Order: 1}}, res.Restrictions)
+}
+
 func (suite *KeeperTestSuite) TestQueryDenomsMetadata() {
 	var (
 		req         *types.QueryDenomsMetadataRequest
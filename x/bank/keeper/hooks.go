@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// denomHooks houses the BankHooks registered for each denom.
+// It exists so that hooks can be registered in the SendKeeper without needing to have a pointer receiver.
+// The hooks are only registered while the app is built and are read-only once sealed, so that they can be read
+// concurrently by the transfers.
+type denomHooks struct {
+	hooks  map[string]types.MultiBankHooks
+	sealed bool
+}
+
+// newDenomHooks creates a new denomHooks without any registered hooks.
+func newDenomHooks() *denomHooks {
+	return &denomHooks{
+		hooks: map[string]types.MultiBankHooks{},
+	}
+}
+
+// RegisterDenomHooks registers hooks called around the transfers of the given denom, after the hooks previously
+// registered for this denom. It must be called when the app is built, on every start as the hooks aren't persisted,
+// before the bank module services are registered: it panics once the hooks are sealed.
+func (k BaseSendKeeper) RegisterDenomHooks(denom string, hooks types.BankHooks) {
+	if k.denomHooks.sealed {
+		panic(fmt.Sprintf("denom hooks sealed; cannot register bank hooks for %s", denom))
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		panic(err)
+	}
+	if hooks == nil {
+		panic("cannot register nil bank hooks")
+	}
+
+	k.denomHooks.hooks[denom] = append(k.denomHooks.hooks[denom], hooks)
+}
+
+// SealDenomHooks seals the denom hooks, RegisterDenomHooks panics once they are sealed. It is called by the bank
+// module when its services are registered, once the app is built.
+func (k BaseSendKeeper) SealDenomHooks() {
+	k.denomHooks.sealed = true
+}
+
+// trackBeforeSend calls the TrackBeforeSend hooks registered for the denoms of amt.
+func (h *denomHooks) trackBeforeSend(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) {
+	for _, coin := range amt {
+		if hooks, ok := h.hooks[coin.Denom]; ok {
+			hooks.TrackBeforeSend(ctx, from, to, sdk.Coins{coin})
+		}
+	}
+}
+
+// beforeSend calls the BeforeSend hooks registered for the denoms of amt.
+func (h *denomHooks) beforeSend(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	for _, coin := range amt {
+		if hooks, ok := h.hooks[coin.Denom]; ok {
+			if err := hooks.BeforeSend(ctx, from, to, sdk.Coins{coin}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// afterSend calls the AfterSend hooks registered for the denoms of amt.
+func (h *denomHooks) afterSend(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	for _, coin := range amt {
+		if hooks, ok := h.hooks[coin.Denom]; ok {
+			if err := hooks.AfterSend(ctx, from, to, sdk.Coins{coin}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -368,7 +368,9 @@ func (k BaseKeeper) MintCoins(ctx context.Context, moduleName string, amounts sd
 	for _, amount := range amounts {
 		supply := k.GetSupply(ctx, amount.GetDenom())
 		supply = supply.Add(amount)
-		k.setSupply(ctx, supply)
+		if err := k.setSupply(ctx, supply); err != nil {
+			return err
+		}
 	}
 
 	k.Logger.Debug("minted coins from module account", "amount", amounts.String(), "from", moduleName)
@@ -411,7 +413,9 @@ func (k BaseKeeper) BurnCoins(ctx context.Context, address []byte, amounts sdk.C
 	for _, amount := range amounts {
 		supply := k.GetSupply(ctx, amount.GetDenom())
 		supply = supply.Sub(amount)
-		k.setSupply(ctx, supply)
+		if err := k.setSupply(ctx, supply); err != nil {
+			return err
+		}
 	}
 
 	addrStr, err := k.ak.AddressCodec().BytesToString(acc.GetAddress())
@@ -429,14 +433,20 @@ func (k BaseKeeper) BurnCoins(ctx context.Context, address []byte, amounts sdk.C
 	)
 }
 
-// setSupply sets the supply for the given coin
-func (k BaseKeeper) setSupply(ctx context.Context, coin sdk.Coin) {
+// setSupply sets the supply for the given coin and records it in the supply history
+func (k BaseKeeper) setSupply(ctx context.Context, coin sdk.Coin) error {
 	// Bank invariants and IBC requires to remove zero coins.
+	var err error
 	if coin.IsZero() {
-		_ = k.Supply.Remove(ctx, coin.Denom)
+		err = k.Supply.Remove(ctx, coin.Denom)
 	} else {
-		_ = k.Supply.Set(ctx, coin.Denom, coin.Amount)
+		err = k.Supply.Set(ctx, coin.Denom, coin.Amount)
 	}
+	if err != nil {
+		return err
+	}
+
+	return k.trackSupplyHistory(ctx, coin)
 }
 
 // trackDelegation tracks the delegation of the given account if it is a vesting account
@@ -309,6 +309,62 @@ func (suite *KeeperTestSuite) TestPrependSendRestriction() {
 	suite.Require().Equal([]int{2, 1}, calls, "restriction calls from original bank keeper")
 }
 
+func (suite *KeeperTestSuite) TestRegisterSendRestriction() {
+	var calls []string
+	testRestriction := func(name string, err error) banktypes.SendRestrictionFn {
+		return func(_ context.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			calls = append(calls, name)
+			return toAddr, err
+		}
+	}
+
+	bk := suite.bankKeeper
+	bk.SetSendRestriction(nil)
+	defer bk.SetSendRestriction(nil)
+
+	suite.mockFundAccount(accAddrs[0])
+	suite.Require().NoError(banktestutil.FundAccount(suite.ctx, bk, accAddrs[0], sdk.NewCoins(newFooCoin(1))))
+
+	suite.Require().NoError(bk.RegisterSendRestriction("b", 2, testRestriction("b", nil)))
+	suite.Require().NoError(bk.RegisterSendRestriction("a", 1, testRestriction("a", nil)))
+	suite.Require().NoError(bk.RegisterSendRestriction("c", 2, testRestriction("c", nil)))
+	bk.PrependSendRestriction(testRestriction("first", nil))
+	bk.AppendSendRestriction(testRestriction("last", nil))
+
+	suite.Require().ErrorContains(bk.RegisterSendRestriction("a", 0, testRestriction("a", nil)), "already registered")
+	suite.Require().ErrorContains(bk.RegisterSendRestriction("", 0, testRestriction("", nil)), "cannot be empty")
+	suite.Require().ErrorContains(bk.RegisterSendRestriction("nil", 0, nil), "cannot be nil")
+
+	suite.Require().Equal([]banktypes.SendRestriction{
+		{Name: "", Order: 1},
+		{Name: "a", Order: 1},
+		{Name: "b", Order: 2},
+		{Name: "c", Order: 2},
+		{Name: "", Order: 2},
+	}, bk.GetSendRestrictions())
+
+	_, err := bk.GetSendRestrictionFn()(suite.ctx, nil, nil, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"first", "a", "b", "c", "last"}, calls)
+
+	// the restrictions stop at the first error
+	calls = nil
+	suite.Require().True(bk.RemoveSendRestriction("b"))
+	suite.Require().False(bk.RemoveSendRestriction("b"))
+	suite.Require().NoError(bk.RegisterSendRestriction("b", 1, testRestriction("b", errors.New("restricted"))))
+	err = bk.SendCoins(suite.ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(1)))
+	suite.Require().ErrorContains(err, "restricted")
+	suite.Require().Equal([]string{"first", "a", "b"}, calls)
+
+	// a restriction can allow the send without running the next ones
+	calls = nil
+	suite.Require().True(bk.RemoveSendRestriction("b"))
+	suite.Require().NoError(bk.RegisterSendRestriction("b", 1, testRestriction("b", banktypes.ErrSkipRemainingSendRestrictions)))
+	suite.mockSendCoins(suite.ctx, authtypes.NewBaseAccountWithAddress(accAddrs[0]), accAddrs[1])
+	suite.Require().NoError(bk.SendCoins(suite.ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(1))))
+	suite.Require().Equal([]string{"first", "a", "b"}, calls)
+}
+
 func (suite *KeeperTestSuite) TestGetAuthority() {
 	env := runtime.NewEnvironment(runtime.NewKVStoreService(storetypes.NewKVStoreKey(banktypes.StoreKey)), coretesting.NewNopLogger())
 	NewKeeperWithAuthority := func(authority string) keeper.BaseKeeper {
@@ -722,6 +778,55 @@ func (suite *KeeperTestSuite) TestInputOutputCoins() {
 	require.Equal(expected, acc3Balances)
 }
 
+func (suite *KeeperTestSuite) TestInputOutputCoinsBatched() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	acc0StrAddr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1StrAddr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	require.NoError(err)
+	acc2StrAddr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[2])
+	require.NoError(err)
+
+	input := banktypes.Input{
+		Address: acc0StrAddr, Coins: sdk.NewCoins(newFooCoin(60), newBarCoin(20)),
+	}
+	outputs := []banktypes.Output{
+		{Address: acc1StrAddr, Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: acc2StrAddr, Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
+		{Address: acc1StrAddr, Coins: sdk.NewCoins(newFooCoin(20), newBarCoin(10))},
+	}
+
+	require.Error(suite.bankKeeper.InputOutputCoinsBatched(ctx, input, []banktypes.Output{}))
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(90), newBarCoin(30))))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.ctx = ctx
+	suite.mockInputOutputCoins([]sdk.AccountI{acc0}, accAddrs[1:3])
+	require.NoError(suite.bankKeeper.InputOutputCoinsBatched(ctx, input, outputs))
+
+	require.Equal(sdk.NewCoins(newFooCoin(30), newBarCoin(10)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(30), newBarCoin(10)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(sdk.NewCoins(newFooCoin(30), newBarCoin(10)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+
+	// one coin_spent event, then one coin_received and transfer event per recipient
+	events := ctx.EventManager().ABCIEvents()
+	require.Len(events, 5)
+	require.Equal(banktypes.EventTypeCoinSpent, events[0].Type)
+	for i, addr := range []string{acc1StrAddr, acc2StrAddr} {
+		received, transfer := events[1+2*i], events[2+2*i]
+		require.Equal(banktypes.EventTypeCoinReceived, received.Type)
+		require.Equal(banktypes.EventTypeTransfer, transfer.Type)
+		require.Equal(banktypes.AttributeKeyRecipient, transfer.Attributes[0].Key)
+		require.Equal(addr, transfer.Attributes[0].Value)
+		require.Equal(sdk.NewCoins(newFooCoin(30), newBarCoin(10)).String(), transfer.Attributes[1].Value)
+	}
+}
+
 func (suite *KeeperTestSuite) TestInputOutputCoinsWithRestrictions() {
 	type restrictionArgs struct {
 		ctx      context.Context
@@ -1228,6 +1333,125 @@ func (suite *KeeperTestSuite) TestSendCoinsWithRestrictions() {
 	}
 }
 
+type mockBankHooks struct {
+	tracked   []sdk.Coins
+	sent      []sdk.Coins
+	beforeErr error
+	afterErr  error
+}
+
+func (h *mockBankHooks) TrackBeforeSend(_ context.Context, _, _ sdk.AccAddress, amount sdk.Coins) {
+	h.tracked = append(h.tracked, amount)
+}
+
+func (h *mockBankHooks) BeforeSend(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
+	return h.beforeErr
+}
+
+func (h *mockBankHooks) AfterSend(_ context.Context, _, _ sdk.AccAddress, amount sdk.Coins) error {
+	if h.afterErr != nil {
+		return h.afterErr
+	}
+	h.sent = append(h.sent, amount)
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestDenomHooks() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	hooks := &mockBankHooks{}
+	suite.bankKeeper.RegisterDenomHooks(fooDenom, hooks)
+	require.Panics(func() { suite.bankKeeper.RegisterDenomHooks("1foo", hooks) })
+
+	// the hooks can't be registered once sealed
+	suite.bankKeeper.SealDenomHooks()
+	require.PanicsWithValue("denom hooks sealed; cannot register bank hooks for bar", func() {
+		suite.bankKeeper.RegisterDenomHooks(barDenom, hooks)
+	})
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+
+	// the hooks are only called with the coins of their denom
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10), newBarCoin(10))))
+	// funding the account from the mint module is also a transfer
+	require.Equal([]sdk.Coins{sdk.NewCoins(newFooCoin(100)), sdk.NewCoins(newFooCoin(10))}, hooks.tracked)
+	require.Equal([]sdk.Coins{sdk.NewCoins(newFooCoin(100)), sdk.NewCoins(newFooCoin(10))}, hooks.sent)
+
+	// transfers of other denoms don't call the hooks
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(10))))
+	require.Len(hooks.tracked, 2)
+
+	// a BeforeSend error blocks the transfer
+	hooks.beforeErr = errors.New("foo transfers are blocked")
+	require.ErrorContains(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))), "foo transfers are blocked")
+	require.Len(hooks.tracked, 3)
+	require.Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(30)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	acc0StrAddr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1StrAddr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	require.NoError(err)
+	input := banktypes.Input{Address: acc0StrAddr, Coins: sdk.NewCoins(newFooCoin(10))}
+	outputs := []banktypes.Output{{Address: acc1StrAddr, Coins: sdk.NewCoins(newFooCoin(10))}}
+
+	suite.mockInputOutputCoins([]sdk.AccountI{acc0}, []sdk.AccAddress{accAddrs[1]})
+	require.ErrorContains(suite.bankKeeper.InputOutputCoins(ctx, input, outputs), "foo transfers are blocked")
+
+	// an AfterSend error fails the transfer
+	hooks.beforeErr = nil
+	hooks.afterErr = errors.New("transfer tax not paid")
+	suite.mockInputOutputCoins([]sdk.AccountI{acc0}, []sdk.AccAddress{accAddrs[1]})
+	require.ErrorContains(suite.bankKeeper.InputOutputCoins(ctx, input, outputs), "transfer tax not paid")
+
+	hooks.afterErr = nil
+	suite.mockInputOutputCoins([]sdk.AccountI{acc0}, []sdk.AccAddress{accAddrs[1]})
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, input, outputs))
+	require.Len(hooks.sent, 3)
+	require.Equal(sdk.NewCoins(newFooCoin(10)), hooks.sent[2])
+}
+
+func (suite *KeeperTestSuite) TestDeniedDenoms() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(suite.bankKeeper.UpdateDeniedDenoms(ctx, []string{fooDenom}, []string{barDenom}))
+	require.True(suite.bankKeeper.IsDeniedDenom(ctx, fooDenom))
+	require.False(suite.bankKeeper.IsDeniedDenom(ctx, barDenom))
+
+	// only the denied denom emits an event, as bar wasn't denied
+	events := ctx.EventManager().Events()
+	require.Len(events, 1)
+	require.Equal(banktypes.EventTypeDenomDenied, events[0].Type)
+	require.Equal(fooDenom, events[0].Attributes[0].Value)
+
+	// the transfers of the modules, e.g. the rewards withdrawals, are allowed
+	// as only the transfers sent by accounts are denied by the msg server
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10), newBarCoin(10))))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(suite.bankKeeper.UpdateDeniedDenoms(ctx, nil, []string{fooDenom}))
+	require.Empty(suite.bankKeeper.GetDeniedDenoms(ctx))
+	events = ctx.EventManager().Events()
+	require.Len(events, 1)
+	require.Equal(banktypes.EventTypeDenomAllowed, events[0].Type)
+
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+}
+
 func (suite *KeeperTestSuite) TestSendCoins_Invalid_SendLockedCoins() {
 	balances := sdk.NewCoins(newFooCoin(50))
 
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"slices"
 
 	"github.com/hashicorp/go-metrics"
 
@@ -56,6 +57,10 @@ func (k msgServer) Send(ctx context.Context, msg *types.MsgSend) (*types.MsgSend
 		return nil, err
 	}
 
+	if err := k.checkDeniedDenoms(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
 	if k.BlockedAddr(to) {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
 	}
@@ -102,6 +107,9 @@ func (k msgServer) MultiSend(ctx context.Context, msg *types.MsgMultiSend) (*typ
 		if err := k.IsSendEnabledCoins(ctx, in.Coins...); err != nil {
 			return nil, err
 		}
+		if err := k.checkDeniedDenoms(ctx, in.Coins); err != nil {
+			return nil, err
+		}
 	}
 
 	for _, out := range msg.Outputs {
@@ -119,7 +127,7 @@ func (k msgServer) MultiSend(ctx context.Context, msg *types.MsgMultiSend) (*typ
 		}
 	}
 
-	err := k.InputOutputCoins(ctx, msg.Inputs[0], msg.Outputs)
+	err := k.InputOutputCoinsBatched(ctx, msg.Inputs[0], msg.Outputs)
 	if err != nil {
 		return nil, err
 	}
@@ -177,6 +185,35 @@ func (k msgServer) SetSendEnabled(ctx context.Context, msg *types.MsgSetSendEnab
 	return &types.MsgSetSendEnabledResponse{}, nil
 }
 
+func (k msgServer) UpdateDeniedDenoms(ctx context.Context, msg *types.MsgUpdateDeniedDenoms) (*types.MsgUpdateDeniedDenomsResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if len(msg.Deny) == 0 && len(msg.Allow) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("no denoms to deny or allow")
+	}
+
+	seen := map[string]bool{}
+	for _, denom := range append(slices.Clone(msg.Deny), msg.Allow...) {
+		if seen[denom] {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom entries found for %q", denom)
+		}
+
+		seen[denom] = true
+
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid denom %q: %s", denom, err)
+		}
+	}
+
+	if err := k.Keeper.UpdateDeniedDenoms(ctx, msg.Deny, msg.Allow); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateDeniedDenomsResponse{}, nil
+}
+
 func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
 	var (
 		from []byte
@@ -212,3 +249,21 @@ func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurn
 
 	return &types.MsgBurnResponse{}, nil
 }
+
+// checkDeniedDenoms returns an ErrDenomDenied if the transfers of any of the denoms of amt are denied.
+// Only the transfers sent by accounts are checked, the transfers of the modules, e.g. the rewards
+// withdrawals, being allowed.
+func (k msgServer) checkDeniedDenoms(ctx context.Context, amt sdk.Coins) error {
+	denied := k.GetDeniedDenoms(ctx)
+	if len(denied) == 0 {
+		return nil
+	}
+
+	for _, coin := range amt {
+		if slices.Contains(denied, coin.Denom) {
+			return types.ErrDenomDenied.Wrapf("%s transfers are denied", coin.Denom)
+		}
+	}
+
+	return nil
+}
@@ -379,6 +379,85 @@ func (suite *KeeperTestSuite) TestMsgSetSendEnabled() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgUpdateDeniedDenoms() {
+	govAccAddr, err := suite.authKeeper.AddressCodec().BytesToString(govAcc.GetAddress())
+	suite.Require().NoError(err)
+	testCases := []struct {
+		name      string
+		req       *banktypes.MsgUpdateDeniedDenoms
+		expDenied []string
+		isExpErr  bool
+		errMsg    string
+	}{
+		{
+			name:      "deny denoms",
+			req:       banktypes.NewMsgUpdateDeniedDenoms(govAccAddr, []string{"ibc/voucher", "atom"}, nil),
+			expDenied: []string{"atom", "ibc/voucher"},
+		},
+		{
+			name:      "deny and allow denoms",
+			req:       banktypes.NewMsgUpdateDeniedDenoms(govAccAddr, []string{"atom", "foocoin"}, []string{"ibc/voucher", "notdenied"}),
+			expDenied: []string{"atom", "foocoin"},
+		},
+		{
+			name:     "duplicate denoms",
+			req:      banktypes.NewMsgUpdateDeniedDenoms(govAccAddr, []string{"atom"}, []string{"atom"}),
+			isExpErr: true,
+			errMsg:   `duplicate denom entries found for "atom": invalid request`,
+		},
+		{
+			name:     "invalid denom",
+			req:      banktypes.NewMsgUpdateDeniedDenoms(govAccAddr, []string{"not a denom"}, nil),
+			isExpErr: true,
+			errMsg:   `invalid denom "not a denom": invalid denom: not a denom: invalid request`,
+		},
+		{
+			name:     "no denoms",
+			req:      banktypes.NewMsgUpdateDeniedDenoms(govAccAddr, nil, nil),
+			isExpErr: true,
+			errMsg:   "no denoms to deny or allow",
+		},
+		{
+			name:     "invalid authority",
+			req:      banktypes.NewMsgUpdateDeniedDenoms("invalid", []string{"atom"}, nil),
+			isExpErr: true,
+			errMsg:   "invalid authority",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.UpdateDeniedDenoms(suite.ctx, tc.req)
+
+			if tc.isExpErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expDenied, suite.bankKeeper.GetDeniedDenoms(suite.ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSendDeniedDenoms() {
+	acc0Addr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	suite.Require().NoError(err)
+	acc1Addr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.bankKeeper.UpdateDeniedDenoms(suite.ctx, []string{"ibc/voucher"}, nil))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("ibc/voucher", 10))
+	_, err = suite.msgServer.Send(suite.ctx, &banktypes.MsgSend{FromAddress: acc0Addr, ToAddress: acc1Addr, Amount: coins})
+	suite.Require().ErrorIs(err, banktypes.ErrDenomDenied)
+
+	_, err = suite.msgServer.MultiSend(suite.ctx, &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{{Address: acc0Addr, Coins: coins}},
+		Outputs: []banktypes.Output{{Address: acc1Addr, Coins: coins}},
+	})
+	suite.Require().ErrorIs(err, banktypes.ErrDenomDenied)
+}
+
 func (suite *KeeperTestSuite) TestMsgBurn() {
 	origCoins := sdk.NewInt64Coin("eth", 100)
 	atom0 := sdk.NewInt64Coin("atom", 0)
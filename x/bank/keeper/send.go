@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
@@ -23,9 +25,16 @@ type SendKeeper interface {
 
 	AppendSendRestriction(restriction types.SendRestrictionFn)
 	PrependSendRestriction(restriction types.SendRestrictionFn)
+	RegisterSendRestriction(name string, order int64, restriction types.SendRestrictionFn) error
+	RemoveSendRestriction(name string) bool
+	GetSendRestrictions() []types.SendRestriction
 	ClearSendRestriction()
 
+	RegisterDenomHooks(denom string, hooks types.BankHooks)
+	SealDenomHooks()
+
 	InputOutputCoins(ctx context.Context, input types.Input, outputs []types.Output) error
+	InputOutputCoinsBatched(ctx context.Context, input types.Input, outputs []types.Output) error
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 
 	GetParams(ctx context.Context) types.Params
@@ -42,6 +51,10 @@ type SendKeeper interface {
 	IsSendEnabledCoin(ctx context.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 
+	IsDeniedDenom(ctx context.Context, denom string) bool
+	GetDeniedDenoms(ctx context.Context) []string
+	UpdateDeniedDenoms(ctx context.Context, deny, allow []string) error
+
 	BlockedAddr(addr sdk.AccAddress) bool
 	GetBlockedAddresses() map[string]bool
 
@@ -67,6 +80,7 @@ type BaseSendKeeper struct {
 	authority string
 
 	sendRestriction *sendRestriction
+	denomHooks      *denomHooks
 }
 
 func NewBaseSendKeeper(
@@ -88,6 +102,7 @@ func NewBaseSendKeeper(
 		blockedAddrs:    blockedAddrs,
 		authority:       authority,
 		sendRestriction: newSendRestriction(),
+		denomHooks:      newDenomHooks(),
 	}
 }
 
@@ -101,7 +116,30 @@ func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestriction
 	k.sendRestriction.prepend(restriction)
 }
 
-// ClearSendRestriction removes the send restriction (if there is one).
+// RegisterSendRestriction adds the provided SendRestrictionFn under the given name, usually the name of the
+// module providing it, so that restrictions of different modules compose instead of overwriting each other.
+// The restrictions are run by ascending order, those of the same order in the order they are added, and
+// stop at the first error. A restriction can return types.ErrSkipRemainingSendRestrictions to allow the
+// send without running the next restrictions.
+// It returns an error if a restriction is already registered under the name.
+func (k BaseSendKeeper) RegisterSendRestriction(name string, order int64, restriction types.SendRestrictionFn) error {
+	return k.sendRestriction.register(name, order, restriction)
+}
+
+// RemoveSendRestriction removes the SendRestrictionFn registered under the given name, returning whether
+// there was one.
+func (k BaseSendKeeper) RemoveSendRestriction(name string) bool {
+	return k.sendRestriction.remove(name)
+}
+
+// GetSendRestrictions returns the names and orders of the send restrictions, in the order they are run.
+// The restrictions added with AppendSendRestriction or PrependSendRestriction have an empty name and the
+// order of the restriction they are added next to.
+func (k BaseSendKeeper) GetSendRestrictions() []types.SendRestriction {
+	return k.sendRestriction.list()
+}
+
+// ClearSendRestriction removes the send restrictions (if there are any).
 func (k BaseSendKeeper) ClearSendRestriction() {
 	k.sendRestriction.clear()
 }
@@ -128,7 +166,7 @@ func (k BaseSendKeeper) SetParams(ctx context.Context, params types.Params) erro
 		k.SetAllSendEnabled(ctx, params.SendEnabled)
 
 		// override params without SendEnabled
-		params = types.NewParams(params.DefaultSendEnabled)
+		params.SendEnabled = nil
 	}
 	return k.Params.Set(ctx, params)
 }
@@ -165,10 +203,19 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 			return err
 		}
 
+		k.denomHooks.trackBeforeSend(ctx, inAddress, outAddress, out.Coins)
+		if err := k.denomHooks.beforeSend(ctx, inAddress, outAddress, out.Coins); err != nil {
+			return err
+		}
+
 		if err := k.addCoins(ctx, outAddress, out.Coins); err != nil {
 			return err
 		}
 
+		if err := k.denomHooks.afterSend(ctx, inAddress, outAddress, out.Coins); err != nil {
+			return err
+		}
+
 		if err := k.EventService.EventManager(ctx).EmitKV(
 			types.EventTypeTransfer,
 			event.NewAttribute(types.AttributeKeyRecipient, out.Address),
@@ -181,6 +228,98 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 	return nil
 }
 
+// InputOutputCoinsBatched performs multi-send functionality like
+// InputOutputCoins, but groups the outputs by recipient so that the balance of
+// each recipient is read and written once per denom, however many outputs it
+// receives. The send restriction and the BeforeSend hooks are applied to each
+// output before any balance is credited, and the AfterSend hooks once all
+// balances are credited. A single coin_received and transfer event is emitted
+// per recipient, with the total amount it received.
+func (k BaseSendKeeper) InputOutputCoinsBatched(ctx context.Context, input types.Input, outputs []types.Output) error {
+	// Safety check ensuring that when sending coins the keeper must maintain the
+	// Check supply invariant and validity of Coins.
+	if err := types.ValidateInputOutputs(input, outputs); err != nil {
+		return err
+	}
+
+	inAddress, err := k.ak.AddressCodec().StringToBytes(input.Address)
+	if err != nil {
+		return err
+	}
+
+	err = k.subUnlockedCoins(ctx, inAddress, input.Coins)
+	if err != nil {
+		return err
+	}
+
+	type recipient struct {
+		addr    sdk.AccAddress
+		addrStr string
+		coins   sdk.Coins
+	}
+
+	// recipients are credited in the order of their first output
+	var (
+		recipients []*recipient
+		byAddr     = make(map[string]*recipient, len(outputs))
+		outAddrs   = make([]sdk.AccAddress, len(outputs))
+	)
+	for i, out := range outputs {
+		addr, err := k.ak.AddressCodec().StringToBytes(out.Address)
+		if err != nil {
+			return err
+		}
+
+		outAddress, err := k.sendRestriction.apply(ctx, inAddress, addr, out.Coins)
+		if err != nil {
+			return err
+		}
+
+		k.denomHooks.trackBeforeSend(ctx, inAddress, outAddress, out.Coins)
+		if err := k.denomHooks.beforeSend(ctx, inAddress, outAddress, out.Coins); err != nil {
+			return err
+		}
+		outAddrs[i] = outAddress
+
+		r, ok := byAddr[string(outAddress)]
+		if !ok {
+			addrStr := out.Address
+			if !outAddress.Equals(sdk.AccAddress(addr)) {
+				if addrStr, err = k.ak.AddressCodec().BytesToString(outAddress); err != nil {
+					return err
+				}
+			}
+
+			r = &recipient{addr: outAddress, addrStr: addrStr}
+			byAddr[string(outAddress)] = r
+			recipients = append(recipients, r)
+		}
+		r.coins = r.coins.Add(out.Coins...)
+	}
+
+	for _, r := range recipients {
+		if err := k.addCoins(ctx, r.addr, r.coins); err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeTransfer,
+			event.NewAttribute(types.AttributeKeyRecipient, r.addrStr),
+			event.NewAttribute(sdk.AttributeKeyAmount, r.coins.String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	for i, out := range outputs {
+		if err := k.denomHooks.afterSend(ctx, inAddress, outAddrs[i], out.Coins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // SendCoins transfers amt coins from a sending account to a receiving account.
 // An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
@@ -194,6 +333,11 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 		return err
 	}
 
+	k.denomHooks.trackBeforeSend(ctx, fromAddr, toAddr, amt)
+	if err := k.denomHooks.beforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
 	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
@@ -204,6 +348,10 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 		return err
 	}
 
+	if err := k.denomHooks.afterSend(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
 	fromAddrString, err := k.ak.AddressCodec().BytesToString(fromAddr)
 	if err != nil {
 		return err
@@ -450,40 +598,165 @@ func (k BaseSendKeeper) getSendEnabledOrDefault(ctx context.Context, denom strin
 	return defaultVal
 }
 
-// sendRestriction is a struct that houses a SendRestrictionFn.
+// IsDeniedDenom returns true if the transfers of the given denom are denied.
+func (k BaseSendKeeper) IsDeniedDenom(ctx context.Context, denom string) bool {
+	return slices.Contains(k.GetParams(ctx).DeniedDenoms, denom)
+}
+
+// GetDeniedDenoms returns the denoms which can't be transferred.
+func (k BaseSendKeeper) GetDeniedDenoms(ctx context.Context) []string {
+	return k.GetParams(ctx).DeniedDenoms
+}
+
+// UpdateDeniedDenoms adds the deny denoms to the denied denoms and removes the allow denoms from them.
+// Denoms which are already denied, or not denied, are ignored. A denom_denied or denom_allowed event is
+// emitted for each updated denom.
+func (k BaseSendKeeper) UpdateDeniedDenoms(ctx context.Context, deny, allow []string) error {
+	params := k.GetParams(ctx)
+	denied := slices.Clone(params.DeniedDenoms)
+
+	em := k.EventService.EventManager(ctx)
+	for _, denom := range deny {
+		if slices.Contains(denied, denom) {
+			continue
+		}
+
+		denied = append(denied, denom)
+		if err := em.EmitKV(types.EventTypeDenomDenied, event.NewAttribute(types.AttributeKeyDenom, denom)); err != nil {
+			return err
+		}
+	}
+
+	for _, denom := range allow {
+		i := slices.Index(denied, denom)
+		if i < 0 {
+			continue
+		}
+
+		denied = slices.Delete(denied, i, i+1)
+		if err := em.EmitKV(types.EventTypeDenomAllowed, event.NewAttribute(types.AttributeKeyDenom, denom)); err != nil {
+			return err
+		}
+	}
+
+	slices.Sort(denied)
+	params.DeniedDenoms = denied
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, params)
+}
+
+// sendRestriction is a struct that houses the SendRestrictionFn of the keeper.
 // It exists so that the SendRestrictionFn can be updated in the SendKeeper without needing to have a pointer receiver.
 type sendRestriction struct {
-	fn types.SendRestrictionFn
+	// entries are the restrictions, sorted by order, the restrictions of the same
+	// order being sorted by registration.
+	entries []sendRestrictionEntry
+}
+
+// sendRestrictionEntry is a restriction and the name and order it is registered with.
+type sendRestrictionEntry struct {
+	name  string
+	order int64
+	fn    types.SendRestrictionFn
 }
 
 // newSendRestriction creates a new sendRestriction with nil send restriction.
 func newSendRestriction() *sendRestriction {
 	return &sendRestriction{
-		fn: nil,
+		entries: nil,
 	}
 }
 
-// append adds the provided restriction to this, to be run after the existing function.
+// append adds the provided restriction to this, to be run after the existing restrictions.
 func (r *sendRestriction) append(restriction types.SendRestrictionFn) {
-	r.fn = r.fn.Then(restriction)
+	if restriction == nil {
+		return
+	}
+
+	var order int64
+	if len(r.entries) > 0 {
+		order = r.entries[len(r.entries)-1].order
+	}
+	r.entries = append(r.entries, sendRestrictionEntry{order: order, fn: restriction})
 }
 
-// prepend adds the provided restriction to this, to be run before the existing function.
+// prepend adds the provided restriction to this, to be run before the existing restrictions.
 func (r *sendRestriction) prepend(restriction types.SendRestrictionFn) {
-	r.fn = restriction.Then(r.fn)
+	if restriction == nil {
+		return
+	}
+
+	var order int64
+	if len(r.entries) > 0 {
+		order = r.entries[0].order
+	}
+	r.entries = slices.Insert(r.entries, 0, sendRestrictionEntry{order: order, fn: restriction})
+}
+
+// register adds the provided restriction to this under the given name, to be run after the
+// existing restrictions of lower or equal order and before the ones of higher order.
+func (r *sendRestriction) register(name string, order int64, restriction types.SendRestrictionFn) error {
+	if name == "" {
+		return errors.New("send restriction name cannot be empty")
+	}
+	if restriction == nil {
+		return fmt.Errorf("send restriction %s cannot be nil", name)
+	}
+	if slices.ContainsFunc(r.entries, func(e sendRestrictionEntry) bool { return e.name == name }) {
+		return fmt.Errorf("send restriction %s is already registered", name)
+	}
+
+	i := len(r.entries)
+	for i > 0 && r.entries[i-1].order > order {
+		i--
+	}
+	r.entries = slices.Insert(r.entries, i, sendRestrictionEntry{name: name, order: order, fn: restriction})
+	return nil
 }
 
-// clear removes the send restriction (sets it to nil).
+// remove removes the restriction registered under the given name, returning whether there was one.
+func (r *sendRestriction) remove(name string) bool {
+	n := len(r.entries)
+	r.entries = slices.DeleteFunc(r.entries, func(e sendRestrictionEntry) bool { return e.name == name })
+	return len(r.entries) != n
+}
+
+// clear removes the send restrictions.
 func (r *sendRestriction) clear() {
-	r.fn = nil
+	r.entries = nil
+}
+
+// list returns the names and orders of the send restrictions, in the order they are run.
+func (r *sendRestriction) list() []types.SendRestriction {
+	restrictions := make([]types.SendRestriction, len(r.entries))
+	for i, e := range r.entries {
+		restrictions[i] = types.SendRestriction{Name: e.name, Order: e.order}
+	}
+	return restrictions
 }
 
 var _ types.SendRestrictionFn = (*sendRestriction)(nil).apply
 
-// apply applies the send restriction if there is one. If not, it's a no-op.
+// apply applies the send restrictions in order until one of them returns an error. If there are
+// none, it's a no-op. A restriction returning types.ErrSkipRemainingSendRestrictions allows the send
+// without running the next restrictions.
 func (r *sendRestriction) apply(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
-	if r == nil || r.fn == nil {
+	if r == nil {
 		return toAddr, nil
 	}
-	return r.fn(ctx, fromAddr, toAddr, amt)
+
+	var err error
+	for _, e := range r.entries {
+		toAddr, err = e.fn(ctx, fromAddr, toAddr, amt)
+		if errors.Is(err, types.ErrSkipRemainingSendRestrictions) {
+			return toAddr, nil
+		}
+		if err != nil {
+			return toAddr, err
+		}
+	}
+	return toAddr, nil
 }
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// supplyHistoryBucket returns the first height of the bucket containing height.
+func supplyHistoryBucket(height, bucketBlocks uint64) uint64 {
+	return height - height%bucketBlocks
+}
+
+// trackSupplyHistory records the supply of coin.Denom for the bucket of the
+// current height, and prunes the buckets which are older than the retention
+// configured in the params. It does nothing if the supply history is disabled.
+func (k BaseKeeper) trackSupplyHistory(ctx context.Context, coin sdk.Coin) error {
+	params := k.GetParams(ctx)
+	if params.SupplyHistoryBucketBlocks == 0 {
+		return nil
+	}
+
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	if height < 0 {
+		height = 0
+	}
+
+	bucket := supplyHistoryBucket(uint64(height), params.SupplyHistoryBucketBlocks)
+	if err := k.HistoricalSupply.Set(ctx, collections.Join(coin.Denom, bucket), coin.Amount); err != nil {
+		return err
+	}
+
+	retention := params.SupplyHistoryRetentionBuckets
+	if retention == 0 || bucket < retention*params.SupplyHistoryBucketBlocks {
+		return nil
+	}
+
+	return k.pruneSupplyHistory(ctx, coin.Denom, bucket-(retention-1)*params.SupplyHistoryBucketBlocks)
+}
+
+// pruneSupplyHistory removes the supply history entries of denom older than
+// the cutoff bucket, except for the newest of them which is still the supply
+// at the cutoff bucket if no entry was recorded for it.
+func (k BaseKeeper) pruneSupplyHistory(ctx context.Context, denom string, cutoff uint64) error {
+	rng := collections.NewPrefixedPairRange[string, uint64](denom).EndExclusive(cutoff).Descending()
+	iter, err := k.HistoricalSupply.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	stale, err := iter.Keys()
+	if err != nil || len(stale) <= 1 {
+		return err
+	}
+
+	for _, key := range stale[1:] {
+		if err := k.HistoricalSupply.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSupplyAtHeight returns the supply of denom at the end of the bucket of
+// the supply history containing height, along with the first height of the
+// bucket in which it was recorded. The returned supply is the current supply
+// if height is in the current bucket. It returns collections.ErrNotFound if no
+// supply was recorded for denom at or before height, e.g. because the
+// history was pruned.
+func (k BaseKeeper) GetSupplyAtHeight(ctx context.Context, denom string, height uint64) (sdk.Coin, uint64, error) {
+	params := k.GetParams(ctx)
+	if params.SupplyHistoryBucketBlocks == 0 {
+		return sdk.Coin{}, 0, collections.ErrNotFound
+	}
+
+	bucket := supplyHistoryBucket(height, params.SupplyHistoryBucketBlocks)
+	rng := collections.NewPrefixedPairRange[string, uint64](denom).EndInclusive(bucket).Descending()
+	iter, err := k.HistoricalSupply.Iterate(ctx, rng)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return sdk.Coin{}, 0, collections.ErrNotFound
+	}
+
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	return sdk.NewCoin(denom, kv.Value), kv.Key.K2(), nil
+}
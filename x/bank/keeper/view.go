@@ -60,26 +60,28 @@ type BaseViewKeeper struct {
 	cdc codec.BinaryCodec
 	ak  types.AccountKeeper
 
-	Schema        collections.Schema
-	Supply        collections.Map[string, math.Int]
-	DenomMetadata collections.Map[string, types.Metadata]
-	SendEnabled   collections.Map[string, bool]
-	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
-	Params        collections.Item[types.Params]
+	Schema           collections.Schema
+	Supply           collections.Map[string, math.Int]
+	DenomMetadata    collections.Map[string, types.Metadata]
+	SendEnabled      collections.Map[string, bool]
+	Balances         *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
+	Params           collections.Item[types.Params]
+	HistoricalSupply collections.Map[collections.Pair[string, uint64], math.Int]
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
 func NewBaseViewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak types.AccountKeeper) BaseViewKeeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := BaseViewKeeper{
-		Environment:   env,
-		cdc:           cdc,
-		ak:            ak,
-		Supply:        collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
-		DenomMetadata: collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
-		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
-		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Environment:      env,
+		cdc:              cdc,
+		ak:               ak,
+		Supply:           collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
+		DenomMetadata:    collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
+		SendEnabled:      collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
+		Balances:         collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		HistoricalSupply: collections.NewMap(sb, types.HistoricalSupplyPrefix, "historical_supply", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), sdk.IntValue),
 	}
 
 	schema, err := sb.Build()
@@ -106,6 +108,12 @@ func (k BaseViewKeeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress)
 	return balances.Sort()
 }
 
+// CanPruneAccount implements the x/auth AccountPruneChecker interface, an
+// account can be pruned once all its balances are zero.
+func (k BaseViewKeeper) CanPruneAccount(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	return k.GetAllBalances(ctx, addr).IsZero(), nil
+}
+
 // GetAccountsBalances returns all the accounts balances from the store.
 func (k BaseViewKeeper) GetAccountsBalances(ctx context.Context) []types.Balance {
 	balances := make([]types.Balance, 0)
@@ -33,11 +33,12 @@ var (
 	_ module.AppModuleSimulation = AppModule{}
 	_ module.HasInvariants       = AppModule{}
 
-	_ appmodule.AppModule             = AppModule{}
-	_ appmodule.HasServices           = AppModule{}
-	_ appmodule.HasMigrations         = AppModule{}
-	_ appmodule.HasGenesis            = AppModule{}
-	_ appmodule.HasRegisterInterfaces = AppModule{}
+	_ appmodule.AppModule              = AppModule{}
+	_ appmodule.HasServices            = AppModule{}
+	_ appmodule.HasMigrations          = AppModule{}
+	_ appmodule.HasGenesis             = AppModule{}
+	_ appmodule.HasRegisterInterfaces  = AppModule{}
+	_ appmodule.HasOrderingConstraints = AppModule{}
 )
 
 // AppModule implements an application module for the bank module.
@@ -90,6 +91,9 @@ func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 	types.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(registrar, am.keeper)
 
+	// the app is built, the denom hooks can no longer be registered
+	am.keeper.SealDenomHooks()
+
 	return nil
 }
 
@@ -152,6 +156,14 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 	return am.cdc.MarshalJSON(gs)
 }
 
+// OrderingConstraints implements appmodule.HasOrderingConstraints.
+// Balances are initialized after the accounts holding them.
+func (AppModule) OrderingConstraints() []appmodule.OrderingConstraint {
+	return []appmodule.OrderingConstraint{
+		{Hook: appmodule.HookInitGenesis, After: []string{"auth"}},
+	}
+}
+
 // ConsensusVersion implements HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
@@ -37,6 +37,14 @@ type Params struct {
 	// As of cosmos-sdk 0.47, this only exists for backwards compatibility of genesis files.
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// denied_denoms is the list of denoms which can't be transferred, e.g. exploited IBC vouchers.
+	DeniedDenoms []string `protobuf:"bytes,3,rep,name=denied_denoms,json=deniedDenoms,proto3" json:"denied_denoms,omitempty"`
+	// supply_history_bucket_blocks is the number of blocks of each bucket of the supply history, which
+	// records the supply of each denom at the end of each bucket in which it changed. 0 disables the history.
+	SupplyHistoryBucketBlocks uint64 `protobuf:"varint,4,opt,name=supply_history_bucket_blocks,json=supplyHistoryBucketBlocks,proto3" json:"supply_history_bucket_blocks,omitempty"`
+	// supply_history_retention_buckets is the number of buckets for which the supply history is kept,
+	// older buckets are pruned. 0 keeps the full history.
+	SupplyHistoryRetentionBuckets uint64 `protobuf:"varint,5,opt,name=supply_history_retention_buckets,json=supplyHistoryRetentionBuckets,proto3" json:"supply_history_retention_buckets,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -87,6 +95,27 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetDeniedDenoms() []string {
+	if m != nil {
+		return m.DeniedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetSupplyHistoryBucketBlocks() uint64 {
+	if m != nil {
+		return m.SupplyHistoryBucketBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSupplyHistoryRetentionBuckets() uint64 {
+	if m != nil {
+		return m.SupplyHistoryRetentionBuckets
+	}
+	return 0
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -456,52 +485,57 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 706 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbd, 0x6f, 0x13, 0x4b,
-	0x10, 0xf7, 0xfa, 0x3b, 0xeb, 0x3c, 0x3d, 0xbd, 0x8d, 0xa5, 0x77, 0xf1, 0xd3, 0x3b, 0x5b, 0x6e,
-	0x30, 0x06, 0xdb, 0xf9, 0x40, 0x48, 0xb8, 0x41, 0x38, 0x7c, 0xb9, 0x40, 0xa0, 0x8b, 0x22, 0x24,
-	0x1a, 0x6b, 0xed, 0x5b, 0xec, 0x55, 0xee, 0x76, 0x4f, 0xb7, 0x7b, 0x21, 0x6e, 0xa9, 0x10, 0x15,
-	0x35, 0x55, 0x44, 0x85, 0x10, 0x85, 0x8b, 0xf4, 0xb4, 0x51, 0xaa, 0x88, 0x0a, 0xa5, 0x08, 0xc8,
-	0x29, 0x9c, 0x3f, 0x03, 0xdd, 0xde, 0x9d, 0xed, 0x08, 0xa7, 0x45, 0xa2, 0xb1, 0x77, 0xe6, 0xf7,
-	0x9b, 0x99, 0xdf, 0xcc, 0xce, 0x2d, 0xd4, 0x7b, 0x5c, 0xd8, 0x5c, 0x34, 0xba, 0x98, 0xed, 0x36,
-	0xf6, 0xd6, 0xbb, 0x44, 0xe2, 0x75, 0x65, 0xd4, 0x1d, 0x97, 0x4b, 0x8e, 0x56, 0x02, 0xbc, 0xae,
-	0x5c, 0x21, 0x5e, 0xc8, 0xf7, 0x79, 0x9f, 0x2b, 0xbc, 0xe1, 0x9f, 0x02, 0x6a, 0x61, 0x35, 0xa0,
-	0x76, 0x02, 0x20, 0x8c, 0x0b, 0xa0, 0x59, 0x15, 0x41, 0xa6, 0x55, 0x7a, 0x9c, 0xb2, 0x10, 0xff,
-	0x37, 0xc4, 0x6d, 0xd1, 0x6f, 0xec, 0xad, 0xfb, 0x7f, 0x21, 0xf0, 0x0f, 0xb6, 0x29, 0xe3, 0x0d,
-	0xf5, 0x1b, 0xb8, 0xca, 0x1f, 0x00, 0x4c, 0x3f, 0xc3, 0x2e, 0xb6, 0x05, 0x7a, 0x04, 0x97, 0x05,
-	0x61, 0x66, 0x87, 0x30, 0xdc, 0xb5, 0x88, 0xa9, 0x81, 0x52, 0xa2, 0x92, 0xdb, 0x28, 0xd5, 0x17,
-	0x68, 0xae, 0x6f, 0x13, 0x66, 0x3e, 0x08, 0x78, 0xad, 0xb8, 0x06, 0x8c, 0x9c, 0x98, 0x39, 0xd0,
-	0x1a, 0xcc, 0x9b, 0xe4, 0x25, 0xf6, 0x2c, 0xd9, 0xb9, 0x94, 0x30, 0x5e, 0x02, 0x95, 0xac, 0x81,
-	0x42, 0x6c, 0x2e, 0x45, 0xf3, 0xff, 0xb7, 0x93, 0x51, 0x55, 0x0b, 0x0a, 0xd5, 0x84, 0xb9, 0xdb,
-	0xd8, 0x0f, 0x46, 0x18, 0x28, 0x2b, 0x6f, 0xc1, 0xdc, 0x1c, 0x1b, 0xe5, 0x61, 0xca, 0x24, 0x8c,
-	0xdb, 0x1a, 0x28, 0x81, 0xca, 0x92, 0x11, 0x18, 0x48, 0x83, 0x99, 0xcb, 0x85, 0x22, 0xb3, 0x99,
-	0xbc, 0x38, 0x28, 0x82, 0xf2, 0x31, 0x80, 0xa9, 0x36, 0x73, 0x3c, 0x89, 0x36, 0x60, 0x06, 0x9b,
-	0xa6, 0x4b, 0x84, 0x08, 0x32, 0xb4, 0xb4, 0xaf, 0x87, 0xb5, 0x7c, 0xd8, 0xe6, 0xbd, 0x00, 0xd9,
-	0x96, 0x2e, 0x65, 0x7d, 0x23, 0x22, 0xa2, 0x57, 0x30, 0xe5, 0x4f, 0x58, 0x68, 0x71, 0x35, 0x95,
-	0xd5, 0xd9, 0x54, 0x04, 0x99, 0x4e, 0x65, 0x8b, 0x53, 0xd6, 0x7a, 0x78, 0x74, 0x56, 0x8c, 0x7d,
-	0xfa, 0x5e, 0xac, 0xf4, 0xa9, 0x1c, 0x78, 0xdd, 0x7a, 0x8f, 0xdb, 0xe1, 0xf5, 0x35, 0xe6, 0x1a,
-	0x94, 0x43, 0x87, 0x08, 0x15, 0x20, 0xde, 0x4f, 0x46, 0xd5, 0x65, 0x8b, 0xf4, 0x71, 0x6f, 0xd8,
-	0x51, 0x35, 0x3e, 0x4e, 0x46, 0x55, 0x60, 0x04, 0xf5, 0x9a, 0xf9, 0x37, 0x07, 0xc5, 0xd8, 0xc5,
-	0x41, 0x31, 0xf6, 0x7a, 0x32, 0xaa, 0x46, 0x72, 0xca, 0x5f, 0x00, 0x4c, 0x3f, 0xf5, 0xe4, 0x1f,
-	0xd7, 0x4d, 0x36, 0xea, 0xa6, 0xfc, 0x19, 0xc0, 0xf4, 0xb6, 0xe7, 0x38, 0xd6, 0xd0, 0x57, 0x23,
-	0xb9, 0xc4, 0x56, 0xb8, 0x71, 0xbf, 0x43, 0x8d, 0xaa, 0xd7, 0xbc, 0x1e, 0xaa, 0x01, 0xc7, 0x87,
-	0xb5, 0xff, 0x16, 0xae, 0xb9, 0x12, 0xd8, 0xd6, 0x40, 0xf9, 0x39, 0x5c, 0xba, 0xef, 0xaf, 0xd9,
-	0x0e, 0xa3, 0xf2, 0x8a, 0x05, 0x2c, 0xc0, 0x2c, 0xd9, 0x77, 0x38, 0x23, 0x4c, 0xaa, 0x0d, 0xfc,
-	0xcb, 0x98, 0xda, 0xfe, 0x72, 0x62, 0x8b, 0x62, 0x41, 0x84, 0x96, 0x28, 0x25, 0x2a, 0x4b, 0x46,
-	0x64, 0x96, 0x4f, 0xe3, 0x30, 0xfb, 0x84, 0x48, 0x6c, 0x62, 0x89, 0x51, 0x09, 0xe6, 0x4c, 0x22,
-	0x7a, 0x2e, 0x75, 0x24, 0xe5, 0x2c, 0x4c, 0x3f, 0xef, 0x42, 0x77, 0x7d, 0x06, 0xe3, 0x76, 0xc7,
-	0x63, 0x54, 0x46, 0xf7, 0xa7, 0x2f, 0xfc, 0x46, 0xa7, 0x7a, 0x0d, 0x68, 0x46, 0x47, 0x81, 0x10,
-	0x4c, 0xfa, 0x73, 0xd5, 0x12, 0x2a, 0xb7, 0x3a, 0xfb, 0xea, 0x4c, 0x2a, 0x1c, 0x0b, 0x0f, 0xb5,
-	0xa4, 0x72, 0x47, 0x26, 0xba, 0x06, 0x93, 0x0c, 0xdb, 0x44, 0x4b, 0xa9, 0xcd, 0x5a, 0x39, 0x3d,
-	0xac, 0xfd, 0x3d, 0x1b, 0x74, 0x69, 0xad, 0x7e, 0x6b, 0xd3, 0x50, 0x04, 0x74, 0x03, 0xa6, 0xc5,
-	0xd0, 0xee, 0x72, 0x4b, 0x4b, 0x5f, 0x4d, 0x0d, 0x29, 0xe8, 0x26, 0x4c, 0x78, 0x2e, 0xd5, 0x32,
-	0x8a, 0x59, 0x18, 0x9f, 0x15, 0x13, 0x3b, 0x46, 0xfb, 0xd7, 0x80, 0xdb, 0x86, 0x4f, 0x43, 0x77,
-	0x60, 0xd6, 0x73, 0x69, 0x67, 0x80, 0xc5, 0x40, 0xcb, 0xaa, 0x10, 0x7d, 0x7c, 0x56, 0xcc, 0xec,
-	0x18, 0xed, 0xc7, 0x58, 0x0c, 0x16, 0x85, 0x65, 0x3c, 0x97, 0xfa, 0x58, 0x6b, 0xf3, 0x68, 0xac,
-	0x83, 0x93, 0xb1, 0x0e, 0x7e, 0x8c, 0x75, 0xf0, 0xee, 0x5c, 0x8f, 0x9d, 0x9c, 0xeb, 0xb1, 0x6f,
-	0xe7, 0x7a, 0xec, 0x45, 0xf8, 0xbc, 0x0a, 0x73, 0xb7, 0x4e, 0x79, 0xf4, 0xdc, 0xa8, 0xc5, 0xe9,
-	0xa6, 0xd5, 0xcb, 0xb8, 0xf9, 0x33, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x68, 0x6d, 0x04, 0xcd, 0x05,
-	0x00, 0x00,
+	// 796 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xbf, 0x8f, 0x23, 0x35,
+	0x14, 0x8e, 0x37, 0xd9, 0x24, 0xeb, 0xdd, 0x13, 0xc2, 0x17, 0x09, 0x6f, 0xe0, 0x26, 0xa3, 0x34,
+	0x84, 0x40, 0x92, 0xdb, 0x5d, 0x40, 0x90, 0x06, 0x91, 0xe3, 0xc7, 0xa5, 0x40, 0xa0, 0x59, 0x56,
+	0x48, 0x08, 0x69, 0xe4, 0xc9, 0x98, 0xc4, 0xca, 0x8c, 0x3d, 0x1a, 0x7b, 0x8e, 0x4b, 0x4b, 0x85,
+	0xa8, 0xae, 0xa6, 0xda, 0x12, 0x21, 0x8a, 0x14, 0xdb, 0xd3, 0x9e, 0xae, 0x3a, 0x51, 0xa1, 0x2b,
+	0x16, 0x94, 0x2d, 0xb2, 0x7f, 0x06, 0x1a, 0x7b, 0x26, 0xc9, 0x42, 0xb6, 0x45, 0xa2, 0x49, 0xfc,
+	0xfc, 0x7d, 0xdf, 0x7b, 0xcf, 0xf6, 0x67, 0x0f, 0xb4, 0x46, 0x42, 0x86, 0x42, 0xf6, 0x3c, 0xc2,
+	0xa7, 0xbd, 0x47, 0x47, 0x1e, 0x55, 0xe4, 0x48, 0x07, 0xdd, 0x28, 0x16, 0x4a, 0xa0, 0xbb, 0x06,
+	0xef, 0xea, 0xa9, 0x0c, 0xaf, 0xd7, 0xc6, 0x62, 0x2c, 0x34, 0xde, 0x4b, 0x47, 0x86, 0x5a, 0x3f,
+	0x34, 0x54, 0xd7, 0x00, 0x99, 0xce, 0x40, 0xeb, 0x2a, 0x92, 0xae, 0xaa, 0x8c, 0x04, 0xe3, 0x19,
+	0xfe, 0x4a, 0x86, 0x87, 0x72, 0xdc, 0x7b, 0x74, 0x94, 0xfe, 0x65, 0xc0, 0xcb, 0x24, 0x64, 0x5c,
+	0xf4, 0xf4, 0xaf, 0x99, 0x6a, 0x3e, 0x29, 0xc2, 0xf2, 0x17, 0x24, 0x26, 0xa1, 0x44, 0x9f, 0xc2,
+	0x03, 0x49, 0xb9, 0xef, 0x52, 0x4e, 0xbc, 0x80, 0xfa, 0x18, 0xd8, 0xc5, 0xd6, 0xfe, 0xb1, 0xdd,
+	0xdd, 0xd2, 0x73, 0xf7, 0x94, 0x72, 0xff, 0x63, 0xc3, 0x1b, 0xec, 0x60, 0xe0, 0xec, 0xcb, 0xf5,
+	0x04, 0xba, 0x0f, 0x6b, 0x3e, 0xfd, 0x96, 0x24, 0x81, 0x72, 0x6f, 0x24, 0xdc, 0xb1, 0x41, 0xab,
+	0xea, 0xa0, 0x0c, 0xdb, 0x48, 0x81, 0xde, 0x83, 0x77, 0x7c, 0xca, 0x19, 0xf5, 0x5d, 0x9f, 0x72,
+	0x11, 0x4a, 0x5c, 0xb4, 0x8b, 0xad, 0xbd, 0xc1, 0xdd, 0x17, 0x17, 0x9d, 0x97, 0x4c, 0xf9, 0x8e,
+	0xf4, 0xa7, 0xf6, 0xfd, 0xee, 0x3b, 0xc7, 0xce, 0x81, 0x61, 0x7e, 0xa4, 0x89, 0xe8, 0x4b, 0xf8,
+	0x9a, 0x4c, 0xa2, 0x28, 0x98, 0xb9, 0x13, 0x26, 0x95, 0x88, 0x67, 0xae, 0x97, 0x8c, 0xa6, 0x54,
+	0xb9, 0x5e, 0x20, 0x46, 0x53, 0x89, 0x4b, 0x36, 0x68, 0x95, 0xb6, 0x27, 0x3a, 0x34, 0xc2, 0x87,
+	0x46, 0x37, 0xd0, 0xb2, 0x81, 0x56, 0xa1, 0x6f, 0xa0, 0xfd, 0x8f, 0xac, 0x31, 0x55, 0x94, 0x2b,
+	0x26, 0x78, 0x96, 0x5f, 0xe2, 0xdd, 0xdb, 0x33, 0xdf, 0xbb, 0x91, 0xd9, 0xc9, 0xa5, 0xa6, 0x84,
+	0xec, 0xdf, 0xfb, 0x71, 0x39, 0x6f, 0xe3, 0xb5, 0xa8, 0xf7, 0xd8, 0x18, 0xc6, 0x9c, 0x43, 0xf3,
+	0x01, 0xdc, 0xdf, 0xdc, 0x9b, 0x1a, 0xdc, 0xd5, 0x9b, 0x82, 0x81, 0x0d, 0x5a, 0x7b, 0x8e, 0x09,
+	0x10, 0x86, 0x95, 0x9b, 0xdb, 0x9a, 0x87, 0xfd, 0xd2, 0xf5, 0x79, 0x03, 0x34, 0x9f, 0x01, 0xb8,
+	0x3b, 0xe4, 0x51, 0xa2, 0xd0, 0x31, 0xac, 0x10, 0xdf, 0x8f, 0xa9, 0x94, 0x26, 0xc3, 0x00, 0xff,
+	0x7e, 0xd1, 0xa9, 0x65, 0x87, 0xfa, 0xa1, 0x41, 0x4e, 0x55, 0xcc, 0xf8, 0xd8, 0xc9, 0x89, 0xe8,
+	0x3b, 0xb8, 0x9b, 0xfa, 0x49, 0xe2, 0x1d, 0xed, 0x81, 0xc3, 0xb5, 0x07, 0x24, 0x5d, 0x79, 0xe0,
+	0x81, 0x60, 0x7c, 0xf0, 0xc9, 0xd3, 0xcb, 0x46, 0xe1, 0x97, 0x3f, 0x1b, 0xad, 0x31, 0x53, 0x93,
+	0xc4, 0xeb, 0x8e, 0x44, 0x98, 0x99, 0xb5, 0xb7, 0xb1, 0x40, 0x35, 0x8b, 0xa8, 0xd4, 0x02, 0xf9,
+	0xd3, 0x72, 0xde, 0x3e, 0x08, 0xe8, 0x98, 0x8c, 0x66, 0xae, 0xae, 0xf1, 0xf3, 0x72, 0xde, 0x06,
+	0x8e, 0xa9, 0xd7, 0xaf, 0xfd, 0x70, 0xde, 0x28, 0x5c, 0x9f, 0x37, 0x0a, 0xdf, 0x2f, 0xe7, 0xed,
+	0xbc, 0x9d, 0xe6, 0x6f, 0x00, 0x96, 0x3f, 0x4f, 0xd4, 0xff, 0x6e, 0x35, 0xd5, 0x7c, 0x35, 0xcd,
+	0x5f, 0x01, 0x2c, 0x9f, 0x6a, 0x53, 0xa4, 0xdd, 0x28, 0xa1, 0x48, 0x80, 0xc1, 0x7f, 0xd6, 0x8d,
+	0xae, 0xd7, 0x7f, 0x23, 0xeb, 0x06, 0x3c, 0xbb, 0xe8, 0xbc, 0xba, 0xf5, 0x52, 0xeb, 0x06, 0x87,
+	0x18, 0x34, 0xbf, 0x82, 0x7b, 0xfa, 0x7e, 0x9d, 0x71, 0xa6, 0x6e, 0x31, 0x60, 0x1d, 0x56, 0xe9,
+	0xe3, 0x48, 0x70, 0xca, 0x95, 0x76, 0xe0, 0x1d, 0x67, 0x15, 0xa7, 0xe6, 0x24, 0x01, 0x23, 0x92,
+	0x66, 0x17, 0xd9, 0xc9, 0xc3, 0xe6, 0x8b, 0x1d, 0x58, 0xfd, 0x8c, 0x2a, 0xe2, 0x13, 0x45, 0x90,
+	0x0d, 0xf7, 0x7d, 0x2a, 0x47, 0x31, 0x8b, 0xd2, 0xdb, 0x91, 0xa5, 0xdf, 0x9c, 0x42, 0x1f, 0xa4,
+	0x0c, 0x2e, 0x42, 0x37, 0xe1, 0x4c, 0xe5, 0xe7, 0x67, 0x6d, 0x7d, 0x91, 0x56, 0xfd, 0x3a, 0xd0,
+	0xcf, 0x87, 0x12, 0x21, 0x58, 0x4a, 0xf7, 0x15, 0x17, 0x75, 0x6e, 0x3d, 0x4e, 0xbb, 0xf3, 0x99,
+	0x8c, 0x02, 0x32, 0xd3, 0xaf, 0xc3, 0x9e, 0x93, 0x87, 0xe8, 0x75, 0x58, 0xe2, 0x24, 0xa4, 0xfa,
+	0x6a, 0x6f, 0x7b, 0x7d, 0xde, 0x3e, 0x71, 0x34, 0x01, 0xbd, 0x09, 0xcb, 0x72, 0x16, 0x7a, 0x22,
+	0xc0, 0xe5, 0xdb, 0xa9, 0x19, 0x05, 0xbd, 0x05, 0x8b, 0x49, 0xcc, 0x70, 0x45, 0x33, 0xeb, 0x8b,
+	0xcb, 0x46, 0xf1, 0xcc, 0x19, 0xfe, 0x5b, 0xf0, 0xae, 0x93, 0xd2, 0xd0, 0xfb, 0xb0, 0x9a, 0xc4,
+	0xcc, 0x9d, 0x10, 0x39, 0xc1, 0x55, 0x2d, 0xb1, 0x16, 0x97, 0x8d, 0xca, 0x99, 0x33, 0x7c, 0x48,
+	0xe4, 0x64, 0x9b, 0xac, 0x92, 0xc4, 0x2c, 0xc5, 0x06, 0x27, 0x4f, 0x17, 0x16, 0x78, 0xbe, 0xb0,
+	0xc0, 0x5f, 0x0b, 0x0b, 0x3c, 0xb9, 0xb2, 0x0a, 0xcf, 0xaf, 0xac, 0xc2, 0x1f, 0x57, 0x56, 0xe1,
+	0xeb, 0xec, 0x63, 0x22, 0xfd, 0x69, 0x97, 0x89, 0xfc, 0xb9, 0xd1, 0xc6, 0xf1, 0xca, 0xfa, 0x3b,
+	0x70, 0xf2, 0xf7, 0x00, 0xbe, 0xf6, 0x25, 0xf8, 0xbb, 0x06, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -580,6 +614,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SupplyHistoryRetentionBuckets != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.SupplyHistoryRetentionBuckets))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SupplyHistoryBucketBlocks != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.SupplyHistoryBucketBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DeniedDenoms) > 0 {
+		for iNdEx := len(m.DeniedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedDenoms[iNdEx])
+			copy(dAtA[i:], m.DeniedDenoms[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.DeniedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -928,6 +981,18 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
+	if len(m.DeniedDenoms) > 0 {
+		for _, s := range m.DeniedDenoms {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.SupplyHistoryBucketBlocks != 0 {
+		n += 1 + sovBank(uint64(m.SupplyHistoryBucketBlocks))
+	}
+	if m.SupplyHistoryRetentionBuckets != 0 {
+		n += 1 + sovBank(uint64(m.SupplyHistoryRetentionBuckets))
+	}
 	return n
 }
 
@@ -1154,6 +1219,76 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedDenoms = append(m.DeniedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyHistoryBucketBlocks", wireType)
+			}
+			m.SupplyHistoryBucketBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupplyHistoryBucketBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyHistoryRetentionBuckets", wireType)
+			}
+			m.SupplyHistoryRetentionBuckets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupplyHistoryRetentionBuckets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -21,4 +21,10 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// denied denoms events name and attributes
+	EventTypeDenomDenied  = "denom_denied"
+	EventTypeDenomAllowed = "denom_allowed"
+
+	AttributeKeyDenom = "denom"
 )
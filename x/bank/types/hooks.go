@@ -0,0 +1,55 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankHooks are called by the bank keeper around the transfers of the denoms
+// they are registered for. The amount given to the hooks only contains the
+// coins of the denom the hooks are registered for.
+type BankHooks interface {
+	// TrackBeforeSend is called before a transfer, and can't abort it.
+	// It is meant for modules keeping track of transfers, e.g. for accounting.
+	TrackBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins)
+	// BeforeSend is called before a transfer, which is aborted if it returns an
+	// error, e.g. to block transfers of a denom.
+	BeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error
+	// AfterSend is called after a transfer, which is reverted if it returns an
+	// error, e.g. to charge a transfer tax.
+	AfterSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error
+}
+
+// combine multiple bank hooks, all hook functions are run in array sequence
+var _ BankHooks = MultiBankHooks{}
+
+type MultiBankHooks []BankHooks
+
+func NewMultiBankHooks(hooks ...BankHooks) MultiBankHooks {
+	return hooks
+}
+
+func (h MultiBankHooks) TrackBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) {
+	for i := range h {
+		h[i].TrackBeforeSend(ctx, from, to, amount)
+	}
+}
+
+func (h MultiBankHooks) BeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	for i := range h {
+		if err := h[i].BeforeSend(ctx, from, to, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiBankHooks) AfterSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterSend(ctx, from, to, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -39,6 +39,9 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// HistoricalSupplyPrefix is the prefix for the supply history, keyed by denom and bucket height.
+	HistoricalSupplyPrefix = collections.NewPrefix(6)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
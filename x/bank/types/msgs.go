@@ -30,3 +30,12 @@ func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefau
 		UseDefaultFor: useDefaultFor,
 	}
 }
+
+// NewMsgUpdateDeniedDenoms constructs a message to deny and allow the transfers of denoms.
+func NewMsgUpdateDeniedDenoms(authority string, deny, allow []string) *MsgUpdateDeniedDenoms {
+	return &MsgUpdateDeniedDenoms{
+		Authority: authority,
+		Deny:      deny,
+		Allow:     allow,
+	}
+}
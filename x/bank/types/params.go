@@ -31,9 +31,27 @@ func (p Params) Validate() error {
 	if len(p.SendEnabled) > 0 {
 		return errors.New("use of send_enabled in params is no longer supported")
 	}
+	if err := validateDeniedDenoms(p.DeniedDenoms); err != nil {
+		return err
+	}
 	return validateIsBool(p.DefaultSendEnabled)
 }
 
+// validateDeniedDenoms checks that the denied denoms are valid and unique.
+func validateDeniedDenoms(denoms []string) error {
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid denied denom %q: %w", denom, err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate denied denom %q", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
 // Validate gets any errors with this SendEnabled entry.
 func (se SendEnabled) Validate() error {
 	return sdk.ValidateDenom(se.Denom)
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -51,6 +52,12 @@ func ComposeMintingRestrictions(restrictions ...MintingRestrictionFn) MintingRes
 	}
 }
 
+// ErrSkipRemainingSendRestrictions can be returned by a SendRestrictionFn to allow
+// the send to the returned toAddr without running the send restrictions
+// registered after it in the bank keeper. It is not returned as an error by the
+// bank keeper.
+var ErrSkipRemainingSendRestrictions = errors.New("skip remaining send restrictions")
+
 // A SendRestrictionFn can restrict sends and/or provide a new receiver address.
 type SendRestrictionFn func(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error)
 
@@ -76,6 +83,8 @@ func (r SendRestrictionFn) Then(second SendRestrictionFn) SendRestrictionFn {
 // Otherwise, a new SendRestrictionFn is returned that runs the non-nil restrictions in the order they are given.
 // The composition runs each send restriction until an error is encountered and returns that error,
 // otherwise it returns the toAddr of the last send restriction.
+// A ErrSkipRemainingSendRestrictions error stops the composition too, and is returned with the toAddr
+// provided along it.
 func ComposeSendRestrictions(restrictions ...SendRestrictionFn) SendRestrictionFn {
 	toRun := make([]SendRestrictionFn, 0, len(restrictions))
 	for _, r := range restrictions {
@@ -405,6 +405,110 @@ func (m *MsgSetSendEnabledResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetSendEnabledResponse proto.InternalMessageInfo
 
+// MsgUpdateDeniedDenoms is the Msg/UpdateDeniedDenoms request type.
+//
+// Denied denoms that are not included in this message are left unchanged.
+type MsgUpdateDeniedDenoms struct {
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// deny is the list of denoms to add to the denied denoms.
+	Deny []string `protobuf:"bytes,2,rep,name=deny,proto3" json:"deny,omitempty"`
+	// allow is the list of denoms to remove from the denied denoms.
+	// If a denom is included that isn't denied, it will be ignored.
+	Allow []string `protobuf:"bytes,3,rep,name=allow,proto3" json:"allow,omitempty"`
+}
+
+func (m *MsgUpdateDeniedDenoms) Reset()         { *m = MsgUpdateDeniedDenoms{} }
+func (m *MsgUpdateDeniedDenoms) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDeniedDenoms) ProtoMessage()    {}
+func (*MsgUpdateDeniedDenoms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{8}
+}
+func (m *MsgUpdateDeniedDenoms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDeniedDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDeniedDenoms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDeniedDenoms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDeniedDenoms.Merge(m, src)
+}
+func (m *MsgUpdateDeniedDenoms) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDeniedDenoms) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDeniedDenoms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDeniedDenoms proto.InternalMessageInfo
+
+func (m *MsgUpdateDeniedDenoms) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateDeniedDenoms) GetDeny() []string {
+	if m != nil {
+		return m.Deny
+	}
+	return nil
+}
+
+func (m *MsgUpdateDeniedDenoms) GetAllow() []string {
+	if m != nil {
+		return m.Allow
+	}
+	return nil
+}
+
+// MsgUpdateDeniedDenomsResponse defines the Msg/UpdateDeniedDenoms response type.
+type MsgUpdateDeniedDenomsResponse struct {
+}
+
+func (m *MsgUpdateDeniedDenomsResponse) Reset()         { *m = MsgUpdateDeniedDenomsResponse{} }
+func (m *MsgUpdateDeniedDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDeniedDenomsResponse) ProtoMessage()    {}
+func (*MsgUpdateDeniedDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{9}
+}
+func (m *MsgUpdateDeniedDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDeniedDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDeniedDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDeniedDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDeniedDenomsResponse.Merge(m, src)
+}
+func (m *MsgUpdateDeniedDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDeniedDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDeniedDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDeniedDenomsResponse proto.InternalMessageInfo
+
 // MsgBurn defines a message for burning coins.
 type MsgBurn struct {
 	FromAddress string        `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
@@ -415,7 +519,7 @@ func (m *MsgBurn) Reset()         { *m = MsgBurn{} }
 func (m *MsgBurn) String() string { return proto.CompactTextString(m) }
 func (*MsgBurn) ProtoMessage()    {}
 func (*MsgBurn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1d8cb1613481f5b7, []int{8}
+	return fileDescriptor_1d8cb1613481f5b7, []int{10}
 }
 func (m *MsgBurn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,7 +556,7 @@ func (m *MsgBurnResponse) Reset()         { *m = MsgBurnResponse{} }
 func (m *MsgBurnResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnResponse) ProtoMessage()    {}
 func (*MsgBurnResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1d8cb1613481f5b7, []int{9}
+	return fileDescriptor_1d8cb1613481f5b7, []int{11}
 }
 func (m *MsgBurnResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,6 +594,8 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.bank.v1beta1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgSetSendEnabled)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabled")
 	proto.RegisterType((*MsgSetSendEnabledResponse)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabledResponse")
+	proto.RegisterType((*MsgUpdateDeniedDenoms)(nil), "cosmos.bank.v1beta1.MsgUpdateDeniedDenoms")
+	proto.RegisterType((*MsgUpdateDeniedDenomsResponse)(nil), "cosmos.bank.v1beta1.MsgUpdateDeniedDenomsResponse")
 	proto.RegisterType((*MsgBurn)(nil), "cosmos.bank.v1beta1.MsgBurn")
 	proto.RegisterType((*MsgBurnResponse)(nil), "cosmos.bank.v1beta1.MsgBurnResponse")
 }
@@ -497,57 +603,62 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/tx.proto", fileDescriptor_1d8cb1613481f5b7) }
 
 var fileDescriptor_1d8cb1613481f5b7 = []byte{
-	// 795 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x4f, 0x13, 0x4d,
-	0x18, 0xee, 0x16, 0xbe, 0x92, 0x0e, 0xfd, 0x3e, 0xc2, 0x42, 0x3e, 0x60, 0x25, 0x6d, 0xd9, 0x18,
-	0x82, 0x08, 0x5b, 0xca, 0x0f, 0x49, 0x6a, 0x34, 0x5a, 0x14, 0xa3, 0x49, 0xa3, 0x29, 0xf1, 0xa0,
-	0x97, 0x66, 0xdb, 0x1d, 0x96, 0x0d, 0xdd, 0x9d, 0x66, 0x67, 0x16, 0xe9, 0xc1, 0xc4, 0x78, 0x32,
-	0xc6, 0x83, 0x67, 0x4f, 0xdc, 0x34, 0xc6, 0x03, 0x07, 0x8e, 0xfe, 0x01, 0xc4, 0x13, 0xe1, 0xe4,
-	0x49, 0x0d, 0x1c, 0xf0, 0x8f, 0xf0, 0x60, 0xe6, 0xc7, 0x6e, 0x97, 0xfe, 0x24, 0x7a, 0xe9, 0x6e,
-	0xe7, 0x7d, 0x9f, 0xe7, 0x9d, 0xe7, 0xd9, 0x79, 0xdf, 0x01, 0x93, 0x15, 0x84, 0x6d, 0x84, 0x33,
-	0x65, 0xdd, 0xd9, 0xce, 0xec, 0x64, 0xcb, 0x90, 0xe8, 0xd9, 0x0c, 0xd9, 0xd5, 0x6a, 0x2e, 0x22,
-	0x48, 0x1e, 0xe1, 0x51, 0x8d, 0x46, 0x35, 0x11, 0x55, 0x46, 0x4d, 0x64, 0x22, 0x16, 0xcf, 0xd0,
-	0x37, 0x9e, 0xaa, 0x24, 0x03, 0x22, 0x0c, 0x03, 0xa2, 0x0a, 0xb2, 0x9c, 0x96, 0x78, 0xa8, 0x10,
-	0xe3, 0xe5, 0xf1, 0x09, 0x1e, 0x2f, 0x71, 0x62, 0x51, 0x97, 0x87, 0xc6, 0x04, 0xd4, 0xc6, 0x66,
-	0x66, 0x27, 0x4b, 0x1f, 0x22, 0x30, 0xac, 0xdb, 0x96, 0x83, 0x32, 0xec, 0x97, 0x2f, 0xa9, 0x9f,
-	0xa2, 0x60, 0xa0, 0x80, 0xcd, 0x0d, 0xe8, 0x18, 0xf2, 0x75, 0x90, 0xd8, 0x74, 0x91, 0x5d, 0xd2,
-	0x0d, 0xc3, 0x85, 0x18, 0x8f, 0x4b, 0x69, 0x69, 0x26, 0x9e, 0x1f, 0x3f, 0x3e, 0x98, 0x1f, 0x15,
-	0xfc, 0xb7, 0x79, 0x64, 0x83, 0xb8, 0x96, 0x63, 0x16, 0x07, 0x69, 0xb6, 0x58, 0x92, 0x57, 0x01,
-	0x20, 0x28, 0x80, 0x46, 0x7b, 0x40, 0xe3, 0x04, 0xf9, 0xc0, 0x3a, 0x88, 0xe9, 0x36, 0xf2, 0x1c,
-	0x32, 0xde, 0x97, 0xee, 0x9b, 0x19, 0x5c, 0x9c, 0xd0, 0x02, 0x13, 0x31, 0xf4, 0x4d, 0xd4, 0xd6,
-	0x90, 0xe5, 0xe4, 0xd7, 0x0f, 0xbf, 0xa5, 0x22, 0x1f, 0xbf, 0xa7, 0x66, 0x4c, 0x8b, 0x6c, 0x79,
-	0x65, 0xad, 0x82, 0x6c, 0xa1, 0x5c, 0x3c, 0xe6, 0xb1, 0xb1, 0x9d, 0x21, 0xf5, 0x1a, 0xc4, 0x0c,
-	0x80, 0xdf, 0x9d, 0xed, 0xcf, 0x26, 0xaa, 0xd0, 0xd4, 0x2b, 0xf5, 0x12, 0xf5, 0x16, 0x7f, 0x38,
-	0xdb, 0x9f, 0x95, 0x8a, 0xa2, 0x60, 0x6e, 0xe1, 0xd5, 0x5e, 0x2a, 0xf2, 0x73, 0x2f, 0x15, 0x79,
-	0x49, 0xf3, 0xc2, 0xda, 0x5f, 0x9f, 0xed, 0xcf, 0xca, 0x21, 0x4e, 0x61, 0x91, 0x3a, 0x0c, 0x86,
-	0xc4, 0x6b, 0x11, 0xe2, 0x1a, 0x72, 0x30, 0x54, 0x3f, 0x4b, 0x20, 0x51, 0xc0, 0x66, 0xc1, 0xab,
-	0x12, 0x8b, 0xd9, 0x78, 0x03, 0xc4, 0x2c, 0xa7, 0xe6, 0x11, 0x6a, 0x20, 0x15, 0xa4, 0x68, 0x6d,
-	0x4e, 0x85, 0x76, 0x9f, 0xa6, 0xe4, 0xe3, 0x54, 0x91, 0xd8, 0x14, 0x07, 0xc9, 0xb7, 0xc0, 0x00,
-	0xf2, 0x08, 0xc3, 0x47, 0x19, 0xfe, 0x52, 0x5b, 0xfc, 0x43, 0x96, 0x13, 0x26, 0xf0, 0x61, 0xb9,
-	0xab, 0xbe, 0x24, 0x41, 0x49, 0xc5, 0x8c, 0x9d, 0x17, 0x13, 0xec, 0x56, 0xfd, 0x1f, 0x8c, 0x86,
-	0xff, 0x07, 0xb2, 0x8e, 0x25, 0x26, 0xf5, 0x71, 0xcd, 0xd0, 0x09, 0x7c, 0xa4, 0xbb, 0xba, 0x8d,
-	0xe5, 0x6b, 0x20, 0xae, 0x7b, 0x64, 0x0b, 0xb9, 0x16, 0xa9, 0xf7, 0x3c, 0x1d, 0x8d, 0x54, 0xf9,
-	0x26, 0x88, 0xd5, 0x18, 0x03, 0x3b, 0x17, 0x9d, 0x14, 0xf1, 0x22, 0xe7, 0x2c, 0xe1, 0xa8, 0xdc,
-	0xbd, 0xe3, 0x83, 0xf9, 0xa1, 0x86, 0x80, 0xf4, 0x82, 0xb6, 0xbc, 0x4a, 0xf5, 0x35, 0x4a, 0x50,
-	0x89, 0x53, 0x21, 0x89, 0xbb, 0xbc, 0x6f, 0x9a, 0x04, 0xa8, 0x1a, 0x18, 0x6b, 0x5a, 0xf2, 0xf5,
-	0xe6, 0x46, 0xda, 0xd4, 0x50, 0x7f, 0x49, 0x60, 0x98, 0x7d, 0x6f, 0x42, 0xbd, 0xb9, 0xeb, 0xe8,
-	0xe5, 0x2a, 0x34, 0xfe, 0xd8, 0x86, 0x35, 0x90, 0xc0, 0xd0, 0x31, 0x4a, 0x90, 0xf3, 0x88, 0xcf,
-	0x9b, 0x6e, 0x6b, 0x46, 0xa8, 0x5e, 0x71, 0x10, 0x87, 0x8a, 0x4f, 0x83, 0x21, 0x0f, 0xc3, 0x92,
-	0x01, 0x37, 0x75, 0xaf, 0x4a, 0x4a, 0x9b, 0xc8, 0x65, 0x7d, 0x13, 0x2f, 0xfe, 0xeb, 0x61, 0x78,
-	0x87, 0xaf, 0xae, 0x23, 0x37, 0x97, 0xbf, 0x90, 0x67, 0x93, 0xcd, 0x67, 0x3c, 0x2c, 0x54, 0x5d,
-	0x00, 0x13, 0x2d, 0x8b, 0xdd, 0x0d, 0x7b, 0x2f, 0xb1, 0x71, 0x92, 0xf7, 0x5c, 0xe7, 0xef, 0xc6,
-	0x49, 0x36, 0x98, 0x0a, 0xd1, 0x1e, 0x53, 0x21, 0xe8, 0xe6, 0x39, 0xbf, 0x9b, 0x5b, 0x36, 0xb6,
-	0x92, 0x6d, 0x69, 0x70, 0x75, 0x9a, 0x1d, 0x6f, 0xba, 0xd1, 0x2e, 0x8a, 0x56, 0xb2, 0x8b, 0x6f,
-	0xfa, 0x41, 0x5f, 0x01, 0x9b, 0xf2, 0x03, 0xd0, 0xcf, 0xba, 0x7b, 0xb2, 0xed, 0xe7, 0x12, 0x43,
-	0x41, 0xb9, 0xdc, 0x2d, 0xea, 0x17, 0x92, 0x9f, 0x80, 0x78, 0x63, 0x5c, 0x4c, 0x75, 0x82, 0x04,
-	0x29, 0xca, 0x95, 0x9e, 0x29, 0x01, 0x75, 0x09, 0xf4, 0x33, 0xf3, 0x3b, 0x6e, 0x93, 0x46, 0x3b,
-	0x6f, 0x33, 0xec, 0x87, 0x3a, 0xf2, 0xa5, 0xd5, 0x0f, 0xf9, 0x19, 0x48, 0x9c, 0x9b, 0x09, 0x1d,
-	0xa9, 0xc2, 0x59, 0xca, 0xdc, 0x45, 0xb2, 0xba, 0x14, 0x5e, 0x5e, 0x95, 0x9f, 0x83, 0xff, 0x9a,
-	0xfa, 0x70, 0xba, 0xb3, 0xd9, 0xe1, 0x3c, 0x45, 0xbb, 0x58, 0x5e, 0xd7, 0xf2, 0xca, 0x3f, 0x2f,
-	0xe8, 0x48, 0xca, 0x2f, 0x1d, 0x9e, 0x24, 0xa5, 0xa3, 0x93, 0xa4, 0xf4, 0xe3, 0x24, 0x29, 0xbd,
-	0x3d, 0x4d, 0x46, 0x8e, 0x4e, 0x93, 0x91, 0xaf, 0xa7, 0xc9, 0xc8, 0x53, 0x71, 0x21, 0x63, 0x63,
-	0x5b, 0xb3, 0x90, 0x3f, 0x80, 0xd8, 0x5d, 0x54, 0x8e, 0xb1, 0xbb, 0x76, 0xe9, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x76, 0x62, 0x35, 0xee, 0x3d, 0x08, 0x00, 0x00,
+	// 874 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xbf, 0x4f, 0xeb, 0x46,
+	0x1c, 0x8f, 0x03, 0x04, 0xe5, 0x48, 0x1b, 0x61, 0xd2, 0x02, 0x2e, 0x4d, 0x82, 0x85, 0x10, 0x4d,
+	0xc1, 0x21, 0x01, 0x8a, 0x94, 0xaa, 0x55, 0x1b, 0x28, 0x55, 0x2b, 0x45, 0xad, 0x82, 0x3a, 0xb4,
+	0x4b, 0xe4, 0xc4, 0x87, 0xb1, 0x48, 0x7c, 0x91, 0xef, 0x0c, 0x64, 0xa8, 0x54, 0x75, 0x6a, 0x3b,
+	0x75, 0xac, 0x3a, 0xb1, 0xb5, 0xaa, 0x3a, 0x30, 0x30, 0x76, 0xe9, 0x86, 0xde, 0x84, 0x98, 0xde,
+	0xf4, 0xde, 0x13, 0x0c, 0xbc, 0x3f, 0xe2, 0x0d, 0x4f, 0x77, 0x3e, 0x3b, 0x4e, 0x9c, 0x38, 0xd1,
+	0x63, 0x49, 0xec, 0xfb, 0x7c, 0x7f, 0x7d, 0x3e, 0x3e, 0x7f, 0x7c, 0x60, 0xa9, 0x81, 0x70, 0x0b,
+	0xe1, 0x7c, 0x5d, 0x35, 0x4f, 0xf2, 0xa7, 0x85, 0x3a, 0x24, 0x6a, 0x21, 0x4f, 0xce, 0x95, 0xb6,
+	0x85, 0x08, 0x12, 0xe7, 0x1c, 0x54, 0xa1, 0xa8, 0xc2, 0x51, 0x29, 0xa5, 0x23, 0x1d, 0x31, 0x3c,
+	0x4f, 0xaf, 0x9c, 0x50, 0x29, 0xed, 0x15, 0xc2, 0xd0, 0x2b, 0xd4, 0x40, 0x86, 0x19, 0xc0, 0x7d,
+	0x8d, 0x58, 0x5d, 0x07, 0x5f, 0x74, 0xf0, 0x9a, 0x53, 0x98, 0xf7, 0x75, 0xa0, 0x79, 0x9e, 0xda,
+	0xc2, 0x7a, 0xfe, 0xb4, 0x40, 0xff, 0x38, 0x30, 0xab, 0xb6, 0x0c, 0x13, 0xe5, 0xd9, 0xaf, 0xb3,
+	0x24, 0xff, 0x1b, 0x05, 0xd3, 0x15, 0xac, 0x1f, 0x42, 0x53, 0x13, 0x3f, 0x06, 0x89, 0x23, 0x0b,
+	0xb5, 0x6a, 0xaa, 0xa6, 0x59, 0x10, 0xe3, 0x05, 0x21, 0x2b, 0xac, 0xc5, 0xcb, 0x0b, 0xb7, 0x57,
+	0x1b, 0x29, 0x5e, 0xff, 0x73, 0x07, 0x39, 0x24, 0x96, 0x61, 0xea, 0xd5, 0x19, 0x1a, 0xcd, 0x97,
+	0xc4, 0x5d, 0x00, 0x08, 0xf2, 0x52, 0xa3, 0x23, 0x52, 0xe3, 0x04, 0xb9, 0x89, 0x1d, 0x10, 0x53,
+	0x5b, 0xc8, 0x36, 0xc9, 0xc2, 0x44, 0x76, 0x62, 0x6d, 0xa6, 0xb8, 0xa8, 0x78, 0x22, 0x62, 0xe8,
+	0x8a, 0xa8, 0xec, 0x21, 0xc3, 0x2c, 0x1f, 0x5c, 0x3f, 0xcb, 0x44, 0xfe, 0x79, 0x9e, 0x59, 0xd3,
+	0x0d, 0x72, 0x6c, 0xd7, 0x95, 0x06, 0x6a, 0x71, 0xe6, 0xfc, 0x6f, 0x03, 0x6b, 0x27, 0x79, 0xd2,
+	0x69, 0x43, 0xcc, 0x12, 0xf0, 0x9f, 0x0f, 0x97, 0xb9, 0x44, 0x13, 0xea, 0x6a, 0xa3, 0x53, 0xa3,
+	0xda, 0xe2, 0xbf, 0x1f, 0x2e, 0x73, 0x42, 0x95, 0x37, 0x2c, 0x6d, 0xfe, 0x72, 0x91, 0x89, 0xbc,
+	0xbc, 0xc8, 0x44, 0x7e, 0xa6, 0x71, 0x7e, 0xee, 0xbf, 0x3d, 0x5c, 0xe6, 0x44, 0x5f, 0x4d, 0x2e,
+	0x91, 0x3c, 0x0b, 0x92, 0xfc, 0xb2, 0x0a, 0x71, 0x1b, 0x99, 0x18, 0xca, 0xff, 0x09, 0x20, 0x51,
+	0xc1, 0x7a, 0xc5, 0x6e, 0x12, 0x83, 0xc9, 0xf8, 0x09, 0x88, 0x19, 0x66, 0xdb, 0x26, 0x54, 0x40,
+	0x4a, 0x48, 0x52, 0x06, 0xec, 0x0a, 0xe5, 0x2b, 0x1a, 0x52, 0x8e, 0x53, 0x46, 0x7c, 0x28, 0x27,
+	0x49, 0xfc, 0x0c, 0x4c, 0x23, 0x9b, 0xb0, 0xfc, 0x28, 0xcb, 0x7f, 0x6f, 0x60, 0xfe, 0x37, 0x36,
+	0xe9, 0x2b, 0xe0, 0xa6, 0x95, 0x3e, 0x74, 0x29, 0xf1, 0x92, 0x94, 0xcc, 0x7c, 0x2f, 0x19, 0x6f,
+	0x5a, 0xf9, 0x5d, 0x90, 0xf2, 0xdf, 0x7b, 0xb4, 0x6e, 0x05, 0x46, 0xf5, 0xbb, 0xb6, 0xa6, 0x12,
+	0xf8, 0xad, 0x6a, 0xa9, 0x2d, 0x2c, 0x7e, 0x04, 0xe2, 0xaa, 0x4d, 0x8e, 0x91, 0x65, 0x90, 0xce,
+	0xc8, 0xdd, 0xd1, 0x0d, 0x15, 0x3f, 0x05, 0xb1, 0x36, 0xab, 0xc0, 0xf6, 0xc5, 0x30, 0x46, 0x4e,
+	0x93, 0x1e, 0x49, 0x9c, 0xac, 0xd2, 0x97, 0xb7, 0x57, 0x1b, 0xc9, 0x2e, 0x81, 0xec, 0xa6, 0xb2,
+	0xbd, 0x4b, 0xf9, 0x75, 0x5b, 0x50, 0x8a, 0xcb, 0x3e, 0x8a, 0xe7, 0xce, 0x7b, 0xd3, 0x47, 0x40,
+	0x56, 0xc0, 0x7c, 0xdf, 0x92, 0xcb, 0xb7, 0x34, 0x37, 0xa0, 0x87, 0xfc, 0x4a, 0x00, 0xb3, 0xec,
+	0x79, 0x13, 0xaa, 0xcd, 0x17, 0xa6, 0x5a, 0x6f, 0x42, 0xed, 0x8d, 0x65, 0xd8, 0x03, 0x09, 0x0c,
+	0x4d, 0xad, 0x06, 0x9d, 0x3a, 0xfc, 0xf1, 0x66, 0x07, 0x8a, 0xe1, 0xeb, 0x57, 0x9d, 0xc1, 0xbe,
+	0xe6, 0xab, 0x20, 0x69, 0x63, 0x58, 0xd3, 0xe0, 0x91, 0x6a, 0x37, 0x49, 0xed, 0x08, 0x59, 0xec,
+	0xbd, 0x89, 0x57, 0xdf, 0xb2, 0x31, 0xdc, 0x77, 0x56, 0x0f, 0x90, 0x55, 0x2a, 0x8f, 0xa5, 0xd9,
+	0x52, 0xff, 0x1e, 0xf7, 0x13, 0x95, 0x37, 0xc1, 0x62, 0x60, 0x31, 0x5c, 0xb0, 0xff, 0x05, 0xf0,
+	0x8e, 0xa7, 0xf0, 0x3e, 0x34, 0x0d, 0xa8, 0xed, 0x43, 0x13, 0x3d, 0x62, 0xef, 0x88, 0x60, 0x52,
+	0x83, 0x66, 0x87, 0x89, 0x15, 0xaf, 0xb2, 0x6b, 0x31, 0x05, 0xa6, 0xd4, 0x66, 0x13, 0x9d, 0x71,
+	0xe6, 0xce, 0x4d, 0xe9, 0x20, 0x30, 0xd0, 0x4e, 0x31, 0xc8, 0x38, 0xdb, 0xcb, 0x38, 0x38, 0xa9,
+	0xbc, 0x0d, 0xde, 0x1f, 0x08, 0x84, 0x30, 0xdf, 0x29, 0xca, 0x7f, 0x09, 0xcc, 0x48, 0xcb, 0xb6,
+	0x65, 0x3e, 0xce, 0x48, 0x0b, 0x9e, 0x1f, 0x46, 0x47, 0xf8, 0xa1, 0xe7, 0x63, 0xeb, 0xae, 0x8f,
+	0x05, 0x07, 0x2b, 0x04, 0xac, 0x4d, 0x5e, 0x05, 0x49, 0x3e, 0x68, 0x18, 0xa3, 0x42, 0xf1, 0x8f,
+	0x29, 0x30, 0x51, 0xc1, 0xba, 0xf8, 0x35, 0x98, 0x64, 0xbe, 0xb6, 0x34, 0x70, 0xa3, 0x72, 0x3b,
+	0x94, 0x56, 0xc2, 0x50, 0xb7, 0x91, 0xf8, 0x3d, 0x88, 0x77, 0x8d, 0x72, 0x79, 0x58, 0x8a, 0x17,
+	0x22, 0x7d, 0x30, 0x32, 0xc4, 0x2b, 0x5d, 0x03, 0x93, 0x4c, 0xfc, 0xa1, 0x63, 0x52, 0x54, 0x5a,
+	0x09, 0x43, 0x3d, 0xf3, 0x9b, 0x7b, 0x12, 0xd4, 0x43, 0x3c, 0x03, 0x89, 0x1e, 0x37, 0x1c, 0x5a,
+	0xca, 0x1f, 0x25, 0xad, 0x8f, 0x13, 0x15, 0xd2, 0x78, 0x7b, 0x57, 0xfc, 0x11, 0xbc, 0xdd, 0xe7,
+	0x40, 0xab, 0xc3, 0xc5, 0xf6, 0xc7, 0x49, 0xca, 0x78, 0x71, 0xe1, 0xed, 0x7f, 0x15, 0x80, 0x38,
+	0xe0, 0x85, 0xce, 0x85, 0x13, 0xf3, 0xc7, 0x4a, 0xc5, 0xf1, 0x63, 0xc3, 0x9e, 0x41, 0x51, 0x9a,
+	0xfa, 0x89, 0x7e, 0x18, 0xca, 0x5b, 0xd7, 0x77, 0x69, 0xe1, 0xe6, 0x2e, 0x2d, 0xbc, 0xb8, 0x4b,
+	0x0b, 0xbf, 0xdf, 0xa7, 0x23, 0x37, 0xf7, 0xe9, 0xc8, 0xd3, 0xfb, 0x74, 0xe4, 0x07, 0x7e, 0x2c,
+	0xc2, 0xda, 0x89, 0x62, 0x20, 0xf7, 0x33, 0xc0, 0x4e, 0x04, 0xf5, 0x18, 0x3b, 0xf1, 0x6c, 0xbd,
+	0x1e, 0x00, 0x4d, 0x29, 0x01, 0x83, 0xc3, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -576,6 +687,9 @@ type MsgClient interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// UpdateDeniedDenoms is a governance operation for adding denoms to and
+	// removing denoms from the list of denoms which can't be transferred.
+	UpdateDeniedDenoms(ctx context.Context, in *MsgUpdateDeniedDenoms, opts ...grpc.CallOption) (*MsgUpdateDeniedDenomsResponse, error)
 }
 
 type msgClient struct {
@@ -631,6 +745,15 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) UpdateDeniedDenoms(ctx context.Context, in *MsgUpdateDeniedDenoms, opts ...grpc.CallOption) (*MsgUpdateDeniedDenomsResponse, error) {
+	out := new(MsgUpdateDeniedDenomsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/UpdateDeniedDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Send defines a method for sending coins from one account to another account.
@@ -647,6 +770,9 @@ type MsgServer interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// UpdateDeniedDenoms is a governance operation for adding denoms to and
+	// removing denoms from the list of denoms which can't be transferred.
+	UpdateDeniedDenoms(context.Context, *MsgUpdateDeniedDenoms) (*MsgUpdateDeniedDenomsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -668,6 +794,9 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) SetSendEnabled(ctx context.Context, req *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (*UnimplementedMsgServer) UpdateDeniedDenoms(ctx context.Context, req *MsgUpdateDeniedDenoms) (*MsgUpdateDeniedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeniedDenoms not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -763,6 +892,24 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateDeniedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDeniedDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDeniedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/UpdateDeniedDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDeniedDenoms(ctx, req.(*MsgUpdateDeniedDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -787,6 +934,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "UpdateDeniedDenoms",
+			Handler:    _Msg_UpdateDeniedDenoms_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -1079,6 +1230,77 @@ func (m *MsgSetSendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateDeniedDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDeniedDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDeniedDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allow) > 0 {
+		for iNdEx := len(m.Allow) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allow[iNdEx])
+			copy(dAtA[i:], m.Allow[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Allow[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Deny) > 0 {
+		for iNdEx := len(m.Deny) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Deny[iNdEx])
+			copy(dAtA[i:], m.Deny[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Deny[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDeniedDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDeniedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDeniedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgBurn) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1277,6 +1499,40 @@ func (m *MsgSetSendEnabledResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateDeniedDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Deny) > 0 {
+		for _, s := range m.Deny {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Allow) > 0 {
+		for _, s := range m.Allow {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateDeniedDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgBurn) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2040,6 +2296,202 @@ func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateDeniedDenoms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDeniedDenoms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDeniedDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deny", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deny = append(m.Deny, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allow = append(m.Allow, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDeniedDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDeniedDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDeniedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -21,6 +21,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current consensus parameters",
 				},
+				{
+					RpcMethod:      "ParamsAtHeight",
+					Use:            "params-at-height [height]",
+					Short:          "Query the consensus parameters in effect at the given height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "height"}},
+				},
+				{
+					RpcMethod: "Halt",
+					Use:       "halt",
+					Short:     "Query the halt height and time coordinated on-chain",
+				},
 			},
 			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
 				"comet": cmtservice.CometBFTAutoCLIDescriptor,
@@ -42,6 +53,54 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod:      "UpdateBlockParams",
+					Use:            "update-block-params-proposal [block]",
+					Short:          "Submit a proposal to update only the block consensus params",
+					Example:        fmt.Sprintf(`%s tx consensus update-block-params-proposal '{ block }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "block"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "UpdateEvidenceParams",
+					Use:            "update-evidence-params-proposal [evidence]",
+					Short:          "Submit a proposal to update only the evidence consensus params",
+					Example:        fmt.Sprintf(`%s tx consensus update-evidence-params-proposal '{ evidence }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "evidence"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "UpdateValidatorParams",
+					Use:            "update-validator-params-proposal [validator]",
+					Short:          "Submit a proposal to update only the validator consensus params",
+					Example:        fmt.Sprintf(`%s tx consensus update-validator-params-proposal '{ validator }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "UpdateSynchronyParams",
+					Use:            "update-synchrony-params-proposal [synchrony]",
+					Short:          "Submit a proposal to update only the synchrony consensus params",
+					Example:        fmt.Sprintf(`%s tx consensus update-synchrony-params-proposal '{ synchrony }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "synchrony"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "UpdateFeatureParams",
+					Use:            "update-feature-params-proposal [feature]",
+					Short:          "Submit a proposal to update only the feature consensus params",
+					Example:        fmt.Sprintf(`%s tx consensus update-feature-params-proposal '{ feature }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "feature"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "SetHalt",
+					Use:            "set-halt-proposal [halt-height] [halt-time]",
+					Short:          "Submit a proposal to set the height and time (in Unix seconds) after which the chain halts, zero disabling each of them",
+					Example:        fmt.Sprintf(`%s tx consensus set-halt-proposal 1000000 0`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "halt_height"}, {ProtoField: "halt_time"}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
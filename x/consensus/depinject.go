@@ -1,12 +1,18 @@
 package consensus
 
 import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/exp/maps"
+
 	modulev1 "cosmossdk.io/api/cosmos/consensus/module/v1"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
 	"cosmossdk.io/x/consensus/keeper"
+	"cosmossdk.io/x/consensus/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -23,6 +29,7 @@ func init() {
 	appconfig.RegisterModule(
 		&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetHooks),
 	)
 }
 
@@ -63,7 +70,8 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	k := keeper.NewKeeper(in.Cdc, in.Environment, authorityAddr)
 	m := NewAppModule(in.Cdc, k)
 	baseappOpt := func(app *baseapp.BaseApp) {
-		app.SetParamStore(k.ParamsStore)
+		app.SetParamStore(k.ParamStore())
+		app.SetHaltStore(k)
 	}
 
 	return ModuleOutputs{
@@ -72,3 +80,28 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		BaseAppOption: baseappOpt,
 	}
 }
+
+// InvokeSetHooks sets the consensus params hooks provided by the other modules
+// on the keeper.
+func InvokeSetHooks(keeper keeper.Keeper, hooks map[string]types.ConsensusParamsHooksWrapper) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	// Default ordering is lexical by module name.
+	modNames := maps.Keys(hooks)
+	order := modNames
+	sort.Strings(order)
+
+	var multiHooks types.MultiConsensusParamsHooks
+	for _, modName := range order {
+		hook, ok := hooks[modName]
+		if !ok {
+			return fmt.Errorf("can't find consensus params hooks for module %s", modName)
+		}
+		multiHooks = append(multiHooks, hook)
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
+}
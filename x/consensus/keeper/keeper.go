@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -26,6 +27,15 @@ type Keeper struct {
 
 	authority   string
 	ParamsStore collections.Item[cmtproto.ConsensusParams]
+	// ParamsHistory indexes the consensus params by the height they were set at.
+	ParamsHistory collections.Map[int64, cmtproto.ConsensusParams]
+	// HaltInfo is the halt height and time coordinated on-chain, checked by BaseApp
+	// before finalizing each block.
+	HaltInfo collections.Item[types.HaltInfo]
+
+	// hooks is shared by all the copies of the keeper, so that hooks set after
+	// the keeper has been handed to the app module are called.
+	hooks *types.ConsensusParamsHooks
 }
 
 var _ exported.ConsensusParamSetter = Keeper{}.ParamsStore
@@ -33,16 +43,74 @@ var _ exported.ConsensusParamSetter = Keeper{}.ParamsStore
 func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, authority string) Keeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	return Keeper{
-		Environment: env,
-		authority:   authority,
-		ParamsStore: collections.NewItem(sb, collections.NewPrefix("Consensus"), "params", codec.CollValue[cmtproto.ConsensusParams](cdc)),
+		Environment:   env,
+		authority:     authority,
+		ParamsStore:   collections.NewItem(sb, collections.NewPrefix("Consensus"), "params", codec.CollValue[cmtproto.ConsensusParams](cdc)),
+		ParamsHistory: collections.NewMap(sb, types.ParamsHistoryPrefix, "params_history", collections.Int64Key, codec.CollValue[cmtproto.ConsensusParams](cdc)),
+		HaltInfo:      collections.NewItem(sb, types.HaltPrefix, "halt", codec.CollValue[types.HaltInfo](cdc)),
+		hooks:         new(types.ConsensusParamsHooks),
 	}
 }
 
+// SetHooks sets the consensus params hooks.
+func (k Keeper) SetHooks(hooks types.ConsensusParamsHooks) {
+	if *k.hooks != nil {
+		panic("cannot set consensus params hooks twice")
+	}
+
+	*k.hooks = hooks
+}
+
 func (k *Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// SetParams sets the consensus params and records them in the params history
+// at the current block height.
+func (k Keeper) SetParams(ctx context.Context, cp cmtproto.ConsensusParams) error {
+	if err := k.ParamsStore.Set(ctx, cp); err != nil {
+		return err
+	}
+
+	return k.ParamsHistory.Set(ctx, k.HeaderService.HeaderInfo(ctx).Height, cp)
+}
+
+// ParamStore returns the consensus params store to be set on BaseApp. Unlike
+// ParamsStore, every write through it is recorded in the params history.
+func (k Keeper) ParamStore() exported.ConsensusParamSetter {
+	return paramStore{k: k}
+}
+
+type paramStore struct {
+	k Keeper
+}
+
+func (s paramStore) Get(ctx context.Context) (cmtproto.ConsensusParams, error) {
+	return s.k.ParamsStore.Get(ctx)
+}
+
+func (s paramStore) Has(ctx context.Context) (bool, error) {
+	return s.k.ParamsStore.Has(ctx)
+}
+
+func (s paramStore) Set(ctx context.Context, cp cmtproto.ConsensusParams) error {
+	return s.k.SetParams(ctx, cp)
+}
+
+// GetHalt returns the halt height and time set through MsgSetHalt, zero if
+// no halt is scheduled. It implements the BaseApp HaltStore interface.
+func (k Keeper) GetHalt(ctx context.Context) (height, time uint64, err error) {
+	halt, err := k.HaltInfo.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, 0, nil
+		}
+		return 0, 0, err
+	}
+
+	return halt.Height, halt.Time, nil
+}
+
 // Querier
 
 var _ types.QueryServer = Keeper{}
@@ -57,20 +125,177 @@ func (k Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types
 	return &types.QueryParamsResponse{Params: &params}, nil
 }
 
+// ParamsAtHeight queries the params of consensus module in effect at the given height,
+// that is the last params set at or before that height.
+func (k Keeper) ParamsAtHeight(ctx context.Context, req *types.QueryParamsAtHeightRequest) (*types.QueryParamsAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must be positive")
+	}
+
+	iter, err := k.ParamsHistory.Iterate(ctx, new(collections.Range[int64]).EndInclusive(req.Height).Descending())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil, status.Errorf(codes.NotFound, "no consensus params recorded at or before height %d", req.Height)
+	}
+
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParamsAtHeightResponse{Params: &kv.Value, SetHeight: kv.Key}, nil
+}
+
+// Halt queries the halt height and time coordinated on-chain.
+func (k Keeper) Halt(ctx context.Context, _ *types.QueryHaltRequest) (*types.QueryHaltResponse, error) {
+	height, time, err := k.GetHalt(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHaltResponse{Halt: &types.HaltInfo{Height: height, Time: time}}, nil
+}
+
 // MsgServer
 
 var _ types.MsgServer = Keeper{}
 
 func (k Keeper) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	consensusParams, err := msg.ToProtoConsensusParams()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.updateParams(ctx, msg.Authority, consensusParams); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// UpdateBlockParams updates the block parameters, leaving the other parameters unchanged.
+func (k Keeper) UpdateBlockParams(ctx context.Context, msg *types.MsgUpdateBlockParams) (*types.MsgUpdateBlockParamsResponse, error) {
+	consensusParams, err := msg.ToProtoConsensusParams()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.updateParams(ctx, msg.Authority, consensusParams); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateBlockParamsResponse{}, nil
+}
+
+// UpdateEvidenceParams updates the evidence parameters, leaving the other parameters unchanged.
+func (k Keeper) UpdateEvidenceParams(ctx context.Context, msg *types.MsgUpdateEvidenceParams) (*types.MsgUpdateEvidenceParamsResponse, error) {
+	consensusParams, err := msg.ToProtoConsensusParams()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.updateParams(ctx, msg.Authority, consensusParams); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateEvidenceParamsResponse{}, nil
+}
+
+// UpdateValidatorParams updates the validator parameters, leaving the other parameters unchanged.
+func (k Keeper) UpdateValidatorParams(ctx context.Context, msg *types.MsgUpdateValidatorParams) (*types.MsgUpdateValidatorParamsResponse, error) {
+	consensusParams, err := msg.ToProtoConsensusParams()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.updateParams(ctx, msg.Authority, consensusParams); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateValidatorParamsResponse{}, nil
+}
+
+// UpdateSynchronyParams updates the synchrony parameters, leaving the other parameters unchanged.
+func (k Keeper) UpdateSynchronyParams(ctx context.Context, msg *types.MsgUpdateSynchronyParams) (*types.MsgUpdateSynchronyParamsResponse, error) {
+	consensusParams, err := msg.ToProtoConsensusParams()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.updateParams(ctx, msg.Authority, consensusParams); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateSynchronyParamsResponse{}, nil
+}
+
+// UpdateFeatureParams updates the feature parameters, leaving the other parameters unchanged.
+func (k Keeper) UpdateFeatureParams(ctx context.Context, msg *types.MsgUpdateFeatureParams) (*types.MsgUpdateFeatureParamsResponse, error) {
+	consensusParams, err := msg.ToProtoConsensusParams()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.updateParams(ctx, msg.Authority, consensusParams); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateFeatureParamsResponse{}, nil
+}
+
+// SetHalt sets the height and time at which the chain halts. Setting both to
+// zero cancels a scheduled halt.
+func (k Keeper) SetHalt(ctx context.Context, msg *types.MsgSetHalt) (*types.MsgSetHaltResponse, error) {
 	if k.GetAuthority() != msg.Authority {
 		return nil, fmt.Errorf("invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
 	}
 
-	consensusParams, err := msg.ToProtoConsensusParams()
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	if msg.HaltHeight != 0 && msg.HaltHeight < uint64(headerInfo.Height) {
+		return nil, fmt.Errorf("halt height %d is before the current height %d", msg.HaltHeight, headerInfo.Height)
+	}
+
+	if msg.HaltTime != 0 && msg.HaltTime < uint64(headerInfo.Time.Unix()) {
+		return nil, fmt.Errorf("halt time %d is before the current block time %d", msg.HaltTime, headerInfo.Time.Unix())
+	}
+
+	var err error
+	if msg.HaltHeight == 0 && msg.HaltTime == 0 {
+		err = k.HaltInfo.Remove(ctx)
+	} else {
+		err = k.HaltInfo.Set(ctx, types.HaltInfo{Height: msg.HaltHeight, Time: msg.HaltTime})
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		"set_halt",
+		event.NewAttribute("authority", msg.Authority),
+		event.NewAttribute("halt_height", strconv.FormatUint(msg.HaltHeight, 10)),
+		event.NewAttribute("halt_time", strconv.FormatUint(msg.HaltTime, 10)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetHaltResponse{}, nil
+}
+
+// updateParams applies the non-nil sections of consensusParams on top of the
+// stored consensus params.
+func (k Keeper) updateParams(ctx context.Context, authority string, consensusParams cmtproto.ConsensusParams) error {
+	if k.GetAuthority() != authority {
+		return fmt.Errorf("invalid authority; expected %s, got %s", k.GetAuthority(), authority)
+	}
+
 	paramsProto, err := k.ParamsStore.Get(ctx)
 
 	var params cmttypes.ConsensusParams
@@ -78,7 +303,7 @@ func (k Keeper) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*
 		if errors.Is(err, collections.ErrNotFound) {
 			params = cmttypes.ConsensusParams{}
 		} else {
-			return nil, err
+			return err
 		}
 	} else {
 		params = cmttypes.ConsensusParamsFromProto(paramsProto)
@@ -87,23 +312,25 @@ func (k Keeper) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*
 	nextParams := params.Update(&consensusParams)
 
 	if err := nextParams.ValidateBasic(); err != nil {
-		return nil, err
+		return err
 	}
 
 	if err := params.ValidateUpdate(&consensusParams, k.HeaderService.HeaderInfo(ctx).Height); err != nil {
-		return nil, err
+		return err
 	}
 
-	if err := k.ParamsStore.Set(ctx, nextParams.ToProto()); err != nil {
-		return nil, err
+	if err := k.SetParams(ctx, nextParams.ToProto()); err != nil {
+		return err
 	}
 
-	if err := k.EventService.EventManager(ctx).EmitKV(
-		"update_consensus_params",
-		event.NewAttribute("authority", msg.Authority),
-		event.NewAttribute("parameters", consensusParams.String())); err != nil {
-		return nil, err
+	if k.hooks != nil && *k.hooks != nil {
+		if err := (*k.hooks).AfterParamsUpdated(ctx, params.ToProto(), nextParams.ToProto()); err != nil {
+			return err
+		}
 	}
 
-	return &types.MsgUpdateParamsResponse{}, nil
+	return k.EventService.EventManager(ctx).EmitKV(
+		"update_consensus_params",
+		event.NewAttribute("authority", authority),
+		event.NewAttribute("parameters", consensusParams.String()))
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -603,3 +605,218 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdatePartialParams() {
+	s.SetupTest(false)
+	defaultConsensusParams := cmttypes.DefaultConsensusParams().ToProto()
+	authority := s.consensusParamsKeeper.GetAuthority()
+
+	_, err := s.consensusParamsKeeper.UpdateBlockParams(s.ctx, &types.MsgUpdateBlockParams{
+		Authority: authority,
+		Block:     &cmtproto.BlockParams{MaxBytes: 2000000, MaxGas: 100},
+	})
+	s.Require().NoError(err)
+
+	res, err := s.consensusParamsKeeper.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(&cmtproto.BlockParams{MaxBytes: 2000000, MaxGas: 100}, res.Params.Block)
+	s.Require().Equal(defaultConsensusParams.Evidence, res.Params.Evidence)
+	s.Require().Equal(defaultConsensusParams.Validator, res.Params.Validator)
+
+	evidence := &cmtproto.EvidenceParams{MaxAgeNumBlocks: 10, MaxAgeDuration: time.Hour, MaxBytes: 100}
+	_, err = s.consensusParamsKeeper.UpdateEvidenceParams(s.ctx, &types.MsgUpdateEvidenceParams{Authority: authority, Evidence: evidence})
+	s.Require().NoError(err)
+
+	validator := &cmtproto.ValidatorParams{PubKeyTypes: []string{cmttypes.ABCIPubKeyTypeSecp256k1}}
+	_, err = s.consensusParamsKeeper.UpdateValidatorParams(s.ctx, &types.MsgUpdateValidatorParams{Authority: authority, Validator: validator})
+	s.Require().NoError(err)
+
+	_, err = s.consensusParamsKeeper.UpdateSynchronyParams(s.ctx, &types.MsgUpdateSynchronyParams{
+		Authority: authority,
+		Synchrony: &cmtproto.SynchronyParams{Precision: getDuration(time.Second)},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.consensusParamsKeeper.UpdateFeatureParams(s.ctx, &types.MsgUpdateFeatureParams{
+		Authority: authority,
+		Feature:   &cmtproto.FeatureParams{VoteExtensionsEnableHeight: &gogotypes.Int64Value{Value: 10}},
+	})
+	s.Require().NoError(err)
+
+	res, err = s.consensusParamsKeeper.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(&cmtproto.BlockParams{MaxBytes: 2000000, MaxGas: 100}, res.Params.Block)
+	s.Require().Equal(evidence, res.Params.Evidence)
+	s.Require().Equal(validator, res.Params.Validator)
+	s.Require().Equal(getDuration(time.Second), res.Params.Synchrony.Precision)
+	s.Require().Equal(defaultConsensusParams.Synchrony.MessageDelay, res.Params.Synchrony.MessageDelay)
+	s.Require().Equal(int64(10), res.Params.Feature.VoteExtensionsEnableHeight.GetValue())
+
+	// invalid updates
+	_, err = s.consensusParamsKeeper.UpdateBlockParams(s.ctx, &types.MsgUpdateBlockParams{Authority: authority})
+	s.Require().ErrorContains(err, "block parameters must be present")
+
+	_, err = s.consensusParamsKeeper.UpdateBlockParams(s.ctx, &types.MsgUpdateBlockParams{
+		Authority: authority,
+		Block:     &cmtproto.BlockParams{MaxGas: -10, MaxBytes: -10},
+	})
+	s.Require().ErrorContains(err, "block.MaxBytes must be -1 or greater than 0. Got -10")
+
+	_, err = s.consensusParamsKeeper.UpdateEvidenceParams(s.ctx, &types.MsgUpdateEvidenceParams{Authority: "invalid", Evidence: evidence})
+	s.Require().ErrorContains(err, "invalid authority")
+
+	_, err = s.consensusParamsKeeper.UpdateFeatureParams(s.ctx, &types.MsgUpdateFeatureParams{
+		Authority: authority,
+		Feature:   &cmtproto.FeatureParams{PbtsEnableHeight: &gogotypes.Int64Value{Value: 1}},
+	})
+	s.Require().ErrorContains(err, "PBTS")
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryParamsAtHeight() {
+	s.SetupTest(false)
+	defaultConsensusParams := cmttypes.DefaultConsensusParams().ToProto()
+	authority := s.consensusParamsKeeper.GetAuthority()
+
+	// the params set in SetupTest bypass the history
+	_, err := s.queryClient.ParamsAtHeight(s.ctx, &types.QueryParamsAtHeightRequest{Height: 5})
+	s.Require().ErrorContains(err, "no consensus params recorded at or before height 5")
+
+	s.Require().NoError(s.consensusParamsKeeper.ParamStore().Set(s.ctx, defaultConsensusParams))
+
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 10})
+	block := &cmtproto.BlockParams{MaxBytes: 2000000, MaxGas: 100}
+	_, err = s.consensusParamsKeeper.UpdateBlockParams(ctx, &types.MsgUpdateBlockParams{Authority: authority, Block: block})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		height       int64
+		expErrMsg    string
+		expBlock     *cmtproto.BlockParams
+		expSetHeight int64
+	}{
+		{"invalid height", 0, "height must be positive", nil, 0},
+		{"before first params", 4, "no consensus params recorded", nil, 0},
+		{"first params", 5, "", defaultConsensusParams.Block, 5},
+		{"between updates", 9, "", defaultConsensusParams.Block, 5},
+		{"updated params", 10, "", block, 10},
+		{"after update", 100, "", block, 10},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.ParamsAtHeight(s.ctx, &types.QueryParamsAtHeightRequest{Height: tc.height})
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expBlock, res.Params.Block)
+			s.Require().Equal(tc.expSetHeight, res.SetHeight)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	s.SetupTest(false)
+	params, err := s.consensusParamsKeeper.ParamsStore.Get(s.ctx)
+	s.Require().NoError(err)
+
+	// the params set before the history existed are recorded at the upgrade
+	// height
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 8})
+	s.Require().NoError(consensusparamkeeper.NewMigrator(*s.consensusParamsKeeper).Migrate1to2(ctx))
+
+	res, err := s.queryClient.ParamsAtHeight(s.ctx, &types.QueryParamsAtHeightRequest{Height: 20})
+	s.Require().NoError(err)
+	s.Require().Equal(&params, res.Params)
+	s.Require().Equal(int64(8), res.SetHeight)
+
+	_, err = s.queryClient.ParamsAtHeight(s.ctx, &types.QueryParamsAtHeightRequest{Height: 7})
+	s.Require().ErrorContains(err, "no consensus params recorded at or before height 7")
+}
+
+type mockConsensusParamsHooks struct {
+	calls     int
+	oldParams cmtproto.ConsensusParams
+	newParams cmtproto.ConsensusParams
+	err       error
+}
+
+func (h *mockConsensusParamsHooks) AfterParamsUpdated(_ context.Context, oldParams, newParams cmtproto.ConsensusParams) error {
+	h.calls++
+	h.oldParams, h.newParams = oldParams, newParams
+	return h.err
+}
+
+func (s *KeeperTestSuite) TestParamsHooks() {
+	s.SetupTest(false)
+	defaultConsensusParams := cmttypes.DefaultConsensusParams().ToProto()
+	authority := s.consensusParamsKeeper.GetAuthority()
+
+	hooks := &mockConsensusParamsHooks{}
+	s.consensusParamsKeeper.SetHooks(types.NewMultiConsensusParamsHooks(hooks))
+	s.Require().Panics(func() { s.consensusParamsKeeper.SetHooks(hooks) })
+
+	block := &cmtproto.BlockParams{MaxBytes: 2000000, MaxGas: 100}
+	_, err := s.consensusParamsKeeper.UpdateBlockParams(s.ctx, &types.MsgUpdateBlockParams{Authority: authority, Block: block})
+	s.Require().NoError(err)
+	s.Require().Equal(1, hooks.calls)
+	s.Require().Equal(defaultConsensusParams.Block, hooks.oldParams.Block)
+	s.Require().Equal(block, hooks.newParams.Block)
+
+	// failed updates don't call the hooks
+	_, err = s.consensusParamsKeeper.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: authority, Block: block})
+	s.Require().Error(err)
+	s.Require().Equal(1, hooks.calls)
+
+	// hook errors abort the update
+	hooks.err = errors.New("hook failure")
+	_, err = s.consensusParamsKeeper.UpdateBlockParams(s.ctx, &types.MsgUpdateBlockParams{
+		Authority: authority,
+		Block:     &cmtproto.BlockParams{MaxBytes: 3000000, MaxGas: 100},
+	})
+	s.Require().ErrorContains(err, "hook failure")
+	s.Require().Equal(2, hooks.calls)
+}
+
+func (s *KeeperTestSuite) TestSetHalt() {
+	s.SetupTest(false)
+	authority := s.consensusParamsKeeper.GetAuthority()
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 5, Time: time.Unix(100, 0)})
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgSetHalt
+		expErrMsg string
+		expHalt   *types.HaltInfo
+	}{
+		{"invalid authority", &types.MsgSetHalt{Authority: "invalid", HaltHeight: 10}, "invalid authority", nil},
+		{"halt height in the past", &types.MsgSetHalt{Authority: authority, HaltHeight: 4}, "halt height 4 is before the current height 5", nil},
+		{"halt time in the past", &types.MsgSetHalt{Authority: authority, HaltTime: 99}, "halt time 99 is before the current block time 100", nil},
+		{"set halt height", &types.MsgSetHalt{Authority: authority, HaltHeight: 10}, "", &types.HaltInfo{Height: 10}},
+		{"set halt height and time", &types.MsgSetHalt{Authority: authority, HaltHeight: 5, HaltTime: 200}, "", &types.HaltInfo{Height: 5, Time: 200}},
+		{"cancel halt", &types.MsgSetHalt{Authority: authority}, "", &types.HaltInfo{}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.consensusParamsKeeper.SetHalt(ctx, tc.msg)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			height, haltTime, err := s.consensusParamsKeeper.GetHalt(ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expHalt.Height, height)
+			s.Require().Equal(tc.expHalt.Time, haltTime)
+
+			res, err := s.queryClient.Halt(ctx, &types.QueryHaltRequest{})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expHalt, res.Halt)
+		})
+	}
+}
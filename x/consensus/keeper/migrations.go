@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/consensus module state from the consensus version
+// 1 to 2. It seeds the params history with the current params at the upgrade
+// height, the params in effect before the upgrade being unknown.
+func (m Migrator) Migrate1to2(ctx context.Context) error {
+	params, err := m.keeper.ParamsStore.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return m.keeper.ParamsHistory.Set(ctx, m.keeper.HeaderService.HeaderInfo(ctx).Height, params)
+}
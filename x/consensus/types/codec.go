@@ -13,6 +13,12 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations(
 		(*coretransaction.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgUpdateBlockParams{},
+		&MsgUpdateEvidenceParams{},
+		&MsgUpdateValidatorParams{},
+		&MsgUpdateSynchronyParams{},
+		&MsgUpdateFeatureParams{},
+		&MsgSetHalt{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -22,4 +28,10 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/consensus/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateBlockParams{}, "cosmos-sdk/MsgUpdateBlockParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateEvidenceParams{}, "cosmos-sdk/MsgUpdateEvidenceParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateValidatorParams{}, "cosmos-sdk/MsgUpdateValidatorParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateSynchronyParams{}, "cosmos-sdk/MsgUpdateSynchronyParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateFeatureParams{}, "cosmos-sdk/MsgUpdateFeatureParams")
+	legacy.RegisterAminoMsg(cdc, &MsgSetHalt{}, "cosmos-sdk/MsgSetHalt")
 }
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	v1 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	_ "github.com/cosmos/cosmos-proto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -92,8 +93,67 @@ func (m *CometInfo) GetLastCommit() *v1.CommitInfo {
 	return nil
 }
 
+// HaltInfo defines the height and time at which the chain halts, coordinated
+// on-chain through MsgSetHalt.
+type HaltInfo struct {
+	// height is the block height after which no block is processed.
+	// Zero disables the halt height.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time, in Unix seconds, after which no block is processed.
+	// Zero disables the halt time.
+	Time uint64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *HaltInfo) Reset()         { *m = HaltInfo{} }
+func (m *HaltInfo) String() string { return proto.CompactTextString(m) }
+func (*HaltInfo) ProtoMessage()    {}
+func (*HaltInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ed86dd7d42fb61b, []int{1}
+}
+func (m *HaltInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HaltInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HaltInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HaltInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HaltInfo.Merge(m, src)
+}
+func (m *HaltInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *HaltInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HaltInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HaltInfo proto.InternalMessageInfo
+
+func (m *HaltInfo) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *HaltInfo) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*CometInfo)(nil), "cosmos.consensus.v1.CometInfo")
+	proto.RegisterType((*HaltInfo)(nil), "cosmos.consensus.v1.HaltInfo")
 }
 
 func init() {
@@ -101,25 +161,29 @@ func init() {
 }
 
 var fileDescriptor_7ed86dd7d42fb61b = []byte{
-	// 285 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
-	0x14, 0x85, 0xeb, 0xbf, 0xbf, 0x10, 0xb8, 0x88, 0xa2, 0xb0, 0x44, 0xa8, 0x58, 0x15, 0x0c, 0x94,
-	0xc5, 0x51, 0xca, 0x02, 0x03, 0x03, 0x74, 0x81, 0x81, 0x25, 0x23, 0x4b, 0xe4, 0x38, 0xae, 0x62,
-	0xd1, 0xe4, 0x46, 0xbe, 0xc6, 0x82, 0xb7, 0xe0, 0xb1, 0x18, 0x3b, 0xc2, 0x86, 0x92, 0x17, 0x41,
-	0x49, 0x28, 0x41, 0x62, 0x3c, 0xc7, 0xe7, 0x93, 0x7c, 0x3f, 0x7a, 0x22, 0x01, 0x73, 0xc0, 0x40,
-	0x42, 0x81, 0xaa, 0xc0, 0x27, 0x0c, 0x5c, 0xd8, 0x07, 0x5e, 0x1a, 0xb0, 0xe0, 0x1d, 0x74, 0x23,
-	0xde, 0xf7, 0x2e, 0x3c, 0x9c, 0x48, 0xc8, 0x95, 0x4d, 0x96, 0x36, 0x10, 0x89, 0xd4, 0x0d, 0x66,
-	0x5f, 0x4a, 0xf5, 0x8d, 0x1c, 0x7f, 0x10, 0xba, 0xb3, 0x68, 0x06, 0x77, 0xc5, 0x12, 0xbc, 0x4b,
-	0xba, 0xad, 0x9c, 0x4e, 0x55, 0x21, 0x95, 0x4f, 0xa6, 0xc3, 0xd9, 0x68, 0x7e, 0xc4, 0x37, 0x38,
-	0x6f, 0x70, 0xee, 0x42, 0x7e, 0xaf, 0x31, 0x51, 0x99, 0x70, 0x1a, 0x4c, 0xf4, 0x33, 0xf7, 0x4e,
-	0xe9, 0xd8, 0x89, 0x95, 0x4e, 0x85, 0x05, 0x83, 0x71, 0x26, 0x30, 0xf3, 0xff, 0x4d, 0xc9, 0x6c,
-	0x37, 0xda, 0xeb, 0xeb, 0x5b, 0x81, 0x99, 0x77, 0x46, 0xf7, 0x4b, 0x03, 0x25, 0xa0, 0x32, 0xb1,
-	0x48, 0x53, 0xa3, 0x10, 0xfd, 0x61, 0xbb, 0x1c, 0x6f, 0xfa, 0xeb, 0xae, 0xf6, 0xae, 0xe8, 0x68,
-	0x25, 0xd0, 0xc6, 0x12, 0xf2, 0x5c, 0x5b, 0xff, 0xff, 0x94, 0xcc, 0x46, 0xf3, 0xc9, 0xdf, 0x1f,
-	0x2d, 0xda, 0xf7, 0xe6, 0x82, 0x88, 0x36, 0x40, 0x97, 0x6f, 0x2e, 0xde, 0x2a, 0x46, 0xd6, 0x15,
-	0x23, 0x9f, 0x15, 0x23, 0xaf, 0x35, 0x1b, 0xac, 0x6b, 0x36, 0x78, 0xaf, 0xd9, 0xe0, 0x81, 0x75,
-	0xa2, 0x30, 0x7d, 0xe4, 0x1a, 0x82, 0xe7, 0x5f, 0x56, 0x5b, 0x37, 0xc9, 0x56, 0x2b, 0xe7, 0xfc,
-	0x2b, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x63, 0x02, 0x4f, 0x76, 0x01, 0x00, 0x00,
+	// 349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
+	0x14, 0xc6, 0x3b, 0xb7, 0xa1, 0xf4, 0x4e, 0x2f, 0xb7, 0x97, 0x14, 0x2e, 0xb1, 0xd4, 0x10, 0xea,
+	0xc2, 0xb8, 0xe8, 0xc4, 0x56, 0x04, 0x15, 0x5c, 0x68, 0x37, 0x15, 0x71, 0x93, 0xa5, 0x9b, 0x30,
+	0x49, 0xa6, 0x66, 0x68, 0x93, 0x09, 0x39, 0x63, 0xd0, 0xb7, 0xf0, 0x61, 0x7c, 0x08, 0x97, 0xc5,
+	0x95, 0xee, 0xa4, 0x7d, 0x11, 0xc9, 0x4c, 0x6b, 0x05, 0x77, 0xf3, 0x7d, 0xf3, 0xfd, 0x0e, 0xe7,
+	0x0f, 0xde, 0x8b, 0x04, 0xa4, 0x02, 0xbc, 0x48, 0x64, 0xc0, 0x32, 0xb8, 0x07, 0xaf, 0x1c, 0x6e,
+	0x05, 0xc9, 0x0b, 0x21, 0x85, 0xd9, 0xd1, 0x21, 0xb2, 0xf5, 0xcb, 0x61, 0xb7, 0x17, 0x89, 0x94,
+	0xc9, 0x70, 0x2a, 0x3d, 0x1a, 0x46, 0xbc, 0xc2, 0xe4, 0x63, 0xce, 0xd6, 0x48, 0x77, 0x47, 0x23,
+	0x81, 0x52, 0xde, 0x9a, 0x57, 0xa2, 0xff, 0x8e, 0xf0, 0xef, 0x71, 0xc5, 0x5e, 0x65, 0x53, 0x61,
+	0x9e, 0xe2, 0x26, 0x2b, 0x79, 0xcc, 0xb2, 0x88, 0x59, 0xc8, 0xa9, 0xbb, 0xad, 0xd1, 0x2e, 0xd9,
+	0x54, 0x26, 0x55, 0x65, 0x52, 0x0e, 0xc9, 0x0d, 0x87, 0x90, 0x25, 0xb4, 0xe4, 0xa2, 0xf0, 0xbf,
+	0xe2, 0xe6, 0x3e, 0x6e, 0x97, 0x74, 0xce, 0x63, 0x2a, 0x45, 0x01, 0x41, 0x42, 0x21, 0xb1, 0x7e,
+	0x39, 0xc8, 0xfd, 0xe3, 0xff, 0xdd, 0xda, 0x13, 0x0a, 0x89, 0x79, 0x80, 0xff, 0xe5, 0x85, 0xc8,
+	0x05, 0xb0, 0x22, 0xa0, 0x71, 0x5c, 0x30, 0x00, 0xab, 0xae, 0x92, 0xed, 0x8d, 0x7f, 0xa1, 0x6d,
+	0xf3, 0x1c, 0xb7, 0xe6, 0x14, 0x64, 0x10, 0x89, 0x34, 0xe5, 0xd2, 0x32, 0x1c, 0xe4, 0xb6, 0x46,
+	0xbd, 0x9f, 0x1d, 0x8d, 0xd5, 0x7f, 0x35, 0x81, 0x8f, 0x2b, 0x40, 0xeb, 0xfe, 0x35, 0x6e, 0x4e,
+	0xe8, 0x5c, 0x4f, 0xf6, 0x1f, 0x37, 0x12, 0xc6, 0xef, 0x12, 0x69, 0x21, 0x07, 0xb9, 0x86, 0xbf,
+	0x56, 0xa6, 0x89, 0x0d, 0xc9, 0x53, 0xa6, 0x7a, 0x35, 0x7c, 0xf5, 0x3e, 0xeb, 0xbc, 0x3e, 0x0f,
+	0xda, 0x7a, 0x4b, 0x03, 0x88, 0x67, 0xce, 0x21, 0x39, 0x1e, 0x5d, 0x9e, 0xbc, 0x2c, 0x6d, 0xb4,
+	0x58, 0xda, 0xe8, 0x63, 0x69, 0xa3, 0xa7, 0x95, 0x5d, 0x5b, 0xac, 0xec, 0xda, 0xdb, 0xca, 0xae,
+	0xdd, 0xda, 0x3a, 0x0a, 0xf1, 0x8c, 0x70, 0xe1, 0x3d, 0x7c, 0xbb, 0x9e, 0xba, 0x41, 0xd8, 0x50,
+	0x9b, 0x3e, 0xfa, 0x1c, 0x00, 0x36, 0x25, 0x08, 0x3f, 0xde, 0x01, 0x00, 0x00,
 }
 
 func (m *CometInfo) Marshal() (dAtA []byte, err error) {
@@ -185,6 +249,39 @@ func (m *CometInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HaltInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HaltInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HaltInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		i = encodeVarintConsensus(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintConsensus(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintConsensus(dAtA []byte, offset int, v uint64) int {
 	offset -= sovConsensus(v)
 	base := offset
@@ -223,6 +320,21 @@ func (m *CometInfo) Size() (n int) {
 	return n
 }
 
+func (m *HaltInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovConsensus(uint64(m.Height))
+	}
+	if m.Time != 0 {
+		n += 1 + sovConsensus(uint64(m.Time))
+	}
+	return n
+}
+
 func sovConsensus(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -417,6 +529,94 @@ func (m *CometInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HaltInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HaltInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HaltInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipConsensus(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"context"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+)
+
+// ConsensusParamsHooks defines the hooks called by the x/consensus module when
+// the consensus params are updated.
+type ConsensusParamsHooks interface {
+	// AfterParamsUpdated is called after the consensus params have been updated
+	// through one of the module messages. Returning an error aborts the update.
+	AfterParamsUpdated(ctx context.Context, oldParams, newParams cmtproto.ConsensusParams) error
+}
+
+var _ ConsensusParamsHooks = MultiConsensusParamsHooks{}
+
+// MultiConsensusParamsHooks combines multiple consensus params hooks, all hook
+// functions are run in array sequence.
+type MultiConsensusParamsHooks []ConsensusParamsHooks
+
+func NewMultiConsensusParamsHooks(hooks ...ConsensusParamsHooks) MultiConsensusParamsHooks {
+	return hooks
+}
+
+// AfterParamsUpdated calls the AfterParamsUpdated hook of every hooks, stopping
+// at the first error.
+func (h MultiConsensusParamsHooks) AfterParamsUpdated(ctx context.Context, oldParams, newParams cmtproto.ConsensusParams) error {
+	for i := range h {
+		if err := h[i].AfterParamsUpdated(ctx, oldParams, newParams); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ConsensusParamsHooksWrapper is a wrapper for modules to inject ConsensusParamsHooks using depinject.
+type ConsensusParamsHooksWrapper struct{ ConsensusParamsHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (ConsensusParamsHooksWrapper) IsOnePerModuleType() {}
@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName defines the name of the x/consensus module.
 	ModuleName = "consensus"
@@ -7,3 +9,9 @@ const (
 	// StoreKey defines the module's store key.
 	StoreKey = ModuleName
 )
+
+// ParamsHistoryPrefix is the prefix of the consensus params history.
+var ParamsHistoryPrefix = collections.NewPrefix(1)
+
+// HaltPrefix is the prefix of the halt height and time set through MsgSetHalt.
+var HaltPrefix = collections.NewPrefix(2)
@@ -67,3 +67,80 @@ func (msg MsgUpdateParams) ToProtoConsensusParams() (cmtproto.ConsensusParams, e
 
 	return cp, nil
 }
+
+func (msg MsgUpdateBlockParams) ToProtoConsensusParams() (cmtproto.ConsensusParams, error) {
+	if msg.Block == nil {
+		return cmtproto.ConsensusParams{}, errors.New("block parameters must be present")
+	}
+
+	return cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{
+			MaxBytes: msg.Block.MaxBytes,
+			MaxGas:   msg.Block.MaxGas,
+		},
+	}, nil
+}
+
+func (msg MsgUpdateEvidenceParams) ToProtoConsensusParams() (cmtproto.ConsensusParams, error) {
+	if msg.Evidence == nil {
+		return cmtproto.ConsensusParams{}, errors.New("evidence parameters must be present")
+	}
+
+	return cmtproto.ConsensusParams{
+		Evidence: &cmtproto.EvidenceParams{
+			MaxAgeNumBlocks: msg.Evidence.MaxAgeNumBlocks,
+			MaxAgeDuration:  msg.Evidence.MaxAgeDuration,
+			MaxBytes:        msg.Evidence.MaxBytes,
+		},
+	}, nil
+}
+
+func (msg MsgUpdateValidatorParams) ToProtoConsensusParams() (cmtproto.ConsensusParams, error) {
+	if msg.Validator == nil {
+		return cmtproto.ConsensusParams{}, errors.New("validator parameters must be present")
+	}
+
+	return cmtproto.ConsensusParams{
+		Validator: &cmtproto.ValidatorParams{
+			PubKeyTypes: msg.Validator.PubKeyTypes,
+		},
+	}, nil
+}
+
+func (msg MsgUpdateSynchronyParams) ToProtoConsensusParams() (cmtproto.ConsensusParams, error) {
+	if msg.Synchrony == nil {
+		return cmtproto.ConsensusParams{}, errors.New("synchrony parameters must be present")
+	}
+
+	cp := cmtproto.ConsensusParams{Synchrony: &cmtproto.SynchronyParams{}}
+	if msg.Synchrony.MessageDelay != nil {
+		delay := *msg.Synchrony.MessageDelay
+		cp.Synchrony.MessageDelay = &delay
+	}
+	if msg.Synchrony.Precision != nil {
+		precision := *msg.Synchrony.Precision
+		cp.Synchrony.Precision = &precision
+	}
+
+	return cp, nil
+}
+
+func (msg MsgUpdateFeatureParams) ToProtoConsensusParams() (cmtproto.ConsensusParams, error) {
+	if msg.Feature == nil {
+		return cmtproto.ConsensusParams{}, errors.New("feature parameters must be present")
+	}
+
+	cp := cmtproto.ConsensusParams{Feature: &cmtproto.FeatureParams{}}
+	if msg.Feature.VoteExtensionsEnableHeight != nil {
+		cp.Feature.VoteExtensionsEnableHeight = &types.Int64Value{
+			Value: msg.Feature.GetVoteExtensionsEnableHeight().GetValue(),
+		}
+	}
+	if msg.Feature.PbtsEnableHeight != nil {
+		cp.Feature.PbtsEnableHeight = &types.Int64Value{
+			Value: msg.Feature.GetPbtsEnableHeight().GetValue(),
+		}
+	}
+
+	return cp, nil
+}
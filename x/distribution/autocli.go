@@ -103,6 +103,8 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "WithdrawDelegatorReward",
 					Use:       "withdraw-rewards [validator-addr]",
 					Short:     "Withdraw rewards from a given delegation address",
+					Long:      "Withdraw rewards from a given delegation address. All the rewards are withdrawn unless the --amount flag is set, in which case the remaining rewards keep accruing to the delegation.",
+					Example:   fmt.Sprintf("%s tx distribution withdraw-rewards cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp --amount 100stake --from mykey", version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "validator_address"},
 					},
@@ -125,6 +127,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "amount", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "SetAutoCompound",
+					Use:       "set-auto-compound [validator-addr] [enabled] [threshold]",
+					Short:     "Enable or disable the auto-compounding of the rewards of a delegation",
+					Long:      "Enable or disable the automatic restaking of the rewards of a delegation to a validator. Rewards are restaked at the end of a block once the bond denom rewards reach the threshold, and only when they are withdrawn to the delegator itself.",
+					Example:   fmt.Sprintf("%s tx distribution set-auto-compound cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp true 1000 --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "enabled"},
+						{ProtoField: "threshold"},
+					},
+				},
 				{
 					RpcMethod:  "FundCommunityPool",
 					Deprecated: fmt.Sprintf("Use %s tx protocolpool fund-community-pool", version.AppName),
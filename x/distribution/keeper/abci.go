@@ -39,3 +39,11 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 
 	return nil
 }
+
+// EndBlocker restakes the rewards of the delegations which opted into
+// auto-compounding.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker)
+
+	return k.ProcessAutoCompounds(ctx)
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// errAutoCompoundBelowThreshold is used to discard the withdrawal of rewards
+// which do not reach the auto-compounding threshold.
+var errAutoCompoundBelowThreshold = errors.New("rewards below auto-compound threshold")
+
+// ProcessAutoCompounds restakes the rewards of the next batch of delegations
+// which opted into auto-compounding. Settings are processed in key order, at
+// most types.AutoCompoundBatchSize per call, and the processing resumes from
+// the stored cursor on the next call.
+func (k Keeper) ProcessAutoCompounds(ctx context.Context) error {
+	var rng collections.Ranger[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
+	cursor, err := k.AutoCompoundCursor.Get(ctx)
+	switch {
+	case err == nil:
+		rng = new(collections.Range[collections.Pair[sdk.AccAddress, sdk.ValAddress]]).StartInclusive(cursor)
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	iter, err := k.AutoCompoundSettings.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	var batch []collections.KeyValue[collections.Pair[sdk.AccAddress, sdk.ValAddress], types.AutoCompoundSetting]
+	for ; iter.Valid() && len(batch) < types.AutoCompoundBatchSize; iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		batch = append(batch, kv)
+	}
+
+	var next *collections.Pair[sdk.AccAddress, sdk.ValAddress]
+	if iter.Valid() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		next = &key
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	if len(batch) > 0 {
+		bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, kv := range batch {
+			if err := k.autoCompound(ctx, kv.Key.K1(), kv.Key.K2(), kv.Value.Threshold, bondDenom); err != nil {
+				return err
+			}
+		}
+	}
+
+	if next == nil {
+		return k.AutoCompoundCursor.Remove(ctx)
+	}
+
+	return k.AutoCompoundCursor.Set(ctx, *next)
+}
+
+// autoCompound withdraws the rewards of a delegation and delegates them back
+// to the validator when the bond denom rewards reach the threshold.
+// Rewards are only restaked when they are withdrawn to the delegator itself.
+// A failure to restake is logged and does not halt the processing of the
+// remaining settings.
+func (k Keeper) autoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, threshold math.Int, bondDenom string) error {
+	del, err := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if del == nil || errors.Is(err, collections.ErrNotFound) {
+		// the delegation does not exist anymore
+		return k.AutoCompoundSettings.Remove(ctx, collections.Join(delAddr, valAddr))
+	}
+
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+	if !withdrawAddr.Equals(delAddr) {
+		return nil
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+	validator, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	err = k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return err
+		}
+
+		amount := rewards.AmountOf(bondDenom)
+		if !amount.IsPositive() || amount.LT(threshold) {
+			return errAutoCompoundBelowThreshold
+		}
+
+		msg := &stakingtypes.MsgDelegate{
+			DelegatorAddress: delegator,
+			ValidatorAddress: validator,
+			Amount:           sdk.NewCoin(bondDenom, amount),
+		}
+		if err := k.MsgRouterService.InvokeTyped(ctx, msg, &stakingtypes.MsgDelegateResponse{}); err != nil {
+			return err
+		}
+
+		return k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeAutoCompound,
+			event.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			event.NewAttribute(types.AttributeKeyValidator, validator),
+			event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		)
+	})
+	if err != nil && !errors.Is(err, errAutoCompoundBelowThreshold) {
+		k.Logger.Error("failed to auto-compound rewards", "delegator", delegator, "validator", validator, "error", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/distribution"
+	"cosmossdk.io/x/distribution/keeper"
+	distrtestutil "cosmossdk.io/x/distribution/testutil"
+	disttypes "cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// delegateRecorder is a staking msg server recording the delegations it receives.
+type delegateRecorder struct {
+	stakingtypes.UnimplementedMsgServer
+
+	delegations []*stakingtypes.MsgDelegate
+}
+
+func (r *delegateRecorder) Delegate(_ context.Context, msg *stakingtypes.MsgDelegate) (*stakingtypes.MsgDelegateResponse, error) {
+	r.delegations = append(r.delegations, msg)
+	return &stakingtypes.MsgDelegateResponse{}, nil
+}
+
+func initAutoCompoundFixture(t *testing.T) (sdk.Context, keeper.Keeper, dep, *delegateRecorder) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, distribution.AppModule{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+
+	stakingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	msr := baseapp.NewMsgServiceRouter()
+	msr.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	recorder := &delegateRecorder{}
+	stakingtypes.RegisterMsgServer(msr, recorder)
+
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger(), runtime.EnvWithMsgRouterService(msr))
+
+	authorityAddr, err := accountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		env,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		testCometService,
+		"fee_collector",
+		authorityAddr,
+	)
+
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	return ctx, distrKeeper, dep{bankKeeper, stakingKeeper, accountKeeper}, recorder
+}
+
+func TestProcessAutoCompounds(t *testing.T) {
+	ctx, distrKeeper, dep, recorder := initAutoCompoundFixture(t)
+
+	// create validator with 50% commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	operatorAddr, err := dep.stakingKeeper.ValidatorAddressCodec().BytesToString(valConsPk0.Address())
+	require.NoError(t, err)
+	val, err := distrtestutil.CreateValidator(valConsPk0, operatorAddr, math.NewInt(1000))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+	addrStr, err := dep.accountKeeper.AddressCodec().BytesToString(addr)
+	require.NoError(t, err)
+	valAddrStr, err := dep.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+
+	del := stakingtypes.NewDelegation(addrStr, valAddrStr, val.DelegatorShares)
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr))
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1})
+
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+	_, err = msgServer.SetAutoCompound(ctx, disttypes.NewMsgSetAutoCompound(addrStr, valAddrStr, true, math.NewInt(10)))
+	require.NoError(t, err)
+
+	// allocate rewards below the threshold: half of them go to the delegator
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(10)}}
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+	// the withdrawal is discarded as the rewards are below the threshold
+	belowThreshold := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(5)))
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, belowThreshold).Return(nil)
+
+	require.NoError(t, distrKeeper.ProcessAutoCompounds(ctx))
+	require.Empty(t, recorder.delegations)
+
+	// the rewards were not withdrawn
+	outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, tokens, outstanding.Rewards)
+
+	// allocate more rewards so that the delegator rewards reach the threshold
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+	expRewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10)))
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, expRewards).Return(nil)
+
+	require.NoError(t, distrKeeper.ProcessAutoCompounds(ctx))
+	require.Len(t, recorder.delegations, 1)
+	require.Equal(t, addrStr, recorder.delegations[0].DelegatorAddress)
+	require.Equal(t, valAddrStr, recorder.delegations[0].ValidatorAddress)
+	require.Equal(t, expRewards[0], recorder.delegations[0].Amount)
+
+	// the cursor is reset once all the settings are processed
+	has, err := distrKeeper.AutoCompoundCursor.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestProcessAutoCompoundsBatches(t *testing.T) {
+	ctx, distrKeeper, dep, recorder := initAutoCompoundFixture(t)
+
+	// none of the delegations exist, so each processed setting is removed
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, collections.ErrNotFound).AnyTimes()
+
+	valAddr := sdk.ValAddress(valConsAddr0)
+	delAddrs := simtestutil.CreateIncrementalAccounts(disttypes.AutoCompoundBatchSize + 1)
+	for _, delAddr := range delAddrs {
+		err := distrKeeper.AutoCompoundSettings.Set(ctx, collections.Join(delAddr, valAddr), disttypes.AutoCompoundSetting{Threshold: math.ZeroInt()})
+		require.NoError(t, err)
+	}
+
+	countSettings := func() int {
+		count := 0
+		err := distrKeeper.AutoCompoundSettings.Walk(ctx, nil, func(collections.Pair[sdk.AccAddress, sdk.ValAddress], disttypes.AutoCompoundSetting) (bool, error) {
+			count++
+			return false, nil
+		})
+		require.NoError(t, err)
+		return count
+	}
+
+	require.NoError(t, distrKeeper.ProcessAutoCompounds(ctx))
+	require.Equal(t, 1, countSettings())
+	has, err := distrKeeper.AutoCompoundCursor.Has(ctx)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	require.NoError(t, distrKeeper.ProcessAutoCompounds(ctx))
+	require.Equal(t, 0, countSettings())
+	has, err = distrKeeper.AutoCompoundCursor.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.Empty(t, recorder.delegations)
+}
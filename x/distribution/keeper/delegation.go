@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/distribution/types"
 
@@ -85,7 +86,8 @@ func (k Keeper) calculateDelegationRewardsBetween(ctx context.Context, val sdk.V
 	return rewards, nil
 }
 
-// calculate the total rewards accrued by a delegation
+// calculate the total rewards accrued by a delegation, including the rewards
+// left unwithdrawn by partial withdrawals
 func (k Keeper) CalculateDelegationRewards(ctx context.Context, val sdk.ValidatorI, del sdk.DelegationI, endingPeriod uint64) (rewards sdk.DecCoins, err error) {
 	addrCodec := k.authKeeper.AddressCodec()
 	delAddr, err := addrCodec.StringToBytes(del.GetDelegatorAddr())
@@ -104,8 +106,16 @@ func (k Keeper) CalculateDelegationRewards(ctx context.Context, val sdk.Validato
 		return sdk.DecCoins{}, err
 	}
 
+	pendingRewards, err := k.DelegatorPendingRewards.Get(ctx, collections.Join(sdk.ValAddress(valAddr), sdk.AccAddress(delAddr)))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return sdk.DecCoins{}, err
+	}
+
 	headerinfo := k.HeaderService.HeaderInfo(ctx)
 	if startingInfo.Height == uint64(headerinfo.Height) { // started this height, no rewards yet
+		if !pendingRewards.Rewards.IsZero() {
+			return pendingRewards.Rewards, nil
+		}
 		return sdk.DecCoins{}, nil
 	}
 
@@ -197,10 +207,17 @@ func (k Keeper) CalculateDelegationRewards(ctx context.Context, val sdk.Validato
 	}
 
 	rewards = rewards.Add(delRewards...)
+	if !pendingRewards.Rewards.IsZero() {
+		rewards = rewards.Add(pendingRewards.Rewards...)
+	}
 	return rewards, nil
 }
 
-func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.ValidatorI, del sdk.DelegationI) (sdk.Coins, error) {
+// withdrawDelegationRewards withdraws the given amount of rewards of a delegation,
+// or all of them if amount is empty. The rewards which are not withdrawn are
+// stored as pending rewards of the delegation, and are included in the rewards
+// calculated for it until they are withdrawn.
+func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.ValidatorI, del sdk.DelegationI, amount sdk.Coins) (sdk.Coins, error) {
 	addrCodec := k.authKeeper.AddressCodec()
 	delAddr, err := addrCodec.StringToBytes(del.GetDelegatorAddr())
 	if err != nil {
@@ -251,8 +268,37 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.Validator
 		)
 	}
 
-	// truncate reward dec coins, return remainder to decimal pool
-	finalRewards, remainder := rewards.TruncateDecimal()
+	var (
+		finalRewards sdk.Coins
+		remainder    sdk.DecCoins
+	)
+	pendingKey := collections.Join(sdk.ValAddress(valAddr), sdk.AccAddress(delAddr))
+	if amount.Empty() {
+		// truncate reward dec coins, return remainder to decimal pool
+		finalRewards, remainder = rewards.TruncateDecimal()
+
+		if err := k.DelegatorPendingRewards.Remove(ctx, pendingKey); err != nil {
+			return nil, err
+		}
+	} else {
+		// keep the rewards which are not withdrawn as pending rewards
+		pending, hasNeg := rewards.SafeSub(sdk.NewDecCoinsFromCoins(amount...))
+		if hasNeg {
+			return nil, errorsmod.Wrapf(types.ErrInsufficientRewards, "%s is greater than the delegation rewards %s", amount, rewards)
+		}
+
+		finalRewards = amount
+		rewards = sdk.NewDecCoinsFromCoins(amount...)
+
+		if pending.IsZero() {
+			err = k.DelegatorPendingRewards.Remove(ctx, pendingKey)
+		} else {
+			err = k.DelegatorPendingRewards.Set(ctx, pendingKey, types.DelegatorPendingRewards{Rewards: pending})
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// add coins to user account
 	if !finalRewards.IsZero() {
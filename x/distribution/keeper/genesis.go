@@ -113,6 +113,38 @@ func (k Keeper) InitGenesis(ctx context.Context, data types.GenesisState) error
 		}
 	}
 
+	for _, pr := range data.DelegatorPendingRewards {
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(pr.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(pr.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		err = k.DelegatorPendingRewards.Set(ctx, collections.Join(sdk.ValAddress(valAddr), sdk.AccAddress(delegatorAddress)), pr.PendingRewards)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, acs := range data.AutoCompoundSettings {
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(acs.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(acs.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		err = k.AutoCompoundSettings.Set(ctx, collections.Join(sdk.AccAddress(delegatorAddress), sdk.ValAddress(valAddr)), acs.Setting)
+		if err != nil {
+			return err
+		}
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.DecimalPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
@@ -285,5 +317,52 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
-	return types.NewGenesisState(params, feePool, dwi, outstanding, acc, his, cur, dels, slashes), nil
+	pendingRewards := make([]types.DelegatorPendingRewardsRecord, 0)
+	err = k.DelegatorPendingRewards.Walk(ctx, nil, func(key collections.Pair[sdk.ValAddress, sdk.AccAddress], rewards types.DelegatorPendingRewards) (stop bool, err error) {
+		delAddr, err := k.authKeeper.AddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		pendingRewards = append(pendingRewards, types.DelegatorPendingRewardsRecord{
+			DelegatorAddress: delAddr,
+			ValidatorAddress: valAddr,
+			PendingRewards:   rewards,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	autoCompounds := make([]types.AutoCompoundSettingRecord, 0)
+	err = k.AutoCompoundSettings.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], setting types.AutoCompoundSetting) (stop bool, err error) {
+		delAddr, err := k.authKeeper.AddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		autoCompounds = append(autoCompounds, types.AutoCompoundSettingRecord{
+			DelegatorAddress: delAddr,
+			ValidatorAddress: valAddr,
+			Setting:          setting,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genState := types.NewGenesisState(params, feePool, dwi, outstanding, acc, his, cur, dels, slashes)
+	genState.DelegatorPendingRewards = pendingRewards
+	genState.AutoCompoundSettings = autoCompounds
+	return genState, nil
 }
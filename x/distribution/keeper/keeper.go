@@ -52,6 +52,12 @@ type Keeper struct {
 	ValidatorHistoricalRewards collections.Map[collections.Pair[sdk.ValAddress, uint64], types.ValidatorHistoricalRewards]
 	// ValidatorSlashEvents key: valAddr+height+period | value: ValidatorSlashEvent
 	ValidatorSlashEvents collections.Map[collections.Triple[sdk.ValAddress, uint64, uint64], types.ValidatorSlashEvent]
+	// DelegatorPendingRewards key: valAddr+delAccAddr | value: DelegatorPendingRewards
+	DelegatorPendingRewards collections.Map[collections.Pair[sdk.ValAddress, sdk.AccAddress], types.DelegatorPendingRewards]
+	// AutoCompoundSettings key: delAddr+valAddr | value: AutoCompoundSetting
+	AutoCompoundSettings collections.Map[collections.Pair[sdk.AccAddress, sdk.ValAddress], types.AutoCompoundSetting]
+	// AutoCompoundCursor is the key of the next auto-compounding setting to process in EndBlock
+	AutoCompoundCursor collections.Item[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -133,6 +139,26 @@ func NewKeeper(
 			collections.TripleKeyCodec(sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), collections.Uint64Key, collections.Uint64Key), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			codec.CollValue[types.ValidatorSlashEvent](cdc),
 		),
+		DelegatorPendingRewards: collections.NewMap(
+			sb,
+			types.DelegatorPendingRewardsPrefix,
+			"delegators_pending_rewards",
+			collections.PairKeyCodec(sdk.ValAddressKey, sdk.AccAddressKey),
+			codec.CollValue[types.DelegatorPendingRewards](cdc),
+		),
+		AutoCompoundSettings: collections.NewMap(
+			sb,
+			types.AutoCompoundSettingPrefix,
+			"auto_compound_settings",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey),
+			codec.CollValue[types.AutoCompoundSetting](cdc),
+		),
+		AutoCompoundCursor: collections.NewItem(
+			sb,
+			types.AutoCompoundCursorKey,
+			"auto_compound_cursor",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey)),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -180,6 +206,13 @@ func (k Keeper) SetWithdrawAddr(ctx context.Context, delegatorAddr, withdrawAddr
 
 // withdraw rewards from a delegation
 func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	return k.WithdrawDelegationRewardsAmount(ctx, delAddr, valAddr, nil)
+}
+
+// WithdrawDelegationRewardsAmount withdraws the given amount of rewards from a
+// delegation, or all of them if amount is empty. The rewards which are not
+// withdrawn keep accruing to the delegation.
+func (k Keeper) WithdrawDelegationRewardsAmount(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coins) (sdk.Coins, error) {
 	val, err := k.stakingKeeper.Validator(ctx, valAddr)
 	if err != nil {
 		return nil, err
@@ -199,7 +232,7 @@ func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAd
 	}
 
 	// withdraw rewards
-	rewards, err := k.withdrawDelegationRewards(ctx, val, del)
+	rewards, err := k.withdrawDelegationRewards(ctx, val, del, amount)
 	if err != nil {
 		return nil, err
 	}
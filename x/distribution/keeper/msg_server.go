@@ -3,10 +3,14 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/go-metrics"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/distribution/types"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -56,7 +60,13 @@ func (k msgServer) WithdrawDelegatorReward(ctx context.Context, msg *types.MsgWi
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
 	}
 
-	amount, err := k.WithdrawDelegationRewards(ctx, delegatorAddress, valAddr)
+	if !msg.Amount.Empty() {
+		if err := validateAmount(msg.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	amount, err := k.WithdrawDelegationRewardsAmount(ctx, delegatorAddress, valAddr, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -209,6 +219,58 @@ func (k msgServer) DepositValidatorRewardsPool(ctx context.Context, msg *types.M
 	return &types.MsgDepositValidatorRewardsPoolResponse{}, nil
 }
 
+func (k msgServer) SetAutoCompound(ctx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	key := collections.Join(sdk.AccAddress(delegatorAddress), sdk.ValAddress(valAddr))
+	if !msg.Enabled {
+		if err := k.AutoCompoundSettings.Remove(ctx, key); err != nil {
+			return nil, err
+		}
+	} else {
+		if msg.Threshold.IsNil() || msg.Threshold.IsNegative() {
+			return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "auto-compound threshold must not be negative")
+		}
+
+		del, err := k.stakingKeeper.Delegation(ctx, delegatorAddress, valAddr)
+		if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+			return nil, err
+		}
+		if del == nil || err != nil {
+			return nil, types.ErrNoDelegationExists
+		}
+
+		if err := k.AutoCompoundSettings.Set(ctx, key, types.AutoCompoundSetting{Threshold: msg.Threshold}); err != nil {
+			return nil, err
+		}
+	}
+
+	threshold := msg.Threshold
+	if threshold.IsNil() {
+		threshold = math.ZeroInt()
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetAutoCompound,
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		event.NewAttribute(types.AttributeKeyThreshold, threshold.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
 func (k *Keeper) validateAuthority(authority string) error {
 	if _, err := k.authKeeper.AddressCodec().StringToBytes(authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
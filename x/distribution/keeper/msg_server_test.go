@@ -6,10 +6,12 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/distribution/keeper"
 	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -385,3 +387,84 @@ func TestMsgDepositValidatorRewardsPool(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetAutoCompound(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	addr0Str, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(addrs[0])
+	require.NoError(t, err)
+	valAddr1Str, err := codectestutil.CodecOptions{}.GetValidatorCodec().BytesToString(addrs[1])
+	require.NoError(t, err)
+	key := collections.Join(addrs[0], sdk.ValAddress(addrs[1]))
+
+	cases := []struct {
+		name     string
+		preRun   func()
+		msg      *types.MsgSetAutoCompound
+		errMsg   string
+		expFound bool
+	}{
+		{
+			name:   "invalid delegator address",
+			msg:    types.NewMsgSetAutoCompound("invalid", valAddr1Str, true, math.NewInt(10)),
+			errMsg: "invalid delegator address",
+		},
+		{
+			name:   "invalid validator address",
+			msg:    types.NewMsgSetAutoCompound(addr0Str, "invalid", true, math.NewInt(10)),
+			errMsg: "invalid validator address",
+		},
+		{
+			name:   "negative threshold",
+			msg:    types.NewMsgSetAutoCompound(addr0Str, valAddr1Str, true, math.NewInt(-1)),
+			errMsg: "threshold must not be negative",
+		},
+		{
+			name: "no delegation",
+			preRun: func() {
+				dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), addrs[0], sdk.ValAddress(addrs[1])).Return(nil, collections.ErrNotFound)
+			},
+			msg:    types.NewMsgSetAutoCompound(addr0Str, valAddr1Str, true, math.NewInt(10)),
+			errMsg: "delegation does not exist",
+		},
+		{
+			name: "enable",
+			preRun: func() {
+				del := stakingtypes.NewDelegation(addr0Str, valAddr1Str, math.LegacyNewDec(100))
+				dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), addrs[0], sdk.ValAddress(addrs[1])).Return(del, nil)
+			},
+			msg:      types.NewMsgSetAutoCompound(addr0Str, valAddr1Str, true, math.NewInt(10)),
+			expFound: true,
+		},
+		{
+			name:     "disable",
+			msg:      types.NewMsgSetAutoCompound(addr0Str, valAddr1Str, false, math.Int{}),
+			expFound: false,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := msgServer.SetAutoCompound(ctx, tc.msg)
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			setting, err := distrKeeper.AutoCompoundSettings.Get(ctx, key)
+			if !tc.expFound {
+				require.ErrorIs(t, err, collections.ErrNotFound)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.msg.Threshold, setting.Threshold)
+		})
+	}
+}
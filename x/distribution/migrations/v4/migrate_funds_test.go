@@ -71,6 +71,7 @@ func TestFundsMigration(t *testing.T) {
 	// create account keeper
 	accountKeeper := authkeeper.NewAccountKeeper(
 		runtime.NewEnvironment(runtime.NewKVStoreService(keys[authtypes.StoreKey]), log.NewNopLogger()),
+		nil,
 		encCfg.Codec,
 		authtypes.ProtoBaseAccount,
 		acctsModKeeper,
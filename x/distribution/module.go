@@ -33,12 +33,14 @@ var (
 	_ module.AppModuleSimulation = AppModule{}
 	_ module.HasInvariants       = AppModule{}
 
-	_ appmodule.AppModule             = AppModule{}
-	_ appmodule.HasBeginBlocker       = AppModule{}
-	_ appmodule.HasServices           = AppModule{}
-	_ appmodule.HasMigrations         = AppModule{}
-	_ appmodule.HasRegisterInterfaces = AppModule{}
-	_ appmodule.HasGenesis            = AppModule{}
+	_ appmodule.AppModule              = AppModule{}
+	_ appmodule.HasBeginBlocker        = AppModule{}
+	_ appmodule.HasEndBlocker          = AppModule{}
+	_ appmodule.HasServices            = AppModule{}
+	_ appmodule.HasMigrations          = AppModule{}
+	_ appmodule.HasRegisterInterfaces  = AppModule{}
+	_ appmodule.HasGenesis             = AppModule{}
+	_ appmodule.HasOrderingConstraints = AppModule{}
 )
 
 // AppModule implements an application module for the distribution module.
@@ -160,6 +162,14 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 	return am.cdc.MarshalJSON(gs)
 }
 
+// OrderingConstraints implements appmodule.HasOrderingConstraints.
+// Fees and newly minted tokens are allocated in the same block they are collected.
+func (AppModule) OrderingConstraints() []appmodule.OrderingConstraint {
+	return []appmodule.OrderingConstraint{
+		{Hook: appmodule.HookBeginBlocker, After: []string{"mint"}},
+	}
+}
+
 // ConsensusVersion implements HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
@@ -168,6 +178,11 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 	return am.keeper.BeginBlocker(ctx)
 }
 
+// EndBlock returns the end blocker for the distribution module.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.EndBlocker(ctx)
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the distribution module.
@@ -61,6 +61,21 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.DelegatorPendingRewardsPrefix):
+			var rewardsA, rewardsB types.DelegatorPendingRewards
+			cdc.MustUnmarshal(kvA.Value, &rewardsA)
+			cdc.MustUnmarshal(kvB.Value, &rewardsB)
+			return fmt.Sprintf("%v\n%v", rewardsA, rewardsB)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundSettingPrefix):
+			var settingA, settingB types.AutoCompoundSetting
+			cdc.MustUnmarshal(kvA.Value, &settingA)
+			cdc.MustUnmarshal(kvB.Value, &settingB)
+			return fmt.Sprintf("%v\n%v", settingA, settingB)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundCursorKey):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
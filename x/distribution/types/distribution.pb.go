@@ -550,6 +550,53 @@ func (m *DelegatorStartingInfo) GetHeight() uint64 {
 	return 0
 }
 
+// DelegatorPendingRewards represents the rewards of a delegation which were
+// left unwithdrawn by a partial withdrawal. They keep accruing to the
+// delegation on top of the rewards calculated from its starting info.
+type DelegatorPendingRewards struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
+}
+
+func (m *DelegatorPendingRewards) Reset()         { *m = DelegatorPendingRewards{} }
+func (m *DelegatorPendingRewards) String() string { return proto.CompactTextString(m) }
+func (*DelegatorPendingRewards) ProtoMessage()    {}
+func (*DelegatorPendingRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{10}
+}
+func (m *DelegatorPendingRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorPendingRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorPendingRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorPendingRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorPendingRewards.Merge(m, src)
+}
+func (m *DelegatorPendingRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorPendingRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorPendingRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorPendingRewards proto.InternalMessageInfo
+
+func (m *DelegatorPendingRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
 // DelegationDelegatorReward represents the properties
 // of a delegator's delegation reward.
 type DelegationDelegatorReward struct {
@@ -561,7 +608,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{10}
+	return fileDescriptor_cd78a31ea281a992, []int{11}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,7 +655,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
+	return fileDescriptor_cd78a31ea281a992, []int{12}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -637,6 +684,47 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// AutoCompoundSetting defines the auto-compounding setting of a delegation.
+// Rewards of the delegation are automatically restaked once they reach the
+// threshold.
+type AutoCompoundSetting struct {
+	// threshold is the minimum amount of bond denom rewards which are restaked.
+	Threshold cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=threshold,proto3,customtype=cosmossdk.io/math.Int" json:"threshold"`
+}
+
+func (m *AutoCompoundSetting) Reset()         { *m = AutoCompoundSetting{} }
+func (m *AutoCompoundSetting) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundSetting) ProtoMessage()    {}
+func (*AutoCompoundSetting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{13}
+}
+func (m *AutoCompoundSetting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundSetting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundSetting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundSetting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundSetting.Merge(m, src)
+}
+func (m *AutoCompoundSetting) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundSetting) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundSetting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundSetting proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -648,8 +736,10 @@ func init() {
 	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.v1beta1.FeePool")
 	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
+	proto.RegisterType((*DelegatorPendingRewards)(nil), "cosmos.distribution.v1beta1.DelegatorPendingRewards")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*AutoCompoundSetting)(nil), "cosmos.distribution.v1beta1.AutoCompoundSetting")
 }
 
 func init() {
@@ -657,73 +747,77 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
+	// 1116 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe4, 0x87, 0xd3, 0x4c, 0xd2, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x9d, 0xae, 0xa8,
-	0x08, 0x81, 0xd8, 0xa4, 0x95, 0x10, 0xf2, 0x05, 0x35, 0x76, 0x2b, 0x90, 0x0a, 0x44, 0x1b, 0x04,
-	0x12, 0x1c, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0x64, 0x77, 0x66, 0x99, 0x19, 0x3b, 0xc9, 0x09, 0x8e,
-	0x85, 0x03, 0x70, 0x03, 0xf5, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53, 0x55,
-	0x01, 0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x04, 0x14,
-	0x82, 0xa2, 0x5e, 0x2c, 0xcf, 0x7b, 0xb3, 0xef, 0xfb, 0xbe, 0x37, 0xef, 0xbd, 0x19, 0x58, 0xf1,
-	0xb9, 0x8c, 0xb8, 0xac, 0x06, 0x54, 0x2a, 0x41, 0x9b, 0x1d, 0x45, 0x39, 0xab, 0x76, 0x97, 0x9b,
-	0x44, 0xe1, 0xe5, 0x23, 0xc6, 0x4a, 0x2c, 0xb8, 0xe2, 0xe8, 0x8a, 0xd9, 0x5f, 0x39, 0xe2, 0xb2,
-	0xfb, 0x8b, 0xd3, 0x2d, 0xde, 0xe2, 0xc9, 0xbe, 0xaa, 0xfe, 0x67, 0x3e, 0x29, 0x96, 0x2c, 0x44,
-	0x13, 0x4b, 0x92, 0x86, 0xf6, 0x39, 0xb5, 0x21, 0x8b, 0x73, 0xc6, 0xef, 0x99, 0x0f, 0x6d, 0x7c,
-	0xe3, 0xba, 0x84, 0x23, 0xca, 0x78, 0x35, 0xf9, 0x35, 0x26, 0xe7, 0xfe, 0x20, 0xcc, 0xaf, 0x62,
-	0x81, 0x23, 0x89, 0x3e, 0x80, 0x17, 0x7d, 0x1e, 0x45, 0x1d, 0x46, 0xd5, 0xb6, 0xa7, 0xf0, 0x56,
-	0x01, 0xcc, 0x83, 0x85, 0xd1, 0x95, 0x57, 0x1e, 0xed, 0x95, 0x73, 0xbf, 0xec, 0x95, 0x2d, 0x55,
-	0x19, 0x6c, 0x54, 0x28, 0xaf, 0x46, 0x58, 0xb5, 0x2b, 0x77, 0x49, 0x0b, 0xfb, 0xdb, 0x0d, 0xe2,
-	0x3f, 0xd9, 0x5d, 0x82, 0x16, 0xa9, 0x41, 0xfc, 0x87, 0x87, 0x3b, 0x8b, 0xc0, 0x1d, 0x4f, 0x83,
-	0xbd, 0x83, 0xb7, 0xd0, 0x87, 0x70, 0x5a, 0x13, 0xd6, 0xac, 0x62, 0x2e, 0x89, 0xf0, 0x04, 0xd9,
-	0xc4, 0x22, 0x28, 0x0c, 0x24, 0x18, 0xaf, 0x9e, 0x0d, 0xa3, 0x00, 0x5c, 0xa4, 0xa3, 0xae, 0xda,
-	0xa0, 0x6e, 0x12, 0x13, 0x85, 0x70, 0xa6, 0xc9, 0x59, 0x47, 0xfe, 0x0d, 0x6c, 0xf0, 0x7f, 0x82,
-	0x4d, 0x25, 0x61, 0x8f, 0xa1, 0xdd, 0x80, 0x33, 0x9b, 0x54, 0xb5, 0x03, 0x81, 0x37, 0x3d, 0x1c,
-	0x04, 0xc2, 0x23, 0x0c, 0x37, 0x43, 0x12, 0x14, 0x86, 0xe6, 0xc1, 0xc2, 0x05, 0x77, 0xaa, 0xe7,
-	0xbc, 0x15, 0x04, 0xe2, 0xb6, 0x71, 0xd5, 0xae, 0x7f, 0x76, 0xb8, 0xb3, 0x38, 0x6f, 0x00, 0x96,
-	0x64, 0xb0, 0x51, 0xdd, 0x3a, 0x5a, 0x31, 0xe6, 0x44, 0x9c, 0x9f, 0x00, 0x2c, 0xbe, 0x8b, 0x43,
-	0x1a, 0x60, 0xc5, 0xc5, 0xeb, 0x54, 0x2a, 0x2e, 0xa8, 0x8f, 0x43, 0x03, 0x2c, 0xd1, 0xe7, 0x00,
-	0x5e, 0xf6, 0x3b, 0x51, 0x27, 0xc4, 0x8a, 0x76, 0x89, 0x15, 0xe9, 0x09, 0xac, 0x28, 0x2f, 0x80,
-	0xf9, 0xc1, 0x85, 0xb1, 0x1b, 0x57, 0x6d, 0x3d, 0x56, 0x74, 0x96, 0x7a, 0x75, 0xa5, 0x15, 0xd5,
-	0x39, 0x65, 0x26, 0x11, 0xdf, 0xfd, 0x5a, 0x7e, 0xb1, 0x45, 0x55, 0xbb, 0xd3, 0xac, 0xf8, 0x3c,
-	0xb2, 0xf5, 0x52, 0xed, 0xa3, 0xa6, 0xb6, 0x63, 0x22, 0x7b, 0xdf, 0x48, 0x73, 0xb6, 0x33, 0x19,
-	0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x3c, 0x9c, 0x14, 0x64, 0x9d, 0x08, 0xc2, 0x7c, 0xe2, 0xf9,
-	0xbc, 0xc3, 0x54, 0x72, 0xbe, 0x17, 0xdd, 0x89, 0xd4, 0x5c, 0xd7, 0x56, 0xe7, 0x5b, 0x00, 0x2f,
-	0xa7, 0xc2, 0xea, 0x1d, 0x21, 0x08, 0x53, 0x3d, 0x55, 0x31, 0x1c, 0x31, 0x4a, 0xe4, 0x39, 0x8b,
-	0xe8, 0xc1, 0xa0, 0x59, 0x98, 0x8f, 0x89, 0xa0, 0xdc, 0x54, 0xe3, 0x90, 0x6b, 0x57, 0xce, 0xd7,
-	0x00, 0x96, 0x52, 0x96, 0xb7, 0x7c, 0xab, 0x99, 0x04, 0x75, 0x1e, 0x45, 0x54, 0x4a, 0xca, 0x19,
-	0xea, 0x42, 0xe8, 0xa7, 0xab, 0x73, 0xe6, 0xdb, 0x87, 0xe4, 0x7c, 0x01, 0xe0, 0x95, 0x94, 0xda,
-	0xdb, 0x1d, 0x25, 0x15, 0x66, 0x01, 0x65, 0xad, 0xa7, 0x96, 0x44, 0xcd, 0x68, 0x2a, 0x65, 0xb4,
-	0x16, 0x62, 0xd9, 0xbe, 0xdd, 0x25, 0x4c, 0xa1, 0x17, 0xe0, 0x33, 0xdd, 0x9e, 0xd9, 0xb3, 0x69,
-	0x06, 0x49, 0x9a, 0x27, 0x53, 0xfb, 0x6a, 0x62, 0x46, 0x6f, 0xc2, 0x0b, 0xeb, 0x02, 0xfb, 0xba,
-	0x03, 0xec, 0x5c, 0x58, 0xfe, 0xcf, 0xad, 0xea, 0xa6, 0x21, 0x9c, 0x4f, 0x01, 0x9c, 0x3e, 0x81,
-	0x91, 0x44, 0x1f, 0xc1, 0xd9, 0x8c, 0x92, 0xd4, 0x0e, 0x8f, 0x24, 0x1e, 0x9b, 0xab, 0x97, 0x2b,
-	0xff, 0x32, 0x95, 0x2b, 0x27, 0x84, 0x5c, 0x19, 0xd5, 0x3c, 0x4d, 0x42, 0xa6, 0xbb, 0x27, 0x40,
-	0x3a, 0x9f, 0x0c, 0xc0, 0x91, 0x3b, 0x84, 0xac, 0x72, 0x1e, 0xa2, 0x8f, 0xe1, 0x44, 0x36, 0x67,
-	0x63, 0xce, 0xc3, 0x53, 0x1d, 0x51, 0xed, 0xac, 0x47, 0x54, 0x00, 0x6e, 0x36, 0xd7, 0x13, 0x02,
-	0x0a, 0x8e, 0x07, 0xc4, 0xa7, 0x11, 0x0e, 0x0d, 0xfc, 0xc0, 0x29, 0xe0, 0x6f, 0x9e, 0x01, 0xde,
-	0x1d, 0xb3, 0x30, 0x1a, 0xd5, 0xf9, 0x6a, 0x00, 0x16, 0xeb, 0xfd, 0x3c, 0xd6, 0x62, 0xc2, 0x02,
-	0x33, 0x4c, 0x71, 0x88, 0xa6, 0xe1, 0xb0, 0xa2, 0x2a, 0x24, 0xe6, 0xd6, 0x71, 0xcd, 0x02, 0xcd,
-	0xc3, 0xb1, 0x80, 0x48, 0x5f, 0xd0, 0x38, 0xab, 0x0a, 0xb7, 0xdf, 0x84, 0xae, 0xc2, 0x51, 0x41,
-	0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x19, 0xf0, 0x6e, 0x66, 0x40, 0xdb, 0x30, 0x8f, 0xa3, 0x64, 0x10,
-	0x0d, 0x25, 0x22, 0xe7, 0x4e, 0x14, 0x99, 0x28, 0xbc, 0x63, 0x15, 0x2e, 0x9c, 0x42, 0x61, 0x22,
-	0xef, 0xfe, 0xe1, 0xce, 0xe2, 0x78, 0x98, 0x94, 0xa1, 0xe7, 0x67, 0x1d, 0x61, 0x01, 0x6b, 0x0b,
-	0xf7, 0x1e, 0x94, 0x73, 0x7f, 0x3c, 0x28, 0xe7, 0xbe, 0xdf, 0x5d, 0x2a, 0x5a, 0xd4, 0x16, 0xef,
-	0xf6, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x07, 0x00, 0x67, 0x1a, 0x44, 0x47, 0xd2, 0x55, 0xa3,
-	0xb0, 0x50, 0x94, 0xb5, 0xde, 0x60, 0xeb, 0xc9, 0x40, 0x8d, 0x05, 0xe9, 0x52, 0xae, 0x2f, 0xb3,
-	0xfe, 0xde, 0x99, 0xe8, 0x99, 0x6d, 0xeb, 0xdc, 0x85, 0xc3, 0x52, 0xe1, 0x0d, 0x62, 0xfb, 0xe6,
-	0xac, 0x77, 0xb6, 0x09, 0x82, 0x1a, 0x30, 0xdf, 0x26, 0xb4, 0xd5, 0x36, 0x09, 0x1d, 0x5a, 0x79,
-	0xe9, 0xcf, 0xbd, 0xf2, 0xa4, 0x2f, 0x88, 0x1e, 0xf2, 0xcc, 0x33, 0xae, 0x6f, 0x0e, 0x77, 0x16,
-	0x8f, 0xdb, 0x6c, 0x02, 0xcc, 0xc2, 0xf9, 0x1d, 0xc0, 0x39, 0x2b, 0x8b, 0x72, 0x96, 0x0a, 0xb4,
-	0xd7, 0xe6, 0x5b, 0xf0, 0x52, 0xd6, 0x84, 0xfa, 0xde, 0x24, 0x52, 0xda, 0x17, 0xc7, 0xb5, 0x27,
-	0xbb, 0x4b, 0xcf, 0x5a, 0x6a, 0xd9, 0xfc, 0x35, 0x5b, 0xd6, 0x94, 0xd0, 0x63, 0x2e, 0x9b, 0x29,
-	0xd6, 0x8e, 0x18, 0xcc, 0xa7, 0x4f, 0x8a, 0xf3, 0x1c, 0x78, 0x16, 0xa5, 0x36, 0xa4, 0x8f, 0xd7,
-	0xf9, 0x11, 0xc0, 0xeb, 0xff, 0x5c, 0xd4, 0xef, 0x51, 0xd5, 0x6e, 0x90, 0x98, 0x4b, 0xaa, 0xce,
-	0xa9, 0xbe, 0x67, 0xfb, 0xea, 0x5b, 0xbb, 0xec, 0x0a, 0x15, 0xe0, 0x48, 0x60, 0x80, 0x0b, 0xc3,
-	0x89, 0xa3, 0xb7, 0xac, 0x3d, 0x77, 0xef, 0x14, 0x25, 0xb9, 0xf2, 0xda, 0xc3, 0xfd, 0x12, 0x78,
-	0xb4, 0x5f, 0x02, 0x8f, 0xf7, 0x4b, 0xe0, 0xb7, 0xfd, 0x12, 0xf8, 0xf2, 0xa0, 0x94, 0x7b, 0x7c,
-	0x50, 0xca, 0xfd, 0x7c, 0x50, 0xca, 0xbd, 0x7f, 0xed, 0x48, 0x59, 0x1d, 0x7b, 0xbb, 0x24, 0x49,
-	0x6b, 0xe6, 0x93, 0xe7, 0xe5, 0xcd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x08, 0x33, 0x8d,
-	0x11, 0x0b, 0x00, 0x00,
+	0x14, 0xf6, 0xe4, 0x87, 0xd3, 0x4c, 0xd2, 0xa4, 0xdd, 0x38, 0x89, 0xe3, 0x16, 0xdb, 0x5d, 0x51,
+	0x11, 0x02, 0xb1, 0x93, 0x56, 0x42, 0xc8, 0x17, 0x94, 0x38, 0xad, 0x88, 0x54, 0x4a, 0xb4, 0x41,
+	0x20, 0xc1, 0xc1, 0x1a, 0xef, 0x4e, 0xec, 0x21, 0xbb, 0x33, 0xcb, 0xcc, 0xac, 0x93, 0x9c, 0xca,
+	0xb1, 0x70, 0x00, 0x6e, 0xa0, 0x9e, 0x2a, 0x10, 0x52, 0xc5, 0x29, 0x87, 0xfc, 0x11, 0x15, 0xa7,
+	0x2a, 0x02, 0x84, 0x38, 0x04, 0x48, 0x0e, 0x41, 0xfc, 0x15, 0x68, 0x76, 0xc6, 0x6b, 0x27, 0x4d,
+	0x51, 0x9a, 0x2a, 0xea, 0xc5, 0xf2, 0xbc, 0x37, 0xfb, 0xbe, 0xef, 0x7b, 0xf3, 0xde, 0x9b, 0x81,
+	0x25, 0x97, 0x89, 0x80, 0x89, 0xb2, 0x47, 0x84, 0xe4, 0xa4, 0x1e, 0x49, 0xc2, 0x68, 0xb9, 0x35,
+	0x5f, 0xc7, 0x12, 0xcd, 0x1f, 0x31, 0x96, 0x42, 0xce, 0x24, 0xb3, 0xae, 0xe8, 0xfd, 0xa5, 0x23,
+	0x2e, 0xb3, 0x3f, 0x97, 0x69, 0xb0, 0x06, 0x8b, 0xf7, 0x95, 0xd5, 0x3f, 0xfd, 0x49, 0x2e, 0x6f,
+	0x20, 0xea, 0x48, 0xe0, 0x24, 0xb4, 0xcb, 0x88, 0x09, 0x99, 0x9b, 0xd2, 0xfe, 0x9a, 0xfe, 0xd0,
+	0xc4, 0xd7, 0xae, 0xcb, 0x28, 0x20, 0x94, 0x95, 0xe3, 0x5f, 0x6d, 0xb2, 0x1f, 0xf4, 0xc2, 0xf4,
+	0x0a, 0xe2, 0x28, 0x10, 0xd6, 0x27, 0xf0, 0xa2, 0xcb, 0x82, 0x20, 0xa2, 0x44, 0x6e, 0xd5, 0x24,
+	0xda, 0xcc, 0x82, 0x22, 0x98, 0x1e, 0x5c, 0x7c, 0xeb, 0xf1, 0x5e, 0x21, 0xf5, 0xc7, 0x5e, 0xc1,
+	0x50, 0x15, 0xde, 0x7a, 0x89, 0xb0, 0x72, 0x80, 0x64, 0xb3, 0x74, 0x07, 0x37, 0x90, 0xbb, 0xb5,
+	0x84, 0xdd, 0xdd, 0x9d, 0x59, 0x68, 0x90, 0x96, 0xb0, 0xfb, 0xe8, 0x70, 0x7b, 0x06, 0x38, 0xc3,
+	0x49, 0xb0, 0x0f, 0xd0, 0xa6, 0xf5, 0x29, 0xcc, 0x28, 0xc2, 0x8a, 0x55, 0xc8, 0x04, 0xe6, 0x35,
+	0x8e, 0x37, 0x10, 0xf7, 0xb2, 0x3d, 0x31, 0xc6, 0xdb, 0x67, 0xc3, 0xc8, 0x02, 0xc7, 0x52, 0x51,
+	0x57, 0x4c, 0x50, 0x27, 0x8e, 0x69, 0xf9, 0x70, 0xbc, 0xce, 0x68, 0x24, 0x9e, 0x02, 0xeb, 0x7d,
+	0x41, 0xb0, 0xb1, 0x38, 0xec, 0x31, 0xb4, 0x1b, 0x70, 0x7c, 0x83, 0xc8, 0xa6, 0xc7, 0xd1, 0x46,
+	0x0d, 0x79, 0x1e, 0xaf, 0x61, 0x8a, 0xea, 0x3e, 0xf6, 0xb2, 0x7d, 0x45, 0x30, 0x7d, 0xc1, 0x19,
+	0x6b, 0x3b, 0x17, 0x3c, 0x8f, 0xdf, 0xd2, 0xae, 0xca, 0xf5, 0x2f, 0x0f, 0xb7, 0x67, 0x8a, 0x1a,
+	0x60, 0x56, 0x78, 0xeb, 0xe5, 0xcd, 0xa3, 0x15, 0xa3, 0x4f, 0xc4, 0xfe, 0x0d, 0xc0, 0xdc, 0x87,
+	0xc8, 0x27, 0x1e, 0x92, 0x8c, 0xbf, 0x4b, 0x84, 0x64, 0x9c, 0xb8, 0xc8, 0xd7, 0xc0, 0xc2, 0xfa,
+	0x0a, 0xc0, 0x49, 0x37, 0x0a, 0x22, 0x1f, 0x49, 0xd2, 0xc2, 0x46, 0x64, 0x8d, 0x23, 0x49, 0x58,
+	0x16, 0x14, 0x7b, 0xa7, 0x87, 0x6e, 0x5c, 0x35, 0xf5, 0x58, 0x52, 0x59, 0x6a, 0xd7, 0x95, 0x52,
+	0x54, 0x65, 0x84, 0xea, 0x44, 0xfc, 0xf4, 0x67, 0xe1, 0x8d, 0x06, 0x91, 0xcd, 0xa8, 0x5e, 0x72,
+	0x59, 0x60, 0xea, 0xa5, 0xdc, 0x45, 0x4d, 0x6e, 0x85, 0x58, 0xb4, 0xbf, 0x11, 0xfa, 0x6c, 0xc7,
+	0x3b, 0xb0, 0x9a, 0x8c, 0xa3, 0x40, 0xad, 0xd7, 0xe0, 0x28, 0xc7, 0x6b, 0x98, 0x63, 0xea, 0xe2,
+	0x9a, 0xcb, 0x22, 0x2a, 0xe3, 0xf3, 0xbd, 0xe8, 0x8c, 0x24, 0xe6, 0xaa, 0xb2, 0xda, 0x3f, 0x00,
+	0x38, 0x99, 0x08, 0xab, 0x46, 0x9c, 0x63, 0x2a, 0xdb, 0xaa, 0x42, 0x38, 0xa0, 0x95, 0x88, 0x73,
+	0x16, 0xd1, 0x86, 0xb1, 0x26, 0x60, 0x3a, 0xc4, 0x9c, 0x30, 0x5d, 0x8d, 0x7d, 0x8e, 0x59, 0xd9,
+	0xdf, 0x01, 0x98, 0x4f, 0x58, 0x2e, 0xb8, 0x46, 0x33, 0xf6, 0xaa, 0x2c, 0x08, 0x88, 0x10, 0x84,
+	0x51, 0xab, 0x05, 0xa1, 0x9b, 0xac, 0xce, 0x99, 0x6f, 0x17, 0x92, 0xfd, 0x35, 0x80, 0x57, 0x12,
+	0x6a, 0xef, 0x47, 0x52, 0x48, 0x44, 0x3d, 0x42, 0x1b, 0x2f, 0x2d, 0x89, 0x8a, 0xd1, 0x58, 0xc2,
+	0x68, 0xd5, 0x47, 0xa2, 0x79, 0xab, 0x85, 0xa9, 0xb4, 0x5e, 0x87, 0x97, 0x5a, 0x6d, 0x73, 0xcd,
+	0xa4, 0x19, 0xc4, 0x69, 0x1e, 0x4d, 0xec, 0x2b, 0xb1, 0xd9, 0x7a, 0x0f, 0x5e, 0x58, 0xe3, 0xc8,
+	0x55, 0x1d, 0x60, 0xe6, 0xc2, 0xfc, 0x73, 0xb7, 0xaa, 0x93, 0x84, 0xb0, 0xbf, 0x00, 0x30, 0x73,
+	0x02, 0x23, 0x61, 0x7d, 0x06, 0x27, 0x3a, 0x94, 0x84, 0x72, 0xd4, 0x70, 0xec, 0x31, 0xb9, 0x9a,
+	0x2b, 0xfd, 0xcf, 0x54, 0x2e, 0x9d, 0x10, 0x72, 0x71, 0x50, 0xf1, 0xd4, 0x09, 0xc9, 0xb4, 0x4e,
+	0x80, 0xb4, 0x3f, 0xef, 0x81, 0x03, 0xb7, 0x31, 0x5e, 0x61, 0xcc, 0xb7, 0xee, 0xc1, 0x91, 0xce,
+	0x9c, 0x0d, 0x19, 0xf3, 0x4f, 0x75, 0x44, 0x95, 0xb3, 0x1e, 0x51, 0x16, 0x38, 0x9d, 0xb9, 0x1e,
+	0x13, 0x90, 0x70, 0xd8, 0xc3, 0x2e, 0x09, 0x90, 0xaf, 0xe1, 0x7b, 0x4e, 0x01, 0x7f, 0xf3, 0x0c,
+	0xf0, 0xce, 0x90, 0x81, 0x51, 0xa8, 0xf6, 0xb7, 0x3d, 0x30, 0x57, 0xed, 0xe6, 0xb1, 0x1a, 0x62,
+	0xea, 0xe9, 0x61, 0x8a, 0x7c, 0x2b, 0x03, 0xfb, 0x25, 0x91, 0x3e, 0xd6, 0xb7, 0x8e, 0xa3, 0x17,
+	0x56, 0x11, 0x0e, 0x79, 0x58, 0xb8, 0x9c, 0x84, 0x9d, 0xaa, 0x70, 0xba, 0x4d, 0xd6, 0x55, 0x38,
+	0xc8, 0xb1, 0x4b, 0x42, 0x82, 0xa9, 0xd4, 0x03, 0xde, 0xe9, 0x18, 0xac, 0x2d, 0x98, 0x46, 0x41,
+	0x3c, 0x88, 0xfa, 0x62, 0x91, 0x53, 0x27, 0x8a, 0x8c, 0x15, 0xde, 0x36, 0x0a, 0xa7, 0x4f, 0xa1,
+	0x30, 0x96, 0xf7, 0xe0, 0x70, 0x7b, 0x66, 0xd8, 0x8f, 0xcb, 0xb0, 0xe6, 0x76, 0x3a, 0xc2, 0x00,
+	0x56, 0xa6, 0xef, 0x3f, 0x2c, 0xa4, 0xfe, 0x79, 0x58, 0x48, 0xfd, 0xbc, 0x33, 0x9b, 0x33, 0xa8,
+	0x0d, 0xd6, 0xea, 0x02, 0xa5, 0x52, 0x71, 0x06, 0xf6, 0x2f, 0x00, 0x8e, 0x2f, 0x61, 0x15, 0x49,
+	0x55, 0x8d, 0x44, 0x5c, 0x12, 0xda, 0x58, 0xa6, 0x6b, 0xf1, 0x40, 0x0d, 0x39, 0x6e, 0x11, 0xa6,
+	0x2e, 0xb3, 0xee, 0xde, 0x19, 0x69, 0x9b, 0x4d, 0xeb, 0xdc, 0x81, 0xfd, 0x42, 0xa2, 0x75, 0x9c,
+	0xed, 0x79, 0xa1, 0x3b, 0x5b, 0x07, 0xb1, 0x96, 0x60, 0xba, 0x89, 0x49, 0xa3, 0xa9, 0x13, 0xda,
+	0xb7, 0xf8, 0xe6, 0xbf, 0x7b, 0x85, 0x51, 0x97, 0x63, 0x35, 0xe4, 0x69, 0x4d, 0xbb, 0xbe, 0x3f,
+	0xdc, 0x9e, 0x39, 0x6e, 0x33, 0x09, 0xd0, 0x0b, 0xfb, 0x47, 0x00, 0x27, 0x13, 0x59, 0x2b, 0xf8,
+	0xe5, 0xce, 0xa7, 0x4a, 0x76, 0x77, 0x67, 0x36, 0x73, 0xf4, 0x9a, 0x2d, 0xce, 0x97, 0xe6, 0x4a,
+	0x73, 0xf6, 0xdf, 0x00, 0x4e, 0x19, 0x9e, 0x84, 0xd1, 0x84, 0xb1, 0xb9, 0xde, 0xef, 0xc2, 0xcb,
+	0x9d, 0x61, 0xa1, 0xee, 0x77, 0x2c, 0x84, 0x79, 0x19, 0x5d, 0xdb, 0xdd, 0x99, 0x7d, 0xc5, 0xd0,
+	0xee, 0xdc, 0x13, 0x7a, 0xcb, 0xaa, 0xe4, 0x4a, 0xee, 0xa5, 0xd6, 0x31, 0xbb, 0x45, 0x61, 0x3a,
+	0x79, 0xfa, 0x9c, 0xa7, 0x70, 0x83, 0x52, 0xe9, 0x53, 0x65, 0x68, 0xff, 0x0a, 0xe0, 0xf5, 0x67,
+	0x37, 0xdf, 0x47, 0x44, 0x36, 0x97, 0x70, 0xc8, 0x04, 0x91, 0xe7, 0xd4, 0x87, 0x13, 0x5d, 0x7d,
+	0xa8, 0x5c, 0x66, 0x65, 0x65, 0xe1, 0x80, 0xa7, 0x81, 0xb3, 0xfd, 0xb1, 0xa3, 0xbd, 0xac, 0xbc,
+	0x7a, 0xff, 0x34, 0xad, 0x73, 0x0f, 0x8e, 0x2d, 0x44, 0x92, 0x55, 0x59, 0x10, 0xb2, 0x88, 0x7a,
+	0xab, 0x58, 0xaa, 0xde, 0xb1, 0xee, 0xc2, 0x41, 0xd9, 0xe4, 0x58, 0x34, 0x99, 0xef, 0x99, 0xc3,
+	0x9a, 0x33, 0x2d, 0x31, 0xfe, 0x74, 0x4b, 0x2c, 0x53, 0xd9, 0xd5, 0x0c, 0xcb, 0xd4, 0xd4, 0x71,
+	0x27, 0xc4, 0xb3, 0x8b, 0x67, 0xf1, 0x9d, 0x47, 0xfb, 0x79, 0xf0, 0x78, 0x3f, 0x0f, 0x9e, 0xec,
+	0xe7, 0xc1, 0x5f, 0xfb, 0x79, 0xf0, 0xcd, 0x41, 0x3e, 0xf5, 0xe4, 0x20, 0x9f, 0xfa, 0xfd, 0x20,
+	0x9f, 0xfa, 0xf8, 0xda, 0x11, 0xb0, 0x63, 0x8f, 0xbc, 0xf8, 0xd4, 0xea, 0xe9, 0xf8, 0x1d, 0x7e,
+	0xf3, 0xbf, 0x01, 0x00, 0xa6, 0xab, 0xf4, 0x9d, 0x3a, 0x0c, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -1004,6 +1098,35 @@ func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DelegatorPendingRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatorPendingRewards)
+	if !ok {
+		that2, ok := that.(DelegatorPendingRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Rewards) != len(that1.Rewards) {
+		return false
+	}
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1072,6 +1195,30 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *AutoCompoundSetting) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoCompoundSetting)
+	if !ok {
+		that2, ok := that.(AutoCompoundSetting)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Threshold.Equal(that1.Threshold) {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1520,6 +1667,43 @@ func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegatorPendingRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorPendingRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorPendingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1622,6 +1806,39 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCompoundSetting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundSetting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundSetting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1811,6 +2028,21 @@ func (m *DelegatorStartingInfo) Size() (n int) {
 	return n
 }
 
+func (m *DelegatorPendingRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *DelegationDelegatorReward) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1859,6 +2091,17 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
+func (m *AutoCompoundSetting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Threshold.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
 func sovDistribution(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3018,6 +3261,90 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegatorPendingRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorPendingRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorPendingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3344,6 +3671,90 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoCompoundSetting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundSetting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundSetting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
@@ -30,6 +32,8 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompoundSettings:            []AutoCompoundSettingRecord{},
+		DelegatorPendingRewards:         []DelegatorPendingRewardsRecord{},
 	}
 }
 
@@ -38,5 +42,30 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	for i, pr := range gs.DelegatorPendingRewards {
+		if pr.DelegatorAddress == "" {
+			return fmt.Errorf("delegator pending rewards %d: missing delegator address", i)
+		}
+		if pr.ValidatorAddress == "" {
+			return fmt.Errorf("delegator pending rewards %d: missing validator address", i)
+		}
+		if err := pr.PendingRewards.Rewards.Validate(); err != nil {
+			return fmt.Errorf("delegator pending rewards %d: %w", i, err)
+		}
+	}
+
+	for i, acs := range gs.AutoCompoundSettings {
+		if acs.DelegatorAddress == "" {
+			return fmt.Errorf("auto-compound setting %d: missing delegator address", i)
+		}
+		if acs.ValidatorAddress == "" {
+			return fmt.Errorf("auto-compound setting %d: missing validator address", i)
+		}
+		if acs.Setting.Threshold.IsNil() || acs.Setting.Threshold.IsNegative() {
+			return fmt.Errorf("auto-compound setting %d: threshold must not be negative", i)
+		}
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
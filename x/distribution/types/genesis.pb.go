@@ -281,6 +281,49 @@ func (m *DelegatorStartingInfoRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorStartingInfoRecord proto.InternalMessageInfo
 
+// DelegatorPendingRewardsRecord is used for import / export via genesis json.
+type DelegatorPendingRewardsRecord struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// pending_rewards defines the rewards left unwithdrawn by a partial withdrawal.
+	PendingRewards DelegatorPendingRewards `protobuf:"bytes,3,opt,name=pending_rewards,json=pendingRewards,proto3" json:"pending_rewards"`
+}
+
+func (m *DelegatorPendingRewardsRecord) Reset()         { *m = DelegatorPendingRewardsRecord{} }
+func (m *DelegatorPendingRewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*DelegatorPendingRewardsRecord) ProtoMessage()    {}
+func (*DelegatorPendingRewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{6}
+}
+func (m *DelegatorPendingRewardsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorPendingRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorPendingRewardsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorPendingRewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorPendingRewardsRecord.Merge(m, src)
+}
+func (m *DelegatorPendingRewardsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorPendingRewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorPendingRewardsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorPendingRewardsRecord proto.InternalMessageInfo
+
 // ValidatorSlashEventRecord is used for import / export via genesis json.
 type ValidatorSlashEventRecord struct {
 	// validator_address is the address of the validator.
@@ -297,7 +340,7 @@ func (m *ValidatorSlashEventRecord) Reset()         { *m = ValidatorSlashEventRe
 func (m *ValidatorSlashEventRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEventRecord) ProtoMessage()    {}
 func (*ValidatorSlashEventRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{6}
+	return fileDescriptor_76eed0f9489db580, []int{7}
 }
 func (m *ValidatorSlashEventRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -326,6 +369,49 @@ func (m *ValidatorSlashEventRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorSlashEventRecord proto.InternalMessageInfo
 
+// AutoCompoundSettingRecord is used for import / export via genesis json.
+type AutoCompoundSettingRecord struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// setting is the auto-compounding setting of the delegation.
+	Setting AutoCompoundSetting `protobuf:"bytes,3,opt,name=setting,proto3" json:"setting"`
+}
+
+func (m *AutoCompoundSettingRecord) Reset()         { *m = AutoCompoundSettingRecord{} }
+func (m *AutoCompoundSettingRecord) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundSettingRecord) ProtoMessage()    {}
+func (*AutoCompoundSettingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{8}
+}
+func (m *AutoCompoundSettingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundSettingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundSettingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundSettingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundSettingRecord.Merge(m, src)
+}
+func (m *AutoCompoundSettingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundSettingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundSettingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundSettingRecord proto.InternalMessageInfo
+
 // GenesisState defines the distribution module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
@@ -346,13 +432,17 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compound_settings defines the auto-compounding settings of delegations at genesis.
+	AutoCompoundSettings []AutoCompoundSettingRecord `protobuf:"bytes,11,rep,name=auto_compound_settings,json=autoCompoundSettings,proto3" json:"auto_compound_settings"`
+	// delegator_pending_rewards defines the rewards left unwithdrawn by partial withdrawals at genesis.
+	DelegatorPendingRewards []DelegatorPendingRewardsRecord `protobuf:"bytes,12,rep,name=delegator_pending_rewards,json=delegatorPendingRewards,proto3" json:"delegator_pending_rewards"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{7}
+	return fileDescriptor_76eed0f9489db580, []int{9}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,7 +478,9 @@ func init() {
 	proto.RegisterType((*ValidatorHistoricalRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewardsRecord")
 	proto.RegisterType((*ValidatorCurrentRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewardsRecord")
 	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfoRecord")
+	proto.RegisterType((*DelegatorPendingRewardsRecord)(nil), "cosmos.distribution.v1beta1.DelegatorPendingRewardsRecord")
 	proto.RegisterType((*ValidatorSlashEventRecord)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEventRecord")
+	proto.RegisterType((*AutoCompoundSettingRecord)(nil), "cosmos.distribution.v1beta1.AutoCompoundSettingRecord")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.distribution.v1beta1.GenesisState")
 }
 
@@ -397,65 +489,74 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 925 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0x8e, 0x93, 0x34, 0xcd, 0x4e, 0x8a, 0xd8, 0x9d, 0x6e, 0x17, 0xef, 0xb6, 0x75, 0x36, 0xa5,
-	0x87, 0x02, 0x5a, 0x87, 0x06, 0x04, 0x55, 0x11, 0xa0, 0x26, 0x6d, 0x81, 0x1e, 0xa0, 0x4a, 0x24,
-	0x10, 0x08, 0x29, 0x9a, 0xd8, 0x13, 0x67, 0xd4, 0xc4, 0x13, 0x79, 0x26, 0x0e, 0x20, 0x71, 0xe0,
-	0x54, 0xc4, 0x89, 0x13, 0xe2, 0x58, 0x71, 0xaa, 0x90, 0x90, 0x38, 0xf4, 0x47, 0x54, 0xe2, 0x52,
-	0x55, 0x1c, 0x38, 0x01, 0xca, 0x1e, 0x40, 0x1c, 0xf9, 0x05, 0xc8, 0x9e, 0xb1, 0x3d, 0x59, 0xbb,
-	0xd9, 0xec, 0xd2, 0x5c, 0x76, 0xe3, 0x99, 0xf7, 0xde, 0xf7, 0x7d, 0xef, 0x3d, 0xbf, 0x67, 0xf0,
-	0x82, 0x45, 0xd9, 0x88, 0xb2, 0xba, 0x4d, 0x18, 0xf7, 0x48, 0x6f, 0xc2, 0x09, 0x75, 0xeb, 0xfe,
-	0xe5, 0x1e, 0xe6, 0xe8, 0x72, 0xdd, 0xc1, 0x2e, 0x66, 0x84, 0x99, 0x63, 0x8f, 0x72, 0x0a, 0xcf,
-	0x0a, 0x53, 0x53, 0x35, 0x35, 0xa5, 0xe9, 0xce, 0xa6, 0x43, 0x1d, 0x1a, 0xda, 0xd5, 0x83, 0x5f,
-	0xc2, 0x65, 0xc7, 0x90, 0xd1, 0x7b, 0x88, 0xe1, 0x38, 0xaa, 0x45, 0x89, 0x2b, 0xef, 0xcd, 0x45,
-	0xe8, 0x73, 0x38, 0xc2, 0x7e, 0x5b, 0xd8, 0x77, 0x05, 0x90, 0xe4, 0x23, 0xae, 0x36, 0xd0, 0x88,
-	0xb8, 0xb4, 0x1e, 0xfe, 0x15, 0x47, 0x17, 0x7e, 0xd2, 0xc0, 0x99, 0xeb, 0x78, 0x88, 0x1d, 0xc4,
-	0xa9, 0xf7, 0x11, 0xe1, 0x03, 0xdb, 0x43, 0xd3, 0xf7, 0xdc, 0x3e, 0x85, 0x37, 0xc0, 0x86, 0x1d,
-	0x5d, 0x74, 0x91, 0x6d, 0x7b, 0x98, 0x31, 0x5d, 0xdb, 0xd5, 0x2e, 0xad, 0x35, 0xf5, 0xc7, 0x0f,
-	0xf6, 0x36, 0x65, 0xe4, 0x6b, 0xe2, 0xa6, 0xc3, 0x3d, 0xe2, 0x3a, 0xed, 0xf5, 0xd8, 0x45, 0x9e,
-	0xc3, 0x16, 0x58, 0x9f, 0xca, 0xb0, 0x71, 0x94, 0xfc, 0x21, 0x51, 0x9e, 0x8d, 0x3c, 0xe4, 0xf1,
-	0xd5, 0xf2, 0xd7, 0xf7, 0xaa, 0xb9, 0xbf, 0xef, 0x55, 0x73, 0x17, 0xee, 0xe6, 0x41, 0xed, 0x43,
-	0x34, 0x24, 0x76, 0x80, 0xf1, 0xc1, 0x84, 0x33, 0x8e, 0x5c, 0x3b, 0xf0, 0xc1, 0x53, 0xe4, 0xd9,
-	0xac, 0x8d, 0x2d, 0xea, 0xd9, 0xf0, 0x7d, 0xb0, 0xe1, 0x47, 0x46, 0x07, 0xb8, 0xd7, 0x1e, 0x3f,
-	0xd8, 0x3b, 0x2f, 0x51, 0xe3, 0x40, 0x07, 0x44, 0xf8, 0x07, 0xce, 0xe1, 0x5d, 0x0d, 0x9c, 0xa6,
-	0x09, 0x58, 0xd7, 0x13, 0x68, 0x7a, 0x7e, 0xb7, 0x70, 0xa9, 0xd2, 0x38, 0x27, 0x4b, 0x64, 0x06,
-	0x25, 0x8c, 0xaa, 0x6d, 0x5e, 0xc7, 0x56, 0x8b, 0x12, 0xb7, 0x79, 0xe5, 0xe1, 0xef, 0xd5, 0xdc,
-	0x8f, 0x7f, 0x54, 0x5f, 0x72, 0x08, 0x1f, 0x4c, 0x7a, 0xa6, 0x45, 0x47, 0xb2, 0x2a, 0xf2, 0xdf,
-	0x1e, 0xb3, 0xef, 0xd4, 0xf9, 0xe7, 0x63, 0xcc, 0x22, 0x1f, 0x76, 0xff, 0xaf, 0x9f, 0x5f, 0xd4,
-	0xda, 0x90, 0xa6, 0xf4, 0x29, 0x99, 0xf8, 0x47, 0x03, 0x17, 0x13, 0x01, 0x96, 0x35, 0x19, 0x4d,
-	0x86, 0x88, 0x63, 0xbb, 0x45, 0x47, 0x23, 0xc2, 0x18, 0xa1, 0xee, 0x8a, 0x92, 0x31, 0x00, 0x15,
-	0x94, 0xc0, 0x85, 0xc5, 0xac, 0x34, 0xde, 0x30, 0x17, 0x74, 0xbe, 0xb9, 0x98, 0x67, 0x73, 0x2d,
-	0x48, 0x91, 0xd0, 0xac, 0x86, 0x56, 0xc4, 0xfe, 0xab, 0x81, 0xdd, 0x38, 0xc8, 0xbb, 0x84, 0x71,
-	0xea, 0x11, 0x0b, 0x0d, 0x57, 0x5b, 0xf5, 0x2d, 0x50, 0x1a, 0x63, 0x8f, 0x50, 0xa1, 0xb1, 0xd8,
-	0x96, 0x4f, 0xf0, 0x53, 0x70, 0x32, 0x6a, 0x80, 0x42, 0x28, 0xfe, 0xf5, 0xe5, 0xc4, 0xa7, 0x78,
-	0xab, 0xc2, 0xa3, 0x90, 0x8a, 0xe8, 0x5f, 0x35, 0x70, 0x3e, 0x76, 0x6e, 0x4d, 0x3c, 0x0f, 0xbb,
-	0x7c, 0xb5, 0x8a, 0x3f, 0x4e, 0x94, 0x89, 0xb2, 0xbe, 0xba, 0x9c, 0xb2, 0x79, 0x72, 0x87, 0xc8,
-	0xfa, 0x21, 0x0f, 0xce, 0xc6, 0x23, 0xa7, 0xc3, 0x91, 0xc7, 0x89, 0xeb, 0x04, 0x23, 0x47, 0x8a,
-	0x7a, 0x4a, 0x83, 0x27, 0x33, 0x37, 0xf9, 0xe3, 0xe7, 0xa6, 0x07, 0x9e, 0x61, 0x92, 0x6c, 0x97,
-	0xb8, 0x7d, 0x2a, 0x6b, 0xdf, 0x58, 0x98, 0xa1, 0x4c, 0x9d, 0x6a, 0x7e, 0x4e, 0x31, 0xe5, 0x42,
-	0x49, 0xd2, 0x77, 0x79, 0xb0, 0x1d, 0x53, 0xeb, 0x0c, 0x11, 0x1b, 0xdc, 0xf0, 0xc3, 0x0c, 0xaf,
-	0xaa, 0xd3, 0x07, 0x98, 0x38, 0x03, 0x1e, 0x75, 0xba, 0x78, 0x52, 0xde, 0x80, 0xc2, 0xdc, 0x1b,
-	0x40, 0xc1, 0x99, 0x04, 0x9f, 0x05, 0xec, 0xba, 0x38, 0xa0, 0xa7, 0x17, 0xc3, 0x9c, 0xbc, 0xbc,
-	0x5c, 0xd7, 0x24, 0xb2, 0xd4, 0x8c, 0x9c, 0xf6, 0xd3, 0xf7, 0x4a, 0x62, 0x7e, 0x29, 0x83, 0x53,
-	0xef, 0x88, 0x9d, 0xdb, 0xe1, 0x88, 0x63, 0x78, 0x13, 0x94, 0xc6, 0xc8, 0x43, 0x23, 0x91, 0x80,
-	0x4a, 0xe3, 0xf9, 0x85, 0xe0, 0xb7, 0x43, 0x53, 0x15, 0x4f, 0x7a, 0xc3, 0x5b, 0xa0, 0xdc, 0xc7,
-	0xb8, 0x3b, 0xa6, 0x74, 0x28, 0x9b, 0xff, 0xe2, 0xc2, 0x48, 0x37, 0x31, 0xbe, 0x4d, 0xe9, 0x70,
-	0xae, 0xd9, 0xfb, 0xe2, 0x0c, 0x4e, 0x81, 0x9e, 0xb4, 0x70, 0xbc, 0xfe, 0x82, 0xae, 0x09, 0x46,
-	0x46, 0x61, 0xf9, 0xb6, 0x51, 0x37, 0xb2, 0x8a, 0xb4, 0x65, 0x67, 0x59, 0x30, 0xf8, 0x45, 0xf6,
-	0x9e, 0x3a, 0x11, 0x62, 0xbe, 0xb5, 0x5c, 0x59, 0x9e, 0xb4, 0x55, 0x55, 0xfc, 0x8c, 0xd5, 0x04,
-	0xbf, 0xd7, 0x40, 0x4d, 0xe9, 0xca, 0x64, 0x8e, 0x77, 0xad, 0x78, 0xd4, 0x33, 0xbd, 0x14, 0x52,
-	0xb9, 0xf6, 0x3f, 0xd6, 0x45, 0x9a, 0x4d, 0xd5, 0x5f, 0xe8, 0xc0, 0xe0, 0x37, 0x1a, 0x38, 0x97,
-	0x50, 0x1b, 0xc4, 0x73, 0x38, 0x4e, 0xd0, 0xc9, 0x90, 0xd5, 0x9b, 0xc7, 0x9c, 0xe3, 0x69, 0x46,
-	0x3b, 0xfe, 0x13, 0x8d, 0xe1, 0x57, 0x1a, 0xd8, 0x4e, 0xc8, 0x58, 0x62, 0x74, 0xc6, 0x4c, 0xca,
-	0x21, 0x93, 0xab, 0xc7, 0x99, 0xbb, 0x69, 0x1a, 0xcf, 0xf9, 0xd9, 0x96, 0xf0, 0x4b, 0xb5, 0x41,
-	0xe7, 0xc6, 0x1a, 0xd3, 0xd7, 0x42, 0x06, 0x57, 0x8e, 0x3e, 0xd7, 0xd2, 0xf8, 0x49, 0x9b, 0xaa,
-	0x76, 0x0c, 0x4e, 0xc1, 0x56, 0xe6, 0xfc, 0x60, 0x3a, 0x08, 0xc1, 0x5f, 0x3b, 0xea, 0x00, 0x49,
-	0x43, 0x6f, 0x66, 0x8c, 0x11, 0x65, 0x0b, 0xdd, 0x2a, 0x96, 0x8b, 0xeb, 0x27, 0x9a, 0x6f, 0xdf,
-	0x9f, 0x19, 0xda, 0xc3, 0x99, 0xa1, 0x3d, 0x9a, 0x19, 0xda, 0x9f, 0x33, 0x43, 0xfb, 0x76, 0xdf,
-	0xc8, 0x3d, 0xda, 0x37, 0x72, 0xbf, 0xed, 0x1b, 0xb9, 0x4f, 0x6a, 0x82, 0x05, 0xb3, 0xef, 0x98,
-	0x84, 0xd6, 0x3f, 0x9b, 0xff, 0x04, 0x0f, 0xbf, 0xd8, 0x7a, 0xa5, 0xf0, 0x33, 0xfa, 0x95, 0xff,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x9c, 0xf4, 0xd9, 0x24, 0x0c, 0x00, 0x00,
+	// 1071 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0x69, 0x3e, 0x26, 0x81, 0x26, 0xd3, 0xd4, 0x5d, 0xa7, 0x8d, 0x3f, 0x42, 0x0f,
+	0x05, 0x94, 0x75, 0x12, 0x10, 0x54, 0x41, 0x80, 0x62, 0xb7, 0x05, 0x7a, 0x80, 0x28, 0x16, 0x20,
+	0x10, 0x92, 0x35, 0xde, 0x9d, 0xd8, 0xa3, 0xda, 0x3b, 0xd6, 0xce, 0xd8, 0x06, 0x24, 0x0e, 0x9c,
+	0x8a, 0xb8, 0xc0, 0x09, 0xc1, 0xad, 0xe2, 0x54, 0x81, 0x90, 0x38, 0xf4, 0x2f, 0xe0, 0xd4, 0x63,
+	0x55, 0x71, 0x40, 0x1c, 0x00, 0x25, 0x07, 0x10, 0x47, 0x6e, 0xdc, 0xd0, 0xee, 0xcc, 0xee, 0xce,
+	0x66, 0xd7, 0xee, 0x36, 0x34, 0x52, 0x2f, 0x6d, 0x3c, 0xf3, 0x3e, 0x7e, 0xbf, 0xf7, 0x9e, 0x7f,
+	0xf3, 0x0c, 0x9e, 0x36, 0x29, 0xeb, 0x51, 0x56, 0xb5, 0x08, 0xe3, 0x0e, 0x69, 0x0d, 0x38, 0xa1,
+	0x76, 0x75, 0xb8, 0xd9, 0xc2, 0x1c, 0x6d, 0x56, 0xdb, 0xd8, 0xc6, 0x8c, 0x30, 0xa3, 0xef, 0x50,
+	0x4e, 0xe1, 0x79, 0x61, 0x6a, 0xa8, 0xa6, 0x86, 0x34, 0x5d, 0x59, 0x6e, 0xd3, 0x36, 0xf5, 0xec,
+	0xaa, 0xee, 0x5f, 0xc2, 0x65, 0xa5, 0x28, 0xa3, 0xb7, 0x10, 0xc3, 0x41, 0x54, 0x93, 0x12, 0x5b,
+	0xde, 0x1b, 0x93, 0xb2, 0x47, 0xf2, 0x08, 0xfb, 0x82, 0xb0, 0x6f, 0x8a, 0x44, 0x12, 0x8f, 0xb8,
+	0x5a, 0x42, 0x3d, 0x62, 0xd3, 0xaa, 0xf7, 0xaf, 0x38, 0x5a, 0xfb, 0x41, 0x03, 0x67, 0xaf, 0xe0,
+	0x2e, 0x6e, 0x23, 0x4e, 0x9d, 0x77, 0x09, 0xef, 0x58, 0x0e, 0x1a, 0xbd, 0x61, 0xef, 0x53, 0x78,
+	0x15, 0x2c, 0x59, 0xfe, 0x45, 0x13, 0x59, 0x96, 0x83, 0x19, 0xd3, 0xb5, 0xb2, 0x76, 0x69, 0xae,
+	0xa6, 0xdf, 0xbf, 0xb3, 0xbe, 0x2c, 0x23, 0xef, 0x88, 0x9b, 0x06, 0x77, 0x88, 0xdd, 0xde, 0x5b,
+	0x0c, 0x5c, 0xe4, 0x39, 0xac, 0x83, 0xc5, 0x91, 0x0c, 0x1b, 0x44, 0xc9, 0x3e, 0x20, 0xca, 0x69,
+	0xdf, 0x43, 0x1e, 0x6f, 0xcf, 0x7e, 0x76, 0xab, 0x94, 0xf9, 0xeb, 0x56, 0x29, 0xb3, 0x76, 0x33,
+	0x0b, 0x2a, 0xef, 0xa0, 0x2e, 0xb1, 0xdc, 0x1c, 0x6f, 0x0d, 0x38, 0xe3, 0xc8, 0xb6, 0x5c, 0x1f,
+	0x3c, 0x42, 0x8e, 0xc5, 0xf6, 0xb0, 0x49, 0x1d, 0x0b, 0xbe, 0x09, 0x96, 0x86, 0xbe, 0xd1, 0x11,
+	0xec, 0x95, 0xfb, 0x77, 0xd6, 0x57, 0x65, 0xd6, 0x20, 0xd0, 0x11, 0x12, 0xc3, 0x23, 0xe7, 0xf0,
+	0xa6, 0x06, 0xce, 0xd0, 0x30, 0x59, 0xd3, 0x11, 0xd9, 0xf4, 0x6c, 0x39, 0x77, 0x69, 0x7e, 0xeb,
+	0x82, 0x6c, 0x91, 0xe1, 0xb6, 0xd0, 0xef, 0xb6, 0x71, 0x05, 0x9b, 0x75, 0x4a, 0xec, 0xda, 0xe5,
+	0xbb, 0xbf, 0x95, 0x32, 0xdf, 0xfd, 0x5e, 0x7a, 0xb6, 0x4d, 0x78, 0x67, 0xd0, 0x32, 0x4c, 0xda,
+	0x93, 0x5d, 0x91, 0xff, 0xad, 0x33, 0xeb, 0x46, 0x95, 0x7f, 0xd4, 0xc7, 0xcc, 0xf7, 0x61, 0xb7,
+	0xff, 0xfc, 0xf1, 0x19, 0x6d, 0x0f, 0xd2, 0x18, 0x3f, 0xa5, 0x12, 0x7f, 0x6b, 0xe0, 0x62, 0x48,
+	0xc0, 0x34, 0x07, 0xbd, 0x41, 0x17, 0x71, 0x6c, 0xd5, 0x69, 0xaf, 0x47, 0x18, 0x23, 0xd4, 0x3e,
+	0xa1, 0x62, 0x74, 0xc0, 0x3c, 0x0a, 0xd3, 0x79, 0xcd, 0x9c, 0xdf, 0x7a, 0xc9, 0x98, 0x30, 0xf9,
+	0xc6, 0x64, 0x9c, 0xb5, 0x39, 0xb7, 0x44, 0x82, 0xb3, 0x1a, 0x5a, 0x21, 0xfb, 0x8f, 0x06, 0xca,
+	0x41, 0x90, 0xd7, 0x09, 0xe3, 0xd4, 0x21, 0x26, 0xea, 0x9e, 0x6c, 0xd7, 0xf3, 0x60, 0xba, 0x8f,
+	0x1d, 0x42, 0x05, 0xc7, 0xa9, 0x3d, 0xf9, 0x09, 0x7e, 0x00, 0x66, 0xfc, 0x01, 0xc8, 0x79, 0xe4,
+	0x5f, 0x4c, 0x47, 0x3e, 0x86, 0x5b, 0x25, 0xee, 0x87, 0x54, 0x48, 0xff, 0xac, 0x81, 0xd5, 0xc0,
+	0xb9, 0x3e, 0x70, 0x1c, 0x6c, 0xf3, 0x93, 0x65, 0xfc, 0x5e, 0xc8, 0x4c, 0xb4, 0xf5, 0xf9, 0x74,
+	0xcc, 0xa2, 0xe0, 0x1e, 0x40, 0xeb, 0xdb, 0x2c, 0x38, 0x1f, 0x48, 0x4e, 0x83, 0x23, 0x87, 0x13,
+	0xbb, 0xed, 0x4a, 0x8e, 0x24, 0xf5, 0x88, 0x84, 0x27, 0xb1, 0x36, 0xd9, 0xe3, 0xd7, 0xa6, 0x05,
+	0x9e, 0x60, 0x12, 0x6c, 0x93, 0xd8, 0xfb, 0x54, 0xf6, 0x7e, 0x6b, 0x62, 0x85, 0x12, 0x79, 0xaa,
+	0xf5, 0x59, 0x60, 0xca, 0x85, 0x52, 0xa4, 0x9f, 0xb2, 0x60, 0x35, 0x70, 0xde, 0xc5, 0x09, 0x1a,
+	0xf7, 0x98, 0x96, 0xa9, 0x03, 0x4e, 0xf7, 0x71, 0x54, 0x25, 0x73, 0x29, 0x46, 0x69, 0x0c, 0x57,
+	0xb5, 0x54, 0x4f, 0xf6, 0x23, 0x57, 0xdb, 0x65, 0xbf, 0x58, 0x2e, 0xdb, 0x0f, 0x23, 0x4f, 0x61,
+	0x79, 0xd3, 0xd8, 0x30, 0x36, 0xd6, 0xbe, 0xca, 0x82, 0x42, 0x00, 0xbc, 0xd1, 0x45, 0xac, 0x73,
+	0x75, 0xe8, 0x8d, 0xe9, 0x49, 0xc9, 0x45, 0x07, 0x93, 0x76, 0x87, 0xfb, 0x72, 0x21, 0x3e, 0x29,
+	0x32, 0x92, 0x8b, 0xc8, 0x08, 0x05, 0x67, 0xc3, 0xfc, 0xcc, 0x45, 0xd7, 0xc4, 0x2e, 0x3c, 0x7d,
+	0xca, 0xab, 0xd7, 0x46, 0xba, 0xaf, 0x5e, 0x48, 0x4b, 0xad, 0xd5, 0x99, 0x61, 0xfc, 0x5e, 0x99,
+	0xae, 0xef, 0xb3, 0xa0, 0xb0, 0x33, 0xe0, 0xb4, 0x4e, 0x7b, 0x7d, 0x3a, 0xb0, 0xad, 0x06, 0xe6,
+	0xdc, 0xab, 0xec, 0xe3, 0x3c, 0x59, 0x6f, 0x83, 0x19, 0x26, 0x70, 0xea, 0xb9, 0x14, 0x15, 0x4a,
+	0xe0, 0x17, 0x11, 0x26, 0x19, 0x2b, 0xc5, 0x18, 0xfd, 0x0b, 0xc0, 0xc2, 0x6b, 0x62, 0xcd, 0x6b,
+	0x70, 0xc4, 0x31, 0xbc, 0x06, 0xa6, 0xfb, 0xc8, 0x41, 0x3d, 0x51, 0x95, 0xf9, 0xad, 0xa7, 0x26,
+	0x02, 0xd9, 0xf5, 0x4c, 0xd5, 0xdc, 0xd2, 0x1b, 0x5e, 0x07, 0xb3, 0xfb, 0x18, 0x37, 0xfb, 0x94,
+	0x76, 0xa5, 0xde, 0x5e, 0x9c, 0x18, 0xe9, 0x1a, 0xc6, 0xbb, 0x94, 0x76, 0x23, 0x34, 0xf6, 0xc5,
+	0x19, 0x1c, 0x01, 0x3d, 0x6c, 0x5a, 0xb0, 0x71, 0xb9, 0x42, 0xe5, 0x7e, 0x01, 0x73, 0xe9, 0x95,
+	0x4a, 0x5d, 0x02, 0xd5, 0x4c, 0x79, 0x2b, 0xc9, 0x82, 0xc1, 0x8f, 0x93, 0x57, 0xa3, 0x53, 0x5e,
+	0xce, 0x57, 0xd2, 0x0d, 0xf1, 0xb8, 0x45, 0x4e, 0xcd, 0x9f, 0xb0, 0x0d, 0xc1, 0xaf, 0x35, 0x50,
+	0x51, 0x66, 0x2c, 0x5c, 0x1d, 0x9a, 0x66, 0xb0, 0x5d, 0x30, 0x7d, 0xda, 0x83, 0xb2, 0xf3, 0x3f,
+	0x36, 0x94, 0x38, 0x9a, 0xd2, 0x70, 0xa2, 0x03, 0x83, 0x9f, 0x6b, 0xe0, 0x42, 0x08, 0xad, 0x13,
+	0x3c, 0xfd, 0x41, 0x81, 0x66, 0x3c, 0x54, 0x2f, 0x1f, 0x73, 0x75, 0x88, 0x23, 0x5a, 0x19, 0x8e,
+	0x35, 0x86, 0x9f, 0x6a, 0xa0, 0x10, 0x82, 0x31, 0xc5, 0x6b, 0x1d, 0x20, 0x99, 0xf5, 0x90, 0x6c,
+	0x1f, 0xe7, 0xa9, 0x8f, 0xc3, 0x38, 0x37, 0x4c, 0xb6, 0x84, 0x9f, 0xa8, 0x03, 0x1a, 0x79, 0x49,
+	0x99, 0x3e, 0xe7, 0x21, 0xb8, 0xfc, 0xf0, 0x4f, 0x69, 0x3c, 0x7f, 0xde, 0x4a, 0xb2, 0x63, 0x70,
+	0x04, 0xf2, 0x89, 0x6a, 0xcb, 0x74, 0xe0, 0x25, 0x7f, 0xe1, 0x61, 0xe5, 0x36, 0x9e, 0x7a, 0x39,
+	0x41, 0x74, 0x19, 0xfc, 0x42, 0x03, 0x79, 0x34, 0xe0, 0xb4, 0x69, 0x4a, 0x31, 0x6a, 0x4a, 0xe5,
+	0x61, 0xfa, 0x7c, 0x8a, 0xcc, 0x63, 0x65, 0xba, 0x56, 0x71, 0x33, 0xff, 0x3a, 0x46, 0xb4, 0x24,
+	0x22, 0x14, 0xf7, 0x66, 0xf0, 0x1b, 0x0d, 0x14, 0xc2, 0x56, 0x1c, 0x7d, 0xad, 0x17, 0x52, 0x4c,
+	0xc3, 0xc4, 0xcd, 0x24, 0x0d, 0xb0, 0x73, 0x56, 0x72, 0x84, 0xf0, 0x8d, 0xba, 0x3e, 0x35, 0x3b,
+	0xb5, 0x78, 0xaa, 0xf6, 0xea, 0xed, 0x83, 0xa2, 0x76, 0xf7, 0xa0, 0xa8, 0xdd, 0x3b, 0x28, 0x6a,
+	0x7f, 0x1c, 0x14, 0xb5, 0x2f, 0x0f, 0x8b, 0x99, 0x7b, 0x87, 0xc5, 0xcc, 0x2f, 0x87, 0xc5, 0xcc,
+	0xfb, 0x15, 0x01, 0x92, 0x59, 0x37, 0x0c, 0x42, 0xab, 0xd1, 0x74, 0xe2, 0x27, 0x55, 0x6b, 0xda,
+	0xfb, 0x9d, 0xfb, 0xdc, 0x7f, 0x03, 0x00, 0xd5, 0x49, 0xda, 0x2d, 0xc5, 0x0f, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -711,6 +812,53 @@ func (m *DelegatorStartingInfoRecord) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegatorPendingRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorPendingRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorPendingRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorSlashEventRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -761,6 +909,53 @@ func (m *ValidatorSlashEventRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCompoundSettingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundSettingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundSettingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Setting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -781,6 +976,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegatorPendingRewards) > 0 {
+		for iNdEx := len(m.DelegatorPendingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorPendingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AutoCompoundSettings) > 0 {
+		for iNdEx := len(m.AutoCompoundSettings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundSettings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1016,6 +1239,25 @@ func (m *DelegatorStartingInfoRecord) Size() (n int) {
 	return n
 }
 
+func (m *DelegatorPendingRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.PendingRewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *ValidatorSlashEventRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1037,6 +1279,25 @@ func (m *ValidatorSlashEventRecord) Size() (n int) {
 	return n
 }
 
+func (m *AutoCompoundSettingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Setting.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1089,6 +1350,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundSettings) > 0 {
+		for _, e := range m.AutoCompoundSettings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorPendingRewards) > 0 {
+		for _, e := range m.DelegatorPendingRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1839,7 +2112,7 @@ func (m *DelegatorStartingInfoRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
+func (m *DelegatorPendingRewardsRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1862,15 +2135,15 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEventRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorPendingRewardsRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEventRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorPendingRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1898,13 +2171,13 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1914,33 +2187,27 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.Period = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1967,7 +2234,7 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorSlashEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1992,7 +2259,7 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2015,15 +2282,315 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorSlashEventRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorSlashEventRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorSlashEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoCompoundSettingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundSettingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundSettingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Setting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Setting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2325,6 +2892,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundSettings = append(m.AutoCompoundSettings, AutoCompoundSettingRecord{})
+			if err := m.AutoCompoundSettings[len(m.AutoCompoundSettings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorPendingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorPendingRewards = append(m.DelegatorPendingRewards, DelegatorPendingRewardsRecord{})
+			if err := m.DelegatorPendingRewards[len(m.DelegatorPendingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -36,5 +36,8 @@ A full setup of the evidence module may look something as follows:
 	)
 
 	// Remaining application bootstrapping...
+
+With depinject, modules register their evidence types by providing a
+types.HandlerRoute, which is added to the router of the evidence keeper.
 */
 package evidence
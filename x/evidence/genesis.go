@@ -24,6 +24,11 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, gs *types.GenesisState) e
 		if !ok {
 			return errors.New("expected evidence")
 		}
+		// equivocation evidence is handled by the module itself, any other
+		// evidence type must have a registered handler
+		if evi.Route() != types.RouteEquivocation && !k.HasEvidenceHandler(evi.Route()) {
+			return fmt.Errorf("no handler registered for evidence route %s", evi.Route())
+		}
 		if _, err := k.Evidences.Get(ctx, evi.Hash()); err == nil {
 			return fmt.Errorf("evidence with hash %s already exists", evi.Hash())
 		}
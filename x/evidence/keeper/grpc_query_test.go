@@ -135,6 +135,28 @@ func (suite *KeeperTestSuite) TestQueryAllEvidence() {
 				suite.NotNil(res.Pagination.NextKey)
 			},
 		},
+		{
+			"success filtered by route",
+			func() {
+				_ = suite.populateEvidence(suite.ctx, 10)
+				req = &types.QueryAllEvidenceRequest{Route: types.RouteEquivocation}
+			},
+			true,
+			func(res *types.QueryAllEvidenceResponse) {
+				suite.Equal(len(res.Evidence), 10)
+			},
+		},
+		{
+			"success filtered by unknown route",
+			func() {
+				_ = suite.populateEvidence(suite.ctx, 10)
+				req = &types.QueryAllEvidenceRequest{Route: "oracle"}
+			},
+			true,
+			func(res *types.QueryAllEvidenceResponse) {
+				suite.Require().Empty(res.Evidence)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
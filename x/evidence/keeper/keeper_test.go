@@ -193,6 +193,33 @@ func (suite *KeeperTestSuite) TestSubmitValidEvidence_Duplicate() {
 	suite.Equal(e, res)
 }
 
+func (suite *KeeperTestSuite) TestSubmitEvidence_NoHandler() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	pk := ed25519.GenPrivKey()
+	consAddr, err := suite.consAddressCodec.BytesToString(pk.PubKey().Address())
+	suite.Require().NoError(err)
+
+	e := &types.Equivocation{
+		Height:           1,
+		Power:            100,
+		Time:             time.Now().UTC(),
+		ConsensusAddress: consAddr,
+	}
+
+	// a keeper without router has no evidence handler
+	k := keeper.NewKeeper(suite.encCfg.Codec, suite.evidenceKeeper.Environment, suite.stakingKeeper, suite.slashingKeeper, suite.addressCodec)
+	suite.False(k.HasEvidenceHandler(types.RouteEquivocation))
+	suite.ErrorIs(k.SubmitEvidence(ctx, e), types.ErrNoEvidenceHandlerExists)
+
+	// a keeper with an empty router has no evidence handler
+	k.SetRouter(types.NewRouter())
+	suite.False(k.HasEvidenceHandler(types.RouteEquivocation))
+	suite.ErrorIs(k.SubmitEvidence(ctx, e), types.ErrNoEvidenceHandlerExists)
+
+	_, err = k.Evidences.Get(ctx, e.Hash())
+	suite.ErrorIs(err, collections.ErrNotFound)
+}
+
 func (suite *KeeperTestSuite) TestSubmitInvalidEvidence() {
 	ctx := suite.ctx.WithIsCheckTx(false)
 	pk := ed25519.GenPrivKey()
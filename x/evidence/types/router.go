@@ -26,12 +26,23 @@ type (
 		Sealed() bool
 	}
 
+	// HandlerRoute defines an evidence Handler registered for a given route.
+	// Modules provide it through depinject to register custom evidence types
+	// with the x/evidence keeper.
+	HandlerRoute struct {
+		RouteKey string
+		Handler  Handler
+	}
+
 	router struct {
 		routes map[string]Handler
 		sealed bool
 	}
 )
 
+// IsManyPerContainerType implements the depinject.ManyPerContainerType interface.
+func (HandlerRoute) IsManyPerContainerType() {}
+
 func NewRouter() Router {
 	return &router{
 		routes: make(map[string]Handler),
@@ -22,6 +22,8 @@ const (
 	FlagExpiration  = "expiration"
 	FlagPeriod      = "period"
 	FlagPeriodLimit = "period-limit"
+	FlagRateWindow  = "rate-window"
+	FlagRateLimit   = "rate-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
 )
@@ -59,9 +61,10 @@ func NewCmdFeeGrant() *cobra.Command {
 Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --rate-window 86400 --rate-limit 1stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
 	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
-				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -159,6 +162,46 @@ Examples:
 				grant = &periodic
 			}
 
+			rateWindow, err := cmd.Flags().GetInt64(FlagRateWindow)
+			if err != nil {
+				return err
+			}
+
+			rateLimitVal, err := cmd.Flags().GetString(FlagRateLimit)
+			if err != nil {
+				return err
+			}
+
+			// check any of rateWindow or rateLimit flags are set,
+			// if set consider it as rate limited fee allowance.
+			if rateWindow > 0 || rateLimitVal != "" {
+				if periodClock > 0 || periodLimitVal != "" {
+					return errors.New("period and rate limit flags cannot be used together")
+				}
+
+				rateLimit, err := sdk.ParseCoinsNormalized(rateLimitVal)
+				if err != nil {
+					return err
+				}
+
+				if rateWindow <= 0 {
+					return errors.New("rate window was not set")
+				}
+
+				if rateLimit == nil {
+					return errors.New("rate limit was not set")
+				}
+
+				rateLimited := feegrant.RateLimitedAllowance{
+					Basic:     basic,
+					Window:    getPeriod(rateWindow),
+					RateLimit: rateLimit,
+					Available: rateLimit,
+				}
+
+				grant = &rateLimited
+			}
+
 			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
 			if err != nil {
 				return err
@@ -186,6 +229,8 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
 	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().Int64(FlagRateWindow, 0, "rate window specifies the time duration(in seconds) over which rate_limit coins refill (ex: 86400)")
+	cmd.Flags().String(FlagRateLimit, "", "rate limit specifies the maximum number of coins that can be spent in any rate window")
 
 	return cmd
 }
@@ -406,6 +406,51 @@ func (s *CLITestSuite) TestNewCmdFeeGrant() {
 			),
 			false, 0, &sdk.TxResponse{},
 		},
+		{
+			"rate window mentioned and rate limit omitted, invalid rate limited grant",
+			append(
+				[]string{
+					granterAddr,
+					"cosmos1w55kgcf3ltaqdy4ww49nge3klxmrdavrr6frmp",
+					fmt.Sprintf("--%s=%d", cli.FlagRateWindow, oneHour),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
+		{
+			"period and rate limit together, invalid rate limited grant",
+			append(
+				[]string{
+					granterAddr,
+					"cosmos1w55kgcf3ltaqdy4ww49nge3klxmrdavrr6frmp",
+					fmt.Sprintf("--%s=%d", cli.FlagPeriod, oneHour),
+					fmt.Sprintf("--%s=%s", cli.FlagPeriodLimit, "10stake"),
+					fmt.Sprintf("--%s=%d", cli.FlagRateWindow, oneHour),
+					fmt.Sprintf("--%s=%s", cli.FlagRateLimit, "10stake"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
+		{
+			"valid rate limited fee grant",
+			append(
+				[]string{
+					granterAddr,
+					"cosmos1w55kgcf3ltaqdy4ww49nge3klxmrdavrr6frmp",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%d", cli.FlagRateWindow, oneHour),
+					fmt.Sprintf("--%s=%s", cli.FlagRateLimit, "10stake"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+					fmt.Sprintf("--%s=%s", cli.FlagExpiration, getFormattedExpiration(tenHours)),
+				},
+				commonFlags...,
+			),
+			false, 0, &sdk.TxResponse{},
+		},
 		{
 			"invalid expiration",
 			append(
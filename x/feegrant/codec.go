@@ -18,7 +18,9 @@ func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	cdc.RegisterInterface((*FeeAllowanceI)(nil), nil)
 	cdc.RegisterConcrete(&BasicAllowance{}, "cosmos-sdk/BasicAllowance")
 	cdc.RegisterConcrete(&PeriodicAllowance{}, "cosmos-sdk/PeriodicAllowance")
+	cdc.RegisterConcrete(&RateLimitedAllowance{}, "cosmos-sdk/RateLimitedAllowance")
 	cdc.RegisterConcrete(&AllowedMsgAllowance{}, "cosmos-sdk/AllowedMsgAllowance")
+	cdc.RegisterConcrete(&ConvertedFeeAllowance{}, "cosmos-sdk/ConvertedFeeAllowance")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -33,7 +35,9 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		(*FeeAllowanceI)(nil),
 		&BasicAllowance{},
 		&PeriodicAllowance{},
+		&RateLimitedAllowance{},
 		&AllowedMsgAllowance{},
+		&ConvertedFeeAllowance{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -0,0 +1,168 @@
+package feegrant
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type priceSourceContextKey struct{}
+
+// PriceSourceContextKey is the context key under which the keeper passes its
+// PriceSource to the allowances.
+var PriceSourceContextKey = priceSourceContextKey{}
+
+var (
+	_ FeeAllowanceI                        = (*ConvertedFeeAllowance)(nil)
+	_ gogoprotoany.UnpackInterfacesMessage = (*ConvertedFeeAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *ConvertedFeeAllowance) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewConvertedFeeAllowance creates a new converted fee allowance, accounted in
+// denom and payable with the allowed denoms.
+func NewConvertedFeeAllowance(allowance FeeAllowanceI, denom string, allowedDenoms []string) (*ConvertedFeeAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConvertedFeeAllowance{
+		Allowance:     any,
+		Denom:         denom,
+		AllowedDenoms: allowedDenoms,
+	}, nil
+}
+
+// GetAllowance returns the inner fee allowance.
+func (a *ConvertedFeeAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets the inner fee allowance.
+func (a *ConvertedFeeAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	var err error
+	a.Allowance, err = types.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	return nil
+}
+
+// Accept converts the fee to the accounting denom and deducts it from the inner
+// allowance. Fees already in the accounting denom are not converted.
+func (a *ConvertedFeeAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	converted, err := a.convertFee(ctx, fee)
+	if err != nil {
+		return false, err
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, converted, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+// convertFee returns the value of the fee in the accounting denom.
+func (a *ConvertedFeeAllowance) convertFee(ctx context.Context, fee sdk.Coins) (sdk.Coins, error) {
+	converted := sdk.NewCoins()
+	for _, coin := range fee {
+		if coin.Denom == a.Denom {
+			converted = converted.Add(coin)
+			continue
+		}
+
+		if len(a.AllowedDenoms) > 0 && !slices.Contains(a.AllowedDenoms, coin.Denom) {
+			return nil, errorsmod.Wrapf(ErrDenomNotAllowed, "cannot pay fees with %s", coin.Denom)
+		}
+
+		priceSource, ok := ctx.Value(PriceSourceContextKey).(PriceSource)
+		if !ok || priceSource == nil {
+			return nil, errors.New("price source not set")
+		}
+
+		value, err := priceSource.ConvertFee(ctx, coin, a.Denom)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "convert %s to %s", coin, a.Denom)
+		}
+		if value.Denom != a.Denom {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "price source converted %s to %s instead of %s", coin, value.Denom, a.Denom)
+		}
+
+		converted = converted.Add(value)
+	}
+
+	return converted, nil
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *ConvertedFeeAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return errorsmod.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	for _, denom := range a.AllowedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// ExpiresAt returns the expiry time of the ConvertedFeeAllowance.
+func (a *ConvertedFeeAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
+
+// UpdatePeriodReset update "PeriodReset" of the ConvertedFeeAllowance.
+func (a *ConvertedFeeAllowance) UpdatePeriodReset(validTime time.Time) error {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+	return allowance.UpdatePeriodReset(validTime)
+}
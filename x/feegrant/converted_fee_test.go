@@ -0,0 +1,180 @@
+package feegrant_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/feegrant"
+	"cosmossdk.io/x/feegrant/module"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestConvertedFeeValidAllow(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, module.AppModule{})
+
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	// one eth is worth 3 atom, one osmo is worth 2 atom
+	prices := mockPriceSource{"eth": 3, "osmo": 2}
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	call := banktypes.MsgSend{}
+
+	cases := map[string]struct {
+		denom         string
+		allowedDenoms []string
+		priceSource   feegrant.PriceSource
+		fee           sdk.Coins
+		valid         bool // all other checks are ignored if valid=false
+		accept        bool
+		remove        bool
+		remains       sdk.Coins
+	}{
+		"invalid denom": {
+			denom: "",
+			valid: false,
+		},
+		"invalid allowed denom": {
+			denom:         "atom",
+			allowedDenoms: []string{"!"},
+			valid:         false,
+		},
+		"fee in accounting denom": {
+			denom:   "atom",
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("atom", 10)),
+			valid:   true,
+			accept:  true,
+			remains: sdk.NewCoins(sdk.NewInt64Coin("atom", 90)),
+		},
+		"fee in accounting denom without price source": {
+			denom:       "atom",
+			priceSource: nil,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("atom", 10)),
+			valid:       true,
+			accept:      true,
+			remains:     sdk.NewCoins(sdk.NewInt64Coin("atom", 90)),
+		},
+		"converted fee": {
+			denom:       "atom",
+			priceSource: prices,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("eth", 10)),
+			valid:       true,
+			accept:      true,
+			remains:     sdk.NewCoins(sdk.NewInt64Coin("atom", 70)),
+		},
+		"converted fee in several denoms": {
+			denom:       "atom",
+			priceSource: prices,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("eth", 10), sdk.NewInt64Coin("osmo", 5)),
+			valid:       true,
+			accept:      true,
+			remains:     sdk.NewCoins(sdk.NewInt64Coin("atom", 55)),
+		},
+		"converted fee uses the whole allowance": {
+			denom:       "atom",
+			priceSource: prices,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("osmo", 50)),
+			valid:       true,
+			accept:      true,
+			remove:      true,
+		},
+		"converted fee more than allowed": {
+			denom:       "atom",
+			priceSource: prices,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("eth", 34)),
+			valid:       true,
+			accept:      false,
+		},
+		"allowed denom": {
+			denom:         "atom",
+			allowedDenoms: []string{"eth"},
+			priceSource:   prices,
+			fee:           sdk.NewCoins(sdk.NewInt64Coin("eth", 10)),
+			valid:         true,
+			accept:        true,
+			remains:       sdk.NewCoins(sdk.NewInt64Coin("atom", 70)),
+		},
+		"denom not allowed": {
+			denom:         "atom",
+			allowedDenoms: []string{"eth"},
+			priceSource:   prices,
+			fee:           sdk.NewCoins(sdk.NewInt64Coin("osmo", 10)),
+			valid:         true,
+			accept:        false,
+		},
+		"no price for denom": {
+			denom:       "atom",
+			priceSource: prices,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			valid:       true,
+			accept:      false,
+		},
+		"no price source": {
+			denom:  "atom",
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("eth", 10)),
+			valid:  true,
+			accept: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewConvertedFeeAllowance(&feegrant.BasicAllowance{SpendLimit: atom}, tc.denom, tc.allowedDenoms)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			acceptCtx := context.WithValue(ctx, corecontext.EnvironmentContextKey, appmodule.Environment{
+				HeaderService: mockHeaderService{},
+				GasService:    mockGasService{},
+			})
+			if tc.priceSource != nil {
+				acceptCtx = context.WithValue(acceptCtx, feegrant.PriceSourceContextKey, tc.priceSource)
+			}
+
+			removed, err := allowance.Accept(acceptCtx, tc.fee, []sdk.Msg{&call})
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.remove, removed)
+			if removed {
+				return
+			}
+
+			// the updated inner allowance must survive a save and load
+			grant, err := feegrant.NewGrant("granter", "grantee", allowance)
+			require.NoError(t, err)
+			bz, err := encCfg.Codec.Marshal(&grant)
+			require.NoError(t, err)
+			var loadedGrant feegrant.Grant
+			require.NoError(t, encCfg.Codec.Unmarshal(bz, &loadedGrant))
+
+			loaded, err := loadedGrant.GetGrant()
+			require.NoError(t, err)
+			inner, err := loaded.(*feegrant.ConvertedFeeAllowance).GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, tc.remains, inner.(*feegrant.BasicAllowance).SpendLimit)
+		})
+	}
+}
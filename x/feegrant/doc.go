@@ -17,7 +17,7 @@ to the one who is signing a transaction and the granter would be the address tha
 pays the fees.
 
 The fee allowance that a grantee receives is specified by an implementation of
-the FeeAllowance interface. Two FeeAllowance implementations are provided in
-this package: BasicAllowance and PeriodicAllowance.
+the FeeAllowance interface. Three FeeAllowance implementations are provided in
+this package: BasicAllowance, PeriodicAllowance and RateLimitedAllowance.
 */
 package feegrant
@@ -11,4 +11,10 @@ const (
 	AttributeKeyGranter = "granter"
 	AttributeKeyGrantee = "grantee"
 	AttributeKeyPruner  = "pruner"
+
+	AttributeKeyFee                      = "fee"
+	AttributeKeyRemainingSpendLimit      = "remaining_spend_limit"
+	AttributeKeyRemainingPeriodAllowance = "remaining_period_allowance"
+
+	AttributeValueUnlimited = "unlimited"
 )
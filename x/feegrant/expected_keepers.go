@@ -25,3 +25,10 @@ type BankKeeper interface {
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
+
+// PriceSource defines the expected price oracle used by ConvertedFeeAllowance
+// to convert fees to the denom in which an allowance is accounted.
+type PriceSource interface {
+	// ConvertFee returns the value of coin in the given denom.
+	ConvertFee(ctx context.Context, coin sdk.Coin, denom string) (sdk.Coin, error)
+}
@@ -178,6 +178,92 @@ func (m *PeriodicAllowance) GetPeriodReset() time.Time {
 	return time.Time{}
 }
 
+// RateLimitedAllowance extends Allowance to allow for both a maximum cap,
+// as well as a spend rate limit. Up to rate_limit coins can be spent in any
+// window, the available coins refilling linearly over time.
+type RateLimitedAllowance struct {
+	// basic specifies a struct of `BasicAllowance`
+	Basic BasicAllowance `protobuf:"bytes,1,opt,name=basic,proto3" json:"basic"`
+	// window specifies the time duration over which the available coins fully
+	// refill up to rate_limit
+	Window time.Duration `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window"`
+	// rate_limit specifies the maximum number of coins that can be spent in
+	// any window
+	RateLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=rate_limit,json=rateLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rate_limit"`
+	// available is the number of coins that can currently be spent
+	Available github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=available,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"available"`
+	// last_refill is the time at which the available coins were last refilled
+	LastRefill time.Time `protobuf:"bytes,5,opt,name=last_refill,json=lastRefill,proto3,stdtime" json:"last_refill"`
+}
+
+func (m *RateLimitedAllowance) Reset()         { *m = RateLimitedAllowance{} }
+func (m *RateLimitedAllowance) String() string { return proto.CompactTextString(m) }
+func (*RateLimitedAllowance) ProtoMessage()    {}
+func (*RateLimitedAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{2}
+}
+func (m *RateLimitedAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitedAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitedAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitedAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitedAllowance.Merge(m, src)
+}
+func (m *RateLimitedAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitedAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitedAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitedAllowance proto.InternalMessageInfo
+
+func (m *RateLimitedAllowance) GetBasic() BasicAllowance {
+	if m != nil {
+		return m.Basic
+	}
+	return BasicAllowance{}
+}
+
+func (m *RateLimitedAllowance) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *RateLimitedAllowance) GetRateLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RateLimit
+	}
+	return nil
+}
+
+func (m *RateLimitedAllowance) GetAvailable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Available
+	}
+	return nil
+}
+
+func (m *RateLimitedAllowance) GetLastRefill() time.Time {
+	if m != nil {
+		return m.LastRefill
+	}
+	return time.Time{}
+}
+
 // AllowedMsgAllowance creates allowance only for specified message types.
 type AllowedMsgAllowance struct {
 	// allowance can be any of basic and periodic fee allowance.
@@ -190,7 +276,7 @@ func (m *AllowedMsgAllowance) Reset()         { *m = AllowedMsgAllowance{} }
 func (m *AllowedMsgAllowance) String() string { return proto.CompactTextString(m) }
 func (*AllowedMsgAllowance) ProtoMessage()    {}
 func (*AllowedMsgAllowance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{2}
+	return fileDescriptor_7279582900c30aea, []int{3}
 }
 func (m *AllowedMsgAllowance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -219,6 +305,54 @@ func (m *AllowedMsgAllowance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AllowedMsgAllowance proto.InternalMessageInfo
 
+// ConvertedFeeAllowance is an allowance whose accounting happens in a single
+// denom, while the grantee pays fees in other denoms. The fees are converted to
+// the accounting denom using the price source of the chain before being
+// deducted from the inner allowance.
+type ConvertedFeeAllowance struct {
+	// allowance is the allowance the converted fees are deducted from, its limits
+	// are expressed in denom.
+	Allowance *any.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// denom is the denom in which the allowance is accounted.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// allowed_denoms are the denoms the grantee can pay fees with, in addition to
+	// denom. If empty, any denom the price source can convert is allowed.
+	AllowedDenoms []string `protobuf:"bytes,3,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+}
+
+func (m *ConvertedFeeAllowance) Reset()         { *m = ConvertedFeeAllowance{} }
+func (m *ConvertedFeeAllowance) String() string { return proto.CompactTextString(m) }
+func (*ConvertedFeeAllowance) ProtoMessage()    {}
+func (*ConvertedFeeAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *ConvertedFeeAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvertedFeeAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvertedFeeAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvertedFeeAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertedFeeAllowance.Merge(m, src)
+}
+func (m *ConvertedFeeAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvertedFeeAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertedFeeAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvertedFeeAllowance proto.InternalMessageInfo
+
 // Grant is stored in the KVStore to record a grant with full context
 type Grant struct {
 	// granter is the address of the user granting an allowance of their funds.
@@ -233,7 +367,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{3}
+	return fileDescriptor_7279582900c30aea, []int{5}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,7 +420,9 @@ func (m *Grant) GetAllowance() *any.Any {
 func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
+	proto.RegisterType((*RateLimitedAllowance)(nil), "cosmos.feegrant.v1beta1.RateLimitedAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
+	proto.RegisterType((*ConvertedFeeAllowance)(nil), "cosmos.feegrant.v1beta1.ConvertedFeeAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
 }
 
@@ -295,49 +431,58 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x4f, 0xd4, 0x4e,
-	0x14, 0xdf, 0x61, 0x81, 0x6f, 0x76, 0x96, 0x2f, 0x42, 0x25, 0xb1, 0x4b, 0x4c, 0x4b, 0x36, 0x51,
-	0x17, 0x12, 0xda, 0x80, 0x37, 0x4e, 0x50, 0x0c, 0xa8, 0x81, 0x84, 0x14, 0x4f, 0x26, 0xa6, 0x99,
-	0xb6, 0x43, 0x9d, 0xb0, 0xed, 0x34, 0x9d, 0xa2, 0xec, 0xd5, 0x93, 0xd1, 0x83, 0x1c, 0x8d, 0x27,
-	0x8e, 0xc6, 0x13, 0x07, 0xfe, 0x08, 0xe2, 0xc1, 0x10, 0x4f, 0x7a, 0x11, 0x03, 0x07, 0xce, 0xfe,
-	0x07, 0xa6, 0x33, 0xd3, 0x6e, 0x61, 0x25, 0x42, 0x62, 0xb8, 0xec, 0x76, 0xde, 0xbc, 0xf7, 0xf9,
-	0xf1, 0xde, 0x6b, 0x0a, 0xef, 0x7a, 0x94, 0x85, 0x94, 0x99, 0x1b, 0x18, 0x07, 0x09, 0x8a, 0x52,
-	0xf3, 0xc5, 0x8c, 0x8b, 0x53, 0x34, 0x53, 0x04, 0x8c, 0x38, 0xa1, 0x29, 0x55, 0x6e, 0x89, 0x3c,
-	0xa3, 0x08, 0xcb, 0xbc, 0xf1, 0xb1, 0x80, 0x06, 0x94, 0xe7, 0x98, 0xd9, 0x93, 0x48, 0x1f, 0x6f,
-	0x04, 0x94, 0x06, 0x6d, 0x6c, 0xf2, 0x93, 0xbb, 0xb5, 0x61, 0xa2, 0xa8, 0x93, 0x5f, 0x09, 0x24,
-	0x47, 0xd4, 0x48, 0x58, 0x71, 0xa5, 0x49, 0x31, 0x2e, 0x62, 0xb8, 0x10, 0xe2, 0x51, 0x12, 0xc9,
-	0xfb, 0x51, 0x14, 0x92, 0x88, 0x9a, 0xfc, 0x57, 0x86, 0xf4, 0xf3, 0x44, 0x29, 0x09, 0x31, 0x4b,
-	0x51, 0x18, 0xe7, 0x98, 0xe7, 0x13, 0xfc, 0xad, 0x04, 0xa5, 0x84, 0x4a, 0xcc, 0xe6, 0x6e, 0x1f,
-	0x1c, 0xb6, 0x10, 0x23, 0xde, 0x42, 0xbb, 0x4d, 0x5f, 0xa2, 0xc8, 0xc3, 0xca, 0x2b, 0x00, 0xeb,
-	0x2c, 0xc6, 0x91, 0xef, 0xb4, 0x49, 0x48, 0x52, 0x15, 0x4c, 0x54, 0x5b, 0xf5, 0xd9, 0x86, 0x21,
-	0xb5, 0x66, 0xea, 0x72, 0xfb, 0xc6, 0x22, 0x25, 0x91, 0xb5, 0x74, 0xf0, 0x43, 0xaf, 0x7c, 0x3a,
-	0xd2, 0x5b, 0x01, 0x49, 0x9f, 0x6f, 0xb9, 0x86, 0x47, 0x43, 0x69, 0x4c, 0xfe, 0x4d, 0x33, 0x7f,
-	0xd3, 0x4c, 0x3b, 0x31, 0x66, 0xbc, 0x80, 0x7d, 0x38, 0xdd, 0x9b, 0x1a, 0x6a, 0xe3, 0x00, 0x79,
-	0x1d, 0x27, 0xf3, 0xc7, 0x3e, 0x9e, 0xee, 0x4d, 0x01, 0x1b, 0x72, 0xd6, 0x95, 0x8c, 0x54, 0x99,
-	0x87, 0x10, 0x6f, 0xc7, 0x44, 0x68, 0x55, 0xfb, 0x26, 0x40, 0xab, 0x3e, 0x3b, 0x6e, 0x08, 0x33,
-	0x46, 0x6e, 0xc6, 0x78, 0x92, 0xbb, 0xb5, 0xfa, 0x77, 0x8e, 0x74, 0x60, 0x97, 0x6a, 0xe6, 0x96,
-	0x3f, 0xef, 0x4f, 0xdf, 0xb9, 0x60, 0x6c, 0xc6, 0x12, 0xc6, 0x85, 0xe1, 0x47, 0x6f, 0x4e, 0xf7,
-	0xa6, 0x1a, 0x25, 0xa5, 0x67, 0xfb, 0xd1, 0xfc, 0xde, 0x0f, 0x47, 0xd7, 0x70, 0x42, 0xa8, 0x5f,
-	0xee, 0xd2, 0x43, 0x38, 0xe0, 0x66, 0x79, 0x2a, 0xe0, 0xda, 0xee, 0x19, 0x17, 0x51, 0x9d, 0x45,
-	0xb3, 0x6a, 0x59, 0xb3, 0x84, 0x5f, 0x01, 0xa0, 0xcc, 0xc3, 0xc1, 0x98, 0xc3, 0x4b, 0x9b, 0x8d,
-	0x1e, 0x9b, 0x0f, 0xe4, 0xcc, 0xac, 0xff, 0xb3, 0xe2, 0xf7, 0x47, 0x3a, 0x10, 0x00, 0xb2, 0x4e,
-	0x79, 0x07, 0xa0, 0x22, 0x1e, 0x9d, 0xf2, 0xe0, 0xaa, 0xd7, 0x35, 0xb8, 0x11, 0x41, 0xbe, 0xde,
-	0x1d, 0xdf, 0x5b, 0x00, 0x65, 0xd0, 0xf1, 0x50, 0x24, 0x54, 0xa9, 0xfd, 0xd7, 0xa5, 0x67, 0x58,
-	0x50, 0x2f, 0xa2, 0x88, 0x4b, 0x52, 0x56, 0xe0, 0x90, 0x14, 0x93, 0x60, 0x86, 0x53, 0x75, 0xe0,
-	0xaf, 0xeb, 0xc4, 0x1b, 0xbd, 0x53, 0x34, 0xba, 0x2e, 0xca, 0xed, 0xac, 0x7a, 0xee, 0xf1, 0x95,
-	0x16, 0xeb, 0x76, 0x49, 0x79, 0xcf, 0x16, 0x35, 0x7f, 0x01, 0x78, 0x93, 0x9f, 0xb0, 0xbf, 0xca,
-	0x82, 0xee, 0x76, 0x3d, 0x83, 0x35, 0x94, 0x1f, 0xe4, 0x86, 0x8d, 0xf5, 0xc8, 0x5d, 0x88, 0x3a,
-	0xd6, 0xe4, 0xa5, 0xc5, 0xd8, 0x5d, 0x44, 0x65, 0x12, 0x8e, 0x20, 0xc1, 0xea, 0x84, 0x98, 0x31,
-	0x14, 0x60, 0xa6, 0xf6, 0x4d, 0x54, 0x5b, 0x35, 0xfb, 0x86, 0x8c, 0xaf, 0xca, 0xf0, 0xdc, 0xda,
-	0xeb, 0x5d, 0xbd, 0x72, 0x25, 0xc7, 0x5a, 0xc9, 0xf1, 0x1f, 0xbc, 0x35, 0xbf, 0x00, 0x38, 0xb0,
-	0x9c, 0x41, 0x28, 0xb3, 0xf0, 0x3f, 0x8e, 0x85, 0x13, 0xee, 0xb1, 0x66, 0xa9, 0x5f, 0xf7, 0xa7,
-	0xc7, 0x24, 0xd1, 0x82, 0xef, 0x27, 0x98, 0xb1, 0xf5, 0x34, 0x21, 0x51, 0x60, 0xe7, 0x89, 0xdd,
-	0x1a, 0xcc, 0x5f, 0x97, 0x4b, 0xd4, 0x9c, 0xeb, 0x66, 0xf5, 0x5f, 0x77, 0xd3, 0x9a, 0x39, 0x38,
-	0xd6, 0xc0, 0xe1, 0xb1, 0x06, 0x7e, 0x1e, 0x6b, 0x60, 0xe7, 0x44, 0xab, 0x1c, 0x9e, 0x68, 0x95,
-	0x6f, 0x27, 0x5a, 0xe5, 0xa9, 0xfc, 0x6c, 0x30, 0x7f, 0xd3, 0x20, 0xd4, 0xdc, 0x2e, 0xbe, 0x2a,
-	0xee, 0x20, 0xa7, 0xbd, 0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x76, 0xbd, 0x15, 0x80, 0x06,
-	0x00, 0x00,
+	// 815 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x4f, 0xe3, 0x46,
+	0x18, 0x8d, 0xf3, 0x83, 0xca, 0x13, 0xa0, 0xe0, 0xa6, 0xaa, 0x83, 0x2a, 0x27, 0x8a, 0x44, 0x1b,
+	0x90, 0x62, 0x13, 0x7a, 0xcb, 0x09, 0x0c, 0x82, 0x16, 0x81, 0x84, 0x4c, 0x4f, 0x95, 0xaa, 0x68,
+	0x12, 0x4f, 0xdc, 0x11, 0xb6, 0x27, 0xf2, 0x98, 0x1f, 0x39, 0xb5, 0xea, 0xa9, 0x6a, 0x0f, 0xe5,
+	0x58, 0xb5, 0x17, 0x8e, 0x55, 0x4f, 0x1c, 0xf8, 0x23, 0x50, 0x0f, 0x2b, 0x84, 0xb4, 0xd2, 0xee,
+	0x65, 0x59, 0xc1, 0x81, 0xf3, 0xfe, 0x07, 0x2b, 0xcf, 0x8c, 0x13, 0xf3, 0x4b, 0x4b, 0xa4, 0x25,
+	0x17, 0xf0, 0x7c, 0xfe, 0xde, 0xf7, 0xbd, 0xf7, 0xe6, 0x59, 0x00, 0xbe, 0x6a, 0x13, 0xea, 0x11,
+	0x6a, 0x74, 0x10, 0x72, 0x02, 0xe8, 0x87, 0xc6, 0x7e, 0xbd, 0x85, 0x42, 0x58, 0xef, 0x17, 0xf4,
+	0x6e, 0x40, 0x42, 0xa2, 0x7c, 0xc1, 0xfb, 0xf4, 0x7e, 0x59, 0xf4, 0xcd, 0x14, 0x1c, 0xe2, 0x10,
+	0xd6, 0x63, 0x44, 0x4f, 0xbc, 0x7d, 0xa6, 0xe8, 0x10, 0xe2, 0xb8, 0xc8, 0x60, 0xa7, 0xd6, 0x5e,
+	0xc7, 0x80, 0x7e, 0x2f, 0x7e, 0xc5, 0x27, 0x35, 0x39, 0x46, 0x8c, 0xe5, 0xaf, 0x34, 0x41, 0xa6,
+	0x05, 0x29, 0xea, 0x13, 0x69, 0x13, 0xec, 0x8b, 0xf7, 0xd3, 0xd0, 0xc3, 0x3e, 0x31, 0xd8, 0x4f,
+	0x51, 0x2a, 0xdd, 0x5d, 0x14, 0x62, 0x0f, 0xd1, 0x10, 0x7a, 0xdd, 0x78, 0xe6, 0xdd, 0x06, 0x7b,
+	0x2f, 0x80, 0x21, 0x26, 0x62, 0x66, 0xe5, 0x38, 0x0d, 0x26, 0x4d, 0x48, 0x71, 0x7b, 0xd9, 0x75,
+	0xc9, 0x01, 0xf4, 0xdb, 0x48, 0xf9, 0x55, 0x02, 0x79, 0xda, 0x45, 0xbe, 0xdd, 0x74, 0xb1, 0x87,
+	0x43, 0x55, 0x2a, 0x67, 0xaa, 0xf9, 0xc5, 0xa2, 0x2e, 0xb8, 0x46, 0xec, 0x62, 0xf9, 0xfa, 0x0a,
+	0xc1, 0xbe, 0xb9, 0x76, 0xf6, 0xa6, 0x94, 0xfa, 0xef, 0xb2, 0x54, 0x75, 0x70, 0xf8, 0xd3, 0x5e,
+	0x4b, 0x6f, 0x13, 0x4f, 0x08, 0x13, 0xbf, 0x6a, 0xd4, 0xde, 0x35, 0xc2, 0x5e, 0x17, 0x51, 0x06,
+	0xa0, 0x7f, 0xdf, 0x9c, 0xcc, 0x8f, 0xbb, 0xc8, 0x81, 0xed, 0x5e, 0x33, 0xd2, 0x47, 0xff, 0xbd,
+	0x39, 0x99, 0x97, 0x2c, 0xc0, 0xb6, 0x6e, 0x46, 0x4b, 0x95, 0x25, 0x00, 0xd0, 0x61, 0x17, 0x73,
+	0xae, 0x6a, 0xba, 0x2c, 0x55, 0xf3, 0x8b, 0x33, 0x3a, 0x17, 0xa3, 0xc7, 0x62, 0xf4, 0xef, 0x63,
+	0xb5, 0x66, 0xf6, 0xe8, 0xb2, 0x24, 0x59, 0x09, 0x4c, 0x63, 0xfd, 0xff, 0xd3, 0xda, 0xec, 0x23,
+	0xd7, 0xa6, 0xaf, 0x21, 0xd4, 0x17, 0xfc, 0xdd, 0xef, 0x37, 0x27, 0xf3, 0xc5, 0x04, 0xd3, 0xdb,
+	0x7e, 0x54, 0x5e, 0x67, 0xc1, 0xf4, 0x36, 0x0a, 0x30, 0xb1, 0x93, 0x2e, 0x7d, 0x0b, 0x72, 0xad,
+	0xa8, 0x4f, 0x95, 0x18, 0xb7, 0xaf, 0xf5, 0xc7, 0x56, 0xdd, 0x9e, 0x66, 0xca, 0x91, 0x59, 0x5c,
+	0x2f, 0x1f, 0xa0, 0x2c, 0x81, 0xb1, 0x2e, 0x1b, 0x2f, 0x64, 0x16, 0xef, 0xc9, 0x5c, 0x15, 0x77,
+	0x66, 0x4e, 0x44, 0xe0, 0xbf, 0x2e, 0x4b, 0x12, 0x1f, 0x20, 0x70, 0xca, 0x9f, 0x12, 0x50, 0xf8,
+	0x63, 0x33, 0x79, 0x71, 0x99, 0x51, 0x5d, 0xdc, 0x14, 0x5f, 0xbe, 0x33, 0xb8, 0xbe, 0x3f, 0x24,
+	0x20, 0x8a, 0xcd, 0x36, 0xf4, 0x39, 0x2b, 0x35, 0x3b, 0x2a, 0x3e, 0x93, 0x7c, 0xf5, 0x0a, 0xf4,
+	0x19, 0x25, 0x65, 0x13, 0x8c, 0x0b, 0x32, 0x01, 0xa2, 0x28, 0x54, 0x73, 0x1f, 0x8c, 0x13, 0x33,
+	0xfa, 0xa8, 0x6f, 0x74, 0x9e, 0xc3, 0xad, 0x08, 0xdd, 0xd8, 0x18, 0x2a, 0x58, 0x5f, 0x26, 0x98,
+	0xdf, 0x4b, 0x51, 0xe5, 0x65, 0x16, 0x14, 0x2c, 0x18, 0x22, 0xe6, 0x1a, 0xb2, 0x9f, 0x29, 0x5e,
+	0x07, 0xd8, 0xb7, 0xc9, 0xc1, 0xf0, 0xf1, 0xe2, 0x38, 0xe5, 0x17, 0x09, 0x80, 0x00, 0x86, 0x68,
+	0xd4, 0xb1, 0x92, 0x83, 0xd8, 0x19, 0xe5, 0x67, 0x20, 0xc3, 0x7d, 0x88, 0x5d, 0xd8, 0x72, 0xd1,
+	0xe8, 0x72, 0x34, 0xd8, 0xa9, 0x6c, 0x80, 0xbc, 0x0b, 0x69, 0xd8, 0x0c, 0x50, 0x07, 0xbb, 0xee,
+	0xf0, 0x09, 0x02, 0x11, 0xda, 0x62, 0xe0, 0x06, 0x7c, 0x72, 0x80, 0x2e, 0x4e, 0x6b, 0x53, 0x87,
+	0xfd, 0xbf, 0x45, 0xe5, 0xba, 0xbe, 0xa0, 0x2f, 0x44, 0xa1, 0x2a, 0x25, 0x64, 0x3c, 0x14, 0x9f,
+	0xca, 0x3b, 0x09, 0x7c, 0xc6, 0x4e, 0xc8, 0xde, 0xa2, 0xce, 0x20, 0x56, 0x3f, 0x02, 0x19, 0xc6,
+	0x07, 0x11, 0xad, 0xc2, 0x3d, 0x11, 0xcb, 0x7e, 0xcf, 0x9c, 0x7b, 0x32, 0x47, 0x6b, 0x30, 0x51,
+	0x99, 0x03, 0x53, 0x90, 0x6f, 0x6d, 0x7a, 0x88, 0x52, 0xe8, 0x20, 0xaa, 0xa6, 0xcb, 0x99, 0xaa,
+	0x6c, 0x7d, 0x2a, 0xea, 0x5b, 0xa2, 0xdc, 0xd8, 0xfe, 0xed, 0xb8, 0x94, 0x1a, 0xea, 0x4b, 0xd2,
+	0x12, 0xa2, 0x1f, 0xd0, 0x56, 0xf9, 0x27, 0x0d, 0x3e, 0x5f, 0x21, 0xfe, 0x3e, 0x0a, 0x42, 0x64,
+	0x27, 0xd1, 0xcf, 0xad, 0xba, 0x00, 0x72, 0x36, 0xf2, 0x89, 0xc7, 0x3e, 0x30, 0xd9, 0xe2, 0x07,
+	0x65, 0x16, 0x4c, 0xc6, 0x5e, 0xb0, 0x02, 0x65, 0x1f, 0x8e, 0x6c, 0x4d, 0x88, 0xea, 0x2a, 0x2b,
+	0x36, 0x3a, 0x43, 0xf9, 0xf0, 0x58, 0x20, 0xca, 0x09, 0x6f, 0x1e, 0xf4, 0xa0, 0xf2, 0x42, 0x02,
+	0xb9, 0xf5, 0x08, 0xa4, 0x2c, 0x82, 0x4f, 0x18, 0x1a, 0x05, 0xcc, 0x0b, 0xd9, 0x54, 0x2f, 0x4e,
+	0x6b, 0x05, 0xb1, 0x7e, 0xd9, 0xb6, 0x03, 0x44, 0xe9, 0x4e, 0x18, 0x60, 0xdf, 0xb1, 0xe2, 0xc6,
+	0x01, 0x06, 0xa9, 0xe9, 0xa7, 0x61, 0xee, 0xb8, 0x9e, 0xf9, 0xd8, 0xae, 0x9b, 0xf5, 0xb3, 0x2b,
+	0x4d, 0x3a, 0xbf, 0xd2, 0xa4, 0xb7, 0x57, 0x9a, 0x74, 0x74, 0xad, 0xa5, 0xce, 0xaf, 0xb5, 0xd4,
+	0xab, 0x6b, 0x2d, 0xf5, 0x83, 0xf8, 0x67, 0x8d, 0xda, 0xbb, 0x3a, 0x26, 0xc6, 0xc0, 0xae, 0xd6,
+	0x18, 0x5b, 0xfb, 0xcd, 0xfb, 0x01, 0x00, 0xe6, 0xdf, 0x54, 0xf4, 0xf6, 0x09, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -464,6 +609,83 @@ func (m *PeriodicAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RateLimitedAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitedAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitedAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastRefill, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastRefill):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintFeegrant(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Available) > 0 {
+		for iNdEx := len(m.Available) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Available[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RateLimit) > 0 {
+		for iNdEx := len(m.RateLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintFeegrant(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Basic.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeegrant(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *AllowedMsgAllowance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -508,6 +730,57 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConvertedFeeAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvertedFeeAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvertedFeeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -614,6 +887,33 @@ func (m *PeriodicAllowance) Size() (n int) {
 	return n
 }
 
+func (m *RateLimitedAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Basic.Size()
+	n += 1 + l + sovFeegrant(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovFeegrant(uint64(l))
+	if len(m.RateLimit) > 0 {
+		for _, e := range m.RateLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.Available) > 0 {
+		for _, e := range m.Available {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastRefill)
+	n += 1 + l + sovFeegrant(uint64(l))
+	return n
+}
+
 func (m *AllowedMsgAllowance) Size() (n int) {
 	if m == nil {
 		return 0
@@ -633,6 +933,29 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 	return n
 }
 
+func (m *ConvertedFeeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -997,7 +1320,7 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
+func (m *RateLimitedAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1020,15 +1343,15 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedMsgAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: RateLimitedAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedMsgAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RateLimitedAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1055,18 +1378,15 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Allowance == nil {
-				m.Allowance = &any.Any{}
-			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeegrant
@@ -1076,23 +1396,393 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFeegrant
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeegrant
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimit = append(m.RateLimit, types.Coin{})
+			if err := m.RateLimit[len(m.RateLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Available = append(m.Available, types.Coin{})
+			if err := m.Available[len(m.Available)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRefill", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastRefill, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedMsgAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedMsgAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvertedFeeAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvertedFeeAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvertedFeeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
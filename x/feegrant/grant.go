@@ -1,6 +1,8 @@
 package feegrant
 
 import (
+	"slices"
+
 	"github.com/cosmos/gogoproto/proto"
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
 
@@ -62,6 +64,41 @@ func (a Grant) GetGrant() (FeeAllowanceI, error) {
 	return allowance, nil
 }
 
+// AllowsMsgType returns true if the allowance of the grant can pay the fees of
+// the given message type, see AllowedMsgTypes.
+func (a Grant) AllowsMsgType(msgTypeURL string) (bool, error) {
+	allowance, err := a.GetGrant()
+	if err != nil {
+		return false, err
+	}
+
+	msgTypes, restricted, err := AllowedMsgTypes(allowance)
+	if err != nil {
+		return false, err
+	}
+
+	return !restricted || slices.Contains(msgTypes, msgTypeURL), nil
+}
+
+// AllowedMsgTypes returns the message types the given allowance can pay the
+// fees of, and whether the allowance is restricted to them. Only an
+// AllowedMsgAllowance, possibly wrapped in a ConvertedFeeAllowance, restricts
+// the message types, every other allowance can pay for any message.
+func AllowedMsgTypes(allowance FeeAllowanceI) (msgTypes []string, restricted bool, err error) {
+	if converted, ok := allowance.(*ConvertedFeeAllowance); ok {
+		if allowance, err = converted.GetAllowance(); err != nil {
+			return nil, false, err
+		}
+	}
+
+	filtered, ok := allowance.(*AllowedMsgAllowance)
+	if !ok {
+		return nil, false, nil
+	}
+
+	return filtered.AllowedMessages, true, nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (a Grant) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
 	var allowance FeeAllowanceI
@@ -116,3 +116,139 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// AllowancesByGranterAndMsgType queries all the allowances granted by the given granter
+// which can pay the fees of the given message type.
+func (q Keeper) AllowancesByGranterAndMsgType(c context.Context, req *feegrant.QueryAllowancesByGranterAndMsgTypeRequest) (*feegrant.QueryAllowancesByGranterAndMsgTypeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.MsgTypeUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty msg type url")
+	}
+
+	granterAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, pageRes, err := q.allowancesByGranterAndMsgType(c, granterAddr, req.MsgTypeUrl, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.QueryAllowancesByGranterAndMsgTypeResponse{Allowances: grants, Pagination: pageRes}, nil
+}
+
+// allowancesByGranterAndMsgType pages the FeeAllowanceByGranterMsgType index for
+// the allowances of granter which can pay for msgTypeURL: the allowances
+// restricted to msgTypeURL, followed by the allowances which can pay for any
+// message. The pagination key is the index key of the next allowance.
+func (q Keeper) allowancesByGranterAndMsgType(ctx context.Context, granter sdk.AccAddress, msgTypeURL string, pageReq *query.PageRequest) ([]*feegrant.Grant, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, nil, status.Error(codes.InvalidArgument, "paginate: invalid request, either offset or key is expected, got both")
+	}
+
+	// as in the collections pagination, the total is counted when no limit is supplied
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit, countTotal = query.DefaultLimit, true
+	}
+	countTotal = countTotal && len(pageReq.Key) == 0
+
+	msgTypes := []string{msgTypeURL, ""}
+	if pageReq.Reverse {
+		msgTypes = []string{"", msgTypeURL}
+	}
+
+	var start *collections.Triple[sdk.AccAddress, string, sdk.AccAddress]
+	if len(pageReq.Key) != 0 {
+		_, key, err := q.FeeAllowanceByGranterMsgType.KeyCodec().Decode(pageReq.Key)
+		if err != nil || !granter.Equals(key.K1()) || (key.K2() != msgTypeURL && key.K2() != "") {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+		start = &key
+	}
+
+	var (
+		grants  []*feegrant.Grant
+		nextKey []byte
+		count   uint64
+	)
+	for _, msgType := range msgTypes {
+		if start != nil && start.K2() != msgType {
+			// the range was paged before the start key
+			continue
+		}
+
+		rng := new(collections.Range[collections.Triple[sdk.AccAddress, string, sdk.AccAddress]]).
+			Prefix(collections.TripleSuperPrefix[sdk.AccAddress, string, sdk.AccAddress](granter, msgType))
+		if start != nil {
+			if pageReq.Reverse {
+				rng = rng.EndInclusive(*start)
+			} else {
+				rng = rng.StartInclusive(*start)
+			}
+			start = nil
+		}
+		if pageReq.Reverse {
+			rng = rng.Descending()
+		}
+
+		iter, err := q.FeeAllowanceByGranterMsgType.Iterate(ctx, rng)
+		if err != nil {
+			return nil, nil, status.Error(codes.Internal, err.Error())
+		}
+
+		for ; iter.Valid(); iter.Next() {
+			count++
+			if count <= pageReq.Offset {
+				continue
+			}
+
+			key, err := iter.Key()
+			if err != nil {
+				iter.Close()
+				return nil, nil, status.Error(codes.Internal, err.Error())
+			}
+
+			if uint64(len(grants)) == limit {
+				if nextKey == nil {
+					nextKey, err = collections.EncodeKeyWithPrefix(nil, q.FeeAllowanceByGranterMsgType.KeyCodec(), key)
+					if err != nil {
+						iter.Close()
+						return nil, nil, status.Error(codes.Internal, err.Error())
+					}
+				}
+				if !countTotal {
+					break
+				}
+				continue
+			}
+
+			grant, err := q.FeeAllowance.Get(ctx, collections.Join(key.K3(), granter))
+			if err != nil {
+				iter.Close()
+				return nil, nil, status.Error(codes.Internal, err.Error())
+			}
+			grants = append(grants, &grant)
+		}
+		iter.Close()
+
+		if nextKey != nil && !countTotal {
+			break
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+
+	return grants, pageRes, nil
+}
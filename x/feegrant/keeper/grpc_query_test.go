@@ -1,9 +1,14 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/feegrant"
+	"cosmossdk.io/x/feegrant/keeper"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 const (
@@ -233,6 +238,110 @@ func (suite *KeeperTestSuite) TestFeeAllowancesByGranter() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestFeeAllowancesByGranterAndMsgType() {
+	msgSend := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	testCases := []struct {
+		name      string
+		req       *feegrant.QueryAllowancesByGranterAndMsgTypeRequest
+		expectErr bool
+		preRun    func()
+		postRun   func(_ *feegrant.QueryAllowancesByGranterAndMsgTypeResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			func() {},
+			func(*feegrant.QueryAllowancesByGranterAndMsgTypeResponse) {},
+		},
+		{
+			"fail: invalid granter",
+			&feegrant.QueryAllowancesByGranterAndMsgTypeRequest{
+				Granter:    invalidGrantee,
+				MsgTypeUrl: msgSend,
+			},
+			true,
+			func() {},
+			func(*feegrant.QueryAllowancesByGranterAndMsgTypeResponse) {},
+		},
+		{
+			"fail: empty msg type url",
+			&feegrant.QueryAllowancesByGranterAndMsgTypeRequest{
+				Granter: suite.encodedAddrs[0],
+			},
+			true,
+			func() {},
+			func(*feegrant.QueryAllowancesByGranterAndMsgTypeResponse) {},
+		},
+		{
+			"no grants",
+			&feegrant.QueryAllowancesByGranterAndMsgTypeRequest{
+				Granter:    suite.encodedAddrs[0],
+				MsgTypeUrl: msgSend,
+			},
+			false,
+			func() {},
+			func(resp *feegrant.QueryAllowancesByGranterAndMsgTypeResponse) {
+				suite.Require().Equal(len(resp.Allowances), 0)
+			},
+		},
+		{
+			"valid query: filters by granter and msg type",
+			&feegrant.QueryAllowancesByGranterAndMsgTypeRequest{
+				Granter:    suite.encodedAddrs[0],
+				MsgTypeUrl: msgSend,
+			},
+			false,
+			func() {
+				// unrestricted allowance, pays for any message
+				suite.grantFeeAllowance(suite.addrs[0], suite.addrs[1])
+
+				// restricted to MsgSend
+				suite.grantAllowedMsgAllowance(suite.addrs[0], suite.addrs[2], msgSend)
+
+				// restricted to another message
+				suite.grantAllowedMsgAllowance(suite.addrs[0], suite.addrs[3], sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+
+				// other granter
+				suite.grantFeeAllowance(suite.addrs[1], suite.addrs[2])
+			},
+			func(resp *feegrant.QueryAllowancesByGranterAndMsgTypeResponse) {
+				suite.Require().Equal(len(resp.Allowances), 2)
+				for _, grant := range resp.Allowances {
+					suite.Require().Equal(grant.Granter, suite.encodedAddrs[0])
+					suite.Require().NotEqual(grant.Grantee, suite.encodedAddrs[3])
+				}
+				suite.Require().Equal(resp.Pagination.Total, uint64(2))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.preRun()
+			resp, err := suite.feegrantKeeper.AllowancesByGranterAndMsgType(suite.ctx, tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) grantAllowedMsgAllowance(granter, grantee sdk.AccAddress, msgTypeURLs ...string) {
+	exp := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+	allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 555)),
+		Expiration: &exp,
+	}, msgTypeURLs)
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, grantee, allowance)
+	suite.Require().NoError(err)
+}
+
 func (suite *KeeperTestSuite) grantFeeAllowance(granter, grantee sdk.AccAddress) {
 	exp := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
 	err := suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, grantee, &feegrant.BasicAllowance{
@@ -241,3 +350,90 @@ func (suite *KeeperTestSuite) grantFeeAllowance(granter, grantee sdk.AccAddress)
 	})
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestFeeAllowancesByGranterAndMsgTypePagination() {
+	msgSend := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	granter := suite.addrs[0]
+
+	// restricted allowances are paged first, followed by the unrestricted ones
+	suite.grantFeeAllowance(granter, suite.addrs[1])
+	suite.grantAllowedMsgAllowance(granter, suite.addrs[2], msgSend)
+	suite.grantFeeAllowance(granter, suite.addrs[3])
+	suite.grantAllowedMsgAllowance(granter, suite.addrs[4], msgSend, sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+	suite.grantAllowedMsgAllowance(granter, suite.addrs[5], sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+
+	expected := []string{suite.encodedAddrs[2], suite.encodedAddrs[4], suite.encodedAddrs[1], suite.encodedAddrs[3]}
+
+	page := func(pageReq *query.PageRequest) ([]string, *query.PageResponse) {
+		resp, err := suite.feegrantKeeper.AllowancesByGranterAndMsgType(suite.ctx, &feegrant.QueryAllowancesByGranterAndMsgTypeRequest{
+			Granter:    suite.encodedAddrs[0],
+			MsgTypeUrl: msgSend,
+			Pagination: pageReq,
+		})
+		suite.Require().NoError(err)
+
+		grantees := make([]string, len(resp.Allowances))
+		for i, grant := range resp.Allowances {
+			grantees[i] = grant.Grantee
+		}
+		return grantees, resp.Pagination
+	}
+
+	for _, reverse := range []bool{false, true} {
+		var (
+			grantees []string
+			key      []byte
+		)
+		for {
+			res, pageRes := page(&query.PageRequest{Key: key, Limit: 1, Reverse: reverse})
+			grantees = append(grantees, res...)
+			if pageRes.NextKey == nil {
+				break
+			}
+			key = pageRes.NextKey
+		}
+
+		if reverse {
+			suite.Require().Equal([]string{expected[3], expected[2], expected[1], expected[0]}, grantees)
+		} else {
+			suite.Require().Equal(expected, grantees)
+		}
+	}
+
+	grantees, pageRes := page(&query.PageRequest{Offset: 1, Limit: 2, CountTotal: true})
+	suite.Require().Equal(expected[1:3], grantees)
+	suite.Require().Equal(uint64(4), pageRes.Total)
+	suite.Require().NotNil(pageRes.NextKey)
+
+	// the index is updated when the allowances are revoked or expire
+	_, err := suite.msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{Granter: suite.encodedAddrs[0], Grantee: suite.encodedAddrs[2]})
+	suite.Require().NoError(err)
+	grantees, _ = page(nil)
+	suite.Require().Equal([]string{expected[1], expected[2], expected[3]}, grantees)
+
+	suite.ctx = suite.ctx.WithHeaderInfo(header.Info{Time: suite.ctx.HeaderInfo().Time.AddDate(2, 0, 0)})
+	suite.Require().NoError(suite.feegrantKeeper.RemoveExpiredAllowances(suite.ctx, 10))
+	grantees, _ = page(nil)
+	suite.Require().Empty(grantees)
+	has, err := suite.feegrantKeeper.FeeAllowanceByGranterMsgType.Has(suite.ctx, collections.Join3(granter, sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), suite.addrs[5]))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	msgSend := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	suite.grantFeeAllowance(suite.addrs[0], suite.addrs[1])
+	suite.grantAllowedMsgAllowance(suite.addrs[0], suite.addrs[2], msgSend)
+	suite.Require().NoError(suite.feegrantKeeper.FeeAllowanceByGranterMsgType.Clear(suite.ctx, nil))
+
+	suite.Require().NoError(keeper.NewMigrator(suite.feegrantKeeper).Migrate2to3(suite.ctx))
+
+	resp, err := suite.feegrantKeeper.AllowancesByGranterAndMsgType(suite.ctx, &feegrant.QueryAllowancesByGranterAndMsgTypeRequest{
+		Granter:    suite.encodedAddrs[0],
+		MsgTypeUrl: msgSend,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Allowances, 2)
+	suite.Require().Equal(suite.encodedAddrs[2], resp.Allowances[0].Grantee)
+	suite.Require().Equal(suite.encodedAddrs[1], resp.Allowances[1].Grantee)
+}
@@ -22,13 +22,17 @@ import (
 type Keeper struct {
 	appmodule.Environment
 
-	cdc        codec.BinaryCodec
-	authKeeper feegrant.AccountKeeper
-	Schema     collections.Schema
+	cdc         codec.BinaryCodec
+	authKeeper  feegrant.AccountKeeper
+	priceSource feegrant.PriceSource
+	Schema      collections.Schema
 	// FeeAllowance key: grantee+granter | value: Grant
 	FeeAllowance collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant]
 	// FeeAllowanceQueue key: expiration time+grantee+granter | value: bool
 	FeeAllowanceQueue collections.Map[collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], bool]
+	// FeeAllowanceByGranterMsgType key: granter+msg type url+grantee, the
+	// allowances which can pay for any message are indexed with an empty msg type url
+	FeeAllowanceByGranterMsgType collections.KeySet[collections.Triple[sdk.AccAddress, string, sdk.AccAddress]]
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
@@ -55,7 +59,24 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak feegrant.Acc
 			collections.TripleKeyCodec(sdk.TimeKey, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			collections.BoolValue,
 		),
+		FeeAllowanceByGranterMsgType: collections.NewKeySet(
+			sb,
+			feegrant.FeeAllowanceByGranterMsgTypeKeyPrefix,
+			"allowances_by_granter_msg_type",
+			collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, sdk.AccAddressKey),
+		),
+	}
+}
+
+// SetPriceSource sets the price source used to convert the fees paid with a
+// ConvertedFeeAllowance. It must be called before the keeper is passed to other
+// modules.
+func (k *Keeper) SetPriceSource(ps feegrant.PriceSource) {
+	if k.priceSource != nil {
+		panic("cannot set price source twice")
 	}
+
+	k.priceSource = ps
 }
 
 // GrantAllowance creates a new grant
@@ -112,6 +133,10 @@ func (k Keeper) GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddr
 		return err
 	}
 
+	if err := k.indexAllowance(ctx, granter, grantee, feeAllowance); err != nil {
+		return err
+	}
+
 	return k.EventService.EventManager(ctx).EmitKV(
 		feegrant.EventTypeSetFeeGrant,
 		event.NewAttribute(feegrant.AttributeKeyGranter, grant.Granter),
@@ -121,7 +146,7 @@ func (k Keeper) GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddr
 
 // UpdateAllowance updates the existing grant.
 func (k Keeper) UpdateAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
-	_, err := k.GetAllowance(ctx, granter, grantee)
+	oldAllowance, err := k.GetAllowance(ctx, granter, grantee)
 	if err != nil {
 		return err
 	}
@@ -144,6 +169,13 @@ func (k Keeper) UpdateAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 		return err
 	}
 
+	if err := k.unindexAllowance(ctx, granter, grantee, oldAllowance); err != nil {
+		return err
+	}
+	if err := k.indexAllowance(ctx, granter, grantee, feeAllowance); err != nil {
+		return err
+	}
+
 	return k.EventService.EventManager(ctx).EmitKV(
 		feegrant.EventTypeUpdateFeeGrant,
 		event.NewAttribute(feegrant.AttributeKeyGranter, grant.Granter),
@@ -162,6 +194,10 @@ func (k Keeper) revokeAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 		return err
 	}
 
+	if err := k.unindexAllowance(ctx, granter, grantee, grant); err != nil {
+		return err
+	}
+
 	exp, err := grant.ExpiresAt()
 	if err != nil {
 		return err
@@ -189,6 +225,53 @@ func (k Keeper) revokeAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 	)
 }
 
+// allowanceMsgTypes returns the msg type urls an allowance is indexed with in
+// FeeAllowanceByGranterMsgType.
+func allowanceMsgTypes(allowance feegrant.FeeAllowanceI) ([]string, error) {
+	msgTypes, restricted, err := feegrant.AllowedMsgTypes(allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	if !restricted {
+		return []string{""}, nil
+	}
+
+	return msgTypes, nil
+}
+
+// indexAllowance adds the allowance to the FeeAllowanceByGranterMsgType index.
+func (k Keeper) indexAllowance(ctx context.Context, granter, grantee sdk.AccAddress, allowance feegrant.FeeAllowanceI) error {
+	msgTypes, err := allowanceMsgTypes(allowance)
+	if err != nil {
+		return err
+	}
+
+	for _, msgType := range msgTypes {
+		if err := k.FeeAllowanceByGranterMsgType.Set(ctx, collections.Join3(granter, msgType, grantee)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unindexAllowance removes the allowance from the FeeAllowanceByGranterMsgType index.
+func (k Keeper) unindexAllowance(ctx context.Context, granter, grantee sdk.AccAddress, allowance feegrant.FeeAllowanceI) error {
+	msgTypes, err := allowanceMsgTypes(allowance)
+	if err != nil {
+		return err
+	}
+
+	for _, msgType := range msgTypes {
+		if err := k.FeeAllowanceByGranterMsgType.Remove(ctx, collections.Join3(granter, msgType, grantee)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // GetAllowance returns the allowance between the granter and grantee.
 // If there is none, it returns nil, nil.
 // Returns an error on parsing issues
@@ -226,17 +309,22 @@ func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddr
 		return err
 	}
 
-	remove, err := grant.Accept(context.WithValue(ctx, corecontext.EnvironmentContextKey, k.Environment), fee, msgs)
+	acceptCtx := context.WithValue(ctx, corecontext.EnvironmentContextKey, k.Environment)
+	if k.priceSource != nil {
+		acceptCtx = context.WithValue(acceptCtx, feegrant.PriceSourceContextKey, k.priceSource)
+	}
+
+	remove, err := grant.Accept(acceptCtx, fee, msgs)
 	if remove && err == nil {
 		// Ignoring the `revokeFeeAllowance` error, because the user has enough grants to perform this transaction.
 		_ = k.revokeAllowance(ctx, granter, grantee)
 
-		return k.emitUseGrantEvent(ctx, granterStr, granteeStr)
+		return k.emitUseGrantEvent(ctx, granterStr, granteeStr, fee, grant, true)
 	}
 	if err != nil {
 		return err
 	}
-	if err := k.emitUseGrantEvent(ctx, granterStr, granteeStr); err != nil {
+	if err := k.emitUseGrantEvent(ctx, granterStr, granteeStr, fee, grant, false); err != nil {
 		return err
 	}
 
@@ -244,12 +332,72 @@ func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddr
 	return k.UpdateAllowance(ctx, granter, grantee, grant)
 }
 
-func (k *Keeper) emitUseGrantEvent(ctx context.Context, granter, grantee string) error {
-	return k.EventService.EventManager(ctx).EmitKV(
-		feegrant.EventTypeUseFeeGrant,
+// emitUseGrantEvent emits the use_feegrant event, including the fee paid and
+// what is left of the allowance after paying it. A revoked allowance has
+// nothing left.
+func (k *Keeper) emitUseGrantEvent(ctx context.Context, granter, grantee string, fee sdk.Coins, grant feegrant.FeeAllowanceI, revoked bool) error {
+	attrs := []event.Attribute{
 		event.NewAttribute(feegrant.AttributeKeyGranter, granter),
 		event.NewAttribute(feegrant.AttributeKeyGrantee, grantee),
-	)
+		event.NewAttribute(feegrant.AttributeKeyFee, fee.String()),
+	}
+
+	if revoked {
+		attrs = append(attrs, event.NewAttribute(feegrant.AttributeKeyRemainingSpendLimit, "0"))
+		return k.EventService.EventManager(ctx).EmitKV(feegrant.EventTypeUseFeeGrant, attrs...)
+	}
+
+	spendLimit, periodAllowance, hasPeriod, err := remainingAllowance(grant)
+	if err != nil {
+		return err
+	}
+
+	attrs = append(attrs, event.NewAttribute(feegrant.AttributeKeyRemainingSpendLimit, formatRemaining(spendLimit)))
+	if hasPeriod {
+		attrs = append(attrs, event.NewAttribute(feegrant.AttributeKeyRemainingPeriodAllowance, formatRemaining(periodAllowance)))
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(feegrant.EventTypeUseFeeGrant, attrs...)
+}
+
+// remainingAllowance returns the remaining total spend limit of an allowance and,
+// for allowances limited per period, the amount left in the current period.
+// A nil spend limit means the allowance has no total limit.
+func remainingAllowance(allowance feegrant.FeeAllowanceI) (spendLimit, periodAllowance sdk.Coins, hasPeriod bool, err error) {
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		return a.SpendLimit, nil, false, nil
+	case *feegrant.PeriodicAllowance:
+		return a.Basic.SpendLimit, a.PeriodCanSpend, true, nil
+	case *feegrant.RateLimitedAllowance:
+		return a.Basic.SpendLimit, a.Available, true, nil
+	case *feegrant.AllowedMsgAllowance:
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return nil, nil, false, err
+		}
+		return remainingAllowance(inner)
+	case *feegrant.ConvertedFeeAllowance:
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return nil, nil, false, err
+		}
+		return remainingAllowance(inner)
+	default:
+		return nil, nil, false, nil
+	}
+}
+
+// formatRemaining formats a remaining amount for an event attribute.
+func formatRemaining(coins sdk.Coins) string {
+	if coins == nil {
+		return feegrant.AttributeValueUnlimited
+	}
+	if coins.IsZero() {
+		return "0"
+	}
+
+	return coins.String()
 }
 
 // InitGenesis will initialize the keeper from a *previously validated* GenesisState
@@ -301,10 +449,19 @@ func (k Keeper) RemoveExpiredAllowances(ctx context.Context, limit int) error {
 	err := k.FeeAllowanceQueue.Walk(ctx, rng, func(key collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], value bool) (stop bool, err error) {
 		grantee, granter := key.K2(), key.K3()
 
+		grant, err := k.GetAllowance(ctx, granter, grantee)
+		if err != nil {
+			return true, err
+		}
+
 		if err := k.FeeAllowance.Remove(ctx, collections.Join(grantee, granter)); err != nil {
 			return true, err
 		}
 
+		if err := k.unindexAllowance(ctx, granter, grantee, grant); err != nil {
+			return true, err
+		}
+
 		keysToRemove = append(keysToRemove, key)
 
 		// limit the amount of iterations to avoid taking too much time
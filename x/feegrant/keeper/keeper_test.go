@@ -1,16 +1,20 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	authtypes "cosmossdk.io/x/auth/types"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/feegrant"
 	"cosmossdk.io/x/feegrant/keeper"
 	"cosmossdk.io/x/feegrant/module"
@@ -305,6 +309,133 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 	suite.Require().NoError(err)
 }
 
+func (suite *KeeperTestSuite) TestUseGrantedFeeEvents() {
+	blockTime := suite.ctx.HeaderInfo().Time
+	oneYear := blockTime.AddDate(1, 0, 0)
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+
+	periodic := &feegrant.PeriodicAllowance{
+		Basic:            feegrant.BasicAllowance{Expiration: &oneYear},
+		Period:           time.Hour,
+		PeriodSpendLimit: suite.atom,
+		PeriodCanSpend:   suite.atom,
+		PeriodReset:      blockTime.Add(time.Hour),
+	}
+	allowed, err := feegrant.NewAllowedMsgAllowance(periodic, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	suite.Require().NoError(err)
+	converted, err := feegrant.NewConvertedFeeAllowance(&feegrant.BasicAllowance{SpendLimit: suite.atom}, "atom", nil)
+	suite.Require().NoError(err)
+
+	cases := map[string]struct {
+		allowance feegrant.FeeAllowanceI
+		fee       sdk.Coins
+		expAttrs  map[string]string
+	}{
+		"basic allowance": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &oneYear},
+			fee:       smallAtom,
+			expAttrs: map[string]string{
+				feegrant.AttributeKeyFee:                 smallAtom.String(),
+				feegrant.AttributeKeyRemainingSpendLimit: "554atom",
+			},
+		},
+		"basic allowance used up": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &oneYear},
+			fee:       suite.atom,
+			expAttrs: map[string]string{
+				feegrant.AttributeKeyFee:                 suite.atom.String(),
+				feegrant.AttributeKeyRemainingSpendLimit: "0",
+			},
+		},
+		"filtered periodic allowance": {
+			allowance: allowed,
+			fee:       smallAtom,
+			expAttrs: map[string]string{
+				feegrant.AttributeKeyFee:                      smallAtom.String(),
+				feegrant.AttributeKeyRemainingSpendLimit:      feegrant.AttributeValueUnlimited,
+				feegrant.AttributeKeyRemainingPeriodAllowance: "554atom",
+			},
+		},
+		"converted allowance": {
+			allowance: converted,
+			fee:       smallAtom,
+			expAttrs: map[string]string{
+				feegrant.AttributeKeyFee:                 smallAtom.String(),
+				feegrant.AttributeKeyRemainingSpendLimit: "554atom",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+			err := suite.feegrantKeeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[1], tc.allowance)
+			suite.Require().NoError(err)
+
+			err = suite.feegrantKeeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[1], tc.fee, []sdk.Msg{&banktypes.MsgSend{}})
+			suite.Require().NoError(err)
+
+			var found bool
+			for _, e := range ctx.EventManager().Events() {
+				if e.Type != feegrant.EventTypeUseFeeGrant {
+					continue
+				}
+				found = true
+				attrs := make(map[string]string, len(e.Attributes))
+				for _, attr := range e.Attributes {
+					attrs[attr.Key] = attr.Value
+				}
+				suite.Require().Equal(suite.encodedAddrs[0], attrs[feegrant.AttributeKeyGranter])
+				suite.Require().Equal(suite.encodedAddrs[1], attrs[feegrant.AttributeKeyGrantee])
+				for k, v := range tc.expAttrs {
+					suite.Require().Equal(v, attrs[k], k)
+				}
+				_, hasPeriod := tc.expAttrs[feegrant.AttributeKeyRemainingPeriodAllowance]
+				_, ok := attrs[feegrant.AttributeKeyRemainingPeriodAllowance]
+				suite.Require().Equal(hasPeriod, ok)
+			}
+			suite.Require().True(found)
+
+			_ = suite.feegrantKeeper.FeeAllowance.Remove(ctx, collections.Join(suite.addrs[1], suite.addrs[0]))
+		})
+	}
+}
+
+type fixedPriceSource struct {
+	rate int64
+}
+
+func (ps fixedPriceSource) ConvertFee(_ context.Context, coin sdk.Coin, denom string) (sdk.Coin, error) {
+	return sdk.NewCoin(denom, coin.Amount.MulRaw(ps.rate)), nil
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFeeConverted() {
+	allowance, err := feegrant.NewConvertedFeeAllowance(&feegrant.BasicAllowance{SpendLimit: suite.atom}, "atom", []string{"eth"})
+	suite.Require().NoError(err)
+	ethFee := sdk.NewCoins(sdk.NewInt64Coin("eth", 5))
+
+	// without a price source the fee can't be converted
+	suite.Require().NoError(suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], allowance))
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], ethFee, []sdk.Msg{})
+	suite.Require().ErrorContains(err, "price source not set")
+
+	k := suite.feegrantKeeper
+	k.SetPriceSource(fixedPriceSource{rate: 10})
+	suite.Require().Panics(func() { k.SetPriceSource(fixedPriceSource{rate: 1}) })
+
+	suite.Require().NoError(k.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], ethFee, []sdk.Msg{}))
+
+	grant, err := k.GetAllowance(suite.ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+	inner, err := grant.(*feegrant.ConvertedFeeAllowance).GetAllowance()
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 505)), inner.(*feegrant.BasicAllowance).SpendLimit)
+
+	// the fee can't be paid with a denom which is not allowed
+	err = k.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin("osmo", 5)), []sdk.Msg{})
+	suite.Require().ErrorIs(err, feegrant.ErrDenomNotAllowed)
+}
+
 func (suite *KeeperTestSuite) TestIterateGrants() {
 	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
 	exp := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
@@ -3,7 +3,11 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/feegrant"
 	v2 "cosmossdk.io/x/feegrant/migrations/v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -20,3 +24,16 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx context.Context) error {
 	return v2.MigrateStore(ctx, m.keeper.Environment, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from version 2 to 3, indexing the existing allowances
+// by granter and msg type.
+func (m Migrator) Migrate2to3(ctx context.Context) error {
+	return m.keeper.FeeAllowance.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], grant feegrant.Grant) (stop bool, err error) {
+		allowance, err := grant.GetGrant()
+		if err != nil {
+			return true, err
+		}
+
+		return false, m.keeper.indexAllowance(ctx, key.K2(), key.K1(), allowance)
+	})
+}
@@ -20,4 +20,9 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = collections.NewPrefix(1)
+
+	// FeeAllowanceByGranterMsgTypeKeyPrefix is the set of the kvstore indexing
+	// the fee allowances by granter and allowed message type
+	// - 0x02<granter><msg_type_url><grantee>: <empty value>
+	FeeAllowanceByGranterMsgTypeKeyPrefix = collections.NewPrefix(2)
 )
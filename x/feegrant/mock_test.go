@@ -2,6 +2,7 @@ package feegrant_test
 
 import (
 	"context"
+	"fmt"
 
 	coregas "cosmossdk.io/core/gas"
 	coreheader "cosmossdk.io/core/header"
@@ -30,3 +31,16 @@ type mockGasMeter struct {
 func (m mockGasMeter) Consume(amount coregas.Gas, descriptor string) error {
 	return nil
 }
+
+// mockPriceSource converts coins to another denom at fixed rates, expressed as
+// the amount of the target denom one unit of a coin is worth.
+type mockPriceSource map[string]int64
+
+func (m mockPriceSource) ConvertFee(_ context.Context, coin sdk.Coin, denom string) (sdk.Coin, error) {
+	rate, ok := m[coin.Denom]
+	if !ok {
+		return sdk.Coin{}, fmt.Errorf("no price for %s", coin.Denom)
+	}
+
+	return sdk.NewCoin(denom, coin.Amount.MulRaw(rate)), nil
+}
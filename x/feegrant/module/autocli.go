@@ -44,6 +44,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "granter"},
 					},
 				},
+				{
+					RpcMethod: "AllowancesByGranterAndMsgType",
+					Use:       "grants-by-granter-and-msg-type [granter] [msg-type-url]",
+					Short:     "Query all grants by a granter which can pay the fees of a message type",
+					Example:   fmt.Sprintf(`$ %s query feegrant grants-by-granter-and-msg-type [granter] /cosmos.bank.v1beta1.MsgSend`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "msg_type_url"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
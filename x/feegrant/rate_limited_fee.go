@@ -0,0 +1,125 @@
+package feegrant
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/core/appmodule"
+	corecontext "cosmossdk.io/core/context"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ FeeAllowanceI = (*RateLimitedAllowance)(nil)
+
+// Accept can use fee payment requested as well as timestamp of the current block
+// to determine whether or not to process this. This is checked in
+// Keeper.UseGrantedFees and the return values should match how it is handled there.
+//
+// If it returns an error, the fee payment is rejected, otherwise it is accepted.
+// The FeeAllowance implementation is expected to update it's internal state
+// and will be saved again after an acceptance.
+//
+// If remove is true (regardless of the error), the FeeAllowance will be deleted from storage
+// (eg. when it is used up). (See call to RevokeAllowance in Keeper.UseGrantedFees)
+func (a *RateLimitedAllowance) Accept(ctx context.Context, fee sdk.Coins, _ []sdk.Msg) (bool, error) {
+	environment, ok := ctx.Value(corecontext.EnvironmentContextKey).(appmodule.Environment)
+	if !ok {
+		return true, errorsmod.Wrap(ErrFeeLimitExpired, "environment not set")
+	}
+	blockTime := environment.HeaderService.HeaderInfo(ctx).Time
+	if a.Basic.Expiration != nil && blockTime.After(*a.Basic.Expiration) {
+		return true, errorsmod.Wrap(ErrFeeLimitExpired, "absolute limit")
+	}
+
+	a.refill(blockTime)
+
+	// deduct from both the available amount and the max amount
+	var isNeg bool
+	a.Available, isNeg = a.Available.SafeSub(fee...)
+	if isNeg {
+		return false, errorsmod.Wrap(ErrFeeLimitExceeded, "rate limit")
+	}
+
+	if a.Basic.SpendLimit != nil {
+		a.Basic.SpendLimit, isNeg = a.Basic.SpendLimit.SafeSub(fee...)
+		if isNeg {
+			return false, errorsmod.Wrap(ErrFeeLimitExceeded, "absolute limit")
+		}
+
+		return a.Basic.SpendLimit.IsZero(), nil
+	}
+
+	return false, nil
+}
+
+// refill tops up the Available amount proportionally to the time elapsed since
+// LastRefill, at a rate of RateLimit per Window, and caps it to RateLimit.
+// If a full Window or more has elapsed, Available is set to RateLimit.
+// It is a no-op if blockTime is not after LastRefill.
+func (a *RateLimitedAllowance) refill(blockTime time.Time) {
+	if !blockTime.After(a.LastRefill) {
+		return
+	}
+
+	elapsed := blockTime.Sub(a.LastRefill)
+	if elapsed >= a.Window {
+		a.Available = a.RateLimit
+	} else {
+		var refill sdk.Coins
+		for _, coin := range a.RateLimit {
+			amount := coin.Amount.Mul(sdkmath.NewInt(int64(elapsed))).Quo(sdkmath.NewInt(int64(a.Window)))
+			refill = refill.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+		a.Available = a.Available.Add(refill...).Min(a.RateLimit)
+	}
+
+	a.LastRefill = blockTime
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a RateLimitedAllowance) ValidateBasic() error {
+	if err := a.Basic.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !a.RateLimit.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "rate limit is invalid: %s", a.RateLimit)
+	}
+	if !a.RateLimit.IsAllPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "rate limit must be positive")
+	}
+	if !a.Available.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "available amount is invalid: %s", a.Available)
+	}
+	// We allow 0 for `Available`
+	if a.Available.IsAnyNegative() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "available amount must not be negative")
+	}
+
+	// ensure RateLimit can be subtracted from total (same coin types)
+	if a.Basic.SpendLimit != nil && !a.RateLimit.DenomsSubsetOf(a.Basic.SpendLimit) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "rate limit has different currency than basic spend limit")
+	}
+
+	if a.Window <= 0 {
+		return errorsmod.Wrap(ErrInvalidDuration, "window must be positive")
+	}
+
+	return nil
+}
+
+// ExpiresAt returns the expiry time of the RateLimitedAllowance.
+func (a RateLimitedAllowance) ExpiresAt() (*time.Time, error) {
+	return a.Basic.ExpiresAt()
+}
+
+// UpdatePeriodReset starts refilling the available coins of the RateLimitedAllowance
+// from validTime.
+func (a *RateLimitedAllowance) UpdatePeriodReset(validTime time.Time) error {
+	a.LastRefill = validTime
+	return nil
+}
@@ -0,0 +1,216 @@
+package feegrant_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRateLimitedFeeValidAllow(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	hundredAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	fiftyAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 50))
+	fortyAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 40))
+	tenAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 1))
+	emptyCoins := sdk.Coins{}
+
+	now := ctx.HeaderInfo().Time
+	oneHour := now.Add(1 * time.Hour)
+	twoHours := now.Add(2 * time.Hour)
+	day := 24 * time.Hour
+
+	cases := map[string]struct {
+		allow          feegrant.RateLimitedAllowance
+		fee            sdk.Coins
+		blockTime      time.Time
+		valid          bool // all other checks are ignored if valid=false
+		accept         bool
+		remove         bool
+		remains        sdk.Coins
+		remainsAvail   sdk.Coins
+		lastRefill     time.Time
+		updateRefillAt bool
+	}{
+		"empty": {
+			allow: feegrant.RateLimitedAllowance{},
+			valid: false,
+		},
+		"no window": {
+			allow: feegrant.RateLimitedAllowance{
+				RateLimit: tenAtom,
+			},
+			valid: false,
+		},
+		"mismatched currencies": {
+			allow: feegrant.RateLimitedAllowance{
+				Basic: feegrant.BasicAllowance{
+					SpendLimit: atom,
+				},
+				Window:    day,
+				RateLimit: eth,
+			},
+			valid: false,
+		},
+		"within available": {
+			allow: feegrant.RateLimitedAllowance{
+				Basic: feegrant.BasicAllowance{
+					SpendLimit: atom,
+					Expiration: &twoHours,
+				},
+				Window:     day,
+				RateLimit:  hundredAtom,
+				Available:  hundredAtom,
+				LastRefill: now,
+			},
+			valid:        true,
+			fee:          tenAtom,
+			blockTime:    now,
+			accept:       true,
+			remainsAvail: hundredAtom.Sub(tenAtom...),
+			remains:      atom.Sub(tenAtom...),
+			lastRefill:   now,
+		},
+		"over rate limit": {
+			allow: feegrant.RateLimitedAllowance{
+				Window:     day,
+				RateLimit:  hundredAtom,
+				Available:  tenAtom,
+				LastRefill: now,
+			},
+			valid:     true,
+			fee:       fiftyAtom,
+			blockTime: now,
+			accept:    false,
+		},
+		"partial refill": {
+			allow: feegrant.RateLimitedAllowance{
+				Window:     10 * time.Hour,
+				RateLimit:  hundredAtom,
+				Available:  emptyCoins,
+				LastRefill: now,
+			},
+			valid:        true,
+			fee:          fortyAtom,
+			blockTime:    now.Add(5 * time.Hour),
+			accept:       true,
+			remainsAvail: tenAtom,
+			lastRefill:   now.Add(5 * time.Hour),
+		},
+		"refill capped to rate limit": {
+			allow: feegrant.RateLimitedAllowance{
+				Window:     10 * time.Hour,
+				RateLimit:  hundredAtom,
+				Available:  fiftyAtom.Add(fortyAtom...),
+				LastRefill: now,
+			},
+			valid:        true,
+			fee:          tenAtom,
+			blockTime:    now.Add(5 * time.Hour),
+			accept:       true,
+			remainsAvail: fiftyAtom.Add(fortyAtom...),
+			lastRefill:   now.Add(5 * time.Hour),
+		},
+		"full refill after window": {
+			allow: feegrant.RateLimitedAllowance{
+				Basic: feegrant.BasicAllowance{
+					SpendLimit: hundredAtom,
+				},
+				Window:     time.Hour,
+				RateLimit:  hundredAtom,
+				Available:  emptyCoins,
+				LastRefill: now,
+			},
+			valid:      true,
+			fee:        hundredAtom,
+			blockTime:  twoHours,
+			accept:     true,
+			remove:     true,
+			lastRefill: twoHours,
+		},
+		"expired": {
+			allow: feegrant.RateLimitedAllowance{
+				Basic: feegrant.BasicAllowance{
+					Expiration: &now,
+				},
+				Window:     day,
+				RateLimit:  hundredAtom,
+				Available:  hundredAtom,
+				LastRefill: now,
+			},
+			valid:     true,
+			fee:       tenAtom,
+			blockTime: oneHour,
+			accept:    false,
+			remove:    true,
+		},
+		"refill starts at grant time": {
+			allow: feegrant.RateLimitedAllowance{
+				Window:     10 * time.Hour,
+				RateLimit:  hundredAtom,
+				Available:  emptyCoins,
+				LastRefill: now.Add(-10 * time.Hour),
+			},
+			valid:          true,
+			fee:            emptyCoins,
+			blockTime:      now,
+			accept:         true,
+			remainsAvail:   emptyCoins,
+			lastRefill:     now,
+			updateRefillAt: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.allow.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.updateRefillAt {
+				err = tc.allow.UpdatePeriodReset(tc.blockTime)
+				require.NoError(t, err)
+			}
+
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: tc.blockTime})
+			// now try to deduct
+			// Set environment to ctx
+			remove, err := tc.allow.Accept(context.WithValue(ctx, corecontext.EnvironmentContextKey, appmodule.Environment{
+				HeaderService: mockHeaderService{},
+				GasService:    mockGasService{},
+			}), tc.fee, []sdk.Msg{})
+			require.Equal(t, tc.remove, remove)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if !remove {
+				assert.Equal(t, tc.remains, tc.allow.Basic.SpendLimit)
+				assert.Equal(t, tc.remainsAvail, tc.allow.Available)
+				assert.Equal(t, tc.lastRefill.String(), tc.allow.LastRefill.String())
+			}
+		})
+	}
+}
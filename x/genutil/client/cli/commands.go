@@ -28,6 +28,18 @@ func Commands(genutilModule genutil.AppModule, genMM genesisMM, appExport server
 // CommandsWithCustomMigrationMap adds core sdk's sub-commands into genesis command with custom migration map.
 // This custom migration map can be used by the application to add its own migration map.
 func CommandsWithCustomMigrationMap(genutilModule genutil.AppModule, genMM genesisMM, appExport servertypes.AppExporter, migrationMap genutiltypes.MigrationMap) *cobra.Command {
+	return CommandsWithGenesisMigrations(genutilModule, genMM, appExport, migrationMap, nil)
+}
+
+// CommandsWithGenesisMigrations adds core sdk's sub-commands into genesis command with custom migration map
+// and a genesis migration registry, used to migrate the genesis between versions with the module genesis migrations.
+func CommandsWithGenesisMigrations(
+	genutilModule genutil.AppModule,
+	genMM genesisMM,
+	appExport servertypes.AppExporter,
+	migrationMap genutiltypes.MigrationMap,
+	migrationRegistry *genutiltypes.GenesisMigrationRegistry,
+) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "genesis",
 		Short:                      "Application's genesis-related subcommands",
@@ -37,7 +49,7 @@ func CommandsWithCustomMigrationMap(genutilModule genutil.AppModule, genMM genes
 	}
 	cmd.AddCommand(
 		GenTxCmd(genMM, banktypes.GenesisBalancesIterator{}),
-		MigrateGenesisCmd(migrationMap),
+		MigrateGenesisCmdWithRegistry(migrationMap, migrationRegistry),
 		CollectGenTxsCmd(genutilModule.GenTxValidator()),
 		ValidateGenesisCmd(genMM),
 		AddGenesisAccountCmd(),
@@ -76,10 +76,16 @@ $ %s gentx my-key-name 1000000stake --home=/path/to/home/dir --keyring-backend=o
 			}
 
 			// read --pubkey, if empty take it from priv_validator.json
+			var pubKeyProofOfPossession []byte
 			if pkStr, _ := cmd.Flags().GetString(cli.FlagPubKey); pkStr != "" {
 				if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(pkStr), &valPubKey); err != nil {
 					return errors.Wrap(err, "failed to unmarshal validator public key")
 				}
+			} else {
+				pubKeyProofOfPossession, err = genutil.NodeValidatorProofOfPossession(config)
+				if err != nil {
+					return errors.Wrap(err, "failed to generate validator public key proof of possession")
+				}
 			}
 
 			appGenesis, err := types.AppGenesisFromFile(config.GenesisFile())
@@ -152,6 +158,7 @@ $ %s gentx my-key-name 1000000stake --home=/path/to/home/dir --keyring-backend=o
 			// config file instead of so many flags.
 			// ref: https://github.com/cosmos/cosmos-sdk/issues/8177
 			createValCfg.Amount = amount
+			createValCfg.PubKeyProofOfPossession = pubKeyProofOfPossession
 
 			// create a 'create-validator' message
 			txBldr, msg, err := cli.BuildCreateValidatorMsg(clientCtx, createValCfg, txFactory, true)
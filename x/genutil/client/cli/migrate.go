@@ -2,6 +2,7 @@ package cli
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
@@ -25,13 +26,28 @@ var MigrationMap = types.MigrationMap{}
 // Applications should pass their own migration map to this function.
 // When the application migration includes a SDK migration, the Cosmos SDK migration function should as well be called.
 func MigrateGenesisCmd(migrations types.MigrationMap) *cobra.Command {
+	return MigrateGenesisCmdWithRegistry(migrations, nil)
+}
+
+// MigrateGenesisCmdWithRegistry returns a command to execute genesis state migration.
+// Besides the migration map, the genesis can be migrated between two versions of the registry,
+// applying the genesis migrations registered by the modules.
+func MigrateGenesisCmdWithRegistry(migrations types.MigrationMap, registry *types.GenesisMigrationRegistry) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "migrate [target-version] [genesis-file]",
-		Short:   "Migrate genesis to a specified target version",
-		Long:    "Migrate the source genesis into the target version and print to STDOUT",
-		Example: fmt.Sprintf("%s migrate v0.47 /path/to/genesis.json --chain-id=cosmoshub-3 --genesis-time=2019-04-22T17:00:00Z", version.AppName),
-		Args:    cobra.ExactArgs(2),
+		Use:   "migrate [target-version] [genesis-file] | [source-version] [target-version] [genesis-file]",
+		Short: "Migrate genesis to a specified target version",
+		Long: `Migrate the source genesis into the target version and print to STDOUT.
+
+When a source version is given, the genesis is migrated version after version up to the target version,
+using the module genesis migrations registered by the application.`,
+		Example: fmt.Sprintf(`%[1]s migrate v0.47 /path/to/genesis.json --chain-id=cosmoshub-3 --genesis-time=2019-04-22T17:00:00Z
+%[1]s migrate v0.50 v0.52 /path/to/genesis.json`, version.AppName),
+		Args: cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 3 {
+				return MigrateRegistryHandler(cmd, args, registry)
+			}
+
 			return MigrateHandler(cmd, args, migrations)
 		},
 	}
@@ -56,7 +72,27 @@ func MigrateHandler(cmd *cobra.Command, args []string, migrations types.Migratio
 		return fmt.Errorf("unknown migration function for version: %s (supported versions %s)", target, strings.Join(versions, ", "))
 	}
 
-	importGenesis := args[1]
+	return migrateGenesisFile(cmd, clientCtx, args[1], migrationFunc)
+}
+
+// MigrateRegistryHandler handles the migration command between a source and
+// a target version of the registry, returning an error upon failure.
+func MigrateRegistryHandler(cmd *cobra.Command, args []string, registry *types.GenesisMigrationRegistry) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+
+	if registry == nil {
+		return errors.New("no genesis migration registry configured by the application")
+	}
+
+	source, target := args[0], args[1]
+	return migrateGenesisFile(cmd, clientCtx, args[2], func(appState types.AppMap, clientCtx client.Context) (types.AppMap, error) {
+		return registry.Migrate(appState, clientCtx, source, target)
+	})
+}
+
+// migrateGenesisFile migrates the app state of the genesis file and outputs
+// the migrated genesis.
+func migrateGenesisFile(cmd *cobra.Command, clientCtx client.Context, importGenesis string, migrationFunc types.MigrationCallback) error {
 	appGenesis, err := types.AppGenesisFromFile(importGenesis)
 	if err != nil {
 		return err
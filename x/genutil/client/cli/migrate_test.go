@@ -1,6 +1,7 @@
 package cli_test
 
 import (
+	"encoding/json"
 	"os"
 	"testing"
 
@@ -12,6 +13,7 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
 func TestMigrateGenesis(t *testing.T) {
@@ -68,3 +70,44 @@ func TestMigrateGenesis(t *testing.T) {
 		})
 	}
 }
+
+func TestMigrateGenesisWithRegistry(t *testing.T) {
+	registry := types.NewGenesisMigrationRegistry("v0.50", "v0.52")
+	require.NoError(t, registry.RegisterMigration("crisis", "v0.50", func(json.RawMessage, client.Context) (json.RawMessage, error) {
+		return json.RawMessage(`{"migrated":true}`), nil
+	}))
+
+	bz, err := os.ReadFile("../../types/testdata/app_genesis.json")
+	require.NoError(t, err)
+	genesisFile := testutil.WriteToNewTempFile(t, string(bz))
+
+	testCases := []struct {
+		name      string
+		registry  *types.GenesisMigrationRegistry
+		args      []string
+		expErrMsg string
+	}{
+		{"no registry", nil, []string{"v0.50", "v0.52", genesisFile.Name()}, "no genesis migration registry configured"},
+		{"unknown version", registry, []string{"v0.47", "v0.52", genesisFile.Name()}, "unknown source version v0.47"},
+		{"valid migration", registry, []string{"v0.50", "v0.52", genesisFile.Name()}, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := clitestutil.ExecTestCLICmd(client.Context{}, cli.MigrateGenesisCmdWithRegistry(cli.MigrationMap, tc.registry), tc.args)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			var appGenesis types.AppGenesis
+			require.NoError(t, json.Unmarshal(out.Bytes(), &appGenesis))
+
+			var appState types.AppMap
+			require.NoError(t, json.Unmarshal(appGenesis.AppState, &appState))
+			require.JSONEq(t, `{"migrated":true}`, string(appState["crisis"]))
+			require.JSONEq(t, `{"evidence":[]}`, string(appState["evidence"]))
+		})
+	}
+}
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/spf13/cobra"
 
@@ -10,15 +12,28 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
-const chainUpgradeGuide = "https://github.com/cosmos/cosmos-sdk/blob/main/UPGRADING.md"
+const (
+	chainUpgradeGuide = "https://github.com/cosmos/cosmos-sdk/blob/main/UPGRADING.md"
+
+	flagStreaming = "streaming"
+)
+
+// moduleGenesisValidator validates the genesis of a single module.
+type moduleGenesisValidator interface {
+	ValidateModuleGenesis(moduleName string, genesisData json.RawMessage) error
+}
 
 // ValidateGenesisCmd takes a genesis file, and makes sure that it is valid.
 func ValidateGenesisCmd(genMM genesisMM) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:     "validate [file]",
 		Aliases: []string{"validate-genesis"},
 		Args:    cobra.RangeArgs(0, 1),
 		Short:   "Validates the genesis file at the default location or at the location passed as an arg",
+		Long: `Validates the genesis file at the default location or at the location passed as an arg.
+
+With --streaming, the app state is read and validated one module at a time instead of being loaded
+in memory at once, which allows validating very large genesis files. All module errors are reported.`,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			cfg := client.GetConfigFromCmd(cmd)
 
@@ -30,6 +45,11 @@ func ValidateGenesisCmd(genMM genesisMM) *cobra.Command {
 				genesis = args[0]
 			}
 
+			streaming, _ := cmd.Flags().GetBool(flagStreaming)
+			if streaming {
+				return validateGenesisStreaming(cmd, genMM, genesis)
+			}
+
 			appGenesis, err := types.AppGenesisFromFile(genesis)
 			if err != nil {
 				return err
@@ -54,4 +74,68 @@ func ValidateGenesisCmd(genMM genesisMM) *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().Bool(flagStreaming, false, "Stream the genesis file and validate it module by module")
+
+	return cmd
+}
+
+// validateGenesisStreaming validates the genesis file without loading its app
+// state in memory. Each module genesis is validated as soon as it is read and
+// all module errors are reported.
+func validateGenesisStreaming(cmd *cobra.Command, genMM genesisMM, genesis string) error {
+	var validator moduleGenesisValidator
+	if genMM != nil {
+		var ok bool
+		if validator, ok = genMM.(moduleGenesisValidator); !ok {
+			return errors.New("streaming validation is not supported by the module manager")
+		}
+	}
+
+	var errs []error
+	seen := make(map[string]struct{})
+	validate := func(moduleName string, moduleGenesis json.RawMessage) {
+		if err := validator.ValidateModuleGenesis(moduleName, moduleGenesis); err != nil {
+			errs = append(errs, fmt.Errorf("module %s: %w", moduleName, err))
+			fmt.Fprintf(cmd.ErrOrStderr(), "module %s: invalid genesis: %v\n", moduleName, err)
+		}
+	}
+
+	appGenesis, err := types.StreamAppGenesisFromFile(genesis, func(moduleName string, moduleGenesis json.RawMessage) error {
+		seen[moduleName] = struct{}{}
+		if validator != nil {
+			validate(moduleName, moduleGenesis)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := appGenesis.ValidateAndComplete(); err != nil {
+		return fmt.Errorf("make sure that you have correctly migrated all CometBFT consensus params. Refer the UPGRADING.md (%s): %w", chainUpgradeGuide, err)
+	}
+
+	if validator != nil {
+		// modules absent from the app state are validated with an empty genesis,
+		// as done by the non-streaming validation
+		var missing []string
+		for moduleName := range genMM.DefaultGenesis() {
+			if _, ok := seen[moduleName]; !ok {
+				missing = append(missing, moduleName)
+			}
+		}
+		sort.Strings(missing)
+
+		for _, moduleName := range missing {
+			validate(moduleName, nil)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("error validating genesis file %s: %w", genesis, errors.Join(errs...))
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "File at %s is a valid genesis file\n", genesis)
+	return nil
 }
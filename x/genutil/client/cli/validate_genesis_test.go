@@ -1,7 +1,10 @@
 package cli_test
 
 import (
+	"encoding/json"
+	"errors"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -68,3 +71,87 @@ func TestValidateGenesis(t *testing.T) {
 		})
 	}
 }
+
+// mockGenesisMM fails the validation of the modules with an error.
+type mockGenesisMM struct {
+	modules map[string]error
+}
+
+func (m mockGenesisMM) DefaultGenesis() map[string]json.RawMessage {
+	genesis := make(map[string]json.RawMessage)
+	for name := range m.modules {
+		genesis[name] = json.RawMessage("{}")
+	}
+	return genesis
+}
+
+func (m mockGenesisMM) ValidateGenesis(genesisData map[string]json.RawMessage) error {
+	for name := range m.modules {
+		if err := m.ValidateModuleGenesis(name, genesisData[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m mockGenesisMM) ValidateModuleGenesis(moduleName string, _ json.RawMessage) error {
+	return m.modules[moduleName]
+}
+
+func TestValidateGenesisStreaming(t *testing.T) {
+	testCases := []struct {
+		name    string
+		genesis string
+		genMM   mockGenesisMM
+		expErrs []string
+	}{
+		{
+			"exported 0.37 genesis file",
+			v037Exported,
+			mockGenesisMM{},
+			[]string{"make sure that you have correctly migrated all CometBFT consensus params"},
+		},
+		{
+			"valid genesis file",
+			"../../types/testdata/app_genesis.json",
+			mockGenesisMM{modules: map[string]error{"bank": nil, "staking": nil}},
+			nil,
+		},
+		{
+			"invalid module genesis",
+			"../../types/testdata/app_genesis.json",
+			mockGenesisMM{modules: map[string]error{
+				"bank":    errors.New("invalid balances"),
+				"staking": errors.New("invalid validators"),
+				"missing": nil,
+			}},
+			[]string{"module bank: invalid balances", "module staking: invalid validators"},
+		},
+		{
+			"module missing from genesis",
+			"../../types/testdata/app_genesis.json",
+			mockGenesisMM{modules: map[string]error{"missing": errors.New("empty genesis")}},
+			[]string{"module missing: empty genesis"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genesisFile := tc.genesis
+			if !strings.HasSuffix(genesisFile, ".json") {
+				genesisFile = testutil.WriteToNewTempFile(t, tc.genesis).Name()
+			}
+
+			_, err := clitestutil.ExecTestCLICmd(client.Context{}, cli.ValidateGenesisCmd(tc.genMM), []string{genesisFile, "--streaming"})
+			if len(tc.expErrs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			for _, expErr := range tc.expErrs {
+				require.Contains(t, err.Error(), expErr)
+			}
+		})
+	}
+}
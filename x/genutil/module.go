@@ -18,8 +18,9 @@ import (
 var (
 	_ module.HasABCIGenesis = AppModule{}
 
-	_ appmodule.AppModule        = AppModule{}
-	_ appmodulev2.GenesisDecoder = AppModule{}
+	_ appmodule.AppModule              = AppModule{}
+	_ appmodule.HasOrderingConstraints = AppModule{}
+	_ appmodulev2.GenesisDecoder       = AppModule{}
 )
 
 // AppModule implements an application module for the genutil module.
@@ -92,6 +93,15 @@ func (am AppModule) GenTxValidator() types.MessageValidator {
 	return am.genTxValidator
 }
 
+// OrderingConstraints implements appmodule.HasOrderingConstraints.
+// Genesis transactions are delivered once the accounts, balances and staking
+// pools are initialized.
+func (AppModule) OrderingConstraints() []appmodule.OrderingConstraint {
+	return []appmodule.OrderingConstraint{
+		{Hook: appmodule.HookInitGenesis, After: []string{"auth", "bank", "staking"}},
+	}
+}
+
 // ConsensusVersion implements HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// ModuleGenesisMigration migrates the genesis of a module from a SDK version
+// to the next one.
+type ModuleGenesisMigration func(json.RawMessage, client.Context) (json.RawMessage, error)
+
+// HasGenesisMigrations is implemented by modules registering migrations of
+// their genesis between SDK versions.
+type HasGenesisMigrations interface {
+	RegisterGenesisMigrations(*GenesisMigrationRegistry) error
+}
+
+// GenesisMigrationRegistry holds the genesis migrations of modules between
+// consecutive SDK versions. Genesis files are migrated by applying, version
+// after version, the migrations registered by each module.
+type GenesisMigrationRegistry struct {
+	versions []string
+	// migrations maps a version to the module migrations from that version
+	// to the next one.
+	migrations map[string]map[string]ModuleGenesisMigration
+}
+
+// NewGenesisMigrationRegistry returns a registry for the given SDK versions,
+// ordered from the oldest to the newest.
+func NewGenesisMigrationRegistry(versions ...string) *GenesisMigrationRegistry {
+	return &GenesisMigrationRegistry{
+		versions:   versions,
+		migrations: make(map[string]map[string]ModuleGenesisMigration),
+	}
+}
+
+// Versions returns the SDK versions known by the registry.
+func (r *GenesisMigrationRegistry) Versions() []string {
+	return slices.Clone(r.versions)
+}
+
+// RegisterMigration registers the migration of the genesis of a module from
+// fromVersion to the next version.
+func (r *GenesisMigrationRegistry) RegisterMigration(moduleName, fromVersion string, migration ModuleGenesisMigration) error {
+	idx := slices.Index(r.versions, fromVersion)
+	if idx < 0 {
+		return fmt.Errorf("unknown genesis migration version %s", fromVersion)
+	}
+	if idx == len(r.versions)-1 {
+		return fmt.Errorf("cannot register genesis migration from the latest version %s", fromVersion)
+	}
+
+	if r.migrations[fromVersion] == nil {
+		r.migrations[fromVersion] = make(map[string]ModuleGenesisMigration)
+	}
+
+	if r.migrations[fromVersion][moduleName] != nil {
+		return fmt.Errorf("another genesis migration for module %s and version %s already exists", moduleName, fromVersion)
+	}
+
+	r.migrations[fromVersion][moduleName] = migration
+
+	return nil
+}
+
+// RegisterModules registers the genesis migrations of the modules
+// implementing HasGenesisMigrations.
+func (r *GenesisMigrationRegistry) RegisterModules(modules map[string]appmodule.AppModule) error {
+	names := make([]string, 0, len(modules))
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if mod, ok := modules[name].(HasGenesisMigrations); ok {
+			if err := mod.RegisterGenesisMigrations(r); err != nil {
+				return fmt.Errorf("failed to register genesis migrations of module %s: %w", name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Migrate migrates the app state from the source to the target version.
+// Module migrations of a version are applied in alphabetical order of module
+// names, and modules absent from the app state are skipped.
+func (r *GenesisMigrationRegistry) Migrate(appState AppMap, clientCtx client.Context, fromVersion, toVersion string) (AppMap, error) {
+	from, to := slices.Index(r.versions, fromVersion), slices.Index(r.versions, toVersion)
+	if from < 0 {
+		return nil, fmt.Errorf("unknown source version %s (supported versions %v)", fromVersion, r.versions)
+	}
+	if to < 0 {
+		return nil, fmt.Errorf("unknown target version %s (supported versions %v)", toVersion, r.versions)
+	}
+	if from > to {
+		return nil, fmt.Errorf("cannot migrate genesis from %s to older version %s", fromVersion, toVersion)
+	}
+
+	migrated := make(AppMap, len(appState))
+	for name, moduleGenesis := range appState {
+		migrated[name] = moduleGenesis
+	}
+
+	for _, version := range r.versions[from:to] {
+		moduleMigrations := r.migrations[version]
+		names := make([]string, 0, len(moduleMigrations))
+		for name := range moduleMigrations {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			moduleGenesis, ok := migrated[name]
+			if !ok {
+				continue
+			}
+
+			moduleGenesis, err := moduleMigrations[name](moduleGenesis, clientCtx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to migrate genesis of module %s from version %s: %w", name, version, err)
+			}
+			migrated[name] = moduleGenesis
+		}
+	}
+
+	return migrated, nil
+}
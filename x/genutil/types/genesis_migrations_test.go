@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// appendMigration appends the version to the module genesis, a JSON array of versions.
+func appendMigration(version string) types.ModuleGenesisMigration {
+	return func(bz json.RawMessage, _ client.Context) (json.RawMessage, error) {
+		var versions []string
+		if err := json.Unmarshal(bz, &versions); err != nil {
+			return nil, err
+		}
+		return json.Marshal(append(versions, version))
+	}
+}
+
+type migratingModule struct {
+	appmodule.AppModule
+	name string
+}
+
+func (m migratingModule) RegisterGenesisMigrations(registry *types.GenesisMigrationRegistry) error {
+	return registry.RegisterMigration(m.name, "v0.50", appendMigration("v0.50"))
+}
+
+func TestGenesisMigrationRegistry_RegisterMigration(t *testing.T) {
+	registry := types.NewGenesisMigrationRegistry("v0.47", "v0.50", "v0.52")
+
+	require.NoError(t, registry.RegisterMigration("bank", "v0.47", appendMigration("v0.47")))
+	require.ErrorContains(t, registry.RegisterMigration("bank", "v0.47", appendMigration("v0.47")), "another genesis migration for module bank and version v0.47 already exists")
+	require.ErrorContains(t, registry.RegisterMigration("bank", "v0.46", appendMigration("v0.46")), "unknown genesis migration version v0.46")
+	require.ErrorContains(t, registry.RegisterMigration("bank", "v0.52", appendMigration("v0.52")), "cannot register genesis migration from the latest version v0.52")
+
+	require.NoError(t, registry.RegisterModules(map[string]appmodule.AppModule{
+		"gov":     migratingModule{name: "gov"},
+		"staking": nil,
+	}))
+	require.Equal(t, []string{"v0.47", "v0.50", "v0.52"}, registry.Versions())
+}
+
+func TestGenesisMigrationRegistry_Migrate(t *testing.T) {
+	registry := types.NewGenesisMigrationRegistry("v0.47", "v0.50", "v0.52")
+	require.NoError(t, registry.RegisterMigration("bank", "v0.47", appendMigration("v0.47")))
+	require.NoError(t, registry.RegisterMigration("bank", "v0.50", appendMigration("v0.50")))
+	require.NoError(t, registry.RegisterMigration("gov", "v0.50", appendMigration("v0.50")))
+	require.NoError(t, registry.RegisterMigration("absent", "v0.50", appendMigration("v0.50")))
+
+	appState := types.AppMap{
+		"bank": json.RawMessage(`[]`),
+		"gov":  json.RawMessage(`[]`),
+		"auth": json.RawMessage(`{}`),
+	}
+
+	migrated, err := registry.Migrate(appState, client.Context{}, "v0.47", "v0.52")
+	require.NoError(t, err)
+	require.Equal(t, types.AppMap{
+		"bank": json.RawMessage(`["v0.47","v0.50"]`),
+		"gov":  json.RawMessage(`["v0.50"]`),
+		"auth": json.RawMessage(`{}`),
+	}, migrated)
+	// the input app state is left untouched
+	require.Equal(t, json.RawMessage(`[]`), appState["bank"])
+
+	migrated, err = registry.Migrate(appState, client.Context{}, "v0.47", "v0.50")
+	require.NoError(t, err)
+	require.Equal(t, json.RawMessage(`["v0.47"]`), migrated["bank"])
+	require.Equal(t, json.RawMessage(`[]`), migrated["gov"])
+
+	_, err = registry.Migrate(appState, client.Context{}, "v0.52", "v0.47")
+	require.ErrorContains(t, err, "cannot migrate genesis from v0.52 to older version v0.47")
+	_, err = registry.Migrate(appState, client.Context{}, "v0.46", "v0.52")
+	require.ErrorContains(t, err, "unknown source version v0.46")
+	_, err = registry.Migrate(appState, client.Context{}, "v0.47", "v0.53")
+	require.ErrorContains(t, err, "unknown target version v0.53")
+
+	require.NoError(t, registry.RegisterMigration("auth", "v0.47", func(json.RawMessage, client.Context) (json.RawMessage, error) {
+		return nil, errors.New("boom")
+	}))
+	_, err = registry.Migrate(appState, client.Context{}, "v0.47", "v0.52")
+	require.ErrorContains(t, err, "failed to migrate genesis of module auth from version v0.47: boom")
+}
@@ -0,0 +1,147 @@
+package types
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// appStateKey is the JSON key of the app state in a genesis file.
+const appStateKey = "app_state"
+
+// ModuleGenesisHandler is called with the genesis of a module while streaming
+// the app state of a genesis file.
+type ModuleGenesisHandler func(moduleName string, moduleGenesis json.RawMessage) error
+
+// StreamAppGenesisFromReader reads the AppGenesis from the reader without
+// loading its app state in memory. The genesis of each module is decoded and
+// passed to handler one at a time, in the order it appears in the app state.
+// The returned AppGenesis has no AppState.
+func StreamAppGenesisFromReader(reader io.Reader, handler ModuleGenesisHandler) (*AppGenesis, error) {
+	dec := json.NewDecoder(reader)
+	if err := expectDelim(dec, '{'); err != nil {
+		return nil, err
+	}
+
+	// all fields but the app state are small, so they are kept in memory and
+	// decoded at once, falling back to CometBFT genesis if needed.
+	fields := make(map[string]json.RawMessage)
+	for dec.More() {
+		key, err := readKey(dec)
+		if err != nil {
+			return nil, err
+		}
+
+		if key == appStateKey {
+			if err := streamAppState(dec, handler); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, fmt.Errorf("error decoding genesis field %s: %w", key, err)
+		}
+		fields[key] = value
+	}
+
+	if err := expectDelim(dec, '}'); err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return AppGenesisFromReader(bytes.NewReader(bz))
+}
+
+// StreamAppGenesisFromFile reads the AppGenesis from the provided file without
+// loading its app state in memory. See StreamAppGenesisFromReader.
+func StreamAppGenesisFromFile(genFile string, handler ModuleGenesisHandler) (*AppGenesis, error) {
+	file, err := os.Open(filepath.Clean(genFile))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	appGenesis, err := StreamAppGenesisFromReader(bufio.NewReader(file), handler)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read genesis from file %s: %w", genFile, err)
+	}
+
+	return appGenesis, nil
+}
+
+// streamAppState decodes the app state object module by module.
+func streamAppState(dec *json.Decoder, handler ModuleGenesisHandler) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("error decoding %s: %w", appStateKey, err)
+	}
+	if tok == nil {
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("expected %s to be an object, got %v", appStateKey, tok)
+	}
+
+	seen := make(map[string]struct{})
+	for dec.More() {
+		moduleName, err := readKey(dec)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := seen[moduleName]; ok {
+			return fmt.Errorf("duplicate genesis for module %s", moduleName)
+		}
+		seen[moduleName] = struct{}{}
+
+		var moduleGenesis json.RawMessage
+		if err := dec.Decode(&moduleGenesis); err != nil {
+			return fmt.Errorf("error decoding genesis of module %s: %w", moduleName, err)
+		}
+
+		if err := handler(moduleName, moduleGenesis); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(dec, '}')
+}
+
+// readKey reads the next object key from the decoder.
+func readKey(dec *json.Decoder) (string, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return "", err
+	}
+
+	key, ok := tok.(string)
+	if !ok {
+		return "", fmt.Errorf("expected object key, got %v", tok)
+	}
+
+	return key, nil
+}
+
+// expectDelim reads the next token and checks it is the expected delimiter.
+func expectDelim(dec *json.Decoder, expected json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != expected {
+		return fmt.Errorf("expected %s, got %v", expected, tok)
+	}
+
+	return nil
+}
@@ -2,7 +2,9 @@ package types_test
 
 import (
 	"encoding/json"
+	"errors"
 	"os"
+	"strings"
 	"testing"
 
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -67,3 +69,61 @@ func TestAppGenesis_ValidGenesis(t *testing.T) {
 	assert.NilError(t, err)
 	golden.Assert(t, string(rawAppGenesis), "app_genesis.json")
 }
+
+func TestStreamAppGenesisFromFile(t *testing.T) {
+	var modules []string
+	genesis, err := types.StreamAppGenesisFromFile("testdata/app_genesis.json", func(moduleName string, moduleGenesis json.RawMessage) error {
+		modules = append(modules, moduleName)
+		assert.Assert(t, json.Valid(moduleGenesis))
+		return nil
+	})
+	assert.NilError(t, err)
+
+	expected, err := types.AppGenesisFromFile("testdata/app_genesis.json")
+	assert.NilError(t, err)
+
+	var appState map[string]json.RawMessage
+	assert.NilError(t, json.Unmarshal(expected.AppState, &appState))
+	assert.Equal(t, len(modules), len(appState))
+	assert.Equal(t, modules[0], "auth")
+
+	// the streamed genesis matches the loaded one, but without the app state
+	assert.Assert(t, genesis.AppState == nil)
+	expected.AppState = nil
+	assert.DeepEqual(t, genesis, expected)
+
+	// cometbft genesis files are supported
+	genesis, err = types.StreamAppGenesisFromFile("testdata/cmt_genesis.json", func(string, json.RawMessage) error { return nil })
+	assert.NilError(t, err)
+	assert.DeepEqual(t, genesis.Consensus.Validators[0].Name, "test")
+}
+
+func TestStreamAppGenesisFromReader(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		expErr string
+	}{
+		{"duplicate module", `{"chain_id":"test","app_state":{"bank":{},"bank":{}}}`, "duplicate genesis for module bank"},
+		{"app state not an object", `{"chain_id":"test","app_state":[]}`, "expected app_state to be an object"},
+		{"truncated app state", `{"chain_id":"test","app_state":{"bank":{}`, "unexpected end of JSON input"},
+		{"null app state", `{"chain_id":"test","app_state":null}`, ""},
+		{"handler error", `{"chain_id":"test","app_state":{"fail":{}}}`, "handler failed"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := types.StreamAppGenesisFromReader(strings.NewReader(tc.input), func(moduleName string, _ json.RawMessage) error {
+				if moduleName == "fail" {
+					return errors.New("handler failed")
+				}
+				return nil
+			})
+			if tc.expErr != "" {
+				assert.ErrorContains(t, err, tc.expErr)
+			} else {
+				assert.NilError(t, err)
+			}
+		})
+	}
+}
@@ -12,12 +12,14 @@ import (
 	cmtcrypto "github.com/cometbft/cometbft/crypto"
 	cmtbls12381 "github.com/cometbft/cometbft/crypto/bls12381"
 	tmed25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	tmsecp256k1 "github.com/cometbft/cometbft/crypto/secp256k1"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/privval"
 	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/go-bip39"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
@@ -89,6 +91,8 @@ func InitializeNodeValidatorFilesFromMnemonic(config *cfg.Config, mnemonic, keyT
 		switch keyType {
 		case "ed25519":
 			filePV = loadOrGenFilePV(tmed25519.GenPrivKey(), pvKeyFile, pvStateFile)
+		case "secp256k1":
+			filePV = loadOrGenFilePV(tmsecp256k1.GenPrivKey(), pvKeyFile, pvStateFile)
 		case "bls12_381":
 			privKey, err = cmtbls12381.GenPrivKey()
 			if err != nil {
@@ -102,6 +106,8 @@ func InitializeNodeValidatorFilesFromMnemonic(config *cfg.Config, mnemonic, keyT
 		switch keyType {
 		case "ed25519":
 			privKey = tmed25519.GenPrivKeyFromSecret([]byte(mnemonic))
+		case "secp256k1":
+			privKey = tmsecp256k1.GenPrivKeySecp256k1([]byte(mnemonic))
 		case "bls12_381":
 			// TODO: need to add support for getting from mnemonic in Comet.
 			return "", nil, errors.New("BLS key type does not support mnemonic")
@@ -125,6 +131,23 @@ func InitializeNodeValidatorFilesFromMnemonic(config *cfg.Config, mnemonic, keyT
 	return nodeID, valPubKey, nil
 }
 
+// NodeValidatorProofOfPossession returns the proof of possession of
+// the consensus key of the node if it is a bls12_381 key, or nil otherwise. The
+// validator files must have been initialized, see InitializeNodeValidatorFiles.
+func NodeValidatorProofOfPossession(config *cfg.Config) ([]byte, error) {
+	filePV := privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	if filePV.Key.PrivKey.Type() != cmtbls12381.KeyType {
+		return nil, nil
+	}
+
+	privKey, err := bls12_381.NewPrivateKeyFromBytes(filePV.Key.PrivKey.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return bls12_381.GenerateProofOfPossession(privKey)
+}
+
 // loadOrGenFilePV loads a FilePV from the given filePaths
 // or else generates a new one and saves it to the filePaths.
 func loadOrGenFilePV(privKey cmtcrypto.PrivKey, keyFilePath, stateFilePath string) *privval.FilePV {
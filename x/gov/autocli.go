@@ -89,6 +89,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "VoteBreakdown",
+					Use:       "vote-breakdown [proposal-id]",
+					Short:     "Query the voting power of each validator inherited from and overridden by its delegators on a proposal",
+					Example:   fmt.Sprintf("%s query gov vote-breakdown 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
 				{
 					RpcMethod: "Constitution",
 					Use:       "constitution",
@@ -154,6 +163,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "CommitVote",
+					Skip:      true, // skipped because the commitment is computed by the custom commit-vote command
+				},
+				{
+					RpcMethod: "RevealVote",
+					Skip:      true, // skipped in favor of the custom reveal-vote command
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
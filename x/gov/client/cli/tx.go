@@ -70,6 +70,8 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 
 	govTxCmd.AddCommand(
 		NewCmdWeightedVote(),
+		NewCmdCommitVote(),
+		NewCmdRevealVote(),
 		NewCmdSubmitProposal(),
 		NewCmdDraftProposal(),
 
@@ -112,6 +114,8 @@ Where proposal.json contains:
   "title": "My proposal",
   "summary": "A short summary of my proposal",
   "proposal_type": "standard",
+  // optional, votes are committed with commit-vote and revealed with reveal-vote
+  "commit_reveal": false,
 }
 
 metadata example: 
@@ -147,6 +151,7 @@ metadata example:
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.CommitReveal = proposal.CommitReveal
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -290,3 +295,95 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no-with-veto=0.05 --from
 
 	return cmd
 }
+
+// NewCmdCommitVote implements committing a vote on a commit-reveal proposal command.
+func NewCmdCommitVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-vote [proposal-id] [weighted-options] [salt]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Commit a vote for a commit-reveal proposal in its commit period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Commit a vote for a commit-reveal proposal in its commit period.
+Only the hash of the vote and of the hex encoded salt is submitted. The same vote
+and salt must be revealed with reveal-vote during the reveal period of the proposal,
+otherwise the vote is not counted. Keep the salt secret until then.
+
+Example:
+$ %s tx gov commit-vote 1 yes=0.6,no=0.4 5f1c3a9e2b7d --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			proposalID, options, salt, err := parseCommitRevealVoteArgs(args)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgCommitVote(from, proposalID, v1.VoteCommitmentHash(proposalID, from, options, salt))
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdRevealVote implements revealing a vote committed on a commit-reveal proposal command.
+func NewCmdRevealVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-vote [proposal-id] [weighted-options] [salt]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Reveal a vote committed for a commit-reveal proposal in its reveal period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reveal a vote committed for a commit-reveal proposal in its reveal period.
+The vote and the hex encoded salt must be the ones given to commit-vote.
+
+Example:
+$ %s tx gov reveal-vote 1 yes=0.6,no=0.4 5f1c3a9e2b7d --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			proposalID, options, salt, err := parseCommitRevealVoteArgs(args)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgRevealVote(from, proposalID, options, metadata, salt)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Specify metadata of the vote")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,10 +1,12 @@
 package cli
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -95,6 +97,7 @@ type proposal struct {
 	Title           string            `json:"title"`
 	Summary         string            `json:"summary"`
 	ProposalTypeStr string            `json:"proposal_type,omitempty"`
+	CommitReveal    bool              `json:"commit_reveal,omitempty"`
 
 	proposalType govv1.ProposalType
 }
@@ -138,6 +141,30 @@ func parseSubmitProposal(cdc codec.Codec, path string) (proposal, []sdk.Msg, sdk
 	return proposal, msgs, deposit, nil
 }
 
+// parseCommitRevealVoteArgs parses the proposal id, weighted vote options and hex encoded salt
+// arguments of the commit-vote and reveal-vote commands.
+func parseCommitRevealVoteArgs(args []string) (uint64, govv1.WeightedVoteOptions, []byte, error) {
+	proposalID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+	}
+
+	options, err := govv1.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	salt, err := hex.DecodeString(args[2])
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("salt %s is not hex encoded: %w", args[2], err)
+	}
+	if len(salt) == 0 {
+		return 0, nil, nil, errors.New("salt cannot be empty")
+	}
+
+	return proposalID, options, salt, nil
+}
+
 // AddGovPropFlagsToCmd adds flags for defining MsgSubmitProposal fields.
 //
 // See also ReadGovPropFlags.
@@ -55,6 +55,17 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 		}
 	}
 
+	for _, voteCommitment := range data.VoteCommitments {
+		addr, err := ak.AddressCodec().StringToBytes(voteCommitment.Voter)
+		if err != nil {
+			return err
+		}
+		err = k.VoteCommitments.Set(ctx, collections.Join(voteCommitment.ProposalId, sdk.AccAddress(addr)), *voteCommitment)
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case v1.StatusDepositPeriod:
@@ -131,6 +142,16 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		return nil, err
 	}
 
+	// export vote commitments of commit-reveal proposals
+	var voteCommitments []*v1.VoteCommitment
+	err = k.VoteCommitments.Walk(ctx, nil, func(_ collections.Pair[uint64, sdk.AccAddress], value v1.VoteCommitment) (stop bool, err error) {
+		voteCommitments = append(voteCommitments, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
 		StartingProposalId: startingProposalID,
 		Deposits:           proposalsDeposits,
@@ -138,5 +159,6 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		Proposals:          proposals,
 		Params:             &params,
 		Constitution:       constitution,
+		VoteCommitments:    voteCommitments,
 	}, nil
 }
@@ -92,12 +92,17 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			k.Logger.Error("failed to emit event", "error", err)
 		}
 
+		msgParams, err := k.getMessageBasedParams(ctx, proposal.MsgTypeURLs())
+		if err != nil {
+			return err
+		}
+
 		k.Logger.Info(
 			"proposal did not meet minimum deposit; deleted",
 			"proposal", proposal.Id,
 			"proposal_type", proposal.ProposalType,
 			"title", proposal.Title,
-			"min_deposit", sdk.NewCoins(proposal.GetMinDepositFromParams(params)...).String(),
+			"min_deposit", sdk.NewCoins(minDeposit(params, msgParams, proposal.ProposalType)...).String(),
 			"total_deposit", sdk.NewCoins(proposal.TotalDeposit...).String(),
 		)
 	}
@@ -145,6 +150,13 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			return err
 		}
 
+		// votes which were committed but never revealed are not counted
+		if proposal.CommitReveal {
+			if err = k.deleteVoteCommitments(ctx, proposal.Id); err != nil {
+				return err
+			}
+		}
+
 		// Deposits are always burned if tally said so, regardless of the proposal type.
 		// If a proposal passes, deposits are always refunded, regardless of the proposal type.
 		// If a proposal fails, and isn't spammy, deposits are refunded, unless the proposal is expedited or optimistic.
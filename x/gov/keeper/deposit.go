@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"cosmossdk.io/collections"
@@ -92,14 +93,19 @@ func (k Keeper) AddDeposit(ctx context.Context, proposalID uint64, depositorAddr
 		return false, err
 	}
 
-	minDepositAmount := proposal.GetMinDepositFromParams(params)
+	msgParams, err := k.getMessageBasedParams(ctx, proposal.MsgTypeURLs())
+	if err != nil {
+		return false, err
+	}
+
+	minDepositAmount := minDeposit(params, msgParams, proposal.ProposalType)
 	minDepositRatio, err := sdkmath.LegacyNewDecFromStr(params.GetMinDepositRatio())
 	if err != nil {
 		return false, err
 	}
 
 	// the deposit must only contain valid denoms (listed in the min deposit param)
-	if err := k.validateDepositDenom(params, depositAmount); err != nil {
+	if err := k.validateDepositDenom(params, msgParams, depositAmount); err != nil {
 		return false, err
 	}
 
@@ -206,9 +212,11 @@ func (k Keeper) AddDeposit(ctx context.Context, proposalID uint64, depositorAddr
 // ChargeDeposit will charge proposal cancellation fee (deposits * proposal_cancel_burn_rate)  and
 // send to a destAddress if defined or burn otherwise.
 // Remaining funds are send back to the depositor.
+// A proposal_deposit_refund event is emitted for every refunded depositor and a
+// proposal_cancel_fee event reports the breakdown between refunded and charged deposits.
 func (k Keeper) ChargeDeposit(ctx context.Context, proposalID uint64, destAddress, proposalCancelRate string) error {
 	rate := sdkmath.LegacyMustNewDecFromStr(proposalCancelRate)
-	var cancellationCharges sdk.Coins
+	var cancellationCharges, refundedAmount sdk.Coins
 
 	deposits, err := k.GetDeposits(ctx, proposalID)
 	if err != nil {
@@ -247,6 +255,16 @@ func (k Keeper) ChargeDeposit(ctx context.Context, proposalID uint64, destAddres
 			if err != nil {
 				return err
 			}
+
+			if err := k.EventService.EventManager(ctx).EmitKV(
+				types.EventTypeProposalRefund,
+				event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+				event.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor),
+				event.NewAttribute(sdk.AttributeKeyAmount, remainingAmount.String()),
+			); err != nil {
+				return err
+			}
+			refundedAmount = refundedAmount.Add(remainingAmount...)
 		}
 		err = k.Deposits.Remove(ctx, collections.Join(deposit.ProposalId, sdk.AccAddress(depositerAddress)))
 		if err != nil {
@@ -254,6 +272,21 @@ func (k Keeper) ChargeDeposit(ctx context.Context, proposalID uint64, destAddres
 		}
 	}
 
+	destination := destAddress
+	if destination == "" {
+		destination = types.AttributeValueCancelDestinationBurn
+	}
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeProposalCancelFee,
+		event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		event.NewAttribute(types.AttributeKeyCancelRatio, rate.String()),
+		event.NewAttribute(types.AttributeKeyRefundedAmount, refundedAmount.String()),
+		event.NewAttribute(types.AttributeKeyChargedAmount, cancellationCharges.String()),
+		event.NewAttribute(types.AttributeKeyCancelDestination, destination),
+	); err != nil {
+		return err
+	}
+
 	// burn the cancellation fee or send the cancellation charges to destination address.
 	if !cancellationCharges.IsZero() {
 		// get the pool module account address
@@ -290,10 +323,25 @@ func (k Keeper) ChargeDeposit(ctx context.Context, proposalID uint64, destAddres
 	return nil
 }
 
+// minDeposit returns the minimum deposit of a proposal of the given type. The
+// min deposit of the message based params of the proposal, if any, overrides
+// the min deposit of standard proposals.
+func minDeposit(params v1.Params, msgParams *v1.MessageBasedParams, proposalType v1.ProposalType) sdk.Coins {
+	switch {
+	case proposalType == v1.ProposalType_PROPOSAL_TYPE_EXPEDITED:
+		return params.ExpeditedMinDeposit
+	case msgParams != nil && len(msgParams.MinDeposit) > 0:
+		return msgParams.MinDeposit
+	default:
+		return params.MinDeposit
+	}
+}
+
 // validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
 // required at the time of proposal submission. This threshold amount is determined by
-// the deposit parameters. Returns nil on success, error otherwise.
-func (k Keeper) validateInitialDeposit(params v1.Params, initialDeposit sdk.Coins, proposalType v1.ProposalType) error {
+// the deposit parameters and the message based params of the proposal, if any.
+// Returns nil on success, error otherwise.
+func (k Keeper) validateInitialDeposit(params v1.Params, msgParams *v1.MessageBasedParams, initialDeposit sdk.Coins, proposalType v1.ProposalType) error {
 	if !initialDeposit.IsValid() || initialDeposit.IsAnyNegative() {
 		return errors.Wrap(sdkerrors.ErrInvalidCoins, initialDeposit.String())
 	}
@@ -306,14 +354,8 @@ func (k Keeper) validateInitialDeposit(params v1.Params, initialDeposit sdk.Coin
 		return nil
 	}
 
-	var minDepositCoins sdk.Coins
-	switch proposalType {
-	case v1.ProposalType_PROPOSAL_TYPE_EXPEDITED:
-		minDepositCoins = params.ExpeditedMinDeposit
-	default:
-		minDepositCoins = params.MinDeposit
-	}
-
+	// the min deposit is copied as it may belong to the params
+	minDepositCoins := slices.Clone(minDeposit(params, msgParams, proposalType))
 	for i := range minDepositCoins {
 		minDepositCoins[i].Amount = sdkmath.LegacyNewDecFromInt(minDepositCoins[i].Amount).Mul(minInitialDepositRatio).RoundInt()
 	}
@@ -323,11 +365,17 @@ func (k Keeper) validateInitialDeposit(params v1.Params, initialDeposit sdk.Coin
 	return nil
 }
 
-// validateDepositDenom validates if the deposit denom is accepted by the governance module.
-func (k Keeper) validateDepositDenom(params v1.Params, depositAmount sdk.Coins) error {
-	denoms := make([]string, 0, len(params.MinDeposit))
-	acceptedDenoms := make(map[string]bool, len(params.MinDeposit))
-	for _, coin := range params.MinDeposit {
+// validateDepositDenom validates if the deposit denom is accepted by the governance module,
+// or by the message based params of the proposal if they override the min deposit.
+func (k Keeper) validateDepositDenom(params v1.Params, msgParams *v1.MessageBasedParams, depositAmount sdk.Coins) error {
+	minDeposit := params.MinDeposit
+	if msgParams != nil && len(msgParams.MinDeposit) > 0 {
+		minDeposit = msgParams.MinDeposit
+	}
+
+	denoms := make([]string, 0, len(minDeposit))
+	acceptedDenoms := make(map[string]bool, len(minDeposit))
+	for _, coin := range minDeposit {
 		acceptedDenoms[coin.Denom] = true
 		denoms = append(denoms, coin.Denom)
 	}
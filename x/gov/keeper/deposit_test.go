@@ -3,12 +3,14 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
@@ -166,6 +168,54 @@ func TestDeposits(t *testing.T) {
 	}
 }
 
+func TestMessageBasedDepositParams(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t)
+	authKeeper, bankKeeper, stakingKeeper := mocks.acctKeeper, mocks.bankKeeper, mocks.stakingKeeper
+	require.NoError(t, trackMockBalances(bankKeeper))
+	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdkmath.NewInt(10000000))
+
+	hour := time.Hour
+	oneStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 1)))
+	require.NoError(t, govKeeper.MessageBasedParams.Set(ctx, sdk.MsgTypeURL(&v1.MsgUpdateParams{}), v1.MessageBasedParams{
+		VotingPeriod:     &hour,
+		Quorum:           "0.4",
+		YesQuorum:        "0",
+		Threshold:        "0.5",
+		VetoThreshold:    "0.66",
+		MinDeposit:       oneStake,
+		MaxDepositPeriod: &hour,
+	}))
+
+	proposal, err := govKeeper.SubmitProposal(ctx, []sdk.Msg{&v1.MsgUpdateParams{Authority: govAcctStr}}, "", "title", "summary", TestAddrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	require.Equal(t, ctx.HeaderInfo().Time.Add(hour), *proposal.DepositEndTime)
+
+	// the deposit must be in a denom of the message based min deposit
+	_, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("other", 1)))
+	require.ErrorIs(t, err, types.ErrInvalidDepositDenom)
+
+	// the message based min deposit is enough to start the voting period
+	votingStarted, err := govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], oneStake)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	proposal, err = govKeeper.Proposals.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, proposal.VotingStartTime.Add(hour), *proposal.VotingEndTime)
+
+	// proposals with other messages use the governance params
+	proposal, err = govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", TestAddrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	params, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ctx.HeaderInfo().Time.Add(*params.MaxDepositPeriod), *proposal.DepositEndTime)
+
+	votingStarted, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], oneStake)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+}
+
 func TestDepositAmount(t *testing.T) {
 	testcases := []struct {
 		name            string
@@ -478,3 +528,42 @@ func TestChargeDeposit(t *testing.T) {
 		}
 	}
 }
+
+func TestChargeDepositEvents(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t)
+	authKeeper, bankKeeper, stakingKeeper := mocks.acctKeeper, mocks.bankKeeper, mocks.stakingKeeper
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdkmath.NewInt(10000000000))
+	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", TestAddrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000)))
+	_, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], deposit)
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, govKeeper.ChargeDeposit(ctx, proposal.Id, "", "0.25"))
+
+	attrs := func(eventType string) map[string]string {
+		for _, e := range ctx.EventManager().Events() {
+			if e.Type != eventType {
+				continue
+			}
+			m := make(map[string]string)
+			for _, attr := range e.Attributes {
+				m[attr.Key] = attr.Value
+			}
+			return m
+		}
+		t.Fatalf("event %s not emitted", eventType)
+		return nil
+	}
+
+	refund := attrs(types.EventTypeProposalRefund)
+	require.Equal(t, "75000stake", refund[sdk.AttributeKeyAmount])
+
+	fee := attrs(types.EventTypeProposalCancelFee)
+	require.Equal(t, "75000stake", fee[types.AttributeKeyRefundedAmount])
+	require.Equal(t, "25000stake", fee[types.AttributeKeyChargedAmount])
+	require.Equal(t, types.AttributeValueCancelDestinationBurn, fee[types.AttributeKeyCancelDestination])
+}
@@ -14,5 +14,5 @@ func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins
 		return err
 	}
 
-	return k.validateInitialDeposit(params, initialDeposit, proposalType)
+	return k.validateInitialDeposit(params, nil, initialDeposit, proposalType)
 }
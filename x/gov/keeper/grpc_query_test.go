@@ -2041,3 +2041,53 @@ func (suite *KeeperTestSuite) TestProposalVoteOptions() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryVoteBreakdown() {
+	suite.reset()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		req      *v1.QueryVoteBreakdownRequest
+		errStr   string
+	}{
+		{
+			name:   "invalid proposal id",
+			req:    &v1.QueryVoteBreakdownRequest{},
+			errStr: "proposal id can not be 0",
+		},
+		{
+			name:   "proposal not found",
+			req:    &v1.QueryVoteBreakdownRequest{ProposalId: 1},
+			errStr: "proposal 1 doesn't exist",
+		},
+		{
+			name: "proposal in deposit period",
+			malleate: func() {
+				propTime := time.Now()
+				proposal := v1.Proposal{
+					Id:             1,
+					Status:         v1.StatusDepositPeriod,
+					SubmitTime:     &propTime,
+					DepositEndTime: &propTime,
+					ProposalType:   v1.ProposalType_PROPOSAL_TYPE_STANDARD,
+				}
+				err := suite.govKeeper.Proposals.Set(suite.ctx, proposal.Id, proposal)
+				suite.Require().NoError(err)
+			},
+			req:    &v1.QueryVoteBreakdownRequest{ProposalId: 1},
+			errStr: "proposal 1 is not in voting period",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			_, err := suite.queryClient.VoteBreakdown(suite.ctx, tc.req)
+			suite.Require().ErrorContains(err, tc.errStr)
+		})
+	}
+}
@@ -54,6 +54,9 @@ type Keeper struct {
 	Deposits collections.Map[collections.Pair[uint64, sdk.AccAddress], v1.Deposit]
 	// Votes key: proposalID+voterAddr | value: Vote
 	Votes collections.Map[collections.Pair[uint64, sdk.AccAddress], v1.Vote]
+	// VoteCommitments key: proposalID+voterAddr | value: VoteCommitment
+	// This is used to store the committed votes of commit-reveal proposals
+	VoteCommitments collections.Map[collections.Pair[uint64, sdk.AccAddress], v1.VoteCommitment]
 	// ProposalID is a counter for proposals. It tracks the next proposal ID to be issued.
 	ProposalID collections.Sequence
 	// Proposals key:proposalID | value: Proposal
@@ -126,6 +129,7 @@ func NewKeeper(
 		MessageBasedParams:     collections.NewMap(sb, types.MessageBasedParamsKey, "proposal_messaged_based_params", collections.StringKey, codec.CollValue[v1.MessageBasedParams](cdc)),
 		Deposits:               collections.NewMap(sb, types.DepositsKeyPrefix, "deposits", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Deposit](cdc)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 		Votes:                  collections.NewMap(sb, types.VotesKeyPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Vote](cdc)),          //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
+		VoteCommitments:        collections.NewMap(sb, types.VoteCommitmentsKeyPrefix, "vote_commitments", collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey), codec.CollValue[v1.VoteCommitment](cdc)),
 		ProposalID:             collections.NewSequence(sb, types.ProposalIDKey, "proposal_id"),
 		Proposals:              collections.NewMap(sb, types.ProposalsKeyPrefix, "proposals", collections.Uint64Key, codec.CollValue[v1.Proposal](cdc)),
 		ProposalVoteOptions:    collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
@@ -79,11 +79,32 @@ func (k msgServer) SubmitProposal(ctx context.Context, msg *v1.MsgSubmitProposal
 	if msg.Expedited { // checking for backward compatibility
 		msg.ProposalType = v1.ProposalType_PROPOSAL_TYPE_EXPEDITED
 	}
-	if err := k.validateInitialDeposit(params, msg.GetInitialDeposit(), msg.ProposalType); err != nil {
+
+	if msg.CommitReveal {
+		if params.RevealPeriod == nil {
+			return nil, errors.Wrap(govtypes.ErrInvalidProposal, "commit-reveal proposals are disabled")
+		}
+
+		if msg.ProposalType != v1.ProposalType_PROPOSAL_TYPE_UNSPECIFIED && msg.ProposalType != v1.ProposalType_PROPOSAL_TYPE_STANDARD {
+			return nil, errors.Wrap(govtypes.ErrInvalidProposalType, "only standard proposals can use commit-reveal voting")
+		}
+	}
+
+	msgURLs := make([]string, len(proposalMsgs))
+	for i, proposalMsg := range proposalMsgs {
+		msgURLs[i] = sdk.MsgTypeURL(proposalMsg)
+	}
+
+	msgParams, err := k.getMessageBasedParams(ctx, msgURLs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.validateInitialDeposit(params, msgParams, msg.GetInitialDeposit(), msg.ProposalType); err != nil {
 		return nil, err
 	}
 
-	if err := k.validateDepositDenom(params, msg.GetInitialDeposit()); err != nil {
+	if err := k.validateDepositDenom(params, msgParams, msg.GetInitialDeposit()); err != nil {
 		return nil, err
 	}
 
@@ -92,6 +113,14 @@ func (k msgServer) SubmitProposal(ctx context.Context, msg *v1.MsgSubmitProposal
 		return nil, err
 	}
 
+	if msg.CommitReveal {
+		proposal.CommitReveal = true
+		proposal.RevealPeriod = params.RevealPeriod
+		if err := k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+			return nil, err
+		}
+	}
+
 	bytes, err := proposal.Marshal()
 	if err != nil {
 		return nil, err
@@ -245,41 +274,83 @@ func (k msgServer) VoteWeighted(ctx context.Context, msg *v1.MsgVoteWeighted) (*
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", accErr)
 	}
 
-	if len(msg.Options) == 0 {
-		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, v1.WeightedVoteOptions(msg.Options).String())
+	if err := validateWeightedVoteOptions(msg.Options); err != nil {
+		return nil, err
+	}
+
+	err := k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, msg.Options, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgVoteWeightedResponse{}, nil
+}
+
+// CommitVote implements the MsgServer.CommitVote method.
+func (k msgServer) CommitVote(ctx context.Context, msg *v1.MsgCommitVote) (*v1.MsgCommitVoteResponse, error) {
+	accAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.Voter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+
+	if err := k.Keeper.CommitVote(ctx, msg.ProposalId, accAddr, msg.Commitment); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCommitVoteResponse{}, nil
+}
+
+// RevealVote implements the MsgServer.RevealVote method.
+func (k msgServer) RevealVote(ctx context.Context, msg *v1.MsgRevealVote) (*v1.MsgRevealVoteResponse, error) {
+	accAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.Voter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+
+	if err := validateWeightedVoteOptions(msg.Options); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RevealVote(ctx, msg.ProposalId, accAddr, msg.Options, msg.Metadata, msg.Salt); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgRevealVoteResponse{}, nil
+}
+
+// validateWeightedVoteOptions checks that the weighted vote options are valid,
+// not duplicated and that their weights sum up to 1.
+func validateWeightedVoteOptions(options v1.WeightedVoteOptions) error {
+	if len(options) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, options.String())
 	}
 
 	totalWeight := math.LegacyNewDec(0)
 	usedOptions := make(map[v1.VoteOption]bool)
-	for _, option := range msg.Options {
+	for _, option := range options {
 		if !option.IsValid() {
-			return nil, errors.Wrap(govtypes.ErrInvalidVote, option.String())
+			return errors.Wrap(govtypes.ErrInvalidVote, option.String())
 		}
 		weight, err := math.LegacyNewDecFromStr(option.Weight)
 		if err != nil {
-			return nil, errors.Wrapf(govtypes.ErrInvalidVote, "invalid weight: %s", err)
+			return errors.Wrapf(govtypes.ErrInvalidVote, "invalid weight: %s", err)
 		}
 		totalWeight = totalWeight.Add(weight)
 		if usedOptions[option.Option] {
-			return nil, errors.Wrap(govtypes.ErrInvalidVote, "duplicated vote option")
+			return errors.Wrap(govtypes.ErrInvalidVote, "duplicated vote option")
 		}
 		usedOptions[option.Option] = true
 	}
 
 	if totalWeight.GT(math.LegacyNewDec(1)) {
-		return nil, errors.Wrap(govtypes.ErrInvalidVote, "total weight overflow 1.00")
+		return errors.Wrap(govtypes.ErrInvalidVote, "total weight overflow 1.00")
 	}
 
 	if totalWeight.LT(math.LegacyNewDec(1)) {
-		return nil, errors.Wrap(govtypes.ErrInvalidVote, "total weight lower than 1.00")
+		return errors.Wrap(govtypes.ErrInvalidVote, "total weight lower than 1.00")
 	}
 
-	err := k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, msg.Options, msg.Metadata)
-	if err != nil {
-		return nil, err
-	}
-
-	return &v1.MsgVoteWeightedResponse{}, nil
+	return nil
 }
 
 // Deposit implements the MsgServer.Deposit method.
@@ -334,7 +405,7 @@ func (k msgServer) UpdateMessageParams(ctx context.Context, msg *v1.MsgUpdateMes
 	}
 
 	// delete the message params if the params are empty
-	if msg.Params == nil || *msg.Params == (v1.MessageBasedParams{}) {
+	if msg.Params == nil || msg.Params.Size() == 0 {
 		if err := k.MessageBasedParams.Remove(ctx, msg.MsgUrl); err != nil {
 			return nil, err
 		}
@@ -381,6 +381,43 @@ func (suite *KeeperTestSuite) TestSubmitMultipleChoiceProposal() {
 	}
 }
 
+// TestSubmitCommitRevealProposal tests only commit-reveal proposal specific logic.
+func (suite *KeeperTestSuite) TestSubmitCommitRevealProposal() {
+	suite.reset()
+	proposerAddr, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+
+	msg, err := v1.NewMsgSubmitProposal(
+		[]sdk.Msg{},
+		sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100000))),
+		proposerAddr,
+		"mandatory metadata",
+		"Proposal",
+		"description of proposal",
+		v1.ProposalType_PROPOSAL_TYPE_STANDARD,
+	)
+	suite.Require().NoError(err)
+	msg.CommitReveal = true
+
+	_, err = suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+	suite.Require().ErrorContains(err, "commit-reveal proposals are disabled")
+
+	params, err := suite.govKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	revealPeriod := time.Hour
+	params.RevealPeriod = &revealPeriod
+	suite.Require().NoError(suite.govKeeper.Params.Set(suite.ctx, params))
+
+	res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	// the reveal period is set from the params at submission
+	proposal, err := suite.govKeeper.Proposals.Get(suite.ctx, res.ProposalId)
+	suite.Require().NoError(err)
+	suite.Require().True(proposal.CommitReveal)
+	suite.Require().Equal(revealPeriod, *proposal.RevealPeriod)
+}
+
 func (suite *KeeperTestSuite) TestMsgCancelProposal() {
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
 	addrs := suite.addrs
@@ -135,8 +135,18 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	msgParams, err := k.getMessageBasedParams(ctx, msgs)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	maxDepositPeriod := params.MaxDepositPeriod
+	if msgParams != nil && msgParams.MaxDepositPeriod != nil {
+		maxDepositPeriod = msgParams.MaxDepositPeriod
+	}
+
 	submitTime := k.HeaderService.HeaderInfo(ctx).Time
-	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*params.MaxDepositPeriod), metadata, title, summary, proposerAddr, proposalType)
+	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*maxDepositPeriod), metadata, title, summary, proposerAddr, proposalType)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
@@ -227,6 +237,12 @@ func (k Keeper) CancelProposal(ctx context.Context, proposalID uint64, proposer
 		}
 	}
 
+	if proposal.CommitReveal {
+		if err = k.deleteVoteCommitments(ctx, proposal.Id); err != nil {
+			return err
+		}
+	}
+
 	err = k.DeleteProposal(ctx, proposal.Id)
 	if err != nil {
 		return err
@@ -281,18 +297,26 @@ func (k Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Proposal)
 	default:
 		votingPeriod = params.VotingPeriod
 
-		if len(proposal.Messages) > 0 {
-			// check if any of the message has message based params
-			customMessageParams, err := k.MessageBasedParams.Get(ctx, sdk.MsgTypeURL(proposal.Messages[0]))
-			if err == nil {
-				votingPeriod = customMessageParams.VotingPeriod
-			} else if !errors.Is(err, collections.ErrNotFound) {
-				return err
-			}
+		customMessageParams, err := k.getMessageBasedParams(ctx, proposal.MsgTypeURLs())
+		if err != nil {
+			return err
+		} else if customMessageParams != nil {
+			votingPeriod = customMessageParams.VotingPeriod
 		}
 	}
 
 	endTime := proposal.VotingStartTime.Add(*votingPeriod)
+	// the voting period of a commit-reveal proposal is its commit period, which is followed by its reveal period.
+	// The reveal period is the one of the params at submission, so that the committed votes can always be revealed.
+	if proposal.CommitReveal {
+		if proposal.RevealPeriod == nil {
+			return fmt.Errorf("commit-reveal proposal %d has no reveal period", proposal.Id)
+		}
+
+		commitEndTime := endTime
+		proposal.CommitEndTime = &commitEndTime
+		endTime = endTime.Add(*proposal.RevealPeriod)
+	}
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1.StatusVotingPeriod
 	if err = k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
@@ -305,3 +329,22 @@ func (k Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Proposal)
 
 	return k.ActiveProposalsQueue.Set(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id), proposal.Id)
 }
+
+// getMessageBasedParams returns the message based params of a proposal with
+// the given message type URLs, or nil if it has none. As a proposal with a
+// message which has message based params can't contain other messages, only
+// proposals with a single message can have message based params.
+func (k Keeper) getMessageBasedParams(ctx context.Context, msgURLs []string) (*v1.MessageBasedParams, error) {
+	if len(msgURLs) != 1 {
+		return nil, nil
+	}
+
+	params, err := k.MessageBasedParams.Get(ctx, msgURLs[0])
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &params, nil
+}
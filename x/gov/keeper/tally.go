@@ -1,8 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
-	"errors"
+	"sort"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
@@ -77,17 +78,15 @@ func (k Keeper) tallyStandard(ctx context.Context, proposal v1.Proposal, totalVo
 	thresholdStr := params.Threshold
 	vetoThresholdStr := params.VetoThreshold
 
-	if len(proposal.Messages) > 0 {
-		// check if any of the message has message based params
-		customMessageParams, err := k.MessageBasedParams.Get(ctx, sdk.MsgTypeURL(proposal.Messages[0]))
-		if err != nil && !errors.Is(err, collections.ErrNotFound) {
-			return false, false, tallyResults, err
-		} else if err == nil {
-			quorumStr = customMessageParams.GetQuorum()
-			thresholdStr = customMessageParams.GetThreshold()
-			vetoThresholdStr = customMessageParams.GetVetoThreshold()
-			yesQuorumStr = customMessageParams.GetYesQuorum()
-		}
+	// check if the message of the proposal has message based params
+	customMessageParams, err := k.getMessageBasedParams(ctx, proposal.MsgTypeURLs())
+	if err != nil {
+		return false, false, tallyResults, err
+	} else if customMessageParams != nil {
+		quorumStr = customMessageParams.GetQuorum()
+		thresholdStr = customMessageParams.GetThreshold()
+		vetoThresholdStr = customMessageParams.GetVetoThreshold()
+		yesQuorumStr = customMessageParams.GetYesQuorum()
 	}
 
 	// If there is not enough quorum of votes, the proposal fails
@@ -326,6 +325,80 @@ func defaultCalculateVoteResultsAndVotingPower(
 	return totalVP, results, nil
 }
 
+// VoteBreakdown returns, for each bonded validator, how its voting power on a
+// proposal is split between the power of its delegators who voted themselves,
+// which overrides the vote of the validator, and the power of its delegators
+// who did not vote, which the validator votes with. The validators are sorted
+// by decreasing voting power.
+//
+// The breakdown follows the default tally, and ignores the
+// CalculateVoteResultsAndVotingPowerFn of the keeper config.
+func (k Keeper) VoteBreakdown(ctx context.Context, proposalID uint64) ([]*v1.ValidatorVoteBreakdown, error) {
+	validators, err := k.getCurrentValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		voter := key.K2()
+		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(voter)
+		if err != nil {
+			return false, err
+		}
+
+		if val, ok := validators[valAddrStr]; ok {
+			val.Vote = vote.Options
+			validators[valAddrStr] = val
+		}
+
+		err = k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
+			val, ok := validators[delegation.GetValidatorAddr()]
+			// the self-delegation of a validator voting is not overridden
+			if !ok || bytes.Equal(val.Address, voter) {
+				return false
+			}
+
+			val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+			validators[delegation.GetValidatorAddr()] = val
+			return false
+		})
+		return false, err
+	}); err != nil {
+		return nil, err
+	}
+
+	breakdowns := make([]*v1.ValidatorVoteBreakdown, 0, len(validators))
+	for valAddr, val := range validators {
+		votingPower := math.LegacyNewDecFromInt(val.BondedTokens)
+		overriddenPower := math.LegacyZeroDec()
+		if val.DelegatorShares.IsPositive() {
+			overriddenPower = val.DelegatorDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+		}
+
+		breakdown := &v1.ValidatorVoteBreakdown{
+			ValidatorAddress: valAddr,
+			VotingPower:      votingPower.String(),
+			OverriddenPower:  overriddenPower.String(),
+			InheritedPower:   votingPower.Sub(overriddenPower).String(),
+		}
+		if len(val.Vote) > 0 {
+			breakdown.Options = val.Vote
+		}
+		breakdowns = append(breakdowns, breakdown)
+	}
+
+	sort.Slice(breakdowns, func(i, j int) bool {
+		tokensI, tokensJ := validators[breakdowns[i].ValidatorAddress].BondedTokens, validators[breakdowns[j].ValidatorAddress].BondedTokens
+		if !tokensI.Equal(tokensJ) {
+			return tokensI.GT(tokensJ)
+		}
+		return breakdowns[i].ValidatorAddress < breakdowns[j].ValidatorAddress
+	})
+
+	return breakdowns, nil
+}
+
 func createEmptyResults() map[v1.VoteOption]math.LegacyDec {
 	results := make(map[v1.VoteOption]math.LegacyDec)
 	results[v1.OptionYes] = math.LegacyZeroDec()
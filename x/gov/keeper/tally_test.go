@@ -1546,3 +1546,90 @@ func TestTally_MultipleChoice(t *testing.T) {
 		})
 	}
 }
+
+func TestVoteBreakdown(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	var (
+		numVals  = 3
+		addrs    = simtestutil.CreateRandomAccounts(numVals + 2)
+		valAddrs = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
+		delAddrs = addrs[numVals:]
+	)
+	valCodec := address.NewBech32Codec("cosmosvaloper")
+	mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valCodec).AnyTimes()
+	valAddrsStr := make([]string, numVals)
+	for i, valAddr := range valAddrs {
+		var err error
+		valAddrsStr[i], err = valCodec.BytesToString(valAddr)
+		require.NoError(t, err)
+	}
+	mocks.stakingKeeper.EXPECT().
+		IterateBondedValidatorsByPower(ctx, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+				for i := 0; i < numVals; i++ {
+					fn(int64(i), stakingtypes.Validator{
+						OperatorAddress: valAddrsStr[i],
+						Status:          stakingtypes.Bonded,
+						Tokens:          sdkmath.NewInt(int64(1000000 * (i + 1))),
+						DelegatorShares: sdkmath.LegacyNewDec(int64(1000000 * (i + 1))),
+					})
+				}
+				return nil
+			})
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	err = govKeeper.ActivateVotingPeriod(ctx, proposal)
+	require.NoError(t, err)
+	s := tallyFixture{
+		t:        t,
+		proposal: proposal,
+		valAddrs: valAddrs,
+		delAddrs: delAddrs,
+		ctx:      ctx,
+		keeper:   govKeeper,
+		mocks:    mocks,
+	}
+
+	delegation := func(delegator sdk.AccAddress, val int, shares int64) stakingtypes.Delegation {
+		delAddr, err := mocks.acctKeeper.AddressCodec().BytesToString(delegator)
+		require.NoError(t, err)
+		return stakingtypes.Delegation{DelegatorAddress: delAddr, ValidatorAddress: valAddrsStr[val], Shares: sdkmath.LegacyNewDec(shares)}
+	}
+	// the self-delegation of validator 0 is not overridden by its own vote
+	delegatorVote(s, sdk.AccAddress(valAddrs[0]), []stakingtypes.Delegation{delegation(sdk.AccAddress(valAddrs[0]), 0, 500000)}, v1.OptionYes)
+	delegatorVote(s, delAddrs[0], []stakingtypes.Delegation{delegation(delAddrs[0], 0, 200000), delegation(delAddrs[0], 2, 600000)}, v1.OptionNo)
+	delegatorVote(s, delAddrs[1], []stakingtypes.Delegation{delegation(delAddrs[1], 1, 300000)}, v1.OptionYes)
+
+	breakdowns, err := govKeeper.VoteBreakdown(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, []*v1.ValidatorVoteBreakdown{
+		{
+			ValidatorAddress: valAddrsStr[2],
+			VotingPower:      "3000000.000000000000000000",
+			OverriddenPower:  "600000.000000000000000000",
+			InheritedPower:   "2400000.000000000000000000",
+		},
+		{
+			ValidatorAddress: valAddrsStr[1],
+			VotingPower:      "2000000.000000000000000000",
+			OverriddenPower:  "300000.000000000000000000",
+			InheritedPower:   "1700000.000000000000000000",
+		},
+		{
+			ValidatorAddress: valAddrsStr[0],
+			Options:          v1.NewNonSplitVoteOption(v1.OptionYes),
+			VotingPower:      "1000000.000000000000000000",
+			OverriddenPower:  "200000.000000000000000000",
+			InheritedPower:   "800000.000000000000000000",
+		},
+	}, breakdowns)
+
+	// the votes are not removed
+	votes, err := govKeeper.Votes.Iterate(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id))
+	require.NoError(t, err)
+	keys, err := votes.Keys()
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+}
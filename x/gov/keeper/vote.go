@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	stderrors "errors"
 	"fmt"
 
@@ -31,15 +33,113 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 		return errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
-	if err := k.assertMetadataLength(metadata); err != nil {
+	// votes on commit-reveal proposals are added when revealed.
+	if proposal.CommitReveal {
+		return errors.Wrapf(types.ErrInvalidVote, "proposal %d uses commit-reveal voting, votes must be committed", proposalID)
+	}
+
+	return k.setVote(ctx, proposal, voterAddr, options, metadata)
+}
+
+// CommitVote commits the hash of a vote on a commit-reveal proposal during its commit period.
+// The vote is only counted once it is revealed during the reveal period.
+func (k Keeper) CommitVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress, commitment []byte) error {
+	proposal, err := k.getCommitRevealProposal(ctx, proposalID)
+	if err != nil {
 		return err
 	}
 
-	err = k.assertVoteOptionsLen(options)
+	if !k.HeaderService.HeaderInfo(ctx).Time.Before(*proposal.CommitEndTime) {
+		return errors.Wrapf(types.ErrVotingPeriodEnded, "commit period of proposal %d has ended", proposalID)
+	}
+
+	if len(commitment) != sha256.Size {
+		return errors.Wrapf(types.ErrInvalidVoteCommitment, "commitment must be %d bytes long, got %d", sha256.Size, len(commitment))
+	}
+
+	voterStrAddr, err := k.authKeeper.AddressCodec().BytesToString(voterAddr)
 	if err != nil {
 		return err
 	}
 
+	voteCommitment := v1.VoteCommitment{ProposalId: proposalID, Voter: voterStrAddr, Commitment: commitment}
+	if err := k.VoteCommitments.Set(ctx, collections.Join(proposalID, voterAddr), voteCommitment); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeProposalVoteCommit,
+		event.NewAttribute(types.AttributeKeyVoter, voterStrAddr),
+		event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+	)
+}
+
+// RevealVote reveals a vote committed on a commit-reveal proposal during its reveal period.
+// The revealed vote must match the commitment of the voter, after which it is added to the proposal votes.
+func (k Keeper) RevealVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress, options v1.WeightedVoteOptions, metadata string, salt []byte) error {
+	proposal, err := k.getCommitRevealProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	if k.HeaderService.HeaderInfo(ctx).Time.Before(*proposal.CommitEndTime) {
+		return errors.Wrapf(types.ErrInvalidVote, "reveal period of proposal %d has not started", proposalID)
+	}
+
+	voteCommitment, err := k.VoteCommitments.Get(ctx, collections.Join(proposalID, voterAddr))
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return errors.Wrapf(types.ErrInvalidVoteCommitment, "no vote committed on proposal %d", proposalID)
+		}
+
+		return err
+	}
+
+	if !bytes.Equal(v1.VoteCommitmentHash(proposalID, voteCommitment.Voter, options, salt), voteCommitment.Commitment) {
+		return errors.Wrap(types.ErrInvalidVoteCommitment, "revealed vote does not match the committed vote")
+	}
+
+	if err := k.setVote(ctx, proposal, voterAddr, options, metadata); err != nil {
+		return err
+	}
+
+	return k.VoteCommitments.Remove(ctx, collections.Join(proposalID, voterAddr))
+}
+
+// getCommitRevealProposal returns the proposal with the given id, given that it is
+// a commit-reveal proposal in voting period.
+func (k Keeper) getCommitRevealProposal(ctx context.Context, proposalID uint64) (v1.Proposal, error) {
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return v1.Proposal{}, errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+		}
+
+		return v1.Proposal{}, err
+	}
+
+	if proposal.Status != v1.StatusVotingPeriod {
+		return v1.Proposal{}, errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	if !proposal.CommitReveal || proposal.CommitEndTime == nil {
+		return v1.Proposal{}, errors.Wrapf(types.ErrInvalidProposal, "proposal %d does not use commit-reveal voting", proposalID)
+	}
+
+	return proposal, nil
+}
+
+// setVote validates and stores the vote of a voter on a proposal in voting period.
+func (k Keeper) setVote(ctx context.Context, proposal v1.Proposal, voterAddr sdk.AccAddress, options v1.WeightedVoteOptions, metadata string) error {
+	proposalID := proposal.Id
+
+	if err := k.assertMetadataLength(metadata); err != nil {
+		return err
+	}
+
+	if err := k.assertVoteOptionsLen(options); err != nil {
+		return err
+	}
+
 	for _, option := range options {
 		switch proposal.ProposalType {
 		case v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC:
@@ -78,13 +178,12 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 		return err
 	}
 	vote := v1.NewVote(proposalID, voterStrAddr, options, metadata)
-	err = k.Votes.Set(ctx, collections.Join(proposalID, voterAddr), vote)
-	if err != nil {
+	if err := k.Votes.Set(ctx, collections.Join(proposalID, voterAddr), vote); err != nil {
 		return err
 	}
 
 	// called after a vote on a proposal is cast
-	if err = k.Hooks().AfterProposalVote(ctx, proposalID, voterAddr); err != nil {
+	if err := k.Hooks().AfterProposalVote(ctx, proposalID, voterAddr); err != nil {
 		return err
 	}
 
@@ -105,3 +204,9 @@ func (k Keeper) deleteVotes(ctx context.Context, proposalID uint64) error {
 
 	return nil
 }
+
+// deleteVoteCommitments deletes all the vote commitments from a given proposalID.
+func (k Keeper) deleteVoteCommitments(ctx context.Context, proposalID uint64) error {
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	return k.VoteCommitments.Clear(ctx, rng)
+}
@@ -2,11 +2,14 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
@@ -226,3 +229,72 @@ func TestVotes_CustomMaxVoteOptionsLen(t *testing.T) {
 	}))
 	require.Len(t, votes, 1)
 }
+
+func TestVotes_CommitReveal(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t)
+	authKeeper, bankKeeper, stakingKeeper := mocks.acctKeeper, mocks.bankKeeper, mocks.stakingKeeper
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdkmath.NewInt(10000000))
+	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	addrs0Str, err := authKeeper.AddressCodec().BytesToString(addrs[0])
+	require.NoError(t, err)
+
+	params, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	revealPeriod := time.Hour
+	params.RevealPeriod = &revealPeriod
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "description", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	proposal.CommitReveal = true
+	proposal.RevealPeriod = &revealPeriod
+	require.NoError(t, govKeeper.Proposals.Set(ctx, proposal.Id, proposal))
+	proposalID := proposal.Id
+
+	// the reveal period of the proposal is not affected by a params update
+	params.RevealPeriod = nil
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+	options := v1.NewNonSplitVoteOption(v1.OptionYes)
+	salt := []byte("salt")
+	commitment := v1.VoteCommitmentHash(proposalID, addrs0Str, options, salt)
+
+	require.Error(t, govKeeper.CommitVote(ctx, proposalID, addrs[0], commitment), "proposal not on voting period")
+
+	require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+	proposal, err = govKeeper.Proposals.Get(ctx, proposalID)
+	require.NoError(t, err)
+	require.Equal(t, proposal.VotingStartTime.Add(*params.VotingPeriod), *proposal.CommitEndTime)
+	require.Equal(t, proposal.CommitEndTime.Add(revealPeriod), *proposal.VotingEndTime)
+
+	// plain votes are not allowed
+	require.ErrorIs(t, govKeeper.AddVote(ctx, proposalID, addrs[0], options, ""), types.ErrInvalidVote)
+
+	// commit period
+	require.ErrorIs(t, govKeeper.CommitVote(ctx, proposalID, addrs[0], []byte("too short")), types.ErrInvalidVoteCommitment)
+	require.NoError(t, govKeeper.CommitVote(ctx, proposalID, addrs[0], commitment))
+	require.ErrorIs(t, govKeeper.RevealVote(ctx, proposalID, addrs[0], options, "", salt), types.ErrInvalidVote)
+	_, err = govKeeper.Votes.Get(ctx, collections.Join(proposalID, addrs[0]))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// reveal period
+	ctx = ctx.WithHeaderInfo(header.Info{Time: *proposal.CommitEndTime})
+	require.ErrorIs(t, govKeeper.CommitVote(ctx, proposalID, addrs[1], commitment), types.ErrVotingPeriodEnded)
+	require.ErrorIs(t, govKeeper.RevealVote(ctx, proposalID, addrs[1], options, "", salt), types.ErrInvalidVoteCommitment)
+	require.ErrorIs(t, govKeeper.RevealVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), "", salt), types.ErrInvalidVoteCommitment)
+	require.ErrorIs(t, govKeeper.RevealVote(ctx, proposalID, addrs[0], options, "", []byte("other salt")), types.ErrInvalidVoteCommitment)
+	require.NoError(t, govKeeper.RevealVote(ctx, proposalID, addrs[0], options, "metadata", salt))
+
+	vote, err := govKeeper.Votes.Get(ctx, collections.Join(proposalID, addrs[0]))
+	require.NoError(t, err)
+	require.Equal(t, addrs0Str, vote.Voter)
+	require.Equal(t, v1.OptionYes, vote.Options[0].Option)
+	require.Equal(t, "metadata", vote.Metadata)
+
+	// a commitment can only be revealed once
+	has, err := govKeeper.VoteCommitments.Has(ctx, collections.Join(proposalID, addrs[0]))
+	require.NoError(t, err)
+	require.False(t, has)
+	require.ErrorIs(t, govKeeper.RevealVote(ctx, proposalID, addrs[0], options, "", salt), types.ErrInvalidVoteCommitment)
+}
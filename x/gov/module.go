@@ -35,12 +35,13 @@ var (
 	_ module.AppModuleSimulation = AppModule{}
 	_ module.HasInvariants       = AppModule{}
 
-	_ appmodule.AppModule             = AppModule{}
-	_ appmodule.HasEndBlocker         = AppModule{}
-	_ appmodule.HasServices           = AppModule{}
-	_ appmodule.HasMigrations         = AppModule{}
-	_ appmodule.HasRegisterInterfaces = AppModule{}
-	_ appmodule.HasGenesis            = AppModule{}
+	_ appmodule.AppModule              = AppModule{}
+	_ appmodule.HasEndBlocker          = AppModule{}
+	_ appmodule.HasServices            = AppModule{}
+	_ appmodule.HasMigrations          = AppModule{}
+	_ appmodule.HasRegisterInterfaces  = AppModule{}
+	_ appmodule.HasGenesis             = AppModule{}
+	_ appmodule.HasOrderingConstraints = AppModule{}
 )
 
 // AppModule implements an application module for the gov module.
@@ -196,6 +197,14 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 	return am.cdc.MarshalJSON(gs)
 }
 
+// OrderingConstraints implements appmodule.HasOrderingConstraints.
+// Proposals are tallied before staking updates the validator set.
+func (AppModule) OrderingConstraints() []appmodule.OrderingConstraint {
+	return []appmodule.OrderingConstraint{
+		{Hook: appmodule.HookEndBlocker, Before: []string{"staking"}},
+	}
+}
+
 // ConsensusVersion implements HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
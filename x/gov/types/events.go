@@ -2,12 +2,15 @@ package types
 
 // Governance module event types
 const (
-	EventTypeSubmitProposal   = "submit_proposal"
-	EventTypeProposalDeposit  = "proposal_deposit"
-	EventTypeProposalVote     = "proposal_vote"
-	EventTypeInactiveProposal = "inactive_proposal"
-	EventTypeActiveProposal   = "active_proposal"
-	EventTypeCancelProposal   = "cancel_proposal"
+	EventTypeSubmitProposal     = "submit_proposal"
+	EventTypeProposalDeposit    = "proposal_deposit"
+	EventTypeProposalVote       = "proposal_vote"
+	EventTypeProposalVoteCommit = "proposal_vote_commit"
+	EventTypeInactiveProposal   = "inactive_proposal"
+	EventTypeActiveProposal     = "active_proposal"
+	EventTypeCancelProposal     = "cancel_proposal"
+	EventTypeProposalRefund     = "proposal_deposit_refund"
+	EventTypeProposalCancelFee  = "proposal_cancel_fee"
 
 	AttributeKeyProposalResult       = "proposal_result"
 	AttributeKeyVoter                = "voter"
@@ -19,6 +22,10 @@ const (
 	AttributeKeyProposalLog          = "proposal_log"           // log of proposal execution
 	AttributeKeyProposalDepositError = "proposal_deposit_error" // error on proposal deposit refund/burn
 	AttributeKeyProposalProposer     = "proposal_proposer"      // account address of the proposer
+	AttributeKeyCancelRatio          = "cancel_ratio"           // ratio of the deposits charged on proposal cancellation
+	AttributeKeyRefundedAmount       = "refunded_amount"        // deposits refunded to the depositors on proposal cancellation
+	AttributeKeyChargedAmount        = "charged_amount"         // deposits charged on proposal cancellation
+	AttributeKeyCancelDestination    = "cancel_destination"     // destination of the cancellation charges
 
 	AttributeValueProposalDropped            = "proposal_dropped"             // didn't meet min deposit
 	AttributeValueProposalPassed             = "proposal_passed"              // met vote quorum
@@ -27,6 +34,7 @@ const (
 	AttributeValueOptimisticProposalRejected = "optimistic_proposal_rejected" // didn't meet optimistic vote quorum
 	AttributeValueProposalFailed             = "proposal_failed"              // error on proposal handler
 	AttributeValueProposalCanceled           = "proposal_canceled"            // error on proposal handler
+	AttributeValueCancelDestinationBurn      = "burn"                         // cancellation charges are burned
 
 	AttributeKeyProposalType   = "proposal_type"
 	AttributeSignalTitle       = "signal_title"
@@ -26,6 +26,7 @@ var (
 	ConstitutionKey              = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
 	ProposalVoteOptionsKeyPrefix = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
 	MessageBasedParamsKey        = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+	VoteCommitmentsKeyPrefix     = collections.NewPrefix(52) // VoteCommitmentsKeyPrefix stores the vote commitments of commit-reveal proposals.
 )
 
 // Reserved kvstore keys
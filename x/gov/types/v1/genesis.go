@@ -34,7 +34,8 @@ func (data GenesisState) Empty() bool {
 // ValidateGenesis checks if gov genesis state is valid ranges
 // It checks if params are in valid ranges
 // It also makes sure that the provided proposal IDs are unique and
-// that there are no duplicate deposit or vote records and no vote or deposits for non-existent proposals
+// that there are no duplicate deposit, vote or vote commitment records and no vote, vote commitment
+// or deposits for non-existent proposals
 func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 	if data.StartingProposalId == 0 {
 		return errors.New("starting proposal id must be greater than 0")
@@ -98,6 +99,29 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate vote commitments
+	errGroup.Go(func() error {
+		type commitmentKey struct {
+			ProposalId uint64
+			Voter      string
+		}
+		commitmentIds := make(map[commitmentKey]struct{})
+		for _, c := range data.VoteCommitments {
+			if _, ok := proposalIds[c.ProposalId]; !ok {
+				return fmt.Errorf("vote commitment %v has non-existent proposal id: %d", c, c.ProposalId)
+			}
+
+			ck := commitmentKey{c.ProposalId, c.Voter}
+			if _, ok := commitmentIds[ck]; ok {
+				return fmt.Errorf("duplicate vote commitment: %v", c)
+			}
+
+			commitmentIds[ck] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic(ac)
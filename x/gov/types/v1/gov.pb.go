@@ -342,6 +342,17 @@ type Proposal struct {
 	FailedReason string `protobuf:"bytes,15,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
 	// proposal_type defines the type of the proposal
 	ProposalType ProposalType `protobuf:"varint,16,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// commit_reveal defines if the votes on the proposal are committed as hashes
+	// during the commit period and revealed during the reveal period. Only the
+	// revealed votes are tallied.
+	CommitReveal bool `protobuf:"varint,17,opt,name=commit_reveal,json=commitReveal,proto3" json:"commit_reveal,omitempty"`
+	// commit_end_time is the end time of the commit period of a commit-reveal
+	// proposal, after which votes are revealed until voting_end_time.
+	CommitEndTime *time.Time `protobuf:"bytes,18,opt,name=commit_end_time,json=commitEndTime,proto3,stdtime" json:"commit_end_time,omitempty"`
+	// reveal_period is the reveal period of a commit-reveal proposal, set from
+	// the params when the proposal is submitted so that it is not affected by
+	// later params updates.
+	RevealPeriod *time.Duration `protobuf:"bytes,19,opt,name=reveal_period,json=revealPeriod,proto3,stdduration" json:"reveal_period,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -490,6 +501,27 @@ func (m *Proposal) GetProposalType() ProposalType {
 	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
 }
 
+func (m *Proposal) GetCommitReveal() bool {
+	if m != nil {
+		return m.CommitReveal
+	}
+	return false
+}
+
+func (m *Proposal) GetCommitEndTime() *time.Time {
+	if m != nil {
+		return m.CommitEndTime
+	}
+	return nil
+}
+
+func (m *Proposal) GetRevealPeriod() *time.Duration {
+	if m != nil {
+		return m.RevealPeriod
+	}
+	return nil
+}
+
 // ProposalVoteOptions defines the stringified vote options for proposals.
 // This allows to support multiple choice options for a given proposal.
 type ProposalVoteOptions struct {
@@ -773,6 +805,72 @@ func (m *Vote) GetMetadata() string {
 	return ""
 }
 
+// VoteCommitment defines the commitment of a vote on a commit-reveal governance
+// proposal, which is the hash of the vote options and of a salt chosen by the
+// voter.
+type VoteCommitment struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the voter address of the proposal.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// commitment is the hash of the vote, see MsgRevealVote.
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *VoteCommitment) Reset()         { *m = VoteCommitment{} }
+func (m *VoteCommitment) String() string { return proto.CompactTextString(m) }
+func (*VoteCommitment) ProtoMessage()    {}
+func (*VoteCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{6}
+}
+func (m *VoteCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteCommitment.Merge(m, src)
+}
+func (m *VoteCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteCommitment proto.InternalMessageInfo
+
+func (m *VoteCommitment) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *VoteCommitment) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *VoteCommitment) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
 // DepositParams defines the params for deposits on governance proposals.
 //
 // Deprecated: Do not use.
@@ -788,7 +886,7 @@ func (m *DepositParams) Reset()         { *m = DepositParams{} }
 func (m *DepositParams) String() string { return proto.CompactTextString(m) }
 func (*DepositParams) ProtoMessage()    {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{6}
+	return fileDescriptor_e05cb1c0d030febb, []int{7}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -843,7 +941,7 @@ func (m *VotingParams) Reset()         { *m = VotingParams{} }
 func (m *VotingParams) String() string { return proto.CompactTextString(m) }
 func (*VotingParams) ProtoMessage()    {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{7}
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -897,7 +995,7 @@ func (m *TallyParams) Reset()         { *m = TallyParams{} }
 func (m *TallyParams) String() string { return proto.CompactTextString(m) }
 func (*TallyParams) ProtoMessage()    {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{8}
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -956,15 +1054,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
@@ -975,7 +1073,7 @@ type Params struct {
 	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,10,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
 	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.67.
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -1005,13 +1103,16 @@ type Params struct {
 	// considered valid for an expedited proposal.
 	ExpeditedQuorum      string `protobuf:"bytes,21,opt,name=expedited_quorum,json=expeditedQuorum,proto3" json:"expedited_quorum,omitempty"`
 	ProposalExecutionGas uint64 `protobuf:"varint,22,opt,name=proposal_execution_gas,json=proposalExecutionGas,proto3" json:"proposal_execution_gas,omitempty"`
+	// Duration of the reveal period of a commit-reveal proposal, which follows
+	// its voting period. Commit-reveal proposals are disabled when not set.
+	RevealPeriod *time.Duration `protobuf:"bytes,23,opt,name=reveal_period,json=revealPeriod,proto3,stdduration" json:"reveal_period,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{9}
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1194,6 +1295,13 @@ func (m *Params) GetProposalExecutionGas() uint64 {
 	return 0
 }
 
+func (m *Params) GetRevealPeriod() *time.Duration {
+	if m != nil {
+		return m.RevealPeriod
+	}
+	return nil
+}
+
 // MessageBasedParams defines the parameters of specific messages in a proposal.
 // It is used to define the parameters of a proposal that is based on a specific message.
 // Once a message has message based params, it only supports a standard proposal type.
@@ -1209,13 +1317,19 @@ type MessageBasedParams struct {
 	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
 	// Minimum value of Veto votes to Total votes ratio for proposal to be vetoed.
 	VetoThreshold string `protobuf:"bytes,4,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// Minimum deposit for a proposal to enter voting period.
+	// If empty, the min_deposit of the governance params is used.
+	MinDeposit []types.Coin `protobuf:"bytes,5,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
+	// Maximum period for Atom holders to deposit on a proposal.
+	// If unset, the max_deposit_period of the governance params is used.
+	MaxDepositPeriod *time.Duration `protobuf:"bytes,6,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 }
 
 func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
 func (m *MessageBasedParams) String() string { return proto.CompactTextString(m) }
 func (*MessageBasedParams) ProtoMessage()    {}
 func (*MessageBasedParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{10}
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
 }
 func (m *MessageBasedParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1279,6 +1393,20 @@ func (m *MessageBasedParams) GetVetoThreshold() string {
 	return ""
 }
 
+func (m *MessageBasedParams) GetMinDeposit() []types.Coin {
+	if m != nil {
+		return m.MinDeposit
+	}
+	return nil
+}
+
+func (m *MessageBasedParams) GetMaxDepositPeriod() *time.Duration {
+	if m != nil {
+		return m.MaxDepositPeriod
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
@@ -1289,6 +1417,7 @@ func init() {
 	proto.RegisterType((*ProposalVoteOptions)(nil), "cosmos.gov.v1.ProposalVoteOptions")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1.Vote")
+	proto.RegisterType((*VoteCommitment)(nil), "cosmos.gov.v1.VoteCommitment")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
@@ -1299,133 +1428,141 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 2006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0xdb, 0xc8,
-	0x19, 0x0e, 0x25, 0xf9, 0x43, 0xaf, 0x25, 0x99, 0x1e, 0xdb, 0x31, 0x63, 0xaf, 0x3f, 0x62, 0x14,
-	0x0b, 0x37, 0xbb, 0x96, 0xec, 0x6c, 0xdd, 0x6e, 0xd3, 0xcd, 0x41, 0xb2, 0x98, 0x84, 0x41, 0x6c,
-	0xa9, 0x14, 0xe3, 0x24, 0x2d, 0x0a, 0x82, 0x36, 0x27, 0x32, 0x77, 0x45, 0x8e, 0x4a, 0x8e, 0x6c,
-	0xab, 0xbf, 0x62, 0x8f, 0x3d, 0x15, 0xbd, 0x75, 0x8f, 0x3d, 0x04, 0xbd, 0xf7, 0xd4, 0x45, 0x0f,
-	0xc5, 0x22, 0xa7, 0x62, 0x81, 0xa6, 0x45, 0x72, 0x28, 0xb0, 0x3f, 0xa1, 0xe8, 0xa1, 0x98, 0xe1,
-	0x50, 0xa4, 0x3e, 0x1c, 0xcb, 0x8b, 0x5e, 0x12, 0x79, 0xe6, 0x79, 0x9e, 0x79, 0xe7, 0xfd, 0x9a,
-	0x57, 0x82, 0xa5, 0x13, 0x12, 0xb8, 0x24, 0x28, 0x35, 0xc9, 0x59, 0xe9, 0x6c, 0x97, 0xfd, 0x57,
-	0x6c, 0xfb, 0x84, 0x12, 0x94, 0x0f, 0x37, 0x8a, 0x6c, 0xe5, 0x6c, 0x77, 0x79, 0x4d, 0xe0, 0x8e,
-	0xad, 0x00, 0x97, 0xce, 0x76, 0x8f, 0x31, 0xb5, 0x76, 0x4b, 0x27, 0xc4, 0xf1, 0x42, 0xf8, 0xf2,
-	0x42, 0x93, 0x34, 0x09, 0xff, 0x58, 0x62, 0x9f, 0xc4, 0xea, 0x7a, 0x93, 0x90, 0x66, 0x0b, 0x97,
-	0xf8, 0x5f, 0xc7, 0x9d, 0x97, 0x25, 0xea, 0xb8, 0x38, 0xa0, 0x96, 0xdb, 0x16, 0x80, 0x5b, 0x83,
-	0x00, 0xcb, 0xeb, 0x8a, 0xad, 0xb5, 0xc1, 0x2d, 0xbb, 0xe3, 0x5b, 0xd4, 0x21, 0xd1, 0x89, 0xb7,
-	0x42, 0x8b, 0xcc, 0xf0, 0x50, 0x61, 0x6d, 0xb8, 0x35, 0x67, 0xb9, 0x8e, 0x47, 0x4a, 0xfc, 0xdf,
-	0x70, 0x69, 0x93, 0x00, 0x7a, 0x86, 0x9d, 0xe6, 0x29, 0xc5, 0xf6, 0x11, 0xa1, 0xb8, 0xd6, 0x66,
-	0x4a, 0x68, 0x17, 0x26, 0x09, 0xff, 0xa4, 0x48, 0x1b, 0xd2, 0x56, 0xe1, 0xee, 0xad, 0x62, 0xdf,
-	0xad, 0x8b, 0x31, 0x54, 0x17, 0x40, 0xf4, 0x21, 0x4c, 0x9e, 0x73, 0x21, 0x25, 0xb5, 0x21, 0x6d,
-	0x65, 0x2b, 0x85, 0xd7, 0xaf, 0xb6, 0x41, 0xb0, 0xaa, 0xf8, 0x44, 0x17, 0xbb, 0x9b, 0xbf, 0x97,
-	0x60, 0xaa, 0x8a, 0xdb, 0x24, 0x70, 0x28, 0x5a, 0x87, 0x99, 0xb6, 0x4f, 0xda, 0x24, 0xb0, 0x5a,
-	0xa6, 0x63, 0xf3, 0xb3, 0x32, 0x3a, 0x44, 0x4b, 0x9a, 0x8d, 0x7e, 0x0c, 0x59, 0x3b, 0xc4, 0x12,
-	0x5f, 0xe8, 0x2a, 0xaf, 0x5f, 0x6d, 0x2f, 0x08, 0xdd, 0xb2, 0x6d, 0xfb, 0x38, 0x08, 0x1a, 0xd4,
-	0x77, 0xbc, 0xa6, 0x1e, 0x43, 0xd1, 0x67, 0x30, 0x69, 0xb9, 0xa4, 0xe3, 0x51, 0x25, 0xbd, 0x91,
-	0xde, 0x9a, 0x89, 0xed, 0x67, 0x61, 0x2a, 0x8a, 0x30, 0x15, 0xf7, 0x89, 0xe3, 0x55, 0xb2, 0x5f,
-	0xbf, 0x59, 0xbf, 0xf1, 0xd5, 0xbf, 0xff, 0x78, 0x47, 0xd2, 0x05, 0x67, 0xf3, 0xcf, 0x53, 0x30,
-	0x5d, 0x17, 0x46, 0xa0, 0x02, 0xa4, 0x7a, 0xa6, 0xa5, 0x1c, 0x1b, 0xed, 0xc0, 0xb4, 0x8b, 0x83,
-	0xc0, 0x6a, 0xe2, 0x40, 0x49, 0x71, 0xf1, 0x85, 0x62, 0x18, 0x91, 0x62, 0x14, 0x91, 0x62, 0xd9,
-	0xeb, 0xea, 0x3d, 0x14, 0xda, 0x83, 0xc9, 0x80, 0x5a, 0xb4, 0x13, 0x28, 0x69, 0xee, 0xcc, 0xd5,
-	0x01, 0x67, 0x46, 0x47, 0x35, 0x38, 0x48, 0x17, 0x60, 0xf4, 0x08, 0xd0, 0x4b, 0xc7, 0xb3, 0x5a,
-	0x26, 0xb5, 0x5a, 0xad, 0xae, 0xe9, 0xe3, 0xa0, 0xd3, 0xa2, 0x4a, 0x66, 0x43, 0xda, 0x9a, 0xb9,
-	0xbb, 0x3c, 0x20, 0x61, 0x30, 0x88, 0xce, 0x11, 0xba, 0xcc, 0x59, 0x89, 0x15, 0x54, 0x86, 0x99,
-	0xa0, 0x73, 0xec, 0x3a, 0xd4, 0x64, 0x69, 0xa6, 0x4c, 0x08, 0x89, 0x41, 0xab, 0x8d, 0x28, 0x07,
-	0x2b, 0x99, 0x2f, 0xff, 0xb9, 0x2e, 0xe9, 0x10, 0x92, 0xd8, 0x32, 0x7a, 0x0c, 0xb2, 0xf0, 0xae,
-	0x89, 0x3d, 0x3b, 0xd4, 0x99, 0x1c, 0x53, 0xa7, 0x20, 0x98, 0xaa, 0x67, 0x73, 0x2d, 0x0d, 0xf2,
-	0x94, 0x50, 0xab, 0x65, 0x8a, 0x75, 0x65, 0xea, 0x1a, 0x31, 0xca, 0x71, 0x6a, 0x94, 0x40, 0x4f,
-	0x60, 0xee, 0x8c, 0x50, 0xc7, 0x6b, 0x9a, 0x01, 0xb5, 0x7c, 0x71, 0xbf, 0xe9, 0x31, 0xed, 0x9a,
-	0x0d, 0xa9, 0x0d, 0xc6, 0xe4, 0x86, 0x3d, 0x02, 0xb1, 0x14, 0xdf, 0x31, 0x3b, 0xa6, 0x56, 0x3e,
-	0x24, 0x46, 0x57, 0x5c, 0x66, 0x49, 0x42, 0x2d, 0xdb, 0xa2, 0x96, 0x02, 0x2c, 0x6d, 0xf5, 0xde,
-	0xdf, 0xe8, 0x87, 0x30, 0x41, 0x1d, 0xda, 0xc2, 0xca, 0x0c, 0xcf, 0xe7, 0xf9, 0x6f, 0x5f, 0x6d,
-	0xcf, 0x86, 0x37, 0xdf, 0x0e, 0xec, 0x2f, 0x36, 0x76, 0x8a, 0x3f, 0xfa, 0x89, 0x1e, 0x22, 0xd0,
-	0x36, 0x4c, 0x05, 0x1d, 0xd7, 0xb5, 0xfc, 0xae, 0x92, 0xbb, 0x1c, 0x1c, 0x61, 0xd0, 0x43, 0x98,
-	0x0e, 0x6b, 0x07, 0xfb, 0x4a, 0x9e, 0xe3, 0x3f, 0xba, 0xac, 0x58, 0x46, 0xe9, 0xf4, 0xc8, 0xe8,
-	0x13, 0xc8, 0xe2, 0x8b, 0x36, 0xb6, 0x1d, 0x8a, 0x6d, 0xa5, 0xb0, 0x21, 0x6d, 0x4d, 0x57, 0x16,
-	0x87, 0x18, 0x7b, 0x3b, 0x8a, 0xa4, 0xc7, 0x38, 0xf4, 0x29, 0xe4, 0x5f, 0x5a, 0x4e, 0x0b, 0xdb,
-	0xa6, 0x8f, 0xad, 0x80, 0x78, 0xca, 0xec, 0x25, 0x26, 0xef, 0xed, 0xe8, 0xb9, 0x10, 0xa9, 0x73,
-	0x20, 0xd2, 0x21, 0xdf, 0x6b, 0x03, 0xb4, 0xdb, 0xc6, 0x8a, 0xcc, 0xeb, 0x64, 0xe5, 0x92, 0x3a,
-	0x31, 0xba, 0x6d, 0x5c, 0x91, 0xbf, 0x7d, 0xb5, 0x9d, 0xbb, 0x60, 0x7d, 0x79, 0xe3, 0x6c, 0xa7,
-	0x78, 0xb7, 0xb8, 0xa3, 0xe7, 0xda, 0x89, 0xfd, 0xcd, 0xbf, 0x4a, 0x30, 0x1f, 0x11, 0xe2, 0x6e,
-	0x15, 0xa0, 0x55, 0x80, 0xb0, 0x61, 0x99, 0xc4, 0xc3, 0xbc, 0xac, 0xb3, 0x7a, 0x36, 0x5c, 0xa9,
-	0x79, 0x38, 0xb1, 0x4d, 0xcf, 0x49, 0xd8, 0x71, 0xa2, 0x6d, 0xe3, 0x9c, 0xa0, 0xdb, 0x90, 0x8b,
-	0xb6, 0x4f, 0x7d, 0x8c, 0x79, 0x41, 0x67, 0xf5, 0x19, 0x01, 0x60, 0x4b, 0xac, 0xa7, 0x09, 0xc8,
-	0x4b, 0xd2, 0xf1, 0x79, 0xbd, 0x66, 0x75, 0x21, 0xfa, 0x80, 0x74, 0xfc, 0x04, 0x20, 0x68, 0x5b,
-	0x2e, 0xaf, 0xc6, 0x1e, 0xa0, 0xd1, 0xb6, 0xdc, 0x7b, 0xf2, 0xeb, 0x81, 0xab, 0x6d, 0xfe, 0x37,
-	0x0d, 0x33, 0xc9, 0x82, 0xde, 0x86, 0x6c, 0x17, 0x07, 0xe6, 0x09, 0xef, 0x70, 0xfc, 0x0e, 0x15,
-	0x39, 0xd1, 0x6e, 0x35, 0xb6, 0xaa, 0x4f, 0x77, 0x71, 0xb0, 0xcf, 0x10, 0x68, 0x0f, 0xf2, 0xd6,
-	0x71, 0x40, 0x2d, 0xc7, 0x13, 0x94, 0xd4, 0x25, 0x94, 0x9c, 0x80, 0x85, 0xb4, 0x8f, 0x60, 0xda,
-	0x23, 0x82, 0x91, 0xbe, 0x84, 0x31, 0xe5, 0x91, 0x10, 0x7c, 0x1f, 0x90, 0x47, 0xcc, 0x73, 0x87,
-	0x9e, 0x9a, 0x67, 0x98, 0x46, 0xb4, 0xcc, 0x25, 0xb4, 0x59, 0x8f, 0x3c, 0x73, 0xe8, 0xe9, 0x11,
-	0xa6, 0x82, 0xfe, 0x29, 0xc8, 0x71, 0x58, 0x04, 0x79, 0x62, 0xe8, 0x1d, 0xd1, 0x3c, 0xaa, 0x17,
-	0x7a, 0xc1, 0x1a, 0x64, 0xd2, 0xf3, 0xe8, 0xd8, 0xc9, 0xf7, 0x31, 0x8d, 0x73, 0x71, 0xe6, 0x67,
-	0x80, 0x92, 0xc1, 0x14, 0xdc, 0xa9, 0x91, 0x5c, 0x39, 0x11, 0xe2, 0x90, 0x7d, 0x0f, 0xe6, 0x12,
-	0x71, 0x16, 0xe4, 0xe9, 0x91, 0xe4, 0xd9, 0x38, 0xfa, 0x21, 0x77, 0x1b, 0x80, 0xc5, 0x5e, 0x90,
-	0xb2, 0x23, 0x49, 0x59, 0x86, 0xe0, 0xf0, 0xcd, 0x3f, 0x49, 0x90, 0x61, 0x39, 0x7c, 0xf5, 0x7b,
-	0x59, 0x84, 0x89, 0x33, 0x42, 0xf1, 0xd5, 0x6f, 0x65, 0x08, 0x43, 0x3f, 0x83, 0xa9, 0xd0, 0xb6,
-	0x40, 0xc9, 0xf0, 0x26, 0x7c, 0x7b, 0xa0, 0xe6, 0x86, 0x67, 0x03, 0x3d, 0x62, 0xf4, 0x35, 0xb9,
-	0x89, 0xfe, 0x26, 0xf7, 0x38, 0x33, 0x9d, 0x96, 0x33, 0x9b, 0xff, 0x90, 0x20, 0x2f, 0x5a, 0x75,
-	0xdd, 0xf2, 0x2d, 0x37, 0x40, 0x2f, 0x60, 0xc6, 0x75, 0xbc, 0x5e, 0xe7, 0x97, 0xae, 0xea, 0xfc,
-	0xab, 0xac, 0xf3, 0x7f, 0xf7, 0x66, 0x7d, 0x31, 0xc1, 0xfa, 0x98, 0xb8, 0x0e, 0xc5, 0x6e, 0x9b,
-	0x76, 0x75, 0x70, 0x1d, 0x2f, 0x7a, 0x0b, 0x5c, 0x40, 0xae, 0x75, 0x11, 0x81, 0xcc, 0x36, 0xf6,
-	0x1d, 0x62, 0x73, 0x47, 0xb0, 0x13, 0x06, 0x1b, 0x78, 0x55, 0x0c, 0x4d, 0x95, 0x1f, 0x7c, 0xf7,
-	0x66, 0xfd, 0x83, 0x61, 0x62, 0x7c, 0xc8, 0x6f, 0x59, 0x7f, 0x97, 0x5d, 0xeb, 0x22, 0xba, 0x09,
-	0xdf, 0xbf, 0x97, 0x52, 0xa4, 0xcd, 0xe7, 0x90, 0x3b, 0xe2, 0x7d, 0x5f, 0xdc, 0xae, 0x0a, 0xe2,
-	0x1d, 0x88, 0x4e, 0x97, 0xae, 0x3a, 0x3d, 0xc3, 0xd5, 0x73, 0x21, 0x2b, 0xa1, 0xfc, 0x3b, 0x49,
-	0x54, 0xbc, 0x50, 0xfe, 0x10, 0x26, 0x7f, 0xdd, 0x21, 0x7e, 0xc7, 0x15, 0xe5, 0x3e, 0x34, 0x5d,
-	0x85, 0xbb, 0xe8, 0x63, 0xc8, 0xb2, 0x64, 0x0e, 0x4e, 0x49, 0xcb, 0xbe, 0x64, 0x10, 0x8b, 0x01,
-	0x68, 0x0f, 0x0a, 0xbc, 0x58, 0x63, 0x4a, 0x7a, 0x24, 0x25, 0xcf, 0x50, 0x46, 0x04, 0xe2, 0x06,
-	0xfe, 0x25, 0x0f, 0x93, 0xc2, 0x36, 0xf5, 0x9a, 0x31, 0x4d, 0xbc, 0xe6, 0xc9, 0xf8, 0x1d, 0x7c,
-	0xbf, 0xf8, 0x65, 0x46, 0xc7, 0x67, 0x38, 0x16, 0xe9, 0xef, 0x11, 0x8b, 0x84, 0xdf, 0x33, 0xe3,
-	0xfb, 0x7d, 0xe2, 0xfa, 0x7e, 0x9f, 0x1c, 0xc3, 0xef, 0x48, 0x83, 0x5b, 0xcc, 0xd1, 0x8e, 0xe7,
-	0x50, 0x27, 0x1e, 0x9f, 0x4c, 0x6e, 0xfe, 0x88, 0xbe, 0xc5, 0x14, 0x6e, 0xba, 0x8e, 0xa7, 0x85,
-	0x78, 0xe1, 0x1e, 0x9d, 0xa1, 0xd1, 0x53, 0x58, 0xec, 0x75, 0x92, 0x13, 0xcb, 0x3b, 0xc1, 0x2d,
-	0x21, 0x13, 0x76, 0xb0, 0xdb, 0xfd, 0x32, 0xa3, 0x9e, 0xf0, 0xf9, 0x88, 0xbf, 0xcf, 0xe9, 0xa1,
-	0xec, 0xaf, 0x60, 0x61, 0x50, 0xd6, 0xc6, 0x41, 0xd4, 0xe2, 0xc6, 0x9f, 0x46, 0xf6, 0x76, 0x74,
-	0xd4, 0xaf, 0x5f, 0xc5, 0x01, 0x45, 0x9f, 0xc3, 0x52, 0x6f, 0xde, 0x30, 0xfb, 0xa3, 0x0b, 0x57,
-	0x45, 0x77, 0x89, 0x45, 0x77, 0xd4, 0x41, 0x8b, 0x3d, 0xc9, 0xa3, 0x64, 0xe4, 0x75, 0x98, 0x8f,
-	0xcf, 0x8a, 0x03, 0x35, 0x33, 0xae, 0x7f, 0x50, 0x8f, 0x1d, 0x07, 0xf0, 0x39, 0xc4, 0x87, 0x99,
-	0xc9, 0x9a, 0xc9, 0x5d, 0xa3, 0x66, 0x62, 0xb3, 0x0e, 0xe2, 0xe2, 0xb9, 0x0f, 0xf2, 0x71, 0xc7,
-	0xf7, 0x98, 0x53, 0xb0, 0x29, 0x32, 0x36, 0xcf, 0x07, 0xb7, 0x91, 0x23, 0x63, 0x81, 0x81, 0x59,
-	0x4f, 0xff, 0x79, 0x98, 0xbe, 0x47, 0xb0, 0xca, 0xe9, 0xbd, 0xe0, 0xf5, 0xaa, 0xd0, 0xc7, 0x4c,
-	0x52, 0x0c, 0x81, 0x23, 0xb5, 0x96, 0x19, 0x33, 0x1a, 0xb5, 0xa2, 0x1a, 0x0c, 0x69, 0xe8, 0xa7,
-	0x50, 0x88, 0xcd, 0x62, 0xc9, 0xcc, 0x87, 0xc2, 0x4b, 0x84, 0x72, 0x91, 0x51, 0x6c, 0x2c, 0x40,
-	0x07, 0x30, 0x97, 0xf0, 0x90, 0xc8, 0x4e, 0x79, 0x5c, 0xef, 0xcf, 0xc6, 0x8d, 0x25, 0xcc, 0xcc,
-	0x5f, 0xc2, 0xf2, 0x60, 0x66, 0xb2, 0x6e, 0x23, 0xb2, 0x67, 0x8e, 0xeb, 0xae, 0x0d, 0xe9, 0xf6,
-	0x4f, 0x98, 0x4b, 0xfd, 0x29, 0x79, 0x60, 0x5d, 0x88, 0x5c, 0x69, 0xc3, 0x3a, 0x7b, 0x14, 0x5d,
-	0x27, 0xa0, 0xce, 0x89, 0x69, 0x75, 0xe8, 0x29, 0xf1, 0x9d, 0xdf, 0x60, 0xdb, 0xb4, 0xc2, 0x2c,
-	0xc7, 0x81, 0x82, 0x36, 0xd2, 0x5b, 0xd9, 0xca, 0xd6, 0x7b, 0x2a, 0xa0, 0xff, 0xac, 0xd5, 0x58,
-	0xb0, 0xdc, 0xd3, 0x2b, 0x47, 0x72, 0xe8, 0x18, 0x12, 0x00, 0xd3, 0xc7, 0x9f, 0xe3, 0x93, 0xfe,
-	0x3c, 0x9d, 0x1f, 0xeb, 0x46, 0x2b, 0xb1, 0x88, 0x2e, 0x34, 0xe2, 0x6c, 0xbd, 0x0f, 0xc0, 0xa6,
-	0x4c, 0x91, 0x4d, 0x0b, 0x63, 0x09, 0xb2, 0xb9, 0x54, 0xe4, 0x94, 0x06, 0x72, 0x9c, 0xec, 0x42,
-	0x64, 0xf1, 0x0a, 0x91, 0xdd, 0xe2, 0x4e, 0x71, 0x47, 0x9f, 0xed, 0xf1, 0x84, 0xd4, 0x03, 0xb8,
-	0xd9, 0x0b, 0x1e, 0xbe, 0xc0, 0x27, 0x1d, 0x3e, 0x77, 0x35, 0xad, 0x40, 0xb9, 0xc9, 0x46, 0xa0,
-	0x11, 0x5f, 0x06, 0x7a, 0x6d, 0x48, 0x8d, 0xe0, 0x0f, 0xad, 0xe0, 0xde, 0xfc, 0xeb, 0xe1, 0xb4,
-	0xdb, 0xfc, 0x2a, 0x05, 0xe8, 0x20, 0xfc, 0xae, 0x5e, 0xb1, 0x02, 0x6c, 0xff, 0x3f, 0xdf, 0xf2,
-	0xc4, 0xfb, 0x91, 0x7a, 0xef, 0xfb, 0xb1, 0x3d, 0xc2, 0xd7, 0x43, 0x0f, 0x48, 0xec, 0xdb, 0xbe,
-	0xe7, 0x26, 0x7d, 0xfd, 0xe7, 0x26, 0x33, 0xce, 0x33, 0x3f, 0xf4, 0x3d, 0xe4, 0xce, 0x1f, 0x24,
-	0xc8, 0x25, 0xbf, 0x85, 0xa1, 0x55, 0xb8, 0x55, 0xd7, 0x6b, 0xf5, 0x5a, 0xa3, 0xfc, 0xc4, 0x34,
-	0x5e, 0xd4, 0x55, 0xf3, 0xe9, 0x61, 0xa3, 0xae, 0xee, 0x6b, 0x0f, 0x34, 0xb5, 0x2a, 0xdf, 0x40,
-	0xcb, 0x70, 0xb3, 0x7f, 0xbb, 0x61, 0x94, 0x0f, 0xab, 0x65, 0xbd, 0x2a, 0x4b, 0xe8, 0x36, 0xac,
-	0xf6, 0xef, 0x1d, 0x3c, 0x7d, 0x62, 0x68, 0xf5, 0x27, 0xaa, 0xb9, 0xff, 0xa8, 0xa6, 0xed, 0xab,
-	0x72, 0x0a, 0x7d, 0x00, 0x4a, 0x3f, 0xa4, 0x56, 0x37, 0xb4, 0x03, 0xad, 0x61, 0x68, 0xfb, 0x72,
-	0x1a, 0xad, 0xc0, 0x52, 0xff, 0xae, 0xfa, 0xbc, 0xae, 0x56, 0x35, 0x43, 0xad, 0xca, 0x99, 0x3b,
-	0xff, 0x91, 0x00, 0x12, 0xbf, 0x67, 0xad, 0xc0, 0xd2, 0x51, 0xcd, 0x08, 0x05, 0x6a, 0x87, 0x03,
-	0x56, 0xce, 0xc3, 0x6c, 0x72, 0xf3, 0x85, 0xda, 0x90, 0xa5, 0xc1, 0xc5, 0xda, 0xa1, 0x2a, 0x4b,
-	0x68, 0x09, 0xe6, 0x93, 0x8b, 0xe5, 0x4a, 0xc3, 0x28, 0x6b, 0x87, 0x72, 0x6a, 0x10, 0x6d, 0x3c,
-	0xab, 0xc9, 0x29, 0x84, 0xa0, 0x90, 0x5c, 0x3c, 0xac, 0xc9, 0x69, 0xb4, 0x08, 0x73, 0x7d, 0xc0,
-	0x47, 0xba, 0xaa, 0xca, 0x69, 0x76, 0xd3, 0x7e, 0xa8, 0xf9, 0x4c, 0x33, 0x1e, 0x99, 0x47, 0xaa,
-	0x51, 0x93, 0x33, 0x68, 0x01, 0xe4, 0xe4, 0xee, 0x83, 0xda, 0x53, 0x7d, 0x78, 0xb5, 0x51, 0x2f,
-	0x1f, 0xc8, 0x13, 0xcb, 0x29, 0x59, 0xba, 0xf3, 0x37, 0x09, 0x0a, 0xfd, 0x3f, 0x2a, 0xa1, 0x75,
-	0x58, 0xe9, 0x39, 0xab, 0x61, 0x94, 0x8d, 0xa7, 0x8d, 0x01, 0x27, 0x6c, 0xc2, 0xda, 0x20, 0xa0,
-	0xaa, 0xd6, 0x6b, 0x0d, 0xcd, 0x30, 0xeb, 0xaa, 0xae, 0xd5, 0x06, 0x43, 0x26, 0x30, 0x47, 0x35,
-	0x43, 0x3b, 0x7c, 0x18, 0x41, 0x52, 0x7d, 0x11, 0x17, 0x90, 0x7a, 0xb9, 0xd1, 0x50, 0xab, 0xe1,
-	0x25, 0x07, 0xf7, 0x74, 0xf5, 0xb1, 0xba, 0xcf, 0x23, 0x36, 0x8a, 0xf9, 0xa0, 0xac, 0x3d, 0x51,
-	0xab, 0xf2, 0x44, 0x65, 0xef, 0xeb, 0xb7, 0x6b, 0xd2, 0x37, 0x6f, 0xd7, 0xa4, 0x7f, 0xbd, 0x5d,
-	0x93, 0xbe, 0x7c, 0xb7, 0x76, 0xe3, 0x9b, 0x77, 0x6b, 0x37, 0xfe, 0xfe, 0x6e, 0xed, 0xc6, 0x2f,
-	0x56, 0xc2, 0xf4, 0x0d, 0xec, 0x2f, 0x8a, 0x0e, 0x29, 0xf1, 0x64, 0x2d, 0xd1, 0x6e, 0x1b, 0x07,
-	0xa5, 0xb3, 0xdd, 0xe3, 0x49, 0x5e, 0xa3, 0x9f, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x10, 0x4b,
-	0xae, 0xb9, 0xcc, 0x15, 0x00, 0x00,
+	// 2131 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0x0f, 0x25, 0x59, 0xb6, 0x9e, 0x25, 0x99, 0x1e, 0xdb, 0x31, 0x6d, 0xaf, 0x65, 0x47, 0x28,
+	0x16, 0x6e, 0x76, 0x2d, 0xdb, 0xd9, 0xba, 0xdd, 0xa6, 0x9b, 0x83, 0x64, 0x31, 0x09, 0x83, 0xd8,
+	0x52, 0x29, 0xc6, 0x49, 0x5a, 0x2c, 0x08, 0x5a, 0x9c, 0xd8, 0xdc, 0x15, 0x49, 0x95, 0xa4, 0xfc,
+	0xa7, 0x9f, 0x62, 0x81, 0x5e, 0x7a, 0x69, 0xd1, 0x5b, 0xf7, 0xd8, 0x43, 0xd0, 0xcf, 0xb0, 0xe8,
+	0xa1, 0x58, 0xe4, 0xd4, 0x2e, 0xd0, 0xb4, 0x48, 0x0e, 0x05, 0xf6, 0x23, 0x14, 0x3d, 0x2c, 0xe6,
+	0x0f, 0x45, 0x52, 0x92, 0x63, 0x25, 0xc8, 0x25, 0xa1, 0x66, 0x7e, 0xbf, 0xdf, 0xbc, 0x99, 0xf7,
+	0xe6, 0xbd, 0x47, 0x1a, 0x16, 0xdb, 0xae, 0x6f, 0xbb, 0xfe, 0xd6, 0xb1, 0x7b, 0xba, 0x75, 0xba,
+	0x43, 0xfe, 0xab, 0x74, 0x3d, 0x37, 0x70, 0x51, 0x81, 0x4d, 0x54, 0xc8, 0xc8, 0xe9, 0xce, 0x72,
+	0x89, 0xe3, 0x8e, 0x0c, 0x1f, 0x6f, 0x9d, 0xee, 0x1c, 0xe1, 0xc0, 0xd8, 0xd9, 0x6a, 0xbb, 0x96,
+	0xc3, 0xe0, 0xcb, 0xf3, 0xc7, 0xee, 0xb1, 0x4b, 0x1f, 0xb7, 0xc8, 0x13, 0x1f, 0x5d, 0x3b, 0x76,
+	0xdd, 0xe3, 0x0e, 0xde, 0xa2, 0xbf, 0x8e, 0x7a, 0xcf, 0xb6, 0x02, 0xcb, 0xc6, 0x7e, 0x60, 0xd8,
+	0x5d, 0x0e, 0x58, 0x1a, 0x04, 0x18, 0xce, 0x05, 0x9f, 0x2a, 0x0d, 0x4e, 0x99, 0x3d, 0xcf, 0x08,
+	0x2c, 0x37, 0x5c, 0x71, 0x89, 0x59, 0xa4, 0xb3, 0x45, 0xb9, 0xb5, 0x6c, 0x6a, 0xd6, 0xb0, 0x2d,
+	0xc7, 0xdd, 0xa2, 0xff, 0xb2, 0xa1, 0xb2, 0x0b, 0xe8, 0x31, 0xb6, 0x8e, 0x4f, 0x02, 0x6c, 0x1e,
+	0xba, 0x01, 0x6e, 0x74, 0x89, 0x12, 0xda, 0x81, 0xac, 0x4b, 0x9f, 0x24, 0x61, 0x5d, 0xd8, 0x28,
+	0xde, 0x5a, 0xaa, 0x24, 0x76, 0x5d, 0x89, 0xa0, 0x2a, 0x07, 0xa2, 0x0f, 0x21, 0x7b, 0x46, 0x85,
+	0xa4, 0xd4, 0xba, 0xb0, 0x91, 0xab, 0x15, 0x5f, 0x3c, 0xdf, 0x04, 0xce, 0xaa, 0xe3, 0xb6, 0xca,
+	0x67, 0xcb, 0x7f, 0x12, 0x60, 0xb2, 0x8e, 0xbb, 0xae, 0x6f, 0x05, 0x68, 0x0d, 0xa6, 0xbb, 0x9e,
+	0xdb, 0x75, 0x7d, 0xa3, 0xa3, 0x5b, 0x26, 0x5d, 0x2b, 0xa3, 0x42, 0x38, 0xa4, 0x98, 0xe8, 0xa7,
+	0x90, 0x33, 0x19, 0xd6, 0xf5, 0xb8, 0xae, 0xf4, 0xe2, 0xf9, 0xe6, 0x3c, 0xd7, 0xad, 0x9a, 0xa6,
+	0x87, 0x7d, 0xbf, 0x15, 0x78, 0x96, 0x73, 0xac, 0x46, 0x50, 0xf4, 0x19, 0x64, 0x0d, 0xdb, 0xed,
+	0x39, 0x81, 0x94, 0x5e, 0x4f, 0x6f, 0x4c, 0x47, 0xf6, 0x13, 0x37, 0x55, 0xb8, 0x9b, 0x2a, 0x7b,
+	0xae, 0xe5, 0xd4, 0x72, 0xdf, 0xbc, 0x5c, 0xbb, 0xf6, 0xf5, 0x7f, 0xff, 0x72, 0x53, 0x50, 0x39,
+	0xa7, 0xfc, 0x75, 0x0e, 0xa6, 0x9a, 0xdc, 0x08, 0x54, 0x84, 0x54, 0xdf, 0xb4, 0x94, 0x65, 0xa2,
+	0x6d, 0x98, 0xb2, 0xb1, 0xef, 0x1b, 0xc7, 0xd8, 0x97, 0x52, 0x54, 0x7c, 0xbe, 0xc2, 0x3c, 0x52,
+	0x09, 0x3d, 0x52, 0xa9, 0x3a, 0x17, 0x6a, 0x1f, 0x85, 0x76, 0x21, 0xeb, 0x07, 0x46, 0xd0, 0xf3,
+	0xa5, 0x34, 0x3d, 0xcc, 0xd5, 0x81, 0xc3, 0x0c, 0x97, 0x6a, 0x51, 0x90, 0xca, 0xc1, 0xe8, 0x3e,
+	0xa0, 0x67, 0x96, 0x63, 0x74, 0xf4, 0xc0, 0xe8, 0x74, 0x2e, 0x74, 0x0f, 0xfb, 0xbd, 0x4e, 0x20,
+	0x65, 0xd6, 0x85, 0x8d, 0xe9, 0x5b, 0xcb, 0x03, 0x12, 0x1a, 0x81, 0xa8, 0x14, 0xa1, 0x8a, 0x94,
+	0x15, 0x1b, 0x41, 0x55, 0x98, 0xf6, 0x7b, 0x47, 0xb6, 0x15, 0xe8, 0x24, 0xcc, 0xa4, 0x09, 0x2e,
+	0x31, 0x68, 0xb5, 0x16, 0xc6, 0x60, 0x2d, 0xf3, 0xd5, 0xbf, 0xd7, 0x04, 0x15, 0x18, 0x89, 0x0c,
+	0xa3, 0x07, 0x20, 0xf2, 0xd3, 0xd5, 0xb1, 0x63, 0x32, 0x9d, 0xec, 0x98, 0x3a, 0x45, 0xce, 0x94,
+	0x1d, 0x93, 0x6a, 0x29, 0x50, 0x08, 0xdc, 0xc0, 0xe8, 0xe8, 0x7c, 0x5c, 0x9a, 0x7c, 0x0b, 0x1f,
+	0xe5, 0x29, 0x35, 0x0c, 0xa0, 0x87, 0x30, 0x7b, 0xea, 0x06, 0x96, 0x73, 0xac, 0xfb, 0x81, 0xe1,
+	0xf1, 0xfd, 0x4d, 0x8d, 0x69, 0xd7, 0x0c, 0xa3, 0xb6, 0x08, 0x93, 0x1a, 0x76, 0x1f, 0xf8, 0x50,
+	0xb4, 0xc7, 0xdc, 0x98, 0x5a, 0x05, 0x46, 0x0c, 0xb7, 0xb8, 0x4c, 0x82, 0x24, 0x30, 0x4c, 0x23,
+	0x30, 0x24, 0x20, 0x61, 0xab, 0xf6, 0x7f, 0xa3, 0x1f, 0xc3, 0x44, 0x60, 0x05, 0x1d, 0x2c, 0x4d,
+	0xd3, 0x78, 0x9e, 0xfb, 0xee, 0xf9, 0xe6, 0x0c, 0xdb, 0xf9, 0xa6, 0x6f, 0x7e, 0xb9, 0xbe, 0x5d,
+	0xf9, 0xc9, 0xcf, 0x54, 0x86, 0x40, 0x9b, 0x30, 0xe9, 0xf7, 0x6c, 0xdb, 0xf0, 0x2e, 0xa4, 0xfc,
+	0xe5, 0xe0, 0x10, 0x83, 0xee, 0xc1, 0x14, 0xbb, 0x3b, 0xd8, 0x93, 0x0a, 0x14, 0xff, 0xd1, 0x65,
+	0x97, 0x65, 0x94, 0x4e, 0x9f, 0x8c, 0x3e, 0x81, 0x1c, 0x3e, 0xef, 0x62, 0xd3, 0x0a, 0xb0, 0x29,
+	0x15, 0xd7, 0x85, 0x8d, 0xa9, 0xda, 0xc2, 0x10, 0x63, 0x77, 0x5b, 0x12, 0xd4, 0x08, 0x87, 0x3e,
+	0x85, 0xc2, 0x33, 0xc3, 0xea, 0x60, 0x53, 0xf7, 0xb0, 0xe1, 0xbb, 0x8e, 0x34, 0x73, 0x89, 0xc9,
+	0xbb, 0xdb, 0x6a, 0x9e, 0x21, 0x55, 0x0a, 0x44, 0x2a, 0x14, 0xfa, 0x69, 0x20, 0xb8, 0xe8, 0x62,
+	0x49, 0xa4, 0xf7, 0x64, 0xe5, 0x92, 0x7b, 0xa2, 0x5d, 0x74, 0x71, 0x4d, 0xfc, 0xee, 0xf9, 0x66,
+	0xfe, 0x9c, 0xe4, 0xe5, 0xf5, 0xd3, 0xed, 0xca, 0xad, 0xca, 0xb6, 0x9a, 0xef, 0xc6, 0xe6, 0xd1,
+	0x2e, 0x14, 0xda, 0xae, 0x4d, 0x62, 0xde, 0xc3, 0xa7, 0xd8, 0xe8, 0x48, 0xb3, 0x74, 0x1b, 0x71,
+	0xda, 0x4e, 0x65, 0x9b, 0xd0, 0x18, 0x4c, 0xa5, 0x28, 0xf4, 0x04, 0x66, 0x38, 0xad, 0x1f, 0x02,
+	0xe8, 0xca, 0x10, 0x98, 0x27, 0x21, 0x30, 0x24, 0xcc, 0xd7, 0x0f, 0x43, 0x42, 0x83, 0x02, 0xb3,
+	0x44, 0xef, 0x62, 0xcf, 0x72, 0x4d, 0x69, 0x8e, 0xea, 0x2e, 0x0d, 0xe9, 0xd6, 0x79, 0x3a, 0xaf,
+	0xcd, 0xff, 0x7e, 0x94, 0x6c, 0x9e, 0xa9, 0x34, 0xa9, 0x48, 0xf9, 0x6f, 0x02, 0xcc, 0x85, 0xe7,
+	0x12, 0x25, 0x65, 0x1f, 0xad, 0x02, 0xb0, 0xbc, 0xac, 0xbb, 0x0e, 0xa6, 0xd9, 0x2b, 0xa7, 0xe6,
+	0xd8, 0x48, 0xc3, 0xc1, 0xb1, 0xe9, 0xe0, 0xcc, 0x95, 0x52, 0xf1, 0x69, 0xed, 0xcc, 0x45, 0x37,
+	0x20, 0x1f, 0x4e, 0x9f, 0x78, 0x18, 0xd3, 0xbc, 0x95, 0x53, 0xa7, 0x39, 0x80, 0x0c, 0x91, 0xd4,
+	0xcd, 0x21, 0xcf, 0xdc, 0x9e, 0x47, 0xd3, 0x52, 0x4e, 0xe5, 0xa2, 0x77, 0xdd, 0x9e, 0x17, 0x03,
+	0xf8, 0x5d, 0xc3, 0x96, 0x26, 0xe2, 0x80, 0x56, 0xd7, 0xb0, 0x6f, 0x8b, 0x2f, 0x06, 0x3c, 0x58,
+	0xfe, 0x7f, 0x1a, 0xa6, 0xe3, 0x79, 0x6b, 0x13, 0x72, 0x17, 0xd8, 0xd7, 0xdb, 0x34, 0x91, 0xd3,
+	0x3d, 0xd4, 0xc4, 0x58, 0x55, 0x51, 0xc8, 0xa8, 0x3a, 0x75, 0x81, 0xfd, 0x3d, 0x82, 0x20, 0x2e,
+	0x37, 0x8e, 0xfc, 0xc0, 0xb0, 0x1c, 0x4e, 0x49, 0x5d, 0x42, 0xc9, 0x73, 0x18, 0xa3, 0x7d, 0x04,
+	0x53, 0x8e, 0xcb, 0x19, 0xe9, 0x4b, 0x18, 0x93, 0x8e, 0xcb, 0xc0, 0x77, 0x00, 0x39, 0xae, 0x7e,
+	0x66, 0x05, 0x27, 0xfa, 0x29, 0x0e, 0x42, 0x5a, 0xe6, 0x12, 0xda, 0x8c, 0xe3, 0x3e, 0xb6, 0x82,
+	0x93, 0x43, 0x1c, 0x70, 0xfa, 0xa7, 0x20, 0x46, 0x6e, 0xe1, 0xe4, 0x89, 0xa1, 0x72, 0xa9, 0x38,
+	0x81, 0x5a, 0xec, 0x3b, 0x6b, 0x90, 0x19, 0x9c, 0x85, 0xcb, 0x66, 0xdf, 0xc4, 0xd4, 0xce, 0xf8,
+	0x9a, 0x9f, 0x01, 0x8a, 0x3b, 0x93, 0x73, 0x27, 0x47, 0x72, 0xc5, 0x98, 0x8b, 0x19, 0xfb, 0x36,
+	0xcc, 0xc6, 0xfc, 0xcc, 0xc9, 0x53, 0x23, 0xc9, 0x33, 0x91, 0xf7, 0x19, 0x77, 0x13, 0x80, 0xf8,
+	0x9e, 0x93, 0x72, 0x23, 0x49, 0x39, 0x82, 0xa0, 0xf0, 0xf2, 0x5f, 0x05, 0xc8, 0x90, 0x18, 0xbe,
+	0xba, 0x2d, 0xa8, 0xc0, 0xc4, 0xa9, 0x1b, 0xe0, 0xab, 0x5b, 0x02, 0x06, 0x43, 0xbf, 0x80, 0x49,
+	0x66, 0x9b, 0x2f, 0x65, 0x68, 0xad, 0xb9, 0x31, 0x90, 0x5a, 0x86, 0x5b, 0x20, 0x35, 0x64, 0x24,
+	0x72, 0xf9, 0x44, 0x32, 0x97, 0x3f, 0xc8, 0x4c, 0xa5, 0xc5, 0x4c, 0xf9, 0x77, 0x02, 0x14, 0x09,
+	0x73, 0x8f, 0x5e, 0x78, 0x1b, 0x3b, 0xc1, 0xfb, 0xdf, 0x42, 0x09, 0xa0, 0xdd, 0x97, 0xa7, 0x71,
+	0x9a, 0x57, 0x63, 0x23, 0x89, 0xdb, 0x44, 0x13, 0x45, 0xf9, 0x5f, 0x02, 0x14, 0x78, 0x9d, 0x6c,
+	0x1a, 0x9e, 0x61, 0xfb, 0xe8, 0x29, 0x4c, 0xdb, 0x96, 0xd3, 0x2f, 0xbb, 0xc2, 0x55, 0x65, 0x77,
+	0x95, 0x94, 0xdd, 0xef, 0x5f, 0xae, 0x2d, 0xc4, 0x58, 0x1f, 0xbb, 0xb6, 0x15, 0x60, 0xbb, 0x1b,
+	0x5c, 0xa8, 0x60, 0x5b, 0x4e, 0x58, 0x88, 0x6d, 0x40, 0xb6, 0x71, 0x1e, 0x82, 0xc2, 0x14, 0x97,
+	0xba, 0x2a, 0xc5, 0xfd, 0xe8, 0xfb, 0x97, 0x6b, 0x1f, 0x0c, 0x13, 0xa3, 0x45, 0x48, 0x0a, 0x54,
+	0x45, 0xdb, 0x38, 0x0f, 0x77, 0x42, 0xe7, 0x6f, 0xa7, 0x24, 0xa1, 0xfc, 0x04, 0xf2, 0x87, 0xb4,
+	0xe8, 0xf2, 0xdd, 0xd5, 0x81, 0x17, 0xe1, 0x70, 0x75, 0xe1, 0xaa, 0xd5, 0x33, 0x54, 0x3d, 0xcf,
+	0x58, 0x31, 0xe5, 0x3f, 0x0a, 0x3c, 0x0f, 0x71, 0xe5, 0x0f, 0x21, 0xfb, 0x9b, 0x9e, 0xeb, 0xf5,
+	0x6c, 0x49, 0x18, 0x8a, 0x61, 0xda, 0xda, 0xb2, 0x59, 0xf4, 0x31, 0xe4, 0xc8, 0x15, 0xf3, 0x4f,
+	0xdc, 0x8e, 0x79, 0x49, 0x17, 0x1c, 0x01, 0xd0, 0x2e, 0x14, 0x69, 0x0a, 0x89, 0x28, 0xe9, 0x91,
+	0x94, 0x02, 0x41, 0x69, 0x21, 0x88, 0x1a, 0xf8, 0x87, 0x22, 0x64, 0xb9, 0x6d, 0xf2, 0x5b, 0xfa,
+	0x34, 0xd6, 0x4a, 0xc5, 0xfd, 0xb7, 0xff, 0x6e, 0xfe, 0xcb, 0x8c, 0xf6, 0xcf, 0xb0, 0x2f, 0xd2,
+	0xef, 0xe0, 0x8b, 0xd8, 0xb9, 0x67, 0xc6, 0x3f, 0xf7, 0x89, 0xb7, 0x3f, 0xf7, 0xec, 0x18, 0xe7,
+	0x8e, 0x14, 0x58, 0x22, 0x07, 0x6d, 0x39, 0x56, 0x60, 0x45, 0xbd, 0xab, 0x4e, 0xcd, 0x97, 0x26,
+	0x47, 0x2a, 0x5c, 0xb7, 0x2d, 0x47, 0x61, 0x78, 0x7e, 0x3c, 0x2a, 0x41, 0xa3, 0x47, 0xb0, 0xd0,
+	0x4f, 0x0e, 0x6d, 0xc3, 0x69, 0xe3, 0x0e, 0x97, 0x61, 0x79, 0xf5, 0x46, 0x52, 0x66, 0x54, 0xff,
+	0x34, 0x17, 0xf2, 0xf7, 0x28, 0x9d, 0xc9, 0x7e, 0x0e, 0xf3, 0x83, 0xb2, 0x26, 0xf6, 0xc3, 0xc4,
+	0x3b, 0x7e, 0x2b, 0xb8, 0xbb, 0xad, 0xa2, 0xa4, 0x7e, 0x1d, 0xfb, 0x01, 0xfa, 0x02, 0x16, 0xfb,
+	0xcd, 0x9e, 0x9e, 0xf4, 0x2e, 0x5c, 0xe5, 0xdd, 0x45, 0xde, 0xca, 0x0c, 0x2d, 0xb4, 0xd0, 0x97,
+	0x3c, 0x8c, 0x7b, 0x5e, 0x85, 0xb9, 0x68, 0xad, 0xc8, 0x51, 0xd3, 0xe3, 0x9e, 0x0f, 0xea, 0xb3,
+	0x23, 0x07, 0x3e, 0x81, 0x68, 0x31, 0x3d, 0x7e, 0x67, 0xf2, 0x6f, 0x71, 0x67, 0x22, 0xb3, 0xf6,
+	0xa3, 0xcb, 0x73, 0x07, 0xc4, 0xa3, 0x9e, 0xe7, 0x90, 0x43, 0xc1, 0x3a, 0x8f, 0xd8, 0x02, 0x6d,
+	0x37, 0x47, 0xf6, 0xeb, 0x45, 0x02, 0x26, 0xf5, 0xe2, 0x97, 0x2c, 0x7c, 0x0f, 0x61, 0x95, 0xd2,
+	0xfb, 0xce, 0xeb, 0xdf, 0x42, 0x0f, 0x13, 0x49, 0xa9, 0x78, 0xb9, 0xd6, 0x32, 0x61, 0x86, 0x0d,
+	0x60, 0x78, 0x07, 0x19, 0x0d, 0xfd, 0x1c, 0x8a, 0x91, 0x59, 0x24, 0x98, 0xa5, 0x99, 0xcb, 0x85,
+	0xf2, 0xa1, 0x51, 0xa4, 0x59, 0x41, 0xfb, 0x30, 0x1b, 0x3b, 0x21, 0x1e, 0x9d, 0xe2, 0xb8, 0xa7,
+	0x3f, 0x13, 0x25, 0x16, 0x16, 0x99, 0xbf, 0x86, 0xe5, 0xc1, 0xc8, 0x24, 0xd9, 0x86, 0x47, 0xcf,
+	0x2c, 0xd5, 0x2d, 0x0d, 0xe9, 0x26, 0xdb, 0xfb, 0xc5, 0x64, 0x48, 0xee, 0x1b, 0xe7, 0x3c, 0x56,
+	0xba, 0xb0, 0x46, 0x4a, 0xb5, 0x6d, 0xf9, 0x81, 0xd5, 0xd6, 0x8d, 0x5e, 0x70, 0xe2, 0x7a, 0xd6,
+	0x6f, 0xb1, 0xa9, 0x1b, 0x2c, 0xca, 0xb1, 0x2f, 0xa1, 0xf5, 0xf4, 0x46, 0xae, 0xb6, 0xf1, 0x86,
+	0x1b, 0x90, 0x5c, 0x6b, 0x35, 0x12, 0xac, 0xf6, 0xf5, 0xaa, 0xa1, 0x1c, 0x3a, 0x82, 0x18, 0x40,
+	0xf7, 0xf0, 0x17, 0xb8, 0x9d, 0x8c, 0xd3, 0xb9, 0xb1, 0x76, 0xb4, 0x12, 0x89, 0xa8, 0x5c, 0x23,
+	0x8a, 0xd6, 0x3b, 0x00, 0xa4, 0xf7, 0xe5, 0xd1, 0x34, 0x3f, 0x96, 0x20, 0xe9, 0x96, 0x79, 0x4c,
+	0x29, 0x20, 0x46, 0xc1, 0xce, 0x45, 0x16, 0xae, 0x10, 0x61, 0xef, 0x17, 0x33, 0x7d, 0x1e, 0x97,
+	0xba, 0x0b, 0xd7, 0xfb, 0xce, 0xc3, 0xe7, 0xb8, 0xdd, 0xa3, 0xdd, 0xe0, 0xb1, 0xe1, 0x4b, 0xd7,
+	0x49, 0x57, 0x33, 0xe2, 0x4d, 0xac, 0x9f, 0x86, 0xe4, 0x10, 0x7e, 0xcf, 0xf0, 0x87, 0x5f, 0x80,
+	0x16, 0xdf, 0xc3, 0x0b, 0xd0, 0xed, 0xb9, 0x17, 0xc3, 0xc1, 0x5c, 0xfe, 0x67, 0x1a, 0xd0, 0x3e,
+	0xfb, 0xfc, 0x52, 0x33, 0x7c, 0x6c, 0xbe, 0xcf, 0x0e, 0x21, 0x56, 0x95, 0x52, 0x6f, 0xac, 0x4a,
+	0x9b, 0x23, 0x3c, 0x38, 0x54, 0x96, 0x22, 0x8f, 0x25, 0x8a, 0x58, 0xfa, 0xed, 0x8b, 0x58, 0x66,
+	0x9c, 0x22, 0xd6, 0x4c, 0x76, 0x0b, 0x13, 0x57, 0x65, 0xbe, 0x79, 0x92, 0xf9, 0x86, 0x3c, 0x10,
+	0x6f, 0x1c, 0x3e, 0x1f, 0xd9, 0x38, 0x64, 0xdf, 0xcd, 0xb5, 0xc3, 0x8d, 0xde, 0xd0, 0x4b, 0xe2,
+	0xcd, 0x3f, 0x0b, 0x90, 0x8f, 0x7f, 0x09, 0x40, 0xab, 0xb0, 0xd4, 0x54, 0x1b, 0xcd, 0x46, 0xab,
+	0xfa, 0x50, 0xd7, 0x9e, 0x36, 0x65, 0xfd, 0xd1, 0x41, 0xab, 0x29, 0xef, 0x29, 0x77, 0x15, 0xb9,
+	0x2e, 0x5e, 0x43, 0xcb, 0x70, 0x3d, 0x39, 0xdd, 0xd2, 0xaa, 0x07, 0xf5, 0xaa, 0x5a, 0x17, 0x05,
+	0x74, 0x03, 0x56, 0x93, 0x73, 0xfb, 0x8f, 0x1e, 0x6a, 0x4a, 0xf3, 0xa1, 0xac, 0xef, 0xdd, 0x6f,
+	0x28, 0x7b, 0xb2, 0x98, 0x42, 0x1f, 0x80, 0x94, 0x84, 0x34, 0x9a, 0x9a, 0xb2, 0xaf, 0xb4, 0x34,
+	0x65, 0x4f, 0x4c, 0xa3, 0x15, 0x58, 0x4c, 0xce, 0xca, 0x4f, 0x9a, 0x72, 0x5d, 0xd1, 0xe4, 0xba,
+	0x98, 0xb9, 0xf9, 0x3f, 0x01, 0x20, 0xf6, 0x4d, 0x75, 0x05, 0x16, 0x0f, 0x1b, 0x1a, 0x13, 0x68,
+	0x1c, 0x0c, 0x58, 0x39, 0x07, 0x33, 0xf1, 0xc9, 0xa7, 0x72, 0x4b, 0x14, 0x06, 0x07, 0x1b, 0x07,
+	0xb2, 0x28, 0xa0, 0x45, 0x98, 0x8b, 0x0f, 0x56, 0x6b, 0x2d, 0xad, 0xaa, 0x1c, 0x88, 0xa9, 0x41,
+	0xb4, 0xf6, 0xb8, 0x21, 0xa6, 0x10, 0x82, 0x62, 0x7c, 0xf0, 0xa0, 0x21, 0xa6, 0xd1, 0x02, 0xcc,
+	0x26, 0x80, 0xf7, 0x55, 0x59, 0x16, 0xd3, 0x64, 0xa7, 0x49, 0xa8, 0xfe, 0x58, 0xd1, 0xee, 0xeb,
+	0x87, 0xb2, 0xd6, 0x10, 0x33, 0x68, 0x1e, 0xc4, 0xf8, 0xec, 0xdd, 0xc6, 0x23, 0x75, 0x78, 0xb4,
+	0xd5, 0xac, 0xee, 0x8b, 0x13, 0xcb, 0x29, 0x51, 0xb8, 0xf9, 0x77, 0x01, 0x8a, 0xc9, 0x0f, 0x9b,
+	0x68, 0x0d, 0x56, 0xfa, 0x87, 0xd5, 0xd2, 0xaa, 0xda, 0xa3, 0xd6, 0xc0, 0x21, 0x94, 0xa1, 0x34,
+	0x08, 0xa8, 0xcb, 0xcd, 0x46, 0x4b, 0xd1, 0xf4, 0xa6, 0xac, 0x2a, 0x8d, 0x41, 0x97, 0x71, 0xcc,
+	0x61, 0x43, 0x53, 0x0e, 0xee, 0x85, 0x90, 0x54, 0xc2, 0xe3, 0x1c, 0xd2, 0xac, 0xb6, 0x5a, 0x72,
+	0x9d, 0x6d, 0x72, 0x70, 0x4e, 0x95, 0x1f, 0xc8, 0x7b, 0xd4, 0x63, 0xa3, 0x98, 0x77, 0xab, 0xca,
+	0x43, 0xb9, 0x2e, 0x4e, 0xd4, 0x76, 0xbf, 0x79, 0x55, 0x12, 0xbe, 0x7d, 0x55, 0x12, 0xfe, 0xf3,
+	0xaa, 0x24, 0x7c, 0xf5, 0xba, 0x74, 0xed, 0xdb, 0xd7, 0xa5, 0x6b, 0xff, 0x78, 0x5d, 0xba, 0xf6,
+	0xab, 0x15, 0x76, 0x7d, 0x7c, 0xf3, 0xcb, 0x8a, 0xe5, 0x6e, 0xd1, 0x60, 0xdd, 0x22, 0x9f, 0xb1,
+	0x7c, 0xf2, 0xf7, 0x80, 0x2c, 0x8d, 0xfd, 0x4f, 0x7e, 0x18, 0x00, 0xa6, 0x22, 0x1f, 0x2e, 0x50,
+	0x18, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1532,6 +1669,42 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RevealPeriod != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.RevealPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.RevealPeriod):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.CommitEndTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CommitEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CommitEndTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.CommitReveal {
+		i--
+		if m.CommitReveal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.ProposalType != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
 		i--
@@ -1585,22 +1758,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.VotingEndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.VotingStartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -1619,22 +1792,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.DepositEndTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.SubmitTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i -= n6
+		i = encodeVarintGov(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -1877,6 +2050,48 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DepositParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1898,12 +2113,12 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1945,12 +2160,12 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2021,6 +2236,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RevealPeriod != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.RevealPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.RevealPeriod):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
 	if m.ProposalExecutionGas != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.ProposalExecutionGas))
 		i--
@@ -2136,12 +2363,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -2188,22 +2415,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
+		n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err13 != nil {
+			return 0, err13
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n13
+		i = encodeVarintGov(dAtA, i, uint64(n13))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2253,6 +2480,30 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa2
 	}
+	if m.MaxDepositPeriod != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintGov(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.VetoThreshold) > 0 {
 		i -= len(m.VetoThreshold)
 		copy(dAtA[i:], m.VetoThreshold)
@@ -2275,12 +2526,12 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.VotingPeriod != nil {
-		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err11 != nil {
-			return 0, err11
+		n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err15 != nil {
+			return 0, err15
 		}
-		i -= n11
-		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i -= n15
+		i = encodeVarintGov(dAtA, i, uint64(n15))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2406,6 +2657,17 @@ func (m *Proposal) Size() (n int) {
 	if m.ProposalType != 0 {
 		n += 2 + sovGov(uint64(m.ProposalType))
 	}
+	if m.CommitReveal {
+		n += 3
+	}
+	if m.CommitEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CommitEndTime)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.RevealPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.RevealPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2509,6 +2771,26 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *VoteCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func (m *DepositParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2658,6 +2940,10 @@ func (m *Params) Size() (n int) {
 	if m.ProposalExecutionGas != 0 {
 		n += 2 + sovGov(uint64(m.ProposalExecutionGas))
 	}
+	if m.RevealPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.RevealPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2683,6 +2969,16 @@ func (m *MessageBasedParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxDepositPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
 	l = len(m.YesQuorum)
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
@@ -3397,9 +3693,116 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			m.Expedited = bool(v != 0)
 		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			m.ProposalType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalType |= ProposalType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitReveal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitReveal = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitEndTime == nil {
+				m.CommitEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CommitEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3409,43 +3812,28 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailedReason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			if m.RevealPeriod == nil {
+				m.RevealPeriod = new(time.Duration)
 			}
-			m.ProposalType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalType |= ProposalType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.RevealPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -4182,6 +4570,141 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VoteCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DepositParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5234,6 +5757,42 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RevealPeriod == nil {
+				m.RevealPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.RevealPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5416,6 +5975,76 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 			}
 			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxDepositPeriod == nil {
+				m.MaxDepositPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 20:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field YesQuorum", wireType)
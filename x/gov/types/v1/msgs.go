@@ -103,6 +103,16 @@ func NewMsgVoteWeighted(voter string, proposalID uint64, options WeightedVoteOpt
 	return &MsgVoteWeighted{proposalID, voter, options, metadata}
 }
 
+// NewMsgCommitVote creates a message to commit a vote on a commit-reveal proposal
+func NewMsgCommitVote(voter string, proposalID uint64, commitment []byte) *MsgCommitVote {
+	return &MsgCommitVote{proposalID, voter, commitment}
+}
+
+// NewMsgRevealVote creates a message to reveal a vote committed on a commit-reveal proposal
+func NewMsgRevealVote(voter string, proposalID uint64, options WeightedVoteOptions, metadata string, salt []byte) *MsgRevealVote {
+	return &MsgRevealVote{proposalID, voter, options, metadata, salt}
+}
+
 // NewMsgExecLegacyContent creates a new MsgExecLegacyContent instance.
 func NewMsgExecLegacyContent(content *codectypes.Any, authority string) *MsgExecLegacyContent {
 	return &MsgExecLegacyContent{
@@ -224,6 +224,10 @@ func (p Params) ValidateBasic(addressCodec address.Codec) error {
 		return fmt.Errorf("expedited voting period %s must be strictly less than the regular voting period %s", p.ExpeditedVotingPeriod, p.VotingPeriod)
 	}
 
+	if p.RevealPeriod != nil && p.RevealPeriod.Seconds() <= 0 {
+		return fmt.Errorf("reveal period must be positive: %s", p.RevealPeriod)
+	}
+
 	for _, addr := range p.OptimisticAuthorizedAddresses {
 		if _, err := addressCodec.StringToBytes(addr); err != nil {
 			return fmt.Errorf("invalid optimistic authorized address: %s", addr)
@@ -286,6 +290,13 @@ func (p MessageBasedParams) ValidateBasic() error {
 		return fmt.Errorf("voting period must be positive: %s", p.VotingPeriod)
 	}
 
+	if minDeposit := sdk.Coins(p.MinDeposit); len(minDeposit) > 0 && (!minDeposit.IsValid() || !minDeposit.IsAllPositive()) {
+		return fmt.Errorf("invalid minimum deposit: %s", minDeposit)
+	}
+	if p.MaxDepositPeriod != nil && p.MaxDepositPeriod.Seconds() <= 0 {
+		return fmt.Errorf("maximum deposit period must be positive: %s", p.MaxDepositPeriod)
+	}
+
 	quorum, err := sdkmath.LegacyNewDecFromStr(p.Quorum)
 	if err != nil {
 		return fmt.Errorf("invalid quorum string: %w", err)
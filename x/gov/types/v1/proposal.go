@@ -74,6 +74,15 @@ func (p Proposal) GetMinDepositFromParams(params Params) sdk.Coins {
 	return params.MinDeposit
 }
 
+// MsgTypeURLs returns the type URLs of the messages of the proposal.
+func (p Proposal) MsgTypeURLs() []string {
+	urls := make([]string, len(p.Messages))
+	for i, msg := range p.Messages {
+		urls[i] = msg.TypeUrl
+	}
+	return urls
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
 	return sdktx.UnpackInterfaces(unpacker, p.Messages)
@@ -1157,6 +1157,184 @@ func (m *QueryMessageBasedParamsResponse) GetParams() *MessageBasedParams {
 	return nil
 }
 
+// QueryVoteBreakdownRequest is the request type for the Query/VoteBreakdown RPC method.
+type QueryVoteBreakdownRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryVoteBreakdownRequest) Reset()         { *m = QueryVoteBreakdownRequest{} }
+func (m *QueryVoteBreakdownRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteBreakdownRequest) ProtoMessage()    {}
+func (*QueryVoteBreakdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{22}
+}
+func (m *QueryVoteBreakdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteBreakdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteBreakdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteBreakdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteBreakdownRequest.Merge(m, src)
+}
+func (m *QueryVoteBreakdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteBreakdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteBreakdownRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteBreakdownRequest proto.InternalMessageInfo
+
+func (m *QueryVoteBreakdownRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryVoteBreakdownResponse is the response type for the Query/VoteBreakdown RPC method.
+type QueryVoteBreakdownResponse struct {
+	// validators defines the vote breakdown of the bonded validators, by
+	// decreasing voting power.
+	Validators []*ValidatorVoteBreakdown `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *QueryVoteBreakdownResponse) Reset()         { *m = QueryVoteBreakdownResponse{} }
+func (m *QueryVoteBreakdownResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteBreakdownResponse) ProtoMessage()    {}
+func (*QueryVoteBreakdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{23}
+}
+func (m *QueryVoteBreakdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteBreakdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteBreakdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteBreakdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteBreakdownResponse.Merge(m, src)
+}
+func (m *QueryVoteBreakdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteBreakdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteBreakdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteBreakdownResponse proto.InternalMessageInfo
+
+func (m *QueryVoteBreakdownResponse) GetValidators() []*ValidatorVoteBreakdown {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// ValidatorVoteBreakdown defines the breakdown of the voting power of a
+// validator on a proposal.
+type ValidatorVoteBreakdown struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// options is the vote of the validator, empty if it did not vote.
+	Options []*WeightedVoteOption `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	// voting_power is the voting power of the validator, its bonded tokens.
+	VotingPower string `protobuf:"bytes,3,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// overridden_power is the voting power of the delegators of the validator
+	// who voted themselves, which is not counted for the vote of the validator.
+	OverriddenPower string `protobuf:"bytes,4,opt,name=overridden_power,json=overriddenPower,proto3" json:"overridden_power,omitempty"`
+	// inherited_power is the voting power of the delegators of the validator who
+	// did not vote, which is counted for the vote of the validator, if any.
+	InheritedPower string `protobuf:"bytes,5,opt,name=inherited_power,json=inheritedPower,proto3" json:"inherited_power,omitempty"`
+}
+
+func (m *ValidatorVoteBreakdown) Reset()         { *m = ValidatorVoteBreakdown{} }
+func (m *ValidatorVoteBreakdown) String() string { return proto.CompactTextString(m) }
+func (*ValidatorVoteBreakdown) ProtoMessage()    {}
+func (*ValidatorVoteBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{24}
+}
+func (m *ValidatorVoteBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorVoteBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorVoteBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorVoteBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorVoteBreakdown.Merge(m, src)
+}
+func (m *ValidatorVoteBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorVoteBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorVoteBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorVoteBreakdown proto.InternalMessageInfo
+
+func (m *ValidatorVoteBreakdown) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorVoteBreakdown) GetOptions() []*WeightedVoteOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *ValidatorVoteBreakdown) GetVotingPower() string {
+	if m != nil {
+		return m.VotingPower
+	}
+	return ""
+}
+
+func (m *ValidatorVoteBreakdown) GetOverriddenPower() string {
+	if m != nil {
+		return m.OverriddenPower
+	}
+	return ""
+}
+
+func (m *ValidatorVoteBreakdown) GetInheritedPower() string {
+	if m != nil {
+		return m.InheritedPower
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryConstitutionRequest)(nil), "cosmos.gov.v1.QueryConstitutionRequest")
 	proto.RegisterType((*QueryConstitutionResponse)(nil), "cosmos.gov.v1.QueryConstitutionResponse")
@@ -1180,89 +1358,105 @@ func init() {
 	proto.RegisterType((*QueryProposalVoteOptionsResponse)(nil), "cosmos.gov.v1.QueryProposalVoteOptionsResponse")
 	proto.RegisterType((*QueryMessageBasedParamsRequest)(nil), "cosmos.gov.v1.QueryMessageBasedParamsRequest")
 	proto.RegisterType((*QueryMessageBasedParamsResponse)(nil), "cosmos.gov.v1.QueryMessageBasedParamsResponse")
+	proto.RegisterType((*QueryVoteBreakdownRequest)(nil), "cosmos.gov.v1.QueryVoteBreakdownRequest")
+	proto.RegisterType((*QueryVoteBreakdownResponse)(nil), "cosmos.gov.v1.QueryVoteBreakdownResponse")
+	proto.RegisterType((*ValidatorVoteBreakdown)(nil), "cosmos.gov.v1.ValidatorVoteBreakdown")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 1220 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdf, 0x6f, 0xdb, 0xd4,
-	0x17, 0x9f, 0xd3, 0xdf, 0x27, 0x69, 0xb7, 0xef, 0x6d, 0xbb, 0x66, 0xee, 0x9a, 0xa6, 0xee, 0x97,
-	0xb6, 0x20, 0x62, 0x27, 0xfd, 0xb1, 0x0a, 0x18, 0x42, 0x6b, 0x47, 0x07, 0x03, 0x44, 0xc9, 0x06,
-	0x0f, 0xbc, 0x44, 0x6e, 0x63, 0x59, 0xd6, 0x52, 0x5f, 0x2f, 0xd7, 0xb1, 0x28, 0xa5, 0x42, 0x9a,
-	0xc4, 0x8f, 0x27, 0x40, 0x62, 0x12, 0xfc, 0x11, 0x7b, 0xec, 0x7f, 0xc0, 0x0b, 0xda, 0xd3, 0x04,
-	0x2f, 0x68, 0x4f, 0xa8, 0xe5, 0x0f, 0x41, 0xbe, 0xf7, 0xd8, 0xb1, 0x1d, 0x27, 0x4d, 0xa7, 0x89,
-	0xc7, 0x5c, 0x7f, 0xce, 0xe7, 0x7c, 0xce, 0xb9, 0xe7, 0x9e, 0x73, 0x02, 0xd7, 0xf6, 0x29, 0x3b,
-	0xa0, 0x4c, 0x33, 0xa9, 0xa7, 0x79, 0x15, 0xed, 0x61, 0xcb, 0x68, 0x1e, 0xaa, 0x4e, 0x93, 0xba,
-	0x94, 0x8c, 0x8b, 0x4f, 0xaa, 0x49, 0x3d, 0xd5, 0xab, 0xc8, 0xaf, 0x21, 0x72, 0x4f, 0x67, 0x86,
-	0xc0, 0x69, 0x5e, 0x65, 0xcf, 0x70, 0xf5, 0x8a, 0xe6, 0xe8, 0xa6, 0x65, 0xeb, 0xae, 0x45, 0x6d,
-	0x61, 0x2a, 0x5f, 0x37, 0x29, 0x35, 0x1b, 0x86, 0xa6, 0x3b, 0x96, 0xa6, 0xdb, 0x36, 0x75, 0xf9,
-	0x47, 0x86, 0x5f, 0x67, 0xe2, 0x3e, 0x7d, 0x7e, 0xf1, 0x01, 0xc5, 0xd4, 0xf8, 0x2f, 0x0d, 0xdd,
-	0xf3, 0x1f, 0x8a, 0x0c, 0xf9, 0x4f, 0x7c, 0x9f, 0xdb, 0xd4, 0x66, 0xae, 0xe5, 0xb6, 0x7c, 0xbe,
-	0xaa, 0xf1, 0xb0, 0x65, 0x30, 0x57, 0x79, 0x07, 0xae, 0xa5, 0x7c, 0x63, 0x0e, 0xb5, 0x99, 0x41,
-	0x14, 0xc8, 0xed, 0x47, 0xce, 0xf3, 0x52, 0x51, 0x5a, 0x19, 0xab, 0xc6, 0xce, 0x94, 0x4d, 0x98,
-	0xe2, 0x04, 0xbb, 0x4d, 0xea, 0x50, 0xa6, 0x37, 0x90, 0x98, 0xcc, 0x43, 0xd6, 0xc1, 0xa3, 0x9a,
-	0x55, 0xe7, 0xa6, 0x83, 0x55, 0x08, 0x8e, 0xde, 0xaf, 0x2b, 0x1f, 0xc2, 0x74, 0xc2, 0x10, 0xbd,
-	0xae, 0xc1, 0x68, 0x00, 0xe3, 0x66, 0xd9, 0xd5, 0x19, 0x35, 0x96, 0x4e, 0x35, 0x34, 0x09, 0x81,
-	0xca, 0x8f, 0x99, 0x04, 0x1d, 0x0b, 0x84, 0xec, 0xc0, 0xe5, 0x50, 0x08, 0x73, 0x75, 0xb7, 0xc5,
-	0x38, 0xeb, 0xc4, 0xea, 0x5c, 0x17, 0xd6, 0x7b, 0x1c, 0x54, 0x9d, 0x70, 0x62, 0xbf, 0x89, 0x0a,
-	0x43, 0x1e, 0x75, 0x8d, 0x66, 0x3e, 0xe3, 0x67, 0x61, 0x2b, 0xff, 0xc7, 0x49, 0x69, 0x0a, 0x09,
-	0x6e, 0xd5, 0xeb, 0x4d, 0x83, 0xb1, 0x7b, 0x6e, 0xd3, 0xb2, 0xcd, 0xaa, 0x80, 0x91, 0x1b, 0x30,
-	0x56, 0x37, 0x1c, 0xca, 0x2c, 0x97, 0x36, 0xf3, 0x03, 0xe7, 0xd8, 0xb4, 0xa1, 0x64, 0x07, 0xa0,
-	0x5d, 0x13, 0xf9, 0x41, 0x9e, 0x80, 0xa5, 0x40, 0xaa, 0x5f, 0x40, 0xaa, 0x28, 0x34, 0x2c, 0x20,
-	0x75, 0x57, 0x37, 0x0d, 0x8c, 0xb5, 0x1a, 0xb1, 0x54, 0x7e, 0x95, 0xe0, 0x6a, 0x32, 0x23, 0x98,
-	0xe1, 0x0d, 0x18, 0x0b, 0x82, 0xf3, 0x93, 0x31, 0xd0, 0x2b, 0xc5, 0x6d, 0x24, 0xb9, 0x13, 0x53,
-	0x96, 0xe1, 0xca, 0x96, 0xcf, 0x55, 0x26, 0x7c, 0xc6, 0xa4, 0xed, 0xc3, 0x15, 0xae, 0xec, 0x33,
-	0xea, 0x1a, 0xfd, 0xd6, 0xcb, 0x45, 0xf3, 0xaf, 0xdc, 0x84, 0xff, 0x45, 0x9c, 0x60, 0xe4, 0xcb,
-	0x30, 0xe8, 0x7f, 0xc5, 0xba, 0x9a, 0x4c, 0x04, 0xcd, 0xa1, 0x1c, 0xa0, 0x7c, 0x15, 0xb1, 0x66,
-	0x7d, 0x6b, 0xdc, 0x49, 0xc9, 0xd0, 0x8b, 0xdc, 0xdd, 0xf7, 0x12, 0x90, 0xa8, 0x7b, 0x54, 0xff,
-	0xaa, 0x48, 0x41, 0x70, 0x67, 0xa9, 0xf2, 0x05, 0xe2, 0xe5, 0xdd, 0xd5, 0x1b, 0xa8, 0x64, 0x57,
-	0x6f, 0xea, 0x07, 0x61, 0x26, 0x16, 0x21, 0xeb, 0xf0, 0x83, 0x9a, 0x7b, 0xe8, 0x88, 0x74, 0x8e,
-	0x6d, 0x65, 0xf2, 0x92, 0x6f, 0xea, 0x1f, 0xdf, 0x3f, 0x74, 0x0c, 0xe5, 0x49, 0x06, 0x26, 0x63,
-	0xb6, 0x18, 0xc6, 0x6d, 0x18, 0xf7, 0xa8, 0x6b, 0xd9, 0x66, 0x4d, 0x80, 0xf1, 0x36, 0x66, 0x3b,
-	0xc3, 0xb1, 0x6c, 0x53, 0xd8, 0x72, 0xee, 0x9c, 0x17, 0x39, 0x21, 0x77, 0x60, 0x02, 0x1f, 0x4d,
-	0x40, 0x23, 0xa2, 0xbc, 0x9e, 0xa0, 0xb9, 0x2d, 0x40, 0x11, 0x9e, 0xf1, 0x7a, 0xf4, 0x88, 0xdc,
-	0x82, 0x9c, 0xab, 0x37, 0x1a, 0x87, 0x01, 0xcd, 0x00, 0xa7, 0x91, 0x13, 0x34, 0xf7, 0x7d, 0x48,
-	0x84, 0x24, 0xeb, 0xb6, 0x0f, 0xc8, 0x36, 0x0c, 0xa3, 0xb1, 0x78, 0xaf, 0xd3, 0xc9, 0xd7, 0x24,
-	0xec, 0xa6, 0x9e, 0x9f, 0x94, 0xae, 0x88, 0x2f, 0x25, 0x56, 0x7f, 0x50, 0xf4, 0xca, 0xea, 0xfa,
-	0x66, 0x15, 0x4d, 0x15, 0x1b, 0xb3, 0x85, 0x82, 0xfb, 0x2e, 0xba, 0x58, 0xa3, 0xc9, 0xf4, 0xdd,
-	0x68, 0x94, 0xf7, 0xb0, 0x73, 0x87, 0xfe, 0xf0, 0x7a, 0xca, 0x30, 0x82, 0x20, 0xbc, 0x98, 0xab,
-	0xe9, 0x19, 0xad, 0x06, 0x30, 0xe5, 0xeb, 0x38, 0xd3, 0x7f, 0xff, 0x5e, 0x1e, 0x4b, 0xd8, 0xfd,
-	0xdb, 0x0a, 0x30, 0x98, 0x55, 0x18, 0x45, 0x95, 0xc1, 0xab, 0xe9, 0x16, 0x4d, 0x88, 0x7b, 0x79,
-	0x6f, 0xe7, 0x4d, 0x98, 0xe1, 0xaa, 0x78, 0xed, 0x54, 0x0d, 0xd6, 0x6a, 0xb8, 0x17, 0x18, 0x8f,
-	0xf9, 0x4e, 0xdb, 0xf0, 0x86, 0x86, 0x78, 0xf5, 0xe1, 0xfd, 0xa4, 0x96, 0x2a, 0x9a, 0x08, 0xa0,
-	0xb2, 0x05, 0xf3, 0xb1, 0x59, 0xe0, 0xb7, 0x8a, 0x8f, 0x1d, 0xbe, 0x58, 0xf4, 0xad, 0xc8, 0x82,
-	0x62, 0x77, 0x0e, 0x54, 0xf6, 0x2e, 0xf8, 0x8f, 0xd4, 0xa8, 0x51, 0x71, 0x8e, 0x02, 0x95, 0x2e,
-	0xc3, 0x25, 0xca, 0x90, 0xf5, 0xda, 0x3f, 0x94, 0xbb, 0x50, 0xe0, 0xae, 0x3e, 0x32, 0x18, 0xd3,
-	0x4d, 0x63, 0x4b, 0x67, 0x46, 0x3d, 0xde, 0x80, 0x56, 0x60, 0xe4, 0x80, 0x99, 0xb5, 0x56, 0xb3,
-	0x81, 0xcd, 0xe7, 0xf2, 0xf3, 0x93, 0x52, 0xf6, 0x0b, 0x7f, 0x21, 0x2a, 0x56, 0xd4, 0xb2, 0x5a,
-	0xae, 0x0e, 0x1f, 0x30, 0xf3, 0xd3, 0x66, 0x43, 0x39, 0xc0, 0xd0, 0xd3, 0xb8, 0x50, 0xf5, 0xdd,
-	0xf0, 0xf9, 0x0a, 0xbd, 0x0b, 0x09, 0xbd, 0x9d, 0xa6, 0x29, 0xee, 0x04, 0xc3, 0xea, 0xd3, 0x1c,
-	0x0c, 0x71, 0x7f, 0xe4, 0x5b, 0x09, 0x72, 0xd1, 0xb5, 0x8a, 0x2c, 0x27, 0x68, 0xbb, 0x2d, 0x65,
-	0xf2, 0xca, 0xf9, 0x40, 0xa1, 0x5c, 0x59, 0x7c, 0xf4, 0xe7, 0x3f, 0x3f, 0x67, 0xe6, 0xc8, 0xac,
-	0x16, 0xdf, 0x0b, 0xa3, 0x2b, 0x1a, 0xf9, 0x46, 0x82, 0xd1, 0x20, 0xe5, 0x64, 0x31, 0x8d, 0x3b,
-	0xb1, 0xbc, 0xc9, 0xff, 0xef, 0x0d, 0x42, 0xe7, 0x2a, 0x77, 0xbe, 0x42, 0x96, 0x12, 0xce, 0xc3,
-	0x8d, 0x41, 0x3b, 0x8a, 0x54, 0xd4, 0x31, 0xf9, 0x12, 0xc6, 0xc2, 0x5d, 0x84, 0xf4, 0x74, 0x11,
-	0x5c, 0xb3, 0xfc, 0xca, 0x39, 0x28, 0x54, 0x52, 0xe4, 0x4a, 0x64, 0x92, 0xef, 0xa6, 0x84, 0x7c,
-	0x27, 0xc1, 0xa0, 0x5f, 0x6e, 0x64, 0x3e, 0x8d, 0x31, 0xb2, 0x88, 0xc8, 0xc5, 0xee, 0x00, 0xf4,
-	0x76, 0x93, 0x7b, 0xbb, 0x41, 0xd6, 0xfb, 0x8b, 0x5b, 0xe3, 0x13, 0x59, 0x3b, 0xe2, 0x6b, 0xc9,
-	0x31, 0x79, 0x24, 0xc1, 0x10, 0x1f, 0xeb, 0xa4, 0xab, 0xa7, 0x30, 0xfc, 0x85, 0x1e, 0x08, 0x14,
-	0xb3, 0xce, 0xc5, 0xa8, 0xe4, 0xf5, 0x8b, 0x88, 0x21, 0x36, 0x0c, 0xe3, 0xe8, 0x4a, 0x75, 0x11,
-	0x7b, 0x6b, 0xb2, 0xd2, 0x0b, 0x82, 0x32, 0xe6, 0xb8, 0x8c, 0x19, 0x32, 0x9d, 0x94, 0x21, 0xbc,
-	0xfc, 0x22, 0xc1, 0x08, 0x36, 0x5a, 0x92, 0x4a, 0x17, 0x1f, 0x7a, 0xf2, 0x62, 0x4f, 0x0c, 0xfa,
-	0xdc, 0xe6, 0x3e, 0xdf, 0x26, 0x6f, 0xf5, 0x19, 0x7a, 0xd0, 0xe0, 0xb5, 0xa3, 0x70, 0x08, 0x1e,
-	0x93, 0x1f, 0x24, 0x18, 0x0d, 0xa6, 0x06, 0xe9, 0xe5, 0x96, 0xf5, 0x7c, 0x1c, 0xc9, 0xc1, 0xa3,
-	0x6c, 0x72, 0x71, 0x15, 0xa2, 0x5d, 0x50, 0x1c, 0x79, 0x2c, 0x41, 0x36, 0xd2, 0xc1, 0xc9, 0x52,
-	0x9a, 0xbb, 0xce, 0x89, 0x22, 0x2f, 0x9f, 0x8b, 0x7b, 0xc1, 0x8a, 0xe1, 0x13, 0x84, 0xfc, 0x26,
-	0xc1, 0x64, 0x4a, 0xdf, 0x26, 0x6a, 0xaf, 0x17, 0xda, 0x39, 0x66, 0x64, 0xad, 0x6f, 0x3c, 0xca,
-	0xfd, 0xe0, 0xe9, 0x49, 0x29, 0x27, 0x3a, 0xad, 0x57, 0x56, 0x57, 0xd5, 0x32, 0x97, 0xbf, 0x41,
-	0xd6, 0x2e, 0x50, 0xf0, 0xc1, 0x3c, 0x22, 0x4f, 0x24, 0x20, 0x9d, 0xdd, 0x9c, 0x94, 0xd2, 0x44,
-	0x75, 0x1d, 0x3e, 0xb2, 0xda, 0x2f, 0x3c, 0xa8, 0x85, 0xd4, 0x10, 0x16, 0xc8, 0x7c, 0xea, 0x63,
-	0xd1, 0x8e, 0x70, 0xb2, 0x1d, 0x6f, 0x6d, 0xfc, 0x7e, 0x5a, 0x90, 0x9e, 0x9d, 0x16, 0xa4, 0xbf,
-	0x4f, 0x0b, 0xd2, 0x4f, 0x67, 0x85, 0x4b, 0xcf, 0xce, 0x0a, 0x97, 0xfe, 0x3a, 0x2b, 0x5c, 0xfa,
-	0x7c, 0x56, 0x58, 0xb2, 0xfa, 0x03, 0xd5, 0xa2, 0x1a, 0xe7, 0xd6, 0xfc, 0x55, 0x9c, 0x69, 0x5e,
-	0x65, 0x6f, 0x98, 0xff, 0xef, 0x5f, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x80, 0xa3, 0x3c,
-	0xa1, 0x10, 0x00, 0x00,
+	// 1429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdd, 0x6f, 0x13, 0xc7,
+	0x16, 0x67, 0x9d, 0xef, 0xe3, 0x7c, 0x31, 0x24, 0xc4, 0x2c, 0xc4, 0x71, 0x36, 0x17, 0x12, 0xae,
+	0xae, 0x77, 0xe3, 0x40, 0x88, 0xf8, 0xb8, 0xba, 0x22, 0x70, 0xa1, 0xa5, 0x85, 0x52, 0x43, 0x5b,
+	0xa9, 0x2f, 0xd6, 0x92, 0x1d, 0x99, 0x15, 0xce, 0x8e, 0xd9, 0x59, 0x2f, 0x4d, 0xd3, 0xa8, 0x15,
+	0x52, 0x3f, 0x9e, 0xda, 0x4a, 0x45, 0x6a, 0xff, 0x08, 0xde, 0x9a, 0xff, 0xa0, 0x2f, 0x15, 0x4f,
+	0x28, 0x7d, 0xa9, 0x78, 0xa8, 0x2a, 0xe8, 0x1f, 0x52, 0xed, 0xcc, 0x59, 0x7b, 0x77, 0xbd, 0x76,
+	0x1c, 0x84, 0xfa, 0x04, 0x3b, 0xf3, 0x3b, 0xbf, 0xf3, 0x3b, 0x67, 0xce, 0x99, 0x39, 0x31, 0x1c,
+	0xdb, 0x60, 0x7c, 0x93, 0x71, 0xa3, 0xca, 0x7c, 0xc3, 0x2f, 0x19, 0x0f, 0x1b, 0xd4, 0xdd, 0xd2,
+	0xeb, 0x2e, 0xf3, 0x18, 0x19, 0x93, 0x5b, 0x7a, 0x95, 0xf9, 0xba, 0x5f, 0x52, 0xff, 0x8d, 0xc8,
+	0x7b, 0x26, 0xa7, 0x12, 0x67, 0xf8, 0xa5, 0x7b, 0xd4, 0x33, 0x4b, 0x46, 0xdd, 0xac, 0xda, 0x8e,
+	0xe9, 0xd9, 0xcc, 0x91, 0xa6, 0xea, 0x89, 0x2a, 0x63, 0xd5, 0x1a, 0x35, 0xcc, 0xba, 0x6d, 0x98,
+	0x8e, 0xc3, 0x3c, 0xb1, 0xc9, 0x71, 0x77, 0x26, 0xee, 0x33, 0xe0, 0x97, 0x1b, 0x28, 0xa6, 0x22,
+	0xbe, 0x0c, 0x74, 0x2f, 0x3e, 0x34, 0x15, 0x72, 0xef, 0x07, 0x3e, 0xaf, 0x30, 0x87, 0x7b, 0xb6,
+	0xd7, 0x08, 0xf8, 0xca, 0xf4, 0x61, 0x83, 0x72, 0x4f, 0xfb, 0x1f, 0x1c, 0x4b, 0xd9, 0xe3, 0x75,
+	0xe6, 0x70, 0x4a, 0x34, 0x18, 0xdd, 0x88, 0xac, 0xe7, 0x94, 0x82, 0xb2, 0x34, 0x52, 0x8e, 0xad,
+	0x69, 0x6b, 0x30, 0x25, 0x08, 0x6e, 0xbb, 0xac, 0xce, 0xb8, 0x59, 0x43, 0x62, 0x32, 0x07, 0xd9,
+	0x3a, 0x2e, 0x55, 0x6c, 0x4b, 0x98, 0xf6, 0x97, 0x21, 0x5c, 0x7a, 0xdb, 0xd2, 0xde, 0x85, 0xe9,
+	0x84, 0x21, 0x7a, 0x3d, 0x03, 0xc3, 0x21, 0x4c, 0x98, 0x65, 0x57, 0x66, 0xf4, 0x58, 0x3a, 0xf5,
+	0xa6, 0x49, 0x13, 0xa8, 0x7d, 0x97, 0x49, 0xd0, 0xf1, 0x50, 0xc8, 0x35, 0x98, 0x68, 0x0a, 0xe1,
+	0x9e, 0xe9, 0x35, 0xb8, 0x60, 0x1d, 0x5f, 0x99, 0xed, 0xc0, 0x7a, 0x47, 0x80, 0xca, 0xe3, 0xf5,
+	0xd8, 0x37, 0xd1, 0x61, 0xc0, 0x67, 0x1e, 0x75, 0x73, 0x99, 0x20, 0x0b, 0xeb, 0xb9, 0xbd, 0xdd,
+	0xe2, 0x14, 0x12, 0x5c, 0xb6, 0x2c, 0x97, 0x72, 0x7e, 0xc7, 0x73, 0x6d, 0xa7, 0x5a, 0x96, 0x30,
+	0x72, 0x0e, 0x46, 0x2c, 0x5a, 0x67, 0xdc, 0xf6, 0x98, 0x9b, 0xeb, 0xdb, 0xc7, 0xa6, 0x05, 0x25,
+	0xd7, 0x00, 0x5a, 0x35, 0x91, 0xeb, 0x17, 0x09, 0x38, 0x15, 0x4a, 0x0d, 0x0a, 0x48, 0x97, 0x85,
+	0x86, 0x05, 0xa4, 0xdf, 0x36, 0xab, 0x14, 0x63, 0x2d, 0x47, 0x2c, 0xb5, 0x9f, 0x14, 0x38, 0x9a,
+	0xcc, 0x08, 0x66, 0x78, 0x15, 0x46, 0xc2, 0xe0, 0x82, 0x64, 0xf4, 0x75, 0x4b, 0x71, 0x0b, 0x49,
+	0xae, 0xc7, 0x94, 0x65, 0x84, 0xb2, 0xc5, 0x7d, 0x95, 0x49, 0x9f, 0x31, 0x69, 0x1b, 0x30, 0x29,
+	0x94, 0x7d, 0xc8, 0x3c, 0xda, 0x6b, 0xbd, 0x1c, 0x34, 0xff, 0xda, 0x25, 0x38, 0x1c, 0x71, 0x82,
+	0x91, 0x2f, 0x42, 0x7f, 0xb0, 0x8b, 0x75, 0x75, 0x24, 0x11, 0xb4, 0x80, 0x0a, 0x80, 0xf6, 0x59,
+	0xc4, 0x9a, 0xf7, 0xac, 0xf1, 0x5a, 0x4a, 0x86, 0x5e, 0xe7, 0xec, 0xbe, 0x51, 0x80, 0x44, 0xdd,
+	0xa3, 0xfa, 0xd3, 0x32, 0x05, 0xe1, 0x99, 0xa5, 0xca, 0x97, 0x88, 0x37, 0x77, 0x56, 0xe7, 0x51,
+	0xc9, 0x6d, 0xd3, 0x35, 0x37, 0x9b, 0x99, 0x58, 0x80, 0x6c, 0x5d, 0x2c, 0x54, 0xbc, 0xad, 0xba,
+	0x4c, 0xe7, 0xc8, 0x7a, 0x26, 0xa7, 0x94, 0x41, 0x2e, 0xdf, 0xdd, 0xaa, 0x53, 0xed, 0x69, 0x06,
+	0x8e, 0xc4, 0x6c, 0x31, 0x8c, 0xab, 0x30, 0xe6, 0x33, 0xcf, 0x76, 0xaa, 0x15, 0x09, 0xc6, 0xd3,
+	0x38, 0xde, 0x1e, 0x8e, 0xed, 0x54, 0xa5, 0xad, 0xe0, 0x1e, 0xf5, 0x23, 0x2b, 0xe4, 0x3a, 0x8c,
+	0x63, 0xd3, 0x84, 0x34, 0x32, 0xca, 0x13, 0x09, 0x9a, 0xab, 0x12, 0x14, 0xe1, 0x19, 0xb3, 0xa2,
+	0x4b, 0xe4, 0x32, 0x8c, 0x7a, 0x66, 0xad, 0xb6, 0x15, 0xd2, 0xf4, 0x09, 0x1a, 0x35, 0x41, 0x73,
+	0x37, 0x80, 0x44, 0x48, 0xb2, 0x5e, 0x6b, 0x81, 0x5c, 0x81, 0x41, 0x34, 0x96, 0xfd, 0x3a, 0x9d,
+	0xec, 0x26, 0x69, 0x37, 0xf5, 0x62, 0xb7, 0x38, 0x29, 0x77, 0x8a, 0xdc, 0x7a, 0x50, 0xf0, 0x97,
+	0xf5, 0xb3, 0x6b, 0x65, 0x34, 0xd5, 0x1c, 0xcc, 0x16, 0x0a, 0xee, 0xb9, 0xe8, 0x62, 0x17, 0x4d,
+	0xa6, 0xe7, 0x8b, 0x46, 0x7b, 0x0b, 0xa6, 0xe2, 0xfe, 0xf0, 0x78, 0x96, 0x61, 0x08, 0x41, 0x78,
+	0x30, 0x47, 0xd3, 0x33, 0x5a, 0x0e, 0x61, 0xda, 0xe7, 0x71, 0xa6, 0x7f, 0xbe, 0x5f, 0x9e, 0x28,
+	0x30, 0x9d, 0x50, 0x80, 0xc1, 0xac, 0xc0, 0x30, 0xaa, 0x0c, 0xbb, 0xa6, 0x53, 0x34, 0x4d, 0xdc,
+	0x9b, 0xeb, 0x9d, 0x0b, 0x30, 0x23, 0x54, 0x89, 0xda, 0x29, 0x53, 0xde, 0xa8, 0x79, 0x07, 0x78,
+	0x1e, 0x73, 0xed, 0xb6, 0xcd, 0x13, 0x1a, 0x10, 0xd5, 0x97, 0x53, 0x3a, 0x97, 0x2a, 0x9a, 0x48,
+	0xa0, 0xb6, 0x0e, 0x73, 0xb1, 0xb7, 0x20, 0xb8, 0x2a, 0xde, 0xab, 0x07, 0x22, 0x7b, 0x3e, 0x2c,
+	0xcd, 0x86, 0x42, 0x67, 0x0e, 0x54, 0xf6, 0x7f, 0x08, 0x9a, 0x94, 0x56, 0x98, 0x5c, 0x47, 0x81,
+	0x5a, 0x87, 0xc7, 0x25, 0xca, 0x90, 0xf5, 0x5b, 0x1f, 0xda, 0x0d, 0xc8, 0x0b, 0x57, 0x37, 0x29,
+	0xe7, 0x66, 0x95, 0xae, 0x9b, 0x9c, 0x5a, 0xf1, 0x0b, 0x68, 0x09, 0x86, 0x36, 0x79, 0xb5, 0xd2,
+	0x70, 0x6b, 0x78, 0xf9, 0x4c, 0xbc, 0xd8, 0x2d, 0x66, 0x3f, 0x09, 0x06, 0xa2, 0x42, 0x49, 0x5f,
+	0xd6, 0x97, 0xcb, 0x83, 0x9b, 0xbc, 0xfa, 0x81, 0x5b, 0xd3, 0x36, 0x61, 0xae, 0x23, 0x17, 0xaa,
+	0xbe, 0xd1, 0x6c, 0x5f, 0xa9, 0x77, 0x3e, 0xa1, 0xb7, 0xdd, 0x34, 0xc5, 0x1d, 0x76, 0xf1, 0x2d,
+	0x1c, 0xa8, 0x82, 0xd8, 0xd6, 0x5d, 0x6a, 0x3e, 0xb0, 0xd8, 0x23, 0xa7, 0xd7, 0x1c, 0x5f, 0x98,
+	0xdc, 0xdb, 0x2d, 0x8e, 0x4a, 0x5a, 0x5f, 0xf0, 0x6a, 0x0d, 0x50, 0xd3, 0xf8, 0x9a, 0xf9, 0x06,
+	0xdf, 0xac, 0xd9, 0x96, 0xe9, 0x31, 0x37, 0x2c, 0xf0, 0x93, 0xc9, 0x7b, 0x34, 0x04, 0xc4, 0x29,
+	0x22, 0x86, 0x29, 0x6e, 0xff, 0xc8, 0xc0, 0xd1, 0x74, 0x43, 0x72, 0x0b, 0x0e, 0x37, 0x4d, 0x2b,
+	0xa6, 0xbc, 0x5d, 0xf0, 0x10, 0xe6, 0xf7, 0x76, 0x8b, 0xb3, 0xe8, 0xbd, 0x69, 0x1d, 0xbf, 0x80,
+	0x26, 0xfd, 0xc4, 0x3a, 0xb9, 0x08, 0x43, 0x61, 0xb9, 0x64, 0x0a, 0x7d, 0x29, 0xe9, 0xff, 0x88,
+	0xda, 0xd5, 0xfb, 0x1e, 0xb5, 0x5a, 0xe5, 0x52, 0x0e, 0x2d, 0x48, 0x09, 0x46, 0xc3, 0xb7, 0x84,
+	0x3d, 0xa2, 0xe1, 0xa0, 0x35, 0xbe, 0xb7, 0x5b, 0x04, 0x24, 0xb9, 0x4a, 0x37, 0xca, 0x59, 0x7c,
+	0x39, 0x02, 0x08, 0x39, 0x0f, 0x93, 0xcc, 0xa7, 0xae, 0x6b, 0x5b, 0x16, 0x75, 0xd0, 0xac, 0x3f,
+	0xd5, 0x6c, 0xa2, 0x85, 0x93, 0xa6, 0x6b, 0x30, 0x61, 0x3b, 0xf7, 0xa9, 0x6b, 0x7b, 0xd4, 0x42,
+	0xcb, 0x81, 0x54, 0xcb, 0xf1, 0x26, 0x4c, 0x18, 0xb6, 0x27, 0x78, 0xe5, 0x8b, 0x71, 0x18, 0x10,
+	0x07, 0x4b, 0xbe, 0x52, 0x60, 0x34, 0x3a, 0x7e, 0x93, 0xc5, 0x44, 0xfc, 0x9d, 0x86, 0x77, 0x75,
+	0x69, 0x7f, 0xa0, 0xac, 0x13, 0x6d, 0xe1, 0xf1, 0x6f, 0x7f, 0xfd, 0x90, 0x99, 0x25, 0xc7, 0x8d,
+	0xf8, 0xdf, 0x0f, 0xd1, 0x51, 0x9e, 0x7c, 0xa9, 0xc0, 0x70, 0xd8, 0x9a, 0x64, 0x21, 0x8d, 0x3b,
+	0x31, 0xe4, 0xab, 0xff, 0xea, 0x0e, 0x42, 0xe7, 0xba, 0x70, 0xbe, 0x44, 0x4e, 0x25, 0x9c, 0x37,
+	0x27, 0x4b, 0x63, 0x3b, 0xd2, 0x15, 0x3b, 0xe4, 0x53, 0x18, 0x09, 0x39, 0x38, 0xe9, 0xea, 0x22,
+	0xbc, 0x0e, 0xd4, 0x93, 0xfb, 0xa0, 0x50, 0x49, 0x41, 0x28, 0x51, 0x49, 0xae, 0x93, 0x12, 0xf2,
+	0xb5, 0x02, 0xfd, 0x41, 0x9d, 0x91, 0xb9, 0x34, 0xc6, 0xc8, 0xc0, 0xaa, 0x16, 0x3a, 0x03, 0xd0,
+	0xdb, 0x25, 0xe1, 0xed, 0x1c, 0x39, 0xdb, 0x5b, 0xdc, 0x86, 0x98, 0xdc, 0x8c, 0xed, 0xe0, 0x1f,
+	0x77, 0x87, 0x3c, 0x56, 0x60, 0x20, 0xa0, 0xe3, 0xa4, 0xa3, 0xa7, 0x66, 0xf8, 0xf3, 0x5d, 0x10,
+	0x28, 0xe6, 0xac, 0x10, 0xa3, 0x93, 0xff, 0x1c, 0x44, 0x0c, 0x71, 0x60, 0x10, 0x47, 0x9c, 0x54,
+	0x17, 0xb1, 0x3b, 0x59, 0xd5, 0xba, 0x41, 0x50, 0xc6, 0xac, 0x90, 0x31, 0x43, 0xa6, 0x93, 0x32,
+	0xa4, 0x97, 0x1f, 0x15, 0x18, 0xc2, 0x07, 0x99, 0xa4, 0xd2, 0xc5, 0x87, 0x23, 0x75, 0xa1, 0x2b,
+	0x06, 0x7d, 0x5e, 0x11, 0x3e, 0xff, 0x4b, 0x2e, 0xf6, 0x18, 0x7a, 0x38, 0x08, 0x18, 0xdb, 0xf8,
+	0x3f, 0xe6, 0xee, 0x90, 0x6f, 0x15, 0x18, 0x46, 0x62, 0x4e, 0xba, 0xb9, 0xe5, 0x5d, 0x9b, 0x23,
+	0x39, 0xa0, 0x68, 0x6b, 0x42, 0x5c, 0x89, 0x18, 0x07, 0x14, 0x47, 0x9e, 0x28, 0x90, 0x8d, 0xbc,
+	0xf4, 0xe4, 0x54, 0x9a, 0xbb, 0xf6, 0xc9, 0x43, 0x5d, 0xdc, 0x17, 0xf7, 0x9a, 0x15, 0x23, 0x26,
+	0x0d, 0xf2, 0x8b, 0x02, 0x47, 0x52, 0xde, 0x77, 0xa2, 0x77, 0xeb, 0xd0, 0xf6, 0x71, 0x44, 0x35,
+	0x7a, 0xc6, 0xa3, 0xdc, 0x77, 0x9e, 0xb5, 0xae, 0xd8, 0x65, 0x7d, 0x45, 0x5f, 0x16, 0xf2, 0x57,
+	0xc9, 0x99, 0x03, 0x14, 0x7c, 0x38, 0xb7, 0x90, 0xa7, 0x0a, 0x90, 0xf6, 0x57, 0x9f, 0x14, 0xd3,
+	0x44, 0x75, 0x1c, 0x52, 0x54, 0xbd, 0x57, 0x78, 0x58, 0x0b, 0xa9, 0x21, 0xcc, 0x93, 0xb9, 0xd4,
+	0x66, 0x31, 0xb6, 0x71, 0x02, 0xda, 0x21, 0x3f, 0x2b, 0x30, 0x16, 0x7f, 0xa4, 0x97, 0x3a, 0xdd,
+	0x08, 0xc9, 0x99, 0x44, 0x3d, 0xdd, 0x03, 0x12, 0xf5, 0xdd, 0x7c, 0x96, 0x78, 0xc5, 0x84, 0xbe,
+	0x35, 0xb2, 0x7a, 0x90, 0x14, 0xdf, 0x0b, 0x69, 0xd7, 0x57, 0x7f, 0x7d, 0x99, 0x57, 0x9e, 0xbf,
+	0xcc, 0x2b, 0x7f, 0xbe, 0xcc, 0x2b, 0xdf, 0xbf, 0xca, 0x1f, 0x7a, 0xfe, 0x2a, 0x7f, 0xe8, 0xf7,
+	0x57, 0xf9, 0x43, 0x1f, 0x1f, 0x97, 0x7c, 0xdc, 0x7a, 0xa0, 0xdb, 0xcc, 0x10, 0x1e, 0x8d, 0xe0,
+	0x0f, 0x4d, 0x1e, 0xfc, 0x6c, 0x36, 0x28, 0x7e, 0xd5, 0x3a, 0xf3, 0xf7, 0x00, 0xfd, 0x51, 0x4f,
+	0x3f, 0x7f, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1299,6 +1493,10 @@ type QueryClient interface {
 	ProposalVoteOptions(ctx context.Context, in *QueryProposalVoteOptionsRequest, opts ...grpc.CallOption) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// VoteBreakdown queries, for each bonded validator, how much of its voting
+	// power on a proposal in voting period is inherited from its delegators who
+	// did not vote, and how much is overridden by the votes of its delegators.
+	VoteBreakdown(ctx context.Context, in *QueryVoteBreakdownRequest, opts ...grpc.CallOption) (*QueryVoteBreakdownResponse, error)
 }
 
 type queryClient struct {
@@ -1408,6 +1606,15 @@ func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBa
 	return out, nil
 }
 
+func (c *queryClient) VoteBreakdown(ctx context.Context, in *QueryVoteBreakdownRequest, opts ...grpc.CallOption) (*QueryVoteBreakdownResponse, error) {
+	out := new(QueryVoteBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/VoteBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Constitution queries the chain's constitution.
@@ -1432,6 +1639,10 @@ type QueryServer interface {
 	ProposalVoteOptions(context.Context, *QueryProposalVoteOptionsRequest) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// VoteBreakdown queries, for each bonded validator, how much of its voting
+	// power on a proposal in voting period is inherited from its delegators who
+	// did not vote, and how much is overridden by the votes of its delegators.
+	VoteBreakdown(context.Context, *QueryVoteBreakdownRequest) (*QueryVoteBreakdownResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1471,6 +1682,9 @@ func (*UnimplementedQueryServer) ProposalVoteOptions(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) MessageBasedParams(ctx context.Context, req *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
 }
+func (*UnimplementedQueryServer) VoteBreakdown(ctx context.Context, req *QueryVoteBreakdownRequest) (*QueryVoteBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteBreakdown not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1674,6 +1888,24 @@ func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VoteBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/VoteBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteBreakdown(ctx, req.(*QueryVoteBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1722,6 +1954,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MessageBasedParams",
 			Handler:    _Query_MessageBasedParams_Handler,
 		},
+		{
+			MethodName: "VoteBreakdown",
+			Handler:    _Query_VoteBreakdown_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -2556,6 +2792,136 @@ func (m *QueryMessageBasedParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryVoteBreakdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteBreakdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteBreakdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteBreakdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteBreakdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteBreakdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorVoteBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorVoteBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorVoteBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InheritedPower) > 0 {
+		i -= len(m.InheritedPower)
+		copy(dAtA[i:], m.InheritedPower)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InheritedPower)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OverriddenPower) > 0 {
+		i -= len(m.OverriddenPower)
+		copy(dAtA[i:], m.OverriddenPower)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OverriddenPower)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VotingPower) > 0 {
+		i -= len(m.VotingPower)
+		copy(dAtA[i:], m.VotingPower)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VotingPower)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2899,27 +3265,85 @@ func (m *QueryMessageBasedParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryVoteBreakdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryVoteBreakdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *QueryConstitutionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
+
+func (m *ValidatorVoteBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.VotingPower)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OverriddenPower)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.InheritedPower)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryConstitutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
@@ -5059,6 +5483,371 @@ func (m *QueryMessageBasedParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryVoteBreakdownRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteBreakdownRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteBreakdownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteBreakdownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &ValidatorVoteBreakdown{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorVoteBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorVoteBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorVoteBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverriddenPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OverriddenPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InheritedPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InheritedPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,11 +1,14 @@
 package v1
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"strings"
 
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -27,6 +30,24 @@ func NewVote(proposalID uint64, voter string, options WeightedVoteOptions, metad
 	return Vote{ProposalId: proposalID, Voter: voter, Options: options, Metadata: metadata}
 }
 
+// VoteCommitmentHash returns the commitment of a vote on a commit-reveal proposal.
+// It is the SHA-256 hash of the proposal id, the voter address, the weighted vote
+// options and the salt chosen by the voter.
+func VoteCommitmentHash(proposalID uint64, voter string, options WeightedVoteOptions, salt []byte) []byte {
+	h := sha256.New()
+	h.Write(sdk.Uint64ToBigEndian(proposalID))
+	h.Write(sdk.Uint64ToBigEndian(uint64(len(voter))))
+	h.Write([]byte(voter))
+	for _, option := range options {
+		h.Write(sdk.Uint64ToBigEndian(uint64(option.Option)))
+		h.Write(sdk.Uint64ToBigEndian(uint64(len(option.Weight))))
+		h.Write([]byte(option.Weight))
+	}
+	h.Write(salt)
+
+	return h.Sum(nil)
+}
+
 // Empty returns whether a vote is empty.
 func (v Vote) Empty() bool {
 	return v.ProposalId == 0 || v.Voter == "" || len(v.Options) == 0
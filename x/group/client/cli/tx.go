@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -41,6 +42,7 @@ func TxCmd(name string) *cobra.Command {
 		MsgCreateGroupPolicyCmd(),
 		MsgUpdateGroupPolicyDecisionPolicyCmd(),
 		MsgSubmitProposalCmd(),
+		MsgVoteWeightedCmd(),
 		NewCmdDraftProposal(),
 	)
 
@@ -334,6 +336,19 @@ Here, we can use percentage decision policy when needed, where 0 < percentage <=
         "voting_period": "120h",
         "min_execution_period": "0s"
     }
+}
+
+Or a guardian decision policy, where the guardian can veto the accepted proposals during the veto window:
+
+{
+    "@type": "/cosmos.group.v1.GuardianDecisionPolicy",
+    "threshold": "1",
+    "windows": {
+        "voting_period": "120h",
+        "min_execution_period": "0s"
+    },
+    "guardian": "cosmos1...",
+    "veto_window": "48h"
 }`, version.AppName),
 		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -539,3 +554,71 @@ metadata example:
 
 	return cmd
 }
+
+// MsgVoteWeightedCmd creates a CLI command for Msg/VoteWeighted.
+//
+// This command is being handled better here, not converting to autocli
+func MsgVoteWeightedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-weighted [proposal-id] [voter] [weighted-options] [metadata]",
+		Short: "Split the voter's weight across several vote options",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Vote on a proposal by splitting the voter's weight across several vote options.
+The weights of the options must sum up to 1.
+
+Example:
+$ %s tx group vote-weighted 1 [voter] yes=0.6,no=0.3,abstain=0.05,no-with-veto=0.05
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			options, err := parseWeightedVoteOptions(args[2])
+			if err != nil {
+				return err
+			}
+
+			voter, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			var metadata string
+			if len(args) > 3 {
+				metadata = args[3]
+			}
+
+			execStr, _ := cmd.Flags().GetString(FlagExec)
+			msg := &group.MsgVoteWeighted{
+				ProposalId: proposalID,
+				Voter:      voter,
+				Options:    options,
+				Metadata:   metadata,
+				Exec:       execFromString(execStr),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExec, "", "Set to 'try' for trying to execute proposal immediately after voting")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
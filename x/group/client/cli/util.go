@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"cosmossdk.io/x/group"
 
@@ -53,6 +54,39 @@ func parseMembers(membersFile string) ([]group.MemberRequest, error) {
 	return members.Members, nil
 }
 
+// parseWeightedVoteOptions parses weighted vote options of the form
+// `yes=0.6,no=0.4`. Options can be written with their full enum name too,
+// e.g. `VOTE_OPTION_YES=0.6`.
+func parseWeightedVoteOptions(str string) ([]group.WeightedVoteOption, error) {
+	var options []group.WeightedVoteOption
+	for _, option := range strings.Split(str, ",") {
+		fields := strings.Split(strings.TrimSpace(option), "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid weighted vote option %q, expected option=weight", option)
+		}
+
+		name := strings.ToUpper(strings.ReplaceAll(fields[0], "-", "_"))
+		if !strings.HasPrefix(name, "VOTE_OPTION_") {
+			name = "VOTE_OPTION_" + name
+		}
+		value, ok := group.VoteOption_value[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid vote option %q", fields[0])
+		}
+
+		options = append(options, group.WeightedVoteOption{
+			Option: group.VoteOption(value),
+			Weight: fields[1],
+		})
+	}
+
+	if err := group.ValidateWeightedVoteOptions(options); err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
 func execFromString(execStr string) group.Exec {
 	exec := group.Exec_EXEC_UNSPECIFIED
 	if execStr == ExecTry {
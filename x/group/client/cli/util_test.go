@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/group"
 )
 
 func TestParseCLIProposal(t *testing.T) {
@@ -32,3 +34,23 @@ func TestParseCLIProposal(t *testing.T) {
 	require.Equal(t, result.Title, "test")
 	require.Equal(t, result.Summary, "test summary")
 }
+
+func TestParseWeightedVoteOptions(t *testing.T) {
+	options, err := parseWeightedVoteOptions("yes=0.6,No=0.3, no-with-veto=0.05,VOTE_OPTION_ABSTAIN=0.05")
+	require.NoError(t, err)
+	require.Equal(t, []group.WeightedVoteOption{
+		{Option: group.VOTE_OPTION_YES, Weight: "0.6"},
+		{Option: group.VOTE_OPTION_NO, Weight: "0.3"},
+		{Option: group.VOTE_OPTION_NO_WITH_VETO, Weight: "0.05"},
+		{Option: group.VOTE_OPTION_ABSTAIN, Weight: "0.05"},
+	}, options)
+
+	_, err = parseWeightedVoteOptions("yes=0.6,no=0.3")
+	require.ErrorContains(t, err, "total weight of vote options must be 1")
+
+	_, err = parseWeightedVoteOptions("yes")
+	require.ErrorContains(t, err, "expected option=weight")
+
+	_, err = parseWeightedVoteOptions("maybe=1")
+	require.ErrorContains(t, err, "invalid vote option")
+}
@@ -20,6 +20,11 @@ type Config struct {
 	// summary field
 	// Defaults to 10200 if not explicitly set.
 	MaxProposalSummaryLen uint64
+
+	// MemberExitDelay defines the duration after which a group member
+	// leaving a group is removed from it.
+	// Members leave their group immediately if not set.
+	MemberExitDelay time.Duration
 }
 
 // DefaultConfig returns the default config for group.
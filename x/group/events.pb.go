@@ -6,16 +6,21 @@ package group
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -528,6 +533,249 @@ func (m *EventProposalPruned) GetTallyResult() *TallyResult {
 	return nil
 }
 
+// EventDelegateWeight is an event emitted when a group member delegates its weight.
+type EventDelegateWeight struct {
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// delegator is the account address of the group member delegating its weight.
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the account address of the group member receiving the weight,
+	// it is empty when the delegation is removed.
+	Delegate string `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate,omitempty"`
+}
+
+func (m *EventDelegateWeight) Reset()         { *m = EventDelegateWeight{} }
+func (m *EventDelegateWeight) String() string { return proto.CompactTextString(m) }
+func (*EventDelegateWeight) ProtoMessage()    {}
+func (*EventDelegateWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{10}
+}
+func (m *EventDelegateWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDelegateWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDelegateWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDelegateWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDelegateWeight.Merge(m, src)
+}
+func (m *EventDelegateWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDelegateWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDelegateWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDelegateWeight proto.InternalMessageInfo
+
+func (m *EventDelegateWeight) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *EventDelegateWeight) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *EventDelegateWeight) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+// EventProposalExecutionScheduled is an event emitted when an accepted proposal
+// is scheduled for execution after the execution delay of its decision policy.
+type EventProposalExecutionScheduled struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// execution_time is the time from which the proposal is executed.
+	ExecutionTime time.Time `protobuf:"bytes,2,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time"`
+}
+
+func (m *EventProposalExecutionScheduled) Reset()         { *m = EventProposalExecutionScheduled{} }
+func (m *EventProposalExecutionScheduled) String() string { return proto.CompactTextString(m) }
+func (*EventProposalExecutionScheduled) ProtoMessage()    {}
+func (*EventProposalExecutionScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{11}
+}
+func (m *EventProposalExecutionScheduled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProposalExecutionScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProposalExecutionScheduled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProposalExecutionScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProposalExecutionScheduled.Merge(m, src)
+}
+func (m *EventProposalExecutionScheduled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProposalExecutionScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProposalExecutionScheduled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProposalExecutionScheduled proto.InternalMessageInfo
+
+func (m *EventProposalExecutionScheduled) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventProposalExecutionScheduled) GetExecutionTime() time.Time {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return time.Time{}
+}
+
+// EventLeaveGroupScheduled is an event emitted when a group member requests to
+// leave the group, and is removed from it after the member exit delay of the
+// module.
+type EventLeaveGroupScheduled struct {
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// address is the account address of the group member.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// exit_time is the time at which the member is removed from the group.
+	ExitTime time.Time `protobuf:"bytes,3,opt,name=exit_time,json=exitTime,proto3,stdtime" json:"exit_time"`
+}
+
+func (m *EventLeaveGroupScheduled) Reset()         { *m = EventLeaveGroupScheduled{} }
+func (m *EventLeaveGroupScheduled) String() string { return proto.CompactTextString(m) }
+func (*EventLeaveGroupScheduled) ProtoMessage()    {}
+func (*EventLeaveGroupScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{12}
+}
+func (m *EventLeaveGroupScheduled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLeaveGroupScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLeaveGroupScheduled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventLeaveGroupScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLeaveGroupScheduled.Merge(m, src)
+}
+func (m *EventLeaveGroupScheduled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLeaveGroupScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLeaveGroupScheduled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLeaveGroupScheduled proto.InternalMessageInfo
+
+func (m *EventLeaveGroupScheduled) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *EventLeaveGroupScheduled) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventLeaveGroupScheduled) GetExitTime() time.Time {
+	if m != nil {
+		return m.ExitTime
+	}
+	return time.Time{}
+}
+
+// EventProposalVetoed is an event emitted when an accepted proposal is vetoed
+// by the guardian of its decision policy.
+type EventProposalVetoed struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// guardian is the account address of the guardian who vetoed the proposal.
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *EventProposalVetoed) Reset()         { *m = EventProposalVetoed{} }
+func (m *EventProposalVetoed) String() string { return proto.CompactTextString(m) }
+func (*EventProposalVetoed) ProtoMessage()    {}
+func (*EventProposalVetoed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{13}
+}
+func (m *EventProposalVetoed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProposalVetoed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProposalVetoed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProposalVetoed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProposalVetoed.Merge(m, src)
+}
+func (m *EventProposalVetoed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProposalVetoed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProposalVetoed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProposalVetoed proto.InternalMessageInfo
+
+func (m *EventProposalVetoed) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventProposalVetoed) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreateGroup)(nil), "cosmos.group.v1.EventCreateGroup")
 	proto.RegisterType((*EventUpdateGroup)(nil), "cosmos.group.v1.EventUpdateGroup")
@@ -539,40 +787,56 @@ func init() {
 	proto.RegisterType((*EventExec)(nil), "cosmos.group.v1.EventExec")
 	proto.RegisterType((*EventLeaveGroup)(nil), "cosmos.group.v1.EventLeaveGroup")
 	proto.RegisterType((*EventProposalPruned)(nil), "cosmos.group.v1.EventProposalPruned")
+	proto.RegisterType((*EventDelegateWeight)(nil), "cosmos.group.v1.EventDelegateWeight")
+	proto.RegisterType((*EventProposalExecutionScheduled)(nil), "cosmos.group.v1.EventProposalExecutionScheduled")
+	proto.RegisterType((*EventLeaveGroupScheduled)(nil), "cosmos.group.v1.EventLeaveGroupScheduled")
+	proto.RegisterType((*EventProposalVetoed)(nil), "cosmos.group.v1.EventProposalVetoed")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/events.proto", fileDescriptor_e8d753981546f032) }
 
 var fileDescriptor_e8d753981546f032 = []byte{
-	// 433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0x8b, 0xda, 0x40,
-	0x18, 0xc6, 0x1d, 0x2b, 0x5a, 0xc7, 0x52, 0xcb, 0xf4, 0x0f, 0xd1, 0x4a, 0x14, 0x2f, 0xf5, 0x50,
-	0x13, 0xb4, 0xd0, 0xf6, 0x26, 0xb5, 0x48, 0x11, 0x3c, 0x48, 0xec, 0x1f, 0xe8, 0xc5, 0x46, 0x67,
-	0xb0, 0xa1, 0xd1, 0x09, 0x33, 0x93, 0x54, 0x8f, 0xfb, 0x0d, 0xf6, 0xa3, 0xec, 0x61, 0x3f, 0xc4,
-	0x1e, 0x65, 0x4f, 0x7b, 0x5c, 0xf4, 0x8b, 0x2c, 0x99, 0x4c, 0x54, 0x5c, 0x96, 0x08, 0x7b, 0x9b,
-	0x99, 0xf7, 0xf7, 0x3c, 0x79, 0x9f, 0x79, 0x33, 0xb0, 0x32, 0xa5, 0x7c, 0x4e, 0xb9, 0x39, 0x63,
-	0xd4, 0xf7, 0xcc, 0xa0, 0x65, 0x92, 0x80, 0x2c, 0x04, 0x37, 0x3c, 0x46, 0x05, 0x45, 0xc5, 0xa8,
-	0x6a, 0xc8, 0xaa, 0x11, 0xb4, 0xca, 0xa5, 0xe8, 0x60, 0x2c, 0xcb, 0xa6, 0xaa, 0xca, 0x4d, 0xf9,
-	0xed, 0xb1, 0x93, 0x58, 0x79, 0x44, 0x15, 0xeb, 0x4d, 0xf8, 0xa2, 0x17, 0x1a, 0x7f, 0x65, 0xc4,
-	0x16, 0xe4, 0x5b, 0x88, 0xa0, 0x12, 0x7c, 0x2a, 0xd9, 0xb1, 0x83, 0x35, 0x50, 0x03, 0x8d, 0x8c,
-	0x95, 0x93, 0xfb, 0x3e, 0xde, 0xe1, 0x3f, 0x3c, 0x7c, 0x0a, 0x3e, 0x80, 0x6f, 0x8e, 0xdd, 0x87,
-	0xd4, 0x75, 0xa6, 0x2b, 0xd4, 0x86, 0x39, 0x1b, 0x63, 0x46, 0x38, 0x97, 0x9a, 0x7c, 0x57, 0xbb,
-	0xbe, 0x6c, 0xbe, 0x52, 0x7d, 0x7f, 0x89, 0x2a, 0x23, 0xc1, 0x9c, 0xc5, 0xcc, 0x8a, 0xc1, 0x9d,
-	0xdb, 0xc1, 0xc7, 0x1f, 0xe1, 0xf6, 0x11, 0xbe, 0x94, 0x6e, 0x23, 0x7f, 0x32, 0x77, 0xc4, 0x90,
-	0x51, 0x8f, 0x72, 0xdb, 0x45, 0x55, 0x58, 0xf0, 0xd4, 0x7a, 0x1f, 0x08, 0xc6, 0x47, 0x7d, 0x5c,
-	0xff, 0x0c, 0x5f, 0x4b, 0xdd, 0x2f, 0x47, 0xfc, 0xc5, 0xcc, 0xfe, 0x7f, 0xba, 0xf2, 0x3d, 0xcc,
-	0x4b, 0xe5, 0x4f, 0x2a, 0x48, 0x32, 0x7d, 0x06, 0x14, 0xde, 0x5b, 0x92, 0x69, 0x22, 0x8e, 0x3a,
-	0x30, 0xcb, 0x08, 0xf7, 0x5d, 0xa1, 0xa5, 0x6b, 0xa0, 0xf1, 0xbc, 0xfd, 0xce, 0x38, 0xfa, 0x45,
-	0x8c, 0xb8, 0xd1, 0xd0, 0xcf, 0x17, 0x94, 0x59, 0x12, 0xb7, 0x94, 0x0c, 0x21, 0x98, 0x71, 0xe9,
-	0x8c, 0x6b, 0x4f, 0xc2, 0x0b, 0xb4, 0xe4, 0xba, 0xfe, 0x07, 0x16, 0x65, 0x0b, 0x03, 0x62, 0x07,
-	0x89, 0xd3, 0x3e, 0x9c, 0x42, 0xfa, 0xd4, 0x29, 0x5c, 0x00, 0x35, 0x86, 0xb8, 0xbb, 0x21, 0xf3,
-	0x17, 0x04, 0x27, 0xe7, 0xfd, 0x04, 0xb3, 0x5c, 0xd8, 0xc2, 0xe7, 0x2a, 0x6f, 0xf5, 0xc1, 0xbc,
-	0x23, 0x89, 0x59, 0x0a, 0x47, 0x1d, 0xf8, 0x4c, 0xd8, 0xae, 0xbb, 0x1a, 0xab, 0xeb, 0x0a, 0xf3,
-	0x16, 0xda, 0x95, 0x7b, 0xf2, 0xef, 0x21, 0xa4, 0xee, 0xa8, 0x20, 0xf6, 0x9b, 0xae, 0x71, 0xb5,
-	0xd1, 0xc1, 0x7a, 0xa3, 0x83, 0xdb, 0x8d, 0x0e, 0xce, 0xb7, 0x7a, 0x6a, 0xbd, 0xd5, 0x53, 0x37,
-	0x5b, 0x3d, 0xf5, 0x5b, 0x65, 0xe5, 0xf8, 0x9f, 0xe1, 0x50, 0x73, 0x19, 0xbd, 0xb8, 0x49, 0x56,
-	0xbe, 0xb4, 0x0f, 0x77, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x96, 0x2a, 0x36, 0xd2, 0x03, 0x00,
-	0x00,
+	// 630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x9b, 0x2a, 0x4d, 0x36, 0xd0, 0x22, 0xb7, 0x20, 0x37, 0x54, 0x76, 0x94, 0x0b, 0x3d,
+	0x10, 0x9b, 0x86, 0x52, 0x10, 0x97, 0xaa, 0x81, 0x0a, 0x55, 0xf4, 0x10, 0x39, 0xa5, 0x95, 0xb8,
+	0x04, 0x27, 0xbb, 0x38, 0xab, 0x3a, 0x59, 0x6b, 0x77, 0x1d, 0x92, 0x63, 0xff, 0xa0, 0x1f, 0xc1,
+	0x07, 0x20, 0xd1, 0x1f, 0xe0, 0xd6, 0x63, 0xd5, 0x13, 0x27, 0x40, 0xc9, 0x8f, 0x20, 0xaf, 0xd7,
+	0x49, 0x09, 0x2d, 0x0e, 0x82, 0xdb, 0xce, 0xce, 0x7b, 0xe3, 0x79, 0x6f, 0x47, 0x63, 0xb0, 0xd6,
+	0x22, 0xac, 0x43, 0x98, 0xe5, 0x52, 0x12, 0xf8, 0x56, 0x6f, 0xc3, 0x42, 0x3d, 0xd4, 0xe5, 0xcc,
+	0xf4, 0x29, 0xe1, 0x44, 0x5d, 0x8a, 0xb2, 0xa6, 0xc8, 0x9a, 0xbd, 0x8d, 0xc2, 0x6a, 0x74, 0xd1,
+	0x10, 0x69, 0x4b, 0x66, 0x45, 0x50, 0xb8, 0x3f, 0x5d, 0x89, 0x0f, 0x7c, 0x14, 0x27, 0x57, 0x5c,
+	0xe2, 0x92, 0x88, 0x14, 0x9e, 0xe4, 0xad, 0xe1, 0x12, 0xe2, 0x7a, 0xc8, 0x12, 0x51, 0x33, 0x78,
+	0x6f, 0x71, 0xdc, 0x41, 0x8c, 0x3b, 0x1d, 0x3f, 0x02, 0x94, 0xca, 0xe0, 0xce, 0x6e, 0xd8, 0xcf,
+	0x0b, 0x8a, 0x1c, 0x8e, 0x5e, 0x85, 0x95, 0xd5, 0x55, 0x90, 0x15, 0x9f, 0x68, 0x60, 0xa8, 0x29,
+	0x45, 0x65, 0x7d, 0xde, 0x5e, 0x10, 0xf1, 0x1e, 0x1c, 0xc3, 0xdf, 0xf8, 0x70, 0x16, 0xf8, 0x3e,
+	0xb8, 0x37, 0x5d, 0xbd, 0x46, 0x3c, 0xdc, 0x1a, 0xa8, 0x15, 0xb0, 0xe0, 0x40, 0x48, 0x11, 0x63,
+	0x82, 0x93, 0xab, 0x6a, 0x97, 0x67, 0xe5, 0x15, 0x29, 0x77, 0x27, 0xca, 0xd4, 0x39, 0xc5, 0x5d,
+	0xd7, 0x8e, 0x81, 0xe3, 0x6a, 0x57, 0x3e, 0xfe, 0x0f, 0xd5, 0xb6, 0xc0, 0xb2, 0xa8, 0x56, 0x0f,
+	0x9a, 0x1d, 0xcc, 0x6b, 0x94, 0xf8, 0x84, 0x39, 0x9e, 0x6a, 0x80, 0xbc, 0x2f, 0xcf, 0x13, 0x41,
+	0x20, 0xbe, 0xda, 0x83, 0xa5, 0x67, 0xe0, 0xae, 0xe0, 0x1d, 0x61, 0xde, 0x86, 0xd4, 0xf9, 0x30,
+	0x3b, 0xf3, 0x21, 0xc8, 0x09, 0xe6, 0x21, 0xe1, 0x28, 0x19, 0x7d, 0xa2, 0x48, 0xf8, 0x6e, 0x1f,
+	0xb5, 0x12, 0xe1, 0xea, 0x36, 0xc8, 0x50, 0xc4, 0x02, 0x8f, 0x6b, 0x73, 0x45, 0x65, 0x7d, 0xb1,
+	0xf2, 0xc0, 0x9c, 0x9a, 0x2c, 0x33, 0x6e, 0x34, 0xac, 0x17, 0x70, 0x42, 0x6d, 0x01, 0xb7, 0x25,
+	0x4d, 0x55, 0xc1, 0xbc, 0x47, 0x5c, 0xa6, 0xa5, 0x43, 0x03, 0x6d, 0x71, 0x2e, 0xbd, 0x03, 0x4b,
+	0xa2, 0x85, 0x7d, 0xe4, 0xf4, 0x12, 0x5f, 0xfb, 0xea, 0x2b, 0xcc, 0xcd, 0xfa, 0x0a, 0x9f, 0x14,
+	0xf9, 0x0c, 0x71, 0x77, 0x35, 0x1a, 0x74, 0x11, 0x4c, 0xd6, 0xfb, 0x14, 0x64, 0x18, 0x77, 0x78,
+	0xc0, 0xa4, 0x5e, 0xe3, 0x46, 0xbd, 0x75, 0x01, 0xb3, 0x25, 0x5c, 0xdd, 0x06, 0xb7, 0xb8, 0xe3,
+	0x79, 0x83, 0x86, 0xb4, 0x2b, 0xd4, 0x9b, 0xaf, 0xac, 0xfd, 0x46, 0x3f, 0x08, 0x41, 0xd2, 0xa3,
+	0x3c, 0x9f, 0x04, 0xa5, 0xcf, 0x71, 0xcb, 0x2f, 0x91, 0x87, 0x5c, 0x87, 0xa3, 0x23, 0x84, 0xdd,
+	0x36, 0xff, 0x93, 0x33, 0x5b, 0x20, 0x07, 0x23, 0x30, 0xa1, 0x89, 0xde, 0x4c, 0xa0, 0xea, 0x26,
+	0xc8, 0xca, 0x00, 0x69, 0xe9, 0x04, 0xda, 0x18, 0xf9, 0x7c, 0xf9, 0xf2, 0xac, 0x2c, 0xf7, 0x4a,
+	0x99, 0xc1, 0xe3, 0xe2, 0x23, 0xf3, 0x49, 0xa5, 0xf4, 0x51, 0x01, 0xc6, 0x2f, 0x46, 0x47, 0x63,
+	0x80, 0x49, 0xb7, 0xde, 0x6a, 0x23, 0x18, 0x78, 0xb3, 0x98, 0xfe, 0x1a, 0x2c, 0xa2, 0x98, 0xd6,
+	0x08, 0x57, 0x89, 0x10, 0x93, 0xaf, 0x14, 0xcc, 0x68, 0xcf, 0x98, 0xf1, 0x9e, 0x31, 0x0f, 0xe2,
+	0x3d, 0x53, 0xcd, 0x9e, 0x7f, 0x33, 0x52, 0xa7, 0xdf, 0x0d, 0xc5, 0xbe, 0x3d, 0xe6, 0x86, 0xd9,
+	0xeb, 0xdb, 0xfc, 0xa2, 0x00, 0x6d, 0x6a, 0xe4, 0x26, 0xfd, 0xfd, 0xdf, 0xd9, 0x53, 0x77, 0x40,
+	0x0e, 0xf5, 0x31, 0x8f, 0x84, 0xa4, 0xff, 0x42, 0x48, 0x36, 0xa4, 0xdd, 0xac, 0xe1, 0x64, 0x7a,
+	0xa6, 0x0f, 0x11, 0x27, 0xb3, 0xd8, 0xbb, 0x09, 0xb2, 0x6e, 0xe0, 0x50, 0x88, 0x9d, 0x6e, 0xa2,
+	0x8a, 0x31, 0xf2, 0xda, 0x1e, 0xaa, 0xe6, 0xf9, 0x50, 0x57, 0x2e, 0x86, 0xba, 0xf2, 0x63, 0xa8,
+	0x2b, 0xa7, 0x23, 0x3d, 0x75, 0x31, 0xd2, 0x53, 0x5f, 0x47, 0x7a, 0xea, 0xad, 0x2c, 0xc7, 0xe0,
+	0xb1, 0x89, 0x89, 0xd5, 0x8f, 0xfe, 0x26, 0xcd, 0x8c, 0x10, 0xfc, 0xf8, 0xe7, 0x00, 0x1a, 0x38,
+	0xeb, 0xa7, 0xae, 0x06, 0x00, 0x00,
 }
 
 func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
@@ -895,98 +1159,254 @@ func (m *EventProposalPruned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventDelegateWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventCreateGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovEvents(uint64(m.GroupId))
-	}
-	return n
+
+func (m *EventDelegateWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventDelegateWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.GroupId != 0 {
-		n += 1 + sovEvents(uint64(m.GroupId))
+		i = encodeVarintEvents(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCreateGroupPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventProposalExecutionScheduled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventUpdateGroupPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+func (m *EventProposalExecutionScheduled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSubmitProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventProposalExecutionScheduled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvents(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
 	if m.ProposalId != 0 {
-		n += 1 + sovEvents(uint64(m.ProposalId))
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventWithdrawProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovEvents(uint64(m.ProposalId))
+func (m *EventLeaveGroupScheduled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventVote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
+func (m *EventLeaveGroupScheduled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLeaveGroupScheduled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExitTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintEvents(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventProposalVetoed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProposalVetoed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProposalVetoed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCreateGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *EventUpdateGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *EventCreateGroupPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateGroupPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSubmitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *EventWithdrawProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *EventVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
 		n += 1 + sovEvents(uint64(m.ProposalId))
 	}
 	return n
@@ -1046,6 +1466,74 @@ func (m *EventProposalPruned) Size() (n int) {
 	return n
 }
 
+func (m *EventDelegateWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GroupId))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventProposalExecutionScheduled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime)
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventLeaveGroupScheduled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GroupId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExitTime)
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventProposalVetoed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1387,7 +1875,353 @@ func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			m.ProposalId = 0
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdrawProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventExec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ProposalExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventLeaveGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1397,11 +2231,24 @@ func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1423,7 +2270,7 @@ func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
+func (m *EventProposalPruned) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1446,10 +2293,10 @@ func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdrawProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventProposalPruned: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventProposalPruned: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1471,6 +2318,61 @@ func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TallyResult == nil {
+				m.TallyResult = &TallyResult{}
+			}
+			if err := m.TallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1492,7 +2394,7 @@ func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventVote) Unmarshal(dAtA []byte) error {
+func (m *EventDelegateWeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1515,17 +2417,17 @@ func (m *EventVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDelegateWeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDelegateWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.ProposalId = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1535,11 +2437,75 @@ func (m *EventVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1561,7 +2527,7 @@ func (m *EventVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventExec) Unmarshal(dAtA []byte) error {
+func (m *EventProposalExecutionScheduled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1584,10 +2550,10 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventExec: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventProposalExecutionScheduled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventExec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventProposalExecutionScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1610,29 +2576,10 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ProposalExecutorResult(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1642,23 +2589,24 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1681,7 +2629,7 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
+func (m *EventLeaveGroupScheduled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1704,10 +2652,10 @@ func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventLeaveGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLeaveGroupScheduled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLeaveGroupScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1761,6 +2709,39 @@ func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1782,7 +2763,7 @@ func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventProposalPruned) Unmarshal(dAtA []byte) error {
+func (m *EventProposalVetoed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1805,10 +2786,10 @@ func (m *EventProposalPruned) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventProposalPruned: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventProposalVetoed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventProposalPruned: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventProposalVetoed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1831,29 +2812,10 @@ func (m *EventProposalPruned) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ProposalStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TallyResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1863,27 +2825,23 @@ func (m *EventProposalPruned) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TallyResult == nil {
-				m.TallyResult = &TallyResult{}
-			}
-			if err := m.TallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
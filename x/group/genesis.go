@@ -21,6 +21,7 @@ func (s GenesisState) Validate() error {
 	groups := make(map[uint64]GroupInfo)
 	groupPolicies := make(map[string]GroupPolicyInfo)
 	groupMembers := make(map[uint64]GroupMember)
+	memberAddresses := make(map[uint64]map[string]bool)
 	proposals := make(map[uint64]Proposal)
 
 	for _, g := range s.Groups {
@@ -54,6 +55,32 @@ func (s GenesisState) Validate() error {
 			return errorsmod.Wrap(err, "GroupMember validation failed")
 		}
 		groupMembers[g.GroupId] = *g
+		if memberAddresses[g.GroupId] == nil {
+			memberAddresses[g.GroupId] = make(map[string]bool)
+		}
+		memberAddresses[g.GroupId][g.Member.Address] = true
+	}
+
+	for _, d := range s.WeightDelegations {
+		if err := d.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "WeightDelegation validation failed")
+		}
+
+		// check that both the delegator and the delegate are members of the group
+		if !memberAddresses[d.GroupId][d.Delegator] || !memberAddresses[d.GroupId][d.Delegate] {
+			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("weight delegation from %s to %s is not between members of group %d", d.Delegator, d.Delegate, d.GroupId))
+		}
+	}
+
+	for _, e := range s.MemberExits {
+		if err := e.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "MemberExit validation failed")
+		}
+
+		// check that the member leaving is a member of the group
+		if !memberAddresses[e.GroupId][e.Address] {
+			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("member exit of %s is not of a member of group %d", e.Address, e.GroupId))
+		}
 	}
 
 	for _, p := range s.Proposals {
@@ -5,6 +5,7 @@ package group
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -43,6 +44,10 @@ type GenesisState struct {
 	Proposals []*Proposal `protobuf:"bytes,7,rep,name=proposals,proto3" json:"proposals,omitempty"`
 	// votes is the list of votes.
 	Votes []*Vote `protobuf:"bytes,8,rep,name=votes,proto3" json:"votes,omitempty"`
+	// weight_delegations is the list of the group members' weight delegations.
+	WeightDelegations []*WeightDelegation `protobuf:"bytes,9,rep,name=weight_delegations,json=weightDelegations,proto3" json:"weight_delegations,omitempty"`
+	// member_exits is the list of the group members leaving their group.
+	MemberExits []*MemberExit `protobuf:"bytes,10,rep,name=member_exits,json=memberExits,proto3" json:"member_exits,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +139,20 @@ func (m *GenesisState) GetVotes() []*Vote {
 	return nil
 }
 
+func (m *GenesisState) GetWeightDelegations() []*WeightDelegation {
+	if m != nil {
+		return m.WeightDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMemberExits() []*MemberExit {
+	if m != nil {
+		return m.MemberExits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.group.v1.GenesisState")
 }
@@ -141,28 +160,33 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/genesis.proto", fileDescriptor_cc6105fe3ef99f06) }
 
 var fileDescriptor_cc6105fe3ef99f06 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4a, 0x7a, 0x41,
-	0x14, 0xc7, 0xbd, 0x3f, 0xff, 0xfc, 0x74, 0xfc, 0x53, 0x0c, 0x05, 0x37, 0xad, 0xc1, 0x5a, 0x09,
-	0xc1, 0x5c, 0xb4, 0x45, 0xeb, 0xda, 0x48, 0x8b, 0x40, 0xae, 0xd0, 0xa2, 0x4d, 0xa8, 0x9d, 0xe4,
-	0x92, 0x7a, 0xc6, 0x7b, 0x26, 0xc9, 0xb7, 0xe8, 0x09, 0x7a, 0x9e, 0x96, 0x2e, 0x5b, 0x86, 0xbe,
-	0x48, 0x78, 0x46, 0x31, 0xd4, 0xdd, 0xcc, 0x99, 0xcf, 0xf7, 0x7c, 0xbe, 0x30, 0xe2, 0xac, 0x87,
-	0x34, 0x44, 0x0a, 0xfa, 0x31, 0xbe, 0x99, 0x60, 0x52, 0x0f, 0xfa, 0x30, 0x02, 0x8a, 0x48, 0x9b,
-	0x18, 0x2d, 0xca, 0x03, 0xf7, 0xac, 0xf9, 0x59, 0x4f, 0xea, 0xe5, 0xca, 0x36, 0x6f, 0xa7, 0x06,
-	0x56, 0xf4, 0xc5, 0x67, 0x52, 0x14, 0x9a, 0x2e, 0xdf, 0xb6, 0x1d, 0x0b, 0xb2, 0x22, 0x72, 0x0c,
-	0x3e, 0x11, 0x8c, 0x7d, 0xaf, 0xea, 0xd5, 0x52, 0x61, 0x96, 0x07, 0x6d, 0x18, 0xcb, 0x86, 0xc8,
-	0xf0, 0x99, 0xfc, 0x7f, 0xd5, 0x64, 0x2d, 0xdf, 0x28, 0xeb, 0x2d, 0x99, 0x6e, 0x2e, 0x0f, 0x77,
-	0xa3, 0x17, 0x0c, 0x57, 0xa4, 0xbc, 0x11, 0x45, 0xb7, 0x70, 0x08, 0xc3, 0x2e, 0xc4, 0xe4, 0x27,
-	0x39, 0x7a, 0xba, 0x3f, 0x7a, 0xcf, 0x50, 0x58, 0xe8, 0x6f, 0x2e, 0x24, 0x6b, 0xe2, 0xd0, 0xad,
-	0x30, 0x38, 0x88, 0x7a, 0x53, 0xae, 0x96, 0xe2, 0x6a, 0x25, 0x9e, 0xb7, 0x78, 0xbc, 0x2c, 0xd8,
-	0x14, 0xa5, 0x3f, 0x64, 0x04, 0xe4, 0xa7, 0xd9, 0x56, 0xdd, 0x6f, 0x73, 0x41, 0xae, 0x5b, 0xdc,
-	0x6c, 0x8a, 0x80, 0xe4, 0xb9, 0x28, 0x98, 0x18, 0x0d, 0x52, 0x67, 0xc0, 0xba, 0x0c, 0xeb, 0xf2,
-	0xeb, 0xd9, 0xd2, 0x75, 0x2d, 0x72, 0xeb, 0x2b, 0xf9, 0xff, 0x59, 0x73, 0xb2, 0xa3, 0x69, 0xad,
-	0x88, 0x70, 0xc3, 0xca, 0x4b, 0x91, 0x9e, 0xa0, 0x05, 0xf2, 0xb3, 0x1c, 0x3a, 0xde, 0x09, 0x3d,
-	0xa0, 0x85, 0xd0, 0x31, 0xb7, 0xfa, 0x6b, 0xae, 0xbc, 0xd9, 0x5c, 0x79, 0x3f, 0x73, 0xe5, 0x7d,
-	0x2c, 0x54, 0x62, 0xb6, 0x50, 0x89, 0xef, 0x85, 0x4a, 0x3c, 0x1e, 0xb9, 0x18, 0x3d, 0xbf, 0xea,
-	0x08, 0x83, 0x77, 0xf7, 0xbf, 0xdd, 0x0c, 0xff, 0xeb, 0xd5, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x8c, 0xb4, 0xee, 0x7e, 0x26, 0x02, 0x00, 0x00,
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x8e, 0x12, 0x41,
+	0x10, 0xc6, 0x19, 0x97, 0xc5, 0xa5, 0x99, 0x5d, 0xb5, 0xd5, 0xa4, 0x17, 0x74, 0x32, 0xeb, 0x89,
+	0xc4, 0x6c, 0x8f, 0x8b, 0x31, 0x9e, 0xdd, 0x68, 0x88, 0x07, 0x93, 0xcd, 0x90, 0x68, 0xe2, 0x65,
+	0xc2, 0x2e, 0xe5, 0xd8, 0x81, 0xa1, 0x87, 0xa9, 0x96, 0x3f, 0x6f, 0xe1, 0xc3, 0xf8, 0x10, 0x1e,
+	0x39, 0x1a, 0x4e, 0x06, 0x5e, 0xc4, 0x50, 0x3d, 0x80, 0x61, 0xb8, 0x75, 0x55, 0xfd, 0xbe, 0xef,
+	0xab, 0xa4, 0x8b, 0x3d, 0xbf, 0xd3, 0x98, 0x68, 0x0c, 0xe2, 0x4c, 0xff, 0x48, 0x83, 0xf1, 0x55,
+	0x10, 0xc3, 0x10, 0x50, 0xa1, 0x4c, 0x33, 0x6d, 0x34, 0x7f, 0x60, 0xc7, 0x92, 0xc6, 0x72, 0x7c,
+	0x55, 0x6f, 0xec, 0xf3, 0x66, 0x96, 0x42, 0x4e, 0xd7, 0xcf, 0xed, 0x30, 0xa2, 0x2a, 0xc8, 0xa5,
+	0x54, 0xbc, 0x58, 0x94, 0x99, 0xdb, 0xb6, 0xd6, 0x1d, 0xd3, 0x35, 0xc0, 0x1b, 0xac, 0x4a, 0x1e,
+	0x11, 0xc2, 0x48, 0x38, 0xbe, 0xd3, 0x2c, 0x87, 0x27, 0xd4, 0xe8, 0xc0, 0x88, 0xb7, 0x58, 0x85,
+	0xde, 0x28, 0xee, 0xf9, 0x47, 0xcd, 0x5a, 0xab, 0x2e, 0xf7, 0xf6, 0x90, 0xed, 0xf5, 0xe3, 0xe3,
+	0xf0, 0x9b, 0x0e, 0x73, 0x92, 0xbf, 0x63, 0xa7, 0xd6, 0x30, 0x81, 0xe4, 0x16, 0x32, 0x14, 0x47,
+	0x24, 0x7d, 0x76, 0x58, 0xfa, 0x89, 0xa0, 0xd0, 0x8d, 0x77, 0x05, 0xf2, 0x26, 0x7b, 0x68, 0x2d,
+	0x52, 0x3d, 0x50, 0x77, 0x33, 0x5a, 0xad, 0x4c, 0xab, 0x9d, 0x51, 0xff, 0x86, 0xda, 0xeb, 0x05,
+	0xdb, 0xec, 0xec, 0x3f, 0x52, 0x01, 0x8a, 0x63, 0x4a, 0xf3, 0x0f, 0xa7, 0x59, 0x21, 0xad, 0x7b,
+	0xba, 0x73, 0x52, 0x80, 0xfc, 0x82, 0xb9, 0x69, 0xa6, 0x53, 0x8d, 0xdd, 0x01, 0xc5, 0x55, 0x28,
+	0xae, 0xb6, 0xe9, 0xad, 0xb3, 0xde, 0xb2, 0xea, 0xa6, 0x44, 0x71, 0x9f, 0x62, 0xce, 0x0b, 0x31,
+	0x37, 0x39, 0x11, 0xee, 0x58, 0xfe, 0x92, 0x1d, 0x8f, 0xb5, 0x01, 0x14, 0x27, 0x24, 0x7a, 0x5a,
+	0x10, 0x7d, 0xd6, 0x06, 0x42, 0xcb, 0x70, 0x60, 0x7c, 0x02, 0x2a, 0xfe, 0x6e, 0xa2, 0x1e, 0x0c,
+	0x20, 0xee, 0x1a, 0xa5, 0x87, 0x28, 0xaa, 0xa4, 0xbc, 0x28, 0x28, 0xbf, 0x10, 0xfa, 0x7e, 0x4b,
+	0x5e, 0x3f, 0x5e, 0xfc, 0xba, 0xcc, 0x8f, 0xe5, 0x12, 0x7b, 0x7d, 0xff, 0x95, 0x7c, 0xd3, 0x0a,
+	0x1f, 0x4d, 0xf6, 0x30, 0xe4, 0x1d, 0xe6, 0xda, 0xff, 0x89, 0x60, 0xaa, 0x0c, 0x0a, 0x46, 0x01,
+	0x8d, 0x42, 0x80, 0xfd, 0x92, 0x0f, 0x53, 0x65, 0x0e, 0x5b, 0xd7, 0x92, 0x2d, 0x80, 0xd7, 0xf2,
+	0xf7, 0xd2, 0x73, 0xe6, 0x4b, 0xcf, 0xf9, 0xbb, 0xf4, 0x9c, 0x9f, 0x2b, 0xaf, 0x34, 0x5f, 0x79,
+	0xa5, 0x3f, 0x2b, 0xaf, 0xf4, 0xf5, 0x89, 0xd5, 0x61, 0xaf, 0x2f, 0x95, 0x0e, 0xa6, 0xf6, 0x6c,
+	0x6f, 0x2b, 0x74, 0x93, 0xaf, 0xff, 0x0d, 0x00, 0x8c, 0x76, 0x9e, 0x29, 0xfd, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -185,6 +209,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MemberExits) > 0 {
+		for iNdEx := len(m.MemberExits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MemberExits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.WeightDelegations) > 0 {
+		for iNdEx := len(m.WeightDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Votes) > 0 {
 		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -329,6 +381,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.WeightDelegations) > 0 {
+		for _, e := range m.WeightDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MemberExits) > 0 {
+		for _, e := range m.MemberExits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -594,6 +658,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightDelegations = append(m.WeightDelegations, &WeightDelegation{})
+			if err := m.WeightDelegations[len(m.WeightDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberExits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberExits = append(m.MemberExits, &MemberExit{})
+			if err := m.MemberExits[len(m.MemberExits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
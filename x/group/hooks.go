@@ -0,0 +1,43 @@
+package group
+
+import (
+	"context"
+)
+
+// GroupHooks defines the hooks of the group module, so that apps can impose
+// consequences to the group members leaving their group, e.g. forfeiting their
+// pending rewards.
+type GroupHooks interface {
+	// AfterMemberExit is called after a member left a group, either when it
+	// sends MsgLeaveGroup or after the member exit delay of the module.
+	// openProposalIDs are the IDs of the proposals of the group which were
+	// open for voting when the member left, it is empty unless the member
+	// left mid-proposal.
+	// An error prevents the member from leaving the group.
+	AfterMemberExit(ctx context.Context, groupID uint64, member string, openProposalIDs []uint64) error
+}
+
+// GroupHooksWrapper is a wrapper for modules to inject GroupHooks using depinject.
+type GroupHooksWrapper struct{ GroupHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (GroupHooksWrapper) IsOnePerModuleType() {}
+
+// combine multiple group hooks, all hook functions are run in array sequence
+var _ GroupHooks = MultiGroupHooks{}
+
+type MultiGroupHooks []GroupHooks
+
+func NewMultiGroupHooks(hooks ...GroupHooks) MultiGroupHooks {
+	return hooks
+}
+
+func (h MultiGroupHooks) AfterMemberExit(ctx context.Context, groupID uint64, member string, openProposalIDs []uint64) error {
+	for i := range h {
+		if err := h[i].AfterMemberExit(ctx, groupID, member, openProposalIDs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
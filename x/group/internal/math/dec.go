@@ -123,6 +123,14 @@ func (x Dec) Quo(y Dec) (Dec, error) {
 	return z, errorsmod.Wrap(err, "decimal quotient error")
 }
 
+// Mul returns a new Dec with value `x*y` (formatted as decimal128, 34 digit precision) without mutating any
+// argument and error if there is an overflow.
+func (x Dec) Mul(y Dec) (Dec, error) {
+	var z Dec
+	_, err := dec128Context.Mul(&z.dec, &x.dec, &y.dec)
+	return z, errorsmod.Wrap(err, "decimal multiplication error")
+}
+
 func (x Dec) IsZero() bool {
 	return x.dec.IsZero()
 }
@@ -88,6 +88,14 @@ func TestDec(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, res.Equal(two))
 
+	res, err = two.Mul(onePointOneFive)
+	require.NoError(t, err)
+	require.Equal(t, "2.30", res.String())
+
+	res, err = minusOne.Mul(five)
+	require.NoError(t, err)
+	require.True(t, res.Equal(minusFivePointZero))
+
 	require.False(t, zero.IsNegative())
 	require.False(t, one.IsNegative())
 	require.True(t, minusOne.IsNegative())
@@ -4,12 +4,21 @@ import (
 	"context"
 )
 
-// EndBlocker called at every block, updates proposal's `FinalTallyResult` and
-// prunes expired proposals.
+// EndBlocker called at every block, removes the members whose exit delay has
+// passed from their group, updates proposal's `FinalTallyResult`, executes the
+// proposals scheduled for execution and prunes expired proposals.
 func (k Keeper) EndBlocker(ctx context.Context) error {
+	if err := k.ExecuteMemberExits(ctx); err != nil {
+		return err
+	}
+
 	if err := k.TallyProposalsAtVPEnd(ctx); err != nil {
 		return err
 	}
 
+	if err := k.ExecuteScheduledProposals(ctx); err != nil {
+		return err
+	}
+
 	return k.PruneProposals(ctx)
 }
@@ -41,6 +41,14 @@ func (k Keeper) InitGenesis(ctx context.Context, cdc codec.JSONCodec, data json.
 		return errors.Wrap(err, "votes")
 	}
 
+	if err := k.weightDelegationTable.Import(store, genesisState.WeightDelegations, 0); err != nil {
+		return errors.Wrap(err, "weight delegations")
+	}
+
+	if err := k.memberExitTable.Import(store, genesisState.MemberExits, 0); err != nil {
+		return errors.Wrap(err, "member exits")
+	}
+
 	return nil
 }
 
@@ -89,5 +97,19 @@ func (k Keeper) ExportGenesis(ctx context.Context, _ codec.JSONCodec) (*group.Ge
 	}
 	genesisState.Votes = votes
 
+	var weightDelegations []*group.WeightDelegation
+	_, err = k.weightDelegationTable.Export(store, &weightDelegations)
+	if err != nil {
+		return nil, errors.Wrap(err, "weight delegations")
+	}
+	genesisState.WeightDelegations = weightDelegations
+
+	var memberExits []*group.MemberExit
+	_, err = k.memberExitTable.Export(store, &memberExits)
+	if err != nil {
+		return nil, errors.Wrap(err, "member exits")
+	}
+	genesisState.MemberExits = memberExits
+
 	return genesisState, nil
 }
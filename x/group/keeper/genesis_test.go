@@ -219,7 +219,10 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 		s.Require().NoError(err)
 		s.assertProposalsEqual(g, res)
 	}
-	s.Require().Equal(genesisState.Votes, exportedGenesisState.Votes)
+	s.Require().Equal(len(genesisState.Votes), len(exportedGenesisState.Votes))
+	for i, v := range genesisState.Votes {
+		s.assertVotesEqual(v, exportedGenesisState.Votes[i])
+	}
 
 	s.Require().Equal(genesisState.GroupSeq, exportedGenesisState.GroupSeq)
 	s.Require().Equal(genesisState.GroupPolicySeq, exportedGenesisState.GroupPolicySeq)
@@ -240,6 +243,16 @@ func (s *GenesisTestSuite) assertGroupPoliciesEqual(g, other *group.GroupPolicyI
 	require.Equal(dp1, dp2)
 }
 
+func (s *GenesisTestSuite) assertVotesEqual(v, other *group.Vote) {
+	require := s.Require()
+	require.Equal(v.ProposalId, other.ProposalId)
+	require.Equal(v.Voter, other.Voter)
+	require.Equal(v.Option, other.Option)
+	require.Equal(v.Metadata, other.Metadata)
+	require.Equal(v.SubmitTime, other.SubmitTime)
+	require.ElementsMatch(v.Options, other.Options)
+}
+
 func (s *GenesisTestSuite) assertProposalsEqual(g, other *group.Proposal) {
 	require := s.Require()
 	require.Equal(g.Id, other.Id)
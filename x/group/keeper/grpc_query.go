@@ -203,6 +203,61 @@ func (k Keeper) getProposalsByGroupPolicy(ctx context.Context, account sdk.AccAd
 	return k.proposalByGroupPolicyIndex.GetPaginated(k.KVStoreService.OpenKVStore(ctx), account.Bytes(), pageRequest)
 }
 
+// ProposalsByVoter queries the proposals a voter has voted on.
+func (k Keeper) ProposalsByVoter(ctx context.Context, request *group.QueryProposalsByVoterRequest) (*group.QueryProposalsByVoterResponse, error) {
+	addr, err := k.accKeeper.AddressCodec().StringToBytes(request.Voter)
+	if err != nil {
+		return nil, err
+	}
+	it, err := k.getVotesByVoter(ctx, addr, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var votes []*group.Vote
+	pageRes, err := orm.Paginate(it, request.Pagination, &votes)
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]*group.Proposal, 0, len(votes))
+	for _, vote := range votes {
+		proposal, err := k.getProposal(ctx, vote.ProposalId)
+		if err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, &proposal)
+	}
+
+	return &group.QueryProposalsByVoterResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ProposalsByStatus queries the proposals with a given status.
+func (k Keeper) ProposalsByStatus(ctx context.Context, request *group.QueryProposalsByStatusRequest) (*group.QueryProposalsByStatusResponse, error) {
+	if _, ok := group.ProposalStatus_name[int32(request.Status)]; !ok || request.Status == group.PROPOSAL_STATUS_UNSPECIFIED {
+		return nil, errorsmod.Wrapf(errors.ErrInvalid, "invalid proposal status %s", request.Status)
+	}
+
+	it, err := k.proposalsByStatus.GetPaginated(k.KVStoreService.OpenKVStore(ctx), uint64(request.Status), request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposals []*group.Proposal
+	pageRes, err := orm.Paginate(it, request.Pagination, &proposals)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryProposalsByStatusResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
+
 // getProposal gets the proposal info of the given proposal id.
 func (k Keeper) getProposal(ctx context.Context, proposalID uint64) (group.Proposal, error) {
 	var p group.Proposal
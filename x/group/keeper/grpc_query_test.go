@@ -448,3 +448,94 @@ func TestQueryGroups(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryProposalsByVoterAndStatus(t *testing.T) {
+	fixture := initKeeper(t)
+
+	for i := 0; i < 2; i++ {
+		_, err := fixture.keeper.SubmitProposal(fixture.ctx, &group.MsgSubmitProposal{
+			GroupPolicyAddress: fixture.defaultGroup.GroupPolicyAddress,
+			Proposers:          []string{fixture.addrs[1]},
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := fixture.keeper.Vote(fixture.ctx, &group.MsgVote{
+		ProposalId: 1,
+		Voter:      fixture.addrs[3],
+		Option:     group.VOTE_OPTION_YES,
+	})
+	require.NoError(t, err)
+
+	_, err = fixture.keeper.WithdrawProposal(fixture.ctx, &group.MsgWithdrawProposal{
+		ProposalId: 2,
+		Address:    fixture.addrs[1],
+	})
+	require.NoError(t, err)
+
+	votersResp, err := fixture.queryClient.ProposalsByVoter(fixture.ctx, &group.QueryProposalsByVoterRequest{
+		Voter: fixture.addrs[3],
+	})
+	require.NoError(t, err)
+	require.Len(t, votersResp.Proposals, 1)
+	require.Equal(t, uint64(1), votersResp.Proposals[0].Id)
+
+	votersResp, err = fixture.queryClient.ProposalsByVoter(fixture.ctx, &group.QueryProposalsByVoterRequest{
+		Voter: fixture.addrs[1],
+	})
+	require.NoError(t, err)
+	require.Len(t, votersResp.Proposals, 0)
+
+	_, err = fixture.queryClient.ProposalsByVoter(fixture.ctx, &group.QueryProposalsByVoterRequest{
+		Voter: "invalid address",
+	})
+	require.ErrorContains(t, err, "decoding bech32 failed")
+
+	testCases := []struct {
+		name      string
+		status    group.ProposalStatus
+		expIDs    []uint64
+		expErrMsg string
+	}{
+		{
+			name:   "submitted",
+			status: group.PROPOSAL_STATUS_SUBMITTED,
+			expIDs: []uint64{1},
+		},
+		{
+			name:   "withdrawn",
+			status: group.PROPOSAL_STATUS_WITHDRAWN,
+			expIDs: []uint64{2},
+		},
+		{
+			name:   "accepted",
+			status: group.PROPOSAL_STATUS_ACCEPTED,
+			expIDs: []uint64{},
+		},
+		{
+			name:      "unspecified",
+			status:    group.PROPOSAL_STATUS_UNSPECIFIED,
+			expErrMsg: "invalid proposal status",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := fixture.queryClient.ProposalsByStatus(fixture.ctx, &group.QueryProposalsByStatusRequest{
+				Status: tc.status,
+			})
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			ids := make([]uint64, len(resp.Proposals))
+			for i, p := range resp.Proposals {
+				ids[i] = p.Id
+			}
+			require.Equal(t, tc.expIDs, ids)
+		})
+	}
+}
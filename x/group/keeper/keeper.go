@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"cosmossdk.io/core/appmodule"
+	storetypes "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/group"
 	"cosmossdk.io/x/group/errors"
@@ -37,11 +38,22 @@ const (
 	ProposalTableSeqPrefix           byte = 0x31
 	ProposalByGroupPolicyIndexPrefix byte = 0x32
 	ProposalsByVotingPeriodEndPrefix byte = 0x33
+	ProposalsByExecutionTimePrefix   byte = 0x34
+	ProposalsByStatusPrefix          byte = 0x35
 
 	// Vote Table
 	VoteTablePrefix           byte = 0x40
 	VoteByProposalIndexPrefix byte = 0x41
 	VoteByVoterIndexPrefix    byte = 0x42
+
+	// Weight Delegation Table
+	WeightDelegationTablePrefix           byte = 0x50
+	WeightDelegationByGroupIndexPrefix    byte = 0x51
+	WeightDelegationByDelegateIndexPrefix byte = 0x52
+
+	// Member Exit Table
+	MemberExitTablePrefix           byte = 0x60
+	MemberExitByExitTimeIndexPrefix byte = 0x61
 )
 
 type Keeper struct {
@@ -67,13 +79,25 @@ type Keeper struct {
 	proposalTable              orm.AutoUInt64Table
 	proposalByGroupPolicyIndex orm.Index
 	proposalsByVotingPeriodEnd orm.Index
+	proposalsByExecutionTime   orm.Index
+	proposalsByStatus          orm.Index
 
 	// Vote Table
 	voteTable           orm.PrimaryKeyTable
 	voteByProposalIndex orm.Index
 	voteByVoterIndex    orm.Index
 
+	// Weight Delegation Table
+	weightDelegationTable           orm.PrimaryKeyTable
+	weightDelegationByGroupIndex    orm.Index
+	weightDelegationByDelegateIndex orm.Index
+
+	// Member Exit Table
+	memberExitTable           orm.PrimaryKeyTable
+	memberExitByExitTimeIndex orm.Index
+
 	config group.Config
+	hooks  *groupHooks
 
 	cdc codec.Codec
 }
@@ -84,6 +108,7 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 		Environment: env,
 		accKeeper:   accKeeper,
 		cdc:         cdc,
+		hooks:       &groupHooks{},
 	}
 
 	/*
@@ -202,6 +227,25 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 	if err != nil {
 		panic(err.Error())
 	}
+	k.proposalsByExecutionTime, err = orm.NewIndex(proposalTable, ProposalsByExecutionTimePrefix, func(value interface{}) ([]interface{}, error) {
+		proposal := value.(*group.Proposal)
+		// only the accepted proposals waiting for their scheduled execution
+		// are indexed.
+		if proposal.ExecutionTime == nil || proposal.Status != group.PROPOSAL_STATUS_ACCEPTED ||
+			proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN {
+			return nil, nil
+		}
+		return []interface{}{sdk.FormatTimeBytes(*proposal.ExecutionTime)}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
+	k.proposalsByStatus, err = orm.NewIndex(proposalTable, ProposalsByStatusPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{uint64(value.(*group.Proposal).Status)}, nil
+	}, uint64(0))
+	if err != nil {
+		panic(err.Error())
+	}
 	k.proposalTable = *proposalTable
 
 	// Vote Table
@@ -227,6 +271,42 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 	}
 	k.voteTable = *voteTable
 
+	// Weight Delegation Table
+	weightDelegationTable, err := orm.NewPrimaryKeyTable([2]byte{WeightDelegationTablePrefix}, &group.WeightDelegation{}, cdc, k.accKeeper.AddressCodec())
+	if err != nil {
+		panic(err.Error())
+	}
+	k.weightDelegationByGroupIndex, err = orm.NewIndex(weightDelegationTable, WeightDelegationByGroupIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{value.(*group.WeightDelegation).GroupId}, nil
+	}, group.WeightDelegation{}.GroupId)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.weightDelegationByDelegateIndex, err = orm.NewIndex(weightDelegationTable, WeightDelegationByDelegateIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		addr, err := accKeeper.AddressCodec().StringToBytes(value.(*group.WeightDelegation).Delegate)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{addr}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
+	k.weightDelegationTable = *weightDelegationTable
+
+	// Member Exit Table
+	memberExitTable, err := orm.NewPrimaryKeyTable([2]byte{MemberExitTablePrefix}, &group.MemberExit{}, cdc, k.accKeeper.AddressCodec())
+	if err != nil {
+		panic(err.Error())
+	}
+	k.memberExitByExitTimeIndex, err = orm.NewIndex(memberExitTable, MemberExitByExitTimeIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{sdk.FormatTimeBytes(value.(*group.MemberExit).ExitTime)}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
+	k.memberExitTable = *memberExitTable
+
 	return k
 }
 
@@ -273,6 +353,32 @@ func (k Keeper) proposalsByVPEnd(ctx context.Context, endTime time.Time) (propos
 	return proposals, nil
 }
 
+// proposalsByExecTime returns all the accepted proposals scheduled for execution
+// before the `endTime` time argument.
+func (k Keeper) proposalsByExecTime(ctx context.Context, endTime time.Time) (proposals []group.Proposal, err error) {
+	timeBytes := sdk.FormatTimeBytes(endTime)
+	it, err := k.proposalsByExecutionTime.PrefixScan(k.KVStoreService.OpenKVStore(ctx), nil, timeBytes)
+	if err != nil {
+		return proposals, err
+	}
+	defer it.Close()
+
+	for {
+		// see proposalsByVPEnd for why the proposal is declared in the loop.
+		var proposal group.Proposal
+		_, err := it.LoadNext(&proposal)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return proposals, err
+		}
+		proposals = append(proposals, proposal)
+	}
+
+	return proposals, nil
+}
+
 // pruneProposal deletes a proposal from state.
 func (k Keeper) pruneProposal(ctx context.Context, proposalID uint64) error {
 	err := k.proposalTable.Delete(k.KVStoreService.OpenKVStore(ctx), proposalID)
@@ -348,7 +454,11 @@ func (k Keeper) pruneVotes(ctx context.Context, proposalID uint64) error {
 
 // votesByProposal returns all votes for a given proposal.
 func (k Keeper) votesByProposal(ctx context.Context, proposalID uint64) ([]group.Vote, error) {
-	it, err := k.voteByProposalIndex.Get(k.KVStoreService.OpenKVStore(ctx), proposalID)
+	return k.votesByProposalFromStore(k.KVStoreService.OpenKVStore(ctx), proposalID)
+}
+
+func (k Keeper) votesByProposalFromStore(kvStore storetypes.KVStore, proposalID uint64) ([]group.Vote, error) {
+	it, err := k.voteByProposalIndex.Get(kvStore, proposalID)
 	if err != nil {
 		return nil, err
 	}
@@ -451,6 +561,55 @@ func (k Keeper) TallyProposalsAtVPEnd(ctx context.Context) error {
 	return nil
 }
 
+// ExecuteScheduledProposals executes the accepted proposals whose execution
+// delay has passed.
+func (k Keeper) ExecuteScheduledProposals(ctx context.Context) error {
+	proposals, err := k.proposalsByExecTime(ctx, k.HeaderService.HeaderInfo(ctx).Time)
+	if err != nil {
+		return err
+	}
+	for _, proposal := range proposals {
+		proposal := proposal
+
+		policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+		if err != nil {
+			return errorsmod.Wrap(err, "group policy")
+		}
+
+		if err := k.doExecuteProposal(ctx, &proposal, policyInfo); err != nil {
+			return errorsmod.Wrapf(err, "execute proposal %d", proposal.Id)
+		}
+	}
+	return nil
+}
+
+// scheduleProposalExecution sets the execution time of a proposal which was
+// just accepted, if its decision policy has an execution delay.
+func (k Keeper) scheduleProposalExecution(ctx context.Context, p *group.Proposal, policy group.DecisionPolicy) error {
+	delay := policy.GetExecutionDelay()
+	if delay <= 0 {
+		return nil
+	}
+
+	// the proposal can never be executed before its min execution period
+	executionTime := k.HeaderService.HeaderInfo(ctx).Time.Add(delay)
+	if minExecutionTime := p.SubmitTime.Add(policy.GetMinExecutionPeriod()); executionTime.Before(minExecutionTime) {
+		executionTime = minExecutionTime
+	}
+	p.ExecutionTime = &executionTime
+
+	return k.EventService.EventManager(ctx).Emit(&group.EventProposalExecutionScheduled{
+		ProposalId:    p.Id,
+		ExecutionTime: executionTime,
+	})
+}
+
+// isTimelocked returns true if the proposal is scheduled for execution at a
+// later time.
+func (k Keeper) isTimelocked(ctx context.Context, p group.Proposal) bool {
+	return p.ExecutionTime != nil && k.HeaderService.HeaderInfo(ctx).Time.Before(*p.ExecutionTime)
+}
+
 // assertMetadataLength returns an error if given metadata length
 // is greater than defined MaxMetadataLen in the module configuration
 func (k Keeper) assertMetadataLength(metadata, description string) error {
@@ -9,6 +9,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -22,6 +23,7 @@ import (
 	minttypes "cosmossdk.io/x/mint/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -48,6 +50,8 @@ type TestSuite struct {
 	blockTime          time.Time
 	bankKeeper         *grouptestutil.MockBankKeeper
 	accountKeeper      *grouptestutil.MockAccountKeeper
+	environment        appmodule.Environment
+	cdc                codec.Codec
 }
 
 func (s *TestSuite) SetupTest() {
@@ -85,6 +89,8 @@ func (s *TestSuite) SetupTest() {
 	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger(), runtime.EnvWithQueryRouterService(bApp.GRPCQueryRouter()), runtime.EnvWithMsgRouterService(bApp.MsgServiceRouter()))
 	config := group.DefaultConfig()
 	s.groupKeeper = keeper.NewKeeper(env, encCfg.Codec, s.accountKeeper, config)
+	s.environment = env
+	s.cdc = encCfg.Codec
 	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Time: s.blockTime})
 	s.sdkCtx = sdk.UnwrapSDKContext(s.ctx)
 
@@ -546,3 +552,124 @@ func (s *TestSuite) TestTallyProposalsAtVPEnd_GroupMemberLeaving() {
 		}
 	})
 }
+
+func (s *TestSuite) TestExecuteScheduledProposals() {
+	votingPeriod := 4 * time.Minute
+	executionDelay := time.Hour
+
+	groupMsg := &group.MsgCreateGroupWithPolicy{
+		Admin: s.addrsStr[0],
+		Members: []group.MemberRequest{
+			{Address: s.addrsStr[0], Weight: "1"},
+			{Address: s.addrsStr[1], Weight: "1"},
+		},
+	}
+	policy := &group.ThresholdDecisionPolicy{
+		Threshold: "1",
+		Windows: &group.DecisionPolicyWindows{
+			VotingPeriod:   votingPeriod,
+			ExecutionDelay: executionDelay,
+		},
+	}
+	s.Require().NoError(groupMsg.SetDecisionPolicy(policy))
+
+	s.setNextAccount()
+	groupRes, err := s.groupKeeper.CreateGroupWithPolicy(s.ctx, groupMsg)
+	s.Require().NoError(err)
+
+	ctx := s.sdkCtx.WithEventManager(sdk.NewEventManager())
+	proposalRes, err := s.groupKeeper.SubmitProposal(ctx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: groupRes.GroupPolicyAddress,
+		Proposers:          []string{s.addrsStr[0]},
+		Exec:               group.Exec_EXEC_TRY,
+	})
+	s.Require().NoError(err)
+
+	// the proposal is accepted but its execution is scheduled after the delay
+	proposal, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalRes.ProposalId})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, proposal.Proposal.Status)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, proposal.Proposal.ExecutorResult)
+	executionTime := s.sdkCtx.HeaderInfo().Time.Add(executionDelay)
+	s.Require().Equal(executionTime, *proposal.Proposal.ExecutionTime)
+	s.Require().True(eventTypeFound(ctx.EventManager().ABCIEvents(), "cosmos.group.v1.EventProposalExecutionScheduled"))
+
+	_, err = s.groupKeeper.Exec(ctx, &group.MsgExec{ProposalId: proposalRes.ProposalId, Executor: s.addrsStr[0]})
+	s.Require().ErrorContains(err, "must wait until")
+
+	// the proposal is not executed before the end of the delay
+	ctx = s.sdkCtx.WithHeaderInfo(header.Info{Time: executionTime.Add(-time.Second)})
+	s.Require().NoError(s.groupKeeper.EndBlocker(ctx))
+	_, err = s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalRes.ProposalId})
+	s.Require().NoError(err)
+
+	// the proposal is executed and pruned once the delay has passed
+	ctx = s.sdkCtx.WithHeaderInfo(header.Info{Time: executionTime.Add(time.Second)}).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.groupKeeper.EndBlocker(ctx))
+	_, err = s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalRes.ProposalId})
+	s.Require().ErrorContains(err, "load proposal")
+	s.Require().True(eventTypeFound(ctx.EventManager().ABCIEvents(), "cosmos.group.v1.EventExec"))
+}
+
+func (s *TestSuite) TestVetoProposal() {
+	guardian := s.addrsStr[2]
+	vetoWindow := time.Hour
+
+	groupMsg := &group.MsgCreateGroupWithPolicy{
+		Admin: s.addrsStr[0],
+		Members: []group.MemberRequest{
+			{Address: s.addrsStr[0], Weight: "1"},
+			{Address: s.addrsStr[1], Weight: "1"},
+		},
+	}
+	policy := group.NewGuardianDecisionPolicy("1", guardian, 4*time.Minute, 0, vetoWindow)
+	s.Require().NoError(groupMsg.SetDecisionPolicy(policy))
+
+	s.setNextAccount()
+	groupRes, err := s.groupKeeper.CreateGroupWithPolicy(s.ctx, groupMsg)
+	s.Require().NoError(err)
+
+	submitAcceptedProposal := func() uint64 {
+		proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+			GroupPolicyAddress: groupRes.GroupPolicyAddress,
+			Proposers:          []string{s.addrsStr[0]},
+			Exec:               group.Exec_EXEC_TRY,
+		})
+		s.Require().NoError(err)
+		return proposalRes.ProposalId
+	}
+	getProposal := func(ctx context.Context, id uint64) group.Proposal {
+		res, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: id})
+		s.Require().NoError(err)
+		return *res.Proposal
+	}
+
+	vetoedID := submitAcceptedProposal()
+	executedID := submitAcceptedProposal()
+
+	// only the guardian can veto
+	_, err = s.groupKeeper.VetoProposal(s.ctx, &group.MsgVetoProposal{ProposalId: vetoedID, Guardian: s.addrsStr[0]})
+	s.Require().ErrorContains(err, "not the guardian")
+
+	ctx := s.sdkCtx.WithEventManager(sdk.NewEventManager())
+	_, err = s.groupKeeper.VetoProposal(ctx, &group.MsgVetoProposal{ProposalId: vetoedID, Guardian: guardian})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_VETOED, getProposal(ctx, vetoedID).Status)
+	s.Require().True(eventTypeFound(ctx.EventManager().ABCIEvents(), "cosmos.group.v1.EventProposalVetoed"))
+
+	_, err = s.groupKeeper.VetoProposal(ctx, &group.MsgVetoProposal{ProposalId: vetoedID, Guardian: guardian})
+	s.Require().ErrorContains(err, "not possible to veto")
+	_, err = s.groupKeeper.Exec(ctx, &group.MsgExec{ProposalId: vetoedID, Executor: s.addrsStr[0]})
+	s.Require().ErrorContains(err, "not possible to exec")
+
+	// at the end of the veto window, the vetoed proposal is not executed while
+	// the other one is executed and pruned
+	ctx = s.sdkCtx.WithHeaderInfo(header.Info{Time: s.sdkCtx.HeaderInfo().Time.Add(vetoWindow + time.Second)})
+	_, err = s.groupKeeper.VetoProposal(ctx, &group.MsgVetoProposal{ProposalId: executedID, Guardian: guardian})
+	s.Require().ErrorContains(err, "veto window of proposal")
+
+	s.Require().NoError(s.groupKeeper.EndBlocker(ctx))
+	s.Require().Equal(group.PROPOSAL_STATUS_VETOED, getProposal(ctx, vetoedID).Status)
+	_, err = s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: executedID})
+	s.Require().ErrorContains(err, "load proposal")
+}
@@ -0,0 +1,246 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	storetypes "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/group"
+	"cosmossdk.io/x/group/errors"
+	"cosmossdk.io/x/group/internal/math"
+	"cosmossdk.io/x/group/internal/orm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// groupHooks houses the GroupHooks of the keeper.
+// It exists so that the hooks can be set in the Keeper without needing to have a pointer receiver.
+type groupHooks struct {
+	hooks group.GroupHooks
+}
+
+// SetHooks sets the group hooks.
+func (k Keeper) SetHooks(gh group.GroupHooks) {
+	if k.hooks.hooks != nil {
+		panic("cannot set group hooks twice")
+	}
+
+	k.hooks.hooks = gh
+}
+
+// afterMemberExit calls the AfterMemberExit hook if the hooks are set.
+func (k Keeper) afterMemberExit(ctx context.Context, groupID uint64, member string, openProposalIDs []uint64) error {
+	if k.hooks.hooks == nil {
+		return nil
+	}
+
+	return k.hooks.hooks.AfterMemberExit(ctx, groupID, member, openProposalIDs)
+}
+
+// withoutMember returns the group info of a group once the given member left
+// it.
+func withoutMember(groupInfo group.GroupInfo, gm *group.GroupMember) (group.GroupInfo, error) {
+	groupWeight, err := math.NewNonNegativeDecFromString(groupInfo.TotalWeight)
+	if err != nil {
+		return group.GroupInfo{}, err
+	}
+
+	memberWeight, err := math.NewPositiveDecFromString(gm.Member.Weight)
+	if err != nil {
+		return group.GroupInfo{}, err
+	}
+
+	updatedWeight, err := math.SubNonNegative(groupWeight, memberWeight)
+	if err != nil {
+		return group.GroupInfo{}, err
+	}
+
+	groupInfo.TotalWeight = updatedWeight.String()
+	groupInfo.Version++
+	return groupInfo, nil
+}
+
+// doLeaveGroup removes a member from its group, recalculates the tallies of
+// the open proposals of the group, and calls the AfterMemberExit hook.
+func (k Keeper) doLeaveGroup(ctx context.Context, groupInfo group.GroupInfo, gm *group.GroupMember) error {
+	groupInfo, err := withoutMember(groupInfo, gm)
+	if err != nil {
+		return err
+	}
+
+	kvStore := k.KVStoreService.OpenKVStore(ctx)
+
+	// delete group member in the groupMemberTable.
+	if err := k.groupMemberTable.Delete(kvStore, gm); err != nil {
+		return errorsmod.Wrap(err, "group member")
+	}
+
+	if err := k.deleteWeightDelegations(kvStore, gm.GroupId, gm.Member.Address); err != nil {
+		return errorsmod.Wrap(err, "weight delegations")
+	}
+
+	if err := k.deleteMemberExit(kvStore, gm.GroupId, gm.Member.Address); err != nil {
+		return errorsmod.Wrap(err, "member exit")
+	}
+
+	if err := k.validateDecisionPolicies(ctx, groupInfo); err != nil {
+		return err
+	}
+
+	if err := k.groupTable.Update(kvStore, groupInfo.Id, &groupInfo); err != nil {
+		return err
+	}
+
+	openProposalIDs, err := k.recalculateOpenProposals(ctx, groupInfo)
+	if err != nil {
+		return errorsmod.Wrap(err, "open proposals")
+	}
+
+	if err := k.afterMemberExit(ctx, gm.GroupId, gm.Member.Address, openProposalIDs); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).Emit(&group.EventLeaveGroup{
+		GroupId: gm.GroupId,
+		Address: gm.Member.Address,
+	})
+}
+
+// scheduleMemberExit schedules the removal of a member from its group after
+// the member exit delay, and returns the time of the removal.
+func (k Keeper) scheduleMemberExit(ctx context.Context, groupInfo group.GroupInfo, gm *group.GroupMember) (time.Time, error) {
+	kvStore := k.KVStoreService.OpenKVStore(ctx)
+
+	exit := group.MemberExit{
+		GroupId:  gm.GroupId,
+		Address:  gm.Member.Address,
+		ExitTime: k.HeaderService.HeaderInfo(ctx).Time.Add(k.config.MemberExitDelay),
+	}
+	if k.memberExitTable.Contains(kvStore, &exit) {
+		return time.Time{}, errorsmod.Wrapf(errors.ErrInvalid, "%s is already leaving group %d", gm.Member.Address, gm.GroupId)
+	}
+
+	// fail early if the group can't do without the member
+	updatedGroupInfo, err := withoutMember(groupInfo, gm)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err := k.validateDecisionPolicies(ctx, updatedGroupInfo); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := k.memberExitTable.Create(kvStore, &exit); err != nil {
+		return time.Time{}, errorsmod.Wrap(err, "member exit")
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&group.EventLeaveGroupScheduled{
+		GroupId:  exit.GroupId,
+		Address:  exit.Address,
+		ExitTime: exit.ExitTime,
+	}); err != nil {
+		return time.Time{}, err
+	}
+
+	return exit.ExitTime, nil
+}
+
+// ExecuteMemberExits removes the members whose exit delay has passed from
+// their group. A member who can't leave its group, e.g. because a hook returns
+// an error, stays in the group and has to leave it again.
+func (k Keeper) ExecuteMemberExits(ctx context.Context) error {
+	kvStore := k.KVStoreService.OpenKVStore(ctx)
+	it, err := k.memberExitByExitTimeIndex.PrefixScan(kvStore, nil, sdk.FormatTimeBytes(k.HeaderService.HeaderInfo(ctx).Time))
+	if err != nil {
+		return err
+	}
+	var exits []*group.MemberExit
+	_, err = orm.ReadAll(it, &exits)
+	if err != nil {
+		return err
+	}
+
+	for _, exit := range exits {
+		if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+			groupInfo, err := k.getGroupInfo(ctx, exit.GroupId)
+			if err != nil {
+				return errorsmod.Wrap(err, "group")
+			}
+
+			gm, err := k.getGroupMember(ctx, &group.GroupMember{
+				GroupId: exit.GroupId,
+				Member:  &group.Member{Address: exit.Address},
+			})
+			if err != nil {
+				return err
+			}
+
+			return k.doLeaveGroup(ctx, groupInfo, gm)
+		}); err != nil {
+			k.Logger.Error("member exit failed", "cause", err, "groupID", exit.GroupId, "member", exit.Address)
+
+			if err := k.deleteMemberExit(kvStore, exit.GroupId, exit.Address); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// deleteMemberExit deletes the scheduled exit of a group member, if any.
+func (k Keeper) deleteMemberExit(kvStore storetypes.KVStore, groupID uint64, member string) error {
+	exit := group.MemberExit{GroupId: groupID, Address: member}
+	if !k.memberExitTable.Contains(kvStore, &exit) {
+		return nil
+	}
+
+	return k.memberExitTable.Delete(kvStore, &exit)
+}
+
+// recalculateOpenProposals tallies again the proposals of a group which are
+// open for voting, after its members changed, and returns their IDs. The
+// proposals whose result became final are accepted or rejected.
+func (k Keeper) recalculateOpenProposals(ctx context.Context, groupInfo group.GroupInfo) ([]uint64, error) {
+	kvStore := k.KVStoreService.OpenKVStore(ctx)
+	it, err := k.groupPolicyByGroupIndex.Get(kvStore, groupInfo.Id)
+	if err != nil {
+		return nil, err
+	}
+	var policies []*group.GroupPolicyInfo
+	if _, err := orm.ReadAll(it, &policies); err != nil {
+		return nil, err
+	}
+
+	var openProposalIDs []uint64
+	for _, policyInfo := range policies {
+		addr, err := k.accKeeper.AddressCodec().StringToBytes(policyInfo.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		proposals, err := k.proposalsByGroupPolicy(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, proposal := range proposals {
+			if proposal.Status != group.PROPOSAL_STATUS_SUBMITTED {
+				continue
+			}
+			openProposalIDs = append(openProposalIDs, proposal.Id)
+
+			if err := k.doTallyAndUpdate(ctx, &proposal, groupInfo, *policyInfo); err != nil {
+				return nil, errorsmod.Wrapf(err, "tally proposal %d", proposal.Id)
+			}
+			if proposal.Status == group.PROPOSAL_STATUS_SUBMITTED {
+				continue
+			}
+			if err := k.proposalTable.Update(kvStore, proposal.Id, &proposal); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return openProposalIDs, nil
+}
@@ -170,6 +170,12 @@ func (k Keeper) UpdateGroupMembers(ctx context.Context, msg *group.MsgUpdateGrou
 				if err := k.groupMemberTable.Delete(kvStore, &groupMember); err != nil {
 					return errorsmod.Wrap(err, "delete member")
 				}
+				if err := k.deleteWeightDelegations(kvStore, msg.GroupId, member.Address); err != nil {
+					return errorsmod.Wrap(err, "delete member weight delegations")
+				}
+				if err := k.deleteMemberExit(kvStore, msg.GroupId, member.Address); err != nil {
+					return errorsmod.Wrap(err, "delete member exit")
+				}
 				continue
 			}
 			// If group member already exists, handle update
@@ -715,71 +721,104 @@ func (k Keeper) Vote(ctx context.Context, msg *group.MsgVote) (*group.MsgVoteRes
 		return nil, errorsmod.Wrap(errors.ErrInvalid, "vote option")
 	}
 
-	if err := k.assertMetadataLength(msg.Metadata, "metadata"); err != nil {
+	if err := k.doVote(ctx, group.Vote{
+		ProposalId: msg.ProposalId,
+		Voter:      msg.Voter,
+		Option:     msg.Option,
+		Metadata:   msg.Metadata,
+	}, msg.Exec); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgVoteResponse{}, nil
+}
+
+// VoteWeighted allows a group member to split their weight across several
+// vote options.
+func (k Keeper) VoteWeighted(ctx context.Context, msg *group.MsgVoteWeighted) (*group.MsgVoteWeightedResponse, error) {
+	if msg.ProposalId == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal id")
+	}
+
+	// verify vote options
+	if err := group.ValidateWeightedVoteOptions(msg.Options); err != nil {
+		return nil, err
+	}
+
+	if err := k.doVote(ctx, group.Vote{
+		ProposalId: msg.ProposalId,
+		Voter:      msg.Voter,
+		Options:    msg.Options,
+		Metadata:   msg.Metadata,
+	}, msg.Exec); err != nil {
 		return nil, err
 	}
 
-	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.Voter); err != nil {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address: %s", msg.Voter)
+	return &group.MsgVoteWeightedResponse{}, nil
+}
+
+// doVote stores the vote of a group member after checking that the proposal
+// is still open for voting, and tries to execute the proposal if requested.
+func (k Keeper) doVote(ctx context.Context, vote group.Vote, exec group.Exec) error {
+	if err := k.assertMetadataLength(vote.Metadata, "metadata"); err != nil {
+		return err
+	}
+
+	if _, err := k.accKeeper.AddressCodec().StringToBytes(vote.Voter); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address: %s", vote.Voter)
 	}
 
 	kvStore := k.KVStoreService.OpenKVStore(ctx)
-	proposal, err := k.getProposal(ctx, msg.ProposalId)
+	proposal, err := k.getProposal(ctx, vote.ProposalId)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Ensure that we can still accept votes for this proposal.
 	if proposal.Status != group.PROPOSAL_STATUS_SUBMITTED {
-		return nil, errorsmod.Wrap(errors.ErrInvalid, "proposal not open for voting")
+		return errorsmod.Wrap(errors.ErrInvalid, "proposal not open for voting")
 	}
 
 	if k.HeaderService.HeaderInfo(ctx).Time.After(proposal.VotingPeriodEnd) {
-		return nil, errorsmod.Wrap(errors.ErrExpired, "voting period has ended already")
+		return errorsmod.Wrap(errors.ErrExpired, "voting period has ended already")
 	}
 
 	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
 	if err != nil {
-		return nil, errorsmod.Wrap(err, "load group policy")
+		return errorsmod.Wrap(err, "load group policy")
 	}
 
 	groupInfo, err := k.getGroupInfo(ctx, policyInfo.GroupId)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Count and store votes.
-	voter := group.GroupMember{GroupId: groupInfo.Id, Member: &group.Member{Address: msg.Voter}}
+	voter := group.GroupMember{GroupId: groupInfo.Id, Member: &group.Member{Address: vote.Voter}}
 	if err := k.groupMemberTable.GetOne(kvStore, orm.PrimaryKey(&voter, k.accKeeper.AddressCodec()), &voter); err != nil {
-		return nil, errorsmod.Wrapf(err, "voter address: %s", msg.Voter)
-	}
-	newVote := group.Vote{
-		ProposalId: msg.ProposalId,
-		Voter:      msg.Voter,
-		Option:     msg.Option,
-		Metadata:   msg.Metadata,
-		SubmitTime: k.HeaderService.HeaderInfo(ctx).Time,
+		return errorsmod.Wrapf(err, "voter address: %s", vote.Voter)
 	}
+	vote.SubmitTime = k.HeaderService.HeaderInfo(ctx).Time
 
 	// The ORM will return an error if the vote already exists,
 	// making sure than a voter hasn't already voted.
-	if err := k.voteTable.Create(kvStore, &newVote); err != nil {
-		return nil, errorsmod.Wrap(err, "store vote")
+	if err := k.voteTable.Create(kvStore, &vote); err != nil {
+		return errorsmod.Wrap(err, "store vote")
 	}
 
-	if err := k.EventService.EventManager(ctx).Emit(&group.EventVote{ProposalId: msg.ProposalId}); err != nil {
-		return nil, err
+	if err := k.EventService.EventManager(ctx).Emit(&group.EventVote{ProposalId: vote.ProposalId}); err != nil {
+		return err
 	}
 
 	// Try to execute proposal immediately
-	if msg.Exec == group.Exec_EXEC_TRY {
-		_, err = k.Exec(ctx, &group.MsgExec{ProposalId: msg.ProposalId, Executor: msg.Voter})
+	if exec == group.Exec_EXEC_TRY {
+		_, err = k.Exec(ctx, &group.MsgExec{ProposalId: vote.ProposalId, Executor: vote.Voter})
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	return &group.MsgVoteResponse{}, nil
+	return nil
 }
 
 // doTallyAndUpdate performs a tally, and, if the tally result is final, then:
@@ -810,6 +849,9 @@ func (k Keeper) doTallyAndUpdate(ctx context.Context, p *group.Proposal, groupIn
 		p.FinalTallyResult = tallyResult
 		if result.Allow {
 			p.Status = group.PROPOSAL_STATUS_ACCEPTED
+			if err := k.scheduleProposalExecution(ctx, p, policy); err != nil {
+				return err
+			}
 		} else {
 			p.Status = group.PROPOSAL_STATUS_REJECTED
 		}
@@ -835,6 +877,12 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 		return nil, errorsmod.Wrapf(errors.ErrInvalid, "not possible to exec with proposal status %s", proposal.Status.String())
 	}
 
+	// Proposals with an execution delay cannot be executed before the end of
+	// their timelock.
+	if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && k.isTimelocked(ctx, proposal) {
+		return nil, errors.ErrInvalid.Wrapf("must wait until %s to execute proposal %d", proposal.ExecutionTime, proposal.Id)
+	}
+
 	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "load group policy")
@@ -852,20 +900,45 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 		if err = k.doTallyAndUpdate(ctx, &proposal, groupInfo, policyInfo); err != nil {
 			return nil, err
 		}
+
+		// The proposal was just accepted and scheduled for execution after
+		// the execution delay of its decision policy.
+		if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && k.isTimelocked(ctx, proposal) {
+			if err := k.proposalTable.Update(k.KVStoreService.OpenKVStore(ctx), proposal.Id, &proposal); err != nil {
+				return nil, err
+			}
+
+			return &group.MsgExecResponse{
+				Result: proposal.ExecutorResult,
+			}, nil
+		}
 	}
 
+	if err := k.doExecuteProposal(ctx, &proposal, policyInfo); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgExecResponse{
+		Result: proposal.ExecutorResult,
+	}, nil
+}
+
+// doExecuteProposal executes the messages of an accepted proposal which has not
+// been executed successfully yet, prunes it on success or updates its executor
+// result otherwise, and emits an EventExec.
+func (k Keeper) doExecuteProposal(ctx context.Context, proposal *group.Proposal, policyInfo group.GroupPolicyInfo) error {
 	// Execute proposal payload.
 	var logs string
 	if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
 		addr, err := k.accKeeper.AddressCodec().StringToBytes(policyInfo.Address)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		decisionPolicy := policyInfo.DecisionPolicy.GetCachedValue().(group.DecisionPolicy)
 
 		if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
-			return k.doExecuteMsgs(ctx, proposal, addr, decisionPolicy)
+			return k.doExecuteMsgs(ctx, *proposal, addr, decisionPolicy)
 		}); err != nil {
 			proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
 			logs = fmt.Sprintf("proposal execution failed on proposal %d, because of error %s", proposal.Id, err.Error())
@@ -879,7 +952,7 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 	// If proposal has successfully run, delete it from state.
 	if proposal.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
 		if err := k.pruneProposal(ctx, proposal.Id); err != nil {
-			return nil, err
+			return err
 		}
 
 		// Emit event for proposal finalized with its result
@@ -889,26 +962,20 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 				Status:      proposal.Status,
 				TallyResult: &proposal.FinalTallyResult,
 			}); err != nil {
-			return nil, err
+			return err
 		}
 	} else {
 		store := k.KVStoreService.OpenKVStore(ctx)
-		if err := k.proposalTable.Update(store, proposal.Id, &proposal); err != nil {
-			return nil, err
+		if err := k.proposalTable.Update(store, proposal.Id, proposal); err != nil {
+			return err
 		}
 	}
 
-	if err := k.EventService.EventManager(ctx).Emit(&group.EventExec{
+	return k.EventService.EventManager(ctx).Emit(&group.EventExec{
 		ProposalId: proposal.Id,
 		Logs:       logs,
 		Result:     proposal.ExecutorResult,
-	}); err != nil {
-		return nil, err
-	}
-
-	return &group.MsgExecResponse{
-		Result: proposal.ExecutorResult,
-	}, nil
+	})
 }
 
 // LeaveGroup implements the MsgServer/LeaveGroup method.
@@ -927,11 +994,6 @@ func (k Keeper) LeaveGroup(ctx context.Context, msg *group.MsgLeaveGroup) (*grou
 		return nil, errorsmod.Wrap(err, "group")
 	}
 
-	groupWeight, err := math.NewNonNegativeDecFromString(groupInfo.TotalWeight)
-	if err != nil {
-		return nil, err
-	}
-
 	gm, err := k.getGroupMember(ctx, &group.GroupMember{
 		GroupId: msg.GroupId,
 		Member:  &group.Member{Address: msg.Address},
@@ -940,43 +1002,142 @@ func (k Keeper) LeaveGroup(ctx context.Context, msg *group.MsgLeaveGroup) (*grou
 		return nil, err
 	}
 
-	memberWeight, err := math.NewPositiveDecFromString(gm.Member.Weight)
+	if k.config.MemberExitDelay <= 0 {
+		if err := k.doLeaveGroup(ctx, groupInfo, gm); err != nil {
+			return nil, err
+		}
+
+		return &group.MsgLeaveGroupResponse{}, nil
+	}
+
+	exitTime, err := k.scheduleMemberExit(ctx, groupInfo, gm)
 	if err != nil {
 		return nil, err
 	}
 
-	updatedWeight, err := math.SubNonNegative(groupWeight, memberWeight)
-	if err != nil {
+	return &group.MsgLeaveGroupResponse{ExitTime: &exitTime}, nil
+}
+
+// DelegateWeight implements the MsgServer/DelegateWeight method.
+func (k Keeper) DelegateWeight(ctx context.Context, msg *group.MsgDelegateWeight) (*group.MsgDelegateWeightResponse, error) {
+	if msg.GroupId == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "group-id")
+	}
+
+	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.Delegator); err != nil {
+		return nil, errorsmod.Wrap(err, "delegator")
+	}
+
+	if _, err := k.getGroupInfo(ctx, msg.GroupId); err != nil {
+		return nil, errorsmod.Wrap(err, "group")
+	}
+
+	if _, err := k.getGroupMember(ctx, &group.GroupMember{
+		GroupId: msg.GroupId,
+		Member:  &group.Member{Address: msg.Delegator},
+	}); err != nil {
 		return nil, err
 	}
 
+	delegation := group.WeightDelegation{
+		GroupId:   msg.GroupId,
+		Delegator: msg.Delegator,
+		Delegate:  msg.Delegate,
+	}
+
 	kvStore := k.KVStoreService.OpenKVStore(ctx)
+	if msg.Delegate == "" {
+		// an empty delegate removes the existing delegation
+		if !k.weightDelegationTable.Contains(kvStore, &delegation) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "%s has no weight delegation in group %d", msg.Delegator, msg.GroupId)
+		}
+		if err := k.weightDelegationTable.Delete(kvStore, &delegation); err != nil {
+			return nil, errorsmod.Wrap(err, "weight delegation")
+		}
+	} else {
+		if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.Delegate); err != nil {
+			return nil, errorsmod.Wrap(err, "delegate")
+		}
 
-	// delete group member in the groupMemberTable.
-	if err := k.groupMemberTable.Delete(kvStore, gm); err != nil {
-		return nil, errorsmod.Wrap(err, "group member")
+		if msg.Delegate == msg.Delegator {
+			return nil, errorsmod.Wrap(errors.ErrInvalid, "cannot delegate weight to self")
+		}
+
+		if _, err := k.getGroupMember(ctx, &group.GroupMember{
+			GroupId: msg.GroupId,
+			Member:  &group.Member{Address: msg.Delegate},
+		}); err != nil {
+			return nil, err
+		}
+
+		if err := k.doDelegateWeight(ctx, delegation); err != nil {
+			return nil, err
+		}
 	}
 
-	// update group weight
-	groupInfo.TotalWeight = updatedWeight.String()
-	groupInfo.Version++
+	if err := k.EventService.EventManager(ctx).Emit(&group.EventDelegateWeight{
+		GroupId:   msg.GroupId,
+		Delegator: msg.Delegator,
+		Delegate:  msg.Delegate,
+	}); err != nil {
+		return nil, err
+	}
 
-	if err := k.validateDecisionPolicies(ctx, groupInfo); err != nil {
+	return &group.MsgDelegateWeightResponse{}, nil
+}
+
+// VetoProposal implements the MsgServer/VetoProposal method.
+func (k Keeper) VetoProposal(ctx context.Context, msg *group.MsgVetoProposal) (*group.MsgVetoProposalResponse, error) {
+	if msg.ProposalId == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal id")
+	}
+
+	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.Guardian); err != nil {
+		return nil, errorsmod.Wrap(err, "guardian")
+	}
+
+	proposal, err := k.getProposal(ctx, msg.ProposalId)
+	if err != nil {
 		return nil, err
 	}
 
-	if err := k.groupTable.Update(kvStore, groupInfo.Id, &groupInfo); err != nil {
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "load group policy")
+	}
+
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
 		return nil, err
 	}
 
-	if err := k.EventService.EventManager(ctx).Emit(&group.EventLeaveGroup{
-		GroupId: msg.GroupId,
-		Address: msg.Address,
+	guardianPolicy, ok := policy.(*group.GuardianDecisionPolicy)
+	if !ok || guardianPolicy.Guardian != msg.Guardian {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "not the guardian of the group policy")
+	}
+
+	if proposal.Status != group.PROPOSAL_STATUS_ACCEPTED {
+		return nil, errorsmod.Wrapf(errors.ErrInvalid, "not possible to veto proposal with status %s", proposal.Status.String())
+	}
+
+	// Accepted proposals can only be vetoed until their scheduled execution.
+	if !k.isTimelocked(ctx, proposal) {
+		return nil, errorsmod.Wrapf(errors.ErrExpired, "veto window of proposal %d has ended", proposal.Id)
+	}
+
+	proposal.Status = group.PROPOSAL_STATUS_VETOED
+	if err := k.proposalTable.Update(k.KVStoreService.OpenKVStore(ctx), proposal.Id, &proposal); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&group.EventProposalVetoed{
+		ProposalId: proposal.Id,
+		Guardian:   msg.Guardian,
 	}); err != nil {
 		return nil, err
 	}
 
-	return &group.MsgLeaveGroupResponse{}, nil
+	return &group.MsgVetoProposalResponse{}, nil
 }
 
 func (k Keeper) getGroupMember(ctx context.Context, member *group.GroupMember) (*group.GroupMember, error) {
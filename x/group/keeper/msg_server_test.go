@@ -3175,6 +3175,88 @@ func (s *TestSuite) TestLeaveGroup() {
 	}
 }
 
+// mockGroupHooks records the calls of the group hooks.
+type mockGroupHooks struct {
+	memberExits [][]uint64
+	err         error
+}
+
+func (h *mockGroupHooks) AfterMemberExit(_ context.Context, _ uint64, _ string, openProposalIDs []uint64) error {
+	if h.err != nil {
+		return h.err
+	}
+	h.memberExits = append(h.memberExits, openProposalIDs)
+	return nil
+}
+
+func (s *TestSuite) TestLeaveGroupWithExitDelay() {
+	member1, member2, member3 := s.addrsStr[1], s.addrsStr[2], s.addrsStr[3]
+	members := []group.MemberRequest{
+		{Address: member1, Weight: "1"},
+		{Address: member2, Weight: "2"},
+		{Address: member3, Weight: "3"},
+	}
+	policy := group.NewThresholdDecisionPolicy("4", time.Hour, 0)
+	s.setNextAccount()
+	policyAddr, groupID := s.createGroupAndGroupPolicy(s.addrs[0], members, policy)
+
+	config := group.DefaultConfig()
+	config.MemberExitDelay = time.Minute
+	k := keeper.NewKeeper(s.environment, s.cdc, s.accountKeeper, config)
+	hooks := &mockGroupHooks{}
+	k.SetHooks(hooks)
+
+	proposalRes, err := k.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{member3},
+	})
+	s.Require().NoError(err)
+	for voter, option := range map[string]group.VoteOption{member2: group.VOTE_OPTION_NO, member3: group.VOTE_OPTION_YES} {
+		_, err := k.Vote(s.ctx, &group.MsgVote{ProposalId: proposalRes.ProposalId, Voter: voter, Option: option})
+		s.Require().NoError(err)
+	}
+
+	// member1 stays in the group until the end of the exit delay
+	res, err := k.LeaveGroup(s.ctx, &group.MsgLeaveGroup{GroupId: groupID, Address: member1})
+	s.Require().NoError(err)
+	s.Require().Equal(s.blockTime.Add(time.Minute), *res.ExitTime)
+	_, err = k.LeaveGroup(s.ctx, &group.MsgLeaveGroup{GroupId: groupID, Address: member1})
+	s.Require().ErrorContains(err, "already leaving")
+
+	ctx := s.sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(time.Minute)})
+	s.Require().NoError(k.EndBlocker(ctx))
+	membersRes, err := k.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Len(membersRes.Members, 3)
+	s.Require().Empty(hooks.memberExits)
+
+	// the proposal can no longer pass once member1 left
+	ctx = s.sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(time.Minute + time.Second)})
+	s.Require().NoError(k.EndBlocker(ctx))
+	membersRes, err = k.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Len(membersRes.Members, 2)
+	s.Require().Equal([][]uint64{{proposalRes.ProposalId}}, hooks.memberExits)
+	proposal, err := k.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalRes.ProposalId})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_REJECTED, proposal.Proposal.Status)
+
+	// a member stays in the group when a hook fails
+	hooks.err = errors.New("forfeit failed")
+	_, err = k.LeaveGroup(ctx, &group.MsgLeaveGroup{GroupId: groupID, Address: member2})
+	s.Require().NoError(err)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(3 * time.Minute)})
+	s.Require().NoError(k.EndBlocker(ctx))
+	membersRes, err = k.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Len(membersRes.Members, 2)
+
+	// the failed exit is not retried
+	hooks.err = nil
+	s.Require().NoError(k.EndBlocker(ctx))
+	s.Require().Len(hooks.memberExits, 1)
+}
+
 func (s *TestSuite) TestExecProposalsWhenMemberLeavesOrIsUpdated() {
 	proposers := []string{s.addrsStr[1]}
 
@@ -3261,6 +3343,8 @@ func (s *TestSuite) TestExecProposalsWhenMemberLeavesOrIsUpdated() {
 				_, err := k.LeaveGroup(ctx, &group.MsgLeaveGroup{GroupId: groupID, Address: s.addrsStr[3]})
 				return err
 			},
+			// the tally is recalculated when the member leaves
+			expErrMsg: "PROPOSAL_STATUS_REJECTED",
 		},
 		"member that leaves does affect the threshold policy outcome": {
 			members: []group.MemberRequest{
@@ -3297,6 +3381,8 @@ func (s *TestSuite) TestExecProposalsWhenMemberLeavesOrIsUpdated() {
 				_, err := k.LeaveGroup(ctx, &group.MsgLeaveGroup{GroupId: groupID, Address: s.addrsStr[3]})
 				return err
 			},
+			// the tally is recalculated when the member leaves
+			expErrMsg: "PROPOSAL_STATUS_REJECTED",
 		},
 		"update group policy voids the proposal": {
 			members: []group.MemberRequest{
@@ -3408,3 +3494,165 @@ func eventTypeFound(events []abci.Event, eventType string) bool {
 	}
 	return eventTypeFound
 }
+
+func (s *TestSuite) TestDelegateWeight() {
+	addrs := simtestutil.CreateIncrementalAccounts(5)
+	addrsStr := make([]string, len(addrs))
+	for i, addr := range addrs {
+		var err error
+		addrsStr[i], err = s.accountKeeper.AddressCodec().BytesToString(addr)
+		s.Require().NoError(err)
+	}
+	member1, member2, member3, nonMember := addrsStr[1], addrsStr[2], addrsStr[3], addrsStr[4]
+
+	members := []group.MemberRequest{
+		{Address: member1, Weight: "1"},
+		{Address: member2, Weight: "2"},
+		{Address: member3, Weight: "3"},
+	}
+	policy := group.NewThresholdDecisionPolicy("4", time.Hour, 0)
+	s.setNextAccount()
+	policyAddr, groupID := s.createGroupAndGroupPolicy(addrs[0], members, policy)
+
+	delegate := func(delegator, delegate string) error {
+		_, err := s.groupKeeper.DelegateWeight(s.ctx, &group.MsgDelegateWeight{
+			GroupId:   groupID,
+			Delegator: delegator,
+			Delegate:  delegate,
+		})
+		return err
+	}
+
+	_, err := s.groupKeeper.DelegateWeight(s.ctx, &group.MsgDelegateWeight{GroupId: 100000, Delegator: member1, Delegate: member2})
+	s.Require().ErrorContains(err, "group: not found")
+	s.Require().ErrorContains(delegate(nonMember, member1), "not part of group")
+	s.Require().ErrorContains(delegate(member1, nonMember), "not part of group")
+	s.Require().ErrorContains(delegate(member1, member1), "cannot delegate weight to self")
+	s.Require().ErrorContains(delegate(member1, ""), "has no weight delegation")
+
+	// member1 -> member2 -> member3
+	s.Require().NoError(delegate(member1, member2))
+	s.Require().NoError(delegate(member2, member3))
+	s.Require().ErrorContains(delegate(member3, member1), "delegation cycle")
+
+	proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{member3},
+	})
+	s.Require().NoError(err)
+	vote := func(voter string, option group.VoteOption) {
+		_, err := s.groupKeeper.Vote(s.ctx, &group.MsgVote{
+			ProposalId: proposalRes.ProposalId,
+			Voter:      voter,
+			Option:     option,
+		})
+		s.Require().NoError(err)
+	}
+	tally := func() group.TallyResult {
+		res, err := s.groupKeeper.TallyResult(s.ctx, &group.QueryTallyResultRequest{ProposalId: proposalRes.ProposalId})
+		s.Require().NoError(err)
+		return res.Tally
+	}
+
+	// the weight of member1 and member2 is delegated transitively to member3
+	vote(member3, group.VOTE_OPTION_YES)
+	s.Require().Equal("6", tally().YesCount)
+
+	// member2 votes directly, and so does member1 through its delegation
+	vote(member2, group.VOTE_OPTION_NO)
+	s.Require().Equal("3", tally().YesCount)
+	s.Require().Equal("3", tally().NoCount)
+
+	// the delegations to member2 are removed when it leaves the group
+	_, err = s.groupKeeper.LeaveGroup(s.ctx, &group.MsgLeaveGroup{GroupId: groupID, Address: member2})
+	s.Require().NoError(err)
+	s.Require().Equal("3", tally().YesCount)
+	s.Require().Equal("0", tally().NoCount)
+	s.Require().ErrorContains(delegate(member1, ""), "has no weight delegation")
+
+	s.Require().NoError(delegate(member1, member3))
+	s.Require().Equal("4", tally().YesCount)
+	s.Require().NoError(delegate(member1, ""))
+	s.Require().Equal("3", tally().YesCount)
+}
+
+func (s *TestSuite) TestVoteWeighted() {
+	addrs := simtestutil.CreateIncrementalAccounts(4)
+	addrsStr := make([]string, len(addrs))
+	for i, addr := range addrs {
+		var err error
+		addrsStr[i], err = s.accountKeeper.AddressCodec().BytesToString(addr)
+		s.Require().NoError(err)
+	}
+	member1, member2, nonMember := addrsStr[1], addrsStr[2], addrsStr[3]
+
+	members := []group.MemberRequest{
+		{Address: member1, Weight: "2"},
+		{Address: member2, Weight: "4"},
+	}
+	policy := group.NewThresholdDecisionPolicy("5", time.Hour, 0)
+	s.setNextAccount()
+	policyAddr, _ := s.createGroupAndGroupPolicy(addrs[0], members, policy)
+
+	proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{member1},
+	})
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+
+	voteWeighted := func(voter string, options ...group.WeightedVoteOption) error {
+		_, err := s.groupKeeper.VoteWeighted(s.ctx, &group.MsgVoteWeighted{
+			ProposalId: proposalID,
+			Voter:      voter,
+			Options:    options,
+		})
+		return err
+	}
+
+	s.Require().ErrorContains(voteWeighted(member1), "vote options: value is empty")
+	s.Require().ErrorContains(voteWeighted(member1,
+		group.WeightedVoteOption{Option: group.VOTE_OPTION_YES, Weight: "0.5"},
+		group.WeightedVoteOption{Option: group.VOTE_OPTION_YES, Weight: "0.5"},
+	), "duplicate value")
+	s.Require().ErrorContains(voteWeighted(member1,
+		group.WeightedVoteOption{Option: group.VOTE_OPTION_YES, Weight: "0.5"},
+		group.WeightedVoteOption{Option: group.VOTE_OPTION_NO, Weight: "0.4"},
+	), "total weight of vote options must be 1")
+	s.Require().ErrorContains(voteWeighted(member1,
+		group.WeightedVoteOption{Option: group.VOTE_OPTION_YES, Weight: "1.5"},
+		group.WeightedVoteOption{Option: group.VOTE_OPTION_NO, Weight: "-0.5"},
+	), "expected a positive decimal")
+	s.Require().ErrorContains(voteWeighted(nonMember,
+		group.WeightedVoteOption{Option: group.VOTE_OPTION_YES, Weight: "1"},
+	), "not found")
+
+	s.Require().NoError(voteWeighted(member1,
+		group.WeightedVoteOption{Option: group.VOTE_OPTION_YES, Weight: "0.5"},
+		group.WeightedVoteOption{Option: group.VOTE_OPTION_ABSTAIN, Weight: "0.5"},
+	))
+	s.Require().NoError(voteWeighted(member2,
+		group.WeightedVoteOption{Option: group.VOTE_OPTION_YES, Weight: "0.75"},
+		group.WeightedVoteOption{Option: group.VOTE_OPTION_NO, Weight: "0.25"},
+	))
+	s.Require().ErrorContains(voteWeighted(member2,
+		group.WeightedVoteOption{Option: group.VOTE_OPTION_YES, Weight: "1"},
+	), "store vote")
+
+	voteRes, err := s.groupKeeper.VoteByProposalVoter(s.ctx, &group.QueryVoteByProposalVoterRequest{
+		ProposalId: proposalID,
+		Voter:      member2,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(group.VOTE_OPTION_UNSPECIFIED, voteRes.Vote.Option)
+	s.Require().Len(voteRes.Vote.Options, 2)
+
+	tallyRes, err := s.groupKeeper.TallyResult(s.ctx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.TallyResult{
+		YesCount:        "4.00",
+		NoCount:         "1.00",
+		AbstainCount:    "1.0",
+		NoWithVetoCount: "0",
+	}, tallyRes.Tally)
+}
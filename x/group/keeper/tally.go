@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	storetypes "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/group"
 	"cosmossdk.io/x/group/errors"
@@ -15,16 +16,26 @@ import (
 // and returns the tally result without modifying the proposal or any state.
 func (k Keeper) Tally(ctx context.Context, p group.Proposal, groupID uint64) (group.TallyResult, error) {
 	// If proposal has already been tallied and updated, then its status is
-	// accepted/rejected, in which case we just return the previously stored result.
+	// accepted/rejected/vetoed, in which case we just return the previously
+	// stored result.
 	//
 	// In all other cases (including withdrawn, aborted...) we do the tally
 	// again.
-	if p.Status == group.PROPOSAL_STATUS_ACCEPTED || p.Status == group.PROPOSAL_STATUS_REJECTED {
+	if p.Status == group.PROPOSAL_STATUS_ACCEPTED || p.Status == group.PROPOSAL_STATUS_REJECTED ||
+		p.Status == group.PROPOSAL_STATUS_VETOED {
 		return p.FinalTallyResult, nil
 	}
 
 	kvStore := k.KVStoreService.OpenKVStore(ctx)
 
+	delegations, err := k.weightDelegationsByGroup(kvStore, groupID)
+	if err != nil {
+		return group.TallyResult{}, err
+	}
+	if len(delegations) > 0 {
+		return k.tallyWithDelegations(kvStore, p, groupID, delegations)
+	}
+
 	it, err := k.voteByProposalIndex.Get(kvStore, p.Id)
 	if err != nil {
 		return group.TallyResult{}, err
@@ -66,3 +77,54 @@ func (k Keeper) Tally(ctx context.Context, p group.Proposal, groupID uint64) (gr
 
 	return tallyResult, nil
 }
+
+// tallyWithDelegations tallies a proposal of a group whose members delegated
+// their weight. The weight of each member is counted for the vote of the first
+// member who voted when following its delegations transitively, starting with
+// the member itself. Hence, a member voting directly overrides its delegation.
+// The weight of the members whose delegation chain has no voter is not counted.
+// Each delegation chain is only followed once, see weightDelegationResolver.
+func (k Keeper) tallyWithDelegations(kvStore storetypes.KVStore, p group.Proposal, groupID uint64, delegations map[string]string) (group.TallyResult, error) {
+	votes, err := k.votesByProposalFromStore(kvStore, p.Id)
+	if err != nil {
+		return group.TallyResult{}, err
+	}
+	votesByVoter := make(map[string]group.Vote, len(votes))
+	for _, vote := range votes {
+		votesByVoter[vote.Voter] = vote
+	}
+
+	it, err := k.groupMemberByGroupIndex.Get(kvStore, groupID)
+	if err != nil {
+		return group.TallyResult{}, err
+	}
+	defer it.Close()
+
+	tallyResult := group.DefaultTallyResult()
+	resolver := newWeightDelegationResolver(delegations, func(addr string) bool {
+		_, voted := votesByVoter[addr]
+		return voted
+	})
+
+	for {
+		var member group.GroupMember
+		_, err = it.LoadNext(&member)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return group.TallyResult{}, err
+		}
+
+		voter, ok := resolver.resolve(member.Member.Address)
+		if !ok {
+			continue
+		}
+
+		if err := tallyResult.Add(votesByVoter[voter], member.Member.Weight); err != nil {
+			return group.TallyResult{}, errorsmod.Wrap(err, "add new vote")
+		}
+	}
+
+	return tallyResult, nil
+}
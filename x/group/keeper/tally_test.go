@@ -63,6 +63,52 @@ func (s *TestSuite) TestTally() {
 				AbstainCount:    "0",
 			},
 		},
+		"proposal with delegated weight": {
+			setupProposal: func(ctx context.Context) uint64 {
+				_, err := s.groupKeeper.DelegateWeight(ctx, &group.MsgDelegateWeight{
+					GroupId:   s.groupID,
+					Delegator: s.addrsStr[4],
+					Delegate:  s.addrsStr[1],
+				})
+				s.Require().NoError(err)
+
+				msgs := []sdk.Msg{msgSend1}
+				return submitProposalAndVote(ctx, s, msgs, proposers, group.VOTE_OPTION_YES)
+			},
+			expTallyResult: group.TallyResult{
+				YesCount:        "3",
+				NoCount:         "0",
+				NoWithVetoCount: "0",
+				AbstainCount:    "0",
+			},
+		},
+		"proposal with direct vote overriding delegated weight": {
+			setupProposal: func(ctx context.Context) uint64 {
+				_, err := s.groupKeeper.DelegateWeight(ctx, &group.MsgDelegateWeight{
+					GroupId:   s.groupID,
+					Delegator: s.addrsStr[4],
+					Delegate:  s.addrsStr[1],
+				})
+				s.Require().NoError(err)
+
+				msgs := []sdk.Msg{msgSend1}
+				proposalID := submitProposalAndVote(ctx, s, msgs, proposers, group.VOTE_OPTION_YES)
+				_, err = s.groupKeeper.Vote(ctx, &group.MsgVote{
+					ProposalId: proposalID,
+					Voter:      s.addrsStr[4],
+					Option:     group.VOTE_OPTION_NO,
+				})
+				s.Require().NoError(err)
+
+				return proposalID
+			},
+			expTallyResult: group.TallyResult{
+				YesCount:        "2",
+				NoCount:         "1",
+				NoWithVetoCount: "0",
+				AbstainCount:    "0",
+			},
+		},
 	}
 
 	for msg, spec := range specs {
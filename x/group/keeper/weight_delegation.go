@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/x/group"
+	"cosmossdk.io/x/group/errors"
+	"cosmossdk.io/x/group/internal/orm"
+)
+
+// weightDelegationsByGroup returns the weight delegations of a group, indexed
+// by delegator address.
+func (k Keeper) weightDelegationsByGroup(kvStore storetypes.KVStore, groupID uint64) (map[string]string, error) {
+	it, err := k.weightDelegationByGroupIndex.Get(kvStore, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	delegations := make(map[string]string)
+	for {
+		var delegation group.WeightDelegation
+		_, err = it.LoadNext(&delegation)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		delegations[delegation.Delegator] = delegation.Delegate
+	}
+
+	return delegations, nil
+}
+
+// resolveWeightDelegation follows the weight delegations starting from the
+// given member, and returns the first member of the chain for which accept
+// returns true. The second return value is false when no member of the chain
+// is accepted.
+func resolveWeightDelegation(member string, delegations map[string]string, accept func(string) bool) (string, bool) {
+	visited := make(map[string]bool)
+	for current := member; !visited[current]; {
+		if accept(current) {
+			return current, true
+		}
+		visited[current] = true
+
+		delegate, ok := delegations[current]
+		if !ok {
+			break
+		}
+		current = delegate
+	}
+
+	return "", false
+}
+
+// weightDelegationResolver resolves the voters of the members of a group by
+// following their weight delegations, see resolveWeightDelegation. The voter
+// found at the end of a chain is memoized for every member of the chain, so
+// that each chain is only followed once and resolving the voters of all the
+// members of a group is linear in the number of members and delegations.
+type weightDelegationResolver struct {
+	delegations map[string]string
+	voted       func(string) bool
+
+	// voters are the resolved voters by member, "" for the members whose
+	// delegation chain has no voter.
+	voters map[string]string
+}
+
+func newWeightDelegationResolver(delegations map[string]string, voted func(string) bool) *weightDelegationResolver {
+	return &weightDelegationResolver{
+		delegations: delegations,
+		voted:       voted,
+		voters:      make(map[string]string),
+	}
+}
+
+// resolve returns the first member who voted when following the delegations
+// starting from the given member. The second return value is false when no
+// member of the chain voted.
+func (r *weightDelegationResolver) resolve(member string) (string, bool) {
+	var (
+		chain   []string
+		inChain = make(map[string]bool)
+		voter   string
+	)
+	for current := member; !inChain[current]; {
+		if v, ok := r.voters[current]; ok {
+			voter = v
+			break
+		}
+		if r.voted(current) {
+			voter = current
+			r.voters[current] = current
+			break
+		}
+		chain = append(chain, current)
+		inChain[current] = true
+
+		delegate, ok := r.delegations[current]
+		if !ok {
+			break
+		}
+		current = delegate
+	}
+
+	for _, m := range chain {
+		r.voters[m] = voter
+	}
+
+	return voter, voter != ""
+}
+
+// deleteWeightDelegations deletes the weight delegations from and to a member
+// leaving a group. The members who delegated their weight to it get their
+// weight back.
+func (k Keeper) deleteWeightDelegations(kvStore storetypes.KVStore, groupID uint64, member string) error {
+	addressCodec := k.accKeeper.AddressCodec()
+
+	delegation := group.WeightDelegation{GroupId: groupID, Delegator: member}
+	if k.weightDelegationTable.Contains(kvStore, &delegation) {
+		if err := k.weightDelegationTable.Delete(kvStore, &delegation); err != nil {
+			return err
+		}
+	}
+
+	memberAddr, err := addressCodec.StringToBytes(member)
+	if err != nil {
+		return err
+	}
+	it, err := k.weightDelegationByDelegateIndex.Get(kvStore, memberAddr)
+	if err != nil {
+		return err
+	}
+	var delegations []*group.WeightDelegation
+	if _, err := orm.ReadAll(it, &delegations); err != nil {
+		return err
+	}
+
+	for _, d := range delegations {
+		if d.GroupId != groupID {
+			continue
+		}
+		if err := k.weightDelegationTable.Delete(kvStore, d); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doDelegateWeight sets the weight delegation of a group member, after
+// checking that it does not create a delegation cycle.
+func (k Keeper) doDelegateWeight(ctx context.Context, delegation group.WeightDelegation) error {
+	kvStore := k.KVStoreService.OpenKVStore(ctx)
+
+	delegations, err := k.weightDelegationsByGroup(kvStore, delegation.GroupId)
+	if err != nil {
+		return err
+	}
+
+	if _, found := resolveWeightDelegation(delegation.Delegate, delegations, func(member string) bool {
+		return member == delegation.Delegator
+	}); found {
+		return errors.ErrInvalid.Wrapf("delegating weight to %s creates a delegation cycle", delegation.Delegate)
+	}
+
+	return k.weightDelegationTable.Set(kvStore, &delegation)
+}
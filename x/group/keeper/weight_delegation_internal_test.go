@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeightDelegationResolver(t *testing.T) {
+	// a single chain m0 -> m1 -> ... -> m999, where only the last member voted
+	const n = 1000
+	delegations := make(map[string]string, n)
+	for i := 0; i < n-1; i++ {
+		delegations[fmt.Sprintf("m%d", i)] = fmt.Sprintf("m%d", i+1)
+	}
+
+	calls := 0
+	resolver := newWeightDelegationResolver(delegations, func(member string) bool {
+		calls++
+		return member == fmt.Sprintf("m%d", n-1)
+	})
+
+	for i := 0; i < n; i++ {
+		voter, ok := resolver.resolve(fmt.Sprintf("m%d", i))
+		require.True(t, ok)
+		require.Equal(t, fmt.Sprintf("m%d", n-1), voter)
+	}
+
+	// the chain is only followed once
+	require.Equal(t, n, calls)
+}
+
+func TestWeightDelegationResolverNoVoter(t *testing.T) {
+	delegations := map[string]string{
+		"a": "c",
+		"b": "c",
+		"c": "d",
+		// cycles can't be created, but are not followed forever either
+		"x": "y",
+		"y": "x",
+	}
+	resolver := newWeightDelegationResolver(delegations, func(member string) bool {
+		return member == "b"
+	})
+
+	// a member voting directly overrides its delegation
+	voter, ok := resolver.resolve("b")
+	require.True(t, ok)
+	require.Equal(t, "b", voter)
+
+	for _, member := range []string{"a", "c", "d", "x", "y", "z"} {
+		_, ok := resolver.resolve(member)
+		require.False(t, ok, member)
+	}
+}
@@ -0,0 +1,45 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/group"
+	"cosmossdk.io/x/group/internal/orm"
+)
+
+const (
+	ModuleName = "group"
+
+	// Proposal Table
+	ProposalTablePrefix     byte = 0x30
+	ProposalTableSeqPrefix  byte = 0x31
+	ProposalsByStatusPrefix byte = 0x35
+)
+
+// Migrate migrates the x/group module state from the consensus version 2 to version 3.
+// Specifically, it indexes the existing proposals by status.
+func Migrate(
+	ctx context.Context,
+	storeService store.KVStoreService,
+	proposalTable orm.AutoUInt64Table,
+) error {
+	store := storeService.OpenKVStore(ctx)
+
+	var proposals []*group.Proposal
+	if _, err := proposalTable.Export(store, &proposals); err != nil {
+		return fmt.Errorf("failed to get proposals: %w", err)
+	}
+
+	for _, proposal := range proposals {
+		// index keys are `prefix | BigEndian(Status) | BigEndian(ProposalId)`
+		key := append([]byte{ProposalsByStatusPrefix}, orm.EncodeSequence(uint64(proposal.Status))...)
+		key = append(key, orm.EncodeSequence(proposal.Id)...)
+		if err := store.Set(key, []byte{}); err != nil {
+			return fmt.Errorf("failed to index proposal %d by status: %w", proposal.Id, err)
+		}
+	}
+
+	return nil
+}
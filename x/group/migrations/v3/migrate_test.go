@@ -0,0 +1,60 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/group"
+	"cosmossdk.io/x/group/internal/orm"
+	v3 "cosmossdk.io/x/group/migrations/v3"
+	groupmodule "cosmossdk.io/x/group/module"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const policyAddr = "cosmos1q32tjg5qm3n9fj8wjgpd7gl98prefntrckjkyvh8tntp7q33zj0s5tkjrk"
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, groupmodule.AppModule{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v3.ModuleName)
+	storeService := runtime.NewKVStoreService(storeKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+
+	proposalTable, err := orm.NewAutoUInt64Table([2]byte{v3.ProposalTablePrefix}, v3.ProposalTableSeqPrefix, &group.Proposal{}, cdc, codectestutil.CodecOptions{}.GetAddressCodec())
+	require.NoError(t, err)
+
+	kvStore := storeService.OpenKVStore(ctx)
+	statuses := []group.ProposalStatus{
+		group.PROPOSAL_STATUS_SUBMITTED,
+		group.PROPOSAL_STATUS_ACCEPTED,
+		group.PROPOSAL_STATUS_SUBMITTED,
+	}
+	for i, status := range statuses {
+		id, err := proposalTable.Create(kvStore, &group.Proposal{
+			Id:                 uint64(i + 1),
+			GroupPolicyAddress: policyAddr,
+			GroupVersion:       1,
+			GroupPolicyVersion: 1,
+			Status:             status,
+			FinalTallyResult:   group.DefaultTallyResult(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint64(i+1), id)
+	}
+
+	require.NoError(t, v3.Migrate(ctx, storeService, *proposalTable))
+
+	for i, status := range statuses {
+		key := append([]byte{v3.ProposalsByStatusPrefix}, orm.EncodeSequence(uint64(status))...)
+		key = append(key, orm.EncodeSequence(uint64(i+1))...)
+		has, err := kvStore.Has(key)
+		require.NoError(t, err)
+		require.True(t, has)
+	}
+}
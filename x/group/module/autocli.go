@@ -100,6 +100,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "voter"},
 					},
 				},
+				{
+					RpcMethod: "ProposalsByVoter",
+					Use:       "proposals-by-voter [voter]",
+					Short:     "Query for proposals by voter account address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "voter"},
+					},
+				},
+				{
+					RpcMethod: "ProposalsByStatus",
+					Use:       "proposals-by-status [status]",
+					Short:     "Query for proposals by status",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "status"},
+					},
+				},
 				{
 					RpcMethod: "GroupsByMember",
 					Use:       "groups-by-member [address]",
@@ -205,6 +221,22 @@ Parameters:
 						{ProtoField: "address"}, {ProtoField: "group_id"},
 					},
 				},
+				{
+					RpcMethod: "DelegateWeight",
+					Use:       "delegate-weight [delegator] [group-id] [delegate]",
+					Short:     "Delegate the weight of a group member to another member, or remove the delegation with an empty delegate",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator"}, {ProtoField: "group_id"}, {ProtoField: "delegate"},
+					},
+				},
+				{
+					RpcMethod: "VetoProposal",
+					Use:       "veto-proposal [proposal-id] [guardian]",
+					Short:     "Veto an accepted proposal as the guardian of its group policy",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"}, {ProtoField: "guardian"},
+					},
+				},
 			},
 		},
 	}
@@ -1,6 +1,9 @@
 package module
 
 import (
+	"fmt"
+	"sort"
+
 	modulev1 "cosmossdk.io/api/cosmos/group/module/v1"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
@@ -21,6 +24,7 @@ func init() {
 	appconfig.RegisterModule(
 		&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetGroupHooks),
 	)
 }
 
@@ -51,8 +55,51 @@ func ProvideModule(in GroupInputs) GroupOutputs {
 			MaxMetadataLen:        in.Config.MaxMetadataLen,
 			MaxProposalTitleLen:   in.Config.MaxProposalTitleLen,
 			MaxProposalSummaryLen: in.Config.MaxProposalSummaryLen,
+			MemberExitDelay:       in.Config.MemberExitDelay.AsDuration(),
 		},
 	)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
 	return GroupOutputs{GroupKeeper: k, Module: m}
 }
+
+func InvokeSetGroupHooks(
+	config *modulev1.Module,
+	keeper keeper.Keeper,
+	groupHooks map[string]group.GroupHooksWrapper,
+) error {
+	// all arguments to invokers are optional
+	if config == nil {
+		return nil
+	}
+
+	modNames := make([]string, 0, len(groupHooks))
+	for modName := range groupHooks {
+		modNames = append(modNames, modName)
+	}
+	order := config.HooksOrder
+	if len(order) == 0 {
+		order = modNames
+		sort.Strings(order)
+	}
+
+	if len(order) != len(modNames) {
+		return fmt.Errorf("len(hooks_order: %v) != len(hooks modules: %v)", order, modNames)
+	}
+
+	if len(modNames) == 0 {
+		return nil
+	}
+
+	var multiHooks group.MultiGroupHooks
+	for _, modName := range order {
+		hook, ok := groupHooks[modName]
+		if !ok {
+			return fmt.Errorf("can't find group hooks for module %s", modName)
+		}
+
+		multiHooks = append(multiHooks, hook)
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
+}
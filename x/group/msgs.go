@@ -22,8 +22,11 @@ var (
 	_ sdk.Msg = &MsgUpdateGroupPolicyDecisionPolicy{}
 	_ sdk.Msg = &MsgUpdateGroupPolicyMetadata{}
 	_ sdk.Msg = &MsgLeaveGroup{}
+	_ sdk.Msg = &MsgDelegateWeight{}
+	_ sdk.Msg = &MsgVetoProposal{}
 	_ sdk.Msg = &MsgExec{}
 	_ sdk.Msg = &MsgVote{}
+	_ sdk.Msg = &MsgVoteWeighted{}
 	_ sdk.Msg = &MsgWithdrawProposal{}
 	_ sdk.Msg = &MsgSubmitProposal{}
 	_ sdk.Msg = &MsgCreateGroupPolicy{}
@@ -48,6 +51,11 @@ func (m *MsgUpdateGroupAdmin) GetGroupID() uint64 {
 	return m.GroupId
 }
 
+// GetGroupID gets the group id of the MsgDelegateWeight.
+func (m *MsgDelegateWeight) GetGroupID() uint64 {
+	return m.GroupId
+}
+
 // NewMsgCreateGroupWithPolicy creates a new MsgCreateGroupWithPolicy.
 func NewMsgCreateGroupWithPolicy(admin string, members []MemberRequest, groupMetadata, groupPolicyMetadata string, groupPolicyAsAdmin bool, decisionPolicy DecisionPolicy) (*MsgCreateGroupWithPolicy, error) {
 	m := &MsgCreateGroupWithPolicy{
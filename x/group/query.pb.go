@@ -1482,6 +1482,226 @@ func (m *QueryGroupsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryProposalsByVoterRequest is the Query/ProposalsByVoter request type.
+type QueryProposalsByVoterRequest struct {
+	// voter is a proposal voter account address.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalsByVoterRequest) Reset()         { *m = QueryProposalsByVoterRequest{} }
+func (m *QueryProposalsByVoterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByVoterRequest) ProtoMessage()    {}
+func (*QueryProposalsByVoterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{28}
+}
+func (m *QueryProposalsByVoterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByVoterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByVoterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsByVoterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByVoterRequest.Merge(m, src)
+}
+func (m *QueryProposalsByVoterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByVoterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByVoterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsByVoterRequest proto.InternalMessageInfo
+
+func (m *QueryProposalsByVoterRequest) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *QueryProposalsByVoterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProposalsByVoterResponse is the Query/ProposalsByVoter response type.
+type QueryProposalsByVoterResponse struct {
+	// proposals are the proposals the voter has voted on.
+	Proposals []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalsByVoterResponse) Reset()         { *m = QueryProposalsByVoterResponse{} }
+func (m *QueryProposalsByVoterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByVoterResponse) ProtoMessage()    {}
+func (*QueryProposalsByVoterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{29}
+}
+func (m *QueryProposalsByVoterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByVoterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByVoterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsByVoterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByVoterResponse.Merge(m, src)
+}
+func (m *QueryProposalsByVoterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByVoterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByVoterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsByVoterResponse proto.InternalMessageInfo
+
+func (m *QueryProposalsByVoterResponse) GetProposals() []*Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryProposalsByVoterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProposalsByStatusRequest is the Query/ProposalsByStatus request type.
+type QueryProposalsByStatusRequest struct {
+	// status is the proposal status.
+	Status ProposalStatus `protobuf:"varint,1,opt,name=status,proto3,enum=cosmos.group.v1.ProposalStatus" json:"status,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalsByStatusRequest) Reset()         { *m = QueryProposalsByStatusRequest{} }
+func (m *QueryProposalsByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByStatusRequest) ProtoMessage()    {}
+func (*QueryProposalsByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{30}
+}
+func (m *QueryProposalsByStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByStatusRequest.Merge(m, src)
+}
+func (m *QueryProposalsByStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsByStatusRequest proto.InternalMessageInfo
+
+func (m *QueryProposalsByStatusRequest) GetStatus() ProposalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (m *QueryProposalsByStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProposalsByStatusResponse is the Query/ProposalsByStatus response type.
+type QueryProposalsByStatusResponse struct {
+	// proposals are the proposals with the given status.
+	Proposals []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalsByStatusResponse) Reset()         { *m = QueryProposalsByStatusResponse{} }
+func (m *QueryProposalsByStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByStatusResponse) ProtoMessage()    {}
+func (*QueryProposalsByStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{31}
+}
+func (m *QueryProposalsByStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsByStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByStatusResponse.Merge(m, src)
+}
+func (m *QueryProposalsByStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsByStatusResponse proto.InternalMessageInfo
+
+func (m *QueryProposalsByStatusResponse) GetProposals() []*Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryProposalsByStatusResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGroupInfoRequest)(nil), "cosmos.group.v1.QueryGroupInfoRequest")
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "cosmos.group.v1.QueryGroupInfoResponse")
@@ -1511,95 +1731,108 @@ func init() {
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.group.v1.QueryTallyResultResponse")
 	proto.RegisterType((*QueryGroupsRequest)(nil), "cosmos.group.v1.QueryGroupsRequest")
 	proto.RegisterType((*QueryGroupsResponse)(nil), "cosmos.group.v1.QueryGroupsResponse")
+	proto.RegisterType((*QueryProposalsByVoterRequest)(nil), "cosmos.group.v1.QueryProposalsByVoterRequest")
+	proto.RegisterType((*QueryProposalsByVoterResponse)(nil), "cosmos.group.v1.QueryProposalsByVoterResponse")
+	proto.RegisterType((*QueryProposalsByStatusRequest)(nil), "cosmos.group.v1.QueryProposalsByStatusRequest")
+	proto.RegisterType((*QueryProposalsByStatusResponse)(nil), "cosmos.group.v1.QueryProposalsByStatusResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x25, 0x3f, 0x5f, 0xda, 0x46, 0x9d, 0x26, 0x6d, 0xb2, 0x8d, 0x9c, 0xb0, 0x85,
-	0xfc, 0xce, 0x6e, 0xec, 0xa4, 0x09, 0x2a, 0x54, 0xa8, 0x96, 0x20, 0xe4, 0x50, 0x94, 0x9a, 0x0a,
-	0x09, 0x2e, 0xd1, 0xa6, 0xde, 0x58, 0xab, 0xda, 0x3b, 0xae, 0xd7, 0x89, 0xb0, 0x22, 0x5f, 0x90,
-	0x40, 0x02, 0x71, 0xa0, 0x14, 0xa1, 0x12, 0x71, 0xe8, 0x01, 0x89, 0x72, 0xe3, 0x50, 0x84, 0xc4,
-	0xad, 0xb7, 0x8a, 0x53, 0x55, 0x2e, 0x9c, 0x10, 0x4a, 0x90, 0xf8, 0x37, 0xd0, 0xce, 0xbc, 0xb5,
-	0xf7, 0xc7, 0xec, 0xda, 0x11, 0x16, 0xcd, 0xa5, 0xaa, 0x77, 0xde, 0x9b, 0xf9, 0xcc, 0xf7, 0xbd,
-	0x9d, 0xfd, 0x4e, 0xe0, 0xd2, 0x6d, 0xe6, 0x94, 0x98, 0xa3, 0x17, 0x2a, 0x6c, 0xb7, 0xac, 0xef,
-	0xa5, 0xf5, 0xbb, 0xbb, 0x66, 0xa5, 0xa6, 0x95, 0x2b, 0xac, 0xca, 0xe8, 0x90, 0x18, 0xd4, 0xf8,
-	0xa0, 0xb6, 0x97, 0x56, 0x86, 0x0b, 0xac, 0xc0, 0xf8, 0x98, 0xee, 0xfe, 0x4f, 0x84, 0x29, 0xe3,
-	0x05, 0xc6, 0x0a, 0x45, 0x53, 0x37, 0xca, 0x96, 0x6e, 0xd8, 0x36, 0xab, 0x1a, 0x55, 0x8b, 0xd9,
-	0x0e, 0x8e, 0x46, 0x56, 0xa8, 0xd6, 0xca, 0xa6, 0x37, 0x38, 0x87, 0x83, 0xdb, 0x86, 0x63, 0x8a,
-	0xa5, 0xf5, 0xbd, 0xf4, 0xb6, 0x59, 0x35, 0xd2, 0x7a, 0xd9, 0x28, 0x58, 0x36, 0x9f, 0x09, 0x63,
-	0xc7, 0x44, 0xec, 0x96, 0x58, 0x1f, 0xd1, 0xc4, 0xd0, 0x39, 0xa3, 0x64, 0xd9, 0x4c, 0xe7, 0xff,
-	0x8a, 0x47, 0x6a, 0x06, 0x46, 0x6e, 0xba, 0xf3, 0xad, 0xbb, 0xcb, 0x6e, 0xd8, 0x3b, 0x2c, 0x67,
-	0xde, 0xdd, 0x35, 0x9d, 0x2a, 0x1d, 0x83, 0x7e, 0x8e, 0xb2, 0x65, 0xe5, 0x47, 0xc9, 0x24, 0x99,
-	0xe9, 0xce, 0xf5, 0xf1, 0xdf, 0x1b, 0x79, 0xf5, 0x1d, 0xb8, 0x10, 0xce, 0x71, 0xca, 0xcc, 0x76,
-	0x4c, 0xaa, 0x41, 0xb7, 0x65, 0xef, 0x30, 0x9e, 0x30, 0x98, 0x51, 0xb4, 0x90, 0x30, 0x5a, 0x33,
-	0x83, 0xc7, 0xa9, 0x37, 0xe1, 0x52, 0x73, 0xa6, 0x4d, 0x56, 0xb4, 0x6e, 0xd7, 0xfc, 0x0c, 0x19,
-	0xe8, 0x33, 0xf2, 0xf9, 0x8a, 0xe9, 0x38, 0x7c, 0xc6, 0x81, 0xec, 0xe8, 0xf3, 0xc7, 0x8b, 0xc3,
-	0x38, 0xe9, 0x75, 0x31, 0xf2, 0x5e, 0xb5, 0x62, 0xd9, 0x85, 0x9c, 0x17, 0xa8, 0xde, 0x82, 0x71,
-	0xf9, 0x94, 0x88, 0xb8, 0x12, 0x40, 0x9c, 0x94, 0x23, 0xfa, 0xf2, 0x04, 0x68, 0x1d, 0x46, 0x9b,
-	0xb3, 0xde, 0x30, 0x4b, 0xdb, 0x66, 0xc5, 0x69, 0xad, 0x14, 0x7d, 0x1b, 0xa0, 0x59, 0x9f, 0xd1,
-	0x53, 0x7c, 0xc9, 0x29, 0x6f, 0x49, 0xb7, 0x98, 0x9a, 0xe8, 0x23, 0x2c, 0xa6, 0xb6, 0x69, 0x14,
-	0x4c, 0x9c, 0x36, 0xe7, 0xcb, 0x54, 0xbf, 0x23, 0x30, 0x26, 0x59, 0x1f, 0xb7, 0xb4, 0x0a, 0x7d,
-	0x25, 0xf1, 0x68, 0x94, 0x4c, 0xbe, 0x34, 0x33, 0x98, 0x19, 0x97, 0xef, 0x4a, 0xe4, 0xe5, 0xbc,
-	0x60, 0xba, 0x2e, 0xa1, 0x9b, 0x6e, 0x49, 0x27, 0x16, 0x0d, 0xe0, 0xdd, 0x0f, 0xe0, 0x39, 0xd9,
-	0xda, 0xf5, 0x7c, 0xc9, 0xb2, 0x3d, 0x7d, 0x34, 0xe8, 0x31, 0xdc, 0xdf, 0x2d, 0x6b, 0x28, 0xc2,
-	0x3a, 0x26, 0xda, 0xb7, 0x04, 0x14, 0x19, 0x15, 0xaa, 0x96, 0x81, 0x5e, 0x2e, 0x8f, 0x27, 0x5a,
-	0x52, 0xb7, 0x62, 0x64, 0xe7, 0x14, 0xfb, 0x84, 0xc0, 0x64, 0xa8, 0x4d, 0x2d, 0xd3, 0xc9, 0x8a,
-	0x9f, 0xff, 0x63, 0x63, 0xfd, 0x4c, 0xe0, 0xe5, 0x04, 0x0e, 0x94, 0x6a, 0x1d, 0xce, 0x0a, 0x90,
-	0x32, 0x06, 0xa0, 0x64, 0xad, 0xdf, 0x9e, 0x33, 0x05, 0xff, 0xbc, 0x9d, 0xd3, 0xef, 0x20, 0x46,
-	0xbf, 0x13, 0xd1, 0x78, 0x71, 0xa2, 0x06, 0xfb, 0xef, 0xe4, 0x89, 0xba, 0x06, 0xc3, 0x1c, 0x7b,
-	0xb3, 0xc2, 0xca, 0xcc, 0x31, 0x8a, 0x9e, 0x8e, 0x13, 0x30, 0x58, 0xc6, 0x47, 0xcd, 0x56, 0x04,
-	0xef, 0xd1, 0x46, 0x5e, 0x7d, 0x17, 0x3f, 0x22, 0xcd, 0x44, 0xdc, 0xe3, 0x15, 0xe8, 0xf7, 0xc2,
-	0xf0, 0xc0, 0x1d, 0x8b, 0xec, 0xae, 0x91, 0xd4, 0x08, 0x55, 0x1f, 0x12, 0x50, 0x03, 0x13, 0x7a,
-	0x1d, 0x29, 0x44, 0xf8, 0x0f, 0x9f, 0x87, 0x8e, 0xd5, 0xf8, 0x07, 0x02, 0x97, 0x13, 0x11, 0x51,
-	0x81, 0x35, 0x18, 0xf0, 0xb6, 0xe5, 0x15, 0x38, 0x41, 0x82, 0x66, 0x6c, 0xe7, 0xaa, 0x5a, 0x81,
-	0x09, 0x0e, 0xfa, 0x3e, 0xab, 0x9a, 0xd9, 0x06, 0xae, 0xfb, 0xab, 0xd2, 0x6e, 0x81, 0xdd, 0x37,
-	0x69, 0xcf, 0x4d, 0xe0, 0x1c, 0x89, 0x6f, 0x12, 0x0f, 0x53, 0x6f, 0xe0, 0xdb, 0x29, 0x5d, 0x13,
-	0x95, 0x99, 0x85, 0x6e, 0x37, 0x18, 0xfb, 0x62, 0x24, 0x22, 0x8a, 0x1b, 0x9d, 0xe3, 0x21, 0xea,
-	0xa7, 0x04, 0x7d, 0x82, 0xfb, 0xcc, 0xc9, 0x1e, 0xbb, 0x41, 0x3b, 0x56, 0xf5, 0xaf, 0x09, 0xba,
-	0x8b, 0x08, 0x08, 0x6e, 0x6a, 0x5e, 0x08, 0xe5, 0x95, 0x3a, 0x66, 0x57, 0x22, 0xa6, 0x73, 0x25,
-	0xfe, 0x8a, 0xa0, 0x3d, 0x41, 0xac, 0x40, 0x71, 0x1b, 0xb5, 0x23, 0x6d, 0xd5, 0xae, 0x63, 0x5a,
-	0xdd, 0xf3, 0x4c, 0x41, 0x10, 0xea, 0x85, 0x0a, 0xf5, 0x20, 0x6c, 0x09, 0xd0, 0x12, 0x9d, 0x80,
-	0x03, 0xe5, 0x80, 0xf8, 0xbd, 0xb0, 0x0f, 0xed, 0x24, 0xd8, 0x95, 0xab, 0x70, 0x91, 0xb3, 0xdd,
-	0x32, 0x8a, 0x45, 0xf7, 0x6c, 0xdb, 0x2d, 0x56, 0xdb, 0xfe, 0x38, 0x7c, 0x80, 0xbd, 0x19, 0xc8,
-	0xc5, 0x4d, 0x5d, 0x83, 0x9e, 0xaa, 0xfb, 0x18, 0x0f, 0x81, 0xa8, 0x6f, 0xf5, 0x25, 0x65, 0x07,
-	0x9e, 0xfe, 0x39, 0xd1, 0xf5, 0xe8, 0x9f, 0x9f, 0xe6, 0x48, 0x4e, 0x64, 0xa9, 0x0e, 0x50, 0x9f,
-	0x64, 0x1e, 0x51, 0x87, 0x2a, 0x72, 0x75, 0xe4, 0xf9, 0xe3, 0xc5, 0x73, 0x22, 0x6d, 0xd1, 0xc9,
-	0xdf, 0x99, 0x5c, 0xd2, 0x56, 0xd6, 0xb4, 0xb4, 0xfa, 0x23, 0x81, 0xf3, 0x81, 0x55, 0x4f, 0x40,
-	0x81, 0x62, 0x58, 0x33, 0xf7, 0x28, 0xf4, 0x70, 0x56, 0xfa, 0x05, 0x81, 0x81, 0xc6, 0xfa, 0x74,
-	0x2a, 0xc2, 0x26, 0xbd, 0x04, 0x2a, 0xd3, 0x2d, 0xe3, 0x04, 0x8b, 0xaa, 0x7d, 0xfc, 0xfb, 0xdf,
-	0xf7, 0x4f, 0xcd, 0xd0, 0x29, 0x3d, 0x7c, 0x8d, 0x45, 0x07, 0x6b, 0xef, 0x30, 0x7d, 0xdf, 0x73,
-	0xb3, 0x75, 0xfa, 0x3d, 0x81, 0xa1, 0x90, 0xad, 0xa1, 0x0b, 0x09, 0x8b, 0x45, 0xee, 0x86, 0xca,
-	0x62, 0x9b, 0xd1, 0x08, 0xb8, 0xc2, 0x01, 0x35, 0xba, 0x10, 0x03, 0xc8, 0x4d, 0x58, 0x0d, 0x39,
-	0xf1, 0xdd, 0xae, 0xd3, 0x07, 0x04, 0x4e, 0xfb, 0xaf, 0x5c, 0x74, 0x36, 0x61, 0xd5, 0xe0, 0xb5,
-	0x50, 0x99, 0x6b, 0x27, 0x14, 0xe9, 0xd2, 0x9c, 0x6e, 0x9e, 0xce, 0xc6, 0xd0, 0xe1, 0x8d, 0xcd,
-	0xaf, 0xe0, 0x01, 0x81, 0x33, 0x81, 0x8b, 0x0d, 0x4d, 0x5a, 0x30, 0x64, 0x8d, 0x95, 0xf9, 0xb6,
-	0x62, 0x91, 0x6e, 0x89, 0xd3, 0xcd, 0xd1, 0x19, 0x39, 0x9d, 0xb3, 0xb5, 0x5d, 0xdb, 0xe2, 0x0e,
-	0xda, 0x55, 0xae, 0x64, 0xd9, 0x75, 0xfa, 0x2b, 0x81, 0x61, 0xd9, 0x8d, 0x82, 0xa6, 0x5b, 0x55,
-	0x2d, 0x72, 0x0b, 0x52, 0x32, 0xc7, 0x49, 0x41, 0xe2, 0xd7, 0x39, 0xf1, 0x15, 0xba, 0x9c, 0x54,
-	0x6d, 0xcb, 0xe4, 0xe4, 0x62, 0xc8, 0xa7, 0xec, 0x2f, 0x51, 0x78, 0x21, 0x70, 0x7b, 0xf0, 0x01,
-	0x9d, 0x33, 0xc7, 0x49, 0x41, 0xf8, 0xd7, 0x38, 0x7c, 0x86, 0x2e, 0xb5, 0x01, 0x1f, 0x94, 0xfd,
-	0x73, 0x02, 0xfd, 0x9e, 0x25, 0xa1, 0xaf, 0xca, 0x97, 0x0e, 0x79, 0x27, 0x65, 0xaa, 0x55, 0x18,
-	0x52, 0xe9, 0x9c, 0x6a, 0x96, 0x4e, 0x47, 0xa8, 0xbc, 0xb3, 0x5e, 0xdf, 0xf7, 0x7d, 0x08, 0xea,
-	0xf4, 0x09, 0x81, 0x0b, 0x72, 0x73, 0x4c, 0x97, 0x93, 0xd7, 0x94, 0xba, 0x7d, 0x65, 0xe5, 0x78,
-	0x49, 0x88, 0xfd, 0x06, 0xc7, 0x5e, 0xa5, 0x2b, 0xb1, 0xd8, 0xcd, 0x26, 0xc0, 0x43, 0xc0, 0xf7,
-	0xfe, 0x3f, 0x21, 0x70, 0x5e, 0xe2, 0x61, 0xe9, 0x92, 0x9c, 0x25, 0xde, 0x62, 0x2b, 0xe9, 0x63,
-	0x64, 0x20, 0xfa, 0x5b, 0x1c, 0xfd, 0x4d, 0x7a, 0x2d, 0x82, 0xee, 0xba, 0x22, 0x97, 0xba, 0xa1,
-	0x37, 0x77, 0x6e, 0x41, 0xfd, 0xf5, 0x7d, 0xfe, 0xb0, 0x4e, 0x1f, 0x11, 0x18, 0x0a, 0xd9, 0xd5,
-	0xb8, 0xa3, 0x56, 0x6e, 0xaf, 0xe3, 0x8e, 0xda, 0x18, 0x0f, 0x9c, 0xd0, 0xbf, 0xdc, 0xcd, 0xf9,
-	0xc1, 0x43, 0x2d, 0xf3, 0x0d, 0x81, 0xd3, 0x7e, 0xb7, 0x18, 0x77, 0xdc, 0x4a, 0x6c, 0x6e, 0xdc,
-	0x71, 0x2b, 0x33, 0x9f, 0x09, 0xbd, 0xdc, 0x20, 0x44, 0x45, 0x51, 0xc3, 0x87, 0x04, 0xce, 0x06,
-	0x7d, 0x19, 0x6d, 0x71, 0x82, 0x06, 0x8c, 0xa5, 0xb2, 0xd0, 0x5e, 0x30, 0xe2, 0x2d, 0x73, 0xbc,
-	0x45, 0x3a, 0x9f, 0x70, 0xde, 0x8a, 0x2f, 0x82, 0xaf, 0x55, 0x0f, 0x08, 0x0c, 0xfa, 0xdc, 0x12,
-	0x9d, 0x91, 0x2f, 0x19, 0x75, 0x70, 0xca, 0x6c, 0x1b, 0x91, 0x48, 0xb6, 0xca, 0xc9, 0x96, 0xa8,
-	0x16, 0xff, 0x36, 0x85, 0xba, 0x90, 0x1b, 0x35, 0xfa, 0x19, 0x81, 0x5e, 0xb1, 0x59, 0x7a, 0x39,
-	0x49, 0x0a, 0x0f, 0xe9, 0x95, 0xe4, 0x20, 0xef, 0x9b, 0xfe, 0x9b, 0xcc, 0xf4, 0x70, 0xc4, 0x31,
-	0x7a, 0x31, 0x46, 0xbc, 0xac, 0xf6, 0xf4, 0x30, 0x45, 0x9e, 0x1d, 0xa6, 0xc8, 0x5f, 0x87, 0x29,
-	0xf2, 0xe5, 0x51, 0xaa, 0xeb, 0xd9, 0x51, 0xaa, 0xeb, 0x8f, 0xa3, 0x54, 0xd7, 0x87, 0xe8, 0xf4,
-	0x9d, 0xfc, 0x1d, 0xcd, 0x62, 0xfa, 0x47, 0x22, 0x61, 0xbb, 0x97, 0xff, 0xa1, 0x7c, 0xf9, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x80, 0x6a, 0xbb, 0x90, 0x03, 0x18, 0x00, 0x00,
+	// 1457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xdf, 0x6f, 0x14, 0x55,
+	0x14, 0xc7, 0x7b, 0x11, 0x0a, 0x3d, 0x05, 0x2a, 0x97, 0x16, 0xb6, 0x43, 0xdd, 0xd6, 0x41, 0x4b,
+	0x7f, 0xb0, 0x33, 0xdd, 0x6d, 0xa1, 0x06, 0x25, 0x86, 0x4d, 0xe4, 0xc7, 0x03, 0x06, 0x16, 0x62,
+	0xa2, 0x2f, 0xcd, 0x94, 0x1d, 0x36, 0x13, 0xda, 0x99, 0x65, 0x67, 0xda, 0xb8, 0x69, 0xf6, 0xc5,
+	0x44, 0x13, 0x8d, 0x31, 0x2a, 0xc6, 0x60, 0xe3, 0x03, 0x0f, 0x26, 0x62, 0x7c, 0xf1, 0x01, 0x24,
+	0xfa, 0x60, 0xc2, 0x1b, 0xe1, 0x89, 0xe0, 0x8b, 0x4f, 0xc6, 0x50, 0x13, 0xff, 0x0d, 0x33, 0xf7,
+	0x9e, 0xd9, 0x9d, 0x1f, 0x77, 0x7e, 0x34, 0xae, 0xba, 0x2f, 0xb0, 0x33, 0xf7, 0x9c, 0x7b, 0x3f,
+	0xf7, 0x7b, 0xce, 0xdc, 0x39, 0x67, 0x0a, 0x47, 0xae, 0x59, 0xf6, 0xaa, 0x65, 0xab, 0xb5, 0x86,
+	0xb5, 0x56, 0x57, 0xd7, 0x8b, 0xea, 0xcd, 0x35, 0xbd, 0xd1, 0x54, 0xea, 0x0d, 0xcb, 0xb1, 0xe8,
+	0x10, 0x1f, 0x54, 0xd8, 0xa0, 0xb2, 0x5e, 0x94, 0x86, 0x6b, 0x56, 0xcd, 0x62, 0x63, 0xaa, 0xfb,
+	0x8b, 0x9b, 0x49, 0x63, 0x35, 0xcb, 0xaa, 0xad, 0xe8, 0xaa, 0x56, 0x37, 0x54, 0xcd, 0x34, 0x2d,
+	0x47, 0x73, 0x0c, 0xcb, 0xb4, 0x71, 0x34, 0xb2, 0x82, 0xd3, 0xac, 0xeb, 0xde, 0xe0, 0x0c, 0x0e,
+	0x2e, 0x6b, 0xb6, 0xce, 0x97, 0x56, 0xd7, 0x8b, 0xcb, 0xba, 0xa3, 0x15, 0xd5, 0xba, 0x56, 0x33,
+	0x4c, 0x36, 0x13, 0xda, 0x8e, 0x72, 0xdb, 0x25, 0xbe, 0x3e, 0xbf, 0xc0, 0xa1, 0x03, 0xda, 0xaa,
+	0x61, 0x5a, 0x2a, 0xfb, 0x97, 0xdf, 0x92, 0x4b, 0x30, 0x72, 0xd9, 0x9d, 0xef, 0x9c, 0xbb, 0xec,
+	0x05, 0xf3, 0xba, 0x55, 0xd1, 0x6f, 0xae, 0xe9, 0xb6, 0x43, 0x47, 0x61, 0x0f, 0x43, 0x59, 0x32,
+	0xaa, 0x39, 0x32, 0x41, 0xa6, 0x76, 0x56, 0x76, 0xb3, 0xeb, 0x0b, 0x55, 0xf9, 0x3c, 0x1c, 0x0a,
+	0xfb, 0xd8, 0x75, 0xcb, 0xb4, 0x75, 0xaa, 0xc0, 0x4e, 0xc3, 0xbc, 0x6e, 0x31, 0x87, 0xc1, 0x92,
+	0xa4, 0x84, 0x84, 0x51, 0x3a, 0x1e, 0xcc, 0x4e, 0xbe, 0x0c, 0x47, 0x3a, 0x33, 0x5d, 0xb2, 0x56,
+	0x8c, 0x6b, 0x4d, 0x3f, 0x43, 0x09, 0x76, 0x6b, 0xd5, 0x6a, 0x43, 0xb7, 0x6d, 0x36, 0xe3, 0x40,
+	0x39, 0xf7, 0xf4, 0x5e, 0x61, 0x18, 0x27, 0x3d, 0xc3, 0x47, 0xae, 0x38, 0x0d, 0xc3, 0xac, 0x55,
+	0x3c, 0x43, 0xf9, 0x2a, 0x8c, 0x89, 0xa7, 0x44, 0xc4, 0x85, 0x00, 0xe2, 0x84, 0x18, 0xd1, 0xe7,
+	0xc7, 0x41, 0x5b, 0x90, 0xeb, 0xcc, 0x7a, 0x51, 0x5f, 0x5d, 0xd6, 0x1b, 0x76, 0xba, 0x52, 0xf4,
+	0x2c, 0x40, 0x27, 0x3e, 0xb9, 0x1d, 0x6c, 0xc9, 0x49, 0x6f, 0x49, 0x37, 0x98, 0x0a, 0xcf, 0x23,
+	0x0c, 0xa6, 0x72, 0x49, 0xab, 0xe9, 0x38, 0x6d, 0xc5, 0xe7, 0x29, 0x7f, 0x4d, 0x60, 0x54, 0xb0,
+	0x3e, 0x6e, 0xe9, 0x24, 0xec, 0x5e, 0xe5, 0xb7, 0x72, 0x64, 0xe2, 0xb9, 0xa9, 0xc1, 0xd2, 0x98,
+	0x78, 0x57, 0xdc, 0xaf, 0xe2, 0x19, 0xd3, 0x73, 0x02, 0xba, 0x63, 0xa9, 0x74, 0x7c, 0xd1, 0x00,
+	0xde, 0xad, 0x00, 0x9e, 0x5d, 0x6e, 0x9e, 0xa9, 0xae, 0x1a, 0xa6, 0xa7, 0x8f, 0x02, 0xbb, 0x34,
+	0xf7, 0x3a, 0x35, 0x86, 0xdc, 0xac, 0x6b, 0xa2, 0x7d, 0x45, 0x40, 0x12, 0x51, 0xa1, 0x6a, 0x25,
+	0xe8, 0x67, 0xf2, 0x78, 0xa2, 0x25, 0x65, 0x2b, 0x5a, 0x76, 0x4f, 0xb1, 0xf7, 0x09, 0x4c, 0x84,
+	0xd2, 0xd4, 0xd0, 0xed, 0x32, 0xbf, 0xfc, 0x0f, 0x13, 0xeb, 0x3e, 0x81, 0x17, 0x13, 0x38, 0x50,
+	0xaa, 0x73, 0xb0, 0x9f, 0x83, 0xd4, 0xd1, 0x00, 0x25, 0x4b, 0x7f, 0x7a, 0xf6, 0xd5, 0xfc, 0xf3,
+	0x76, 0x4f, 0xbf, 0xcd, 0x18, 0xfd, 0x7a, 0x22, 0xf1, 0xe2, 0x44, 0x0d, 0xe6, 0x5f, 0xef, 0x89,
+	0xba, 0x08, 0xc3, 0x0c, 0xfb, 0x52, 0xc3, 0xaa, 0x5b, 0xb6, 0xb6, 0xe2, 0xe9, 0x38, 0x0e, 0x83,
+	0x75, 0xbc, 0xd5, 0x49, 0x45, 0xf0, 0x6e, 0x5d, 0xa8, 0xca, 0x6f, 0xc2, 0x48, 0xc8, 0x11, 0xf7,
+	0x78, 0x02, 0xf6, 0x78, 0x66, 0x78, 0xe0, 0x8e, 0x46, 0x76, 0xd7, 0x76, 0x6a, 0x9b, 0xca, 0x77,
+	0x08, 0xc8, 0x81, 0x09, 0xbd, 0x8c, 0xe4, 0x22, 0xfc, 0x83, 0xd7, 0x43, 0xd7, 0x62, 0xfc, 0x2d,
+	0x81, 0xa3, 0x89, 0x88, 0xa8, 0xc0, 0x22, 0x0c, 0x78, 0xdb, 0xf2, 0x02, 0x9c, 0x20, 0x41, 0xc7,
+	0xb6, 0x7b, 0x51, 0x6d, 0xc0, 0x38, 0x03, 0x7d, 0xcb, 0x72, 0xf4, 0x72, 0x1b, 0xd7, 0xbd, 0x6a,
+	0x64, 0x0d, 0xb0, 0xfb, 0x24, 0xad, 0xbb, 0x0e, 0xb9, 0x1d, 0x29, 0x3a, 0x73, 0x33, 0xf9, 0x22,
+	0x3e, 0x9d, 0xc2, 0x35, 0x51, 0x99, 0x69, 0xd8, 0xe9, 0x1a, 0x63, 0x5e, 0x8c, 0x44, 0x44, 0x71,
+	0xad, 0x2b, 0xcc, 0x44, 0xfe, 0x80, 0x60, 0x9d, 0xe0, 0xde, 0xb3, 0xcb, 0xdb, 0x4e, 0xd0, 0xae,
+	0x45, 0xfd, 0x0b, 0x02, 0x63, 0x62, 0x10, 0xdc, 0xd4, 0x2c, 0x17, 0xca, 0x0b, 0x75, 0xcc, 0xae,
+	0xb8, 0x4d, 0xf7, 0x42, 0xfc, 0x39, 0xc1, 0xf2, 0x04, 0xb1, 0x02, 0xc1, 0x6d, 0xc7, 0x8e, 0x64,
+	0x8a, 0x5d, 0xd7, 0xb4, 0xfa, 0xcc, 0x2b, 0x0a, 0x82, 0x50, 0xff, 0xab, 0x50, 0xb7, 0xc3, 0x25,
+	0x01, 0x96, 0x44, 0x3d, 0x70, 0xa0, 0x6c, 0x12, 0x38, 0x22, 0x44, 0xeb, 0x85, 0x72, 0xe5, 0x14,
+	0x1c, 0x66, 0x6c, 0x57, 0xb5, 0x95, 0x15, 0xf7, 0x6c, 0x5b, 0x5b, 0x71, 0x32, 0xbf, 0x1c, 0xde,
+	0x86, 0x5c, 0xd4, 0x17, 0x37, 0x75, 0x1a, 0x76, 0x39, 0xee, 0x6d, 0x3c, 0x04, 0xa2, 0x75, 0xab,
+	0xcf, 0xa9, 0x3c, 0xf0, 0xe8, 0xf7, 0xf1, 0xbe, 0xbb, 0x7f, 0xfd, 0x30, 0x43, 0x2a, 0xdc, 0x4b,
+	0xb6, 0x81, 0xfa, 0x24, 0xf3, 0x88, 0xba, 0x14, 0x91, 0x53, 0x23, 0x4f, 0xef, 0x15, 0x0e, 0x70,
+	0xb7, 0x82, 0x5d, 0xbd, 0x31, 0x31, 0xa7, 0x2c, 0x2c, 0x2a, 0x45, 0xf9, 0x3b, 0x02, 0x07, 0x03,
+	0xab, 0xf6, 0x40, 0x80, 0xe2, 0x58, 0xbf, 0xf7, 0xce, 0x2b, 0xdf, 0x5b, 0xaa, 0x17, 0x0e, 0x87,
+	0x53, 0x07, 0x9f, 0xde, 0x2b, 0x0c, 0x05, 0x78, 0x4f, 0x94, 0xe4, 0x1f, 0x09, 0xbc, 0x10, 0x43,
+	0xdb, 0x2b, 0x6f, 0x53, 0x31, 0xf8, 0x7d, 0x01, 0xf8, 0x15, 0x47, 0x73, 0xd6, 0xda, 0x39, 0xb9,
+	0x08, 0xfd, 0x36, 0xbb, 0xc1, 0x84, 0xde, 0x5f, 0x1a, 0x8f, 0xa5, 0x46, 0x3f, 0x34, 0xff, 0x77,
+	0x05, 0x7f, 0x40, 0x20, 0x1f, 0xc7, 0xdd, 0xd3, 0x8a, 0x97, 0x3e, 0x19, 0x81, 0x5d, 0x8c, 0x9c,
+	0x7e, 0x4c, 0x60, 0xa0, 0xfd, 0x60, 0xd1, 0xc9, 0x08, 0x9b, 0xf0, 0xeb, 0x86, 0x74, 0x2c, 0xd5,
+	0x8e, 0x93, 0xc8, 0xca, 0x7b, 0xbf, 0xfe, 0x79, 0x6b, 0xc7, 0x14, 0x9d, 0x54, 0xc3, 0xdf, 0x67,
+	0xb0, 0x35, 0x33, 0xaf, 0x5b, 0xea, 0x06, 0xfe, 0xae, 0xb6, 0xe8, 0x37, 0x04, 0x86, 0x42, 0xf5,
+	0x3a, 0x3d, 0x9e, 0xb0, 0x58, 0xe4, 0xa3, 0x87, 0x54, 0xc8, 0x68, 0x8d, 0x80, 0x0b, 0x0c, 0x50,
+	0xa1, 0xc7, 0x63, 0x00, 0x59, 0x77, 0xd1, 0x44, 0x4e, 0x7c, 0x69, 0xb5, 0xe8, 0x6d, 0x02, 0x7b,
+	0xfd, 0xdf, 0x12, 0xe8, 0x74, 0xc2, 0xaa, 0xc1, 0xef, 0x1d, 0xd2, 0x4c, 0x16, 0x53, 0xa4, 0x2b,
+	0x32, 0xba, 0x59, 0x3a, 0x1d, 0x43, 0x87, 0x9f, 0x22, 0xfc, 0x0a, 0x6e, 0x12, 0xd8, 0x17, 0xe8,
+	0xd8, 0x69, 0xd2, 0x82, 0xa1, 0x9e, 0x4f, 0x9a, 0xcd, 0x64, 0x8b, 0x74, 0x73, 0x8c, 0x6e, 0x86,
+	0x4e, 0x89, 0xe9, 0xec, 0xa5, 0xe5, 0xe6, 0x12, 0x6b, 0x0d, 0x5d, 0xe5, 0x56, 0x0d, 0xb3, 0x45,
+	0x7f, 0x26, 0x30, 0x2c, 0x6a, 0x95, 0x69, 0x31, 0x2d, 0x6a, 0x91, 0xf6, 0x5e, 0x2a, 0x6d, 0xc7,
+	0x05, 0x89, 0x5f, 0x65, 0xc4, 0x27, 0xe8, 0x7c, 0x52, 0xb4, 0x0d, 0x9d, 0x91, 0xf3, 0x21, 0x9f,
+	0xb2, 0x0f, 0xa2, 0xf0, 0x5c, 0xe0, 0x6c, 0xf0, 0x01, 0x9d, 0x4b, 0xdb, 0x71, 0x41, 0xf8, 0x57,
+	0x18, 0x7c, 0x89, 0xce, 0x65, 0x80, 0x0f, 0xca, 0xfe, 0x11, 0x81, 0x3d, 0xde, 0x21, 0x43, 0x5f,
+	0x16, 0x2f, 0x1d, 0x6a, 0x0a, 0xa4, 0xc9, 0x34, 0x33, 0xa4, 0x52, 0x19, 0xd5, 0x34, 0x3d, 0x16,
+	0xa1, 0xf2, 0x0e, 0x33, 0x75, 0xc3, 0x57, 0xe1, 0xb4, 0xe8, 0x43, 0x02, 0x87, 0xc4, 0x5d, 0x1f,
+	0x9d, 0x4f, 0x5e, 0x53, 0xd8, 0xc6, 0x4a, 0x0b, 0xdb, 0x73, 0x42, 0xec, 0xd7, 0x18, 0xf6, 0x49,
+	0xba, 0x10, 0x8b, 0xdd, 0x49, 0x02, 0x3c, 0x04, 0x7c, 0xcf, 0xff, 0x43, 0x02, 0x07, 0x05, 0xcd,
+	0x19, 0x9d, 0x13, 0xb3, 0xc4, 0xf7, 0x8e, 0x52, 0x71, 0x1b, 0x1e, 0x88, 0xfe, 0x06, 0x43, 0x7f,
+	0x9d, 0x9e, 0x8e, 0xa0, 0xbb, 0x45, 0x86, 0x4b, 0xdd, 0xd6, 0xdb, 0xbd, 0xd1, 0x08, 0xea, 0xaf,
+	0x6e, 0xb0, 0x9b, 0x2d, 0x7a, 0x97, 0xc0, 0x50, 0xa8, 0x0f, 0x8b, 0x3b, 0x6a, 0xc5, 0x7d, 0xa3,
+	0x54, 0xc8, 0x68, 0x9d, 0x9a, 0xbf, 0x2e, 0x91, 0xed, 0x07, 0x0f, 0xa5, 0xcc, 0x97, 0x04, 0xf6,
+	0xfa, 0xdb, 0xa0, 0xb8, 0xe3, 0x56, 0xd0, 0xbf, 0xc5, 0x1d, 0xb7, 0xa2, 0xae, 0x2a, 0x21, 0x97,
+	0xdb, 0x84, 0xa8, 0x28, 0x6a, 0x78, 0x87, 0xc0, 0xfe, 0x60, 0xc3, 0x41, 0x53, 0x4e, 0xd0, 0x40,
+	0xc7, 0x24, 0x1d, 0xcf, 0x66, 0x8c, 0x78, 0xf3, 0x0c, 0xaf, 0x40, 0x67, 0x13, 0xce, 0x5b, 0xfe,
+	0x46, 0xf0, 0xa5, 0xea, 0x26, 0x81, 0x41, 0x5f, 0x1b, 0x40, 0xa7, 0xc4, 0x4b, 0x46, 0x5b, 0x13,
+	0x69, 0x3a, 0x83, 0x25, 0x92, 0x9d, 0x64, 0x64, 0x73, 0x54, 0x89, 0x7f, 0x9a, 0x42, 0x59, 0xc8,
+	0x3a, 0x10, 0xfa, 0x21, 0x81, 0x7e, 0xbe, 0x59, 0x7a, 0x34, 0x49, 0x0a, 0x0f, 0xe9, 0xa5, 0x64,
+	0x23, 0xef, 0x9d, 0xfe, 0x58, 0x54, 0xcd, 0x33, 0xc4, 0x51, 0x7a, 0x38, 0x46, 0x3c, 0xfa, 0x13,
+	0x81, 0xe7, 0xc3, 0x95, 0x33, 0x2d, 0xa4, 0x1e, 0x2e, 0x81, 0x64, 0x53, 0xb2, 0x9a, 0x23, 0xe9,
+	0xd9, 0xc7, 0xd1, 0xe2, 0x2c, 0x36, 0xc8, 0x81, 0x83, 0x29, 0x98, 0x87, 0xbf, 0x10, 0x38, 0x10,
+	0x29, 0x42, 0x69, 0x3a, 0x4d, 0xa0, 0xca, 0x96, 0xd4, 0xcc, 0xf6, 0x88, 0x7f, 0x3e, 0x0e, 0x5f,
+	0xa5, 0x85, 0x64, 0x7c, 0x5e, 0x9e, 0xab, 0x1b, 0xfc, 0xff, 0x56, 0x59, 0x79, 0xf4, 0x2c, 0x4f,
+	0x9e, 0x3c, 0xcb, 0x93, 0x3f, 0x9e, 0xe5, 0xc9, 0xa7, 0x5b, 0xf9, 0xbe, 0x27, 0x5b, 0xf9, 0xbe,
+	0xdf, 0xb6, 0xf2, 0x7d, 0xef, 0x60, 0x3f, 0x65, 0x57, 0x6f, 0x28, 0x86, 0xa5, 0xbe, 0xcb, 0xe7,
+	0x5b, 0xee, 0x67, 0x7f, 0x7e, 0x9b, 0xff, 0x7b, 0x00, 0xa3, 0x7f, 0xdd, 0xa6, 0x59, 0x1c, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1646,6 +1879,12 @@ type QueryClient interface {
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
 	// Groups queries all groups in state.
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// ProposalsByVoter queries the proposals a voter has voted on. Votes are
+	// pruned once proposals are tallied, so these are the proposals still open
+	// for voting.
+	ProposalsByVoter(ctx context.Context, in *QueryProposalsByVoterRequest, opts ...grpc.CallOption) (*QueryProposalsByVoterResponse, error)
+	// ProposalsByStatus queries the proposals with a given status.
+	ProposalsByStatus(ctx context.Context, in *QueryProposalsByStatusRequest, opts ...grpc.CallOption) (*QueryProposalsByStatusResponse, error)
 }
 
 type queryClient struct {
@@ -1782,6 +2021,24 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ProposalsByVoter(ctx context.Context, in *QueryProposalsByVoterRequest, opts ...grpc.CallOption) (*QueryProposalsByVoterResponse, error) {
+	out := new(QueryProposalsByVoterResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/ProposalsByVoter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalsByStatus(ctx context.Context, in *QueryProposalsByStatusRequest, opts ...grpc.CallOption) (*QueryProposalsByStatusResponse, error) {
+	out := new(QueryProposalsByStatusResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/ProposalsByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1816,6 +2073,12 @@ type QueryServer interface {
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
 	// Groups queries all groups in state.
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// ProposalsByVoter queries the proposals a voter has voted on. Votes are
+	// pruned once proposals are tallied, so these are the proposals still open
+	// for voting.
+	ProposalsByVoter(context.Context, *QueryProposalsByVoterRequest) (*QueryProposalsByVoterResponse, error)
+	// ProposalsByStatus queries the proposals with a given status.
+	ProposalsByStatus(context.Context, *QueryProposalsByStatusRequest) (*QueryProposalsByStatusResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1864,6 +2127,12 @@ func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTall
 func (*UnimplementedQueryServer) Groups(ctx context.Context, req *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (*UnimplementedQueryServer) ProposalsByVoter(ctx context.Context, req *QueryProposalsByVoterRequest) (*QueryProposalsByVoterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByVoter not implemented")
+}
+func (*UnimplementedQueryServer) ProposalsByStatus(ctx context.Context, req *QueryProposalsByStatusRequest) (*QueryProposalsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByStatus not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2121,6 +2390,42 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalsByVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByVoterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/ProposalsByVoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByVoter(ctx, req.(*QueryProposalsByVoterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/ProposalsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByStatus(ctx, req.(*QueryProposalsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2181,6 +2486,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "ProposalsByVoter",
+			Handler:    _Query_ProposalsByVoter_Handler,
+		},
+		{
+			MethodName: "ProposalsByStatus",
+			Handler:    _Query_ProposalsByStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -3314,57 +3627,237 @@ func (m *QueryGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryGroupInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovQuery(uint64(m.GroupId))
+func (m *QueryProposalsByVoterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGroupInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryProposalsByVoterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupPolicyInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProposalsByVoterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupPolicyInfoResponse) Size() (n int) {
-	if m == nil {
+func (m *QueryProposalsByVoterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByVoterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGroupInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *QueryGroupInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupPolicyInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupPolicyInfoResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -3770,6 +4263,77 @@ func (m *QueryGroupsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProposalsByVoterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsByVoterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6664,6 +7228,469 @@ func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProposalsByVoterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByVoterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByVoterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByVoterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
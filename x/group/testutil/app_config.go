@@ -24,4 +24,5 @@ var AppConfig = configurator.NewAppConfig(
 	configurator.ConsensusModule(),
 	configurator.GenutilModule(),
 	configurator.GroupModule(),
+	configurator.WithDerivedOrdering(),
 )
@@ -242,6 +242,21 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
 
+// UpdateDeniedDenoms mocks base method.
+func (m *MockBankKeeper) UpdateDeniedDenoms(arg0 context.Context, arg1 *types.MsgUpdateDeniedDenoms) (*types.MsgUpdateDeniedDenomsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeniedDenoms", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgUpdateDeniedDenomsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDeniedDenoms indicates an expected call of UpdateDeniedDenoms.
+func (mr *MockBankKeeperMockRecorder) UpdateDeniedDenoms(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeniedDenoms", reflect.TypeOf((*MockBankKeeper)(nil).UpdateDeniedDenoms), arg0, arg1)
+}
+
 // UpdateParams mocks base method.
 func (m *MockBankKeeper) UpdateParams(arg0 context.Context, arg1 *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	m.ctrl.T.Helper()
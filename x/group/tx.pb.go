@@ -12,19 +12,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	any "github.com/cosmos/gogoproto/types/any"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1430,6 +1434,10 @@ func (m *MsgLeaveGroup) GetGroupId() uint64 {
 
 // MsgLeaveGroupResponse is the Msg/LeaveGroup response type.
 type MsgLeaveGroupResponse struct {
+	// exit_time is the time at which the member is removed from the group, when
+	// the module has a member exit delay. It is unset when the member left the
+	// group immediately.
+	ExitTime *time.Time `protobuf:"bytes,1,opt,name=exit_time,json=exitTime,proto3,stdtime" json:"exit_time,omitempty"`
 }
 
 func (m *MsgLeaveGroupResponse) Reset()         { *m = MsgLeaveGroupResponse{} }
@@ -1465,6 +1473,327 @@ func (m *MsgLeaveGroupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLeaveGroupResponse proto.InternalMessageInfo
 
+func (m *MsgLeaveGroupResponse) GetExitTime() *time.Time {
+	if m != nil {
+		return m.ExitTime
+	}
+	return nil
+}
+
+// MsgDelegateWeight is the Msg/DelegateWeight request type.
+type MsgDelegateWeight struct {
+	// delegator is the account address of the group member delegating its weight.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// delegate is the account address of the group member receiving the weight.
+	// An empty delegate removes the existing delegation of the delegator.
+	Delegate string `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate,omitempty"`
+}
+
+func (m *MsgDelegateWeight) Reset()         { *m = MsgDelegateWeight{} }
+func (m *MsgDelegateWeight) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateWeight) ProtoMessage()    {}
+func (*MsgDelegateWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{28}
+}
+func (m *MsgDelegateWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateWeight.Merge(m, src)
+}
+func (m *MsgDelegateWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateWeight proto.InternalMessageInfo
+
+func (m *MsgDelegateWeight) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *MsgDelegateWeight) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *MsgDelegateWeight) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+// MsgDelegateWeightResponse is the Msg/DelegateWeight response type.
+type MsgDelegateWeightResponse struct {
+}
+
+func (m *MsgDelegateWeightResponse) Reset()         { *m = MsgDelegateWeightResponse{} }
+func (m *MsgDelegateWeightResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateWeightResponse) ProtoMessage()    {}
+func (*MsgDelegateWeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{29}
+}
+func (m *MsgDelegateWeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateWeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateWeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateWeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateWeightResponse.Merge(m, src)
+}
+func (m *MsgDelegateWeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateWeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateWeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateWeightResponse proto.InternalMessageInfo
+
+// MsgVetoProposal is the Msg/VetoProposal request type.
+type MsgVetoProposal struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// guardian is the account address of the guardian of the proposal's decision policy.
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *MsgVetoProposal) Reset()         { *m = MsgVetoProposal{} }
+func (m *MsgVetoProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposal) ProtoMessage()    {}
+func (*MsgVetoProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{30}
+}
+func (m *MsgVetoProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposal.Merge(m, src)
+}
+func (m *MsgVetoProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposal proto.InternalMessageInfo
+
+func (m *MsgVetoProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgVetoProposal) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+// MsgVetoProposalResponse is the Msg/VetoProposal response type.
+type MsgVetoProposalResponse struct {
+}
+
+func (m *MsgVetoProposalResponse) Reset()         { *m = MsgVetoProposalResponse{} }
+func (m *MsgVetoProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposalResponse) ProtoMessage()    {}
+func (*MsgVetoProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{31}
+}
+func (m *MsgVetoProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposalResponse.Merge(m, src)
+}
+func (m *MsgVetoProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposalResponse proto.InternalMessageInfo
+
+// MsgVoteWeighted is the Msg/VoteWeighted request type.
+type MsgVoteWeighted struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the voter account address.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// options defines the weighted vote options, whose weights must sum up to 1.
+	Options []WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options"`
+	// metadata is any arbitrary metadata attached to the vote.
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// exec defines whether the proposal should be executed
+	// immediately after voting or not.
+	Exec Exec `protobuf:"varint,5,opt,name=exec,proto3,enum=cosmos.group.v1.Exec" json:"exec,omitempty"`
+}
+
+func (m *MsgVoteWeighted) Reset()         { *m = MsgVoteWeighted{} }
+func (m *MsgVoteWeighted) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteWeighted) ProtoMessage()    {}
+func (*MsgVoteWeighted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{32}
+}
+func (m *MsgVoteWeighted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteWeighted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteWeighted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteWeighted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteWeighted.Merge(m, src)
+}
+func (m *MsgVoteWeighted) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteWeighted) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteWeighted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteWeighted proto.InternalMessageInfo
+
+func (m *MsgVoteWeighted) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgVoteWeighted) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *MsgVoteWeighted) GetOptions() []WeightedVoteOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *MsgVoteWeighted) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *MsgVoteWeighted) GetExec() Exec {
+	if m != nil {
+		return m.Exec
+	}
+	return Exec_EXEC_UNSPECIFIED
+}
+
+// MsgVoteWeightedResponse is the Msg/VoteWeighted response type.
+type MsgVoteWeightedResponse struct {
+}
+
+func (m *MsgVoteWeightedResponse) Reset()         { *m = MsgVoteWeightedResponse{} }
+func (m *MsgVoteWeightedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteWeightedResponse) ProtoMessage()    {}
+func (*MsgVoteWeightedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{33}
+}
+func (m *MsgVoteWeightedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteWeightedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteWeightedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteWeightedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteWeightedResponse.Merge(m, src)
+}
+func (m *MsgVoteWeightedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteWeightedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteWeightedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteWeightedResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.Exec", Exec_name, Exec_value)
 	proto.RegisterType((*MsgCreateGroup)(nil), "cosmos.group.v1.MsgCreateGroup")
@@ -1495,103 +1824,125 @@ func init() {
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1.MsgExecResponse")
 	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1.MsgLeaveGroup")
 	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "cosmos.group.v1.MsgLeaveGroupResponse")
+	proto.RegisterType((*MsgDelegateWeight)(nil), "cosmos.group.v1.MsgDelegateWeight")
+	proto.RegisterType((*MsgDelegateWeightResponse)(nil), "cosmos.group.v1.MsgDelegateWeightResponse")
+	proto.RegisterType((*MsgVetoProposal)(nil), "cosmos.group.v1.MsgVetoProposal")
+	proto.RegisterType((*MsgVetoProposalResponse)(nil), "cosmos.group.v1.MsgVetoProposalResponse")
+	proto.RegisterType((*MsgVoteWeighted)(nil), "cosmos.group.v1.MsgVoteWeighted")
+	proto.RegisterType((*MsgVoteWeightedResponse)(nil), "cosmos.group.v1.MsgVoteWeightedResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xcf, 0xda, 0xce, 0xdb, 0x93, 0x7f, 0x9d, 0x64, 0x93, 0xb4, 0xce, 0xb6, 0xb5, 0xdd, 0xe9,
-	0x4b, 0x52, 0xab, 0x5e, 0x37, 0x4e, 0xdb, 0xbf, 0x30, 0x48, 0xa8, 0x49, 0x03, 0x0a, 0xc2, 0x10,
-	0x6d, 0x5b, 0x0a, 0x5c, 0xcc, 0x26, 0xbb, 0x5d, 0x56, 0xb5, 0xbd, 0xc6, 0xb3, 0x4e, 0x93, 0x1b,
-	0x2f, 0x17, 0xde, 0x24, 0x90, 0xe0, 0x03, 0xc0, 0x8d, 0x63, 0x91, 0x7a, 0xe0, 0xc6, 0x0d, 0x55,
-	0xe5, 0x52, 0x71, 0x42, 0x1c, 0x10, 0x6a, 0x85, 0x7a, 0xe3, 0x2b, 0x80, 0x76, 0x66, 0x77, 0xec,
-	0xf1, 0xee, 0x7a, 0xb7, 0x96, 0x05, 0x97, 0xc8, 0x3b, 0xcf, 0xef, 0x79, 0xfb, 0x3d, 0xcf, 0x3c,
-	0x33, 0x13, 0xc8, 0xec, 0x59, 0xb8, 0x61, 0xe1, 0x92, 0xd1, 0xb6, 0x3a, 0xad, 0xd2, 0xfe, 0x5a,
-	0xc9, 0x3e, 0x90, 0x5b, 0x6d, 0xcb, 0xb6, 0xc4, 0x59, 0x2a, 0x91, 0x89, 0x44, 0xde, 0x5f, 0x93,
-	0x16, 0x0d, 0xcb, 0xb0, 0x88, 0xac, 0xe4, 0xfc, 0xa2, 0x30, 0x69, 0x99, 0xc2, 0x6a, 0x54, 0xe0,
-	0xea, 0xb8, 0x22, 0xc3, 0xb2, 0x8c, 0xba, 0x5e, 0x22, 0x5f, 0xbb, 0x9d, 0xdb, 0x25, 0xb5, 0x79,
-	0xe8, 0x8a, 0x8e, 0xfb, 0xdc, 0x1e, 0xb6, 0x74, 0x4f, 0xef, 0x98, 0x2b, 0x6c, 0x60, 0xc3, 0x11,
-	0x35, 0xb0, 0xe1, 0x0a, 0xe6, 0xd5, 0x86, 0xd9, 0xb4, 0x4a, 0xe4, 0x2f, 0x5d, 0x42, 0x3f, 0x0b,
-	0x90, 0xae, 0x62, 0x63, 0xb3, 0xad, 0xab, 0xb6, 0xfe, 0xb2, 0x63, 0x4d, 0x94, 0x61, 0x5c, 0xd5,
-	0x1a, 0x66, 0x33, 0x23, 0xe4, 0x85, 0xd5, 0xe9, 0x8d, 0xcc, 0x2f, 0xf7, 0x8b, 0x8b, 0x6e, 0x5c,
-	0x57, 0x35, 0xad, 0xad, 0x63, 0x7c, 0xdd, 0x6e, 0x9b, 0x4d, 0x43, 0xa1, 0x30, 0x71, 0x13, 0x26,
-	0x1b, 0x7a, 0x63, 0x57, 0x6f, 0xe3, 0x4c, 0x22, 0x9f, 0x5c, 0x9d, 0x29, 0x67, 0xe5, 0xbe, 0xd4,
-	0xe5, 0x2a, 0x91, 0x2b, 0xfa, 0x7b, 0x1d, 0x1d, 0xdb, 0x1b, 0xd3, 0x0f, 0x7e, 0xcf, 0x8d, 0x7d,
-	0xf7, 0xf4, 0x5e, 0x41, 0x50, 0x3c, 0x4d, 0x51, 0x82, 0xa9, 0x86, 0x6e, 0xab, 0x9a, 0x6a, 0xab,
-	0x99, 0xa4, 0xe3, 0x57, 0x61, 0xdf, 0x95, 0xd5, 0x0f, 0x9f, 0xde, 0x2b, 0x50, 0x67, 0x9f, 0x3e,
-	0xbd, 0x57, 0x70, 0x19, 0x2b, 0x62, 0xed, 0x4e, 0x89, 0x0f, 0x1d, 0xad, 0xc3, 0x51, 0x7e, 0x45,
-	0xd1, 0x71, 0xcb, 0x6a, 0x62, 0x5d, 0x5c, 0x86, 0x29, 0x12, 0x4d, 0xcd, 0xd4, 0x48, 0x5e, 0x29,
-	0x65, 0x92, 0x7c, 0x6f, 0x6b, 0xe8, 0x4f, 0x01, 0x96, 0xaa, 0xd8, 0xb8, 0xd9, 0xd2, 0x3c, 0xad,
-	0xaa, 0x1b, 0xd4, 0xb3, 0x32, 0xd1, 0xeb, 0x24, 0xc1, 0x39, 0x11, 0x77, 0x20, 0x4d, 0x53, 0xad,
-	0x75, 0x88, 0x1f, 0x9c, 0x49, 0x3e, 0x2b, 0x57, 0x47, 0xa8, 0x01, 0x1a, 0x27, 0xae, 0x94, 0x78,
-	0x56, 0xf2, 0x3c, 0x2b, 0xfe, 0x6c, 0x50, 0x0e, 0x4e, 0x06, 0x0a, 0x3c, 0x8e, 0xd0, 0x4f, 0x02,
-	0x2c, 0xf0, 0x88, 0xab, 0x24, 0xad, 0x11, 0xd2, 0x70, 0x19, 0xa6, 0x9b, 0xfa, 0xdd, 0x1a, 0x35,
-	0x97, 0x8c, 0x30, 0x37, 0xd5, 0xd4, 0xef, 0x92, 0x08, 0x2a, 0x45, 0x3e, 0xd7, 0x6c, 0x68, 0xae,
-	0x04, 0x8e, 0x4e, 0xc2, 0xf1, 0x80, 0x65, 0x96, 0xe7, 0xf7, 0x02, 0x69, 0x13, 0x8e, 0x09, 0xda,
-	0x6a, 0xa3, 0x4c, 0x75, 0x50, 0x47, 0x5f, 0xe4, 0xf3, 0x39, 0x35, 0xa0, 0x76, 0x54, 0x03, 0xe5,
-	0x21, 0x1b, 0x2c, 0x61, 0x59, 0x7d, 0x9d, 0x80, 0x45, 0xbe, 0xf9, 0x77, 0xac, 0xba, 0xb9, 0x77,
-	0xf8, 0x2f, 0xe5, 0x24, 0xaa, 0x30, 0xab, 0xe9, 0x7b, 0x26, 0x36, 0xad, 0x66, 0xad, 0x45, 0x3c,
-	0x67, 0x52, 0x79, 0x61, 0x75, 0xa6, 0xbc, 0x28, 0xd3, 0x39, 0x26, 0x7b, 0x73, 0x4c, 0xbe, 0xda,
-	0x3c, 0xdc, 0x40, 0x0f, 0xef, 0x17, 0xb3, 0xfd, 0xbd, 0x7f, 0xcd, 0x35, 0x40, 0x23, 0x57, 0xd2,
-	0x1a, 0xf7, 0x5d, 0x29, 0x7f, 0xfc, 0x4d, 0x6e, 0x8c, 0xa7, 0x2e, 0x17, 0x3a, 0x0c, 0xa8, 0x0e,
-	0x52, 0xe0, 0x44, 0xd0, 0x3a, 0x1b, 0x0c, 0x65, 0x98, 0x54, 0x29, 0x0b, 0x91, 0xfc, 0x78, 0x40,
-	0xf4, 0x51, 0x02, 0x96, 0xf9, 0x6a, 0x50, 0xa3, 0xc3, 0x6d, 0x97, 0x57, 0x60, 0x91, 0xf2, 0x4d,
-	0x59, 0xab, 0x79, 0xe1, 0x24, 0x22, 0xd4, 0x45, 0xa3, 0xd7, 0x33, 0x91, 0x0c, 0xbb, 0xbf, 0xd6,
-	0x79, 0x52, 0xcf, 0x84, 0xf6, 0x63, 0x4f, 0x9e, 0xe8, 0x34, 0x9c, 0x0a, 0x15, 0xb2, 0xae, 0xfc,
-	0x21, 0x09, 0x19, 0x9e, 0xff, 0x5b, 0xa6, 0xfd, 0xee, 0x90, 0x9d, 0x39, 0x92, 0x93, 0xe6, 0x2c,
-	0xa4, 0x29, 0xdd, 0x7d, 0x9d, 0x7c, 0xc4, 0xe0, 0x26, 0x41, 0x19, 0x96, 0xb8, 0xaa, 0x30, 0x74,
-	0x8a, 0xa0, 0x17, 0x7a, 0xc8, 0x67, 0x3a, 0x6b, 0x7d, 0x3a, 0x2a, 0x76, 0x2b, 0x31, 0x9e, 0x17,
-	0x56, 0xa7, 0xf8, 0x82, 0x61, 0xda, 0x2c, 0x01, 0xbb, 0x66, 0x62, 0xc4, 0xbb, 0xe6, 0x8a, 0x7f,
-	0xd7, 0x9c, 0x0e, 0xdd, 0x35, 0xdd, 0xea, 0xa0, 0x4f, 0x04, 0xc8, 0x87, 0x09, 0x63, 0x9c, 0xab,
-	0xa3, 0xec, 0x6b, 0xf4, 0x63, 0x02, 0x50, 0x50, 0xb3, 0xf1, 0xa9, 0xff, 0xa7, 0x5b, 0x2f, 0xa0,
-	0x92, 0xc9, 0x11, 0x57, 0xb2, 0xe2, 0xaf, 0xe4, 0x4a, 0xe8, 0x56, 0xe5, 0x6d, 0xa1, 0x0b, 0x50,
-	0x88, 0x26, 0x90, 0x6d, 0xdb, 0xbf, 0x04, 0x32, 0x36, 0x7d, 0xf0, 0xa1, 0x0f, 0xca, 0x51, 0x32,
-	0x3d, 0xe8, 0x64, 0xbd, 0x12, 0x97, 0x1e, 0x3e, 0x1f, 0x74, 0x0e, 0xce, 0x0c, 0x92, 0x33, 0x62,
-	0x3e, 0x4b, 0xc2, 0x7c, 0x15, 0x1b, 0xd7, 0x3b, 0xbb, 0x0d, 0xd3, 0xde, 0x69, 0x5b, 0x2d, 0x0b,
-	0xab, 0xf5, 0xd0, 0xec, 0x84, 0x21, 0xb2, 0x3b, 0x01, 0xd3, 0x2d, 0x62, 0xd7, 0x1b, 0x73, 0xd3,
-	0x4a, 0x77, 0x61, 0xe0, 0x09, 0x7c, 0xd1, 0x91, 0x61, 0xac, 0x1a, 0x3a, 0xce, 0xa4, 0xc8, 0x7c,
-	0x0c, 0x6c, 0x3d, 0x85, 0xa1, 0xc4, 0xf3, 0x90, 0xd2, 0x0f, 0xf4, 0x3d, 0x32, 0x9f, 0xd2, 0xe5,
-	0x25, 0xdf, 0x34, 0xdd, 0x3a, 0xd0, 0xf7, 0x14, 0x02, 0x11, 0xcf, 0xc3, 0xb8, 0x6d, 0xda, 0x75,
-	0x9d, 0x8c, 0xa7, 0xe9, 0x8d, 0x85, 0xdf, 0xee, 0x17, 0x67, 0xbb, 0xe4, 0xe6, 0x2f, 0xca, 0x97,
-	0xfe, 0xaf, 0x50, 0x84, 0x58, 0x84, 0x49, 0xdc, 0x69, 0x34, 0xd4, 0xf6, 0x61, 0x66, 0x32, 0x1c,
-	0xec, 0x61, 0x2a, 0xcf, 0x79, 0x5d, 0xdd, 0x4d, 0xd3, 0x29, 0x1d, 0xea, 0x29, 0x1d, 0x7d, 0xe6,
-	0xf8, 0x78, 0x47, 0x2f, 0x90, 0x73, 0x98, 0x5f, 0x64, 0xa3, 0x29, 0x07, 0x33, 0x2d, 0x77, 0xad,
-	0x3b, 0x9d, 0xc0, 0x5b, 0xda, 0xd6, 0xd0, 0xb7, 0xf4, 0xbe, 0xeb, 0x4c, 0x35, 0xad, 0xad, 0xde,
-	0x65, 0xd5, 0x8c, 0x52, 0xec, 0xbd, 0x33, 0x24, 0x62, 0xde, 0x19, 0x2a, 0x97, 0x9d, 0x0c, 0xbd,
-	0xaf, 0xfe, 0x43, 0x96, 0xe5, 0xd7, 0x1f, 0x8b, 0x7b, 0x95, 0xed, 0x5f, 0x66, 0xed, 0xf8, 0xb7,
-	0x00, 0x93, 0x55, 0x6c, 0xbc, 0x61, 0xd9, 0xd1, 0xf9, 0x3a, 0x7b, 0x76, 0xdf, 0xb2, 0xf5, 0x76,
-	0x64, 0xd0, 0x14, 0x26, 0xae, 0xc3, 0x84, 0xd5, 0xb2, 0x4d, 0x8b, 0xde, 0x24, 0xd2, 0xe5, 0xe3,
-	0xbe, 0xfe, 0x70, 0xfc, 0xbe, 0x4e, 0x20, 0x8a, 0x0b, 0xe5, 0x1a, 0x34, 0xd5, 0xd7, 0xa0, 0xf1,
-	0xdb, 0xad, 0xb2, 0x42, 0xf6, 0x31, 0x89, 0xc3, 0x21, 0x2b, 0x13, 0x44, 0x96, 0xe3, 0x1d, 0xcd,
-	0xc3, 0xac, 0xfb, 0x93, 0x91, 0xf2, 0x39, 0x25, 0xc5, 0xb1, 0x16, 0x4d, 0xca, 0x25, 0x98, 0x72,
-	0x1c, 0x76, 0x6c, 0x2b, 0x9a, 0x17, 0x86, 0xac, 0x14, 0x9c, 0xf0, 0xd8, 0x67, 0x68, 0x84, 0x4e,
-	0x08, 0x48, 0x21, 0x11, 0x92, 0xdc, 0xbc, 0xd6, 0x7c, 0x11, 0x26, 0xda, 0x3a, 0xee, 0xd4, 0x6d,
-	0xe2, 0x32, 0x5d, 0x5e, 0xf1, 0x51, 0xe1, 0x55, 0x7a, 0xcb, 0x75, 0xa1, 0x10, 0xb8, 0xe2, 0xaa,
-	0xa1, 0x2f, 0x04, 0x38, 0x52, 0xc5, 0xc6, 0xab, 0xba, 0xba, 0xef, 0xbe, 0xda, 0x87, 0xb8, 0xc7,
-	0x0e, 0xb8, 0xe9, 0xd3, 0xd7, 0x65, 0x6f, 0xbb, 0x66, 0x83, 0xf2, 0xeb, 0xfa, 0x47, 0xc7, 0xc8,
-	0x23, 0xba, 0xbb, 0xe0, 0xe5, 0x5a, 0x28, 0x40, 0x8a, 0x54, 0x62, 0x11, 0xe6, 0xb6, 0xde, 0xdc,
-	0xda, 0xac, 0xdd, 0x7c, 0xed, 0xfa, 0xce, 0xd6, 0xe6, 0xf6, 0x4b, 0xdb, 0x5b, 0xd7, 0xe6, 0xc6,
-	0xc4, 0xff, 0xc1, 0x14, 0x59, 0xbd, 0xa1, 0xbc, 0x35, 0x27, 0x94, 0x1f, 0xce, 0x40, 0xb2, 0x8a,
-	0x0d, 0xf1, 0x16, 0xcc, 0xf4, 0xfe, 0x47, 0x22, 0xe7, 0xbf, 0xe6, 0x71, 0xf7, 0x12, 0x69, 0x25,
-	0x02, 0xc0, 0x88, 0xaf, 0x83, 0x18, 0xf0, 0xce, 0x3f, 0x17, 0xa4, 0xee, 0xc7, 0x49, 0x72, 0x3c,
-	0x1c, 0xf3, 0x76, 0x1b, 0xe6, 0x7c, 0x8f, 0xe9, 0x33, 0x11, 0x36, 0x08, 0x4a, 0xba, 0x10, 0x07,
-	0xc5, 0xfc, 0x58, 0xb0, 0x10, 0xf4, 0x98, 0x5d, 0x89, 0x0c, 0x97, 0x02, 0xa5, 0x52, 0x4c, 0x20,
-	0x73, 0x68, 0xc2, 0xbc, 0xff, 0x9d, 0x79, 0x36, 0xa2, 0x08, 0x14, 0x26, 0x15, 0x63, 0xc1, 0x98,
-	0xab, 0x0e, 0x2c, 0x05, 0x3f, 0x1e, 0xce, 0x47, 0xd8, 0xe9, 0x42, 0xa5, 0xb5, 0xd8, 0x50, 0xe6,
-	0xf6, 0x00, 0x8e, 0x86, 0x3c, 0xef, 0x0a, 0x11, 0x64, 0xf5, 0x60, 0xa5, 0x72, 0x7c, 0x2c, 0xf3,
-	0xfc, 0x95, 0x00, 0xb9, 0xa8, 0x7b, 0xee, 0x7a, 0x2c, 0xbb, 0xbc, 0x92, 0xf4, 0xfc, 0x10, 0x4a,
-	0x2c, 0xaa, 0x0f, 0x04, 0x58, 0x0e, 0xbf, 0x0d, 0x16, 0x63, 0x99, 0x66, 0xfd, 0x76, 0xf9, 0x99,
-	0xe0, 0x2c, 0x86, 0x77, 0x20, 0xdd, 0x77, 0xef, 0x42, 0x41, 0x86, 0x78, 0x8c, 0x54, 0x88, 0xc6,
-	0xf4, 0x6e, 0x58, 0xdf, 0x6d, 0x20, 0x70, 0xc3, 0xf6, 0xa3, 0x82, 0x37, 0x6c, 0xd8, 0xb1, 0x2d,
-	0x6e, 0x40, 0x8a, 0x1c, 0xd9, 0x99, 0x20, 0x2d, 0x47, 0x22, 0xe5, 0xc3, 0x24, 0xbd, 0x36, 0xc8,
-	0x5c, 0x0d, 0xb4, 0xe1, 0x48, 0x82, 0x6d, 0x70, 0xe7, 0xd0, 0x0d, 0x80, 0x9e, 0x23, 0x24, 0x1b,
-	0x84, 0xef, 0xca, 0xa5, 0x73, 0x83, 0xe5, 0x9e, 0x55, 0x69, 0xfc, 0x7d, 0xe7, 0xc5, 0xbd, 0x21,
-	0x3f, 0x78, 0x9c, 0x15, 0x1e, 0x3d, 0xce, 0x0a, 0x7f, 0x3c, 0xce, 0x0a, 0x5f, 0x3e, 0xc9, 0x8e,
-	0x3d, 0x7a, 0x92, 0x1d, 0xfb, 0xf5, 0x49, 0x76, 0xec, 0x6d, 0xf7, 0x58, 0xc2, 0xda, 0x1d, 0xd9,
-	0xb4, 0x4a, 0x07, 0xf4, 0x3c, 0xd9, 0x9d, 0x20, 0x97, 0xd4, 0xf5, 0x7f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x59, 0x7d, 0x05, 0x2f, 0x53, 0x17, 0x00, 0x00,
+	// 1705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcb, 0x6f, 0x1b, 0x55,
+	0x17, 0xcf, 0xd8, 0x4e, 0x62, 0x9f, 0xb4, 0x4e, 0x32, 0x49, 0x5a, 0x67, 0xda, 0xda, 0xee, 0xf4,
+	0x91, 0xd4, 0xaa, 0xed, 0xc4, 0x49, 0xfa, 0xe9, 0xf3, 0xf7, 0x49, 0xa8, 0x4e, 0x03, 0x0d, 0xc2,
+	0x10, 0x4d, 0x5b, 0x0a, 0x6c, 0xcc, 0x24, 0x73, 0x3b, 0x1d, 0x35, 0xf6, 0x18, 0xcf, 0x38, 0x4d,
+	0x76, 0x3c, 0x36, 0xbc, 0x24, 0x2a, 0xc1, 0x1f, 0x00, 0x62, 0xc3, 0x8e, 0x22, 0x55, 0x82, 0x1d,
+	0x3b, 0x54, 0x95, 0x4d, 0xd5, 0x15, 0x62, 0x01, 0xa8, 0x15, 0x74, 0xc7, 0xbf, 0x00, 0x9a, 0x7b,
+	0x67, 0xae, 0xe7, 0x7a, 0x66, 0x3c, 0xae, 0x65, 0xc1, 0x26, 0x8a, 0xef, 0xf9, 0xdd, 0xf3, 0xf8,
+	0xdd, 0x73, 0xce, 0x9c, 0x7b, 0x21, 0xb5, 0xa3, 0x1b, 0x75, 0xdd, 0x28, 0xaa, 0x2d, 0xbd, 0xdd,
+	0x2c, 0xee, 0x2d, 0x17, 0xcd, 0xfd, 0x42, 0xb3, 0xa5, 0x9b, 0x3a, 0x3f, 0x49, 0x24, 0x05, 0x2c,
+	0x29, 0xec, 0x2d, 0x0b, 0xb3, 0xaa, 0xae, 0xea, 0x58, 0x56, 0xb4, 0xfe, 0x23, 0x30, 0x61, 0x9e,
+	0xc0, 0x6a, 0x44, 0x60, 0xef, 0xb1, 0x45, 0xaa, 0xae, 0xab, 0xbb, 0xa8, 0x88, 0x7f, 0x6d, 0xb7,
+	0x6f, 0x14, 0xe5, 0xc6, 0x81, 0x2d, 0xca, 0x74, 0x8b, 0x4c, 0xad, 0x8e, 0x0c, 0x53, 0xae, 0x37,
+	0x6d, 0xc0, 0x31, 0x8f, 0x5f, 0x07, 0x4d, 0xe4, 0x28, 0x3e, 0x6a, 0x0b, 0xeb, 0x86, 0x6a, 0x89,
+	0xea, 0x86, 0x6a, 0x0b, 0xa6, 0xe5, 0xba, 0xd6, 0xd0, 0x8b, 0xf8, 0x2f, 0x59, 0x12, 0x7f, 0xe4,
+	0x20, 0x59, 0x35, 0xd4, 0xf5, 0x16, 0x92, 0x4d, 0xf4, 0x82, 0xa5, 0x8d, 0x2f, 0xc0, 0xa8, 0xac,
+	0xd4, 0xb5, 0x46, 0x8a, 0xcb, 0x72, 0x8b, 0x89, 0x4a, 0xea, 0xd1, 0xbd, 0xfc, 0xac, 0xed, 0xf8,
+	0x45, 0x45, 0x69, 0x21, 0xc3, 0xb8, 0x62, 0xb6, 0xb4, 0x86, 0x2a, 0x11, 0x18, 0xbf, 0x0e, 0xe3,
+	0x75, 0x54, 0xdf, 0x46, 0x2d, 0x23, 0x15, 0xc9, 0x46, 0x17, 0x27, 0x4a, 0xe9, 0x42, 0x17, 0x37,
+	0x85, 0x2a, 0x96, 0x4b, 0xe8, 0xad, 0x36, 0x32, 0xcc, 0x4a, 0xe2, 0xfe, 0x2f, 0x99, 0x91, 0xaf,
+	0x9e, 0xde, 0xcd, 0x71, 0x92, 0xb3, 0x93, 0x17, 0x20, 0x5e, 0x47, 0xa6, 0xac, 0xc8, 0xa6, 0x9c,
+	0x8a, 0x5a, 0x76, 0x25, 0xfa, 0xbb, 0xbc, 0xf8, 0xee, 0xd3, 0xbb, 0x39, 0x62, 0xec, 0xc3, 0xa7,
+	0x77, 0x73, 0x36, 0xa5, 0x79, 0x43, 0xb9, 0x55, 0x64, 0x5d, 0x17, 0x57, 0xe0, 0x08, 0xbb, 0x22,
+	0x21, 0xa3, 0xa9, 0x37, 0x0c, 0xc4, 0xcf, 0x43, 0x1c, 0x7b, 0x53, 0xd3, 0x14, 0x1c, 0x57, 0x4c,
+	0x1a, 0xc7, 0xbf, 0x37, 0x15, 0xf1, 0x77, 0x0e, 0xe6, 0xaa, 0x86, 0x7a, 0xad, 0xa9, 0x38, 0xbb,
+	0xaa, 0xb6, 0x53, 0xcf, 0xca, 0x84, 0xdb, 0x48, 0x84, 0x31, 0xc2, 0x6f, 0x41, 0x92, 0x84, 0x5a,
+	0x6b, 0x63, 0x3b, 0x46, 0x2a, 0xfa, 0xac, 0x5c, 0x1d, 0x26, 0x0a, 0x88, 0x9f, 0x46, 0xb9, 0xc8,
+	0xb2, 0x92, 0x65, 0x59, 0xf1, 0x46, 0x23, 0x66, 0xe0, 0x84, 0xaf, 0xc0, 0xe1, 0x48, 0xfc, 0x81,
+	0x83, 0x19, 0x16, 0x71, 0x11, 0x87, 0x35, 0x44, 0x1a, 0xd6, 0x20, 0xd1, 0x40, 0xb7, 0x6b, 0x44,
+	0x5d, 0x34, 0x44, 0x5d, 0xbc, 0x81, 0x6e, 0x63, 0x0f, 0xca, 0x79, 0x36, 0xd6, 0x74, 0x60, 0xac,
+	0x18, 0x2e, 0x9e, 0x80, 0x63, 0x3e, 0xcb, 0x34, 0xce, 0x6f, 0x38, 0x38, 0xc2, 0xca, 0xab, 0x76,
+	0xaa, 0x0d, 0x33, 0xd4, 0x5e, 0x19, 0xbd, 0xc4, 0xc6, 0x73, 0xb2, 0xc7, 0xd9, 0x91, 0x1d, 0x62,
+	0x16, 0xd2, 0xfe, 0x12, 0x1a, 0xd5, 0x67, 0x11, 0x98, 0x65, 0x93, 0x7f, 0x4b, 0xdf, 0xd5, 0x76,
+	0x0e, 0xfe, 0xa1, 0x98, 0x78, 0x19, 0x26, 0x15, 0xb4, 0xa3, 0x19, 0x9a, 0xde, 0xa8, 0x35, 0xb1,
+	0xe5, 0x54, 0x2c, 0xcb, 0x2d, 0x4e, 0x94, 0x66, 0x0b, 0xa4, 0x9b, 0x15, 0x9c, 0x6e, 0x56, 0xb8,
+	0xd8, 0x38, 0xa8, 0x88, 0x0f, 0xee, 0xe5, 0xd3, 0xdd, 0xb9, 0x7f, 0xc9, 0x56, 0x40, 0x3c, 0x97,
+	0x92, 0x0a, 0xf3, 0xbb, 0x5c, 0x7a, 0xff, 0xf3, 0xcc, 0x08, 0x4b, 0x5d, 0x26, 0xb0, 0x19, 0x90,
+	0x3d, 0xa2, 0x04, 0xc7, 0xfd, 0xd6, 0x69, 0x63, 0x28, 0xc1, 0xb8, 0x4c, 0x58, 0x08, 0xe5, 0xc7,
+	0x01, 0x8a, 0xef, 0x45, 0x60, 0x9e, 0x3d, 0x0d, 0xa2, 0x74, 0xb0, 0x72, 0x79, 0x11, 0x66, 0x09,
+	0xdf, 0x84, 0xb5, 0x9a, 0xe3, 0x4e, 0x24, 0x64, 0x3b, 0xaf, 0xba, 0x2d, 0x63, 0xc9, 0xa0, 0xf5,
+	0xb5, 0xc2, 0x92, 0x7a, 0x3a, 0x30, 0x1f, 0x5d, 0x71, 0x8a, 0xa7, 0xe0, 0x64, 0xa0, 0x90, 0x66,
+	0xe5, 0x77, 0x51, 0x48, 0xb1, 0xfc, 0x5f, 0xd7, 0xcc, 0x9b, 0x03, 0x66, 0xe6, 0x50, 0xbe, 0x34,
+	0x67, 0x20, 0x49, 0xe8, 0xee, 0xca, 0xe4, 0xc3, 0x2a, 0xd3, 0x09, 0x4a, 0x30, 0xc7, 0x9c, 0x0a,
+	0x45, 0xc7, 0x30, 0x7a, 0xc6, 0x45, 0x3e, 0xdd, 0xb3, 0xdc, 0xb5, 0x47, 0x36, 0xec, 0x93, 0x18,
+	0xcd, 0x72, 0x8b, 0x71, 0xf6, 0xc0, 0x0c, 0x92, 0x2c, 0x3e, 0x55, 0x33, 0x36, 0xe4, 0xaa, 0xb9,
+	0xe0, 0xad, 0x9a, 0x53, 0x81, 0x55, 0xd3, 0x39, 0x1d, 0xf1, 0x03, 0x0e, 0xb2, 0x41, 0xc2, 0x3e,
+	0xbe, 0xab, 0xc3, 0xcc, 0x6b, 0xf1, 0xfb, 0x08, 0x88, 0x7e, 0xc9, 0xc6, 0x86, 0xfe, 0xaf, 0x96,
+	0x9e, 0xcf, 0x49, 0x46, 0x87, 0x7c, 0x92, 0x65, 0xef, 0x49, 0x2e, 0x04, 0x96, 0x2a, 0xab, 0x4b,
+	0x3c, 0x0f, 0xb9, 0x70, 0x02, 0x69, 0xd9, 0xfe, 0xc9, 0xc1, 0x71, 0x3f, 0xf8, 0xc0, 0x1f, 0xca,
+	0x61, 0x32, 0xdd, 0xeb, 0xcb, 0x7a, 0xa1, 0x5f, 0x7a, 0xd8, 0x78, 0xc4, 0xb3, 0x70, 0xba, 0x97,
+	0x9c, 0x12, 0xf3, 0x51, 0x14, 0xa6, 0xab, 0x86, 0x7a, 0xa5, 0xbd, 0x5d, 0xd7, 0xcc, 0xad, 0x96,
+	0xde, 0xd4, 0x0d, 0x79, 0x37, 0x30, 0x3a, 0x6e, 0x80, 0xe8, 0x8e, 0x43, 0xa2, 0x89, 0xf5, 0x3a,
+	0x6d, 0x2e, 0x21, 0x75, 0x16, 0x7a, 0x7e, 0x81, 0x97, 0x2c, 0x99, 0x61, 0xc8, 0x2a, 0x32, 0x52,
+	0xb1, 0x6c, 0x34, 0x28, 0xf5, 0x24, 0x8a, 0xe2, 0xcf, 0x41, 0x0c, 0xed, 0xa3, 0x1d, 0xdc, 0x9f,
+	0x92, 0xa5, 0x39, 0x4f, 0x37, 0xdd, 0xd8, 0x47, 0x3b, 0x12, 0x86, 0xf0, 0xe7, 0x60, 0xd4, 0xd4,
+	0xcc, 0x5d, 0x84, 0xdb, 0x53, 0xa2, 0x32, 0xf3, 0xf3, 0xbd, 0xfc, 0x64, 0x87, 0xdc, 0xec, 0x52,
+	0x61, 0xf5, 0x3f, 0x12, 0x41, 0xf0, 0x79, 0x18, 0x37, 0xda, 0xf5, 0xba, 0xdc, 0x3a, 0x48, 0x8d,
+	0x07, 0x83, 0x1d, 0x4c, 0xf9, 0xbf, 0x4e, 0x56, 0x77, 0xc2, 0xb4, 0x8e, 0x4e, 0x74, 0x1d, 0x1d,
+	0xb9, 0xe6, 0x78, 0x78, 0x17, 0xff, 0x0f, 0xf3, 0x9e, 0x45, 0xda, 0x9a, 0x32, 0x30, 0xd1, 0xb4,
+	0xd7, 0x3a, 0xdd, 0x09, 0x9c, 0xa5, 0x4d, 0x45, 0xfc, 0x82, 0xcc, 0xbb, 0x56, 0x57, 0x53, 0x5a,
+	0xf2, 0x6d, 0x7a, 0x9a, 0x61, 0x1b, 0xdd, 0x33, 0x43, 0xa4, 0xcf, 0x99, 0xa1, 0xbc, 0x66, 0x45,
+	0xe8, 0xfc, 0xea, 0xfe, 0xc8, 0xd2, 0xf8, 0xba, 0x7d, 0xb1, 0x47, 0xd9, 0xee, 0x65, 0x9a, 0x8e,
+	0x7f, 0x71, 0x30, 0x5e, 0x35, 0xd4, 0x57, 0x75, 0x33, 0x3c, 0x5e, 0xab, 0x66, 0xf7, 0x74, 0x13,
+	0xb5, 0x42, 0x9d, 0x26, 0x30, 0x7e, 0x05, 0xc6, 0xf4, 0xa6, 0xa9, 0xe9, 0x64, 0x92, 0x48, 0x96,
+	0x8e, 0x79, 0xf2, 0xc3, 0xb2, 0xfb, 0x0a, 0x86, 0x48, 0x36, 0x94, 0x49, 0xd0, 0x58, 0x57, 0x82,
+	0xf6, 0x9f, 0x6e, 0xe5, 0x05, 0x5c, 0xc7, 0xd8, 0x0f, 0x8b, 0xac, 0x94, 0x1f, 0x59, 0x96, 0x75,
+	0x71, 0x1a, 0x26, 0xed, 0x7f, 0x29, 0x29, 0x1f, 0x13, 0x52, 0x2c, 0x6d, 0xe1, 0xa4, 0xac, 0x42,
+	0xdc, 0x32, 0xd8, 0x36, 0xf5, 0x70, 0x5e, 0x28, 0xb2, 0x9c, 0xb3, 0xdc, 0xa3, 0x3f, 0x03, 0x3d,
+	0xb4, 0x5c, 0x10, 0x25, 0x98, 0xb4, 0xff, 0xa5, 0xa9, 0xf9, 0x1c, 0x8c, 0xb5, 0x90, 0xd1, 0xde,
+	0x35, 0xb1, 0xc9, 0x64, 0x69, 0xc1, 0x43, 0x85, 0x73, 0xd2, 0x1b, 0xb6, 0x09, 0x09, 0xc3, 0x25,
+	0x7b, 0x9b, 0xf8, 0x09, 0x07, 0x87, 0xab, 0x86, 0xfa, 0x12, 0x92, 0xf7, 0xec, 0x5b, 0xfb, 0x00,
+	0x73, 0x6c, 0x8f, 0x49, 0x9f, 0xdc, 0x2e, 0xdd, 0xe9, 0x9a, 0xf6, 0x8b, 0xaf, 0x63, 0x5f, 0xd4,
+	0x60, 0x8e, 0x59, 0xa0, 0xb1, 0x6e, 0x41, 0x02, 0xed, 0x6b, 0x66, 0xcd, 0x7a, 0xc2, 0xc0, 0xae,
+	0x4d, 0x94, 0x04, 0x4f, 0x5b, 0xba, 0xea, 0xbc, 0x6f, 0x54, 0x8e, 0xde, 0xf9, 0x35, 0xc3, 0x79,
+	0xfa, 0xc5, 0x5a, 0xc9, 0x22, 0x5f, 0x33, 0x2d, 0x9c, 0xf8, 0x07, 0x87, 0x7b, 0xf0, 0x25, 0xb4,
+	0x8b, 0x54, 0xd9, 0x44, 0xd7, 0x91, 0xa6, 0xde, 0x34, 0xf9, 0x0b, 0x90, 0x50, 0xc8, 0x8a, 0xde,
+	0x0a, 0xa5, 0xa0, 0x03, 0xed, 0x75, 0xdd, 0x59, 0x85, 0xb8, 0x8d, 0x43, 0xe1, 0xc3, 0xb4, 0x83,
+	0x2c, 0x5f, 0x7e, 0xe4, 0xf5, 0x1e, 0xb7, 0x37, 0x6a, 0x33, 0xb0, 0xbd, 0xb1, 0x21, 0x89, 0x4b,
+	0x30, 0xef, 0x59, 0x74, 0x78, 0x2d, 0xcf, 0xf8, 0x98, 0x11, 0xbf, 0xe6, 0x48, 0x39, 0x20, 0x53,
+	0xef, 0xbf, 0x9d, 0xad, 0x42, 0x5c, 0x6d, 0xcb, 0x2d, 0x45, 0x93, 0x1b, 0xe1, 0x25, 0xe0, 0x20,
+	0xcb, 0x1b, 0x01, 0x61, 0x52, 0x44, 0xf7, 0xab, 0x44, 0xa7, 0x6e, 0x5d, 0xde, 0x89, 0x05, 0x38,
+	0xda, 0xb5, 0xd4, 0x3b, 0xc2, 0x6f, 0x23, 0xb4, 0xe0, 0x09, 0x21, 0x48, 0x19, 0x7e, 0xe7, 0xbb,
+	0x0c, 0xe3, 0xa4, 0x9d, 0x39, 0xcf, 0x34, 0xa7, 0x3c, 0x05, 0xea, 0x18, 0xef, 0xb4, 0x40, 0xe6,
+	0xb6, 0x61, 0x6f, 0x1f, 0x56, 0x3b, 0xac, 0x04, 0x90, 0xdd, 0xe9, 0x90, 0xd9, 0xa0, 0x0e, 0xe9,
+	0x38, 0xea, 0x30, 0xed, 0x5a, 0xea, 0xc9, 0x74, 0x2e, 0x07, 0x31, 0xdc, 0x42, 0x67, 0x61, 0x6a,
+	0xe3, 0xb5, 0x8d, 0xf5, 0xda, 0xb5, 0x97, 0xaf, 0x6c, 0x6d, 0xac, 0x6f, 0x3e, 0xbf, 0xb9, 0x71,
+	0x69, 0x6a, 0x84, 0x3f, 0x04, 0x71, 0xbc, 0x7a, 0x55, 0x7a, 0x7d, 0x8a, 0x2b, 0x7d, 0x99, 0x84,
+	0x68, 0xd5, 0x50, 0xf9, 0xeb, 0x30, 0xe1, 0x7e, 0x4a, 0xcc, 0x78, 0xef, 0x67, 0xcc, 0x85, 0x42,
+	0x58, 0x08, 0x01, 0xd0, 0x2e, 0xb2, 0x0b, 0xbc, 0xcf, 0x03, 0xdd, 0x59, 0xbf, 0xed, 0x5e, 0x9c,
+	0x50, 0xe8, 0x0f, 0x47, 0xad, 0xdd, 0x80, 0x29, 0xcf, 0x2b, 0xd8, 0xe9, 0x10, 0x1d, 0x18, 0x25,
+	0x9c, 0xef, 0x07, 0x45, 0xed, 0xe8, 0x30, 0xe3, 0xf7, 0x0a, 0xb5, 0x10, 0xea, 0x2e, 0x01, 0x0a,
+	0xc5, 0x3e, 0x81, 0xd4, 0xa0, 0x06, 0xd3, 0xde, 0x07, 0xa2, 0x33, 0x21, 0x87, 0x40, 0x60, 0x42,
+	0xbe, 0x2f, 0x18, 0x35, 0xd5, 0x86, 0x39, 0xff, 0x5b, 0xff, 0xb9, 0x10, 0x3d, 0x1d, 0xa8, 0xb0,
+	0xdc, 0x37, 0x94, 0x9a, 0xdd, 0x87, 0x23, 0x01, 0xef, 0x32, 0xb9, 0x10, 0xb2, 0x5c, 0x58, 0xa1,
+	0xd4, 0x3f, 0x96, 0x5a, 0xfe, 0x94, 0x83, 0x4c, 0xd8, 0x05, 0x75, 0xa5, 0x2f, 0xbd, 0xec, 0x26,
+	0xe1, 0x7f, 0x03, 0x6c, 0xa2, 0x5e, 0xbd, 0xc3, 0xc1, 0x7c, 0xf0, 0x35, 0x2e, 0xdf, 0x97, 0x6a,
+	0x9a, 0x6f, 0x6b, 0xcf, 0x04, 0xa7, 0x3e, 0xbc, 0x09, 0xc9, 0xae, 0x0b, 0x93, 0xe8, 0xa7, 0x88,
+	0xc5, 0x08, 0xb9, 0x70, 0x8c, 0xbb, 0x60, 0x3d, 0x63, 0xbc, 0x6f, 0xc1, 0x76, 0xa3, 0x84, 0xf3,
+	0xfd, 0xa0, 0xa8, 0x9d, 0x0a, 0xc4, 0xf0, 0xac, 0x9d, 0xf2, 0xdb, 0x65, 0x49, 0x84, 0x6c, 0x90,
+	0xc4, 0xad, 0x03, 0xf7, 0x55, 0x5f, 0x1d, 0x96, 0x44, 0xc8, 0x06, 0x49, 0xa8, 0x8e, 0xab, 0x00,
+	0xae, 0xd9, 0x2f, 0xed, 0x87, 0xef, 0xc8, 0x85, 0xb3, 0xbd, 0xe5, 0xae, 0x92, 0x4d, 0x76, 0x0d,
+	0x55, 0xbe, 0xe7, 0xc4, 0x62, 0x84, 0x5c, 0x38, 0x86, 0x8e, 0xe6, 0x33, 0x0f, 0xbc, 0x1f, 0x1a,
+	0x5e, 0x87, 0x43, 0xcc, 0xc0, 0xe2, 0x4f, 0xa1, 0x0b, 0x21, 0x2c, 0x86, 0x21, 0xc2, 0x0d, 0xba,
+	0xe7, 0x87, 0xc0, 0x33, 0x73, 0x10, 0xc2, 0x62, 0x18, 0xa2, 0xa7, 0x41, 0x61, 0xf4, 0x6d, 0x6b,
+	0x2a, 0xa8, 0x14, 0xee, 0x3f, 0x4e, 0x73, 0x0f, 0x1f, 0xa7, 0xb9, 0xdf, 0x1e, 0xa7, 0xb9, 0x3b,
+	0x4f, 0xd2, 0x23, 0x0f, 0x9f, 0xa4, 0x47, 0x7e, 0x7a, 0x92, 0x1e, 0x79, 0xc3, 0x9e, 0x46, 0x0c,
+	0xe5, 0x56, 0x41, 0xd3, 0x8b, 0xfb, 0xe4, 0x0b, 0xbe, 0x3d, 0x86, 0xe7, 0xe3, 0x95, 0xbf, 0x07,
+	0x00, 0x6e, 0x22, 0x68, 0x12, 0x86, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1632,8 +1983,15 @@ type MsgClient interface {
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
-	// LeaveGroup allows a group member to leave the group.
+	// LeaveGroup allows a group member to leave the group. When the module has a
+	// member exit delay, the member is removed from the group after it.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// DelegateWeight allows a group member to delegate its voting weight to another member of the group.
+	DelegateWeight(ctx context.Context, in *MsgDelegateWeight, opts ...grpc.CallOption) (*MsgDelegateWeightResponse, error)
+	// VetoProposal allows the guardian of a GuardianDecisionPolicy to veto an accepted proposal.
+	VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error)
+	// VoteWeighted allows a group member to split their weight across several vote options.
+	VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error)
 }
 
 type msgClient struct {
@@ -1770,6 +2128,33 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) DelegateWeight(ctx context.Context, in *MsgDelegateWeight, opts ...grpc.CallOption) (*MsgDelegateWeightResponse, error) {
+	out := new(MsgDelegateWeightResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/DelegateWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error) {
+	out := new(MsgVetoProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/VetoProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error) {
+	out := new(MsgVoteWeightedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/VoteWeighted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateGroup creates a new group with an admin account address, a list of members and some optional metadata.
@@ -1798,8 +2183,15 @@ type MsgServer interface {
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
-	// LeaveGroup allows a group member to leave the group.
+	// LeaveGroup allows a group member to leave the group. When the module has a
+	// member exit delay, the member is removed from the group after it.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// DelegateWeight allows a group member to delegate its voting weight to another member of the group.
+	DelegateWeight(context.Context, *MsgDelegateWeight) (*MsgDelegateWeightResponse, error)
+	// VetoProposal allows the guardian of a GuardianDecisionPolicy to veto an accepted proposal.
+	VetoProposal(context.Context, *MsgVetoProposal) (*MsgVetoProposalResponse, error)
+	// VoteWeighted allows a group member to split their weight across several vote options.
+	VoteWeighted(context.Context, *MsgVoteWeighted) (*MsgVoteWeightedResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1848,6 +2240,15 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (*UnimplementedMsgServer) DelegateWeight(ctx context.Context, req *MsgDelegateWeight) (*MsgDelegateWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateWeight not implemented")
+}
+func (*UnimplementedMsgServer) VetoProposal(ctx context.Context, req *MsgVetoProposal) (*MsgVetoProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoProposal not implemented")
+}
+func (*UnimplementedMsgServer) VoteWeighted(ctx context.Context, req *MsgVoteWeighted) (*MsgVoteWeightedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteWeighted not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2105,41 +2506,95 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.group.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateGroup",
-			Handler:    _Msg_CreateGroup_Handler,
-		},
-		{
-			MethodName: "UpdateGroupMembers",
-			Handler:    _Msg_UpdateGroupMembers_Handler,
-		},
-		{
-			MethodName: "UpdateGroupAdmin",
-			Handler:    _Msg_UpdateGroupAdmin_Handler,
-		},
-		{
-			MethodName: "UpdateGroupMetadata",
-			Handler:    _Msg_UpdateGroupMetadata_Handler,
-		},
-		{
-			MethodName: "CreateGroupPolicy",
-			Handler:    _Msg_CreateGroupPolicy_Handler,
-		},
-		{
-			MethodName: "CreateGroupWithPolicy",
-			Handler:    _Msg_CreateGroupWithPolicy_Handler,
-		},
-		{
-			MethodName: "UpdateGroupPolicyAdmin",
-			Handler:    _Msg_UpdateGroupPolicyAdmin_Handler,
-		},
-		{
-			MethodName: "UpdateGroupPolicyDecisionPolicy",
-			Handler:    _Msg_UpdateGroupPolicyDecisionPolicy_Handler,
+func _Msg_DelegateWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateWeight)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/DelegateWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateWeight(ctx, req.(*MsgDelegateWeight))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VetoProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/VetoProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoProposal(ctx, req.(*MsgVetoProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteWeighted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteWeighted)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteWeighted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/VoteWeighted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteWeighted(ctx, req.(*MsgVoteWeighted))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.group.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGroup",
+			Handler:    _Msg_CreateGroup_Handler,
+		},
+		{
+			MethodName: "UpdateGroupMembers",
+			Handler:    _Msg_UpdateGroupMembers_Handler,
+		},
+		{
+			MethodName: "UpdateGroupAdmin",
+			Handler:    _Msg_UpdateGroupAdmin_Handler,
+		},
+		{
+			MethodName: "UpdateGroupMetadata",
+			Handler:    _Msg_UpdateGroupMetadata_Handler,
+		},
+		{
+			MethodName: "CreateGroupPolicy",
+			Handler:    _Msg_CreateGroupPolicy_Handler,
+		},
+		{
+			MethodName: "CreateGroupWithPolicy",
+			Handler:    _Msg_CreateGroupWithPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateGroupPolicyAdmin",
+			Handler:    _Msg_UpdateGroupPolicyAdmin_Handler,
+		},
+		{
+			MethodName: "UpdateGroupPolicyDecisionPolicy",
+			Handler:    _Msg_UpdateGroupPolicyDecisionPolicy_Handler,
 		},
 		{
 			MethodName: "UpdateGroupPolicyMetadata",
@@ -2165,6 +2620,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "DelegateWeight",
+			Handler:    _Msg_DelegateWeight_Handler,
+		},
+		{
+			MethodName: "VetoProposal",
+			Handler:    _Msg_VetoProposal_Handler,
+		},
+		{
+			MethodName: "VoteWeighted",
+			Handler:    _Msg_VoteWeighted_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
@@ -3214,153 +3681,370 @@ func (m *MsgLeaveGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExitTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExitTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCreateGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDelegateWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovTx(uint64(m.GroupId))
-	}
-	return n
+func (m *MsgDelegateWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateGroupMembers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDelegateWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.GroupId != 0 {
-		n += 1 + sovTx(uint64(m.GroupId))
+		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.MemberUpdates) > 0 {
-		for _, e := range m.MemberUpdates {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateGroupMembersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelegateWeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateGroupAdmin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GroupId != 0 {
-		n += 1 + sovTx(uint64(m.GroupId))
-	}
-	l = len(m.NewAdmin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgDelegateWeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateGroupAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDelegateWeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateGroupMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GroupId != 0 {
-		n += 1 + sovTx(uint64(m.GroupId))
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateGroupMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgVetoProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateGroupPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgVetoProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteWeighted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteWeighted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exec != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Exec))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteWeightedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteWeightedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteWeightedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupMembers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	if len(m.MemberUpdates) > 0 {
+		for _, e := range m.MemberUpdates {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateGroupAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateGroupMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateGroupPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.GroupId != 0 {
 		n += 1 + sovTx(uint64(m.GroupId))
@@ -3691,21 +4375,117 @@ func (m *MsgLeaveGroupResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.ExitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExitTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgDelegateWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgDelegateWeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *MsgVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVetoProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteWeighted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Exec != 0 {
+		n += 1 + sovTx(uint64(m.Exec))
+	}
+	return n
+}
+
+func (m *MsgVoteWeightedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowTx
@@ -5547,7 +6327,490 @@ func (m *MsgUpdateGroupPolicyMetadata) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupPolicyMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &any.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+			}
+			m.Exec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exec |= Exec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5575,7 +6838,7 @@ func (m *MsgUpdateGroupPolicyMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5598,7 +6861,7 @@ func (m *MsgUpdateGroupPolicyMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGroupPolicyMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5621,10 +6884,10 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGroupPolicyMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGroupPolicyMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5648,7 +6911,7 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5671,17 +6934,17 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5691,27 +6954,14 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5739,13 +6989,13 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
 			}
-			var stringLen uint64
+			m.Option = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5755,29 +7005,16 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Option |= VoteOption(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5787,25 +7024,23 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &any.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
@@ -5826,11 +7061,111 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5840,27 +7175,14 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5888,7 +7210,7 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Summary = string(dAtA[iNdEx:postIndex])
+			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5911,7 +7233,7 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5934,17 +7256,17 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			m.ProposalId = 0
+			m.Result = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5954,7 +7276,7 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				m.Result |= ProposalExecutorResult(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5980,7 +7302,7 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5996,39 +7318,20 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if b < 0x80 {
+				break
 			}
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeaveGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -6060,6 +7363,25 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6081,7 +7403,7 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6104,12 +7426,48 @@ func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeaveGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeaveGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExitTime == nil {
+				m.ExitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6131,7 +7489,7 @@ func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVote) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateWeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6154,34 +7512,15 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateWeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6209,13 +7548,13 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.Option = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6225,14 +7564,14 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
+				m.GroupId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6260,27 +7599,8 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
-			}
-			m.Exec = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Exec |= Exec(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6302,7 +7622,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateWeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6325,10 +7645,10 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateWeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateWeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6352,7 +7672,7 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExec) Unmarshal(dAtA []byte) error {
+func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6375,10 +7695,10 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVetoProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6402,7 +7722,7 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6430,7 +7750,7 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Executor = string(dAtA[iNdEx:postIndex])
+			m.Guardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6453,7 +7773,7 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6476,31 +7796,12 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVetoProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVetoProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ProposalExecutorResult(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6522,7 +7823,7 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6545,15 +7846,34 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteWeighted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6581,13 +7901,79 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
 			}
-			m.GroupId = 0
+			m.Exec = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6597,7 +7983,7 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint64(b&0x7F) << shift
+				m.Exec |= Exec(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6623,7 +8009,7 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteWeightedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6646,10 +8032,10 @@ func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteWeightedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteWeightedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
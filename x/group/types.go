@@ -40,6 +40,10 @@ type DecisionPolicy interface {
 	// where we can execution a proposal. It can be set to 0 or to a value
 	// lesser than VotingPeriod to allow TRY_EXEC.
 	GetMinExecutionPeriod() time.Duration
+	// GetExecutionDelay returns the duration after a proposal is accepted
+	// during which it cannot be executed. Proposals with an execution delay
+	// are executed automatically once the delay has passed.
+	GetExecutionDelay() time.Duration
 	// Allow defines policy-specific logic to allow a proposal to pass or not,
 	// based on its tally result, the group's total power and the time since
 	// the proposal was submitted.
@@ -54,7 +58,7 @@ var _ DecisionPolicy = &ThresholdDecisionPolicy{}
 
 // NewThresholdDecisionPolicy creates a threshold DecisionPolicy
 func NewThresholdDecisionPolicy(threshold string, votingPeriod, minExecutionPeriod time.Duration) DecisionPolicy {
-	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
+	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: minExecutionPeriod}}
 }
 
 // GetVotingPeriod returns the voitng period of ThresholdDecisionPolicy
@@ -67,6 +71,11 @@ func (p ThresholdDecisionPolicy) GetMinExecutionPeriod() time.Duration {
 	return p.Windows.MinExecutionPeriod
 }
 
+// GetExecutionDelay returns the execution delay of ThresholdDecisionPolicy
+func (p ThresholdDecisionPolicy) GetExecutionDelay() time.Duration {
+	return p.Windows.ExecutionDelay
+}
+
 // ValidateBasic does basic validation on ThresholdDecisionPolicy
 func (p ThresholdDecisionPolicy) ValidateBasic() error {
 	if _, err := math.NewPositiveDecFromString(p.Threshold); err != nil {
@@ -77,6 +86,10 @@ func (p ThresholdDecisionPolicy) ValidateBasic() error {
 		return errorsmod.Wrap(errors.ErrInvalid, "voting period cannot be zero")
 	}
 
+	if p.Windows.ExecutionDelay < 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "execution delay cannot be negative")
+	}
+
 	return nil
 }
 
@@ -151,6 +164,9 @@ func (p *ThresholdDecisionPolicy) Validate(g GroupInfo, config Config) error {
 	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
 		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
 	}
+	if p.Windows.ExecutionDelay >= config.MaxExecutionPeriod {
+		return errorsmod.Wrap(errors.ErrInvalid, "execution_delay should be smaller than max_execution_period")
+	}
 	return nil
 }
 
@@ -159,7 +175,7 @@ var _ DecisionPolicy = &PercentageDecisionPolicy{}
 
 // NewPercentageDecisionPolicy creates a new percentage DecisionPolicy
 func NewPercentageDecisionPolicy(percentage string, votingPeriod, executionPeriod time.Duration) DecisionPolicy {
-	return &PercentageDecisionPolicy{percentage, &DecisionPolicyWindows{votingPeriod, executionPeriod}}
+	return &PercentageDecisionPolicy{percentage, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: executionPeriod}}
 }
 
 // GetVotingPeriod returns the voitng period of PercentageDecisionPolicy
@@ -172,6 +188,11 @@ func (p PercentageDecisionPolicy) GetMinExecutionPeriod() time.Duration {
 	return p.Windows.MinExecutionPeriod
 }
 
+// GetExecutionDelay returns the execution delay of PercentageDecisionPolicy
+func (p PercentageDecisionPolicy) GetExecutionDelay() time.Duration {
+	return p.Windows.ExecutionDelay
+}
+
 // ValidateBasic does basic validation on PercentageDecisionPolicy
 func (p PercentageDecisionPolicy) ValidateBasic() error {
 	percentage, err := math.NewPositiveDecFromString(p.Percentage)
@@ -186,6 +207,10 @@ func (p PercentageDecisionPolicy) ValidateBasic() error {
 		return errorsmod.Wrap(errors.ErrInvalid, "voting period cannot be 0")
 	}
 
+	if p.Windows.ExecutionDelay < 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "execution delay cannot be negative")
+	}
+
 	return nil
 }
 
@@ -194,6 +219,9 @@ func (p *PercentageDecisionPolicy) Validate(g GroupInfo, config Config) error {
 	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
 		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
 	}
+	if p.Windows.ExecutionDelay >= config.MaxExecutionPeriod {
+		return errorsmod.Wrap(errors.ErrInvalid, "execution_delay should be smaller than max_execution_period")
+	}
 	return nil
 }
 
@@ -243,6 +271,79 @@ func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string) (D
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &GuardianDecisionPolicy{}
+
+// NewGuardianDecisionPolicy creates a guardian DecisionPolicy
+func NewGuardianDecisionPolicy(threshold, guardian string, votingPeriod, minExecutionPeriod, vetoWindow time.Duration) DecisionPolicy {
+	return &GuardianDecisionPolicy{
+		Threshold:  threshold,
+		Windows:    &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: minExecutionPeriod},
+		Guardian:   guardian,
+		VetoWindow: vetoWindow,
+	}
+}
+
+// GetVotingPeriod returns the voting period of GuardianDecisionPolicy
+func (p GuardianDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+// GetMinExecutionPeriod returns the minimum execution period of GuardianDecisionPolicy
+func (p GuardianDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+// GetExecutionDelay returns the execution delay of GuardianDecisionPolicy, the
+// accepted proposals are never executed before the end of the veto window.
+func (p GuardianDecisionPolicy) GetExecutionDelay() time.Duration {
+	if p.Windows.ExecutionDelay > p.VetoWindow {
+		return p.Windows.ExecutionDelay
+	}
+	return p.VetoWindow
+}
+
+// thresholdPolicy returns the ThresholdDecisionPolicy deciding whether a
+// proposal is accepted before the veto window.
+func (p GuardianDecisionPolicy) thresholdPolicy() *ThresholdDecisionPolicy {
+	return &ThresholdDecisionPolicy{Threshold: p.Threshold, Windows: p.Windows}
+}
+
+// ValidateBasic does basic validation on GuardianDecisionPolicy
+func (p GuardianDecisionPolicy) ValidateBasic() error {
+	if err := p.thresholdPolicy().ValidateBasic(); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Guardian); err != nil {
+		return errorsmod.Wrap(err, "guardian")
+	}
+
+	if p.VetoWindow <= 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "veto window must be positive")
+	}
+
+	return nil
+}
+
+// Allow allows a proposal to pass when the tally of yes votes equals or exceeds the threshold before the timeout.
+// The guardian can still veto the proposal during the veto window.
+func (p GuardianDecisionPolicy) Allow(tallyResult TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	return p.thresholdPolicy().Allow(tallyResult, totalPower)
+}
+
+// Validate validates the policy against the group.
+func (p *GuardianDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	if err := p.thresholdPolicy().Validate(g, config); err != nil {
+		return err
+	}
+
+	if p.VetoWindow >= config.MaxExecutionPeriod {
+		return errorsmod.Wrap(errors.ErrInvalid, "veto_window should be smaller than max_execution_period")
+	}
+	return nil
+}
+
 var _ orm.Validateable = GroupPolicyInfo{}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
@@ -383,6 +484,66 @@ func (g GroupMember) ValidateBasic() error {
 	return nil
 }
 
+func (d WeightDelegation) PrimaryKeyFields(addressCodec address.Codec) ([]interface{}, error) {
+	addr, err := addressCodec.StringToBytes(d.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{d.GroupId, addr}, nil
+}
+
+var _ orm.Validateable = WeightDelegation{}
+
+// ValidateBasic does basic validation on weight delegation.
+func (d WeightDelegation) ValidateBasic() error {
+	if d.GroupId == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "weight delegation's group id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(d.Delegator); err != nil {
+		return errorsmod.Wrap(err, "weight delegation's delegator")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(d.Delegate); err != nil {
+		return errorsmod.Wrap(err, "weight delegation's delegate")
+	}
+
+	if d.Delegator == d.Delegate {
+		return errorsmod.Wrap(errors.ErrInvalid, "cannot delegate weight to self")
+	}
+
+	return nil
+}
+
+func (e MemberExit) PrimaryKeyFields(addressCodec address.Codec) ([]interface{}, error) {
+	addr, err := addressCodec.StringToBytes(e.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{e.GroupId, addr}, nil
+}
+
+var _ orm.Validateable = MemberExit{}
+
+// ValidateBasic does basic validation on member exit.
+func (e MemberExit) ValidateBasic() error {
+	if e.GroupId == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "member exit's group id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+		return errorsmod.Wrap(err, "member exit's address")
+	}
+
+	if e.ExitTime.IsZero() {
+		return errorsmod.Wrap(errors.ErrEmpty, "member exit's exit time")
+	}
+
+	return nil
+}
+
 // MemberToMemberRequest converts a `Member` (used for storage)
 // to a `MemberRequest` (used in requests). The only difference
 // between the two is that `MemberRequest` doesn't have any `AddedAt` field
@@ -449,6 +610,12 @@ func (v Vote) ValidateBasic() error {
 	if v.ProposalId == 0 {
 		return errorsmod.Wrap(errors.ErrEmpty, "voter ProposalId")
 	}
+	if len(v.Options) > 0 {
+		if v.Option != VOTE_OPTION_UNSPECIFIED {
+			return errorsmod.Wrap(errors.ErrInvalid, "vote option must be unspecified for a weighted vote")
+		}
+		return ValidateWeightedVoteOptions(v.Options)
+	}
 	if v.Option == VOTE_OPTION_UNSPECIFIED {
 		return errorsmod.Wrap(errors.ErrEmpty, "voter vote option")
 	}
@@ -458,6 +625,44 @@ func (v Vote) ValidateBasic() error {
 	return nil
 }
 
+// ValidateWeightedVoteOptions checks that the weighted vote options are valid
+// vote options without duplicates, with positive weights summing up to 1.
+func ValidateWeightedVoteOptions(options []WeightedVoteOption) error {
+	if len(options) == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "vote options")
+	}
+
+	totalWeight := math.NewDecFromInt64(0)
+	seen := make(map[VoteOption]bool, len(options))
+	for _, option := range options {
+		if option.Option == VOTE_OPTION_UNSPECIFIED {
+			return errorsmod.Wrap(errors.ErrEmpty, "vote option")
+		}
+		if _, ok := VoteOption_name[int32(option.Option)]; !ok {
+			return errorsmod.Wrap(errors.ErrInvalid, "vote option")
+		}
+		if seen[option.Option] {
+			return errorsmod.Wrapf(errors.ErrDuplicate, "vote option %s", option.Option)
+		}
+		seen[option.Option] = true
+
+		weight, err := math.NewPositiveDecFromString(option.Weight)
+		if err != nil {
+			return errorsmod.Wrapf(err, "weight of vote option %s", option.Option)
+		}
+		totalWeight, err = totalWeight.Add(weight)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !totalWeight.Equal(math.NewDecFromInt64(1)) {
+		return errorsmod.Wrapf(errors.ErrInvalid, "total weight of vote options must be 1, got %s", totalWeight)
+	}
+
+	return nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (q QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
 	return unpackGroupPolicies(unpacker, q.GroupPolicies)
@@ -487,6 +692,28 @@ func (t *TallyResult) operation(vote Vote, weight string, op operation) error {
 		return err
 	}
 
+	if len(vote.Options) == 0 {
+		return t.optionOperation(vote.Option, weightDec, op)
+	}
+
+	// a weighted vote splits the voter's weight across its options
+	for _, option := range vote.Options {
+		optionWeight, err := math.NewPositiveDecFromString(option.Weight)
+		if err != nil {
+			return errorsmod.Wrapf(err, "weight of vote option %s", option.Option)
+		}
+		optionWeight, err = optionWeight.Mul(weightDec)
+		if err != nil {
+			return err
+		}
+		if err := t.optionOperation(option.Option, optionWeight, op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *TallyResult) optionOperation(option VoteOption, weightDec math.Dec, op operation) error {
 	yesCount, err := t.GetYesCount()
 	if err != nil {
 		return errorsmod.Wrap(err, "yes count")
@@ -504,7 +731,7 @@ func (t *TallyResult) operation(vote Vote, weight string, op operation) error {
 		return errorsmod.Wrap(err, "veto count")
 	}
 
-	switch vote.Option {
+	switch option {
 	case VOTE_OPTION_YES:
 		yesCount, err := op(yesCount, weightDec)
 		if err != nil {
@@ -530,7 +757,7 @@ func (t *TallyResult) operation(vote Vote, weight string, op operation) error {
 		}
 		t.NoWithVetoCount = vetoCount.String()
 	default:
-		return errorsmod.Wrapf(errors.ErrInvalid, "unknown vote option %s", vote.Option.String())
+		return errorsmod.Wrapf(errors.ErrInvalid, "unknown vote option %s", option.String())
 	}
 	return nil
 }
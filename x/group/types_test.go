@@ -30,6 +30,17 @@ func TestThresholdDecisionPolicyValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"execution delay too big",
+			group.ThresholdDecisionPolicy{
+				Threshold: "5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod:   time.Hour,
+					ExecutionDelay: config.MaxExecutionPeriod,
+				},
+			},
+			true,
+		},
 		{
 			"all good",
 			group.ThresholdDecisionPolicy{
@@ -54,6 +65,54 @@ func TestThresholdDecisionPolicyValidate(t *testing.T) {
 	}
 }
 
+func TestGuardianDecisionPolicyValidate(t *testing.T) {
+	g := group.GroupInfo{
+		TotalWeight: "10",
+	}
+	config := group.DefaultConfig()
+	guardian := "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+	testCases := []struct {
+		name   string
+		policy group.DecisionPolicy
+		expErr bool
+	}{
+		{
+			"invalid guardian",
+			group.NewGuardianDecisionPolicy("5", "invalid", time.Hour, 0, time.Hour),
+			true,
+		},
+		{
+			"zero veto window",
+			group.NewGuardianDecisionPolicy("5", guardian, time.Hour, 0, 0),
+			true,
+		},
+		{
+			"veto window too big",
+			group.NewGuardianDecisionPolicy("5", guardian, time.Hour, 0, config.MaxExecutionPeriod),
+			true,
+		},
+		{
+			"all good",
+			group.NewGuardianDecisionPolicy("5", guardian, time.Hour, 0, time.Hour),
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if err == nil {
+				err = tc.policy.Validate(g, config)
+			}
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, time.Hour, tc.policy.GetExecutionDelay())
+			}
+		})
+	}
+}
+
 func TestPercentageDecisionPolicyValidate(t *testing.T) {
 	g := group.GroupInfo{}
 	config := group.DefaultConfig()
@@ -1,10 +1,14 @@
 package mint
 
 import (
+	"errors"
+	"fmt"
+
 	modulev1 "cosmossdk.io/api/cosmos/mint/module/v1"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	"cosmossdk.io/math"
 	authtypes "cosmossdk.io/x/auth/types"
 	epochstypes "cosmossdk.io/x/epochs/types"
 	"cosmossdk.io/x/mint/keeper"
@@ -32,7 +36,7 @@ type ModuleInputs struct {
 	Environment            appmodule.Environment
 	Cdc                    codec.Codec
 	MintFn                 types.MintFn                 `optional:"true"`
-	InflationCalculationFn types.InflationCalculationFn `optional:"true"` // deprecated
+	InflationCalculationFn types.InflationCalculationFn `optional:"true"`
 
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
@@ -78,6 +82,17 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		panic("MintFn and InflationCalculationFn cannot both be set")
 	}
 
+	if in.Config.InflationCurve != "" {
+		if in.MintFn != nil || in.InflationCalculationFn != nil {
+			panic("inflation curve cannot be set with a custom MintFn or InflationCalculationFn")
+		}
+
+		in.InflationCalculationFn, err = inflationCalculationFnFromConfig(in.Config, in.Environment)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	// if no mintFn is provided, use the default minting function
 	if in.MintFn == nil {
 		// if no inflationCalculationFn is provided, use the default inflation calculation function
@@ -92,3 +107,43 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	return ModuleOutputs{MintKeeper: k, Module: m, EpochHooks: epochstypes.EpochHooksWrapper{EpochHooks: m}}
 }
+
+// inflationCalculationFnFromConfig returns the built-in inflation curve
+// selected by the module config.
+func inflationCalculationFnFromConfig(config *modulev1.Module, env appmodule.Environment) (types.InflationCalculationFn, error) {
+	if config.InflationCurve == types.InflationCurveDynamic {
+		return types.DefaultInflationCalculationFn, nil
+	}
+
+	inflationRate, err := math.LegacyNewDecFromStr(config.InflationRate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid inflation rate %q: %w", config.InflationRate, err)
+	}
+	if inflationRate.IsNegative() || inflationRate.GT(math.LegacyOneDec()) {
+		return nil, fmt.Errorf("inflation rate must be between 0 and 1, is %s", inflationRate)
+	}
+
+	switch config.InflationCurve {
+	case types.InflationCurveFixed:
+		return types.FixedInflationCalculationFn(inflationRate), nil
+
+	case types.InflationCurveHalving:
+		if config.HalvingInterval == 0 {
+			return nil, errors.New("halving interval must be positive")
+		}
+		return types.HalvingInflationCalculationFn(env.HeaderService, inflationRate, config.HalvingInterval), nil
+
+	case types.InflationCurveDecay:
+		decayRate, err := math.LegacyNewDecFromStr(config.DecayRate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid decay rate %q: %w", config.DecayRate, err)
+		}
+		if decayRate.IsNegative() || decayRate.GT(math.LegacyOneDec()) {
+			return nil, fmt.Errorf("decay rate must be between 0 and 1, is %s", decayRate)
+		}
+		return types.DecayInflationCalculationFn(env.HeaderService, inflationRate, decayRate), nil
+
+	default:
+		return nil, fmt.Errorf("unknown inflation curve %q", config.InflationCurve)
+	}
+}
@@ -0,0 +1,40 @@
+package mint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	modulev1 "cosmossdk.io/api/cosmos/mint/module/v1"
+	"cosmossdk.io/core/appmodule"
+)
+
+func TestInflationCalculationFnFromConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *modulev1.Module
+		expErr string
+	}{
+		{"dynamic", &modulev1.Module{InflationCurve: "dynamic"}, ""},
+		{"fixed", &modulev1.Module{InflationCurve: "fixed", InflationRate: "0.05"}, ""},
+		{"fixed without rate", &modulev1.Module{InflationCurve: "fixed"}, "invalid inflation rate"},
+		{"fixed with rate above one", &modulev1.Module{InflationCurve: "fixed", InflationRate: "1.5"}, "inflation rate must be between 0 and 1"},
+		{"halving", &modulev1.Module{InflationCurve: "halving", InflationRate: "0.1", HalvingInterval: 1000}, ""},
+		{"halving without interval", &modulev1.Module{InflationCurve: "halving", InflationRate: "0.1"}, "halving interval must be positive"},
+		{"decay", &modulev1.Module{InflationCurve: "decay", InflationRate: "0.1", DecayRate: "0.2"}, ""},
+		{"decay with negative rate", &modulev1.Module{InflationCurve: "decay", InflationRate: "0.1", DecayRate: "-0.2"}, "decay rate must be between 0 and 1"},
+		{"unknown", &modulev1.Module{InflationCurve: "linear", InflationRate: "0.1"}, "unknown inflation curve"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ic, err := inflationCalculationFnFromConfig(tc.config, appmodule.Environment{})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, ic)
+		})
+	}
+}
@@ -11,15 +11,14 @@ import (
 // BeginBlock. It receives the minter and params stored in the keeper, along with the current
 // bondedRatio and returns the newly calculated inflation rate.
 // It can be used to specify a custom inflation calculation logic, instead of relying on the
-// default logic provided by the sdk.
-// Deprecated: use MintFn instead.
+// default logic provided by the sdk, either by providing it through depinject or by selecting
+// one of the built-in inflation curves with the module config.
 type InflationCalculationFn func(ctx context.Context, minter Minter, params Params, bondedRatio math.LegacyDec) math.LegacyDec
 
 // MintFn defines the function that needs to be implemented in order to customize the minting process.
 type MintFn func(ctx context.Context, env appmodule.Environment, minter *Minter, epochId string, epochNumber int64) error
 
 // DefaultInflationCalculationFn is the default function used to calculate inflation.
-// Deprecated: use DefaultMintFn instead.
 func DefaultInflationCalculationFn(_ context.Context, minter Minter, params Params, bondedRatio math.LegacyDec) math.LegacyDec {
 	return minter.NextInflationRate(params, bondedRatio)
 }
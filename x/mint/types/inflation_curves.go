@@ -0,0 +1,56 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+)
+
+// Built-in inflation curves which can be selected with the module config.
+const (
+	InflationCurveDynamic = "dynamic"
+	InflationCurveFixed   = "fixed"
+	InflationCurveHalving = "halving"
+	InflationCurveDecay   = "decay"
+)
+
+// FixedInflationCalculationFn returns an InflationCalculationFn keeping the
+// inflation rate constant.
+func FixedInflationCalculationFn(inflation math.LegacyDec) InflationCalculationFn {
+	return func(_ context.Context, _ Minter, _ Params, _ math.LegacyDec) math.LegacyDec {
+		return inflation
+	}
+}
+
+// HalvingInflationCalculationFn returns an InflationCalculationFn halving the
+// initial inflation rate every halvingInterval blocks. The inflation rate never
+// goes below the InflationMin parameter.
+func HalvingInflationCalculationFn(headerService header.Service, initialInflation math.LegacyDec, halvingInterval uint64) InflationCalculationFn {
+	return func(ctx context.Context, _ Minter, params Params, _ math.LegacyDec) math.LegacyDec {
+		height := headerService.HeaderInfo(ctx).Height
+		halvings := uint64(height) / halvingInterval
+
+		inflation := initialInflation
+		for i := uint64(0); i < halvings && inflation.GT(params.InflationMin); i++ {
+			inflation = inflation.QuoInt64(2)
+		}
+
+		return math.LegacyMaxDec(inflation, params.InflationMin)
+	}
+}
+
+// DecayInflationCalculationFn returns an InflationCalculationFn reducing the
+// initial inflation rate by decayRate every year, as defined by the
+// BlocksPerYear parameter. The inflation rate never goes below the
+// InflationMin parameter.
+func DecayInflationCalculationFn(headerService header.Service, initialInflation, decayRate math.LegacyDec) InflationCalculationFn {
+	return func(ctx context.Context, _ Minter, params Params, _ math.LegacyDec) math.LegacyDec {
+		height := headerService.HeaderInfo(ctx).Height
+		years := uint64(height) / params.BlocksPerYear
+
+		inflation := initialInflation.Mul(math.LegacyOneDec().Sub(decayRate).Power(years))
+
+		return math.LegacyMaxDec(inflation, params.InflationMin)
+	}
+}
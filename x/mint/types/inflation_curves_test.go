@@ -0,0 +1,82 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+)
+
+// heightService is a header service returning a fixed height.
+type heightService struct {
+	height int64
+}
+
+func (s *heightService) HeaderInfo(context.Context) header.Info {
+	return header.Info{Height: s.height}
+}
+
+func TestFixedInflationCalculationFn(t *testing.T) {
+	rate := math.LegacyNewDecWithPrec(5, 2)
+	ic := FixedInflationCalculationFn(rate)
+
+	for _, bondedRatio := range []math.LegacyDec{math.LegacyZeroDec(), math.LegacyNewDecWithPrec(5, 1), math.LegacyOneDec()} {
+		require.Equal(t, rate, ic(context.Background(), DefaultInitialMinter(), DefaultParams(), bondedRatio))
+	}
+}
+
+func TestHalvingInflationCalculationFn(t *testing.T) {
+	params := DefaultParams()
+	params.InflationMin = math.LegacyNewDecWithPrec(2, 2)
+
+	hs := &heightService{}
+	ic := HalvingInflationCalculationFn(hs, math.LegacyNewDecWithPrec(16, 2), 100)
+
+	tests := []struct {
+		height       int64
+		expInflation math.LegacyDec
+	}{
+		{0, math.LegacyNewDecWithPrec(16, 2)},
+		{99, math.LegacyNewDecWithPrec(16, 2)},
+		{100, math.LegacyNewDecWithPrec(8, 2)},
+		{250, math.LegacyNewDecWithPrec(4, 2)},
+		{300, math.LegacyNewDecWithPrec(2, 2)},
+		// the inflation never goes below the minimum
+		{400, math.LegacyNewDecWithPrec(2, 2)},
+		{1_000_000, math.LegacyNewDecWithPrec(2, 2)},
+	}
+
+	for _, tc := range tests {
+		hs.height = tc.height
+		require.Equal(t, tc.expInflation, ic(context.Background(), DefaultInitialMinter(), params, math.LegacyZeroDec()), "height %d", tc.height)
+	}
+}
+
+func TestDecayInflationCalculationFn(t *testing.T) {
+	params := DefaultParams()
+	params.BlocksPerYear = 1000
+	params.InflationMin = math.LegacyNewDecWithPrec(5, 2)
+
+	hs := &heightService{}
+	ic := DecayInflationCalculationFn(hs, math.LegacyNewDecWithPrec(10, 2), math.LegacyNewDecWithPrec(20, 2))
+
+	tests := []struct {
+		height       int64
+		expInflation math.LegacyDec
+	}{
+		{0, math.LegacyNewDecWithPrec(10, 2)},
+		{999, math.LegacyNewDecWithPrec(10, 2)},
+		{1000, math.LegacyNewDecWithPrec(8, 2)},
+		{2000, math.LegacyNewDecWithPrec(64, 3)},
+		// the inflation never goes below the minimum
+		{4000, math.LegacyNewDecWithPrec(5, 2)},
+	}
+
+	for _, tc := range tests {
+		hs.height = tc.height
+		require.Equal(t, tc.expInflation, ic(context.Background(), DefaultInitialMinter(), params, math.LegacyZeroDec()), "height %d", tc.height)
+	}
+}
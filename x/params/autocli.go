@@ -25,6 +25,33 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "subspaces",
 					Short:     "Query for all registered subspaces and all keys for a subspace",
 				},
+				{
+					RpcMethod: "Schemas",
+					Use:       "schemas [subspace]",
+					Short:     "Query for the schema and raw value of all parameters, optionally restricted to a single subspace",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "subspace", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "ParamsMigrations",
+					Use:       "migrate-params [authority] [subspaces...]",
+					Short:     "Generate the MsgUpdateParams messages migrating legacy subspaces to module managed parameters",
+					Long: "Generate the MsgUpdateParams messages setting the parameters stored in legacy subspaces as the parameters of the modules which now manage them. " +
+						"The authority is usually the address of the governance module account. The output can be used as the messages of a governance proposal.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "authority"},
+						{ProtoField: "subspaces", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "RegisteredKeys",
+					Use:       "registered-keys [subspace]",
+					Short:     "Query for the keys which can be updated by parameter change proposals, optionally restricted to a single subspace",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "subspace", Optional: true},
+					},
+				},
 			},
 		},
 	}
@@ -2,13 +2,16 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/errors"
+	"cosmossdk.io/x/params/types"
 	"cosmossdk.io/x/params/types/proposal"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -46,7 +49,11 @@ func (k Keeper) Subspaces(
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
 	}
 
-	spaces := k.GetSubspaces()
+	spaces, err := k.selectSubspaces("")
+	if err != nil {
+		return nil, err
+	}
+
 	resp := &proposal.QuerySubspacesResponse{
 		Subspaces: make([]*proposal.Subspace, len(spaces)),
 	}
@@ -67,3 +74,126 @@ func (k Keeper) Subspaces(
 
 	return resp, nil
 }
+
+// Schemas implements the gRPC query handler for fetching the schema and the
+// current raw value of every parameter of the registered subspaces.
+func (k Keeper) Schemas(
+	goCtx context.Context,
+	req *proposal.QuerySchemasRequest,
+) (*proposal.QuerySchemasResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	spaces, err := k.selectSubspaces(req.Subspace)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &proposal.QuerySchemasResponse{
+		Subspaces: make([]*proposal.SubspaceSchema, len(spaces)),
+	}
+	for i, ss := range spaces {
+		schemas := ss.Schema(ctx)
+		params := make([]*proposal.ParamSchema, len(schemas))
+		for j, schema := range schemas {
+			params[j] = &proposal.ParamSchema{
+				Key:       schema.Key,
+				ValueType: schema.Type,
+				Value:     string(schema.Value),
+			}
+			if schema.Range != nil {
+				params[j].Min = schema.Range.Min
+				params[j].Max = schema.Range.Max
+			}
+		}
+
+		resp.Subspaces[i] = &proposal.SubspaceSchema{
+			Subspace: ss.Name(),
+			Params:   params,
+		}
+	}
+
+	return resp, nil
+}
+
+// RegisteredKeys implements the gRPC query handler for fetching the keys
+// registered in the KeyTable of each registered subspace.
+func (k Keeper) RegisteredKeys(
+	_ context.Context,
+	req *proposal.QueryRegisteredKeysRequest,
+) (*proposal.QueryRegisteredKeysResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	spaces, err := k.selectSubspaces(req.Subspace)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proposal.QueryRegisteredKeysResponse{
+		Subspaces: make([]*proposal.Subspace, len(spaces)),
+	}
+	for i, ss := range spaces {
+		resp.Subspaces[i] = &proposal.Subspace{
+			Subspace: ss.Name(),
+			Keys:     ss.RegisteredKeys(),
+		}
+	}
+
+	return resp, nil
+}
+
+// ParamsMigrations implements the gRPC query handler for generating the
+// MsgUpdateParams messages migrating the legacy subspaces with a registered
+// migration.
+func (k Keeper) ParamsMigrations(
+	goCtx context.Context,
+	req *proposal.QueryParamsMigrationsRequest,
+) (*proposal.QueryParamsMigrationsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Authority == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty authority")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	msgs, err := k.GenerateMsgUpdateParams(ctx, req.Authority, req.Subspaces...)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	resp := &proposal.QueryParamsMigrationsResponse{
+		Messages: make([]*codectypes.Any, len(msgs)),
+	}
+	for i, msg := range msgs {
+		resp.Messages[i], err = codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%s", err)
+		}
+	}
+
+	return resp, nil
+}
+
+// selectSubspaces returns the registered subspace with the given name, or all
+// the registered subspaces sorted by name if name is empty.
+func (k Keeper) selectSubspaces(name string) ([]types.Subspace, error) {
+	if name != "" {
+		ss, ok := k.GetSubspace(name)
+		if !ok {
+			return nil, errors.Wrap(proposal.ErrUnknownSubspace, name)
+		}
+
+		return []types.Subspace{ss}, nil
+	}
+
+	spaces := k.GetSubspaces()
+	sort.Slice(spaces, func(i, j int) bool { return spaces[i].Name() < spaces[j].Name() })
+
+	return spaces, nil
+}
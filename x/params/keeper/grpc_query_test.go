@@ -5,6 +5,8 @@ import (
 
 	"cosmossdk.io/x/params/types"
 	"cosmossdk.io/x/params/types/proposal"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (suite *KeeperTestSuite) TestGRPCQueryParams() {
@@ -101,3 +103,107 @@ func (suite *KeeperTestSuite) TestGRPCQuerySubspaces() {
 	suite.Require().Contains(spaces, "bank")
 	suite.Require().Contains(spaces, "staking")
 }
+
+func (suite *KeeperTestSuite) TestGRPCQuerySchemas() {
+	key := []byte("key")
+	table := types.NewKeyTable(types.NewParamSetPair(key, uint64(0), validateNoOp)).
+		RegisterRange(key, types.NewParamRange("1", "100"))
+	space := suite.paramsKeeper.Subspace("schema").WithKeyTable(table)
+	space.Set(suite.ctx, key, uint64(10))
+
+	_, err := suite.queryClient.Schemas(suite.ctx, &proposal.QuerySchemasRequest{Subspace: "unknown"})
+	suite.Require().ErrorContains(err, "unknown subspace")
+
+	resp, err := suite.queryClient.Schemas(suite.ctx, &proposal.QuerySchemasRequest{Subspace: "schema"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*proposal.SubspaceSchema{
+		{
+			Subspace: "schema",
+			Params: []*proposal.ParamSchema{
+				{Key: "key", ValueType: "uint64", Min: "1", Max: "100", Value: `"10"`},
+			},
+		},
+	}, resp.Subspaces)
+
+	resp, err = suite.queryClient.Schemas(suite.ctx, &proposal.QuerySchemasRequest{})
+	suite.Require().NoError(err)
+
+	spaces := make([]string, len(resp.Subspaces))
+	for i, ss := range resp.Subspaces {
+		spaces[i] = ss.Subspace
+	}
+	suite.Require().Equal([]string{"bank", "schema", "staking"}, spaces)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryRegisteredKeys() {
+	table := types.NewKeyTable(
+		types.NewParamSetPair([]byte("key2"), uint64(0), validateNoOp),
+		types.NewParamSetPair([]byte("key1"), "", validateNoOp),
+	)
+	suite.paramsKeeper.Subspace("registered").WithKeyTable(table)
+
+	_, err := suite.queryClient.RegisteredKeys(suite.ctx, &proposal.QueryRegisteredKeysRequest{Subspace: "unknown"})
+	suite.Require().ErrorContains(err, "unknown subspace")
+
+	resp, err := suite.queryClient.RegisteredKeys(suite.ctx, &proposal.QueryRegisteredKeysRequest{Subspace: "registered"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*proposal.Subspace{
+		{Subspace: "registered", Keys: []string{"key1", "key2"}},
+	}, resp.Subspaces)
+
+	resp, err = suite.queryClient.RegisteredKeys(suite.ctx, &proposal.QueryRegisteredKeysRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*proposal.Subspace{
+		{Subspace: "bank"},
+		{Subspace: "registered", Keys: []string{"key1", "key2"}},
+		{Subspace: "staking"},
+	}, resp.Subspaces)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryParamsMigrations() {
+	table := types.NewKeyTable(
+		types.NewParamSetPair([]byte("Param1"), int64(0), validateNoOp),
+		types.NewParamSetPair([]byte("Param2"), "", validateNoOp),
+	)
+	space := suite.paramsKeeper.Subspace("legacy").WithKeyTable(table)
+	space.Set(suite.ctx, []byte("Param1"), int64(42))
+	space.Set(suite.ctx, []byte("Param2"), "hello")
+
+	// ParamChange stands in for the MsgUpdateParams of a module
+	newMsg := func(authority string, p migratedParams) sdk.Msg {
+		return &proposal.ParamChange{Subspace: authority, Key: p.Label, Value: fmt.Sprint(p.Param1)}
+	}
+	suite.paramsKeeper.RegisterParamsMigration(types.NewParamsMigration("legacy", newMsg, types.NewLegacyParamMapping([]byte("Param2"), "Label")))
+	suite.paramsKeeper.RegisterParamsMigration(types.NewParamsMigration("bank", newMsg))
+	suite.paramsKeeper.RegisterParamsMigration(types.NewParamsMigration("unknown", newMsg))
+	suite.Require().Panics(func() {
+		suite.paramsKeeper.RegisterParamsMigration(types.NewParamsMigration("legacy", newMsg))
+	})
+
+	_, err := suite.queryClient.ParamsMigrations(suite.ctx, &proposal.QueryParamsMigrationsRequest{})
+	suite.Require().ErrorContains(err, "empty authority")
+
+	// subspaces which aren't registered can't be migrated
+	_, err = suite.queryClient.ParamsMigrations(suite.ctx, &proposal.QueryParamsMigrationsRequest{Authority: "gov"})
+	suite.Require().ErrorContains(err, "unknown subspace")
+
+	_, err = suite.queryClient.ParamsMigrations(suite.ctx, &proposal.QueryParamsMigrationsRequest{Authority: "gov", Subspaces: []string{"staking"}})
+	suite.Require().ErrorContains(err, "no migration registered for subspace staking")
+
+	_, err = suite.queryClient.ParamsMigrations(suite.ctx, &proposal.QueryParamsMigrationsRequest{Authority: "gov", Subspaces: []string{"bank"}})
+	suite.Require().ErrorContains(err, "no legacy parameters stored in subspace bank")
+
+	resp, err := suite.queryClient.ParamsMigrations(suite.ctx, &proposal.QueryParamsMigrationsRequest{Authority: "gov", Subspaces: []string{"legacy"}})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Messages, 1)
+
+	var msg proposal.ParamChange
+	suite.Require().NoError(msg.Unmarshal(resp.Messages[0].Value))
+	suite.Require().Equal(proposal.ParamChange{Subspace: "gov", Key: "hello", Value: "42"}, msg)
+
+	// when scanning all the subspaces, the ones without parameters are skipped
+	suite.paramsKeeper.Subspace("unknown")
+	msgs, err := suite.paramsKeeper.GenerateMsgUpdateParams(suite.ctx, "gov")
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.Msg{&proposal.ParamChange{Subspace: "gov", Key: "hello", Value: "42"}}, msgs)
+}
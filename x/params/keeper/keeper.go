@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/params/types"
@@ -17,6 +21,7 @@ type Keeper struct {
 	key         storetypes.StoreKey
 	tkey        storetypes.StoreKey
 	spaces      map[string]*types.Subspace
+	migrations  map[string]types.ParamsMigration
 }
 
 // NewKeeper constructs a params keeper
@@ -27,6 +32,7 @@ func NewKeeper(cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey
 		key:         key,
 		tkey:        tkey,
 		spaces:      make(map[string]*types.Subspace),
+		migrations:  make(map[string]types.ParamsMigration),
 	}
 }
 
@@ -72,3 +78,89 @@ func (k Keeper) GetSubspaces() []types.Subspace {
 
 	return spaces
 }
+
+// ValidateParamChanges checks that each change targets a registered subspace
+// and a key registered in its KeyTable, and that its raw value decodes to a
+// valid value of the registered type, without applying any of the changes.
+func (k Keeper) ValidateParamChanges(ctx sdk.Context, changes []proposal.ParamChange) error {
+	for _, c := range changes {
+		ss, ok := k.GetSubspace(c.Subspace)
+		if !ok {
+			return errorsmod.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
+		}
+
+		if !slices.Contains(ss.RegisteredKeys(), c.Key) {
+			return errorsmod.Wrapf(proposal.ErrUnknownKey, "subspace: %s, key: %s", c.Subspace, c.Key)
+		}
+
+		if _, err := ss.DecodeUpdate(ctx, []byte(c.Key), []byte(c.Value)); err != nil {
+			return errorsmod.Wrapf(proposal.ErrInvalidValue, "subspace: %s, key: %s, value: %s, err: %s", c.Subspace, c.Key, c.Value, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// RegisterParamsMigration registers the migration of a legacy subspace to a
+// module which manages its own parameters, which is used by
+// GenerateMsgUpdateParams.
+func (k Keeper) RegisterParamsMigration(migration types.ParamsMigration) {
+	if migration.Subspace == "" {
+		panic("cannot use empty string for subspace")
+	}
+
+	if _, ok := k.migrations[migration.Subspace]; ok {
+		panic(fmt.Sprintf("migration of subspace %s already registered", migration.Subspace))
+	}
+
+	k.migrations[migration.Subspace] = migration
+}
+
+// GenerateMsgUpdateParams scans the legacy subspaces with a registered
+// migration and returns, sorted by subspace name, the MsgUpdateParams setting
+// their stored parameters as the parameters of the modules which now manage
+// them, with the given authority. If no subspace is given, the subspaces
+// without stored parameters are skipped. The messages are typically submitted
+// in a governance proposal to migrate chains off x/params.
+func (k Keeper) GenerateMsgUpdateParams(ctx sdk.Context, authority string, subspaces ...string) ([]sdk.Msg, error) {
+	scanAll := len(subspaces) == 0
+	if scanAll {
+		for name := range k.migrations {
+			subspaces = append(subspaces, name)
+		}
+	}
+	slices.Sort(subspaces)
+
+	msgs := make([]sdk.Msg, 0, len(subspaces))
+	for _, name := range subspaces {
+		migration, ok := k.migrations[name]
+		if !ok {
+			return nil, fmt.Errorf("no migration registered for subspace %s", name)
+		}
+
+		ss, ok := k.GetSubspace(name)
+		if !ok {
+			return nil, errorsmod.Wrap(proposal.ErrUnknownSubspace, name)
+		}
+
+		empty := true
+		ss.IterateKeys(ctx, func([]byte) bool {
+			empty = false
+			return true
+		})
+		if empty {
+			if scanAll {
+				continue
+			}
+			return nil, fmt.Errorf("no legacy parameters stored in subspace %s", name)
+		}
+
+		msg, err := migration.MsgUpdateParams(ctx, ss, authority)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
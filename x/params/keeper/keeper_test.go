@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
 	"reflect"
 	"testing"
 
@@ -289,3 +291,95 @@ func TestJSONUpdate(t *testing.T) {
 	space.Get(ctx, key, &param)
 	require.Equal(t, paramJSON{40964096, "goodbyeworld"}, param)
 }
+
+type migratedParams struct {
+	Param1 uint64
+	Label  string
+	Dec    math.LegacyDec
+}
+
+func TestMigrateLegacyParams(t *testing.T) {
+	_, ctx, _, _, k := testComponents()
+
+	space := k.Subspace("legacy").WithKeyTable(types.NewKeyTable(
+		types.NewParamSetPair([]byte("Param1"), int64(0), validateNoOp),
+		types.NewParamSetPair([]byte("Param2"), "", validateNoOp),
+		types.NewParamSetPair([]byte("Dec"), math.LegacyDec{}, validateNoOp),
+	))
+	space.Set(ctx, []byte("Param1"), int64(42))
+	space.Set(ctx, []byte("Param2"), "hello")
+	space.Set(ctx, []byte("Dec"), math.LegacyNewDecWithPrec(5, 2))
+
+	var stored migratedParams
+	set := func(_ context.Context, p migratedParams) error {
+		stored = p
+		return nil
+	}
+
+	err := keeper.MigrateLegacyParams(ctx, k, "unknown", set)
+	require.ErrorContains(t, err, "not registered")
+
+	err = keeper.MigrateLegacyParams(ctx, k, "legacy", set, types.NewLegacyParamMapping([]byte("Param2"), "Label"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), stored.Param1)
+	require.Equal(t, "hello", stored.Label)
+	require.True(t, math.LegacyNewDecWithPrec(5, 2).Equal(stored.Dec))
+
+	space.Set(ctx, []byte("Param1"), int64(-1))
+	err = keeper.MigrateLegacyParams(ctx, k, "legacy", set, types.NewLegacyParamMapping([]byte("Param2"), "Label"))
+	require.ErrorContains(t, err, "negative value")
+}
+
+func (suite *KeeperTestSuite) TestValidateParamChanges() {
+	table := types.NewKeyTable(
+		types.NewParamSetPair([]byte("key"), uint64(0), func(i interface{}) error {
+			if i.(uint64) == 0 {
+				return errors.New("must be positive")
+			}
+			return nil
+		}),
+	)
+	space := suite.paramsKeeper.Subspace("validate").WithKeyTable(table)
+
+	testCases := []struct {
+		name    string
+		changes []proposal.ParamChange
+		expErr  error
+	}{
+		{"unknown subspace", []proposal.ParamChange{proposal.NewParamChange("unknown", "key", `"1"`)}, proposal.ErrUnknownSubspace},
+		{"unknown key", []proposal.ParamChange{proposal.NewParamChange("validate", "unknown", `"1"`)}, proposal.ErrUnknownKey},
+		{"bad encoding", []proposal.ParamChange{proposal.NewParamChange("validate", "key", `"a"`)}, proposal.ErrInvalidValue},
+		{"invalid value", []proposal.ParamChange{proposal.NewParamChange("validate", "key", `"0"`)}, proposal.ErrInvalidValue},
+		{
+			"invalid second change",
+			[]proposal.ParamChange{
+				proposal.NewParamChange("validate", "key", `"1"`),
+				proposal.NewParamChange("validate", "key", `"0"`),
+			},
+			proposal.ErrInvalidValue,
+		},
+		{"valid", []proposal.ParamChange{proposal.NewParamChange("validate", "key", `"1"`)}, nil},
+	}
+
+	handler := params.NewParamChangeProposalHandler(suite.paramsKeeper)
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := suite.paramsKeeper.ValidateParamChanges(suite.ctx, tc.changes)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			// the handler rejects invalid proposals without applying any change
+			cacheCtx, _ := suite.ctx.CacheContext()
+			err = handler(cacheCtx, proposal.NewParameterChangeProposal("title", "description", tc.changes))
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().False(space.Has(cacheCtx, []byte("key")))
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/x/params/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateLegacyParams reads the parameters stored in the legacy subspace with the
+// given name, converts them into a new P using the provided mappings and hands the
+// result to set, which is typically the Set method of the module's params
+// collections.Item. It is meant to be called from an upgrade handler, e.g.:
+//
+//	err := paramskeeper.MigrateLegacyParams(ctx, app.ParamsKeeper, minttypes.ModuleName, app.MintKeeper.Params.Set)
+//
+// See types.Subspace.MigrateTo for the conversion and validation rules.
+func MigrateLegacyParams[P any](ctx sdk.Context, k Keeper, subspace string, set func(context.Context, P) error, mappings ...types.LegacyParamMapping) error {
+	ss, ok := k.GetSubspace(subspace)
+	if !ok {
+		return fmt.Errorf("legacy subspace %s is not registered", subspace)
+	}
+
+	params := new(P)
+	if err := ss.MigrateTo(ctx, params, mappings...); err != nil {
+		return err
+	}
+
+	if err := set(ctx, *params); err != nil {
+		return fmt.Errorf("failed to store migrated parameters of subspace %s: %w", subspace, err)
+	}
+
+	k.Logger(ctx).Info("migrated legacy parameters", "subspace", subspace)
+	return nil
+}
@@ -31,6 +31,13 @@ func NewParamChangeProposalHandler(k keeper.Keeper) govtypes.Handler {
 }
 
 func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal.ParameterChangeProposal) error {
+	// validate all the changes before applying any, so that as the handler is
+	// also run when the proposal is submitted, invalid proposals are rejected
+	// at submission with a clear error
+	if err := k.ValidateParamChanges(ctx, p.Changes); err != nil {
+		return err
+	}
+
 	for _, c := range p.Changes {
 		ss, ok := k.GetSubspace(c.Subspace)
 		if !ok {
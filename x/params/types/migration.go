@@ -0,0 +1,309 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LegacyParamMapping maps a single legacy subspace parameter key onto a field
+// of a module managed params message.
+type LegacyParamMapping struct {
+	// Key is the legacy subspace parameter key.
+	Key []byte
+	// Field is the name of the target field, either as the Go struct field
+	// name or as the protobuf field name. If empty, the field whose name
+	// matches Key (ignoring case and underscores) is used.
+	Field string
+	// Convert optionally converts the decoded legacy value into a value
+	// assignable to the target field. If nil, a direct assignment or a
+	// lossless reflective conversion is attempted.
+	Convert func(legacy interface{}) (interface{}, error)
+	// Skip marks the legacy key as intentionally dropped by the migration.
+	Skip bool
+}
+
+// NewLegacyParamMapping creates a LegacyParamMapping for the given legacy key
+// and target field.
+func NewLegacyParamMapping(key []byte, field string) LegacyParamMapping {
+	return LegacyParamMapping{Key: key, Field: field}
+}
+
+// ParamsMigration describes how the parameters stored in a legacy subspace are
+// migrated to a module which manages its own parameters, so that the
+// MsgUpdateParams setting them can be generated, see NewParamsMigration.
+type ParamsMigration struct {
+	// Subspace is the name of the legacy subspace.
+	Subspace string
+	// Mappings are the mappings passed to Subspace.MigrateTo.
+	Mappings []LegacyParamMapping
+
+	newParams func() interface{}
+	newMsg    func(authority string, params interface{}) sdk.Msg
+}
+
+// NewParamsMigration creates a ParamsMigration for the legacy subspace with the
+// given name to the params P of a module, where newMsg returns the module's
+// MsgUpdateParams for the given authority and params, e.g.:
+//
+//	types.NewParamsMigration(minttypes.ModuleName, func(authority string, params minttypes.Params) sdk.Msg {
+//		return &minttypes.MsgUpdateParams{Authority: authority, Params: params}
+//	})
+func NewParamsMigration[P any](subspace string, newMsg func(authority string, params P) sdk.Msg, mappings ...LegacyParamMapping) ParamsMigration {
+	return ParamsMigration{
+		Subspace: subspace,
+		Mappings: mappings,
+		newParams: func() interface{} {
+			return new(P)
+		},
+		newMsg: func(authority string, params interface{}) sdk.Msg {
+			return newMsg(authority, *params.(*P))
+		},
+	}
+}
+
+// MsgUpdateParams converts the parameters stored in the Subspace with
+// MigrateTo and returns the MsgUpdateParams of the migration setting them with
+// the given authority.
+func (m ParamsMigration) MsgUpdateParams(ctx sdk.Context, s Subspace, authority string) (sdk.Msg, error) {
+	if m.newParams == nil || m.newMsg == nil {
+		return nil, fmt.Errorf("migration of subspace %s must be created with NewParamsMigration", m.Subspace)
+	}
+
+	params := m.newParams()
+	if err := s.MigrateTo(ctx, params, m.Mappings...); err != nil {
+		return nil, err
+	}
+
+	return m.newMsg(authority, params), nil
+}
+
+// MigrateTo copies every parameter stored in the Subspace into target, which
+// must be a pointer to a struct such as a gogoproto generated params message.
+//
+// Each stored key is decoded using the type registered in the Subspace's
+// KeyTable and validated with the registered validation function before being
+// converted into the type of the matching target field. Keys that are not
+// registered in the KeyTable are decoded straight into the target field type.
+// Keys which are neither mapped nor matched to a target field cause an error,
+// so that no legacy parameter gets silently lost. If target implements
+// Validate() error, it is called once all fields have been set.
+func (s Subspace) MigrateTo(ctx sdk.Context, target interface{}, mappings ...LegacyParamMapping) error {
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("migration target must be a non-nil pointer to a struct, got %T", target)
+	}
+	dst := rv.Elem()
+
+	byKey := make(map[string]LegacyParamMapping, len(mappings))
+	for _, m := range mappings {
+		if len(m.Key) == 0 {
+			return fmt.Errorf("cannot migrate parameter with an empty key in subspace %s", s.Name())
+		}
+		if _, ok := byKey[string(m.Key)]; ok {
+			return fmt.Errorf("duplicate mapping for parameter %s in subspace %s", m.Key, s.Name())
+		}
+		byKey[string(m.Key)] = m
+	}
+
+	var keys []string
+	s.IterateKeys(ctx, func(key []byte) bool {
+		keys = append(keys, string(key))
+		return false
+	})
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		mapping, ok := byKey[key]
+		if !ok {
+			mapping = LegacyParamMapping{Key: []byte(key)}
+		}
+		if mapping.Skip {
+			continue
+		}
+
+		fieldName := mapping.Field
+		if fieldName == "" {
+			fieldName = key
+		}
+		field, ok := findField(dst, fieldName)
+		if !ok {
+			return fmt.Errorf("no field %s on %T for legacy parameter %s in subspace %s", fieldName, target, key, s.Name())
+		}
+
+		value, err := s.legacyValue(ctx, []byte(key), field.Type())
+		if err != nil {
+			return err
+		}
+
+		if mapping.Convert != nil {
+			converted, err := mapping.Convert(value.Interface())
+			if err != nil {
+				return fmt.Errorf("failed to convert legacy parameter %s in subspace %s: %w", key, s.Name(), err)
+			}
+			value = reflect.ValueOf(converted)
+		}
+
+		if err := assignValue(field, value); err != nil {
+			return fmt.Errorf("legacy parameter %s in subspace %s: %w", key, s.Name(), err)
+		}
+	}
+
+	if v, ok := target.(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("migrated parameters of subspace %s are invalid: %w", s.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+// legacyValue decodes the raw value stored under key. If the key is registered
+// in the KeyTable, the registered type is used and the value is validated;
+// otherwise the value is decoded into fallback.
+func (s Subspace) legacyValue(ctx sdk.Context, key []byte, fallback reflect.Type) (reflect.Value, error) {
+	bz := s.GetRaw(ctx, key)
+
+	attr, ok := s.table.m[string(key)]
+	if !ok {
+		ptr := reflect.New(fallback)
+		if err := s.legacyAmino.UnmarshalJSON(bz, ptr.Interface()); err != nil {
+			return reflect.Value{}, fmt.Errorf("failed to decode legacy parameter %s in subspace %s: %w", key, s.Name(), err)
+		}
+		return ptr.Elem(), nil
+	}
+
+	ptr := reflect.New(attr.ty)
+	if err := s.legacyAmino.UnmarshalJSON(bz, ptr.Interface()); err != nil {
+		return reflect.Value{}, fmt.Errorf("failed to decode legacy parameter %s in subspace %s: %w", key, s.Name(), err)
+	}
+
+	if err := attr.vfn(ptr.Elem().Interface()); err != nil {
+		return reflect.Value{}, fmt.Errorf("invalid legacy parameter %s in subspace %s: %w", key, s.Name(), err)
+	}
+
+	return ptr.Elem(), nil
+}
+
+// findField looks up a struct field either by its Go name or by the name set
+// in its protobuf struct tag. Names are compared ignoring case and underscores.
+func findField(v reflect.Value, name string) (reflect.Value, bool) {
+	want := normalizeFieldName(name)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		if normalizeFieldName(sf.Name) == want || normalizeFieldName(protoFieldName(sf)) == want {
+			return v.Field(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// protoFieldName returns the field name declared in a gogoproto struct tag,
+// e.g. `protobuf:"varint,1,opt,name=max_memo_characters,..."`.
+func protoFieldName(sf reflect.StructField) string {
+	for _, part := range strings.Split(sf.Tag.Get("protobuf"), ",") {
+		if name, ok := strings.CutPrefix(part, "name="); ok {
+			return name
+		}
+	}
+
+	return ""
+}
+
+func normalizeFieldName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// assignValue sets src into dst, allocating pointers and performing lossless
+// conversions between numeric, string and boolean kinds where required.
+func assignValue(dst, src reflect.Value) error {
+	if !src.IsValid() {
+		return fmt.Errorf("cannot assign nil value to field of type %s", dst.Type())
+	}
+
+	if src.Type().AssignableTo(dst.Type()) {
+		dst.Set(src)
+		return nil
+	}
+
+	if dst.Kind() == reflect.Ptr {
+		elem := reflect.New(dst.Type().Elem())
+		if err := assignValue(elem.Elem(), src); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+	}
+
+	if src.Kind() == reflect.Ptr {
+		if src.IsNil() {
+			return nil
+		}
+		return assignValue(dst, src.Elem())
+	}
+
+	if !src.Type().ConvertibleTo(dst.Type()) || !compatibleKinds(src.Kind(), dst.Kind()) {
+		return fmt.Errorf("cannot convert value of type %s to %s", src.Type(), dst.Type())
+	}
+
+	if isSignedKind(src.Kind()) && isUnsignedKind(dst.Kind()) && src.Int() < 0 {
+		return fmt.Errorf("negative value %d cannot be converted to %s", src.Int(), dst.Type())
+	}
+
+	converted := src.Convert(dst.Type())
+	if isUnsignedKind(src.Kind()) && isSignedKind(dst.Kind()) && converted.Int() < 0 {
+		return fmt.Errorf("value %d of type %s overflows %s", src.Uint(), src.Type(), dst.Type())
+	}
+	if !reflect.DeepEqual(converted.Convert(src.Type()).Interface(), src.Interface()) {
+		return fmt.Errorf("value %v of type %s overflows %s", src.Interface(), src.Type(), dst.Type())
+	}
+
+	dst.Set(converted)
+	return nil
+}
+
+func compatibleKinds(src, dst reflect.Kind) bool {
+	switch {
+	case isNumericKind(src) && isNumericKind(dst):
+		return true
+	case src == reflect.String && dst == reflect.String:
+		return true
+	case src == reflect.Bool && dst == reflect.Bool:
+		return true
+	case src == reflect.Slice && dst == reflect.Slice:
+		return true
+	default:
+		return false
+	}
+}
+
+func isNumericKind(k reflect.Kind) bool {
+	return isSignedKind(k) || isUnsignedKind(k) || k == reflect.Float32 || k == reflect.Float64
+}
+
+func isSignedKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	default:
+		return false
+	}
+}
+
+func isUnsignedKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,132 @@
+package types_test
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/x/params/types"
+)
+
+type migratedParams struct {
+	UnbondingTime time.Duration `protobuf:"bytes,1,opt,name=unbonding_time,json=unbondingTime,proto3,stdduration"`
+	MaxValidators uint32        `protobuf:"varint,2,opt,name=max_validators,json=maxValidators,proto3"`
+	Denom         string        `protobuf:"bytes,3,opt,name=denom,proto3"`
+}
+
+func (p migratedParams) Validate() error {
+	if p.MaxValidators == 0 {
+		return errors.New("max validators must be positive")
+	}
+
+	return nil
+}
+
+type narrowParams struct {
+	MaxValidators uint8
+	BondDenom     string
+	UnbondingTime time.Duration
+}
+
+func (suite *SubspaceTestSuite) setLegacyParams(p params) {
+	suite.Require().NotPanics(func() {
+		suite.ss.SetParamSet(suite.ctx, &p)
+	})
+}
+
+func (suite *SubspaceTestSuite) TestMigrateTo() {
+	suite.setLegacyParams(params{UnbondingTime: time.Hour * 48, MaxValidators: 100, BondDenom: "stake"})
+
+	var res migratedParams
+	err := suite.ss.MigrateTo(suite.ctx, &res, types.NewLegacyParamMapping(keyBondDenom, "denom"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(migratedParams{UnbondingTime: time.Hour * 48, MaxValidators: 100, Denom: "stake"}, res)
+}
+
+func (suite *SubspaceTestSuite) TestMigrateToConvert() {
+	suite.setLegacyParams(params{UnbondingTime: time.Hour * 48, MaxValidators: 100, BondDenom: "stake"})
+
+	var res migratedParams
+	err := suite.ss.MigrateTo(suite.ctx, &res,
+		types.NewLegacyParamMapping(keyBondDenom, "Denom"),
+		types.LegacyParamMapping{
+			Key: keyMaxValidators,
+			Convert: func(legacy interface{}) (interface{}, error) {
+				return uint32(legacy.(uint16)) * 2, nil
+			},
+		},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint32(200), res.MaxValidators)
+}
+
+func (suite *SubspaceTestSuite) TestMigrateToErrors() {
+	suite.setLegacyParams(params{UnbondingTime: time.Hour * 48, MaxValidators: 300, BondDenom: "stake"})
+
+	testCases := []struct {
+		name     string
+		target   interface{}
+		mappings []types.LegacyParamMapping
+		expErr   string
+	}{
+		{
+			name:   "non pointer target",
+			target: migratedParams{},
+			expErr: "must be a non-nil pointer to a struct",
+		},
+		{
+			name:   "unmapped legacy key",
+			target: &migratedParams{},
+			expErr: "no field BondDenom",
+		},
+		{
+			name:   "overflowing conversion",
+			target: &narrowParams{},
+			expErr: "overflows uint8",
+		},
+		{
+			name:   "duplicate mapping",
+			target: &migratedParams{},
+			mappings: []types.LegacyParamMapping{
+				types.NewLegacyParamMapping(keyBondDenom, "denom"),
+				types.NewLegacyParamMapping(keyBondDenom, "denom"),
+			},
+			expErr: "duplicate mapping",
+		},
+		{
+			name:   "failing conversion",
+			target: &migratedParams{},
+			mappings: []types.LegacyParamMapping{
+				types.NewLegacyParamMapping(keyBondDenom, "denom"),
+				{Key: keyMaxValidators, Convert: func(interface{}) (interface{}, error) { return nil, errors.New("boom") }},
+			},
+			expErr: "boom",
+		},
+		{
+			name:   "invalid result",
+			target: &migratedParams{},
+			mappings: []types.LegacyParamMapping{
+				types.NewLegacyParamMapping(keyBondDenom, "denom"),
+				{Key: keyMaxValidators, Convert: func(interface{}) (interface{}, error) { return uint32(0), nil }},
+			},
+			expErr: "max validators must be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := suite.ss.MigrateTo(suite.ctx, tc.target, tc.mappings...)
+			suite.Require().ErrorContains(err, tc.expErr)
+		})
+	}
+}
+
+func (suite *SubspaceTestSuite) TestMigrateToSkip() {
+	suite.setLegacyParams(params{UnbondingTime: time.Hour * 48, MaxValidators: 100, BondDenom: "stake"})
+
+	var res migratedParams
+	err := suite.ss.MigrateTo(suite.ctx, &res, types.LegacyParamMapping{Key: keyBondDenom, Skip: true})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Denom)
+	suite.Require().Equal(uint32(100), res.MaxValidators)
+}
@@ -3,6 +3,10 @@ package types
 type (
 	ValueValidatorFn func(value interface{}) error
 
+	// ValueDecoderFn decodes the raw value of a parameter change into a value of
+	// the type registered for the parameter key.
+	ValueDecoderFn func(value []byte) (interface{}, error)
+
 	// ParamSetPair is used for associating paramsubspace key and field of param
 	// structs.
 	ParamSetPair struct {
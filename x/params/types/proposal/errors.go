@@ -10,4 +10,6 @@ var (
 	ErrEmptySubspace    = errors.Register(ModuleName, 5, "parameter subspace is empty")
 	ErrEmptyKey         = errors.Register(ModuleName, 6, "parameter key is empty")
 	ErrEmptyValue       = errors.Register(ModuleName, 7, "parameter value is empty")
+	ErrUnknownKey       = errors.Register(ModuleName, 8, "unknown parameter key")
+	ErrInvalidValue     = errors.Register(ModuleName, 9, "invalid parameter value")
 )
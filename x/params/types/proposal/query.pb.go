@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	any "github.com/cosmos/gogoproto/types/any"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -270,47 +271,510 @@ func (m *Subspace) GetKeys() []string {
 	return nil
 }
 
+// QuerySchemasRequest defines a request type for querying the parameter
+// schemas of the registered subspaces.
+type QuerySchemasRequest struct {
+	// subspace optionally restricts the response to a single subspace.
+	Subspace string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+}
+
+func (m *QuerySchemasRequest) Reset()         { *m = QuerySchemasRequest{} }
+func (m *QuerySchemasRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySchemasRequest) ProtoMessage()    {}
+func (*QuerySchemasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{5}
+}
+func (m *QuerySchemasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySchemasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySchemasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySchemasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySchemasRequest.Merge(m, src)
+}
+func (m *QuerySchemasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySchemasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySchemasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySchemasRequest proto.InternalMessageInfo
+
+func (m *QuerySchemasRequest) GetSubspace() string {
+	if m != nil {
+		return m.Subspace
+	}
+	return ""
+}
+
+// QuerySchemasResponse defines the response type for querying the parameter
+// schemas of the registered subspaces.
+type QuerySchemasResponse struct {
+	Subspaces []*SubspaceSchema `protobuf:"bytes,1,rep,name=subspaces,proto3" json:"subspaces,omitempty"`
+}
+
+func (m *QuerySchemasResponse) Reset()         { *m = QuerySchemasResponse{} }
+func (m *QuerySchemasResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySchemasResponse) ProtoMessage()    {}
+func (*QuerySchemasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{6}
+}
+func (m *QuerySchemasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySchemasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySchemasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySchemasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySchemasResponse.Merge(m, src)
+}
+func (m *QuerySchemasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySchemasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySchemasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySchemasResponse proto.InternalMessageInfo
+
+func (m *QuerySchemasResponse) GetSubspaces() []*SubspaceSchema {
+	if m != nil {
+		return m.Subspaces
+	}
+	return nil
+}
+
+// SubspaceSchema defines a parameter subspace name and the schema of all the
+// parameters known to the subspace.
+type SubspaceSchema struct {
+	Subspace string         `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+	Params   []*ParamSchema `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *SubspaceSchema) Reset()         { *m = SubspaceSchema{} }
+func (m *SubspaceSchema) String() string { return proto.CompactTextString(m) }
+func (*SubspaceSchema) ProtoMessage()    {}
+func (*SubspaceSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{7}
+}
+func (m *SubspaceSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubspaceSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubspaceSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubspaceSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubspaceSchema.Merge(m, src)
+}
+func (m *SubspaceSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubspaceSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubspaceSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubspaceSchema proto.InternalMessageInfo
+
+func (m *SubspaceSchema) GetSubspace() string {
+	if m != nil {
+		return m.Subspace
+	}
+	return ""
+}
+
+func (m *SubspaceSchema) GetParams() []*ParamSchema {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// ParamSchema describes a single parameter of a subspace.
+type ParamSchema struct {
+	// key is the parameter key within the subspace.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value_type is the Go type registered for the parameter in the subspace's key
+	// table. It is empty for keys that are stored but not registered.
+	ValueType string `protobuf:"bytes,2,opt,name=value_type,json=valueType,proto3" json:"value_type,omitempty"`
+	// min is the declared inclusive lower bound of the parameter, if any.
+	Min string `protobuf:"bytes,3,opt,name=min,proto3" json:"min,omitempty"`
+	// max is the declared inclusive upper bound of the parameter, if any.
+	Max string `protobuf:"bytes,4,opt,name=max,proto3" json:"max,omitempty"`
+	// value is the raw (amino JSON encoded) value currently stored for the
+	// parameter. It is empty if the parameter is not set.
+	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *ParamSchema) Reset()         { *m = ParamSchema{} }
+func (m *ParamSchema) String() string { return proto.CompactTextString(m) }
+func (*ParamSchema) ProtoMessage()    {}
+func (*ParamSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{8}
+}
+func (m *ParamSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamSchema.Merge(m, src)
+}
+func (m *ParamSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamSchema proto.InternalMessageInfo
+
+func (m *ParamSchema) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ParamSchema) GetValueType() string {
+	if m != nil {
+		return m.ValueType
+	}
+	return ""
+}
+
+func (m *ParamSchema) GetMin() string {
+	if m != nil {
+		return m.Min
+	}
+	return ""
+}
+
+func (m *ParamSchema) GetMax() string {
+	if m != nil {
+		return m.Max
+	}
+	return ""
+}
+
+func (m *ParamSchema) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// QueryRegisteredKeysRequest defines a request type for querying the keys
+// registered in the key tables of the registered subspaces.
+type QueryRegisteredKeysRequest struct {
+	// subspace optionally restricts the response to a single subspace.
+	Subspace string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+}
+
+func (m *QueryRegisteredKeysRequest) Reset()         { *m = QueryRegisteredKeysRequest{} }
+func (m *QueryRegisteredKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredKeysRequest) ProtoMessage()    {}
+func (*QueryRegisteredKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{9}
+}
+func (m *QueryRegisteredKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegisteredKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredKeysRequest.Merge(m, src)
+}
+func (m *QueryRegisteredKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegisteredKeysRequest proto.InternalMessageInfo
+
+func (m *QueryRegisteredKeysRequest) GetSubspace() string {
+	if m != nil {
+		return m.Subspace
+	}
+	return ""
+}
+
+// QueryRegisteredKeysResponse defines the response type for querying the keys
+// registered in the key tables of the registered subspaces.
+type QueryRegisteredKeysResponse struct {
+	// subspaces contains the registered keys of each subspace, sorted by
+	// subspace name and key.
+	Subspaces []*Subspace `protobuf:"bytes,1,rep,name=subspaces,proto3" json:"subspaces,omitempty"`
+}
+
+func (m *QueryRegisteredKeysResponse) Reset()         { *m = QueryRegisteredKeysResponse{} }
+func (m *QueryRegisteredKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredKeysResponse) ProtoMessage()    {}
+func (*QueryRegisteredKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{10}
+}
+func (m *QueryRegisteredKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegisteredKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredKeysResponse.Merge(m, src)
+}
+func (m *QueryRegisteredKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegisteredKeysResponse proto.InternalMessageInfo
+
+func (m *QueryRegisteredKeysResponse) GetSubspaces() []*Subspace {
+	if m != nil {
+		return m.Subspaces
+	}
+	return nil
+}
+
+// QueryParamsMigrationsRequest defines a request type for generating the
+// MsgUpdateParams messages migrating legacy subspaces.
+type QueryParamsMigrationsRequest struct {
+	// authority is the address set as the authority of the generated messages,
+	// usually the address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// subspaces optionally restricts the generated messages to the given
+	// subspaces. Otherwise, messages are generated for every subspace with a
+	// registered migration and stored parameters.
+	Subspaces []string `protobuf:"bytes,2,rep,name=subspaces,proto3" json:"subspaces,omitempty"`
+}
+
+func (m *QueryParamsMigrationsRequest) Reset()         { *m = QueryParamsMigrationsRequest{} }
+func (m *QueryParamsMigrationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsMigrationsRequest) ProtoMessage()    {}
+func (*QueryParamsMigrationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{11}
+}
+func (m *QueryParamsMigrationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsMigrationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsMigrationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsMigrationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsMigrationsRequest.Merge(m, src)
+}
+func (m *QueryParamsMigrationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsMigrationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsMigrationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsMigrationsRequest proto.InternalMessageInfo
+
+func (m *QueryParamsMigrationsRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *QueryParamsMigrationsRequest) GetSubspaces() []string {
+	if m != nil {
+		return m.Subspaces
+	}
+	return nil
+}
+
+// QueryParamsMigrationsResponse defines the response type for generating the
+// MsgUpdateParams messages migrating legacy subspaces.
+type QueryParamsMigrationsResponse struct {
+	// messages are the generated MsgUpdateParams messages, sorted by subspace
+	// name, which can be used as the messages of a governance proposal.
+	Messages []*any.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *QueryParamsMigrationsResponse) Reset()         { *m = QueryParamsMigrationsResponse{} }
+func (m *QueryParamsMigrationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsMigrationsResponse) ProtoMessage()    {}
+func (*QueryParamsMigrationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{12}
+}
+func (m *QueryParamsMigrationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsMigrationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsMigrationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsMigrationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsMigrationsResponse.Merge(m, src)
+}
+func (m *QueryParamsMigrationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsMigrationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsMigrationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsMigrationsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsMigrationsResponse) GetMessages() []*any.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.params.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.params.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QuerySubspacesRequest)(nil), "cosmos.params.v1beta1.QuerySubspacesRequest")
 	proto.RegisterType((*QuerySubspacesResponse)(nil), "cosmos.params.v1beta1.QuerySubspacesResponse")
 	proto.RegisterType((*Subspace)(nil), "cosmos.params.v1beta1.Subspace")
+	proto.RegisterType((*QuerySchemasRequest)(nil), "cosmos.params.v1beta1.QuerySchemasRequest")
+	proto.RegisterType((*QuerySchemasResponse)(nil), "cosmos.params.v1beta1.QuerySchemasResponse")
+	proto.RegisterType((*SubspaceSchema)(nil), "cosmos.params.v1beta1.SubspaceSchema")
+	proto.RegisterType((*ParamSchema)(nil), "cosmos.params.v1beta1.ParamSchema")
+	proto.RegisterType((*QueryRegisteredKeysRequest)(nil), "cosmos.params.v1beta1.QueryRegisteredKeysRequest")
+	proto.RegisterType((*QueryRegisteredKeysResponse)(nil), "cosmos.params.v1beta1.QueryRegisteredKeysResponse")
+	proto.RegisterType((*QueryParamsMigrationsRequest)(nil), "cosmos.params.v1beta1.QueryParamsMigrationsRequest")
+	proto.RegisterType((*QueryParamsMigrationsResponse)(nil), "cosmos.params.v1beta1.QueryParamsMigrationsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/params/v1beta1/query.proto", fileDescriptor_2b32979c1792ccc4) }
 
 var fileDescriptor_2b32979c1792ccc4 = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xb1, 0x8e, 0xd3, 0x30,
-	0x1c, 0xc6, 0xe3, 0x94, 0x3b, 0x5d, 0x7c, 0x03, 0xe0, 0xe3, 0x50, 0x88, 0x20, 0x0d, 0x16, 0x48,
-	0xe5, 0x74, 0x8d, 0xb9, 0x82, 0x18, 0x40, 0x30, 0xf4, 0x1e, 0x00, 0x08, 0xdb, 0x2d, 0xc8, 0xbd,
-	0xb3, 0x42, 0x94, 0x36, 0x76, 0xe3, 0x14, 0x91, 0x95, 0x81, 0x19, 0x89, 0x67, 0x40, 0x62, 0x64,
-	0x60, 0xe0, 0x11, 0x2a, 0xa6, 0x0a, 0x16, 0x26, 0x84, 0x5a, 0x24, 0x5e, 0x03, 0xc5, 0x4e, 0x8a,
-	0xae, 0x4d, 0xab, 0x2e, 0x95, 0xed, 0x7e, 0xdf, 0xf7, 0xff, 0xe5, 0x73, 0x02, 0x6f, 0x9e, 0x72,
-	0x39, 0xe0, 0x92, 0x08, 0x9a, 0xd2, 0x81, 0x24, 0xaf, 0x8f, 0x7a, 0x2c, 0xa3, 0x47, 0x64, 0x38,
-	0x62, 0x69, 0xee, 0x8b, 0x94, 0x67, 0x1c, 0xed, 0x6b, 0x89, 0xaf, 0x25, 0x7e, 0x29, 0x71, 0xae,
-	0x84, 0x3c, 0xe4, 0x4a, 0x41, 0x8a, 0x95, 0x16, 0x3b, 0xd7, 0x43, 0xce, 0xc3, 0x3e, 0x23, 0x54,
-	0x44, 0x84, 0x26, 0x09, 0xcf, 0x68, 0x16, 0xf1, 0x44, 0x96, 0xff, 0xe2, 0xfa, 0x69, 0x65, 0xb2,
-	0xd6, 0x5c, 0xa6, 0x83, 0x28, 0xe1, 0x44, 0xfd, 0x96, 0x47, 0xd7, 0xb4, 0xed, 0xa5, 0x9e, 0x56,
-	0xe1, 0x14, 0x1b, 0xdc, 0x85, 0xe8, 0x79, 0xc1, 0xfa, 0x4c, 0x45, 0x04, 0x6c, 0x38, 0x62, 0x32,
-	0x43, 0x0e, 0xdc, 0x91, 0xa3, 0x9e, 0x14, 0xf4, 0x94, 0xd9, 0xc0, 0x03, 0x2d, 0x2b, 0x98, 0xef,
-	0xd1, 0x25, 0xd8, 0x88, 0x59, 0x6e, 0x9b, 0xea, 0xb8, 0x58, 0xe2, 0x13, 0xb8, 0x77, 0x2e, 0x43,
-	0x0a, 0x9e, 0x48, 0x86, 0x8e, 0xe1, 0x96, 0x02, 0x53, 0x09, 0xbb, 0x1d, 0xec, 0xd7, 0xf6, 0xe0,
-	0x2b, 0xd7, 0xf1, 0x2b, 0x9a, 0x84, 0xac, 0x6b, 0x8d, 0x7f, 0x35, 0x8d, 0x4f, 0x7f, 0x3f, 0x1f,
-	0x80, 0x40, 0x7b, 0xf1, 0x21, 0xdc, 0x57, 0xd9, 0x2f, 0xca, 0xf1, 0x15, 0xe2, 0xc3, 0xbd, 0xef,
-	0x5f, 0xda, 0x17, 0x75, 0x62, 0x5b, 0x9e, 0xc5, 0xde, 0x5d, 0xff, 0xfe, 0x03, 0xdc, 0x87, 0x57,
-	0x17, 0xd5, 0x25, 0xcc, 0x63, 0x68, 0x55, 0x4f, 0x20, 0x6d, 0xe0, 0x35, 0x5a, 0xbb, 0x9d, 0xe6,
-	0x0a, 0xa0, 0xca, 0x1c, 0xfc, 0x77, 0xd4, 0x4f, 0x7b, 0x0a, 0x77, 0x2a, 0xed, 0xda, 0xc6, 0x10,
-	0xbc, 0x10, 0xb3, 0x5c, 0xda, 0xa6, 0xd7, 0x68, 0x59, 0x81, 0x5a, 0xd7, 0x06, 0x76, 0xbe, 0x9a,
-	0x70, 0x4b, 0xf1, 0xa3, 0x77, 0x00, 0x6e, 0xeb, 0x3a, 0xd1, 0x9d, 0x15, 0x98, 0xcb, 0xd7, 0xe6,
-	0x1c, 0x6c, 0x22, 0xd5, 0x85, 0xe0, 0xdb, 0x6f, 0x7f, 0xfc, 0xf9, 0x60, 0x36, 0xd1, 0x0d, 0xb2,
-	0xee, 0x9d, 0x42, 0x1f, 0x01, 0xb4, 0xe6, 0x6d, 0xa2, 0xc3, 0x75, 0x03, 0x16, 0xaf, 0xc8, 0x69,
-	0x6f, 0xa8, 0x2e, 0x89, 0x1e, 0x7d, 0x5b, 0xae, 0x44, 0x41, 0x62, 0xe4, 0xad, 0x80, 0x9c, 0x5f,
-	0x50, 0xf7, 0xc9, 0x78, 0xea, 0x82, 0xc9, 0xd4, 0x05, 0xbf, 0xa7, 0x2e, 0x78, 0x3f, 0x73, 0x8d,
-	0xc9, 0xcc, 0x35, 0x7e, 0xce, 0x5c, 0xe3, 0xe4, 0x96, 0xb6, 0xca, 0xb3, 0xd8, 0x8f, 0x38, 0x79,
-	0x53, 0x45, 0x64, 0xb9, 0x60, 0x92, 0x88, 0x94, 0x0b, 0x2e, 0x69, 0xbf, 0xb7, 0xad, 0x3e, 0x87,
-	0x7b, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xb1, 0xeb, 0xb5, 0x8e, 0xd0, 0x03, 0x00, 0x00,
+	// 811 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x4f, 0x13, 0x4d,
+	0x18, 0xee, 0x52, 0xda, 0x8f, 0x0e, 0x09, 0x1f, 0xdf, 0x50, 0xbe, 0xac, 0x2b, 0x94, 0xba, 0x11,
+	0x53, 0x91, 0xee, 0x42, 0xf9, 0x91, 0x88, 0xd1, 0x84, 0x92, 0x78, 0x31, 0x44, 0x5d, 0x3c, 0x71,
+	0x69, 0xa6, 0x74, 0x58, 0x36, 0xed, 0xfe, 0x60, 0x67, 0x4b, 0xe8, 0xc1, 0x8b, 0x07, 0x13, 0x0f,
+	0x26, 0x26, 0x9e, 0x3d, 0x9a, 0x78, 0xf4, 0xc0, 0xc9, 0xa3, 0x27, 0xc2, 0x89, 0xe0, 0xc5, 0x78,
+	0x30, 0x06, 0x4c, 0xfc, 0x37, 0x4c, 0x67, 0x66, 0x17, 0x4a, 0x77, 0xb7, 0xc5, 0xc4, 0x0b, 0xd9,
+	0x9d, 0x7d, 0xde, 0xe7, 0x79, 0xe6, 0x99, 0x77, 0x5e, 0x0a, 0x6e, 0x6c, 0xd9, 0xc4, 0xb4, 0x89,
+	0xea, 0x20, 0x17, 0x99, 0x44, 0xdd, 0x9b, 0xaf, 0x62, 0x0f, 0xcd, 0xab, 0xbb, 0x4d, 0xec, 0xb6,
+	0x14, 0xc7, 0xb5, 0x3d, 0x1b, 0x8e, 0x33, 0x88, 0xc2, 0x20, 0x0a, 0x87, 0x48, 0x59, 0xdd, 0xd6,
+	0x6d, 0x8a, 0x50, 0xdb, 0x4f, 0x0c, 0x2c, 0x4d, 0xe8, 0xb6, 0xad, 0x37, 0xb0, 0x8a, 0x1c, 0x43,
+	0x45, 0x96, 0x65, 0x7b, 0xc8, 0x33, 0x6c, 0x8b, 0xf0, 0xaf, 0x72, 0xb8, 0x1a, 0x67, 0x66, 0x98,
+	0xff, 0x90, 0x69, 0x58, 0xb6, 0x4a, 0xff, 0xf2, 0xa5, 0x6b, 0xac, 0xac, 0xc2, 0xd4, 0x7c, 0x3b,
+	0xec, 0x13, 0xd7, 0xa3, 0x6f, 0xd5, 0xe6, 0xb6, 0x8a, 0x2c, 0xee, 0x5b, 0x2e, 0x03, 0xf8, 0xb4,
+	0xbd, 0x8d, 0x27, 0x94, 0x5d, 0xc3, 0xbb, 0x4d, 0x4c, 0x3c, 0x28, 0x81, 0x21, 0xd2, 0xac, 0x12,
+	0x07, 0x6d, 0x61, 0x51, 0xc8, 0x0b, 0x85, 0x8c, 0x16, 0xbc, 0xc3, 0x51, 0x90, 0xac, 0xe3, 0x96,
+	0x38, 0x40, 0x97, 0xdb, 0x8f, 0xf2, 0x26, 0x18, 0xeb, 0xe0, 0x20, 0x8e, 0x6d, 0x11, 0x0c, 0xd7,
+	0x40, 0x8a, 0x7a, 0xa6, 0x0c, 0xc3, 0x25, 0x59, 0x09, 0x8d, 0x48, 0xa1, 0x55, 0x6b, 0x3b, 0xc8,
+	0xd2, 0x71, 0x39, 0x73, 0xf8, 0x7d, 0x2a, 0xf1, 0xe1, 0xd7, 0xc7, 0x19, 0x41, 0x63, 0xb5, 0xf2,
+	0x2c, 0x18, 0xa7, 0xdc, 0x1b, 0x5c, 0xde, 0xb7, 0xb8, 0x32, 0x76, 0x72, 0x50, 0xfc, 0x97, 0x31,
+	0x16, 0x49, 0xad, 0x9e, 0x9f, 0x53, 0x16, 0x97, 0xe5, 0x06, 0xf8, 0xff, 0x32, 0x9a, 0x9b, 0xb9,
+	0x0f, 0x32, 0xfe, 0x0e, 0x88, 0x28, 0xe4, 0x93, 0x85, 0xe1, 0xd2, 0x54, 0x84, 0x21, 0xbf, 0x58,
+	0x3b, 0xaf, 0x08, 0x57, 0x7b, 0x0c, 0x86, 0x7c, 0x6c, 0x6c, 0x62, 0x10, 0x0c, 0xd6, 0x71, 0x8b,
+	0x88, 0x03, 0xf9, 0x64, 0x21, 0xa3, 0xd1, 0xe7, 0x70, 0xc2, 0x87, 0x3c, 0xc8, 0x8d, 0xad, 0x1d,
+	0x6c, 0xa2, 0x7e, 0x4e, 0x23, 0x84, 0x67, 0xa9, 0x24, 0x3b, 0x20, 0xdb, 0xc9, 0x13, 0x9c, 0x48,
+	0x57, 0x08, 0xd3, 0x3d, 0x42, 0x60, 0x14, 0xf1, 0x51, 0x2c, 0x95, 0xe4, 0xe7, 0x60, 0xa4, 0xb3,
+	0x22, 0x36, 0x90, 0x15, 0x90, 0x66, 0x72, 0x34, 0x92, 0x1e, 0xad, 0xc1, 0x1d, 0xf0, 0x8a, 0x70,
+	0xf9, 0xd7, 0x02, 0x18, 0xbe, 0x00, 0xf6, 0x7b, 0x54, 0x08, 0x7a, 0x14, 0x4e, 0x02, 0xb0, 0x87,
+	0x1a, 0x4d, 0x5c, 0xf1, 0x5a, 0x0e, 0xe6, 0xcd, 0x9b, 0xa1, 0x2b, 0xcf, 0x5a, 0x0e, 0x6d, 0x6a,
+	0xd3, 0xb0, 0xc4, 0x24, 0x2b, 0x30, 0x0d, 0x8b, 0xae, 0xa0, 0x7d, 0x71, 0x90, 0xaf, 0xa0, 0x7d,
+	0x98, 0x05, 0x29, 0x5a, 0x20, 0xa6, 0xe8, 0x1a, 0x7b, 0x09, 0xf7, 0xb3, 0x0e, 0x24, 0x7a, 0x00,
+	0x1a, 0xd6, 0x0d, 0xe2, 0x61, 0x17, 0xd7, 0x1e, 0xe1, 0xd6, 0x9f, 0x9f, 0xe7, 0x2e, 0xb8, 0x1e,
+	0x4a, 0xf7, 0xb7, 0x7a, 0x7b, 0xa9, 0x24, 0xbf, 0x12, 0xc0, 0xc4, 0x85, 0x4b, 0xbd, 0x6e, 0xe8,
+	0x2e, 0x1b, 0x52, 0xfe, 0x26, 0x96, 0x41, 0x06, 0x35, 0xbd, 0x1d, 0xdb, 0x35, 0x3c, 0x1e, 0x74,
+	0x59, 0x3c, 0x39, 0x28, 0x66, 0xb9, 0xee, 0x6a, 0xad, 0xe6, 0x62, 0x42, 0x36, 0x3c, 0xd7, 0xb0,
+	0x74, 0xed, 0x1c, 0x0a, 0x27, 0x2e, 0x9a, 0x65, 0x37, 0xa2, 0x97, 0x97, 0x6d, 0x30, 0x19, 0x61,
+	0x85, 0x07, 0x30, 0x07, 0x86, 0x4c, 0x4c, 0x08, 0xd2, 0x83, 0xfd, 0x67, 0x15, 0x36, 0xf2, 0x14,
+	0x7f, 0xe4, 0x29, 0xab, 0x56, 0x4b, 0x0b, 0x50, 0xa1, 0x3a, 0xa5, 0x6f, 0x69, 0x90, 0xa2, 0x42,
+	0xf0, 0xa5, 0x00, 0xd2, 0x4c, 0x0d, 0xde, 0x8e, 0x48, 0xb2, 0x7b, 0x6a, 0x4a, 0x33, 0xfd, 0x40,
+	0x99, 0x65, 0x79, 0xfa, 0xc5, 0x97, 0x9f, 0x6f, 0x07, 0xa6, 0xe0, 0xa4, 0x1a, 0x37, 0xed, 0xe1,
+	0x7b, 0x01, 0x64, 0x82, 0x61, 0x06, 0x67, 0xe3, 0x04, 0x2e, 0x4f, 0x48, 0xa9, 0xd8, 0x27, 0x9a,
+	0x3b, 0xba, 0x77, 0xd4, 0x3d, 0x91, 0xa8, 0x49, 0x19, 0xe6, 0x23, 0x4c, 0x06, 0xe7, 0x06, 0xdf,
+	0x09, 0xe0, 0x1f, 0x3e, 0x6d, 0x60, 0x6c, 0x0c, 0x9d, 0xa3, 0x4d, 0xba, 0xd3, 0x17, 0x96, 0x3b,
+	0xbc, 0x7b, 0xd4, 0x7d, 0x68, 0xd4, 0x61, 0x1e, 0xe6, 0xa2, 0x1c, 0x72, 0x4f, 0x9f, 0x04, 0x30,
+	0xd2, 0x79, 0x7b, 0xe0, 0x7c, 0x9c, 0x74, 0xe8, 0xc5, 0x95, 0x4a, 0x57, 0x29, 0xe1, 0xa6, 0x57,
+	0xa3, 0x4c, 0x17, 0xe0, 0xad, 0x08, 0xd3, 0x6e, 0xc0, 0x54, 0x69, 0xff, 0xaf, 0x80, 0x9f, 0x05,
+	0x30, 0x7a, 0xb9, 0xf7, 0xe1, 0x42, 0xef, 0x66, 0xeb, 0xba, 0xb4, 0xd2, 0xe2, 0xd5, 0x8a, 0xf8,
+	0x16, 0xd6, 0xa2, 0xb6, 0x30, 0x03, 0x0b, 0xb1, 0xed, 0x5b, 0x31, 0x03, 0xb2, 0xf2, 0x83, 0xc3,
+	0xd3, 0x9c, 0x70, 0x7c, 0x9a, 0x13, 0x7e, 0x9c, 0xe6, 0x84, 0x37, 0x67, 0xb9, 0xc4, 0xf1, 0x59,
+	0x2e, 0xf1, 0xf5, 0x2c, 0x97, 0xd8, 0xbc, 0xc9, 0x28, 0x48, 0xad, 0xae, 0x18, 0xb6, 0xba, 0xef,
+	0x53, 0xb5, 0x07, 0x35, 0x69, 0xff, 0x64, 0x71, 0x6c, 0x82, 0x1a, 0xd5, 0x34, 0xbd, 0xc9, 0x0b,
+	0xbf, 0x07, 0x00, 0xf6, 0x94, 0x00, 0xfc, 0x8c, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -330,6 +794,18 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Subspaces queries for all registered subspaces and all keys for a subspace.
 	Subspaces(ctx context.Context, in *QuerySubspacesRequest, opts ...grpc.CallOption) (*QuerySubspacesResponse, error)
+	// Schemas queries for the registered subspaces together with the schema and
+	// the current raw value of each of their parameters.
+	Schemas(ctx context.Context, in *QuerySchemasRequest, opts ...grpc.CallOption) (*QuerySchemasResponse, error)
+	// RegisteredKeys queries for the registered subspaces and the keys
+	// registered in each of their key tables, which are the keys a parameter
+	// change proposal can update.
+	RegisteredKeys(ctx context.Context, in *QueryRegisteredKeysRequest, opts ...grpc.CallOption) (*QueryRegisteredKeysResponse, error)
+	// ParamsMigrations generates the MsgUpdateParams messages which set the
+	// parameters stored in legacy subspaces as the parameters of the modules
+	// which manage their own parameters, for the subspaces with a registered
+	// migration.
+	ParamsMigrations(ctx context.Context, in *QueryParamsMigrationsRequest, opts ...grpc.CallOption) (*QueryParamsMigrationsResponse, error)
 }
 
 type queryClient struct {
@@ -358,13 +834,52 @@ func (c *queryClient) Subspaces(ctx context.Context, in *QuerySubspacesRequest,
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries a specific parameter of a module, given its subspace and
-	// key.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Subspaces queries for all registered subspaces and all keys for a subspace.
-	Subspaces(context.Context, *QuerySubspacesRequest) (*QuerySubspacesResponse, error)
+func (c *queryClient) Schemas(ctx context.Context, in *QuerySchemasRequest, opts ...grpc.CallOption) (*QuerySchemasResponse, error) {
+	out := new(QuerySchemasResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.params.v1beta1.Query/Schemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegisteredKeys(ctx context.Context, in *QueryRegisteredKeysRequest, opts ...grpc.CallOption) (*QueryRegisteredKeysResponse, error) {
+	out := new(QueryRegisteredKeysResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.params.v1beta1.Query/RegisteredKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamsMigrations(ctx context.Context, in *QueryParamsMigrationsRequest, opts ...grpc.CallOption) (*QueryParamsMigrationsResponse, error) {
+	out := new(QueryParamsMigrationsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.params.v1beta1.Query/ParamsMigrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries a specific parameter of a module, given its subspace and
+	// key.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Subspaces queries for all registered subspaces and all keys for a subspace.
+	Subspaces(context.Context, *QuerySubspacesRequest) (*QuerySubspacesResponse, error)
+	// Schemas queries for the registered subspaces together with the schema and
+	// the current raw value of each of their parameters.
+	Schemas(context.Context, *QuerySchemasRequest) (*QuerySchemasResponse, error)
+	// RegisteredKeys queries for the registered subspaces and the keys
+	// registered in each of their key tables, which are the keys a parameter
+	// change proposal can update.
+	RegisteredKeys(context.Context, *QueryRegisteredKeysRequest) (*QueryRegisteredKeysResponse, error)
+	// ParamsMigrations generates the MsgUpdateParams messages which set the
+	// parameters stored in legacy subspaces as the parameters of the modules
+	// which manage their own parameters, for the subspaces with a registered
+	// migration.
+	ParamsMigrations(context.Context, *QueryParamsMigrationsRequest) (*QueryParamsMigrationsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -377,6 +892,15 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) Subspaces(ctx context.Context, req *QuerySubspacesRequest) (*QuerySubspacesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Subspaces not implemented")
 }
+func (*UnimplementedQueryServer) Schemas(ctx context.Context, req *QuerySchemasRequest) (*QuerySchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schemas not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredKeys(ctx context.Context, req *QueryRegisteredKeysRequest) (*QueryRegisteredKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredKeys not implemented")
+}
+func (*UnimplementedQueryServer) ParamsMigrations(ctx context.Context, req *QueryParamsMigrationsRequest) (*QueryParamsMigrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsMigrations not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -418,6 +942,60 @@ func _Query_Subspaces_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Schemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Schemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.params.v1beta1.Query/Schemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Schemas(ctx, req.(*QuerySchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegisteredKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.params.v1beta1.Query/RegisteredKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredKeys(ctx, req.(*QueryRegisteredKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsMigrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.params.v1beta1.Query/ParamsMigrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsMigrations(ctx, req.(*QueryParamsMigrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.params.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -430,6 +1008,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Subspaces",
 			Handler:    _Query_Subspaces_Handler,
 		},
+		{
+			MethodName: "Schemas",
+			Handler:    _Query_Schemas_Handler,
+		},
+		{
+			MethodName: "RegisteredKeys",
+			Handler:    _Query_RegisteredKeys_Handler,
+		},
+		{
+			MethodName: "ParamsMigrations",
+			Handler:    _Query_ParamsMigrations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/params/v1beta1/query.proto",
@@ -604,95 +1194,1272 @@ func (m *Subspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySchemasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QuerySchemasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySchemasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Subspace)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySchemasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySchemasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySchemasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Param.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Subspaces) > 0 {
+		for iNdEx := len(m.Subspaces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subspaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySubspacesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SubspaceSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SubspaceSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubspaceSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySubspacesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ParamSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Subspaces) > 0 {
-		for _, e := range m.Subspaces {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValueType) > 0 {
+		i -= len(m.ValueType)
+		copy(dAtA[i:], m.ValueType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValueType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Subspace) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRegisteredKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Subspace)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.Keys) > 0 {
-		for _, s := range m.Keys {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subspaces) > 0 {
+		for iNdEx := len(m.Subspaces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subspaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsMigrationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsMigrationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsMigrationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subspaces) > 0 {
+		for iNdEx := len(m.Subspaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Subspaces[iNdEx])
+			copy(dAtA[i:], m.Subspaces[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Subspaces[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsMigrationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsMigrationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsMigrationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Param.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySubspacesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySubspacesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subspaces) > 0 {
+		for _, e := range m.Subspaces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Subspace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySchemasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySchemasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subspaces) > 0 {
+		for _, e := range m.Subspaces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubspaceSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValueType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegisteredKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegisteredKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subspaces) > 0 {
+		for _, e := range m.Subspaces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsMigrationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Subspaces) > 0 {
+		for _, s := range m.Subspaces {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsMigrationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Param", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Param.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubspacesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubspacesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubspacesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubspacesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubspacesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubspacesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspaces = append(m.Subspaces, &Subspace{})
+			if err := m.Subspaces[len(m.Subspaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Subspace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Subspace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Subspace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySchemasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySchemasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySchemasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySchemasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySchemasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySchemasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspaces = append(m.Subspaces, &SubspaceSchema{})
+			if err := m.Subspaces[len(m.Subspaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubspaceSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubspaceSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubspaceSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, &ParamSchema{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *ParamSchema) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -715,15 +2482,15 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamSchema: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamSchema: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -751,11 +2518,11 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subspace = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -783,63 +2550,77 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.ValueType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Min = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Max = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Param", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -849,24 +2630,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Param.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -889,7 +2669,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySubspacesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRegisteredKeysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -912,12 +2692,44 @@ func (m *QuerySubspacesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubspacesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRegisteredKeysRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubspacesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRegisteredKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -939,7 +2751,7 @@ func (m *QuerySubspacesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySubspacesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRegisteredKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -962,10 +2774,10 @@ func (m *QuerySubspacesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubspacesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRegisteredKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubspacesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRegisteredKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1023,7 +2835,7 @@ func (m *QuerySubspacesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Subspace) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsMigrationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1046,15 +2858,15 @@ func (m *Subspace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Subspace: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsMigrationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Subspace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsMigrationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1082,11 +2894,11 @@ func (m *Subspace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subspace = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspaces", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1114,7 +2926,91 @@ func (m *Subspace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			m.Subspaces = append(m.Subspaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsMigrationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsMigrationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsMigrationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &any.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"reflect"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParamRange declares the inclusive bounds of a parameter. Bounds are kept as
+// strings so they can describe integers, decimals and durations alike. An empty
+// bound means the parameter is unbounded on that side.
+type ParamRange struct {
+	Min string
+	Max string
+}
+
+// NewParamRange creates a new ParamRange instance.
+func NewParamRange(minimum, maximum string) ParamRange {
+	return ParamRange{Min: minimum, Max: maximum}
+}
+
+// ParamSchema describes a single parameter of a Subspace.
+type ParamSchema struct {
+	// Key is the parameter key.
+	Key string
+	// Type is the fully qualified Go type registered for the key. It is empty
+	// for keys that are present in the store but not registered in the KeyTable.
+	Type string
+	// Range holds the declared bounds of the parameter, if any.
+	Range *ParamRange
+	// Value is the raw value currently stored for the parameter, if any.
+	Value []byte
+}
+
+// Schema returns the schema and raw value of every parameter registered in the
+// Subspace's KeyTable, as well as of every key present in the store which is
+// not registered. The result is sorted by key.
+func (s Subspace) Schema(ctx sdk.Context) []ParamSchema {
+	schemas := make(map[string]ParamSchema, len(s.table.m))
+	for key, attr := range s.table.m {
+		schemas[key] = ParamSchema{
+			Key:   key,
+			Type:  typeName(attr.ty),
+			Range: attr.rng,
+		}
+	}
+
+	s.IterateKeys(ctx, func(key []byte) bool {
+		schema, ok := schemas[string(key)]
+		if !ok {
+			schema = ParamSchema{Key: string(key)}
+		}
+
+		schema.Value = s.GetRaw(ctx, key)
+		schemas[string(key)] = schema
+		return false
+	})
+
+	res := make([]ParamSchema, 0, len(schemas))
+	for _, schema := range schemas {
+		res = append(res, schema)
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Key < res[j].Key })
+
+	return res
+}
+
+// typeName returns the fully qualified name of a named type, or its string
+// representation for unnamed types such as slices.
+func typeName(ty reflect.Type) string {
+	if ty.Name() != "" && ty.PkgPath() != "" {
+		return ty.PkgPath() + "." + ty.Name()
+	}
+
+	return ty.String()
+}
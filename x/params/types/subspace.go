@@ -212,23 +212,65 @@ func (s Subspace) Update(ctx sdk.Context, key, value []byte) error {
 		panic(fmt.Sprintf("parameter %s not registered", key))
 	}
 
-	ty := attr.ty
-	dest := reflect.New(ty).Interface()
-	s.GetIfExists(ctx, key, dest)
-
-	if err := s.legacyAmino.UnmarshalJSON(value, dest); err != nil {
+	decoded, err := s.DecodeUpdate(ctx, key, value)
+	if err != nil {
 		return err
 	}
 
-	// destValue contains the dereferenced value of dest so validation function do
-	// not have to operate on pointers.
-	destValue := reflect.Indirect(reflect.ValueOf(dest)).Interface()
-	if err := s.Validate(ctx, key, destValue); err != nil {
-		return err
+	dest := reflect.New(attr.ty)
+	dest.Elem().Set(reflect.ValueOf(decoded))
+	s.Set(ctx, key, dest.Interface())
+	return nil
+}
+
+// DecodeUpdate decodes and validates the raw value of an update of a
+// parameter key without storing it, returning the value Update would store.
+// The raw value is decoded with the decoder registered for the key, if any,
+// and otherwise as amino JSON on top of the current value. An error is
+// returned if the key is not registered, if the value can't be decoded or if
+// it is invalid as determined by the registered validation function.
+func (s Subspace) DecodeUpdate(ctx sdk.Context, key, value []byte) (interface{}, error) {
+	attr, ok := s.table.m[string(key)]
+	if !ok {
+		return nil, fmt.Errorf("parameter %s not registered", key)
 	}
 
-	s.Set(ctx, key, dest)
-	return nil
+	var decoded interface{}
+	if attr.dec != nil {
+		res, err := attr.dec(value)
+		if err != nil {
+			return nil, err
+		}
+
+		resValue := reflect.Indirect(reflect.ValueOf(res))
+		if !resValue.IsValid() || resValue.Type() != attr.ty {
+			return nil, fmt.Errorf("decoder of parameter %s returned %T, expected %s", key, res, attr.ty)
+		}
+		decoded = resValue.Interface()
+	} else {
+		dest := reflect.New(attr.ty).Interface()
+		s.GetIfExists(ctx, key, dest)
+
+		if err := s.legacyAmino.UnmarshalJSON(value, dest); err != nil {
+			return nil, err
+		}
+
+		// decoded contains the dereferenced value of dest so validation function do
+		// not have to operate on pointers.
+		decoded = reflect.Indirect(reflect.ValueOf(dest)).Interface()
+	}
+
+	if err := s.Validate(ctx, key, decoded); err != nil {
+		return nil, err
+	}
+
+	return decoded, nil
+}
+
+// RegisteredKeys returns the keys registered in the Subspace's KeyTable,
+// sorted.
+func (s Subspace) RegisteredKeys() []string {
+	return s.table.keys()
 }
 
 // GetParamSet iterates through each ParamSetPair where for each pair, it will
@@ -262,6 +262,70 @@ func (suite *SubspaceTestSuite) TestSetParamSet() {
 	suite.Require().Equal(a.BondDenom, b.BondDenom)
 }
 
+func (suite *SubspaceTestSuite) TestSchema() {
+	table := paramKeyTable().RegisterRange(keyMaxValidators, types.NewParamRange("1", "300"))
+	ss := types.NewSubspace(suite.cdc, suite.amino, key, tkey, "schemasubspace").WithKeyTable(table)
+	ss.Set(suite.ctx, keyBondDenom, "stake")
+
+	store := suite.ctx.KVStore(key)
+	store.Set([]byte("schemasubspace/Unregistered"), []byte(`"raw"`))
+
+	suite.Require().Equal([]types.ParamSchema{
+		{Key: "BondDenom", Type: "string", Value: []byte(`"stake"`)},
+		{Key: "MaxValidators", Type: "uint16", Range: &types.ParamRange{Min: "1", Max: "300"}},
+		{Key: "UnbondingTime", Type: "time.Duration"},
+		{Key: "Unregistered", Value: []byte(`"raw"`)},
+	}, ss.Schema(suite.ctx))
+}
+
+func (suite *SubspaceTestSuite) TestDecodeUpdate() {
+	decodeHours := func(value []byte) (interface{}, error) {
+		var hours int64
+		if _, err := fmt.Sscanf(string(value), "%dh", &hours); err != nil {
+			return nil, err
+		}
+		return time.Duration(hours) * time.Hour, nil
+	}
+	table := paramKeyTable().RegisterDecoder(keyUnbondingTime, decodeHours)
+	ss := types.NewSubspace(suite.cdc, suite.amino, key, tkey, "decodesubspace").WithKeyTable(table)
+
+	_, err := ss.DecodeUpdate(suite.ctx, []byte("Unregistered"), []byte(`"stake"`))
+	suite.Require().ErrorContains(err, "not registered")
+
+	// the default decoder is amino JSON
+	_, err = ss.DecodeUpdate(suite.ctx, keyBondDenom, []byte(`1`))
+	suite.Require().Error(err)
+	_, err = ss.DecodeUpdate(suite.ctx, keyBondDenom, []byte(`""`))
+	suite.Require().ErrorContains(err, "invalid parameter value")
+	decoded, err := ss.DecodeUpdate(suite.ctx, keyBondDenom, []byte(`"stake"`))
+	suite.Require().NoError(err)
+	suite.Require().Equal("stake", decoded)
+	suite.Require().False(ss.Has(suite.ctx, keyBondDenom))
+
+	// registered decoders are used instead and their output is validated
+	_, err = ss.DecodeUpdate(suite.ctx, keyUnbondingTime, []byte(`"86400000000000"`))
+	suite.Require().Error(err)
+	_, err = ss.DecodeUpdate(suite.ctx, keyUnbondingTime, []byte(`1h`))
+	suite.Require().ErrorContains(err, "invalid parameter value")
+	decoded, err = ss.DecodeUpdate(suite.ctx, keyUnbondingTime, []byte(`48h`))
+	suite.Require().NoError(err)
+	suite.Require().Equal(48*time.Hour, decoded)
+
+	suite.Require().NoError(ss.Update(suite.ctx, keyUnbondingTime, []byte(`48h`)))
+	var unbondingTime time.Duration
+	ss.Get(suite.ctx, keyUnbondingTime, &unbondingTime)
+	suite.Require().Equal(48*time.Hour, unbondingTime)
+
+	badTable := paramKeyTable().RegisterDecoder(keyMaxValidators, func([]byte) (interface{}, error) {
+		return uint32(1), nil
+	})
+	badSS := types.NewSubspace(suite.cdc, suite.amino, key, tkey, "baddecodesubspace").WithKeyTable(badTable)
+	_, err = badSS.DecodeUpdate(suite.ctx, keyMaxValidators, []byte(`1`))
+	suite.Require().ErrorContains(err, "expected uint16")
+
+	suite.Require().Equal([]string{"BondDenom", "MaxValidators", "UnbondingTime"}, ss.RegisteredKeys())
+}
+
 func (suite *SubspaceTestSuite) TestName() {
 	suite.Require().Equal("testsubspace", suite.ss.Name())
 }
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"fmt"
 	"reflect"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -9,6 +11,8 @@ import (
 type attribute struct {
 	ty  reflect.Type
 	vfn ValueValidatorFn
+	dec ValueDecoderFn
+	rng *ParamRange
 }
 
 // KeyTable subspaces appropriate type for each parameter key
@@ -71,6 +75,51 @@ func (t KeyTable) RegisterParamSet(ps ParamSet) KeyTable {
 	return t
 }
 
+// RegisterRange declares the inclusive bounds accepted for an already registered
+// parameter key. The range is informational only and is exposed through the
+// Subspace schema; enforcement remains the job of the key's ValueValidatorFn.
+func (t KeyTable) RegisterRange(key []byte, rng ParamRange) KeyTable {
+	attr, ok := t.m[string(key)]
+	if !ok {
+		panic(fmt.Sprintf("cannot register range for unregistered parameter key %s", key))
+	}
+
+	attr.rng = &rng
+	t.m[string(key)] = attr
+
+	return t
+}
+
+// RegisterDecoder registers the decoder used for the raw values of the changes
+// to an already registered parameter key, in place of the default amino JSON
+// decoding of the value on top of the current one. The decoder must return a
+// value, or a pointer to a value, of the type registered for the key.
+func (t KeyTable) RegisterDecoder(key []byte, dec ValueDecoderFn) KeyTable {
+	attr, ok := t.m[string(key)]
+	if !ok {
+		panic(fmt.Sprintf("cannot register decoder for unregistered parameter key %s", key))
+	}
+	if dec == nil {
+		panic(fmt.Sprintf("cannot register nil decoder for parameter key %s", key))
+	}
+
+	attr.dec = dec
+	t.m[string(key)] = attr
+
+	return t
+}
+
+// keys returns the registered parameter keys, sorted.
+func (t KeyTable) keys() []string {
+	keys := make([]string, 0, len(t.m))
+	for k := range t.m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
 func (t KeyTable) maxKeyLength() (res int) {
 	for k := range t.m {
 		l := len(k)
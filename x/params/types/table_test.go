@@ -43,4 +43,12 @@ func TestKeyTable(t *testing.T) {
 			types.ParamSetPair{[]byte("test2"), uint16(100), validateMaxValidators},
 		)
 	})
+
+	require.Panics(t, func() { table.RegisterRange([]byte("unknown"), types.NewParamRange("0", "1")) })
+	require.NotPanics(t, func() { table.RegisterRange(keyMaxValidators, types.NewParamRange("1", "")) })
+
+	decoder := func([]byte) (interface{}, error) { return uint16(1), nil }
+	require.Panics(t, func() { table.RegisterDecoder([]byte("unknown"), decoder) })
+	require.Panics(t, func() { table.RegisterDecoder(keyMaxValidators, nil) })
+	require.NotPanics(t, func() { table.RegisterDecoder(keyMaxValidators, decoder) })
 }
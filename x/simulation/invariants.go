@@ -0,0 +1,126 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+var _ sdk.InvariantRegistry = (*InvariantRegistry)(nil)
+
+// InvariantRegistry holds the invariants asserted by the simulator. It
+// implements sdk.InvariantRegistry so modules can register their invariants
+// directly on it.
+type InvariantRegistry struct {
+	routes     []string
+	invariants map[string]sdk.Invariant
+}
+
+// NewInvariantRegistry creates a new, empty InvariantRegistry.
+func NewInvariantRegistry() *InvariantRegistry {
+	return &InvariantRegistry{invariants: make(map[string]sdk.Invariant)}
+}
+
+// RegisterRoute registers an invariant under the "module/route" route.
+// Invariants are asserted in registration order.
+func (ir *InvariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	fullRoute := fmt.Sprintf("%s/%s", moduleName, route)
+	if _, ok := ir.invariants[fullRoute]; ok {
+		panic(fmt.Sprintf("invariant route %s already registered", fullRoute))
+	}
+
+	ir.routes = append(ir.routes, fullRoute)
+	ir.invariants[fullRoute] = invar
+}
+
+// Check asserts all the registered invariants against the given context and
+// returns the route and message of the first broken one.
+func (ir *InvariantRegistry) Check(ctx sdk.Context) (route, msg string, broken bool) {
+	for _, route := range ir.routes {
+		if msg, broken := ir.invariants[route](ctx); broken {
+			return route, msg, true
+		}
+	}
+
+	return "", "", false
+}
+
+// InvariantConfig configures the invariant assertions of a simulation run
+// started with SimulateFromSeedWithInvariants.
+type InvariantConfig struct {
+	// Registry holds the invariants to assert.
+	Registry *InvariantRegistry
+	// Period is the number of blocks between two invariant assertions, 0 or 1
+	// asserting them at the end of every block.
+	Period int
+	// AppFactory returns a new application to replay operation sequences from
+	// genesis on while minimizing a failure. When nil, the failure is reported
+	// without minimization.
+	AppFactory func() ReplayApp
+	// MaxReplays bounds the number of replays done while minimizing a failure,
+	// 0 meaning no bound.
+	MaxReplays int
+	// StoreDecoders are used to render the state diff of the failure report.
+	StoreDecoders simulation.StoreDecoderRegistry
+	// ReportDir is the directory the failure report is written to, defaulting
+	// to $HOME/.simapp/simulations.
+	ReportDir string
+}
+
+func (c InvariantConfig) period() int64 {
+	if c.Period <= 1 {
+		return 1
+	}
+
+	return int64(c.Period)
+}
+
+// InvariantFailureReport describes a broken invariant together with the
+// minimized operation sequence reproducing it. Every operation is replayed from
+// genesis of the simulation Seed with its own random source seeded with its
+// recorded seed.
+type InvariantFailureReport struct {
+	Seed     int64  `json:"seed" yaml:"seed"`
+	FuzzSeed []byte `json:"fuzz_seed,omitempty" yaml:"fuzz_seed,omitempty"`
+
+	Route   string `json:"route" yaml:"route"`
+	Message string `json:"message" yaml:"message"`
+	Height  int64  `json:"height" yaml:"height"`
+
+	TotalOperations int               `json:"total_operations" yaml:"total_operations"`
+	Minimized       bool              `json:"minimized" yaml:"minimized"`
+	Operations      []OperationRecord `json:"operations" yaml:"operations"`
+
+	// StateDiff is the difference between the state of a replay without any
+	// operation and the state of the replay of Operations, at Height.
+	StateDiff string `json:"state_diff,omitempty" yaml:"state_diff,omitempty"`
+}
+
+// WriteTo writes the report as JSON in the given directory, or in
+// $HOME/.simapp/simulations when empty, and returns the path of the file.
+func (r InvariantFailureReport) WriteTo(dir string) (string, error) {
+	if dir == "" {
+		dir = path.Join(os.ExpandEnv("$HOME"), ".simapp", "simulations")
+	}
+
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return "", err
+	}
+
+	bz, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	filePath := path.Join(dir, fmt.Sprintf("seed_%d--invariant--%d.json", r.Seed, time.Now().UnixNano()))
+	if err := os.WriteFile(filePath, bz, 0o600); err != nil {
+		return "", err
+	}
+
+	return filePath, nil
+}
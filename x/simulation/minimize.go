@@ -0,0 +1,389 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// MinimizeOperations returns a subsequence of ops for which fails still returns
+// true, and from which no single operation can be removed without fails
+// returning false. It implements the ddmin delta debugging algorithm, fails
+// being expected to return true for ops.
+func MinimizeOperations[T any](ops []T, fails func([]T) bool) []T {
+	if fails(nil) {
+		return nil
+	}
+
+	n := 2
+	for len(ops) >= 2 {
+		chunks := splitOperations(ops, n)
+
+		reduced := false
+		for _, chunk := range chunks {
+			if fails(chunk) {
+				ops, n, reduced = chunk, 2, true
+				break
+			}
+		}
+
+		if !reduced && n > 2 {
+			for i := range chunks {
+				complement := make([]T, 0, len(ops)-len(chunks[i]))
+				for j, chunk := range chunks {
+					if j != i {
+						complement = append(complement, chunk...)
+					}
+				}
+
+				if fails(complement) {
+					ops, n, reduced = complement, max(n-1, 2), true
+					break
+				}
+			}
+		}
+
+		if !reduced {
+			if n >= len(ops) {
+				break
+			}
+
+			n = min(2*n, len(ops))
+		}
+	}
+
+	return ops
+}
+
+// splitOperations splits ops into n contiguous chunks of almost equal length.
+func splitOperations[T any](ops []T, n int) [][]T {
+	chunks := make([][]T, 0, n)
+	start := 0
+	for i := 0; i < n; i++ {
+		end := start + (len(ops)-start)/(n-i)
+		chunks = append(chunks, ops[start:end])
+		start = end
+	}
+
+	return chunks
+}
+
+// OperationRecord records an operation executed during a simulation so that it
+// can be replayed on its own. Index is the index of the operation within the
+// simulation weighted operations, and the operations it schedules run with
+// random sources derived from Seed.
+type OperationRecord struct {
+	Height    int64           `json:"height" yaml:"height"`
+	Order     int64           `json:"order" yaml:"order"`
+	Index     int             `json:"index" yaml:"index"`
+	Seed      int64           `json:"seed" yaml:"seed"`
+	Operation json.RawMessage `json:"operation" yaml:"operation"`
+}
+
+type recordedBlock struct {
+	height   int64
+	time     time.Time
+	proposer []byte
+	req      *abci.FinalizeBlockRequest
+}
+
+// operationRecorder records the blocks and operations of a simulation. A nil
+// recorder records nothing.
+type operationRecorder struct {
+	blocks []recordedBlock
+	ops    []OperationRecord
+}
+
+// rand returns the random source to run the next operation with. When
+// recording, every operation gets its own source, seeded from r, so that it can
+// be replayed regardless of the operations executed before it.
+func (rec *operationRecorder) rand(r *rand.Rand) (*rand.Rand, int64) {
+	if rec == nil {
+		return r, 0
+	}
+
+	seed := r.Int63()
+	return rand.New(rand.NewSource(seed)), seed
+}
+
+func (rec *operationRecorder) addBlock(height int64, blockTime time.Time, proposer []byte, req *abci.FinalizeBlockRequest) {
+	if rec == nil {
+		return
+	}
+
+	rec.blocks = append(rec.blocks, recordedBlock{height: height, time: blockTime, proposer: proposer, req: req})
+}
+
+// addOperation records an executed operation and returns the operations it
+// scheduled, derived from its seed when recording.
+func (rec *operationRecorder) addOperation(
+	height, order int64, index int, seed int64, opMsg simulation.OperationMsg, futureOps []simulation.FutureOperation,
+) []simulation.FutureOperation {
+	if rec == nil {
+		return futureOps
+	}
+
+	rec.ops = append(rec.ops, OperationRecord{
+		Height:    height,
+		Order:     order,
+		Index:     index,
+		Seed:      seed,
+		Operation: opMsg.MustMarshal(),
+	})
+
+	return deriveFutureOperations(seed, futureOps)
+}
+
+// deriveFutureOperations makes the given future operations ignore the random
+// source they are run with in favor of sources derived from seed, so that they
+// run the same way whenever the operation scheduling them is replayed.
+func deriveFutureOperations(seed int64, futureOps []simulation.FutureOperation) []simulation.FutureOperation {
+	seeds := rand.New(rand.NewSource(seed))
+	for i := range futureOps {
+		op, opSeed := futureOps[i].Op, seeds.Int63()
+		futureOps[i].Op = func(
+			_ *rand.Rand, app simulation.AppEntrypoint, ctx sdk.Context, accounts []simulation.Account, chainID string,
+		) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+			opMsg, futureOps, err := op(rand.New(rand.NewSource(opSeed)), app, ctx, accounts, chainID)
+			return opMsg, deriveFutureOperations(opSeed, futureOps), err
+		}
+	}
+
+	return futureOps
+}
+
+// ReplayApp is a new application, with an empty state, together with the
+// simulation operations and invariants bound to it. The operations must be the
+// same, in the same order, as the ones of the simulation being minimized.
+type ReplayApp struct {
+	App        *baseapp.BaseApp
+	Operations WeightedOperations
+	Invariants *InvariantRegistry
+}
+
+// invariantMinimizer minimizes the operations recorded during a simulation
+// breaking an invariant by replaying them from genesis on new applications.
+type invariantMinimizer struct {
+	cfg        InvariantConfig
+	config     simulation.Config
+	appStateFn simulation.AppStateFn
+	randAccFn  simulation.RandomAccountFn
+	cdc        codec.JSONCodec
+	accounts   []simulation.Account
+	recorder   *operationRecorder
+}
+
+type replayResult struct {
+	app    *baseapp.BaseApp
+	ctx    sdk.Context
+	height int64
+	route  string
+	msg    string
+	broken bool
+}
+
+// replay replays ops, and the operations they schedule, from genesis up to the
+// given height. When route is not empty, the replay stops at the first block
+// where that invariant is broken. Operation errors are ignored.
+func (m *invariantMinimizer) replay(ops []OperationRecord, height int64, route string) (res replayResult, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("replay panicked: %v", r)
+		}
+	}()
+
+	replayApp := m.cfg.AppFactory()
+	res.app = replayApp.App
+
+	r := rand.New(NewByteSource(m.config.FuzzSeed, m.config.Seed))
+	params := RandomParams(r)
+	accs := m.randAccFn(r, params.NumKeys())
+	initChain(r, params, accs, res.app, m.appStateFn, m.config, m.cdc)
+
+	var (
+		operationQueue     = NewOperationQueue()
+		timeOperationQueue []simulation.FutureOperation
+		i                  = 0
+	)
+
+	for _, block := range m.recorder.blocks {
+		if block.height > height {
+			break
+		}
+
+		if _, err := res.app.FinalizeBlock(block.req); err != nil {
+			return res, err
+		}
+
+		res.height = block.height
+		res.ctx = newBlockContext(res.app, block.height, block.time, block.proposer, m.config.ChainID)
+
+		// scheduled operations ignore the random source they are given
+		var futureOps []simulation.FutureOperation
+		for _, op := range operationQueue[int(block.height)] {
+			_, scheduled, _ := op(r, res.app, res.ctx, m.accounts, m.config.ChainID)
+			futureOps = append(futureOps, scheduled...)
+		}
+		delete(operationQueue, int(block.height))
+
+		for len(timeOperationQueue) > 0 && block.time.After(timeOperationQueue[0].BlockTime) {
+			_, scheduled, _ := timeOperationQueue[0].Op(r, res.app, res.ctx, m.accounts, m.config.ChainID)
+			futureOps = append(futureOps, scheduled...)
+			timeOperationQueue = timeOperationQueue[1:]
+		}
+		queueOperations(operationQueue, timeOperationQueue, futureOps)
+
+		for ; i < len(ops) && ops[i].Height == block.height; i++ {
+			op := replayApp.Operations[ops[i].Index].Op()
+			_, scheduled, _ := op(rand.New(rand.NewSource(ops[i].Seed)), res.app, res.ctx, m.accounts, m.config.ChainID)
+			queueOperations(operationQueue, timeOperationQueue, deriveFutureOperations(ops[i].Seed, scheduled))
+		}
+
+		if route != "" {
+			if res.route, res.msg, res.broken = replayApp.Invariants.Check(res.ctx); res.broken && res.route == route {
+				return res, nil
+			}
+			res.broken = false
+		}
+
+		if block.height == height {
+			break
+		}
+
+		if m.config.Commit {
+			res.app.SimWriteState()
+			if _, err := res.app.Commit(); err != nil {
+				return res, err
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// report builds the report of the given invariant failure, minimizing the
+// recorded operations still breaking the invariant when an AppFactory is set.
+func (m *invariantMinimizer) report(route, msg string, height int64) InvariantFailureReport {
+	report := InvariantFailureReport{
+		Seed:            m.config.Seed,
+		FuzzSeed:        m.config.FuzzSeed,
+		Route:           route,
+		Message:         msg,
+		Height:          height,
+		TotalOperations: len(m.recorder.ops),
+		Operations:      m.recorder.ops,
+	}
+
+	if m.cfg.AppFactory == nil {
+		return report
+	}
+
+	replays := 0
+	fails := func(ops []OperationRecord) bool {
+		if m.cfg.MaxReplays > 0 && replays >= m.cfg.MaxReplays {
+			return false
+		}
+		replays++
+
+		res, err := m.replay(ops, height, route)
+		return err == nil && res.broken
+	}
+
+	// the failure must be reproducible for the minimization to make sense
+	if !fails(m.recorder.ops) {
+		return report
+	}
+
+	minimized := MinimizeOperations(m.recorder.ops, fails)
+
+	final, err := m.replay(minimized, height, route)
+	if err != nil || !final.broken {
+		return report
+	}
+
+	report.Minimized = true
+	report.Operations = minimized
+	report.Height = final.height
+	report.Message = final.msg
+
+	if baseline, err := m.replay(nil, final.height, ""); err == nil {
+		report.StateDiff = stateDiff(baseline, final, m.cfg.StoreDecoders)
+	}
+
+	return report
+}
+
+// stateDiff returns the difference between the KV stores of two replays.
+func stateDiff(a, b replayResult, decoders simulation.StoreDecoderRegistry) string {
+	keysA, okA := a.app.CommitMultiStore().(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	keysB, okB := b.app.CommitMultiStore().(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !okA || !okB {
+		return ""
+	}
+
+	storeKeysB := keysB.StoreKeysByName()
+	names := make([]string, 0, len(storeKeysB))
+	for name := range keysA.StoreKeysByName() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var diff string
+	for _, name := range names {
+		keyA := keysA.StoreKeysByName()[name]
+		keyB, ok := storeKeysB[name]
+		if _, isKV := keyA.(*storetypes.KVStoreKey); !isKV || !ok {
+			continue
+		}
+
+		kvAs, kvBs := simtestutil.DiffKVStores(a.ctx.KVStore(keyA), b.ctx.KVStore(keyB), nil)
+		if len(kvAs) == 0 {
+			continue
+		}
+
+		diff += fmt.Sprintf("store %s: %d entries differ\n", name, len(kvAs))
+		for i := range kvAs {
+			// store decoders expect both values to be set
+			if len(kvAs[i].Value) == 0 || len(kvBs[i].Value) == 0 {
+				diff += fmt.Sprintf("store A %X => %X\nstore B %X => %X\n", kvAs[i].Key, kvAs[i].Value, kvBs[i].Key, kvBs[i].Value)
+				continue
+			}
+
+			diff += simtestutil.GetSimulationLog(name, decoders, kvAs[i:i+1], kvBs[i:i+1])
+		}
+	}
+
+	return diff
+}
+
+// newBlockContext returns the context operations of the given block are run with.
+func newBlockContext(app *baseapp.BaseApp, height int64, blockTime time.Time, proposer []byte, chainID string) sdk.Context {
+	return app.NewContextLegacy(false, cmtproto.Header{
+		Height:          height,
+		Time:            blockTime,
+		ProposerAddress: proposer,
+		ChainID:         chainID,
+	}).WithHeaderInfo(header.Info{
+		Height:  height,
+		Time:    blockTime,
+		ChainID: chainID,
+	})
+}
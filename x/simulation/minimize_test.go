@@ -0,0 +1,74 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMinimizeOperations(t *testing.T) {
+	ops := make([]int, 100)
+	for i := range ops {
+		ops[i] = i
+	}
+
+	contains := func(ops []int, op int) bool {
+		for _, o := range ops {
+			if o == op {
+				return true
+			}
+		}
+		return false
+	}
+
+	testCases := []struct {
+		name     string
+		fails    func([]int) bool
+		expected []int
+	}{
+		{
+			name:     "single operation",
+			fails:    func(ops []int) bool { return contains(ops, 42) },
+			expected: []int{42},
+		},
+		{
+			name:     "pair of operations",
+			fails:    func(ops []int) bool { return contains(ops, 3) && contains(ops, 97) },
+			expected: []int{3, 97},
+		},
+		{
+			name:     "several operations",
+			fails:    func(ops []int) bool { return contains(ops, 10) && contains(ops, 11) && contains(ops, 60) },
+			expected: []int{10, 11, 60},
+		},
+		{
+			name:     "no operation",
+			fails:    func([]int) bool { return true },
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, MinimizeOperations(ops, tc.fails))
+		})
+	}
+}
+
+func TestInvariantRegistry(t *testing.T) {
+	ir := NewInvariantRegistry()
+	ir.RegisterRoute("bank", "total-supply", func(sdk.Context) (string, bool) { return "", false })
+	ir.RegisterRoute("staking", "bonded-tokens", func(sdk.Context) (string, bool) { return "bonded tokens mismatch", true })
+	ir.RegisterRoute("staking", "delegations", func(sdk.Context) (string, bool) { return "delegations mismatch", true })
+
+	route, msg, broken := ir.Check(sdk.Context{})
+	require.True(t, broken)
+	require.Equal(t, "staking/bonded-tokens", route)
+	require.Equal(t, "bonded tokens mismatch", msg)
+
+	require.Panics(t, func() {
+		ir.RegisterRoute("bank", "total-supply", func(sdk.Context) (string, bool) { return "", false })
+	})
+}
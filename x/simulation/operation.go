@@ -143,19 +143,21 @@ func (ops WeightedOperations) totalWeight() int {
 	return totalOpWeight
 }
 
-func (ops WeightedOperations) getSelectOpFn() simulation.SelectOpFn {
+// getSelectOpIndexFn returns a function selecting the index of a random
+// operation, biased by the operations weight.
+func (ops WeightedOperations) getSelectOpIndexFn() func(r *rand.Rand) int {
 	totalOpWeight := ops.totalWeight()
 
-	return func(r *rand.Rand) simulation.Operation {
+	return func(r *rand.Rand) int {
 		x := r.Intn(totalOpWeight)
 		for i := 0; i < len(ops); i++ {
 			if x <= ops[i].Weight() {
-				return ops[i].Op()
+				return i
 			}
 
 			x -= ops[i].Weight()
 		}
 		// shouldn't happen
-		return ops[0].Op()
+		return 0
 	}
 }
@@ -15,7 +15,6 @@ import (
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 
 	"cosmossdk.io/core/address"
-	"cosmossdk.io/core/header"
 	corelog "cosmossdk.io/core/log"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -92,6 +91,56 @@ func SimulateFromSeedX(
 	cdc codec.JSONCodec,
 	addressCodec address.Codec,
 	logWriter LogWriter,
+) (exportedParams Params, err error) {
+	tb.Helper()
+	return simulateFromSeed(tb, logger, w, app, appStateFn, randAccFn, ops, blockedAddrs, config, cdc, addressCodec, logWriter, nil)
+}
+
+// SimulateFromSeedWithInvariants tests an application like SimulateFromSeedX,
+// asserting the given invariants along the way. When an invariant is broken,
+// the executed operations are minimized to a sequence still breaking it and a
+// report with the seeds and the resulting state diff is written to disk.
+//
+// Every operation is run with its own random source seeded from the
+// simulation one, so runs with invariants differ from SimulateFromSeedX runs
+// of the same seed.
+func SimulateFromSeedWithInvariants(
+	tb testing.TB,
+	logger corelog.Logger,
+	w io.Writer,
+	app *baseapp.BaseApp,
+	appStateFn simulation.AppStateFn,
+	randAccFn simulation.RandomAccountFn,
+	ops WeightedOperations,
+	blockedAddrs map[string]bool,
+	config simulation.Config,
+	cdc codec.JSONCodec,
+	addressCodec address.Codec,
+	logWriter LogWriter,
+	invariants InvariantConfig,
+) (exportedParams Params, err error) {
+	tb.Helper()
+	if invariants.Registry == nil {
+		return Params{}, errors.New("invariant registry cannot be nil")
+	}
+
+	return simulateFromSeed(tb, logger, w, app, appStateFn, randAccFn, ops, blockedAddrs, config, cdc, addressCodec, logWriter, &invariants)
+}
+
+func simulateFromSeed(
+	tb testing.TB,
+	logger corelog.Logger,
+	w io.Writer,
+	app *baseapp.BaseApp,
+	appStateFn simulation.AppStateFn,
+	randAccFn simulation.RandomAccountFn,
+	ops WeightedOperations,
+	blockedAddrs map[string]bool,
+	config simulation.Config,
+	cdc codec.JSONCodec,
+	addressCodec address.Codec,
+	logWriter LogWriter,
+	invariants *InvariantConfig,
 ) (exportedParams Params, err error) {
 	tb.Helper()
 	// in case we have to end early, don't os.Exit so that we can run cleanup code.
@@ -135,6 +184,12 @@ func SimulateFromSeedX(
 	accs = tmpAccs
 	nextValidators := validators
 
+	// operations are only recorded when they may have to be replayed
+	var recorder *operationRecorder
+	if invariants != nil {
+		recorder = &operationRecorder{}
+	}
+
 	var (
 		pastTimes          []time.Time
 		pastVoteInfos      [][]abci.VoteInfo
@@ -171,6 +226,7 @@ func SimulateFromSeedX(
 		timeOperationQueue,
 		logWriter,
 		config,
+		recorder,
 	)
 
 	if !testingMode {
@@ -197,22 +253,14 @@ func SimulateFromSeedX(
 
 		// Run the BeginBlock handler
 		logWriter.AddEntry(BeginBlockEntry(blockTime, blockHeight))
+		recorder.addBlock(blockHeight, blockTime, proposerAddress, finalizeBlockReq)
 
 		res, err := app.FinalizeBlock(finalizeBlockReq)
 		if err != nil {
 			return params, fmt.Errorf("block finalization failed at height %d: %w", blockHeight, err)
 		}
 
-		ctx := app.NewContextLegacy(false, cmtproto.Header{
-			Height:          blockHeight,
-			Time:            blockTime,
-			ProposerAddress: proposerAddress,
-			ChainID:         config.ChainID,
-		}).WithHeaderInfo(header.Info{
-			Height:  blockHeight,
-			Time:    blockTime,
-			ChainID: config.ChainID,
-		})
+		ctx := newBlockContext(app, blockHeight, blockTime, proposerAddress, config.ChainID)
 
 		// run queued operations; ignores block size if block size is too small
 		numQueuedOpsRan, futureOps := runQueuedOperations(
@@ -238,12 +286,31 @@ func SimulateFromSeedX(
 		})
 		opCount += operations + numQueuedOpsRan + numQueuedTimeOpsRan
 
+		if invariants != nil && blockHeight%invariants.period() == 0 {
+			if route, msg, broken := invariants.Registry.Check(ctx); broken {
+				logWriter.PrintLogs()
+				reportInvariantFailure(tb, logger, route, msg, blockHeight, &invariantMinimizer{
+					cfg:        *invariants,
+					config:     config,
+					appStateFn: appStateFn,
+					randAccFn:  randAccFn,
+					cdc:        cdc,
+					accounts:   accs,
+					recorder:   recorder,
+				})
+			}
+		}
+
 		blockHeight++
 
 		logWriter.AddEntry(EndBlockEntry(blockTime, blockHeight))
 
-		blockTime = blockTime.Add(time.Duration(minTimePerBlock) * time.Second)
-		blockTime = blockTime.Add(time.Duration(int64(r.Intn(int(timeDiff)))) * time.Second)
+		if config.BlockTimeFn != nil {
+			blockTime = config.BlockTimeFn(r, blockHeight, blockTime)
+		} else {
+			blockTime = blockTime.Add(time.Duration(minTimePerBlock) * time.Second)
+			blockTime = blockTime.Add(time.Duration(int64(r.Intn(int(timeDiff)))) * time.Second)
+		}
 		proposerAddress = validators.randomProposer(r)
 
 		if config.Commit {
@@ -285,6 +352,22 @@ func SimulateFromSeedX(
 	return exportedParams, err
 }
 
+// reportInvariantFailure minimizes and reports a broken invariant, failing the test.
+func reportInvariantFailure(tb testing.TB, logger corelog.Logger, route, msg string, height int64, minimizer *invariantMinimizer) {
+	tb.Helper()
+	logger.Error("invariant broken, minimizing the operations", "route", route, "height", height,
+		"operations", len(minimizer.recorder.ops))
+
+	report := minimizer.report(route, msg, height)
+	reportPath, err := report.WriteTo(minimizer.cfg.ReportDir)
+	if err != nil {
+		tb.Fatalf("invariant %s broken at height %d: %s\nfailed to write report: %v", route, height, msg, err)
+	}
+
+	tb.Fatalf("invariant %s broken at height %d: %s\nreproduced by %d of %d operations (minimized: %t) with seed %d, report written to %s",
+		route, report.Height, report.Message, len(report.Operations), report.TotalOperations, report.Minimized, report.Seed, reportPath)
+}
+
 type blockSimFn func(
 	r *rand.Rand,
 	app *baseapp.BaseApp,
@@ -298,12 +381,12 @@ type blockSimFn func(
 func createBlockSimulator(tb testing.TB, printProgress bool, w io.Writer, params Params,
 	event func(route, op, evResult string), ops WeightedOperations,
 	operationQueue OperationQueue, timeOperationQueue []simulation.FutureOperation,
-	logWriter LogWriter, config simulation.Config,
+	logWriter LogWriter, config simulation.Config, recorder *operationRecorder,
 ) blockSimFn {
 	tb.Helper()
 	lastBlockSizeState := 0 // state for [4 * uniform distribution]
 	blocksize := 0
-	selectOp := ops.getSelectOpFn()
+	selectOpIndex := ops.getSelectOpIndexFn()
 
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accounts []simulation.Account, header cmtproto.Header,
@@ -315,8 +398,10 @@ func createBlockSimulator(tb testing.TB, printProgress bool, w io.Writer, params
 		lastBlockSizeState, blocksize = getBlockSize(r, params, lastBlockSizeState, config.BlockSize)
 
 		type opAndR struct {
-			op   simulation.Operation
-			rand *rand.Rand
+			op    simulation.Operation
+			rand  *rand.Rand
+			index int
+			seed  int64
 		}
 
 		opAndRz := make([]opAndR, 0, blocksize)
@@ -324,9 +409,13 @@ func createBlockSimulator(tb testing.TB, printProgress bool, w io.Writer, params
 		// Predetermine the blocksize slice so that we can do things like block
 		// out certain operations without changing the ops that follow.
 		for i := 0; i < blocksize; i++ {
+			index := selectOpIndex(r)
+			opR, seed := recorder.rand(r)
 			opAndRz = append(opAndRz, opAndR{
-				op:   selectOp(r),
-				rand: r,
+				op:    ops[index].Op(),
+				rand:  opR,
+				index: index,
+				seed:  seed,
 			})
 		}
 
@@ -336,6 +425,7 @@ func createBlockSimulator(tb testing.TB, printProgress bool, w io.Writer, params
 			op, r2 := opAndR.op, opAndR.rand
 			opMsg, futureOps, err := op(r2, app, ctx, accounts, config.ChainID)
 			opMsg.LogEvent(event)
+			futureOps = recorder.addOperation(header.Height, int64(i), opAndR.index, opAndR.seed, opMsg, futureOps)
 
 			if !config.Lean || opMsg.OK {
 				logWriter.AddEntry(MsgEntry(header.Time, header.Height, int64(i), opMsg))
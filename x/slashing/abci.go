@@ -29,5 +29,7 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper, cometService comet.Servi
 			return err
 		}
 	}
-	return nil
+
+	// prune the slash events which are past the retention period
+	return k.PruneSlashEvents(ctx, params.SlashEventsRetentionBlocks)
 }
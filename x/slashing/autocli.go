@@ -34,6 +34,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "signing-infos",
 					Short:     "Query signing information of all validators",
 				},
+				{
+					RpcMethod: "SlashesByValidator",
+					Use:       "slashes [validator-addr]",
+					Short:     "Query the slash events of a validator",
+					Long:      "Query the slash events of a validator which have not been pruned yet, ordered by height",
+					Example:   fmt.Sprintf("%s query slashing slashes cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -59,6 +59,17 @@ func (keeper Keeper) InitGenesis(ctx context.Context, stakingKeeper types.Stakin
 		}
 	}
 
+	for _, slashEvent := range data.SlashEvents {
+		valAddr, err := keeper.sk.ValidatorAddressCodec().StringToBytes(slashEvent.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := keeper.setSlashEvent(ctx, valAddr, slashEvent); err != nil {
+			return err
+		}
+	}
+
 	if err := keeper.Params.Set(ctx, data.Params); err != nil {
 		return err
 	}
@@ -100,5 +111,15 @@ func (keeper Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, er
 	if err != nil {
 		return nil, err
 	}
-	return types.NewGenesisState(params, signingInfos, missedBlocks), nil
+
+	slashEvents := make([]types.SlashEvent, 0)
+	err = keeper.SlashEvents.Walk(ctx, nil, func(_ uint64, slashEvent types.SlashEvent) (stop bool, err error) {
+		slashEvents = append(slashEvents, slashEvent)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, signingInfos, missedBlocks, slashEvents), nil
 }
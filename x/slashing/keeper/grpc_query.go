@@ -6,10 +6,12 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/slashing/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -80,3 +82,30 @@ func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRe
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// SlashesByValidator returns the slash events of a validator.
+func (k Keeper) SlashesByValidator(ctx context.Context, req *types.QuerySlashesByValidatorRequest) (*types.QuerySlashesByValidatorResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+	}
+
+	slashes, pageRes, err := query.CollectionPaginate(ctx, k.SlashEventsByValidator, req.Pagination,
+		func(key collections.Pair[sdk.ValAddress, uint64], _ []byte) (types.SlashEvent, error) {
+			return k.SlashEvents.Get(ctx, key.K2())
+		}, query.WithCollectionPaginationPairPrefix[sdk.ValAddress, uint64](valAddr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashesByValidatorResponse{Slashes: slashes, Pagination: pageRes}, nil
+}
@@ -24,6 +24,7 @@ func (k Keeper) Hooks() Hooks {
 }
 
 // AfterValidatorBonded updates the signing info start height or create a new signing info
+// recording the height at which the validator is first bonded
 func (h Hooks) AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	signingInfo, err := h.k.ValidatorSigningInfo.Get(ctx, consAddr)
 	blockHeight := h.k.HeaderService.HeaderInfo(ctx).Height
@@ -41,6 +42,7 @@ func (h Hooks) AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddres
 			false,
 			0,
 		)
+		signingInfo.FirstBondedHeight = blockHeight
 	}
 
 	return h.k.ValidatorSigningInfo.Set(ctx, consAddr, signingInfo)
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/core/header"
 	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -44,3 +45,25 @@ func (s *KeeperTestSuite) TestAfterValidatorCreatedOrRemoved() {
 	_, err = keeper.GetPubkey(ctx, addr.Bytes())
 	require.Error(err)
 }
+
+func (s *KeeperTestSuite) TestAfterValidatorBondedFirstBondedHeight() {
+	ctx, keeper := s.ctx.WithHeaderInfo(header.Info{Height: 10}), s.slashingKeeper
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(consAddr.Bytes())
+	require.NoError(keeper.Hooks().AfterValidatorBonded(ctx, consAddr, valAddr))
+
+	info, err := keeper.ValidatorSigningInfo.Get(ctx, consAddr)
+	require.NoError(err)
+	require.Equal(int64(10), info.StartHeight)
+	require.Equal(int64(10), info.FirstBondedHeight)
+
+	// bonding again only resets the start height
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 20})
+	require.NoError(keeper.Hooks().AfterValidatorBonded(ctx, consAddr, valAddr))
+
+	info, err = keeper.ValidatorSigningInfo.Get(ctx, consAddr)
+	require.NoError(err)
+	require.Equal(int64(20), info.StartHeight)
+	require.Equal(int64(10), info.FirstBondedHeight)
+}
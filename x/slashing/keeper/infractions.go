@@ -134,7 +134,8 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 	maxMissed := signedBlocksWindow - minSignedPerWindow
 
 	// if we are past the minimum height and the validator has missed too many blocks, punish them
-	if height > minHeight && signInfo.MissedBlocksCounter > maxMissed {
+	// unless it is still within the downtime grace window following its first bonding
+	if height > minHeight && signInfo.MissedBlocksCounter > maxMissed && !params.InDowntimeGraceWindow(signInfo.FirstBondedHeight, height) {
 		modifiedSignInfo = true
 		validator, err := k.sk.ValidatorByConsAddr(ctx, consAddr)
 		if err != nil {
@@ -159,6 +160,10 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 				return err
 			}
 
+			if err := k.recordSlashEvent(ctx, consAddr, slashFractionDowntime, power, st.Infraction_INFRACTION_DOWNTIME, coinsBurned); err != nil {
+				return err
+			}
+
 			if err := k.EventService.EventManager(ctx).EmitKV(
 				types.EventTypeSlash,
 				event.NewAttribute(types.AttributeKeyAddress, consStr),
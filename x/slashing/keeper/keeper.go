@@ -36,6 +36,12 @@ type Keeper struct {
 	AddrPubkeyRelation collections.Map[[]byte, cryptotypes.PubKey]
 	// ValidatorMissedBlockBitmap key: ConsAddr | value: byte key for a validator's missed block bitmap chunk
 	ValidatorMissedBlockBitmap collections.Map[collections.Pair[[]byte, uint64], []byte]
+	// SlashEvents key: sequence | value: SlashEvent
+	SlashEvents collections.Map[uint64, types.SlashEvent]
+	// SlashEventSequence is the sequence of the next slash event
+	SlashEventSequence collections.Sequence
+	// SlashEventsByValidator key: ValAddr+sequence | value: none used (index key for slash events by validator)
+	SlashEventsByValidator collections.Map[collections.Pair[sdk.ValAddress, uint64], []byte]
 }
 
 // NewKeeper creates a slashing keeper
@@ -69,6 +75,21 @@ func NewKeeper(environment appmodule.Environment, cdc codec.BinaryCodec, legacyA
 			collections.PairKeyCodec(sdk.LengthPrefixedBytesKey, collections.Uint64Key),
 			collections.BytesValue,
 		),
+		SlashEvents: collections.NewMap(
+			sb,
+			types.SlashEventsKeyPrefix,
+			"slash_events",
+			collections.Uint64Key,
+			codec.CollValue[types.SlashEvent](cdc),
+		),
+		SlashEventSequence: collections.NewSequence(sb, types.SlashEventSequenceKey, "slash_event_sequence"),
+		SlashEventsByValidator: collections.NewMap(
+			sb,
+			types.SlashEventsByValidatorKeyPrefix,
+			"slash_events_by_validator",
+			collections.PairKeyCodec(sdk.ValAddressKey, collections.Uint64Key),
+			collections.BytesValue,
+		),
 	}
 
 	schema, err := sb.Build()
@@ -103,24 +124,20 @@ func (k Keeper) SlashWithInfractionReason(ctx context.Context, consAddr sdk.Cons
 		return err
 	}
 
-	reasonAttr := event.NewAttribute(types.AttributeKeyReason, types.AttributeValueUnspecified)
-	switch infraction {
-	case st.Infraction_INFRACTION_DOUBLE_SIGN:
-		reasonAttr = event.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign)
-	case st.Infraction_INFRACTION_DOWNTIME:
-		reasonAttr = event.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature)
-	}
-
 	consStr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
 	if err != nil {
 		return err
 	}
 
+	if err := k.recordSlashEvent(ctx, consAddr, fraction, power, infraction, coinsBurned); err != nil {
+		return err
+	}
+
 	return k.EventService.EventManager(ctx).EmitKV(
 		types.EventTypeSlash,
 		event.NewAttribute(types.AttributeKeyAddress, consStr),
 		event.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
-		reasonAttr,
+		event.NewAttribute(types.AttributeKeyReason, infractionReason(infraction)),
 		event.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
 	)
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
 	sdkmath "cosmossdk.io/math"
@@ -17,6 +18,7 @@ import (
 	slashingkeeper "cosmossdk.io/x/slashing/keeper"
 	slashingtestutil "cosmossdk.io/x/slashing/testutil"
 	slashingtypes "cosmossdk.io/x/slashing/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec/address"
@@ -102,6 +104,7 @@ func (s *KeeperTestSuite) TestJailAndSlash() {
 		slashFractionDoubleSign,
 		st.Infraction_INFRACTION_UNSPECIFIED,
 	).Return(sdkmath.NewInt(0), nil)
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(s.ctx, consAddr).Return(s.slashedValidator(), nil)
 
 	err = s.slashingKeeper.Slash(
 		s.ctx,
@@ -126,6 +129,7 @@ func (s *KeeperTestSuite) TestJailAndSlashWithInfractionReason() {
 		slashFractionDoubleSign,
 		st.Infraction_INFRACTION_DOUBLE_SIGN,
 	).Return(sdkmath.NewInt(0), nil)
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(s.ctx, consAddr).Return(s.slashedValidator(), nil)
 
 	err = s.slashingKeeper.SlashWithInfractionReason(
 		s.ctx,
@@ -140,6 +144,54 @@ func (s *KeeperTestSuite) TestJailAndSlashWithInfractionReason() {
 	s.Require().NoError(s.slashingKeeper.Jail(s.ctx, consAddr))
 }
 
+func (s *KeeperTestSuite) TestHandleValidatorSignatureDowntimeGrace() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(addr)
+	require.NoError(err)
+	validator, err := stakingtypes.NewValidator(valAddr, pubKey, stakingtypes.Description{})
+	require.NoError(err)
+	valConsAddr := sdk.ConsAddress(pubKey.Address())
+	valConsStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(valConsAddr)
+	require.NoError(err)
+
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), valConsAddr).Return(validator, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().ValidatorIdentifier(gomock.Any(), valConsAddr).Return(valConsAddr, nil).AnyTimes()
+
+	params := slashingtestutil.TestParams()
+	params.SignedBlocksWindow = 10
+	params.DowntimeGraceBlocks = 100
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// the validator was first bonded at height 1 and missed too many blocks
+	info := slashingtypes.NewValidatorSigningInfo(valConsStr, 1, time.Unix(0, 0), false, params.SignedBlocksWindow)
+	info.FirstBondedHeight = 1
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, valConsAddr, info))
+
+	// within the grace window the validator is neither slashed nor jailed
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 50})
+	require.NoError(keeper.HandleValidatorSignatureWithParams(ctx, params, pubKey.Address(), 1, comet.BlockIDFlagAbsent))
+
+	// once the grace window is over the validator is slashed and jailed
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 102})
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), valConsAddr, int64(102-sdk.ValidatorUpdateDelay-1), int64(1), params.SlashFractionDowntime, st.Infraction_INFRACTION_DOWNTIME).Return(sdkmath.NewInt(0), nil)
+	s.stakingKeeper.EXPECT().Jail(gomock.Any(), valConsAddr).Return(nil)
+	require.NoError(keeper.HandleValidatorSignatureWithParams(ctx, params, pubKey.Address(), 1, comet.BlockIDFlagAbsent))
+
+	info, err = keeper.ValidatorSigningInfo.Get(ctx, valConsAddr)
+	require.NoError(err)
+	require.Zero(info.MissedBlocksCounter)
+}
+
+// slashedValidator returns the validator whose consensus address is consAddr.
+func (s *KeeperTestSuite) slashedValidator() stakingtypes.Validator {
+	valAddr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(consAddr)
+	s.Require().NoError(err)
+	return stakingtypes.Validator{OperatorAddress: valAddr}
+}
+
 // ValidatorMissedBlockBitmapKey returns the key for a validator's missed block
 // bitmap chunk.
 func validatorMissedBlockBitmapKey(v sdk.ConsAddress, chunkIndex int64) []byte {
@@ -162,7 +214,7 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 		func(i int64) {
 			s.ctx.KVStore(s.key).Set(validatorMissedBlockBitmapKey(consAddr, index), []byte{})
 		},
-		"7ad1f994d45ec9495ae5f990a3fba100c2cc70167a154c33fb43882dc004eafd",
+		"85be7d63d8909eb51fbf42181b8adcf4604ddd12f581d5c605d5c3a1609bda9a",
 	)
 	s.Require().NoError(err)
 
@@ -174,7 +226,7 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 			err := s.slashingKeeper.SetMissedBlockBitmapChunk(s.ctx, consAddr, index, []byte{})
 			s.Require().NoError(err)
 		},
-		"7ad1f994d45ec9495ae5f990a3fba100c2cc70167a154c33fb43882dc004eafd",
+		"85be7d63d8909eb51fbf42181b8adcf4604ddd12f581d5c605d5c3a1609bda9a",
 	)
 	s.Require().NoError(err)
 }
@@ -118,6 +118,22 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid downtime grace blocks",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:      int64(750),
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    time.Duration(10),
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+					DowntimeGraceBlocks:     -1,
+				},
+			},
+			expectErr: true,
+			expErrMsg: "downtime grace blocks cannot be negative",
+		},
 		{
 			name: "set full valid params",
 			request: &slashingtypes.MsgUpdateParams{
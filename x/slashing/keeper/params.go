@@ -40,3 +40,9 @@ func (k Keeper) SlashFractionDowntime(ctx context.Context) (sdkmath.LegacyDec, e
 	params, err := k.Params.Get(ctx)
 	return params.SlashFractionDowntime, err
 }
+
+// DowntimeGraceBlocks - number of blocks after first bonding exempt from downtime slashing
+func (k Keeper) DowntimeGraceBlocks(ctx context.Context) (int64, error) {
+	params, err := k.Params.Get(ctx)
+	return params.DowntimeGraceBlocks, err
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+
+	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/slashing/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordSlashEvent persists a slash applied to the validator with the given
+// consensus address, so that it can be queried until it gets pruned.
+func (k Keeper) recordSlashEvent(ctx context.Context, consAddr sdk.ConsAddress, fraction sdkmath.LegacyDec, power int64, infraction st.Infraction, burned sdkmath.Int) error {
+	validator, err := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	slashEvent := types.SlashEvent{
+		ValidatorAddress: validator.GetOperator(),
+		Height:           k.HeaderService.HeaderInfo(ctx).Height,
+		Fraction:         fraction,
+		Reason:           infractionReason(infraction),
+		Power:            power,
+		BurnedCoins:      burned,
+	}
+
+	return k.setSlashEvent(ctx, valAddr, slashEvent)
+}
+
+// setSlashEvent stores a slash event under the next sequence and indexes it by
+// validator.
+func (k Keeper) setSlashEvent(ctx context.Context, valAddr sdk.ValAddress, slashEvent types.SlashEvent) error {
+	seq, err := k.SlashEventSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SlashEvents.Set(ctx, seq, slashEvent); err != nil {
+		return err
+	}
+
+	return k.SlashEventsByValidator.Set(ctx, collections.Join(valAddr, seq), []byte{})
+}
+
+// PruneSlashEvents removes the slash events which are older than the given
+// retention, in number of blocks. A zero retention keeps all the events.
+func (k Keeper) PruneSlashEvents(ctx context.Context, retentionBlocks int64) error {
+	if retentionBlocks == 0 {
+		return nil
+	}
+
+	cutoff := k.HeaderService.HeaderInfo(ctx).Height - retentionBlocks
+
+	// sequences are increasing with the height, so stop at the first event
+	// which is still retained
+	var expired []collections.KeyValue[uint64, types.SlashEvent]
+	err := k.SlashEvents.Walk(ctx, nil, func(seq uint64, slashEvent types.SlashEvent) (stop bool, err error) {
+		if slashEvent.Height > cutoff {
+			return true, nil
+		}
+		expired = append(expired, collections.KeyValue[uint64, types.SlashEvent]{Key: seq, Value: slashEvent})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range expired {
+		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(kv.Value.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.SlashEventsByValidator.Remove(ctx, collections.Join(sdk.ValAddress(valAddr), kv.Key)); err != nil {
+			return err
+		}
+
+		if err := k.SlashEvents.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// infractionReason returns the reason recorded for the given infraction.
+func infractionReason(infraction st.Infraction) string {
+	switch infraction {
+	case st.Infraction_INFRACTION_DOUBLE_SIGN:
+		return types.AttributeValueDoubleSign
+	case st.Infraction_INFRACTION_DOWNTIME:
+		return types.AttributeValueMissingSignature
+	default:
+		return types.AttributeValueUnspecified
+	}
+}
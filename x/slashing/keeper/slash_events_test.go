@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/golang/mock/gomock"
+
+	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	slashingtypes "cosmossdk.io/x/slashing/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *KeeperTestSuite) TestSlashEvents() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	validator := s.slashedValidator()
+	fraction := sdkmath.LegacyNewDecWithPrec(5, 2)
+
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, gomock.Any(), int64(10), fraction, gomock.Any()).Return(sdkmath.NewInt(100), nil).AnyTimes()
+
+	// slash the validator at heights 10, 20 and 30
+	for _, height := range []int64{10, 20, 30} {
+		ctx = ctx.WithHeaderInfo(header.Info{Height: height})
+		require.NoError(keeper.SlashWithInfractionReason(ctx, consAddr, fraction, 10, height-1, st.Infraction_INFRACTION_DOUBLE_SIGN))
+	}
+
+	_, err := s.queryClient.SlashesByValidator(gocontext.Background(), &slashingtypes.QuerySlashesByValidatorRequest{})
+	require.ErrorContains(err, "invalid request")
+
+	res, err := s.queryClient.SlashesByValidator(gocontext.Background(), &slashingtypes.QuerySlashesByValidatorRequest{
+		ValidatorAddress: validator.OperatorAddress,
+		Pagination:       &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal(uint64(3), res.Pagination.Total)
+	require.Len(res.Slashes, 2)
+	require.Equal(slashingtypes.SlashEvent{
+		ValidatorAddress: validator.OperatorAddress,
+		Height:           10,
+		Fraction:         fraction,
+		Reason:           slashingtypes.AttributeValueDoubleSign,
+		Power:            10,
+		BurnedCoins:      sdkmath.NewInt(100),
+	}, res.Slashes[0])
+	require.Equal(int64(20), res.Slashes[1].Height)
+
+	res, err = s.queryClient.SlashesByValidator(gocontext.Background(), &slashingtypes.QuerySlashesByValidatorRequest{
+		ValidatorAddress: validator.OperatorAddress,
+		Pagination:       &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Len(res.Slashes, 1)
+	require.Equal(int64(30), res.Slashes[0].Height)
+
+	// another validator has no slash events
+	otherValAddr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(sdk.ValAddress("addr2_______________"))
+	require.NoError(err)
+	res, err = s.queryClient.SlashesByValidator(gocontext.Background(), &slashingtypes.QuerySlashesByValidatorRequest{ValidatorAddress: otherValAddr})
+	require.NoError(err)
+	require.Empty(res.Slashes)
+
+	// the events are exported in genesis
+	genesisState, err := keeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Len(genesisState.SlashEvents, 3)
+
+	// with a retention of 15 blocks at height 35, the events before height 20 are pruned
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 35})
+	require.NoError(keeper.PruneSlashEvents(ctx, 15))
+
+	res, err = s.queryClient.SlashesByValidator(gocontext.Background(), &slashingtypes.QuerySlashesByValidatorRequest{ValidatorAddress: validator.OperatorAddress})
+	require.NoError(err)
+	require.Len(res.Slashes, 1)
+	require.Equal(int64(30), res.Slashes[0].Height)
+
+	// a zero retention keeps all the events
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1000})
+	require.NoError(keeper.PruneSlashEvents(ctx, 0))
+	res, err = s.queryClient.SlashesByValidator(gocontext.Background(), &slashingtypes.QuerySlashesByValidatorRequest{ValidatorAddress: validator.OperatorAddress})
+	require.NoError(err)
+	require.Len(res.Slashes, 1)
+}
@@ -31,12 +31,13 @@ var (
 	_ module.HasGRPCGateway      = AppModule{}
 	_ module.AppModuleSimulation = AppModule{}
 
-	_ appmodule.AppModule             = AppModule{}
-	_ appmodule.HasBeginBlocker       = AppModule{}
-	_ appmodule.HasServices           = AppModule{}
-	_ appmodule.HasMigrations         = AppModule{}
-	_ appmodule.HasGenesis            = AppModule{}
-	_ appmodule.HasRegisterInterfaces = AppModule{}
+	_ appmodule.AppModule              = AppModule{}
+	_ appmodule.HasBeginBlocker        = AppModule{}
+	_ appmodule.HasServices            = AppModule{}
+	_ appmodule.HasMigrations          = AppModule{}
+	_ appmodule.HasGenesis             = AppModule{}
+	_ appmodule.HasRegisterInterfaces  = AppModule{}
+	_ appmodule.HasOrderingConstraints = AppModule{}
 )
 
 // AppModule implements an application module for the slashing module.
@@ -158,6 +159,16 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 	return am.cdc.MarshalJSON(gs)
 }
 
+// OrderingConstraints implements appmodule.HasOrderingConstraints.
+// Slashing happens after distribution so that there is nothing left over in
+// the validator fee pool, and its genesis depends on the staking validators.
+func (AppModule) OrderingConstraints() []appmodule.OrderingConstraint {
+	return []appmodule.OrderingConstraint{
+		{Hook: appmodule.HookBeginBlocker, After: []string{"distribution"}},
+		{Hook: appmodule.HookInitGenesis, After: []string{"staking"}},
+	}
+}
+
 // ConsensusVersion implements HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
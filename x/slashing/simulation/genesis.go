@@ -15,11 +15,13 @@ import (
 
 // Simulation parameter constants
 const (
-	SignedBlocksWindow      = "signed_blocks_window"
-	MinSignedPerWindow      = "min_signed_per_window"
-	DowntimeJailDuration    = "downtime_jail_duration"
-	SlashFractionDoubleSign = "slash_fraction_double_sign"
-	SlashFractionDowntime   = "slash_fraction_downtime"
+	SignedBlocksWindow         = "signed_blocks_window"
+	MinSignedPerWindow         = "min_signed_per_window"
+	DowntimeJailDuration       = "downtime_jail_duration"
+	SlashFractionDoubleSign    = "slash_fraction_double_sign"
+	SlashFractionDowntime      = "slash_fraction_downtime"
+	DowntimeGraceBlocks        = "downtime_grace_blocks"
+	SlashEventsRetentionBlocks = "slash_events_retention_blocks"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -47,6 +49,16 @@ func GenSlashFractionDowntime(r *rand.Rand) math.LegacyDec {
 	return math.LegacyNewDec(1).Quo(math.LegacyNewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimeGraceBlocks randomized DowntimeGraceBlocks
+func GenDowntimeGraceBlocks(r *rand.Rand) int64 {
+	return int64(r.Intn(100))
+}
+
+// GenSlashEventsRetentionBlocks randomized SlashEventsRetentionBlocks
+func GenSlashEventsRetentionBlocks(r *rand.Rand) int64 {
+	return int64(simulation.RandIntBetween(r, 0, 1000))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -64,12 +76,18 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var slashFractionDowntime math.LegacyDec
 	simState.AppParams.GetOrGenerate(SlashFractionDowntime, &slashFractionDowntime, simState.Rand, func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) })
 
+	var downtimeGraceBlocks int64
+	simState.AppParams.GetOrGenerate(DowntimeGraceBlocks, &downtimeGraceBlocks, simState.Rand, func(r *rand.Rand) { downtimeGraceBlocks = GenDowntimeGraceBlocks(r) })
+
+	var slashEventsRetentionBlocks int64
+	simState.AppParams.GetOrGenerate(SlashEventsRetentionBlocks, &slashEventsRetentionBlocks, simState.Rand, func(r *rand.Rand) { slashEventsRetentionBlocks = GenSlashEventsRetentionBlocks(r) })
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, downtimeGraceBlocks, slashEventsRetentionBlocks,
 	)
 
-	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
+	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{}, []types.SlashEvent{})
 
 	bz, err := json.MarshalIndent(&slashingGenesis, "", " ")
 	if err != nil {
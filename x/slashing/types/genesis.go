@@ -9,12 +9,13 @@ import (
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
-	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks,
+	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks, slashEvents []SlashEvent,
 ) *GenesisState {
 	return &GenesisState{
 		Params:       params,
 		SigningInfos: signingInfos,
 		MissedBlocks: missedBlocks,
+		SlashEvents:  slashEvents,
 	}
 }
 
@@ -32,6 +33,7 @@ func DefaultGenesisState() *GenesisState {
 		Params:       DefaultParams(),
 		SigningInfos: []SigningInfo{},
 		MissedBlocks: []ValidatorMissedBlocks{},
+		SlashEvents:  []SlashEvent{},
 	}
 }
 
@@ -62,5 +64,21 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if data.Params.SlashEventsRetentionBlocks < 0 {
+		return fmt.Errorf("slash events retention blocks cannot be negative, is %d", data.Params.SlashEventsRetentionBlocks)
+	}
+
+	for i, slashEvent := range data.SlashEvents {
+		if slashEvent.ValidatorAddress == "" {
+			return fmt.Errorf("slash event %d: missing validator address", i)
+		}
+		if slashEvent.Fraction.IsNil() || slashEvent.Fraction.IsNegative() || slashEvent.Fraction.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("slash event %d: invalid slash fraction", i)
+		}
+		if i > 0 && slashEvent.Height < data.SlashEvents[i-1].Height {
+			return fmt.Errorf("slash event %d: slash events must be ordered by height", i)
+		}
+	}
+
 	return nil
 }
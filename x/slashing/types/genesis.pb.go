@@ -35,6 +35,8 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// slash_events are the slash events which have not been pruned yet.
+	SlashEvents []SlashEvent `protobuf:"bytes,4,rep,name=slash_events,json=slashEvents,proto3" json:"slash_events"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -91,6 +93,13 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetSlashEvents() []SlashEvent {
+	if m != nil {
+		return m.SlashEvents
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -269,34 +278,37 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x31, 0xef, 0xd2, 0x40,
-	0x18, 0xc6, 0x7b, 0x10, 0xd1, 0xff, 0x15, 0x06, 0x2f, 0x88, 0x95, 0xc4, 0x82, 0x44, 0x0d, 0x31,
-	0xa1, 0x0d, 0x38, 0x38, 0x30, 0x59, 0x07, 0xe3, 0x60, 0x62, 0x0a, 0x71, 0x70, 0xb0, 0x39, 0xe8,
-	0x51, 0x2f, 0xd0, 0x3b, 0xd2, 0xb7, 0x12, 0xfc, 0x06, 0x8e, 0x7e, 0x0c, 0xe3, 0xe4, 0xe0, 0xe4,
-	0x27, 0x60, 0x24, 0x4e, 0x4e, 0xc6, 0xc0, 0xe0, 0xd7, 0x30, 0xde, 0x15, 0xa9, 0x86, 0x86, 0xe1,
-	0xbf, 0x34, 0xbd, 0xbb, 0xdf, 0xf3, 0xbc, 0xef, 0xfb, 0xb4, 0x87, 0xef, 0x4d, 0x25, 0xc4, 0x12,
-	0x5c, 0x58, 0x50, 0x78, 0xc3, 0x45, 0xe4, 0xae, 0xfa, 0x13, 0x96, 0xd2, 0xbe, 0x1b, 0x31, 0xc1,
-	0x80, 0x83, 0xb3, 0x4c, 0x64, 0x2a, 0xc9, 0x4d, 0x8d, 0x39, 0x07, 0xcc, 0xc9, 0xb0, 0x66, 0x3d,
-	0x92, 0x91, 0x54, 0x8c, 0xfb, 0xe7, 0x4d, 0xe3, 0xcd, 0xfb, 0x45, 0xae, 0x7f, 0xf5, 0x9a, 0xbb,
-	0xa5, 0xb9, 0x40, 0x1b, 0x64, 0x35, 0xf4, 0xd1, 0x75, 0x1a, 0x73, 0x21, 0x5d, 0xf5, 0xd4, 0x5b,
-	0x9d, 0xf7, 0x25, 0x5c, 0x7d, 0xaa, 0xdb, 0x1a, 0xa5, 0x34, 0x65, 0xc4, 0xc3, 0x95, 0x25, 0x4d,
-	0x68, 0x0c, 0x16, 0x6a, 0xa3, 0xae, 0x39, 0x68, 0x39, 0x05, 0x6d, 0x3a, 0x2f, 0x14, 0xe6, 0x5d,
-	0x6c, 0x7e, 0xb4, 0x8c, 0x8f, 0xbf, 0x3e, 0x3f, 0x40, 0x7e, 0xa6, 0x24, 0x63, 0x5c, 0x03, 0x1e,
-	0x09, 0x2e, 0xa2, 0x80, 0x8b, 0x99, 0x04, 0xab, 0xd4, 0x2e, 0x77, 0xcd, 0xc1, 0xdd, 0x42, 0xab,
-	0x91, 0xa6, 0x9f, 0x89, 0x99, 0xcc, 0xfb, 0x55, 0xe1, 0xb8, 0x0f, 0xe4, 0x35, 0xae, 0xc5, 0x1c,
-	0x80, 0x85, 0xc1, 0x64, 0x21, 0xa7, 0x73, 0xb0, 0xca, 0xca, 0xd5, 0x29, 0x74, 0x7d, 0x49, 0x17,
-	0x3c, 0xa4, 0xa9, 0x4c, 0x9e, 0x2b, 0x99, 0xa7, 0x54, 0xff, 0xf8, 0xc7, 0xb9, 0x83, 0xce, 0x57,
-	0x84, 0xcd, 0x5c, 0x23, 0x64, 0x88, 0xaf, 0xd2, 0x30, 0x4c, 0x18, 0xe8, 0x28, 0x2e, 0xbc, 0x3b,
-	0xdf, 0xbe, 0xf4, 0x6e, 0x67, 0xc5, 0x9e, 0x48, 0x01, 0x4c, 0xc0, 0x5b, 0x78, 0xac, 0x91, 0x51,
-	0x9a, 0x70, 0x11, 0xf9, 0x07, 0x05, 0x11, 0xb8, 0xb1, 0x3a, 0x94, 0x0f, 0xf2, 0x61, 0x58, 0x25,
-	0x15, 0x6b, 0xef, 0x7c, 0xd7, 0x05, 0xa1, 0xd4, 0x57, 0x27, 0x80, 0xce, 0x27, 0x84, 0x6f, 0x9c,
-	0x9c, 0xf7, 0x72, 0x63, 0x8c, 0xff, 0xcf, 0xfc, 0xdc, 0x97, 0xcc, 0x95, 0x2e, 0x4e, 0x7a, 0x88,
-	0xcd, 0x1c, 0x47, 0xea, 0xf8, 0x0a, 0x17, 0x21, 0x5b, 0xab, 0xfe, 0xca, 0xbe, 0x5e, 0x90, 0x06,
-	0xae, 0x68, 0x91, 0x4a, 0xec, 0x9a, 0x9f, 0xad, 0xbc, 0x47, 0x9b, 0x9d, 0x8d, 0xb6, 0x3b, 0x1b,
-	0xfd, 0xdc, 0xd9, 0xe8, 0xc3, 0xde, 0x36, 0xb6, 0x7b, 0xdb, 0xf8, 0xbe, 0xb7, 0x8d, 0x57, 0xd9,
-	0x50, 0x10, 0xce, 0x1d, 0x2e, 0xdd, 0xf5, 0xf1, 0xa6, 0xa4, 0xef, 0x96, 0x0c, 0x26, 0x15, 0xf5,
-	0xc7, 0x3f, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xaf, 0xca, 0xae, 0x5a, 0x9f, 0x03, 0x00, 0x00,
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xb3, 0x09, 0x04, 0xba, 0x4e, 0x25, 0x58, 0x85, 0x62, 0x2a, 0xd5, 0x0d, 0xe1, 0x8f,
+	0x2a, 0xa4, 0xac, 0x9b, 0x72, 0xe0, 0xd0, 0x13, 0x46, 0x08, 0x71, 0x40, 0x42, 0x4e, 0xc5, 0x81,
+	0x03, 0xd6, 0xa6, 0xde, 0x9a, 0x55, 0xe3, 0xdd, 0xc8, 0xb3, 0x58, 0xe5, 0x2d, 0x78, 0x0c, 0xc4,
+	0x89, 0x43, 0x4f, 0x3c, 0x41, 0x8f, 0x15, 0x27, 0xc4, 0x01, 0xa1, 0xe4, 0xc0, 0x91, 0x57, 0x40,
+	0xde, 0x75, 0x52, 0x83, 0x6a, 0xf5, 0xc0, 0xc5, 0xf2, 0xce, 0xfe, 0xe6, 0x9b, 0x99, 0x6f, 0x6c,
+	0x7c, 0x6f, 0x5f, 0x41, 0xaa, 0xc0, 0x87, 0x09, 0x83, 0xb7, 0x42, 0x26, 0x7e, 0x3e, 0x1c, 0x73,
+	0xcd, 0x86, 0x7e, 0xc2, 0x25, 0x07, 0x01, 0x74, 0x9a, 0x29, 0xad, 0xc8, 0x4d, 0x8b, 0xd1, 0x05,
+	0x46, 0x4b, 0x6c, 0xbd, 0x9b, 0xa8, 0x44, 0x19, 0xc6, 0x2f, 0xde, 0x2c, 0xbe, 0x7e, 0xbf, 0x4e,
+	0x75, 0x99, 0x6f, 0xb9, 0x5b, 0x96, 0x8b, 0xac, 0x40, 0x59, 0xc3, 0x5e, 0x5d, 0x67, 0xa9, 0x90,
+	0xca, 0x37, 0x4f, 0x1b, 0xea, 0xff, 0x6e, 0xe2, 0xce, 0x33, 0xdb, 0xd6, 0x48, 0x33, 0xcd, 0x49,
+	0x80, 0xdb, 0x53, 0x96, 0xb1, 0x14, 0x5c, 0xd4, 0x43, 0x5b, 0xce, 0xce, 0x26, 0xad, 0x69, 0x93,
+	0xbe, 0x34, 0x58, 0xb0, 0x72, 0xf2, 0x63, 0xb3, 0xf1, 0xf1, 0xd7, 0xe7, 0x07, 0x28, 0x2c, 0x33,
+	0xc9, 0x1e, 0x5e, 0x05, 0x91, 0x48, 0x21, 0x93, 0x48, 0xc8, 0x03, 0x05, 0x6e, 0xb3, 0xd7, 0xda,
+	0x72, 0x76, 0xee, 0xd6, 0x4a, 0x8d, 0x2c, 0xfd, 0x5c, 0x1e, 0xa8, 0xaa, 0x5e, 0x07, 0xce, 0xe2,
+	0x40, 0xde, 0xe0, 0xd5, 0x54, 0x00, 0xf0, 0x38, 0x1a, 0x4f, 0xd4, 0xfe, 0x21, 0xb8, 0x2d, 0xa3,
+	0x4a, 0x6b, 0x55, 0x5f, 0xb1, 0x89, 0x88, 0x99, 0x56, 0xd9, 0x0b, 0x93, 0x16, 0x98, 0xac, 0xbf,
+	0xf4, 0xd3, 0xca, 0x05, 0xe1, 0xb8, 0x63, 0x24, 0x22, 0x9e, 0x73, 0xa9, 0xc1, 0xbd, 0x64, 0xe4,
+	0xef, 0xd4, 0x37, 0x5d, 0x04, 0x9e, 0x16, 0x6c, 0xb0, 0x51, 0x68, 0x7e, 0x3f, 0x1e, 0x5c, 0x3b,
+	0x5a, 0x6e, 0xa3, 0x37, 0xa4, 0xdb, 0x74, 0xdb, 0xd6, 0x71, 0x60, 0x89, 0x42, 0xff, 0x0b, 0xc2,
+	0x4e, 0x65, 0x5e, 0xb2, 0x8b, 0xaf, 0xb0, 0x38, 0xce, 0x38, 0x58, 0xc7, 0x57, 0x82, 0xdb, 0x5f,
+	0x8f, 0x07, 0x1b, 0x65, 0xd1, 0x27, 0x4a, 0x02, 0x97, 0xf0, 0x0e, 0x1e, 0x5b, 0x64, 0xa4, 0x33,
+	0x21, 0x93, 0x70, 0x91, 0x41, 0x24, 0x5e, 0xcb, 0x17, 0x53, 0x46, 0x55, 0xcf, 0xdd, 0xa6, 0xd9,
+	0xde, 0xe0, 0x62, 0x73, 0x6a, 0xbc, 0xef, 0xe6, 0xe7, 0x00, 0xfd, 0x4f, 0x08, 0xdf, 0x38, 0xd7,
+	0xd6, 0xff, 0x1b, 0x63, 0xef, 0xdf, 0xd5, 0x5e, 0xf4, 0xc1, 0x54, 0x4a, 0xd7, 0x2e, 0xb4, 0xbf,
+	0x8b, 0x9d, 0x0a, 0x47, 0xba, 0xf8, 0xb2, 0x90, 0x31, 0x3f, 0x32, 0xfd, 0xb5, 0x42, 0x7b, 0x20,
+	0x6b, 0xb8, 0x6d, 0x93, 0x8c, 0x63, 0x57, 0xc3, 0xf2, 0x14, 0x3c, 0x3a, 0x99, 0x79, 0xe8, 0x74,
+	0xe6, 0xa1, 0x9f, 0x33, 0x0f, 0x7d, 0x98, 0x7b, 0x8d, 0xd3, 0xb9, 0xd7, 0xf8, 0x36, 0xf7, 0x1a,
+	0xaf, 0xcb, 0xa1, 0x20, 0x3e, 0xa4, 0x42, 0xf9, 0x67, 0x1b, 0xf7, 0xf5, 0xfb, 0x29, 0x87, 0x71,
+	0xdb, 0xfc, 0x58, 0x0f, 0xff, 0x0c, 0x00, 0x11, 0x7f, 0x2e, 0x97, 0x06, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -319,6 +331,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SlashEvents) > 0 {
+		for iNdEx := len(m.SlashEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -513,6 +539,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SlashEvents) > 0 {
+		for _, e := range m.SlashEvents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -701,6 +733,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashEvents = append(m.SlashEvents, SlashEvent{})
+			if err := m.SlashEvents[len(m.SlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
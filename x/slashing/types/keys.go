@@ -50,12 +50,21 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><chunk_index>: bitmap_chunk
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<sequence>: SlashEvent
+//
+// - 0x05: slash event sequence
+//
+// - 0x06<valAddrLen (1 Byte)><valAddr_Bytes><sequence>: []byte{}
 
 var (
 	ParamsKey                           = collections.NewPrefix(0) // Prefix for params key
 	ValidatorSigningInfoKeyPrefix       = collections.NewPrefix(1) // Prefix for signing info
 	ValidatorMissedBlockBitmapKeyPrefix = collections.NewPrefix(2) // Prefix for missed block bitmap
 	AddrPubkeyRelationKeyPrefix         = collections.NewPrefix(3) // Prefix for address-pubkey relation
+	SlashEventsKeyPrefix                = collections.NewPrefix(4) // Prefix for slash events
+	SlashEventSequenceKey               = collections.NewPrefix(5) // Key for the slash event sequence
+	SlashEventsByValidatorKeyPrefix     = collections.NewPrefix(6) // Prefix for slash events by validator index
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -9,8 +9,10 @@ import (
 
 // Default parameter namespace
 const (
-	DefaultSignedBlocksWindow   = int64(100)
-	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultSignedBlocksWindow         = int64(100)
+	DefaultDowntimeJailDuration       = 60 * 10 * time.Second
+	DefaultDowntimeGraceBlocks        = int64(0)
+	DefaultSlashEventsRetentionBlocks = int64(1_000_000)
 )
 
 var (
@@ -22,14 +24,16 @@ var (
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow math.LegacyDec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime math.LegacyDec,
+	slashFractionDoubleSign, slashFractionDowntime math.LegacyDec, downtimeGraceBlocks, slashEventsRetentionBlocks int64,
 ) Params {
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:         signedBlocksWindow,
+		MinSignedPerWindow:         minSignedPerWindow,
+		DowntimeJailDuration:       downtimeJailDuration,
+		SlashFractionDoubleSign:    slashFractionDoubleSign,
+		SlashFractionDowntime:      slashFractionDowntime,
+		DowntimeGraceBlocks:        downtimeGraceBlocks,
+		SlashEventsRetentionBlocks: slashEventsRetentionBlocks,
 	}
 }
 
@@ -41,6 +45,8 @@ func DefaultParams() Params {
 		DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign,
 		DefaultSlashFractionDowntime,
+		DefaultDowntimeGraceBlocks,
+		DefaultSlashEventsRetentionBlocks,
 	)
 }
 
@@ -61,6 +67,12 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateDowntimeGraceBlocks(p.DowntimeGraceBlocks); err != nil {
+		return err
+	}
+	if err := validateSlashEventsRetentionBlocks(p.SlashEventsRetentionBlocks); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -147,6 +159,32 @@ func validateSlashFractionDowntime(i interface{}) error {
 	return nil
 }
 
+func validateDowntimeGraceBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime grace blocks cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateSlashEventsRetentionBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("slash events retention blocks cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
 // MinSignedPerWindowInt returns min signed per window as an integer (vs the decimal in the param)
 func (p *Params) MinSignedPerWindowInt() int64 {
 	signedBlocksWindow := p.SignedBlocksWindow
@@ -156,3 +194,9 @@ func (p *Params) MinSignedPerWindowInt() int64 {
 	//       less than 1.
 	return minSignedPerWindow.MulInt64(signedBlocksWindow).RoundInt64()
 }
+
+// InDowntimeGraceWindow returns true if a validator first bonded at the given
+// height is still exempt from downtime slashing at the current height.
+func (p *Params) InDowntimeGraceWindow(firstBondedHeight, height int64) bool {
+	return p.DowntimeGraceBlocks > 0 && height <= firstBondedHeight+p.DowntimeGraceBlocks
+}
@@ -309,6 +309,117 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QuerySlashesByValidatorRequest is the request type for the
+// Query/SlashesByValidator RPC method
+type QuerySlashesByValidatorRequest struct {
+	// validator_address is the operator address of the validator to query slash
+	// events of.
+	ValidatorAddress string             `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashesByValidatorRequest) Reset()         { *m = QuerySlashesByValidatorRequest{} }
+func (m *QuerySlashesByValidatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashesByValidatorRequest) ProtoMessage()    {}
+func (*QuerySlashesByValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QuerySlashesByValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashesByValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashesByValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashesByValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashesByValidatorRequest.Merge(m, src)
+}
+func (m *QuerySlashesByValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashesByValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashesByValidatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashesByValidatorRequest proto.InternalMessageInfo
+
+func (m *QuerySlashesByValidatorRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QuerySlashesByValidatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySlashesByValidatorResponse is the response type for the
+// Query/SlashesByValidator RPC method
+type QuerySlashesByValidatorResponse struct {
+	// slashes are the slash events of the validator, ordered by height.
+	Slashes    []SlashEvent        `protobuf:"bytes,1,rep,name=slashes,proto3" json:"slashes"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashesByValidatorResponse) Reset()         { *m = QuerySlashesByValidatorResponse{} }
+func (m *QuerySlashesByValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashesByValidatorResponse) ProtoMessage()    {}
+func (*QuerySlashesByValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QuerySlashesByValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashesByValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashesByValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashesByValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashesByValidatorResponse.Merge(m, src)
+}
+func (m *QuerySlashesByValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashesByValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashesByValidatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashesByValidatorResponse proto.InternalMessageInfo
+
+func (m *QuerySlashesByValidatorResponse) GetSlashes() []SlashEvent {
+	if m != nil {
+		return m.Slashes
+	}
+	return nil
+}
+
+func (m *QuerySlashesByValidatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -316,6 +427,8 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QuerySlashesByValidatorRequest)(nil), "cosmos.slashing.v1beta1.QuerySlashesByValidatorRequest")
+	proto.RegisterType((*QuerySlashesByValidatorResponse)(nil), "cosmos.slashing.v1beta1.QuerySlashesByValidatorResponse")
 }
 
 func init() {
@@ -323,43 +436,50 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x06, 0x3a, 0x29, 0xa2, 0x63, 0xa1, 0x6d, 0xd0, 0x8d, 0x5d, 0x21, 0x2d,
-	0xd5, 0xee, 0x98, 0x88, 0xf4, 0xe4, 0xc1, 0x28, 0x8a, 0xe0, 0x41, 0x53, 0x10, 0xf4, 0x12, 0x66,
-	0x9b, 0xe9, 0x38, 0xb8, 0x99, 0xd9, 0xee, 0x6c, 0x82, 0x45, 0xf4, 0xe0, 0xd9, 0x83, 0xe0, 0x67,
-	0x10, 0x3c, 0xaa, 0xf8, 0x21, 0x7a, 0x2c, 0x7a, 0xf1, 0x24, 0x92, 0x08, 0xde, 0xfd, 0x04, 0xb2,
-	0x33, 0x93, 0x64, 0x4a, 0x5c, 0x4d, 0xe9, 0x25, 0x0c, 0x6f, 0xde, 0xff, 0xfd, 0x7f, 0xef, 0xe5,
-	0xcd, 0xc2, 0x8b, 0xdb, 0x52, 0x75, 0xa4, 0xc2, 0x2a, 0x22, 0xea, 0x09, 0x17, 0x0c, 0xf7, 0x6a,
-	0x21, 0x4d, 0x49, 0x0d, 0xef, 0x76, 0x69, 0xb2, 0x17, 0xc4, 0x89, 0x4c, 0x25, 0x5a, 0x34, 0x49,
-	0xc1, 0x30, 0x29, 0xb0, 0x49, 0xe5, 0x75, 0xab, 0x0e, 0x89, 0xa2, 0x46, 0x31, 0xd2, 0xc7, 0x84,
-	0x71, 0x41, 0x52, 0x2e, 0x85, 0x29, 0x52, 0x5e, 0x60, 0x92, 0x49, 0x7d, 0xc4, 0xd9, 0xc9, 0x46,
-	0xcf, 0x31, 0x29, 0x59, 0x44, 0x31, 0x89, 0x39, 0x26, 0x42, 0xc8, 0x54, 0x4b, 0x94, 0xbd, 0xad,
-	0xe6, 0xd1, 0x8d, 0x48, 0x4c, 0xde, 0xb2, 0xc9, 0x6b, 0x99, 0xf2, 0x96, 0xd6, 0x5c, 0x9d, 0x21,
-	0x1d, 0x2e, 0x24, 0xd6, 0xbf, 0x26, 0xe4, 0x2f, 0x40, 0xf4, 0x20, 0x63, 0xbd, 0x4f, 0x12, 0xd2,
-	0x51, 0x4d, 0xba, 0xdb, 0xa5, 0x2a, 0xf5, 0x1f, 0xc1, 0xb3, 0x87, 0xa2, 0x2a, 0x96, 0x42, 0x51,
-	0xd4, 0x80, 0xc5, 0x58, 0x47, 0x96, 0xc0, 0x05, 0xb0, 0x56, 0xaa, 0x57, 0x82, 0x9c, 0x61, 0x04,
-	0x46, 0xd8, 0x98, 0xdb, 0xff, 0x5e, 0x29, 0xbc, 0xff, 0xf5, 0x61, 0x1d, 0x34, 0xad, 0xd2, 0x6f,
-	0xc1, 0x45, 0x5d, 0x7a, 0x8b, 0x33, 0xc1, 0x05, 0xbb, 0x2b, 0x76, 0xa4, 0x75, 0x45, 0xb7, 0xe0,
-	0xfc, 0xb6, 0x14, 0xaa, 0x45, 0xda, 0xed, 0x84, 0x2a, 0x63, 0x32, 0xd7, 0x58, 0xf9, 0xf2, 0x79,
-	0xe3, 0xbc, 0xf5, 0xb9, 0x99, 0x61, 0x08, 0xd5, 0x55, 0x37, 0x4c, 0xca, 0x56, 0x9a, 0x70, 0xc1,
-	0x9a, 0xa5, 0x4c, 0x66, 0x43, 0xfe, 0x4b, 0xb8, 0x34, 0x69, 0x60, 0x1b, 0x08, 0xe1, 0xe9, 0x1e,
-	0x89, 0x5a, 0xca, 0x5c, 0xb5, 0xb8, 0xd8, 0x91, 0xb6, 0x95, 0x8d, 0xdc, 0x56, 0x1e, 0x92, 0x88,
-	0xb7, 0x49, 0x2a, 0x13, 0xa7, 0xa0, 0xdb, 0xd8, 0xa9, 0x1e, 0x89, 0x9c, 0x2b, 0x3f, 0x9c, 0xf4,
-	0x1f, 0xce, 0x15, 0xdd, 0x86, 0x70, 0xbc, 0x0b, 0xd6, 0xb9, 0x3a, 0x74, 0xce, 0x16, 0x27, 0x30,
-	0xab, 0x36, 0x1e, 0x23, 0xa3, 0x56, 0xdb, 0x74, 0x94, 0xfe, 0x27, 0x00, 0x97, 0xff, 0x62, 0x62,
-	0xbb, 0xbc, 0x07, 0x4f, 0xd8, 0xce, 0x66, 0x8f, 0xd5, 0x99, 0xae, 0x82, 0xee, 0x1c, 0x62, 0x9e,
-	0xd1, 0xcc, 0xab, 0xff, 0x65, 0x36, 0x28, 0x2e, 0x74, 0xfd, 0xf7, 0x2c, 0x3c, 0xa9, 0xa1, 0xd1,
-	0x6b, 0x00, 0x8b, 0x66, 0x43, 0xd0, 0xa5, 0x5c, 0xba, 0xc9, 0xb5, 0x2c, 0x5f, 0x9e, 0x2e, 0xd9,
-	0x78, 0xfb, 0xab, 0xaf, 0xbe, 0xfe, 0x7c, 0x3b, 0xb3, 0x82, 0x2a, 0x38, 0xef, 0xe5, 0x98, 0x95,
-	0x44, 0x1f, 0x01, 0x2c, 0x39, 0x23, 0x40, 0x57, 0xfe, 0x6d, 0x33, 0xb9, 0xb9, 0xe5, 0xda, 0x11,
-	0x14, 0x96, 0xee, 0xba, 0xa6, 0xdb, 0x44, 0xd7, 0x72, 0xe9, 0xdc, 0x2d, 0x55, 0xf8, 0xb9, 0xfb,
-	0x34, 0x5e, 0xa0, 0x77, 0x00, 0xce, 0xbb, 0x7f, 0x3e, 0x9a, 0x1e, 0x61, 0x34, 0xce, 0xfa, 0x51,
-	0x24, 0x16, 0x3b, 0xd0, 0xd8, 0x6b, 0xa8, 0x3a, 0x1d, 0x76, 0x63, 0x73, 0xbf, 0xef, 0x81, 0x83,
-	0xbe, 0x07, 0x7e, 0xf4, 0x3d, 0xf0, 0x66, 0xe0, 0x15, 0x0e, 0x06, 0x5e, 0xe1, 0xdb, 0xc0, 0x2b,
-	0x3c, 0xb6, 0x6f, 0x5a, 0xb5, 0x9f, 0x06, 0x5c, 0xe2, 0x67, 0xe3, 0x42, 0xe9, 0x5e, 0x4c, 0x55,
-	0x58, 0xd4, 0xdf, 0xa7, 0xab, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x12, 0x73, 0x05, 0x05, 0x95,
-	0x05, 0x00, 0x00,
+	// 686 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x4f, 0x13, 0x4f,
+	0x14, 0xc7, 0x3b, 0xfc, 0x7e, 0x60, 0x18, 0x88, 0x81, 0x91, 0x04, 0x68, 0x74, 0x0b, 0x4b, 0x02,
+	0x04, 0x65, 0x87, 0x62, 0x0c, 0x5e, 0x8c, 0xa1, 0x2a, 0x6a, 0x62, 0x8c, 0x96, 0xc4, 0x44, 0x3d,
+	0x34, 0x53, 0x3a, 0xac, 0x1b, 0xcb, 0xcc, 0xb2, 0xb3, 0x6c, 0x24, 0x04, 0x0f, 0x9e, 0x3d, 0x98,
+	0xf8, 0x37, 0x98, 0x78, 0x54, 0xc3, 0xc1, 0x3f, 0x81, 0x78, 0x22, 0x7a, 0xd1, 0x8b, 0x31, 0x2d,
+	0x89, 0xff, 0x86, 0xd9, 0x99, 0xd9, 0xed, 0xd6, 0x65, 0xa1, 0x55, 0x2f, 0xcd, 0x66, 0xe6, 0x7d,
+	0xdf, 0xf7, 0xf3, 0x5e, 0xde, 0xbc, 0xc2, 0xa9, 0x35, 0x2e, 0x36, 0xb8, 0xc0, 0xa2, 0x4e, 0xc4,
+	0x13, 0x87, 0xd9, 0x38, 0x28, 0x56, 0xa9, 0x4f, 0x8a, 0x78, 0x73, 0x8b, 0x7a, 0xdb, 0x96, 0xeb,
+	0x71, 0x9f, 0xa3, 0x51, 0x15, 0x64, 0x45, 0x41, 0x96, 0x0e, 0xca, 0xcf, 0x69, 0x75, 0x95, 0x08,
+	0xaa, 0x14, 0xb1, 0xde, 0x25, 0xb6, 0xc3, 0x88, 0xef, 0x70, 0xa6, 0x92, 0xe4, 0x47, 0x6c, 0x6e,
+	0x73, 0xf9, 0x89, 0xc3, 0x2f, 0x7d, 0x7a, 0xd6, 0xe6, 0xdc, 0xae, 0x53, 0x4c, 0x5c, 0x07, 0x13,
+	0xc6, 0xb8, 0x2f, 0x25, 0x42, 0xdf, 0x4e, 0x67, 0xd1, 0xc5, 0x24, 0x2a, 0x6e, 0x5c, 0xc5, 0x55,
+	0x54, 0x7a, 0x4d, 0xab, 0xae, 0x86, 0xc9, 0x86, 0xc3, 0x38, 0x96, 0xbf, 0xea, 0xc8, 0x1c, 0x81,
+	0xe8, 0x7e, 0xc8, 0x7a, 0x8f, 0x78, 0x64, 0x43, 0x94, 0xe9, 0xe6, 0x16, 0x15, 0xbe, 0xf9, 0x10,
+	0x9e, 0x69, 0x3b, 0x15, 0x2e, 0x67, 0x82, 0xa2, 0x12, 0xec, 0x73, 0xe5, 0xc9, 0x18, 0x98, 0x00,
+	0xb3, 0x03, 0x8b, 0x05, 0x2b, 0xa3, 0x19, 0x96, 0x12, 0x96, 0xfa, 0xf7, 0xbf, 0x17, 0x72, 0x6f,
+	0x7f, 0xbe, 0x9b, 0x03, 0x65, 0xad, 0x34, 0x2b, 0x70, 0x54, 0xa6, 0x5e, 0x75, 0x6c, 0xe6, 0x30,
+	0xfb, 0x36, 0x5b, 0xe7, 0xda, 0x15, 0x5d, 0x87, 0x83, 0x6b, 0x9c, 0x89, 0x0a, 0xa9, 0xd5, 0x3c,
+	0x2a, 0x94, 0x49, 0x7f, 0x69, 0xf2, 0xf3, 0xde, 0xfc, 0x39, 0xed, 0x73, 0x2d, 0xc4, 0x60, 0x62,
+	0x4b, 0x2c, 0xab, 0x90, 0x55, 0xdf, 0x73, 0x98, 0x5d, 0x1e, 0x08, 0x65, 0xfa, 0xc8, 0x7c, 0x0e,
+	0xc7, 0xd2, 0x06, 0xba, 0x80, 0x2a, 0x1c, 0x0a, 0x48, 0xbd, 0x22, 0xd4, 0x55, 0xc5, 0x61, 0xeb,
+	0x5c, 0x97, 0x32, 0x9f, 0x59, 0xca, 0x03, 0x52, 0x77, 0x6a, 0xc4, 0xe7, 0x5e, 0x22, 0x61, 0xb2,
+	0xb0, 0xd3, 0x01, 0xa9, 0x27, 0xae, 0xcc, 0x6a, 0xda, 0x3f, 0xea, 0x2b, 0x5a, 0x81, 0xb0, 0x35,
+	0x0b, 0xda, 0x79, 0x3a, 0x72, 0x0e, 0x07, 0xc7, 0x52, 0xa3, 0xd6, 0x6a, 0xa3, 0x4d, 0xb5, 0xb6,
+	0x9c, 0x50, 0x9a, 0x1f, 0x00, 0x1c, 0x3f, 0xc2, 0x44, 0x57, 0x79, 0x07, 0xfe, 0xaf, 0x2b, 0xfb,
+	0xef, 0xaf, 0x2a, 0x93, 0x59, 0xd0, 0xcd, 0x36, 0xe6, 0x1e, 0xc9, 0x3c, 0x73, 0x22, 0xb3, 0x42,
+	0x69, 0x83, 0xfe, 0x08, 0xa0, 0xa1, 0xa0, 0x43, 0x10, 0x2a, 0x4a, 0xdb, 0x31, 0x40, 0xd4, 0x9f,
+	0xbb, 0x70, 0x38, 0x88, 0xce, 0x8e, 0x19, 0x83, 0x58, 0xd7, 0x3e, 0x06, 0x43, 0xc1, 0x6f, 0xe7,
+	0x68, 0xe5, 0x08, 0xf6, 0x3f, 0xe9, 0xf7, 0x1e, 0x80, 0x85, 0x4c, 0x74, 0xdd, 0xf5, 0x5b, 0xf0,
+	0x94, 0x50, 0xb7, 0xba, 0xf1, 0x53, 0x99, 0x8d, 0x97, 0x59, 0x6e, 0x04, 0x94, 0xf9, 0xc9, 0x76,
+	0x47, 0xf2, 0x7f, 0xd6, 0xf1, 0xc5, 0x6f, 0xbd, 0xb0, 0x57, 0x62, 0xa3, 0x97, 0x00, 0xf6, 0xa9,
+	0x37, 0x89, 0xce, 0x67, 0x62, 0xa5, 0x17, 0x41, 0xfe, 0x42, 0x67, 0xc1, 0xca, 0xdb, 0x9c, 0x79,
+	0xf1, 0xe5, 0xf0, 0x75, 0xcf, 0x24, 0x2a, 0xe0, 0xac, 0x5d, 0xa5, 0x96, 0x00, 0x7a, 0x0f, 0xe0,
+	0x40, 0x62, 0xe8, 0xd0, 0xc2, 0xf1, 0x36, 0xe9, 0x5d, 0x91, 0x2f, 0x76, 0xa1, 0xd0, 0x74, 0x57,
+	0x24, 0xdd, 0x12, 0xba, 0x94, 0x49, 0x97, 0xdc, 0x0b, 0x02, 0xef, 0x24, 0x97, 0xd1, 0x2e, 0x7a,
+	0x03, 0xe0, 0x60, 0x22, 0xad, 0x40, 0x9d, 0x23, 0xc4, 0xed, 0x5c, 0xec, 0x46, 0xa2, 0xb1, 0x2d,
+	0x89, 0x3d, 0x8b, 0xa6, 0x3b, 0xc3, 0x46, 0x87, 0x00, 0xa2, 0xf4, 0x98, 0xa2, 0xa5, 0x13, 0xac,
+	0xb3, 0xde, 0x64, 0xfe, 0x72, 0xf7, 0x42, 0x4d, 0xfe, 0xf8, 0xd3, 0xde, 0xfc, 0xd0, 0xb3, 0x98,
+	0x77, 0xa2, 0x68, 0x2d, 0x58, 0x0b, 0xb2, 0x9a, 0x65, 0x74, 0x35, 0xb3, 0x9a, 0xf8, 0x11, 0x0b,
+	0xbc, 0x93, 0x5a, 0x06, 0xbb, 0x58, 0x3f, 0x92, 0xd2, 0xd2, 0x7e, 0xc3, 0x00, 0x07, 0x0d, 0x03,
+	0xfc, 0x68, 0x18, 0xe0, 0x55, 0xd3, 0xc8, 0x1d, 0x34, 0x8d, 0xdc, 0xd7, 0xa6, 0x91, 0x7b, 0xa4,
+	0xb7, 0x84, 0xa8, 0x3d, 0xb5, 0x1c, 0x8e, 0x5b, 0xfe, 0xd8, 0xdf, 0x76, 0xa9, 0xa8, 0xf6, 0xc9,
+	0x3f, 0xbe, 0x8b, 0xbf, 0x06, 0x00, 0xf1, 0xda, 0x94, 0x34, 0xee, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -380,6 +500,8 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// SlashesByValidator queries the slash events of a validator.
+	SlashesByValidator(ctx context.Context, in *QuerySlashesByValidatorRequest, opts ...grpc.CallOption) (*QuerySlashesByValidatorResponse, error)
 }
 
 type queryClient struct {
@@ -417,6 +539,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) SlashesByValidator(ctx context.Context, in *QuerySlashesByValidatorRequest, opts ...grpc.CallOption) (*QuerySlashesByValidatorResponse, error) {
+	out := new(QuerySlashesByValidatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/SlashesByValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -425,6 +556,8 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// SlashesByValidator queries the slash events of a validator.
+	SlashesByValidator(context.Context, *QuerySlashesByValidatorRequest) (*QuerySlashesByValidatorResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -440,6 +573,9 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) SlashesByValidator(ctx context.Context, req *QuerySlashesByValidatorRequest) (*QuerySlashesByValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashesByValidator not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -499,6 +635,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SlashesByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashesByValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashesByValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/SlashesByValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashesByValidator(ctx, req.(*QuerySlashesByValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -515,6 +669,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "SlashesByValidator",
+			Handler:    _Query_SlashesByValidator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -723,6 +881,97 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySlashesByValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashesByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashesByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashesByValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashesByValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashesByValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -810,6 +1059,42 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySlashesByValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashesByValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1320,6 +1605,244 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySlashesByValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashesByValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashesByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashesByValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashesByValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashesByValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashes = append(m.Slashes, SlashEvent{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
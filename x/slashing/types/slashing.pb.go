@@ -50,6 +50,9 @@ type ValidatorSigningInfo struct {
 	// A counter of missed (unsigned) blocks. It is used to avoid unnecessary
 	// reads in the missed block bitmap.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// Height at which the validator was first bonded. Unlike start_height, it is
+	// not reset when the validator is re-bonded or un-jailed.
+	FirstBondedHeight int64 `protobuf:"varint,7,opt,name=first_bonded_height,json=firstBondedHeight,proto3" json:"first_bonded_height,omitempty"`
 }
 
 func (m *ValidatorSigningInfo) Reset()         { *m = ValidatorSigningInfo{} }
@@ -128,6 +131,13 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetFirstBondedHeight() int64 {
+	if m != nil {
+		return m.FirstBondedHeight
+	}
+	return 0
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                       `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -135,6 +145,13 @@ type Params struct {
 	DowntimeJailDuration    time.Duration               `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+	// downtime_grace_blocks is the number of blocks after a validator is first
+	// bonded during which it is not slashed nor jailed for downtime. Zero
+	// disables the grace window.
+	DowntimeGraceBlocks int64 `protobuf:"varint,6,opt,name=downtime_grace_blocks,json=downtimeGraceBlocks,proto3" json:"downtime_grace_blocks,omitempty"`
+	// slash_events_retention_blocks is the number of blocks during which slash
+	// events are kept in state before being pruned. Zero disables the pruning.
+	SlashEventsRetentionBlocks int64 `protobuf:"varint,7,opt,name=slash_events_retention_blocks,json=slashEventsRetentionBlocks,proto3" json:"slash_events_retention_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -184,9 +201,102 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetDowntimeGraceBlocks() int64 {
+	if m != nil {
+		return m.DowntimeGraceBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSlashEventsRetentionBlocks() int64 {
+	if m != nil {
+		return m.SlashEventsRetentionBlocks
+	}
+	return 0
+}
+
+// SlashEvent records a slash applied to a validator.
+type SlashEvent struct {
+	// validator_address is the operator address of the slashed validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// height is the block height at which the slash was applied.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// fraction is the fraction of the validator stake which was slashed.
+	Fraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fraction"`
+	// reason is the reason of the slash, i.e. double_sign, missing_signature or
+	// unspecified.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// power is the voting power of the validator at the infraction height.
+	Power int64 `protobuf:"varint,5,opt,name=power,proto3" json:"power,omitempty"`
+	// burned_coins is the amount of tokens burned by the slash.
+	BurnedCoins cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=burned_coins,json=burnedCoins,proto3,customtype=cosmossdk.io/math.Int" json:"burned_coins"`
+}
+
+func (m *SlashEvent) Reset()         { *m = SlashEvent{} }
+func (m *SlashEvent) String() string { return proto.CompactTextString(m) }
+func (*SlashEvent) ProtoMessage()    {}
+func (*SlashEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *SlashEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashEvent.Merge(m, src)
+}
+func (m *SlashEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashEvent proto.InternalMessageInfo
+
+func (m *SlashEvent) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SlashEvent) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SlashEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SlashEvent) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*SlashEvent)(nil), "cosmos.slashing.v1beta1.SlashEvent")
 }
 
 func init() {
@@ -194,47 +304,60 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0x00, 0x3f, 0x7e, 0x3a, 0xad, 0x07, 0xc7, 0x22, 0x4b, 0x95, 0x6d, 0x21, 0xd1, 0x34,
-	0x24, 0xec, 0x0a, 0x26, 0x1e, 0xe0, 0x64, 0x69, 0x8c, 0x1a, 0x12, 0xc9, 0xe2, 0x9f, 0xc4, 0x83,
-	0x9b, 0xd9, 0x9d, 0xe9, 0x76, 0x64, 0x77, 0xa6, 0xd9, 0x99, 0x15, 0xf8, 0x0a, 0x26, 0x26, 0x1c,
-	0x3d, 0x7a, 0xe4, 0xc8, 0x81, 0xaf, 0x60, 0xc2, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0x94, 0x03, 0x7e,
-	0x0c, 0xb3, 0x33, 0xbb, 0x80, 0x70, 0xe3, 0xd2, 0xb4, 0xcf, 0xf3, 0xbc, 0xcf, 0x3b, 0xef, 0xf3,
-	0xbe, 0x29, 0x7c, 0x18, 0x0a, 0x99, 0x08, 0xe9, 0xca, 0x18, 0xcb, 0x3e, 0xe3, 0x91, 0xfb, 0x69,
-	0x21, 0xa0, 0x0a, 0x2f, 0x9c, 0x01, 0xce, 0x20, 0x15, 0x4a, 0xa0, 0x49, 0xa3, 0x73, 0xce, 0xe0,
-	0x42, 0xd7, 0xa8, 0x47, 0x22, 0x12, 0x5a, 0xe3, 0xe6, 0xdf, 0x8c, 0xbc, 0x61, 0x47, 0x42, 0x44,
-	0x31, 0x75, 0xf5, 0xaf, 0x20, 0xeb, 0xb9, 0x24, 0x4b, 0xb1, 0x62, 0x82, 0x17, 0x7c, 0xf3, 0x32,
-	0xaf, 0x58, 0x42, 0xa5, 0xc2, 0xc9, 0xa0, 0x10, 0x4c, 0x99, 0x7e, 0xbe, 0x71, 0x2e, 0x9a, 0x1b,
-	0xea, 0x36, 0x4e, 0x18, 0x17, 0xae, 0xfe, 0x34, 0xd0, 0xec, 0xf7, 0x11, 0x58, 0x7f, 0x8b, 0x63,
-	0x46, 0xb0, 0x12, 0xe9, 0x3a, 0x8b, 0x38, 0xe3, 0xd1, 0x0b, 0xde, 0x13, 0x68, 0x19, 0xfe, 0x8f,
-	0x09, 0x49, 0xa9, 0x94, 0x16, 0x68, 0x81, 0xf6, 0xcd, 0xce, 0xcc, 0xd1, 0xfe, 0xfc, 0x74, 0x61,
-	0xb7, 0x22, 0xb8, 0xa4, 0x5c, 0x66, 0xf2, 0xa9, 0x91, 0xac, 0xab, 0x94, 0xf1, 0xc8, 0x2b, 0x2b,
-	0xd0, 0x0c, 0xac, 0x49, 0x85, 0x53, 0xe5, 0xf7, 0x29, 0x8b, 0xfa, 0xca, 0x1a, 0x69, 0x81, 0xf6,
-	0xa8, 0x57, 0xd5, 0xd8, 0x73, 0x0d, 0xa1, 0x07, 0xb0, 0xc6, 0x38, 0xa1, 0x5b, 0xbe, 0xe8, 0xf5,
-	0x24, 0x55, 0xd6, 0x68, 0x2e, 0xe9, 0x8c, 0x58, 0xc0, 0xab, 0x6a, 0xfc, 0x95, 0x86, 0xd1, 0x2a,
-	0xac, 0x7d, 0xc4, 0x2c, 0xa6, 0xc4, 0xcf, 0xb8, 0x62, 0xb1, 0x35, 0xd6, 0x02, 0xed, 0xea, 0x62,
-	0xc3, 0x31, 0x29, 0x38, 0x65, 0x0a, 0xce, 0xeb, 0x32, 0x85, 0xce, 0xad, 0x83, 0xe3, 0x66, 0x65,
-	0xe7, 0x57, 0x13, 0xec, 0x9e, 0xee, 0xcd, 0x01, 0xaf, 0x6a, 0xca, 0xdf, 0xe4, 0xd5, 0xc8, 0x86,
-	0x50, 0x89, 0x24, 0x90, 0x4a, 0x70, 0x4a, 0xac, 0xff, 0x5a, 0xa0, 0x7d, 0xc3, 0xbb, 0x80, 0xa0,
-	0x45, 0x38, 0x91, 0x30, 0x29, 0x29, 0xf1, 0x83, 0x58, 0x84, 0x1b, 0xd2, 0x0f, 0x45, 0xc6, 0x15,
-	0x4d, 0xad, 0x71, 0x3d, 0xc0, 0x1d, 0x43, 0x76, 0x34, 0xb7, 0x62, 0xa8, 0xa5, 0xb1, 0x3f, 0xdf,
-	0x9a, 0x60, 0xf6, 0xcb, 0x18, 0x1c, 0x5f, 0xc3, 0x29, 0x4e, 0x24, 0x7a, 0x04, 0xeb, 0x92, 0x45,
-	0xfc, 0xdc, 0x64, 0x93, 0x71, 0x22, 0x36, 0x75, 0x8c, 0xa3, 0x1e, 0x32, 0x9c, 0xf1, 0x78, 0xa7,
-	0x19, 0xc4, 0xf2, 0xb6, 0xdc, 0x2f, 0xaa, 0x06, 0x34, 0x2d, 0x4b, 0xf2, 0xdc, 0x6a, 0x9d, 0x27,
-	0xf9, 0x44, 0x3f, 0x8f, 0x9b, 0xf7, 0x4c, 0xfa, 0x92, 0x6c, 0x38, 0x4c, 0xb8, 0x09, 0x56, 0x7d,
-	0x67, 0x95, 0x46, 0x38, 0xdc, 0xee, 0xd2, 0xf0, 0x68, 0x7f, 0x1e, 0x16, 0xcb, 0xe9, 0xd2, 0xd0,
-	0x8c, 0x8e, 0x12, 0xc6, 0xd7, 0xb5, 0xe7, 0x1a, 0x4d, 0x8b, 0x56, 0x1f, 0xe0, 0x5d, 0x22, 0x36,
-	0x79, 0x7e, 0x34, 0x7e, 0x9e, 0x8c, 0x5f, 0x9e, 0x97, 0x5e, 0x40, 0x75, 0x71, 0xea, 0x4a, 0xb2,
-	0xdd, 0x42, 0x60, 0x82, 0xfd, 0x7a, 0x16, 0x6c, 0xbd, 0xf4, 0x79, 0x89, 0x59, 0x5c, 0x8a, 0x90,
-	0x84, 0x0d, 0x7d, 0xe8, 0x7e, 0x2f, 0xc5, 0x61, 0x8e, 0xf8, 0x44, 0x64, 0x41, 0x4c, 0xf5, 0x70,
-	0x7a, 0x7b, 0xd7, 0x9f, 0x67, 0x52, 0x3b, 0x3f, 0x2b, 0x8c, 0xbb, 0xda, 0x37, 0x9f, 0x0f, 0x71,
-	0x38, 0x79, 0xa5, 0xa9, 0x79, 0x9b, 0xde, 0xf1, 0xf5, 0x3b, 0x4e, 0x5c, 0xea, 0x68, 0x4c, 0x97,
-	0x66, 0x3e, 0x9f, 0xee, 0xcd, 0xdd, 0x37, 0xe2, 0x79, 0x49, 0x36, 0xdc, 0xad, 0xf3, 0x7f, 0x01,
-	0x73, 0x04, 0x9d, 0xe5, 0xdd, 0xa1, 0x0d, 0x0e, 0x86, 0x36, 0x38, 0x1c, 0xda, 0xe0, 0xf7, 0xd0,
-	0x06, 0x3b, 0x27, 0x76, 0xe5, 0xf0, 0xc4, 0xae, 0xfc, 0x38, 0xb1, 0x2b, 0xef, 0xa7, 0xff, 0x79,
-	0xc7, 0x85, 0x6a, 0xb5, 0x3d, 0xa0, 0x32, 0x18, 0xd7, 0xe1, 0x3f, 0xfe, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x57, 0xe6, 0xe5, 0x80, 0x63, 0x04, 0x00, 0x00,
+	// 836 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x3f, 0x6f, 0xdb, 0x46,
+	0x14, 0x37, 0x2d, 0x5b, 0x89, 0x4f, 0x2a, 0x10, 0x9f, 0xe5, 0x98, 0x71, 0x6b, 0x4a, 0x36, 0xd0,
+	0xc2, 0x08, 0x20, 0x52, 0x76, 0x81, 0x0e, 0xce, 0x54, 0x5a, 0x6d, 0xe3, 0x22, 0x68, 0x03, 0xaa,
+	0x6d, 0x80, 0x0e, 0x25, 0x8e, 0xe4, 0x89, 0xba, 0x9a, 0xbc, 0x13, 0xee, 0x4e, 0x52, 0xf2, 0x15,
+	0xda, 0x25, 0x63, 0xc7, 0x8e, 0x19, 0x3d, 0xf8, 0x43, 0x64, 0x0c, 0x3c, 0x15, 0x19, 0xd2, 0x56,
+	0x1e, 0xd2, 0x8f, 0x51, 0xf0, 0xee, 0xc8, 0xf8, 0x4f, 0x9b, 0xc5, 0x8b, 0xa0, 0x7b, 0x7f, 0x7e,
+	0xef, 0xbd, 0xdf, 0xef, 0xf1, 0x81, 0x4f, 0x62, 0x26, 0x72, 0x26, 0x3c, 0x91, 0x21, 0x31, 0x22,
+	0x34, 0xf5, 0xa6, 0x7b, 0x11, 0x96, 0x68, 0xaf, 0x32, 0xb8, 0x63, 0xce, 0x24, 0x83, 0x1b, 0x3a,
+	0xce, 0xad, 0xcc, 0x26, 0x6e, 0xb3, 0x95, 0xb2, 0x94, 0xa9, 0x18, 0xaf, 0xf8, 0xa7, 0xc3, 0x37,
+	0x9d, 0x94, 0xb1, 0x34, 0xc3, 0x9e, 0x7a, 0x45, 0x93, 0xa1, 0x97, 0x4c, 0x38, 0x92, 0x84, 0x51,
+	0xe3, 0x6f, 0x5f, 0xf5, 0x4b, 0x92, 0x63, 0x21, 0x51, 0x3e, 0x36, 0x01, 0xf7, 0x74, 0xbd, 0x50,
+	0x23, 0x9b, 0xe2, 0xda, 0xb5, 0x8a, 0x72, 0x42, 0x99, 0xa7, 0x7e, 0xb5, 0x69, 0xe7, 0xd7, 0x1a,
+	0x68, 0xfd, 0x80, 0x32, 0x92, 0x20, 0xc9, 0xf8, 0x80, 0xa4, 0x94, 0xd0, 0xf4, 0x88, 0x0e, 0x19,
+	0x7c, 0x00, 0x6e, 0xa1, 0x24, 0xe1, 0x58, 0x08, 0xdb, 0xea, 0x58, 0xbb, 0x2b, 0xfe, 0xf6, 0xd9,
+	0x69, 0x77, 0xcb, 0xc0, 0x1d, 0x32, 0x2a, 0x30, 0x15, 0x13, 0xf1, 0xb9, 0x0e, 0x19, 0x48, 0x4e,
+	0x68, 0x1a, 0x94, 0x19, 0x70, 0x1b, 0x34, 0x85, 0x44, 0x5c, 0x86, 0x23, 0x4c, 0xd2, 0x91, 0xb4,
+	0x17, 0x3b, 0xd6, 0x6e, 0x2d, 0x68, 0x28, 0xdb, 0x43, 0x65, 0x82, 0x1f, 0x83, 0x26, 0xa1, 0x09,
+	0x7e, 0x1a, 0xb2, 0xe1, 0x50, 0x60, 0x69, 0xd7, 0x8a, 0x10, 0x7f, 0xd1, 0xb6, 0x82, 0x86, 0xb2,
+	0x7f, 0xab, 0xcc, 0xf0, 0x11, 0x68, 0xfe, 0x8c, 0x48, 0x86, 0x93, 0x70, 0x42, 0x25, 0xc9, 0xec,
+	0xa5, 0x8e, 0xb5, 0xdb, 0xd8, 0xdf, 0x74, 0x35, 0x0b, 0x6e, 0xc9, 0x82, 0xfb, 0x5d, 0xc9, 0x82,
+	0xff, 0xc1, 0xcb, 0x37, 0xed, 0x85, 0xe7, 0x7f, 0xb6, 0xad, 0x17, 0x6f, 0x4f, 0xee, 0x5b, 0x41,
+	0x43, 0xa7, 0x7f, 0x5f, 0x64, 0x43, 0x07, 0x00, 0xc9, 0xf2, 0x48, 0x48, 0x46, 0x71, 0x62, 0x2f,
+	0x77, 0xac, 0xdd, 0xdb, 0xc1, 0x05, 0x0b, 0xdc, 0x07, 0xeb, 0x39, 0x11, 0x02, 0x27, 0x61, 0x94,
+	0xb1, 0xf8, 0x58, 0x84, 0x31, 0x9b, 0x50, 0x89, 0xb9, 0x5d, 0x57, 0x03, 0xac, 0x69, 0xa7, 0xaf,
+	0x7c, 0x87, 0xda, 0x05, 0xfb, 0x60, 0x6d, 0x48, 0xb8, 0x90, 0x61, 0xc4, 0x68, 0x82, 0x93, 0x72,
+	0xe4, 0x5b, 0x6a, 0x9e, 0xd6, 0xeb, 0xd3, 0xee, 0x9d, 0xa7, 0xd5, 0x4a, 0x74, 0xf6, 0xdc, 0x9e,
+	0xdb, 0x0b, 0x56, 0x55, 0x82, 0xaf, 0xe2, 0x35, 0x1d, 0x07, 0x4b, 0xff, 0xfc, 0xde, 0xb6, 0x76,
+	0x4e, 0x96, 0x41, 0xfd, 0x31, 0xe2, 0x28, 0x17, 0xb0, 0x07, 0x5a, 0x82, 0xa4, 0xf4, 0x5d, 0x2b,
+	0x33, 0x42, 0x13, 0x36, 0x53, 0x62, 0xd4, 0x02, 0xa8, 0x7d, 0xba, 0x93, 0x27, 0xca, 0x03, 0x49,
+	0xd1, 0x3c, 0x0d, 0x4d, 0xd6, 0x18, 0xf3, 0x32, 0xa5, 0x60, 0xbf, 0xe9, 0x7f, 0x56, 0xf0, 0xf2,
+	0xfa, 0x4d, 0xfb, 0x43, 0xad, 0xa1, 0x48, 0x8e, 0x5d, 0xc2, 0xbc, 0x1c, 0xc9, 0x91, 0xfb, 0x08,
+	0xa7, 0x28, 0x7e, 0xd6, 0xc7, 0xf1, 0xd9, 0x69, 0x17, 0x18, 0x89, 0xfb, 0x38, 0xd6, 0x04, 0xc2,
+	0x9c, 0xd0, 0x81, 0xc2, 0x7c, 0x8c, 0xb9, 0x29, 0xf5, 0x13, 0xb8, 0x9b, 0xb0, 0x19, 0x2d, 0x56,
+	0x2f, 0x2c, 0xf8, 0x0d, 0xcb, 0x25, 0x55, 0x32, 0x36, 0xf6, 0xef, 0x5d, 0xd3, 0xa7, 0x6f, 0x02,
+	0xb4, 0x3c, 0xbf, 0x55, 0xf2, 0xb4, 0x4a, 0x9c, 0xaf, 0x11, 0xc9, 0xca, 0x20, 0x28, 0xc0, 0xa6,
+	0xa2, 0x2c, 0x1c, 0x72, 0x14, 0x17, 0x96, 0x30, 0x61, 0x93, 0x28, 0xc3, 0x6a, 0x38, 0x7b, 0xe9,
+	0x46, 0xf3, 0x6c, 0x28, 0xe4, 0x2f, 0x0d, 0x70, 0x5f, 0xe1, 0x16, 0xf3, 0x41, 0x0a, 0x36, 0xae,
+	0x15, 0xd5, 0xbd, 0xd9, 0xcb, 0x37, 0xaa, 0xb8, 0x7e, 0xa5, 0xa2, 0x06, 0x85, 0x0f, 0xc1, 0x7a,
+	0x45, 0x62, 0xca, 0x51, 0x8c, 0x8d, 0xd2, 0x76, 0xfd, 0x3d, 0xab, 0xb3, 0x56, 0xa6, 0x7c, 0x55,
+	0x64, 0x68, 0xfd, 0xe1, 0x13, 0xb0, 0xa5, 0x3b, 0xc7, 0x53, 0x4c, 0xa5, 0x08, 0x39, 0x96, 0x98,
+	0xaa, 0x09, 0x0c, 0xe2, 0xfb, 0x96, 0x51, 0x33, 0xfd, 0x85, 0xca, 0x0c, 0xca, 0x44, 0x0d, 0x7c,
+	0xb0, 0xfd, 0xcb, 0xdb, 0x93, 0xfb, 0x1f, 0xe9, 0x79, 0xba, 0x22, 0x39, 0xf6, 0xde, 0x65, 0x7b,
+	0x7a, 0x4f, 0x77, 0xfe, 0x5e, 0x04, 0x60, 0x50, 0x21, 0xc0, 0x6f, 0xc0, 0xea, 0xb4, 0x3c, 0x27,
+	0xe1, 0xff, 0x1f, 0x90, 0xea, 0xe4, 0x5c, 0x3e, 0x20, 0x77, 0xa6, 0x57, 0xec, 0xf0, 0x2e, 0xa8,
+	0x5f, 0xba, 0x21, 0xe6, 0x05, 0x03, 0x70, 0xbb, 0x94, 0xc9, 0xae, 0xdd, 0x48, 0x9d, 0x0a, 0xa7,
+	0xa8, 0xc5, 0x31, 0x12, 0x4c, 0x6f, 0xd8, 0x4a, 0x60, 0x5e, 0xb0, 0x05, 0x96, 0xc7, 0x6c, 0x86,
+	0xb9, 0x5a, 0x83, 0x5a, 0xa0, 0x1f, 0x70, 0x00, 0x9a, 0xd1, 0x84, 0x17, 0x9f, 0x5a, 0xcc, 0x08,
+	0xd5, 0xaa, 0xad, 0xf8, 0x3d, 0xd3, 0xc5, 0xfa, 0xf5, 0x2e, 0x8e, 0xa8, 0xbc, 0x50, 0xff, 0x88,
+	0x4a, 0x73, 0xa0, 0x34, 0xca, 0x61, 0x01, 0x72, 0xd0, 0x3a, 0xfb, 0x0f, 0x89, 0xfc, 0x07, 0x2f,
+	0xe6, 0x8e, 0xf5, 0x72, 0xee, 0x58, 0xaf, 0xe6, 0x8e, 0xf5, 0xd7, 0xdc, 0xb1, 0x9e, 0x9f, 0x3b,
+	0x0b, 0xaf, 0xce, 0x9d, 0x85, 0x3f, 0xce, 0x9d, 0x85, 0x1f, 0xb7, 0x2e, 0x95, 0xba, 0xa0, 0x90,
+	0x7c, 0x36, 0xc6, 0x22, 0xaa, 0xab, 0x6f, 0xf0, 0xd3, 0x7f, 0x07, 0x00, 0xa1, 0xa4, 0x65, 0x7a,
+	0xb0, 0x06, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -274,6 +397,9 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.FirstBondedHeight != that1.FirstBondedHeight {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -310,6 +436,51 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.DowntimeGraceBlocks != that1.DowntimeGraceBlocks {
+		return false
+	}
+	if this.SlashEventsRetentionBlocks != that1.SlashEventsRetentionBlocks {
+		return false
+	}
+	return true
+}
+func (this *SlashEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashEvent)
+	if !ok {
+		that2, ok := that.(SlashEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Fraction.Equal(that1.Fraction) {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.Power != that1.Power {
+		return false
+	}
+	if !this.BurnedCoins.Equal(that1.BurnedCoins) {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -332,6 +503,11 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FirstBondedHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.FirstBondedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -395,6 +571,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SlashEventsRetentionBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SlashEventsRetentionBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DowntimeGraceBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.DowntimeGraceBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -441,6 +627,73 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BurnedCoins.Size()
+		i -= size
+		if _, err := m.BurnedCoins.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Power != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -476,6 +729,9 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if m.FirstBondedHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.FirstBondedHeight))
+	}
 	return n
 }
 
@@ -496,6 +752,39 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	if m.DowntimeGraceBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.DowntimeGraceBlocks))
+	}
+	if m.SlashEventsRetentionBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.SlashEventsRetentionBlocks))
+	}
+	return n
+}
+
+func (m *SlashEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovSlashing(uint64(m.Power))
+	}
+	l = m.BurnedCoins.Size()
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -676,6 +965,25 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstBondedHeight", wireType)
+			}
+			m.FirstBondedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstBondedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -877,6 +1185,263 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeGraceBlocks", wireType)
+			}
+			m.DowntimeGraceBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeGraceBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEventsRetentionBlocks", wireType)
+			}
+			m.SlashEventsRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashEventsRetentionBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedCoins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedCoins.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -170,6 +170,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod:      "CancelUnbondingDelegation",
 					Use:            "cancel-unbond [validator-addr] [amount] [creation-height]",
 					Short:          "Cancel unbonding delegation and delegate back to the validator",
+					Long:           "Cancel the unbonding delegation entry created at the given height, or the unbonding delegation entries oldest first if the height is 0, and delegate back to the validator",
 					Example:        fmt.Sprintf(`%s tx staking cancel-unbond cosmosvaloper... 100stake 2 --from mykey`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}, {ProtoField: "creation_height"}},
 				},
@@ -177,6 +178,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod:      "RotateConsPubKey",
 					Use:            "rotate-cons-pubkey [validator-address] [new-pubkey]",
 					Short:          fmt.Sprintf("rotate validator consensus pub key. Note: you have to replace the `~/.%sd/config/priv_validator_key.json` with new key and restart the node after rotating the key", version.AppName),
+					Long:           "Rotate the validator consensus pub key. A bls12_381 pub key requires the proof of possession of its private key, given with --new-pubkey-proof-of-possession.",
 					Example:        fmt.Sprintf(`%s tx staking rotate-cons-pubkey myvalidator {"@type":"/cosmos.crypto.ed25519.PubKey","key":"oWg2ISpLF405Jcm2vXV+2v4fnjodh6aafuIdeoW+rUw="}`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "new_pubkey"}},
 				},
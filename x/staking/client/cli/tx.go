@@ -78,7 +78,8 @@ Where validator.json contains:
 	"min-self-delegation": "1"
 }
 
-where we can get the pubkey using "%s tendermint show-validator"
+where we can get the pubkey using "%s tendermint show-validator". A bls12_381 pubkey also
+requires its base64 encoded proof of possession in "pubkey-proof-of-possession".
 `, version.AppName, version.AppName)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -195,6 +196,7 @@ func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *fl
 	if err != nil {
 		return txf, nil, err
 	}
+	msg.PubkeyProofOfPossession = val.PubKeyProofOfPossession
 	if err := msg.Validate(valAc); err != nil {
 		return txf, nil, err
 	}
@@ -256,6 +258,8 @@ type TxCreateValidatorConfig struct {
 	MinSelfDelegation       string
 
 	PubKey cryptotypes.PubKey
+	// PubKeyProofOfPossession is required for bls12_381 consensus keys.
+	PubKeyProofOfPossession []byte
 
 	IP              string
 	P2PPort         uint
@@ -415,6 +419,7 @@ func BuildCreateValidatorMsg(clientCtx client.Context, config TxCreateValidatorC
 	if err != nil {
 		return txBldr, msg, err
 	}
+	msg.PubkeyProofOfPossession = config.PubKeyProofOfPossession
 
 	if generateOnly {
 		ip := config.IP
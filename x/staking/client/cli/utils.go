@@ -17,30 +17,32 @@ import (
 
 // validator struct to define the fields of the validator
 type validator struct {
-	Amount            sdk.Coin
-	PubKey            cryptotypes.PubKey
-	Moniker           string
-	Identity          string
-	Website           string
-	Security          string
-	Details           string
-	CommissionRates   types.CommissionRates
-	MinSelfDelegation math.Int
+	Amount                  sdk.Coin
+	PubKey                  cryptotypes.PubKey
+	PubKeyProofOfPossession []byte
+	Moniker                 string
+	Identity                string
+	Website                 string
+	Security                string
+	Details                 string
+	CommissionRates         types.CommissionRates
+	MinSelfDelegation       math.Int
 }
 
 func parseAndValidateValidatorJSON(cdc codec.Codec, path string) (validator, error) {
 	type internalVal struct {
-		Amount              string          `json:"amount"`
-		PubKey              json.RawMessage `json:"pubkey"`
-		Moniker             string          `json:"moniker"`
-		Identity            string          `json:"identity,omitempty"`
-		Website             string          `json:"website,omitempty"`
-		Security            string          `json:"security,omitempty"`
-		Details             string          `json:"details,omitempty"`
-		CommissionRate      string          `json:"commission-rate"`
-		CommissionMaxRate   string          `json:"commission-max-rate"`
-		CommissionMaxChange string          `json:"commission-max-change-rate"`
-		MinSelfDelegation   string          `json:"min-self-delegation"`
+		Amount                  string          `json:"amount"`
+		PubKey                  json.RawMessage `json:"pubkey"`
+		PubKeyProofOfPossession []byte          `json:"pubkey-proof-of-possession,omitempty"`
+		Moniker                 string          `json:"moniker"`
+		Identity                string          `json:"identity,omitempty"`
+		Website                 string          `json:"website,omitempty"`
+		Security                string          `json:"security,omitempty"`
+		Details                 string          `json:"details,omitempty"`
+		CommissionRate          string          `json:"commission-rate"`
+		CommissionMaxRate       string          `json:"commission-max-rate"`
+		CommissionMaxChange     string          `json:"commission-max-change-rate"`
+		MinSelfDelegation       string          `json:"min-self-delegation"`
 	}
 
 	contents, err := os.ReadFile(path)
@@ -88,15 +90,16 @@ func parseAndValidateValidatorJSON(cdc codec.Codec, path string) (validator, err
 	}
 
 	return validator{
-		Amount:            amount,
-		PubKey:            pk,
-		Moniker:           v.Moniker,
-		Identity:          v.Identity,
-		Website:           v.Website,
-		Security:          v.Security,
-		Details:           v.Details,
-		CommissionRates:   commissionRates,
-		MinSelfDelegation: minSelfDelegation,
+		Amount:                  amount,
+		PubKey:                  pk,
+		PubKeyProofOfPossession: v.PubKeyProofOfPossession,
+		Moniker:                 v.Moniker,
+		Identity:                v.Identity,
+		Website:                 v.Website,
+		Security:                v.Security,
+		Details:                 v.Details,
+		CommissionRates:         commissionRates,
+		MinSelfDelegation:       minSelfDelegation,
 	}, nil
 }
 
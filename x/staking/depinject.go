@@ -32,6 +32,7 @@ func init() {
 		&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
 		appconfig.Invoke(InvokeSetStakingHooks),
+		appconfig.Invoke(InvokeSetBondDenomConverter),
 	)
 }
 
@@ -52,8 +53,9 @@ type ModuleInputs struct {
 type ModuleOutputs struct {
 	depinject.Out
 
-	StakingKeeper *keeper.Keeper
-	Module        appmodule.AppModule
+	StakingKeeper       *keeper.Keeper
+	Module              appmodule.AppModule
+	AccountPruneChecker authtypes.AccountPruneCheckerWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -79,7 +81,11 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.CometInfoService,
 	)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper)
-	return ModuleOutputs{StakingKeeper: k, Module: m}
+	return ModuleOutputs{
+		StakingKeeper:       k,
+		Module:              m,
+		AccountPruneChecker: authtypes.AccountPruneCheckerWrapper{AccountPruneChecker: k},
+	}
 }
 
 func InvokeSetStakingHooks(
@@ -121,6 +127,17 @@ func InvokeSetStakingHooks(
 	return nil
 }
 
+// InvokeSetBondDenomConverter sets the bond denom converter provided by the app,
+// if any, on the keeper.
+func InvokeSetBondDenomConverter(keeper *keeper.Keeper, converter types.BondDenomConverter) {
+	// all arguments to invokers are optional
+	if keeper == nil || converter == nil {
+		return
+	}
+
+	keeper.SetBondDenomConverter(converter)
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the staking module.
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// bondCoin returns the bond denom coin the given coin of a delegator is bonded
+// as. A coin of an alternative bond denom is converted to the bond denom by the
+// bond denom converter, if set, and rejected otherwise.
+func (k Keeper) bondCoin(ctx context.Context, delAddr sdk.AccAddress, coin sdk.Coin) (sdk.Coin, error) {
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if coin.Denom == bondDenom {
+		return coin, nil
+	}
+
+	if k.bondDenomConverter == nil {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", coin.Denom, bondDenom,
+		)
+	}
+
+	converted, err := k.bondDenomConverter.ConvertToBondDenom(ctx, delAddr, coin)
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrapf(err, "failed to convert %s to %s", coin, bondDenom)
+	}
+	if converted.Denom != bondDenom || !converted.IsValid() || !converted.Amount.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid conversion of %s: got %s, expected a positive amount of %s", coin, converted, bondDenom,
+		)
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeConvertBondDenom,
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(sdk.AttributeKeyAmount, coin.String()),
+		event.NewAttribute(types.AttributeKeyConvertedAmount, converted.String()),
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return converted, nil
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// rateConverter converts an alternative bond denom to the bond denom at a fixed
+// rate.
+type rateConverter struct {
+	denom string
+	rate  math.LegacyDec
+}
+
+func (c rateConverter) ConvertToBondDenom(_ context.Context, _ sdk.AccAddress, coin sdk.Coin) (sdk.Coin, error) {
+	if coin.Denom != c.denom {
+		return sdk.Coin{}, fmt.Errorf("denom %s is not accepted", coin.Denom)
+	}
+
+	return sdk.NewCoin(sdk.DefaultBondDenom, c.rate.MulInt(coin.Amount).TruncateInt()), nil
+}
+
+func (s *KeeperTestSuite) TestAltBondDenom() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	msg, err := types.NewMsgCreateValidator(s.valAddressToString(ValAddr), ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin("lst", 10), types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+
+	// the alternative bond denoms are rejected without a converter
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.ErrorContains(err, "invalid coin denomination: got lst, expected stake")
+
+	keeper.SetBondDenomConverter(rateConverter{denom: "lst", rate: math.LegacyNewDecWithPrec(15, 1)})
+	require.Panics(func() { keeper.SetBondDenomConverter(rateConverter{}) })
+
+	// the converted coins are delegated, and count in the validator power
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), Addr, types.NotBondedPoolName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15)))
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), Addr, types.NotBondedPoolName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 6)))
+	_, err = msgServer.Delegate(ctx, &types.MsgDelegate{
+		DelegatorAddress: s.addressToString(Addr),
+		ValidatorAddress: s.valAddressToString(ValAddr),
+		Amount:           sdk.NewInt64Coin("lst", 4),
+	})
+	require.NoError(err)
+
+	validator, err := keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.NewInt(21), validator.Tokens)
+
+	// the bond denom is not converted
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), Addr, types.NotBondedPoolName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 4)))
+	_, err = msgServer.Delegate(ctx, &types.MsgDelegate{
+		DelegatorAddress: s.addressToString(Addr),
+		ValidatorAddress: s.valAddressToString(ValAddr),
+		Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, 4),
+	})
+	require.NoError(err)
+
+	// the denoms not accepted by the converter are rejected
+	_, err = msgServer.Delegate(ctx, &types.MsgDelegate{
+		DelegatorAddress: s.addressToString(Addr),
+		ValidatorAddress: s.valAddressToString(ValAddr),
+		Amount:           sdk.NewInt64Coin("other", 4),
+	})
+	require.ErrorContains(err, "failed to convert 4other to stake: denom other is not accepted")
+}
+
+func (s *KeeperTestSuite) TestAltBondDenomMinSelfDelegation() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	keeper.SetBondDenomConverter(rateConverter{denom: "lst", rate: math.LegacyNewDecWithPrec(5, 1)})
+
+	// the min self delegation is compared to the converted amount
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	msg, err := types.NewMsgCreateValidator(s.valAddressToString(ValAddr), ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin("lst", 10), types.Description{Moniker: "NewVal"}, comm, math.NewInt(6))
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.ErrorIs(err, types.ErrSelfDelegationBelowMinimum)
+}
@@ -150,6 +150,23 @@ func (k Keeper) GetUnbondingDelegations(ctx context.Context, delegator sdk.AccAd
 	return unbondingDelegations[:i], nil // trim if the array length < maxRetrieve
 }
 
+// CanPruneAccount implements the x/auth AccountPruneChecker interface, an
+// account can be pruned once it has neither delegations nor unbonding
+// delegations.
+func (k Keeper) CanPruneAccount(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	delegations, err := k.GetDelegatorDelegations(ctx, addr, 1)
+	if err != nil || len(delegations) != 0 {
+		return false, err
+	}
+
+	ubds, err := k.GetUnbondingDelegations(ctx, addr, 1)
+	if err != nil || len(ubds) != 0 {
+		return false, err
+	}
+
+	return true, nil
+}
+
 // GetUnbondingDelegation returns a unbonding delegation.
 func (k Keeper) GetUnbondingDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (ubd types.UnbondingDelegation, err error) {
 	ubd, err = k.UnbondingDelegations.Get(ctx, collections.Join(delAddr.Bytes(), valAddr.Bytes()))
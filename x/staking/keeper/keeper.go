@@ -58,6 +58,7 @@ type Keeper struct {
 	authKeeper            types.AccountKeeper
 	bankKeeper            types.BankKeeper
 	hooks                 types.StakingHooks
+	bondDenomConverter    types.BondDenomConverter
 	authority             string
 	validatorAddressCodec addresscodec.Codec
 	consensusAddressCodec addresscodec.Codec
@@ -316,6 +317,17 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) {
 	k.hooks = sh
 }
 
+// SetBondDenomConverter sets the converter of the alternative bond denoms which
+// are accepted in the delegations. Like the hooks, it must be set on the keeper
+// pointer before the keeper is used.
+func (k *Keeper) SetBondDenomConverter(converter types.BondDenomConverter) {
+	if k.bondDenomConverter != nil {
+		panic("cannot set bond denom converter twice")
+	}
+
+	k.bondDenomConverter = converter
+}
+
 // GetAuthority returns the x/staking module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
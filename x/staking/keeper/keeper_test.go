@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -85,7 +86,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		Params: simtestutil.DefaultConsensusParams,
 	}, nil).AnyTimes()
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, encCfg.InterfaceRegistry)
-	consensustypes.RegisterQueryServer(queryHelper, ck)
+	consensustypes.RegisterQueryServer(queryHelper, &consensusQueryServer{keeper: ck})
 
 	bankKeeper := stakingtestutil.NewMockBankKeeper(ctrl)
 	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger(), runtime.EnvWithQueryRouterService(queryHelper.GRPCQueryRouter), runtime.EnvWithMsgRouterService(s.baseApp.MsgServiceRouter()))
@@ -606,3 +607,14 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
+
+// consensusQueryServer exposes the mocked consensus keeper as a consensus query server.
+type consensusQueryServer struct {
+	consensustypes.UnimplementedQueryServer
+
+	keeper stakingtypes.ConsensusKeeper
+}
+
+func (s *consensusQueryServer) Params(ctx context.Context, req *consensustypes.QueryParamsRequest) (*consensustypes.QueryParamsResponse, error) {
+	return s.keeper.Params(ctx, req)
+}
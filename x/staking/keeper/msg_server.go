@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"sort"
 	"strconv"
 	"time"
 
@@ -20,6 +21,8 @@ import (
 	consensusv1 "cosmossdk.io/x/consensus/types"
 	"cosmossdk.io/x/staking/types"
 
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -74,11 +77,11 @@ func (k msgServer) CreateValidator(ctx context.Context, msg *types.MsgCreateVali
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to query consensus params: %s", err)
 	}
 	if res.Params.Validator != nil {
-		pkType := pk.Type()
+		pkType := cryptocodec.ToCmtPubKeyType(pk)
 		if !slices.Contains(res.Params.Validator.PubKeyTypes, pkType) {
 			return nil, errorsmod.Wrapf(
 				types.ErrValidatorPubKeyTypeNotSupported,
-				"got: %s, expected: %s", pk.Type(), res.Params.Validator.PubKeyTypes,
+				"got: %s, expected: %s", pkType, res.Params.Validator.PubKeyTypes,
 			)
 		}
 
@@ -90,20 +93,23 @@ func (k msgServer) CreateValidator(ctx context.Context, msg *types.MsgCreateVali
 		}
 	}
 
+	if err := validateProofOfPossession(pk, msg.PubkeyProofOfPossession); err != nil {
+		return nil, err
+	}
+
 	err = k.checkConsKeyAlreadyUsed(ctx, pk)
 	if err != nil {
 		return nil, err
 	}
 
-	bondDenom, err := k.BondDenom(ctx)
+	value, err := k.bondCoin(ctx, sdk.AccAddress(valAddr), msg.Value)
 	if err != nil {
 		return nil, err
 	}
 
-	if msg.Value.Denom != bondDenom {
-		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Value.Denom, bondDenom,
-		)
+	// the min self delegation is compared to the bonded tokens, once converted
+	if value.Amount.LT(msg.MinSelfDelegation) {
+		return nil, types.ErrSelfDelegationBelowMinimum
 	}
 
 	if _, err := msg.Description.EnsureLength(); err != nil {
@@ -150,7 +156,7 @@ func (k msgServer) CreateValidator(ctx context.Context, msg *types.MsgCreateVali
 	// move coins from the msg.Address account to a (self-delegation) delegator account
 	// the validator account and global shares are updated within here
 	// NOTE source will always be from a wallet which are unbonded
-	_, err = k.Keeper.Delegate(ctx, sdk.AccAddress(valAddr), msg.Value.Amount, types.Unbonded, validator, true)
+	_, err = k.Keeper.Delegate(ctx, sdk.AccAddress(valAddr), value.Amount, types.Unbonded, validator, true)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +164,7 @@ func (k msgServer) CreateValidator(ctx context.Context, msg *types.MsgCreateVali
 	if err := k.EventService.EventManager(ctx).EmitKV(
 		types.EventTypeCreateValidator,
 		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
-		event.NewAttribute(sdk.AttributeKeyAmount, msg.Value.String()),
+		event.NewAttribute(sdk.AttributeKeyAmount, value.String()),
 	); err != nil {
 		return nil, err
 	}
@@ -279,30 +285,24 @@ func (k msgServer) Delegate(ctx context.Context, msg *types.MsgDelegate) (*types
 		return nil, err
 	}
 
-	bondDenom, err := k.BondDenom(ctx)
+	amount, err := k.bondCoin(ctx, delegatorAddress, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
 
-	if msg.Amount.Denom != bondDenom {
-		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
-		)
-	}
-
 	// NOTE: source funds are always unbonded
-	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonded, validator, true)
+	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, amount.Amount, types.Unbonded, validator, true)
 	if err != nil {
 		return nil, err
 	}
 
-	if msg.Amount.Amount.IsInt64() {
+	if amount.Amount.IsInt64() {
 		defer func() {
 			telemetry.IncrCounter(1, types.ModuleName, "delegate")
 			telemetry.SetGaugeWithLabels(
 				[]string{"tx", "msg", sdk.MsgTypeURL(msg)},
-				float32(msg.Amount.Amount.Int64()),
-				[]metrics.Label{telemetry.NewLabel("denom", msg.Amount.Denom)},
+				float32(amount.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", amount.Denom)},
 			)
 		}()
 	}
@@ -311,7 +311,7 @@ func (k msgServer) Delegate(ctx context.Context, msg *types.MsgDelegate) (*types
 		types.EventTypeDelegate,
 		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
 		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
-		event.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		event.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
 		event.NewAttribute(types.AttributeKeyNewShares, newShares.String()),
 	); err != nil {
 		return nil, err
@@ -486,7 +486,8 @@ func (k msgServer) CancelUnbondingDelegation(ctx context.Context, msg *types.Msg
 		)
 	}
 
-	if msg.CreationHeight <= 0 {
+	// a creation height of 0 cancels the amount across the unbonding entries
+	if msg.CreationHeight < 0 {
 		return nil, errorsmod.Wrap(
 			sdkerrors.ErrInvalidRequest,
 			"invalid height",
@@ -529,29 +530,10 @@ func (k msgServer) CancelUnbondingDelegation(ctx context.Context, msg *types.Msg
 		)
 	}
 
-	var (
-		unbondEntry      types.UnbondingDelegationEntry
-		unbondEntryIndex int64 = -1
-	)
-
-	for i, entry := range ubd.Entries {
-		if entry.CreationHeight == msg.CreationHeight {
-			unbondEntry = entry
-			unbondEntryIndex = int64(i)
-			break
-		}
-	}
-	if unbondEntryIndex == -1 {
-		return nil, sdkerrors.ErrNotFound.Wrapf("unbonding delegation entry is not found at block height %d", msg.CreationHeight)
-	}
-
-	if unbondEntry.Balance.LT(msg.Amount.Amount) {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("amount is greater than the unbonding delegation entry balance")
-	}
-
 	headerInfo := k.HeaderService.HeaderInfo(ctx)
-	if unbondEntry.CompletionTime.Before(headerInfo.Time) {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("unbonding delegation is already processed")
+	cancellations, err := unbondingCancellations(ubd, msg.CreationHeight, msg.Amount.Amount, headerInfo.Time)
+	if err != nil {
+		return nil, err
 	}
 
 	// delegate back the unbonding delegation amount to the validator
@@ -560,14 +542,20 @@ func (k msgServer) CancelUnbondingDelegation(ctx context.Context, msg *types.Msg
 		return nil, err
 	}
 
-	amount := unbondEntry.Balance.Sub(msg.Amount.Amount)
-	if amount.IsZero() {
-		ubd.RemoveEntry(unbondEntryIndex)
-	} else {
-		// update the unbondingDelegationEntryBalance and InitialBalance for ubd entry
-		unbondEntry.Balance = amount
-		unbondEntry.InitialBalance = unbondEntry.InitialBalance.Sub(msg.Amount.Amount)
-		ubd.Entries[unbondEntryIndex] = unbondEntry
+	// entries are updated from the last one so that removing an entry doesn't
+	// shift the indexes of the entries which remain to be updated
+	for i := len(cancellations) - 1; i >= 0; i-- {
+		c := cancellations[i]
+		unbondEntry := ubd.Entries[c.index]
+		amount := unbondEntry.Balance.Sub(c.amount)
+		if amount.IsZero() {
+			ubd.RemoveEntry(int64(c.index))
+		} else {
+			// update the unbondingDelegationEntryBalance and InitialBalance for ubd entry
+			unbondEntry.Balance = amount
+			unbondEntry.InitialBalance = unbondEntry.InitialBalance.Sub(c.amount)
+			ubd.Entries[c.index] = unbondEntry
+		}
 	}
 
 	// set the unbonding delegation or remove it if there are no more entries
@@ -581,19 +569,93 @@ func (k msgServer) CancelUnbondingDelegation(ctx context.Context, msg *types.Msg
 		return nil, err
 	}
 
-	if err := k.EventService.EventManager(ctx).EmitKV(
-		types.EventTypeCancelUnbondingDelegation,
-		event.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
-		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
-		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
-		event.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(msg.CreationHeight, 10)),
-	); err != nil {
-		return nil, err
+	for _, c := range cancellations {
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeCancelUnbondingDelegation,
+			event.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(msg.Amount.Denom, c.amount).String()),
+			event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			event.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(c.creationHeight, 10)),
+		); err != nil {
+			return nil, err
+		}
 	}
 
 	return &types.MsgCancelUnbondingDelegationResponse{}, nil
 }
 
+// unbondingCancellation is the amount cancelled from the unbonding delegation
+// entry at index.
+type unbondingCancellation struct {
+	index          int
+	creationHeight int64
+	amount         math.Int
+}
+
+// unbondingCancellations returns the amounts to cancel from the entries of ubd
+// in order to cancel amount, in increasing order of index. If creationHeight
+// is 0, the amount is cancelled across the entries which aren't completed yet,
+// oldest first; otherwise it is cancelled from the entry created at
+// creationHeight.
+func unbondingCancellations(ubd types.UnbondingDelegation, creationHeight int64, amount math.Int, blockTime time.Time) ([]unbondingCancellation, error) {
+	if creationHeight != 0 {
+		for i, entry := range ubd.Entries {
+			if entry.CreationHeight != creationHeight {
+				continue
+			}
+
+			if entry.Balance.LT(amount) {
+				return nil, sdkerrors.ErrInvalidRequest.Wrap("amount is greater than the unbonding delegation entry balance")
+			}
+
+			if entry.CompletionTime.Before(blockTime) {
+				return nil, sdkerrors.ErrInvalidRequest.Wrap("unbonding delegation is already processed")
+			}
+
+			return []unbondingCancellation{{index: i, creationHeight: creationHeight, amount: amount}}, nil
+		}
+
+		return nil, sdkerrors.ErrNotFound.Wrapf("unbonding delegation entry is not found at block height %d", creationHeight)
+	}
+
+	indexes := make([]int, 0, len(ubd.Entries))
+	for i, entry := range ubd.Entries {
+		if !entry.CompletionTime.Before(blockTime) {
+			indexes = append(indexes, i)
+		}
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return ubd.Entries[indexes[i]].CreationHeight < ubd.Entries[indexes[j]].CreationHeight
+	})
+
+	var cancellations []unbondingCancellation
+	remaining := amount
+	for _, i := range indexes {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		entry := ubd.Entries[i]
+		cancelled := math.MinInt(entry.Balance, remaining)
+		if !cancelled.IsPositive() {
+			continue
+		}
+
+		cancellations = append(cancellations, unbondingCancellation{index: i, creationHeight: entry.CreationHeight, amount: cancelled})
+		remaining = remaining.Sub(cancelled)
+	}
+
+	if remaining.IsPositive() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("amount is greater than the balance of the unbonding delegation entries")
+	}
+
+	sort.Slice(cancellations, func(i, j int) bool {
+		return cancellations[i].index < cancellations[j].index
+	})
+
+	return cancellations, nil
+}
+
 // UpdateParams defines a method to perform updation of params exist in x/staking module.
 func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.authority != msg.Authority {
@@ -657,11 +719,11 @@ func (k msgServer) RotateConsPubKey(ctx context.Context, msg *types.MsgRotateCon
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to query consensus params: %s", err)
 	}
 	if paramsRes.Params.Validator != nil {
-		pkType := pk.Type()
+		pkType := cryptocodec.ToCmtPubKeyType(pk)
 		if !slices.Contains(paramsRes.Params.Validator.PubKeyTypes, pkType) {
 			return nil, errorsmod.Wrapf(
 				types.ErrValidatorPubKeyTypeNotSupported,
-				"got: %s, expected: %s", pk.Type(), paramsRes.Params.Validator.PubKeyTypes,
+				"got: %s, expected: %s", pkType, paramsRes.Params.Validator.PubKeyTypes,
 			)
 		}
 
@@ -673,6 +735,10 @@ func (k msgServer) RotateConsPubKey(ctx context.Context, msg *types.MsgRotateCon
 		}
 	}
 
+	if err := validateProofOfPossession(pk, msg.NewPubkeyProofOfPossession); err != nil {
+		return nil, err
+	}
+
 	err = k.checkConsKeyAlreadyUsed(ctx, pk)
 	if err != nil {
 		return nil, err
@@ -730,6 +796,21 @@ func (k msgServer) RotateConsPubKey(ctx context.Context, msg *types.MsgRotateCon
 	return res, nil
 }
 
+// validateProofOfPossession requires bls12_381 consensus keys to come with a
+// valid proof of possession, as they are vulnerable to rogue-key attacks
+// otherwise.
+func validateProofOfPossession(pk cryptotypes.PubKey, proof []byte) error {
+	if pk.Type() != bls12_381.KeyType {
+		return nil
+	}
+
+	if !bls12_381.VerifyProofOfPossession(pk, proof) {
+		return types.ErrInvalidProofOfPossession
+	}
+
+	return nil
+}
+
 // checkConsKeyAlreadyUsed returns an error if the consensus public key is already used,
 // in ConsAddrToValidatorIdentifierMap, OldToNewConsAddrMap, or in the current block (RotationHistory).
 func (k msgServer) checkConsKeyAlreadyUsed(ctx context.Context, newConsPubKey cryptotypes.PubKey) error {
@@ -1081,6 +1081,63 @@ func (s *KeeperTestSuite) TestMsgCancelUnbondingDelegation() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgCancelUnbondingDelegationAcrossEntries() {
+	ctx, keeper, msgServer, ak := s.ctx, s.stakingKeeper, s.msgServer, s.accountKeeper
+	require := s.Require()
+
+	pk := ed25519.GenPrivKey().PubKey()
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(s.ctx, int64(100))}
+
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), Addr, types.NotBondedPoolName, gomock.Any()).AnyTimes()
+
+	msg, err := types.NewMsgCreateValidator(s.valAddressToString(ValAddr), pk, amt, types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	require.NoError(keeper.SetDelegation(ctx, types.NewDelegation(s.addressToString(Addr), s.valAddressToString(ValAddr), math.LegacyNewDec(100))))
+
+	blockTime := ctx.HeaderInfo().Time
+	ubd := types.NewUnbondingDelegation(Addr, ValAddr, 20, blockTime.Add(time.Minute*20), math.NewInt(30), 0, keeper.ValidatorAddressCodec(), ak.AddressCodec())
+	ubd.AddEntry(5, blockTime.Add(-time.Minute), math.NewInt(50), 0)
+	ubd.AddEntry(10, blockTime.Add(time.Minute*10), math.NewInt(30), 0)
+	ubd.AddEntry(30, blockTime.Add(time.Minute*30), math.NewInt(40), 0)
+	require.NoError(keeper.SetUnbondingDelegation(ctx, ubd))
+
+	cancel := func(amount int64) error {
+		_, err := msgServer.CancelUnbondingDelegation(ctx, &types.MsgCancelUnbondingDelegation{
+			DelegatorAddress: s.addressToString(Addr),
+			ValidatorAddress: s.valAddressToString(ValAddr),
+			Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		})
+		return err
+	}
+
+	// the completed entry at height 5 can't be cancelled
+	require.ErrorContains(cancel(101), "amount is greater than the balance of the unbonding delegation entries")
+
+	// the entry at height 10 is cancelled, then part of the entry at height 20
+	require.NoError(cancel(50))
+	resUnbond, err := keeper.GetUnbondingDelegation(ctx, Addr, ValAddr)
+	require.NoError(err)
+	require.Len(resUnbond.Entries, 3)
+	for i, exp := range []struct {
+		height  int64
+		balance int64
+	}{{20, 10}, {5, 50}, {30, 40}} {
+		require.Equal(exp.height, resUnbond.Entries[i].CreationHeight)
+		require.Equal(math.NewInt(exp.balance), resUnbond.Entries[i].Balance)
+		require.Equal(math.NewInt(exp.balance), resUnbond.Entries[i].InitialBalance)
+	}
+
+	require.NoError(cancel(50))
+	resUnbond, err = keeper.GetUnbondingDelegation(ctx, Addr, ValAddr)
+	require.NoError(err)
+	require.Len(resUnbond.Entries, 1)
+	require.Equal(int64(5), resUnbond.Entries[0].CreationHeight)
+}
+
 func (s *KeeperTestSuite) TestMsgUpdateParams() {
 	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
 	require := s.Require()
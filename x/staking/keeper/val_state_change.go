@@ -16,6 +16,7 @@ import (
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/staking/types"
 
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -282,13 +283,13 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]appmod
 		if !(validator.Jailed || validator.Status != types.Bonded) {
 			updates = append(updates, appmodule.ValidatorUpdate{
 				PubKey:     oldPk.Bytes(),
-				PubKeyType: oldPk.Type(),
+				PubKeyType: cryptocodec.ToCmtPubKeyType(oldPk),
 				Power:      0,
 			})
 
 			updates = append(updates, appmodule.ValidatorUpdate{
 				PubKey:     newPk.Bytes(),
-				PubKeyType: newPk.Type(),
+				PubKeyType: cryptocodec.ToCmtPubKeyType(newPk),
 				Power:      validator.ConsensusPower(powerReduction),
 			})
 
@@ -35,10 +35,11 @@ var (
 	_ module.HasABCIGenesis      = AppModule{}
 	_ module.HasABCIEndBlock     = AppModule{}
 
-	_ appmodule.AppModule             = AppModule{}
-	_ appmodule.HasServices           = AppModule{}
-	_ appmodule.HasMigrations         = AppModule{}
-	_ appmodule.HasRegisterInterfaces = AppModule{}
+	_ appmodule.AppModule              = AppModule{}
+	_ appmodule.HasServices            = AppModule{}
+	_ appmodule.HasMigrations          = AppModule{}
+	_ appmodule.HasRegisterInterfaces  = AppModule{}
+	_ appmodule.HasOrderingConstraints = AppModule{}
 
 	_ depinject.OnePerModuleType = AppModule{}
 )
@@ -167,6 +168,14 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 	return marshalJSON, nil
 }
 
+// OrderingConstraints implements appmodule.HasOrderingConstraints.
+// Staking genesis checks the pool balances, so it is initialized after bank.
+func (AppModule) OrderingConstraints() []appmodule.OrderingConstraint {
+	return []appmodule.OrderingConstraint{
+		{Hook: appmodule.HookInitGenesis, After: []string{"auth", "bank"}},
+	}
+}
+
 // ConsensusVersion implements HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return consensusVersion }
 
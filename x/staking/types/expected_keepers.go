@@ -110,6 +110,21 @@ type StakingHooks interface {
 	AfterConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey, rotationFee sdk.Coin) error
 }
 
+// BondDenomConverter is the extension point letting an app accept alternative
+// bond denoms in the delegations, e.g. liquid staking tokens or secondary
+// assets, so that they contribute to the validator power. The coins of an
+// alternative denom are converted to the bond denom when delegated, at the
+// conversion rate of the converter, typically provided by an oracle, and the
+// delegation is then backed by the bond denom like any other.
+type BondDenomConverter interface {
+	// ConvertToBondDenom converts the given coin of an alternative bond denom,
+	// held by the given address, to the bond denom, e.g. by escrowing it and
+	// minting its value in the bond denom. It returns the bond denom coin, which
+	// must be held by the address on return, or an error if the denom is not
+	// accepted.
+	ConvertToBondDenom(ctx context.Context, addr sdk.AccAddress, coin sdk.Coin) (sdk.Coin, error)
+}
+
 // StakingHooksWrapper is a wrapper for modules to inject StakingHooks using depinject.
 type StakingHooksWrapper struct{ StakingHooks }
 
@@ -50,6 +50,9 @@ type MsgCreateValidator struct {
 	ValidatorAddress string     `protobuf:"bytes,5,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	Pubkey           *any.Any   `protobuf:"bytes,6,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	Value            types.Coin `protobuf:"bytes,7,opt,name=value,proto3" json:"value"`
+	// pubkey_proof_of_possession is the signature of the consensus public key by
+	// its private key, required for bls12_381 keys to prevent rogue-key attacks.
+	PubkeyProofOfPossession []byte `protobuf:"bytes,8,opt,name=pubkey_proof_of_possession,json=pubkeyProofOfPossession,proto3" json:"pubkey_proof_of_possession,omitempty"`
 }
 
 func (m *MsgCreateValidator) Reset()         { *m = MsgCreateValidator{} }
@@ -468,9 +471,11 @@ func (m *MsgUndelegateResponse) GetAmount() types.Coin {
 type MsgCancelUnbondingDelegation struct {
 	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	// amount is always less than or equal to unbonding delegation entry balance
+	// amount is always less than or equal to unbonding delegation entry balance, or to the balance of
+	// all the entries which aren't completed yet if creation_height is 0.
 	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
-	// creation_height is the height which the unbonding took place.
+	// creation_height is the height which the unbonding took place. If it is 0, the amount is cancelled
+	// across the unbonding delegation entries, oldest first.
 	CreationHeight int64 `protobuf:"varint,4,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
 }
 
@@ -643,6 +648,10 @@ var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 type MsgRotateConsPubKey struct {
 	ValidatorAddress string   `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	NewPubkey        *any.Any `protobuf:"bytes,2,opt,name=new_pubkey,json=newPubkey,proto3" json:"new_pubkey,omitempty"`
+	// new_pubkey_proof_of_possession is the signature of the new consensus public
+	// key by its private key, required for bls12_381 keys to prevent rogue-key
+	// attacks.
+	NewPubkeyProofOfPossession []byte `protobuf:"bytes,3,opt,name=new_pubkey_proof_of_possession,json=newPubkeyProofOfPossession,proto3" json:"new_pubkey_proof_of_possession,omitempty"`
 }
 
 func (m *MsgRotateConsPubKey) Reset()         { *m = MsgRotateConsPubKey{} }
@@ -738,87 +747,91 @@ func init() {
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 1277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x41, 0x4f, 0x1b, 0x47,
-	0x14, 0xf6, 0xda, 0x09, 0x29, 0x43, 0xc1, 0xb0, 0x40, 0x62, 0x16, 0x6a, 0xd3, 0x0d, 0x15, 0x94,
-	0xca, 0x6b, 0x20, 0x01, 0x54, 0x27, 0xaa, 0x82, 0x21, 0x6d, 0xd3, 0x96, 0x16, 0x2d, 0x21, 0x95,
-	0xaa, 0xb6, 0xee, 0x78, 0x77, 0x58, 0x56, 0x78, 0x77, 0x9c, 0x9d, 0x31, 0x89, 0x0f, 0x95, 0xaa,
-	0x9e, 0x9a, 0x9e, 0x72, 0xaf, 0x2a, 0xa5, 0x52, 0x2b, 0xf5, 0xc8, 0x81, 0x63, 0x7f, 0x40, 0x94,
-	0x53, 0x44, 0x2f, 0x51, 0x0e, 0xb4, 0x82, 0x03, 0xfd, 0x0f, 0xb9, 0x54, 0xbb, 0x3b, 0x5e, 0x7b,
-	0xd7, 0xde, 0xc5, 0xd0, 0xe6, 0x92, 0x4b, 0x62, 0xde, 0x7c, 0xf3, 0xcd, 0xcc, 0xf7, 0xbe, 0x79,
-	0xf3, 0x16, 0x64, 0x14, 0x4c, 0x0c, 0x4c, 0x72, 0x84, 0xc2, 0x6d, 0xdd, 0xd4, 0x72, 0x3b, 0xb3,
-	0x25, 0x44, 0xe1, 0x6c, 0x8e, 0xde, 0x97, 0x2a, 0x16, 0xa6, 0x98, 0xbf, 0xe8, 0x02, 0x24, 0x06,
-	0x90, 0x18, 0x40, 0x18, 0xd1, 0x30, 0xd6, 0xca, 0x28, 0xe7, 0xa0, 0x4a, 0xd5, 0xcd, 0x1c, 0x34,
-	0x6b, 0xee, 0x14, 0x21, 0x13, 0x1c, 0xa2, 0xba, 0x81, 0x08, 0x85, 0x46, 0x85, 0x01, 0x86, 0x34,
-	0xac, 0x61, 0xe7, 0x67, 0xce, 0xfe, 0xc5, 0xa2, 0x23, 0xee, 0x4a, 0x45, 0x77, 0x80, 0x2d, 0xeb,
-	0x0e, 0xa5, 0xd9, 0x2e, 0x4b, 0x90, 0x20, 0x6f, 0x8b, 0x0a, 0xd6, 0x4d, 0x36, 0x3e, 0x11, 0x72,
-	0x8a, 0xfa, 0xa6, 0x5d, 0xd4, 0x25, 0x86, 0x32, 0x88, 0x8d, 0xb0, 0xff, 0x63, 0x03, 0x03, 0xd0,
-	0xd0, 0x4d, 0x9c, 0x73, 0xfe, 0x75, 0x43, 0xe2, 0x8b, 0x73, 0x80, 0x5f, 0x25, 0xda, 0xb2, 0x85,
-	0x20, 0x45, 0x77, 0x60, 0x59, 0x57, 0x21, 0xc5, 0x16, 0xbf, 0x06, 0x7a, 0x54, 0x44, 0x14, 0x4b,
-	0xaf, 0x50, 0x1d, 0x9b, 0x29, 0x6e, 0x9c, 0x9b, 0xea, 0x99, 0xbb, 0x2c, 0xb5, 0xd7, 0x48, 0x5a,
-	0x69, 0x40, 0x0b, 0xdd, 0x8f, 0x0f, 0x32, 0xb1, 0xdf, 0x8f, 0x77, 0xa7, 0x39, 0xb9, 0x99, 0x82,
-	0x97, 0x01, 0x50, 0xb0, 0x61, 0xe8, 0x84, 0xd8, 0x84, 0x71, 0x87, 0x70, 0x32, 0x8c, 0x70, 0xd9,
-	0x43, 0xca, 0x90, 0x22, 0xd2, 0x4c, 0xda, 0xc4, 0xc2, 0x7f, 0x03, 0x06, 0x0d, 0xdd, 0x2c, 0x12,
-	0x54, 0xde, 0x2c, 0xaa, 0xa8, 0x8c, 0x34, 0xe8, 0xec, 0x36, 0x31, 0xce, 0x4d, 0x75, 0x17, 0x66,
-	0xec, 0x39, 0xcf, 0x0f, 0x32, 0xc3, 0xee, 0x1a, 0x44, 0xdd, 0x96, 0x74, 0x9c, 0x33, 0x20, 0xdd,
-	0x92, 0x6e, 0x99, 0x74, 0x7f, 0x2f, 0x0b, 0xd8, 0xe2, 0xb7, 0x4c, 0xea, 0x52, 0x0f, 0x18, 0xba,
-	0xb9, 0x8e, 0xca, 0x9b, 0x2b, 0x1e, 0x15, 0xff, 0x01, 0x18, 0x60, 0xc4, 0xd8, 0x2a, 0x42, 0x55,
-	0xb5, 0x10, 0x21, 0xa9, 0x73, 0x0e, 0xbf, 0xb0, 0xbf, 0x97, 0x1d, 0x62, 0x14, 0x4b, 0xee, 0xc8,
-	0x3a, 0xb5, 0x74, 0x53, 0x4b, 0x71, 0x72, 0xbf, 0x37, 0x89, 0x8d, 0xf0, 0x9f, 0x82, 0x81, 0x9d,
-	0xba, 0xba, 0x1e, 0xd1, 0x79, 0x87, 0xe8, 0xcd, 0xfd, 0xbd, 0xec, 0x1b, 0x8c, 0xc8, 0xcb, 0x80,
-	0x8f, 0x51, 0xee, 0xdf, 0x09, 0xc4, 0xf9, 0xf7, 0x41, 0x57, 0xa5, 0x5a, 0xda, 0x46, 0xb5, 0x54,
-	0x97, 0x23, 0xe5, 0x90, 0xe4, 0x9a, 0x51, 0xaa, 0x9b, 0x51, 0x5a, 0x32, 0x6b, 0x85, 0xd4, 0x93,
-	0xc6, 0x1e, 0x15, 0xab, 0x56, 0xa1, 0x58, 0x5a, 0xab, 0x96, 0x3e, 0x46, 0x35, 0x99, 0xcd, 0xe6,
-	0xf3, 0xe0, 0xfc, 0x0e, 0x2c, 0x57, 0x51, 0xea, 0x82, 0x43, 0x33, 0x52, 0xcf, 0x88, 0xed, 0xc0,
-	0xa6, 0x74, 0xe8, 0xbe, 0xc4, 0xba, 0x53, 0xf2, 0x37, 0x7e, 0x78, 0x94, 0x89, 0xfd, 0xf3, 0x28,
-	0x13, 0xfb, 0xfe, 0x78, 0x77, 0xba, 0xf5, 0x78, 0x3f, 0x1e, 0xef, 0x4e, 0xb3, 0x73, 0x65, 0x89,
-	0xba, 0x9d, 0x6b, 0xb5, 0x99, 0x38, 0x06, 0x84, 0xd6, 0xa8, 0x8c, 0x48, 0x05, 0x9b, 0x04, 0x89,
-	0xbf, 0x25, 0x40, 0xff, 0x2a, 0xd1, 0x6e, 0xaa, 0x3a, 0x7d, 0x99, 0xce, 0x6c, 0x9b, 0x9a, 0xf8,
-	0xd9, 0x53, 0x73, 0x07, 0x24, 0x1b, 0x1e, 0x2d, 0x5a, 0x90, 0x22, 0xe6, 0xc8, 0xec, 0xf3, 0x83,
-	0xcc, 0x68, 0xab, 0x1b, 0x3f, 0x41, 0x1a, 0x54, 0x6a, 0x2b, 0x48, 0x69, 0xf2, 0xe4, 0x0a, 0x52,
-	0xe4, 0x3e, 0xc5, 0x77, 0x0b, 0xf8, 0xcf, 0xdb, 0xbb, 0xdd, 0x75, 0xe3, 0x64, 0x87, 0x4e, 0x6f,
-	0x63, 0xf2, 0xfc, 0x7b, 0x27, 0xe7, 0x71, 0xd4, 0x9f, 0x47, 0x5f, 0x4a, 0x44, 0x01, 0xa4, 0x82,
-	0x31, 0x2f, 0x87, 0x3f, 0xc7, 0x41, 0xcf, 0x2a, 0xd1, 0xd8, 0x6a, 0x88, 0xbf, 0xd9, 0xee, 0x42,
-	0x71, 0xce, 0x11, 0x52, 0x61, 0x17, 0xaa, 0xd3, 0xeb, 0xf4, 0x1f, 0x72, 0x76, 0x1d, 0x74, 0x41,
-	0x03, 0x57, 0x4d, 0xea, 0xa4, 0xaa, 0xd3, 0x7b, 0xc0, 0xe6, 0xe4, 0xdf, 0xf5, 0x09, 0xd8, 0x72,
-	0x3e, 0x5b, 0xc0, 0x8b, 0x7e, 0x01, 0xeb, 0x7a, 0x88, 0xc3, 0x60, 0xb0, 0xe9, 0x4f, 0x4f, 0xb6,
-	0x07, 0x09, 0xa7, 0x2c, 0x17, 0x90, 0xa6, 0x9b, 0x32, 0x52, 0xff, 0x67, 0xf5, 0x36, 0xc0, 0x70,
-	0x43, 0x3d, 0x62, 0x29, 0xa7, 0x57, 0x70, 0xd0, 0x9b, 0xbf, 0x6e, 0x29, 0x6d, 0x69, 0x55, 0x42,
-	0x3d, 0xda, 0xc4, 0xe9, 0x69, 0x57, 0x08, 0x6d, 0xcd, 0xcd, 0xb9, 0x33, 0xe4, 0xe6, 0xc6, 0xc9,
-	0xb9, 0x09, 0x14, 0xa9, 0x80, 0xe8, 0x62, 0xc5, 0x29, 0x52, 0x81, 0x68, 0x3d, 0x53, 0xbc, 0xec,
-	0xdc, 0xf6, 0x4a, 0x19, 0xd9, 0x57, 0xa9, 0x68, 0x77, 0x00, 0xac, 0x26, 0x09, 0x2d, 0x15, 0xf9,
-	0x76, 0xbd, 0x3d, 0x28, 0xf4, 0xda, 0xfb, 0x7c, 0xf8, 0x57, 0x86, 0x73, 0xf7, 0xda, 0xd7, 0x60,
-	0xb0, 0x31, 0xe2, 0x2f, 0x71, 0xd0, 0xbb, 0x4a, 0xb4, 0x0d, 0x53, 0x7d, 0xa5, 0xaf, 0xcd, 0xb5,
-	0x93, 0x53, 0x93, 0xf2, 0xa7, 0xa6, 0xa1, 0x88, 0xf8, 0x07, 0x07, 0x86, 0x7d, 0x91, 0x97, 0x99,
-	0x11, 0xfe, 0x33, 0xef, 0xa0, 0xf1, 0x93, 0x0e, 0x3a, 0xe6, 0xf4, 0x1d, 0x7b, 0xd9, 0x64, 0x63,
-	0xeb, 0xe3, 0x33, 0xd2, 0xfc, 0x8c, 0xef, 0xec, 0xe2, 0x8b, 0x38, 0x18, 0xb3, 0x9f, 0x3e, 0x68,
-	0x2a, 0xa8, 0xbc, 0x61, 0x96, 0xb0, 0xa9, 0xea, 0xa6, 0xd6, 0xd4, 0x79, 0xbc, 0x8a, 0x19, 0xe7,
-	0x27, 0x41, 0x52, 0xb1, 0x1f, 0x7b, 0x3b, 0x31, 0x5b, 0x48, 0xd7, 0xb6, 0xdc, 0x3b, 0x9d, 0x90,
-	0xfb, 0xea, 0xe1, 0x0f, 0x9d, 0x68, 0xfe, 0xab, 0xba, 0x35, 0xf6, 0x83, 0x42, 0x5e, 0x5d, 0x08,
-	0x77, 0xcb, 0x64, 0xa0, 0xdb, 0x08, 0x13, 0x57, 0xbc, 0x06, 0x26, 0xa2, 0xc6, 0xeb, 0x56, 0xca,
-	0x0f, 0xb6, 0x59, 0x5e, 0x7c, 0xc6, 0x81, 0xa4, 0xed, 0xbc, 0x8a, 0x0a, 0x29, 0x5a, 0x83, 0x16,
-	0x34, 0x08, 0xbf, 0x00, 0xba, 0x61, 0x95, 0x6e, 0x61, 0x4b, 0xa7, 0xb5, 0x13, 0xb3, 0xd4, 0x80,
-	0xf2, 0x4b, 0xa0, 0xab, 0xe2, 0x30, 0x30, 0x5f, 0xa5, 0xc3, 0x1a, 0x19, 0x77, 0x1d, 0x9f, 0xa6,
-	0xee, 0xc4, 0xfc, 0x47, 0xad, 0x7b, 0x5c, 0xb4, 0x25, 0x6a, 0xac, 0x62, 0x4b, 0x33, 0xd1, 0x24,
-	0xcd, 0x7d, 0xef, 0xfb, 0x21, 0x70, 0x0c, 0x51, 0x02, 0x97, 0x02, 0xa1, 0x28, 0x29, 0x16, 0xc5,
-	0x9f, 0xe2, 0xce, 0xf3, 0x25, 0x63, 0x0a, 0x29, 0x5a, 0xc6, 0x26, 0x71, 0xbb, 0xcb, 0xf6, 0xae,
-	0xe3, 0xce, 0xee, 0xba, 0xaf, 0x01, 0x30, 0xd1, 0xbd, 0x22, 0xeb, 0x78, 0xe3, 0x11, 0x1d, 0xef,
-	0xdb, 0x61, 0x1d, 0xef, 0xfe, 0x5e, 0xb6, 0x97, 0xc5, 0x59, 0x0b, 0xdc, 0x6d, 0xa2, 0x7b, 0x6b,
-	0x0e, 0x63, 0xfe, 0x76, 0xa8, 0xdd, 0xe6, 0x67, 0xc3, 0x9b, 0xa2, 0xb4, 0xdf, 0x6e, 0x41, 0x15,
-	0xc4, 0x39, 0x30, 0xda, 0x26, 0x1c, 0xa1, 0xe8, 0xfc, 0xec, 0xdc, 0x9f, 0x17, 0x40, 0x62, 0x95,
-	0x68, 0xfc, 0x5d, 0x90, 0x0c, 0x7e, 0x93, 0x4d, 0x87, 0x79, 0xa3, 0xb5, 0x85, 0x16, 0xe6, 0x3a,
-	0xc7, 0x7a, 0x75, 0x73, 0x1b, 0xf4, 0xfa, 0x5b, 0xed, 0xa9, 0x08, 0x12, 0x1f, 0x52, 0x98, 0xe9,
-	0x14, 0xe9, 0x2d, 0xf6, 0x25, 0x78, 0xcd, 0xeb, 0x09, 0x2f, 0x47, 0xcc, 0xae, 0x83, 0x84, 0x77,
-	0x3a, 0x00, 0x79, 0xec, 0x77, 0x41, 0x32, 0xd8, 0x3a, 0x45, 0xa9, 0x17, 0xc0, 0x46, 0xaa, 0x17,
-	0xd6, 0x07, 0x94, 0x00, 0x68, 0x7a, 0xaf, 0xdf, 0x8a, 0x60, 0x68, 0xc0, 0x84, 0x6c, 0x47, 0x30,
-	0x6f, 0x8d, 0x5f, 0x39, 0x30, 0x12, 0xfe, 0x62, 0x5c, 0x8d, 0xca, 0x79, 0xd8, 0x2c, 0xe1, 0xfa,
-	0x59, 0x66, 0x79, 0x7d, 0xea, 0xe0, 0x93, 0xd6, 0x02, 0xc9, 0x7f, 0x0b, 0x5e, 0xf7, 0x15, 0xc7,
-	0xc9, 0xa8, 0x53, 0x36, 0x01, 0x85, 0x5c, 0x87, 0xc0, 0xa8, 0xe5, 0x17, 0xf9, 0x07, 0x1c, 0xe8,
-	0x6f, 0xa9, 0x48, 0x51, 0xf6, 0x09, 0x82, 0x85, 0x2b, 0xa7, 0x00, 0x47, 0xec, 0x65, 0x7e, 0x56,
-	0x38, 0xff, 0x9d, 0x5d, 0xab, 0x0b, 0x0b, 0x8f, 0x0f, 0xd3, 0xdc, 0xd3, 0xc3, 0x34, 0xf7, 0xf7,
-	0x61, 0x9a, 0x7b, 0x78, 0x94, 0x8e, 0x3d, 0x3d, 0x4a, 0xc7, 0x9e, 0x1d, 0xa5, 0x63, 0x5f, 0x8c,
-	0xf9, 0xbe, 0xd9, 0x1a, 0x95, 0x99, 0xd6, 0x2a, 0x88, 0x94, 0xba, 0x9c, 0xda, 0x76, 0xe5, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x0b, 0xcb, 0xe0, 0xf9, 0xbe, 0x12, 0x00, 0x00,
+	// 1335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xdf, 0x6f, 0xd3, 0xd6,
+	0x17, 0x8f, 0x13, 0x5a, 0xe8, 0x85, 0x92, 0xd6, 0xa1, 0x90, 0x9a, 0x92, 0xf4, 0x6b, 0xf8, 0xaa,
+	0x5d, 0xa7, 0x38, 0x6d, 0xa0, 0xa0, 0x05, 0x34, 0x41, 0x28, 0xdb, 0xd8, 0xd6, 0x11, 0x99, 0x5f,
+	0xd2, 0xb4, 0x2d, 0x73, 0xec, 0x1b, 0xd7, 0x6a, 0xec, 0x6b, 0x7c, 0x6f, 0x0a, 0x79, 0x98, 0x34,
+	0xed, 0x69, 0xec, 0x89, 0x7f, 0x60, 0x12, 0x93, 0x36, 0x69, 0x8f, 0x3c, 0xf4, 0x71, 0x7f, 0x00,
+	0x42, 0x9a, 0x84, 0xba, 0x17, 0xc4, 0x03, 0x9b, 0xe0, 0xa1, 0xfb, 0x03, 0xf6, 0xb6, 0x97, 0xc9,
+	0xbe, 0x8e, 0x1d, 0xdb, 0xb1, 0x9b, 0x76, 0xe3, 0x85, 0x17, 0x48, 0xcf, 0xfd, 0x9c, 0xcf, 0xb9,
+	0xf7, 0x7c, 0xce, 0xb9, 0xf7, 0x18, 0x14, 0x65, 0x84, 0x75, 0x84, 0xcb, 0x98, 0x48, 0xeb, 0x9a,
+	0xa1, 0x96, 0x37, 0x96, 0x9a, 0x90, 0x48, 0x4b, 0x65, 0x72, 0x4f, 0x30, 0x2d, 0x44, 0x10, 0x7b,
+	0x94, 0x02, 0x04, 0x17, 0x20, 0xb8, 0x00, 0x6e, 0x5a, 0x45, 0x48, 0x6d, 0xc3, 0xb2, 0x83, 0x6a,
+	0x76, 0x5a, 0x65, 0xc9, 0xe8, 0x52, 0x17, 0xae, 0x18, 0x5e, 0x22, 0x9a, 0x0e, 0x31, 0x91, 0x74,
+	0xd3, 0x05, 0x1c, 0x51, 0x91, 0x8a, 0x9c, 0x9f, 0x65, 0xfb, 0x97, 0x6b, 0x9d, 0xa6, 0x91, 0x1a,
+	0x74, 0xc1, 0x0d, 0x4b, 0x97, 0x0a, 0xee, 0x2e, 0x9b, 0x12, 0x86, 0xde, 0x16, 0x65, 0xa4, 0x19,
+	0xee, 0xfa, 0xa9, 0x98, 0x53, 0xf4, 0x36, 0x4d, 0x51, 0xc7, 0x5c, 0x94, 0x8e, 0x6d, 0x84, 0xfd,
+	0x9f, 0xbb, 0x30, 0x29, 0xe9, 0x9a, 0x81, 0xca, 0xce, 0xbf, 0xd4, 0xc4, 0xff, 0x3a, 0x02, 0xd8,
+	0x55, 0xac, 0x5e, 0xb6, 0xa0, 0x44, 0xe0, 0x2d, 0xa9, 0xad, 0x29, 0x12, 0x41, 0x16, 0x5b, 0x07,
+	0x07, 0x15, 0x88, 0x65, 0x4b, 0x33, 0x89, 0x86, 0x8c, 0x3c, 0x33, 0xcb, 0xcc, 0x1f, 0xac, 0x9c,
+	0x14, 0x06, 0xe7, 0x48, 0x58, 0xf1, 0xa1, 0xb5, 0xb1, 0xc7, 0x2f, 0x8a, 0xa9, 0x9f, 0xb7, 0x1f,
+	0x2d, 0x30, 0x62, 0x3f, 0x05, 0x2b, 0x02, 0x20, 0x23, 0x5d, 0xd7, 0x30, 0xb6, 0x09, 0xd3, 0x0e,
+	0xe1, 0x5c, 0x1c, 0xe1, 0x65, 0x0f, 0x29, 0x4a, 0x04, 0xe2, 0x7e, 0xd2, 0x3e, 0x16, 0xf6, 0x4b,
+	0x90, 0xd3, 0x35, 0xa3, 0x81, 0x61, 0xbb, 0xd5, 0x50, 0x60, 0x1b, 0xaa, 0x92, 0xb3, 0xdb, 0xcc,
+	0x2c, 0x33, 0x3f, 0x56, 0x5b, 0xb4, 0x7d, 0x9e, 0xbf, 0x28, 0x4e, 0xd1, 0x18, 0x58, 0x59, 0x17,
+	0x34, 0x54, 0xd6, 0x25, 0xb2, 0x26, 0x5c, 0x35, 0xc8, 0xd6, 0x66, 0x09, 0xb8, 0xc1, 0xaf, 0x1a,
+	0x84, 0x52, 0x4f, 0xea, 0x9a, 0x71, 0x1d, 0xb6, 0x5b, 0x2b, 0x1e, 0x15, 0xfb, 0x3e, 0x98, 0x74,
+	0x89, 0x91, 0xd5, 0x90, 0x14, 0xc5, 0x82, 0x18, 0xe7, 0xf7, 0x39, 0xfc, 0xdc, 0xd6, 0x66, 0xe9,
+	0x88, 0x4b, 0x71, 0x89, 0xae, 0x5c, 0x27, 0x96, 0x66, 0xa8, 0x79, 0x46, 0x9c, 0xf0, 0x9c, 0xdc,
+	0x15, 0xf6, 0x13, 0x30, 0xb9, 0xd1, 0xcb, 0xae, 0x47, 0x34, 0xe2, 0x10, 0xfd, 0x6f, 0x6b, 0xb3,
+	0x74, 0xc2, 0x25, 0xf2, 0x14, 0x08, 0x30, 0x8a, 0x13, 0x1b, 0x21, 0x3b, 0xfb, 0x1e, 0x18, 0x35,
+	0x3b, 0xcd, 0x75, 0xd8, 0xcd, 0x8f, 0x3a, 0xa9, 0x3c, 0x22, 0xd0, 0x62, 0x14, 0x7a, 0xc5, 0x28,
+	0x5c, 0x32, 0xba, 0xb5, 0xfc, 0x13, 0x7f, 0x8f, 0xb2, 0xd5, 0x35, 0x09, 0x12, 0xea, 0x9d, 0xe6,
+	0x47, 0xb0, 0x2b, 0xba, 0xde, 0x6c, 0x15, 0x8c, 0x6c, 0x48, 0xed, 0x0e, 0xcc, 0xef, 0x77, 0x68,
+	0xa6, 0x7b, 0x8a, 0xd8, 0x15, 0xd8, 0x27, 0x87, 0x16, 0x10, 0x96, 0xba, 0xb0, 0x75, 0xc0, 0x51,
+	0x16, 0xbb, 0x94, 0x51, 0xab, 0x81, 0x5a, 0x0d, 0x13, 0x61, 0x0c, 0xa9, 0xc4, 0x07, 0x66, 0x99,
+	0xf9, 0x43, 0xb5, 0xdc, 0xf3, 0xcd, 0x52, 0x96, 0x72, 0x96, 0xb0, 0xb2, 0x3e, 0xbb, 0x28, 0x2c,
+	0x57, 0xc4, 0x63, 0xd4, 0xad, 0x6e, 0x7b, 0x5d, 0x6b, 0xd5, 0x3d, 0x9f, 0xea, 0xc5, 0x6f, 0x1f,
+	0x16, 0x53, 0x7f, 0x3e, 0x2c, 0xa6, 0xbe, 0xd9, 0x7e, 0xb4, 0x10, 0x4d, 0xd8, 0x77, 0xdb, 0x8f,
+	0x16, 0x4e, 0xf8, 0x64, 0xe5, 0x68, 0xe1, 0xf2, 0x33, 0x80, 0x8b, 0x5a, 0x45, 0x88, 0x4d, 0x64,
+	0x60, 0xc8, 0xff, 0x94, 0x01, 0x13, 0xab, 0x58, 0xbd, 0xa2, 0x68, 0xe4, 0x75, 0xd6, 0xfa, 0x40,
+	0xb1, 0xd3, 0x7b, 0x17, 0xfb, 0x16, 0xc8, 0xfa, 0x55, 0xdf, 0xb0, 0x24, 0x02, 0xdd, 0x1a, 0x2f,
+	0x3d, 0x7f, 0x51, 0x3c, 0x1e, 0xad, 0xef, 0x8f, 0xa1, 0x2a, 0xc9, 0xdd, 0x15, 0x28, 0xf7, 0x55,
+	0xf9, 0x0a, 0x94, 0xc5, 0xc3, 0x72, 0xa0, 0xaf, 0xd8, 0xdb, 0x83, 0xfb, 0x87, 0xd6, 0xf7, 0xdc,
+	0x90, 0xbd, 0x33, 0xa0, 0x6d, 0xaa, 0xef, 0xee, 0xac, 0xe3, 0xf1, 0xa0, 0x8e, 0x01, 0x49, 0x78,
+	0x0e, 0xe4, 0xc3, 0x36, 0x4f, 0xc3, 0xef, 0xd3, 0xe0, 0xe0, 0x2a, 0x56, 0xdd, 0x68, 0x90, 0xbd,
+	0x32, 0xa8, 0x45, 0x19, 0xe7, 0x08, 0xf9, 0xb8, 0x16, 0x1d, 0xb6, 0x41, 0xff, 0x85, 0x66, 0x17,
+	0xc0, 0xa8, 0xa4, 0xa3, 0x8e, 0x41, 0xf2, 0x99, 0x5d, 0x74, 0x96, 0xeb, 0x53, 0x7d, 0x27, 0x90,
+	0xc0, 0xc8, 0xf9, 0xec, 0x04, 0x1e, 0x0d, 0x26, 0xb0, 0x97, 0x0f, 0x7e, 0x0a, 0xe4, 0xfa, 0xfe,
+	0xf4, 0xd2, 0x76, 0x3f, 0xe3, 0x5c, 0xf4, 0x35, 0xa8, 0x6a, 0x86, 0x08, 0x95, 0xff, 0x38, 0x7b,
+	0x37, 0xc1, 0x94, 0x9f, 0x3d, 0x6c, 0xc9, 0xbb, 0xcf, 0x60, 0xce, 0xf3, 0xbf, 0x6e, 0xc9, 0x03,
+	0x69, 0x15, 0x4c, 0x3c, 0xda, 0xcc, 0xee, 0x69, 0x57, 0x30, 0x89, 0x6a, 0xb3, 0x6f, 0x0f, 0xda,
+	0x5c, 0xdc, 0x59, 0x9b, 0xd0, 0x25, 0x15, 0x4a, 0x3a, 0x6f, 0x02, 0x2e, 0x6a, 0xed, 0x29, 0xc5,
+	0x8a, 0x4e, 0xb7, 0x9b, 0x6d, 0x68, 0xb7, 0x52, 0xc3, 0x9e, 0x29, 0xdc, 0x3b, 0x89, 0x8b, 0xdc,
+	0xf1, 0x37, 0x7a, 0x03, 0x47, 0x6d, 0xdc, 0xde, 0xe7, 0x83, 0xdf, 0x8b, 0x0c, 0xdd, 0xeb, 0x61,
+	0x9f, 0xc1, 0xc6, 0xf0, 0x3f, 0xa4, 0xc1, 0xf8, 0x2a, 0x56, 0x6f, 0x1a, 0xca, 0x1b, 0xdd, 0x36,
+	0xe7, 0x77, 0x96, 0x26, 0x1f, 0x94, 0xc6, 0xcf, 0x08, 0xff, 0x0b, 0x03, 0xa6, 0x02, 0x96, 0xd7,
+	0xa9, 0x08, 0x7b, 0xcd, 0x3b, 0x68, 0x7a, 0xa7, 0x83, 0xce, 0x38, 0x93, 0x4c, 0xe4, 0x1d, 0x5d,
+	0x0c, 0x9c, 0x9d, 0xff, 0x3b, 0x0d, 0x66, 0xec, 0xa7, 0x4f, 0x32, 0x64, 0xd8, 0xbe, 0x69, 0x34,
+	0x91, 0xa1, 0x68, 0x86, 0xda, 0x37, 0xcb, 0xbc, 0x89, 0x8a, 0xb3, 0x73, 0x20, 0x2b, 0xdb, 0x8f,
+	0xbd, 0x2d, 0xcc, 0x1a, 0xd4, 0xd4, 0x35, 0xda, 0xd3, 0x19, 0xf1, 0x70, 0xcf, 0xfc, 0x81, 0x63,
+	0xad, 0x7e, 0xde, 0x2b, 0x8d, 0xad, 0x70, 0x22, 0xcf, 0x9c, 0x8d, 0xaf, 0x96, 0xb9, 0xd0, 0xb4,
+	0x11, 0x97, 0x5c, 0xfe, 0x3c, 0x38, 0x95, 0xb4, 0xde, 0x2b, 0xa5, 0x6a, 0x6e, 0x40, 0x78, 0xfe,
+	0x19, 0x03, 0xb2, 0x76, 0xe5, 0x99, 0x8a, 0x44, 0x60, 0x5d, 0xb2, 0x24, 0x1d, 0xb3, 0x67, 0xc1,
+	0x98, 0xd4, 0x21, 0x6b, 0xc8, 0xd2, 0x48, 0x77, 0x47, 0x95, 0x7c, 0x28, 0x7b, 0x09, 0x8c, 0x9a,
+	0x0e, 0x83, 0x5b, 0x57, 0x85, 0xb8, 0x41, 0x86, 0xc6, 0x09, 0xe4, 0x94, 0x3a, 0x56, 0x3f, 0x8c,
+	0xee, 0xf1, 0x9c, 0x9d, 0x22, 0x3f, 0x8a, 0x9d, 0x9a, 0x53, 0x7d, 0xa9, 0xb9, 0xe7, 0x7d, 0x91,
+	0x84, 0x8e, 0xc1, 0x0b, 0xe0, 0x58, 0xc8, 0x94, 0x94, 0x8a, 0x73, 0xfc, 0x5f, 0x69, 0xe7, 0xf9,
+	0x12, 0x11, 0x91, 0x08, 0xbc, 0x8c, 0x0c, 0x4c, 0xe7, 0xd5, 0xc1, 0x55, 0xc7, 0xec, 0xbd, 0xea,
+	0xbe, 0x00, 0xc0, 0x80, 0x77, 0x1b, 0xee, 0x0c, 0x9d, 0x4e, 0x98, 0xa1, 0xdf, 0x8a, 0x9b, 0xa1,
+	0xb7, 0x36, 0x4b, 0xe3, 0xae, 0x9d, 0x1a, 0xc4, 0x31, 0x03, 0xde, 0xad, 0xd3, 0xb9, 0xfa, 0x36,
+	0x28, 0xf8, 0xfc, 0x03, 0xe7, 0xe3, 0x4c, 0xfc, 0x7c, 0xcc, 0x79, 0x3c, 0xd1, 0x11, 0xf9, 0x46,
+	0x6c, 0x1d, 0x2f, 0x2f, 0xc5, 0x4f, 0x5b, 0x85, 0x60, 0x1d, 0x87, 0xd3, 0xcb, 0x57, 0xc0, 0xf1,
+	0x01, 0xe6, 0x04, 0xa9, 0x96, 0x97, 0x2a, 0xbf, 0xed, 0x07, 0x99, 0x55, 0xac, 0xb2, 0x77, 0x40,
+	0x36, 0xfc, 0xf9, 0xb8, 0x10, 0x57, 0x74, 0xd1, 0xd9, 0x9c, 0xab, 0x0c, 0x8f, 0xf5, 0x2e, 0xe4,
+	0x75, 0x30, 0x1e, 0x9c, 0xe1, 0xe7, 0x13, 0x48, 0x02, 0x48, 0x6e, 0x71, 0x58, 0xa4, 0x17, 0xec,
+	0x33, 0x70, 0xc0, 0x1b, 0x36, 0x4f, 0x26, 0x78, 0xf7, 0x40, 0xdc, 0xdb, 0x43, 0x80, 0x3c, 0xf6,
+	0x3b, 0x20, 0x1b, 0x9e, 0xc9, 0x92, 0xb2, 0x17, 0xc2, 0x72, 0x95, 0xe1, 0xb1, 0x5e, 0xc8, 0x26,
+	0x00, 0x7d, 0x83, 0xc0, 0xff, 0x13, 0x18, 0x7c, 0x18, 0x57, 0x1a, 0x0a, 0xe6, 0xc5, 0xf8, 0x91,
+	0x01, 0xd3, 0xf1, 0x4f, 0xd1, 0x99, 0x24, 0xcd, 0xe3, 0xbc, 0xb8, 0x0b, 0x7b, 0xf1, 0xf2, 0x06,
+	0xe0, 0xdc, 0x93, 0xe8, 0xcd, 0xcb, 0x7e, 0x05, 0x0e, 0x05, 0x6e, 0xdd, 0xb9, 0xa4, 0x53, 0xf6,
+	0x01, 0xb9, 0xf2, 0x90, 0xc0, 0xa4, 0xf0, 0xe7, 0xd8, 0xfb, 0x0c, 0x98, 0x88, 0x5c, 0x75, 0x49,
+	0xe5, 0x13, 0x06, 0x73, 0xa7, 0x77, 0x01, 0x4e, 0xd8, 0xcb, 0xf2, 0x12, 0x37, 0xf2, 0xb5, 0xfd,
+	0x08, 0xd4, 0xce, 0x3e, 0x7e, 0x59, 0x60, 0x9e, 0xbe, 0x2c, 0x30, 0x7f, 0xbc, 0x2c, 0x30, 0x0f,
+	0x5e, 0x15, 0x52, 0x4f, 0x5f, 0x15, 0x52, 0xcf, 0x5e, 0x15, 0x52, 0x9f, 0xce, 0x04, 0x3e, 0x06,
+	0xfd, 0x2b, 0x9f, 0x74, 0x4d, 0x88, 0x9b, 0xa3, 0xce, 0xa5, 0x79, 0xfa, 0x9f, 0x01, 0x00, 0x46,
+	0x24, 0x14, 0xfe, 0x69, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1201,6 +1214,13 @@ func (m *MsgCreateValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PubkeyProofOfPossession) > 0 {
+		i -= len(m.PubkeyProofOfPossession)
+		copy(dAtA[i:], m.PubkeyProofOfPossession)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubkeyProofOfPossession)))
+		i--
+		dAtA[i] = 0x42
+	}
 	{
 		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1781,6 +1801,13 @@ func (m *MsgRotateConsPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NewPubkeyProofOfPossession) > 0 {
+		i -= len(m.NewPubkeyProofOfPossession)
+		copy(dAtA[i:], m.NewPubkeyProofOfPossession)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewPubkeyProofOfPossession)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.NewPubkey != nil {
 		{
 			size, err := m.NewPubkey.MarshalToSizedBuffer(dAtA[:i])
@@ -1863,6 +1890,10 @@ func (m *MsgCreateValidator) Size() (n int) {
 	}
 	l = m.Value.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PubkeyProofOfPossession)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2070,6 +2101,10 @@ func (m *MsgRotateConsPubKey) Size() (n int) {
 		l = m.NewPubkey.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.NewPubkeyProofOfPossession)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2350,6 +2385,40 @@ func (m *MsgCreateValidator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubkeyProofOfPossession", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubkeyProofOfPossession = append(m.PubkeyProofOfPossession[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubkeyProofOfPossession == nil {
+				m.PubkeyProofOfPossession = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3858,6 +3927,40 @@ func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubkeyProofOfPossession", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPubkeyProofOfPossession = append(m.NewPubkeyProofOfPossession[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewPubkeyProofOfPossession == nil {
+				m.NewPubkeyProofOfPossession = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -16,6 +16,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -266,7 +267,7 @@ func (v Validator) ModuleValidatorUpdate(r math.Int) appmodule.ValidatorUpdate {
 
 	return appmodule.ValidatorUpdate{
 		PubKey:     consPk.Bytes(),
-		PubKeyType: consPk.Type(),
+		PubKeyType: cryptocodec.ToCmtPubKeyType(consPk),
 		Power:      v.ConsensusPower(r),
 	}
 }
@@ -282,7 +283,7 @@ func (v Validator) ModuleValidatorUpdateZero() appmodule.ValidatorUpdate {
 
 	return appmodule.ValidatorUpdate{
 		PubKey:     consPk.Bytes(),
-		PubKeyType: consPk.Type(),
+		PubKeyType: cryptocodec.ToCmtPubKeyType(consPk),
 		Power:      0,
 	}
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -264,6 +265,16 @@ func TestBondStatus(t *testing.T) {
 	require.Equal(t, types.BondStatusUnbonding, types.Unbonding.String())
 }
 
+func TestModuleValidatorUpdatePubKeyType(t *testing.T) {
+	val := newValidator(t, valAddr1, pk1)
+	require.Equal(t, "ed25519", val.ModuleValidatorUpdate(sdk.DefaultPowerReduction).PubKeyType)
+
+	// bls12_381 keys are known by CometBFT under another type
+	val = newValidator(t, valAddr2, &bls12_381.PubKey{Key: make([]byte, bls12_381.PubKeySize)})
+	require.Equal(t, "bls12_381", val.ModuleValidatorUpdate(sdk.DefaultPowerReduction).PubKeyType)
+	require.Equal(t, "bls12_381", val.ModuleValidatorUpdateZero().PubKeyType)
+}
+
 func mkValidator(tokens int64, shares math.LegacyDec) types.Validator {
 	vAddr1, _ := codectestutil.CodecOptions{}.GetValidatorCodec().BytesToString(valAddr1)
 	return types.Validator{
@@ -0,0 +1,270 @@
+package eip712
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/x/tx/decode"
+	"cosmossdk.io/x/tx/signing"
+)
+
+// SignMode is the SIGN_MODE_EIP_712 sign mode. It is referenced by its number
+// as x/tx builds against released versions of cosmossdk.io/api.
+const SignMode = signingv1beta1.SignMode(712)
+
+const (
+	// DefaultDomainName is the default name of the EIP-712 domain.
+	DefaultDomainName = "Cosmos SDK"
+	// DefaultDomainVersion is the default version of the EIP-712 domain.
+	DefaultDomainVersion = "1"
+
+	domainType  = "EIP712Domain"
+	primaryType = "Tx"
+)
+
+// eip155ChainIDRegex matches chain IDs of the form {identifier}_{EIP155}-{version}.
+var eip155ChainIDRegex = regexp.MustCompile(`^[a-z]+[a-z0-9-]*_([1-9][0-9]*)-[1-9][0-9]*$`)
+
+// ChainIDFn returns the EIP-155 chain ID of the domain of the transactions
+// signed for a Cosmos chain ID.
+type ChainIDFn func(chainID string) (*big.Int, error)
+
+// ParseEIP155ChainID is the default ChainIDFn. It parses chain IDs of the form
+// {identifier}_{EIP155}-{version}, e.g. "evmos_9001-2".
+func ParseEIP155ChainID(chainID string) (*big.Int, error) {
+	matches := eip155ChainIDRegex.FindStringSubmatch(chainID)
+	if matches == nil {
+		return nil, fmt.Errorf("chain ID %q is not of the form {identifier}_{EIP155}-{version}", chainID)
+	}
+
+	eip155ChainID, ok := new(big.Int).SetString(matches[1], 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid EIP-155 chain ID %s", matches[1])
+	}
+
+	return eip155ChainID, nil
+}
+
+// Type is a field of an EIP-712 struct type.
+type Type struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// TypedData is the EIP-712 typed data of a transaction, in the JSON format
+// expected by the eth_signTypedData_v4 RPC method of Ethereum wallets.
+//
+// Integers are represented as decimal strings and bytes as 0x prefixed hex
+// strings.
+type TypedData struct {
+	Types       map[string][]Type `json:"types"`
+	PrimaryType string            `json:"primaryType"`
+	Domain      map[string]any    `json:"domain"`
+	Message     map[string]any    `json:"message"`
+}
+
+// SignModeHandler implements the SIGN_MODE_EIP_712 signing mode. Its sign bytes
+// are the EIP-712 encoding of the typed data of the transaction, which
+// signatures are over the Keccak-256 hash of, as produced by Ethereum wallets.
+//
+// The typed data types are derived from the protobuf descriptors of the
+// transaction messages: every field is part of the type, in field number
+// order, messages being named after their fully qualified protobuf name with
+// dots replaced by underscores.
+type SignModeHandler struct {
+	fileResolver  signing.ProtoFileResolver
+	typeResolver  protoregistry.MessageTypeResolver
+	chainIDFn     ChainIDFn
+	domainName    string
+	domainVersion string
+}
+
+// SignModeHandlerOptions are the options for the SignModeHandler.
+type SignModeHandlerOptions struct {
+	// FileResolver are the protobuf files to use for resolving message
+	// descriptors. If it is nil, the global protobuf registry will be used.
+	FileResolver signing.ProtoFileResolver
+
+	// TypeResolver are the protobuf type resolvers to use for resolving the
+	// messages packed in Any. If it is nil, the global protobuf registry will
+	// be used.
+	TypeResolver signing.TypeResolver
+
+	// ChainIDFn returns the EIP-155 chain ID of the EIP-712 domain. It defaults
+	// to ParseEIP155ChainID.
+	ChainIDFn ChainIDFn
+
+	// DomainName is the name of the EIP-712 domain. It defaults to
+	// DefaultDomainName.
+	DomainName string
+
+	// DomainVersion is the version of the EIP-712 domain. It defaults to
+	// DefaultDomainVersion.
+	DomainVersion string
+}
+
+// NewSignModeHandler returns a new SignModeHandler.
+func NewSignModeHandler(options SignModeHandlerOptions) *SignModeHandler {
+	h := &SignModeHandler{
+		fileResolver:  options.FileResolver,
+		typeResolver:  options.TypeResolver,
+		chainIDFn:     options.ChainIDFn,
+		domainName:    options.DomainName,
+		domainVersion: options.DomainVersion,
+	}
+	if h.fileResolver == nil {
+		h.fileResolver = gogoproto.HybridResolver
+	}
+	if h.typeResolver == nil {
+		h.typeResolver = protoregistry.GlobalTypes
+	}
+	if h.chainIDFn == nil {
+		h.chainIDFn = ParseEIP155ChainID
+	}
+	if h.domainName == "" {
+		h.domainName = DefaultDomainName
+	}
+	if h.domainVersion == "" {
+		h.domainVersion = DefaultDomainVersion
+	}
+
+	return h
+}
+
+// Mode implements signing.SignModeHandler.Mode.
+func (h SignModeHandler) Mode() signingv1beta1.SignMode {
+	return SignMode
+}
+
+// GetSignBytes implements signing.SignModeHandler.GetSignBytes.
+func (h SignModeHandler) GetSignBytes(_ context.Context, signerData signing.SignerData, txData signing.TxData) ([]byte, error) {
+	typedData, err := h.GetTypedData(signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	return typedData.SignBytes()
+}
+
+// GetTypedData returns the EIP-712 typed data of a transaction, to be passed
+// to Ethereum wallets for signing.
+//
+// The primary type is Tx, made of the chain_id, account_number and sequence of
+// the signer, the fee of the transaction, the fields of its body but the
+// messages and the extension options, which are not supported, and its
+// messages as msg0...msgN fields.
+func (h SignModeHandler) GetTypedData(signerData signing.SignerData, txData signing.TxData) (*TypedData, error) {
+	body := txData.Body
+	_, err := decode.RejectUnknownFields(
+		txData.BodyBytes, body.ProtoReflect().Descriptor(), false, h.fileResolver)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body.ExtensionOptions) > 0 || len(body.NonCriticalExtensionOptions) > 0 {
+		return nil, fmt.Errorf("%s does not support protobuf extension options: invalid request", h.Mode())
+	}
+
+	if signerData.Address == "" {
+		return nil, fmt.Errorf("got empty address in %s handler: invalid request", h.Mode())
+	}
+
+	if txData.AuthInfo.Fee == nil {
+		return nil, errors.New("fee cannot be nil")
+	}
+
+	eip155ChainID, err := h.chainIDFn(signerData.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	enc := newEncoder(h.typeResolver, h.fileResolver)
+	fields := []Type{
+		{Name: "chain_id", Type: "string"},
+		{Name: "account_number", Type: "uint64"},
+		{Name: "sequence", Type: "uint64"},
+	}
+	message := map[string]any{
+		"chain_id":       signerData.ChainID,
+		"account_number": strconv.FormatUint(signerData.AccountNumber, 10),
+		"sequence":       strconv.FormatUint(signerData.Sequence, 10),
+	}
+
+	feeType, feeValue, err := enc.encodeMessage(txData.AuthInfo.Fee.ProtoReflect())
+	if err != nil {
+		return nil, fmt.Errorf("fee: %w", err)
+	}
+	fields = append(fields, Type{Name: "fee", Type: feeType})
+	message["fee"] = feeValue
+
+	for _, fd := range sortedFields(body.ProtoReflect().Descriptor()) {
+		switch fd.Name() {
+		case "messages", "extension_options", "non_critical_extension_options":
+			continue
+		}
+
+		typ, value, err := enc.encodeField(fd, body.ProtoReflect())
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, Type{Name: string(fd.Name()), Type: typ})
+		message[string(fd.Name())] = value
+	}
+
+	for i, msg := range body.Messages {
+		typ, value, err := enc.encodeMessage(msg.ProtoReflect())
+		if err != nil {
+			return nil, fmt.Errorf("message %d: %w", i, err)
+		}
+		name := fmt.Sprintf("msg%d", i)
+		fields = append(fields, Type{Name: name, Type: typ})
+		message[name] = value
+	}
+
+	enc.types[primaryType] = fields
+	enc.types[domainType] = []Type{
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+	}
+
+	typedData := &TypedData{
+		Types:       enc.types,
+		PrimaryType: primaryType,
+		Domain: map[string]any{
+			"name":    h.domainName,
+			"version": h.domainVersion,
+			"chainId": eip155ChainID.String(),
+		},
+		Message: message,
+	}
+
+	// drop the types defined for Any lists which ended up encoded as raw Any
+	used := make(map[string]bool)
+	if err := typedData.collectDeps(primaryType, used); err != nil {
+		return nil, err
+	}
+	for name := range typedData.Types {
+		if !used[name] && name != domainType {
+			delete(typedData.Types, name)
+		}
+	}
+
+	return typedData, nil
+}
+
+var _ signing.SignModeHandler = (*SignModeHandler)(nil)
+
+// typeName returns the EIP-712 type name of a protobuf message.
+func typeName(desc protoreflect.MessageDescriptor) string {
+	return dotsToUnderscores.Replace(string(desc.FullName()))
+}
@@ -0,0 +1,229 @@
+package eip712_test
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	authzv1beta1 "cosmossdk.io/api/cosmos/authz/v1beta1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/eip712"
+)
+
+// TestTypedDataHash checks the hashing of the example of the EIP-712
+// specification, https://eips.ethereum.org/assets/eip-712/Example.js.
+func TestTypedDataHash(t *testing.T) {
+	typedData := &eip712.TypedData{
+		Types: map[string][]eip712.Type{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Person": {
+				{Name: "name", Type: "string"},
+				{Name: "wallet", Type: "address"},
+			},
+			"Mail": {
+				{Name: "from", Type: "Person"},
+				{Name: "to", Type: "Person"},
+				{Name: "contents", Type: "string"},
+			},
+		},
+		PrimaryType: "Mail",
+		Domain: map[string]any{
+			"name":              "Ether Mail",
+			"version":           "1",
+			"chainId":           "1",
+			"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+		},
+		Message: map[string]any{
+			"from": map[string]any{
+				"name":   "Cow",
+				"wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+			},
+			"to": map[string]any{
+				"name":   "Bob",
+				"wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+			},
+			"contents": "Hello, Bob!",
+		},
+	}
+
+	signBytes, err := typedData.SignBytes()
+	require.NoError(t, err)
+	require.Equal(t, "1901"+
+		"f2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f"+
+		"c52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e",
+		hex.EncodeToString(signBytes))
+
+	hash, err := typedData.Hash()
+	require.NoError(t, err)
+	require.Equal(t, "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hex.EncodeToString(hash))
+
+	typedData.Message["contents"] = 1
+	_, err = typedData.Hash()
+	require.ErrorContains(t, err, "expected a string")
+}
+
+func TestParseEIP155ChainID(t *testing.T) {
+	chainID, err := eip712.ParseEIP155ChainID("evmos_9001-2")
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(9001), chainID)
+
+	for _, invalid := range []string{"cosmoshub-4", "evmos_9001", "evmos_0-1", "evmos-9001-2", ""} {
+		_, err := eip712.ParseEIP155ChainID(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestGetTypedData(t *testing.T) {
+	send := &bankv1beta1.MsgSend{
+		FromAddress: "granter",
+		ToAddress:   "recipient",
+		Amount:      []*basev1beta1.Coin{{Denom: "stake", Amount: "10"}},
+	}
+	exec := &authzv1beta1.MsgExec{Grantee: "grantee", Msgs: []*anypb.Any{mustAny(t, send), mustAny(t, send)}}
+
+	handler := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{})
+	signerData := signing.SignerData{Address: "grantee", ChainID: "cosmos_9000-1", AccountNumber: 1, Sequence: 2}
+	txData := newTxData(t, &txv1beta1.TxBody{
+		Messages:      []*anypb.Any{mustAny(t, send), mustAny(t, exec)},
+		Memo:          "memo",
+		TimeoutHeight: 100,
+	})
+
+	typedData, err := handler.GetTypedData(signerData, txData)
+	require.NoError(t, err)
+	require.Equal(t, "Tx", typedData.PrimaryType)
+	require.Equal(t, map[string]any{"name": eip712.DefaultDomainName, "version": eip712.DefaultDomainVersion, "chainId": "9000"}, typedData.Domain)
+
+	txType := typedData.Types["Tx"]
+	require.Equal(t, []eip712.Type{
+		{Name: "chain_id", Type: "string"},
+		{Name: "account_number", Type: "uint64"},
+		{Name: "sequence", Type: "uint64"},
+		{Name: "fee", Type: "cosmos_tx_v1beta1_Fee"},
+		{Name: "memo", Type: "string"},
+		{Name: "timeout_height", Type: "uint64"},
+	}, txType[:6])
+	require.Equal(t, []eip712.Type{
+		{Name: "msg0", Type: "Any_cosmos_bank_v1beta1_MsgSend"},
+		{Name: "msg1", Type: "Any_cosmos_authz_v1beta1_MsgExec"},
+	}, txType[len(txType)-2:])
+	require.Equal(t, []eip712.Type{
+		{Name: "grantee", Type: "string"},
+		{Name: "msgs", Type: "Any_cosmos_bank_v1beta1_MsgSend[]"},
+	}, typedData.Types["cosmos_authz_v1beta1_MsgExec"])
+	require.Equal(t, []eip712.Type{
+		{Name: "denom", Type: "string"},
+		{Name: "amount", Type: "string"},
+	}, typedData.Types["cosmos_base_v1beta1_Coin"])
+
+	require.Equal(t, "2", typedData.Message["sequence"])
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", typedData.Message["msg0"].(map[string]any)["type_url"])
+
+	signBytes, err := handler.GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	require.Len(t, signBytes, 66)
+	require.Equal(t, []byte{0x19, 0x01}, signBytes[:2])
+
+	// the sign bytes are deterministic and cover the signer data
+	again, err := handler.GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	require.Equal(t, signBytes, again)
+
+	signerData.Sequence++
+	other, err := handler.GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, other)
+}
+
+func TestGetTypedDataMixedAnyList(t *testing.T) {
+	send := &bankv1beta1.MsgSend{FromAddress: "granter", ToAddress: "recipient"}
+	multiSend := &bankv1beta1.MsgMultiSend{}
+	exec := &authzv1beta1.MsgExec{Grantee: "grantee", Msgs: []*anypb.Any{mustAny(t, send), mustAny(t, multiSend)}}
+
+	handler := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{})
+	signerData := signing.SignerData{Address: "grantee", ChainID: "cosmos_9000-1"}
+	typedData, err := handler.GetTypedData(signerData, newTxData(t, &txv1beta1.TxBody{Messages: []*anypb.Any{mustAny(t, exec)}}))
+	require.NoError(t, err)
+
+	// messages of different types can't be held by an array, they are encoded as raw Any
+	require.Equal(t, []eip712.Type{
+		{Name: "grantee", Type: "string"},
+		{Name: "msgs", Type: "google_protobuf_Any[]"},
+	}, typedData.Types["cosmos_authz_v1beta1_MsgExec"])
+	require.NotContains(t, typedData.Types, "Any_cosmos_bank_v1beta1_MsgSend")
+
+	_, err = typedData.SignBytes()
+	require.NoError(t, err)
+}
+
+func TestGetSignBytesErrors(t *testing.T) {
+	handler := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{})
+	msg := mustAny(t, &bankv1beta1.MsgSend{})
+	signerData := signing.SignerData{Address: "signer", ChainID: "cosmos_9000-1"}
+
+	_, err := handler.GetSignBytes(context.Background(), signerData, newTxData(t, &txv1beta1.TxBody{
+		Messages:         []*anypb.Any{msg},
+		ExtensionOptions: []*anypb.Any{msg},
+	}))
+	require.ErrorContains(t, err, "does not support protobuf extension options")
+
+	txData := newTxData(t, &txv1beta1.TxBody{Messages: []*anypb.Any{msg}})
+	_, err = handler.GetSignBytes(context.Background(), signing.SignerData{Address: "signer", ChainID: "cosmoshub-4"}, txData)
+	require.ErrorContains(t, err, "is not of the form")
+
+	txData.AuthInfo.Fee = nil
+	_, err = handler.GetSignBytes(context.Background(), signerData, txData)
+	require.ErrorContains(t, err, "fee cannot be nil")
+
+	// a custom chain ID function supports any chain ID
+	handler = eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{
+		ChainIDFn: func(string) (*big.Int, error) { return big.NewInt(1), nil },
+	})
+	_, err = handler.GetSignBytes(context.Background(), signing.SignerData{Address: "signer", ChainID: "cosmoshub-4"},
+		newTxData(t, &txv1beta1.TxBody{Messages: []*anypb.Any{msg}}))
+	require.NoError(t, err)
+}
+
+func newTxData(t *testing.T, body *txv1beta1.TxBody) signing.TxData {
+	t.Helper()
+
+	authInfo := &txv1beta1.AuthInfo{
+		Fee: &txv1beta1.Fee{
+			Amount:   []*basev1beta1.Coin{{Denom: "stake", Amount: "1000"}},
+			GasLimit: 20000,
+		},
+	}
+	bodyBz, err := proto.Marshal(body)
+	require.NoError(t, err)
+	authInfoBz, err := proto.Marshal(authInfo)
+	require.NoError(t, err)
+
+	return signing.TxData{
+		Body:          body,
+		AuthInfo:      authInfo,
+		BodyBytes:     bodyBz,
+		AuthInfoBytes: authInfoBz,
+	}
+}
+
+func mustAny(t *testing.T, msg proto.Message) *anypb.Any {
+	t.Helper()
+
+	a, err := anyutil.New(msg)
+	require.NoError(t, err)
+	return a
+}
@@ -0,0 +1,271 @@
+package eip712
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Hash returns the EIP-712 digest of the typed data, that is the Keccak-256
+// hash of its SignBytes, the hash Ethereum wallets sign.
+func (td *TypedData) Hash() ([]byte, error) {
+	signBytes, err := td.SignBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return keccak256(signBytes), nil
+}
+
+// SignBytes returns the EIP-712 encoding of the typed data:
+//
+//	0x19 0x01 || hashStruct(EIP712Domain, domain) || hashStruct(primaryType, message)
+func (td *TypedData) SignBytes() ([]byte, error) {
+	domainSeparator, err := td.hashStruct(domainType, td.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash domain: %w", err)
+	}
+
+	messageHash, err := td.hashStruct(td.PrimaryType, td.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash message: %w", err)
+	}
+
+	signBytes := make([]byte, 0, 2+len(domainSeparator)+len(messageHash))
+	signBytes = append(signBytes, 0x19, 0x01)
+	signBytes = append(signBytes, domainSeparator...)
+	return append(signBytes, messageHash...), nil
+}
+
+// hashStruct returns keccak256(typeHash || encodeData(value)).
+func (td *TypedData) hashStruct(typeName string, value map[string]any) ([]byte, error) {
+	fields, ok := td.Types[typeName]
+	if !ok {
+		return nil, fmt.Errorf("undefined type %s", typeName)
+	}
+
+	encodedType, err := td.encodeType(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(keccak256([]byte(encodedType)))
+	for _, field := range fields {
+		fieldValue, ok := value[field.Name]
+		if !ok {
+			return nil, fmt.Errorf("missing value of field %s of type %s", field.Name, typeName)
+		}
+
+		encoded, err := td.encodeValue(field.Type, fieldValue)
+		if err != nil {
+			return nil, fmt.Errorf("field %s of type %s: %w", field.Name, typeName, err)
+		}
+		buf.Write(encoded)
+	}
+
+	if len(value) != len(fields) {
+		return nil, fmt.Errorf("value of type %s has %d fields, expected %d", typeName, len(value), len(fields))
+	}
+
+	return keccak256(buf.Bytes()), nil
+}
+
+// encodeType returns the encoding of a type, followed by the encodings of the
+// types it references sorted by name, e.g.
+// "Mail(Person from,Person to,string contents)Person(string name,address wallet)".
+func (td *TypedData) encodeType(typeName string) (string, error) {
+	deps := make(map[string]bool)
+	if err := td.collectDeps(typeName, deps); err != nil {
+		return "", err
+	}
+	delete(deps, typeName)
+
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	for _, name := range append([]string{typeName}, names...) {
+		sb.WriteString(name)
+		sb.WriteByte('(')
+		for i, field := range td.Types[name] {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			sb.WriteString(field.Type)
+			sb.WriteByte(' ')
+			sb.WriteString(field.Name)
+		}
+		sb.WriteByte(')')
+	}
+
+	return sb.String(), nil
+}
+
+func (td *TypedData) collectDeps(typeName string, deps map[string]bool) error {
+	if deps[typeName] {
+		return nil
+	}
+
+	fields, ok := td.Types[typeName]
+	if !ok {
+		return fmt.Errorf("undefined type %s", typeName)
+	}
+
+	deps[typeName] = true
+	for _, field := range fields {
+		base := strings.TrimSuffix(field.Type, "[]")
+		if _, ok := td.Types[base]; ok {
+			if err := td.collectDeps(base, deps); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// encodeValue returns the 32 bytes encoding of a value of the given type.
+func (td *TypedData) encodeValue(typ string, value any) ([]byte, error) {
+	if elemType, ok := strings.CutSuffix(typ, "[]"); ok {
+		values, ok := value.([]any)
+		if !ok {
+			return nil, fmt.Errorf("expected an array of %s, got %T", elemType, value)
+		}
+
+		buf := new(bytes.Buffer)
+		for _, v := range values {
+			encoded, err := td.encodeValue(elemType, v)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(encoded)
+		}
+
+		return keccak256(buf.Bytes()), nil
+	}
+
+	if _, ok := td.Types[typ]; ok {
+		fields, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("expected a %s struct, got %T", typ, value)
+		}
+
+		return td.hashStruct(typ, fields)
+	}
+
+	switch typ {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string, got %T", value)
+		}
+		return keccak256([]byte(s)), nil
+
+	case "bytes":
+		bz, err := decodeHex(value)
+		if err != nil {
+			return nil, err
+		}
+		return keccak256(bz), nil
+
+	case "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected a bool, got %T", value)
+		}
+		encoded := make([]byte, 32)
+		if b {
+			encoded[31] = 1
+		}
+		return encoded, nil
+
+	case "address":
+		bz, err := decodeHex(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) != 20 {
+			return nil, fmt.Errorf("expected a 20 bytes address, got %d bytes", len(bz))
+		}
+		return leftPad(bz), nil
+	}
+
+	if size, ok := strings.CutPrefix(typ, "uint"); ok {
+		return encodeInteger(size, false, value)
+	}
+	if size, ok := strings.CutPrefix(typ, "int"); ok {
+		return encodeInteger(size, true, value)
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", typ)
+}
+
+// encodeInteger returns the 32 bytes two's complement encoding of an integer
+// given as a decimal string.
+func encodeInteger(size string, signed bool, value any) ([]byte, error) {
+	bits, err := strconv.Atoi(size)
+	if err != nil || bits <= 0 || bits > 256 || bits%8 != 0 {
+		return nil, fmt.Errorf("invalid integer size %s", size)
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected an integer as a decimal string, got %T", value)
+	}
+	i, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %q", s)
+	}
+
+	bound := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	if signed {
+		bound.Rsh(bound, 1)
+		if i.Cmp(bound) >= 0 || i.Cmp(new(big.Int).Neg(bound)) < 0 {
+			return nil, fmt.Errorf("integer %s overflows int%d", s, bits)
+		}
+	} else if i.Sign() < 0 || i.Cmp(bound) >= 0 {
+		return nil, fmt.Errorf("integer %s overflows uint%d", s, bits)
+	}
+
+	if i.Sign() < 0 {
+		// two's complement over 256 bits
+		i.Add(i, new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+
+	return leftPad(i.Bytes()), nil
+}
+
+func decodeHex(value any) ([]byte, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected a hex string, got %T", value)
+	}
+	s, ok = strings.CutPrefix(s, "0x")
+	if !ok {
+		return nil, errors.New("hex string must be prefixed with 0x")
+	}
+
+	return hex.DecodeString(s)
+}
+
+func leftPad(bz []byte) []byte {
+	padded := make([]byte, 32)
+	copy(padded[32-len(bz):], bz)
+	return padded
+}
+
+func keccak256(bz []byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(bz)
+	return h.Sum(nil)
+}
@@ -0,0 +1,309 @@
+package eip712
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"cosmossdk.io/x/tx/signing"
+)
+
+const anyFullName = "google.protobuf.Any"
+
+var dotsToUnderscores = strings.NewReplacer(".", "_")
+
+// encoder derives the EIP-712 types and values of protobuf messages.
+type encoder struct {
+	typeResolver protoregistry.MessageTypeResolver
+	fileResolver signing.ProtoFileResolver
+	types        map[string][]Type
+	// unset are the messages being encoded with their default value, to detect
+	// recursive message types.
+	unset map[protoreflect.FullName]bool
+}
+
+func newEncoder(typeResolver protoregistry.MessageTypeResolver, fileResolver signing.ProtoFileResolver) *encoder {
+	return &encoder{
+		typeResolver: typeResolver,
+		fileResolver: fileResolver,
+		types:        make(map[string][]Type),
+		unset:        make(map[protoreflect.FullName]bool),
+	}
+}
+
+// encodeMessage returns the EIP-712 type name and value of a message, defining
+// its type and the types it references.
+//
+// The type of a message only depends on its descriptor, but for the messages
+// packed in its Any fields. When messages of the same protobuf type have
+// different EIP-712 types, the types are distinguished by a numeric suffix, in
+// the order they are encountered.
+func (e *encoder) encodeMessage(msg protoreflect.Message) (string, map[string]any, error) {
+	desc := msg.Descriptor()
+	if desc.FullName() == anyFullName {
+		return e.encodeAny(msg)
+	}
+
+	if !msg.IsValid() {
+		if e.unset[desc.FullName()] {
+			return "", nil, fmt.Errorf("recursive message type %s is not supported", desc.FullName())
+		}
+		e.unset[desc.FullName()] = true
+		defer delete(e.unset, desc.FullName())
+	}
+
+	fds := sortedFields(desc)
+	fields := make([]Type, 0, len(fds))
+	value := make(map[string]any, len(fds))
+	for _, fd := range fds {
+		typ, v, err := e.encodeField(fd, msg)
+		if err != nil {
+			return "", nil, fmt.Errorf("%s: %w", desc.FullName(), err)
+		}
+		fields = append(fields, Type{Name: string(fd.Name()), Type: typ})
+		value[string(fd.Name())] = v
+	}
+
+	return e.defineType(typeName(desc), fields), value, nil
+}
+
+// encodeAny returns the EIP-712 type name and value of an Any, a struct of
+// its type_url and of the message it packs as value.
+func (e *encoder) encodeAny(anyMsg protoreflect.Message) (string, map[string]any, error) {
+	fields := anyMsg.Descriptor().Fields()
+	typeURL := anyMsg.Get(fields.ByName("type_url")).String()
+	bz := anyMsg.Get(fields.ByName("value")).Bytes()
+	if typeURL == "" {
+		return e.rawAnyType(), map[string]any{"type_url": typeURL, "value": hexBytes(bz)}, nil
+	}
+
+	packed, err := e.resolve(typeURL)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := proto.Unmarshal(bz, packed.Interface()); err != nil {
+		return "", nil, fmt.Errorf("failed to unmarshal %s: %w", typeURL, err)
+	}
+
+	valueType, value, err := e.encodeMessage(packed)
+	if err != nil {
+		return "", nil, err
+	}
+
+	name := e.defineType("Any_"+valueType, []Type{
+		{Name: "type_url", Type: "string"},
+		{Name: "value", Type: valueType},
+	})
+
+	return name, map[string]any{"type_url": typeURL, "value": value}, nil
+}
+
+// rawAnyType defines the type of the Any whose packed message is not encoded,
+// its value being encoded as bytes.
+func (e *encoder) rawAnyType() string {
+	return e.defineType(dotsToUnderscores.Replace(anyFullName), []Type{
+		{Name: "type_url", Type: "string"},
+		{Name: "value", Type: "bytes"},
+	})
+}
+
+func (e *encoder) resolve(typeURL string) (protoreflect.Message, error) {
+	typ, err := e.typeResolver.FindMessageByURL(typeURL)
+	if err == nil {
+		return typ.New(), nil
+	}
+	if !errors.Is(err, protoregistry.NotFound) {
+		return nil, err
+	}
+
+	name := typeURL
+	if i := strings.LastIndexByte(typeURL, '/'); i >= 0 {
+		name = typeURL[i+1:]
+	}
+	desc, err := e.fileResolver.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, fmt.Errorf("can't resolve type URL %s: %w", typeURL, err)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+
+	return dynamicpb.NewMessage(msgDesc), nil
+}
+
+// encodeField returns the EIP-712 type and value of a field of msg.
+func (e *encoder) encodeField(fd protoreflect.FieldDescriptor, msg protoreflect.Message) (string, any, error) {
+	if fd.IsMap() {
+		return "", nil, fmt.Errorf("map field %s is not supported", fd.Name())
+	}
+
+	if !fd.IsList() {
+		return e.encodeSingular(fd, msg.Get(fd))
+	}
+
+	list := msg.Get(fd).List()
+	if fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind {
+		elemType, err := scalarType(fd)
+		if err != nil {
+			return "", nil, err
+		}
+
+		values := make([]any, list.Len())
+		for i := range values {
+			if _, values[i], err = e.encodeSingular(fd, list.Get(i)); err != nil {
+				return "", nil, err
+			}
+		}
+
+		return elemType + "[]", values, nil
+	}
+
+	isAny := fd.Message().FullName() == anyFullName
+	if list.Len() == 0 {
+		if isAny {
+			return e.rawAnyType() + "[]", []any{}, nil
+		}
+
+		// the element type is derived from the default value of the element
+		elemType, _, err := e.encodeMessage(dynamicpb.NewMessageType(fd.Message()).Zero())
+		if err != nil {
+			return "", nil, err
+		}
+		return elemType + "[]", []any{}, nil
+	}
+
+	var elemType string
+	values := make([]any, list.Len())
+	for i := range values {
+		typ, value, err := e.encodeMessage(list.Get(i).Message())
+		if err != nil {
+			return "", nil, err
+		}
+		if i > 0 && typ != elemType {
+			if !isAny {
+				return "", nil, fmt.Errorf("elements of repeated field %s have different types %s and %s", fd.Name(), elemType, typ)
+			}
+			return e.encodeRawAnyList(list)
+		}
+		elemType, values[i] = typ, value
+	}
+
+	return elemType + "[]", values, nil
+}
+
+// encodeRawAnyList encodes a list of Any packing messages of different types,
+// which EIP-712 arrays can't hold, as raw Any.
+func (e *encoder) encodeRawAnyList(list protoreflect.List) (string, any, error) {
+	values := make([]any, list.Len())
+	for i := range values {
+		anyMsg := list.Get(i).Message()
+		fields := anyMsg.Descriptor().Fields()
+		values[i] = map[string]any{
+			"type_url": anyMsg.Get(fields.ByName("type_url")).String(),
+			"value":    hexBytes(anyMsg.Get(fields.ByName("value")).Bytes()),
+		}
+	}
+
+	return e.rawAnyType() + "[]", values, nil
+}
+
+// encodeSingular returns the EIP-712 type and value of a singular field value,
+// or of an element of a repeated field.
+func (e *encoder) encodeSingular(fd protoreflect.FieldDescriptor, v protoreflect.Value) (string, any, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return e.encodeMessage(v.Message())
+	case protoreflect.EnumKind:
+		value := fd.Enum().Values().ByNumber(v.Enum())
+		if value == nil {
+			return "", nil, fmt.Errorf("unknown value %d of enum %s", v.Enum(), fd.Enum().FullName())
+		}
+		return "string", string(value.Name()), nil
+	}
+
+	typ, err := scalarType(fd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return typ, v.String(), nil
+	case protoreflect.BoolKind:
+		return typ, v.Bool(), nil
+	case protoreflect.BytesKind:
+		return typ, hexBytes(v.Bytes()), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return typ, strconv.FormatInt(v.Int(), 10), nil
+	default:
+		return typ, strconv.FormatUint(v.Uint(), 10), nil
+	}
+}
+
+// scalarType returns the EIP-712 type of a scalar field.
+func scalarType(fd protoreflect.FieldDescriptor) (string, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind, protoreflect.EnumKind:
+		return "string", nil
+	case protoreflect.BoolKind:
+		return "bool", nil
+	case protoreflect.BytesKind:
+		return "bytes", nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32", nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64", nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32", nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64", nil
+	default:
+		return "", fmt.Errorf("field %s of kind %s is not supported", fd.Name(), fd.Kind())
+	}
+}
+
+// defineType defines a struct type named after name, returning the name of the
+// type defined. A numeric suffix is added to the name when a different type of
+// the same name is already defined.
+func (e *encoder) defineType(name string, fields []Type) string {
+	for i := 0; ; i++ {
+		candidate := name
+		if i > 0 {
+			candidate = fmt.Sprintf("%s_%d", name, i)
+		}
+
+		defined, ok := e.types[candidate]
+		if !ok {
+			e.types[candidate] = fields
+			return candidate
+		}
+		if slices.Equal(defined, fields) {
+			return candidate
+		}
+	}
+}
+
+// sortedFields returns the fields of a message in field number order.
+func sortedFields(desc protoreflect.MessageDescriptor) []protoreflect.FieldDescriptor {
+	fds := make([]protoreflect.FieldDescriptor, desc.Fields().Len())
+	for i := range fds {
+		fds[i] = desc.Fields().Get(i)
+	}
+	sort.Slice(fds, func(i, j int) bool { return fds[i].Number() < fds[j].Number() })
+	return fds
+}
+
+func hexBytes(bz []byte) string {
+	return "0x" + hex.EncodeToString(bz)
+}
@@ -0,0 +1,362 @@
+package textual_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	_ "cosmossdk.io/api/cosmos/auth/v1beta1"
+	_ "cosmossdk.io/api/cosmos/authz/v1beta1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	_ "cosmossdk.io/api/cosmos/circuit/v1"
+	_ "cosmossdk.io/api/cosmos/consensus/v1"
+	_ "cosmossdk.io/api/cosmos/distribution/v1beta1"
+	_ "cosmossdk.io/api/cosmos/evidence/v1beta1"
+	feegrantv1beta1 "cosmossdk.io/api/cosmos/feegrant/v1beta1"
+	_ "cosmossdk.io/api/cosmos/gov/v1"
+	_ "cosmossdk.io/api/cosmos/gov/v1beta1"
+	groupv1 "cosmossdk.io/api/cosmos/group/v1"
+	_ "cosmossdk.io/api/cosmos/mint/v1beta1"
+	_ "cosmossdk.io/api/cosmos/nft/v1beta1"
+	_ "cosmossdk.io/api/cosmos/protocolpool/v1"
+	_ "cosmossdk.io/api/cosmos/slashing/v1beta1"
+	_ "cosmossdk.io/api/cosmos/staking/v1beta1"
+	_ "cosmossdk.io/api/cosmos/upgrade/v1beta1"
+	vestingv1beta1 "cosmossdk.io/api/cosmos/vesting/v1beta1"
+	"cosmossdk.io/x/tx/signing/textual"
+)
+
+// firstPartyPackages are the packages of the first-party modules whose Msg
+// services must be supported by SIGN_MODE_TEXTUAL.
+var firstPartyPackages = []protoreflect.FullName{
+	"cosmos.auth.v1beta1",
+	"cosmos.authz.v1beta1",
+	"cosmos.bank.v1beta1",
+	"cosmos.circuit.v1",
+	"cosmos.consensus.v1",
+	"cosmos.distribution.v1beta1",
+	"cosmos.evidence.v1beta1",
+	"cosmos.feegrant.v1beta1",
+	"cosmos.gov.v1",
+	"cosmos.gov.v1beta1",
+	"cosmos.group.v1",
+	"cosmos.mint.v1beta1",
+	"cosmos.nft.v1beta1",
+	"cosmos.protocolpool.v1",
+	"cosmos.slashing.v1beta1",
+	"cosmos.staking.v1beta1",
+	"cosmos.upgrade.v1beta1",
+	"cosmos.vesting.v1beta1",
+}
+
+// TestConformanceFirstPartyMsgs renders every message of the first-party Msg
+// services, with all its fields set, and checks that the screens are valid
+// and parse back to the same message.
+func TestConformanceFirstPartyMsgs(t *testing.T) {
+	for name, tr := range conformanceHandlers(t) {
+		for _, pkg := range firstPartyPackages {
+			msgs := msgServiceInputs(t, pkg)
+			require.NotEmpty(t, msgs, "package %s has no Msg service", pkg)
+
+			for _, md := range msgs {
+				t.Run(fmt.Sprintf("%s/%s", name, md.FullName()), func(t *testing.T) {
+					msgType, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+					require.NoError(t, err)
+
+					msg := msgType.New()
+					populate(t, msg, 0)
+					requireRoundTrip(t, tr, msg.Interface())
+				})
+			}
+		}
+	}
+}
+
+// TestConformanceInterfaces renders the messages of the group, feegrant and
+// vesting modules packing interfaces, or using types with dedicated value
+// renderers, with realistic values.
+func TestConformanceInterfaces(t *testing.T) {
+	coins := []*basev1beta1.Coin{{Denom: "stake", Amount: "1000"}}
+	expiration := timestamppb.New(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+	basic := &feegrantv1beta1.BasicAllowance{SpendLimit: coins, Expiration: expiration}
+	periodic := &feegrantv1beta1.PeriodicAllowance{
+		Basic:            basic,
+		Period:           durationpb.New(24 * time.Hour),
+		PeriodSpendLimit: coins,
+		PeriodCanSpend:   coins,
+		PeriodReset:      expiration,
+	}
+	send := &bankv1beta1.MsgSend{FromAddress: "cosmos1from", ToAddress: "cosmos1to", Amount: coins}
+	windows := &groupv1.DecisionPolicyWindows{VotingPeriod: durationpb.New(time.Hour), MinExecutionPeriod: durationpb.New(time.Minute)}
+
+	testCases := []struct {
+		name string
+		msg  proto.Message
+	}{
+		{"basic allowance", &feegrantv1beta1.MsgGrantAllowance{Granter: "cosmos1granter", Grantee: "cosmos1grantee", Allowance: mustAny(t, basic)}},
+		{"periodic allowance", &feegrantv1beta1.MsgGrantAllowance{Granter: "cosmos1granter", Grantee: "cosmos1grantee", Allowance: mustAny(t, periodic)}},
+		{
+			"allowed msg allowance",
+			&feegrantv1beta1.MsgGrantAllowance{
+				Granter: "cosmos1granter",
+				Grantee: "cosmos1grantee",
+				Allowance: mustAny(t, &feegrantv1beta1.AllowedMsgAllowance{
+					Allowance:       mustAny(t, periodic),
+					AllowedMessages: []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.gov.v1.MsgVote"},
+				}),
+			},
+		},
+		{
+			"threshold decision policy",
+			&groupv1.MsgCreateGroupPolicy{
+				Admin:          "cosmos1admin",
+				GroupId:        1,
+				Metadata:       "policy",
+				DecisionPolicy: mustAny(t, &groupv1.ThresholdDecisionPolicy{Threshold: "2", Windows: windows}),
+			},
+		},
+		{
+			"percentage decision policy",
+			&groupv1.MsgCreateGroupWithPolicy{
+				Admin:               "cosmos1admin",
+				Members:             []*groupv1.MemberRequest{{Address: "cosmos1member", Weight: "1.5", Metadata: "member"}},
+				GroupMetadata:       "group",
+				GroupPolicyMetadata: "policy",
+				GroupPolicyAsAdmin:  true,
+				DecisionPolicy:      mustAny(t, &groupv1.PercentageDecisionPolicy{Percentage: "0.5", Windows: windows}),
+			},
+		},
+		{
+			"group proposal",
+			&groupv1.MsgSubmitProposal{
+				GroupPolicyAddress: "cosmos1policy",
+				Proposers:          []string{"cosmos1proposer"},
+				Messages:           []*anypb.Any{mustAny(t, send)},
+				Exec:               groupv1.Exec_EXEC_TRY,
+				Title:              "title",
+				Summary:            "summary",
+			},
+		},
+		{"group vote", &groupv1.MsgVote{ProposalId: 1, Voter: "cosmos1voter", Option: groupv1.VoteOption_VOTE_OPTION_YES, Exec: groupv1.Exec_EXEC_TRY}},
+		{
+			"vesting account",
+			&vestingv1beta1.MsgCreateVestingAccount{FromAddress: "cosmos1from", ToAddress: "cosmos1to", Amount: coins, EndTime: 1893456000, Delayed: true},
+		},
+		{
+			"periodic vesting account",
+			&vestingv1beta1.MsgCreatePeriodicVestingAccount{
+				FromAddress:    "cosmos1from",
+				ToAddress:      "cosmos1to",
+				StartTime:      1893456000,
+				VestingPeriods: []*vestingv1beta1.Period{{Length: 86400, Amount: coins}, {Length: 3600, Amount: coins}},
+			},
+		},
+		{"permanent locked account", &vestingv1beta1.MsgCreatePermanentLockedAccount{FromAddress: "cosmos1from", ToAddress: "cosmos1to", Amount: coins}},
+	}
+
+	for name, tr := range conformanceHandlers(t) {
+		for _, tc := range testCases {
+			t.Run(fmt.Sprintf("%s/%s", name, tc.name), func(t *testing.T) {
+				requireRoundTrip(t, tr, tc.msg)
+			})
+		}
+	}
+}
+
+// conformanceHandlers returns sign mode handlers resolving messages to their
+// generated types, and to dynamic messages as in applications whose modules
+// only register gogoproto types.
+func conformanceHandlers(t *testing.T) map[string]*textual.SignModeHandler {
+	t.Helper()
+
+	generated, err := textual.NewSignModeHandler(textual.SignModeOptions{CoinMetadataQuerier: EmptyCoinMetadataQuerier})
+	require.NoError(t, err)
+
+	dynamic, err := textual.NewSignModeHandler(textual.SignModeOptions{
+		CoinMetadataQuerier: EmptyCoinMetadataQuerier,
+		FileResolver:        protoregistry.GlobalFiles,
+		TypeResolver:        new(protoregistry.Types),
+	})
+	require.NoError(t, err)
+
+	return map[string]*textual.SignModeHandler{"generated": generated, "dynamic": dynamic}
+}
+
+// requireRoundTrip checks that msg, packed in an Any as in a transaction body,
+// renders to valid screens parsing back to msg.
+func requireRoundTrip(t *testing.T, tr *textual.SignModeHandler, msg proto.Message) {
+	t.Helper()
+
+	anyMsg := mustAny(t, msg)
+	rend := textual.NewAnyValueRenderer(tr)
+	screens, err := rend.Format(context.Background(), protoreflect.ValueOfMessage(anyMsg.ProtoReflect()))
+	require.NoError(t, err)
+
+	for i, screen := range screens {
+		require.NotEmpty(t, screen.Content, "screen %d has no content", i)
+		require.Less(t, screen.Indent, 16, "screen %d is too deeply indented", i)
+	}
+
+	val, err := rend.Parse(context.Background(), screens)
+	require.NoError(t, err)
+
+	parsed, err := anyutil.Unpack(val.Message().Interface().(*anypb.Any), nil, nil)
+	require.NoError(t, err)
+
+	diff := cmp.Diff(msg, parsed, protocmp.Transform())
+	require.Empty(t, diff)
+}
+
+func mustAny(t *testing.T, msg proto.Message) *anypb.Any {
+	t.Helper()
+
+	anyMsg, err := anyutil.New(msg)
+	require.NoError(t, err)
+
+	return anyMsg
+}
+
+// msgServiceInputs returns the request messages of the Msg service of the
+// given package.
+func msgServiceInputs(t *testing.T, pkg protoreflect.FullName) []protoreflect.MessageDescriptor {
+	t.Helper()
+
+	var msgs []protoreflect.MessageDescriptor
+	protoregistry.GlobalFiles.RangeFilesByPackage(pkg, func(fd protoreflect.FileDescriptor) bool {
+		for i := 0; i < fd.Services().Len(); i++ {
+			sd := fd.Services().Get(i)
+			if sd.Name() != "Msg" {
+				continue
+			}
+
+			for j := 0; j < sd.Methods().Len(); j++ {
+				msgs = append(msgs, sd.Methods().Get(j).Input())
+			}
+		}
+
+		return true
+	})
+
+	return msgs
+}
+
+// populate sets all the fields of msg, recursively up to a small depth, with
+// non-default values rendered by the value renderers of their type.
+func populate(t *testing.T, msg protoreflect.Message, depth int) {
+	t.Helper()
+
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if oneof := fd.ContainingOneof(); oneof != nil && msg.WhichOneof(oneof) != nil {
+			continue
+		}
+
+		switch {
+		case fd.IsMap():
+			t.Fatalf("field %s is a map, which cannot be rendered", fd.FullName())
+
+		case fd.IsList():
+			list := msg.Mutable(fd).List()
+			for j := 0; j < 2; j++ {
+				if fd.Kind() == protoreflect.MessageKind {
+					if depth > 2 {
+						break
+					}
+					elem := list.NewElement()
+					populateMessage(t, elem.Message(), depth+1)
+					list.Append(elem)
+				} else {
+					list.Append(scalarValue(t, fd, j))
+				}
+			}
+
+		case fd.Kind() == protoreflect.MessageKind:
+			if depth <= 2 {
+				populateMessage(t, msg.Mutable(fd).Message(), depth+1)
+			}
+
+		default:
+			msg.Set(fd, scalarValue(t, fd, 0))
+		}
+	}
+}
+
+// populateMessage populates a message field value, setting valid timestamps
+// and durations.
+func populateMessage(t *testing.T, msg protoreflect.Message, depth int) {
+	t.Helper()
+
+	switch m := msg.Interface().(type) {
+	case *timestamppb.Timestamp:
+		m.Seconds, m.Nanos = 1893456000, 500
+		return
+	case *durationpb.Duration:
+		m.Seconds, m.Nanos = 90061, 500
+		return
+	case *anypb.Any:
+		populateAny(t, m)
+		return
+	}
+
+	populate(t, msg, depth)
+}
+
+// populateAny packs a message send in anyMsg.
+func populateAny(t *testing.T, anyMsg *anypb.Any) {
+	t.Helper()
+
+	send := &bankv1beta1.MsgSend{
+		FromAddress: "cosmos1from",
+		ToAddress:   "cosmos1to",
+		Amount:      []*basev1beta1.Coin{{Denom: "stake", Amount: "10"}},
+	}
+	require.NoError(t, anyutil.MarshalFrom(anyMsg, send, proto.MarshalOptions{}))
+}
+
+func scalarValue(t *testing.T, fd protoreflect.FieldDescriptor, i int) protoreflect.Value {
+	t.Helper()
+
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		switch proto.GetExtension(fd.Options(), cosmos_proto.E_Scalar) {
+		case "cosmos.Int":
+			return protoreflect.ValueOfString(fmt.Sprintf("%d", 1000+i))
+		case "cosmos.Dec":
+			return protoreflect.ValueOfString(fmt.Sprintf("%d.5", i))
+		}
+		return protoreflect.ValueOfString(fmt.Sprintf("%s%d", fd.Name(), i))
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte{byte(i), 1, 2, 3})
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(true)
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		return protoreflect.ValueOfEnum(values.Get(values.Len() - 1).Number())
+	case protoreflect.Int32Kind:
+		return protoreflect.ValueOfInt32(int32(-7 - i))
+	case protoreflect.Int64Kind:
+		return protoreflect.ValueOfInt64(int64(-7 - i))
+	case protoreflect.Uint32Kind:
+		return protoreflect.ValueOfUint32(uint32(7 + i))
+	case protoreflect.Uint64Kind:
+		return protoreflect.ValueOfUint64(uint64(7 + i))
+	default:
+		t.Fatalf("field %s has kind %s, which cannot be rendered", fd.FullName(), fd.Kind())
+		return protoreflect.Value{}
+	}
+}
@@ -45,7 +45,9 @@ type SignModeOptions struct {
 	FileResolver signing.ProtoFileResolver
 
 	// TypeResolver are the protobuf type resolvers to use for resolving message
-	// types. If it is nil, then a dynamicpb will be used on top of FileResolver.
+	// types. If it is nil, the global protobuf registry will be used. Messages
+	// it does not resolve are parsed into dynamicpb messages on top of
+	// FileResolver.
 	TypeResolver protoregistry.MessageTypeResolver
 }
 
@@ -11,6 +11,7 @@ import (
 
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
 )
 
 var (
@@ -193,8 +194,12 @@ func (mr *messageValueRenderer) Parse(ctx context.Context, screens []Screen) (pr
 		return nilValue, fmt.Errorf("bad message indentation: want 0, got %d", screens[0].Indent)
 	}
 
-	msgType, err := protoregistry.GlobalTypes.FindMessageByName(mr.msgDesc.FullName())
-	if err != nil {
+	// Messages without a registered type, e.g. the ones of modules registering
+	// gogoproto types only, are parsed into a dynamicpb message.
+	msgType, err := mr.tr.typeResolver.FindMessageByName(mr.msgDesc.FullName())
+	if errors.Is(err, protoregistry.NotFound) {
+		msgType = dynamicpb.NewMessageType(mr.msgDesc)
+	} else if err != nil {
 		return nilValue, err
 	}
 	msg := msgType.New()
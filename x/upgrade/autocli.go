@@ -40,6 +40,21 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "authority",
 					Short:     "Get the upgrade authority address",
 				},
+				{
+					RpcMethod: "UpgradeQueue",
+					Use:       "queue",
+					Short:     "Query the upgrade plans queued after the current plan",
+				},
+				{
+					RpcMethod: "PlanArtifacts",
+					Use:       "plan-artifacts",
+					Short:     "Query the binaries listed in the info of the current upgrade plan, with their checksums and signatures",
+				},
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the upgrade module parameters",
+				},
 				{
 					RpcMethod: "UpgradedConsensusState",
 					Skip:      true, // Skipping this command as the query is deprecated.
@@ -59,6 +74,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "SoftwareUpgrade",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "ScheduleUpgrades",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
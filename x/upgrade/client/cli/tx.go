@@ -36,6 +36,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		NewCmdSubmitUpgradeProposal(),
+		NewCmdEmergencyCancelUpgrade(),
 	)
 
 	return cmd
@@ -136,6 +137,33 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 	return cmd
 }
 
+// NewCmdEmergencyCancelUpgrade implements a command handler for cancelling the planned upgrades as an emergency authority.
+// Unlike cancel-upgrade-proposal, the cancel message is signed directly by the sender, which must be an emergency authority.
+func NewCmdEmergencyCancelUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emergency-cancel-upgrade",
+		Args:  cobra.NoArgs,
+		Short: "Cancel the planned chain upgrades as an emergency authority",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &types.MsgCancelUpgrade{Authority: authority})
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // getDefaultDaemonName gets the default name to use for the daemon.
 // If a DAEMON_NAME env var is set, that is used.
 // Otherwise, the last part of the currently running executable is used.
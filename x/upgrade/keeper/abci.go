@@ -62,6 +62,21 @@ func (k Keeper) PreBlocker(ctx context.Context) error {
 		return nil
 	}
 
+	// Clear the plan if the chain went past it without applying it, e.g. when it was restarted with an override
+	expired, err := k.IsPlanExpired(ctx, plan, blockHeight)
+	if err != nil {
+		return err
+	}
+	if expired {
+		k.Logger.Info(fmt.Sprintf("UPGRADE \"%s\" EXPIRED at %d: %s", plan.Name, plan.Height, plan.Info))
+
+		// Clear the expired upgrade plan and schedule the next queued plan
+		if err := k.ClearUpgradePlan(ctx); err != nil {
+			return err
+		}
+		return k.scheduleNextQueuedUpgrade(ctx)
+	}
+
 	// To make sure clear upgrade is executed at the same block
 	if plan.ShouldExecute(blockHeight) {
 		// If skip upgrade has been set for current height, we clear the upgrade plan
@@ -69,19 +84,31 @@ func (k Keeper) PreBlocker(ctx context.Context) error {
 			skipUpgradeMsg := fmt.Sprintf("UPGRADE \"%s\" SKIPPED at %d: %s", plan.Name, plan.Height, plan.Info)
 			k.Logger.Info(skipUpgradeMsg)
 
-			// Clear the upgrade plan at current height
+			// Clear the upgrade plan at current height and schedule the next queued plan
 			if err := k.ClearUpgradePlan(ctx); err != nil {
 				return err
 			}
-			return nil
+			return k.scheduleNextQueuedUpgrade(ctx)
 		}
 
 		// Prepare shutdown if we don't have an upgrade handler for this upgrade name (meaning this software is out of date)
 		if !k.HasHandler(plan.Name) {
+			// Verify the state and compute its fingerprint, so that the new binary can check it starts from it.
+			var fingerprint *types.StateFingerprint
+			if k.preUpgradeHandler != nil {
+				if err := k.preUpgradeHandler(ctx, plan); err != nil {
+					return fmt.Errorf("pre-upgrade handler failed for upgrade \"%s\": %w", plan.Name, err)
+				}
+
+				fingerprint, err = k.StateFingerprint(ctx)
+				if err != nil {
+					return err
+				}
+			}
+
 			// Write the upgrade info to disk. The UpgradeStoreLoader uses this info to perform or skip
 			// store migrations.
-			err := k.DumpUpgradeInfoToDisk(blockHeight, plan)
-			if err != nil {
+			if err := k.dumpUpgradeInfoToDisk(blockHeight, plan, fingerprint); err != nil {
 				return fmt.Errorf("unable to write upgrade info to filesystem: %w", err)
 			}
 
@@ -92,7 +119,12 @@ func (k Keeper) PreBlocker(ctx context.Context) error {
 			return errors.New(upgradeMsg)
 		}
 
-		// We have an upgrade handler for this upgrade name, so apply the upgrade
+		// We have an upgrade handler for this upgrade name, so apply the upgrade after checking that the state
+		// matches the one the previous binary halted at
+		if err := k.verifyStateFingerprint(ctx, plan); err != nil {
+			return err
+		}
+
 		k.Logger.Info(fmt.Sprintf("applying upgrade \"%s\" at %s", plan.Name, plan.DueAt()))
 		if err := k.ApplyUpgrade(ctx, plan); err != nil {
 			return err
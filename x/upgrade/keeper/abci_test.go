@@ -2,7 +2,10 @@ package keeper_test
 
 import (
 	"context"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"testing"
 	"time"
@@ -171,6 +174,158 @@ func TestCanOverwriteScheduleUpgrade(t *testing.T) {
 	s.VerifyDoUpgrade(t)
 }
 
+func TestScheduleUpgrades(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{15: true})
+
+	err := s.keeper.ScheduleUpgrades(s.ctx, nil)
+	require.ErrorIs(t, err, types.ErrInvalidUpgradeQueue)
+
+	err = s.keeper.ScheduleUpgrades(s.ctx, []types.Plan{{Name: "test", Height: 11}, {Name: "test", Height: 12}})
+	require.ErrorContains(t, err, "duplicate upgrade name test")
+
+	err = s.keeper.ScheduleUpgrades(s.ctx, []types.Plan{{Name: "test", Height: 11}, {Name: "test2", Height: 11}})
+	require.ErrorContains(t, err, "upgrade test2 must be scheduled after upgrade test")
+
+	err = s.keeper.ScheduleUpgrades(s.ctx, []types.Plan{{Name: "test", Height: 11}, {Name: "test2"}})
+	require.Error(t, err)
+
+	t.Log("Verify the first plan is the current plan and the others are queued")
+	plans := []types.Plan{{Name: "test", Height: 11}, {Name: "skipped", Height: 15}, {Name: "test2", Height: 20}}
+	require.NoError(t, s.keeper.ScheduleUpgrades(s.ctx, plans))
+
+	plan, err := s.keeper.GetUpgradePlan(s.ctx)
+	require.NoError(t, err)
+	require.Equal(t, plans[0], plan)
+	queue, err := s.keeper.GetUpgradeQueue(s.ctx)
+	require.NoError(t, err)
+	require.Equal(t, plans[1:], queue)
+
+	t.Log("Verify the next plan is scheduled once the upgrade is applied")
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 11})
+	s.keeper.SetUpgradeHandler("test", func(ctx context.Context, plan types.Plan, vm appmodule.VersionMap) (appmodule.VersionMap, error) {
+		return vm, nil
+	})
+	require.NoError(t, s.preModule.PreBlock(ctx))
+	s.VerifyDone(t, ctx, "test")
+
+	plan, err = s.keeper.GetUpgradePlan(ctx)
+	require.NoError(t, err)
+	require.Equal(t, plans[1], plan)
+
+	t.Log("Verify the next plan is scheduled once the upgrade is skipped")
+	ctx = s.ctx.WithHeaderInfo(header.Info{Height: 15})
+	require.NoError(t, s.preModule.PreBlock(ctx))
+	s.VerifyNotDone(t, ctx, "skipped")
+	plan, err = s.keeper.GetUpgradePlan(ctx)
+	require.NoError(t, err)
+	require.Equal(t, plans[2], plan)
+
+	ctx = s.ctx.WithHeaderInfo(header.Info{Height: 20})
+	s.VerifyDoUpgradeWithCtx(t, ctx, "test2")
+	s.VerifyDone(t, ctx, "test2")
+
+	queue, err = s.keeper.GetUpgradeQueue(ctx)
+	require.NoError(t, err)
+	require.Empty(t, queue)
+
+	t.Log("Verify completed upgrades can't be queued")
+	err = s.keeper.ScheduleUpgrades(ctx, []types.Plan{{Name: "test3", Height: 21}, {Name: "test", Height: 22}})
+	require.ErrorContains(t, err, "upgrade with name test has already been completed")
+}
+
+func TestScheduleUpgradeClearsQueue(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+
+	require.NoError(t, s.keeper.ScheduleUpgrades(s.ctx, []types.Plan{{Name: "test", Height: 11}, {Name: "test2", Height: 20}}))
+	require.NoError(t, s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: 11}))
+
+	queue, err := s.keeper.GetUpgradeQueue(s.ctx)
+	require.NoError(t, err)
+	require.Empty(t, queue)
+
+	s.VerifyDoUpgrade(t)
+}
+
+func TestPlanExpiry(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	err := s.keeper.SetParams(s.ctx, types.Params{PlanExpiryBlocks: 5})
+	require.NoError(t, err)
+	err = s.keeper.ScheduleUpgrades(s.ctx, []types.Plan{
+		{Name: "expired", Height: 11},
+		{Name: "next", Height: 30},
+	})
+	require.NoError(t, err)
+
+	t.Log("Verify that the node halts at the upgrade height and within the expiry")
+	for _, height := range []int64{11, 16} {
+		err = s.preModule.PreBlock(s.ctx.WithHeaderInfo(header.Info{Height: height}))
+		require.ErrorContains(t, err, "UPGRADE \"expired\" NEEDED at height: 11: ")
+	}
+
+	t.Log("Verify that the plan is cleared once expired and the next queued plan is scheduled")
+	newCtx := s.ctx.WithHeaderInfo(header.Info{Height: 17})
+	err = s.preModule.PreBlock(newCtx)
+	require.NoError(t, err)
+	s.VerifyNotDone(t, newCtx, "expired")
+	plan, err := s.keeper.GetUpgradePlan(newCtx)
+	require.NoError(t, err)
+	require.Equal(t, "next", plan.Name)
+}
+
+func TestPreUpgradeHandler(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	err := s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: 11})
+	require.NoError(t, err)
+
+	appHash := []byte{0x01, 0x02}
+	newCtx := s.ctx.WithHeaderInfo(header.Info{Height: 11, Time: time.Now(), AppHash: appHash})
+
+	t.Log("Verify that the node halts without upgrade info if the pre-upgrade handler fails")
+	s.keeper.SetPreUpgradeHandler(func(_ context.Context, plan types.Plan) error {
+		return fmt.Errorf("invariant broken before %s", plan.Name)
+	})
+	err = s.preModule.PreBlock(newCtx)
+	require.ErrorContains(t, err, "pre-upgrade handler failed for upgrade \"test\": invariant broken before test")
+	plan, err := s.keeper.ReadUpgradeInfoFromDisk()
+	require.NoError(t, err)
+	require.Empty(t, plan.Name)
+
+	t.Log("Verify that the state fingerprint is written to the upgrade info file")
+	var called int
+	s.keeper.SetPreUpgradeHandler(func(context.Context, types.Plan) error {
+		called++
+		return nil
+	})
+	err = s.preModule.PreBlock(newCtx)
+	require.ErrorContains(t, err, "UPGRADE \"test\" NEEDED at height: 11: ")
+	require.Equal(t, 1, called)
+
+	upgradeInfoPath, err := s.keeper.GetUpgradeInfoPath()
+	require.NoError(t, err)
+	data, err := os.ReadFile(upgradeInfoPath)
+	require.NoError(t, err)
+	var upgradeInfo types.UpgradeInfo
+	require.NoError(t, json.Unmarshal(data, &upgradeInfo))
+	require.Equal(t, "test", upgradeInfo.Name)
+	require.Equal(t, int64(11), upgradeInfo.Height)
+	require.NotNil(t, upgradeInfo.Fingerprint)
+	require.Equal(t, hex.EncodeToString(appHash), upgradeInfo.Fingerprint.AppHash)
+
+	t.Log("Verify that the upgrade isn't applied from a different state")
+	s.keeper.SetUpgradeHandler("test", func(_ context.Context, _ types.Plan, vm appmodule.VersionMap) (appmodule.VersionMap, error) {
+		return vm, nil
+	})
+	err = s.preModule.PreBlock(newCtx.WithHeaderInfo(header.Info{Height: 11, Time: time.Now(), AppHash: []byte{0x03}}))
+	require.ErrorContains(t, err, "state fingerprint mismatch for upgrade \"test\": expected app hash 0102, got 03")
+	s.VerifyNotDone(t, newCtx, "test")
+
+	t.Log("Verify that the upgrade is applied from the expected state")
+	err = s.preModule.PreBlock(newCtx)
+	require.NoError(t, err)
+	s.VerifyDone(t, newCtx, "test")
+	s.VerifyCleared(t, newCtx)
+}
+
 func TestHaltIfTooNew(t *testing.T) {
 	s := setupTest(t, 10, map[int64]bool{})
 	t.Log("Verify that we don't panic with registered plan not in database at all")
@@ -3,9 +3,14 @@ package keeper
 import (
 	"context"
 	"errors"
+	"net/url"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	upgradeplan "cosmossdk.io/x/upgrade/plan"
 	"cosmossdk.io/x/upgrade/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -77,3 +82,68 @@ func (k Keeper) ModuleVersions(ctx context.Context, req *types.QueryModuleVersio
 func (k Keeper) Authority(c context.Context, req *types.QueryAuthorityRequest) (*types.QueryAuthorityResponse, error) {
 	return &types.QueryAuthorityResponse{Address: k.authority}, nil
 }
+
+// UpgradeQueue implements the Query/UpgradeQueue gRPC method
+func (k Keeper) UpgradeQueue(ctx context.Context, req *types.QueryUpgradeQueueRequest) (*types.QueryUpgradeQueueResponse, error) {
+	plans, err := k.GetUpgradeQueue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUpgradeQueueResponse{Plans: plans}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// PlanArtifacts implements the Query/PlanArtifacts gRPC method
+func (k Keeper) PlanArtifacts(ctx context.Context, req *types.QueryPlanArtifactsRequest) (*types.QueryPlanArtifactsResponse, error) {
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		if errors.Is(err, types.ErrNoUpgradePlanFound) {
+			return &types.QueryPlanArtifactsResponse{}, nil
+		}
+
+		return nil, err
+	}
+
+	res := &types.QueryPlanArtifactsResponse{Name: plan.Name, Height: plan.Height}
+	info := strings.TrimSpace(plan.Info)
+	if len(info) == 0 {
+		return res, nil
+	}
+
+	// the node never downloads the plan info, which is left to the caller
+	if _, err := url.ParseRequestURI(info); err == nil {
+		res.InfoUrl = info
+		return res, nil
+	}
+
+	planInfo, err := upgradeplan.ParseInfo(info)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "plan %s doesn't list binaries: %v", plan.Name, err)
+	}
+
+	artifacts, err := planInfo.Artifacts()
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "plan %s lists invalid binaries: %v", plan.Name, err)
+	}
+
+	for _, artifact := range artifacts {
+		res.Artifacts = append(res.Artifacts, types.PlanArtifact{
+			Platform:  artifact.Platform,
+			Url:       artifact.URL,
+			Checksum:  artifact.Checksum,
+			Signature: artifact.Signature,
+		})
+	}
+
+	return res, nil
+}
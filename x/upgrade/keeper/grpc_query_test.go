@@ -239,6 +239,77 @@ func (suite *UpgradeTestSuite) TestAuthority() {
 	suite.Require().Equal(suite.encodedAuthority, res.Address)
 }
 
+func (suite *UpgradeTestSuite) TestPlanArtifacts() {
+	testCases := []struct {
+		msg         string
+		info        string
+		schedule    bool
+		expResponse *types.QueryPlanArtifactsResponse
+		expErr      string
+	}{
+		{
+			msg:         "without current upgrade plan",
+			expResponse: &types.QueryPlanArtifactsResponse{},
+		},
+		{
+			msg:         "without plan info",
+			schedule:    true,
+			expResponse: &types.QueryPlanArtifactsResponse{Name: "test-plan", Height: 5},
+		},
+		{
+			msg:         "with plan info url",
+			info:        "https://example.com/info.json?checksum=sha256:cafe",
+			schedule:    true,
+			expResponse: &types.QueryPlanArtifactsResponse{Name: "test-plan", Height: 5, InfoUrl: "https://example.com/info.json?checksum=sha256:cafe"},
+		},
+		{
+			msg:      "with binaries",
+			info:     `{"binaries":{"linux/amd64":"https://example.com/simd?checksum=sha256:cafe","any":"https://example.com/simd.zip"},"signatures":{"linux/amd64":"c2ln"}}`,
+			schedule: true,
+			expResponse: &types.QueryPlanArtifactsResponse{
+				Name:   "test-plan",
+				Height: 5,
+				Artifacts: []types.PlanArtifact{
+					{Platform: "any", Url: "https://example.com/simd.zip"},
+					{Platform: "linux/amd64", Url: "https://example.com/simd?checksum=sha256:cafe", Checksum: "sha256:cafe", Signature: "c2ln"},
+				},
+			},
+		},
+		{
+			msg:      "with plan info not listing binaries",
+			info:     "upgrade to v2",
+			schedule: true,
+			expErr:   "plan test-plan doesn't list binaries",
+		},
+		{
+			msg:      "with invalid binaries",
+			info:     `{"binaries":{"linux":"https://example.com/simd"}}`,
+			schedule: true,
+			expErr:   "plan test-plan lists invalid binaries",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			if tc.schedule {
+				err := suite.upgradeKeeper.ScheduleUpgrade(suite.ctx, types.Plan{Name: "test-plan", Height: 5, Info: tc.info})
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.queryClient.PlanArtifacts(context.Background(), &types.QueryPlanArtifactsRequest{})
+			if tc.expErr != "" {
+				suite.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expResponse, res)
+		})
+	}
+}
+
 func TestUpgradeTestSuite(t *testing.T) {
 	suite.Run(t, new(UpgradeTestSuite))
 }
@@ -3,11 +3,14 @@ package keeper
 import (
 	"context"
 	"encoding/binary"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 
@@ -34,6 +37,7 @@ type Keeper struct {
 	skipUpgradeHeights map[int64]bool                  // map of heights to skip for an upgrade
 	cdc                codec.BinaryCodec               // App-wide binary codec
 	upgradeHandlers    map[string]types.UpgradeHandler // map of plan name to upgrade handler
+	preUpgradeHandler  types.PreUpgradeHandler         // called before halting at the height of an upgrade without handler
 	versionModifier    app.VersionModifier             // implements setting the protocol version field on BaseApp
 	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
 	authority          string                          // the address capable of executing and canceling an upgrade. Usually the gov module account
@@ -90,6 +94,65 @@ func (k Keeper) SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandl
 	k.upgradeHandlers[name] = upgradeHandler
 }
 
+// SetPreUpgradeHandler sets the PreUpgradeHandler called when the node halts at the height of an upgrade it has no
+// UpgradeHandler for. When it is set, a StateFingerprint is also written to the upgrade info file, which is verified
+// by the binary applying the upgrade.
+func (k *Keeper) SetPreUpgradeHandler(preUpgradeHandler types.PreUpgradeHandler) {
+	k.preUpgradeHandler = preUpgradeHandler
+}
+
+// GetParams returns the module params, or the default params if they were never set.
+func (k Keeper) GetParams(ctx context.Context) (params types.Params, err error) {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ParamsKey())
+	if err != nil {
+		return params, err
+	}
+
+	if bz == nil {
+		return types.DefaultParams(), nil
+	}
+
+	err = k.cdc.Unmarshal(bz, &params)
+	return params, err
+}
+
+// SetParams sets the module params.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	return k.KVStoreService.OpenKVStore(ctx).Set(types.ParamsKey(), bz)
+}
+
+// IsEmergencyAuthority returns true if the given address is one of the emergency authorities of the params, which
+// in addition to the authority can cancel upgrades with MsgCancelUpgrade. They can't schedule upgrades.
+func (k Keeper) IsEmergencyAuthority(ctx context.Context, authority string) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.Contains(params.EmergencyAuthorities, authority), nil
+}
+
+// IsPlanExpired returns true if the given plan expired at the given height, that is if the chain went past the
+// plan expiry of the params after the height of the plan without applying it.
+func (k Keeper) IsPlanExpired(ctx context.Context, plan types.Plan, height int64) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return params.PlanExpiryBlocks > 0 && height > plan.Height+int64(params.PlanExpiryBlocks), nil
+}
+
 // SetModuleVersionMap saves a given version map to state
 func (k Keeper) SetModuleVersionMap(ctx context.Context, vm appmodule.VersionMap) error {
 	if len(vm) > 0 {
@@ -187,7 +250,8 @@ func (k Keeper) getModuleVersion(ctx context.Context, name string) (uint64, erro
 }
 
 // ScheduleUpgrade schedules an upgrade based on the specified plan.
-// If there is another Plan already scheduled, it will cancel and overwrite it.
+// If there is another Plan already scheduled, it will cancel and overwrite it,
+// along with any queued plans (see ScheduleUpgrades).
 // ScheduleUpgrade will also write the upgraded IBC ClientState to the upgraded client
 // path if it is specified in the plan.
 func (k Keeper) ScheduleUpgrade(ctx context.Context, plan types.Plan) error {
@@ -226,6 +290,10 @@ func (k Keeper) ScheduleUpgrade(ctx context.Context, plan types.Plan) error {
 		}
 	}
 
+	if err := k.ClearUpgradeQueue(ctx); err != nil {
+		return err
+	}
+
 	bz, err := k.cdc.Marshal(&plan)
 	if err != nil {
 		return err
@@ -411,7 +479,8 @@ func (k Keeper) HasHandler(name string) bool {
 }
 
 // ApplyUpgrade will execute the handler associated with the Plan and mark the plan as done.
-// If successful, it will increment the app version and clear the IBC state
+// If successful, it will increment the app version, clear the IBC state and
+// schedule the next queued plan, if any.
 func (k Keeper) ApplyUpgrade(ctx context.Context, plan types.Plan) error {
 	handler := k.upgradeHandlers[plan.Name]
 	if handler == nil {
@@ -457,7 +526,12 @@ func (k Keeper) ApplyUpgrade(ctx context.Context, plan types.Plan) error {
 		return err
 	}
 
-	return k.setDone(ctx, plan.Name)
+	err = k.setDone(ctx, plan.Name)
+	if err != nil {
+		return err
+	}
+
+	return k.scheduleNextQueuedUpgrade(ctx)
 }
 
 // IsSkipHeight checks if the given height is part of skipUpgradeHeights
@@ -467,15 +541,22 @@ func (k Keeper) IsSkipHeight(height int64) bool {
 
 // DumpUpgradeInfoToDisk writes upgrade information to UpgradeInfoFileName.
 func (k Keeper) DumpUpgradeInfoToDisk(height int64, p types.Plan) error {
+	return k.dumpUpgradeInfoToDisk(height, p, nil)
+}
+
+func (k Keeper) dumpUpgradeInfoToDisk(height int64, p types.Plan, fingerprint *types.StateFingerprint) error {
 	upgradeInfoFilePath, err := k.GetUpgradeInfoPath()
 	if err != nil {
 		return err
 	}
 
-	upgradeInfo := types.Plan{
-		Name:   p.Name,
-		Height: height,
-		Info:   p.Info,
+	upgradeInfo := types.UpgradeInfo{
+		Plan: types.Plan{
+			Name:   p.Name,
+			Height: height,
+			Info:   p.Info,
+		},
+		Fingerprint: fingerprint,
 	}
 	info, err := json.Marshal(upgradeInfo)
 	if err != nil {
@@ -500,7 +581,22 @@ func (k Keeper) GetUpgradeInfoPath() (string, error) {
 // the upgrade path directory cannot be created or if the file exists and
 // cannot be read or if the upgrade info fails to unmarshal.
 func (k Keeper) ReadUpgradeInfoFromDisk() (types.Plan, error) {
-	var upgradeInfo types.Plan
+	upgradeInfo, err := k.readUpgradeInfoFromDisk()
+	if err != nil {
+		return upgradeInfo.Plan, err
+	}
+
+	if upgradeInfo.Height > 0 {
+		telemetry.SetGaugeWithLabels([]string{"server", "info"}, 1, []metrics.Label{telemetry.NewLabel("upgrade_height", strconv.FormatInt(upgradeInfo.Height, 10))})
+	}
+
+	return upgradeInfo.Plan, nil
+}
+
+// readUpgradeInfoFromDisk returns the upgrade info written to disk by the old
+// binary when panicking, which is empty if there is no upgrade info file.
+func (k Keeper) readUpgradeInfoFromDisk() (types.UpgradeInfo, error) {
+	var upgradeInfo types.UpgradeInfo
 
 	upgradeInfoPath, err := k.GetUpgradeInfoPath()
 	if err != nil {
@@ -525,11 +621,52 @@ func (k Keeper) ReadUpgradeInfoFromDisk() (types.Plan, error) {
 		return upgradeInfo, err
 	}
 
-	if upgradeInfo.Height > 0 {
-		telemetry.SetGaugeWithLabels([]string{"server", "info"}, 1, []metrics.Label{telemetry.NewLabel("upgrade_height", strconv.FormatInt(upgradeInfo.Height, 10))})
+	return upgradeInfo, nil
+}
+
+// StateFingerprint returns the fingerprint of the current state, made of the
+// app hash of the current block and the module versions.
+func (k Keeper) StateFingerprint(ctx context.Context) (*types.StateFingerprint, error) {
+	vm, err := k.GetModuleVersionMap(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return upgradeInfo, nil
+	return &types.StateFingerprint{
+		AppHash:        hex.EncodeToString(k.HeaderService.HeaderInfo(ctx).AppHash),
+		ModuleVersions: vm,
+	}, nil
+}
+
+// verifyStateFingerprint checks that the current state matches the
+// StateFingerprint written to the upgrade info file by the binary which halted
+// for the given upgrade, if any.
+func (k Keeper) verifyStateFingerprint(ctx context.Context, plan types.Plan) error {
+	upgradeInfo, err := k.readUpgradeInfoFromDisk()
+	if err != nil {
+		return fmt.Errorf("unable to read upgrade info from filesystem: %w", err)
+	}
+
+	if upgradeInfo.Fingerprint == nil || upgradeInfo.Name != plan.Name || upgradeInfo.Height != k.HeaderService.HeaderInfo(ctx).Height {
+		return nil
+	}
+
+	fingerprint, err := k.StateFingerprint(ctx)
+	if err != nil {
+		return err
+	}
+
+	if fingerprint.AppHash != upgradeInfo.Fingerprint.AppHash {
+		return fmt.Errorf("state fingerprint mismatch for upgrade \"%s\": expected app hash %s, got %s",
+			plan.Name, upgradeInfo.Fingerprint.AppHash, fingerprint.AppHash)
+	}
+
+	if !maps.Equal(fingerprint.ModuleVersions, upgradeInfo.Fingerprint.ModuleVersions) {
+		return fmt.Errorf("state fingerprint mismatch for upgrade \"%s\": expected module versions %v, got %v",
+			plan.Name, upgradeInfo.Fingerprint.ModuleVersions, fingerprint.ModuleVersions)
+	}
+
+	return nil
 }
 
 // SetDowngradeVerified updates downgradeVerified.
@@ -77,10 +77,12 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.homeDir = homeDir
 
 	s.msgSrvr = keeper.NewMsgServerImpl(s.upgradeKeeper)
-	s.addrs = simtestutil.CreateIncrementalAccounts(1)
-	encodedAddr, err := ac.BytesToString(s.addrs[0].Bytes())
-	s.Require().NoError(err)
-	s.encodedAddrs = []string{encodedAddr}
+	s.addrs = simtestutil.CreateIncrementalAccounts(2)
+	s.encodedAddrs = make([]string, len(s.addrs))
+	for i, addr := range s.addrs {
+		s.encodedAddrs[i], err = ac.BytesToString(addr.Bytes())
+		s.Require().NoError(err)
+	}
 }
 
 func (s *KeeperTestSuite) TestReadUpgradeInfoFromDisk() {
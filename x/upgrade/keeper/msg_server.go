@@ -22,8 +22,9 @@ func NewMsgServerImpl(k *Keeper) types.MsgServer {
 }
 
 var (
-	_    types.MsgServer = msgServer{}
-	_, _ sdk.Msg         = &types.MsgSoftwareUpgrade{}, &types.MsgCancelUpgrade{}
+	_       types.MsgServer = msgServer{}
+	_, _, _ sdk.Msg         = &types.MsgSoftwareUpgrade{}, &types.MsgCancelUpgrade{}, &types.MsgScheduleUpgrades{}
+	_       sdk.Msg         = &types.MsgUpdateParams{}
 )
 
 // SoftwareUpgrade implements the Msg/SoftwareUpgrade Msg service.
@@ -41,9 +42,17 @@ func (k msgServer) SoftwareUpgrade(ctx context.Context, msg *types.MsgSoftwareUp
 }
 
 // CancelUpgrade implements the Msg/CancelUpgrade Msg service.
+// It can be submitted by the authority or by any of the emergency authorities.
 func (k msgServer) CancelUpgrade(ctx context.Context, msg *types.MsgCancelUpgrade) (*types.MsgCancelUpgradeResponse, error) {
 	if k.authority != msg.Authority {
-		return nil, errors.Wrapf(types.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+		isEmergencyAuthority, err := k.IsEmergencyAuthority(ctx, msg.Authority)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isEmergencyAuthority {
+			return nil, errors.Wrapf(types.ErrInvalidSigner, "expected %s or an emergency authority got %s", k.authority, msg.Authority)
+		}
 	}
 
 	err := k.ClearUpgradePlan(ctx)
@@ -51,5 +60,37 @@ func (k msgServer) CancelUpgrade(ctx context.Context, msg *types.MsgCancelUpgrad
 		return nil, err
 	}
 
+	err = k.ClearUpgradeQueue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.MsgCancelUpgradeResponse{}, nil
 }
+
+// ScheduleUpgrades implements the Msg/ScheduleUpgrades Msg service.
+func (k msgServer) ScheduleUpgrades(ctx context.Context, msg *types.MsgScheduleUpgrades) (*types.MsgScheduleUpgradesResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	err := k.Keeper.ScheduleUpgrades(ctx, msg.Plans)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleUpgradesResponse{}, nil
+}
+
+// UpdateParams implements the Msg/UpdateParams Msg service.
+func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -78,10 +78,9 @@ func (s *KeeperTestSuite) TestSoftwareUpgrade() {
 }
 
 func (s *KeeperTestSuite) TestCancelUpgrade() {
-	err := s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{
-		Name:   "some name",
-		Info:   "some info",
-		Height: 123450000,
+	err := s.upgradeKeeper.ScheduleUpgrades(s.ctx, []types.Plan{
+		{Name: "some name", Info: "some info", Height: 123450000},
+		{Name: "next name", Info: "some info", Height: 123460000},
 	})
 	s.Require().NoError(err)
 
@@ -126,7 +125,106 @@ func (s *KeeperTestSuite) TestCancelUpgrade() {
 				s.Require().NoError(err)
 				_, err := s.upgradeKeeper.GetUpgradePlan(s.ctx)
 				s.Require().ErrorIs(err, types.ErrNoUpgradePlanFound)
+				queue, err := s.upgradeKeeper.GetUpgradeQueue(s.ctx)
+				s.Require().NoError(err)
+				s.Require().Empty(queue)
 			}
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestEmergencyCancelUpgrade() {
+	err := s.upgradeKeeper.SetParams(s.ctx, types.Params{EmergencyAuthorities: []string{s.encodedAddrs[1]}})
+	s.Require().NoError(err)
+
+	err = s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "some name", Info: "some info", Height: 123450000})
+	s.Require().NoError(err)
+
+	// emergency authorities can't schedule upgrades
+	_, err = s.msgSrvr.SoftwareUpgrade(s.ctx, &types.MsgSoftwareUpgrade{
+		Authority: s.encodedAddrs[1],
+		Plan:      types.Plan{Name: "other name", Height: 123450000},
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = s.msgSrvr.CancelUpgrade(s.ctx, &types.MsgCancelUpgrade{Authority: s.encodedAddrs[0]})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+	_, err = s.upgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().NoError(err)
+
+	_, err = s.msgSrvr.CancelUpgrade(s.ctx, &types.MsgCancelUpgrade{Authority: s.encodedAddrs[1]})
+	s.Require().NoError(err)
+	_, err = s.upgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().ErrorIs(err, types.ErrNoUpgradePlanFound)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params, err := s.upgradeKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), params)
+
+	testCases := []struct {
+		name   string
+		req    *types.MsgUpdateParams
+		errMsg string
+	}{
+		{
+			"unauthorized authority address",
+			&types.MsgUpdateParams{Authority: s.encodedAddrs[0], Params: types.Params{PlanExpiryBlocks: 10}},
+			"expected authority account as only signer for proposal message",
+		},
+		{
+			"invalid emergency authority",
+			&types.MsgUpdateParams{Authority: s.encodedAuthority, Params: types.Params{EmergencyAuthorities: []string{"authority"}}},
+			"invalid emergency authority",
+		},
+		{
+			"duplicate emergency authority",
+			&types.MsgUpdateParams{Authority: s.encodedAuthority, Params: types.Params{EmergencyAuthorities: []string{s.encodedAddrs[1], s.encodedAddrs[1]}}},
+			"duplicate emergency authority",
+		},
+		{
+			"params updated successfully",
+			&types.MsgUpdateParams{Authority: s.encodedAuthority, Params: types.Params{EmergencyAuthorities: []string{s.encodedAddrs[1]}, PlanExpiryBlocks: 10}},
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgSrvr.UpdateParams(s.ctx, tc.req)
+			if tc.errMsg != "" {
+				s.Require().ErrorContains(err, tc.errMsg)
+				return
+			}
+
+			s.Require().NoError(err)
+			res, err := s.upgradeKeeper.Params(s.ctx, &types.QueryParamsRequest{})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.req.Params, res.Params)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestScheduleUpgrades() {
+	plans := []types.Plan{
+		{Name: "v1.1", Info: "some text here", Height: 123450000},
+		{Name: "v1.2", Info: "some text here", Height: 123550000},
+	}
+
+	_, err := s.msgSrvr.ScheduleUpgrades(s.ctx, &types.MsgScheduleUpgrades{Authority: s.encodedAddrs[0], Plans: plans})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = s.msgSrvr.ScheduleUpgrades(s.ctx, &types.MsgScheduleUpgrades{Authority: s.encodedAuthority})
+	s.Require().ErrorIs(err, types.ErrInvalidUpgradeQueue)
+
+	_, err = s.msgSrvr.ScheduleUpgrades(s.ctx, &types.MsgScheduleUpgrades{Authority: s.encodedAuthority, Plans: plans})
+	s.Require().NoError(err)
+
+	plan, err := s.upgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(plans[0], plan)
+
+	res, err := s.upgradeKeeper.UpgradeQueue(s.ctx, &types.QueryUpgradeQueueRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(plans[1:], res.Plans)
+}
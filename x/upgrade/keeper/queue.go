@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/go-metrics"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// ScheduleUpgrades schedules a sequence of upgrades, which must be in strictly
+// increasing order of height. The first plan becomes the current plan, as with
+// ScheduleUpgrade, and the other plans are queued: once an upgrade is applied
+// or skipped, the next queued plan becomes the current plan. Any previously
+// scheduled plan and queued plans are cancelled and overwritten.
+//
+// Note that as the binary applying an upgrade must not have a handler for the
+// following upgrade, each upgrade of the sequence requires its own binary.
+func (k Keeper) ScheduleUpgrades(ctx context.Context, plans []types.Plan) error {
+	if len(plans) == 0 {
+		return types.ErrInvalidUpgradeQueue.Wrap("no upgrade plans")
+	}
+
+	names := make(map[string]bool, len(plans))
+	for i, plan := range plans {
+		if err := plan.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if names[plan.Name] {
+			return types.ErrInvalidUpgradeQueue.Wrapf("duplicate upgrade name %s", plan.Name)
+		}
+		names[plan.Name] = true
+
+		if i == 0 {
+			continue
+		}
+
+		if plan.Height <= plans[i-1].Height {
+			return types.ErrInvalidUpgradeQueue.Wrapf("upgrade %s must be scheduled after upgrade %s", plan.Name, plans[i-1].Name)
+		}
+
+		doneHeight, err := k.GetDoneHeight(ctx, plan.Name)
+		if err != nil {
+			return err
+		}
+
+		if doneHeight != 0 {
+			return types.ErrInvalidUpgradeQueue.Wrapf("upgrade with name %s has already been completed", plan.Name)
+		}
+	}
+
+	// this also clears the previously queued plans
+	if err := k.ScheduleUpgrade(ctx, plans[0]); err != nil {
+		return err
+	}
+
+	store := k.KVStoreService.OpenKVStore(ctx)
+	for _, plan := range plans[1:] {
+		bz, err := k.cdc.Marshal(&plan)
+		if err != nil {
+			return err
+		}
+
+		if err := store.Set(types.QueuedPlanKey(plan.Height, plan.Name), bz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetUpgradeQueue returns the upgrade plans queued after the current plan, in
+// increasing order of height.
+func (k Keeper) GetUpgradeQueue(ctx context.Context) ([]types.Plan, error) {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	prefix := []byte{types.QueuedPlanByte}
+	it, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var plans []types.Plan
+	for ; it.Valid(); it.Next() {
+		var plan types.Plan
+		if err := k.cdc.Unmarshal(it.Value(), &plan); err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+
+	return plans, nil
+}
+
+// ClearUpgradeQueue clears the upgrade plans queued after the current plan.
+func (k Keeper) ClearUpgradeQueue(ctx context.Context) error {
+	plans, err := k.GetUpgradeQueue(ctx)
+	if err != nil {
+		return err
+	}
+
+	store := k.KVStoreService.OpenKVStore(ctx)
+	for _, plan := range plans {
+		if err := store.Delete(types.QueuedPlanKey(plan.Height, plan.Name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scheduleNextQueuedUpgrade makes the first queued plan, if any, the current
+// plan. It must only be called once the current plan was cleared.
+func (k Keeper) scheduleNextQueuedUpgrade(ctx context.Context) error {
+	plans, err := k.GetUpgradeQueue(ctx)
+	if err != nil || len(plans) == 0 {
+		return err
+	}
+
+	next := plans[0]
+	store := k.KVStoreService.OpenKVStore(ctx)
+	if err := store.Delete(types.QueuedPlanKey(next.Height, next.Name)); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&next)
+	if err != nil {
+		return err
+	}
+
+	if err := store.Set(types.PlanKey(), bz); err != nil {
+		return err
+	}
+
+	k.Logger.Info("scheduled next queued upgrade", "name", next.Name, "height", next.Height)
+	telemetry.SetGaugeWithLabels([]string{"server", "info"}, 1, []metrics.Label{telemetry.NewLabel("upgrade_height", strconv.FormatInt(next.Height, 10))})
+
+	return nil
+}
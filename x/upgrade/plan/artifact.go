@@ -0,0 +1,150 @@
+package plan
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	neturl "net/url"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Artifact is a binary listed in a Plan.Info, along with what it is verified against once downloaded.
+type Artifact struct {
+	// Platform is the os/arch string the binary is built for, or "any".
+	Platform string
+	// URL is where the binary can be downloaded.
+	URL string
+	// Checksum is the value of the checksum query parameter of the URL, e.g. "sha256:<hex>".
+	// go-getter verifies it when downloading the binary.
+	Checksum string
+	// Signature is the signature of the binary listed in the Plan.Info, if any.
+	Signature string
+}
+
+// SignatureVerifier verifies the signature of a downloaded artifact.
+// binaryPath is the path of the downloaded binary.
+type SignatureVerifier func(artifact Artifact, binaryPath string) error
+
+// ParseOptionSignatureVerifier returns a ParseOption that sets the SignatureVerifier of the ParseConfig.
+// When set, all fetched artifacts must have a valid signature.
+func ParseOptionSignatureVerifier(verifier SignatureVerifier) ParseOption {
+	return func(c *ParseConfig) {
+		c.SignatureVerifier = verifier
+	}
+}
+
+// NewEd25519SignatureVerifier returns a SignatureVerifier checking that the signature of an artifact
+// is the base64 encoded ed25519 signature of its binary by the given public key.
+func NewEd25519SignatureVerifier(pubKey ed25519.PublicKey) SignatureVerifier {
+	return func(artifact Artifact, binaryPath string) error {
+		sig, err := base64.StdEncoding.DecodeString(artifact.Signature)
+		if err != nil {
+			return fmt.Errorf("invalid signature encoding: %w", err)
+		}
+
+		bz, err := os.ReadFile(binaryPath)
+		if err != nil {
+			return fmt.Errorf("could not read binary: %w", err)
+		}
+
+		if !ed25519.Verify(pubKey, bz, sig) {
+			return errors.New("signature verification failed")
+		}
+
+		return nil
+	}
+}
+
+// Artifacts returns the artifacts listed in this Info, sorted by platform.
+// An error is returned if the binaries are invalid or if a signature has no corresponding binary.
+func (m Info) Artifacts() ([]Artifact, error) {
+	if err := m.Binaries.ValidateBasic(m.parseConfig.EnforceChecksum); err != nil {
+		return nil, err
+	}
+
+	for platform := range m.Signatures {
+		if _, ok := m.Binaries[platform]; !ok {
+			return nil, fmt.Errorf("signature for os/arch %s has no corresponding binary", platform)
+		}
+	}
+
+	artifacts := make([]Artifact, 0, len(m.Binaries))
+	for platform, url := range m.Binaries {
+		artifact, err := m.artifact(platform, url)
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, artifact)
+	}
+
+	sort.Slice(artifacts, func(i, j int) bool {
+		return artifacts[i].Platform < artifacts[j].Platform
+	})
+
+	return artifacts, nil
+}
+
+// Artifact returns the artifact for the given os/arch platform, or the "any" artifact if there is
+// no binary for this platform.
+func (m Info) Artifact(platform string) (Artifact, error) {
+	url, ok := m.Binaries[platform]
+	if !ok {
+		if url, ok = m.Binaries["any"]; !ok {
+			return Artifact{}, fmt.Errorf("no binary found for os/arch %s", platform)
+		}
+		platform = "any"
+	}
+
+	if err := ValidateURL(url, m.parseConfig.EnforceChecksum); err != nil {
+		return Artifact{}, fmt.Errorf("invalid url \"%s\" in binaries[%s]: %w", url, platform, err)
+	}
+
+	return m.artifact(platform, url)
+}
+
+func (m Info) artifact(platform, url string) (Artifact, error) {
+	u, err := neturl.Parse(url)
+	if err != nil {
+		return Artifact{}, fmt.Errorf("invalid url \"%s\" in binaries[%s]: %w", url, platform, err)
+	}
+
+	return Artifact{
+		Platform:  platform,
+		URL:       url,
+		Checksum:  u.Query().Get("checksum"),
+		Signature: m.Signatures[platform],
+	}, nil
+}
+
+// FetchArtifact downloads the artifact for the given os/arch platform into dstRoot, as DownloadUpgrade does,
+// and verifies it. The checksum is verified when downloading and, if a SignatureVerifier is configured,
+// the signature of the binary is verified once downloaded. If the verification fails, the downloaded binary
+// is removed.
+func (m Info) FetchArtifact(dstRoot, platform, daemonName string) (Artifact, error) {
+	artifact, err := m.Artifact(platform)
+	if err != nil {
+		return Artifact{}, err
+	}
+
+	verifier := m.parseConfig.SignatureVerifier
+	if verifier != nil && len(artifact.Signature) == 0 {
+		return Artifact{}, fmt.Errorf("missing signature for os/arch %s", artifact.Platform)
+	}
+
+	if err := DownloadUpgrade(dstRoot, artifact.URL, daemonName); err != nil {
+		return Artifact{}, fmt.Errorf("error downloading binary for os/arch %s: %w", artifact.Platform, err)
+	}
+
+	if verifier != nil {
+		binaryPath := filepath.Join(dstRoot, "bin", daemonName)
+		if err := verifier(artifact, binaryPath); err != nil {
+			_ = os.Remove(binaryPath)
+			return Artifact{}, fmt.Errorf("error verifying binary for os/arch %s: %w", artifact.Platform, err)
+		}
+	}
+
+	return artifact, nil
+}
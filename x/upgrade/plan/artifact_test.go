@@ -0,0 +1,92 @@
+package plan
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtifacts(t *testing.T) {
+	info, err := ParseInfo(`{"binaries":{"os2/arch2":"https://example.com/b?checksum=sha256:cafe","any":"https://example.com/a"},"signatures":{"os2/arch2":"c2ln"}}`)
+	require.NoError(t, err)
+
+	artifacts, err := info.Artifacts()
+	require.NoError(t, err)
+	require.Equal(t, []Artifact{
+		{Platform: "any", URL: "https://example.com/a"},
+		{Platform: "os2/arch2", URL: "https://example.com/b?checksum=sha256:cafe", Checksum: "sha256:cafe", Signature: "c2ln"},
+	}, artifacts)
+
+	artifact, err := info.Artifact("os1/arch1")
+	require.NoError(t, err)
+	require.Equal(t, "any", artifact.Platform)
+
+	info, err = ParseInfo(`{"binaries":{"os1/arch1":"https://example.com/a"}}`, ParseOptionEnforceChecksum(true))
+	require.NoError(t, err)
+	_, err = info.Artifacts()
+	require.ErrorContains(t, err, "missing checksum query parameter")
+	_, err = info.Artifact("os2/arch2")
+	require.EqualError(t, err, "no binary found for os/arch os2/arch2")
+
+	info, err = ParseInfo(`{"binaries":{"os1/arch1":"https://example.com/a"},"signatures":{"os2/arch2":"c2ln"}}`)
+	require.NoError(t, err)
+	_, err = info.Artifacts()
+	require.EqualError(t, err, "signature for os/arch os2/arch2 has no corresponding binary")
+}
+
+func TestFetchArtifact(t *testing.T) {
+	home := t.TempDir()
+	binary := NewTestFile("binary", "#!/usr/bin\necho 'I am a signed binary'\n")
+	binaryPath, err := binary.SaveIn(home)
+	require.NoError(t, err)
+	url := makeFileURL(t, binaryPath)
+
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, binary.Contents))
+	verifier := ParseOptionSignatureVerifier(NewEd25519SignatureVerifier(pubKey))
+
+	t.Run("checksum and signature verified", func(t *testing.T) {
+		dstRoot := filepath.Join(home, "valid")
+		info, err := ParseInfo(fmt.Sprintf(`{"binaries":{"any":%q},"signatures":{"any":%q}}`, url, signature), ParseOptionEnforceChecksum(true), verifier)
+		require.NoError(t, err)
+
+		artifact, err := info.FetchArtifact(dstRoot, "os1/arch1", "simd")
+		require.NoError(t, err)
+		require.Equal(t, signature, artifact.Signature)
+		requireFileEquals(t, filepath.Join(dstRoot, "bin", "simd"), binary)
+	})
+
+	t.Run("missing signature", func(t *testing.T) {
+		info, err := ParseInfo(fmt.Sprintf(`{"binaries":{"any":%q}}`, url), verifier)
+		require.NoError(t, err)
+
+		_, err = info.FetchArtifact(filepath.Join(home, "unsigned"), "any", "simd")
+		require.EqualError(t, err, "missing signature for os/arch any")
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		dstRoot := filepath.Join(home, "invalid")
+		otherSignature := base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, []byte("another binary")))
+		info, err := ParseInfo(fmt.Sprintf(`{"binaries":{"any":%q},"signatures":{"any":%q}}`, url, otherSignature), verifier)
+		require.NoError(t, err)
+
+		_, err = info.FetchArtifact(dstRoot, "any", "simd")
+		require.EqualError(t, err, "error verifying binary for os/arch any: signature verification failed")
+		require.NoFileExists(t, filepath.Join(dstRoot, "bin", "simd"))
+	})
+
+	t.Run("invalid checksum", func(t *testing.T) {
+		badURL := fileStr + binaryPath + checksumStr + "2c22e34510bd1d4ad2343cdc54f7165bccf30caef73f39af7dd1db2795a3da48"
+		info, err := ParseInfo(fmt.Sprintf(`{"binaries":{"any":%q}}`, badURL))
+		require.NoError(t, err)
+
+		_, err = info.FetchArtifact(filepath.Join(home, "checksum"), "any", "simd")
+		require.ErrorContains(t, err, "Checksums did not match")
+	})
+}
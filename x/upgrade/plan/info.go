@@ -18,6 +18,9 @@ type Info struct {
 	parseConfig ParseConfig
 
 	Binaries BinaryDownloadURLMap `json:"binaries"`
+	// Signatures are the optional signatures of the binaries, by os/arch strings.
+	// They are verified by the SignatureVerifier of the ParseConfig when fetching artifacts.
+	Signatures map[string]string `json:"signatures,omitempty"`
 }
 
 // BinaryDownloadURLMap is a map of os/architecture strings to a URL where the binary can be downloaded.
@@ -28,6 +31,8 @@ type ParseConfig struct {
 	// EnforceChecksum, if true, will cause all downloaded files to be checked against their checksums.
 	// When false, checksums are not enforced to be present in the url.
 	EnforceChecksum bool
+	// SignatureVerifier, if set, is used to verify the signatures of fetched artifacts.
+	SignatureVerifier SignatureVerifier
 }
 
 // ParseOption is used to configure the parsing of a Plan.Info string.
@@ -25,3 +25,11 @@ import (
 //
 // Please also refer to docs/core/upgrade.md for more information.
 type UpgradeHandler func(ctx context.Context, plan Plan, fromVM appmodule.VersionMap) (appmodule.VersionMap, error)
+
+// PreUpgradeHandler specifies the type of function that is called by a binary
+// halting at the height of an upgrade it has no UpgradeHandler for, before the
+// upgrade info file is written. It is typically used to verify the invariants
+// of the state which is about to be upgraded. If it returns an error, the node
+// halts without writing the upgrade info file, so that tools such as cosmovisor
+// don't switch to the new binary.
+type PreUpgradeHandler func(ctx context.Context, plan Plan) error
@@ -1,6 +1,9 @@
 package types
 
-import "fmt"
+import (
+	"encoding/binary"
+	"fmt"
+)
 
 const (
 	// ModuleName is the name of this module
@@ -28,6 +31,13 @@ const (
 	// VersionMapByte is a prefix to look up module names (key) and versions (value)
 	VersionMapByte = 0x2
 
+	// QueuedPlanByte is a prefix to look up the upgrade plans queued after the
+	// current plan by height and name. 0x3 was used by the protocol version.
+	QueuedPlanByte = 0x4
+
+	// ParamsByte specifies the Byte under which the module params are stored in the store
+	ParamsByte = 0x5
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -44,6 +54,21 @@ func PlanKey() []byte {
 	return []byte{PlanByte}
 }
 
+// ParamsKey is the key under which the module params are saved
+func ParamsKey() []byte {
+	return []byte{ParamsByte}
+}
+
+// QueuedPlanKey is the key under which a queued plan is saved, ordered by
+// height
+func QueuedPlanKey(height int64, name string) []byte {
+	key := make([]byte, 9+len(name))
+	key[0] = QueuedPlanByte
+	binary.BigEndian.PutUint64(key[1:9], uint64(height))
+	copy(key[9:], name)
+	return key
+}
+
 // UpgradedClientKey is the key under which the upgraded client state is saved
 // Connecting IBC chains can verify against the upgraded client in this path before
 // upgrading their clients
@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultParams returns the default params: no emergency authorities and
+// plans which never expire.
+func DefaultParams() Params {
+	return Params{}
+}
+
+// Validate does basic validation of the params.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.EmergencyAuthorities))
+	for _, authority := range p.EmergencyAuthorities {
+		if _, _, err := bech32.DecodeAndConvert(authority); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid emergency authority %s: %s", authority, err)
+		}
+
+		if seen[authority] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate emergency authority %s", authority)
+		}
+		seen[authority] = true
+	}
+
+	return nil
+}
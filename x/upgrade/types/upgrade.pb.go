@@ -223,11 +223,56 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
+// Params defines the parameters of the upgrade module.
+type Params struct {
+	// emergency_authorities are the addresses which, in addition to the authority,
+	// can cancel upgrades with MsgCancelUpgrade, without being able to schedule them.
+	EmergencyAuthorities []string `protobuf:"bytes,1,rep,name=emergency_authorities,json=emergencyAuthorities,proto3" json:"emergency_authorities,omitempty"`
+	// plan_expiry_blocks is the number of blocks after the height of an upgrade plan
+	// after which the plan is cleared if it wasn't applied, e.g. when the chain was
+	// restarted with an override. If zero, plans never expire.
+	PlanExpiryBlocks uint64 `protobuf:"varint,2,opt,name=plan_expiry_blocks,json=planExpiryBlocks,proto3" json:"plan_expiry_blocks,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
+	proto.RegisterType((*Params)(nil), "cosmos.upgrade.v1beta1.Params")
 }
 
 func init() {
@@ -235,41 +280,47 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xbf, 0x6e, 0xd3, 0x40,
-	0x18, 0xcf, 0xb5, 0x6e, 0x51, 0x2e, 0x42, 0x15, 0x26, 0xb4, 0xd7, 0xa8, 0x38, 0x96, 0xc5, 0x10,
-	0x55, 0x8a, 0x4d, 0x5b, 0x26, 0x33, 0x20, 0x92, 0x11, 0x90, 0x8a, 0x0b, 0x1d, 0x58, 0xa2, 0x4b,
-	0x7c, 0x71, 0xad, 0xda, 0x77, 0x96, 0xef, 0x12, 0xc8, 0x2b, 0x30, 0xf5, 0x11, 0x18, 0x11, 0x53,
-	0x87, 0x3e, 0x44, 0xc4, 0x54, 0x31, 0x21, 0x21, 0xf1, 0x27, 0x19, 0xca, 0xc6, 0x2b, 0xa0, 0xbb,
-	0xb3, 0xab, 0x08, 0x0a, 0x62, 0x60, 0x89, 0xbe, 0xef, 0xbb, 0xef, 0x97, 0xdf, 0x9f, 0x3b, 0xc3,
-	0x3b, 0x03, 0xc6, 0x53, 0xc6, 0xbd, 0x51, 0x16, 0xe5, 0x38, 0x24, 0xde, 0x78, 0xa7, 0x4f, 0x04,
-	0xde, 0x29, 0x7b, 0x37, 0xcb, 0x99, 0x60, 0xe6, 0xba, 0xde, 0x72, 0xcb, 0x69, 0xb1, 0xd5, 0xd8,
-	0x8c, 0x18, 0x8b, 0x12, 0xe2, 0xa9, 0xad, 0xfe, 0x68, 0xe8, 0x61, 0x3a, 0xd1, 0x90, 0x46, 0x3d,
-	0x62, 0x11, 0x53, 0xa5, 0x27, 0xab, 0x62, 0xda, 0xfc, 0x15, 0x20, 0xe2, 0x94, 0x70, 0x81, 0xd3,
-	0xac, 0x58, 0xd8, 0xd4, 0x4c, 0x3d, 0x8d, 0x2c, 0x68, 0xf5, 0xd1, 0x0d, 0x9c, 0xc6, 0x94, 0x79,
-	0xea, 0x57, 0x8f, 0x9c, 0x1f, 0x00, 0x1a, 0xfb, 0x09, 0xa6, 0xa6, 0x09, 0x0d, 0x8a, 0x53, 0x82,
-	0x80, 0x0d, 0x5a, 0xd5, 0x40, 0xd5, 0xe6, 0x03, 0x68, 0xc8, 0x7f, 0x47, 0x4b, 0x36, 0x68, 0xd5,
-	0x76, 0x1b, 0xae, 0xa6, 0x76, 0x4b, 0x6a, 0xf7, 0x59, 0x49, 0xdd, 0x59, 0x9b, 0x7e, 0x6e, 0x56,
-	0x4e, 0xbe, 0x34, 0xc1, 0xdb, 0x8b, 0xd3, 0x6d, 0x80, 0x40, 0xa0, 0x80, 0xe6, 0x3a, 0x5c, 0x3d,
-	0x22, 0x71, 0x74, 0x24, 0xd0, 0xb2, 0x0d, 0x5a, 0xcb, 0x41, 0xd1, 0x49, 0xb2, 0x98, 0x0e, 0x19,
-	0x32, 0x34, 0x99, 0xac, 0xcd, 0xc7, 0xf0, 0x56, 0x11, 0x4e, 0xd8, 0x1b, 0x24, 0x31, 0xa1, 0xa2,
-	0xc7, 0x05, 0x16, 0x04, 0xad, 0x28, 0xf6, 0xfa, 0x6f, 0xec, 0x0f, 0xe9, 0xa4, 0xb3, 0x84, 0x40,
-	0x70, 0xb3, 0x84, 0x75, 0x15, 0xea, 0x40, 0x82, 0x7c, 0xf4, 0xfd, 0x4d, 0x13, 0xbc, 0xbe, 0x38,
-	0xdd, 0x5e, 0xd3, 0x09, 0xb4, 0x79, 0x78, 0xec, 0x49, 0xa3, 0xce, 0x27, 0x00, 0x37, 0x0e, 0xd8,
-	0x50, 0xbc, 0xc4, 0x39, 0x79, 0xae, 0x91, 0xfb, 0x39, 0xcb, 0x18, 0xc7, 0x89, 0x59, 0x87, 0x2b,
-	0x22, 0x16, 0x49, 0x99, 0x82, 0x6e, 0x4c, 0x1b, 0xd6, 0x42, 0xc2, 0x07, 0x79, 0x9c, 0x89, 0x98,
-	0x51, 0x95, 0x46, 0x35, 0x58, 0x1c, 0x99, 0xf7, 0xa1, 0x91, 0x25, 0x98, 0x2a, 0x97, 0xb5, 0xdd,
-	0x2d, 0xf7, 0xea, 0xcb, 0x76, 0x25, 0x7f, 0xa7, 0x2a, 0xa3, 0x52, 0x31, 0x05, 0x0a, 0xe4, 0x3f,
-	0x92, 0x52, 0xdf, 0x9f, 0xb5, 0x1b, 0x05, 0x2a, 0x62, 0xe3, 0x4b, 0x44, 0x97, 0x51, 0x41, 0xa8,
-	0x90, 0x46, 0x9c, 0x05, 0x23, 0x7f, 0xd0, 0x8f, 0x80, 0xf3, 0x0e, 0xc0, 0xdb, 0x5d, 0x4c, 0x07,
-	0x24, 0xf9, 0xcf, 0x1e, 0xfd, 0xa7, 0xff, 0x26, 0xb3, 0xb5, 0x20, 0xf3, 0xaf, 0x42, 0x10, 0x70,
-	0x0e, 0xe1, 0xf5, 0x27, 0x2c, 0x1c, 0x25, 0xe4, 0x90, 0xe4, 0x5c, 0xe6, 0x78, 0xd5, 0x23, 0x44,
-	0xf0, 0xda, 0x58, 0x1f, 0x2b, 0x55, 0x46, 0x50, 0xb6, 0xfe, 0x86, 0x54, 0xf4, 0xe1, 0xac, 0xbd,
-	0x70, 0xc5, 0xf6, 0x5d, 0xf7, 0xde, 0x5e, 0xc7, 0x9f, 0x7e, 0xb3, 0x2a, 0xd3, 0x99, 0x05, 0xce,
-	0x67, 0x16, 0xf8, 0x3a, 0xb3, 0xc0, 0xc9, 0xdc, 0xaa, 0x9c, 0xcf, 0xad, 0xca, 0xc7, 0xb9, 0x55,
-	0x79, 0xb1, 0xa5, 0xd7, 0x79, 0x78, 0xec, 0xc6, 0xcc, 0x7b, 0x75, 0xf9, 0xe1, 0x8a, 0x49, 0x46,
-	0x78, 0x7f, 0x55, 0xbd, 0xaf, 0xbd, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x6a, 0x37, 0x42,
-	0xd7, 0x03, 0x00, 0x00,
+	// 631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0x3b, 0x50, 0xf8, 0xa5, 0x43, 0x7e, 0x41, 0xd7, 0x02, 0x43, 0xc5, 0x6d, 0xd3, 0x78,
+	0x68, 0x88, 0xdd, 0x15, 0xd0, 0x98, 0xd4, 0x83, 0xa1, 0xc4, 0x93, 0x92, 0xe0, 0xa2, 0x1c, 0xbc,
+	0x34, 0xd3, 0xee, 0xb0, 0x4c, 0xd8, 0x9d, 0xd9, 0xcc, 0x4c, 0x91, 0xbe, 0x05, 0x4f, 0xbc, 0x04,
+	0x8f, 0xc6, 0x13, 0x07, 0xce, 0x9e, 0x89, 0x27, 0xc2, 0xc9, 0xc4, 0xc4, 0x3f, 0x70, 0xc0, 0x9b,
+	0x6f, 0xc1, 0xcc, 0xcc, 0x2e, 0x36, 0x5a, 0x8d, 0x07, 0x2f, 0xcd, 0xcc, 0x33, 0xcf, 0xa7, 0xdf,
+	0xef, 0xf3, 0x9d, 0xdd, 0x85, 0x37, 0x7b, 0x5c, 0x26, 0x5c, 0xfa, 0xfd, 0x34, 0x12, 0x38, 0x24,
+	0xfe, 0xde, 0x52, 0x97, 0x28, 0xbc, 0x94, 0xef, 0xbd, 0x54, 0x70, 0xc5, 0x9d, 0x59, 0xdb, 0xe5,
+	0xe5, 0xd5, 0xac, 0xab, 0x32, 0x1f, 0x71, 0x1e, 0xc5, 0xc4, 0x37, 0x5d, 0xdd, 0xfe, 0xb6, 0x8f,
+	0xd9, 0xc0, 0x22, 0x95, 0x72, 0xc4, 0x23, 0x6e, 0x96, 0xbe, 0x5e, 0x65, 0xd5, 0xea, 0xcf, 0x80,
+	0xa2, 0x09, 0x91, 0x0a, 0x27, 0x69, 0xd6, 0x30, 0x6f, 0x95, 0x3a, 0x96, 0xcc, 0x64, 0xed, 0xd1,
+	0x55, 0x9c, 0x50, 0xc6, 0x7d, 0xf3, 0x6b, 0x4b, 0xf5, 0x6f, 0x00, 0x16, 0x37, 0x62, 0xcc, 0x1c,
+	0x07, 0x16, 0x19, 0x4e, 0x08, 0x02, 0x35, 0xd0, 0x28, 0x05, 0x66, 0xed, 0x3c, 0x80, 0x45, 0xfd,
+	0xef, 0x68, 0xac, 0x06, 0x1a, 0x53, 0xcb, 0x15, 0xcf, 0x4a, 0x7b, 0xb9, 0xb4, 0xf7, 0x34, 0x97,
+	0x6e, 0x4f, 0x1f, 0x7f, 0xac, 0x16, 0x0e, 0x3e, 0x55, 0xc1, 0xeb, 0x8b, 0xc3, 0x45, 0x80, 0x40,
+	0x60, 0x40, 0x67, 0x16, 0x4e, 0xee, 0x10, 0x1a, 0xed, 0x28, 0x34, 0x5e, 0x03, 0x8d, 0xf1, 0x20,
+	0xdb, 0x69, 0x31, 0xca, 0xb6, 0x39, 0x2a, 0x5a, 0x31, 0xbd, 0x76, 0x1e, 0xc3, 0x99, 0x2c, 0x9c,
+	0xb0, 0xd3, 0x8b, 0x29, 0x61, 0xaa, 0x23, 0x15, 0x56, 0x04, 0x4d, 0x18, 0xf5, 0xf2, 0x2f, 0xea,
+	0xab, 0x6c, 0xd0, 0x1e, 0x43, 0x20, 0xb8, 0x96, 0x63, 0x6b, 0x86, 0xda, 0xd4, 0x50, 0x0b, 0x7d,
+	0x7d, 0x55, 0x05, 0x2f, 0x2f, 0x0e, 0x17, 0xa7, 0x6d, 0x02, 0x4d, 0x19, 0xee, 0xfa, 0x7a, 0xd0,
+	0xfa, 0x07, 0x00, 0xe7, 0x36, 0xf9, 0xb6, 0x7a, 0x81, 0x05, 0x79, 0x66, 0xc9, 0x0d, 0xc1, 0x53,
+	0x2e, 0x71, 0xec, 0x94, 0xe1, 0x84, 0xa2, 0x2a, 0xce, 0x53, 0xb0, 0x1b, 0xa7, 0x06, 0xa7, 0x42,
+	0x22, 0x7b, 0x82, 0xa6, 0x8a, 0x72, 0x66, 0xd2, 0x28, 0x05, 0xc3, 0x25, 0xe7, 0x3e, 0x2c, 0xa6,
+	0x31, 0x66, 0x66, 0xca, 0xa9, 0xe5, 0x05, 0x6f, 0xf4, 0x65, 0x7b, 0x5a, 0xbf, 0x5d, 0xd2, 0x51,
+	0x99, 0x98, 0x02, 0x03, 0xb5, 0x1e, 0x69, 0xab, 0xef, 0x8e, 0x9a, 0x95, 0x8c, 0x8a, 0xf8, 0xde,
+	0x25, 0xb1, 0xc6, 0x99, 0x22, 0x4c, 0xe9, 0x41, 0xea, 0x43, 0x83, 0xfc, 0xc6, 0x3f, 0x02, 0xf5,
+	0x37, 0x00, 0xde, 0x58, 0xc3, 0xac, 0x47, 0xe2, 0x7f, 0x3c, 0x63, 0xeb, 0xc9, 0xdf, 0xd9, 0x6c,
+	0x0c, 0xd9, 0xfc, 0xa3, 0x11, 0x04, 0xea, 0x5b, 0xf0, 0xff, 0x75, 0x1e, 0xf6, 0x63, 0xb2, 0x45,
+	0x84, 0xa4, 0x7c, 0xf4, 0x43, 0x88, 0xe0, 0x7f, 0x7b, 0xf6, 0xd8, 0xb8, 0x2a, 0x06, 0xf9, 0xb6,
+	0x35, 0xa7, 0x1d, 0x9d, 0x1e, 0x35, 0x87, 0xae, 0xb8, 0x76, 0xdb, 0xbb, 0xb3, 0x52, 0x7f, 0x0b,
+	0xe0, 0xe4, 0x06, 0x16, 0x38, 0x91, 0xce, 0x3a, 0x9c, 0x21, 0x09, 0x11, 0x11, 0x61, 0xbd, 0x41,
+	0x07, 0xf7, 0xd5, 0x0e, 0x17, 0x54, 0x51, 0x22, 0x11, 0xa8, 0x8d, 0x37, 0x4a, 0x6d, 0x74, 0x7a,
+	0xd4, 0x2c, 0x67, 0x03, 0xad, 0x86, 0xa1, 0x20, 0x52, 0x6e, 0x2a, 0x41, 0x59, 0x14, 0x94, 0x2f,
+	0xb1, 0xd5, 0x1f, 0x94, 0x73, 0x0b, 0x3a, 0xfa, 0xce, 0x3a, 0x64, 0x3f, 0xa5, 0x62, 0xd0, 0xe9,
+	0xc6, 0xbc, 0xb7, 0x2b, 0x33, 0x5f, 0x57, 0xf4, 0xc9, 0x43, 0x73, 0xd0, 0x36, 0xf5, 0xd6, 0xbd,
+	0x91, 0x06, 0xef, 0x2e, 0xeb, 0x9c, 0xae, 0x0f, 0xe5, 0xb4, 0x7f, 0xf9, 0xf1, 0xb0, 0xae, 0xdb,
+	0xad, 0xe3, 0x2f, 0x6e, 0xe1, 0xf8, 0xcc, 0x05, 0x27, 0x67, 0x2e, 0xf8, 0x7c, 0xe6, 0x82, 0x83,
+	0x73, 0xb7, 0x70, 0x72, 0xee, 0x16, 0xde, 0x9f, 0xbb, 0x85, 0xe7, 0x0b, 0x16, 0x95, 0xe1, 0xae,
+	0x47, 0xf9, 0x10, 0xac, 0x06, 0x29, 0x91, 0xdd, 0x49, 0xf3, 0x82, 0xac, 0x7c, 0x1f, 0x00, 0xa6,
+	0x26, 0xf3, 0x9e, 0x98, 0x04, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -392,6 +443,38 @@ func (this *ModuleVersion) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.EmergencyAuthorities) != len(that1.EmergencyAuthorities) {
+		return false
+	}
+	for i := range this.EmergencyAuthorities {
+		if this.EmergencyAuthorities[i] != that1.EmergencyAuthorities[i] {
+			return false
+		}
+	}
+	if this.PlanExpiryBlocks != that1.PlanExpiryBlocks {
+		return false
+	}
+	return true
+}
 func (m *Plan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -573,6 +656,43 @@ func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PlanExpiryBlocks != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.PlanExpiryBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EmergencyAuthorities) > 0 {
+		for iNdEx := len(m.EmergencyAuthorities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EmergencyAuthorities[iNdEx])
+			copy(dAtA[i:], m.EmergencyAuthorities[iNdEx])
+			i = encodeVarintUpgrade(dAtA, i, uint64(len(m.EmergencyAuthorities[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -662,6 +782,24 @@ func (m *ModuleVersion) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EmergencyAuthorities) > 0 {
+		for _, s := range m.EmergencyAuthorities {
+			l = len(s)
+			n += 1 + l + sovUpgrade(uint64(l))
+		}
+	}
+	if m.PlanExpiryBlocks != 0 {
+		n += 1 + sovUpgrade(uint64(m.PlanExpiryBlocks))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1232,6 +1370,107 @@ func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyAuthorities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyAuthorities = append(m.EmergencyAuthorities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanExpiryBlocks", wireType)
+			}
+			m.PlanExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanExpiryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
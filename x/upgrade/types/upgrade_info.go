@@ -0,0 +1,21 @@
+package types
+
+// StateFingerprint identifies the state from which an upgrade is applied, so
+// that the new binary can verify it starts from the state the previous binary
+// halted at.
+type StateFingerprint struct {
+	// AppHash is the hex encoded app hash of the block at the upgrade height.
+	AppHash string `json:"app_hash"`
+	// ModuleVersions are the consensus versions of the modules before the
+	// upgrade.
+	ModuleVersions map[string]uint64 `json:"module_versions"`
+}
+
+// UpgradeInfo is the content of the upgrade info file written when a binary
+// halts at the height of an upgrade. It contains the fields of the Plan, with
+// the height of the upgrade, and the StateFingerprint if a PreUpgradeHandler
+// is set.
+type UpgradeInfo struct {
+	Plan
+	Fingerprint *StateFingerprint `json:"fingerprint,omitempty"`
+}